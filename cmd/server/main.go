@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	// Schedules name IANA timezones; embed the database for minimal images
+	_ "time/tzdata"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
@@ -13,6 +15,7 @@ import (
 	"github.com/go-tangra/go-tangra-common/registration"
 	"github.com/go-tangra/go-tangra-common/service"
 	"github.com/go-tangra/go-tangra-executor/cmd/server/assets"
+	executorService "github.com/go-tangra/go-tangra-executor/internal/service"
 )
 
 var (
@@ -29,6 +32,13 @@ func newApp(
 	gs *grpc.Server,
 	hs *kratosHttp.Server,
 	regClient *registration.Client,
+	reaper *executorService.ExecutionReaper,
+	orchestrator *executorService.RunOrchestrator,
+	scheduler *executorService.Scheduler,
+	workflowEngine *executorService.WorkflowEngine,
+	retryDispatcher *executorService.RetryDispatcher,
+	concurrencyGate *executorService.ConcurrencyGate,
+	commandQueue *executorService.CommandQueue,
 ) *kratos.App {
 	if regClient != nil {
 		// Populate the full registration config on the pre-created client
@@ -47,7 +57,8 @@ func newApp(
 		globalRegHelper = registration.StartRegistrationWithClient(ctx.GetLogger(), regClient)
 	}
 
-	return bootstrap.NewApp(ctx, gs, hs)
+	// The background loops run as servers so they start and stop with the app
+	return bootstrap.NewApp(ctx, gs, hs, reaper, orchestrator, scheduler, workflowEngine, retryDispatcher, concurrencyGate, commandQueue)
 }
 
 func runApp() error {
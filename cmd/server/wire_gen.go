@@ -43,26 +43,92 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	scriptService := service.NewScriptService(context, scriptRepo, assignmentRepo, portalClient)
-	assignmentService := service.NewAssignmentService(context, assignmentRepo, scriptRepo)
-	executionLogRepo := data.NewExecutionLogRepo(context, entClient)
-	commandRegistry := service.NewCommandRegistry()
-	executionService := service.NewExecutionService(context, scriptRepo, assignmentRepo, executionLogRepo, commandRegistry)
-	clientService := service.NewClientService(context, scriptRepo, assignmentRepo, executionLogRepo, commandRegistry)
+	scriptVersionRepo := data.NewScriptVersionRepo(context, entClient)
+	scriptChangeRequestRepo := data.NewScriptChangeRequestRepo(context, entClient, scriptRepo)
+	tenantSettingRepo := data.NewTenantSettingRepo(context, entClient)
+	scriptService := service.NewScriptService(context, scriptRepo, scriptVersionRepo, scriptChangeRequestRepo, assignmentRepo, tenantSettingRepo, portalClient)
+	clientRepo := data.NewClientRepo(context, entClient)
+	clientGroupRepo := data.NewClientGroupRepo(context, entClient)
+	assignmentResolver := service.NewAssignmentResolver(context, assignmentRepo, clientRepo, clientGroupRepo)
+	assignmentService := service.NewAssignmentService(context, assignmentRepo, scriptRepo, clientGroupRepo, assignmentResolver)
+	secretCipher, err := data.NewSecretCipher(context)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	executionLogRepo := data.NewExecutionLogRepo(context, entClient, secretCipher)
+	commandRepo := data.NewCommandRepo(context, entClient)
+	outputChunkRepo := data.NewOutputChunkRepo(context, entClient)
+	executionRunRepo := data.NewExecutionRunRepo(context, entClient)
+	redisClient, cleanup3, err := data.NewRedisClient(context)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	commandRegistry, cleanup4, err := service.NewCommandRegistry(context, redisClient)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	queuedCommandRepo := data.NewQueuedCommandRepo(context, entClient)
+	retryPlanner := service.NewRetryPlanner(context, scriptRepo, executionLogRepo)
+	commandQueue := service.NewCommandQueue(context, queuedCommandRepo, commandRepo, executionLogRepo, clientRepo, retryPlanner)
+	maintenanceWindowRepo := data.NewMaintenanceWindowRepo(context, entClient)
+	secretRepo := data.NewSecretRepo(context, entClient, secretCipher)
+	secretResolver := service.NewSecretResolver(context, secretRepo)
+	protectedClientRepo := data.NewProtectedClientRepo(context, entClient)
+	executionService := service.NewExecutionService(context, scriptRepo, assignmentResolver, executionLogRepo, commandRepo, outputChunkRepo, executionRunRepo, clientRepo, tenantSettingRepo, commandRegistry, commandQueue, retryPlanner, maintenanceWindowRepo, scriptVersionRepo, secretResolver, protectedClientRepo)
+	eventRuleRepo := data.NewEventRuleRepo(context, entClient)
+	eventEvaluator := service.NewEventEvaluator(context, executionService, eventRuleRepo, scriptRepo, clientRepo, assignmentResolver)
+	workflowRunRepo := data.NewWorkflowRunRepo(context, entClient)
+	workflowEngine := service.NewWorkflowEngine(context, executionService, workflowRunRepo, executionLogRepo, scriptRepo)
+	concurrencyGate := service.NewConcurrencyGate(context, executionService, executionLogRepo, scriptRepo, tenantSettingRepo)
+	signingKeyRepo := data.NewSigningKeyRepo(context, entClient, secretCipher)
+	commandSigner, cleanup5, err := service.NewCommandSigner(context, signingKeyRepo, secretCipher)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	clientService := service.NewClientService(context, scriptRepo, assignmentResolver, executionLogRepo, commandRepo, outputChunkRepo, clientRepo, commandRegistry, commandQueue, eventEvaluator, workflowEngine, retryPlanner, concurrencyGate, secretResolver, commandSigner)
 	statisticsRepo := data.NewStatisticsRepo(context, entClient)
 	statisticsService := service.NewStatisticsService(context, statisticsRepo)
 	backupService := service.NewBackupService(context, entClient)
 	collector := metrics.NewCollector(context)
-	grpcServer := server.NewGRPCServer(context, v, collector, scriptService, assignmentService, executionService, clientService, statisticsService, backupService)
+	settingsService := service.NewSettingsService(context, tenantSettingRepo, protectedClientRepo)
+	inventoryService := service.NewInventoryService(context, clientRepo, clientGroupRepo, assignmentRepo, assignmentResolver, commandRegistry, eventEvaluator)
+	scheduleRepo := data.NewScheduleRepo(context, entClient)
+	scheduleService := service.NewScheduleService(context, scheduleRepo, scriptRepo)
+	eventRuleService := service.NewEventRuleService(context, eventRuleRepo, scriptRepo)
+	workflowRepo := data.NewWorkflowRepo(context, entClient)
+	workflowService := service.NewWorkflowService(context, workflowRepo, workflowRunRepo, executionLogRepo, scriptRepo, assignmentResolver, workflowEngine)
+	maintenanceWindowService := service.NewMaintenanceWindowService(context, maintenanceWindowRepo)
+	secretService := service.NewSecretService(context, secretRepo)
+	signingKeyService := service.NewSigningKeyService(context, signingKeyRepo, commandSigner)
+	executionApprovalService := service.NewExecutionApprovalService(context, protectedClientRepo, executionLogRepo, scriptRepo, tenantSettingRepo, concurrencyGate)
+	grpcServer := server.NewGRPCServer(context, v, collector, scriptService, assignmentService, executionService, clientService, statisticsService, backupService, settingsService, inventoryService, scheduleService, eventRuleService, workflowService, maintenanceWindowService, secretService, signingKeyService, executionApprovalService)
 	httpServer := server.NewHTTPServer(context)
 
 	// Seed Prometheus metrics from database
 	seedCtx := viewer.NewSystemViewerContext(gocontext.Background())
 	collector.Seed(seedCtx, statisticsRepo)
 
-	app := newApp(context, grpcServer, httpServer, client)
+	executionReaper := service.NewExecutionReaper(context, executionLogRepo, scriptRepo, tenantSettingRepo, commandRepo, commandRegistry, collector, retryPlanner, eventEvaluator, workflowEngine, concurrencyGate)
+	runOrchestrator := service.NewRunOrchestrator(context, executionService, executionRunRepo)
+	scheduler := service.NewScheduler(context, executionService, scheduleRepo, scriptRepo)
+	retryDispatcher := service.NewRetryDispatcher(context, executionService, executionLogRepo, scriptRepo, executionRunRepo, tenantSettingRepo, eventEvaluator, workflowEngine)
+	app := newApp(context, grpcServer, httpServer, client, executionReaper, runOrchestrator, scheduler, workflowEngine, retryDispatcher, concurrencyGate, commandQueue)
 	return app, func() {
 		collector.Stop(gocontext.Background())
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 	}, nil
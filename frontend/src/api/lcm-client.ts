@@ -29,6 +29,11 @@ export interface ConnectedClient {
   clientId?: string;
   clientVersion?: string;
   connectedAt?: string;
+  lastSeenAt?: string;
+  load1?: number;
+  load5?: number;
+  load15?: number;
+  uptimeSeconds?: number;
 }
 
 export interface ListConnectedClientsResponse {
@@ -12,9 +12,35 @@ import { executorApi, type RequestOptions } from './client';
 export type ScriptType =
   | 'SCRIPT_TYPE_BASH'
   | 'SCRIPT_TYPE_JAVASCRIPT'
-  | 'SCRIPT_TYPE_LUA';
+  | 'SCRIPT_TYPE_LUA'
+  | 'SCRIPT_TYPE_PYTHON'
+  | 'SCRIPT_TYPE_POWERSHELL';
 
-export type TriggerType = 'TRIGGER_TYPE_CLIENT_PULL' | 'TRIGGER_TYPE_UI_PUSH';
+export type AssignmentTargetType =
+  | 'ASSIGNMENT_TARGET_TYPE_CLIENT'
+  | 'ASSIGNMENT_TARGET_TYPE_SELECTOR'
+  | 'ASSIGNMENT_TARGET_TYPE_GROUP';
+
+export type TriggerType =
+  | 'TRIGGER_TYPE_CLIENT_PULL'
+  | 'TRIGGER_TYPE_UI_PUSH'
+  | 'TRIGGER_TYPE_SCHEDULED'
+  | 'TRIGGER_TYPE_EVENT'
+  | 'TRIGGER_TYPE_WORKFLOW';
+
+export type EventType =
+  | 'EVENT_TYPE_UNSPECIFIED'
+  | 'EVENT_TYPE_CLIENT_FIRST_CONNECT'
+  | 'EVENT_TYPE_CLIENT_VERSION_CHANGED'
+  | 'EVENT_TYPE_CLIENT_LABELS_CHANGED'
+  | 'EVENT_TYPE_SCRIPT_FAILED';
+
+export interface ExecutionEvent {
+  type: EventType;
+  ruleId: string;
+  detail?: string;
+  sourceExecutionId?: string;
+}
 
 export type ExecutionStatus =
   | 'EXECUTION_STATUS_PENDING'
@@ -23,7 +49,10 @@ export type ExecutionStatus =
   | 'EXECUTION_STATUS_FAILED'
   | 'EXECUTION_STATUS_REJECTED_HASH_MISMATCH'
   | 'EXECUTION_STATUS_REJECTED_NOT_APPROVED'
-  | 'EXECUTION_STATUS_CLIENT_OFFLINE';
+  | 'EXECUTION_STATUS_CLIENT_OFFLINE'
+  | 'EXECUTION_STATUS_TIMED_OUT'
+  | 'EXECUTION_STATUS_CANCELLED'
+  | 'EXECUTION_STATUS_AWAITING_APPROVAL';
 
 // ==================== Entity Types ====================
 
@@ -41,6 +70,52 @@ export interface Script {
   updatedBy?: number;
   createTime: string;
   updateTime?: string;
+  timeoutSeconds?: number;
+  retryPolicy?: RetryPolicy;
+  singleton?: boolean;
+  concurrencyLimitAction?: ConcurrencyLimitAction;
+  parameters?: ScriptParameter[];
+}
+
+export type ParameterType =
+  | 'PARAMETER_TYPE_UNSPECIFIED'
+  | 'PARAMETER_TYPE_STRING'
+  | 'PARAMETER_TYPE_INTEGER'
+  | 'PARAMETER_TYPE_NUMBER'
+  | 'PARAMETER_TYPE_BOOLEAN';
+
+export type ParameterDelivery =
+  | 'PARAMETER_DELIVERY_UNSPECIFIED'
+  | 'PARAMETER_DELIVERY_ENV'
+  | 'PARAMETER_DELIVERY_ARG';
+
+// An input a script declares. Values come from the default, the assignments
+// reaching the client and the trigger, later ones taking precedence.
+export interface ScriptParameter {
+  name: string;
+  type?: ParameterType;
+  description?: string;
+  required?: boolean;
+  defaultValue?: string;
+  allowedValues?: string[];
+  secret?: boolean;
+  delivery?: ParameterDelivery;
+}
+
+// What happens to an execution over a concurrency limit
+export type ConcurrencyLimitAction =
+  | 'CONCURRENCY_LIMIT_ACTION_UNSPECIFIED'
+  | 'CONCURRENCY_LIMIT_ACTION_REJECT'
+  | 'CONCURRENCY_LIMIT_ACTION_QUEUE';
+
+// Retries of failed or undelivered executions. maxAttempts of 0 or 1 turns
+// retries off; the backoff doubles with every attempt up to maxBackoffSeconds.
+export interface RetryPolicy {
+  maxAttempts: number;
+  backoffSeconds?: number;
+  maxBackoffSeconds?: number;
+  retryableExitCodes?: number[];
+  retryableStatuses?: ExecutionStatus[];
 }
 
 export interface ScriptAssignment {
@@ -51,6 +126,17 @@ export interface ScriptAssignment {
   createdBy?: number;
   createTime: string;
   script?: Script;
+  targetType: AssignmentTargetType;
+  selector?: string;
+  groupId?: string;
+  parameters?: Record<string, string>;
+}
+
+// Exactly one of clientId, selector or groupId is set
+export interface AssignmentTarget {
+  clientId?: string;
+  selector?: string;
+  groupId?: string;
 }
 
 export interface ExecutionLog {
@@ -71,6 +157,303 @@ export interface ExecutionLog {
   durationMs?: number;
   createdBy?: number;
   createTime: string;
+  cancelRequestedAt?: string;
+  cancelledBy?: number;
+  cancelReason?: string;
+  runId?: string;
+  event?: ExecutionEvent;
+  workflowRunId?: string;
+  workflowStepId?: string;
+  attempt?: number;
+  originalExecutionId?: string;
+  nextRetryAt?: string;
+  retriedByExecutionId?: string;
+  waitingForSlot?: boolean;
+  heldUntil?: string;
+  maintenanceOverride?: string;
+  parameters?: Record<string, string>;
+  secretNames?: string[];
+  approvalExpiresAt?: string;
+  approvedBy?: number;
+  approveTime?: string;
+  approvalComment?: string;
+}
+
+export type RunStatus =
+  | 'RUN_STATUS_UNSPECIFIED'
+  | 'RUN_STATUS_RUNNING'
+  | 'RUN_STATUS_COMPLETED'
+  | 'RUN_STATUS_PAUSED'
+  | 'RUN_STATUS_HALTED'
+  | 'RUN_STATUS_ABORTED';
+
+export interface RunStrategy {
+  batchSize?: number;
+  canarySize?: number;
+  batchPauseSeconds?: number;
+  maxFailures?: number;
+}
+
+export interface RunProgress {
+  total: number;
+  pending: number;
+  running: number;
+  completed: number;
+  failed: number;
+  cancelled: number;
+}
+
+export interface ExecutionRun {
+  id: string;
+  tenantId: number;
+  scriptId: string;
+  scriptName: string;
+  selector?: string;
+  clientIds: string[];
+  status: RunStatus;
+  progress?: RunProgress;
+  createdBy?: number;
+  createTime: string;
+  completedAt?: string;
+  strategy?: RunStrategy;
+  targetCount: number;
+  dispatched: number;
+  currentBatch: number;
+  nextBatchAt?: string;
+  haltReason?: string;
+  scheduleId?: string;
+}
+
+export interface EventRule {
+  id: string;
+  tenantId: number;
+  name: string;
+  description?: string;
+  eventType: EventType;
+  scriptId: string;
+  selector?: string;
+  sourceScriptId?: string;
+  enabled: boolean;
+  lastFiredAt?: string;
+  createdBy?: number;
+  createTime: string;
+  updateTime?: string;
+}
+
+export type WorkflowStepCondition =
+  | 'WORKFLOW_STEP_CONDITION_UNSPECIFIED'
+  | 'WORKFLOW_STEP_CONDITION_ON_SUCCESS'
+  | 'WORKFLOW_STEP_CONDITION_ON_FAILURE'
+  | 'WORKFLOW_STEP_CONDITION_ALWAYS';
+
+export interface WorkflowStep {
+  id: string;
+  name?: string;
+  scriptId: string;
+  dependsOn?: string[];
+  condition?: WorkflowStepCondition;
+  successExitCodes?: number[];
+  outputPattern?: string;
+}
+
+export interface Workflow {
+  id: string;
+  tenantId: number;
+  name: string;
+  description?: string;
+  steps: WorkflowStep[];
+  createdBy?: number;
+  createTime: string;
+  updateTime?: string;
+}
+
+export type WorkflowRunStatus =
+  | 'WORKFLOW_RUN_STATUS_UNSPECIFIED'
+  | 'WORKFLOW_RUN_STATUS_RUNNING'
+  | 'WORKFLOW_RUN_STATUS_SUCCEEDED'
+  | 'WORKFLOW_RUN_STATUS_FAILED';
+
+export type WorkflowStepStatus =
+  | 'WORKFLOW_STEP_STATUS_UNSPECIFIED'
+  | 'WORKFLOW_STEP_STATUS_WAITING'
+  | 'WORKFLOW_STEP_STATUS_RUNNING'
+  | 'WORKFLOW_STEP_STATUS_SUCCEEDED'
+  | 'WORKFLOW_STEP_STATUS_FAILED'
+  | 'WORKFLOW_STEP_STATUS_SKIPPED';
+
+export interface WorkflowStepState {
+  stepId: string;
+  status: WorkflowStepStatus;
+  executionId?: string;
+  exitCode?: number;
+}
+
+export interface WorkflowRun {
+  id: string;
+  tenantId: number;
+  workflowId: string;
+  workflowName: string;
+  clientId: string;
+  status: WorkflowRunStatus;
+  steps: WorkflowStep[];
+  stepStates: WorkflowStepState[];
+  createdBy?: number;
+  createTime: string;
+  completedAt?: string;
+}
+
+export type CatchUpPolicy =
+  | 'CATCH_UP_POLICY_UNSPECIFIED'
+  | 'CATCH_UP_POLICY_SKIP'
+  | 'CATCH_UP_POLICY_RUN_ONCE'
+  | 'CATCH_UP_POLICY_RUN_ALL';
+
+export interface Schedule {
+  id: string;
+  tenantId: number;
+  name: string;
+  description?: string;
+  scriptId: string;
+  cronExpression: string;
+  timezone: string;
+  clientIds: string[];
+  selector?: string;
+  strategy?: RunStrategy;
+  enabled: boolean;
+  catchUpPolicy: CatchUpPolicy;
+  nextRunAt?: string;
+  lastRunAt?: string;
+  lastRunId?: string;
+  lastError?: string;
+  createdBy?: number;
+  createTime: string;
+  updateTime?: string;
+}
+
+export type MaintenanceWindowAction =
+  | 'MAINTENANCE_WINDOW_ACTION_UNSPECIFIED'
+  | 'MAINTENANCE_WINDOW_ACTION_REJECT'
+  | 'MAINTENANCE_WINDOW_ACTION_QUEUE'
+  | 'MAINTENANCE_WINDOW_ACTION_REQUIRE_OVERRIDE';
+
+export interface MaintenanceWindow {
+  id: string;
+  tenantId: number;
+  name: string;
+  description?: string;
+  clientPatterns: string[];
+  cronExpression: string;
+  durationMinutes: number;
+  timezone: string;
+  action: MaintenanceWindowAction;
+  enabled: boolean;
+  open: boolean;
+  nextOpenAt?: string;
+  createdBy?: number;
+  createTime: string;
+  updateTime?: string;
+}
+
+// A value scripts reference as {{secret "name"}}. The value is never returned.
+export interface Secret {
+  id: string;
+  tenantId: number;
+  name: string;
+  description?: string;
+  keyId: string;
+  createdBy?: number;
+  updatedBy?: number;
+  createTime: string;
+  updateTime?: string;
+}
+
+export type SigningAlgorithm =
+  | 'SIGNING_ALGORITHM_UNSPECIFIED'
+  | 'SIGNING_ALGORITHM_ED25519'
+  | 'SIGNING_ALGORITHM_ECDSA_P256';
+
+export type SigningKeyStatus =
+  | 'SIGNING_KEY_STATUS_UNSPECIFIED'
+  | 'SIGNING_KEY_STATUS_ACTIVE'
+  | 'SIGNING_KEY_STATUS_RETIRED'
+  | 'SIGNING_KEY_STATUS_REVOKED';
+
+// A key commands and scripts are signed with; only the public half is exposed
+export interface SigningKey {
+  id: string;
+  algorithm: SigningAlgorithm;
+  status: SigningKeyStatus;
+  publicKeyPem: string;
+  createTime: string;
+  retiredAt?: string;
+  revokedAt?: string;
+  publishedUntil?: string;
+}
+
+// A client whose executions wait for a second person's approval
+export interface ProtectedClient {
+  id: string;
+  tenantId: number;
+  clientId: string;
+  reason?: string;
+  createdBy?: number;
+  createTime: string;
+}
+
+// A saved revision of a script's content. Lists leave out the content.
+export interface ScriptVersion {
+  id: string;
+  scriptId: string;
+  version: number;
+  content?: string;
+  contentHash: string;
+  changeNote?: string;
+  restoredFromVersion?: number;
+  createdBy?: number;
+  createTime: string;
+  approvedBy?: number;
+  changeRequestId?: string;
+}
+
+export type ScriptChangeRequestStatus =
+  | 'SCRIPT_CHANGE_REQUEST_STATUS_PENDING'
+  | 'SCRIPT_CHANGE_REQUEST_STATUS_APPROVED'
+  | 'SCRIPT_CHANGE_REQUEST_STATUS_REJECTED'
+  | 'SCRIPT_CHANGE_REQUEST_STATUS_CANCELLED';
+
+export interface ScriptChangeComment {
+  authorId?: number;
+  authorName: string;
+  body: string;
+  createTime: string;
+}
+
+// A content change waiting for a second person when the tenant requires
+// script approval. Lists leave out the content.
+export interface ScriptChangeRequest {
+  id: string;
+  tenantId: number;
+  scriptId: string;
+  baseVersion: number;
+  content?: string;
+  contentHash: string;
+  changeNote?: string;
+  restoredFromVersion?: number;
+  status: ScriptChangeRequestStatus;
+  requestedBy?: number;
+  reviewedBy?: number;
+  reviewTime?: string;
+  reviewComment?: string;
+  rejectionReason?: string;
+  appliedVersion?: number;
+  comments?: ScriptChangeComment[];
+  createTime: string;
+  updateTime?: string;
+}
+
+export interface SkippedTarget {
+  clientId: string;
+  reason: string;
 }
 
 // ==================== Request/Response Types ====================
@@ -81,6 +464,12 @@ export interface CreateScriptRequest {
   scriptType: ScriptType;
   content: string;
   enabled?: boolean;
+  timeoutSeconds?: number;
+  retryPolicy?: RetryPolicy;
+  singleton?: boolean;
+  concurrencyLimitAction?: ConcurrencyLimitAction;
+  changeNote?: string;
+  parameters?: ScriptParameter[];
 }
 
 export interface UpdateScriptRequest {
@@ -89,6 +478,13 @@ export interface UpdateScriptRequest {
   content?: string;
   enabled?: boolean;
   password?: string;
+  timeoutSeconds?: number;
+  retryPolicy?: RetryPolicy;
+  singleton?: boolean;
+  concurrencyLimitAction?: ConcurrencyLimitAction;
+  changeNote?: string;
+  // Replaces all parameters when set
+  parameters?: { values: ScriptParameter[] };
 }
 
 export interface ListScriptsResponse {
@@ -96,6 +492,77 @@ export interface ListScriptsResponse {
   total: number;
 }
 
+export interface ListScriptVersionsResponse {
+  versions: ScriptVersion[];
+  total: number;
+}
+
+export type DiagnosticSeverity =
+  | 'DIAGNOSTIC_SEVERITY_UNSPECIFIED'
+  | 'DIAGNOSTIC_SEVERITY_INFO'
+  | 'DIAGNOSTIC_SEVERITY_WARNING'
+  | 'DIAGNOSTIC_SEVERITY_ERROR';
+
+// rule is "syntax" for parse errors; line and column are 0 when unknown
+export interface ScriptDiagnostic {
+  severity: DiagnosticSeverity;
+  rule: string;
+  message: string;
+  line: number;
+  column: number;
+}
+
+export interface LintRule {
+  id: string;
+  description: string;
+  severity: DiagnosticSeverity;
+  enabled: boolean;
+}
+
+export interface CreateScriptResponse {
+  script: Script;
+  diagnostics?: ScriptDiagnostic[];
+}
+
+// changeRequest is set when a content change awaits approval
+export interface UpdateScriptResponse {
+  script: Script;
+  changeRequest?: ScriptChangeRequest;
+  diagnostics?: ScriptDiagnostic[];
+}
+
+// blocked tells whether saving the content would be refused
+export interface ValidateScriptResponse {
+  diagnostics?: ScriptDiagnostic[];
+  blocked: boolean;
+  blockSeverity: DiagnosticSeverity;
+}
+
+export interface ListScriptChangeRequestsResponse {
+  changeRequests: ScriptChangeRequest[];
+  total: number;
+}
+
+export interface GetScriptChangeRequestResponse {
+  changeRequest: ScriptChangeRequest;
+  diff: string;
+  addedLines: number;
+  removedLines: number;
+}
+
+export interface DiffScriptVersionsResponse {
+  fromVersion: number;
+  toVersion: number;
+  diff: string;
+  addedLines: number;
+  removedLines: number;
+}
+
+export interface GetExecutionResponse {
+  execution: ExecutionLog;
+  scriptVersion?: ScriptVersion;
+}
+
 export interface ListAssignmentsResponse {
   assignments: ScriptAssignment[];
 }
@@ -109,6 +576,162 @@ export interface ListExecutionsResponse {
   total: number;
 }
 
+export interface TriggerExecutionResponse {
+  execution: ExecutionLog;
+  queued: boolean;
+}
+
+// Overrides maintenance windows that require it; the justification is recorded
+export interface TriggerExecutionOverride {
+  overrideMaintenanceWindow: boolean;
+  overrideJustification: string;
+}
+
+export interface TriggerRunResponse {
+  run: ExecutionRun;
+  skipped: SkippedTarget[];
+}
+
+export interface AbortRunResponse {
+  run: ExecutionRun;
+  cancelled: number;
+}
+
+export interface CreateScheduleRequest {
+  name: string;
+  description?: string;
+  scriptId: string;
+  cronExpression: string;
+  timezone?: string;
+  clientIds?: string[];
+  selector?: string;
+  strategy?: RunStrategy;
+  enabled?: boolean;
+  catchUpPolicy?: CatchUpPolicy;
+}
+
+export interface UpdateScheduleRequest {
+  name?: string;
+  description?: string;
+  cronExpression?: string;
+  timezone?: string;
+  clientIds?: { values: string[] };
+  selector?: string;
+  strategy?: RunStrategy;
+  enabled?: boolean;
+  catchUpPolicy?: CatchUpPolicy;
+}
+
+export interface CreateMaintenanceWindowRequest {
+  name: string;
+  description?: string;
+  clientPatterns: string[];
+  cronExpression: string;
+  durationMinutes: number;
+  timezone?: string;
+  action?: MaintenanceWindowAction;
+  enabled?: boolean;
+}
+
+export interface UpdateMaintenanceWindowRequest {
+  name?: string;
+  description?: string;
+  clientPatterns?: { values: string[] };
+  cronExpression?: string;
+  durationMinutes?: number;
+  timezone?: string;
+  action?: MaintenanceWindowAction;
+  enabled?: boolean;
+}
+
+export interface ListMaintenanceWindowsResponse {
+  windows: MaintenanceWindow[];
+  total: number;
+}
+
+export interface CreateSecretRequest {
+  name: string;
+  description?: string;
+  value: string;
+}
+
+export interface UpdateSecretRequest {
+  description?: string;
+  value?: string;
+}
+
+export interface ListProtectedClientsResponse {
+  clients: ProtectedClient[];
+  total: number;
+}
+
+export interface ListSecretsResponse {
+  secrets: Secret[];
+  total: number;
+}
+
+export interface CreateEventRuleRequest {
+  name: string;
+  description?: string;
+  eventType: EventType;
+  scriptId: string;
+  selector?: string;
+  sourceScriptId?: string;
+  enabled?: boolean;
+}
+
+export interface UpdateEventRuleRequest {
+  name?: string;
+  description?: string;
+  scriptId?: string;
+  selector?: string;
+  sourceScriptId?: string;
+  enabled?: boolean;
+}
+
+export interface ListEventRulesResponse {
+  rules: EventRule[];
+  total: number;
+}
+
+export interface CreateWorkflowRequest {
+  name: string;
+  description?: string;
+  steps: WorkflowStep[];
+}
+
+// Steps replace all existing steps when non-empty
+export interface UpdateWorkflowRequest {
+  name?: string;
+  description?: string;
+  steps?: WorkflowStep[];
+}
+
+export interface ListWorkflowsResponse {
+  workflows: Workflow[];
+  total: number;
+}
+
+export interface ListWorkflowRunsResponse {
+  runs: WorkflowRun[];
+  total: number;
+}
+
+export interface ListSchedulesResponse {
+  schedules: Schedule[];
+  total: number;
+}
+
+export interface ListRunsResponse {
+  runs: ExecutionRun[];
+  total: number;
+}
+
+export interface CancelExecutionResponse {
+  execution: ExecutionLog;
+  clientNotified: boolean;
+}
+
 export interface GetExecutionOutputResponse {
   output: string;
   errorOutput: string;
@@ -119,7 +742,16 @@ export interface GetExecutionOutputResponse {
 
 export const ScriptService = {
   create: (data: CreateScriptRequest, options?: RequestOptions) =>
-    executorApi.post<{ script: Script }>('/scripts', data, options),
+    executorApi.post<CreateScriptResponse>('/scripts', data, options),
+
+  validate: (
+    data: { scriptType: ScriptType; content: string },
+    options?: RequestOptions,
+  ) =>
+    executorApi.post<ValidateScriptResponse>('/scripts/validate', data, options),
+
+  listLintRules: (options?: RequestOptions) =>
+    executorApi.get<{ rules: LintRule[] }>('/lint-rules', options),
 
   get: (id: string, options?: RequestOptions) =>
     executorApi.get<{ script: Script }>(`/scripts/${id}`, options),
@@ -152,26 +784,152 @@ export const ScriptService = {
     id: string,
     data: UpdateScriptRequest,
     options?: RequestOptions,
-  ) => executorApi.put<{ script: Script }>(`/scripts/${id}`, data, options),
+  ) => executorApi.put<UpdateScriptResponse>(`/scripts/${id}`, data, options),
 
   delete: (id: string, options?: RequestOptions) =>
     executorApi.delete<void>(`/scripts/${id}`, options),
-};
-
-// ==================== Assignment Service ====================
 
-export const AssignmentService = {
+  listVersions: (
+    scriptId: string,
+    params?: { page?: number; pageSize?: number },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    const qs = query.toString();
+    return executorApi.get<ListScriptVersionsResponse>(
+      `/scripts/${scriptId}/versions${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  getVersion: (scriptId: string, version: number, options?: RequestOptions) =>
+    executorApi.get<{ version: ScriptVersion }>(
+      `/scripts/${scriptId}/versions/${version}`,
+      options,
+    ),
+
+  // toVersion defaults to the current version
+  diff: (
+    scriptId: string,
+    fromVersion: number,
+    toVersion?: number,
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    query.set('fromVersion', String(fromVersion));
+    if (toVersion) query.set('toVersion', String(toVersion));
+    return executorApi.get<DiffScriptVersionsResponse>(
+      `/scripts/${scriptId}/diff?${query.toString()}`,
+      options,
+    );
+  },
+
+  rollback: (
+    id: string,
+    version: number,
+    password: string,
+    changeNote?: string,
+    options?: RequestOptions,
+  ) =>
+    executorApi.post<UpdateScriptResponse>(
+      `/scripts/${id}/rollback`,
+      { version, password, changeNote: changeNote || undefined },
+      options,
+    ),
+
+  listChangeRequests: (
+    params?: {
+      scriptId?: string;
+      status?: ScriptChangeRequestStatus;
+      page?: number;
+      pageSize?: number;
+    },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.scriptId) query.set('scriptId', params.scriptId);
+    if (params?.status) query.set('status', params.status);
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    const qs = query.toString();
+    return executorApi.get<ListScriptChangeRequestsResponse>(
+      `/script-change-requests${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  getChangeRequest: (id: string, options?: RequestOptions) =>
+    executorApi.get<GetScriptChangeRequestResponse>(
+      `/script-change-requests/${id}`,
+      options,
+    ),
+
+  approveChangeRequest: (
+    id: string,
+    password: string,
+    comment?: string,
+    options?: RequestOptions,
+  ) =>
+    executorApi.post<{ changeRequest: ScriptChangeRequest; script: Script }>(
+      `/script-change-requests/${id}/approve`,
+      { password, comment: comment || undefined },
+      options,
+    ),
+
+  rejectChangeRequest: (id: string, reason: string, options?: RequestOptions) =>
+    executorApi.post<{ changeRequest: ScriptChangeRequest }>(
+      `/script-change-requests/${id}/reject`,
+      { reason },
+      options,
+    ),
+
+  cancelChangeRequest: (id: string, options?: RequestOptions) =>
+    executorApi.post<{ changeRequest: ScriptChangeRequest }>(
+      `/script-change-requests/${id}/cancel`,
+      {},
+      options,
+    ),
+
+  commentChangeRequest: (id: string, body: string, options?: RequestOptions) =>
+    executorApi.post<{ changeRequest: ScriptChangeRequest }>(
+      `/script-change-requests/${id}/comments`,
+      { body },
+      options,
+    ),
+};
+
+// ==================== Assignment Service ====================
+
+export const AssignmentService = {
   assign: (
     scriptId: string,
-    clientId: string,
+    target: AssignmentTarget,
+    parameters?: Record<string, string>,
     options?: RequestOptions,
   ) =>
     executorApi.post<{ assignment: ScriptAssignment }>(
       `/scripts/${scriptId}/assignments`,
-      { clientId },
+      { ...target, parameters },
+      options,
+    ),
+
+  // Replaces all parameter values; masked secret values keep their stored value
+  update: (
+    id: string,
+    parameters: Record<string, string>,
+    options?: RequestOptions,
+  ) =>
+    executorApi.put<{ assignment: ScriptAssignment }>(
+      `/assignments/${id}`,
+      { parameters },
       options,
     ),
 
+  delete: (id: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/assignments/${id}`, options),
+
   unassign: (
     scriptId: string,
     clientId: string,
@@ -217,22 +975,187 @@ export const ClientUpdateService = {
     ),
 };
 
+// ==================== Inventory Types ====================
+
+export interface ClientFacts {
+  hostname?: string;
+  os?: string;
+  osVersion?: string;
+  kernelVersion?: string;
+  arch?: string;
+  cpuCount?: number;
+  memoryBytes?: number;
+  // Empty for agents that run only Bash, JavaScript and Lua
+  scriptTypes?: ScriptType[];
+}
+
+export interface InventoryClient {
+  id: string;
+  clientId: string;
+  machineId: string;
+  clientVersion: string;
+  facts?: ClientFacts;
+  labels?: Record<string, string>;
+  description?: string;
+  online: boolean;
+  firstSeenAt: string;
+  lastSeenAt: string;
+  updatedBy?: number;
+  createTime: string;
+  updateTime?: string;
+}
+
+export interface ClientGroup {
+  id: string;
+  tenantId: number;
+  name: string;
+  description?: string;
+  selector?: string;
+  clientIds?: string[];
+  createdBy?: number;
+  createTime: string;
+  updateTime?: string;
+}
+
+export interface ListClientGroupsResponse {
+  groups: ClientGroup[];
+  total: number;
+}
+
+export interface CreateClientGroupRequest {
+  name: string;
+  description?: string;
+  selector?: string;
+  clientIds?: string[];
+}
+
+export interface UpdateClientGroupRequest {
+  name?: string;
+  description?: string;
+  selector?: string;
+  clientIds?: { values: string[] };
+}
+
+export interface ListClientGroupMembersResponse {
+  clients: InventoryClient[];
+  pendingClientIds?: string[];
+}
+
+export interface ListInventoryClientsResponse {
+  clients: InventoryClient[];
+  total: number;
+}
+
+export interface UpdateInventoryClientRequest {
+  description?: string;
+  labels?: { values: Record<string, string> };
+}
+
+// ==================== Inventory Service ====================
+
+export const InventoryService = {
+  list: (
+    params?: {
+      page?: number;
+      pageSize?: number;
+      query?: string;
+      online?: boolean;
+    },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    if (params?.query) query.set('query', params.query);
+    if (params?.online !== undefined)
+      query.set('online', String(params.online));
+    const qs = query.toString();
+    return executorApi.get<ListInventoryClientsResponse>(
+      `/inventory/clients${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  get: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ client: InventoryClient }>(
+      `/inventory/clients/${id}`,
+      options,
+    ),
+
+  update: (
+    id: string,
+    data: UpdateInventoryClientRequest,
+    options?: RequestOptions,
+  ) =>
+    executorApi.put<{ client: InventoryClient }>(
+      `/inventory/clients/${id}`,
+      data,
+      options,
+    ),
+
+  delete: (id: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/inventory/clients/${id}`, options),
+
+  createGroup: (data: CreateClientGroupRequest, options?: RequestOptions) =>
+    executorApi.post<{ group: ClientGroup }>('/inventory/groups', data, options),
+
+  listGroups: (
+    params?: { page?: number; pageSize?: number; name?: string },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    if (params?.name) query.set('name', params.name);
+    const qs = query.toString();
+    return executorApi.get<ListClientGroupsResponse>(
+      `/inventory/groups${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  getGroup: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ group: ClientGroup }>(`/inventory/groups/${id}`, options),
+
+  updateGroup: (
+    id: string,
+    data: UpdateClientGroupRequest,
+    options?: RequestOptions,
+  ) =>
+    executorApi.put<{ group: ClientGroup }>(
+      `/inventory/groups/${id}`,
+      data,
+      options,
+    ),
+
+  deleteGroup: (id: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/inventory/groups/${id}`, options),
+
+  listGroupMembers: (id: string, options?: RequestOptions) =>
+    executorApi.get<ListClientGroupMembersResponse>(
+      `/inventory/groups/${id}/members`,
+      options,
+    ),
+};
+
 // ==================== Execution Service ====================
 
 export const ExecutionService = {
   trigger: (
     scriptId: string,
     clientId: string,
+    override?: TriggerExecutionOverride,
+    parameters?: Record<string, string>,
     options?: RequestOptions,
   ) =>
-    executorApi.post<{ execution: ExecutionLog }>(
+    executorApi.post<TriggerExecutionResponse>(
       `/scripts/${scriptId}/execute`,
-      { clientId },
+      { clientId, ...override, parameters },
       options,
     ),
 
   get: (id: string, options?: RequestOptions) =>
-    executorApi.get<{ execution: ExecutionLog }>(`/executions/${id}`, options),
+    executorApi.get<GetExecutionResponse>(`/executions/${id}`, options),
 
   list: (
     params?: {
@@ -241,6 +1164,8 @@ export const ExecutionService = {
       scriptId?: string;
       clientId?: string;
       status?: string;
+      runId?: string;
+      originalExecutionId?: string;
     },
     options?: RequestOptions,
   ) => {
@@ -250,6 +1175,9 @@ export const ExecutionService = {
     if (params?.scriptId) query.set('scriptId', params.scriptId);
     if (params?.clientId) query.set('clientId', params.clientId);
     if (params?.status) query.set('status', params.status);
+    if (params?.runId) query.set('runId', params.runId);
+    if (params?.originalExecutionId)
+      query.set('originalExecutionId', params.originalExecutionId);
     const qs = query.toString();
     return executorApi.get<ListExecutionsResponse>(
       `/executions${qs ? `?${qs}` : ''}`,
@@ -257,9 +1185,370 @@ export const ExecutionService = {
     );
   },
 
+  cancel: (id: string, reason?: string, options?: RequestOptions) =>
+    executorApi.post<CancelExecutionResponse>(
+      `/executions/${id}/cancel`,
+      { reason: reason || undefined },
+      options,
+    ),
+
   getOutput: (id: string, options?: RequestOptions) =>
     executorApi.get<GetExecutionOutputResponse>(
       `/executions/${id}/output`,
       options,
     ),
+
+  triggerRun: (
+    scriptId: string,
+    target: { clientIds?: string[]; selector?: string },
+    strategy?: RunStrategy,
+    options?: RequestOptions,
+  ) =>
+    executorApi.post<TriggerRunResponse>(
+      `/scripts/${scriptId}/runs`,
+      {
+        clientIds: target.clientIds ?? [],
+        selector: target.selector || undefined,
+        strategy,
+      },
+      options,
+    ),
+
+  pauseRun: (id: string, options?: RequestOptions) =>
+    executorApi.post<{ run: ExecutionRun }>(`/runs/${id}/pause`, {}, options),
+
+  resumeRun: (id: string, options?: RequestOptions) =>
+    executorApi.post<{ run: ExecutionRun }>(`/runs/${id}/resume`, {}, options),
+
+  abortRun: (id: string, reason?: string, options?: RequestOptions) =>
+    executorApi.post<AbortRunResponse>(
+      `/runs/${id}/abort`,
+      { reason: reason || undefined },
+      options,
+    ),
+
+  getRun: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ run: ExecutionRun }>(`/runs/${id}`, options),
+
+  listRuns: (
+    params?: {
+      page?: number;
+      pageSize?: number;
+      scriptId?: string;
+      status?: RunStatus;
+    },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    if (params?.scriptId) query.set('scriptId', params.scriptId);
+    if (params?.status) query.set('status', params.status);
+    const qs = query.toString();
+    return executorApi.get<ListRunsResponse>(
+      `/runs${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+};
+
+// ==================== Schedule Service ====================
+
+export const ScheduleService = {
+  list: (
+    params?: {
+      page?: number;
+      pageSize?: number;
+      scriptId?: string;
+      enabled?: boolean;
+    },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    if (params?.scriptId) query.set('scriptId', params.scriptId);
+    if (params?.enabled !== undefined)
+      query.set('enabled', String(params.enabled));
+    const qs = query.toString();
+    return executorApi.get<ListSchedulesResponse>(
+      `/schedules${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  get: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ schedule: Schedule }>(`/schedules/${id}`, options),
+
+  create: (data: CreateScheduleRequest, options?: RequestOptions) =>
+    executorApi.post<{ schedule: Schedule }>('/schedules', data, options),
+
+  update: (id: string, data: UpdateScheduleRequest, options?: RequestOptions) =>
+    executorApi.put<{ schedule: Schedule }>(`/schedules/${id}`, data, options),
+
+  delete: (id: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/schedules/${id}`, options),
+};
+
+// ==================== Maintenance Window Service ====================
+
+export const MaintenanceWindowService = {
+  list: (
+    params?: {
+      page?: number;
+      pageSize?: number;
+      enabled?: boolean;
+      clientId?: string;
+    },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    if (params?.enabled !== undefined)
+      query.set('enabled', String(params.enabled));
+    if (params?.clientId) query.set('clientId', params.clientId);
+    const qs = query.toString();
+    return executorApi.get<ListMaintenanceWindowsResponse>(
+      `/maintenance-windows${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  get: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ window: MaintenanceWindow }>(
+      `/maintenance-windows/${id}`,
+      options,
+    ),
+
+  create: (data: CreateMaintenanceWindowRequest, options?: RequestOptions) =>
+    executorApi.post<{ window: MaintenanceWindow }>(
+      '/maintenance-windows',
+      data,
+      options,
+    ),
+
+  update: (
+    id: string,
+    data: UpdateMaintenanceWindowRequest,
+    options?: RequestOptions,
+  ) =>
+    executorApi.put<{ window: MaintenanceWindow }>(
+      `/maintenance-windows/${id}`,
+      data,
+      options,
+    ),
+
+  delete: (id: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/maintenance-windows/${id}`, options),
+};
+
+// ==================== Execution Approval Service ====================
+
+export const ExecutionApprovalService = {
+  listProtectedClients: (
+    params?: { page?: number; pageSize?: number },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    const qs = query.toString();
+    return executorApi.get<ListProtectedClientsResponse>(
+      `/protected-clients${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  protectClient: (
+    data: { clientId: string; reason?: string },
+    options?: RequestOptions,
+  ) =>
+    executorApi.post<{ client: ProtectedClient }>(
+      '/protected-clients',
+      data,
+      options,
+    ),
+
+  unprotectClient: (clientId: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/protected-clients/${clientId}`, options),
+
+  approve: (id: string, comment?: string, options?: RequestOptions) =>
+    executorApi.post<{ execution: ExecutionLog }>(
+      `/executions/${id}/approve`,
+      comment ? { comment } : {},
+      options,
+    ),
+
+  deny: (id: string, reason: string, options?: RequestOptions) =>
+    executorApi.post<{ execution: ExecutionLog }>(
+      `/executions/${id}/deny`,
+      { reason },
+      options,
+    ),
+};
+
+// ==================== Secret Service ====================
+
+export const SecretService = {
+  list: (
+    params?: { page?: number; pageSize?: number },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    const qs = query.toString();
+    return executorApi.get<ListSecretsResponse>(
+      `/secrets${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  get: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ secret: Secret }>(`/secrets/${id}`, options),
+
+  create: (data: CreateSecretRequest, options?: RequestOptions) =>
+    executorApi.post<{ secret: Secret }>('/secrets', data, options),
+
+  update: (id: string, data: UpdateSecretRequest, options?: RequestOptions) =>
+    executorApi.put<{ secret: Secret }>(`/secrets/${id}`, data, options),
+
+  delete: (id: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/secrets/${id}`, options),
+};
+
+// ==================== Signing Key Service ====================
+
+export const SigningKeyService = {
+  list: (params?: { includeRevoked?: boolean }, options?: RequestOptions) =>
+    executorApi.get<{ keys: SigningKey[] }>(
+      `/signing-keys${params?.includeRevoked ? '?includeRevoked=true' : ''}`,
+      options,
+    ),
+
+  rotate: (algorithm?: SigningAlgorithm, options?: RequestOptions) =>
+    executorApi.post<{ key: SigningKey }>(
+      '/signing-keys/rotate',
+      algorithm ? { algorithm } : {},
+      options,
+    ),
+
+  revoke: (id: string, options?: RequestOptions) =>
+    executorApi.post<{ key: SigningKey }>(
+      `/signing-keys/${id}/revoke`,
+      {},
+      options,
+    ),
+};
+
+// ==================== Event Rule Service ====================
+
+export const EventRuleService = {
+  list: (
+    params?: {
+      page?: number;
+      pageSize?: number;
+      eventType?: EventType;
+      scriptId?: string;
+      enabled?: boolean;
+    },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    if (params?.eventType) query.set('eventType', params.eventType);
+    if (params?.scriptId) query.set('scriptId', params.scriptId);
+    if (params?.enabled !== undefined)
+      query.set('enabled', String(params.enabled));
+    const qs = query.toString();
+    return executorApi.get<ListEventRulesResponse>(
+      `/event-rules${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  get: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ rule: EventRule }>(`/event-rules/${id}`, options),
+
+  create: (data: CreateEventRuleRequest, options?: RequestOptions) =>
+    executorApi.post<{ rule: EventRule }>('/event-rules', data, options),
+
+  update: (
+    id: string,
+    data: UpdateEventRuleRequest,
+    options?: RequestOptions,
+  ) =>
+    executorApi.put<{ rule: EventRule }>(`/event-rules/${id}`, data, options),
+
+  delete: (id: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/event-rules/${id}`, options),
+};
+
+// ==================== Workflow Service ====================
+
+export const WorkflowService = {
+  list: (
+    params?: { page?: number; pageSize?: number },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    const qs = query.toString();
+    return executorApi.get<ListWorkflowsResponse>(
+      `/workflows${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
+
+  get: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ workflow: Workflow }>(`/workflows/${id}`, options),
+
+  create: (data: CreateWorkflowRequest, options?: RequestOptions) =>
+    executorApi.post<{ workflow: Workflow }>('/workflows', data, options),
+
+  update: (
+    id: string,
+    data: UpdateWorkflowRequest,
+    options?: RequestOptions,
+  ) =>
+    executorApi.put<{ workflow: Workflow }>(`/workflows/${id}`, data, options),
+
+  delete: (id: string, options?: RequestOptions) =>
+    executorApi.delete<void>(`/workflows/${id}`, options),
+
+  trigger: (workflowId: string, clientId: string, options?: RequestOptions) =>
+    executorApi.post<{ run: WorkflowRun }>(
+      `/workflows/${workflowId}/runs`,
+      { clientId },
+      options,
+    ),
+
+  getRun: (id: string, options?: RequestOptions) =>
+    executorApi.get<{ run: WorkflowRun }>(`/workflow-runs/${id}`, options),
+
+  listRuns: (
+    params?: {
+      page?: number;
+      pageSize?: number;
+      workflowId?: string;
+      clientId?: string;
+      status?: WorkflowRunStatus;
+    },
+    options?: RequestOptions,
+  ) => {
+    const query = new URLSearchParams();
+    if (params?.page) query.set('page', String(params.page));
+    if (params?.pageSize) query.set('pageSize', String(params.pageSize));
+    if (params?.workflowId) query.set('workflowId', params.workflowId);
+    if (params?.clientId) query.set('clientId', params.clientId);
+    if (params?.status) query.set('status', params.status);
+    const qs = query.toString();
+    return executorApi.get<ListWorkflowRunsResponse>(
+      `/workflow-runs${qs ? `?${qs}` : ''}`,
+      options,
+    );
+  },
 };
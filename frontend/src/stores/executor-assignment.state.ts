@@ -2,6 +2,7 @@ import { defineStore } from 'pinia';
 
 import {
   AssignmentService,
+  type AssignmentTarget,
   type ListAssignmentsResponse,
   type ListClientScriptsResponse,
   type ScriptAssignment,
@@ -12,9 +13,21 @@ export const useExecutorAssignmentStore = defineStore(
   () => {
     async function assignScript(
       scriptId: string,
-      clientId: string,
+      target: AssignmentTarget,
+      parameters?: Record<string, string>,
+    ): Promise<{ assignment: ScriptAssignment }> {
+      return await AssignmentService.assign(scriptId, target, parameters);
+    }
+
+    async function updateAssignmentParameters(
+      id: string,
+      parameters: Record<string, string>,
     ): Promise<{ assignment: ScriptAssignment }> {
-      return await AssignmentService.assign(scriptId, clientId);
+      return await AssignmentService.update(id, parameters);
+    }
+
+    async function deleteAssignment(id: string): Promise<void> {
+      return await AssignmentService.delete(id);
     }
 
     async function unassignScript(
@@ -41,7 +54,9 @@ export const useExecutorAssignmentStore = defineStore(
     return {
       $reset,
       assignScript,
+      updateAssignmentParameters,
       unassignScript,
+      deleteAssignment,
       listAssignments,
       listClientScripts,
     };
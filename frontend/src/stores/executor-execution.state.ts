@@ -3,10 +3,13 @@ import { defineStore } from 'pinia';
 import {
   ExecutionService,
   ClientUpdateService,
-  type ExecutionLog,
+  type CancelExecutionResponse,
   type GetExecutionOutputResponse,
+  type GetExecutionResponse,
   type ListExecutionsResponse,
   type TriggerClientUpdateResponse,
+  type TriggerExecutionOverride,
+  type TriggerExecutionResponse,
 } from '../api/services';
 
 export const useExecutorExecutionStore = defineStore(
@@ -15,13 +18,18 @@ export const useExecutorExecutionStore = defineStore(
     async function triggerExecution(
       scriptId: string,
       clientId: string,
-    ): Promise<{ execution: ExecutionLog }> {
-      return await ExecutionService.trigger(scriptId, clientId);
+      override?: TriggerExecutionOverride,
+      parameters?: Record<string, string>,
+    ): Promise<TriggerExecutionResponse> {
+      return await ExecutionService.trigger(
+        scriptId,
+        clientId,
+        override,
+        parameters,
+      );
     }
 
-    async function getExecution(
-      id: string,
-    ): Promise<{ execution: ExecutionLog }> {
+    async function getExecution(id: string): Promise<GetExecutionResponse> {
       return await ExecutionService.get(id);
     }
 
@@ -48,6 +56,13 @@ export const useExecutorExecutionStore = defineStore(
       return await ExecutionService.getOutput(id);
     }
 
+    async function cancelExecution(
+      id: string,
+      reason?: string,
+    ): Promise<CancelExecutionResponse> {
+      return await ExecutionService.cancel(id, reason);
+    }
+
     async function triggerClientUpdate(
       clientId: string,
       targetVersion?: string,
@@ -63,6 +78,7 @@ export const useExecutorExecutionStore = defineStore(
       getExecution,
       listExecutions,
       getExecutionOutput,
+      cancelExecution,
       triggerClientUpdate,
     };
   },
@@ -3,6 +3,8 @@ import { defineStore } from 'pinia';
 import {
   ScriptService,
   type CreateScriptRequest,
+  type DiffScriptVersionsResponse,
+  type ListScriptVersionsResponse,
   type ListScriptsResponse,
   type Script,
   type UpdateScriptRequest,
@@ -47,6 +49,30 @@ export const useExecutorScriptStore = defineStore('executor-script', () => {
     return await ScriptService.delete(id);
   }
 
+  async function listScriptVersions(
+    scriptId: string,
+    paging?: { page?: number; pageSize?: number },
+  ): Promise<ListScriptVersionsResponse> {
+    return await ScriptService.listVersions(scriptId, paging);
+  }
+
+  async function diffScriptVersions(
+    scriptId: string,
+    fromVersion: number,
+    toVersion?: number,
+  ): Promise<DiffScriptVersionsResponse> {
+    return await ScriptService.diff(scriptId, fromVersion, toVersion);
+  }
+
+  async function rollbackScript(
+    id: string,
+    version: number,
+    password: string,
+    changeNote?: string,
+  ): Promise<{ script: Script }> {
+    return await ScriptService.rollback(id, version, password, changeNote);
+  }
+
   function $reset() {}
 
   return {
@@ -56,5 +82,8 @@ export const useExecutorScriptStore = defineStore('executor-script', () => {
     createScript,
     updateScript,
     deleteScript,
+    listScriptVersions,
+    diffScriptVersions,
+    rollbackScript,
   };
 });
@@ -8,6 +8,7 @@ package executorpb
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -25,6 +26,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// What an assignment targets
+type AssignmentTargetType int32
+
+const (
+	AssignmentTargetType_ASSIGNMENT_TARGET_TYPE_UNSPECIFIED AssignmentTargetType = 0
+	AssignmentTargetType_ASSIGNMENT_TARGET_TYPE_CLIENT      AssignmentTargetType = 1 // a single client by mTLS CN
+	AssignmentTargetType_ASSIGNMENT_TARGET_TYPE_SELECTOR    AssignmentTargetType = 2 // every client whose labels in the tenant match a selector
+	AssignmentTargetType_ASSIGNMENT_TARGET_TYPE_GROUP       AssignmentTargetType = 3 // every member of a client group
+)
+
+// Enum value maps for AssignmentTargetType.
+var (
+	AssignmentTargetType_name = map[int32]string{
+		0: "ASSIGNMENT_TARGET_TYPE_UNSPECIFIED",
+		1: "ASSIGNMENT_TARGET_TYPE_CLIENT",
+		2: "ASSIGNMENT_TARGET_TYPE_SELECTOR",
+		3: "ASSIGNMENT_TARGET_TYPE_GROUP",
+	}
+	AssignmentTargetType_value = map[string]int32{
+		"ASSIGNMENT_TARGET_TYPE_UNSPECIFIED": 0,
+		"ASSIGNMENT_TARGET_TYPE_CLIENT":      1,
+		"ASSIGNMENT_TARGET_TYPE_SELECTOR":    2,
+		"ASSIGNMENT_TARGET_TYPE_GROUP":       3,
+	}
+)
+
+func (x AssignmentTargetType) Enum() *AssignmentTargetType {
+	p := new(AssignmentTargetType)
+	*p = x
+	return p
+}
+
+func (x AssignmentTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_service_v1_assignment_proto_enumTypes[0].Descriptor()
+}
+
+func (AssignmentTargetType) Type() protoreflect.EnumType {
+	return &file_executor_service_v1_assignment_proto_enumTypes[0]
+}
+
+func (x AssignmentTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentTargetType.Descriptor instead.
+func (AssignmentTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{0}
+}
+
 // Script assignment entity
 type ScriptAssignment struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
@@ -35,7 +89,12 @@ type ScriptAssignment struct {
 	CreatedBy  *uint32                `protobuf:"varint,5,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Optionally populated when listing
-	Script        *Script `protobuf:"bytes,7,opt,name=script,proto3,oneof" json:"script,omitempty"`
+	Script     *Script              `protobuf:"bytes,7,opt,name=script,proto3,oneof" json:"script,omitempty"`
+	TargetType AssignmentTargetType `protobuf:"varint,8,opt,name=target_type,json=targetType,proto3,enum=executor.service.v1.AssignmentTargetType" json:"target_type,omitempty"`
+	Selector   *string              `protobuf:"bytes,9,opt,name=selector,proto3,oneof" json:"selector,omitempty"`
+	GroupId    *string              `protobuf:"bytes,10,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// Values of the script's parameters for the targeted clients, secrets masked
+	Parameters    map[string]string `protobuf:"bytes,11,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -119,11 +178,43 @@ func (x *ScriptAssignment) GetScript() *Script {
 	return nil
 }
 
-// Assign script request
+func (x *ScriptAssignment) GetTargetType() AssignmentTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return AssignmentTargetType_ASSIGNMENT_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ScriptAssignment) GetSelector() string {
+	if x != nil && x.Selector != nil {
+		return *x.Selector
+	}
+	return ""
+}
+
+func (x *ScriptAssignment) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *ScriptAssignment) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+// Assign script request. Exactly one of client_id, selector or group_id must be set.
 type AssignScriptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScriptId      string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
-	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	ClientId string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Selector *string                `protobuf:"bytes,3,opt,name=selector,proto3,oneof" json:"selector,omitempty"`
+	GroupId  *string                `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// Values of the script's parameters for the targeted clients
+	Parameters    map[string]string `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -172,6 +263,27 @@ func (x *AssignScriptRequest) GetClientId() string {
 	return ""
 }
 
+func (x *AssignScriptRequest) GetSelector() string {
+	if x != nil && x.Selector != nil {
+		return *x.Selector
+	}
+	return ""
+}
+
+func (x *AssignScriptRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *AssignScriptRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
 type AssignScriptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Assignment    *ScriptAssignment      `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
@@ -216,6 +328,150 @@ func (x *AssignScriptResponse) GetAssignment() *ScriptAssignment {
 	return nil
 }
 
+// Update assignment request
+type UpdateAssignmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replaces all parameter values. Secret values sent back masked keep
+	// their stored value.
+	Parameters    map[string]string `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssignmentRequest) Reset() {
+	*x = UpdateAssignmentRequest{}
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateAssignmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type UpdateAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *ScriptAssignment      `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssignmentResponse) Reset() {
+	*x = UpdateAssignmentResponse{}
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentResponse) ProtoMessage() {}
+
+func (x *UpdateAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateAssignmentResponse) GetAssignment() *ScriptAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+// Delete assignment request
+type DeleteAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentRequest) Reset() {
+	*x = DeleteAssignmentRequest{}
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentRequest) ProtoMessage() {}
+
+func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteAssignmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 // Unassign script request
 type UnassignScriptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -227,7 +483,7 @@ type UnassignScriptRequest struct {
 
 func (x *UnassignScriptRequest) Reset() {
 	*x = UnassignScriptRequest{}
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[3]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +495,7 @@ func (x *UnassignScriptRequest) String() string {
 func (*UnassignScriptRequest) ProtoMessage() {}
 
 func (x *UnassignScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[3]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +508,7 @@ func (x *UnassignScriptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnassignScriptRequest.ProtoReflect.Descriptor instead.
 func (*UnassignScriptRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{3}
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UnassignScriptRequest) GetScriptId() string {
@@ -279,7 +535,7 @@ type ListAssignmentsRequest struct {
 
 func (x *ListAssignmentsRequest) Reset() {
 	*x = ListAssignmentsRequest{}
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[4]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +547,7 @@ func (x *ListAssignmentsRequest) String() string {
 func (*ListAssignmentsRequest) ProtoMessage() {}
 
 func (x *ListAssignmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[4]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +560,7 @@ func (x *ListAssignmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssignmentsRequest.ProtoReflect.Descriptor instead.
 func (*ListAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{4}
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListAssignmentsRequest) GetScriptId() string {
@@ -323,7 +579,7 @@ type ListAssignmentsResponse struct {
 
 func (x *ListAssignmentsResponse) Reset() {
 	*x = ListAssignmentsResponse{}
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[5]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -335,7 +591,7 @@ func (x *ListAssignmentsResponse) String() string {
 func (*ListAssignmentsResponse) ProtoMessage() {}
 
 func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[5]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +604,7 @@ func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssignmentsResponse.ProtoReflect.Descriptor instead.
 func (*ListAssignmentsResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{5}
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListAssignmentsResponse) GetAssignments() []*ScriptAssignment {
@@ -368,7 +624,7 @@ type ListClientScriptsRequest struct {
 
 func (x *ListClientScriptsRequest) Reset() {
 	*x = ListClientScriptsRequest{}
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[6]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -380,7 +636,7 @@ func (x *ListClientScriptsRequest) String() string {
 func (*ListClientScriptsRequest) ProtoMessage() {}
 
 func (x *ListClientScriptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[6]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +649,7 @@ func (x *ListClientScriptsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClientScriptsRequest.ProtoReflect.Descriptor instead.
 func (*ListClientScriptsRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{6}
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListClientScriptsRequest) GetClientId() string {
@@ -412,7 +668,7 @@ type ListClientScriptsResponse struct {
 
 func (x *ListClientScriptsResponse) Reset() {
 	*x = ListClientScriptsResponse{}
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[7]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -424,7 +680,7 @@ func (x *ListClientScriptsResponse) String() string {
 func (*ListClientScriptsResponse) ProtoMessage() {}
 
 func (x *ListClientScriptsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_assignment_proto_msgTypes[7]
+	mi := &file_executor_service_v1_assignment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +693,7 @@ func (x *ListClientScriptsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClientScriptsResponse.ProtoReflect.Descriptor instead.
 func (*ListClientScriptsResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{7}
+	return file_executor_service_v1_assignment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListClientScriptsResponse) GetAssignments() []*ScriptAssignment {
@@ -451,7 +707,7 @@ var File_executor_service_v1_assignment_proto protoreflect.FileDescriptor
 
 const file_executor_service_v1_assignment_proto_rawDesc = "" +
 	"\n" +
-	"$executor/service/v1/assignment.proto\x12\x13executor.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a executor/service/v1/script.proto\"\xae\x02\n" +
+	"$executor/service/v1/assignment.proto\x12\x13executor.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\x1a executor/service/v1/script.proto\"\xeb\x04\n" +
 	"\x10ScriptAssignment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x1b\n" +
@@ -461,16 +717,53 @@ const file_executor_service_v1_assignment_proto_rawDesc = "" +
 	"created_by\x18\x05 \x01(\rH\x00R\tcreatedBy\x88\x01\x01\x12;\n" +
 	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x128\n" +
-	"\x06script\x18\a \x01(\v2\x1b.executor.service.v1.ScriptH\x01R\x06script\x88\x01\x01B\r\n" +
+	"\x06script\x18\a \x01(\v2\x1b.executor.service.v1.ScriptH\x01R\x06script\x88\x01\x01\x12J\n" +
+	"\vtarget_type\x18\b \x01(\x0e2).executor.service.v1.AssignmentTargetTypeR\n" +
+	"targetType\x12\x1f\n" +
+	"\bselector\x18\t \x01(\tH\x02R\bselector\x88\x01\x01\x12\x1e\n" +
+	"\bgroup_id\x18\n" +
+	" \x01(\tH\x03R\agroupId\x88\x01\x01\x12U\n" +
+	"\n" +
+	"parameters\x18\v \x03(\v25.executor.service.v1.ScriptAssignment.ParametersEntryR\n" +
+	"parameters\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
 	"\v_created_byB\t\n" +
-	"\a_script\"l\n" +
+	"\a_scriptB\v\n" +
+	"\t_selectorB\v\n" +
+	"\t_group_id\"\xf9\x02\n" +
 	"\x13AssignScriptRequest\x12)\n" +
-	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12*\n" +
-	"\tclient_id\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bclientId\"]\n" +
+	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12%\n" +
+	"\tclient_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bclientId\x12)\n" +
+	"\bselector\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\bselector\x88\x01\x01\x12'\n" +
+	"\bgroup_id\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18$H\x01R\agroupId\x88\x01\x01\x12c\n" +
+	"\n" +
+	"parameters\x18\x05 \x03(\v28.executor.service.v1.AssignScriptRequest.ParametersEntryB\tڶ\x1a\x05\xa2\x01\x02\b\x01R\n" +
+	"parameters\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
+	"\t_selectorB\v\n" +
+	"\t_group_id\"]\n" +
 	"\x14AssignScriptResponse\x12E\n" +
 	"\n" +
 	"assignment\x18\x01 \x01(\v2%.executor.service.v1.ScriptAssignmentR\n" +
-	"assignment\"n\n" +
+	"assignment\"\xdf\x01\n" +
+	"\x17UpdateAssignmentRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12g\n" +
+	"\n" +
+	"parameters\x18\x02 \x03(\v2<.executor.service.v1.UpdateAssignmentRequest.ParametersEntryB\tڶ\x1a\x05\xa2\x01\x02\b\x01R\n" +
+	"parameters\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"a\n" +
+	"\x18UpdateAssignmentResponse\x12E\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2%.executor.service.v1.ScriptAssignmentR\n" +
+	"assignment\"7\n" +
+	"\x17DeleteAssignmentRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"n\n" +
 	"\x15UnassignScriptRequest\x12)\n" +
 	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12*\n" +
 	"\tclient_id\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bclientId\"C\n" +
@@ -481,10 +774,17 @@ const file_executor_service_v1_assignment_proto_rawDesc = "" +
 	"\x18ListClientScriptsRequest\x12*\n" +
 	"\tclient_id\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bclientId\"d\n" +
 	"\x19ListClientScriptsResponse\x12G\n" +
-	"\vassignments\x18\x01 \x03(\v2%.executor.service.v1.ScriptAssignmentR\vassignments2\xfb\x04\n" +
+	"\vassignments\x18\x01 \x03(\v2%.executor.service.v1.ScriptAssignmentR\vassignments*\xa8\x01\n" +
+	"\x14AssignmentTargetType\x12&\n" +
+	"\"ASSIGNMENT_TARGET_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dASSIGNMENT_TARGET_TYPE_CLIENT\x10\x01\x12#\n" +
+	"\x1fASSIGNMENT_TARGET_TYPE_SELECTOR\x10\x02\x12 \n" +
+	"\x1cASSIGNMENT_TARGET_TYPE_GROUP\x10\x032\x86\a\n" +
 	"\x19ExecutorAssignmentService\x12\x93\x01\n" +
-	"\fAssignScript\x12(.executor.service.v1.AssignScriptRequest\x1a).executor.service.v1.AssignScriptResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/scripts/{script_id}/assignments\x12\x8d\x01\n" +
-	"\x0eUnassignScript\x12*.executor.service.v1.UnassignScriptRequest\x1a\x16.google.protobuf.Empty\"7\x82\xd3\xe4\x93\x021*//v1/scripts/{script_id}/assignments/{client_id}\x12\x99\x01\n" +
+	"\fAssignScript\x12(.executor.service.v1.AssignScriptRequest\x1a).executor.service.v1.AssignScriptResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/scripts/{script_id}/assignments\x12v\n" +
+	"\x10DeleteAssignment\x12,.executor.service.v1.DeleteAssignmentRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/assignments/{id}\x12\x8d\x01\n" +
+	"\x0eUnassignScript\x12*.executor.service.v1.UnassignScriptRequest\x1a\x16.google.protobuf.Empty\"7\x82\xd3\xe4\x93\x021*//v1/scripts/{script_id}/assignments/{client_id}\x12\x90\x01\n" +
+	"\x10UpdateAssignment\x12,.executor.service.v1.UpdateAssignmentRequest\x1a-.executor.service.v1.UpdateAssignmentResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/assignments/{id}\x12\x99\x01\n" +
 	"\x0fListAssignments\x12+.executor.service.v1.ListAssignmentsRequest\x1a,.executor.service.v1.ListAssignmentsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/scripts/{script_id}/assignments\x12\x9b\x01\n" +
 	"\x11ListClientScripts\x12-.executor.service.v1.ListClientScriptsRequest\x1a..executor.service.v1.ListClientScriptsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/clients/{client_id}/scriptsB\xe7\x01\n" +
 	"\x17com.executor.service.v1B\x0fAssignmentProtoP\x01ZMgithub.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1;executorpb\xa2\x02\x03ESX\xaa\x02\x13Executor.Service.V1\xca\x02\x13Executor\\Service\\V1\xe2\x02\x1fExecutor\\Service\\V1\\GPBMetadata\xea\x02\x15Executor::Service::V1b\x06proto3"
@@ -501,39 +801,56 @@ func file_executor_service_v1_assignment_proto_rawDescGZIP() []byte {
 	return file_executor_service_v1_assignment_proto_rawDescData
 }
 
-var file_executor_service_v1_assignment_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_executor_service_v1_assignment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_executor_service_v1_assignment_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_executor_service_v1_assignment_proto_goTypes = []any{
-	(*ScriptAssignment)(nil),          // 0: executor.service.v1.ScriptAssignment
-	(*AssignScriptRequest)(nil),       // 1: executor.service.v1.AssignScriptRequest
-	(*AssignScriptResponse)(nil),      // 2: executor.service.v1.AssignScriptResponse
-	(*UnassignScriptRequest)(nil),     // 3: executor.service.v1.UnassignScriptRequest
-	(*ListAssignmentsRequest)(nil),    // 4: executor.service.v1.ListAssignmentsRequest
-	(*ListAssignmentsResponse)(nil),   // 5: executor.service.v1.ListAssignmentsResponse
-	(*ListClientScriptsRequest)(nil),  // 6: executor.service.v1.ListClientScriptsRequest
-	(*ListClientScriptsResponse)(nil), // 7: executor.service.v1.ListClientScriptsResponse
-	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
-	(*Script)(nil),                    // 9: executor.service.v1.Script
-	(*emptypb.Empty)(nil),             // 10: google.protobuf.Empty
+	(AssignmentTargetType)(0),         // 0: executor.service.v1.AssignmentTargetType
+	(*ScriptAssignment)(nil),          // 1: executor.service.v1.ScriptAssignment
+	(*AssignScriptRequest)(nil),       // 2: executor.service.v1.AssignScriptRequest
+	(*AssignScriptResponse)(nil),      // 3: executor.service.v1.AssignScriptResponse
+	(*UpdateAssignmentRequest)(nil),   // 4: executor.service.v1.UpdateAssignmentRequest
+	(*UpdateAssignmentResponse)(nil),  // 5: executor.service.v1.UpdateAssignmentResponse
+	(*DeleteAssignmentRequest)(nil),   // 6: executor.service.v1.DeleteAssignmentRequest
+	(*UnassignScriptRequest)(nil),     // 7: executor.service.v1.UnassignScriptRequest
+	(*ListAssignmentsRequest)(nil),    // 8: executor.service.v1.ListAssignmentsRequest
+	(*ListAssignmentsResponse)(nil),   // 9: executor.service.v1.ListAssignmentsResponse
+	(*ListClientScriptsRequest)(nil),  // 10: executor.service.v1.ListClientScriptsRequest
+	(*ListClientScriptsResponse)(nil), // 11: executor.service.v1.ListClientScriptsResponse
+	nil,                               // 12: executor.service.v1.ScriptAssignment.ParametersEntry
+	nil,                               // 13: executor.service.v1.AssignScriptRequest.ParametersEntry
+	nil,                               // 14: executor.service.v1.UpdateAssignmentRequest.ParametersEntry
+	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
+	(*Script)(nil),                    // 16: executor.service.v1.Script
+	(*emptypb.Empty)(nil),             // 17: google.protobuf.Empty
 }
 var file_executor_service_v1_assignment_proto_depIdxs = []int32{
-	8,  // 0: executor.service.v1.ScriptAssignment.create_time:type_name -> google.protobuf.Timestamp
-	9,  // 1: executor.service.v1.ScriptAssignment.script:type_name -> executor.service.v1.Script
-	0,  // 2: executor.service.v1.AssignScriptResponse.assignment:type_name -> executor.service.v1.ScriptAssignment
-	0,  // 3: executor.service.v1.ListAssignmentsResponse.assignments:type_name -> executor.service.v1.ScriptAssignment
-	0,  // 4: executor.service.v1.ListClientScriptsResponse.assignments:type_name -> executor.service.v1.ScriptAssignment
-	1,  // 5: executor.service.v1.ExecutorAssignmentService.AssignScript:input_type -> executor.service.v1.AssignScriptRequest
-	3,  // 6: executor.service.v1.ExecutorAssignmentService.UnassignScript:input_type -> executor.service.v1.UnassignScriptRequest
-	4,  // 7: executor.service.v1.ExecutorAssignmentService.ListAssignments:input_type -> executor.service.v1.ListAssignmentsRequest
-	6,  // 8: executor.service.v1.ExecutorAssignmentService.ListClientScripts:input_type -> executor.service.v1.ListClientScriptsRequest
-	2,  // 9: executor.service.v1.ExecutorAssignmentService.AssignScript:output_type -> executor.service.v1.AssignScriptResponse
-	10, // 10: executor.service.v1.ExecutorAssignmentService.UnassignScript:output_type -> google.protobuf.Empty
-	5,  // 11: executor.service.v1.ExecutorAssignmentService.ListAssignments:output_type -> executor.service.v1.ListAssignmentsResponse
-	7,  // 12: executor.service.v1.ExecutorAssignmentService.ListClientScripts:output_type -> executor.service.v1.ListClientScriptsResponse
-	9,  // [9:13] is the sub-list for method output_type
-	5,  // [5:9] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	15, // 0: executor.service.v1.ScriptAssignment.create_time:type_name -> google.protobuf.Timestamp
+	16, // 1: executor.service.v1.ScriptAssignment.script:type_name -> executor.service.v1.Script
+	0,  // 2: executor.service.v1.ScriptAssignment.target_type:type_name -> executor.service.v1.AssignmentTargetType
+	12, // 3: executor.service.v1.ScriptAssignment.parameters:type_name -> executor.service.v1.ScriptAssignment.ParametersEntry
+	13, // 4: executor.service.v1.AssignScriptRequest.parameters:type_name -> executor.service.v1.AssignScriptRequest.ParametersEntry
+	1,  // 5: executor.service.v1.AssignScriptResponse.assignment:type_name -> executor.service.v1.ScriptAssignment
+	14, // 6: executor.service.v1.UpdateAssignmentRequest.parameters:type_name -> executor.service.v1.UpdateAssignmentRequest.ParametersEntry
+	1,  // 7: executor.service.v1.UpdateAssignmentResponse.assignment:type_name -> executor.service.v1.ScriptAssignment
+	1,  // 8: executor.service.v1.ListAssignmentsResponse.assignments:type_name -> executor.service.v1.ScriptAssignment
+	1,  // 9: executor.service.v1.ListClientScriptsResponse.assignments:type_name -> executor.service.v1.ScriptAssignment
+	2,  // 10: executor.service.v1.ExecutorAssignmentService.AssignScript:input_type -> executor.service.v1.AssignScriptRequest
+	6,  // 11: executor.service.v1.ExecutorAssignmentService.DeleteAssignment:input_type -> executor.service.v1.DeleteAssignmentRequest
+	7,  // 12: executor.service.v1.ExecutorAssignmentService.UnassignScript:input_type -> executor.service.v1.UnassignScriptRequest
+	4,  // 13: executor.service.v1.ExecutorAssignmentService.UpdateAssignment:input_type -> executor.service.v1.UpdateAssignmentRequest
+	8,  // 14: executor.service.v1.ExecutorAssignmentService.ListAssignments:input_type -> executor.service.v1.ListAssignmentsRequest
+	10, // 15: executor.service.v1.ExecutorAssignmentService.ListClientScripts:input_type -> executor.service.v1.ListClientScriptsRequest
+	3,  // 16: executor.service.v1.ExecutorAssignmentService.AssignScript:output_type -> executor.service.v1.AssignScriptResponse
+	17, // 17: executor.service.v1.ExecutorAssignmentService.DeleteAssignment:output_type -> google.protobuf.Empty
+	17, // 18: executor.service.v1.ExecutorAssignmentService.UnassignScript:output_type -> google.protobuf.Empty
+	5,  // 19: executor.service.v1.ExecutorAssignmentService.UpdateAssignment:output_type -> executor.service.v1.UpdateAssignmentResponse
+	9,  // 20: executor.service.v1.ExecutorAssignmentService.ListAssignments:output_type -> executor.service.v1.ListAssignmentsResponse
+	11, // 21: executor.service.v1.ExecutorAssignmentService.ListClientScripts:output_type -> executor.service.v1.ListClientScriptsResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_executor_service_v1_assignment_proto_init() }
@@ -543,18 +860,20 @@ func file_executor_service_v1_assignment_proto_init() {
 	}
 	file_executor_service_v1_script_proto_init()
 	file_executor_service_v1_assignment_proto_msgTypes[0].OneofWrappers = []any{}
+	file_executor_service_v1_assignment_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_executor_service_v1_assignment_proto_rawDesc), len(file_executor_service_v1_assignment_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_executor_service_v1_assignment_proto_goTypes,
 		DependencyIndexes: file_executor_service_v1_assignment_proto_depIdxs,
+		EnumInfos:         file_executor_service_v1_assignment_proto_enumTypes,
 		MessageInfos:      file_executor_service_v1_assignment_proto_msgTypes,
 	}.Build()
 	File_executor_service_v1_assignment_proto = out.File
@@ -26,6 +26,7 @@ var (
 	_ annotations.FieldBehavior
 	_ emptypb.Empty
 	_ timestamppb.Timestamp
+	_ redact.FieldRules
 )
 
 // RegisterRedactedExecutorAssignmentServiceServer wraps the ExecutorAssignmentServiceServer with the redacted server and registers the service in GRPC
@@ -57,6 +58,17 @@ func (s *redactedExecutorAssignmentServiceServer) AssignScript(ctx context.Conte
 	return res, err
 }
 
+// DeleteAssignment is the redacted wrapper for the actual ExecutorAssignmentServiceServer.DeleteAssignment method
+// Unary RPC
+func (s *redactedExecutorAssignmentServiceServer) DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.DeleteAssignment(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // UnassignScript is the redacted wrapper for the actual ExecutorAssignmentServiceServer.UnassignScript method
 // Unary RPC
 func (s *redactedExecutorAssignmentServiceServer) UnassignScript(ctx context.Context, in *UnassignScriptRequest) (*emptypb.Empty, error) {
@@ -68,6 +80,17 @@ func (s *redactedExecutorAssignmentServiceServer) UnassignScript(ctx context.Con
 	return res, err
 }
 
+// UpdateAssignment is the redacted wrapper for the actual ExecutorAssignmentServiceServer.UpdateAssignment method
+// Unary RPC
+func (s *redactedExecutorAssignmentServiceServer) UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest) (*UpdateAssignmentResponse, error) {
+	res, err := s.srv.UpdateAssignment(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // ListAssignments is the redacted wrapper for the actual ExecutorAssignmentServiceServer.ListAssignments method
 // Unary RPC
 func (s *redactedExecutorAssignmentServiceServer) ListAssignments(ctx context.Context, in *ListAssignmentsRequest) (*ListAssignmentsResponse, error) {
@@ -109,6 +132,14 @@ func (x *ScriptAssignment) Redact() string {
 	// Safe field: CreateTime
 
 	// Safe field: Script
+
+	// Safe field: TargetType
+
+	// Safe field: Selector
+
+	// Safe field: GroupId
+
+	// Safe field: Parameters
 	return x.String()
 }
 
@@ -121,6 +152,13 @@ func (x *AssignScriptRequest) Redact() string {
 	// Safe field: ScriptId
 
 	// Safe field: ClientId
+
+	// Safe field: Selector
+
+	// Safe field: GroupId
+
+	// Redacting field: Parameters
+	x.Parameters = map[string]string{}
 	return x.String()
 }
 
@@ -134,6 +172,39 @@ func (x *AssignScriptResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for UpdateAssignmentRequest
+func (x *UpdateAssignmentRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Redacting field: Parameters
+	x.Parameters = map[string]string{}
+	return x.String()
+}
+
+// Redact method implementation for UpdateAssignmentResponse
+func (x *UpdateAssignmentResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Assignment
+	return x.String()
+}
+
+// Redact method implementation for DeleteAssignmentRequest
+func (x *DeleteAssignmentRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
 // Redact method implementation for UnassignScriptRequest
 func (x *UnassignScriptRequest) Redact() string {
 	if x == nil {
@@ -94,6 +94,10 @@ func (m *ScriptAssignment) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TargetType
+
+	// no validation rules for Parameters
+
 	if m.CreatedBy != nil {
 		// no validation rules for CreatedBy
 	}
@@ -131,6 +135,14 @@ func (m *ScriptAssignment) validate(all bool) error {
 
 	}
 
+	if m.Selector != nil {
+		// no validation rules for Selector
+	}
+
+	if m.GroupId != nil {
+		// no validation rules for GroupId
+	}
+
 	if len(errors) > 0 {
 		return ScriptAssignmentMultiError(errors)
 	}
@@ -235,6 +247,16 @@ func (m *AssignScriptRequest) validate(all bool) error {
 
 	// no validation rules for ClientId
 
+	// no validation rules for Parameters
+
+	if m.Selector != nil {
+		// no validation rules for Selector
+	}
+
+	if m.GroupId != nil {
+		// no validation rules for GroupId
+	}
+
 	if len(errors) > 0 {
 		return AssignScriptRequestMultiError(errors)
 	}
@@ -446,6 +468,347 @@ var _ interface {
 	ErrorName() string
 } = AssignScriptResponseValidationError{}
 
+// Validate checks the field values on UpdateAssignmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAssignmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAssignmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAssignmentRequestMultiError, or nil if none found.
+func (m *UpdateAssignmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAssignmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Parameters
+
+	if len(errors) > 0 {
+		return UpdateAssignmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAssignmentRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAssignmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAssignmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAssignmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAssignmentRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAssignmentRequestValidationError is the validation error returned by
+// UpdateAssignmentRequest.Validate if the designated constraints aren't met.
+type UpdateAssignmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAssignmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAssignmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAssignmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAssignmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAssignmentRequestValidationError) ErrorName() string {
+	return "UpdateAssignmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAssignmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAssignmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAssignmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAssignmentRequestValidationError{}
+
+// Validate checks the field values on UpdateAssignmentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAssignmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAssignmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAssignmentResponseMultiError, or nil if none found.
+func (m *UpdateAssignmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAssignmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAssignment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAssignmentResponseValidationError{
+					field:  "Assignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAssignmentResponseValidationError{
+					field:  "Assignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAssignment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAssignmentResponseValidationError{
+				field:  "Assignment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAssignmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAssignmentResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateAssignmentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAssignmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAssignmentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAssignmentResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAssignmentResponseValidationError is the validation error returned by
+// UpdateAssignmentResponse.Validate if the designated constraints aren't met.
+type UpdateAssignmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAssignmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAssignmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAssignmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAssignmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAssignmentResponseValidationError) ErrorName() string {
+	return "UpdateAssignmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAssignmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAssignmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAssignmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAssignmentResponseValidationError{}
+
+// Validate checks the field values on DeleteAssignmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAssignmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAssignmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAssignmentRequestMultiError, or nil if none found.
+func (m *DeleteAssignmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAssignmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteAssignmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAssignmentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAssignmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAssignmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAssignmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAssignmentRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAssignmentRequestValidationError is the validation error returned by
+// DeleteAssignmentRequest.Validate if the designated constraints aren't met.
+type DeleteAssignmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAssignmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAssignmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAssignmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAssignmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAssignmentRequestValidationError) ErrorName() string {
+	return "DeleteAssignmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAssignmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAssignmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAssignmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAssignmentRequestValidationError{}
+
 // Validate checks the field values on UnassignScriptRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
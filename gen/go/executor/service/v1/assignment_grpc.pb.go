@@ -21,7 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	ExecutorAssignmentService_AssignScript_FullMethodName      = "/executor.service.v1.ExecutorAssignmentService/AssignScript"
+	ExecutorAssignmentService_DeleteAssignment_FullMethodName  = "/executor.service.v1.ExecutorAssignmentService/DeleteAssignment"
 	ExecutorAssignmentService_UnassignScript_FullMethodName    = "/executor.service.v1.ExecutorAssignmentService/UnassignScript"
+	ExecutorAssignmentService_UpdateAssignment_FullMethodName  = "/executor.service.v1.ExecutorAssignmentService/UpdateAssignment"
 	ExecutorAssignmentService_ListAssignments_FullMethodName   = "/executor.service.v1.ExecutorAssignmentService/ListAssignments"
 	ExecutorAssignmentService_ListClientScripts_FullMethodName = "/executor.service.v1.ExecutorAssignmentService/ListClientScripts"
 )
@@ -34,11 +36,15 @@ const (
 type ExecutorAssignmentServiceClient interface {
 	// Assign a script to a client
 	AssignScript(ctx context.Context, in *AssignScriptRequest, opts ...grpc.CallOption) (*AssignScriptResponse, error)
+	// Delete an assignment by ID (any target type)
+	DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Unassign a script from a client
 	UnassignScript(ctx context.Context, in *UnassignScriptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Replace the parameter values of an assignment
+	UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*UpdateAssignmentResponse, error)
 	// List assignments for a script
 	ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
-	// List scripts assigned to a client
+	// List scripts assigned to a client, directly or through a selector or group
 	ListClientScripts(ctx context.Context, in *ListClientScriptsRequest, opts ...grpc.CallOption) (*ListClientScriptsResponse, error)
 }
 
@@ -60,6 +66,16 @@ func (c *executorAssignmentServiceClient) AssignScript(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *executorAssignmentServiceClient) DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ExecutorAssignmentService_DeleteAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorAssignmentServiceClient) UnassignScript(ctx context.Context, in *UnassignScriptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -70,6 +86,16 @@ func (c *executorAssignmentServiceClient) UnassignScript(ctx context.Context, in
 	return out, nil
 }
 
+func (c *executorAssignmentServiceClient) UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*UpdateAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAssignmentResponse)
+	err := c.cc.Invoke(ctx, ExecutorAssignmentService_UpdateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorAssignmentServiceClient) ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAssignmentsResponse)
@@ -98,11 +124,15 @@ func (c *executorAssignmentServiceClient) ListClientScripts(ctx context.Context,
 type ExecutorAssignmentServiceServer interface {
 	// Assign a script to a client
 	AssignScript(context.Context, *AssignScriptRequest) (*AssignScriptResponse, error)
+	// Delete an assignment by ID (any target type)
+	DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*emptypb.Empty, error)
 	// Unassign a script from a client
 	UnassignScript(context.Context, *UnassignScriptRequest) (*emptypb.Empty, error)
+	// Replace the parameter values of an assignment
+	UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*UpdateAssignmentResponse, error)
 	// List assignments for a script
 	ListAssignments(context.Context, *ListAssignmentsRequest) (*ListAssignmentsResponse, error)
-	// List scripts assigned to a client
+	// List scripts assigned to a client, directly or through a selector or group
 	ListClientScripts(context.Context, *ListClientScriptsRequest) (*ListClientScriptsResponse, error)
 	mustEmbedUnimplementedExecutorAssignmentServiceServer()
 }
@@ -117,9 +147,15 @@ type UnimplementedExecutorAssignmentServiceServer struct{}
 func (UnimplementedExecutorAssignmentServiceServer) AssignScript(context.Context, *AssignScriptRequest) (*AssignScriptResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AssignScript not implemented")
 }
+func (UnimplementedExecutorAssignmentServiceServer) DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAssignment not implemented")
+}
 func (UnimplementedExecutorAssignmentServiceServer) UnassignScript(context.Context, *UnassignScriptRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UnassignScript not implemented")
 }
+func (UnimplementedExecutorAssignmentServiceServer) UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*UpdateAssignmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAssignment not implemented")
+}
 func (UnimplementedExecutorAssignmentServiceServer) ListAssignments(context.Context, *ListAssignmentsRequest) (*ListAssignmentsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListAssignments not implemented")
 }
@@ -166,6 +202,24 @@ func _ExecutorAssignmentService_AssignScript_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorAssignmentService_DeleteAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorAssignmentServiceServer).DeleteAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorAssignmentService_DeleteAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorAssignmentServiceServer).DeleteAssignment(ctx, req.(*DeleteAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutorAssignmentService_UnassignScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnassignScriptRequest)
 	if err := dec(in); err != nil {
@@ -184,6 +238,24 @@ func _ExecutorAssignmentService_UnassignScript_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorAssignmentService_UpdateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorAssignmentServiceServer).UpdateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorAssignmentService_UpdateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorAssignmentServiceServer).UpdateAssignment(ctx, req.(*UpdateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutorAssignmentService_ListAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAssignmentsRequest)
 	if err := dec(in); err != nil {
@@ -231,10 +303,18 @@ var ExecutorAssignmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssignScript",
 			Handler:    _ExecutorAssignmentService_AssignScript_Handler,
 		},
+		{
+			MethodName: "DeleteAssignment",
+			Handler:    _ExecutorAssignmentService_DeleteAssignment_Handler,
+		},
 		{
 			MethodName: "UnassignScript",
 			Handler:    _ExecutorAssignmentService_UnassignScript_Handler,
 		},
+		{
+			MethodName: "UpdateAssignment",
+			Handler:    _ExecutorAssignmentService_UpdateAssignment_Handler,
+		},
 		{
 			MethodName: "ListAssignments",
 			Handler:    _ExecutorAssignmentService_ListAssignments_Handler,
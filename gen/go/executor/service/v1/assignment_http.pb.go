@@ -21,25 +21,33 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationExecutorAssignmentServiceAssignScript = "/executor.service.v1.ExecutorAssignmentService/AssignScript"
+const OperationExecutorAssignmentServiceDeleteAssignment = "/executor.service.v1.ExecutorAssignmentService/DeleteAssignment"
 const OperationExecutorAssignmentServiceListAssignments = "/executor.service.v1.ExecutorAssignmentService/ListAssignments"
 const OperationExecutorAssignmentServiceListClientScripts = "/executor.service.v1.ExecutorAssignmentService/ListClientScripts"
 const OperationExecutorAssignmentServiceUnassignScript = "/executor.service.v1.ExecutorAssignmentService/UnassignScript"
+const OperationExecutorAssignmentServiceUpdateAssignment = "/executor.service.v1.ExecutorAssignmentService/UpdateAssignment"
 
 type ExecutorAssignmentServiceHTTPServer interface {
 	// AssignScript Assign a script to a client
 	AssignScript(context.Context, *AssignScriptRequest) (*AssignScriptResponse, error)
+	// DeleteAssignment Delete an assignment by ID (any target type)
+	DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*emptypb.Empty, error)
 	// ListAssignments List assignments for a script
 	ListAssignments(context.Context, *ListAssignmentsRequest) (*ListAssignmentsResponse, error)
-	// ListClientScripts List scripts assigned to a client
+	// ListClientScripts List scripts assigned to a client, directly or through a selector or group
 	ListClientScripts(context.Context, *ListClientScriptsRequest) (*ListClientScriptsResponse, error)
 	// UnassignScript Unassign a script from a client
 	UnassignScript(context.Context, *UnassignScriptRequest) (*emptypb.Empty, error)
+	// UpdateAssignment Replace the parameter values of an assignment
+	UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*UpdateAssignmentResponse, error)
 }
 
 func RegisterExecutorAssignmentServiceHTTPServer(s *http.Server, srv ExecutorAssignmentServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/scripts/{script_id}/assignments", _ExecutorAssignmentService_AssignScript0_HTTP_Handler(srv))
+	r.DELETE("/v1/assignments/{id}", _ExecutorAssignmentService_DeleteAssignment0_HTTP_Handler(srv))
 	r.DELETE("/v1/scripts/{script_id}/assignments/{client_id}", _ExecutorAssignmentService_UnassignScript0_HTTP_Handler(srv))
+	r.PUT("/v1/assignments/{id}", _ExecutorAssignmentService_UpdateAssignment0_HTTP_Handler(srv))
 	r.GET("/v1/scripts/{script_id}/assignments", _ExecutorAssignmentService_ListAssignments0_HTTP_Handler(srv))
 	r.GET("/v1/clients/{client_id}/scripts", _ExecutorAssignmentService_ListClientScripts0_HTTP_Handler(srv))
 }
@@ -69,6 +77,28 @@ func _ExecutorAssignmentService_AssignScript0_HTTP_Handler(srv ExecutorAssignmen
 	}
 }
 
+func _ExecutorAssignmentService_DeleteAssignment0_HTTP_Handler(srv ExecutorAssignmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAssignmentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorAssignmentServiceDeleteAssignment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAssignment(ctx, req.(*DeleteAssignmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ExecutorAssignmentService_UnassignScript0_HTTP_Handler(srv ExecutorAssignmentServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UnassignScriptRequest
@@ -91,6 +121,31 @@ func _ExecutorAssignmentService_UnassignScript0_HTTP_Handler(srv ExecutorAssignm
 	}
 }
 
+func _ExecutorAssignmentService_UpdateAssignment0_HTTP_Handler(srv ExecutorAssignmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateAssignmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorAssignmentServiceUpdateAssignment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateAssignment(ctx, req.(*UpdateAssignmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateAssignmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ExecutorAssignmentService_ListAssignments0_HTTP_Handler(srv ExecutorAssignmentServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListAssignmentsRequest
@@ -138,12 +193,16 @@ func _ExecutorAssignmentService_ListClientScripts0_HTTP_Handler(srv ExecutorAssi
 type ExecutorAssignmentServiceHTTPClient interface {
 	// AssignScript Assign a script to a client
 	AssignScript(ctx context.Context, req *AssignScriptRequest, opts ...http.CallOption) (rsp *AssignScriptResponse, err error)
+	// DeleteAssignment Delete an assignment by ID (any target type)
+	DeleteAssignment(ctx context.Context, req *DeleteAssignmentRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// ListAssignments List assignments for a script
 	ListAssignments(ctx context.Context, req *ListAssignmentsRequest, opts ...http.CallOption) (rsp *ListAssignmentsResponse, err error)
-	// ListClientScripts List scripts assigned to a client
+	// ListClientScripts List scripts assigned to a client, directly or through a selector or group
 	ListClientScripts(ctx context.Context, req *ListClientScriptsRequest, opts ...http.CallOption) (rsp *ListClientScriptsResponse, err error)
 	// UnassignScript Unassign a script from a client
 	UnassignScript(ctx context.Context, req *UnassignScriptRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// UpdateAssignment Replace the parameter values of an assignment
+	UpdateAssignment(ctx context.Context, req *UpdateAssignmentRequest, opts ...http.CallOption) (rsp *UpdateAssignmentResponse, err error)
 }
 
 type ExecutorAssignmentServiceHTTPClientImpl struct {
@@ -168,6 +227,20 @@ func (c *ExecutorAssignmentServiceHTTPClientImpl) AssignScript(ctx context.Conte
 	return &out, nil
 }
 
+// DeleteAssignment Delete an assignment by ID (any target type)
+func (c *ExecutorAssignmentServiceHTTPClientImpl) DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/assignments/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorAssignmentServiceDeleteAssignment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListAssignments List assignments for a script
 func (c *ExecutorAssignmentServiceHTTPClientImpl) ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...http.CallOption) (*ListAssignmentsResponse, error) {
 	var out ListAssignmentsResponse
@@ -182,7 +255,7 @@ func (c *ExecutorAssignmentServiceHTTPClientImpl) ListAssignments(ctx context.Co
 	return &out, nil
 }
 
-// ListClientScripts List scripts assigned to a client
+// ListClientScripts List scripts assigned to a client, directly or through a selector or group
 func (c *ExecutorAssignmentServiceHTTPClientImpl) ListClientScripts(ctx context.Context, in *ListClientScriptsRequest, opts ...http.CallOption) (*ListClientScriptsResponse, error) {
 	var out ListClientScriptsResponse
 	pattern := "/v1/clients/{client_id}/scripts"
@@ -209,3 +282,17 @@ func (c *ExecutorAssignmentServiceHTTPClientImpl) UnassignScript(ctx context.Con
 	}
 	return &out, nil
 }
+
+// UpdateAssignment Replace the parameter values of an assignment
+func (c *ExecutorAssignmentServiceHTTPClientImpl) UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...http.CallOption) (*UpdateAssignmentResponse, error) {
+	var out UpdateAssignmentResponse
+	pattern := "/v1/assignments/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorAssignmentServiceUpdateAssignment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -12,7 +12,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -31,6 +31,8 @@ type CommandType int32
 const (
 	CommandType_COMMAND_TYPE_SCRIPT_EXECUTION CommandType = 0 // default, backward compatible
 	CommandType_COMMAND_TYPE_CLIENT_UPDATE    CommandType = 1 // trigger client self-update
+	CommandType_COMMAND_TYPE_ABORT            CommandType = 2 // execution timed out, stop it if still running
+	CommandType_COMMAND_TYPE_CANCEL           CommandType = 3 // user cancelled the execution, terminate it and report back
 )
 
 // Enum value maps for CommandType.
@@ -38,10 +40,14 @@ var (
 	CommandType_name = map[int32]string{
 		0: "COMMAND_TYPE_SCRIPT_EXECUTION",
 		1: "COMMAND_TYPE_CLIENT_UPDATE",
+		2: "COMMAND_TYPE_ABORT",
+		3: "COMMAND_TYPE_CANCEL",
 	}
 	CommandType_value = map[string]int32{
 		"COMMAND_TYPE_SCRIPT_EXECUTION": 0,
 		"COMMAND_TYPE_CLIENT_UPDATE":    1,
+		"COMMAND_TYPE_ABORT":            2,
+		"COMMAND_TYPE_CANCEL":           3,
 	}
 )
 
@@ -74,16 +80,24 @@ func (CommandType) EnumDescriptor() ([]byte, []int) {
 
 // Execution command sent to client via stream
 type ExecutionCommand struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommandId     string                 `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	ScriptId      string                 `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
-	ScriptName    string                 `protobuf:"bytes,4,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptType    ScriptType             `protobuf:"varint,5,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
-	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	ContentHash   string                 `protobuf:"bytes,7,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
-	CommandType   CommandType            `protobuf:"varint,8,opt,name=command_type,json=commandType,proto3,enum=executor.service.v1.CommandType" json:"command_type,omitempty"`
-	TargetVersion string                 `protobuf:"bytes,9,opt,name=target_version,json=targetVersion,proto3" json:"target_version,omitempty"` // empty = latest
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CommandId   string                 `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	ExecutionId string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	ScriptId    string                 `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	ScriptName  string                 `protobuf:"bytes,4,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptType  ScriptType             `protobuf:"varint,5,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
+	// Script content with referenced secrets filled in; content_hash is the hash of it as sent
+	Content        string      `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	ContentHash    string      `protobuf:"bytes,7,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	CommandType    CommandType `protobuf:"varint,8,opt,name=command_type,json=commandType,proto3,enum=executor.service.v1.CommandType" json:"command_type,omitempty"`
+	TargetVersion  string      `protobuf:"bytes,9,opt,name=target_version,json=targetVersion,proto3" json:"target_version,omitempty"`      // empty = latest
+	TimeoutSeconds int32       `protobuf:"varint,10,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // 0 = no limit
+	// Parameter values delivered as environment variables, by variable name
+	Env map[string]string `protobuf:"bytes,11,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Parameter values delivered as positional arguments
+	Args []string `protobuf:"bytes,12,rep,name=args,proto3" json:"args,omitempty"`
+	// Signature over a SignedCommand holding this command; unset when signing is disabled
+	Signature     *Signature `protobuf:"bytes,13,opt,name=signature,proto3,oneof" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -181,17 +195,204 @@ func (x *ExecutionCommand) GetTargetVersion() string {
 	return ""
 }
 
-// Fetch script request
-type FetchScriptRequest struct {
+func (x *ExecutionCommand) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ExecutionCommand) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ExecutionCommand) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ExecutionCommand) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// What the signature of a command covers. Clients must only run the command
+// decoded from the signed payload, addressed to themselves and not expired,
+// and reject a command_id they have already seen.
+type SignedCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       *ExecutionCommand      `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`                   // without its signature
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // the client the command is addressed to
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedCommand) Reset() {
+	*x = SignedCommand{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedCommand) ProtoMessage() {}
+
+func (x *SignedCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedCommand.ProtoReflect.Descriptor instead.
+func (*SignedCommand) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SignedCommand) GetCommand() *ExecutionCommand {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *SignedCommand) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SignedCommand) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *SignedCommand) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// What the signature of fetched script content covers
+type SignedScript struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScriptId      string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ContentHash   string                 `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"` // SHA-256 of the content as sent
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *SignedScript) Reset() {
+	*x = SignedScript{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedScript) ProtoMessage() {}
+
+func (x *SignedScript) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedScript.ProtoReflect.Descriptor instead.
+func (*SignedScript) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignedScript) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *SignedScript) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SignedScript) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *SignedScript) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SignedScript) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *SignedScript) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Fetch script request
+type FetchScriptRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	// Reserve the execution the client runs the script as, taking a
+	// concurrency slot on the client until its result is submitted
+	ReserveExecution bool `protobuf:"varint,2,opt,name=reserve_execution,json=reserveExecution,proto3" json:"reserve_execution,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *FetchScriptRequest) Reset() {
 	*x = FetchScriptRequest{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[1]
+	mi := &file_executor_service_v1_client_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -203,7 +404,7 @@ func (x *FetchScriptRequest) String() string {
 func (*FetchScriptRequest) ProtoMessage() {}
 
 func (x *FetchScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[1]
+	mi := &file_executor_service_v1_client_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -216,7 +417,7 @@ func (x *FetchScriptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchScriptRequest.ProtoReflect.Descriptor instead.
 func (*FetchScriptRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{1}
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *FetchScriptRequest) GetScriptId() string {
@@ -226,21 +427,35 @@ func (x *FetchScriptRequest) GetScriptId() string {
 	return ""
 }
 
+func (x *FetchScriptRequest) GetReserveExecution() bool {
+	if x != nil {
+		return x.ReserveExecution
+	}
+	return false
+}
+
 type FetchScriptResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScriptId      string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
-	ScriptName    string                 `protobuf:"bytes,2,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptType    ScriptType             `protobuf:"varint,3,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	ContentHash   string                 `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
-	Version       int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId   string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	ScriptName string                 `protobuf:"bytes,2,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptType ScriptType             `protobuf:"varint,3,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
+	// Script content with referenced secrets filled in; content_hash is the hash of it as sent
+	Content     string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	ContentHash string `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	Version     int32  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Signature over a SignedScript; unset when signing is disabled
+	Signature *Signature `protobuf:"bytes,7,opt,name=signature,proto3,oneof" json:"signature,omitempty"`
+	// Execution reserved for running the script when reserve_execution was
+	// set, holding a concurrency slot on the client; its result is reported
+	// with SubmitExecution
+	ExecutionId   string `protobuf:"bytes,8,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FetchScriptResponse) Reset() {
 	*x = FetchScriptResponse{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[2]
+	mi := &file_executor_service_v1_client_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -252,7 +467,7 @@ func (x *FetchScriptResponse) String() string {
 func (*FetchScriptResponse) ProtoMessage() {}
 
 func (x *FetchScriptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[2]
+	mi := &file_executor_service_v1_client_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,7 +480,7 @@ func (x *FetchScriptResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchScriptResponse.ProtoReflect.Descriptor instead.
 func (*FetchScriptResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{2}
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *FetchScriptResponse) GetScriptId() string {
@@ -293,47 +508,399 @@ func (x *FetchScriptResponse) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
-	return ""
+	return ""
+}
+
+func (x *FetchScriptResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *FetchScriptResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FetchScriptResponse) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *FetchScriptResponse) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+// Stream commands request
+type StreamCommandsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	Facts         *ClientFacts           `protobuf:"bytes,3,opt,name=facts,proto3" json:"facts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamCommandsRequest) Reset() {
+	*x = StreamCommandsRequest{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCommandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCommandsRequest) ProtoMessage() {}
+
+func (x *StreamCommandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCommandsRequest.ProtoReflect.Descriptor instead.
+func (*StreamCommandsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamCommandsRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *StreamCommandsRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *StreamCommandsRequest) GetFacts() *ClientFacts {
+	if x != nil {
+		return x.Facts
+	}
+	return nil
+}
+
+// First message on the Connect stream
+type ConnectHello struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	Facts         *ClientFacts           `protobuf:"bytes,3,opt,name=facts,proto3" json:"facts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectHello) Reset() {
+	*x = ConnectHello{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectHello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectHello) ProtoMessage() {}
+
+func (x *ConnectHello) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectHello.ProtoReflect.Descriptor instead.
+func (*ConnectHello) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConnectHello) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ConnectHello) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ConnectHello) GetFacts() *ClientFacts {
+	if x != nil {
+		return x.Facts
+	}
+	return nil
+}
+
+// Periodic liveness signal with host load
+type Heartbeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Load1         float64                `protobuf:"fixed64,1,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5         float64                `protobuf:"fixed64,2,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15        float64                `protobuf:"fixed64,3,opt,name=load15,proto3" json:"load15,omitempty"`
+	UptimeSeconds int64                  `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Heartbeat) Reset() {
+	*x = Heartbeat{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Heartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heartbeat) ProtoMessage() {}
+
+func (x *Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heartbeat.ProtoReflect.Descriptor instead.
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Heartbeat) GetLoad1() float64 {
+	if x != nil {
+		return x.Load1
+	}
+	return 0
+}
+
+func (x *Heartbeat) GetLoad5() float64 {
+	if x != nil {
+		return x.Load5
+	}
+	return 0
+}
+
+func (x *Heartbeat) GetLoad15() float64 {
+	if x != nil {
+		return x.Load15
+	}
+	return 0
+}
+
+func (x *Heartbeat) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+// Client-to-server message on the Connect stream
+type ConnectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ConnectRequest_Hello
+	//	*ConnectRequest_Heartbeat
+	//	*ConnectRequest_Ack
+	Payload       isConnectRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRequest) ProtoMessage() {}
+
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConnectRequest) GetPayload() isConnectRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetHello() *ConnectHello {
+	if x != nil {
+		if x, ok := x.Payload.(*ConnectRequest_Hello); ok {
+			return x.Hello
+		}
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetHeartbeat() *Heartbeat {
+	if x != nil {
+		if x, ok := x.Payload.(*ConnectRequest_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetAck() *AckCommandRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ConnectRequest_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+type isConnectRequest_Payload interface {
+	isConnectRequest_Payload()
+}
+
+type ConnectRequest_Hello struct {
+	Hello *ConnectHello `protobuf:"bytes,1,opt,name=hello,proto3,oneof"`
+}
+
+type ConnectRequest_Heartbeat struct {
+	Heartbeat *Heartbeat `protobuf:"bytes,2,opt,name=heartbeat,proto3,oneof"`
+}
+
+type ConnectRequest_Ack struct {
+	Ack *AckCommandRequest `protobuf:"bytes,3,opt,name=ack,proto3,oneof"`
+}
+
+func (*ConnectRequest_Hello) isConnectRequest_Payload() {}
+
+func (*ConnectRequest_Heartbeat) isConnectRequest_Payload() {}
+
+func (*ConnectRequest_Ack) isConnectRequest_Payload() {}
+
+// Sent once after the hello is accepted
+type ConnectAccepted struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	HeartbeatIntervalSeconds int32                  `protobuf:"varint,1,opt,name=heartbeat_interval_seconds,json=heartbeatIntervalSeconds,proto3" json:"heartbeat_interval_seconds,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ConnectAccepted) Reset() {
+	*x = ConnectAccepted{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectAccepted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectAccepted) ProtoMessage() {}
+
+func (x *ConnectAccepted) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *FetchScriptResponse) GetContentHash() string {
-	if x != nil {
-		return x.ContentHash
-	}
-	return ""
+// Deprecated: Use ConnectAccepted.ProtoReflect.Descriptor instead.
+func (*ConnectAccepted) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *FetchScriptResponse) GetVersion() int32 {
+func (x *ConnectAccepted) GetHeartbeatIntervalSeconds() int32 {
 	if x != nil {
-		return x.Version
+		return x.HeartbeatIntervalSeconds
 	}
 	return 0
 }
 
-// Stream commands request
-type StreamCommandsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ClientVersion string                 `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+// Server-to-client message on the Connect stream
+type ConnectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ConnectResponse_Accepted
+	//	*ConnectResponse_Command
+	Payload       isConnectResponse_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamCommandsRequest) Reset() {
-	*x = StreamCommandsRequest{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[3]
+func (x *ConnectResponse) Reset() {
+	*x = ConnectResponse{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamCommandsRequest) String() string {
+func (x *ConnectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamCommandsRequest) ProtoMessage() {}
+func (*ConnectResponse) ProtoMessage() {}
 
-func (x *StreamCommandsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[3]
+func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,25 +911,52 @@ func (x *StreamCommandsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamCommandsRequest.ProtoReflect.Descriptor instead.
-func (*StreamCommandsRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
+func (*ConnectResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StreamCommandsRequest) GetClientId() string {
+func (x *ConnectResponse) GetPayload() isConnectResponse_Payload {
 	if x != nil {
-		return x.ClientId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *StreamCommandsRequest) GetClientVersion() string {
+func (x *ConnectResponse) GetAccepted() *ConnectAccepted {
 	if x != nil {
-		return x.ClientVersion
+		if x, ok := x.Payload.(*ConnectResponse_Accepted); ok {
+			return x.Accepted
+		}
 	}
-	return ""
+	return nil
+}
+
+func (x *ConnectResponse) GetCommand() *ExecutionCommand {
+	if x != nil {
+		if x, ok := x.Payload.(*ConnectResponse_Command); ok {
+			return x.Command
+		}
+	}
+	return nil
 }
 
+type isConnectResponse_Payload interface {
+	isConnectResponse_Payload()
+}
+
+type ConnectResponse_Accepted struct {
+	Accepted *ConnectAccepted `protobuf:"bytes,1,opt,name=accepted,proto3,oneof"`
+}
+
+type ConnectResponse_Command struct {
+	Command *ExecutionCommand `protobuf:"bytes,2,opt,name=command,proto3,oneof"`
+}
+
+func (*ConnectResponse_Accepted) isConnectResponse_Payload() {}
+
+func (*ConnectResponse_Command) isConnectResponse_Payload() {}
+
 // Ack command request
 type AckCommandRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -375,7 +969,7 @@ type AckCommandRequest struct {
 
 func (x *AckCommandRequest) Reset() {
 	*x = AckCommandRequest{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[4]
+	mi := &file_executor_service_v1_client_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +981,7 @@ func (x *AckCommandRequest) String() string {
 func (*AckCommandRequest) ProtoMessage() {}
 
 func (x *AckCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[4]
+	mi := &file_executor_service_v1_client_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +994,7 @@ func (x *AckCommandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckCommandRequest.ProtoReflect.Descriptor instead.
 func (*AckCommandRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{4}
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AckCommandRequest) GetCommandId() string {
@@ -433,7 +1027,7 @@ type AckCommandResponse struct {
 
 func (x *AckCommandResponse) Reset() {
 	*x = AckCommandResponse{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[5]
+	mi := &file_executor_service_v1_client_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +1039,7 @@ func (x *AckCommandResponse) String() string {
 func (*AckCommandResponse) ProtoMessage() {}
 
 func (x *AckCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[5]
+	mi := &file_executor_service_v1_client_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +1052,7 @@ func (x *AckCommandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckCommandResponse.ProtoReflect.Descriptor instead.
 func (*AckCommandResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{5}
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AckCommandResponse) GetAcknowledged() bool {
@@ -482,7 +1076,7 @@ type ReportResultRequest struct {
 
 func (x *ReportResultRequest) Reset() {
 	*x = ReportResultRequest{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[6]
+	mi := &file_executor_service_v1_client_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -494,7 +1088,7 @@ func (x *ReportResultRequest) String() string {
 func (*ReportResultRequest) ProtoMessage() {}
 
 func (x *ReportResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[6]
+	mi := &file_executor_service_v1_client_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +1101,7 @@ func (x *ReportResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportResultRequest.ProtoReflect.Descriptor instead.
 func (*ReportResultRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{6}
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReportResultRequest) GetExecutionId() string {
@@ -554,7 +1148,7 @@ type ReportResultResponse struct {
 
 func (x *ReportResultResponse) Reset() {
 	*x = ReportResultResponse{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[7]
+	mi := &file_executor_service_v1_client_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -566,7 +1160,7 @@ func (x *ReportResultResponse) String() string {
 func (*ReportResultResponse) ProtoMessage() {}
 
 func (x *ReportResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[7]
+	mi := &file_executor_service_v1_client_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +1173,7 @@ func (x *ReportResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportResultResponse.ProtoReflect.Descriptor instead.
 func (*ReportResultResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{7}
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReportResultResponse) GetRecorded() bool {
@@ -589,21 +1183,189 @@ func (x *ReportResultResponse) GetRecorded() bool {
 	return false
 }
 
+type StreamOutputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChunksStored  uint32                 `protobuf:"varint,1,opt,name=chunks_stored,json=chunksStored,proto3" json:"chunks_stored,omitempty"` // duplicates of already stored sequence numbers are skipped
+	LastSeq       int64                  `protobuf:"varint,2,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamOutputResponse) Reset() {
+	*x = StreamOutputResponse{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOutputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOutputResponse) ProtoMessage() {}
+
+func (x *StreamOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOutputResponse.ProtoReflect.Descriptor instead.
+func (*StreamOutputResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StreamOutputResponse) GetChunksStored() uint32 {
+	if x != nil {
+		return x.ChunksStored
+	}
+	return 0
+}
+
+func (x *StreamOutputResponse) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+// Report cancelled request (sent after the client killed a cancelled execution)
+type ReportCancelledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionId   string                 `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	Output        string                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"` // output captured before termination
+	ErrorOutput   string                 `protobuf:"bytes,3,opt,name=error_output,json=errorOutput,proto3" json:"error_output,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCancelledRequest) Reset() {
+	*x = ReportCancelledRequest{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCancelledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCancelledRequest) ProtoMessage() {}
+
+func (x *ReportCancelledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCancelledRequest.ProtoReflect.Descriptor instead.
+func (*ReportCancelledRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReportCancelledRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *ReportCancelledRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ReportCancelledRequest) GetErrorOutput() string {
+	if x != nil {
+		return x.ErrorOutput
+	}
+	return ""
+}
+
+func (x *ReportCancelledRequest) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type ReportCancelledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recorded      bool                   `protobuf:"varint,1,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCancelledResponse) Reset() {
+	*x = ReportCancelledResponse{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCancelledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCancelledResponse) ProtoMessage() {}
+
+func (x *ReportCancelledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCancelledResponse.ProtoReflect.Descriptor instead.
+func (*ReportCancelledResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReportCancelledResponse) GetRecorded() bool {
+	if x != nil {
+		return x.Recorded
+	}
+	return false
+}
+
 // Submit execution request (client-pull: creates log + stores result in one shot)
 type SubmitExecutionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScriptId      string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
-	ExitCode      int32                  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	Output        string                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
-	ErrorOutput   string                 `protobuf:"bytes,4,opt,name=error_output,json=errorOutput,proto3" json:"error_output,omitempty"`
-	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId    string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	ExitCode    int32                  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Output      string                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	ErrorOutput string                 `protobuf:"bytes,4,opt,name=error_output,json=errorOutput,proto3" json:"error_output,omitempty"`
+	DurationMs  int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// Execution reserved by FetchScript; without it a new execution is
+	// created within the client's concurrency limits
+	ExecutionId   *string `protobuf:"bytes,6,opt,name=execution_id,json=executionId,proto3,oneof" json:"execution_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitExecutionRequest) Reset() {
 	*x = SubmitExecutionRequest{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[8]
+	mi := &file_executor_service_v1_client_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +1377,7 @@ func (x *SubmitExecutionRequest) String() string {
 func (*SubmitExecutionRequest) ProtoMessage() {}
 
 func (x *SubmitExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[8]
+	mi := &file_executor_service_v1_client_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +1390,7 @@ func (x *SubmitExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitExecutionRequest.ProtoReflect.Descriptor instead.
 func (*SubmitExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{8}
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SubmitExecutionRequest) GetScriptId() string {
@@ -666,6 +1428,13 @@ func (x *SubmitExecutionRequest) GetDurationMs() int64 {
 	return 0
 }
 
+func (x *SubmitExecutionRequest) GetExecutionId() string {
+	if x != nil && x.ExecutionId != nil {
+		return *x.ExecutionId
+	}
+	return ""
+}
+
 type SubmitExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExecutionId   string                 `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
@@ -676,7 +1445,7 @@ type SubmitExecutionResponse struct {
 
 func (x *SubmitExecutionResponse) Reset() {
 	*x = SubmitExecutionResponse{}
-	mi := &file_executor_service_v1_client_proto_msgTypes[9]
+	mi := &file_executor_service_v1_client_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -688,7 +1457,7 @@ func (x *SubmitExecutionResponse) String() string {
 func (*SubmitExecutionResponse) ProtoMessage() {}
 
 func (x *SubmitExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_client_proto_msgTypes[9]
+	mi := &file_executor_service_v1_client_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +1470,7 @@ func (x *SubmitExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitExecutionResponse.ProtoReflect.Descriptor instead.
 func (*SubmitExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{9}
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SubmitExecutionResponse) GetExecutionId() string {
@@ -718,11 +1487,100 @@ func (x *SubmitExecutionResponse) GetRecorded() bool {
 	return false
 }
 
+// Get verification keys request
+type GetVerificationKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerificationKeysRequest) Reset() {
+	*x = GetVerificationKeysRequest{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerificationKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerificationKeysRequest) ProtoMessage() {}
+
+func (x *GetVerificationKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerificationKeysRequest.ProtoReflect.Descriptor instead.
+func (*GetVerificationKeysRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{20}
+}
+
+type GetVerificationKeysResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Keys           []*SigningKey          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"` // active and retired keys
+	SigningEnabled bool                   `protobuf:"varint,2,opt,name=signing_enabled,json=signingEnabled,proto3" json:"signing_enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetVerificationKeysResponse) Reset() {
+	*x = GetVerificationKeysResponse{}
+	mi := &file_executor_service_v1_client_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerificationKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerificationKeysResponse) ProtoMessage() {}
+
+func (x *GetVerificationKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_client_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerificationKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetVerificationKeysResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_client_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetVerificationKeysResponse) GetKeys() []*SigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *GetVerificationKeysResponse) GetSigningEnabled() bool {
+	if x != nil {
+		return x.SigningEnabled
+	}
+	return false
+}
+
 var File_executor_service_v1_client_proto protoreflect.FileDescriptor
 
 const file_executor_service_v1_client_proto_rawDesc = "" +
 	"\n" +
-	" executor/service/v1/client.proto\x12\x13executor.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\x1a executor/service/v1/script.proto\"\x8d\x03\n" +
+	" executor/service/v1/client.proto\x12\x13executor.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\x1a#executor/service/v1/execution.proto\x1a#executor/service/v1/inventory.proto\x1a executor/service/v1/script.proto\x1a!executor/service/v1/signing.proto\"\xab\x05\n" +
 	"\x10ExecutionCommand\x12\x1d\n" +
 	"\n" +
 	"command_id\x18\x01 \x01(\tR\tcommandId\x12!\n" +
@@ -735,9 +1593,34 @@ const file_executor_service_v1_client_proto_rawDesc = "" +
 	"\acontent\x18\x06 \x01(\tB\x06ڶ\x1a\x02z\x00R\acontent\x12)\n" +
 	"\fcontent_hash\x18\a \x01(\tB\x06ڶ\x1a\x02z\x00R\vcontentHash\x12C\n" +
 	"\fcommand_type\x18\b \x01(\x0e2 .executor.service.v1.CommandTypeR\vcommandType\x12%\n" +
-	"\x0etarget_version\x18\t \x01(\tR\rtargetVersion\"?\n" +
+	"\x0etarget_version\x18\t \x01(\tR\rtargetVersion\x12'\n" +
+	"\x0ftimeout_seconds\x18\n" +
+	" \x01(\x05R\x0etimeoutSeconds\x12K\n" +
+	"\x03env\x18\v \x03(\v2..executor.service.v1.ExecutionCommand.EnvEntryB\tڶ\x1a\x05\xa2\x01\x02\b\x01R\x03env\x12\x1d\n" +
+	"\x04args\x18\f \x03(\tB\tڶ\x1a\x05\xa2\x01\x02\b\x01R\x04args\x12A\n" +
+	"\tsignature\x18\r \x01(\v2\x1e.executor.service.v1.SignatureH\x00R\tsignature\x88\x01\x01\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_signature\"\xe1\x01\n" +
+	"\rSignedCommand\x12?\n" +
+	"\acommand\x18\x01 \x01(\v2%.executor.service.v1.ExecutionCommandR\acommand\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x127\n" +
+	"\tissued_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xf9\x01\n" +
+	"\fSignedScript\x12\x1b\n" +
+	"\tscript_id\x18\x01 \x01(\tR\bscriptId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12!\n" +
+	"\fcontent_hash\x18\x03 \x01(\tR\vcontentHash\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x127\n" +
+	"\tissued_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"l\n" +
 	"\x12FetchScriptRequest\x12)\n" +
-	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\"\xfc\x01\n" +
+	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12+\n" +
+	"\x11reserve_execution\x18\x02 \x01(\bR\x10reserveExecution\"\xf0\x02\n" +
 	"\x13FetchScriptResponse\x12\x1b\n" +
 	"\tscript_id\x18\x01 \x01(\tR\bscriptId\x12\x1f\n" +
 	"\vscript_name\x18\x02 \x01(\tR\n" +
@@ -746,10 +1629,35 @@ const file_executor_service_v1_client_proto_rawDesc = "" +
 	"scriptType\x12 \n" +
 	"\acontent\x18\x04 \x01(\tB\x06ڶ\x1a\x02z\x00R\acontent\x12)\n" +
 	"\fcontent_hash\x18\x05 \x01(\tB\x06ڶ\x1a\x02z\x00R\vcontentHash\x12\x18\n" +
-	"\aversion\x18\x06 \x01(\x05R\aversion\"j\n" +
+	"\aversion\x18\x06 \x01(\x05R\aversion\x12A\n" +
+	"\tsignature\x18\a \x01(\v2\x1e.executor.service.v1.SignatureH\x00R\tsignature\x88\x01\x01\x12!\n" +
+	"\fexecution_id\x18\b \x01(\tR\vexecutionIdB\f\n" +
+	"\n" +
+	"_signature\"\xa2\x01\n" +
 	"\x15StreamCommandsRequest\x12*\n" +
 	"\tclient_id\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bclientId\x12%\n" +
-	"\x0eclient_version\x18\x02 \x01(\tR\rclientVersion\"\xab\x01\n" +
+	"\x0eclient_version\x18\x02 \x01(\tR\rclientVersion\x126\n" +
+	"\x05facts\x18\x03 \x01(\v2 .executor.service.v1.ClientFactsR\x05facts\"\x99\x01\n" +
+	"\fConnectHello\x12*\n" +
+	"\tclient_id\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bclientId\x12%\n" +
+	"\x0eclient_version\x18\x02 \x01(\tR\rclientVersion\x126\n" +
+	"\x05facts\x18\x03 \x01(\v2 .executor.service.v1.ClientFactsR\x05facts\"v\n" +
+	"\tHeartbeat\x12\x14\n" +
+	"\x05load1\x18\x01 \x01(\x01R\x05load1\x12\x14\n" +
+	"\x05load5\x18\x02 \x01(\x01R\x05load5\x12\x16\n" +
+	"\x06load15\x18\x03 \x01(\x01R\x06load15\x12%\n" +
+	"\x0euptime_seconds\x18\x04 \x01(\x03R\ruptimeSeconds\"\xd2\x01\n" +
+	"\x0eConnectRequest\x129\n" +
+	"\x05hello\x18\x01 \x01(\v2!.executor.service.v1.ConnectHelloH\x00R\x05hello\x12>\n" +
+	"\theartbeat\x18\x02 \x01(\v2\x1e.executor.service.v1.HeartbeatH\x00R\theartbeat\x12:\n" +
+	"\x03ack\x18\x03 \x01(\v2&.executor.service.v1.AckCommandRequestH\x00R\x03ackB\t\n" +
+	"\apayload\"O\n" +
+	"\x0fConnectAccepted\x12<\n" +
+	"\x1aheartbeat_interval_seconds\x18\x01 \x01(\x05R\x18heartbeatIntervalSeconds\"\xa3\x01\n" +
+	"\x0fConnectResponse\x12B\n" +
+	"\baccepted\x18\x01 \x01(\v2$.executor.service.v1.ConnectAcceptedH\x00R\baccepted\x12A\n" +
+	"\acommand\x18\x02 \x01(\v2%.executor.service.v1.ExecutionCommandH\x00R\acommandB\t\n" +
+	"\apayload\"\xab\x01\n" +
 	"\x11AckCommandRequest\x12+\n" +
 	"\n" +
 	"command_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\tcommandId\x12\x1a\n" +
@@ -766,27 +1674,50 @@ const file_executor_service_v1_client_proto_rawDesc = "" +
 	"\vduration_ms\x18\x05 \x01(\x03R\n" +
 	"durationMs\"2\n" +
 	"\x14ReportResultResponse\x12\x1a\n" +
-	"\brecorded\x18\x01 \x01(\bR\brecorded\"\xcc\x01\n" +
+	"\brecorded\x18\x01 \x01(\bR\brecorded\"V\n" +
+	"\x14StreamOutputResponse\x12#\n" +
+	"\rchunks_stored\x18\x01 \x01(\rR\fchunksStored\x12\x19\n" +
+	"\blast_seq\x18\x02 \x01(\x03R\alastSeq\"\xb5\x01\n" +
+	"\x16ReportCancelledRequest\x12/\n" +
+	"\fexecution_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\vexecutionId\x12\x1e\n" +
+	"\x06output\x18\x02 \x01(\tB\x06ڶ\x1a\x02z\x00R\x06output\x12)\n" +
+	"\ferror_output\x18\x03 \x01(\tB\x06ڶ\x1a\x02z\x00R\verrorOutput\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\"5\n" +
+	"\x17ReportCancelledResponse\x12\x1a\n" +
+	"\brecorded\x18\x01 \x01(\bR\brecorded\"\x8e\x02\n" +
 	"\x16SubmitExecutionRequest\x12)\n" +
 	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12\x1b\n" +
 	"\texit_code\x18\x02 \x01(\x05R\bexitCode\x12\x1e\n" +
 	"\x06output\x18\x03 \x01(\tB\x06ڶ\x1a\x02z\x00R\x06output\x12)\n" +
 	"\ferror_output\x18\x04 \x01(\tB\x06ڶ\x1a\x02z\x00R\verrorOutput\x12\x1f\n" +
 	"\vduration_ms\x18\x05 \x01(\x03R\n" +
-	"durationMs\"X\n" +
+	"durationMs\x12/\n" +
+	"\fexecution_id\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x18$H\x00R\vexecutionId\x88\x01\x01B\x0f\n" +
+	"\r_execution_id\"X\n" +
 	"\x17SubmitExecutionResponse\x12!\n" +
 	"\fexecution_id\x18\x01 \x01(\tR\vexecutionId\x12\x1a\n" +
-	"\brecorded\x18\x02 \x01(\bR\brecorded*P\n" +
+	"\brecorded\x18\x02 \x01(\bR\brecorded\"\x1c\n" +
+	"\x1aGetVerificationKeysRequest\"{\n" +
+	"\x1bGetVerificationKeysResponse\x123\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1f.executor.service.v1.SigningKeyR\x04keys\x12'\n" +
+	"\x0fsigning_enabled\x18\x02 \x01(\bR\x0esigningEnabled*\x81\x01\n" +
 	"\vCommandType\x12!\n" +
 	"\x1dCOMMAND_TYPE_SCRIPT_EXECUTION\x10\x00\x12\x1e\n" +
-	"\x1aCOMMAND_TYPE_CLIENT_UPDATE\x10\x012\xcb\x05\n" +
+	"\x1aCOMMAND_TYPE_CLIENT_UPDATE\x10\x01\x12\x16\n" +
+	"\x12COMMAND_TYPE_ABORT\x10\x02\x12\x17\n" +
+	"\x13COMMAND_TYPE_CANCEL\x10\x032\xce\t\n" +
 	"\x15ExecutorClientService\x12\x88\x01\n" +
 	"\vFetchScript\x12'.executor.service.v1.FetchScriptRequest\x1a(.executor.service.v1.FetchScriptResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/client/scripts/{script_id}\x12g\n" +
-	"\x0eStreamCommands\x12*.executor.service.v1.StreamCommandsRequest\x1a%.executor.service.v1.ExecutionCommand\"\x000\x01\x12\x8e\x01\n" +
+	"\x0eStreamCommands\x12*.executor.service.v1.StreamCommandsRequest\x1a%.executor.service.v1.ExecutionCommand\"\x000\x01\x12Z\n" +
+	"\aConnect\x12#.executor.service.v1.ConnectRequest\x1a$.executor.service.v1.ConnectResponse\"\x00(\x010\x01\x12\x8e\x01\n" +
 	"\n" +
-	"AckCommand\x12&.executor.service.v1.AckCommandRequest\x1a'.executor.service.v1.AckCommandResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/client/commands/{command_id}/ack\x12\x9b\x01\n" +
-	"\fReportResult\x12(.executor.service.v1.ReportResultRequest\x1a).executor.service.v1.ReportResultResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/client/executions/{execution_id}/result\x12\x8e\x01\n" +
-	"\x0fSubmitExecution\x12+.executor.service.v1.SubmitExecutionRequest\x1a,.executor.service.v1.SubmitExecutionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/client/executionsB\xe3\x01\n" +
+	"AckCommand\x12&.executor.service.v1.AckCommandRequest\x1a'.executor.service.v1.AckCommandResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/client/commands/{command_id}/ack\x12_\n" +
+	"\fStreamOutput\x12 .executor.service.v1.OutputChunk\x1a).executor.service.v1.StreamOutputResponse\"\x00(\x01\x12\x9b\x01\n" +
+	"\fReportResult\x12(.executor.service.v1.ReportResultRequest\x1a).executor.service.v1.ReportResultResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/client/executions/{execution_id}/result\x12\xa7\x01\n" +
+	"\x0fReportCancelled\x12+.executor.service.v1.ReportCancelledRequest\x1a,.executor.service.v1.ReportCancelledResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/client/executions/{execution_id}/cancelled\x12\x8e\x01\n" +
+	"\x0fSubmitExecution\x12+.executor.service.v1.SubmitExecutionRequest\x1a,.executor.service.v1.SubmitExecutionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/client/executions\x12\x99\x01\n" +
+	"\x13GetVerificationKeys\x12/.executor.service.v1.GetVerificationKeysRequest\x1a0.executor.service.v1.GetVerificationKeysResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/client/signing-keysB\xe3\x01\n" +
 	"\x17com.executor.service.v1B\vClientProtoP\x01ZMgithub.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1;executorpb\xa2\x02\x03ESX\xaa\x02\x13Executor.Service.V1\xca\x02\x13Executor\\Service\\V1\xe2\x02\x1fExecutor\\Service\\V1\\GPBMetadata\xea\x02\x15Executor::Service::V1b\x06proto3"
 
 var (
@@ -802,40 +1733,82 @@ func file_executor_service_v1_client_proto_rawDescGZIP() []byte {
 }
 
 var file_executor_service_v1_client_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_executor_service_v1_client_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_executor_service_v1_client_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_executor_service_v1_client_proto_goTypes = []any{
-	(CommandType)(0),                // 0: executor.service.v1.CommandType
-	(*ExecutionCommand)(nil),        // 1: executor.service.v1.ExecutionCommand
-	(*FetchScriptRequest)(nil),      // 2: executor.service.v1.FetchScriptRequest
-	(*FetchScriptResponse)(nil),     // 3: executor.service.v1.FetchScriptResponse
-	(*StreamCommandsRequest)(nil),   // 4: executor.service.v1.StreamCommandsRequest
-	(*AckCommandRequest)(nil),       // 5: executor.service.v1.AckCommandRequest
-	(*AckCommandResponse)(nil),      // 6: executor.service.v1.AckCommandResponse
-	(*ReportResultRequest)(nil),     // 7: executor.service.v1.ReportResultRequest
-	(*ReportResultResponse)(nil),    // 8: executor.service.v1.ReportResultResponse
-	(*SubmitExecutionRequest)(nil),  // 9: executor.service.v1.SubmitExecutionRequest
-	(*SubmitExecutionResponse)(nil), // 10: executor.service.v1.SubmitExecutionResponse
-	(ScriptType)(0),                 // 11: executor.service.v1.ScriptType
+	(CommandType)(0),                    // 0: executor.service.v1.CommandType
+	(*ExecutionCommand)(nil),            // 1: executor.service.v1.ExecutionCommand
+	(*SignedCommand)(nil),               // 2: executor.service.v1.SignedCommand
+	(*SignedScript)(nil),                // 3: executor.service.v1.SignedScript
+	(*FetchScriptRequest)(nil),          // 4: executor.service.v1.FetchScriptRequest
+	(*FetchScriptResponse)(nil),         // 5: executor.service.v1.FetchScriptResponse
+	(*StreamCommandsRequest)(nil),       // 6: executor.service.v1.StreamCommandsRequest
+	(*ConnectHello)(nil),                // 7: executor.service.v1.ConnectHello
+	(*Heartbeat)(nil),                   // 8: executor.service.v1.Heartbeat
+	(*ConnectRequest)(nil),              // 9: executor.service.v1.ConnectRequest
+	(*ConnectAccepted)(nil),             // 10: executor.service.v1.ConnectAccepted
+	(*ConnectResponse)(nil),             // 11: executor.service.v1.ConnectResponse
+	(*AckCommandRequest)(nil),           // 12: executor.service.v1.AckCommandRequest
+	(*AckCommandResponse)(nil),          // 13: executor.service.v1.AckCommandResponse
+	(*ReportResultRequest)(nil),         // 14: executor.service.v1.ReportResultRequest
+	(*ReportResultResponse)(nil),        // 15: executor.service.v1.ReportResultResponse
+	(*StreamOutputResponse)(nil),        // 16: executor.service.v1.StreamOutputResponse
+	(*ReportCancelledRequest)(nil),      // 17: executor.service.v1.ReportCancelledRequest
+	(*ReportCancelledResponse)(nil),     // 18: executor.service.v1.ReportCancelledResponse
+	(*SubmitExecutionRequest)(nil),      // 19: executor.service.v1.SubmitExecutionRequest
+	(*SubmitExecutionResponse)(nil),     // 20: executor.service.v1.SubmitExecutionResponse
+	(*GetVerificationKeysRequest)(nil),  // 21: executor.service.v1.GetVerificationKeysRequest
+	(*GetVerificationKeysResponse)(nil), // 22: executor.service.v1.GetVerificationKeysResponse
+	nil,                                 // 23: executor.service.v1.ExecutionCommand.EnvEntry
+	(ScriptType)(0),                     // 24: executor.service.v1.ScriptType
+	(*Signature)(nil),                   // 25: executor.service.v1.Signature
+	(*timestamppb.Timestamp)(nil),       // 26: google.protobuf.Timestamp
+	(*ClientFacts)(nil),                 // 27: executor.service.v1.ClientFacts
+	(*SigningKey)(nil),                  // 28: executor.service.v1.SigningKey
+	(*OutputChunk)(nil),                 // 29: executor.service.v1.OutputChunk
 }
 var file_executor_service_v1_client_proto_depIdxs = []int32{
-	11, // 0: executor.service.v1.ExecutionCommand.script_type:type_name -> executor.service.v1.ScriptType
+	24, // 0: executor.service.v1.ExecutionCommand.script_type:type_name -> executor.service.v1.ScriptType
 	0,  // 1: executor.service.v1.ExecutionCommand.command_type:type_name -> executor.service.v1.CommandType
-	11, // 2: executor.service.v1.FetchScriptResponse.script_type:type_name -> executor.service.v1.ScriptType
-	2,  // 3: executor.service.v1.ExecutorClientService.FetchScript:input_type -> executor.service.v1.FetchScriptRequest
-	4,  // 4: executor.service.v1.ExecutorClientService.StreamCommands:input_type -> executor.service.v1.StreamCommandsRequest
-	5,  // 5: executor.service.v1.ExecutorClientService.AckCommand:input_type -> executor.service.v1.AckCommandRequest
-	7,  // 6: executor.service.v1.ExecutorClientService.ReportResult:input_type -> executor.service.v1.ReportResultRequest
-	9,  // 7: executor.service.v1.ExecutorClientService.SubmitExecution:input_type -> executor.service.v1.SubmitExecutionRequest
-	3,  // 8: executor.service.v1.ExecutorClientService.FetchScript:output_type -> executor.service.v1.FetchScriptResponse
-	1,  // 9: executor.service.v1.ExecutorClientService.StreamCommands:output_type -> executor.service.v1.ExecutionCommand
-	6,  // 10: executor.service.v1.ExecutorClientService.AckCommand:output_type -> executor.service.v1.AckCommandResponse
-	8,  // 11: executor.service.v1.ExecutorClientService.ReportResult:output_type -> executor.service.v1.ReportResultResponse
-	10, // 12: executor.service.v1.ExecutorClientService.SubmitExecution:output_type -> executor.service.v1.SubmitExecutionResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	23, // 2: executor.service.v1.ExecutionCommand.env:type_name -> executor.service.v1.ExecutionCommand.EnvEntry
+	25, // 3: executor.service.v1.ExecutionCommand.signature:type_name -> executor.service.v1.Signature
+	1,  // 4: executor.service.v1.SignedCommand.command:type_name -> executor.service.v1.ExecutionCommand
+	26, // 5: executor.service.v1.SignedCommand.issued_at:type_name -> google.protobuf.Timestamp
+	26, // 6: executor.service.v1.SignedCommand.expires_at:type_name -> google.protobuf.Timestamp
+	26, // 7: executor.service.v1.SignedScript.issued_at:type_name -> google.protobuf.Timestamp
+	26, // 8: executor.service.v1.SignedScript.expires_at:type_name -> google.protobuf.Timestamp
+	24, // 9: executor.service.v1.FetchScriptResponse.script_type:type_name -> executor.service.v1.ScriptType
+	25, // 10: executor.service.v1.FetchScriptResponse.signature:type_name -> executor.service.v1.Signature
+	27, // 11: executor.service.v1.StreamCommandsRequest.facts:type_name -> executor.service.v1.ClientFacts
+	27, // 12: executor.service.v1.ConnectHello.facts:type_name -> executor.service.v1.ClientFacts
+	7,  // 13: executor.service.v1.ConnectRequest.hello:type_name -> executor.service.v1.ConnectHello
+	8,  // 14: executor.service.v1.ConnectRequest.heartbeat:type_name -> executor.service.v1.Heartbeat
+	12, // 15: executor.service.v1.ConnectRequest.ack:type_name -> executor.service.v1.AckCommandRequest
+	10, // 16: executor.service.v1.ConnectResponse.accepted:type_name -> executor.service.v1.ConnectAccepted
+	1,  // 17: executor.service.v1.ConnectResponse.command:type_name -> executor.service.v1.ExecutionCommand
+	28, // 18: executor.service.v1.GetVerificationKeysResponse.keys:type_name -> executor.service.v1.SigningKey
+	4,  // 19: executor.service.v1.ExecutorClientService.FetchScript:input_type -> executor.service.v1.FetchScriptRequest
+	6,  // 20: executor.service.v1.ExecutorClientService.StreamCommands:input_type -> executor.service.v1.StreamCommandsRequest
+	9,  // 21: executor.service.v1.ExecutorClientService.Connect:input_type -> executor.service.v1.ConnectRequest
+	12, // 22: executor.service.v1.ExecutorClientService.AckCommand:input_type -> executor.service.v1.AckCommandRequest
+	29, // 23: executor.service.v1.ExecutorClientService.StreamOutput:input_type -> executor.service.v1.OutputChunk
+	14, // 24: executor.service.v1.ExecutorClientService.ReportResult:input_type -> executor.service.v1.ReportResultRequest
+	17, // 25: executor.service.v1.ExecutorClientService.ReportCancelled:input_type -> executor.service.v1.ReportCancelledRequest
+	19, // 26: executor.service.v1.ExecutorClientService.SubmitExecution:input_type -> executor.service.v1.SubmitExecutionRequest
+	21, // 27: executor.service.v1.ExecutorClientService.GetVerificationKeys:input_type -> executor.service.v1.GetVerificationKeysRequest
+	5,  // 28: executor.service.v1.ExecutorClientService.FetchScript:output_type -> executor.service.v1.FetchScriptResponse
+	1,  // 29: executor.service.v1.ExecutorClientService.StreamCommands:output_type -> executor.service.v1.ExecutionCommand
+	11, // 30: executor.service.v1.ExecutorClientService.Connect:output_type -> executor.service.v1.ConnectResponse
+	13, // 31: executor.service.v1.ExecutorClientService.AckCommand:output_type -> executor.service.v1.AckCommandResponse
+	16, // 32: executor.service.v1.ExecutorClientService.StreamOutput:output_type -> executor.service.v1.StreamOutputResponse
+	15, // 33: executor.service.v1.ExecutorClientService.ReportResult:output_type -> executor.service.v1.ReportResultResponse
+	18, // 34: executor.service.v1.ExecutorClientService.ReportCancelled:output_type -> executor.service.v1.ReportCancelledResponse
+	20, // 35: executor.service.v1.ExecutorClientService.SubmitExecution:output_type -> executor.service.v1.SubmitExecutionResponse
+	22, // 36: executor.service.v1.ExecutorClientService.GetVerificationKeys:output_type -> executor.service.v1.GetVerificationKeysResponse
+	28, // [28:37] is the sub-list for method output_type
+	19, // [19:28] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_executor_service_v1_client_proto_init() }
@@ -843,15 +1816,30 @@ func file_executor_service_v1_client_proto_init() {
 	if File_executor_service_v1_client_proto != nil {
 		return
 	}
+	file_executor_service_v1_execution_proto_init()
+	file_executor_service_v1_inventory_proto_init()
 	file_executor_service_v1_script_proto_init()
+	file_executor_service_v1_signing_proto_init()
+	file_executor_service_v1_client_proto_msgTypes[0].OneofWrappers = []any{}
 	file_executor_service_v1_client_proto_msgTypes[4].OneofWrappers = []any{}
+	file_executor_service_v1_client_proto_msgTypes[8].OneofWrappers = []any{
+		(*ConnectRequest_Hello)(nil),
+		(*ConnectRequest_Heartbeat)(nil),
+		(*ConnectRequest_Ack)(nil),
+	}
+	file_executor_service_v1_client_proto_msgTypes[10].OneofWrappers = []any{
+		(*ConnectResponse_Accepted)(nil),
+		(*ConnectResponse_Command)(nil),
+	}
+	file_executor_service_v1_client_proto_msgTypes[11].OneofWrappers = []any{}
+	file_executor_service_v1_client_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_executor_service_v1_client_proto_rawDesc), len(file_executor_service_v1_client_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -64,6 +64,14 @@ func (s *redactedExecutorClientServiceServer) StreamCommands(in *StreamCommandsR
 	return s.srv.StreamCommands(in, stream)
 }
 
+// Connect is the redacted wrapper for the actual ExecutorClientServiceServer.Connect method
+// Bidirectional streaming
+func (s *redactedExecutorClientServiceServer) Connect(stream grpc.BidiStreamingServer[ConnectRequest, ConnectResponse]) error {
+	// Note: Redaction for bidirectional streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.Connect(stream)
+}
+
 // AckCommand is the redacted wrapper for the actual ExecutorClientServiceServer.AckCommand method
 // Unary RPC
 func (s *redactedExecutorClientServiceServer) AckCommand(ctx context.Context, in *AckCommandRequest) (*AckCommandResponse, error) {
@@ -75,6 +83,14 @@ func (s *redactedExecutorClientServiceServer) AckCommand(ctx context.Context, in
 	return res, err
 }
 
+// StreamOutput is the redacted wrapper for the actual ExecutorClientServiceServer.StreamOutput method
+// Client streaming
+func (s *redactedExecutorClientServiceServer) StreamOutput(stream grpc.ClientStreamingServer[OutputChunk, StreamOutputResponse]) error {
+	// Note: Redaction for client streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.StreamOutput(stream)
+}
+
 // ReportResult is the redacted wrapper for the actual ExecutorClientServiceServer.ReportResult method
 // Unary RPC
 func (s *redactedExecutorClientServiceServer) ReportResult(ctx context.Context, in *ReportResultRequest) (*ReportResultResponse, error) {
@@ -86,6 +102,17 @@ func (s *redactedExecutorClientServiceServer) ReportResult(ctx context.Context,
 	return res, err
 }
 
+// ReportCancelled is the redacted wrapper for the actual ExecutorClientServiceServer.ReportCancelled method
+// Unary RPC
+func (s *redactedExecutorClientServiceServer) ReportCancelled(ctx context.Context, in *ReportCancelledRequest) (*ReportCancelledResponse, error) {
+	res, err := s.srv.ReportCancelled(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // SubmitExecution is the redacted wrapper for the actual ExecutorClientServiceServer.SubmitExecution method
 // Unary RPC
 func (s *redactedExecutorClientServiceServer) SubmitExecution(ctx context.Context, in *SubmitExecutionRequest) (*SubmitExecutionResponse, error) {
@@ -97,6 +124,17 @@ func (s *redactedExecutorClientServiceServer) SubmitExecution(ctx context.Contex
 	return res, err
 }
 
+// GetVerificationKeys is the redacted wrapper for the actual ExecutorClientServiceServer.GetVerificationKeys method
+// Unary RPC
+func (s *redactedExecutorClientServiceServer) GetVerificationKeys(ctx context.Context, in *GetVerificationKeysRequest) (*GetVerificationKeysResponse, error) {
+	res, err := s.srv.GetVerificationKeys(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for ExecutionCommand
 func (x *ExecutionCommand) Redact() string {
 	if x == nil {
@@ -122,6 +160,52 @@ func (x *ExecutionCommand) Redact() string {
 	// Safe field: CommandType
 
 	// Safe field: TargetVersion
+
+	// Safe field: TimeoutSeconds
+
+	// Redacting field: Env
+	x.Env = map[string]string{}
+
+	// Redacting field: Args
+	x.Args = []string{}
+
+	// Safe field: Signature
+	return x.String()
+}
+
+// Redact method implementation for SignedCommand
+func (x *SignedCommand) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Command
+
+	// Safe field: ClientId
+
+	// Safe field: IssuedAt
+
+	// Safe field: ExpiresAt
+	return x.String()
+}
+
+// Redact method implementation for SignedScript
+func (x *SignedScript) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ScriptId
+
+	// Safe field: Version
+
+	// Safe field: ContentHash
+
+	// Safe field: ClientId
+
+	// Safe field: IssuedAt
+
+	// Safe field: ExpiresAt
 	return x.String()
 }
 
@@ -132,6 +216,8 @@ func (x *FetchScriptRequest) Redact() string {
 	}
 
 	// Safe field: ScriptId
+
+	// Safe field: ReserveExecution
 	return x.String()
 }
 
@@ -154,6 +240,10 @@ func (x *FetchScriptResponse) Redact() string {
 	x.ContentHash = ``
 
 	// Safe field: Version
+
+	// Safe field: Signature
+
+	// Safe field: ExecutionId
 	return x.String()
 }
 
@@ -166,6 +256,74 @@ func (x *StreamCommandsRequest) Redact() string {
 	// Safe field: ClientId
 
 	// Safe field: ClientVersion
+
+	// Safe field: Facts
+	return x.String()
+}
+
+// Redact method implementation for ConnectHello
+func (x *ConnectHello) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ClientId
+
+	// Safe field: ClientVersion
+
+	// Safe field: Facts
+	return x.String()
+}
+
+// Redact method implementation for Heartbeat
+func (x *Heartbeat) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Load1
+
+	// Safe field: Load5
+
+	// Safe field: Load15
+
+	// Safe field: UptimeSeconds
+	return x.String()
+}
+
+// Redact method implementation for ConnectRequest
+func (x *ConnectRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Hello
+
+	// Safe field: Heartbeat
+
+	// Safe field: Ack
+	return x.String()
+}
+
+// Redact method implementation for ConnectAccepted
+func (x *ConnectAccepted) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: HeartbeatIntervalSeconds
+	return x.String()
+}
+
+// Redact method implementation for ConnectResponse
+func (x *ConnectResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Accepted
+
+	// Safe field: Command
 	return x.String()
 }
 
@@ -223,6 +381,46 @@ func (x *ReportResultResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for StreamOutputResponse
+func (x *StreamOutputResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ChunksStored
+
+	// Safe field: LastSeq
+	return x.String()
+}
+
+// Redact method implementation for ReportCancelledRequest
+func (x *ReportCancelledRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ExecutionId
+
+	// Redacting field: Output
+	x.Output = ``
+
+	// Redacting field: ErrorOutput
+	x.ErrorOutput = ``
+
+	// Safe field: DurationMs
+	return x.String()
+}
+
+// Redact method implementation for ReportCancelledResponse
+func (x *ReportCancelledResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Recorded
+	return x.String()
+}
+
 // Redact method implementation for SubmitExecutionRequest
 func (x *SubmitExecutionRequest) Redact() string {
 	if x == nil {
@@ -240,6 +438,8 @@ func (x *SubmitExecutionRequest) Redact() string {
 	x.ErrorOutput = ``
 
 	// Safe field: DurationMs
+
+	// Safe field: ExecutionId
 	return x.String()
 }
 
@@ -254,3 +454,23 @@ func (x *SubmitExecutionResponse) Redact() string {
 	// Safe field: Recorded
 	return x.String()
 }
+
+// Redact method implementation for GetVerificationKeysRequest
+func (x *GetVerificationKeysRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for GetVerificationKeysResponse
+func (x *GetVerificationKeysResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Keys
+
+	// Safe field: SigningEnabled
+	return x.String()
+}
@@ -75,6 +75,43 @@ func (m *ExecutionCommand) validate(all bool) error {
 
 	// no validation rules for TargetVersion
 
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for Env
+
+	if m.Signature != nil {
+
+		if all {
+			switch v := interface{}(m.GetSignature()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionCommandValidationError{
+						field:  "Signature",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionCommandValidationError{
+						field:  "Signature",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSignature()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionCommandValidationError{
+					field:  "Signature",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ExecutionCommandMultiError(errors)
 	}
@@ -153,6 +190,360 @@ var _ interface {
 	ErrorName() string
 } = ExecutionCommandValidationError{}
 
+// Validate checks the field values on SignedCommand with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SignedCommand) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignedCommand with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SignedCommandMultiError, or
+// nil if none found.
+func (m *SignedCommand) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignedCommand) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommand()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SignedCommandValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SignedCommandValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommand()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SignedCommandValidationError{
+				field:  "Command",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClientId
+
+	if all {
+		switch v := interface{}(m.GetIssuedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SignedCommandValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SignedCommandValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssuedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SignedCommandValidationError{
+				field:  "IssuedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SignedCommandValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SignedCommandValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SignedCommandValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SignedCommandMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignedCommandMultiError is an error wrapping multiple validation errors
+// returned by SignedCommand.ValidateAll() if the designated constraints
+// aren't met.
+type SignedCommandMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignedCommandMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignedCommandMultiError) AllErrors() []error { return m }
+
+// SignedCommandValidationError is the validation error returned by
+// SignedCommand.Validate if the designated constraints aren't met.
+type SignedCommandValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignedCommandValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignedCommandValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignedCommandValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignedCommandValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignedCommandValidationError) ErrorName() string { return "SignedCommandValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignedCommandValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignedCommand.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignedCommandValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignedCommandValidationError{}
+
+// Validate checks the field values on SignedScript with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SignedScript) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignedScript with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SignedScriptMultiError, or
+// nil if none found.
+func (m *SignedScript) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignedScript) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScriptId
+
+	// no validation rules for Version
+
+	// no validation rules for ContentHash
+
+	// no validation rules for ClientId
+
+	if all {
+		switch v := interface{}(m.GetIssuedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SignedScriptValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SignedScriptValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssuedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SignedScriptValidationError{
+				field:  "IssuedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SignedScriptValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SignedScriptValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SignedScriptValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SignedScriptMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignedScriptMultiError is an error wrapping multiple validation errors
+// returned by SignedScript.ValidateAll() if the designated constraints aren't met.
+type SignedScriptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignedScriptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignedScriptMultiError) AllErrors() []error { return m }
+
+// SignedScriptValidationError is the validation error returned by
+// SignedScript.Validate if the designated constraints aren't met.
+type SignedScriptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignedScriptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignedScriptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignedScriptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignedScriptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignedScriptValidationError) ErrorName() string { return "SignedScriptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignedScriptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignedScript.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignedScriptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignedScriptValidationError{}
+
 // Validate checks the field values on FetchScriptRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -177,6 +568,8 @@ func (m *FetchScriptRequest) validate(all bool) error {
 
 	// no validation rules for ScriptId
 
+	// no validation rules for ReserveExecution
+
 	if len(errors) > 0 {
 		return FetchScriptRequestMultiError(errors)
 	}
@@ -291,6 +684,41 @@ func (m *FetchScriptResponse) validate(all bool) error {
 
 	// no validation rules for Version
 
+	// no validation rules for ExecutionId
+
+	if m.Signature != nil {
+
+		if all {
+			switch v := interface{}(m.GetSignature()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FetchScriptResponseValidationError{
+						field:  "Signature",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FetchScriptResponseValidationError{
+						field:  "Signature",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSignature()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FetchScriptResponseValidationError{
+					field:  "Signature",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return FetchScriptResponseMultiError(errors)
 	}
@@ -397,6 +825,35 @@ func (m *StreamCommandsRequest) validate(all bool) error {
 
 	// no validation rules for ClientVersion
 
+	if all {
+		switch v := interface{}(m.GetFacts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamCommandsRequestValidationError{
+					field:  "Facts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamCommandsRequestValidationError{
+					field:  "Facts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFacts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamCommandsRequestValidationError{
+				field:  "Facts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return StreamCommandsRequestMultiError(errors)
 	}
@@ -477,50 +934,74 @@ var _ interface {
 	ErrorName() string
 } = StreamCommandsRequestValidationError{}
 
-// Validate checks the field values on AckCommandRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AckCommandRequest) Validate() error {
+// Validate checks the field values on ConnectHello with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConnectHello) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AckCommandRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AckCommandRequestMultiError, or nil if none found.
-func (m *AckCommandRequest) ValidateAll() error {
+// ValidateAll checks the field values on ConnectHello with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConnectHelloMultiError, or
+// nil if none found.
+func (m *ConnectHello) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AckCommandRequest) validate(all bool) error {
+func (m *ConnectHello) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CommandId
+	// no validation rules for ClientId
 
-	// no validation rules for Accepted
+	// no validation rules for ClientVersion
 
-	if m.RejectionReason != nil {
-		// no validation rules for RejectionReason
+	if all {
+		switch v := interface{}(m.GetFacts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectHelloValidationError{
+					field:  "Facts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectHelloValidationError{
+					field:  "Facts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFacts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectHelloValidationError{
+				field:  "Facts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return AckCommandRequestMultiError(errors)
+		return ConnectHelloMultiError(errors)
 	}
 
 	return nil
 }
 
-// AckCommandRequestMultiError is an error wrapping multiple validation errors
-// returned by AckCommandRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AckCommandRequestMultiError []error
+// ConnectHelloMultiError is an error wrapping multiple validation errors
+// returned by ConnectHello.ValidateAll() if the designated constraints aren't met.
+type ConnectHelloMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AckCommandRequestMultiError) Error() string {
+func (m ConnectHelloMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -529,11 +1010,11 @@ func (m AckCommandRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AckCommandRequestMultiError) AllErrors() []error { return m }
+func (m ConnectHelloMultiError) AllErrors() []error { return m }
 
-// AckCommandRequestValidationError is the validation error returned by
-// AckCommandRequest.Validate if the designated constraints aren't met.
-type AckCommandRequestValidationError struct {
+// ConnectHelloValidationError is the validation error returned by
+// ConnectHello.Validate if the designated constraints aren't met.
+type ConnectHelloValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -541,24 +1022,22 @@ type AckCommandRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AckCommandRequestValidationError) Field() string { return e.field }
+func (e ConnectHelloValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AckCommandRequestValidationError) Reason() string { return e.reason }
+func (e ConnectHelloValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AckCommandRequestValidationError) Cause() error { return e.cause }
+func (e ConnectHelloValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AckCommandRequestValidationError) Key() bool { return e.key }
+func (e ConnectHelloValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AckCommandRequestValidationError) ErrorName() string {
-	return "AckCommandRequestValidationError"
-}
+func (e ConnectHelloValidationError) ErrorName() string { return "ConnectHelloValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AckCommandRequestValidationError) Error() string {
+func (e ConnectHelloValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -570,14 +1049,14 @@ func (e AckCommandRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAckCommandRequest.%s: %s%s",
+		"invalid %sConnectHello.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AckCommandRequestValidationError{}
+var _ error = ConnectHelloValidationError{}
 
 var _ interface {
 	Field() string
@@ -585,46 +1064,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AckCommandRequestValidationError{}
+} = ConnectHelloValidationError{}
 
-// Validate checks the field values on AckCommandResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AckCommandResponse) Validate() error {
+// Validate checks the field values on Heartbeat with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Heartbeat) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AckCommandResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AckCommandResponseMultiError, or nil if none found.
-func (m *AckCommandResponse) ValidateAll() error {
+// ValidateAll checks the field values on Heartbeat with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HeartbeatMultiError, or nil
+// if none found.
+func (m *Heartbeat) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AckCommandResponse) validate(all bool) error {
+func (m *Heartbeat) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Acknowledged
+	// no validation rules for Load1
+
+	// no validation rules for Load5
+
+	// no validation rules for Load15
+
+	// no validation rules for UptimeSeconds
 
 	if len(errors) > 0 {
-		return AckCommandResponseMultiError(errors)
+		return HeartbeatMultiError(errors)
 	}
 
 	return nil
 }
 
-// AckCommandResponseMultiError is an error wrapping multiple validation errors
-// returned by AckCommandResponse.ValidateAll() if the designated constraints
-// aren't met.
-type AckCommandResponseMultiError []error
+// HeartbeatMultiError is an error wrapping multiple validation errors returned
+// by Heartbeat.ValidateAll() if the designated constraints aren't met.
+type HeartbeatMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AckCommandResponseMultiError) Error() string {
+func (m HeartbeatMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -633,11 +1117,11 @@ func (m AckCommandResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AckCommandResponseMultiError) AllErrors() []error { return m }
+func (m HeartbeatMultiError) AllErrors() []error { return m }
 
-// AckCommandResponseValidationError is the validation error returned by
-// AckCommandResponse.Validate if the designated constraints aren't met.
-type AckCommandResponseValidationError struct {
+// HeartbeatValidationError is the validation error returned by
+// Heartbeat.Validate if the designated constraints aren't met.
+type HeartbeatValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -645,24 +1129,22 @@ type AckCommandResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AckCommandResponseValidationError) Field() string { return e.field }
+func (e HeartbeatValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AckCommandResponseValidationError) Reason() string { return e.reason }
+func (e HeartbeatValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AckCommandResponseValidationError) Cause() error { return e.cause }
+func (e HeartbeatValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AckCommandResponseValidationError) Key() bool { return e.key }
+func (e HeartbeatValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AckCommandResponseValidationError) ErrorName() string {
-	return "AckCommandResponseValidationError"
-}
+func (e HeartbeatValidationError) ErrorName() string { return "HeartbeatValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AckCommandResponseValidationError) Error() string {
+func (e HeartbeatValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -674,14 +1156,14 @@ func (e AckCommandResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAckCommandResponse.%s: %s%s",
+		"invalid %sHeartbeat.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AckCommandResponseValidationError{}
+var _ error = HeartbeatValidationError{}
 
 var _ interface {
 	Field() string
@@ -689,24 +1171,1077 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AckCommandResponseValidationError{}
+} = HeartbeatValidationError{}
 
-// Validate checks the field values on ReportResultRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReportResultRequest) Validate() error {
+// Validate checks the field values on ConnectRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConnectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReportResultRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// ValidateAll checks the field values on ConnectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConnectRequestMultiError,
+// or nil if none found.
+func (m *ConnectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *ConnectRequest_Hello:
+		if v == nil {
+			err := ConnectRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetHello()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectRequestValidationError{
+						field:  "Hello",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectRequestValidationError{
+						field:  "Hello",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHello()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectRequestValidationError{
+					field:  "Hello",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectRequest_Heartbeat:
+		if v == nil {
+			err := ConnectRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetHeartbeat()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectRequestValidationError{
+						field:  "Heartbeat",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectRequestValidationError{
+						field:  "Heartbeat",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHeartbeat()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectRequestValidationError{
+					field:  "Heartbeat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectRequest_Ack:
+		if v == nil {
+			err := ConnectRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAck()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectRequestValidationError{
+						field:  "Ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectRequestValidationError{
+						field:  "Ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAck()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectRequestValidationError{
+					field:  "Ack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ConnectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectRequestMultiError is an error wrapping multiple validation errors
+// returned by ConnectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectRequestMultiError) AllErrors() []error { return m }
+
+// ConnectRequestValidationError is the validation error returned by
+// ConnectRequest.Validate if the designated constraints aren't met.
+type ConnectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectRequestValidationError) ErrorName() string { return "ConnectRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectRequestValidationError{}
+
+// Validate checks the field values on ConnectAccepted with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConnectAccepted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectAccepted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectAcceptedMultiError, or nil if none found.
+func (m *ConnectAccepted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectAccepted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HeartbeatIntervalSeconds
+
+	if len(errors) > 0 {
+		return ConnectAcceptedMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectAcceptedMultiError is an error wrapping multiple validation errors
+// returned by ConnectAccepted.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectAcceptedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectAcceptedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectAcceptedMultiError) AllErrors() []error { return m }
+
+// ConnectAcceptedValidationError is the validation error returned by
+// ConnectAccepted.Validate if the designated constraints aren't met.
+type ConnectAcceptedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectAcceptedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectAcceptedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectAcceptedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectAcceptedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectAcceptedValidationError) ErrorName() string { return "ConnectAcceptedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectAcceptedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectAccepted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectAcceptedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectAcceptedValidationError{}
+
+// Validate checks the field values on ConnectResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConnectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectResponseMultiError, or nil if none found.
+func (m *ConnectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *ConnectResponse_Accepted:
+		if v == nil {
+			err := ConnectResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAccepted()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectResponseValidationError{
+						field:  "Accepted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectResponseValidationError{
+						field:  "Accepted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccepted()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectResponseValidationError{
+					field:  "Accepted",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectResponse_Command:
+		if v == nil {
+			err := ConnectResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCommand()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectResponseValidationError{
+						field:  "Command",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectResponseValidationError{
+						field:  "Command",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCommand()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectResponseValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ConnectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectResponseMultiError is an error wrapping multiple validation errors
+// returned by ConnectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectResponseMultiError) AllErrors() []error { return m }
+
+// ConnectResponseValidationError is the validation error returned by
+// ConnectResponse.Validate if the designated constraints aren't met.
+type ConnectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectResponseValidationError) ErrorName() string { return "ConnectResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectResponseValidationError{}
+
+// Validate checks the field values on AckCommandRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AckCommandRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AckCommandRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AckCommandRequestMultiError, or nil if none found.
+func (m *AckCommandRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AckCommandRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	// no validation rules for Accepted
+
+	if m.RejectionReason != nil {
+		// no validation rules for RejectionReason
+	}
+
+	if len(errors) > 0 {
+		return AckCommandRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AckCommandRequestMultiError is an error wrapping multiple validation errors
+// returned by AckCommandRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AckCommandRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AckCommandRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AckCommandRequestMultiError) AllErrors() []error { return m }
+
+// AckCommandRequestValidationError is the validation error returned by
+// AckCommandRequest.Validate if the designated constraints aren't met.
+type AckCommandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AckCommandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AckCommandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AckCommandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AckCommandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AckCommandRequestValidationError) ErrorName() string {
+	return "AckCommandRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AckCommandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAckCommandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AckCommandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AckCommandRequestValidationError{}
+
+// Validate checks the field values on AckCommandResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AckCommandResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AckCommandResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AckCommandResponseMultiError, or nil if none found.
+func (m *AckCommandResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AckCommandResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Acknowledged
+
+	if len(errors) > 0 {
+		return AckCommandResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AckCommandResponseMultiError is an error wrapping multiple validation errors
+// returned by AckCommandResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AckCommandResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AckCommandResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AckCommandResponseMultiError) AllErrors() []error { return m }
+
+// AckCommandResponseValidationError is the validation error returned by
+// AckCommandResponse.Validate if the designated constraints aren't met.
+type AckCommandResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AckCommandResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AckCommandResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AckCommandResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AckCommandResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AckCommandResponseValidationError) ErrorName() string {
+	return "AckCommandResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AckCommandResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAckCommandResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AckCommandResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AckCommandResponseValidationError{}
+
+// Validate checks the field values on ReportResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
 // ReportResultRequestMultiError, or nil if none found.
 func (m *ReportResultRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReportResultRequest) validate(all bool) error {
+func (m *ReportResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExecutionId
+
+	// no validation rules for ExitCode
+
+	// no validation rules for Output
+
+	// no validation rules for ErrorOutput
+
+	// no validation rules for DurationMs
+
+	if len(errors) > 0 {
+		return ReportResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportResultRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportResultRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportResultRequestMultiError) AllErrors() []error { return m }
+
+// ReportResultRequestValidationError is the validation error returned by
+// ReportResultRequest.Validate if the designated constraints aren't met.
+type ReportResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportResultRequestValidationError) ErrorName() string {
+	return "ReportResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportResultRequestValidationError{}
+
+// Validate checks the field values on ReportResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportResultResponseMultiError, or nil if none found.
+func (m *ReportResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Recorded
+
+	if len(errors) > 0 {
+		return ReportResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportResultResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportResultResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReportResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportResultResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportResultResponseMultiError) AllErrors() []error { return m }
+
+// ReportResultResponseValidationError is the validation error returned by
+// ReportResultResponse.Validate if the designated constraints aren't met.
+type ReportResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportResultResponseValidationError) ErrorName() string {
+	return "ReportResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportResultResponseValidationError{}
+
+// Validate checks the field values on StreamOutputResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamOutputResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamOutputResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamOutputResponseMultiError, or nil if none found.
+func (m *StreamOutputResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamOutputResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChunksStored
+
+	// no validation rules for LastSeq
+
+	if len(errors) > 0 {
+		return StreamOutputResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamOutputResponseMultiError is an error wrapping multiple validation
+// errors returned by StreamOutputResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StreamOutputResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamOutputResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamOutputResponseMultiError) AllErrors() []error { return m }
+
+// StreamOutputResponseValidationError is the validation error returned by
+// StreamOutputResponse.Validate if the designated constraints aren't met.
+type StreamOutputResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamOutputResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamOutputResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamOutputResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamOutputResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamOutputResponseValidationError) ErrorName() string {
+	return "StreamOutputResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamOutputResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamOutputResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamOutputResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamOutputResponseValidationError{}
+
+// Validate checks the field values on ReportCancelledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportCancelledRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportCancelledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportCancelledRequestMultiError, or nil if none found.
+func (m *ReportCancelledRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportCancelledRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -715,8 +2250,6 @@ func (m *ReportResultRequest) validate(all bool) error {
 
 	// no validation rules for ExecutionId
 
-	// no validation rules for ExitCode
-
 	// no validation rules for Output
 
 	// no validation rules for ErrorOutput
@@ -724,19 +2257,19 @@ func (m *ReportResultRequest) validate(all bool) error {
 	// no validation rules for DurationMs
 
 	if len(errors) > 0 {
-		return ReportResultRequestMultiError(errors)
+		return ReportCancelledRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportResultRequestMultiError is an error wrapping multiple validation
-// errors returned by ReportResultRequest.ValidateAll() if the designated
+// ReportCancelledRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportCancelledRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ReportResultRequestMultiError []error
+type ReportCancelledRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportResultRequestMultiError) Error() string {
+func (m ReportCancelledRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -745,11 +2278,11 @@ func (m ReportResultRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportResultRequestMultiError) AllErrors() []error { return m }
+func (m ReportCancelledRequestMultiError) AllErrors() []error { return m }
 
-// ReportResultRequestValidationError is the validation error returned by
-// ReportResultRequest.Validate if the designated constraints aren't met.
-type ReportResultRequestValidationError struct {
+// ReportCancelledRequestValidationError is the validation error returned by
+// ReportCancelledRequest.Validate if the designated constraints aren't met.
+type ReportCancelledRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -757,24 +2290,24 @@ type ReportResultRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportResultRequestValidationError) Field() string { return e.field }
+func (e ReportCancelledRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportResultRequestValidationError) Reason() string { return e.reason }
+func (e ReportCancelledRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportResultRequestValidationError) Cause() error { return e.cause }
+func (e ReportCancelledRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportResultRequestValidationError) Key() bool { return e.key }
+func (e ReportCancelledRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportResultRequestValidationError) ErrorName() string {
-	return "ReportResultRequestValidationError"
+func (e ReportCancelledRequestValidationError) ErrorName() string {
+	return "ReportCancelledRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReportResultRequestValidationError) Error() string {
+func (e ReportCancelledRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -786,14 +2319,14 @@ func (e ReportResultRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReportResultRequest.%s: %s%s",
+		"invalid %sReportCancelledRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportResultRequestValidationError{}
+var _ error = ReportCancelledRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -801,24 +2334,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportResultRequestValidationError{}
+} = ReportCancelledRequestValidationError{}
 
-// Validate checks the field values on ReportResultResponse with the rules
+// Validate checks the field values on ReportCancelledResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReportResultResponse) Validate() error {
+func (m *ReportCancelledResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReportResultResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReportCancelledResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReportResultResponseMultiError, or nil if none found.
-func (m *ReportResultResponse) ValidateAll() error {
+// ReportCancelledResponseMultiError, or nil if none found.
+func (m *ReportCancelledResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReportResultResponse) validate(all bool) error {
+func (m *ReportCancelledResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -828,19 +2361,19 @@ func (m *ReportResultResponse) validate(all bool) error {
 	// no validation rules for Recorded
 
 	if len(errors) > 0 {
-		return ReportResultResponseMultiError(errors)
+		return ReportCancelledResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportResultResponseMultiError is an error wrapping multiple validation
-// errors returned by ReportResultResponse.ValidateAll() if the designated
+// ReportCancelledResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportCancelledResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ReportResultResponseMultiError []error
+type ReportCancelledResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportResultResponseMultiError) Error() string {
+func (m ReportCancelledResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -849,11 +2382,11 @@ func (m ReportResultResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportResultResponseMultiError) AllErrors() []error { return m }
+func (m ReportCancelledResponseMultiError) AllErrors() []error { return m }
 
-// ReportResultResponseValidationError is the validation error returned by
-// ReportResultResponse.Validate if the designated constraints aren't met.
-type ReportResultResponseValidationError struct {
+// ReportCancelledResponseValidationError is the validation error returned by
+// ReportCancelledResponse.Validate if the designated constraints aren't met.
+type ReportCancelledResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -861,24 +2394,24 @@ type ReportResultResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportResultResponseValidationError) Field() string { return e.field }
+func (e ReportCancelledResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportResultResponseValidationError) Reason() string { return e.reason }
+func (e ReportCancelledResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportResultResponseValidationError) Cause() error { return e.cause }
+func (e ReportCancelledResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportResultResponseValidationError) Key() bool { return e.key }
+func (e ReportCancelledResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportResultResponseValidationError) ErrorName() string {
-	return "ReportResultResponseValidationError"
+func (e ReportCancelledResponseValidationError) ErrorName() string {
+	return "ReportCancelledResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReportResultResponseValidationError) Error() string {
+func (e ReportCancelledResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -890,14 +2423,14 @@ func (e ReportResultResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReportResultResponse.%s: %s%s",
+		"invalid %sReportCancelledResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportResultResponseValidationError{}
+var _ error = ReportCancelledResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -905,7 +2438,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportResultResponseValidationError{}
+} = ReportCancelledResponseValidationError{}
 
 // Validate checks the field values on SubmitExecutionRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -939,6 +2472,10 @@ func (m *SubmitExecutionRequest) validate(all bool) error {
 
 	// no validation rules for DurationMs
 
+	if m.ExecutionId != nil {
+		// no validation rules for ExecutionId
+	}
+
 	if len(errors) > 0 {
 		return SubmitExecutionRequestMultiError(errors)
 	}
@@ -1124,3 +2661,244 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SubmitExecutionResponseValidationError{}
+
+// Validate checks the field values on GetVerificationKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVerificationKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVerificationKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVerificationKeysRequestMultiError, or nil if none found.
+func (m *GetVerificationKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVerificationKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetVerificationKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVerificationKeysRequestMultiError is an error wrapping multiple
+// validation errors returned by GetVerificationKeysRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetVerificationKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVerificationKeysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVerificationKeysRequestMultiError) AllErrors() []error { return m }
+
+// GetVerificationKeysRequestValidationError is the validation error returned
+// by GetVerificationKeysRequest.Validate if the designated constraints aren't met.
+type GetVerificationKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVerificationKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVerificationKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVerificationKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVerificationKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVerificationKeysRequestValidationError) ErrorName() string {
+	return "GetVerificationKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVerificationKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVerificationKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVerificationKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVerificationKeysRequestValidationError{}
+
+// Validate checks the field values on GetVerificationKeysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVerificationKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVerificationKeysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVerificationKeysResponseMultiError, or nil if none found.
+func (m *GetVerificationKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVerificationKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVerificationKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVerificationKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVerificationKeysResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SigningEnabled
+
+	if len(errors) > 0 {
+		return GetVerificationKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVerificationKeysResponseMultiError is an error wrapping multiple
+// validation errors returned by GetVerificationKeysResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetVerificationKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVerificationKeysResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVerificationKeysResponseMultiError) AllErrors() []error { return m }
+
+// GetVerificationKeysResponseValidationError is the validation error returned
+// by GetVerificationKeysResponse.Validate if the designated constraints
+// aren't met.
+type GetVerificationKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVerificationKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVerificationKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVerificationKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVerificationKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVerificationKeysResponseValidationError) ErrorName() string {
+	return "GetVerificationKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVerificationKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVerificationKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVerificationKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVerificationKeysResponseValidationError{}
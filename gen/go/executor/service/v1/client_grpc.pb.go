@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExecutorClientService_FetchScript_FullMethodName     = "/executor.service.v1.ExecutorClientService/FetchScript"
-	ExecutorClientService_StreamCommands_FullMethodName  = "/executor.service.v1.ExecutorClientService/StreamCommands"
-	ExecutorClientService_AckCommand_FullMethodName      = "/executor.service.v1.ExecutorClientService/AckCommand"
-	ExecutorClientService_ReportResult_FullMethodName    = "/executor.service.v1.ExecutorClientService/ReportResult"
-	ExecutorClientService_SubmitExecution_FullMethodName = "/executor.service.v1.ExecutorClientService/SubmitExecution"
+	ExecutorClientService_FetchScript_FullMethodName         = "/executor.service.v1.ExecutorClientService/FetchScript"
+	ExecutorClientService_StreamCommands_FullMethodName      = "/executor.service.v1.ExecutorClientService/StreamCommands"
+	ExecutorClientService_Connect_FullMethodName             = "/executor.service.v1.ExecutorClientService/Connect"
+	ExecutorClientService_AckCommand_FullMethodName          = "/executor.service.v1.ExecutorClientService/AckCommand"
+	ExecutorClientService_StreamOutput_FullMethodName        = "/executor.service.v1.ExecutorClientService/StreamOutput"
+	ExecutorClientService_ReportResult_FullMethodName        = "/executor.service.v1.ExecutorClientService/ReportResult"
+	ExecutorClientService_ReportCancelled_FullMethodName     = "/executor.service.v1.ExecutorClientService/ReportCancelled"
+	ExecutorClientService_SubmitExecution_FullMethodName     = "/executor.service.v1.ExecutorClientService/SubmitExecution"
+	ExecutorClientService_GetVerificationKeys_FullMethodName = "/executor.service.v1.ExecutorClientService/GetVerificationKeys"
 )
 
 // ExecutorClientServiceClient is the client API for ExecutorClientService service.
@@ -35,13 +39,24 @@ type ExecutorClientServiceClient interface {
 	// Fetch a script (validates mTLS CN assignment)
 	FetchScript(ctx context.Context, in *FetchScriptRequest, opts ...grpc.CallOption) (*FetchScriptResponse, error)
 	// Stream execution commands (server-side streaming)
+	// Deprecated: use Connect, which also carries heartbeats and acks.
 	StreamCommands(ctx context.Context, in *StreamCommandsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecutionCommand], error)
+	// Bidirectional client channel: the client sends a hello, then heartbeats
+	// and command acks; the server sends commands. Clients that miss heartbeats
+	// are disconnected.
+	Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConnectRequest, ConnectResponse], error)
 	// Acknowledge a command (accepted or rejected)
 	AckCommand(ctx context.Context, in *AckCommandRequest, opts ...grpc.CallOption) (*AckCommandResponse, error)
+	// Stream stdout/stderr of a running execution as it is produced (client-side streaming)
+	StreamOutput(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[OutputChunk, StreamOutputResponse], error)
 	// Report execution result
 	ReportResult(ctx context.Context, in *ReportResultRequest, opts ...grpc.CallOption) (*ReportResultResponse, error)
+	// Confirm that a cancelled execution was terminated
+	ReportCancelled(ctx context.Context, in *ReportCancelledRequest, opts ...grpc.CallOption) (*ReportCancelledResponse, error)
 	// Submit a complete execution log (client-pull scenario)
 	SubmitExecution(ctx context.Context, in *SubmitExecutionRequest, opts ...grpc.CallOption) (*SubmitExecutionResponse, error)
+	// Get the public keys that commands and scripts are signed with
+	GetVerificationKeys(ctx context.Context, in *GetVerificationKeysRequest, opts ...grpc.CallOption) (*GetVerificationKeysResponse, error)
 }
 
 type executorClientServiceClient struct {
@@ -81,6 +96,19 @@ func (c *executorClientServiceClient) StreamCommands(ctx context.Context, in *St
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ExecutorClientService_StreamCommandsClient = grpc.ServerStreamingClient[ExecutionCommand]
 
+func (c *executorClientServiceClient) Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConnectRequest, ConnectResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExecutorClientService_ServiceDesc.Streams[1], ExecutorClientService_Connect_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConnectRequest, ConnectResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecutorClientService_ConnectClient = grpc.BidiStreamingClient[ConnectRequest, ConnectResponse]
+
 func (c *executorClientServiceClient) AckCommand(ctx context.Context, in *AckCommandRequest, opts ...grpc.CallOption) (*AckCommandResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AckCommandResponse)
@@ -91,6 +119,19 @@ func (c *executorClientServiceClient) AckCommand(ctx context.Context, in *AckCom
 	return out, nil
 }
 
+func (c *executorClientServiceClient) StreamOutput(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[OutputChunk, StreamOutputResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExecutorClientService_ServiceDesc.Streams[2], ExecutorClientService_StreamOutput_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[OutputChunk, StreamOutputResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecutorClientService_StreamOutputClient = grpc.ClientStreamingClient[OutputChunk, StreamOutputResponse]
+
 func (c *executorClientServiceClient) ReportResult(ctx context.Context, in *ReportResultRequest, opts ...grpc.CallOption) (*ReportResultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReportResultResponse)
@@ -101,6 +142,16 @@ func (c *executorClientServiceClient) ReportResult(ctx context.Context, in *Repo
 	return out, nil
 }
 
+func (c *executorClientServiceClient) ReportCancelled(ctx context.Context, in *ReportCancelledRequest, opts ...grpc.CallOption) (*ReportCancelledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportCancelledResponse)
+	err := c.cc.Invoke(ctx, ExecutorClientService_ReportCancelled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorClientServiceClient) SubmitExecution(ctx context.Context, in *SubmitExecutionRequest, opts ...grpc.CallOption) (*SubmitExecutionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubmitExecutionResponse)
@@ -111,6 +162,16 @@ func (c *executorClientServiceClient) SubmitExecution(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *executorClientServiceClient) GetVerificationKeys(ctx context.Context, in *GetVerificationKeysRequest, opts ...grpc.CallOption) (*GetVerificationKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVerificationKeysResponse)
+	err := c.cc.Invoke(ctx, ExecutorClientService_GetVerificationKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecutorClientServiceServer is the server API for ExecutorClientService service.
 // All implementations must embed UnimplementedExecutorClientServiceServer
 // for forward compatibility.
@@ -120,13 +181,24 @@ type ExecutorClientServiceServer interface {
 	// Fetch a script (validates mTLS CN assignment)
 	FetchScript(context.Context, *FetchScriptRequest) (*FetchScriptResponse, error)
 	// Stream execution commands (server-side streaming)
+	// Deprecated: use Connect, which also carries heartbeats and acks.
 	StreamCommands(*StreamCommandsRequest, grpc.ServerStreamingServer[ExecutionCommand]) error
+	// Bidirectional client channel: the client sends a hello, then heartbeats
+	// and command acks; the server sends commands. Clients that miss heartbeats
+	// are disconnected.
+	Connect(grpc.BidiStreamingServer[ConnectRequest, ConnectResponse]) error
 	// Acknowledge a command (accepted or rejected)
 	AckCommand(context.Context, *AckCommandRequest) (*AckCommandResponse, error)
+	// Stream stdout/stderr of a running execution as it is produced (client-side streaming)
+	StreamOutput(grpc.ClientStreamingServer[OutputChunk, StreamOutputResponse]) error
 	// Report execution result
 	ReportResult(context.Context, *ReportResultRequest) (*ReportResultResponse, error)
+	// Confirm that a cancelled execution was terminated
+	ReportCancelled(context.Context, *ReportCancelledRequest) (*ReportCancelledResponse, error)
 	// Submit a complete execution log (client-pull scenario)
 	SubmitExecution(context.Context, *SubmitExecutionRequest) (*SubmitExecutionResponse, error)
+	// Get the public keys that commands and scripts are signed with
+	GetVerificationKeys(context.Context, *GetVerificationKeysRequest) (*GetVerificationKeysResponse, error)
 	mustEmbedUnimplementedExecutorClientServiceServer()
 }
 
@@ -143,15 +215,27 @@ func (UnimplementedExecutorClientServiceServer) FetchScript(context.Context, *Fe
 func (UnimplementedExecutorClientServiceServer) StreamCommands(*StreamCommandsRequest, grpc.ServerStreamingServer[ExecutionCommand]) error {
 	return status.Error(codes.Unimplemented, "method StreamCommands not implemented")
 }
+func (UnimplementedExecutorClientServiceServer) Connect(grpc.BidiStreamingServer[ConnectRequest, ConnectResponse]) error {
+	return status.Error(codes.Unimplemented, "method Connect not implemented")
+}
 func (UnimplementedExecutorClientServiceServer) AckCommand(context.Context, *AckCommandRequest) (*AckCommandResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AckCommand not implemented")
 }
+func (UnimplementedExecutorClientServiceServer) StreamOutput(grpc.ClientStreamingServer[OutputChunk, StreamOutputResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamOutput not implemented")
+}
 func (UnimplementedExecutorClientServiceServer) ReportResult(context.Context, *ReportResultRequest) (*ReportResultResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReportResult not implemented")
 }
+func (UnimplementedExecutorClientServiceServer) ReportCancelled(context.Context, *ReportCancelledRequest) (*ReportCancelledResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportCancelled not implemented")
+}
 func (UnimplementedExecutorClientServiceServer) SubmitExecution(context.Context, *SubmitExecutionRequest) (*SubmitExecutionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitExecution not implemented")
 }
+func (UnimplementedExecutorClientServiceServer) GetVerificationKeys(context.Context, *GetVerificationKeysRequest) (*GetVerificationKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVerificationKeys not implemented")
+}
 func (UnimplementedExecutorClientServiceServer) mustEmbedUnimplementedExecutorClientServiceServer() {}
 func (UnimplementedExecutorClientServiceServer) testEmbeddedByValue()                               {}
 
@@ -202,6 +286,13 @@ func _ExecutorClientService_StreamCommands_Handler(srv interface{}, stream grpc.
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ExecutorClientService_StreamCommandsServer = grpc.ServerStreamingServer[ExecutionCommand]
 
+func _ExecutorClientService_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExecutorClientServiceServer).Connect(&grpc.GenericServerStream[ConnectRequest, ConnectResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecutorClientService_ConnectServer = grpc.BidiStreamingServer[ConnectRequest, ConnectResponse]
+
 func _ExecutorClientService_AckCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AckCommandRequest)
 	if err := dec(in); err != nil {
@@ -220,6 +311,13 @@ func _ExecutorClientService_AckCommand_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorClientService_StreamOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExecutorClientServiceServer).StreamOutput(&grpc.GenericServerStream[OutputChunk, StreamOutputResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecutorClientService_StreamOutputServer = grpc.ClientStreamingServer[OutputChunk, StreamOutputResponse]
+
 func _ExecutorClientService_ReportResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReportResultRequest)
 	if err := dec(in); err != nil {
@@ -238,6 +336,24 @@ func _ExecutorClientService_ReportResult_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorClientService_ReportCancelled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCancelledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorClientServiceServer).ReportCancelled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorClientService_ReportCancelled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorClientServiceServer).ReportCancelled(ctx, req.(*ReportCancelledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutorClientService_SubmitExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitExecutionRequest)
 	if err := dec(in); err != nil {
@@ -256,6 +372,24 @@ func _ExecutorClientService_SubmitExecution_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorClientService_GetVerificationKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerificationKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorClientServiceServer).GetVerificationKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorClientService_GetVerificationKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorClientServiceServer).GetVerificationKeys(ctx, req.(*GetVerificationKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExecutorClientService_ServiceDesc is the grpc.ServiceDesc for ExecutorClientService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -275,10 +409,18 @@ var ExecutorClientService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportResult",
 			Handler:    _ExecutorClientService_ReportResult_Handler,
 		},
+		{
+			MethodName: "ReportCancelled",
+			Handler:    _ExecutorClientService_ReportCancelled_Handler,
+		},
 		{
 			MethodName: "SubmitExecution",
 			Handler:    _ExecutorClientService_SubmitExecution_Handler,
 		},
+		{
+			MethodName: "GetVerificationKeys",
+			Handler:    _ExecutorClientService_GetVerificationKeys_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -286,6 +428,17 @@ var ExecutorClientService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ExecutorClientService_StreamCommands_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Connect",
+			Handler:       _ExecutorClientService_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamOutput",
+			Handler:       _ExecutorClientService_StreamOutput_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "executor/service/v1/client.proto",
 }
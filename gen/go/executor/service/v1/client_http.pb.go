@@ -21,6 +21,8 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationExecutorClientServiceAckCommand = "/executor.service.v1.ExecutorClientService/AckCommand"
 const OperationExecutorClientServiceFetchScript = "/executor.service.v1.ExecutorClientService/FetchScript"
+const OperationExecutorClientServiceGetVerificationKeys = "/executor.service.v1.ExecutorClientService/GetVerificationKeys"
+const OperationExecutorClientServiceReportCancelled = "/executor.service.v1.ExecutorClientService/ReportCancelled"
 const OperationExecutorClientServiceReportResult = "/executor.service.v1.ExecutorClientService/ReportResult"
 const OperationExecutorClientServiceSubmitExecution = "/executor.service.v1.ExecutorClientService/SubmitExecution"
 
@@ -29,6 +31,10 @@ type ExecutorClientServiceHTTPServer interface {
 	AckCommand(context.Context, *AckCommandRequest) (*AckCommandResponse, error)
 	// FetchScript Fetch a script (validates mTLS CN assignment)
 	FetchScript(context.Context, *FetchScriptRequest) (*FetchScriptResponse, error)
+	// GetVerificationKeys Get the public keys that commands and scripts are signed with
+	GetVerificationKeys(context.Context, *GetVerificationKeysRequest) (*GetVerificationKeysResponse, error)
+	// ReportCancelled Confirm that a cancelled execution was terminated
+	ReportCancelled(context.Context, *ReportCancelledRequest) (*ReportCancelledResponse, error)
 	// ReportResult Report execution result
 	ReportResult(context.Context, *ReportResultRequest) (*ReportResultResponse, error)
 	// SubmitExecution Submit a complete execution log (client-pull scenario)
@@ -40,7 +46,9 @@ func RegisterExecutorClientServiceHTTPServer(s *http.Server, srv ExecutorClientS
 	r.GET("/v1/client/scripts/{script_id}", _ExecutorClientService_FetchScript0_HTTP_Handler(srv))
 	r.POST("/v1/client/commands/{command_id}/ack", _ExecutorClientService_AckCommand0_HTTP_Handler(srv))
 	r.POST("/v1/client/executions/{execution_id}/result", _ExecutorClientService_ReportResult0_HTTP_Handler(srv))
+	r.POST("/v1/client/executions/{execution_id}/cancelled", _ExecutorClientService_ReportCancelled0_HTTP_Handler(srv))
 	r.POST("/v1/client/executions", _ExecutorClientService_SubmitExecution0_HTTP_Handler(srv))
+	r.GET("/v1/client/signing-keys", _ExecutorClientService_GetVerificationKeys0_HTTP_Handler(srv))
 }
 
 func _ExecutorClientService_FetchScript0_HTTP_Handler(srv ExecutorClientServiceHTTPServer) func(ctx http.Context) error {
@@ -115,6 +123,31 @@ func _ExecutorClientService_ReportResult0_HTTP_Handler(srv ExecutorClientService
 	}
 }
 
+func _ExecutorClientService_ReportCancelled0_HTTP_Handler(srv ExecutorClientServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportCancelledRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorClientServiceReportCancelled)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportCancelled(ctx, req.(*ReportCancelledRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportCancelledResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ExecutorClientService_SubmitExecution0_HTTP_Handler(srv ExecutorClientServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SubmitExecutionRequest
@@ -137,11 +170,34 @@ func _ExecutorClientService_SubmitExecution0_HTTP_Handler(srv ExecutorClientServ
 	}
 }
 
+func _ExecutorClientService_GetVerificationKeys0_HTTP_Handler(srv ExecutorClientServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetVerificationKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorClientServiceGetVerificationKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetVerificationKeys(ctx, req.(*GetVerificationKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetVerificationKeysResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ExecutorClientServiceHTTPClient interface {
 	// AckCommand Acknowledge a command (accepted or rejected)
 	AckCommand(ctx context.Context, req *AckCommandRequest, opts ...http.CallOption) (rsp *AckCommandResponse, err error)
 	// FetchScript Fetch a script (validates mTLS CN assignment)
 	FetchScript(ctx context.Context, req *FetchScriptRequest, opts ...http.CallOption) (rsp *FetchScriptResponse, err error)
+	// GetVerificationKeys Get the public keys that commands and scripts are signed with
+	GetVerificationKeys(ctx context.Context, req *GetVerificationKeysRequest, opts ...http.CallOption) (rsp *GetVerificationKeysResponse, err error)
+	// ReportCancelled Confirm that a cancelled execution was terminated
+	ReportCancelled(ctx context.Context, req *ReportCancelledRequest, opts ...http.CallOption) (rsp *ReportCancelledResponse, err error)
 	// ReportResult Report execution result
 	ReportResult(ctx context.Context, req *ReportResultRequest, opts ...http.CallOption) (rsp *ReportResultResponse, err error)
 	// SubmitExecution Submit a complete execution log (client-pull scenario)
@@ -184,6 +240,34 @@ func (c *ExecutorClientServiceHTTPClientImpl) FetchScript(ctx context.Context, i
 	return &out, nil
 }
 
+// GetVerificationKeys Get the public keys that commands and scripts are signed with
+func (c *ExecutorClientServiceHTTPClientImpl) GetVerificationKeys(ctx context.Context, in *GetVerificationKeysRequest, opts ...http.CallOption) (*GetVerificationKeysResponse, error) {
+	var out GetVerificationKeysResponse
+	pattern := "/v1/client/signing-keys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorClientServiceGetVerificationKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReportCancelled Confirm that a cancelled execution was terminated
+func (c *ExecutorClientServiceHTTPClientImpl) ReportCancelled(ctx context.Context, in *ReportCancelledRequest, opts ...http.CallOption) (*ReportCancelledResponse, error) {
+	var out ReportCancelledResponse
+	pattern := "/v1/client/executions/{execution_id}/cancelled"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorClientServiceReportCancelled))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ReportResult Report execution result
 func (c *ExecutorClientServiceHTTPClientImpl) ReportResult(ctx context.Context, in *ReportResultRequest, opts ...http.CallOption) (*ReportResultResponse, error) {
 	var out ReportResultResponse
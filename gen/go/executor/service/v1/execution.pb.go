@@ -32,6 +32,9 @@ const (
 	TriggerType_TRIGGER_TYPE_UNSPECIFIED TriggerType = 0
 	TriggerType_TRIGGER_TYPE_CLIENT_PULL TriggerType = 1
 	TriggerType_TRIGGER_TYPE_UI_PUSH     TriggerType = 2
+	TriggerType_TRIGGER_TYPE_SCHEDULED   TriggerType = 3
+	TriggerType_TRIGGER_TYPE_EVENT       TriggerType = 4
+	TriggerType_TRIGGER_TYPE_WORKFLOW    TriggerType = 5
 )
 
 // Enum value maps for TriggerType.
@@ -40,11 +43,17 @@ var (
 		0: "TRIGGER_TYPE_UNSPECIFIED",
 		1: "TRIGGER_TYPE_CLIENT_PULL",
 		2: "TRIGGER_TYPE_UI_PUSH",
+		3: "TRIGGER_TYPE_SCHEDULED",
+		4: "TRIGGER_TYPE_EVENT",
+		5: "TRIGGER_TYPE_WORKFLOW",
 	}
 	TriggerType_value = map[string]int32{
 		"TRIGGER_TYPE_UNSPECIFIED": 0,
 		"TRIGGER_TYPE_CLIENT_PULL": 1,
 		"TRIGGER_TYPE_UI_PUSH":     2,
+		"TRIGGER_TYPE_SCHEDULED":   3,
+		"TRIGGER_TYPE_EVENT":       4,
+		"TRIGGER_TYPE_WORKFLOW":    5,
 	}
 )
 
@@ -75,6 +84,62 @@ func (TriggerType) EnumDescriptor() ([]byte, []int) {
 	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{0}
 }
 
+// Executor events that event rules react to
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED            EventType = 0
+	EventType_EVENT_TYPE_CLIENT_FIRST_CONNECT   EventType = 1 // a client connected for the first time
+	EventType_EVENT_TYPE_CLIENT_VERSION_CHANGED EventType = 2 // a client reconnected with another agent version
+	EventType_EVENT_TYPE_CLIENT_LABELS_CHANGED  EventType = 3 // an operator changed a client's labels
+	EventType_EVENT_TYPE_SCRIPT_FAILED          EventType = 4 // another script failed or timed out on the client
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_CLIENT_FIRST_CONNECT",
+		2: "EVENT_TYPE_CLIENT_VERSION_CHANGED",
+		3: "EVENT_TYPE_CLIENT_LABELS_CHANGED",
+		4: "EVENT_TYPE_SCRIPT_FAILED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":            0,
+		"EVENT_TYPE_CLIENT_FIRST_CONNECT":   1,
+		"EVENT_TYPE_CLIENT_VERSION_CHANGED": 2,
+		"EVENT_TYPE_CLIENT_LABELS_CHANGED":  3,
+		"EVENT_TYPE_SCRIPT_FAILED":          4,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_service_v1_execution_proto_enumTypes[1].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_executor_service_v1_execution_proto_enumTypes[1]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{1}
+}
+
 // Execution status
 type ExecutionStatus int32
 
@@ -87,19 +152,25 @@ const (
 	ExecutionStatus_EXECUTION_STATUS_REJECTED_HASH_MISMATCH ExecutionStatus = 5
 	ExecutionStatus_EXECUTION_STATUS_REJECTED_NOT_APPROVED  ExecutionStatus = 6
 	ExecutionStatus_EXECUTION_STATUS_CLIENT_OFFLINE         ExecutionStatus = 7
+	ExecutionStatus_EXECUTION_STATUS_TIMED_OUT              ExecutionStatus = 8
+	ExecutionStatus_EXECUTION_STATUS_CANCELLED              ExecutionStatus = 9
+	ExecutionStatus_EXECUTION_STATUS_AWAITING_APPROVAL      ExecutionStatus = 10 // on a protected client, held until a second person approves it
 )
 
 // Enum value maps for ExecutionStatus.
 var (
 	ExecutionStatus_name = map[int32]string{
-		0: "EXECUTION_STATUS_UNSPECIFIED",
-		1: "EXECUTION_STATUS_PENDING",
-		2: "EXECUTION_STATUS_RUNNING",
-		3: "EXECUTION_STATUS_COMPLETED",
-		4: "EXECUTION_STATUS_FAILED",
-		5: "EXECUTION_STATUS_REJECTED_HASH_MISMATCH",
-		6: "EXECUTION_STATUS_REJECTED_NOT_APPROVED",
-		7: "EXECUTION_STATUS_CLIENT_OFFLINE",
+		0:  "EXECUTION_STATUS_UNSPECIFIED",
+		1:  "EXECUTION_STATUS_PENDING",
+		2:  "EXECUTION_STATUS_RUNNING",
+		3:  "EXECUTION_STATUS_COMPLETED",
+		4:  "EXECUTION_STATUS_FAILED",
+		5:  "EXECUTION_STATUS_REJECTED_HASH_MISMATCH",
+		6:  "EXECUTION_STATUS_REJECTED_NOT_APPROVED",
+		7:  "EXECUTION_STATUS_CLIENT_OFFLINE",
+		8:  "EXECUTION_STATUS_TIMED_OUT",
+		9:  "EXECUTION_STATUS_CANCELLED",
+		10: "EXECUTION_STATUS_AWAITING_APPROVAL",
 	}
 	ExecutionStatus_value = map[string]int32{
 		"EXECUTION_STATUS_UNSPECIFIED":            0,
@@ -110,6 +181,9 @@ var (
 		"EXECUTION_STATUS_REJECTED_HASH_MISMATCH": 5,
 		"EXECUTION_STATUS_REJECTED_NOT_APPROVED":  6,
 		"EXECUTION_STATUS_CLIENT_OFFLINE":         7,
+		"EXECUTION_STATUS_TIMED_OUT":              8,
+		"EXECUTION_STATUS_CANCELLED":              9,
+		"EXECUTION_STATUS_AWAITING_APPROVAL":      10,
 	}
 )
 
@@ -124,11 +198,11 @@ func (x ExecutionStatus) String() string {
 }
 
 func (ExecutionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_executor_service_v1_execution_proto_enumTypes[1].Descriptor()
+	return file_executor_service_v1_execution_proto_enumTypes[2].Descriptor()
 }
 
 func (ExecutionStatus) Type() protoreflect.EnumType {
-	return &file_executor_service_v1_execution_proto_enumTypes[1]
+	return &file_executor_service_v1_execution_proto_enumTypes[2]
 }
 
 func (x ExecutionStatus) Number() protoreflect.EnumNumber {
@@ -137,36 +211,235 @@ func (x ExecutionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExecutionStatus.Descriptor instead.
 func (ExecutionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{1}
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{2}
+}
+
+// Fan-out run status
+type RunStatus int32
+
+const (
+	RunStatus_RUN_STATUS_UNSPECIFIED RunStatus = 0
+	RunStatus_RUN_STATUS_RUNNING     RunStatus = 1
+	RunStatus_RUN_STATUS_COMPLETED   RunStatus = 2 // every execution of the run reached a final status
+	RunStatus_RUN_STATUS_PAUSED      RunStatus = 3 // no further batches until resumed
+	RunStatus_RUN_STATUS_HALTED      RunStatus = 4 // stopped by the failure threshold or a failed canary
+	RunStatus_RUN_STATUS_ABORTED     RunStatus = 5 // stopped by an operator
+)
+
+// Enum value maps for RunStatus.
+var (
+	RunStatus_name = map[int32]string{
+		0: "RUN_STATUS_UNSPECIFIED",
+		1: "RUN_STATUS_RUNNING",
+		2: "RUN_STATUS_COMPLETED",
+		3: "RUN_STATUS_PAUSED",
+		4: "RUN_STATUS_HALTED",
+		5: "RUN_STATUS_ABORTED",
+	}
+	RunStatus_value = map[string]int32{
+		"RUN_STATUS_UNSPECIFIED": 0,
+		"RUN_STATUS_RUNNING":     1,
+		"RUN_STATUS_COMPLETED":   2,
+		"RUN_STATUS_PAUSED":      3,
+		"RUN_STATUS_HALTED":      4,
+		"RUN_STATUS_ABORTED":     5,
+	}
+)
+
+func (x RunStatus) Enum() *RunStatus {
+	p := new(RunStatus)
+	*p = x
+	return p
+}
+
+func (x RunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_service_v1_execution_proto_enumTypes[3].Descriptor()
+}
+
+func (RunStatus) Type() protoreflect.EnumType {
+	return &file_executor_service_v1_execution_proto_enumTypes[3]
+}
+
+func (x RunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunStatus.Descriptor instead.
+func (RunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{3}
+}
+
+// Output stream an output chunk belongs to
+type OutputStream int32
+
+const (
+	OutputStream_OUTPUT_STREAM_UNSPECIFIED OutputStream = 0
+	OutputStream_OUTPUT_STREAM_STDOUT      OutputStream = 1
+	OutputStream_OUTPUT_STREAM_STDERR      OutputStream = 2
+)
+
+// Enum value maps for OutputStream.
+var (
+	OutputStream_name = map[int32]string{
+		0: "OUTPUT_STREAM_UNSPECIFIED",
+		1: "OUTPUT_STREAM_STDOUT",
+		2: "OUTPUT_STREAM_STDERR",
+	}
+	OutputStream_value = map[string]int32{
+		"OUTPUT_STREAM_UNSPECIFIED": 0,
+		"OUTPUT_STREAM_STDOUT":      1,
+		"OUTPUT_STREAM_STDERR":      2,
+	}
+)
+
+func (x OutputStream) Enum() *OutputStream {
+	p := new(OutputStream)
+	*p = x
+	return p
+}
+
+func (x OutputStream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutputStream) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_service_v1_execution_proto_enumTypes[4].Descriptor()
+}
+
+func (OutputStream) Type() protoreflect.EnumType {
+	return &file_executor_service_v1_execution_proto_enumTypes[4]
+}
+
+func (x OutputStream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutputStream.Descriptor instead.
+func (OutputStream) EnumDescriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{4}
+}
+
+// The event that made an event rule start an execution
+type ExecutionEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              EventType              `protobuf:"varint,1,opt,name=type,proto3,enum=executor.service.v1.EventType" json:"type,omitempty"`
+	RuleId            string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Detail            *string                `protobuf:"bytes,3,opt,name=detail,proto3,oneof" json:"detail,omitempty"`                                                  // e.g. the old and new agent version
+	SourceExecutionId *string                `protobuf:"bytes,4,opt,name=source_execution_id,json=sourceExecutionId,proto3,oneof" json:"source_execution_id,omitempty"` // the failed execution, for SCRIPT_FAILED
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExecutionEvent) Reset() {
+	*x = ExecutionEvent{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionEvent) ProtoMessage() {}
+
+func (x *ExecutionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionEvent.ProtoReflect.Descriptor instead.
+func (*ExecutionEvent) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExecutionEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ExecutionEvent) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ExecutionEvent) GetDetail() string {
+	if x != nil && x.Detail != nil {
+		return *x.Detail
+	}
+	return ""
+}
+
+func (x *ExecutionEvent) GetSourceExecutionId() string {
+	if x != nil && x.SourceExecutionId != nil {
+		return *x.SourceExecutionId
+	}
+	return ""
 }
 
 // Execution log entity
 type ExecutionLog struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId        uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	ScriptId        string                 `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
-	ScriptName      string                 `protobuf:"bytes,4,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ClientId        string                 `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ScriptHash      string                 `protobuf:"bytes,6,opt,name=script_hash,json=scriptHash,proto3" json:"script_hash,omitempty"`
-	TriggerType     TriggerType            `protobuf:"varint,7,opt,name=trigger_type,json=triggerType,proto3,enum=executor.service.v1.TriggerType" json:"trigger_type,omitempty"`
-	Status          ExecutionStatus        `protobuf:"varint,8,opt,name=status,proto3,enum=executor.service.v1.ExecutionStatus" json:"status,omitempty"`
-	ExitCode        *int32                 `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
-	Output          *string                `protobuf:"bytes,10,opt,name=output,proto3,oneof" json:"output,omitempty"`
-	ErrorOutput     *string                `protobuf:"bytes,11,opt,name=error_output,json=errorOutput,proto3,oneof" json:"error_output,omitempty"`
-	RejectionReason *string                `protobuf:"bytes,12,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
-	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
-	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
-	DurationMs      *int64                 `protobuf:"varint,15,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
-	CreatedBy       *uint32                `protobuf:"varint,16,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	CreateTime      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId             uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ScriptId             string                 `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	ScriptName           string                 `protobuf:"bytes,4,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ClientId             string                 `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ScriptHash           string                 `protobuf:"bytes,6,opt,name=script_hash,json=scriptHash,proto3" json:"script_hash,omitempty"`
+	TriggerType          TriggerType            `protobuf:"varint,7,opt,name=trigger_type,json=triggerType,proto3,enum=executor.service.v1.TriggerType" json:"trigger_type,omitempty"`
+	Status               ExecutionStatus        `protobuf:"varint,8,opt,name=status,proto3,enum=executor.service.v1.ExecutionStatus" json:"status,omitempty"`
+	ExitCode             *int32                 `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	Output               *string                `protobuf:"bytes,10,opt,name=output,proto3,oneof" json:"output,omitempty"`
+	ErrorOutput          *string                `protobuf:"bytes,11,opt,name=error_output,json=errorOutput,proto3,oneof" json:"error_output,omitempty"`
+	RejectionReason      *string                `protobuf:"bytes,12,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
+	StartedAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	CompletedAt          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	DurationMs           *int64                 `protobuf:"varint,15,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
+	CreatedBy            *uint32                `protobuf:"varint,16,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreateTime           *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	CancelRequestedAt    *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=cancel_requested_at,json=cancelRequestedAt,proto3,oneof" json:"cancel_requested_at,omitempty"`
+	CancelledBy          *uint32                `protobuf:"varint,19,opt,name=cancelled_by,json=cancelledBy,proto3,oneof" json:"cancelled_by,omitempty"`
+	CancelReason         *string                `protobuf:"bytes,20,opt,name=cancel_reason,json=cancelReason,proto3,oneof" json:"cancel_reason,omitempty"`
+	RunId                *string                `protobuf:"bytes,21,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"`                           // set when part of a fan-out run
+	Event                *ExecutionEvent        `protobuf:"bytes,22,opt,name=event,proto3,oneof" json:"event,omitempty"`                                        // set when started by an event rule
+	WorkflowRunId        *string                `protobuf:"bytes,23,opt,name=workflow_run_id,json=workflowRunId,proto3,oneof" json:"workflow_run_id,omitempty"` // set when a step of a workflow run
+	WorkflowStepId       *string                `protobuf:"bytes,24,opt,name=workflow_step_id,json=workflowStepId,proto3,oneof" json:"workflow_step_id,omitempty"`
+	Attempt              uint32                 `protobuf:"varint,25,opt,name=attempt,proto3" json:"attempt,omitempty"`                                                                                // 1 for the first attempt
+	OriginalExecutionId  *string                `protobuf:"bytes,26,opt,name=original_execution_id,json=originalExecutionId,proto3,oneof" json:"original_execution_id,omitempty"`                      // first attempt, set on retries
+	NextRetryAt          *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=next_retry_at,json=nextRetryAt,proto3,oneof" json:"next_retry_at,omitempty"`                                              // set while a retry is pending
+	RetriedByExecutionId *string                `protobuf:"bytes,28,opt,name=retried_by_execution_id,json=retriedByExecutionId,proto3,oneof" json:"retried_by_execution_id,omitempty"`                 // the attempt that retried this one
+	WaitingForSlot       bool                   `protobuf:"varint,29,opt,name=waiting_for_slot,json=waitingForSlot,proto3" json:"waiting_for_slot,omitempty"`                                          // PENDING until a concurrency slot frees up
+	HeldUntil            *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=held_until,json=heldUntil,proto3,oneof" json:"held_until,omitempty"`                                                      // queued until a maintenance window opens
+	MaintenanceOverride  *string                `protobuf:"bytes,31,opt,name=maintenance_override,json=maintenanceOverride,proto3,oneof" json:"maintenance_override,omitempty"`                        // justification for running outside maintenance windows
+	Parameters           map[string]string      `protobuf:"bytes,32,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // parameter values the execution ran with, secrets masked
+	SecretNames          []string               `protobuf:"bytes,33,rep,name=secret_names,json=secretNames,proto3" json:"secret_names,omitempty"`                                                      // secrets the script referenced, masked in the output
+	ApprovalExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,34,opt,name=approval_expires_at,json=approvalExpiresAt,proto3,oneof" json:"approval_expires_at,omitempty"`                            // set when the client is protected
+	ApprovedBy           *uint32                `protobuf:"varint,35,opt,name=approved_by,json=approvedBy,proto3,oneof" json:"approved_by,omitempty"`
+	ApproveTime          *timestamppb.Timestamp `protobuf:"bytes,36,opt,name=approve_time,json=approveTime,proto3,oneof" json:"approve_time,omitempty"`
+	ApprovalComment      *string                `protobuf:"bytes,37,opt,name=approval_comment,json=approvalComment,proto3,oneof" json:"approval_comment,omitempty"`
+	TimeoutReason        *string                `protobuf:"bytes,38,opt,name=timeout_reason,json=timeoutReason,proto3,oneof" json:"timeout_reason,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ExecutionLog) Reset() {
 	*x = ExecutionLog{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[0]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -178,7 +451,7 @@ func (x *ExecutionLog) String() string {
 func (*ExecutionLog) ProtoMessage() {}
 
 func (x *ExecutionLog) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[0]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +464,7 @@ func (x *ExecutionLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionLog.ProtoReflect.Descriptor instead.
 func (*ExecutionLog) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{0}
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *ExecutionLog) GetId() string {
@@ -289,54 +562,1621 @@ func (x *ExecutionLog) GetCompletedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CompletedAt
 	}
-	return nil
+	return nil
+}
+
+func (x *ExecutionLog) GetDurationMs() int64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *ExecutionLog) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ExecutionLog) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetCancelRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelRequestedAt
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetCancelledBy() uint32 {
+	if x != nil && x.CancelledBy != nil {
+		return *x.CancelledBy
+	}
+	return 0
+}
+
+func (x *ExecutionLog) GetCancelReason() string {
+	if x != nil && x.CancelReason != nil {
+		return *x.CancelReason
+	}
+	return ""
+}
+
+func (x *ExecutionLog) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
+}
+
+func (x *ExecutionLog) GetEvent() *ExecutionEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetWorkflowRunId() string {
+	if x != nil && x.WorkflowRunId != nil {
+		return *x.WorkflowRunId
+	}
+	return ""
+}
+
+func (x *ExecutionLog) GetWorkflowStepId() string {
+	if x != nil && x.WorkflowStepId != nil {
+		return *x.WorkflowStepId
+	}
+	return ""
+}
+
+func (x *ExecutionLog) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ExecutionLog) GetOriginalExecutionId() string {
+	if x != nil && x.OriginalExecutionId != nil {
+		return *x.OriginalExecutionId
+	}
+	return ""
+}
+
+func (x *ExecutionLog) GetNextRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetRetriedByExecutionId() string {
+	if x != nil && x.RetriedByExecutionId != nil {
+		return *x.RetriedByExecutionId
+	}
+	return ""
+}
+
+func (x *ExecutionLog) GetWaitingForSlot() bool {
+	if x != nil {
+		return x.WaitingForSlot
+	}
+	return false
+}
+
+func (x *ExecutionLog) GetHeldUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HeldUntil
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetMaintenanceOverride() string {
+	if x != nil && x.MaintenanceOverride != nil {
+		return *x.MaintenanceOverride
+	}
+	return ""
+}
+
+func (x *ExecutionLog) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetSecretNames() []string {
+	if x != nil {
+		return x.SecretNames
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetApprovalExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovalExpiresAt
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetApprovedBy() uint32 {
+	if x != nil && x.ApprovedBy != nil {
+		return *x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *ExecutionLog) GetApproveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApproveTime
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetApprovalComment() string {
+	if x != nil && x.ApprovalComment != nil {
+		return *x.ApprovalComment
+	}
+	return ""
+}
+
+func (x *ExecutionLog) GetTimeoutReason() string {
+	if x != nil && x.TimeoutReason != nil {
+		return *x.TimeoutReason
+	}
+	return ""
+}
+
+// Aggregated status counts of the executions in a run
+type RunProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Pending       uint32                 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Running       uint32                 `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	Completed     uint32                 `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed        uint32                 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"` // failed, timed out, rejected or client offline
+	Cancelled     uint32                 `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunProgress) Reset() {
+	*x = RunProgress{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunProgress) ProtoMessage() {}
+
+func (x *RunProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunProgress.ProtoReflect.Descriptor instead.
+func (*RunProgress) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RunProgress) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RunProgress) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *RunProgress) GetRunning() uint32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *RunProgress) GetCompleted() uint32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *RunProgress) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *RunProgress) GetCancelled() uint32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+// How a run rolls out over its targets. Without a batch size and canary
+// every target is dispatched at once.
+type RunStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Targets per batch; 0 dispatches everything after the canary in one batch
+	BatchSize uint32 `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Size of a first batch that must succeed completely before the rollout continues
+	CanarySize uint32 `protobuf:"varint,2,opt,name=canary_size,json=canarySize,proto3" json:"canary_size,omitempty"`
+	// Wait between the end of one batch and the start of the next
+	BatchPauseSeconds uint32 `protobuf:"varint,3,opt,name=batch_pause_seconds,json=batchPauseSeconds,proto3" json:"batch_pause_seconds,omitempty"`
+	// Halt the run once more executions than this have failed; unset means no threshold
+	MaxFailures   *uint32 `protobuf:"varint,4,opt,name=max_failures,json=maxFailures,proto3,oneof" json:"max_failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunStrategy) Reset() {
+	*x = RunStrategy{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunStrategy) ProtoMessage() {}
+
+func (x *RunStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunStrategy.ProtoReflect.Descriptor instead.
+func (*RunStrategy) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RunStrategy) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *RunStrategy) GetCanarySize() uint32 {
+	if x != nil {
+		return x.CanarySize
+	}
+	return 0
+}
+
+func (x *RunStrategy) GetBatchPauseSeconds() uint32 {
+	if x != nil {
+		return x.BatchPauseSeconds
+	}
+	return 0
+}
+
+func (x *RunStrategy) GetMaxFailures() uint32 {
+	if x != nil && x.MaxFailures != nil {
+		return *x.MaxFailures
+	}
+	return 0
+}
+
+// A script fanned out to many clients
+type ExecutionRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ScriptId      string                 `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	ScriptName    string                 `protobuf:"bytes,4,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	Selector      *string                `protobuf:"bytes,5,opt,name=selector,proto3,oneof" json:"selector,omitempty"`
+	ClientIds     []string               `protobuf:"bytes,6,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	Status        RunStatus              `protobuf:"varint,7,opt,name=status,proto3,enum=executor.service.v1.RunStatus" json:"status,omitempty"`
+	Progress      *RunProgress           `protobuf:"bytes,8,opt,name=progress,proto3" json:"progress,omitempty"`
+	CreatedBy     *uint32                `protobuf:"varint,9,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	Strategy      *RunStrategy           `protobuf:"bytes,12,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	TargetCount   uint32                 `protobuf:"varint,13,opt,name=target_count,json=targetCount,proto3" json:"target_count,omitempty"`    // assigned targets to roll out to
+	Dispatched    uint32                 `protobuf:"varint,14,opt,name=dispatched,proto3" json:"dispatched,omitempty"`                         // targets handed out so far
+	CurrentBatch  uint32                 `protobuf:"varint,15,opt,name=current_batch,json=currentBatch,proto3" json:"current_batch,omitempty"` // 1-based; the canary is batch 1
+	NextBatchAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=next_batch_at,json=nextBatchAt,proto3,oneof" json:"next_batch_at,omitempty"`
+	HaltReason    *string                `protobuf:"bytes,17,opt,name=halt_reason,json=haltReason,proto3,oneof" json:"halt_reason,omitempty"`
+	ScheduleId    *string                `protobuf:"bytes,18,opt,name=schedule_id,json=scheduleId,proto3,oneof" json:"schedule_id,omitempty"` // set when started by a schedule
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionRun) Reset() {
+	*x = ExecutionRun{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionRun) ProtoMessage() {}
+
+func (x *ExecutionRun) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionRun.ProtoReflect.Descriptor instead.
+func (*ExecutionRun) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExecutionRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExecutionRun) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ExecutionRun) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *ExecutionRun) GetScriptName() string {
+	if x != nil {
+		return x.ScriptName
+	}
+	return ""
+}
+
+func (x *ExecutionRun) GetSelector() string {
+	if x != nil && x.Selector != nil {
+		return *x.Selector
+	}
+	return ""
+}
+
+func (x *ExecutionRun) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+func (x *ExecutionRun) GetStatus() RunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RunStatus_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ExecutionRun) GetProgress() *RunProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *ExecutionRun) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ExecutionRun) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ExecutionRun) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *ExecutionRun) GetStrategy() *RunStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+func (x *ExecutionRun) GetTargetCount() uint32 {
+	if x != nil {
+		return x.TargetCount
+	}
+	return 0
+}
+
+func (x *ExecutionRun) GetDispatched() uint32 {
+	if x != nil {
+		return x.Dispatched
+	}
+	return 0
+}
+
+func (x *ExecutionRun) GetCurrentBatch() uint32 {
+	if x != nil {
+		return x.CurrentBatch
+	}
+	return 0
+}
+
+func (x *ExecutionRun) GetNextBatchAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextBatchAt
+	}
+	return nil
+}
+
+func (x *ExecutionRun) GetHaltReason() string {
+	if x != nil && x.HaltReason != nil {
+		return *x.HaltReason
+	}
+	return ""
+}
+
+func (x *ExecutionRun) GetScheduleId() string {
+	if x != nil && x.ScheduleId != nil {
+		return *x.ScheduleId
+	}
+	return ""
+}
+
+// A requested client that did not get an execution
+type SkippedTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedTarget) Reset() {
+	*x = SkippedTarget{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedTarget) ProtoMessage() {}
+
+func (x *SkippedTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedTarget.ProtoReflect.Descriptor instead.
+func (*SkippedTarget) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SkippedTarget) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SkippedTarget) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// A piece of live execution output. Sequence numbers start at 1 and are
+// shared by stdout and stderr, so chunks can be replayed in order.
+type OutputChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionId   string                 `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Stream        OutputStream           `protobuf:"varint,3,opt,name=stream,proto3,enum=executor.service.v1.OutputStream" json:"stream,omitempty"`
+	Data          string                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputChunk) Reset() {
+	*x = OutputChunk{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputChunk) ProtoMessage() {}
+
+func (x *OutputChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputChunk.ProtoReflect.Descriptor instead.
+func (*OutputChunk) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OutputChunk) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *OutputChunk) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *OutputChunk) GetStream() OutputStream {
+	if x != nil {
+		return x.Stream
+	}
+	return OutputStream_OUTPUT_STREAM_UNSPECIFIED
+}
+
+func (x *OutputChunk) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *OutputChunk) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// Trigger execution request
+type TriggerExecutionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	ClientId string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Run outside the client's maintenance windows when they require an override
+	OverrideMaintenanceWindow *bool `protobuf:"varint,3,opt,name=override_maintenance_window,json=overrideMaintenanceWindow,proto3,oneof" json:"override_maintenance_window,omitempty"`
+	// Why the override is needed; required with override_maintenance_window
+	OverrideJustification *string `protobuf:"bytes,4,opt,name=override_justification,json=overrideJustification,proto3,oneof" json:"override_justification,omitempty"`
+	// Values of the script's parameters by name; they override the values of
+	// the assignments and the defaults
+	Parameters    map[string]string `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerExecutionRequest) Reset() {
+	*x = TriggerExecutionRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerExecutionRequest) ProtoMessage() {}
+
+func (x *TriggerExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerExecutionRequest.ProtoReflect.Descriptor instead.
+func (*TriggerExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TriggerExecutionRequest) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *TriggerExecutionRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TriggerExecutionRequest) GetOverrideMaintenanceWindow() bool {
+	if x != nil && x.OverrideMaintenanceWindow != nil {
+		return *x.OverrideMaintenanceWindow
+	}
+	return false
+}
+
+func (x *TriggerExecutionRequest) GetOverrideJustification() string {
+	if x != nil && x.OverrideJustification != nil {
+		return *x.OverrideJustification
+	}
+	return ""
+}
+
+func (x *TriggerExecutionRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type TriggerExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *ExecutionLog          `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Queued        bool                   `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"` // client offline, command waits for reconnect
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerExecutionResponse) Reset() {
+	*x = TriggerExecutionResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerExecutionResponse) ProtoMessage() {}
+
+func (x *TriggerExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerExecutionResponse.ProtoReflect.Descriptor instead.
+func (*TriggerExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TriggerExecutionResponse) GetExecution() *ExecutionLog {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *TriggerExecutionResponse) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+// Get execution request
+type GetExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionRequest) Reset() {
+	*x = GetExecutionRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionRequest) ProtoMessage() {}
+
+func (x *GetExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetExecutionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetExecutionResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Execution *ExecutionLog          `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	// The script content the execution ran, resolved by its hash; unset if
+	// that content is no longer known
+	ScriptVersion *ScriptVersion `protobuf:"bytes,2,opt,name=script_version,json=scriptVersion,proto3,oneof" json:"script_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionResponse) Reset() {
+	*x = GetExecutionResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionResponse) ProtoMessage() {}
+
+func (x *GetExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetExecutionResponse) GetExecution() *ExecutionLog {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *GetExecutionResponse) GetScriptVersion() *ScriptVersion {
+	if x != nil {
+		return x.ScriptVersion
+	}
+	return nil
+}
+
+// List executions request
+type ListExecutionsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	ScriptId *string                `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3,oneof" json:"script_id,omitempty"`
+	ClientId *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	Status   *ExecutionStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=executor.service.v1.ExecutionStatus,oneof" json:"status,omitempty"`
+	RunId    *string                `protobuf:"bytes,6,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"`
+	// All attempts of an execution: the first one and its retries
+	OriginalExecutionId *string `protobuf:"bytes,7,opt,name=original_execution_id,json=originalExecutionId,proto3,oneof" json:"original_execution_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListExecutionsRequest) Reset() {
+	*x = ListExecutionsRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionsRequest) ProtoMessage() {}
+
+func (x *ListExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListExecutionsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListExecutionsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExecutionsRequest) GetScriptId() string {
+	if x != nil && x.ScriptId != nil {
+		return *x.ScriptId
+	}
+	return ""
+}
+
+func (x *ListExecutionsRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ListExecutionsRequest) GetStatus() ExecutionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (x *ListExecutionsRequest) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
+}
+
+func (x *ListExecutionsRequest) GetOriginalExecutionId() string {
+	if x != nil && x.OriginalExecutionId != nil {
+		return *x.OriginalExecutionId
+	}
+	return ""
+}
+
+type ListExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*ExecutionLog        `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExecutionsResponse) Reset() {
+	*x = ListExecutionsResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionsResponse) ProtoMessage() {}
+
+func (x *ListExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListExecutionsResponse) GetExecutions() []*ExecutionLog {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *ListExecutionsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Get execution output request
+type GetExecutionOutputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionOutputRequest) Reset() {
+	*x = GetExecutionOutputRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionOutputRequest) ProtoMessage() {}
+
+func (x *GetExecutionOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionOutputRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionOutputRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetExecutionOutputRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetExecutionOutputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	ErrorOutput   string                 `protobuf:"bytes,2,opt,name=error_output,json=errorOutput,proto3" json:"error_output,omitempty"`
+	ExitCode      *int32                 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionOutputResponse) Reset() {
+	*x = GetExecutionOutputResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionOutputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionOutputResponse) ProtoMessage() {}
+
+func (x *GetExecutionOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionOutputResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionOutputResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetExecutionOutputResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *GetExecutionOutputResponse) GetErrorOutput() string {
+	if x != nil {
+		return x.ErrorOutput
+	}
+	return ""
+}
+
+func (x *GetExecutionOutputResponse) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+// Tail execution request
+type TailExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AfterSeq      *int64                 `protobuf:"varint,2,opt,name=after_seq,json=afterSeq,proto3,oneof" json:"after_seq,omitempty"` // resume after this sequence number, unset = from the start
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailExecutionRequest) Reset() {
+	*x = TailExecutionRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailExecutionRequest) ProtoMessage() {}
+
+func (x *TailExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailExecutionRequest.ProtoReflect.Descriptor instead.
+func (*TailExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TailExecutionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TailExecutionRequest) GetAfterSeq() int64 {
+	if x != nil && x.AfterSeq != nil {
+		return *x.AfterSeq
+	}
+	return 0
+}
+
+type TailExecutionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*TailExecutionResponse_Chunk
+	//	*TailExecutionResponse_Finished
+	Event         isTailExecutionResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailExecutionResponse) Reset() {
+	*x = TailExecutionResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailExecutionResponse) ProtoMessage() {}
+
+func (x *TailExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailExecutionResponse.ProtoReflect.Descriptor instead.
+func (*TailExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TailExecutionResponse) GetEvent() isTailExecutionResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TailExecutionResponse) GetChunk() *OutputChunk {
+	if x != nil {
+		if x, ok := x.Event.(*TailExecutionResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+func (x *TailExecutionResponse) GetFinished() *ExecutionLog {
+	if x != nil {
+		if x, ok := x.Event.(*TailExecutionResponse_Finished); ok {
+			return x.Finished
+		}
+	}
+	return nil
+}
+
+type isTailExecutionResponse_Event interface {
+	isTailExecutionResponse_Event()
+}
+
+type TailExecutionResponse_Chunk struct {
+	Chunk *OutputChunk `protobuf:"bytes,1,opt,name=chunk,proto3,oneof"`
+}
+
+type TailExecutionResponse_Finished struct {
+	Finished *ExecutionLog `protobuf:"bytes,2,opt,name=finished,proto3,oneof"` // sent once the execution reached a final status
+}
+
+func (*TailExecutionResponse_Chunk) isTailExecutionResponse_Event() {}
+
+func (*TailExecutionResponse_Finished) isTailExecutionResponse_Event() {}
+
+// Trigger run request. Targets are the listed clients plus every inventory
+// client matching the selector; at least one of them must be given.
+type TriggerRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId      string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	ClientIds     []string               `protobuf:"bytes,2,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	Selector      *string                `protobuf:"bytes,3,opt,name=selector,proto3,oneof" json:"selector,omitempty"`
+	Strategy      *RunStrategy           `protobuf:"bytes,4,opt,name=strategy,proto3,oneof" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerRunRequest) Reset() {
+	*x = TriggerRunRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRunRequest) ProtoMessage() {}
+
+func (x *TriggerRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRunRequest.ProtoReflect.Descriptor instead.
+func (*TriggerRunRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TriggerRunRequest) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *TriggerRunRequest) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+func (x *TriggerRunRequest) GetSelector() string {
+	if x != nil && x.Selector != nil {
+		return *x.Selector
+	}
+	return ""
+}
+
+func (x *TriggerRunRequest) GetStrategy() *RunStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+type TriggerRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *ExecutionRun          `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Skipped       []*SkippedTarget       `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerRunResponse) Reset() {
+	*x = TriggerRunResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRunResponse) ProtoMessage() {}
+
+func (x *TriggerRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRunResponse.ProtoReflect.Descriptor instead.
+func (*TriggerRunResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TriggerRunResponse) GetRun() *ExecutionRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *TriggerRunResponse) GetSkipped() []*SkippedTarget {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+// Get run request
+type GetRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRunRequest) Reset() {
+	*x = GetRunRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRunRequest) ProtoMessage() {}
+
+func (x *GetRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRunRequest.ProtoReflect.Descriptor instead.
+func (*GetRunRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRunRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *ExecutionRun          `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRunResponse) Reset() {
+	*x = GetRunResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRunResponse) ProtoMessage() {}
+
+func (x *GetRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRunResponse.ProtoReflect.Descriptor instead.
+func (*GetRunResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRunResponse) GetRun() *ExecutionRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+// List runs request
+type ListRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	ScriptId      *string                `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3,oneof" json:"script_id,omitempty"`
+	Status        *RunStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=executor.service.v1.RunStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunsRequest) Reset() {
+	*x = ListRunsRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsRequest) ProtoMessage() {}
+
+func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListRunsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRunsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListRunsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRunsRequest) GetScriptId() string {
+	if x != nil && x.ScriptId != nil {
+		return *x.ScriptId
+	}
+	return ""
+}
+
+func (x *ListRunsRequest) GetStatus() RunStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return RunStatus_RUN_STATUS_UNSPECIFIED
+}
+
+type ListRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*ExecutionRun        `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunsResponse) Reset() {
+	*x = ListRunsResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsResponse) ProtoMessage() {}
+
+func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ExecutionLog) GetDurationMs() int64 {
-	if x != nil && x.DurationMs != nil {
-		return *x.DurationMs
-	}
-	return 0
+// Deprecated: Use ListRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListRunsResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ExecutionLog) GetCreatedBy() uint32 {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+func (x *ListRunsResponse) GetRuns() []*ExecutionRun {
+	if x != nil {
+		return x.Runs
 	}
-	return 0
+	return nil
 }
 
-func (x *ExecutionLog) GetCreateTime() *timestamppb.Timestamp {
+func (x *ListRunsResponse) GetTotal() uint32 {
 	if x != nil {
-		return x.CreateTime
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-// Trigger execution request
-type TriggerExecutionRequest struct {
+// Pause run request
+type PauseRunRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScriptId      string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
-	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TriggerExecutionRequest) Reset() {
-	*x = TriggerExecutionRequest{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[1]
+func (x *PauseRunRequest) Reset() {
+	*x = PauseRunRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TriggerExecutionRequest) String() string {
+func (x *PauseRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerExecutionRequest) ProtoMessage() {}
+func (*PauseRunRequest) ProtoMessage() {}
 
-func (x *TriggerExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[1]
+func (x *PauseRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,47 +2187,40 @@ func (x *TriggerExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerExecutionRequest.ProtoReflect.Descriptor instead.
-func (*TriggerExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *TriggerExecutionRequest) GetScriptId() string {
-	if x != nil {
-		return x.ScriptId
-	}
-	return ""
+// Deprecated: Use PauseRunRequest.ProtoReflect.Descriptor instead.
+func (*PauseRunRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *TriggerExecutionRequest) GetClientId() string {
+func (x *PauseRunRequest) GetId() string {
 	if x != nil {
-		return x.ClientId
+		return x.Id
 	}
 	return ""
 }
 
-type TriggerExecutionResponse struct {
+type PauseRunResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Execution     *ExecutionLog          `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Run           *ExecutionRun          `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TriggerExecutionResponse) Reset() {
-	*x = TriggerExecutionResponse{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[2]
+func (x *PauseRunResponse) Reset() {
+	*x = PauseRunResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TriggerExecutionResponse) String() string {
+func (x *PauseRunResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerExecutionResponse) ProtoMessage() {}
+func (*PauseRunResponse) ProtoMessage() {}
 
-func (x *TriggerExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[2]
+func (x *PauseRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,41 +2231,41 @@ func (x *TriggerExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerExecutionResponse.ProtoReflect.Descriptor instead.
-func (*TriggerExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PauseRunResponse.ProtoReflect.Descriptor instead.
+func (*PauseRunResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TriggerExecutionResponse) GetExecution() *ExecutionLog {
+func (x *PauseRunResponse) GetRun() *ExecutionRun {
 	if x != nil {
-		return x.Execution
+		return x.Run
 	}
 	return nil
 }
 
-// Get execution request
-type GetExecutionRequest struct {
+// Resume run request
+type ResumeRunRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExecutionRequest) Reset() {
-	*x = GetExecutionRequest{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[3]
+func (x *ResumeRunRequest) Reset() {
+	*x = ResumeRunRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExecutionRequest) String() string {
+func (x *ResumeRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExecutionRequest) ProtoMessage() {}
+func (*ResumeRunRequest) ProtoMessage() {}
 
-func (x *GetExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[3]
+func (x *ResumeRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,40 +2276,40 @@ func (x *GetExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExecutionRequest.ProtoReflect.Descriptor instead.
-func (*GetExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ResumeRunRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRunRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetExecutionRequest) GetId() string {
+func (x *ResumeRunRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetExecutionResponse struct {
+type ResumeRunResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Execution     *ExecutionLog          `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Run           *ExecutionRun          `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExecutionResponse) Reset() {
-	*x = GetExecutionResponse{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[4]
+func (x *ResumeRunResponse) Reset() {
+	*x = ResumeRunResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExecutionResponse) String() string {
+func (x *ResumeRunResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExecutionResponse) ProtoMessage() {}
+func (*ResumeRunResponse) ProtoMessage() {}
 
-func (x *GetExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[4]
+func (x *ResumeRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,45 +2320,42 @@ func (x *GetExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExecutionResponse.ProtoReflect.Descriptor instead.
-func (*GetExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ResumeRunResponse.ProtoReflect.Descriptor instead.
+func (*ResumeRunResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetExecutionResponse) GetExecution() *ExecutionLog {
+func (x *ResumeRunResponse) GetRun() *ExecutionRun {
 	if x != nil {
-		return x.Execution
+		return x.Run
 	}
 	return nil
 }
 
-// List executions request
-type ListExecutionsRequest struct {
+// Abort run request
+type AbortRunRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize      *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	ScriptId      *string                `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3,oneof" json:"script_id,omitempty"`
-	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	Status        *ExecutionStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=executor.service.v1.ExecutionStatus,oneof" json:"status,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExecutionsRequest) Reset() {
-	*x = ListExecutionsRequest{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[5]
+func (x *AbortRunRequest) Reset() {
+	*x = AbortRunRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExecutionsRequest) String() string {
+func (x *AbortRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionsRequest) ProtoMessage() {}
+func (*AbortRunRequest) ProtoMessage() {}
 
-func (x *ListExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[5]
+func (x *AbortRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,69 +2366,48 @@ func (x *ListExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListExecutionsRequest) GetPage() uint32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-func (x *ListExecutionsRequest) GetPageSize() uint32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
-	}
-	return 0
+// Deprecated: Use AbortRunRequest.ProtoReflect.Descriptor instead.
+func (*AbortRunRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListExecutionsRequest) GetScriptId() string {
-	if x != nil && x.ScriptId != nil {
-		return *x.ScriptId
+func (x *AbortRunRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListExecutionsRequest) GetClientId() string {
-	if x != nil && x.ClientId != nil {
-		return *x.ClientId
+func (x *AbortRunRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
 	return ""
 }
 
-func (x *ListExecutionsRequest) GetStatus() ExecutionStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
-}
-
-type ListExecutionsResponse struct {
+type AbortRunResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*ExecutionLog        `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Run           *ExecutionRun          `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Cancelled     uint32                 `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"` // executions a cancel was issued for
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExecutionsResponse) Reset() {
-	*x = ListExecutionsResponse{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[6]
+func (x *AbortRunResponse) Reset() {
+	*x = AbortRunResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExecutionsResponse) String() string {
+func (x *AbortRunResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionsResponse) ProtoMessage() {}
+func (*AbortRunResponse) ProtoMessage() {}
 
-func (x *ListExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[6]
+func (x *AbortRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,48 +2418,49 @@ func (x *ListExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AbortRunResponse.ProtoReflect.Descriptor instead.
+func (*AbortRunResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListExecutionsResponse) GetExecutions() []*ExecutionLog {
+func (x *AbortRunResponse) GetRun() *ExecutionRun {
 	if x != nil {
-		return x.Executions
+		return x.Run
 	}
 	return nil
 }
 
-func (x *ListExecutionsResponse) GetTotal() uint32 {
+func (x *AbortRunResponse) GetCancelled() uint32 {
 	if x != nil {
-		return x.Total
+		return x.Cancelled
 	}
 	return 0
 }
 
-// Get execution output request
-type GetExecutionOutputRequest struct {
+// Cancel execution request
+type CancelExecutionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExecutionOutputRequest) Reset() {
-	*x = GetExecutionOutputRequest{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[7]
+func (x *CancelExecutionRequest) Reset() {
+	*x = CancelExecutionRequest{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExecutionOutputRequest) String() string {
+func (x *CancelExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExecutionOutputRequest) ProtoMessage() {}
+func (*CancelExecutionRequest) ProtoMessage() {}
 
-func (x *GetExecutionOutputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[7]
+func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,42 +2471,48 @@ func (x *GetExecutionOutputRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExecutionOutputRequest.ProtoReflect.Descriptor instead.
-func (*GetExecutionOutputRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetExecutionOutputRequest) GetId() string {
+func (x *CancelExecutionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetExecutionOutputResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
-	ErrorOutput   string                 `protobuf:"bytes,2,opt,name=error_output,json=errorOutput,proto3" json:"error_output,omitempty"`
-	ExitCode      *int32                 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CancelExecutionRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
 }
 
-func (x *GetExecutionOutputResponse) Reset() {
-	*x = GetExecutionOutputResponse{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[8]
+type CancelExecutionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Execution      *ExecutionLog          `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	ClientNotified bool                   `protobuf:"varint,2,opt,name=client_notified,json=clientNotified,proto3" json:"client_notified,omitempty"` // false when the cancel waits for the client to reconnect
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelExecutionResponse) Reset() {
+	*x = CancelExecutionResponse{}
+	mi := &file_executor_service_v1_execution_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExecutionOutputResponse) String() string {
+func (x *CancelExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExecutionOutputResponse) ProtoMessage() {}
+func (*CancelExecutionResponse) ProtoMessage() {}
 
-func (x *GetExecutionOutputResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[8]
+func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_execution_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,30 +2523,23 @@ func (x *GetExecutionOutputResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExecutionOutputResponse.ProtoReflect.Descriptor instead.
-func (*GetExecutionOutputResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
+func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetExecutionOutputResponse) GetOutput() string {
+func (x *CancelExecutionResponse) GetExecution() *ExecutionLog {
 	if x != nil {
-		return x.Output
+		return x.Execution
 	}
-	return ""
+	return nil
 }
 
-func (x *GetExecutionOutputResponse) GetErrorOutput() string {
+func (x *CancelExecutionResponse) GetClientNotified() bool {
 	if x != nil {
-		return x.ErrorOutput
-	}
-	return ""
-}
-
-func (x *GetExecutionOutputResponse) GetExitCode() int32 {
-	if x != nil && x.ExitCode != nil {
-		return *x.ExitCode
+		return x.ClientNotified
 	}
-	return 0
+	return false
 }
 
 // Trigger client update request
@@ -744,7 +2553,7 @@ type TriggerClientUpdateRequest struct {
 
 func (x *TriggerClientUpdateRequest) Reset() {
 	*x = TriggerClientUpdateRequest{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[9]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -756,7 +2565,7 @@ func (x *TriggerClientUpdateRequest) String() string {
 func (*TriggerClientUpdateRequest) ProtoMessage() {}
 
 func (x *TriggerClientUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[9]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +2578,7 @@ func (x *TriggerClientUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerClientUpdateRequest.ProtoReflect.Descriptor instead.
 func (*TriggerClientUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{9}
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TriggerClientUpdateRequest) GetClientId() string {
@@ -796,7 +2605,7 @@ type TriggerClientUpdateResponse struct {
 
 func (x *TriggerClientUpdateResponse) Reset() {
 	*x = TriggerClientUpdateResponse{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[10]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +2617,7 @@ func (x *TriggerClientUpdateResponse) String() string {
 func (*TriggerClientUpdateResponse) ProtoMessage() {}
 
 func (x *TriggerClientUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[10]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +2630,7 @@ func (x *TriggerClientUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerClientUpdateResponse.ProtoReflect.Descriptor instead.
 func (*TriggerClientUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{10}
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TriggerClientUpdateResponse) GetCommandId() string {
@@ -847,7 +2656,7 @@ type ListConnectedClientsRequest struct {
 
 func (x *ListConnectedClientsRequest) Reset() {
 	*x = ListConnectedClientsRequest{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[11]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +2668,7 @@ func (x *ListConnectedClientsRequest) String() string {
 func (*ListConnectedClientsRequest) ProtoMessage() {}
 
 func (x *ListConnectedClientsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[11]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +2681,7 @@ func (x *ListConnectedClientsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConnectedClientsRequest.ProtoReflect.Descriptor instead.
 func (*ListConnectedClientsRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{11}
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{33}
 }
 
 // A currently connected client
@@ -881,13 +2690,18 @@ type ConnectedClient struct {
 	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	ClientVersion string                 `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
 	ConnectedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen_at,json=lastSeenAt,proto3,oneof" json:"last_seen_at,omitempty"`
+	Load1         float64                `protobuf:"fixed64,5,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5         float64                `protobuf:"fixed64,6,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15        float64                `protobuf:"fixed64,7,opt,name=load15,proto3" json:"load15,omitempty"`
+	UptimeSeconds int64                  `protobuf:"varint,8,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ConnectedClient) Reset() {
 	*x = ConnectedClient{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[12]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +2713,7 @@ func (x *ConnectedClient) String() string {
 func (*ConnectedClient) ProtoMessage() {}
 
 func (x *ConnectedClient) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[12]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +2726,7 @@ func (x *ConnectedClient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectedClient.ProtoReflect.Descriptor instead.
 func (*ConnectedClient) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{12}
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ConnectedClient) GetClientId() string {
@@ -936,6 +2750,41 @@ func (x *ConnectedClient) GetConnectedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ConnectedClient) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *ConnectedClient) GetLoad1() float64 {
+	if x != nil {
+		return x.Load1
+	}
+	return 0
+}
+
+func (x *ConnectedClient) GetLoad5() float64 {
+	if x != nil {
+		return x.Load5
+	}
+	return 0
+}
+
+func (x *ConnectedClient) GetLoad15() float64 {
+	if x != nil {
+		return x.Load15
+	}
+	return 0
+}
+
+func (x *ConnectedClient) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
 // List connected clients response
 type ListConnectedClientsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -946,7 +2795,7 @@ type ListConnectedClientsResponse struct {
 
 func (x *ListConnectedClientsResponse) Reset() {
 	*x = ListConnectedClientsResponse{}
-	mi := &file_executor_service_v1_execution_proto_msgTypes[13]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +2807,7 @@ func (x *ListConnectedClientsResponse) String() string {
 func (*ListConnectedClientsResponse) ProtoMessage() {}
 
 func (x *ListConnectedClientsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_execution_proto_msgTypes[13]
+	mi := &file_executor_service_v1_execution_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +2820,7 @@ func (x *ListConnectedClientsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConnectedClientsResponse.ProtoReflect.Descriptor instead.
 func (*ListConnectedClientsResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{13}
+	return file_executor_service_v1_execution_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListConnectedClientsResponse) GetClients() []*ConnectedClient {
@@ -985,7 +2834,14 @@ var File_executor_service_v1_execution_proto protoreflect.FileDescriptor
 
 const file_executor_service_v1_execution_proto_rawDesc = "" +
 	"\n" +
-	"#executor/service/v1/execution.proto\x12\x13executor.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\"\xf2\x06\n" +
+	"#executor/service/v1/execution.proto\x12\x13executor.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\x1a(executor/service/v1/script_version.proto\"\xd2\x01\n" +
+	"\x0eExecutionEvent\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.executor.service.v1.EventTypeR\x04type\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\x06detail\x18\x03 \x01(\tH\x00R\x06detail\x88\x01\x01\x123\n" +
+	"\x13source_execution_id\x18\x04 \x01(\tH\x01R\x11sourceExecutionId\x88\x01\x01B\t\n" +
+	"\a_detailB\x16\n" +
+	"\x14_source_execution_id\"\xd9\x12\n" +
 	"\fExecutionLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x1b\n" +
@@ -1010,7 +2866,36 @@ const file_executor_service_v1_execution_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x10 \x01(\rH\aR\tcreatedBy\x88\x01\x01\x12;\n" +
 	"\vcreate_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTimeB\f\n" +
+	"createTime\x12O\n" +
+	"\x13cancel_requested_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampH\bR\x11cancelRequestedAt\x88\x01\x01\x12&\n" +
+	"\fcancelled_by\x18\x13 \x01(\rH\tR\vcancelledBy\x88\x01\x01\x12(\n" +
+	"\rcancel_reason\x18\x14 \x01(\tH\n" +
+	"R\fcancelReason\x88\x01\x01\x12\x1a\n" +
+	"\x06run_id\x18\x15 \x01(\tH\vR\x05runId\x88\x01\x01\x12>\n" +
+	"\x05event\x18\x16 \x01(\v2#.executor.service.v1.ExecutionEventH\fR\x05event\x88\x01\x01\x12+\n" +
+	"\x0fworkflow_run_id\x18\x17 \x01(\tH\rR\rworkflowRunId\x88\x01\x01\x12-\n" +
+	"\x10workflow_step_id\x18\x18 \x01(\tH\x0eR\x0eworkflowStepId\x88\x01\x01\x12\x18\n" +
+	"\aattempt\x18\x19 \x01(\rR\aattempt\x127\n" +
+	"\x15original_execution_id\x18\x1a \x01(\tH\x0fR\x13originalExecutionId\x88\x01\x01\x12C\n" +
+	"\rnext_retry_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampH\x10R\vnextRetryAt\x88\x01\x01\x12:\n" +
+	"\x17retried_by_execution_id\x18\x1c \x01(\tH\x11R\x14retriedByExecutionId\x88\x01\x01\x12(\n" +
+	"\x10waiting_for_slot\x18\x1d \x01(\bR\x0ewaitingForSlot\x12>\n" +
+	"\n" +
+	"held_until\x18\x1e \x01(\v2\x1a.google.protobuf.TimestampH\x12R\theldUntil\x88\x01\x01\x126\n" +
+	"\x14maintenance_override\x18\x1f \x01(\tH\x13R\x13maintenanceOverride\x88\x01\x01\x12Q\n" +
+	"\n" +
+	"parameters\x18  \x03(\v21.executor.service.v1.ExecutionLog.ParametersEntryR\n" +
+	"parameters\x12!\n" +
+	"\fsecret_names\x18! \x03(\tR\vsecretNames\x12O\n" +
+	"\x13approval_expires_at\x18\" \x01(\v2\x1a.google.protobuf.TimestampH\x14R\x11approvalExpiresAt\x88\x01\x01\x12$\n" +
+	"\vapproved_by\x18# \x01(\rH\x15R\n" +
+	"approvedBy\x88\x01\x01\x12B\n" +
+	"\fapprove_time\x18$ \x01(\v2\x1a.google.protobuf.TimestampH\x16R\vapproveTime\x88\x01\x01\x12.\n" +
+	"\x10approval_comment\x18% \x01(\tH\x17R\x0fapprovalComment\x88\x01\x01\x12*\n" +
+	"\x0etimeout_reason\x18& \x01(\tH\x18R\rtimeoutReason\x88\x01\x01\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
 	"\n" +
 	"_exit_codeB\t\n" +
 	"\a_outputB\x0f\n" +
@@ -1019,22 +2904,114 @@ const file_executor_service_v1_execution_proto_rawDesc = "" +
 	"\v_started_atB\x0f\n" +
 	"\r_completed_atB\x0e\n" +
 	"\f_duration_msB\r\n" +
-	"\v_created_by\"p\n" +
+	"\v_created_byB\x16\n" +
+	"\x14_cancel_requested_atB\x0f\n" +
+	"\r_cancelled_byB\x10\n" +
+	"\x0e_cancel_reasonB\t\n" +
+	"\a_run_idB\b\n" +
+	"\x06_eventB\x12\n" +
+	"\x10_workflow_run_idB\x13\n" +
+	"\x11_workflow_step_idB\x18\n" +
+	"\x16_original_execution_idB\x10\n" +
+	"\x0e_next_retry_atB\x1a\n" +
+	"\x18_retried_by_execution_idB\r\n" +
+	"\v_held_untilB\x17\n" +
+	"\x15_maintenance_overrideB\x16\n" +
+	"\x14_approval_expires_atB\x0e\n" +
+	"\f_approved_byB\x0f\n" +
+	"\r_approve_timeB\x13\n" +
+	"\x11_approval_commentB\x11\n" +
+	"\x0f_timeout_reason\"\xab\x01\n" +
+	"\vRunProgress\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\rR\x05total\x12\x18\n" +
+	"\apending\x18\x02 \x01(\rR\apending\x12\x18\n" +
+	"\arunning\x18\x03 \x01(\rR\arunning\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\rR\tcompleted\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\rR\x06failed\x12\x1c\n" +
+	"\tcancelled\x18\x06 \x01(\rR\tcancelled\"\xd5\x01\n" +
+	"\vRunStrategy\x12'\n" +
+	"\n" +
+	"batch_size\x18\x01 \x01(\rB\b\xbaH\x05*\x03\x18\x88'R\tbatchSize\x12)\n" +
+	"\vcanary_size\x18\x02 \x01(\rB\b\xbaH\x05*\x03\x18\x88'R\n" +
+	"canarySize\x129\n" +
+	"\x13batch_pause_seconds\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x18\x80\xa3\x05R\x11batchPauseSeconds\x12&\n" +
+	"\fmax_failures\x18\x04 \x01(\rH\x00R\vmaxFailures\x88\x01\x01B\x0f\n" +
+	"\r_max_failures\"\xea\x06\n" +
+	"\fExecutionRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x1b\n" +
+	"\tscript_id\x18\x03 \x01(\tR\bscriptId\x12\x1f\n" +
+	"\vscript_name\x18\x04 \x01(\tR\n" +
+	"scriptName\x12\x1f\n" +
+	"\bselector\x18\x05 \x01(\tH\x00R\bselector\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\x06 \x03(\tR\tclientIds\x126\n" +
+	"\x06status\x18\a \x01(\x0e2\x1e.executor.service.v1.RunStatusR\x06status\x12<\n" +
+	"\bprogress\x18\b \x01(\v2 .executor.service.v1.RunProgressR\bprogress\x12\"\n" +
+	"\n" +
+	"created_by\x18\t \x01(\rH\x01R\tcreatedBy\x88\x01\x01\x12;\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12B\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vcompletedAt\x88\x01\x01\x12<\n" +
+	"\bstrategy\x18\f \x01(\v2 .executor.service.v1.RunStrategyR\bstrategy\x12!\n" +
+	"\ftarget_count\x18\r \x01(\rR\vtargetCount\x12\x1e\n" +
+	"\n" +
+	"dispatched\x18\x0e \x01(\rR\n" +
+	"dispatched\x12#\n" +
+	"\rcurrent_batch\x18\x0f \x01(\rR\fcurrentBatch\x12C\n" +
+	"\rnext_batch_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\vnextBatchAt\x88\x01\x01\x12$\n" +
+	"\vhalt_reason\x18\x11 \x01(\tH\x04R\n" +
+	"haltReason\x88\x01\x01\x12$\n" +
+	"\vschedule_id\x18\x12 \x01(\tH\x05R\n" +
+	"scheduleId\x88\x01\x01B\v\n" +
+	"\t_selectorB\r\n" +
+	"\v_created_byB\x0f\n" +
+	"\r_completed_atB\x10\n" +
+	"\x0e_next_batch_atB\x0e\n" +
+	"\f_halt_reasonB\x0e\n" +
+	"\f_schedule_id\"D\n" +
+	"\rSkippedTarget\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x82\x02\n" +
+	"\vOutputChunk\x12/\n" +
+	"\fexecution_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\vexecutionId\x12\x19\n" +
+	"\x03seq\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x03seq\x12E\n" +
+	"\x06stream\x18\x03 \x01(\x0e2!.executor.service.v1.OutputStreamB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06stream\x12#\n" +
+	"\x04data\x18\x04 \x01(\tB\x0f\xbaH\x06r\x04\x18\x80\x80\x04ڶ\x1a\x02z\x00R\x04data\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xde\x03\n" +
 	"\x17TriggerExecutionRequest\x12)\n" +
 	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12*\n" +
-	"\tclient_id\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bclientId\"[\n" +
+	"\tclient_id\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bclientId\x12C\n" +
+	"\x1boverride_maintenance_window\x18\x03 \x01(\bH\x00R\x19overrideMaintenanceWindow\x88\x01\x01\x12D\n" +
+	"\x16override_justification\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x01R\x15overrideJustification\x88\x01\x01\x12g\n" +
+	"\n" +
+	"parameters\x18\x05 \x03(\v2<.executor.service.v1.TriggerExecutionRequest.ParametersEntryB\tڶ\x1a\x05\xa2\x01\x02\b\x01R\n" +
+	"parameters\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x1e\n" +
+	"\x1c_override_maintenance_windowB\x19\n" +
+	"\x17_override_justification\"s\n" +
 	"\x18TriggerExecutionResponse\x12?\n" +
-	"\texecution\x18\x01 \x01(\v2!.executor.service.v1.ExecutionLogR\texecution\"3\n" +
+	"\texecution\x18\x01 \x01(\v2!.executor.service.v1.ExecutionLogR\texecution\x12\x16\n" +
+	"\x06queued\x18\x02 \x01(\bR\x06queued\"3\n" +
 	"\x13GetExecutionRequest\x12\x1c\n" +
-	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"W\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"\xba\x01\n" +
 	"\x14GetExecutionResponse\x12?\n" +
-	"\texecution\x18\x01 \x01(\v2!.executor.service.v1.ExecutionLogR\texecution\"\x97\x02\n" +
+	"\texecution\x18\x01 \x01(\v2!.executor.service.v1.ExecutionLogR\texecution\x12N\n" +
+	"\x0escript_version\x18\x02 \x01(\v2\".executor.service.v1.ScriptVersionH\x00R\rscriptVersion\x88\x01\x01B\x11\n" +
+	"\x0f_script_version\"\x91\x03\n" +
 	"\x15ListExecutionsRequest\x12\x17\n" +
 	"\x04page\x18\x01 \x01(\rH\x00R\x04page\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\rH\x01R\bpageSize\x88\x01\x01\x12 \n" +
 	"\tscript_id\x18\x03 \x01(\tH\x02R\bscriptId\x88\x01\x01\x12 \n" +
 	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12A\n" +
-	"\x06status\x18\x05 \x01(\x0e2$.executor.service.v1.ExecutionStatusH\x04R\x06status\x88\x01\x01B\a\n" +
+	"\x06status\x18\x05 \x01(\x0e2$.executor.service.v1.ExecutionStatusH\x04R\x06status\x88\x01\x01\x12\x1a\n" +
+	"\x06run_id\x18\x06 \x01(\tH\x05R\x05runId\x88\x01\x01\x127\n" +
+	"\x15original_execution_id\x18\a \x01(\tH\x06R\x13originalExecutionId\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\f\n" +
@@ -1042,7 +3019,9 @@ const file_executor_service_v1_execution_proto_rawDesc = "" +
 	"_script_idB\f\n" +
 	"\n" +
 	"_client_idB\t\n" +
-	"\a_status\"q\n" +
+	"\a_statusB\t\n" +
+	"\a_run_idB\x18\n" +
+	"\x16_original_execution_id\"q\n" +
 	"\x16ListExecutionsResponse\x12A\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2!.executor.service.v1.ExecutionLogR\n" +
@@ -1055,7 +3034,67 @@ const file_executor_service_v1_execution_proto_rawDesc = "" +
 	"\ferror_output\x18\x02 \x01(\tB\x06ڶ\x1a\x02z\x00R\verrorOutput\x12 \n" +
 	"\texit_code\x18\x03 \x01(\x05H\x00R\bexitCode\x88\x01\x01B\f\n" +
 	"\n" +
-	"_exit_code\"o\n" +
+	"_exit_code\"d\n" +
+	"\x14TailExecutionRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12 \n" +
+	"\tafter_seq\x18\x02 \x01(\x03H\x00R\bafterSeq\x88\x01\x01B\f\n" +
+	"\n" +
+	"_after_seq\"\x9b\x01\n" +
+	"\x15TailExecutionResponse\x128\n" +
+	"\x05chunk\x18\x01 \x01(\v2 .executor.service.v1.OutputChunkH\x00R\x05chunk\x12?\n" +
+	"\bfinished\x18\x02 \x01(\v2!.executor.service.v1.ExecutionLogH\x00R\bfinishedB\a\n" +
+	"\x05event\"\xf0\x01\n" +
+	"\x11TriggerRunRequest\x12)\n" +
+	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12(\n" +
+	"\n" +
+	"client_ids\x18\x02 \x03(\tB\t\xbaH\x06\x92\x01\x03\x10\x88'R\tclientIds\x12)\n" +
+	"\bselector\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\bselector\x88\x01\x01\x12A\n" +
+	"\bstrategy\x18\x04 \x01(\v2 .executor.service.v1.RunStrategyH\x01R\bstrategy\x88\x01\x01B\v\n" +
+	"\t_selectorB\v\n" +
+	"\t_strategy\"\x87\x01\n" +
+	"\x12TriggerRunResponse\x123\n" +
+	"\x03run\x18\x01 \x01(\v2!.executor.service.v1.ExecutionRunR\x03run\x12<\n" +
+	"\askipped\x18\x02 \x03(\v2\".executor.service.v1.SkippedTargetR\askipped\"-\n" +
+	"\rGetRunRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"E\n" +
+	"\x0eGetRunResponse\x123\n" +
+	"\x03run\x18\x01 \x01(\v2!.executor.service.v1.ExecutionRunR\x03run\"\xdb\x01\n" +
+	"\x0fListRunsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\rH\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\rH\x01R\bpageSize\x88\x01\x01\x12 \n" +
+	"\tscript_id\x18\x03 \x01(\tH\x02R\bscriptId\x88\x01\x01\x12;\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.executor.service.v1.RunStatusH\x03R\x06status\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\f\n" +
+	"\n" +
+	"_script_idB\t\n" +
+	"\a_status\"_\n" +
+	"\x10ListRunsResponse\x125\n" +
+	"\x04runs\x18\x01 \x03(\v2!.executor.service.v1.ExecutionRunR\x04runs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"/\n" +
+	"\x0fPauseRunRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"G\n" +
+	"\x10PauseRunResponse\x123\n" +
+	"\x03run\x18\x01 \x01(\v2!.executor.service.v1.ExecutionRunR\x03run\"0\n" +
+	"\x10ResumeRunRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"H\n" +
+	"\x11ResumeRunResponse\x123\n" +
+	"\x03run\x18\x01 \x01(\v2!.executor.service.v1.ExecutionRunR\x03run\"a\n" +
+	"\x0fAbortRunRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12%\n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"e\n" +
+	"\x10AbortRunResponse\x123\n" +
+	"\x03run\x18\x01 \x01(\v2!.executor.service.v1.ExecutionRunR\x03run\x12\x1c\n" +
+	"\tcancelled\x18\x02 \x01(\rR\tcancelled\"h\n" +
+	"\x16CancelExecutionRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12%\n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\x83\x01\n" +
+	"\x17CancelExecutionResponse\x12?\n" +
+	"\texecution\x18\x01 \x01(\v2!.executor.service.v1.ExecutionLogR\texecution\x12'\n" +
+	"\x0fclient_notified\x18\x02 \x01(\bR\x0eclientNotified\"o\n" +
 	"\x1aTriggerClientUpdateRequest\x12*\n" +
 	"\tclient_id\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bclientId\x12%\n" +
 	"\x0etarget_version\x18\x02 \x01(\tR\rtargetVersion\"a\n" +
@@ -1063,17 +3102,33 @@ const file_executor_service_v1_execution_proto_rawDesc = "" +
 	"\n" +
 	"command_id\x18\x01 \x01(\tR\tcommandId\x12#\n" +
 	"\rclient_online\x18\x02 \x01(\bR\fclientOnline\"\x1d\n" +
-	"\x1bListConnectedClientsRequest\"\x94\x01\n" +
+	"\x1bListConnectedClientsRequest\"\xd3\x02\n" +
 	"\x0fConnectedClient\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12%\n" +
 	"\x0eclient_version\x18\x02 \x01(\tR\rclientVersion\x12=\n" +
-	"\fconnected_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vconnectedAt\"^\n" +
+	"\fconnected_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vconnectedAt\x12A\n" +
+	"\flast_seen_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"lastSeenAt\x88\x01\x01\x12\x14\n" +
+	"\x05load1\x18\x05 \x01(\x01R\x05load1\x12\x14\n" +
+	"\x05load5\x18\x06 \x01(\x01R\x05load5\x12\x16\n" +
+	"\x06load15\x18\a \x01(\x01R\x06load15\x12%\n" +
+	"\x0euptime_seconds\x18\b \x01(\x03R\ruptimeSecondsB\x0f\n" +
+	"\r_last_seen_at\"^\n" +
 	"\x1cListConnectedClientsResponse\x12>\n" +
-	"\aclients\x18\x01 \x03(\v2$.executor.service.v1.ConnectedClientR\aclients*c\n" +
+	"\aclients\x18\x01 \x03(\v2$.executor.service.v1.ConnectedClientR\aclients*\xb2\x01\n" +
 	"\vTriggerType\x12\x1c\n" +
 	"\x18TRIGGER_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18TRIGGER_TYPE_CLIENT_PULL\x10\x01\x12\x18\n" +
-	"\x14TRIGGER_TYPE_UI_PUSH\x10\x02*\xaa\x02\n" +
+	"\x14TRIGGER_TYPE_UI_PUSH\x10\x02\x12\x1a\n" +
+	"\x16TRIGGER_TYPE_SCHEDULED\x10\x03\x12\x16\n" +
+	"\x12TRIGGER_TYPE_EVENT\x10\x04\x12\x19\n" +
+	"\x15TRIGGER_TYPE_WORKFLOW\x10\x05*\xb7\x01\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fEVENT_TYPE_CLIENT_FIRST_CONNECT\x10\x01\x12%\n" +
+	"!EVENT_TYPE_CLIENT_VERSION_CHANGED\x10\x02\x12$\n" +
+	" EVENT_TYPE_CLIENT_LABELS_CHANGED\x10\x03\x12\x1c\n" +
+	"\x18EVENT_TYPE_SCRIPT_FAILED\x10\x04*\x92\x03\n" +
 	"\x0fExecutionStatus\x12 \n" +
 	"\x1cEXECUTION_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18EXECUTION_STATUS_PENDING\x10\x01\x12\x1c\n" +
@@ -1082,12 +3137,37 @@ const file_executor_service_v1_execution_proto_rawDesc = "" +
 	"\x17EXECUTION_STATUS_FAILED\x10\x04\x12+\n" +
 	"'EXECUTION_STATUS_REJECTED_HASH_MISMATCH\x10\x05\x12*\n" +
 	"&EXECUTION_STATUS_REJECTED_NOT_APPROVED\x10\x06\x12#\n" +
-	"\x1fEXECUTION_STATUS_CLIENT_OFFLINE\x10\a2\x9e\a\n" +
+	"\x1fEXECUTION_STATUS_CLIENT_OFFLINE\x10\a\x12\x1e\n" +
+	"\x1aEXECUTION_STATUS_TIMED_OUT\x10\b\x12\x1e\n" +
+	"\x1aEXECUTION_STATUS_CANCELLED\x10\t\x12&\n" +
+	"\"EXECUTION_STATUS_AWAITING_APPROVAL\x10\n" +
+	"*\x9f\x01\n" +
+	"\tRunStatus\x12\x1a\n" +
+	"\x16RUN_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12RUN_STATUS_RUNNING\x10\x01\x12\x18\n" +
+	"\x14RUN_STATUS_COMPLETED\x10\x02\x12\x15\n" +
+	"\x11RUN_STATUS_PAUSED\x10\x03\x12\x15\n" +
+	"\x11RUN_STATUS_HALTED\x10\x04\x12\x16\n" +
+	"\x12RUN_STATUS_ABORTED\x10\x05*a\n" +
+	"\fOutputStream\x12\x1d\n" +
+	"\x19OUTPUT_STREAM_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14OUTPUT_STREAM_STDOUT\x10\x01\x12\x18\n" +
+	"\x14OUTPUT_STREAM_STDERR\x10\x022\xed\x0e\n" +
 	"\x18ExecutorExecutionService\x12\x9b\x01\n" +
 	"\x10TriggerExecution\x12,.executor.service.v1.TriggerExecutionRequest\x1a-.executor.service.v1.TriggerExecutionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/scripts/{script_id}/execute\x12\x80\x01\n" +
 	"\fGetExecution\x12(.executor.service.v1.GetExecutionRequest\x1a).executor.service.v1.GetExecutionResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/executions/{id}\x12\x81\x01\n" +
 	"\x0eListExecutions\x12*.executor.service.v1.ListExecutionsRequest\x1a+.executor.service.v1.ListExecutionsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/executions\x12\x99\x01\n" +
-	"\x12GetExecutionOutput\x12..executor.service.v1.GetExecutionOutputRequest\x1a/.executor.service.v1.GetExecutionOutputResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/executions/{id}/output\x12\xa3\x01\n" +
+	"\x12GetExecutionOutput\x12..executor.service.v1.GetExecutionOutputRequest\x1a/.executor.service.v1.GetExecutionOutputResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/executions/{id}/output\x12j\n" +
+	"\rTailExecution\x12).executor.service.v1.TailExecutionRequest\x1a*.executor.service.v1.TailExecutionResponse\"\x000\x01\x12\x86\x01\n" +
+	"\n" +
+	"TriggerRun\x12&.executor.service.v1.TriggerRunRequest\x1a'.executor.service.v1.TriggerRunResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/scripts/{script_id}/runs\x12h\n" +
+	"\x06GetRun\x12\".executor.service.v1.GetRunRequest\x1a#.executor.service.v1.GetRunResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/runs/{id}\x12i\n" +
+	"\bListRuns\x12$.executor.service.v1.ListRunsRequest\x1a%.executor.service.v1.ListRunsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/runs\x12w\n" +
+	"\bPauseRun\x12$.executor.service.v1.PauseRunRequest\x1a%.executor.service.v1.PauseRunResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/runs/{id}/pause\x12{\n" +
+	"\tResumeRun\x12%.executor.service.v1.ResumeRunRequest\x1a&.executor.service.v1.ResumeRunResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/runs/{id}/resume\x12w\n" +
+	"\bAbortRun\x12$.executor.service.v1.AbortRunRequest\x1a%.executor.service.v1.AbortRunResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/runs/{id}/abort\x12\x93\x01\n" +
+	"\x0fCancelExecution\x12+.executor.service.v1.CancelExecutionRequest\x1a,.executor.service.v1.CancelExecutionResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/executions/{id}/cancel\x12\xa3\x01\n" +
 	"\x13TriggerClientUpdate\x12/.executor.service.v1.TriggerClientUpdateRequest\x1a0.executor.service.v1.TriggerClientUpdateResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/clients/{client_id}/update\x12\x9a\x01\n" +
 	"\x14ListConnectedClients\x120.executor.service.v1.ListConnectedClientsRequest\x1a1.executor.service.v1.ListConnectedClientsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/clients/connectedB\xe6\x01\n" +
 	"\x17com.executor.service.v1B\x0eExecutionProtoP\x01ZMgithub.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1;executorpb\xa2\x02\x03ESX\xaa\x02\x13Executor.Service.V1\xca\x02\x13Executor\\Service\\V1\xe2\x02\x1fExecutor\\Service\\V1\\GPBMetadata\xea\x02\x15Executor::Service::V1b\x06proto3"
@@ -1104,56 +3184,131 @@ func file_executor_service_v1_execution_proto_rawDescGZIP() []byte {
 	return file_executor_service_v1_execution_proto_rawDescData
 }
 
-var file_executor_service_v1_execution_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_executor_service_v1_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_executor_service_v1_execution_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_executor_service_v1_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_executor_service_v1_execution_proto_goTypes = []any{
 	(TriggerType)(0),                     // 0: executor.service.v1.TriggerType
-	(ExecutionStatus)(0),                 // 1: executor.service.v1.ExecutionStatus
-	(*ExecutionLog)(nil),                 // 2: executor.service.v1.ExecutionLog
-	(*TriggerExecutionRequest)(nil),      // 3: executor.service.v1.TriggerExecutionRequest
-	(*TriggerExecutionResponse)(nil),     // 4: executor.service.v1.TriggerExecutionResponse
-	(*GetExecutionRequest)(nil),          // 5: executor.service.v1.GetExecutionRequest
-	(*GetExecutionResponse)(nil),         // 6: executor.service.v1.GetExecutionResponse
-	(*ListExecutionsRequest)(nil),        // 7: executor.service.v1.ListExecutionsRequest
-	(*ListExecutionsResponse)(nil),       // 8: executor.service.v1.ListExecutionsResponse
-	(*GetExecutionOutputRequest)(nil),    // 9: executor.service.v1.GetExecutionOutputRequest
-	(*GetExecutionOutputResponse)(nil),   // 10: executor.service.v1.GetExecutionOutputResponse
-	(*TriggerClientUpdateRequest)(nil),   // 11: executor.service.v1.TriggerClientUpdateRequest
-	(*TriggerClientUpdateResponse)(nil),  // 12: executor.service.v1.TriggerClientUpdateResponse
-	(*ListConnectedClientsRequest)(nil),  // 13: executor.service.v1.ListConnectedClientsRequest
-	(*ConnectedClient)(nil),              // 14: executor.service.v1.ConnectedClient
-	(*ListConnectedClientsResponse)(nil), // 15: executor.service.v1.ListConnectedClientsResponse
-	(*timestamppb.Timestamp)(nil),        // 16: google.protobuf.Timestamp
+	(EventType)(0),                       // 1: executor.service.v1.EventType
+	(ExecutionStatus)(0),                 // 2: executor.service.v1.ExecutionStatus
+	(RunStatus)(0),                       // 3: executor.service.v1.RunStatus
+	(OutputStream)(0),                    // 4: executor.service.v1.OutputStream
+	(*ExecutionEvent)(nil),               // 5: executor.service.v1.ExecutionEvent
+	(*ExecutionLog)(nil),                 // 6: executor.service.v1.ExecutionLog
+	(*RunProgress)(nil),                  // 7: executor.service.v1.RunProgress
+	(*RunStrategy)(nil),                  // 8: executor.service.v1.RunStrategy
+	(*ExecutionRun)(nil),                 // 9: executor.service.v1.ExecutionRun
+	(*SkippedTarget)(nil),                // 10: executor.service.v1.SkippedTarget
+	(*OutputChunk)(nil),                  // 11: executor.service.v1.OutputChunk
+	(*TriggerExecutionRequest)(nil),      // 12: executor.service.v1.TriggerExecutionRequest
+	(*TriggerExecutionResponse)(nil),     // 13: executor.service.v1.TriggerExecutionResponse
+	(*GetExecutionRequest)(nil),          // 14: executor.service.v1.GetExecutionRequest
+	(*GetExecutionResponse)(nil),         // 15: executor.service.v1.GetExecutionResponse
+	(*ListExecutionsRequest)(nil),        // 16: executor.service.v1.ListExecutionsRequest
+	(*ListExecutionsResponse)(nil),       // 17: executor.service.v1.ListExecutionsResponse
+	(*GetExecutionOutputRequest)(nil),    // 18: executor.service.v1.GetExecutionOutputRequest
+	(*GetExecutionOutputResponse)(nil),   // 19: executor.service.v1.GetExecutionOutputResponse
+	(*TailExecutionRequest)(nil),         // 20: executor.service.v1.TailExecutionRequest
+	(*TailExecutionResponse)(nil),        // 21: executor.service.v1.TailExecutionResponse
+	(*TriggerRunRequest)(nil),            // 22: executor.service.v1.TriggerRunRequest
+	(*TriggerRunResponse)(nil),           // 23: executor.service.v1.TriggerRunResponse
+	(*GetRunRequest)(nil),                // 24: executor.service.v1.GetRunRequest
+	(*GetRunResponse)(nil),               // 25: executor.service.v1.GetRunResponse
+	(*ListRunsRequest)(nil),              // 26: executor.service.v1.ListRunsRequest
+	(*ListRunsResponse)(nil),             // 27: executor.service.v1.ListRunsResponse
+	(*PauseRunRequest)(nil),              // 28: executor.service.v1.PauseRunRequest
+	(*PauseRunResponse)(nil),             // 29: executor.service.v1.PauseRunResponse
+	(*ResumeRunRequest)(nil),             // 30: executor.service.v1.ResumeRunRequest
+	(*ResumeRunResponse)(nil),            // 31: executor.service.v1.ResumeRunResponse
+	(*AbortRunRequest)(nil),              // 32: executor.service.v1.AbortRunRequest
+	(*AbortRunResponse)(nil),             // 33: executor.service.v1.AbortRunResponse
+	(*CancelExecutionRequest)(nil),       // 34: executor.service.v1.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),      // 35: executor.service.v1.CancelExecutionResponse
+	(*TriggerClientUpdateRequest)(nil),   // 36: executor.service.v1.TriggerClientUpdateRequest
+	(*TriggerClientUpdateResponse)(nil),  // 37: executor.service.v1.TriggerClientUpdateResponse
+	(*ListConnectedClientsRequest)(nil),  // 38: executor.service.v1.ListConnectedClientsRequest
+	(*ConnectedClient)(nil),              // 39: executor.service.v1.ConnectedClient
+	(*ListConnectedClientsResponse)(nil), // 40: executor.service.v1.ListConnectedClientsResponse
+	nil,                                  // 41: executor.service.v1.ExecutionLog.ParametersEntry
+	nil,                                  // 42: executor.service.v1.TriggerExecutionRequest.ParametersEntry
+	(*timestamppb.Timestamp)(nil),        // 43: google.protobuf.Timestamp
+	(*ScriptVersion)(nil),                // 44: executor.service.v1.ScriptVersion
 }
 var file_executor_service_v1_execution_proto_depIdxs = []int32{
-	0,  // 0: executor.service.v1.ExecutionLog.trigger_type:type_name -> executor.service.v1.TriggerType
-	1,  // 1: executor.service.v1.ExecutionLog.status:type_name -> executor.service.v1.ExecutionStatus
-	16, // 2: executor.service.v1.ExecutionLog.started_at:type_name -> google.protobuf.Timestamp
-	16, // 3: executor.service.v1.ExecutionLog.completed_at:type_name -> google.protobuf.Timestamp
-	16, // 4: executor.service.v1.ExecutionLog.create_time:type_name -> google.protobuf.Timestamp
-	2,  // 5: executor.service.v1.TriggerExecutionResponse.execution:type_name -> executor.service.v1.ExecutionLog
-	2,  // 6: executor.service.v1.GetExecutionResponse.execution:type_name -> executor.service.v1.ExecutionLog
-	1,  // 7: executor.service.v1.ListExecutionsRequest.status:type_name -> executor.service.v1.ExecutionStatus
-	2,  // 8: executor.service.v1.ListExecutionsResponse.executions:type_name -> executor.service.v1.ExecutionLog
-	16, // 9: executor.service.v1.ConnectedClient.connected_at:type_name -> google.protobuf.Timestamp
-	14, // 10: executor.service.v1.ListConnectedClientsResponse.clients:type_name -> executor.service.v1.ConnectedClient
-	3,  // 11: executor.service.v1.ExecutorExecutionService.TriggerExecution:input_type -> executor.service.v1.TriggerExecutionRequest
-	5,  // 12: executor.service.v1.ExecutorExecutionService.GetExecution:input_type -> executor.service.v1.GetExecutionRequest
-	7,  // 13: executor.service.v1.ExecutorExecutionService.ListExecutions:input_type -> executor.service.v1.ListExecutionsRequest
-	9,  // 14: executor.service.v1.ExecutorExecutionService.GetExecutionOutput:input_type -> executor.service.v1.GetExecutionOutputRequest
-	11, // 15: executor.service.v1.ExecutorExecutionService.TriggerClientUpdate:input_type -> executor.service.v1.TriggerClientUpdateRequest
-	13, // 16: executor.service.v1.ExecutorExecutionService.ListConnectedClients:input_type -> executor.service.v1.ListConnectedClientsRequest
-	4,  // 17: executor.service.v1.ExecutorExecutionService.TriggerExecution:output_type -> executor.service.v1.TriggerExecutionResponse
-	6,  // 18: executor.service.v1.ExecutorExecutionService.GetExecution:output_type -> executor.service.v1.GetExecutionResponse
-	8,  // 19: executor.service.v1.ExecutorExecutionService.ListExecutions:output_type -> executor.service.v1.ListExecutionsResponse
-	10, // 20: executor.service.v1.ExecutorExecutionService.GetExecutionOutput:output_type -> executor.service.v1.GetExecutionOutputResponse
-	12, // 21: executor.service.v1.ExecutorExecutionService.TriggerClientUpdate:output_type -> executor.service.v1.TriggerClientUpdateResponse
-	15, // 22: executor.service.v1.ExecutorExecutionService.ListConnectedClients:output_type -> executor.service.v1.ListConnectedClientsResponse
-	17, // [17:23] is the sub-list for method output_type
-	11, // [11:17] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	1,  // 0: executor.service.v1.ExecutionEvent.type:type_name -> executor.service.v1.EventType
+	0,  // 1: executor.service.v1.ExecutionLog.trigger_type:type_name -> executor.service.v1.TriggerType
+	2,  // 2: executor.service.v1.ExecutionLog.status:type_name -> executor.service.v1.ExecutionStatus
+	43, // 3: executor.service.v1.ExecutionLog.started_at:type_name -> google.protobuf.Timestamp
+	43, // 4: executor.service.v1.ExecutionLog.completed_at:type_name -> google.protobuf.Timestamp
+	43, // 5: executor.service.v1.ExecutionLog.create_time:type_name -> google.protobuf.Timestamp
+	43, // 6: executor.service.v1.ExecutionLog.cancel_requested_at:type_name -> google.protobuf.Timestamp
+	5,  // 7: executor.service.v1.ExecutionLog.event:type_name -> executor.service.v1.ExecutionEvent
+	43, // 8: executor.service.v1.ExecutionLog.next_retry_at:type_name -> google.protobuf.Timestamp
+	43, // 9: executor.service.v1.ExecutionLog.held_until:type_name -> google.protobuf.Timestamp
+	41, // 10: executor.service.v1.ExecutionLog.parameters:type_name -> executor.service.v1.ExecutionLog.ParametersEntry
+	43, // 11: executor.service.v1.ExecutionLog.approval_expires_at:type_name -> google.protobuf.Timestamp
+	43, // 12: executor.service.v1.ExecutionLog.approve_time:type_name -> google.protobuf.Timestamp
+	3,  // 13: executor.service.v1.ExecutionRun.status:type_name -> executor.service.v1.RunStatus
+	7,  // 14: executor.service.v1.ExecutionRun.progress:type_name -> executor.service.v1.RunProgress
+	43, // 15: executor.service.v1.ExecutionRun.create_time:type_name -> google.protobuf.Timestamp
+	43, // 16: executor.service.v1.ExecutionRun.completed_at:type_name -> google.protobuf.Timestamp
+	8,  // 17: executor.service.v1.ExecutionRun.strategy:type_name -> executor.service.v1.RunStrategy
+	43, // 18: executor.service.v1.ExecutionRun.next_batch_at:type_name -> google.protobuf.Timestamp
+	4,  // 19: executor.service.v1.OutputChunk.stream:type_name -> executor.service.v1.OutputStream
+	43, // 20: executor.service.v1.OutputChunk.create_time:type_name -> google.protobuf.Timestamp
+	42, // 21: executor.service.v1.TriggerExecutionRequest.parameters:type_name -> executor.service.v1.TriggerExecutionRequest.ParametersEntry
+	6,  // 22: executor.service.v1.TriggerExecutionResponse.execution:type_name -> executor.service.v1.ExecutionLog
+	6,  // 23: executor.service.v1.GetExecutionResponse.execution:type_name -> executor.service.v1.ExecutionLog
+	44, // 24: executor.service.v1.GetExecutionResponse.script_version:type_name -> executor.service.v1.ScriptVersion
+	2,  // 25: executor.service.v1.ListExecutionsRequest.status:type_name -> executor.service.v1.ExecutionStatus
+	6,  // 26: executor.service.v1.ListExecutionsResponse.executions:type_name -> executor.service.v1.ExecutionLog
+	11, // 27: executor.service.v1.TailExecutionResponse.chunk:type_name -> executor.service.v1.OutputChunk
+	6,  // 28: executor.service.v1.TailExecutionResponse.finished:type_name -> executor.service.v1.ExecutionLog
+	8,  // 29: executor.service.v1.TriggerRunRequest.strategy:type_name -> executor.service.v1.RunStrategy
+	9,  // 30: executor.service.v1.TriggerRunResponse.run:type_name -> executor.service.v1.ExecutionRun
+	10, // 31: executor.service.v1.TriggerRunResponse.skipped:type_name -> executor.service.v1.SkippedTarget
+	9,  // 32: executor.service.v1.GetRunResponse.run:type_name -> executor.service.v1.ExecutionRun
+	3,  // 33: executor.service.v1.ListRunsRequest.status:type_name -> executor.service.v1.RunStatus
+	9,  // 34: executor.service.v1.ListRunsResponse.runs:type_name -> executor.service.v1.ExecutionRun
+	9,  // 35: executor.service.v1.PauseRunResponse.run:type_name -> executor.service.v1.ExecutionRun
+	9,  // 36: executor.service.v1.ResumeRunResponse.run:type_name -> executor.service.v1.ExecutionRun
+	9,  // 37: executor.service.v1.AbortRunResponse.run:type_name -> executor.service.v1.ExecutionRun
+	6,  // 38: executor.service.v1.CancelExecutionResponse.execution:type_name -> executor.service.v1.ExecutionLog
+	43, // 39: executor.service.v1.ConnectedClient.connected_at:type_name -> google.protobuf.Timestamp
+	43, // 40: executor.service.v1.ConnectedClient.last_seen_at:type_name -> google.protobuf.Timestamp
+	39, // 41: executor.service.v1.ListConnectedClientsResponse.clients:type_name -> executor.service.v1.ConnectedClient
+	12, // 42: executor.service.v1.ExecutorExecutionService.TriggerExecution:input_type -> executor.service.v1.TriggerExecutionRequest
+	14, // 43: executor.service.v1.ExecutorExecutionService.GetExecution:input_type -> executor.service.v1.GetExecutionRequest
+	16, // 44: executor.service.v1.ExecutorExecutionService.ListExecutions:input_type -> executor.service.v1.ListExecutionsRequest
+	18, // 45: executor.service.v1.ExecutorExecutionService.GetExecutionOutput:input_type -> executor.service.v1.GetExecutionOutputRequest
+	20, // 46: executor.service.v1.ExecutorExecutionService.TailExecution:input_type -> executor.service.v1.TailExecutionRequest
+	22, // 47: executor.service.v1.ExecutorExecutionService.TriggerRun:input_type -> executor.service.v1.TriggerRunRequest
+	24, // 48: executor.service.v1.ExecutorExecutionService.GetRun:input_type -> executor.service.v1.GetRunRequest
+	26, // 49: executor.service.v1.ExecutorExecutionService.ListRuns:input_type -> executor.service.v1.ListRunsRequest
+	28, // 50: executor.service.v1.ExecutorExecutionService.PauseRun:input_type -> executor.service.v1.PauseRunRequest
+	30, // 51: executor.service.v1.ExecutorExecutionService.ResumeRun:input_type -> executor.service.v1.ResumeRunRequest
+	32, // 52: executor.service.v1.ExecutorExecutionService.AbortRun:input_type -> executor.service.v1.AbortRunRequest
+	34, // 53: executor.service.v1.ExecutorExecutionService.CancelExecution:input_type -> executor.service.v1.CancelExecutionRequest
+	36, // 54: executor.service.v1.ExecutorExecutionService.TriggerClientUpdate:input_type -> executor.service.v1.TriggerClientUpdateRequest
+	38, // 55: executor.service.v1.ExecutorExecutionService.ListConnectedClients:input_type -> executor.service.v1.ListConnectedClientsRequest
+	13, // 56: executor.service.v1.ExecutorExecutionService.TriggerExecution:output_type -> executor.service.v1.TriggerExecutionResponse
+	15, // 57: executor.service.v1.ExecutorExecutionService.GetExecution:output_type -> executor.service.v1.GetExecutionResponse
+	17, // 58: executor.service.v1.ExecutorExecutionService.ListExecutions:output_type -> executor.service.v1.ListExecutionsResponse
+	19, // 59: executor.service.v1.ExecutorExecutionService.GetExecutionOutput:output_type -> executor.service.v1.GetExecutionOutputResponse
+	21, // 60: executor.service.v1.ExecutorExecutionService.TailExecution:output_type -> executor.service.v1.TailExecutionResponse
+	23, // 61: executor.service.v1.ExecutorExecutionService.TriggerRun:output_type -> executor.service.v1.TriggerRunResponse
+	25, // 62: executor.service.v1.ExecutorExecutionService.GetRun:output_type -> executor.service.v1.GetRunResponse
+	27, // 63: executor.service.v1.ExecutorExecutionService.ListRuns:output_type -> executor.service.v1.ListRunsResponse
+	29, // 64: executor.service.v1.ExecutorExecutionService.PauseRun:output_type -> executor.service.v1.PauseRunResponse
+	31, // 65: executor.service.v1.ExecutorExecutionService.ResumeRun:output_type -> executor.service.v1.ResumeRunResponse
+	33, // 66: executor.service.v1.ExecutorExecutionService.AbortRun:output_type -> executor.service.v1.AbortRunResponse
+	35, // 67: executor.service.v1.ExecutorExecutionService.CancelExecution:output_type -> executor.service.v1.CancelExecutionResponse
+	37, // 68: executor.service.v1.ExecutorExecutionService.TriggerClientUpdate:output_type -> executor.service.v1.TriggerClientUpdateResponse
+	40, // 69: executor.service.v1.ExecutorExecutionService.ListConnectedClients:output_type -> executor.service.v1.ListConnectedClientsResponse
+	56, // [56:70] is the sub-list for method output_type
+	42, // [42:56] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_executor_service_v1_execution_proto_init() }
@@ -1161,16 +3316,32 @@ func file_executor_service_v1_execution_proto_init() {
 	if File_executor_service_v1_execution_proto != nil {
 		return
 	}
+	file_executor_service_v1_script_version_proto_init()
 	file_executor_service_v1_execution_proto_msgTypes[0].OneofWrappers = []any{}
-	file_executor_service_v1_execution_proto_msgTypes[5].OneofWrappers = []any{}
-	file_executor_service_v1_execution_proto_msgTypes[8].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[1].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[3].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[4].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[7].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[10].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[11].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[14].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[15].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[16].OneofWrappers = []any{
+		(*TailExecutionResponse_Chunk)(nil),
+		(*TailExecutionResponse_Finished)(nil),
+	}
+	file_executor_service_v1_execution_proto_msgTypes[17].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[21].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[27].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[29].OneofWrappers = []any{}
+	file_executor_service_v1_execution_proto_msgTypes[34].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_executor_service_v1_execution_proto_rawDesc), len(file_executor_service_v1_execution_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   14,
+			NumEnums:      5,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -89,6 +89,91 @@ func (s *redactedExecutorExecutionServiceServer) GetExecutionOutput(ctx context.
 	return res, err
 }
 
+// TailExecution is the redacted wrapper for the actual ExecutorExecutionServiceServer.TailExecution method
+// Server streaming
+func (s *redactedExecutorExecutionServiceServer) TailExecution(in *TailExecutionRequest, stream grpc.ServerStreamingServer[TailExecutionResponse]) error {
+	// Note: Redaction for server streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.TailExecution(in, stream)
+}
+
+// TriggerRun is the redacted wrapper for the actual ExecutorExecutionServiceServer.TriggerRun method
+// Unary RPC
+func (s *redactedExecutorExecutionServiceServer) TriggerRun(ctx context.Context, in *TriggerRunRequest) (*TriggerRunResponse, error) {
+	res, err := s.srv.TriggerRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetRun is the redacted wrapper for the actual ExecutorExecutionServiceServer.GetRun method
+// Unary RPC
+func (s *redactedExecutorExecutionServiceServer) GetRun(ctx context.Context, in *GetRunRequest) (*GetRunResponse, error) {
+	res, err := s.srv.GetRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListRuns is the redacted wrapper for the actual ExecutorExecutionServiceServer.ListRuns method
+// Unary RPC
+func (s *redactedExecutorExecutionServiceServer) ListRuns(ctx context.Context, in *ListRunsRequest) (*ListRunsResponse, error) {
+	res, err := s.srv.ListRuns(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PauseRun is the redacted wrapper for the actual ExecutorExecutionServiceServer.PauseRun method
+// Unary RPC
+func (s *redactedExecutorExecutionServiceServer) PauseRun(ctx context.Context, in *PauseRunRequest) (*PauseRunResponse, error) {
+	res, err := s.srv.PauseRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ResumeRun is the redacted wrapper for the actual ExecutorExecutionServiceServer.ResumeRun method
+// Unary RPC
+func (s *redactedExecutorExecutionServiceServer) ResumeRun(ctx context.Context, in *ResumeRunRequest) (*ResumeRunResponse, error) {
+	res, err := s.srv.ResumeRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// AbortRun is the redacted wrapper for the actual ExecutorExecutionServiceServer.AbortRun method
+// Unary RPC
+func (s *redactedExecutorExecutionServiceServer) AbortRun(ctx context.Context, in *AbortRunRequest) (*AbortRunResponse, error) {
+	res, err := s.srv.AbortRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// CancelExecution is the redacted wrapper for the actual ExecutorExecutionServiceServer.CancelExecution method
+// Unary RPC
+func (s *redactedExecutorExecutionServiceServer) CancelExecution(ctx context.Context, in *CancelExecutionRequest) (*CancelExecutionResponse, error) {
+	res, err := s.srv.CancelExecution(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // TriggerClientUpdate is the redacted wrapper for the actual ExecutorExecutionServiceServer.TriggerClientUpdate method
 // Unary RPC
 func (s *redactedExecutorExecutionServiceServer) TriggerClientUpdate(ctx context.Context, in *TriggerClientUpdateRequest) (*TriggerClientUpdateResponse, error) {
@@ -111,6 +196,22 @@ func (s *redactedExecutorExecutionServiceServer) ListConnectedClients(ctx contex
 	return res, err
 }
 
+// Redact method implementation for ExecutionEvent
+func (x *ExecutionEvent) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Type
+
+	// Safe field: RuleId
+
+	// Safe field: Detail
+
+	// Safe field: SourceExecutionId
+	return x.String()
+}
+
 // Redact method implementation for ExecutionLog
 func (x *ExecutionLog) Redact() string {
 	if x == nil {
@@ -154,6 +255,159 @@ func (x *ExecutionLog) Redact() string {
 
 	// Safe field: CreatedBy
 
+	// Safe field: CreateTime
+
+	// Safe field: CancelRequestedAt
+
+	// Safe field: CancelledBy
+
+	// Safe field: CancelReason
+
+	// Safe field: RunId
+
+	// Safe field: Event
+
+	// Safe field: WorkflowRunId
+
+	// Safe field: WorkflowStepId
+
+	// Safe field: Attempt
+
+	// Safe field: OriginalExecutionId
+
+	// Safe field: NextRetryAt
+
+	// Safe field: RetriedByExecutionId
+
+	// Safe field: WaitingForSlot
+
+	// Safe field: HeldUntil
+
+	// Safe field: MaintenanceOverride
+
+	// Safe field: Parameters
+
+	// Safe field: SecretNames
+
+	// Safe field: ApprovalExpiresAt
+
+	// Safe field: ApprovedBy
+
+	// Safe field: ApproveTime
+
+	// Safe field: ApprovalComment
+
+	// Safe field: TimeoutReason
+	return x.String()
+}
+
+// Redact method implementation for RunProgress
+func (x *RunProgress) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Total
+
+	// Safe field: Pending
+
+	// Safe field: Running
+
+	// Safe field: Completed
+
+	// Safe field: Failed
+
+	// Safe field: Cancelled
+	return x.String()
+}
+
+// Redact method implementation for RunStrategy
+func (x *RunStrategy) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: BatchSize
+
+	// Safe field: CanarySize
+
+	// Safe field: BatchPauseSeconds
+
+	// Safe field: MaxFailures
+	return x.String()
+}
+
+// Redact method implementation for ExecutionRun
+func (x *ExecutionRun) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: ScriptId
+
+	// Safe field: ScriptName
+
+	// Safe field: Selector
+
+	// Safe field: ClientIds
+
+	// Safe field: Status
+
+	// Safe field: Progress
+
+	// Safe field: CreatedBy
+
+	// Safe field: CreateTime
+
+	// Safe field: CompletedAt
+
+	// Safe field: Strategy
+
+	// Safe field: TargetCount
+
+	// Safe field: Dispatched
+
+	// Safe field: CurrentBatch
+
+	// Safe field: NextBatchAt
+
+	// Safe field: HaltReason
+
+	// Safe field: ScheduleId
+	return x.String()
+}
+
+// Redact method implementation for SkippedTarget
+func (x *SkippedTarget) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ClientId
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for OutputChunk
+func (x *OutputChunk) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ExecutionId
+
+	// Safe field: Seq
+
+	// Safe field: Stream
+
+	// Redacting field: Data
+	x.Data = ``
+
 	// Safe field: CreateTime
 	return x.String()
 }
@@ -167,6 +421,13 @@ func (x *TriggerExecutionRequest) Redact() string {
 	// Safe field: ScriptId
 
 	// Safe field: ClientId
+
+	// Safe field: OverrideMaintenanceWindow
+
+	// Safe field: OverrideJustification
+
+	// Redacting field: Parameters
+	x.Parameters = map[string]string{}
 	return x.String()
 }
 
@@ -177,6 +438,8 @@ func (x *TriggerExecutionResponse) Redact() string {
 	}
 
 	// Safe field: Execution
+
+	// Safe field: Queued
 	return x.String()
 }
 
@@ -197,6 +460,8 @@ func (x *GetExecutionResponse) Redact() string {
 	}
 
 	// Safe field: Execution
+
+	// Safe field: ScriptVersion
 	return x.String()
 }
 
@@ -215,6 +480,10 @@ func (x *ListExecutionsRequest) Redact() string {
 	// Safe field: ClientId
 
 	// Safe field: Status
+
+	// Safe field: RunId
+
+	// Safe field: OriginalExecutionId
 	return x.String()
 }
 
@@ -256,6 +525,194 @@ func (x *GetExecutionOutputResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for TailExecutionRequest
+func (x *TailExecutionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: AfterSeq
+	return x.String()
+}
+
+// Redact method implementation for TailExecutionResponse
+func (x *TailExecutionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Chunk
+
+	// Safe field: Finished
+	return x.String()
+}
+
+// Redact method implementation for TriggerRunRequest
+func (x *TriggerRunRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ScriptId
+
+	// Safe field: ClientIds
+
+	// Safe field: Selector
+
+	// Safe field: Strategy
+	return x.String()
+}
+
+// Redact method implementation for TriggerRunResponse
+func (x *TriggerRunResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Run
+
+	// Safe field: Skipped
+	return x.String()
+}
+
+// Redact method implementation for GetRunRequest
+func (x *GetRunRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for GetRunResponse
+func (x *GetRunResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Run
+	return x.String()
+}
+
+// Redact method implementation for ListRunsRequest
+func (x *ListRunsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+
+	// Safe field: ScriptId
+
+	// Safe field: Status
+	return x.String()
+}
+
+// Redact method implementation for ListRunsResponse
+func (x *ListRunsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Runs
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for PauseRunRequest
+func (x *PauseRunRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for PauseRunResponse
+func (x *PauseRunResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Run
+	return x.String()
+}
+
+// Redact method implementation for ResumeRunRequest
+func (x *ResumeRunRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for ResumeRunResponse
+func (x *ResumeRunResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Run
+	return x.String()
+}
+
+// Redact method implementation for AbortRunRequest
+func (x *AbortRunRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for AbortRunResponse
+func (x *AbortRunResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Run
+
+	// Safe field: Cancelled
+	return x.String()
+}
+
+// Redact method implementation for CancelExecutionRequest
+func (x *CancelExecutionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for CancelExecutionResponse
+func (x *CancelExecutionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Execution
+
+	// Safe field: ClientNotified
+	return x.String()
+}
+
 // Redact method implementation for TriggerClientUpdateRequest
 func (x *TriggerClientUpdateRequest) Redact() string {
 	if x == nil {
@@ -299,6 +756,16 @@ func (x *ConnectedClient) Redact() string {
 	// Safe field: ClientVersion
 
 	// Safe field: ConnectedAt
+
+	// Safe field: LastSeenAt
+
+	// Safe field: Load1
+
+	// Safe field: Load5
+
+	// Safe field: Load15
+
+	// Safe field: UptimeSeconds
 	return x.String()
 }
 
@@ -35,6 +35,118 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on ExecutionEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecutionEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecutionEventMultiError,
+// or nil if none found.
+func (m *ExecutionEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for RuleId
+
+	if m.Detail != nil {
+		// no validation rules for Detail
+	}
+
+	if m.SourceExecutionId != nil {
+		// no validation rules for SourceExecutionId
+	}
+
+	if len(errors) > 0 {
+		return ExecutionEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionEventMultiError is an error wrapping multiple validation errors
+// returned by ExecutionEvent.ValidateAll() if the designated constraints
+// aren't met.
+type ExecutionEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionEventMultiError) AllErrors() []error { return m }
+
+// ExecutionEventValidationError is the validation error returned by
+// ExecutionEvent.Validate if the designated constraints aren't met.
+type ExecutionEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionEventValidationError) ErrorName() string { return "ExecutionEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecutionEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionEventValidationError{}
+
 // Validate checks the field values on ExecutionLog with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -102,80 +214,3162 @@ func (m *ExecutionLog) validate(all bool) error {
 		}
 	}
 
-	if m.ExitCode != nil {
-		// no validation rules for ExitCode
-	}
+	// no validation rules for Attempt
+
+	// no validation rules for WaitingForSlot
+
+	// no validation rules for Parameters
+
+	if m.ExitCode != nil {
+		// no validation rules for ExitCode
+	}
+
+	if m.Output != nil {
+		// no validation rules for Output
+	}
+
+	if m.ErrorOutput != nil {
+		// no validation rules for ErrorOutput
+	}
+
+	if m.RejectionReason != nil {
+		// no validation rules for RejectionReason
+	}
+
+	if m.StartedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "StartedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "StartedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionLogValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CompletedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCompletedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionLogValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DurationMs != nil {
+		// no validation rules for DurationMs
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.CancelRequestedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCancelRequestedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "CancelRequestedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "CancelRequestedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCancelRequestedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionLogValidationError{
+					field:  "CancelRequestedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CancelledBy != nil {
+		// no validation rules for CancelledBy
+	}
+
+	if m.CancelReason != nil {
+		// no validation rules for CancelReason
+	}
+
+	if m.RunId != nil {
+		// no validation rules for RunId
+	}
+
+	if m.Event != nil {
+
+		if all {
+			switch v := interface{}(m.GetEvent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "Event",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "Event",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionLogValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.WorkflowRunId != nil {
+		// no validation rules for WorkflowRunId
+	}
+
+	if m.WorkflowStepId != nil {
+		// no validation rules for WorkflowStepId
+	}
+
+	if m.OriginalExecutionId != nil {
+		// no validation rules for OriginalExecutionId
+	}
+
+	if m.NextRetryAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetNextRetryAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "NextRetryAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "NextRetryAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNextRetryAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionLogValidationError{
+					field:  "NextRetryAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RetriedByExecutionId != nil {
+		// no validation rules for RetriedByExecutionId
+	}
+
+	if m.HeldUntil != nil {
+
+		if all {
+			switch v := interface{}(m.GetHeldUntil()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "HeldUntil",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "HeldUntil",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHeldUntil()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionLogValidationError{
+					field:  "HeldUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.MaintenanceOverride != nil {
+		// no validation rules for MaintenanceOverride
+	}
+
+	if m.ApprovalExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetApprovalExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "ApprovalExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "ApprovalExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetApprovalExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionLogValidationError{
+					field:  "ApprovalExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ApprovedBy != nil {
+		// no validation rules for ApprovedBy
+	}
+
+	if m.ApproveTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetApproveTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "ApproveTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionLogValidationError{
+						field:  "ApproveTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetApproveTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionLogValidationError{
+					field:  "ApproveTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ApprovalComment != nil {
+		// no validation rules for ApprovalComment
+	}
+
+	if m.TimeoutReason != nil {
+		// no validation rules for TimeoutReason
+	}
+
+	if len(errors) > 0 {
+		return ExecutionLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionLogMultiError is an error wrapping multiple validation errors
+// returned by ExecutionLog.ValidateAll() if the designated constraints aren't met.
+type ExecutionLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionLogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionLogMultiError) AllErrors() []error { return m }
+
+// ExecutionLogValidationError is the validation error returned by
+// ExecutionLog.Validate if the designated constraints aren't met.
+type ExecutionLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionLogValidationError) ErrorName() string { return "ExecutionLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecutionLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionLogValidationError{}
+
+// Validate checks the field values on RunProgress with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RunProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RunProgress with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RunProgressMultiError, or
+// nil if none found.
+func (m *RunProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RunProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Pending
+
+	// no validation rules for Running
+
+	// no validation rules for Completed
+
+	// no validation rules for Failed
+
+	// no validation rules for Cancelled
+
+	if len(errors) > 0 {
+		return RunProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunProgressMultiError is an error wrapping multiple validation errors
+// returned by RunProgress.ValidateAll() if the designated constraints aren't met.
+type RunProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunProgressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunProgressMultiError) AllErrors() []error { return m }
+
+// RunProgressValidationError is the validation error returned by
+// RunProgress.Validate if the designated constraints aren't met.
+type RunProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunProgressValidationError) ErrorName() string { return "RunProgressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunProgressValidationError{}
+
+// Validate checks the field values on RunStrategy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RunStrategy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RunStrategy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RunStrategyMultiError, or
+// nil if none found.
+func (m *RunStrategy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RunStrategy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchSize
+
+	// no validation rules for CanarySize
+
+	// no validation rules for BatchPauseSeconds
+
+	if m.MaxFailures != nil {
+		// no validation rules for MaxFailures
+	}
+
+	if len(errors) > 0 {
+		return RunStrategyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunStrategyMultiError is an error wrapping multiple validation errors
+// returned by RunStrategy.ValidateAll() if the designated constraints aren't met.
+type RunStrategyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunStrategyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunStrategyMultiError) AllErrors() []error { return m }
+
+// RunStrategyValidationError is the validation error returned by
+// RunStrategy.Validate if the designated constraints aren't met.
+type RunStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunStrategyValidationError) ErrorName() string { return "RunStrategyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunStrategyValidationError{}
+
+// Validate checks the field values on ExecutionRun with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecutionRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionRun with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecutionRunMultiError, or
+// nil if none found.
+func (m *ExecutionRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for ScriptId
+
+	// no validation rules for ScriptName
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetProgress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionRunValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionRunValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionRunValidationError{
+				field:  "Progress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionRunValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionRunValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionRunValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStrategy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionRunValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionRunValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStrategy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionRunValidationError{
+				field:  "Strategy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TargetCount
+
+	// no validation rules for Dispatched
+
+	// no validation rules for CurrentBatch
+
+	if m.Selector != nil {
+		// no validation rules for Selector
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.CompletedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCompletedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionRunValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionRunValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionRunValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextBatchAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetNextBatchAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecutionRunValidationError{
+						field:  "NextBatchAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecutionRunValidationError{
+						field:  "NextBatchAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNextBatchAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecutionRunValidationError{
+					field:  "NextBatchAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.HaltReason != nil {
+		// no validation rules for HaltReason
+	}
+
+	if m.ScheduleId != nil {
+		// no validation rules for ScheduleId
+	}
+
+	if len(errors) > 0 {
+		return ExecutionRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionRunMultiError is an error wrapping multiple validation errors
+// returned by ExecutionRun.ValidateAll() if the designated constraints aren't met.
+type ExecutionRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionRunMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionRunMultiError) AllErrors() []error { return m }
+
+// ExecutionRunValidationError is the validation error returned by
+// ExecutionRun.Validate if the designated constraints aren't met.
+type ExecutionRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionRunValidationError) ErrorName() string { return "ExecutionRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecutionRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionRunValidationError{}
+
+// Validate checks the field values on SkippedTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SkippedTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkippedTarget with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SkippedTargetMultiError, or
+// nil if none found.
+func (m *SkippedTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkippedTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return SkippedTargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkippedTargetMultiError is an error wrapping multiple validation errors
+// returned by SkippedTarget.ValidateAll() if the designated constraints
+// aren't met.
+type SkippedTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkippedTargetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkippedTargetMultiError) AllErrors() []error { return m }
+
+// SkippedTargetValidationError is the validation error returned by
+// SkippedTarget.Validate if the designated constraints aren't met.
+type SkippedTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkippedTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkippedTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkippedTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkippedTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkippedTargetValidationError) ErrorName() string { return "SkippedTargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkippedTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkippedTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkippedTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkippedTargetValidationError{}
+
+// Validate checks the field values on OutputChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutputChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutputChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutputChunkMultiError, or
+// nil if none found.
+func (m *OutputChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutputChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExecutionId
+
+	// no validation rules for Seq
+
+	// no validation rules for Stream
+
+	// no validation rules for Data
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutputChunkValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutputChunkValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutputChunkValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OutputChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutputChunkMultiError is an error wrapping multiple validation errors
+// returned by OutputChunk.ValidateAll() if the designated constraints aren't met.
+type OutputChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutputChunkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutputChunkMultiError) AllErrors() []error { return m }
+
+// OutputChunkValidationError is the validation error returned by
+// OutputChunk.Validate if the designated constraints aren't met.
+type OutputChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutputChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutputChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutputChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutputChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutputChunkValidationError) ErrorName() string { return "OutputChunkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutputChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutputChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutputChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutputChunkValidationError{}
+
+// Validate checks the field values on TriggerExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerExecutionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerExecutionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerExecutionRequestMultiError, or nil if none found.
+func (m *TriggerExecutionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerExecutionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScriptId
+
+	// no validation rules for ClientId
+
+	// no validation rules for Parameters
+
+	if m.OverrideMaintenanceWindow != nil {
+		// no validation rules for OverrideMaintenanceWindow
+	}
+
+	if m.OverrideJustification != nil {
+		// no validation rules for OverrideJustification
+	}
+
+	if len(errors) > 0 {
+		return TriggerExecutionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerExecutionRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerExecutionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerExecutionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerExecutionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerExecutionRequestMultiError) AllErrors() []error { return m }
+
+// TriggerExecutionRequestValidationError is the validation error returned by
+// TriggerExecutionRequest.Validate if the designated constraints aren't met.
+type TriggerExecutionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerExecutionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerExecutionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerExecutionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerExecutionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerExecutionRequestValidationError) ErrorName() string {
+	return "TriggerExecutionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerExecutionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerExecutionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerExecutionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerExecutionRequestValidationError{}
+
+// Validate checks the field values on TriggerExecutionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerExecutionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerExecutionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerExecutionResponseMultiError, or nil if none found.
+func (m *TriggerExecutionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerExecutionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExecution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerExecutionResponseValidationError{
+					field:  "Execution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerExecutionResponseValidationError{
+					field:  "Execution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerExecutionResponseValidationError{
+				field:  "Execution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Queued
+
+	if len(errors) > 0 {
+		return TriggerExecutionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerExecutionResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerExecutionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerExecutionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerExecutionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerExecutionResponseMultiError) AllErrors() []error { return m }
+
+// TriggerExecutionResponseValidationError is the validation error returned by
+// TriggerExecutionResponse.Validate if the designated constraints aren't met.
+type TriggerExecutionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerExecutionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerExecutionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerExecutionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerExecutionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerExecutionResponseValidationError) ErrorName() string {
+	return "TriggerExecutionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerExecutionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerExecutionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerExecutionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerExecutionResponseValidationError{}
+
+// Validate checks the field values on GetExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExecutionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExecutionRequestMultiError, or nil if none found.
+func (m *GetExecutionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExecutionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetExecutionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExecutionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetExecutionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetExecutionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExecutionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExecutionRequestMultiError) AllErrors() []error { return m }
+
+// GetExecutionRequestValidationError is the validation error returned by
+// GetExecutionRequest.Validate if the designated constraints aren't met.
+type GetExecutionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExecutionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExecutionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExecutionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExecutionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExecutionRequestValidationError) ErrorName() string {
+	return "GetExecutionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExecutionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExecutionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExecutionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExecutionRequestValidationError{}
+
+// Validate checks the field values on GetExecutionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExecutionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExecutionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExecutionResponseMultiError, or nil if none found.
+func (m *GetExecutionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExecutionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExecution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExecutionResponseValidationError{
+					field:  "Execution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExecutionResponseValidationError{
+					field:  "Execution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExecutionResponseValidationError{
+				field:  "Execution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ScriptVersion != nil {
+
+		if all {
+			switch v := interface{}(m.GetScriptVersion()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetExecutionResponseValidationError{
+						field:  "ScriptVersion",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetExecutionResponseValidationError{
+						field:  "ScriptVersion",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetScriptVersion()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetExecutionResponseValidationError{
+					field:  "ScriptVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetExecutionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExecutionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetExecutionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetExecutionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExecutionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExecutionResponseMultiError) AllErrors() []error { return m }
+
+// GetExecutionResponseValidationError is the validation error returned by
+// GetExecutionResponse.Validate if the designated constraints aren't met.
+type GetExecutionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExecutionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExecutionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExecutionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExecutionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExecutionResponseValidationError) ErrorName() string {
+	return "GetExecutionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExecutionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExecutionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExecutionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExecutionResponseValidationError{}
+
+// Validate checks the field values on ListExecutionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListExecutionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListExecutionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExecutionsRequestMultiError, or nil if none found.
+func (m *ListExecutionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListExecutionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.ScriptId != nil {
+		// no validation rules for ScriptId
+	}
+
+	if m.ClientId != nil {
+		// no validation rules for ClientId
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.RunId != nil {
+		// no validation rules for RunId
+	}
+
+	if m.OriginalExecutionId != nil {
+		// no validation rules for OriginalExecutionId
+	}
+
+	if len(errors) > 0 {
+		return ListExecutionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListExecutionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListExecutionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListExecutionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListExecutionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListExecutionsRequestMultiError) AllErrors() []error { return m }
+
+// ListExecutionsRequestValidationError is the validation error returned by
+// ListExecutionsRequest.Validate if the designated constraints aren't met.
+type ListExecutionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExecutionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExecutionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExecutionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExecutionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExecutionsRequestValidationError) ErrorName() string {
+	return "ListExecutionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExecutionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExecutionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExecutionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExecutionsRequestValidationError{}
+
+// Validate checks the field values on ListExecutionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListExecutionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListExecutionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExecutionsResponseMultiError, or nil if none found.
+func (m *ListExecutionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListExecutionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetExecutions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListExecutionsResponseValidationError{
+						field:  fmt.Sprintf("Executions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListExecutionsResponseValidationError{
+						field:  fmt.Sprintf("Executions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExecutionsResponseValidationError{
+					field:  fmt.Sprintf("Executions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListExecutionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListExecutionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListExecutionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListExecutionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListExecutionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListExecutionsResponseMultiError) AllErrors() []error { return m }
+
+// ListExecutionsResponseValidationError is the validation error returned by
+// ListExecutionsResponse.Validate if the designated constraints aren't met.
+type ListExecutionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExecutionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExecutionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExecutionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExecutionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExecutionsResponseValidationError) ErrorName() string {
+	return "ListExecutionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExecutionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExecutionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExecutionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExecutionsResponseValidationError{}
+
+// Validate checks the field values on GetExecutionOutputRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExecutionOutputRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExecutionOutputRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExecutionOutputRequestMultiError, or nil if none found.
+func (m *GetExecutionOutputRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExecutionOutputRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetExecutionOutputRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExecutionOutputRequestMultiError is an error wrapping multiple validation
+// errors returned by GetExecutionOutputRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetExecutionOutputRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExecutionOutputRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExecutionOutputRequestMultiError) AllErrors() []error { return m }
+
+// GetExecutionOutputRequestValidationError is the validation error returned by
+// GetExecutionOutputRequest.Validate if the designated constraints aren't met.
+type GetExecutionOutputRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExecutionOutputRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExecutionOutputRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExecutionOutputRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExecutionOutputRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExecutionOutputRequestValidationError) ErrorName() string {
+	return "GetExecutionOutputRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExecutionOutputRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExecutionOutputRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExecutionOutputRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExecutionOutputRequestValidationError{}
+
+// Validate checks the field values on GetExecutionOutputResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExecutionOutputResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExecutionOutputResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExecutionOutputResponseMultiError, or nil if none found.
+func (m *GetExecutionOutputResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExecutionOutputResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Output
+
+	// no validation rules for ErrorOutput
+
+	if m.ExitCode != nil {
+		// no validation rules for ExitCode
+	}
+
+	if len(errors) > 0 {
+		return GetExecutionOutputResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExecutionOutputResponseMultiError is an error wrapping multiple
+// validation errors returned by GetExecutionOutputResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetExecutionOutputResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExecutionOutputResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExecutionOutputResponseMultiError) AllErrors() []error { return m }
+
+// GetExecutionOutputResponseValidationError is the validation error returned
+// by GetExecutionOutputResponse.Validate if the designated constraints aren't met.
+type GetExecutionOutputResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExecutionOutputResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExecutionOutputResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExecutionOutputResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExecutionOutputResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExecutionOutputResponseValidationError) ErrorName() string {
+	return "GetExecutionOutputResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExecutionOutputResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExecutionOutputResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExecutionOutputResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExecutionOutputResponseValidationError{}
+
+// Validate checks the field values on TailExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TailExecutionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TailExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TailExecutionRequestMultiError, or nil if none found.
+func (m *TailExecutionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TailExecutionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.AfterSeq != nil {
+		// no validation rules for AfterSeq
+	}
+
+	if len(errors) > 0 {
+		return TailExecutionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TailExecutionRequestMultiError is an error wrapping multiple validation
+// errors returned by TailExecutionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TailExecutionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TailExecutionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TailExecutionRequestMultiError) AllErrors() []error { return m }
+
+// TailExecutionRequestValidationError is the validation error returned by
+// TailExecutionRequest.Validate if the designated constraints aren't met.
+type TailExecutionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TailExecutionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TailExecutionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TailExecutionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TailExecutionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TailExecutionRequestValidationError) ErrorName() string {
+	return "TailExecutionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TailExecutionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTailExecutionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TailExecutionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TailExecutionRequestValidationError{}
+
+// Validate checks the field values on TailExecutionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TailExecutionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TailExecutionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TailExecutionResponseMultiError, or nil if none found.
+func (m *TailExecutionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TailExecutionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Event.(type) {
+	case *TailExecutionResponse_Chunk:
+		if v == nil {
+			err := TailExecutionResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetChunk()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TailExecutionResponseValidationError{
+						field:  "Chunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TailExecutionResponseValidationError{
+						field:  "Chunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChunk()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TailExecutionResponseValidationError{
+					field:  "Chunk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TailExecutionResponse_Finished:
+		if v == nil {
+			err := TailExecutionResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFinished()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TailExecutionResponseValidationError{
+						field:  "Finished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TailExecutionResponseValidationError{
+						field:  "Finished",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFinished()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TailExecutionResponseValidationError{
+					field:  "Finished",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return TailExecutionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TailExecutionResponseMultiError is an error wrapping multiple validation
+// errors returned by TailExecutionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TailExecutionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TailExecutionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TailExecutionResponseMultiError) AllErrors() []error { return m }
+
+// TailExecutionResponseValidationError is the validation error returned by
+// TailExecutionResponse.Validate if the designated constraints aren't met.
+type TailExecutionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TailExecutionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TailExecutionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TailExecutionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TailExecutionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TailExecutionResponseValidationError) ErrorName() string {
+	return "TailExecutionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TailExecutionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTailExecutionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TailExecutionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TailExecutionResponseValidationError{}
+
+// Validate checks the field values on TriggerRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerRunRequestMultiError, or nil if none found.
+func (m *TriggerRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScriptId
+
+	if m.Selector != nil {
+		// no validation rules for Selector
+	}
+
+	if m.Strategy != nil {
+
+		if all {
+			switch v := interface{}(m.GetStrategy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerRunRequestValidationError{
+						field:  "Strategy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerRunRequestValidationError{
+						field:  "Strategy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStrategy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerRunRequestValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TriggerRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerRunRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerRunRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerRunRequestMultiError) AllErrors() []error { return m }
+
+// TriggerRunRequestValidationError is the validation error returned by
+// TriggerRunRequest.Validate if the designated constraints aren't met.
+type TriggerRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerRunRequestValidationError) ErrorName() string {
+	return "TriggerRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerRunRequestValidationError{}
+
+// Validate checks the field values on TriggerRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerRunResponseMultiError, or nil if none found.
+func (m *TriggerRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerRunResponseValidationError{
+				field:  "Run",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSkipped() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerRunResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerRunResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerRunResponseValidationError{
+					field:  fmt.Sprintf("Skipped[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TriggerRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerRunResponseMultiError is an error wrapping multiple validation errors
+// returned by TriggerRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerRunResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerRunResponseMultiError) AllErrors() []error { return m }
+
+// TriggerRunResponseValidationError is the validation error returned by
+// TriggerRunResponse.Validate if the designated constraints aren't met.
+type TriggerRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerRunResponseValidationError) ErrorName() string {
+	return "TriggerRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerRunResponseValidationError{}
+
+// Validate checks the field values on GetRunRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRunRequestMultiError, or
+// nil if none found.
+func (m *GetRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRunRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRunRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRunRequestMultiError) AllErrors() []error { return m }
+
+// GetRunRequestValidationError is the validation error returned by
+// GetRunRequest.Validate if the designated constraints aren't met.
+type GetRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRunRequestValidationError) ErrorName() string { return "GetRunRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRunRequestValidationError{}
+
+// Validate checks the field values on GetRunResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRunResponseMultiError,
+// or nil if none found.
+func (m *GetRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRunResponseValidationError{
+				field:  "Run",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRunResponseMultiError is an error wrapping multiple validation errors
+// returned by GetRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRunResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRunResponseMultiError) AllErrors() []error { return m }
+
+// GetRunResponseValidationError is the validation error returned by
+// GetRunResponse.Validate if the designated constraints aren't met.
+type GetRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRunResponseValidationError) ErrorName() string { return "GetRunResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRunResponseValidationError{}
+
+// Validate checks the field values on ListRunsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRunsRequestMultiError, or nil if none found.
+func (m *ListRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.ScriptId != nil {
+		// no validation rules for ScriptId
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if len(errors) > 0 {
+		return ListRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRunsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRunsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRunsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRunsRequestMultiError) AllErrors() []error { return m }
+
+// ListRunsRequestValidationError is the validation error returned by
+// ListRunsRequest.Validate if the designated constraints aren't met.
+type ListRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRunsRequestValidationError) Field() string { return e.field }
 
-	if m.Output != nil {
-		// no validation rules for Output
-	}
+// Reason function returns reason value.
+func (e ListRunsRequestValidationError) Reason() string { return e.reason }
 
-	if m.ErrorOutput != nil {
-		// no validation rules for ErrorOutput
+// Cause function returns cause value.
+func (e ListRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRunsRequestValidationError) ErrorName() string { return "ListRunsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if m.RejectionReason != nil {
-		// no validation rules for RejectionReason
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if m.StartedAt != nil {
+	return fmt.Sprintf(
+		"invalid %sListRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-		if all {
-			switch v := interface{}(m.GetStartedAt()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExecutionLogValidationError{
-						field:  "StartedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ExecutionLogValidationError{
-						field:  "StartedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ExecutionLogValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+var _ error = ListRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRunsRequestValidationError{}
+
+// Validate checks the field values on ListRunsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRunsResponse) Validate() error {
+	return m.validate(false)
+}
 
+// ValidateAll checks the field values on ListRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRunsResponseMultiError, or nil if none found.
+func (m *ListRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if m.CompletedAt != nil {
+	var errors []error
+
+	for idx, item := range m.GetRuns() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetCompletedAt()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExecutionLogValidationError{
-						field:  "CompletedAt",
+					errors = append(errors, ListRunsResponseValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ExecutionLogValidationError{
-						field:  "CompletedAt",
+					errors = append(errors, ListRunsResponseValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ExecutionLogValidationError{
-					field:  "CompletedAt",
+				return ListRunsResponseValidationError{
+					field:  fmt.Sprintf("Runs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -184,27 +3378,22 @@ func (m *ExecutionLog) validate(all bool) error {
 
 	}
 
-	if m.DurationMs != nil {
-		// no validation rules for DurationMs
-	}
-
-	if m.CreatedBy != nil {
-		// no validation rules for CreatedBy
-	}
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return ExecutionLogMultiError(errors)
+		return ListRunsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExecutionLogMultiError is an error wrapping multiple validation errors
-// returned by ExecutionLog.ValidateAll() if the designated constraints aren't met.
-type ExecutionLogMultiError []error
+// ListRunsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRunsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRunsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExecutionLogMultiError) Error() string {
+func (m ListRunsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -213,11 +3402,11 @@ func (m ExecutionLogMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExecutionLogMultiError) AllErrors() []error { return m }
+func (m ListRunsResponseMultiError) AllErrors() []error { return m }
 
-// ExecutionLogValidationError is the validation error returned by
-// ExecutionLog.Validate if the designated constraints aren't met.
-type ExecutionLogValidationError struct {
+// ListRunsResponseValidationError is the validation error returned by
+// ListRunsResponse.Validate if the designated constraints aren't met.
+type ListRunsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -225,22 +3414,22 @@ type ExecutionLogValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExecutionLogValidationError) Field() string { return e.field }
+func (e ListRunsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExecutionLogValidationError) Reason() string { return e.reason }
+func (e ListRunsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExecutionLogValidationError) Cause() error { return e.cause }
+func (e ListRunsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExecutionLogValidationError) Key() bool { return e.key }
+func (e ListRunsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExecutionLogValidationError) ErrorName() string { return "ExecutionLogValidationError" }
+func (e ListRunsResponseValidationError) ErrorName() string { return "ListRunsResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExecutionLogValidationError) Error() string {
+func (e ListRunsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -252,14 +3441,14 @@ func (e ExecutionLogValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExecutionLog.%s: %s%s",
+		"invalid %sListRunsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExecutionLogValidationError{}
+var _ error = ListRunsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -267,48 +3456,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExecutionLogValidationError{}
+} = ListRunsResponseValidationError{}
 
-// Validate checks the field values on TriggerExecutionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerExecutionRequest) Validate() error {
+// Validate checks the field values on PauseRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerExecutionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PauseRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerExecutionRequestMultiError, or nil if none found.
-func (m *TriggerExecutionRequest) ValidateAll() error {
+// PauseRunRequestMultiError, or nil if none found.
+func (m *PauseRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerExecutionRequest) validate(all bool) error {
+func (m *PauseRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ScriptId
-
-	// no validation rules for ClientId
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return TriggerExecutionRequestMultiError(errors)
+		return PauseRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerExecutionRequestMultiError is an error wrapping multiple validation
-// errors returned by TriggerExecutionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TriggerExecutionRequestMultiError []error
+// PauseRunRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerExecutionRequestMultiError) Error() string {
+func (m PauseRunRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -317,11 +3504,11 @@ func (m TriggerExecutionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerExecutionRequestMultiError) AllErrors() []error { return m }
+func (m PauseRunRequestMultiError) AllErrors() []error { return m }
 
-// TriggerExecutionRequestValidationError is the validation error returned by
-// TriggerExecutionRequest.Validate if the designated constraints aren't met.
-type TriggerExecutionRequestValidationError struct {
+// PauseRunRequestValidationError is the validation error returned by
+// PauseRunRequest.Validate if the designated constraints aren't met.
+type PauseRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -329,24 +3516,22 @@ type TriggerExecutionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerExecutionRequestValidationError) Field() string { return e.field }
+func (e PauseRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerExecutionRequestValidationError) Reason() string { return e.reason }
+func (e PauseRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerExecutionRequestValidationError) Cause() error { return e.cause }
+func (e PauseRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerExecutionRequestValidationError) Key() bool { return e.key }
+func (e PauseRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerExecutionRequestValidationError) ErrorName() string {
-	return "TriggerExecutionRequestValidationError"
-}
+func (e PauseRunRequestValidationError) ErrorName() string { return "PauseRunRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TriggerExecutionRequestValidationError) Error() string {
+func (e PauseRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -358,14 +3543,14 @@ func (e TriggerExecutionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerExecutionRequest.%s: %s%s",
+		"invalid %sPauseRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerExecutionRequestValidationError{}
+var _ error = PauseRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -373,24 +3558,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerExecutionRequestValidationError{}
+} = PauseRunRequestValidationError{}
 
-// Validate checks the field values on TriggerExecutionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerExecutionResponse) Validate() error {
+// Validate checks the field values on PauseRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerExecutionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PauseRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerExecutionResponseMultiError, or nil if none found.
-func (m *TriggerExecutionResponse) ValidateAll() error {
+// PauseRunResponseMultiError, or nil if none found.
+func (m *PauseRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerExecutionResponse) validate(all bool) error {
+func (m *PauseRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -398,28 +3583,28 @@ func (m *TriggerExecutionResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetExecution()).(type) {
+		switch v := interface{}(m.GetRun()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TriggerExecutionResponseValidationError{
-					field:  "Execution",
+				errors = append(errors, PauseRunResponseValidationError{
+					field:  "Run",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TriggerExecutionResponseValidationError{
-					field:  "Execution",
+				errors = append(errors, PauseRunResponseValidationError{
+					field:  "Run",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExecution()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TriggerExecutionResponseValidationError{
-				field:  "Execution",
+			return PauseRunResponseValidationError{
+				field:  "Run",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -427,19 +3612,19 @@ func (m *TriggerExecutionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TriggerExecutionResponseMultiError(errors)
+		return PauseRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerExecutionResponseMultiError is an error wrapping multiple validation
-// errors returned by TriggerExecutionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TriggerExecutionResponseMultiError []error
+// PauseRunResponseMultiError is an error wrapping multiple validation errors
+// returned by PauseRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PauseRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerExecutionResponseMultiError) Error() string {
+func (m PauseRunResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -448,11 +3633,11 @@ func (m TriggerExecutionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerExecutionResponseMultiError) AllErrors() []error { return m }
+func (m PauseRunResponseMultiError) AllErrors() []error { return m }
 
-// TriggerExecutionResponseValidationError is the validation error returned by
-// TriggerExecutionResponse.Validate if the designated constraints aren't met.
-type TriggerExecutionResponseValidationError struct {
+// PauseRunResponseValidationError is the validation error returned by
+// PauseRunResponse.Validate if the designated constraints aren't met.
+type PauseRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -460,24 +3645,22 @@ type TriggerExecutionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerExecutionResponseValidationError) Field() string { return e.field }
+func (e PauseRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerExecutionResponseValidationError) Reason() string { return e.reason }
+func (e PauseRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerExecutionResponseValidationError) Cause() error { return e.cause }
+func (e PauseRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerExecutionResponseValidationError) Key() bool { return e.key }
+func (e PauseRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerExecutionResponseValidationError) ErrorName() string {
-	return "TriggerExecutionResponseValidationError"
-}
+func (e PauseRunResponseValidationError) ErrorName() string { return "PauseRunResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TriggerExecutionResponseValidationError) Error() string {
+func (e PauseRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -489,14 +3672,14 @@ func (e TriggerExecutionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerExecutionResponse.%s: %s%s",
+		"invalid %sPauseRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerExecutionResponseValidationError{}
+var _ error = PauseRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -504,24 +3687,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerExecutionResponseValidationError{}
+} = PauseRunResponseValidationError{}
 
-// Validate checks the field values on GetExecutionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetExecutionRequest) Validate() error {
+// Validate checks the field values on ResumeRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetExecutionRequest with the rules
+// ValidateAll checks the field values on ResumeRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetExecutionRequestMultiError, or nil if none found.
-func (m *GetExecutionRequest) ValidateAll() error {
+// ResumeRunRequestMultiError, or nil if none found.
+func (m *ResumeRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetExecutionRequest) validate(all bool) error {
+func (m *ResumeRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -531,19 +3714,19 @@ func (m *GetExecutionRequest) validate(all bool) error {
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetExecutionRequestMultiError(errors)
+		return ResumeRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetExecutionRequestMultiError is an error wrapping multiple validation
-// errors returned by GetExecutionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetExecutionRequestMultiError []error
+// ResumeRunRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetExecutionRequestMultiError) Error() string {
+func (m ResumeRunRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -552,11 +3735,11 @@ func (m GetExecutionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetExecutionRequestMultiError) AllErrors() []error { return m }
+func (m ResumeRunRequestMultiError) AllErrors() []error { return m }
 
-// GetExecutionRequestValidationError is the validation error returned by
-// GetExecutionRequest.Validate if the designated constraints aren't met.
-type GetExecutionRequestValidationError struct {
+// ResumeRunRequestValidationError is the validation error returned by
+// ResumeRunRequest.Validate if the designated constraints aren't met.
+type ResumeRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -564,24 +3747,22 @@ type GetExecutionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetExecutionRequestValidationError) Field() string { return e.field }
+func (e ResumeRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetExecutionRequestValidationError) Reason() string { return e.reason }
+func (e ResumeRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetExecutionRequestValidationError) Cause() error { return e.cause }
+func (e ResumeRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetExecutionRequestValidationError) Key() bool { return e.key }
+func (e ResumeRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetExecutionRequestValidationError) ErrorName() string {
-	return "GetExecutionRequestValidationError"
-}
+func (e ResumeRunRequestValidationError) ErrorName() string { return "ResumeRunRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetExecutionRequestValidationError) Error() string {
+func (e ResumeRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -593,14 +3774,14 @@ func (e GetExecutionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetExecutionRequest.%s: %s%s",
+		"invalid %sResumeRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetExecutionRequestValidationError{}
+var _ error = ResumeRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -608,24 +3789,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetExecutionRequestValidationError{}
+} = ResumeRunRequestValidationError{}
 
-// Validate checks the field values on GetExecutionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetExecutionResponse) Validate() error {
+// Validate checks the field values on ResumeRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetExecutionResponse with the rules
+// ValidateAll checks the field values on ResumeRunResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetExecutionResponseMultiError, or nil if none found.
-func (m *GetExecutionResponse) ValidateAll() error {
+// ResumeRunResponseMultiError, or nil if none found.
+func (m *ResumeRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetExecutionResponse) validate(all bool) error {
+func (m *ResumeRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -633,28 +3814,28 @@ func (m *GetExecutionResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetExecution()).(type) {
+		switch v := interface{}(m.GetRun()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetExecutionResponseValidationError{
-					field:  "Execution",
+				errors = append(errors, ResumeRunResponseValidationError{
+					field:  "Run",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetExecutionResponseValidationError{
-					field:  "Execution",
+				errors = append(errors, ResumeRunResponseValidationError{
+					field:  "Run",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExecution()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetExecutionResponseValidationError{
-				field:  "Execution",
+			return ResumeRunResponseValidationError{
+				field:  "Run",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -662,19 +3843,19 @@ func (m *GetExecutionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetExecutionResponseMultiError(errors)
+		return ResumeRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetExecutionResponseMultiError is an error wrapping multiple validation
-// errors returned by GetExecutionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetExecutionResponseMultiError []error
+// ResumeRunResponseMultiError is an error wrapping multiple validation errors
+// returned by ResumeRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetExecutionResponseMultiError) Error() string {
+func (m ResumeRunResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -683,11 +3864,11 @@ func (m GetExecutionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetExecutionResponseMultiError) AllErrors() []error { return m }
+func (m ResumeRunResponseMultiError) AllErrors() []error { return m }
 
-// GetExecutionResponseValidationError is the validation error returned by
-// GetExecutionResponse.Validate if the designated constraints aren't met.
-type GetExecutionResponseValidationError struct {
+// ResumeRunResponseValidationError is the validation error returned by
+// ResumeRunResponse.Validate if the designated constraints aren't met.
+type ResumeRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -695,24 +3876,24 @@ type GetExecutionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetExecutionResponseValidationError) Field() string { return e.field }
+func (e ResumeRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetExecutionResponseValidationError) Reason() string { return e.reason }
+func (e ResumeRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetExecutionResponseValidationError) Cause() error { return e.cause }
+func (e ResumeRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetExecutionResponseValidationError) Key() bool { return e.key }
+func (e ResumeRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetExecutionResponseValidationError) ErrorName() string {
-	return "GetExecutionResponseValidationError"
+func (e ResumeRunResponseValidationError) ErrorName() string {
+	return "ResumeRunResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetExecutionResponseValidationError) Error() string {
+func (e ResumeRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -724,14 +3905,14 @@ func (e GetExecutionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetExecutionResponse.%s: %s%s",
+		"invalid %sResumeRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetExecutionResponseValidationError{}
+var _ error = ResumeRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -739,64 +3920,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetExecutionResponseValidationError{}
+} = ResumeRunResponseValidationError{}
 
-// Validate checks the field values on ListExecutionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListExecutionsRequest) Validate() error {
+// Validate checks the field values on AbortRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AbortRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListExecutionsRequest with the rules
+// ValidateAll checks the field values on AbortRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListExecutionsRequestMultiError, or nil if none found.
-func (m *ListExecutionsRequest) ValidateAll() error {
+// AbortRunRequestMultiError, or nil if none found.
+func (m *AbortRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListExecutionsRequest) validate(all bool) error {
+func (m *AbortRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Page != nil {
-		// no validation rules for Page
-	}
-
-	if m.PageSize != nil {
-		// no validation rules for PageSize
-	}
-
-	if m.ScriptId != nil {
-		// no validation rules for ScriptId
-	}
-
-	if m.ClientId != nil {
-		// no validation rules for ClientId
-	}
+	// no validation rules for Id
 
-	if m.Status != nil {
-		// no validation rules for Status
+	if m.Reason != nil {
+		// no validation rules for Reason
 	}
 
 	if len(errors) > 0 {
-		return ListExecutionsRequestMultiError(errors)
+		return AbortRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListExecutionsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListExecutionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListExecutionsRequestMultiError []error
+// AbortRunRequestMultiError is an error wrapping multiple validation errors
+// returned by AbortRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AbortRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListExecutionsRequestMultiError) Error() string {
+func (m AbortRunRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -805,11 +3972,11 @@ func (m ListExecutionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListExecutionsRequestMultiError) AllErrors() []error { return m }
+func (m AbortRunRequestMultiError) AllErrors() []error { return m }
 
-// ListExecutionsRequestValidationError is the validation error returned by
-// ListExecutionsRequest.Validate if the designated constraints aren't met.
-type ListExecutionsRequestValidationError struct {
+// AbortRunRequestValidationError is the validation error returned by
+// AbortRunRequest.Validate if the designated constraints aren't met.
+type AbortRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -817,24 +3984,22 @@ type ListExecutionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListExecutionsRequestValidationError) Field() string { return e.field }
+func (e AbortRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListExecutionsRequestValidationError) Reason() string { return e.reason }
+func (e AbortRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListExecutionsRequestValidationError) Cause() error { return e.cause }
+func (e AbortRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListExecutionsRequestValidationError) Key() bool { return e.key }
+func (e AbortRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListExecutionsRequestValidationError) ErrorName() string {
-	return "ListExecutionsRequestValidationError"
-}
+func (e AbortRunRequestValidationError) ErrorName() string { return "AbortRunRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListExecutionsRequestValidationError) Error() string {
+func (e AbortRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -846,14 +4011,14 @@ func (e ListExecutionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListExecutionsRequest.%s: %s%s",
+		"invalid %sAbortRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListExecutionsRequestValidationError{}
+var _ error = AbortRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -861,80 +4026,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListExecutionsRequestValidationError{}
+} = AbortRunRequestValidationError{}
 
-// Validate checks the field values on ListExecutionsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListExecutionsResponse) Validate() error {
+// Validate checks the field values on AbortRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AbortRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListExecutionsResponse with the rules
+// ValidateAll checks the field values on AbortRunResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListExecutionsResponseMultiError, or nil if none found.
-func (m *ListExecutionsResponse) ValidateAll() error {
+// AbortRunResponseMultiError, or nil if none found.
+func (m *AbortRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListExecutionsResponse) validate(all bool) error {
+func (m *AbortRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetExecutions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListExecutionsResponseValidationError{
-						field:  fmt.Sprintf("Executions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListExecutionsResponseValidationError{
-						field:  fmt.Sprintf("Executions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AbortRunResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListExecutionsResponseValidationError{
-					field:  fmt.Sprintf("Executions[%v]", idx),
+				errors = append(errors, AbortRunResponseValidationError{
+					field:  "Run",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AbortRunResponseValidationError{
+				field:  "Run",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Total
+	// no validation rules for Cancelled
 
 	if len(errors) > 0 {
-		return ListExecutionsResponseMultiError(errors)
+		return AbortRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListExecutionsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListExecutionsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListExecutionsResponseMultiError []error
+// AbortRunResponseMultiError is an error wrapping multiple validation errors
+// returned by AbortRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AbortRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListExecutionsResponseMultiError) Error() string {
+func (m AbortRunResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -943,11 +4103,11 @@ func (m ListExecutionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListExecutionsResponseMultiError) AllErrors() []error { return m }
+func (m AbortRunResponseMultiError) AllErrors() []error { return m }
 
-// ListExecutionsResponseValidationError is the validation error returned by
-// ListExecutionsResponse.Validate if the designated constraints aren't met.
-type ListExecutionsResponseValidationError struct {
+// AbortRunResponseValidationError is the validation error returned by
+// AbortRunResponse.Validate if the designated constraints aren't met.
+type AbortRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -955,24 +4115,22 @@ type ListExecutionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListExecutionsResponseValidationError) Field() string { return e.field }
+func (e AbortRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListExecutionsResponseValidationError) Reason() string { return e.reason }
+func (e AbortRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListExecutionsResponseValidationError) Cause() error { return e.cause }
+func (e AbortRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListExecutionsResponseValidationError) Key() bool { return e.key }
+func (e AbortRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListExecutionsResponseValidationError) ErrorName() string {
-	return "ListExecutionsResponseValidationError"
-}
+func (e AbortRunResponseValidationError) ErrorName() string { return "AbortRunResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListExecutionsResponseValidationError) Error() string {
+func (e AbortRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -984,14 +4142,14 @@ func (e ListExecutionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListExecutionsResponse.%s: %s%s",
+		"invalid %sAbortRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListExecutionsResponseValidationError{}
+var _ error = AbortRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -999,24 +4157,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListExecutionsResponseValidationError{}
+} = AbortRunResponseValidationError{}
 
-// Validate checks the field values on GetExecutionOutputRequest with the rules
+// Validate checks the field values on CancelExecutionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetExecutionOutputRequest) Validate() error {
+func (m *CancelExecutionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetExecutionOutputRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CancelExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetExecutionOutputRequestMultiError, or nil if none found.
-func (m *GetExecutionOutputRequest) ValidateAll() error {
+// CancelExecutionRequestMultiError, or nil if none found.
+func (m *CancelExecutionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetExecutionOutputRequest) validate(all bool) error {
+func (m *CancelExecutionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1025,20 +4183,24 @@ func (m *GetExecutionOutputRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
 	if len(errors) > 0 {
-		return GetExecutionOutputRequestMultiError(errors)
+		return CancelExecutionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetExecutionOutputRequestMultiError is an error wrapping multiple validation
-// errors returned by GetExecutionOutputRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetExecutionOutputRequestMultiError []error
+// CancelExecutionRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelExecutionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelExecutionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetExecutionOutputRequestMultiError) Error() string {
+func (m CancelExecutionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1047,11 +4209,11 @@ func (m GetExecutionOutputRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetExecutionOutputRequestMultiError) AllErrors() []error { return m }
+func (m CancelExecutionRequestMultiError) AllErrors() []error { return m }
 
-// GetExecutionOutputRequestValidationError is the validation error returned by
-// GetExecutionOutputRequest.Validate if the designated constraints aren't met.
-type GetExecutionOutputRequestValidationError struct {
+// CancelExecutionRequestValidationError is the validation error returned by
+// CancelExecutionRequest.Validate if the designated constraints aren't met.
+type CancelExecutionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1059,24 +4221,24 @@ type GetExecutionOutputRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetExecutionOutputRequestValidationError) Field() string { return e.field }
+func (e CancelExecutionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetExecutionOutputRequestValidationError) Reason() string { return e.reason }
+func (e CancelExecutionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetExecutionOutputRequestValidationError) Cause() error { return e.cause }
+func (e CancelExecutionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetExecutionOutputRequestValidationError) Key() bool { return e.key }
+func (e CancelExecutionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetExecutionOutputRequestValidationError) ErrorName() string {
-	return "GetExecutionOutputRequestValidationError"
+func (e CancelExecutionRequestValidationError) ErrorName() string {
+	return "CancelExecutionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetExecutionOutputRequestValidationError) Error() string {
+func (e CancelExecutionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1088,14 +4250,14 @@ func (e GetExecutionOutputRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetExecutionOutputRequest.%s: %s%s",
+		"invalid %sCancelExecutionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetExecutionOutputRequestValidationError{}
+var _ error = CancelExecutionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1103,52 +4265,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetExecutionOutputRequestValidationError{}
+} = CancelExecutionRequestValidationError{}
 
-// Validate checks the field values on GetExecutionOutputResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CancelExecutionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetExecutionOutputResponse) Validate() error {
+func (m *CancelExecutionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetExecutionOutputResponse with the
+// ValidateAll checks the field values on CancelExecutionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetExecutionOutputResponseMultiError, or nil if none found.
-func (m *GetExecutionOutputResponse) ValidateAll() error {
+// CancelExecutionResponseMultiError, or nil if none found.
+func (m *CancelExecutionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetExecutionOutputResponse) validate(all bool) error {
+func (m *CancelExecutionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Output
-
-	// no validation rules for ErrorOutput
-
-	if m.ExitCode != nil {
-		// no validation rules for ExitCode
+	if all {
+		switch v := interface{}(m.GetExecution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelExecutionResponseValidationError{
+					field:  "Execution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelExecutionResponseValidationError{
+					field:  "Execution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelExecutionResponseValidationError{
+				field:  "Execution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
+	// no validation rules for ClientNotified
+
 	if len(errors) > 0 {
-		return GetExecutionOutputResponseMultiError(errors)
+		return CancelExecutionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetExecutionOutputResponseMultiError is an error wrapping multiple
-// validation errors returned by GetExecutionOutputResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetExecutionOutputResponseMultiError []error
+// CancelExecutionResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelExecutionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelExecutionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetExecutionOutputResponseMultiError) Error() string {
+func (m CancelExecutionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1157,11 +4342,11 @@ func (m GetExecutionOutputResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetExecutionOutputResponseMultiError) AllErrors() []error { return m }
+func (m CancelExecutionResponseMultiError) AllErrors() []error { return m }
 
-// GetExecutionOutputResponseValidationError is the validation error returned
-// by GetExecutionOutputResponse.Validate if the designated constraints aren't met.
-type GetExecutionOutputResponseValidationError struct {
+// CancelExecutionResponseValidationError is the validation error returned by
+// CancelExecutionResponse.Validate if the designated constraints aren't met.
+type CancelExecutionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1169,24 +4354,24 @@ type GetExecutionOutputResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetExecutionOutputResponseValidationError) Field() string { return e.field }
+func (e CancelExecutionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetExecutionOutputResponseValidationError) Reason() string { return e.reason }
+func (e CancelExecutionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetExecutionOutputResponseValidationError) Cause() error { return e.cause }
+func (e CancelExecutionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetExecutionOutputResponseValidationError) Key() bool { return e.key }
+func (e CancelExecutionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetExecutionOutputResponseValidationError) ErrorName() string {
-	return "GetExecutionOutputResponseValidationError"
+func (e CancelExecutionResponseValidationError) ErrorName() string {
+	return "CancelExecutionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetExecutionOutputResponseValidationError) Error() string {
+func (e CancelExecutionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1198,14 +4383,14 @@ func (e GetExecutionOutputResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetExecutionOutputResponse.%s: %s%s",
+		"invalid %sCancelExecutionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetExecutionOutputResponseValidationError{}
+var _ error = CancelExecutionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1213,7 +4398,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetExecutionOutputResponseValidationError{}
+} = CancelExecutionResponseValidationError{}
 
 // Validate checks the field values on TriggerClientUpdateRequest with the
 // rules defined in the proto definition for this message. If any rules are
@@ -1586,6 +4771,47 @@ func (m *ConnectedClient) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Load1
+
+	// no validation rules for Load5
+
+	// no validation rules for Load15
+
+	// no validation rules for UptimeSeconds
+
+	if m.LastSeenAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastSeenAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectedClientValidationError{
+						field:  "LastSeenAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectedClientValidationError{
+						field:  "LastSeenAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastSeenAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectedClientValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ConnectedClientMultiError(errors)
 	}
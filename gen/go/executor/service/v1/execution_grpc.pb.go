@@ -23,6 +23,14 @@ const (
 	ExecutorExecutionService_GetExecution_FullMethodName         = "/executor.service.v1.ExecutorExecutionService/GetExecution"
 	ExecutorExecutionService_ListExecutions_FullMethodName       = "/executor.service.v1.ExecutorExecutionService/ListExecutions"
 	ExecutorExecutionService_GetExecutionOutput_FullMethodName   = "/executor.service.v1.ExecutorExecutionService/GetExecutionOutput"
+	ExecutorExecutionService_TailExecution_FullMethodName        = "/executor.service.v1.ExecutorExecutionService/TailExecution"
+	ExecutorExecutionService_TriggerRun_FullMethodName           = "/executor.service.v1.ExecutorExecutionService/TriggerRun"
+	ExecutorExecutionService_GetRun_FullMethodName               = "/executor.service.v1.ExecutorExecutionService/GetRun"
+	ExecutorExecutionService_ListRuns_FullMethodName             = "/executor.service.v1.ExecutorExecutionService/ListRuns"
+	ExecutorExecutionService_PauseRun_FullMethodName             = "/executor.service.v1.ExecutorExecutionService/PauseRun"
+	ExecutorExecutionService_ResumeRun_FullMethodName            = "/executor.service.v1.ExecutorExecutionService/ResumeRun"
+	ExecutorExecutionService_AbortRun_FullMethodName             = "/executor.service.v1.ExecutorExecutionService/AbortRun"
+	ExecutorExecutionService_CancelExecution_FullMethodName      = "/executor.service.v1.ExecutorExecutionService/CancelExecution"
 	ExecutorExecutionService_TriggerClientUpdate_FullMethodName  = "/executor.service.v1.ExecutorExecutionService/TriggerClientUpdate"
 	ExecutorExecutionService_ListConnectedClients_FullMethodName = "/executor.service.v1.ExecutorExecutionService/ListConnectedClients"
 )
@@ -41,6 +49,23 @@ type ExecutorExecutionServiceClient interface {
 	ListExecutions(ctx context.Context, in *ListExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsResponse, error)
 	// Get execution output (full stdout/stderr)
 	GetExecutionOutput(ctx context.Context, in *GetExecutionOutputRequest, opts ...grpc.CallOption) (*GetExecutionOutputResponse, error)
+	// Follow the output of an execution as it arrives (server-side streaming).
+	// The stream ends after the execution reaches a final status.
+	TailExecution(ctx context.Context, in *TailExecutionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailExecutionResponse], error)
+	// Run a script on many clients at once
+	TriggerRun(ctx context.Context, in *TriggerRunRequest, opts ...grpc.CallOption) (*TriggerRunResponse, error)
+	// Get a run with its progress
+	GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*GetRunResponse, error)
+	// List runs with their progress
+	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
+	// Stop dispatching further batches of a run; executions in flight continue
+	PauseRun(ctx context.Context, in *PauseRunRequest, opts ...grpc.CallOption) (*PauseRunResponse, error)
+	// Continue a paused run
+	ResumeRun(ctx context.Context, in *ResumeRunRequest, opts ...grpc.CallOption) (*ResumeRunResponse, error)
+	// Stop a run for good and cancel its executions still in flight
+	AbortRun(ctx context.Context, in *AbortRunRequest, opts ...grpc.CallOption) (*AbortRunResponse, error)
+	// Cancel a pending or running execution
+	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error)
 	// Trigger a client self-update via the command stream
 	TriggerClientUpdate(ctx context.Context, in *TriggerClientUpdateRequest, opts ...grpc.CallOption) (*TriggerClientUpdateResponse, error)
 	// List currently connected clients with their versions
@@ -95,6 +120,95 @@ func (c *executorExecutionServiceClient) GetExecutionOutput(ctx context.Context,
 	return out, nil
 }
 
+func (c *executorExecutionServiceClient) TailExecution(ctx context.Context, in *TailExecutionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailExecutionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExecutorExecutionService_ServiceDesc.Streams[0], ExecutorExecutionService_TailExecution_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailExecutionRequest, TailExecutionResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecutorExecutionService_TailExecutionClient = grpc.ServerStreamingClient[TailExecutionResponse]
+
+func (c *executorExecutionServiceClient) TriggerRun(ctx context.Context, in *TriggerRunRequest, opts ...grpc.CallOption) (*TriggerRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerRunResponse)
+	err := c.cc.Invoke(ctx, ExecutorExecutionService_TriggerRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorExecutionServiceClient) GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*GetRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRunResponse)
+	err := c.cc.Invoke(ctx, ExecutorExecutionService_GetRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorExecutionServiceClient) ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRunsResponse)
+	err := c.cc.Invoke(ctx, ExecutorExecutionService_ListRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorExecutionServiceClient) PauseRun(ctx context.Context, in *PauseRunRequest, opts ...grpc.CallOption) (*PauseRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseRunResponse)
+	err := c.cc.Invoke(ctx, ExecutorExecutionService_PauseRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorExecutionServiceClient) ResumeRun(ctx context.Context, in *ResumeRunRequest, opts ...grpc.CallOption) (*ResumeRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeRunResponse)
+	err := c.cc.Invoke(ctx, ExecutorExecutionService_ResumeRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorExecutionServiceClient) AbortRun(ctx context.Context, in *AbortRunRequest, opts ...grpc.CallOption) (*AbortRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortRunResponse)
+	err := c.cc.Invoke(ctx, ExecutorExecutionService_AbortRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorExecutionServiceClient) CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelExecutionResponse)
+	err := c.cc.Invoke(ctx, ExecutorExecutionService_CancelExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorExecutionServiceClient) TriggerClientUpdate(ctx context.Context, in *TriggerClientUpdateRequest, opts ...grpc.CallOption) (*TriggerClientUpdateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TriggerClientUpdateResponse)
@@ -129,6 +243,23 @@ type ExecutorExecutionServiceServer interface {
 	ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error)
 	// Get execution output (full stdout/stderr)
 	GetExecutionOutput(context.Context, *GetExecutionOutputRequest) (*GetExecutionOutputResponse, error)
+	// Follow the output of an execution as it arrives (server-side streaming).
+	// The stream ends after the execution reaches a final status.
+	TailExecution(*TailExecutionRequest, grpc.ServerStreamingServer[TailExecutionResponse]) error
+	// Run a script on many clients at once
+	TriggerRun(context.Context, *TriggerRunRequest) (*TriggerRunResponse, error)
+	// Get a run with its progress
+	GetRun(context.Context, *GetRunRequest) (*GetRunResponse, error)
+	// List runs with their progress
+	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
+	// Stop dispatching further batches of a run; executions in flight continue
+	PauseRun(context.Context, *PauseRunRequest) (*PauseRunResponse, error)
+	// Continue a paused run
+	ResumeRun(context.Context, *ResumeRunRequest) (*ResumeRunResponse, error)
+	// Stop a run for good and cancel its executions still in flight
+	AbortRun(context.Context, *AbortRunRequest) (*AbortRunResponse, error)
+	// Cancel a pending or running execution
+	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
 	// Trigger a client self-update via the command stream
 	TriggerClientUpdate(context.Context, *TriggerClientUpdateRequest) (*TriggerClientUpdateResponse, error)
 	// List currently connected clients with their versions
@@ -155,6 +286,30 @@ func (UnimplementedExecutorExecutionServiceServer) ListExecutions(context.Contex
 func (UnimplementedExecutorExecutionServiceServer) GetExecutionOutput(context.Context, *GetExecutionOutputRequest) (*GetExecutionOutputResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetExecutionOutput not implemented")
 }
+func (UnimplementedExecutorExecutionServiceServer) TailExecution(*TailExecutionRequest, grpc.ServerStreamingServer[TailExecutionResponse]) error {
+	return status.Error(codes.Unimplemented, "method TailExecution not implemented")
+}
+func (UnimplementedExecutorExecutionServiceServer) TriggerRun(context.Context, *TriggerRunRequest) (*TriggerRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerRun not implemented")
+}
+func (UnimplementedExecutorExecutionServiceServer) GetRun(context.Context, *GetRunRequest) (*GetRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRun not implemented")
+}
+func (UnimplementedExecutorExecutionServiceServer) ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRuns not implemented")
+}
+func (UnimplementedExecutorExecutionServiceServer) PauseRun(context.Context, *PauseRunRequest) (*PauseRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseRun not implemented")
+}
+func (UnimplementedExecutorExecutionServiceServer) ResumeRun(context.Context, *ResumeRunRequest) (*ResumeRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeRun not implemented")
+}
+func (UnimplementedExecutorExecutionServiceServer) AbortRun(context.Context, *AbortRunRequest) (*AbortRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AbortRun not implemented")
+}
+func (UnimplementedExecutorExecutionServiceServer) CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelExecution not implemented")
+}
 func (UnimplementedExecutorExecutionServiceServer) TriggerClientUpdate(context.Context, *TriggerClientUpdateRequest) (*TriggerClientUpdateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TriggerClientUpdate not implemented")
 }
@@ -255,6 +410,143 @@ func _ExecutorExecutionService_GetExecutionOutput_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorExecutionService_TailExecution_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailExecutionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutorExecutionServiceServer).TailExecution(m, &grpc.GenericServerStream[TailExecutionRequest, TailExecutionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecutorExecutionService_TailExecutionServer = grpc.ServerStreamingServer[TailExecutionResponse]
+
+func _ExecutorExecutionService_TriggerRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorExecutionServiceServer).TriggerRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorExecutionService_TriggerRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorExecutionServiceServer).TriggerRun(ctx, req.(*TriggerRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorExecutionService_GetRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorExecutionServiceServer).GetRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorExecutionService_GetRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorExecutionServiceServer).GetRun(ctx, req.(*GetRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorExecutionService_ListRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorExecutionServiceServer).ListRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorExecutionService_ListRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorExecutionServiceServer).ListRuns(ctx, req.(*ListRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorExecutionService_PauseRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorExecutionServiceServer).PauseRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorExecutionService_PauseRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorExecutionServiceServer).PauseRun(ctx, req.(*PauseRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorExecutionService_ResumeRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorExecutionServiceServer).ResumeRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorExecutionService_ResumeRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorExecutionServiceServer).ResumeRun(ctx, req.(*ResumeRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorExecutionService_AbortRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorExecutionServiceServer).AbortRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorExecutionService_AbortRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorExecutionServiceServer).AbortRun(ctx, req.(*AbortRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorExecutionService_CancelExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorExecutionServiceServer).CancelExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorExecutionService_CancelExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorExecutionServiceServer).CancelExecution(ctx, req.(*CancelExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutorExecutionService_TriggerClientUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TriggerClientUpdateRequest)
 	if err := dec(in); err != nil {
@@ -314,6 +606,34 @@ var ExecutorExecutionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExecutionOutput",
 			Handler:    _ExecutorExecutionService_GetExecutionOutput_Handler,
 		},
+		{
+			MethodName: "TriggerRun",
+			Handler:    _ExecutorExecutionService_TriggerRun_Handler,
+		},
+		{
+			MethodName: "GetRun",
+			Handler:    _ExecutorExecutionService_GetRun_Handler,
+		},
+		{
+			MethodName: "ListRuns",
+			Handler:    _ExecutorExecutionService_ListRuns_Handler,
+		},
+		{
+			MethodName: "PauseRun",
+			Handler:    _ExecutorExecutionService_PauseRun_Handler,
+		},
+		{
+			MethodName: "ResumeRun",
+			Handler:    _ExecutorExecutionService_ResumeRun_Handler,
+		},
+		{
+			MethodName: "AbortRun",
+			Handler:    _ExecutorExecutionService_AbortRun_Handler,
+		},
+		{
+			MethodName: "CancelExecution",
+			Handler:    _ExecutorExecutionService_CancelExecution_Handler,
+		},
 		{
 			MethodName: "TriggerClientUpdate",
 			Handler:    _ExecutorExecutionService_TriggerClientUpdate_Handler,
@@ -323,6 +643,12 @@ var ExecutorExecutionService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ExecutorExecutionService_ListConnectedClients_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailExecution",
+			Handler:       _ExecutorExecutionService_TailExecution_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "executor/service/v1/execution.proto",
 }
@@ -19,26 +19,47 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationExecutorExecutionServiceAbortRun = "/executor.service.v1.ExecutorExecutionService/AbortRun"
+const OperationExecutorExecutionServiceCancelExecution = "/executor.service.v1.ExecutorExecutionService/CancelExecution"
 const OperationExecutorExecutionServiceGetExecution = "/executor.service.v1.ExecutorExecutionService/GetExecution"
 const OperationExecutorExecutionServiceGetExecutionOutput = "/executor.service.v1.ExecutorExecutionService/GetExecutionOutput"
+const OperationExecutorExecutionServiceGetRun = "/executor.service.v1.ExecutorExecutionService/GetRun"
 const OperationExecutorExecutionServiceListConnectedClients = "/executor.service.v1.ExecutorExecutionService/ListConnectedClients"
 const OperationExecutorExecutionServiceListExecutions = "/executor.service.v1.ExecutorExecutionService/ListExecutions"
+const OperationExecutorExecutionServiceListRuns = "/executor.service.v1.ExecutorExecutionService/ListRuns"
+const OperationExecutorExecutionServicePauseRun = "/executor.service.v1.ExecutorExecutionService/PauseRun"
+const OperationExecutorExecutionServiceResumeRun = "/executor.service.v1.ExecutorExecutionService/ResumeRun"
 const OperationExecutorExecutionServiceTriggerClientUpdate = "/executor.service.v1.ExecutorExecutionService/TriggerClientUpdate"
 const OperationExecutorExecutionServiceTriggerExecution = "/executor.service.v1.ExecutorExecutionService/TriggerExecution"
+const OperationExecutorExecutionServiceTriggerRun = "/executor.service.v1.ExecutorExecutionService/TriggerRun"
 
 type ExecutorExecutionServiceHTTPServer interface {
+	// AbortRun Stop a run for good and cancel its executions still in flight
+	AbortRun(context.Context, *AbortRunRequest) (*AbortRunResponse, error)
+	// CancelExecution Cancel a pending or running execution
+	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
 	// GetExecution Get execution details
 	GetExecution(context.Context, *GetExecutionRequest) (*GetExecutionResponse, error)
 	// GetExecutionOutput Get execution output (full stdout/stderr)
 	GetExecutionOutput(context.Context, *GetExecutionOutputRequest) (*GetExecutionOutputResponse, error)
+	// GetRun Get a run with its progress
+	GetRun(context.Context, *GetRunRequest) (*GetRunResponse, error)
 	// ListConnectedClients List currently connected clients with their versions
 	ListConnectedClients(context.Context, *ListConnectedClientsRequest) (*ListConnectedClientsResponse, error)
 	// ListExecutions List executions
 	ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error)
+	// ListRuns List runs with their progress
+	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
+	// PauseRun Stop dispatching further batches of a run; executions in flight continue
+	PauseRun(context.Context, *PauseRunRequest) (*PauseRunResponse, error)
+	// ResumeRun Continue a paused run
+	ResumeRun(context.Context, *ResumeRunRequest) (*ResumeRunResponse, error)
 	// TriggerClientUpdate Trigger a client self-update via the command stream
 	TriggerClientUpdate(context.Context, *TriggerClientUpdateRequest) (*TriggerClientUpdateResponse, error)
 	// TriggerExecution Trigger script execution on a client (UI-push)
 	TriggerExecution(context.Context, *TriggerExecutionRequest) (*TriggerExecutionResponse, error)
+	// TriggerRun Run a script on many clients at once
+	TriggerRun(context.Context, *TriggerRunRequest) (*TriggerRunResponse, error)
 }
 
 func RegisterExecutorExecutionServiceHTTPServer(s *http.Server, srv ExecutorExecutionServiceHTTPServer) {
@@ -47,6 +68,13 @@ func RegisterExecutorExecutionServiceHTTPServer(s *http.Server, srv ExecutorExec
 	r.GET("/v1/executions/{id}", _ExecutorExecutionService_GetExecution0_HTTP_Handler(srv))
 	r.GET("/v1/executions", _ExecutorExecutionService_ListExecutions0_HTTP_Handler(srv))
 	r.GET("/v1/executions/{id}/output", _ExecutorExecutionService_GetExecutionOutput0_HTTP_Handler(srv))
+	r.POST("/v1/scripts/{script_id}/runs", _ExecutorExecutionService_TriggerRun0_HTTP_Handler(srv))
+	r.GET("/v1/runs/{id}", _ExecutorExecutionService_GetRun0_HTTP_Handler(srv))
+	r.GET("/v1/runs", _ExecutorExecutionService_ListRuns0_HTTP_Handler(srv))
+	r.POST("/v1/runs/{id}/pause", _ExecutorExecutionService_PauseRun0_HTTP_Handler(srv))
+	r.POST("/v1/runs/{id}/resume", _ExecutorExecutionService_ResumeRun0_HTTP_Handler(srv))
+	r.POST("/v1/runs/{id}/abort", _ExecutorExecutionService_AbortRun0_HTTP_Handler(srv))
+	r.POST("/v1/executions/{id}/cancel", _ExecutorExecutionService_CancelExecution0_HTTP_Handler(srv))
 	r.POST("/v1/clients/{client_id}/update", _ExecutorExecutionService_TriggerClientUpdate0_HTTP_Handler(srv))
 	r.GET("/v1/clients/connected", _ExecutorExecutionService_ListConnectedClients0_HTTP_Handler(srv))
 }
@@ -139,6 +167,172 @@ func _ExecutorExecutionService_GetExecutionOutput0_HTTP_Handler(srv ExecutorExec
 	}
 }
 
+func _ExecutorExecutionService_TriggerRun0_HTTP_Handler(srv ExecutorExecutionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TriggerRunRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorExecutionServiceTriggerRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TriggerRun(ctx, req.(*TriggerRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TriggerRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorExecutionService_GetRun0_HTTP_Handler(srv ExecutorExecutionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRunRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorExecutionServiceGetRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRun(ctx, req.(*GetRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorExecutionService_ListRuns0_HTTP_Handler(srv ExecutorExecutionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRunsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorExecutionServiceListRuns)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRuns(ctx, req.(*ListRunsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRunsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorExecutionService_PauseRun0_HTTP_Handler(srv ExecutorExecutionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PauseRunRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorExecutionServicePauseRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseRun(ctx, req.(*PauseRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PauseRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorExecutionService_ResumeRun0_HTTP_Handler(srv ExecutorExecutionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResumeRunRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorExecutionServiceResumeRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResumeRun(ctx, req.(*ResumeRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResumeRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorExecutionService_AbortRun0_HTTP_Handler(srv ExecutorExecutionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AbortRunRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorExecutionServiceAbortRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AbortRun(ctx, req.(*AbortRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AbortRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorExecutionService_CancelExecution0_HTTP_Handler(srv ExecutorExecutionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelExecutionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorExecutionServiceCancelExecution)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelExecution(ctx, req.(*CancelExecutionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CancelExecutionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ExecutorExecutionService_TriggerClientUpdate0_HTTP_Handler(srv ExecutorExecutionServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in TriggerClientUpdateRequest
@@ -184,18 +378,32 @@ func _ExecutorExecutionService_ListConnectedClients0_HTTP_Handler(srv ExecutorEx
 }
 
 type ExecutorExecutionServiceHTTPClient interface {
+	// AbortRun Stop a run for good and cancel its executions still in flight
+	AbortRun(ctx context.Context, req *AbortRunRequest, opts ...http.CallOption) (rsp *AbortRunResponse, err error)
+	// CancelExecution Cancel a pending or running execution
+	CancelExecution(ctx context.Context, req *CancelExecutionRequest, opts ...http.CallOption) (rsp *CancelExecutionResponse, err error)
 	// GetExecution Get execution details
 	GetExecution(ctx context.Context, req *GetExecutionRequest, opts ...http.CallOption) (rsp *GetExecutionResponse, err error)
 	// GetExecutionOutput Get execution output (full stdout/stderr)
 	GetExecutionOutput(ctx context.Context, req *GetExecutionOutputRequest, opts ...http.CallOption) (rsp *GetExecutionOutputResponse, err error)
+	// GetRun Get a run with its progress
+	GetRun(ctx context.Context, req *GetRunRequest, opts ...http.CallOption) (rsp *GetRunResponse, err error)
 	// ListConnectedClients List currently connected clients with their versions
 	ListConnectedClients(ctx context.Context, req *ListConnectedClientsRequest, opts ...http.CallOption) (rsp *ListConnectedClientsResponse, err error)
 	// ListExecutions List executions
 	ListExecutions(ctx context.Context, req *ListExecutionsRequest, opts ...http.CallOption) (rsp *ListExecutionsResponse, err error)
+	// ListRuns List runs with their progress
+	ListRuns(ctx context.Context, req *ListRunsRequest, opts ...http.CallOption) (rsp *ListRunsResponse, err error)
+	// PauseRun Stop dispatching further batches of a run; executions in flight continue
+	PauseRun(ctx context.Context, req *PauseRunRequest, opts ...http.CallOption) (rsp *PauseRunResponse, err error)
+	// ResumeRun Continue a paused run
+	ResumeRun(ctx context.Context, req *ResumeRunRequest, opts ...http.CallOption) (rsp *ResumeRunResponse, err error)
 	// TriggerClientUpdate Trigger a client self-update via the command stream
 	TriggerClientUpdate(ctx context.Context, req *TriggerClientUpdateRequest, opts ...http.CallOption) (rsp *TriggerClientUpdateResponse, err error)
 	// TriggerExecution Trigger script execution on a client (UI-push)
 	TriggerExecution(ctx context.Context, req *TriggerExecutionRequest, opts ...http.CallOption) (rsp *TriggerExecutionResponse, err error)
+	// TriggerRun Run a script on many clients at once
+	TriggerRun(ctx context.Context, req *TriggerRunRequest, opts ...http.CallOption) (rsp *TriggerRunResponse, err error)
 }
 
 type ExecutorExecutionServiceHTTPClientImpl struct {
@@ -206,6 +414,34 @@ func NewExecutorExecutionServiceHTTPClient(client *http.Client) ExecutorExecutio
 	return &ExecutorExecutionServiceHTTPClientImpl{client}
 }
 
+// AbortRun Stop a run for good and cancel its executions still in flight
+func (c *ExecutorExecutionServiceHTTPClientImpl) AbortRun(ctx context.Context, in *AbortRunRequest, opts ...http.CallOption) (*AbortRunResponse, error) {
+	var out AbortRunResponse
+	pattern := "/v1/runs/{id}/abort"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorExecutionServiceAbortRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelExecution Cancel a pending or running execution
+func (c *ExecutorExecutionServiceHTTPClientImpl) CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...http.CallOption) (*CancelExecutionResponse, error) {
+	var out CancelExecutionResponse
+	pattern := "/v1/executions/{id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorExecutionServiceCancelExecution))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetExecution Get execution details
 func (c *ExecutorExecutionServiceHTTPClientImpl) GetExecution(ctx context.Context, in *GetExecutionRequest, opts ...http.CallOption) (*GetExecutionResponse, error) {
 	var out GetExecutionResponse
@@ -234,6 +470,20 @@ func (c *ExecutorExecutionServiceHTTPClientImpl) GetExecutionOutput(ctx context.
 	return &out, nil
 }
 
+// GetRun Get a run with its progress
+func (c *ExecutorExecutionServiceHTTPClientImpl) GetRun(ctx context.Context, in *GetRunRequest, opts ...http.CallOption) (*GetRunResponse, error) {
+	var out GetRunResponse
+	pattern := "/v1/runs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorExecutionServiceGetRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListConnectedClients List currently connected clients with their versions
 func (c *ExecutorExecutionServiceHTTPClientImpl) ListConnectedClients(ctx context.Context, in *ListConnectedClientsRequest, opts ...http.CallOption) (*ListConnectedClientsResponse, error) {
 	var out ListConnectedClientsResponse
@@ -262,6 +512,48 @@ func (c *ExecutorExecutionServiceHTTPClientImpl) ListExecutions(ctx context.Cont
 	return &out, nil
 }
 
+// ListRuns List runs with their progress
+func (c *ExecutorExecutionServiceHTTPClientImpl) ListRuns(ctx context.Context, in *ListRunsRequest, opts ...http.CallOption) (*ListRunsResponse, error) {
+	var out ListRunsResponse
+	pattern := "/v1/runs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorExecutionServiceListRuns))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseRun Stop dispatching further batches of a run; executions in flight continue
+func (c *ExecutorExecutionServiceHTTPClientImpl) PauseRun(ctx context.Context, in *PauseRunRequest, opts ...http.CallOption) (*PauseRunResponse, error) {
+	var out PauseRunResponse
+	pattern := "/v1/runs/{id}/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorExecutionServicePauseRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResumeRun Continue a paused run
+func (c *ExecutorExecutionServiceHTTPClientImpl) ResumeRun(ctx context.Context, in *ResumeRunRequest, opts ...http.CallOption) (*ResumeRunResponse, error) {
+	var out ResumeRunResponse
+	pattern := "/v1/runs/{id}/resume"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorExecutionServiceResumeRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // TriggerClientUpdate Trigger a client self-update via the command stream
 func (c *ExecutorExecutionServiceHTTPClientImpl) TriggerClientUpdate(ctx context.Context, in *TriggerClientUpdateRequest, opts ...http.CallOption) (*TriggerClientUpdateResponse, error) {
 	var out TriggerClientUpdateResponse
@@ -289,3 +581,17 @@ func (c *ExecutorExecutionServiceHTTPClientImpl) TriggerExecution(ctx context.Co
 	}
 	return &out, nil
 }
+
+// TriggerRun Run a script on many clients at once
+func (c *ExecutorExecutionServiceHTTPClientImpl) TriggerRun(ctx context.Context, in *TriggerRunRequest, opts ...http.CallOption) (*TriggerRunResponse, error) {
+	var out TriggerRunResponse
+	pattern := "/v1/scripts/{script_id}/runs"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorExecutionServiceTriggerRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -26,10 +26,13 @@ type ExecutorErrorReason int32
 
 const (
 	// 400 - Bad Request
-	ExecutorErrorReason_BAD_REQUEST            ExecutorErrorReason = 0
-	ExecutorErrorReason_INVALID_SCRIPT_TYPE    ExecutorErrorReason = 1
-	ExecutorErrorReason_INVALID_SCRIPT_CONTENT ExecutorErrorReason = 2
-	ExecutorErrorReason_PASSWORD_REQUIRED      ExecutorErrorReason = 3
+	ExecutorErrorReason_BAD_REQUEST             ExecutorErrorReason = 0
+	ExecutorErrorReason_INVALID_SCRIPT_TYPE     ExecutorErrorReason = 1
+	ExecutorErrorReason_INVALID_SCRIPT_CONTENT  ExecutorErrorReason = 2
+	ExecutorErrorReason_PASSWORD_REQUIRED       ExecutorErrorReason = 3
+	ExecutorErrorReason_INVALID_LABEL_SELECTOR  ExecutorErrorReason = 4
+	ExecutorErrorReason_INVALID_CRON_EXPRESSION ExecutorErrorReason = 5
+	ExecutorErrorReason_INVALID_WORKFLOW        ExecutorErrorReason = 6
 	// 401 - Unauthorized
 	ExecutorErrorReason_UNAUTHORIZED                 ExecutorErrorReason = 100
 	ExecutorErrorReason_PASSWORD_VERIFICATION_FAILED ExecutorErrorReason = 101
@@ -37,21 +40,49 @@ const (
 	ExecutorErrorReason_FORBIDDEN           ExecutorErrorReason = 300
 	ExecutorErrorReason_CLIENT_NOT_ASSIGNED ExecutorErrorReason = 301
 	// 404 - Not Found
-	ExecutorErrorReason_NOT_FOUND            ExecutorErrorReason = 400
-	ExecutorErrorReason_SCRIPT_NOT_FOUND     ExecutorErrorReason = 401
-	ExecutorErrorReason_ASSIGNMENT_NOT_FOUND ExecutorErrorReason = 402
-	ExecutorErrorReason_EXECUTION_NOT_FOUND  ExecutorErrorReason = 403
-	ExecutorErrorReason_COMMAND_NOT_FOUND    ExecutorErrorReason = 404
+	ExecutorErrorReason_NOT_FOUND                       ExecutorErrorReason = 400
+	ExecutorErrorReason_SCRIPT_NOT_FOUND                ExecutorErrorReason = 401
+	ExecutorErrorReason_ASSIGNMENT_NOT_FOUND            ExecutorErrorReason = 402
+	ExecutorErrorReason_EXECUTION_NOT_FOUND             ExecutorErrorReason = 403
+	ExecutorErrorReason_COMMAND_NOT_FOUND               ExecutorErrorReason = 404
+	ExecutorErrorReason_CLIENT_NOT_FOUND                ExecutorErrorReason = 405
+	ExecutorErrorReason_CLIENT_GROUP_NOT_FOUND          ExecutorErrorReason = 406
+	ExecutorErrorReason_RUN_NOT_FOUND                   ExecutorErrorReason = 407
+	ExecutorErrorReason_SCHEDULE_NOT_FOUND              ExecutorErrorReason = 408
+	ExecutorErrorReason_EVENT_RULE_NOT_FOUND            ExecutorErrorReason = 409
+	ExecutorErrorReason_WORKFLOW_NOT_FOUND              ExecutorErrorReason = 410
+	ExecutorErrorReason_WORKFLOW_RUN_NOT_FOUND          ExecutorErrorReason = 411
+	ExecutorErrorReason_MAINTENANCE_WINDOW_NOT_FOUND    ExecutorErrorReason = 412
+	ExecutorErrorReason_SCRIPT_VERSION_NOT_FOUND        ExecutorErrorReason = 413
+	ExecutorErrorReason_SECRET_NOT_FOUND                ExecutorErrorReason = 414
+	ExecutorErrorReason_SIGNING_KEY_NOT_FOUND           ExecutorErrorReason = 415
+	ExecutorErrorReason_SCRIPT_CHANGE_REQUEST_NOT_FOUND ExecutorErrorReason = 416
+	ExecutorErrorReason_PROTECTED_CLIENT_NOT_FOUND      ExecutorErrorReason = 417
 	// 409 - Conflict
-	ExecutorErrorReason_ASSIGNMENT_ALREADY_EXISTS ExecutorErrorReason = 900
-	ExecutorErrorReason_SCRIPT_DISABLED           ExecutorErrorReason = 901
+	ExecutorErrorReason_ASSIGNMENT_ALREADY_EXISTS       ExecutorErrorReason = 900
+	ExecutorErrorReason_SCRIPT_DISABLED                 ExecutorErrorReason = 901
+	ExecutorErrorReason_EXECUTION_NOT_CANCELLABLE       ExecutorErrorReason = 902
+	ExecutorErrorReason_CLIENT_GROUP_ALREADY_EXISTS     ExecutorErrorReason = 903
+	ExecutorErrorReason_RUN_STATE_CONFLICT              ExecutorErrorReason = 904
+	ExecutorErrorReason_CONCURRENCY_LIMIT_REACHED       ExecutorErrorReason = 905
+	ExecutorErrorReason_OUTSIDE_MAINTENANCE_WINDOW      ExecutorErrorReason = 906
+	ExecutorErrorReason_MAINTENANCE_OVERRIDE_REQUIRED   ExecutorErrorReason = 907
+	ExecutorErrorReason_SECRET_ALREADY_EXISTS           ExecutorErrorReason = 908
+	ExecutorErrorReason_SCRIPT_CHANGE_CONFLICT          ExecutorErrorReason = 909
+	ExecutorErrorReason_EXECUTION_APPROVAL_CONFLICT     ExecutorErrorReason = 910
+	ExecutorErrorReason_PROTECTED_CLIENT_ALREADY_EXISTS ExecutorErrorReason = 911
+	ExecutorErrorReason_SCRIPT_TYPE_NOT_SUPPORTED       ExecutorErrorReason = 912 // the client has no interpreter for the script type
+	ExecutorErrorReason_EXECUTION_STATE_CONFLICT        ExecutorErrorReason = 913 // the execution moved on before the update
+	ExecutorErrorReason_SIGNING_KEY_CONFLICT            ExecutorErrorReason = 914 // the active signing key was replaced concurrently
 	// 500 - Internal Server Error
 	ExecutorErrorReason_INTERNAL_SERVER_ERROR ExecutorErrorReason = 2000
 	ExecutorErrorReason_DATABASE_ERROR        ExecutorErrorReason = 2001
 	// 503 - Service Unavailable
-	ExecutorErrorReason_SERVICE_UNAVAILABLE ExecutorErrorReason = 2300
-	ExecutorErrorReason_PORTAL_UNAVAILABLE  ExecutorErrorReason = 2301
-	ExecutorErrorReason_CLIENT_OFFLINE      ExecutorErrorReason = 2302
+	ExecutorErrorReason_SERVICE_UNAVAILABLE    ExecutorErrorReason = 2300
+	ExecutorErrorReason_PORTAL_UNAVAILABLE     ExecutorErrorReason = 2301
+	ExecutorErrorReason_CLIENT_OFFLINE         ExecutorErrorReason = 2302
+	ExecutorErrorReason_SECRETS_NOT_CONFIGURED ExecutorErrorReason = 2303
+	ExecutorErrorReason_SIGNING_NOT_CONFIGURED ExecutorErrorReason = 2304
 )
 
 // Enum value maps for ExecutorErrorReason.
@@ -61,6 +92,9 @@ var (
 		1:    "INVALID_SCRIPT_TYPE",
 		2:    "INVALID_SCRIPT_CONTENT",
 		3:    "PASSWORD_REQUIRED",
+		4:    "INVALID_LABEL_SELECTOR",
+		5:    "INVALID_CRON_EXPRESSION",
+		6:    "INVALID_WORKFLOW",
 		100:  "UNAUTHORIZED",
 		101:  "PASSWORD_VERIFICATION_FAILED",
 		300:  "FORBIDDEN",
@@ -70,35 +104,94 @@ var (
 		402:  "ASSIGNMENT_NOT_FOUND",
 		403:  "EXECUTION_NOT_FOUND",
 		404:  "COMMAND_NOT_FOUND",
+		405:  "CLIENT_NOT_FOUND",
+		406:  "CLIENT_GROUP_NOT_FOUND",
+		407:  "RUN_NOT_FOUND",
+		408:  "SCHEDULE_NOT_FOUND",
+		409:  "EVENT_RULE_NOT_FOUND",
+		410:  "WORKFLOW_NOT_FOUND",
+		411:  "WORKFLOW_RUN_NOT_FOUND",
+		412:  "MAINTENANCE_WINDOW_NOT_FOUND",
+		413:  "SCRIPT_VERSION_NOT_FOUND",
+		414:  "SECRET_NOT_FOUND",
+		415:  "SIGNING_KEY_NOT_FOUND",
+		416:  "SCRIPT_CHANGE_REQUEST_NOT_FOUND",
+		417:  "PROTECTED_CLIENT_NOT_FOUND",
 		900:  "ASSIGNMENT_ALREADY_EXISTS",
 		901:  "SCRIPT_DISABLED",
+		902:  "EXECUTION_NOT_CANCELLABLE",
+		903:  "CLIENT_GROUP_ALREADY_EXISTS",
+		904:  "RUN_STATE_CONFLICT",
+		905:  "CONCURRENCY_LIMIT_REACHED",
+		906:  "OUTSIDE_MAINTENANCE_WINDOW",
+		907:  "MAINTENANCE_OVERRIDE_REQUIRED",
+		908:  "SECRET_ALREADY_EXISTS",
+		909:  "SCRIPT_CHANGE_CONFLICT",
+		910:  "EXECUTION_APPROVAL_CONFLICT",
+		911:  "PROTECTED_CLIENT_ALREADY_EXISTS",
+		912:  "SCRIPT_TYPE_NOT_SUPPORTED",
+		913:  "EXECUTION_STATE_CONFLICT",
+		914:  "SIGNING_KEY_CONFLICT",
 		2000: "INTERNAL_SERVER_ERROR",
 		2001: "DATABASE_ERROR",
 		2300: "SERVICE_UNAVAILABLE",
 		2301: "PORTAL_UNAVAILABLE",
 		2302: "CLIENT_OFFLINE",
+		2303: "SECRETS_NOT_CONFIGURED",
+		2304: "SIGNING_NOT_CONFIGURED",
 	}
 	ExecutorErrorReason_value = map[string]int32{
-		"BAD_REQUEST":                  0,
-		"INVALID_SCRIPT_TYPE":          1,
-		"INVALID_SCRIPT_CONTENT":       2,
-		"PASSWORD_REQUIRED":            3,
-		"UNAUTHORIZED":                 100,
-		"PASSWORD_VERIFICATION_FAILED": 101,
-		"FORBIDDEN":                    300,
-		"CLIENT_NOT_ASSIGNED":          301,
-		"NOT_FOUND":                    400,
-		"SCRIPT_NOT_FOUND":             401,
-		"ASSIGNMENT_NOT_FOUND":         402,
-		"EXECUTION_NOT_FOUND":          403,
-		"COMMAND_NOT_FOUND":            404,
-		"ASSIGNMENT_ALREADY_EXISTS":    900,
-		"SCRIPT_DISABLED":              901,
-		"INTERNAL_SERVER_ERROR":        2000,
-		"DATABASE_ERROR":               2001,
-		"SERVICE_UNAVAILABLE":          2300,
-		"PORTAL_UNAVAILABLE":           2301,
-		"CLIENT_OFFLINE":               2302,
+		"BAD_REQUEST":                     0,
+		"INVALID_SCRIPT_TYPE":             1,
+		"INVALID_SCRIPT_CONTENT":          2,
+		"PASSWORD_REQUIRED":               3,
+		"INVALID_LABEL_SELECTOR":          4,
+		"INVALID_CRON_EXPRESSION":         5,
+		"INVALID_WORKFLOW":                6,
+		"UNAUTHORIZED":                    100,
+		"PASSWORD_VERIFICATION_FAILED":    101,
+		"FORBIDDEN":                       300,
+		"CLIENT_NOT_ASSIGNED":             301,
+		"NOT_FOUND":                       400,
+		"SCRIPT_NOT_FOUND":                401,
+		"ASSIGNMENT_NOT_FOUND":            402,
+		"EXECUTION_NOT_FOUND":             403,
+		"COMMAND_NOT_FOUND":               404,
+		"CLIENT_NOT_FOUND":                405,
+		"CLIENT_GROUP_NOT_FOUND":          406,
+		"RUN_NOT_FOUND":                   407,
+		"SCHEDULE_NOT_FOUND":              408,
+		"EVENT_RULE_NOT_FOUND":            409,
+		"WORKFLOW_NOT_FOUND":              410,
+		"WORKFLOW_RUN_NOT_FOUND":          411,
+		"MAINTENANCE_WINDOW_NOT_FOUND":    412,
+		"SCRIPT_VERSION_NOT_FOUND":        413,
+		"SECRET_NOT_FOUND":                414,
+		"SIGNING_KEY_NOT_FOUND":           415,
+		"SCRIPT_CHANGE_REQUEST_NOT_FOUND": 416,
+		"PROTECTED_CLIENT_NOT_FOUND":      417,
+		"ASSIGNMENT_ALREADY_EXISTS":       900,
+		"SCRIPT_DISABLED":                 901,
+		"EXECUTION_NOT_CANCELLABLE":       902,
+		"CLIENT_GROUP_ALREADY_EXISTS":     903,
+		"RUN_STATE_CONFLICT":              904,
+		"CONCURRENCY_LIMIT_REACHED":       905,
+		"OUTSIDE_MAINTENANCE_WINDOW":      906,
+		"MAINTENANCE_OVERRIDE_REQUIRED":   907,
+		"SECRET_ALREADY_EXISTS":           908,
+		"SCRIPT_CHANGE_CONFLICT":          909,
+		"EXECUTION_APPROVAL_CONFLICT":     910,
+		"PROTECTED_CLIENT_ALREADY_EXISTS": 911,
+		"SCRIPT_TYPE_NOT_SUPPORTED":       912,
+		"EXECUTION_STATE_CONFLICT":        913,
+		"SIGNING_KEY_CONFLICT":            914,
+		"INTERNAL_SERVER_ERROR":           2000,
+		"DATABASE_ERROR":                  2001,
+		"SERVICE_UNAVAILABLE":             2300,
+		"PORTAL_UNAVAILABLE":              2301,
+		"CLIENT_OFFLINE":                  2302,
+		"SECRETS_NOT_CONFIGURED":          2303,
+		"SIGNING_NOT_CONFIGURED":          2304,
 	}
 )
 
@@ -133,12 +226,15 @@ var File_executor_service_v1_executor_error_proto protoreflect.FileDescriptor
 
 const file_executor_service_v1_executor_error_proto_rawDesc = "" +
 	"\n" +
-	"(executor/service/v1/executor_error.proto\x12\x13executor.service.v1\x1a\x13errors/errors.proto*\xf1\x04\n" +
+	"(executor/service/v1/executor_error.proto\x12\x13executor.service.v1\x1a\x13errors/errors.proto*\xbd\r\n" +
 	"\x13ExecutorErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
 	"\x13INVALID_SCRIPT_TYPE\x10\x01\x1a\x04\xa8E\x90\x03\x12 \n" +
 	"\x16INVALID_SCRIPT_CONTENT\x10\x02\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
-	"\x11PASSWORD_REQUIRED\x10\x03\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\x11PASSWORD_REQUIRED\x10\x03\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x16INVALID_LABEL_SELECTOR\x10\x04\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x17INVALID_CRON_EXPRESSION\x10\x05\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10INVALID_WORKFLOW\x10\x06\x1a\x04\xa8E\x90\x03\x12\x16\n" +
 	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12&\n" +
 	"\x1cPASSWORD_VERIFICATION_FAILED\x10e\x1a\x04\xa8E\x91\x03\x12\x14\n" +
 	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x1e\n" +
@@ -147,14 +243,42 @@ const file_executor_service_v1_executor_error_proto_rawDesc = "" +
 	"\x10SCRIPT_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
 	"\x14ASSIGNMENT_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12\x1e\n" +
 	"\x13EXECUTION_NOT_FOUND\x10\x93\x03\x1a\x04\xa8E\x94\x03\x12\x1c\n" +
-	"\x11COMMAND_NOT_FOUND\x10\x94\x03\x1a\x04\xa8E\x94\x03\x12$\n" +
+	"\x11COMMAND_NOT_FOUND\x10\x94\x03\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x10CLIENT_NOT_FOUND\x10\x95\x03\x1a\x04\xa8E\x94\x03\x12!\n" +
+	"\x16CLIENT_GROUP_NOT_FOUND\x10\x96\x03\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\rRUN_NOT_FOUND\x10\x97\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
+	"\x12SCHEDULE_NOT_FOUND\x10\x98\x03\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
+	"\x14EVENT_RULE_NOT_FOUND\x10\x99\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
+	"\x12WORKFLOW_NOT_FOUND\x10\x9a\x03\x1a\x04\xa8E\x94\x03\x12!\n" +
+	"\x16WORKFLOW_RUN_NOT_FOUND\x10\x9b\x03\x1a\x04\xa8E\x94\x03\x12'\n" +
+	"\x1cMAINTENANCE_WINDOW_NOT_FOUND\x10\x9c\x03\x1a\x04\xa8E\x94\x03\x12#\n" +
+	"\x18SCRIPT_VERSION_NOT_FOUND\x10\x9d\x03\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x10SECRET_NOT_FOUND\x10\x9e\x03\x1a\x04\xa8E\x94\x03\x12 \n" +
+	"\x15SIGNING_KEY_NOT_FOUND\x10\x9f\x03\x1a\x04\xa8E\x94\x03\x12*\n" +
+	"\x1fSCRIPT_CHANGE_REQUEST_NOT_FOUND\x10\xa0\x03\x1a\x04\xa8E\x94\x03\x12%\n" +
+	"\x1aPROTECTED_CLIENT_NOT_FOUND\x10\xa1\x03\x1a\x04\xa8E\x94\x03\x12$\n" +
 	"\x19ASSIGNMENT_ALREADY_EXISTS\x10\x84\a\x1a\x04\xa8E\x99\x03\x12\x1a\n" +
-	"\x0fSCRIPT_DISABLED\x10\x85\a\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x0fSCRIPT_DISABLED\x10\x85\a\x1a\x04\xa8E\x99\x03\x12$\n" +
+	"\x19EXECUTION_NOT_CANCELLABLE\x10\x86\a\x1a\x04\xa8E\x99\x03\x12&\n" +
+	"\x1bCLIENT_GROUP_ALREADY_EXISTS\x10\x87\a\x1a\x04\xa8E\x99\x03\x12\x1d\n" +
+	"\x12RUN_STATE_CONFLICT\x10\x88\a\x1a\x04\xa8E\x99\x03\x12$\n" +
+	"\x19CONCURRENCY_LIMIT_REACHED\x10\x89\a\x1a\x04\xa8E\x99\x03\x12%\n" +
+	"\x1aOUTSIDE_MAINTENANCE_WINDOW\x10\x8a\a\x1a\x04\xa8E\x99\x03\x12(\n" +
+	"\x1dMAINTENANCE_OVERRIDE_REQUIRED\x10\x8b\a\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x15SECRET_ALREADY_EXISTS\x10\x8c\a\x1a\x04\xa8E\x99\x03\x12!\n" +
+	"\x16SCRIPT_CHANGE_CONFLICT\x10\x8d\a\x1a\x04\xa8E\x99\x03\x12&\n" +
+	"\x1bEXECUTION_APPROVAL_CONFLICT\x10\x8e\a\x1a\x04\xa8E\x99\x03\x12*\n" +
+	"\x1fPROTECTED_CLIENT_ALREADY_EXISTS\x10\x8f\a\x1a\x04\xa8E\x99\x03\x12$\n" +
+	"\x19SCRIPT_TYPE_NOT_SUPPORTED\x10\x90\a\x1a\x04\xa8E\x99\x03\x12#\n" +
+	"\x18EXECUTION_STATE_CONFLICT\x10\x91\a\x1a\x04\xa8E\x99\x03\x12\x1f\n" +
+	"\x14SIGNING_KEY_CONFLICT\x10\x92\a\x1a\x04\xa8E\x99\x03\x12 \n" +
 	"\x15INTERNAL_SERVER_ERROR\x10\xd0\x0f\x1a\x04\xa8E\xf4\x03\x12\x19\n" +
 	"\x0eDATABASE_ERROR\x10\xd1\x0f\x1a\x04\xa8E\xf4\x03\x12\x1e\n" +
 	"\x13SERVICE_UNAVAILABLE\x10\xfc\x11\x1a\x04\xa8E\xf7\x03\x12\x1d\n" +
 	"\x12PORTAL_UNAVAILABLE\x10\xfd\x11\x1a\x04\xa8E\xf7\x03\x12\x19\n" +
-	"\x0eCLIENT_OFFLINE\x10\xfe\x11\x1a\x04\xa8E\xf7\x03\x1a\x04\xa0E\xf4\x03B\xea\x01\n" +
+	"\x0eCLIENT_OFFLINE\x10\xfe\x11\x1a\x04\xa8E\xf7\x03\x12!\n" +
+	"\x16SECRETS_NOT_CONFIGURED\x10\xff\x11\x1a\x04\xa8E\xf7\x03\x12!\n" +
+	"\x16SIGNING_NOT_CONFIGURED\x10\x80\x12\x1a\x04\xa8E\xf7\x03\x1a\x04\xa0E\xf4\x03B\xea\x01\n" +
 	"\x17com.executor.service.v1B\x12ExecutorErrorProtoP\x01ZMgithub.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1;executorpb\xa2\x02\x03ESX\xaa\x02\x13Executor.Service.V1\xca\x02\x13Executor\\Service\\V1\xe2\x02\x1fExecutor\\Service\\V1\\GPBMetadata\xea\x02\x15Executor::Service::V1b\x06proto3"
 
 var (
@@ -61,6 +61,42 @@ func ErrorPasswordRequired(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, ExecutorErrorReason_PASSWORD_REQUIRED.String(), fmt.Sprintf(format, args...))
 }
 
+func IsInvalidLabelSelector(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_INVALID_LABEL_SELECTOR.String() && e.Code == 400
+}
+
+func ErrorInvalidLabelSelector(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ExecutorErrorReason_INVALID_LABEL_SELECTOR.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidCronExpression(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_INVALID_CRON_EXPRESSION.String() && e.Code == 400
+}
+
+func ErrorInvalidCronExpression(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ExecutorErrorReason_INVALID_CRON_EXPRESSION.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidWorkflow(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_INVALID_WORKFLOW.String() && e.Code == 400
+}
+
+func ErrorInvalidWorkflow(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ExecutorErrorReason_INVALID_WORKFLOW.String(), fmt.Sprintf(format, args...))
+}
+
 // 401 - Unauthorized
 func IsUnauthorized(err error) bool {
 	if err == nil {
@@ -175,6 +211,162 @@ func ErrorCommandNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, ExecutorErrorReason_COMMAND_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
 
+func IsClientNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_CLIENT_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorClientNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_CLIENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsClientGroupNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_CLIENT_GROUP_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorClientGroupNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_CLIENT_GROUP_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRunNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_RUN_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorRunNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_RUN_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsScheduleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SCHEDULE_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorScheduleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_SCHEDULE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEventRuleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_EVENT_RULE_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorEventRuleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_EVENT_RULE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsWorkflowNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_WORKFLOW_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorWorkflowNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_WORKFLOW_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsWorkflowRunNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_WORKFLOW_RUN_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorWorkflowRunNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_WORKFLOW_RUN_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsMaintenanceWindowNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_MAINTENANCE_WINDOW_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorMaintenanceWindowNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_MAINTENANCE_WINDOW_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsScriptVersionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SCRIPT_VERSION_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorScriptVersionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_SCRIPT_VERSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSecretNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SECRET_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorSecretNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_SECRET_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSigningKeyNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SIGNING_KEY_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorSigningKeyNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_SIGNING_KEY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsScriptChangeRequestNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SCRIPT_CHANGE_REQUEST_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorScriptChangeRequestNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_SCRIPT_CHANGE_REQUEST_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsProtectedClientNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_PROTECTED_CLIENT_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorProtectedClientNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ExecutorErrorReason_PROTECTED_CLIENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
 // 409 - Conflict
 func IsAssignmentAlreadyExists(err error) bool {
 	if err == nil {
@@ -201,6 +393,168 @@ func ErrorScriptDisabled(format string, args ...interface{}) *errors.Error {
 	return errors.New(409, ExecutorErrorReason_SCRIPT_DISABLED.String(), fmt.Sprintf(format, args...))
 }
 
+func IsExecutionNotCancellable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_EXECUTION_NOT_CANCELLABLE.String() && e.Code == 409
+}
+
+func ErrorExecutionNotCancellable(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_EXECUTION_NOT_CANCELLABLE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsClientGroupAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_CLIENT_GROUP_ALREADY_EXISTS.String() && e.Code == 409
+}
+
+func ErrorClientGroupAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_CLIENT_GROUP_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRunStateConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_RUN_STATE_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorRunStateConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_RUN_STATE_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsConcurrencyLimitReached(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_CONCURRENCY_LIMIT_REACHED.String() && e.Code == 409
+}
+
+func ErrorConcurrencyLimitReached(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_CONCURRENCY_LIMIT_REACHED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsOutsideMaintenanceWindow(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_OUTSIDE_MAINTENANCE_WINDOW.String() && e.Code == 409
+}
+
+func ErrorOutsideMaintenanceWindow(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_OUTSIDE_MAINTENANCE_WINDOW.String(), fmt.Sprintf(format, args...))
+}
+
+func IsMaintenanceOverrideRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_MAINTENANCE_OVERRIDE_REQUIRED.String() && e.Code == 409
+}
+
+func ErrorMaintenanceOverrideRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_MAINTENANCE_OVERRIDE_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSecretAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SECRET_ALREADY_EXISTS.String() && e.Code == 409
+}
+
+func ErrorSecretAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_SECRET_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+func IsScriptChangeConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SCRIPT_CHANGE_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorScriptChangeConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_SCRIPT_CHANGE_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsExecutionApprovalConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_EXECUTION_APPROVAL_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorExecutionApprovalConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_EXECUTION_APPROVAL_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsProtectedClientAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_PROTECTED_CLIENT_ALREADY_EXISTS.String() && e.Code == 409
+}
+
+func ErrorProtectedClientAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_PROTECTED_CLIENT_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+// the client has no interpreter for the script type
+func IsScriptTypeNotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SCRIPT_TYPE_NOT_SUPPORTED.String() && e.Code == 409
+}
+
+// the client has no interpreter for the script type
+func ErrorScriptTypeNotSupported(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_SCRIPT_TYPE_NOT_SUPPORTED.String(), fmt.Sprintf(format, args...))
+}
+
+// the execution moved on before the update
+func IsExecutionStateConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_EXECUTION_STATE_CONFLICT.String() && e.Code == 409
+}
+
+// the execution moved on before the update
+func ErrorExecutionStateConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_EXECUTION_STATE_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+// the active signing key was replaced concurrently
+func IsSigningKeyConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SIGNING_KEY_CONFLICT.String() && e.Code == 409
+}
+
+// the active signing key was replaced concurrently
+func ErrorSigningKeyConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ExecutorErrorReason_SIGNING_KEY_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
 // 500 - Internal Server Error
 func IsInternalServerError(err error) bool {
 	if err == nil {
@@ -264,3 +618,27 @@ func IsClientOffline(err error) bool {
 func ErrorClientOffline(format string, args ...interface{}) *errors.Error {
 	return errors.New(503, ExecutorErrorReason_CLIENT_OFFLINE.String(), fmt.Sprintf(format, args...))
 }
+
+func IsSecretsNotConfigured(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SECRETS_NOT_CONFIGURED.String() && e.Code == 503
+}
+
+func ErrorSecretsNotConfigured(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, ExecutorErrorReason_SECRETS_NOT_CONFIGURED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSigningNotConfigured(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ExecutorErrorReason_SIGNING_NOT_CONFIGURED.String() && e.Code == 503
+}
+
+func ErrorSigningNotConfigured(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, ExecutorErrorReason_SIGNING_NOT_CONFIGURED.String(), fmt.Sprintf(format, args...))
+}
@@ -34,6 +34,8 @@ const (
 	ScriptType_SCRIPT_TYPE_BASH        ScriptType = 1
 	ScriptType_SCRIPT_TYPE_JAVASCRIPT  ScriptType = 2
 	ScriptType_SCRIPT_TYPE_LUA         ScriptType = 3
+	ScriptType_SCRIPT_TYPE_PYTHON      ScriptType = 4 // python3 on the client
+	ScriptType_SCRIPT_TYPE_POWERSHELL  ScriptType = 5 // PowerShell Core (pwsh) on the client
 )
 
 // Enum value maps for ScriptType.
@@ -43,12 +45,16 @@ var (
 		1: "SCRIPT_TYPE_BASH",
 		2: "SCRIPT_TYPE_JAVASCRIPT",
 		3: "SCRIPT_TYPE_LUA",
+		4: "SCRIPT_TYPE_PYTHON",
+		5: "SCRIPT_TYPE_POWERSHELL",
 	}
 	ScriptType_value = map[string]int32{
 		"SCRIPT_TYPE_UNSPECIFIED": 0,
 		"SCRIPT_TYPE_BASH":        1,
 		"SCRIPT_TYPE_JAVASCRIPT":  2,
 		"SCRIPT_TYPE_LUA":         3,
+		"SCRIPT_TYPE_PYTHON":      4,
+		"SCRIPT_TYPE_POWERSHELL":  5,
 	}
 )
 
@@ -79,24 +85,188 @@ func (ScriptType) EnumDescriptor() ([]byte, []int) {
 	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{0}
 }
 
+// Type of a script parameter value. Values travel as strings and are
+// checked against the type when supplied.
+type ParameterType int32
+
+const (
+	ParameterType_PARAMETER_TYPE_UNSPECIFIED ParameterType = 0 // same as STRING
+	ParameterType_PARAMETER_TYPE_STRING      ParameterType = 1
+	ParameterType_PARAMETER_TYPE_INTEGER     ParameterType = 2
+	ParameterType_PARAMETER_TYPE_NUMBER      ParameterType = 3
+	ParameterType_PARAMETER_TYPE_BOOLEAN     ParameterType = 4 // true or false
+)
+
+// Enum value maps for ParameterType.
+var (
+	ParameterType_name = map[int32]string{
+		0: "PARAMETER_TYPE_UNSPECIFIED",
+		1: "PARAMETER_TYPE_STRING",
+		2: "PARAMETER_TYPE_INTEGER",
+		3: "PARAMETER_TYPE_NUMBER",
+		4: "PARAMETER_TYPE_BOOLEAN",
+	}
+	ParameterType_value = map[string]int32{
+		"PARAMETER_TYPE_UNSPECIFIED": 0,
+		"PARAMETER_TYPE_STRING":      1,
+		"PARAMETER_TYPE_INTEGER":     2,
+		"PARAMETER_TYPE_NUMBER":      3,
+		"PARAMETER_TYPE_BOOLEAN":     4,
+	}
+)
+
+func (x ParameterType) Enum() *ParameterType {
+	p := new(ParameterType)
+	*p = x
+	return p
+}
+
+func (x ParameterType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParameterType) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_service_v1_script_proto_enumTypes[1].Descriptor()
+}
+
+func (ParameterType) Type() protoreflect.EnumType {
+	return &file_executor_service_v1_script_proto_enumTypes[1]
+}
+
+func (x ParameterType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParameterType.Descriptor instead.
+func (ParameterType) EnumDescriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{1}
+}
+
+// How a parameter value reaches the script on the client
+type ParameterDelivery int32
+
+const (
+	ParameterDelivery_PARAMETER_DELIVERY_UNSPECIFIED ParameterDelivery = 0 // same as ENV
+	ParameterDelivery_PARAMETER_DELIVERY_ENV         ParameterDelivery = 1 // environment variable named after the parameter
+	ParameterDelivery_PARAMETER_DELIVERY_ARG         ParameterDelivery = 2 // positional argument, in declaration order
+)
+
+// Enum value maps for ParameterDelivery.
+var (
+	ParameterDelivery_name = map[int32]string{
+		0: "PARAMETER_DELIVERY_UNSPECIFIED",
+		1: "PARAMETER_DELIVERY_ENV",
+		2: "PARAMETER_DELIVERY_ARG",
+	}
+	ParameterDelivery_value = map[string]int32{
+		"PARAMETER_DELIVERY_UNSPECIFIED": 0,
+		"PARAMETER_DELIVERY_ENV":         1,
+		"PARAMETER_DELIVERY_ARG":         2,
+	}
+)
+
+func (x ParameterDelivery) Enum() *ParameterDelivery {
+	p := new(ParameterDelivery)
+	*p = x
+	return p
+}
+
+func (x ParameterDelivery) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParameterDelivery) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_service_v1_script_proto_enumTypes[2].Descriptor()
+}
+
+func (ParameterDelivery) Type() protoreflect.EnumType {
+	return &file_executor_service_v1_script_proto_enumTypes[2]
+}
+
+func (x ParameterDelivery) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParameterDelivery.Descriptor instead.
+func (ParameterDelivery) EnumDescriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{2}
+}
+
+// What happens to a new execution of a script while a concurrency limit is
+// reached: the script is a singleton already active on the client, or the
+// client has as many active executions as the tenant allows
+type ConcurrencyLimitAction int32
+
+const (
+	ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_UNSPECIFIED ConcurrencyLimitAction = 0 // same as REJECT
+	ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_REJECT      ConcurrencyLimitAction = 1 // fail with CONCURRENCY_LIMIT_REACHED
+	ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_QUEUE       ConcurrencyLimitAction = 2 // wait for a free slot, oldest first
+)
+
+// Enum value maps for ConcurrencyLimitAction.
+var (
+	ConcurrencyLimitAction_name = map[int32]string{
+		0: "CONCURRENCY_LIMIT_ACTION_UNSPECIFIED",
+		1: "CONCURRENCY_LIMIT_ACTION_REJECT",
+		2: "CONCURRENCY_LIMIT_ACTION_QUEUE",
+	}
+	ConcurrencyLimitAction_value = map[string]int32{
+		"CONCURRENCY_LIMIT_ACTION_UNSPECIFIED": 0,
+		"CONCURRENCY_LIMIT_ACTION_REJECT":      1,
+		"CONCURRENCY_LIMIT_ACTION_QUEUE":       2,
+	}
+)
+
+func (x ConcurrencyLimitAction) Enum() *ConcurrencyLimitAction {
+	p := new(ConcurrencyLimitAction)
+	*p = x
+	return p
+}
+
+func (x ConcurrencyLimitAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConcurrencyLimitAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_service_v1_script_proto_enumTypes[3].Descriptor()
+}
+
+func (ConcurrencyLimitAction) Type() protoreflect.EnumType {
+	return &file_executor_service_v1_script_proto_enumTypes[3]
+}
+
+func (x ConcurrencyLimitAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConcurrencyLimitAction.Descriptor instead.
+func (ConcurrencyLimitAction) EnumDescriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{3}
+}
+
 // Script entity
 type Script struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId      uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ScriptType    ScriptType             `protobuf:"varint,5,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
-	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	ContentHash   string                 `protobuf:"bytes,7,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
-	Version       int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
-	Enabled       bool                   `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	CreatedBy     *uint32                `protobuf:"varint,10,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	UpdatedBy     *uint32                `protobuf:"varint,11,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId               uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name                   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description            string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ScriptType             ScriptType             `protobuf:"varint,5,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
+	Content                string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	ContentHash            string                 `protobuf:"bytes,7,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	Version                int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Enabled                bool                   `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedBy              *uint32                `protobuf:"varint,10,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy              *uint32                `protobuf:"varint,11,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	CreateTime             *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime             *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3,oneof" json:"update_time,omitempty"`
+	TimeoutSeconds         *int32                 `protobuf:"varint,14,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"` // unset = tenant default
+	RetryPolicy            *RetryPolicy           `protobuf:"bytes,15,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`           // unset = no retries
+	Singleton              bool                   `protobuf:"varint,16,opt,name=singleton,proto3" json:"singleton,omitempty"`                                       // one active execution per client at a time
+	ConcurrencyLimitAction ConcurrencyLimitAction `protobuf:"varint,17,opt,name=concurrency_limit_action,json=concurrencyLimitAction,proto3,enum=executor.service.v1.ConcurrencyLimitAction" json:"concurrency_limit_action,omitempty"`
+	Parameters             []*ScriptParameter     `protobuf:"bytes,18,rep,name=parameters,proto3" json:"parameters,omitempty"` // inputs supplied when the script runs
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Script) Reset() {
@@ -220,32 +390,76 @@ func (x *Script) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
-// Create script request
-type CreateScriptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ScriptType    ScriptType             `protobuf:"varint,3,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+func (x *Script) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Script) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Script) GetSingleton() bool {
+	if x != nil {
+		return x.Singleton
+	}
+	return false
+}
+
+func (x *Script) GetConcurrencyLimitAction() ConcurrencyLimitAction {
+	if x != nil {
+		return x.ConcurrencyLimitAction
+	}
+	return ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_UNSPECIFIED
+}
+
+func (x *Script) GetParameters() []*ScriptParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+// An input a script declares. Values come from the default, the assignments
+// that reach the client and the trigger, later ones taking precedence.
+type ScriptParameter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Letters, digits and underscores, not starting with a digit
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        ParameterType `protobuf:"varint,2,opt,name=type,proto3,enum=executor.service.v1.ParameterType" json:"type,omitempty"`
+	Description *string       `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Executions without a value for the parameter are rejected
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// Not allowed on secret parameters
+	DefaultValue *string `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	// Values the parameter is restricted to; empty allows any value of the type
+	AllowedValues []string `protobuf:"bytes,6,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	// Masked wherever the value is shown or recorded
+	Secret        bool              `protobuf:"varint,7,opt,name=secret,proto3" json:"secret,omitempty"`
+	Delivery      ParameterDelivery `protobuf:"varint,8,opt,name=delivery,proto3,enum=executor.service.v1.ParameterDelivery" json:"delivery,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateScriptRequest) Reset() {
-	*x = CreateScriptRequest{}
+func (x *ScriptParameter) Reset() {
+	*x = ScriptParameter{}
 	mi := &file_executor_service_v1_script_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateScriptRequest) String() string {
+func (x *ScriptParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateScriptRequest) ProtoMessage() {}
+func (*ScriptParameter) ProtoMessage() {}
 
-func (x *CreateScriptRequest) ProtoReflect() protoreflect.Message {
+func (x *ScriptParameter) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_service_v1_script_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -257,67 +471,89 @@ func (x *CreateScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateScriptRequest.ProtoReflect.Descriptor instead.
-func (*CreateScriptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScriptParameter.ProtoReflect.Descriptor instead.
+func (*ScriptParameter) Descriptor() ([]byte, []int) {
 	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateScriptRequest) GetName() string {
+func (x *ScriptParameter) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateScriptRequest) GetDescription() string {
+func (x *ScriptParameter) GetType() ParameterType {
 	if x != nil {
-		return x.Description
+		return x.Type
+	}
+	return ParameterType_PARAMETER_TYPE_UNSPECIFIED
+}
+
+func (x *ScriptParameter) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *CreateScriptRequest) GetScriptType() ScriptType {
+func (x *ScriptParameter) GetRequired() bool {
 	if x != nil {
-		return x.ScriptType
+		return x.Required
 	}
-	return ScriptType_SCRIPT_TYPE_UNSPECIFIED
+	return false
 }
 
-func (x *CreateScriptRequest) GetContent() string {
-	if x != nil {
-		return x.Content
+func (x *ScriptParameter) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
 	}
 	return ""
 }
 
-func (x *CreateScriptRequest) GetEnabled() bool {
+func (x *ScriptParameter) GetAllowedValues() []string {
 	if x != nil {
-		return x.Enabled
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *ScriptParameter) GetSecret() bool {
+	if x != nil {
+		return x.Secret
 	}
 	return false
 }
 
-type CreateScriptResponse struct {
+func (x *ScriptParameter) GetDelivery() ParameterDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return ParameterDelivery_PARAMETER_DELIVERY_UNSPECIFIED
+}
+
+// Script parameters, wrapped so an update can tell "unchanged" from "cleared"
+type ScriptParameterList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *Script                `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Values        []*ScriptParameter     `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateScriptResponse) Reset() {
-	*x = CreateScriptResponse{}
+func (x *ScriptParameterList) Reset() {
+	*x = ScriptParameterList{}
 	mi := &file_executor_service_v1_script_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateScriptResponse) String() string {
+func (x *ScriptParameterList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateScriptResponse) ProtoMessage() {}
+func (*ScriptParameterList) ProtoMessage() {}
 
-func (x *CreateScriptResponse) ProtoReflect() protoreflect.Message {
+func (x *ScriptParameterList) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_service_v1_script_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -329,41 +565,1693 @@ func (x *CreateScriptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateScriptResponse.ProtoReflect.Descriptor instead.
-func (*CreateScriptResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ScriptParameterList.ProtoReflect.Descriptor instead.
+func (*ScriptParameterList) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScriptParameterList) GetValues() []*ScriptParameter {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// How failed or undelivered server-initiated executions of a script are
+// retried. Every retry is a new execution linked to the first attempt.
+type RetryPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Attempts in total, including the first; 0 or 1 disables retries
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Delay before the first retry; doubles for every further attempt
+	BackoffSeconds uint32 `protobuf:"varint,2,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoff_seconds,omitempty"`
+	// Upper bound of the delay; 0 means no bound
+	MaxBackoffSeconds uint32 `protobuf:"varint,3,opt,name=max_backoff_seconds,json=maxBackoffSeconds,proto3" json:"max_backoff_seconds,omitempty"`
+	// Exit codes of FAILED executions that are retried; empty retries every
+	// non-zero exit code
+	RetryableExitCodes []int32 `protobuf:"varint,4,rep,packed,name=retryable_exit_codes,json=retryableExitCodes,proto3" json:"retryable_exit_codes,omitempty"`
+	// Final statuses that are retried: FAILED, CLIENT_OFFLINE or TIMED_OUT.
+	// Empty retries all three.
+	RetryableStatuses []ExecutionStatus `protobuf:"varint,5,rep,packed,name=retryable_statuses,json=retryableStatuses,proto3,enum=executor.service.v1.ExecutionStatus" json:"retryable_statuses,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffSeconds() uint32 {
+	if x != nil {
+		return x.BackoffSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxBackoffSeconds() uint32 {
+	if x != nil {
+		return x.MaxBackoffSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryableExitCodes() []int32 {
+	if x != nil {
+		return x.RetryableExitCodes
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetRetryableStatuses() []ExecutionStatus {
+	if x != nil {
+		return x.RetryableStatuses
+	}
+	return nil
+}
+
+// Create script request
+type CreateScriptRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ScriptType  ScriptType             `protobuf:"varint,3,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
+	Content     string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Enabled     bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Execution timeout; unset falls back to the tenant default
+	TimeoutSeconds *int32       `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	RetryPolicy    *RetryPolicy `protobuf:"bytes,7,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// Allow only one active execution of the script per client
+	Singleton              bool                   `protobuf:"varint,8,opt,name=singleton,proto3" json:"singleton,omitempty"`
+	ConcurrencyLimitAction ConcurrencyLimitAction `protobuf:"varint,9,opt,name=concurrency_limit_action,json=concurrencyLimitAction,proto3,enum=executor.service.v1.ConcurrencyLimitAction" json:"concurrency_limit_action,omitempty"`
+	// Recorded with the first version
+	ChangeNote    *string            `protobuf:"bytes,10,opt,name=change_note,json=changeNote,proto3,oneof" json:"change_note,omitempty"`
+	Parameters    []*ScriptParameter `protobuf:"bytes,11,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScriptRequest) Reset() {
+	*x = CreateScriptRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScriptRequest) ProtoMessage() {}
+
+func (x *CreateScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScriptRequest.ProtoReflect.Descriptor instead.
+func (*CreateScriptRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateScriptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateScriptRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateScriptRequest) GetScriptType() ScriptType {
+	if x != nil {
+		return x.ScriptType
+	}
+	return ScriptType_SCRIPT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateScriptRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateScriptRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CreateScriptRequest) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CreateScriptRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CreateScriptRequest) GetSingleton() bool {
+	if x != nil {
+		return x.Singleton
+	}
+	return false
+}
+
+func (x *CreateScriptRequest) GetConcurrencyLimitAction() ConcurrencyLimitAction {
+	if x != nil {
+		return x.ConcurrencyLimitAction
+	}
+	return ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_UNSPECIFIED
+}
+
+func (x *CreateScriptRequest) GetChangeNote() string {
+	if x != nil && x.ChangeNote != nil {
+		return *x.ChangeNote
+	}
+	return ""
+}
+
+func (x *CreateScriptRequest) GetParameters() []*ScriptParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type CreateScriptResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Script *Script                `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	// Findings below the tenant's block severity
+	Diagnostics []*ScriptDiagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// Set when the tenant requires approval; the script has no content until
+	// the request is approved
+	ChangeRequest *ScriptChangeRequest `protobuf:"bytes,3,opt,name=change_request,json=changeRequest,proto3,oneof" json:"change_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScriptResponse) Reset() {
+	*x = CreateScriptResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScriptResponse) ProtoMessage() {}
+
+func (x *CreateScriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScriptResponse.ProtoReflect.Descriptor instead.
+func (*CreateScriptResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateScriptResponse) GetScript() *Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *CreateScriptResponse) GetDiagnostics() []*ScriptDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *CreateScriptResponse) GetChangeRequest() *ScriptChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+// Validate script request
+type ValidateScriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScriptType    ScriptType             `protobuf:"varint,1,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType" json:"script_type,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateScriptRequest) Reset() {
+	*x = ValidateScriptRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateScriptRequest) ProtoMessage() {}
+
+func (x *ValidateScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateScriptRequest.ProtoReflect.Descriptor instead.
+func (*ValidateScriptRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ValidateScriptRequest) GetScriptType() ScriptType {
+	if x != nil {
+		return x.ScriptType
+	}
+	return ScriptType_SCRIPT_TYPE_UNSPECIFIED
+}
+
+func (x *ValidateScriptRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ValidateScriptResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Diagnostics []*ScriptDiagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// Whether saving the content would be refused under the tenant's block
+	// severity
+	Blocked       bool               `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	BlockSeverity DiagnosticSeverity `protobuf:"varint,3,opt,name=block_severity,json=blockSeverity,proto3,enum=executor.service.v1.DiagnosticSeverity" json:"block_severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateScriptResponse) Reset() {
+	*x = ValidateScriptResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateScriptResponse) ProtoMessage() {}
+
+func (x *ValidateScriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateScriptResponse.ProtoReflect.Descriptor instead.
+func (*ValidateScriptResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidateScriptResponse) GetDiagnostics() []*ScriptDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *ValidateScriptResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *ValidateScriptResponse) GetBlockSeverity() DiagnosticSeverity {
+	if x != nil {
+		return x.BlockSeverity
+	}
+	return DiagnosticSeverity_DIAGNOSTIC_SEVERITY_UNSPECIFIED
+}
+
+// List lint rules request
+type ListLintRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLintRulesRequest) Reset() {
+	*x = ListLintRulesRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLintRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLintRulesRequest) ProtoMessage() {}
+
+func (x *ListLintRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLintRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListLintRulesRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{8}
+}
+
+type ListLintRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*LintRule            `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLintRulesResponse) Reset() {
+	*x = ListLintRulesResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLintRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLintRulesResponse) ProtoMessage() {}
+
+func (x *ListLintRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLintRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListLintRulesResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLintRulesResponse) GetRules() []*LintRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Get script request
+type GetScriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScriptRequest) Reset() {
+	*x = GetScriptRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScriptRequest) ProtoMessage() {}
+
+func (x *GetScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScriptRequest.ProtoReflect.Descriptor instead.
+func (*GetScriptRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetScriptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetScriptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        *Script                `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScriptResponse) Reset() {
+	*x = GetScriptResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScriptResponse) ProtoMessage() {}
+
+func (x *GetScriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScriptResponse.ProtoReflect.Descriptor instead.
+func (*GetScriptResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetScriptResponse) GetScript() *Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+// List scripts request
+type ListScriptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	ScriptType    *ScriptType            `protobuf:"varint,3,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType,oneof" json:"script_type,omitempty"`
+	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScriptsRequest) Reset() {
+	*x = ListScriptsRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScriptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScriptsRequest) ProtoMessage() {}
+
+func (x *ListScriptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScriptsRequest.ProtoReflect.Descriptor instead.
+func (*ListScriptsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListScriptsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListScriptsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScriptsRequest) GetScriptType() ScriptType {
+	if x != nil && x.ScriptType != nil {
+		return *x.ScriptType
+	}
+	return ScriptType_SCRIPT_TYPE_UNSPECIFIED
+}
+
+func (x *ListScriptsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListScriptsRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type ListScriptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scripts       []*Script              `protobuf:"bytes,1,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScriptsResponse) Reset() {
+	*x = ListScriptsResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScriptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScriptsResponse) ProtoMessage() {}
+
+func (x *ListScriptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScriptsResponse.ProtoReflect.Descriptor instead.
+func (*ListScriptsResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListScriptsResponse) GetScripts() []*Script {
+	if x != nil {
+		return x.Scripts
+	}
+	return nil
+}
+
+func (x *ListScriptsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Update script request
+type UpdateScriptRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Content     *string                `protobuf:"bytes,4,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Enabled     *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	// Password required when content changes
+	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Execution timeout; 0 clears it so the tenant default applies
+	TimeoutSeconds *int32 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	// Replaces the retry policy; max_attempts 0 turns retries off
+	RetryPolicy            *RetryPolicy            `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	Singleton              *bool                   `protobuf:"varint,9,opt,name=singleton,proto3,oneof" json:"singleton,omitempty"`
+	ConcurrencyLimitAction *ConcurrencyLimitAction `protobuf:"varint,10,opt,name=concurrency_limit_action,json=concurrencyLimitAction,proto3,enum=executor.service.v1.ConcurrencyLimitAction,oneof" json:"concurrency_limit_action,omitempty"`
+	// Recorded with the new version when content changes
+	ChangeNote *string `protobuf:"bytes,11,opt,name=change_note,json=changeNote,proto3,oneof" json:"change_note,omitempty"`
+	// Replaces all parameters when set
+	Parameters    *ScriptParameterList `protobuf:"bytes,12,opt,name=parameters,proto3,oneof" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScriptRequest) Reset() {
+	*x = UpdateScriptRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScriptRequest) ProtoMessage() {}
+
+func (x *UpdateScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScriptRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScriptRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateScriptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateScriptRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateScriptRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateScriptRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateScriptRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateScriptRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *UpdateScriptRequest) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *UpdateScriptRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *UpdateScriptRequest) GetSingleton() bool {
+	if x != nil && x.Singleton != nil {
+		return *x.Singleton
+	}
+	return false
+}
+
+func (x *UpdateScriptRequest) GetConcurrencyLimitAction() ConcurrencyLimitAction {
+	if x != nil && x.ConcurrencyLimitAction != nil {
+		return *x.ConcurrencyLimitAction
+	}
+	return ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_UNSPECIFIED
+}
+
+func (x *UpdateScriptRequest) GetChangeNote() string {
+	if x != nil && x.ChangeNote != nil {
+		return *x.ChangeNote
+	}
+	return ""
+}
+
+func (x *UpdateScriptRequest) GetParameters() *ScriptParameterList {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type UpdateScriptResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Script *Script                `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	// Set when the content change awaits approval; the script keeps its current
+	// content until then
+	ChangeRequest *ScriptChangeRequest `protobuf:"bytes,2,opt,name=change_request,json=changeRequest,proto3,oneof" json:"change_request,omitempty"`
+	// Findings on changed content below the tenant's block severity
+	Diagnostics   []*ScriptDiagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScriptResponse) Reset() {
+	*x = UpdateScriptResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScriptResponse) ProtoMessage() {}
+
+func (x *UpdateScriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScriptResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScriptResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateScriptResponse) GetScript() *Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *UpdateScriptResponse) GetChangeRequest() *ScriptChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+func (x *UpdateScriptResponse) GetDiagnostics() []*ScriptDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+// Delete script request
+type DeleteScriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScriptRequest) Reset() {
+	*x = DeleteScriptRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScriptRequest) ProtoMessage() {}
+
+func (x *DeleteScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScriptRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScriptRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteScriptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// List script versions request
+type ListScriptVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId      string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	Page          *uint32                `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScriptVersionsRequest) Reset() {
+	*x = ListScriptVersionsRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScriptVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScriptVersionsRequest) ProtoMessage() {}
+
+func (x *ListScriptVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScriptVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListScriptVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListScriptVersionsRequest) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *ListScriptVersionsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListScriptVersionsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListScriptVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*ScriptVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScriptVersionsResponse) Reset() {
+	*x = ListScriptVersionsResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScriptVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScriptVersionsResponse) ProtoMessage() {}
+
+func (x *ListScriptVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScriptVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListScriptVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListScriptVersionsResponse) GetVersions() []*ScriptVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListScriptVersionsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Get script version request
+type GetScriptVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId      string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScriptVersionRequest) Reset() {
+	*x = GetScriptVersionRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScriptVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScriptVersionRequest) ProtoMessage() {}
+
+func (x *GetScriptVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScriptVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetScriptVersionRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetScriptVersionRequest) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *GetScriptVersionRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetScriptVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *ScriptVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScriptVersionResponse) Reset() {
+	*x = GetScriptVersionResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScriptVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScriptVersionResponse) ProtoMessage() {}
+
+func (x *GetScriptVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScriptVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetScriptVersionResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetScriptVersionResponse) GetVersion() *ScriptVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// Diff script versions request
+type DiffScriptVersionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ScriptId    string                 `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	FromVersion uint32                 `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// Defaults to the current version
+	ToVersion     *uint32 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3,oneof" json:"to_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffScriptVersionsRequest) Reset() {
+	*x = DiffScriptVersionsRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffScriptVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffScriptVersionsRequest) ProtoMessage() {}
+
+func (x *DiffScriptVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffScriptVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffScriptVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DiffScriptVersionsRequest) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *DiffScriptVersionsRequest) GetFromVersion() uint32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffScriptVersionsRequest) GetToVersion() uint32 {
+	if x != nil && x.ToVersion != nil {
+		return *x.ToVersion
+	}
+	return 0
+}
+
+type DiffScriptVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromVersion   uint32                 `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     uint32                 `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Diff          string                 `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"` // unified diff, empty when identical
+	AddedLines    uint32                 `protobuf:"varint,4,opt,name=added_lines,json=addedLines,proto3" json:"added_lines,omitempty"`
+	RemovedLines  uint32                 `protobuf:"varint,5,opt,name=removed_lines,json=removedLines,proto3" json:"removed_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffScriptVersionsResponse) Reset() {
+	*x = DiffScriptVersionsResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffScriptVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffScriptVersionsResponse) ProtoMessage() {}
+
+func (x *DiffScriptVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffScriptVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffScriptVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DiffScriptVersionsResponse) GetFromVersion() uint32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffScriptVersionsResponse) GetToVersion() uint32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffScriptVersionsResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *DiffScriptVersionsResponse) GetAddedLines() uint32 {
+	if x != nil {
+		return x.AddedLines
+	}
+	return 0
+}
+
+func (x *DiffScriptVersionsResponse) GetRemovedLines() uint32 {
+	if x != nil {
+		return x.RemovedLines
+	}
+	return 0
+}
+
+// Rollback script request
+type RollbackScriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ChangeNote    *string                `protobuf:"bytes,4,opt,name=change_note,json=changeNote,proto3,oneof" json:"change_note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackScriptRequest) Reset() {
+	*x = RollbackScriptRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackScriptRequest) ProtoMessage() {}
+
+func (x *RollbackScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackScriptRequest.ProtoReflect.Descriptor instead.
+func (*RollbackScriptRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RollbackScriptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RollbackScriptRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollbackScriptRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RollbackScriptRequest) GetChangeNote() string {
+	if x != nil && x.ChangeNote != nil {
+		return *x.ChangeNote
+	}
+	return ""
+}
+
+type RollbackScriptResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Script *Script                `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	// Set when the rollback awaits approval
+	ChangeRequest *ScriptChangeRequest `protobuf:"bytes,2,opt,name=change_request,json=changeRequest,proto3,oneof" json:"change_request,omitempty"`
+	// Findings on the restored content below the tenant's block severity
+	Diagnostics   []*ScriptDiagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackScriptResponse) Reset() {
+	*x = RollbackScriptResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackScriptResponse) ProtoMessage() {}
+
+func (x *RollbackScriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackScriptResponse.ProtoReflect.Descriptor instead.
+func (*RollbackScriptResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RollbackScriptResponse) GetScript() *Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *RollbackScriptResponse) GetChangeRequest() *ScriptChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+func (x *RollbackScriptResponse) GetDiagnostics() []*ScriptDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+// List script change requests request
+type ListScriptChangeRequestsRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	ScriptId      *string                    `protobuf:"bytes,1,opt,name=script_id,json=scriptId,proto3,oneof" json:"script_id,omitempty"`
+	Status        *ScriptChangeRequestStatus `protobuf:"varint,2,opt,name=status,proto3,enum=executor.service.v1.ScriptChangeRequestStatus,oneof" json:"status,omitempty"`
+	Page          *uint32                    `protobuf:"varint,3,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32                    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScriptChangeRequestsRequest) Reset() {
+	*x = ListScriptChangeRequestsRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScriptChangeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScriptChangeRequestsRequest) ProtoMessage() {}
+
+func (x *ListScriptChangeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScriptChangeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListScriptChangeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListScriptChangeRequestsRequest) GetScriptId() string {
+	if x != nil && x.ScriptId != nil {
+		return *x.ScriptId
+	}
+	return ""
+}
+
+func (x *ListScriptChangeRequestsRequest) GetStatus() ScriptChangeRequestStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListScriptChangeRequestsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListScriptChangeRequestsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListScriptChangeRequestsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequests []*ScriptChangeRequest `protobuf:"bytes,1,rep,name=change_requests,json=changeRequests,proto3" json:"change_requests,omitempty"`
+	Total          uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListScriptChangeRequestsResponse) Reset() {
+	*x = ListScriptChangeRequestsResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScriptChangeRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScriptChangeRequestsResponse) ProtoMessage() {}
+
+func (x *ListScriptChangeRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScriptChangeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListScriptChangeRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListScriptChangeRequestsResponse) GetChangeRequests() []*ScriptChangeRequest {
+	if x != nil {
+		return x.ChangeRequests
+	}
+	return nil
+}
+
+func (x *ListScriptChangeRequestsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Get script change request request
+type GetScriptChangeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScriptChangeRequestRequest) Reset() {
+	*x = GetScriptChangeRequestRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScriptChangeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScriptChangeRequestRequest) ProtoMessage() {}
+
+func (x *GetScriptChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScriptChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetScriptChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetScriptChangeRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetScriptChangeRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequest *ScriptChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	Diff          string                 `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"` // unified diff against the base version
+	AddedLines    uint32                 `protobuf:"varint,3,opt,name=added_lines,json=addedLines,proto3" json:"added_lines,omitempty"`
+	RemovedLines  uint32                 `protobuf:"varint,4,opt,name=removed_lines,json=removedLines,proto3" json:"removed_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScriptChangeRequestResponse) Reset() {
+	*x = GetScriptChangeRequestResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScriptChangeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScriptChangeRequestResponse) ProtoMessage() {}
+
+func (x *GetScriptChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScriptChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetScriptChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateScriptResponse) GetScript() *Script {
+func (x *GetScriptChangeRequestResponse) GetChangeRequest() *ScriptChangeRequest {
 	if x != nil {
-		return x.Script
+		return x.ChangeRequest
 	}
 	return nil
 }
 
-// Get script request
-type GetScriptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *GetScriptChangeRequestResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *GetScriptChangeRequestResponse) GetAddedLines() uint32 {
+	if x != nil {
+		return x.AddedLines
+	}
+	return 0
+}
+
+func (x *GetScriptChangeRequestResponse) GetRemovedLines() uint32 {
+	if x != nil {
+		return x.RemovedLines
+	}
+	return 0
+}
+
+// Approve script change request request
+type ApproveScriptChangeRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The approver's password
+	Password      string  `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Comment       *string `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetScriptRequest) Reset() {
-	*x = GetScriptRequest{}
-	mi := &file_executor_service_v1_script_proto_msgTypes[3]
+func (x *ApproveScriptChangeRequestRequest) Reset() {
+	*x = ApproveScriptChangeRequestRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetScriptRequest) String() string {
+func (x *ApproveScriptChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScriptRequest) ProtoMessage() {}
+func (*ApproveScriptChangeRequestRequest) ProtoMessage() {}
 
-func (x *GetScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_script_proto_msgTypes[3]
+func (x *ApproveScriptChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,40 +2262,57 @@ func (x *GetScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScriptRequest.ProtoReflect.Descriptor instead.
-func (*GetScriptRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ApproveScriptChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveScriptChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetScriptRequest) GetId() string {
+func (x *ApproveScriptChangeRequestRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetScriptResponse struct {
+func (x *ApproveScriptChangeRequestRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ApproveScriptChangeRequestRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type ApproveScriptChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *Script                `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	ChangeRequest *ScriptChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	Script        *Script                `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	// Findings on the applied content below the tenant's block severity
+	Diagnostics   []*ScriptDiagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetScriptResponse) Reset() {
-	*x = GetScriptResponse{}
-	mi := &file_executor_service_v1_script_proto_msgTypes[4]
+func (x *ApproveScriptChangeRequestResponse) Reset() {
+	*x = ApproveScriptChangeRequestResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetScriptResponse) String() string {
+func (x *ApproveScriptChangeRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScriptResponse) ProtoMessage() {}
+func (*ApproveScriptChangeRequestResponse) ProtoMessage() {}
 
-func (x *GetScriptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_script_proto_msgTypes[4]
+func (x *ApproveScriptChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,45 +2323,56 @@ func (x *GetScriptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScriptResponse.ProtoReflect.Descriptor instead.
-func (*GetScriptResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ApproveScriptChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveScriptChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetScriptResponse) GetScript() *Script {
+func (x *ApproveScriptChangeRequestResponse) GetChangeRequest() *ScriptChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+func (x *ApproveScriptChangeRequestResponse) GetScript() *Script {
 	if x != nil {
 		return x.Script
 	}
 	return nil
 }
 
-// List scripts request
-type ListScriptsRequest struct {
+func (x *ApproveScriptChangeRequestResponse) GetDiagnostics() []*ScriptDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+// Reject script change request request
+type RejectScriptChangeRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *uint32                `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize      *uint32                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	ScriptType    *ScriptType            `protobuf:"varint,3,opt,name=script_type,json=scriptType,proto3,enum=executor.service.v1.ScriptType,oneof" json:"script_type,omitempty"`
-	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Enabled       *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListScriptsRequest) Reset() {
-	*x = ListScriptsRequest{}
-	mi := &file_executor_service_v1_script_proto_msgTypes[5]
+func (x *RejectScriptChangeRequestRequest) Reset() {
+	*x = RejectScriptChangeRequestRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListScriptsRequest) String() string {
+func (x *RejectScriptChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScriptsRequest) ProtoMessage() {}
+func (*RejectScriptChangeRequestRequest) ProtoMessage() {}
 
-func (x *ListScriptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_script_proto_msgTypes[5]
+func (x *RejectScriptChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,69 +2383,47 @@ func (x *ListScriptsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScriptsRequest.ProtoReflect.Descriptor instead.
-func (*ListScriptsRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListScriptsRequest) GetPage() uint32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-func (x *ListScriptsRequest) GetPageSize() uint32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
-	}
-	return 0
-}
-
-func (x *ListScriptsRequest) GetScriptType() ScriptType {
-	if x != nil && x.ScriptType != nil {
-		return *x.ScriptType
-	}
-	return ScriptType_SCRIPT_TYPE_UNSPECIFIED
+// Deprecated: Use RejectScriptChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectScriptChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListScriptsRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *RejectScriptChangeRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListScriptsRequest) GetEnabled() bool {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
+func (x *RejectScriptChangeRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
-type ListScriptsResponse struct {
+type RejectScriptChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Scripts       []*Script              `protobuf:"bytes,1,rep,name=scripts,proto3" json:"scripts,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ChangeRequest *ScriptChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListScriptsResponse) Reset() {
-	*x = ListScriptsResponse{}
-	mi := &file_executor_service_v1_script_proto_msgTypes[6]
+func (x *RejectScriptChangeRequestResponse) Reset() {
+	*x = RejectScriptChangeRequestResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListScriptsResponse) String() string {
+func (x *RejectScriptChangeRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScriptsResponse) ProtoMessage() {}
+func (*RejectScriptChangeRequestResponse) ProtoMessage() {}
 
-func (x *ListScriptsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_script_proto_msgTypes[6]
+func (x *RejectScriptChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,54 +2434,41 @@ func (x *ListScriptsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScriptsResponse.ProtoReflect.Descriptor instead.
-func (*ListScriptsResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RejectScriptChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectScriptChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListScriptsResponse) GetScripts() []*Script {
+func (x *RejectScriptChangeRequestResponse) GetChangeRequest() *ScriptChangeRequest {
 	if x != nil {
-		return x.Scripts
+		return x.ChangeRequest
 	}
 	return nil
 }
 
-func (x *ListScriptsResponse) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// Update script request
-type UpdateScriptRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Content     *string                `protobuf:"bytes,4,opt,name=content,proto3,oneof" json:"content,omitempty"`
-	Enabled     *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
-	// Password required when content changes
-	Password      *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+// Cancel script change request request
+type CancelScriptChangeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateScriptRequest) Reset() {
-	*x = UpdateScriptRequest{}
-	mi := &file_executor_service_v1_script_proto_msgTypes[7]
+func (x *CancelScriptChangeRequestRequest) Reset() {
+	*x = CancelScriptChangeRequestRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateScriptRequest) String() string {
+func (x *CancelScriptChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateScriptRequest) ProtoMessage() {}
+func (*CancelScriptChangeRequestRequest) ProtoMessage() {}
 
-func (x *UpdateScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_script_proto_msgTypes[7]
+func (x *CancelScriptChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,75 +2479,86 @@ func (x *UpdateScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateScriptRequest.ProtoReflect.Descriptor instead.
-func (*UpdateScriptRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CancelScriptChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelScriptChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateScriptRequest) GetId() string {
+func (x *CancelScriptChangeRequestRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateScriptRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+type CancelScriptChangeRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequest *ScriptChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateScriptRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+func (x *CancelScriptChangeRequestResponse) Reset() {
+	*x = CancelScriptChangeRequestResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateScriptRequest) GetContent() string {
-	if x != nil && x.Content != nil {
-		return *x.Content
-	}
-	return ""
+func (x *CancelScriptChangeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateScriptRequest) GetEnabled() bool {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
+func (*CancelScriptChangeRequestResponse) ProtoMessage() {}
+
+func (x *CancelScriptChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateScriptRequest) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+// Deprecated: Use CancelScriptChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelScriptChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelScriptChangeRequestResponse) GetChangeRequest() *ScriptChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
 	}
-	return ""
+	return nil
 }
 
-type UpdateScriptResponse struct {
+// Comment on script change request request
+type CommentScriptChangeRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *Script                `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateScriptResponse) Reset() {
-	*x = UpdateScriptResponse{}
-	mi := &file_executor_service_v1_script_proto_msgTypes[8]
+func (x *CommentScriptChangeRequestRequest) Reset() {
+	*x = CommentScriptChangeRequestRequest{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateScriptResponse) String() string {
+func (x *CommentScriptChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateScriptResponse) ProtoMessage() {}
+func (*CommentScriptChangeRequestRequest) ProtoMessage() {}
 
-func (x *UpdateScriptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_script_proto_msgTypes[8]
+func (x *CommentScriptChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,41 +2569,47 @@ func (x *UpdateScriptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateScriptResponse.ProtoReflect.Descriptor instead.
-func (*UpdateScriptResponse) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CommentScriptChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*CommentScriptChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UpdateScriptResponse) GetScript() *Script {
+func (x *CommentScriptChangeRequestRequest) GetId() string {
 	if x != nil {
-		return x.Script
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Delete script request
-type DeleteScriptRequest struct {
+func (x *CommentScriptChangeRequestRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CommentScriptChangeRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChangeRequest *ScriptChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteScriptRequest) Reset() {
-	*x = DeleteScriptRequest{}
-	mi := &file_executor_service_v1_script_proto_msgTypes[9]
+func (x *CommentScriptChangeRequestResponse) Reset() {
+	*x = CommentScriptChangeRequestResponse{}
+	mi := &file_executor_service_v1_script_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteScriptRequest) String() string {
+func (x *CommentScriptChangeRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteScriptRequest) ProtoMessage() {}
+func (*CommentScriptChangeRequestResponse) ProtoMessage() {}
 
-func (x *DeleteScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_service_v1_script_proto_msgTypes[9]
+func (x *CommentScriptChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_service_v1_script_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,23 +2620,23 @@ func (x *DeleteScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteScriptRequest.ProtoReflect.Descriptor instead.
-func (*DeleteScriptRequest) Descriptor() ([]byte, []int) {
-	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CommentScriptChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*CommentScriptChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_executor_service_v1_script_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeleteScriptRequest) GetId() string {
+func (x *CommentScriptChangeRequestResponse) GetChangeRequest() *ScriptChangeRequest {
 	if x != nil {
-		return x.Id
+		return x.ChangeRequest
 	}
-	return ""
+	return nil
 }
 
 var File_executor_service_v1_script_proto protoreflect.FileDescriptor
 
 const file_executor_service_v1_script_proto_rawDesc = "" +
 	"\n" +
-	" executor/service/v1/script.proto\x12\x13executor.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\"\xa3\x04\n" +
+	" executor/service/v1/script.proto\x12\x13executor.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\x1a#executor/service/v1/execution.proto\x1a/executor/service/v1/script_change_request.proto\x1a+executor/service/v1/script_validation.proto\x1a(executor/service/v1/script_version.proto\"\x8b\a\n" +
 	"\x06Script\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x12\n" +
@@ -758,19 +2656,75 @@ const file_executor_service_v1_script_proto_rawDesc = "" +
 	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x02R\n" +
-	"updateTime\x88\x01\x01B\r\n" +
+	"updateTime\x88\x01\x01\x12,\n" +
+	"\x0ftimeout_seconds\x18\x0e \x01(\x05H\x03R\x0etimeoutSeconds\x88\x01\x01\x12H\n" +
+	"\fretry_policy\x18\x0f \x01(\v2 .executor.service.v1.RetryPolicyH\x04R\vretryPolicy\x88\x01\x01\x12\x1c\n" +
+	"\tsingleton\x18\x10 \x01(\bR\tsingleton\x12e\n" +
+	"\x18concurrency_limit_action\x18\x11 \x01(\x0e2+.executor.service.v1.ConcurrencyLimitActionR\x16concurrencyLimitAction\x12D\n" +
+	"\n" +
+	"parameters\x18\x12 \x03(\v2$.executor.service.v1.ScriptParameterR\n" +
+	"parametersB\r\n" +
 	"\v_created_byB\r\n" +
 	"\v_updated_byB\x0e\n" +
-	"\f_update_time\"\xf9\x01\n" +
+	"\f_update_timeB\x12\n" +
+	"\x10_timeout_secondsB\x0f\n" +
+	"\r_retry_policy\"\x84\x03\n" +
+	"\x0fScriptParameter\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\x126\n" +
+	"\x04type\x18\x02 \x01(\x0e2\".executor.service.v1.ParameterTypeR\x04type\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\vdescription\x88\x01\x01\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12(\n" +
+	"\rdefault_value\x18\x05 \x01(\tH\x01R\fdefaultValue\x88\x01\x01\x12%\n" +
+	"\x0eallowed_values\x18\x06 \x03(\tR\rallowedValues\x12\x16\n" +
+	"\x06secret\x18\a \x01(\bR\x06secret\x12B\n" +
+	"\bdelivery\x18\b \x01(\x0e2&.executor.service.v1.ParameterDeliveryR\bdeliveryB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_default_value\"S\n" +
+	"\x13ScriptParameterList\x12<\n" +
+	"\x06values\x18\x01 \x03(\v2$.executor.service.v1.ScriptParameterR\x06values\"\xaf\x02\n" +
+	"\vRetryPolicy\x12*\n" +
+	"\fmax_attempts\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18\n" +
+	"R\vmaxAttempts\x122\n" +
+	"\x0fbackoff_seconds\x18\x02 \x01(\rB\t\xbaH\x06*\x04\x18\x80\xa3\x05R\x0ebackoffSeconds\x129\n" +
+	"\x13max_backoff_seconds\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x18\x80\xa3\x05R\x11maxBackoffSeconds\x120\n" +
+	"\x14retryable_exit_codes\x18\x04 \x03(\x05R\x12retryableExitCodes\x12S\n" +
+	"\x12retryable_statuses\x18\x05 \x03(\x0e2$.executor.service.v1.ExecutionStatusR\x11retryableStatuses\"\xb8\x05\n" +
 	"\x13CreateScriptRequest\x12!\n" +
 	"\x04name\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
 	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12M\n" +
 	"\vscript_type\x18\x03 \x01(\x0e2\x1f.executor.service.v1.ScriptTypeB\v\xe0A\x02\xbaH\x05\x82\x01\x02 \x00R\n" +
 	"scriptType\x12*\n" +
 	"\acontent\x18\x04 \x01(\tB\x10\xe0A\x02\xbaH\x04r\x02\x10\x01ڶ\x1a\x02z\x00R\acontent\x12\x18\n" +
-	"\aenabled\x18\x05 \x01(\bR\aenabled\"K\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x129\n" +
+	"\x0ftimeout_seconds\x18\x06 \x01(\x05B\v\xbaH\b\x1a\x06\x18\x80\xf5$(\x00H\x00R\x0etimeoutSeconds\x88\x01\x01\x12H\n" +
+	"\fretry_policy\x18\a \x01(\v2 .executor.service.v1.RetryPolicyH\x01R\vretryPolicy\x88\x01\x01\x12\x1c\n" +
+	"\tsingleton\x18\b \x01(\bR\tsingleton\x12e\n" +
+	"\x18concurrency_limit_action\x18\t \x01(\x0e2+.executor.service.v1.ConcurrencyLimitActionR\x16concurrencyLimitAction\x12.\n" +
+	"\vchange_note\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x02R\n" +
+	"changeNote\x88\x01\x01\x12N\n" +
+	"\n" +
+	"parameters\x18\v \x03(\v2$.executor.service.v1.ScriptParameterB\b\xbaH\x05\x92\x01\x02\x10@R\n" +
+	"parametersB\x12\n" +
+	"\x10_timeout_secondsB\x0f\n" +
+	"\r_retry_policyB\x0e\n" +
+	"\f_change_note\"\xfd\x01\n" +
 	"\x14CreateScriptResponse\x123\n" +
-	"\x06script\x18\x01 \x01(\v2\x1b.executor.service.v1.ScriptR\x06script\"0\n" +
+	"\x06script\x18\x01 \x01(\v2\x1b.executor.service.v1.ScriptR\x06script\x12G\n" +
+	"\vdiagnostics\x18\x02 \x03(\v2%.executor.service.v1.ScriptDiagnosticR\vdiagnostics\x12T\n" +
+	"\x0echange_request\x18\x03 \x01(\v2(.executor.service.v1.ScriptChangeRequestH\x00R\rchangeRequest\x88\x01\x01B\x11\n" +
+	"\x0f_change_request\"\x92\x01\n" +
+	"\x15ValidateScriptRequest\x12M\n" +
+	"\vscript_type\x18\x01 \x01(\x0e2\x1f.executor.service.v1.ScriptTypeB\v\xe0A\x02\xbaH\x05\x82\x01\x02 \x00R\n" +
+	"scriptType\x12*\n" +
+	"\acontent\x18\x02 \x01(\tB\x10\xe0A\x02\xbaH\x04r\x02\x10\x01ڶ\x1a\x02z\x00R\acontent\"\xcb\x01\n" +
+	"\x16ValidateScriptResponse\x12G\n" +
+	"\vdiagnostics\x18\x01 \x03(\v2%.executor.service.v1.ScriptDiagnosticR\vdiagnostics\x12\x18\n" +
+	"\ablocked\x18\x02 \x01(\bR\ablocked\x12N\n" +
+	"\x0eblock_severity\x18\x03 \x01(\x0e2'.executor.service.v1.DiagnosticSeverityR\rblockSeverity\"\x16\n" +
+	"\x14ListLintRulesRequest\"L\n" +
+	"\x15ListLintRulesResponse\x123\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1d.executor.service.v1.LintRuleR\x05rules\"0\n" +
 	"\x10GetScriptRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"H\n" +
 	"\x11GetScriptResponse\x123\n" +
@@ -791,37 +2745,176 @@ const file_executor_service_v1_script_proto_rawDesc = "" +
 	"\b_enabled\"b\n" +
 	"\x13ListScriptsResponse\x125\n" +
 	"\ascripts\x18\x01 \x03(\v2\x1b.executor.service.v1.ScriptR\ascripts\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\xb4\x02\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xb6\x06\n" +
 	"\x13UpdateScriptRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12!\n" +
 	"\x04name\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x01R\vdescription\x88\x01\x01\x12%\n" +
 	"\acontent\x18\x04 \x01(\tB\x06ڶ\x1a\x02z\x00H\x02R\acontent\x88\x01\x01\x12\x1d\n" +
 	"\aenabled\x18\x05 \x01(\bH\x03R\aenabled\x88\x01\x01\x12'\n" +
-	"\bpassword\x18\x06 \x01(\tB\x06ڶ\x1a\x02z\x00H\x04R\bpassword\x88\x01\x01B\a\n" +
+	"\bpassword\x18\x06 \x01(\tB\x06ڶ\x1a\x02z\x00H\x04R\bpassword\x88\x01\x01\x129\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x05B\v\xbaH\b\x1a\x06\x18\x80\xf5$(\x00H\x05R\x0etimeoutSeconds\x88\x01\x01\x12H\n" +
+	"\fretry_policy\x18\b \x01(\v2 .executor.service.v1.RetryPolicyH\x06R\vretryPolicy\x88\x01\x01\x12!\n" +
+	"\tsingleton\x18\t \x01(\bH\aR\tsingleton\x88\x01\x01\x12j\n" +
+	"\x18concurrency_limit_action\x18\n" +
+	" \x01(\x0e2+.executor.service.v1.ConcurrencyLimitActionH\bR\x16concurrencyLimitAction\x88\x01\x01\x12.\n" +
+	"\vchange_note\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\tR\n" +
+	"changeNote\x88\x01\x01\x12M\n" +
+	"\n" +
+	"parameters\x18\f \x01(\v2(.executor.service.v1.ScriptParameterListH\n" +
+	"R\n" +
+	"parameters\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
 	"\b_contentB\n" +
 	"\n" +
 	"\b_enabledB\v\n" +
-	"\t_password\"K\n" +
+	"\t_passwordB\x12\n" +
+	"\x10_timeout_secondsB\x0f\n" +
+	"\r_retry_policyB\f\n" +
+	"\n" +
+	"_singletonB\x1b\n" +
+	"\x19_concurrency_limit_actionB\x0e\n" +
+	"\f_change_noteB\r\n" +
+	"\v_parameters\"\xfd\x01\n" +
 	"\x14UpdateScriptResponse\x123\n" +
-	"\x06script\x18\x01 \x01(\v2\x1b.executor.service.v1.ScriptR\x06script\"3\n" +
+	"\x06script\x18\x01 \x01(\v2\x1b.executor.service.v1.ScriptR\x06script\x12T\n" +
+	"\x0echange_request\x18\x02 \x01(\v2(.executor.service.v1.ScriptChangeRequestH\x00R\rchangeRequest\x88\x01\x01\x12G\n" +
+	"\vdiagnostics\x18\x03 \x03(\v2%.executor.service.v1.ScriptDiagnosticR\vdiagnosticsB\x11\n" +
+	"\x0f_change_request\"3\n" +
 	"\x13DeleteScriptRequest\x12\x1c\n" +
-	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id*p\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"\x98\x01\n" +
+	"\x19ListScriptVersionsRequest\x12)\n" +
+	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\rH\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\rH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"r\n" +
+	"\x1aListScriptVersionsResponse\x12>\n" +
+	"\bversions\x18\x01 \x03(\v2\".executor.service.v1.ScriptVersionR\bversions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"j\n" +
+	"\x17GetScriptVersionRequest\x12)\n" +
+	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12$\n" +
+	"\aversion\x18\x02 \x01(\rB\n" +
+	"\xe0A\x02\xbaH\x04*\x02(\x01R\aversion\"X\n" +
+	"\x18GetScriptVersionResponse\x12<\n" +
+	"\aversion\x18\x01 \x01(\v2\".executor.service.v1.ScriptVersionR\aversion\"\xb1\x01\n" +
+	"\x19DiffScriptVersionsRequest\x12)\n" +
+	"\tscript_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\bscriptId\x12-\n" +
+	"\ffrom_version\x18\x02 \x01(\rB\n" +
+	"\xe0A\x02\xbaH\x04*\x02(\x01R\vfromVersion\x12+\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\rB\a\xbaH\x04*\x02(\x01H\x00R\ttoVersion\x88\x01\x01B\r\n" +
+	"\v_to_version\"\xc0\x01\n" +
+	"\x1aDiffScriptVersionsResponse\x12!\n" +
+	"\ffrom_version\x18\x01 \x01(\rR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\rR\ttoVersion\x12\x1a\n" +
+	"\x04diff\x18\x03 \x01(\tB\x06ڶ\x1a\x02z\x00R\x04diff\x12\x1f\n" +
+	"\vadded_lines\x18\x04 \x01(\rR\n" +
+	"addedLines\x12#\n" +
+	"\rremoved_lines\x18\x05 \x01(\rR\fremovedLines\"\xbf\x01\n" +
+	"\x15RollbackScriptRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12$\n" +
+	"\aversion\x18\x02 \x01(\rB\n" +
+	"\xe0A\x02\xbaH\x04*\x02(\x01R\aversion\x12\"\n" +
+	"\bpassword\x18\x03 \x01(\tB\x06ڶ\x1a\x02z\x00R\bpassword\x12.\n" +
+	"\vchange_note\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x00R\n" +
+	"changeNote\x88\x01\x01B\x0e\n" +
+	"\f_change_note\"\xff\x01\n" +
+	"\x16RollbackScriptResponse\x123\n" +
+	"\x06script\x18\x01 \x01(\v2\x1b.executor.service.v1.ScriptR\x06script\x12T\n" +
+	"\x0echange_request\x18\x02 \x01(\v2(.executor.service.v1.ScriptChangeRequestH\x00R\rchangeRequest\x88\x01\x01\x12G\n" +
+	"\vdiagnostics\x18\x03 \x03(\v2%.executor.service.v1.ScriptDiagnosticR\vdiagnosticsB\x11\n" +
+	"\x0f_change_request\"\x84\x02\n" +
+	"\x1fListScriptChangeRequestsRequest\x12)\n" +
+	"\tscript_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18$H\x00R\bscriptId\x88\x01\x01\x12K\n" +
+	"\x06status\x18\x02 \x01(\x0e2..executor.service.v1.ScriptChangeRequestStatusH\x01R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x03 \x01(\rH\x02R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x04 \x01(\rH\x03R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_script_idB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\x8b\x01\n" +
+	" ListScriptChangeRequestsResponse\x12Q\n" +
+	"\x0fchange_requests\x18\x01 \x03(\v2(.executor.service.v1.ScriptChangeRequestR\x0echangeRequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"=\n" +
+	"\x1dGetScriptChangeRequestRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"\xd3\x01\n" +
+	"\x1eGetScriptChangeRequestResponse\x12O\n" +
+	"\x0echange_request\x18\x01 \x01(\v2(.executor.service.v1.ScriptChangeRequestR\rchangeRequest\x12\x1a\n" +
+	"\x04diff\x18\x02 \x01(\tB\x06ڶ\x1a\x02z\x00R\x04diff\x12\x1f\n" +
+	"\vadded_lines\x18\x03 \x01(\rR\n" +
+	"addedLines\x12#\n" +
+	"\rremoved_lines\x18\x04 \x01(\rR\fremovedLines\"\x9a\x01\n" +
+	"!ApproveScriptChangeRequestRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12\"\n" +
+	"\bpassword\x18\x02 \x01(\tB\x06ڶ\x1a\x02z\x00R\bpassword\x12'\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"\xf3\x01\n" +
+	"\"ApproveScriptChangeRequestResponse\x12O\n" +
+	"\x0echange_request\x18\x01 \x01(\v2(.executor.service.v1.ScriptChangeRequestR\rchangeRequest\x123\n" +
+	"\x06script\x18\x02 \x01(\v2\x1b.executor.service.v1.ScriptR\x06script\x12G\n" +
+	"\vdiagnostics\x18\x03 \x03(\v2%.executor.service.v1.ScriptDiagnosticR\vdiagnostics\"g\n" +
+	" RejectScriptChangeRequestRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12%\n" +
+	"\x06reason\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\x10R\x06reason\"t\n" +
+	"!RejectScriptChangeRequestResponse\x12O\n" +
+	"\x0echange_request\x18\x01 \x01(\v2(.executor.service.v1.ScriptChangeRequestR\rchangeRequest\"@\n" +
+	" CancelScriptChangeRequestRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\"t\n" +
+	"!CancelScriptChangeRequestResponse\x12O\n" +
+	"\x0echange_request\x18\x01 \x01(\v2(.executor.service.v1.ScriptChangeRequestR\rchangeRequest\"d\n" +
+	"!CommentScriptChangeRequestRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x02id\x12!\n" +
+	"\x04body\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\x10R\x04body\"u\n" +
+	"\"CommentScriptChangeRequestResponse\x12O\n" +
+	"\x0echange_request\x18\x01 \x01(\v2(.executor.service.v1.ScriptChangeRequestR\rchangeRequest*\xa4\x01\n" +
 	"\n" +
 	"ScriptType\x12\x1b\n" +
 	"\x17SCRIPT_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10SCRIPT_TYPE_BASH\x10\x01\x12\x1a\n" +
 	"\x16SCRIPT_TYPE_JAVASCRIPT\x10\x02\x12\x13\n" +
-	"\x0fSCRIPT_TYPE_LUA\x10\x032\xf0\x04\n" +
+	"\x0fSCRIPT_TYPE_LUA\x10\x03\x12\x16\n" +
+	"\x12SCRIPT_TYPE_PYTHON\x10\x04\x12\x1a\n" +
+	"\x16SCRIPT_TYPE_POWERSHELL\x10\x05*\x9d\x01\n" +
+	"\rParameterType\x12\x1e\n" +
+	"\x1aPARAMETER_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PARAMETER_TYPE_STRING\x10\x01\x12\x1a\n" +
+	"\x16PARAMETER_TYPE_INTEGER\x10\x02\x12\x19\n" +
+	"\x15PARAMETER_TYPE_NUMBER\x10\x03\x12\x1a\n" +
+	"\x16PARAMETER_TYPE_BOOLEAN\x10\x04*o\n" +
+	"\x11ParameterDelivery\x12\"\n" +
+	"\x1ePARAMETER_DELIVERY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PARAMETER_DELIVERY_ENV\x10\x01\x12\x1a\n" +
+	"\x16PARAMETER_DELIVERY_ARG\x10\x02*\x8b\x01\n" +
+	"\x16ConcurrencyLimitAction\x12(\n" +
+	"$CONCURRENCY_LIMIT_ACTION_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fCONCURRENCY_LIMIT_ACTION_REJECT\x10\x01\x12\"\n" +
+	"\x1eCONCURRENCY_LIMIT_ACTION_QUEUE\x10\x022\xd9\x14\n" +
 	"\x15ExecutorScriptService\x12{\n" +
-	"\fCreateScript\x12(.executor.service.v1.CreateScriptRequest\x1a).executor.service.v1.CreateScriptResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/scripts\x12t\n" +
+	"\fCreateScript\x12(.executor.service.v1.CreateScriptRequest\x1a).executor.service.v1.CreateScriptResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/scripts\x12\x8a\x01\n" +
+	"\x0eValidateScript\x12*.executor.service.v1.ValidateScriptRequest\x1a+.executor.service.v1.ValidateScriptResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/scripts/validate\x12~\n" +
+	"\rListLintRules\x12).executor.service.v1.ListLintRulesRequest\x1a*.executor.service.v1.ListLintRulesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/lint-rules\x12t\n" +
 	"\tGetScript\x12%.executor.service.v1.GetScriptRequest\x1a&.executor.service.v1.GetScriptResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/scripts/{id}\x12u\n" +
 	"\vListScripts\x12'.executor.service.v1.ListScriptsRequest\x1a(.executor.service.v1.ListScriptsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/scripts\x12\x80\x01\n" +
 	"\fUpdateScript\x12(.executor.service.v1.UpdateScriptRequest\x1a).executor.service.v1.UpdateScriptResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/scripts/{id}\x12j\n" +
-	"\fDeleteScript\x12(.executor.service.v1.DeleteScriptRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/scripts/{id}B\xe3\x01\n" +
+	"\fDeleteScript\x12(.executor.service.v1.DeleteScriptRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/scripts/{id}\x12\x9f\x01\n" +
+	"\x12ListScriptVersions\x12..executor.service.v1.ListScriptVersionsRequest\x1a/.executor.service.v1.ListScriptVersionsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/scripts/{script_id}/versions\x12\xa3\x01\n" +
+	"\x10GetScriptVersion\x12,.executor.service.v1.GetScriptVersionRequest\x1a-.executor.service.v1.GetScriptVersionResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/scripts/{script_id}/versions/{version}\x12\x9b\x01\n" +
+	"\x12DiffScriptVersions\x12..executor.service.v1.DiffScriptVersionsRequest\x1a/.executor.service.v1.DiffScriptVersionsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/scripts/{script_id}/diff\x12\x8f\x01\n" +
+	"\x0eRollbackScript\x12*.executor.service.v1.RollbackScriptRequest\x1a+.executor.service.v1.RollbackScriptResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/scripts/{id}/rollback\x12\xab\x01\n" +
+	"\x18ListScriptChangeRequests\x124.executor.service.v1.ListScriptChangeRequestsRequest\x1a5.executor.service.v1.ListScriptChangeRequestsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/script-change-requests\x12\xaa\x01\n" +
+	"\x16GetScriptChangeRequest\x122.executor.service.v1.GetScriptChangeRequestRequest\x1a3.executor.service.v1.GetScriptChangeRequestResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/script-change-requests/{id}\x12\xc1\x01\n" +
+	"\x1aApproveScriptChangeRequest\x126.executor.service.v1.ApproveScriptChangeRequestRequest\x1a7.executor.service.v1.ApproveScriptChangeRequestResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/script-change-requests/{id}/approve\x12\xbd\x01\n" +
+	"\x19RejectScriptChangeRequest\x125.executor.service.v1.RejectScriptChangeRequestRequest\x1a6.executor.service.v1.RejectScriptChangeRequestResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/script-change-requests/{id}/reject\x12\xbd\x01\n" +
+	"\x19CancelScriptChangeRequest\x125.executor.service.v1.CancelScriptChangeRequestRequest\x1a6.executor.service.v1.CancelScriptChangeRequestResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/script-change-requests/{id}/cancel\x12\xc2\x01\n" +
+	"\x1aCommentScriptChangeRequest\x126.executor.service.v1.CommentScriptChangeRequestRequest\x1a7.executor.service.v1.CommentScriptChangeRequestResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/script-change-requests/{id}/commentsB\xe3\x01\n" +
 	"\x17com.executor.service.v1B\vScriptProtoP\x01ZMgithub.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1;executorpb\xa2\x02\x03ESX\xaa\x02\x13Executor.Service.V1\xca\x02\x13Executor\\Service\\V1\xe2\x02\x1fExecutor\\Service\\V1\\GPBMetadata\xea\x02\x15Executor::Service::V1b\x06proto3"
 
 var (
@@ -836,48 +2929,144 @@ func file_executor_service_v1_script_proto_rawDescGZIP() []byte {
 	return file_executor_service_v1_script_proto_rawDescData
 }
 
-var file_executor_service_v1_script_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_executor_service_v1_script_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_executor_service_v1_script_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_executor_service_v1_script_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_executor_service_v1_script_proto_goTypes = []any{
-	(ScriptType)(0),               // 0: executor.service.v1.ScriptType
-	(*Script)(nil),                // 1: executor.service.v1.Script
-	(*CreateScriptRequest)(nil),   // 2: executor.service.v1.CreateScriptRequest
-	(*CreateScriptResponse)(nil),  // 3: executor.service.v1.CreateScriptResponse
-	(*GetScriptRequest)(nil),      // 4: executor.service.v1.GetScriptRequest
-	(*GetScriptResponse)(nil),     // 5: executor.service.v1.GetScriptResponse
-	(*ListScriptsRequest)(nil),    // 6: executor.service.v1.ListScriptsRequest
-	(*ListScriptsResponse)(nil),   // 7: executor.service.v1.ListScriptsResponse
-	(*UpdateScriptRequest)(nil),   // 8: executor.service.v1.UpdateScriptRequest
-	(*UpdateScriptResponse)(nil),  // 9: executor.service.v1.UpdateScriptResponse
-	(*DeleteScriptRequest)(nil),   // 10: executor.service.v1.DeleteScriptRequest
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 12: google.protobuf.Empty
+	(ScriptType)(0),                            // 0: executor.service.v1.ScriptType
+	(ParameterType)(0),                         // 1: executor.service.v1.ParameterType
+	(ParameterDelivery)(0),                     // 2: executor.service.v1.ParameterDelivery
+	(ConcurrencyLimitAction)(0),                // 3: executor.service.v1.ConcurrencyLimitAction
+	(*Script)(nil),                             // 4: executor.service.v1.Script
+	(*ScriptParameter)(nil),                    // 5: executor.service.v1.ScriptParameter
+	(*ScriptParameterList)(nil),                // 6: executor.service.v1.ScriptParameterList
+	(*RetryPolicy)(nil),                        // 7: executor.service.v1.RetryPolicy
+	(*CreateScriptRequest)(nil),                // 8: executor.service.v1.CreateScriptRequest
+	(*CreateScriptResponse)(nil),               // 9: executor.service.v1.CreateScriptResponse
+	(*ValidateScriptRequest)(nil),              // 10: executor.service.v1.ValidateScriptRequest
+	(*ValidateScriptResponse)(nil),             // 11: executor.service.v1.ValidateScriptResponse
+	(*ListLintRulesRequest)(nil),               // 12: executor.service.v1.ListLintRulesRequest
+	(*ListLintRulesResponse)(nil),              // 13: executor.service.v1.ListLintRulesResponse
+	(*GetScriptRequest)(nil),                   // 14: executor.service.v1.GetScriptRequest
+	(*GetScriptResponse)(nil),                  // 15: executor.service.v1.GetScriptResponse
+	(*ListScriptsRequest)(nil),                 // 16: executor.service.v1.ListScriptsRequest
+	(*ListScriptsResponse)(nil),                // 17: executor.service.v1.ListScriptsResponse
+	(*UpdateScriptRequest)(nil),                // 18: executor.service.v1.UpdateScriptRequest
+	(*UpdateScriptResponse)(nil),               // 19: executor.service.v1.UpdateScriptResponse
+	(*DeleteScriptRequest)(nil),                // 20: executor.service.v1.DeleteScriptRequest
+	(*ListScriptVersionsRequest)(nil),          // 21: executor.service.v1.ListScriptVersionsRequest
+	(*ListScriptVersionsResponse)(nil),         // 22: executor.service.v1.ListScriptVersionsResponse
+	(*GetScriptVersionRequest)(nil),            // 23: executor.service.v1.GetScriptVersionRequest
+	(*GetScriptVersionResponse)(nil),           // 24: executor.service.v1.GetScriptVersionResponse
+	(*DiffScriptVersionsRequest)(nil),          // 25: executor.service.v1.DiffScriptVersionsRequest
+	(*DiffScriptVersionsResponse)(nil),         // 26: executor.service.v1.DiffScriptVersionsResponse
+	(*RollbackScriptRequest)(nil),              // 27: executor.service.v1.RollbackScriptRequest
+	(*RollbackScriptResponse)(nil),             // 28: executor.service.v1.RollbackScriptResponse
+	(*ListScriptChangeRequestsRequest)(nil),    // 29: executor.service.v1.ListScriptChangeRequestsRequest
+	(*ListScriptChangeRequestsResponse)(nil),   // 30: executor.service.v1.ListScriptChangeRequestsResponse
+	(*GetScriptChangeRequestRequest)(nil),      // 31: executor.service.v1.GetScriptChangeRequestRequest
+	(*GetScriptChangeRequestResponse)(nil),     // 32: executor.service.v1.GetScriptChangeRequestResponse
+	(*ApproveScriptChangeRequestRequest)(nil),  // 33: executor.service.v1.ApproveScriptChangeRequestRequest
+	(*ApproveScriptChangeRequestResponse)(nil), // 34: executor.service.v1.ApproveScriptChangeRequestResponse
+	(*RejectScriptChangeRequestRequest)(nil),   // 35: executor.service.v1.RejectScriptChangeRequestRequest
+	(*RejectScriptChangeRequestResponse)(nil),  // 36: executor.service.v1.RejectScriptChangeRequestResponse
+	(*CancelScriptChangeRequestRequest)(nil),   // 37: executor.service.v1.CancelScriptChangeRequestRequest
+	(*CancelScriptChangeRequestResponse)(nil),  // 38: executor.service.v1.CancelScriptChangeRequestResponse
+	(*CommentScriptChangeRequestRequest)(nil),  // 39: executor.service.v1.CommentScriptChangeRequestRequest
+	(*CommentScriptChangeRequestResponse)(nil), // 40: executor.service.v1.CommentScriptChangeRequestResponse
+	(*timestamppb.Timestamp)(nil),              // 41: google.protobuf.Timestamp
+	(ExecutionStatus)(0),                       // 42: executor.service.v1.ExecutionStatus
+	(*ScriptDiagnostic)(nil),                   // 43: executor.service.v1.ScriptDiagnostic
+	(*ScriptChangeRequest)(nil),                // 44: executor.service.v1.ScriptChangeRequest
+	(DiagnosticSeverity)(0),                    // 45: executor.service.v1.DiagnosticSeverity
+	(*LintRule)(nil),                           // 46: executor.service.v1.LintRule
+	(*ScriptVersion)(nil),                      // 47: executor.service.v1.ScriptVersion
+	(ScriptChangeRequestStatus)(0),             // 48: executor.service.v1.ScriptChangeRequestStatus
+	(*emptypb.Empty)(nil),                      // 49: google.protobuf.Empty
 }
 var file_executor_service_v1_script_proto_depIdxs = []int32{
 	0,  // 0: executor.service.v1.Script.script_type:type_name -> executor.service.v1.ScriptType
-	11, // 1: executor.service.v1.Script.create_time:type_name -> google.protobuf.Timestamp
-	11, // 2: executor.service.v1.Script.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 3: executor.service.v1.CreateScriptRequest.script_type:type_name -> executor.service.v1.ScriptType
-	1,  // 4: executor.service.v1.CreateScriptResponse.script:type_name -> executor.service.v1.Script
-	1,  // 5: executor.service.v1.GetScriptResponse.script:type_name -> executor.service.v1.Script
-	0,  // 6: executor.service.v1.ListScriptsRequest.script_type:type_name -> executor.service.v1.ScriptType
-	1,  // 7: executor.service.v1.ListScriptsResponse.scripts:type_name -> executor.service.v1.Script
-	1,  // 8: executor.service.v1.UpdateScriptResponse.script:type_name -> executor.service.v1.Script
-	2,  // 9: executor.service.v1.ExecutorScriptService.CreateScript:input_type -> executor.service.v1.CreateScriptRequest
-	4,  // 10: executor.service.v1.ExecutorScriptService.GetScript:input_type -> executor.service.v1.GetScriptRequest
-	6,  // 11: executor.service.v1.ExecutorScriptService.ListScripts:input_type -> executor.service.v1.ListScriptsRequest
-	8,  // 12: executor.service.v1.ExecutorScriptService.UpdateScript:input_type -> executor.service.v1.UpdateScriptRequest
-	10, // 13: executor.service.v1.ExecutorScriptService.DeleteScript:input_type -> executor.service.v1.DeleteScriptRequest
-	3,  // 14: executor.service.v1.ExecutorScriptService.CreateScript:output_type -> executor.service.v1.CreateScriptResponse
-	5,  // 15: executor.service.v1.ExecutorScriptService.GetScript:output_type -> executor.service.v1.GetScriptResponse
-	7,  // 16: executor.service.v1.ExecutorScriptService.ListScripts:output_type -> executor.service.v1.ListScriptsResponse
-	9,  // 17: executor.service.v1.ExecutorScriptService.UpdateScript:output_type -> executor.service.v1.UpdateScriptResponse
-	12, // 18: executor.service.v1.ExecutorScriptService.DeleteScript:output_type -> google.protobuf.Empty
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	41, // 1: executor.service.v1.Script.create_time:type_name -> google.protobuf.Timestamp
+	41, // 2: executor.service.v1.Script.update_time:type_name -> google.protobuf.Timestamp
+	7,  // 3: executor.service.v1.Script.retry_policy:type_name -> executor.service.v1.RetryPolicy
+	3,  // 4: executor.service.v1.Script.concurrency_limit_action:type_name -> executor.service.v1.ConcurrencyLimitAction
+	5,  // 5: executor.service.v1.Script.parameters:type_name -> executor.service.v1.ScriptParameter
+	1,  // 6: executor.service.v1.ScriptParameter.type:type_name -> executor.service.v1.ParameterType
+	2,  // 7: executor.service.v1.ScriptParameter.delivery:type_name -> executor.service.v1.ParameterDelivery
+	5,  // 8: executor.service.v1.ScriptParameterList.values:type_name -> executor.service.v1.ScriptParameter
+	42, // 9: executor.service.v1.RetryPolicy.retryable_statuses:type_name -> executor.service.v1.ExecutionStatus
+	0,  // 10: executor.service.v1.CreateScriptRequest.script_type:type_name -> executor.service.v1.ScriptType
+	7,  // 11: executor.service.v1.CreateScriptRequest.retry_policy:type_name -> executor.service.v1.RetryPolicy
+	3,  // 12: executor.service.v1.CreateScriptRequest.concurrency_limit_action:type_name -> executor.service.v1.ConcurrencyLimitAction
+	5,  // 13: executor.service.v1.CreateScriptRequest.parameters:type_name -> executor.service.v1.ScriptParameter
+	4,  // 14: executor.service.v1.CreateScriptResponse.script:type_name -> executor.service.v1.Script
+	43, // 15: executor.service.v1.CreateScriptResponse.diagnostics:type_name -> executor.service.v1.ScriptDiagnostic
+	44, // 16: executor.service.v1.CreateScriptResponse.change_request:type_name -> executor.service.v1.ScriptChangeRequest
+	0,  // 17: executor.service.v1.ValidateScriptRequest.script_type:type_name -> executor.service.v1.ScriptType
+	43, // 18: executor.service.v1.ValidateScriptResponse.diagnostics:type_name -> executor.service.v1.ScriptDiagnostic
+	45, // 19: executor.service.v1.ValidateScriptResponse.block_severity:type_name -> executor.service.v1.DiagnosticSeverity
+	46, // 20: executor.service.v1.ListLintRulesResponse.rules:type_name -> executor.service.v1.LintRule
+	4,  // 21: executor.service.v1.GetScriptResponse.script:type_name -> executor.service.v1.Script
+	0,  // 22: executor.service.v1.ListScriptsRequest.script_type:type_name -> executor.service.v1.ScriptType
+	4,  // 23: executor.service.v1.ListScriptsResponse.scripts:type_name -> executor.service.v1.Script
+	7,  // 24: executor.service.v1.UpdateScriptRequest.retry_policy:type_name -> executor.service.v1.RetryPolicy
+	3,  // 25: executor.service.v1.UpdateScriptRequest.concurrency_limit_action:type_name -> executor.service.v1.ConcurrencyLimitAction
+	6,  // 26: executor.service.v1.UpdateScriptRequest.parameters:type_name -> executor.service.v1.ScriptParameterList
+	4,  // 27: executor.service.v1.UpdateScriptResponse.script:type_name -> executor.service.v1.Script
+	44, // 28: executor.service.v1.UpdateScriptResponse.change_request:type_name -> executor.service.v1.ScriptChangeRequest
+	43, // 29: executor.service.v1.UpdateScriptResponse.diagnostics:type_name -> executor.service.v1.ScriptDiagnostic
+	47, // 30: executor.service.v1.ListScriptVersionsResponse.versions:type_name -> executor.service.v1.ScriptVersion
+	47, // 31: executor.service.v1.GetScriptVersionResponse.version:type_name -> executor.service.v1.ScriptVersion
+	4,  // 32: executor.service.v1.RollbackScriptResponse.script:type_name -> executor.service.v1.Script
+	44, // 33: executor.service.v1.RollbackScriptResponse.change_request:type_name -> executor.service.v1.ScriptChangeRequest
+	43, // 34: executor.service.v1.RollbackScriptResponse.diagnostics:type_name -> executor.service.v1.ScriptDiagnostic
+	48, // 35: executor.service.v1.ListScriptChangeRequestsRequest.status:type_name -> executor.service.v1.ScriptChangeRequestStatus
+	44, // 36: executor.service.v1.ListScriptChangeRequestsResponse.change_requests:type_name -> executor.service.v1.ScriptChangeRequest
+	44, // 37: executor.service.v1.GetScriptChangeRequestResponse.change_request:type_name -> executor.service.v1.ScriptChangeRequest
+	44, // 38: executor.service.v1.ApproveScriptChangeRequestResponse.change_request:type_name -> executor.service.v1.ScriptChangeRequest
+	4,  // 39: executor.service.v1.ApproveScriptChangeRequestResponse.script:type_name -> executor.service.v1.Script
+	43, // 40: executor.service.v1.ApproveScriptChangeRequestResponse.diagnostics:type_name -> executor.service.v1.ScriptDiagnostic
+	44, // 41: executor.service.v1.RejectScriptChangeRequestResponse.change_request:type_name -> executor.service.v1.ScriptChangeRequest
+	44, // 42: executor.service.v1.CancelScriptChangeRequestResponse.change_request:type_name -> executor.service.v1.ScriptChangeRequest
+	44, // 43: executor.service.v1.CommentScriptChangeRequestResponse.change_request:type_name -> executor.service.v1.ScriptChangeRequest
+	8,  // 44: executor.service.v1.ExecutorScriptService.CreateScript:input_type -> executor.service.v1.CreateScriptRequest
+	10, // 45: executor.service.v1.ExecutorScriptService.ValidateScript:input_type -> executor.service.v1.ValidateScriptRequest
+	12, // 46: executor.service.v1.ExecutorScriptService.ListLintRules:input_type -> executor.service.v1.ListLintRulesRequest
+	14, // 47: executor.service.v1.ExecutorScriptService.GetScript:input_type -> executor.service.v1.GetScriptRequest
+	16, // 48: executor.service.v1.ExecutorScriptService.ListScripts:input_type -> executor.service.v1.ListScriptsRequest
+	18, // 49: executor.service.v1.ExecutorScriptService.UpdateScript:input_type -> executor.service.v1.UpdateScriptRequest
+	20, // 50: executor.service.v1.ExecutorScriptService.DeleteScript:input_type -> executor.service.v1.DeleteScriptRequest
+	21, // 51: executor.service.v1.ExecutorScriptService.ListScriptVersions:input_type -> executor.service.v1.ListScriptVersionsRequest
+	23, // 52: executor.service.v1.ExecutorScriptService.GetScriptVersion:input_type -> executor.service.v1.GetScriptVersionRequest
+	25, // 53: executor.service.v1.ExecutorScriptService.DiffScriptVersions:input_type -> executor.service.v1.DiffScriptVersionsRequest
+	27, // 54: executor.service.v1.ExecutorScriptService.RollbackScript:input_type -> executor.service.v1.RollbackScriptRequest
+	29, // 55: executor.service.v1.ExecutorScriptService.ListScriptChangeRequests:input_type -> executor.service.v1.ListScriptChangeRequestsRequest
+	31, // 56: executor.service.v1.ExecutorScriptService.GetScriptChangeRequest:input_type -> executor.service.v1.GetScriptChangeRequestRequest
+	33, // 57: executor.service.v1.ExecutorScriptService.ApproveScriptChangeRequest:input_type -> executor.service.v1.ApproveScriptChangeRequestRequest
+	35, // 58: executor.service.v1.ExecutorScriptService.RejectScriptChangeRequest:input_type -> executor.service.v1.RejectScriptChangeRequestRequest
+	37, // 59: executor.service.v1.ExecutorScriptService.CancelScriptChangeRequest:input_type -> executor.service.v1.CancelScriptChangeRequestRequest
+	39, // 60: executor.service.v1.ExecutorScriptService.CommentScriptChangeRequest:input_type -> executor.service.v1.CommentScriptChangeRequestRequest
+	9,  // 61: executor.service.v1.ExecutorScriptService.CreateScript:output_type -> executor.service.v1.CreateScriptResponse
+	11, // 62: executor.service.v1.ExecutorScriptService.ValidateScript:output_type -> executor.service.v1.ValidateScriptResponse
+	13, // 63: executor.service.v1.ExecutorScriptService.ListLintRules:output_type -> executor.service.v1.ListLintRulesResponse
+	15, // 64: executor.service.v1.ExecutorScriptService.GetScript:output_type -> executor.service.v1.GetScriptResponse
+	17, // 65: executor.service.v1.ExecutorScriptService.ListScripts:output_type -> executor.service.v1.ListScriptsResponse
+	19, // 66: executor.service.v1.ExecutorScriptService.UpdateScript:output_type -> executor.service.v1.UpdateScriptResponse
+	49, // 67: executor.service.v1.ExecutorScriptService.DeleteScript:output_type -> google.protobuf.Empty
+	22, // 68: executor.service.v1.ExecutorScriptService.ListScriptVersions:output_type -> executor.service.v1.ListScriptVersionsResponse
+	24, // 69: executor.service.v1.ExecutorScriptService.GetScriptVersion:output_type -> executor.service.v1.GetScriptVersionResponse
+	26, // 70: executor.service.v1.ExecutorScriptService.DiffScriptVersions:output_type -> executor.service.v1.DiffScriptVersionsResponse
+	28, // 71: executor.service.v1.ExecutorScriptService.RollbackScript:output_type -> executor.service.v1.RollbackScriptResponse
+	30, // 72: executor.service.v1.ExecutorScriptService.ListScriptChangeRequests:output_type -> executor.service.v1.ListScriptChangeRequestsResponse
+	32, // 73: executor.service.v1.ExecutorScriptService.GetScriptChangeRequest:output_type -> executor.service.v1.GetScriptChangeRequestResponse
+	34, // 74: executor.service.v1.ExecutorScriptService.ApproveScriptChangeRequest:output_type -> executor.service.v1.ApproveScriptChangeRequestResponse
+	36, // 75: executor.service.v1.ExecutorScriptService.RejectScriptChangeRequest:output_type -> executor.service.v1.RejectScriptChangeRequestResponse
+	38, // 76: executor.service.v1.ExecutorScriptService.CancelScriptChangeRequest:output_type -> executor.service.v1.CancelScriptChangeRequestResponse
+	40, // 77: executor.service.v1.ExecutorScriptService.CommentScriptChangeRequest:output_type -> executor.service.v1.CommentScriptChangeRequestResponse
+	61, // [61:78] is the sub-list for method output_type
+	44, // [44:61] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_executor_service_v1_script_proto_init() }
@@ -885,16 +3074,30 @@ func file_executor_service_v1_script_proto_init() {
 	if File_executor_service_v1_script_proto != nil {
 		return
 	}
+	file_executor_service_v1_execution_proto_init()
+	file_executor_service_v1_script_change_request_proto_init()
+	file_executor_service_v1_script_validation_proto_init()
+	file_executor_service_v1_script_version_proto_init()
 	file_executor_service_v1_script_proto_msgTypes[0].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[1].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[4].OneofWrappers = []any{}
 	file_executor_service_v1_script_proto_msgTypes[5].OneofWrappers = []any{}
-	file_executor_service_v1_script_proto_msgTypes[7].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[12].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[14].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[15].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[17].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[21].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[23].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[24].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[25].OneofWrappers = []any{}
+	file_executor_service_v1_script_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_executor_service_v1_script_proto_rawDesc), len(file_executor_service_v1_script_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      4,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
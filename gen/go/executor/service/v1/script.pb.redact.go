@@ -58,6 +58,28 @@ func (s *redactedExecutorScriptServiceServer) CreateScript(ctx context.Context,
 	return res, err
 }
 
+// ValidateScript is the redacted wrapper for the actual ExecutorScriptServiceServer.ValidateScript method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) ValidateScript(ctx context.Context, in *ValidateScriptRequest) (*ValidateScriptResponse, error) {
+	res, err := s.srv.ValidateScript(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListLintRules is the redacted wrapper for the actual ExecutorScriptServiceServer.ListLintRules method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) ListLintRules(ctx context.Context, in *ListLintRulesRequest) (*ListLintRulesResponse, error) {
+	res, err := s.srv.ListLintRules(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // GetScript is the redacted wrapper for the actual ExecutorScriptServiceServer.GetScript method
 // Unary RPC
 func (s *redactedExecutorScriptServiceServer) GetScript(ctx context.Context, in *GetScriptRequest) (*GetScriptResponse, error) {
@@ -102,6 +124,116 @@ func (s *redactedExecutorScriptServiceServer) DeleteScript(ctx context.Context,
 	return res, err
 }
 
+// ListScriptVersions is the redacted wrapper for the actual ExecutorScriptServiceServer.ListScriptVersions method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) ListScriptVersions(ctx context.Context, in *ListScriptVersionsRequest) (*ListScriptVersionsResponse, error) {
+	res, err := s.srv.ListScriptVersions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetScriptVersion is the redacted wrapper for the actual ExecutorScriptServiceServer.GetScriptVersion method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) GetScriptVersion(ctx context.Context, in *GetScriptVersionRequest) (*GetScriptVersionResponse, error) {
+	res, err := s.srv.GetScriptVersion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DiffScriptVersions is the redacted wrapper for the actual ExecutorScriptServiceServer.DiffScriptVersions method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) DiffScriptVersions(ctx context.Context, in *DiffScriptVersionsRequest) (*DiffScriptVersionsResponse, error) {
+	res, err := s.srv.DiffScriptVersions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RollbackScript is the redacted wrapper for the actual ExecutorScriptServiceServer.RollbackScript method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) RollbackScript(ctx context.Context, in *RollbackScriptRequest) (*RollbackScriptResponse, error) {
+	res, err := s.srv.RollbackScript(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListScriptChangeRequests is the redacted wrapper for the actual ExecutorScriptServiceServer.ListScriptChangeRequests method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) ListScriptChangeRequests(ctx context.Context, in *ListScriptChangeRequestsRequest) (*ListScriptChangeRequestsResponse, error) {
+	res, err := s.srv.ListScriptChangeRequests(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetScriptChangeRequest is the redacted wrapper for the actual ExecutorScriptServiceServer.GetScriptChangeRequest method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) GetScriptChangeRequest(ctx context.Context, in *GetScriptChangeRequestRequest) (*GetScriptChangeRequestResponse, error) {
+	res, err := s.srv.GetScriptChangeRequest(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ApproveScriptChangeRequest is the redacted wrapper for the actual ExecutorScriptServiceServer.ApproveScriptChangeRequest method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) ApproveScriptChangeRequest(ctx context.Context, in *ApproveScriptChangeRequestRequest) (*ApproveScriptChangeRequestResponse, error) {
+	res, err := s.srv.ApproveScriptChangeRequest(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RejectScriptChangeRequest is the redacted wrapper for the actual ExecutorScriptServiceServer.RejectScriptChangeRequest method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) RejectScriptChangeRequest(ctx context.Context, in *RejectScriptChangeRequestRequest) (*RejectScriptChangeRequestResponse, error) {
+	res, err := s.srv.RejectScriptChangeRequest(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// CancelScriptChangeRequest is the redacted wrapper for the actual ExecutorScriptServiceServer.CancelScriptChangeRequest method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) CancelScriptChangeRequest(ctx context.Context, in *CancelScriptChangeRequestRequest) (*CancelScriptChangeRequestResponse, error) {
+	res, err := s.srv.CancelScriptChangeRequest(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// CommentScriptChangeRequest is the redacted wrapper for the actual ExecutorScriptServiceServer.CommentScriptChangeRequest method
+// Unary RPC
+func (s *redactedExecutorScriptServiceServer) CommentScriptChangeRequest(ctx context.Context, in *CommentScriptChangeRequestRequest) (*CommentScriptChangeRequestResponse, error) {
+	res, err := s.srv.CommentScriptChangeRequest(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for Script
 func (x *Script) Redact() string {
 	if x == nil {
@@ -135,6 +267,68 @@ func (x *Script) Redact() string {
 	// Safe field: CreateTime
 
 	// Safe field: UpdateTime
+
+	// Safe field: TimeoutSeconds
+
+	// Safe field: RetryPolicy
+
+	// Safe field: Singleton
+
+	// Safe field: ConcurrencyLimitAction
+
+	// Safe field: Parameters
+	return x.String()
+}
+
+// Redact method implementation for ScriptParameter
+func (x *ScriptParameter) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Name
+
+	// Safe field: Type
+
+	// Safe field: Description
+
+	// Safe field: Required
+
+	// Safe field: DefaultValue
+
+	// Safe field: AllowedValues
+
+	// Safe field: Secret
+
+	// Safe field: Delivery
+	return x.String()
+}
+
+// Redact method implementation for ScriptParameterList
+func (x *ScriptParameterList) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Values
+	return x.String()
+}
+
+// Redact method implementation for RetryPolicy
+func (x *RetryPolicy) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: MaxAttempts
+
+	// Safe field: BackoffSeconds
+
+	// Safe field: MaxBackoffSeconds
+
+	// Safe field: RetryableExitCodes
+
+	// Safe field: RetryableStatuses
 	return x.String()
 }
 
@@ -154,6 +348,18 @@ func (x *CreateScriptRequest) Redact() string {
 	x.Content = ``
 
 	// Safe field: Enabled
+
+	// Safe field: TimeoutSeconds
+
+	// Safe field: RetryPolicy
+
+	// Safe field: Singleton
+
+	// Safe field: ConcurrencyLimitAction
+
+	// Safe field: ChangeNote
+
+	// Safe field: Parameters
 	return x.String()
 }
 
@@ -164,6 +370,55 @@ func (x *CreateScriptResponse) Redact() string {
 	}
 
 	// Safe field: Script
+
+	// Safe field: Diagnostics
+
+	// Safe field: ChangeRequest
+	return x.String()
+}
+
+// Redact method implementation for ValidateScriptRequest
+func (x *ValidateScriptRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ScriptType
+
+	// Redacting field: Content
+	x.Content = ``
+	return x.String()
+}
+
+// Redact method implementation for ValidateScriptResponse
+func (x *ValidateScriptResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Diagnostics
+
+	// Safe field: Blocked
+
+	// Safe field: BlockSeverity
+	return x.String()
+}
+
+// Redact method implementation for ListLintRulesRequest
+func (x *ListLintRulesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for ListLintRulesResponse
+func (x *ListLintRulesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Rules
 	return x.String()
 }
 
@@ -238,6 +493,18 @@ func (x *UpdateScriptRequest) Redact() string {
 	// Redacting field: Password
 	PasswordTmp := ``
 	x.Password = &PasswordTmp
+
+	// Safe field: TimeoutSeconds
+
+	// Safe field: RetryPolicy
+
+	// Safe field: Singleton
+
+	// Safe field: ConcurrencyLimitAction
+
+	// Safe field: ChangeNote
+
+	// Safe field: Parameters
 	return x.String()
 }
 
@@ -248,6 +515,10 @@ func (x *UpdateScriptResponse) Redact() string {
 	}
 
 	// Safe field: Script
+
+	// Safe field: ChangeRequest
+
+	// Safe field: Diagnostics
 	return x.String()
 }
 
@@ -260,3 +531,263 @@ func (x *DeleteScriptRequest) Redact() string {
 	// Safe field: Id
 	return x.String()
 }
+
+// Redact method implementation for ListScriptVersionsRequest
+func (x *ListScriptVersionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ScriptId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListScriptVersionsResponse
+func (x *ListScriptVersionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Versions
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for GetScriptVersionRequest
+func (x *GetScriptVersionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ScriptId
+
+	// Safe field: Version
+	return x.String()
+}
+
+// Redact method implementation for GetScriptVersionResponse
+func (x *GetScriptVersionResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Version
+	return x.String()
+}
+
+// Redact method implementation for DiffScriptVersionsRequest
+func (x *DiffScriptVersionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ScriptId
+
+	// Safe field: FromVersion
+
+	// Safe field: ToVersion
+	return x.String()
+}
+
+// Redact method implementation for DiffScriptVersionsResponse
+func (x *DiffScriptVersionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FromVersion
+
+	// Safe field: ToVersion
+
+	// Redacting field: Diff
+	x.Diff = ``
+
+	// Safe field: AddedLines
+
+	// Safe field: RemovedLines
+	return x.String()
+}
+
+// Redact method implementation for RollbackScriptRequest
+func (x *RollbackScriptRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Version
+
+	// Redacting field: Password
+	x.Password = ``
+
+	// Safe field: ChangeNote
+	return x.String()
+}
+
+// Redact method implementation for RollbackScriptResponse
+func (x *RollbackScriptResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Script
+
+	// Safe field: ChangeRequest
+
+	// Safe field: Diagnostics
+	return x.String()
+}
+
+// Redact method implementation for ListScriptChangeRequestsRequest
+func (x *ListScriptChangeRequestsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ScriptId
+
+	// Safe field: Status
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListScriptChangeRequestsResponse
+func (x *ListScriptChangeRequestsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ChangeRequests
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for GetScriptChangeRequestRequest
+func (x *GetScriptChangeRequestRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for GetScriptChangeRequestResponse
+func (x *GetScriptChangeRequestResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ChangeRequest
+
+	// Redacting field: Diff
+	x.Diff = ``
+
+	// Safe field: AddedLines
+
+	// Safe field: RemovedLines
+	return x.String()
+}
+
+// Redact method implementation for ApproveScriptChangeRequestRequest
+func (x *ApproveScriptChangeRequestRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Redacting field: Password
+	x.Password = ``
+
+	// Safe field: Comment
+	return x.String()
+}
+
+// Redact method implementation for ApproveScriptChangeRequestResponse
+func (x *ApproveScriptChangeRequestResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ChangeRequest
+
+	// Safe field: Script
+
+	// Safe field: Diagnostics
+	return x.String()
+}
+
+// Redact method implementation for RejectScriptChangeRequestRequest
+func (x *RejectScriptChangeRequestRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for RejectScriptChangeRequestResponse
+func (x *RejectScriptChangeRequestResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ChangeRequest
+	return x.String()
+}
+
+// Redact method implementation for CancelScriptChangeRequestRequest
+func (x *CancelScriptChangeRequestRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for CancelScriptChangeRequestResponse
+func (x *CancelScriptChangeRequestResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ChangeRequest
+	return x.String()
+}
+
+// Redact method implementation for CommentScriptChangeRequestRequest
+func (x *CommentScriptChangeRequestRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Body
+	return x.String()
+}
+
+// Redact method implementation for CommentScriptChangeRequestResponse
+func (x *CommentScriptChangeRequestResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ChangeRequest
+	return x.String()
+}
@@ -103,6 +103,44 @@ func (m *Script) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Singleton
+
+	// no validation rules for ConcurrencyLimitAction
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScriptValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScriptValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScriptValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.CreatedBy != nil {
 		// no validation rules for CreatedBy
 	}
@@ -144,6 +182,43 @@ func (m *Script) validate(all bool) error {
 
 	}
 
+	if m.TimeoutSeconds != nil {
+		// no validation rules for TimeoutSeconds
+	}
+
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScriptValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScriptValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScriptValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ScriptMultiError(errors)
 	}
@@ -221,22 +296,22 @@ var _ interface {
 	ErrorName() string
 } = ScriptValidationError{}
 
-// Validate checks the field values on CreateScriptRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateScriptRequest) Validate() error {
+// Validate checks the field values on ScriptParameter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ScriptParameter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateScriptRequest with the rules
+// ValidateAll checks the field values on ScriptParameter with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateScriptRequestMultiError, or nil if none found.
-func (m *CreateScriptRequest) ValidateAll() error {
+// ScriptParameterMultiError, or nil if none found.
+func (m *ScriptParameter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateScriptRequest) validate(all bool) error {
+func (m *ScriptParameter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -245,28 +320,36 @@ func (m *CreateScriptRequest) validate(all bool) error {
 
 	// no validation rules for Name
 
-	// no validation rules for Description
+	// no validation rules for Type
 
-	// no validation rules for ScriptType
+	// no validation rules for Required
 
-	// no validation rules for Content
+	// no validation rules for Secret
 
-	// no validation rules for Enabled
+	// no validation rules for Delivery
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.DefaultValue != nil {
+		// no validation rules for DefaultValue
+	}
 
 	if len(errors) > 0 {
-		return CreateScriptRequestMultiError(errors)
+		return ScriptParameterMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateScriptRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateScriptRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateScriptRequestMultiError []error
+// ScriptParameterMultiError is an error wrapping multiple validation errors
+// returned by ScriptParameter.ValidateAll() if the designated constraints
+// aren't met.
+type ScriptParameterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateScriptRequestMultiError) Error() string {
+func (m ScriptParameterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -275,11 +358,11 @@ func (m CreateScriptRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateScriptRequestMultiError) AllErrors() []error { return m }
+func (m ScriptParameterMultiError) AllErrors() []error { return m }
 
-// CreateScriptRequestValidationError is the validation error returned by
-// CreateScriptRequest.Validate if the designated constraints aren't met.
-type CreateScriptRequestValidationError struct {
+// ScriptParameterValidationError is the validation error returned by
+// ScriptParameter.Validate if the designated constraints aren't met.
+type ScriptParameterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -287,24 +370,22 @@ type CreateScriptRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateScriptRequestValidationError) Field() string { return e.field }
+func (e ScriptParameterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateScriptRequestValidationError) Reason() string { return e.reason }
+func (e ScriptParameterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateScriptRequestValidationError) Cause() error { return e.cause }
+func (e ScriptParameterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateScriptRequestValidationError) Key() bool { return e.key }
+func (e ScriptParameterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateScriptRequestValidationError) ErrorName() string {
-	return "CreateScriptRequestValidationError"
-}
+func (e ScriptParameterValidationError) ErrorName() string { return "ScriptParameterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateScriptRequestValidationError) Error() string {
+func (e ScriptParameterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -316,14 +397,14 @@ func (e CreateScriptRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateScriptRequest.%s: %s%s",
+		"invalid %sScriptParameter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateScriptRequestValidationError{}
+var _ error = ScriptParameterValidationError{}
 
 var _ interface {
 	Field() string
@@ -331,73 +412,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateScriptRequestValidationError{}
+} = ScriptParameterValidationError{}
 
-// Validate checks the field values on CreateScriptResponse with the rules
+// Validate checks the field values on ScriptParameterList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateScriptResponse) Validate() error {
+func (m *ScriptParameterList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateScriptResponse with the rules
+// ValidateAll checks the field values on ScriptParameterList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateScriptResponseMultiError, or nil if none found.
-func (m *CreateScriptResponse) ValidateAll() error {
+// ScriptParameterListMultiError, or nil if none found.
+func (m *ScriptParameterList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateScriptResponse) validate(all bool) error {
+func (m *ScriptParameterList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetScript()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateScriptResponseValidationError{
-					field:  "Script",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScriptParameterListValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScriptParameterListValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateScriptResponseValidationError{
-					field:  "Script",
+				return ScriptParameterListValidationError{
+					field:  fmt.Sprintf("Values[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetScript()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateScriptResponseValidationError{
-				field:  "Script",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateScriptResponseMultiError(errors)
+		return ScriptParameterListMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateScriptResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateScriptResponse.ValidateAll() if the designated
+// ScriptParameterListMultiError is an error wrapping multiple validation
+// errors returned by ScriptParameterList.ValidateAll() if the designated
 // constraints aren't met.
-type CreateScriptResponseMultiError []error
+type ScriptParameterListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateScriptResponseMultiError) Error() string {
+func (m ScriptParameterListMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -406,11 +492,11 @@ func (m CreateScriptResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateScriptResponseMultiError) AllErrors() []error { return m }
+func (m ScriptParameterListMultiError) AllErrors() []error { return m }
 
-// CreateScriptResponseValidationError is the validation error returned by
-// CreateScriptResponse.Validate if the designated constraints aren't met.
-type CreateScriptResponseValidationError struct {
+// ScriptParameterListValidationError is the validation error returned by
+// ScriptParameterList.Validate if the designated constraints aren't met.
+type ScriptParameterListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -418,24 +504,24 @@ type CreateScriptResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateScriptResponseValidationError) Field() string { return e.field }
+func (e ScriptParameterListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateScriptResponseValidationError) Reason() string { return e.reason }
+func (e ScriptParameterListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateScriptResponseValidationError) Cause() error { return e.cause }
+func (e ScriptParameterListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateScriptResponseValidationError) Key() bool { return e.key }
+func (e ScriptParameterListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateScriptResponseValidationError) ErrorName() string {
-	return "CreateScriptResponseValidationError"
+func (e ScriptParameterListValidationError) ErrorName() string {
+	return "ScriptParameterListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateScriptResponseValidationError) Error() string {
+func (e ScriptParameterListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -447,14 +533,14 @@ func (e CreateScriptResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateScriptResponse.%s: %s%s",
+		"invalid %sScriptParameterList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateScriptResponseValidationError{}
+var _ error = ScriptParameterListValidationError{}
 
 var _ interface {
 	Field() string
@@ -462,46 +548,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateScriptResponseValidationError{}
+} = ScriptParameterListValidationError{}
 
-// Validate checks the field values on GetScriptRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetScriptRequest) Validate() error {
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RetryPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetScriptRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetScriptRequestMultiError, or nil if none found.
-func (m *GetScriptRequest) ValidateAll() error {
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetScriptRequest) validate(all bool) error {
+func (m *RetryPolicy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for MaxAttempts
+
+	// no validation rules for BackoffSeconds
+
+	// no validation rules for MaxBackoffSeconds
 
 	if len(errors) > 0 {
-		return GetScriptRequestMultiError(errors)
+		return RetryPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetScriptRequestMultiError is an error wrapping multiple validation errors
-// returned by GetScriptRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetScriptRequestMultiError []error
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetScriptRequestMultiError) Error() string {
+func (m RetryPolicyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -510,11 +599,11 @@ func (m GetScriptRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetScriptRequestMultiError) AllErrors() []error { return m }
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
 
-// GetScriptRequestValidationError is the validation error returned by
-// GetScriptRequest.Validate if the designated constraints aren't met.
-type GetScriptRequestValidationError struct {
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -522,22 +611,22 @@ type GetScriptRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetScriptRequestValidationError) Field() string { return e.field }
+func (e RetryPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetScriptRequestValidationError) Reason() string { return e.reason }
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetScriptRequestValidationError) Cause() error { return e.cause }
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetScriptRequestValidationError) Key() bool { return e.key }
+func (e RetryPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetScriptRequestValidationError) ErrorName() string { return "GetScriptRequestValidationError" }
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetScriptRequestValidationError) Error() string {
+func (e RetryPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -549,14 +638,14 @@ func (e GetScriptRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetScriptRequest.%s: %s%s",
+		"invalid %sRetryPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetScriptRequestValidationError{}
+var _ error = RetryPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -564,73 +653,133 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetScriptRequestValidationError{}
+} = RetryPolicyValidationError{}
 
-// Validate checks the field values on GetScriptResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetScriptResponse) Validate() error {
+// Validate checks the field values on CreateScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScriptRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetScriptResponse with the rules
+// ValidateAll checks the field values on CreateScriptRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetScriptResponseMultiError, or nil if none found.
-func (m *GetScriptResponse) ValidateAll() error {
+// CreateScriptRequestMultiError, or nil if none found.
+func (m *CreateScriptRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetScriptResponse) validate(all bool) error {
+func (m *CreateScriptRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetScript()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetScriptResponseValidationError{
-					field:  "Script",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetScriptResponseValidationError{
-					field:  "Script",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetScript()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetScriptResponseValidationError{
-				field:  "Script",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
 
-	if len(errors) > 0 {
-		return GetScriptResponseMultiError(errors)
-	}
+	// no validation rules for Description
 
-	return nil
-}
+	// no validation rules for ScriptType
 
-// GetScriptResponseMultiError is an error wrapping multiple validation errors
-// returned by GetScriptResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetScriptResponseMultiError []error
+	// no validation rules for Content
+
+	// no validation rules for Enabled
+
+	// no validation rules for Singleton
+
+	// no validation rules for ConcurrencyLimitAction
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateScriptRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateScriptRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateScriptRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TimeoutSeconds != nil {
+		// no validation rules for TimeoutSeconds
+	}
+
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateScriptRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateScriptRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateScriptRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ChangeNote != nil {
+		// no validation rules for ChangeNote
+	}
+
+	if len(errors) > 0 {
+		return CreateScriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScriptRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateScriptRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateScriptRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetScriptResponseMultiError) Error() string {
+func (m CreateScriptRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -639,11 +788,11 @@ func (m GetScriptResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetScriptResponseMultiError) AllErrors() []error { return m }
+func (m CreateScriptRequestMultiError) AllErrors() []error { return m }
 
-// GetScriptResponseValidationError is the validation error returned by
-// GetScriptResponse.Validate if the designated constraints aren't met.
-type GetScriptResponseValidationError struct {
+// CreateScriptRequestValidationError is the validation error returned by
+// CreateScriptRequest.Validate if the designated constraints aren't met.
+type CreateScriptRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -651,24 +800,24 @@ type GetScriptResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetScriptResponseValidationError) Field() string { return e.field }
+func (e CreateScriptRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetScriptResponseValidationError) Reason() string { return e.reason }
+func (e CreateScriptRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetScriptResponseValidationError) Cause() error { return e.cause }
+func (e CreateScriptRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetScriptResponseValidationError) Key() bool { return e.key }
+func (e CreateScriptRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetScriptResponseValidationError) ErrorName() string {
-	return "GetScriptResponseValidationError"
+func (e CreateScriptRequestValidationError) ErrorName() string {
+	return "CreateScriptRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetScriptResponseValidationError) Error() string {
+func (e CreateScriptRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -680,14 +829,14 @@ func (e GetScriptResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetScriptResponse.%s: %s%s",
+		"invalid %sCreateScriptRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetScriptResponseValidationError{}
+var _ error = CreateScriptRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -695,64 +844,140 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetScriptResponseValidationError{}
+} = CreateScriptRequestValidationError{}
 
-// Validate checks the field values on ListScriptsRequest with the rules
+// Validate checks the field values on CreateScriptResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListScriptsRequest) Validate() error {
+func (m *CreateScriptResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListScriptsRequest with the rules
+// ValidateAll checks the field values on CreateScriptResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListScriptsRequestMultiError, or nil if none found.
-func (m *ListScriptsRequest) ValidateAll() error {
+// CreateScriptResponseMultiError, or nil if none found.
+func (m *CreateScriptResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListScriptsRequest) validate(all bool) error {
+func (m *CreateScriptResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Page != nil {
-		// no validation rules for Page
+	if all {
+		switch v := interface{}(m.GetScript()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateScriptResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateScriptResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScript()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateScriptResponseValidationError{
+				field:  "Script",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.PageSize != nil {
-		// no validation rules for PageSize
-	}
+	for idx, item := range m.GetDiagnostics() {
+		_, _ = idx, item
 
-	if m.ScriptType != nil {
-		// no validation rules for ScriptType
-	}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateScriptResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateScriptResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateScriptResponseValidationError{
+					field:  fmt.Sprintf("Diagnostics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.Name != nil {
-		// no validation rules for Name
 	}
 
-	if m.Enabled != nil {
-		// no validation rules for Enabled
+	if m.ChangeRequest != nil {
+
+		if all {
+			switch v := interface{}(m.GetChangeRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateScriptResponseValidationError{
+						field:  "ChangeRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateScriptResponseValidationError{
+						field:  "ChangeRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateScriptResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
-		return ListScriptsRequestMultiError(errors)
+		return CreateScriptResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListScriptsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListScriptsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListScriptsRequestMultiError []error
+// CreateScriptResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateScriptResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateScriptResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListScriptsRequestMultiError) Error() string {
+func (m CreateScriptResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -761,11 +986,3571 @@ func (m ListScriptsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListScriptsRequestMultiError) AllErrors() []error { return m }
+func (m CreateScriptResponseMultiError) AllErrors() []error { return m }
 
-// ListScriptsRequestValidationError is the validation error returned by
-// ListScriptsRequest.Validate if the designated constraints aren't met.
-type ListScriptsRequestValidationError struct {
+// CreateScriptResponseValidationError is the validation error returned by
+// CreateScriptResponse.Validate if the designated constraints aren't met.
+type CreateScriptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScriptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScriptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScriptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScriptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScriptResponseValidationError) ErrorName() string {
+	return "CreateScriptResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScriptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScriptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScriptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScriptResponseValidationError{}
+
+// Validate checks the field values on ValidateScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateScriptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateScriptRequestMultiError, or nil if none found.
+func (m *ValidateScriptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateScriptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScriptType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ValidateScriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateScriptRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateScriptRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateScriptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateScriptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateScriptRequestMultiError) AllErrors() []error { return m }
+
+// ValidateScriptRequestValidationError is the validation error returned by
+// ValidateScriptRequest.Validate if the designated constraints aren't met.
+type ValidateScriptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateScriptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateScriptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateScriptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateScriptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateScriptRequestValidationError) ErrorName() string {
+	return "ValidateScriptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateScriptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateScriptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateScriptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateScriptRequestValidationError{}
+
+// Validate checks the field values on ValidateScriptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateScriptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateScriptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateScriptResponseMultiError, or nil if none found.
+func (m *ValidateScriptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateScriptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDiagnostics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateScriptResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateScriptResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateScriptResponseValidationError{
+					field:  fmt.Sprintf("Diagnostics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Blocked
+
+	// no validation rules for BlockSeverity
+
+	if len(errors) > 0 {
+		return ValidateScriptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateScriptResponseMultiError is an error wrapping multiple validation
+// errors returned by ValidateScriptResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateScriptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateScriptResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateScriptResponseMultiError) AllErrors() []error { return m }
+
+// ValidateScriptResponseValidationError is the validation error returned by
+// ValidateScriptResponse.Validate if the designated constraints aren't met.
+type ValidateScriptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateScriptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateScriptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateScriptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateScriptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateScriptResponseValidationError) ErrorName() string {
+	return "ValidateScriptResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateScriptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateScriptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateScriptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateScriptResponseValidationError{}
+
+// Validate checks the field values on ListLintRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLintRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLintRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLintRulesRequestMultiError, or nil if none found.
+func (m *ListLintRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLintRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListLintRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLintRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLintRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListLintRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLintRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLintRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListLintRulesRequestValidationError is the validation error returned by
+// ListLintRulesRequest.Validate if the designated constraints aren't met.
+type ListLintRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLintRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLintRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLintRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLintRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLintRulesRequestValidationError) ErrorName() string {
+	return "ListLintRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLintRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLintRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLintRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLintRulesRequestValidationError{}
+
+// Validate checks the field values on ListLintRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLintRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLintRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLintRulesResponseMultiError, or nil if none found.
+func (m *ListLintRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLintRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLintRulesResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLintRulesResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLintRulesResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLintRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLintRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLintRulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLintRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLintRulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLintRulesResponseMultiError) AllErrors() []error { return m }
+
+// ListLintRulesResponseValidationError is the validation error returned by
+// ListLintRulesResponse.Validate if the designated constraints aren't met.
+type ListLintRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLintRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLintRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLintRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLintRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLintRulesResponseValidationError) ErrorName() string {
+	return "ListLintRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLintRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLintRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLintRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLintRulesResponseValidationError{}
+
+// Validate checks the field values on GetScriptRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetScriptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetScriptRequestMultiError, or nil if none found.
+func (m *GetScriptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScriptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetScriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScriptRequestMultiError is an error wrapping multiple validation errors
+// returned by GetScriptRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetScriptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScriptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScriptRequestMultiError) AllErrors() []error { return m }
+
+// GetScriptRequestValidationError is the validation error returned by
+// GetScriptRequest.Validate if the designated constraints aren't met.
+type GetScriptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScriptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScriptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScriptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScriptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScriptRequestValidationError) ErrorName() string { return "GetScriptRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetScriptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScriptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScriptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScriptRequestValidationError{}
+
+// Validate checks the field values on GetScriptResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetScriptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScriptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetScriptResponseMultiError, or nil if none found.
+func (m *GetScriptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScriptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScript()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetScriptResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetScriptResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScript()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetScriptResponseValidationError{
+				field:  "Script",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetScriptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScriptResponseMultiError is an error wrapping multiple validation errors
+// returned by GetScriptResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetScriptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScriptResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScriptResponseMultiError) AllErrors() []error { return m }
+
+// GetScriptResponseValidationError is the validation error returned by
+// GetScriptResponse.Validate if the designated constraints aren't met.
+type GetScriptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScriptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScriptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScriptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScriptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScriptResponseValidationError) ErrorName() string {
+	return "GetScriptResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScriptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScriptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScriptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScriptResponseValidationError{}
+
+// Validate checks the field values on ListScriptsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScriptsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScriptsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScriptsRequestMultiError, or nil if none found.
+func (m *ListScriptsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScriptsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.ScriptType != nil {
+		// no validation rules for ScriptType
+	}
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Enabled != nil {
+		// no validation rules for Enabled
+	}
+
+	if len(errors) > 0 {
+		return ListScriptsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScriptsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListScriptsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListScriptsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScriptsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScriptsRequestMultiError) AllErrors() []error { return m }
+
+// ListScriptsRequestValidationError is the validation error returned by
+// ListScriptsRequest.Validate if the designated constraints aren't met.
+type ListScriptsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScriptsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScriptsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScriptsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScriptsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScriptsRequestValidationError) ErrorName() string {
+	return "ListScriptsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScriptsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScriptsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScriptsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScriptsRequestValidationError{}
+
+// Validate checks the field values on ListScriptsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScriptsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScriptsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScriptsResponseMultiError, or nil if none found.
+func (m *ListScriptsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScriptsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScripts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListScriptsResponseValidationError{
+						field:  fmt.Sprintf("Scripts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListScriptsResponseValidationError{
+						field:  fmt.Sprintf("Scripts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListScriptsResponseValidationError{
+					field:  fmt.Sprintf("Scripts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListScriptsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScriptsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListScriptsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListScriptsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScriptsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScriptsResponseMultiError) AllErrors() []error { return m }
+
+// ListScriptsResponseValidationError is the validation error returned by
+// ListScriptsResponse.Validate if the designated constraints aren't met.
+type ListScriptsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScriptsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScriptsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScriptsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScriptsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScriptsResponseValidationError) ErrorName() string {
+	return "ListScriptsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScriptsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScriptsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScriptsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScriptsResponseValidationError{}
+
+// Validate checks the field values on UpdateScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateScriptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateScriptRequestMultiError, or nil if none found.
+func (m *UpdateScriptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateScriptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Content != nil {
+		// no validation rules for Content
+	}
+
+	if m.Enabled != nil {
+		// no validation rules for Enabled
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.TimeoutSeconds != nil {
+		// no validation rules for TimeoutSeconds
+	}
+
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateScriptRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateScriptRequestValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateScriptRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Singleton != nil {
+		// no validation rules for Singleton
+	}
+
+	if m.ConcurrencyLimitAction != nil {
+		// no validation rules for ConcurrencyLimitAction
+	}
+
+	if m.ChangeNote != nil {
+		// no validation rules for ChangeNote
+	}
+
+	if m.Parameters != nil {
+
+		if all {
+			switch v := interface{}(m.GetParameters()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateScriptRequestValidationError{
+						field:  "Parameters",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateScriptRequestValidationError{
+						field:  "Parameters",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetParameters()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateScriptRequestValidationError{
+					field:  "Parameters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateScriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateScriptRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateScriptRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateScriptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateScriptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateScriptRequestMultiError) AllErrors() []error { return m }
+
+// UpdateScriptRequestValidationError is the validation error returned by
+// UpdateScriptRequest.Validate if the designated constraints aren't met.
+type UpdateScriptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateScriptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateScriptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateScriptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateScriptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateScriptRequestValidationError) ErrorName() string {
+	return "UpdateScriptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateScriptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateScriptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateScriptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateScriptRequestValidationError{}
+
+// Validate checks the field values on UpdateScriptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateScriptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateScriptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateScriptResponseMultiError, or nil if none found.
+func (m *UpdateScriptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateScriptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScript()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateScriptResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateScriptResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScript()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateScriptResponseValidationError{
+				field:  "Script",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDiagnostics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateScriptResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateScriptResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateScriptResponseValidationError{
+					field:  fmt.Sprintf("Diagnostics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ChangeRequest != nil {
+
+		if all {
+			switch v := interface{}(m.GetChangeRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateScriptResponseValidationError{
+						field:  "ChangeRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateScriptResponseValidationError{
+						field:  "ChangeRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateScriptResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateScriptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateScriptResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateScriptResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateScriptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateScriptResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateScriptResponseMultiError) AllErrors() []error { return m }
+
+// UpdateScriptResponseValidationError is the validation error returned by
+// UpdateScriptResponse.Validate if the designated constraints aren't met.
+type UpdateScriptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateScriptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateScriptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateScriptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateScriptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateScriptResponseValidationError) ErrorName() string {
+	return "UpdateScriptResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateScriptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateScriptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateScriptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateScriptResponseValidationError{}
+
+// Validate checks the field values on DeleteScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteScriptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteScriptRequestMultiError, or nil if none found.
+func (m *DeleteScriptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteScriptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteScriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteScriptRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteScriptRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteScriptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteScriptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteScriptRequestMultiError) AllErrors() []error { return m }
+
+// DeleteScriptRequestValidationError is the validation error returned by
+// DeleteScriptRequest.Validate if the designated constraints aren't met.
+type DeleteScriptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteScriptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteScriptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteScriptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteScriptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteScriptRequestValidationError) ErrorName() string {
+	return "DeleteScriptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteScriptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteScriptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteScriptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteScriptRequestValidationError{}
+
+// Validate checks the field values on ListScriptVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScriptVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScriptVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScriptVersionsRequestMultiError, or nil if none found.
+func (m *ListScriptVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScriptVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScriptId
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListScriptVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScriptVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListScriptVersionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListScriptVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScriptVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScriptVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListScriptVersionsRequestValidationError is the validation error returned by
+// ListScriptVersionsRequest.Validate if the designated constraints aren't met.
+type ListScriptVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScriptVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScriptVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScriptVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScriptVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScriptVersionsRequestValidationError) ErrorName() string {
+	return "ListScriptVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScriptVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScriptVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScriptVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScriptVersionsRequestValidationError{}
+
+// Validate checks the field values on ListScriptVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScriptVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScriptVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScriptVersionsResponseMultiError, or nil if none found.
+func (m *ListScriptVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScriptVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListScriptVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListScriptVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListScriptVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListScriptVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScriptVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListScriptVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListScriptVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScriptVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScriptVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListScriptVersionsResponseValidationError is the validation error returned
+// by ListScriptVersionsResponse.Validate if the designated constraints aren't met.
+type ListScriptVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScriptVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScriptVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScriptVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScriptVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScriptVersionsResponseValidationError) ErrorName() string {
+	return "ListScriptVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScriptVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScriptVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScriptVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScriptVersionsResponseValidationError{}
+
+// Validate checks the field values on GetScriptVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetScriptVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScriptVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetScriptVersionRequestMultiError, or nil if none found.
+func (m *GetScriptVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScriptVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScriptId
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return GetScriptVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScriptVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetScriptVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetScriptVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScriptVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScriptVersionRequestMultiError) AllErrors() []error { return m }
+
+// GetScriptVersionRequestValidationError is the validation error returned by
+// GetScriptVersionRequest.Validate if the designated constraints aren't met.
+type GetScriptVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScriptVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScriptVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScriptVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScriptVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScriptVersionRequestValidationError) ErrorName() string {
+	return "GetScriptVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScriptVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScriptVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScriptVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScriptVersionRequestValidationError{}
+
+// Validate checks the field values on GetScriptVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetScriptVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScriptVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetScriptVersionResponseMultiError, or nil if none found.
+func (m *GetScriptVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScriptVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetScriptVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetScriptVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetScriptVersionResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetScriptVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScriptVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetScriptVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetScriptVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScriptVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScriptVersionResponseMultiError) AllErrors() []error { return m }
+
+// GetScriptVersionResponseValidationError is the validation error returned by
+// GetScriptVersionResponse.Validate if the designated constraints aren't met.
+type GetScriptVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScriptVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScriptVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScriptVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScriptVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScriptVersionResponseValidationError) ErrorName() string {
+	return "GetScriptVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScriptVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScriptVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScriptVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScriptVersionResponseValidationError{}
+
+// Validate checks the field values on DiffScriptVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffScriptVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffScriptVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffScriptVersionsRequestMultiError, or nil if none found.
+func (m *DiffScriptVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffScriptVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScriptId
+
+	// no validation rules for FromVersion
+
+	if m.ToVersion != nil {
+		// no validation rules for ToVersion
+	}
+
+	if len(errors) > 0 {
+		return DiffScriptVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffScriptVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by DiffScriptVersionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DiffScriptVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffScriptVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffScriptVersionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffScriptVersionsRequestValidationError is the validation error returned by
+// DiffScriptVersionsRequest.Validate if the designated constraints aren't met.
+type DiffScriptVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffScriptVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffScriptVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffScriptVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffScriptVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffScriptVersionsRequestValidationError) ErrorName() string {
+	return "DiffScriptVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffScriptVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffScriptVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffScriptVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffScriptVersionsRequestValidationError{}
+
+// Validate checks the field values on DiffScriptVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffScriptVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffScriptVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffScriptVersionsResponseMultiError, or nil if none found.
+func (m *DiffScriptVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffScriptVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromVersion
+
+	// no validation rules for ToVersion
+
+	// no validation rules for Diff
+
+	// no validation rules for AddedLines
+
+	// no validation rules for RemovedLines
+
+	if len(errors) > 0 {
+		return DiffScriptVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffScriptVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiffScriptVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiffScriptVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffScriptVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffScriptVersionsResponseMultiError) AllErrors() []error { return m }
+
+// DiffScriptVersionsResponseValidationError is the validation error returned
+// by DiffScriptVersionsResponse.Validate if the designated constraints aren't met.
+type DiffScriptVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffScriptVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffScriptVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffScriptVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffScriptVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffScriptVersionsResponseValidationError) ErrorName() string {
+	return "DiffScriptVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffScriptVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffScriptVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffScriptVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffScriptVersionsResponseValidationError{}
+
+// Validate checks the field values on RollbackScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackScriptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackScriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackScriptRequestMultiError, or nil if none found.
+func (m *RollbackScriptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackScriptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Version
+
+	// no validation rules for Password
+
+	if m.ChangeNote != nil {
+		// no validation rules for ChangeNote
+	}
+
+	if len(errors) > 0 {
+		return RollbackScriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackScriptRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackScriptRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackScriptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackScriptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackScriptRequestMultiError) AllErrors() []error { return m }
+
+// RollbackScriptRequestValidationError is the validation error returned by
+// RollbackScriptRequest.Validate if the designated constraints aren't met.
+type RollbackScriptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackScriptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackScriptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackScriptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackScriptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackScriptRequestValidationError) ErrorName() string {
+	return "RollbackScriptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackScriptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackScriptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackScriptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackScriptRequestValidationError{}
+
+// Validate checks the field values on RollbackScriptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackScriptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackScriptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackScriptResponseMultiError, or nil if none found.
+func (m *RollbackScriptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackScriptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScript()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackScriptResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackScriptResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScript()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackScriptResponseValidationError{
+				field:  "Script",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDiagnostics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RollbackScriptResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RollbackScriptResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RollbackScriptResponseValidationError{
+					field:  fmt.Sprintf("Diagnostics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ChangeRequest != nil {
+
+		if all {
+			switch v := interface{}(m.GetChangeRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RollbackScriptResponseValidationError{
+						field:  "ChangeRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RollbackScriptResponseValidationError{
+						field:  "ChangeRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RollbackScriptResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RollbackScriptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackScriptResponseMultiError is an error wrapping multiple validation
+// errors returned by RollbackScriptResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackScriptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackScriptResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackScriptResponseMultiError) AllErrors() []error { return m }
+
+// RollbackScriptResponseValidationError is the validation error returned by
+// RollbackScriptResponse.Validate if the designated constraints aren't met.
+type RollbackScriptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackScriptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackScriptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackScriptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackScriptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackScriptResponseValidationError) ErrorName() string {
+	return "RollbackScriptResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackScriptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackScriptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackScriptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackScriptResponseValidationError{}
+
+// Validate checks the field values on ListScriptChangeRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScriptChangeRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScriptChangeRequestsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListScriptChangeRequestsRequestMultiError, or nil if none found.
+func (m *ListScriptChangeRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScriptChangeRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.ScriptId != nil {
+		// no validation rules for ScriptId
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListScriptChangeRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScriptChangeRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListScriptChangeRequestsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListScriptChangeRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScriptChangeRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScriptChangeRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListScriptChangeRequestsRequestValidationError is the validation error
+// returned by ListScriptChangeRequestsRequest.Validate if the designated
+// constraints aren't met.
+type ListScriptChangeRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScriptChangeRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScriptChangeRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScriptChangeRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScriptChangeRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScriptChangeRequestsRequestValidationError) ErrorName() string {
+	return "ListScriptChangeRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScriptChangeRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScriptChangeRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScriptChangeRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScriptChangeRequestsRequestValidationError{}
+
+// Validate checks the field values on ListScriptChangeRequestsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListScriptChangeRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScriptChangeRequestsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListScriptChangeRequestsResponseMultiError, or nil if none found.
+func (m *ListScriptChangeRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScriptChangeRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChangeRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListScriptChangeRequestsResponseValidationError{
+						field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListScriptChangeRequestsResponseValidationError{
+						field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListScriptChangeRequestsResponseValidationError{
+					field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListScriptChangeRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScriptChangeRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListScriptChangeRequestsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListScriptChangeRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScriptChangeRequestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScriptChangeRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListScriptChangeRequestsResponseValidationError is the validation error
+// returned by ListScriptChangeRequestsResponse.Validate if the designated
+// constraints aren't met.
+type ListScriptChangeRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScriptChangeRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScriptChangeRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScriptChangeRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScriptChangeRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScriptChangeRequestsResponseValidationError) ErrorName() string {
+	return "ListScriptChangeRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScriptChangeRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScriptChangeRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScriptChangeRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScriptChangeRequestsResponseValidationError{}
+
+// Validate checks the field values on GetScriptChangeRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetScriptChangeRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScriptChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetScriptChangeRequestRequestMultiError, or nil if none found.
+func (m *GetScriptChangeRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScriptChangeRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetScriptChangeRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScriptChangeRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by GetScriptChangeRequestRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetScriptChangeRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScriptChangeRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScriptChangeRequestRequestMultiError) AllErrors() []error { return m }
+
+// GetScriptChangeRequestRequestValidationError is the validation error
+// returned by GetScriptChangeRequestRequest.Validate if the designated
+// constraints aren't met.
+type GetScriptChangeRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScriptChangeRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScriptChangeRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScriptChangeRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScriptChangeRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScriptChangeRequestRequestValidationError) ErrorName() string {
+	return "GetScriptChangeRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScriptChangeRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScriptChangeRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScriptChangeRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScriptChangeRequestRequestValidationError{}
+
+// Validate checks the field values on GetScriptChangeRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetScriptChangeRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScriptChangeRequestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetScriptChangeRequestResponseMultiError, or nil if none found.
+func (m *GetScriptChangeRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScriptChangeRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChangeRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetScriptChangeRequestResponseValidationError{
+				field:  "ChangeRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Diff
+
+	// no validation rules for AddedLines
+
+	// no validation rules for RemovedLines
+
+	if len(errors) > 0 {
+		return GetScriptChangeRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScriptChangeRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by GetScriptChangeRequestResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetScriptChangeRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScriptChangeRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScriptChangeRequestResponseMultiError) AllErrors() []error { return m }
+
+// GetScriptChangeRequestResponseValidationError is the validation error
+// returned by GetScriptChangeRequestResponse.Validate if the designated
+// constraints aren't met.
+type GetScriptChangeRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScriptChangeRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScriptChangeRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScriptChangeRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScriptChangeRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScriptChangeRequestResponseValidationError) ErrorName() string {
+	return "GetScriptChangeRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScriptChangeRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScriptChangeRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScriptChangeRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScriptChangeRequestResponseValidationError{}
+
+// Validate checks the field values on ApproveScriptChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ApproveScriptChangeRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveScriptChangeRequestRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ApproveScriptChangeRequestRequestMultiError, or nil if none found.
+func (m *ApproveScriptChangeRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveScriptChangeRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Password
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if len(errors) > 0 {
+		return ApproveScriptChangeRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveScriptChangeRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ApproveScriptChangeRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveScriptChangeRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveScriptChangeRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveScriptChangeRequestRequestMultiError) AllErrors() []error { return m }
+
+// ApproveScriptChangeRequestRequestValidationError is the validation error
+// returned by ApproveScriptChangeRequestRequest.Validate if the designated
+// constraints aren't met.
+type ApproveScriptChangeRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveScriptChangeRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveScriptChangeRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveScriptChangeRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveScriptChangeRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveScriptChangeRequestRequestValidationError) ErrorName() string {
+	return "ApproveScriptChangeRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveScriptChangeRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveScriptChangeRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveScriptChangeRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveScriptChangeRequestRequestValidationError{}
+
+// Validate checks the field values on ApproveScriptChangeRequestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ApproveScriptChangeRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveScriptChangeRequestResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ApproveScriptChangeRequestResponseMultiError, or nil if none found.
+func (m *ApproveScriptChangeRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveScriptChangeRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChangeRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveScriptChangeRequestResponseValidationError{
+				field:  "ChangeRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetScript()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveScriptChangeRequestResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveScriptChangeRequestResponseValidationError{
+					field:  "Script",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScript()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveScriptChangeRequestResponseValidationError{
+				field:  "Script",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDiagnostics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApproveScriptChangeRequestResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApproveScriptChangeRequestResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApproveScriptChangeRequestResponseValidationError{
+					field:  fmt.Sprintf("Diagnostics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApproveScriptChangeRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveScriptChangeRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ApproveScriptChangeRequestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveScriptChangeRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveScriptChangeRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveScriptChangeRequestResponseMultiError) AllErrors() []error { return m }
+
+// ApproveScriptChangeRequestResponseValidationError is the validation error
+// returned by ApproveScriptChangeRequestResponse.Validate if the designated
+// constraints aren't met.
+type ApproveScriptChangeRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveScriptChangeRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveScriptChangeRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveScriptChangeRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveScriptChangeRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveScriptChangeRequestResponseValidationError) ErrorName() string {
+	return "ApproveScriptChangeRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveScriptChangeRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveScriptChangeRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveScriptChangeRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveScriptChangeRequestResponseValidationError{}
+
+// Validate checks the field values on RejectScriptChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RejectScriptChangeRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectScriptChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RejectScriptChangeRequestRequestMultiError, or nil if none found.
+func (m *RejectScriptChangeRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectScriptChangeRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RejectScriptChangeRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectScriptChangeRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RejectScriptChangeRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejectScriptChangeRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectScriptChangeRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectScriptChangeRequestRequestMultiError) AllErrors() []error { return m }
+
+// RejectScriptChangeRequestRequestValidationError is the validation error
+// returned by RejectScriptChangeRequestRequest.Validate if the designated
+// constraints aren't met.
+type RejectScriptChangeRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectScriptChangeRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectScriptChangeRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectScriptChangeRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectScriptChangeRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectScriptChangeRequestRequestValidationError) ErrorName() string {
+	return "RejectScriptChangeRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectScriptChangeRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectScriptChangeRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectScriptChangeRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectScriptChangeRequestRequestValidationError{}
+
+// Validate checks the field values on RejectScriptChangeRequestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RejectScriptChangeRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectScriptChangeRequestResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RejectScriptChangeRequestResponseMultiError, or nil if none found.
+func (m *RejectScriptChangeRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectScriptChangeRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChangeRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectScriptChangeRequestResponseValidationError{
+				field:  "ChangeRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectScriptChangeRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectScriptChangeRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RejectScriptChangeRequestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejectScriptChangeRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectScriptChangeRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectScriptChangeRequestResponseMultiError) AllErrors() []error { return m }
+
+// RejectScriptChangeRequestResponseValidationError is the validation error
+// returned by RejectScriptChangeRequestResponse.Validate if the designated
+// constraints aren't met.
+type RejectScriptChangeRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -773,24 +4558,24 @@ type ListScriptsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListScriptsRequestValidationError) Field() string { return e.field }
+func (e RejectScriptChangeRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListScriptsRequestValidationError) Reason() string { return e.reason }
+func (e RejectScriptChangeRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListScriptsRequestValidationError) Cause() error { return e.cause }
+func (e RejectScriptChangeRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListScriptsRequestValidationError) Key() bool { return e.key }
+func (e RejectScriptChangeRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListScriptsRequestValidationError) ErrorName() string {
-	return "ListScriptsRequestValidationError"
+func (e RejectScriptChangeRequestResponseValidationError) ErrorName() string {
+	return "RejectScriptChangeRequestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListScriptsRequestValidationError) Error() string {
+func (e RejectScriptChangeRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -802,14 +4587,14 @@ func (e ListScriptsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListScriptsRequest.%s: %s%s",
+		"invalid %sRejectScriptChangeRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListScriptsRequestValidationError{}
+var _ error = RejectScriptChangeRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -817,80 +4602,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListScriptsRequestValidationError{}
+} = RejectScriptChangeRequestResponseValidationError{}
 
-// Validate checks the field values on ListScriptsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListScriptsResponse) Validate() error {
+// Validate checks the field values on CancelScriptChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CancelScriptChangeRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListScriptsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListScriptsResponseMultiError, or nil if none found.
-func (m *ListScriptsResponse) ValidateAll() error {
+// ValidateAll checks the field values on CancelScriptChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelScriptChangeRequestRequestMultiError, or nil if none found.
+func (m *CancelScriptChangeRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListScriptsResponse) validate(all bool) error {
+func (m *CancelScriptChangeRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetScripts() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListScriptsResponseValidationError{
-						field:  fmt.Sprintf("Scripts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListScriptsResponseValidationError{
-						field:  fmt.Sprintf("Scripts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListScriptsResponseValidationError{
-					field:  fmt.Sprintf("Scripts[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Total
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListScriptsResponseMultiError(errors)
+		return CancelScriptChangeRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListScriptsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListScriptsResponse.ValidateAll() if the designated
+// CancelScriptChangeRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CancelScriptChangeRequestRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListScriptsResponseMultiError []error
+type CancelScriptChangeRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListScriptsResponseMultiError) Error() string {
+func (m CancelScriptChangeRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -899,11 +4652,12 @@ func (m ListScriptsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListScriptsResponseMultiError) AllErrors() []error { return m }
+func (m CancelScriptChangeRequestRequestMultiError) AllErrors() []error { return m }
 
-// ListScriptsResponseValidationError is the validation error returned by
-// ListScriptsResponse.Validate if the designated constraints aren't met.
-type ListScriptsResponseValidationError struct {
+// CancelScriptChangeRequestRequestValidationError is the validation error
+// returned by CancelScriptChangeRequestRequest.Validate if the designated
+// constraints aren't met.
+type CancelScriptChangeRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -911,24 +4665,24 @@ type ListScriptsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListScriptsResponseValidationError) Field() string { return e.field }
+func (e CancelScriptChangeRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListScriptsResponseValidationError) Reason() string { return e.reason }
+func (e CancelScriptChangeRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListScriptsResponseValidationError) Cause() error { return e.cause }
+func (e CancelScriptChangeRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListScriptsResponseValidationError) Key() bool { return e.key }
+func (e CancelScriptChangeRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListScriptsResponseValidationError) ErrorName() string {
-	return "ListScriptsResponseValidationError"
+func (e CancelScriptChangeRequestRequestValidationError) ErrorName() string {
+	return "CancelScriptChangeRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListScriptsResponseValidationError) Error() string {
+func (e CancelScriptChangeRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -940,14 +4694,14 @@ func (e ListScriptsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListScriptsResponse.%s: %s%s",
+		"invalid %sCancelScriptChangeRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListScriptsResponseValidationError{}
+var _ error = CancelScriptChangeRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -955,66 +4709,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListScriptsResponseValidationError{}
+} = CancelScriptChangeRequestRequestValidationError{}
 
-// Validate checks the field values on UpdateScriptRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateScriptRequest) Validate() error {
+// Validate checks the field values on CancelScriptChangeRequestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CancelScriptChangeRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateScriptRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateScriptRequestMultiError, or nil if none found.
-func (m *UpdateScriptRequest) ValidateAll() error {
+// ValidateAll checks the field values on CancelScriptChangeRequestResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CancelScriptChangeRequestResponseMultiError, or nil if none found.
+func (m *CancelScriptChangeRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateScriptRequest) validate(all bool) error {
+func (m *CancelScriptChangeRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.Name != nil {
-		// no validation rules for Name
-	}
-
-	if m.Description != nil {
-		// no validation rules for Description
-	}
-
-	if m.Content != nil {
-		// no validation rules for Content
-	}
-
-	if m.Enabled != nil {
-		// no validation rules for Enabled
-	}
-
-	if m.Password != nil {
-		// no validation rules for Password
+	if all {
+		switch v := interface{}(m.GetChangeRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelScriptChangeRequestResponseValidationError{
+				field:  "ChangeRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return UpdateScriptRequestMultiError(errors)
+		return CancelScriptChangeRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateScriptRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateScriptRequest.ValidateAll() if the designated
+// CancelScriptChangeRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CancelScriptChangeRequestResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateScriptRequestMultiError []error
+type CancelScriptChangeRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateScriptRequestMultiError) Error() string {
+func (m CancelScriptChangeRequestResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1023,11 +4786,12 @@ func (m UpdateScriptRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateScriptRequestMultiError) AllErrors() []error { return m }
+func (m CancelScriptChangeRequestResponseMultiError) AllErrors() []error { return m }
 
-// UpdateScriptRequestValidationError is the validation error returned by
-// UpdateScriptRequest.Validate if the designated constraints aren't met.
-type UpdateScriptRequestValidationError struct {
+// CancelScriptChangeRequestResponseValidationError is the validation error
+// returned by CancelScriptChangeRequestResponse.Validate if the designated
+// constraints aren't met.
+type CancelScriptChangeRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1035,24 +4799,24 @@ type UpdateScriptRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateScriptRequestValidationError) Field() string { return e.field }
+func (e CancelScriptChangeRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateScriptRequestValidationError) Reason() string { return e.reason }
+func (e CancelScriptChangeRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateScriptRequestValidationError) Cause() error { return e.cause }
+func (e CancelScriptChangeRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateScriptRequestValidationError) Key() bool { return e.key }
+func (e CancelScriptChangeRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateScriptRequestValidationError) ErrorName() string {
-	return "UpdateScriptRequestValidationError"
+func (e CancelScriptChangeRequestResponseValidationError) ErrorName() string {
+	return "CancelScriptChangeRequestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateScriptRequestValidationError) Error() string {
+func (e CancelScriptChangeRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1064,14 +4828,14 @@ func (e UpdateScriptRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateScriptRequest.%s: %s%s",
+		"invalid %sCancelScriptChangeRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateScriptRequestValidationError{}
+var _ error = CancelScriptChangeRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1079,73 +4843,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateScriptRequestValidationError{}
+} = CancelScriptChangeRequestResponseValidationError{}
 
-// Validate checks the field values on UpdateScriptResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateScriptResponse) Validate() error {
+// Validate checks the field values on CommentScriptChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CommentScriptChangeRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateScriptResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateScriptResponseMultiError, or nil if none found.
-func (m *UpdateScriptResponse) ValidateAll() error {
+// ValidateAll checks the field values on CommentScriptChangeRequestRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CommentScriptChangeRequestRequestMultiError, or nil if none found.
+func (m *CommentScriptChangeRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateScriptResponse) validate(all bool) error {
+func (m *CommentScriptChangeRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetScript()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateScriptResponseValidationError{
-					field:  "Script",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateScriptResponseValidationError{
-					field:  "Script",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetScript()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateScriptResponseValidationError{
-				field:  "Script",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for Body
 
 	if len(errors) > 0 {
-		return UpdateScriptResponseMultiError(errors)
+		return CommentScriptChangeRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateScriptResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateScriptResponse.ValidateAll() if the designated
+// CommentScriptChangeRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CommentScriptChangeRequestRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateScriptResponseMultiError []error
+type CommentScriptChangeRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateScriptResponseMultiError) Error() string {
+func (m CommentScriptChangeRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1154,11 +4895,12 @@ func (m UpdateScriptResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateScriptResponseMultiError) AllErrors() []error { return m }
+func (m CommentScriptChangeRequestRequestMultiError) AllErrors() []error { return m }
 
-// UpdateScriptResponseValidationError is the validation error returned by
-// UpdateScriptResponse.Validate if the designated constraints aren't met.
-type UpdateScriptResponseValidationError struct {
+// CommentScriptChangeRequestRequestValidationError is the validation error
+// returned by CommentScriptChangeRequestRequest.Validate if the designated
+// constraints aren't met.
+type CommentScriptChangeRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1166,24 +4908,24 @@ type UpdateScriptResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateScriptResponseValidationError) Field() string { return e.field }
+func (e CommentScriptChangeRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateScriptResponseValidationError) Reason() string { return e.reason }
+func (e CommentScriptChangeRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateScriptResponseValidationError) Cause() error { return e.cause }
+func (e CommentScriptChangeRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateScriptResponseValidationError) Key() bool { return e.key }
+func (e CommentScriptChangeRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateScriptResponseValidationError) ErrorName() string {
-	return "UpdateScriptResponseValidationError"
+func (e CommentScriptChangeRequestRequestValidationError) ErrorName() string {
+	return "CommentScriptChangeRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateScriptResponseValidationError) Error() string {
+func (e CommentScriptChangeRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1195,14 +4937,14 @@ func (e UpdateScriptResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateScriptResponse.%s: %s%s",
+		"invalid %sCommentScriptChangeRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateScriptResponseValidationError{}
+var _ error = CommentScriptChangeRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1210,46 +4952,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateScriptResponseValidationError{}
+} = CommentScriptChangeRequestRequestValidationError{}
 
-// Validate checks the field values on DeleteScriptRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteScriptRequest) Validate() error {
+// Validate checks the field values on CommentScriptChangeRequestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CommentScriptChangeRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteScriptRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteScriptRequestMultiError, or nil if none found.
-func (m *DeleteScriptRequest) ValidateAll() error {
+// ValidateAll checks the field values on CommentScriptChangeRequestResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CommentScriptChangeRequestResponseMultiError, or nil if none found.
+func (m *CommentScriptChangeRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteScriptRequest) validate(all bool) error {
+func (m *CommentScriptChangeRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetChangeRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentScriptChangeRequestResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentScriptChangeRequestResponseValidationError{
+				field:  "ChangeRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteScriptRequestMultiError(errors)
+		return CommentScriptChangeRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteScriptRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteScriptRequest.ValidateAll() if the designated
+// CommentScriptChangeRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CommentScriptChangeRequestResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteScriptRequestMultiError []error
+type CommentScriptChangeRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteScriptRequestMultiError) Error() string {
+func (m CommentScriptChangeRequestResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1258,11 +5029,12 @@ func (m DeleteScriptRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteScriptRequestMultiError) AllErrors() []error { return m }
+func (m CommentScriptChangeRequestResponseMultiError) AllErrors() []error { return m }
 
-// DeleteScriptRequestValidationError is the validation error returned by
-// DeleteScriptRequest.Validate if the designated constraints aren't met.
-type DeleteScriptRequestValidationError struct {
+// CommentScriptChangeRequestResponseValidationError is the validation error
+// returned by CommentScriptChangeRequestResponse.Validate if the designated
+// constraints aren't met.
+type CommentScriptChangeRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1270,24 +5042,24 @@ type DeleteScriptRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteScriptRequestValidationError) Field() string { return e.field }
+func (e CommentScriptChangeRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteScriptRequestValidationError) Reason() string { return e.reason }
+func (e CommentScriptChangeRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteScriptRequestValidationError) Cause() error { return e.cause }
+func (e CommentScriptChangeRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteScriptRequestValidationError) Key() bool { return e.key }
+func (e CommentScriptChangeRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteScriptRequestValidationError) ErrorName() string {
-	return "DeleteScriptRequestValidationError"
+func (e CommentScriptChangeRequestResponseValidationError) ErrorName() string {
+	return "CommentScriptChangeRequestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteScriptRequestValidationError) Error() string {
+func (e CommentScriptChangeRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1299,14 +5071,14 @@ func (e DeleteScriptRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteScriptRequest.%s: %s%s",
+		"invalid %sCommentScriptChangeRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteScriptRequestValidationError{}
+var _ error = CommentScriptChangeRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1314,4 +5086,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteScriptRequestValidationError{}
+} = CommentScriptChangeRequestResponseValidationError{}
@@ -20,11 +20,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExecutorScriptService_CreateScript_FullMethodName = "/executor.service.v1.ExecutorScriptService/CreateScript"
-	ExecutorScriptService_GetScript_FullMethodName    = "/executor.service.v1.ExecutorScriptService/GetScript"
-	ExecutorScriptService_ListScripts_FullMethodName  = "/executor.service.v1.ExecutorScriptService/ListScripts"
-	ExecutorScriptService_UpdateScript_FullMethodName = "/executor.service.v1.ExecutorScriptService/UpdateScript"
-	ExecutorScriptService_DeleteScript_FullMethodName = "/executor.service.v1.ExecutorScriptService/DeleteScript"
+	ExecutorScriptService_CreateScript_FullMethodName               = "/executor.service.v1.ExecutorScriptService/CreateScript"
+	ExecutorScriptService_ValidateScript_FullMethodName             = "/executor.service.v1.ExecutorScriptService/ValidateScript"
+	ExecutorScriptService_ListLintRules_FullMethodName              = "/executor.service.v1.ExecutorScriptService/ListLintRules"
+	ExecutorScriptService_GetScript_FullMethodName                  = "/executor.service.v1.ExecutorScriptService/GetScript"
+	ExecutorScriptService_ListScripts_FullMethodName                = "/executor.service.v1.ExecutorScriptService/ListScripts"
+	ExecutorScriptService_UpdateScript_FullMethodName               = "/executor.service.v1.ExecutorScriptService/UpdateScript"
+	ExecutorScriptService_DeleteScript_FullMethodName               = "/executor.service.v1.ExecutorScriptService/DeleteScript"
+	ExecutorScriptService_ListScriptVersions_FullMethodName         = "/executor.service.v1.ExecutorScriptService/ListScriptVersions"
+	ExecutorScriptService_GetScriptVersion_FullMethodName           = "/executor.service.v1.ExecutorScriptService/GetScriptVersion"
+	ExecutorScriptService_DiffScriptVersions_FullMethodName         = "/executor.service.v1.ExecutorScriptService/DiffScriptVersions"
+	ExecutorScriptService_RollbackScript_FullMethodName             = "/executor.service.v1.ExecutorScriptService/RollbackScript"
+	ExecutorScriptService_ListScriptChangeRequests_FullMethodName   = "/executor.service.v1.ExecutorScriptService/ListScriptChangeRequests"
+	ExecutorScriptService_GetScriptChangeRequest_FullMethodName     = "/executor.service.v1.ExecutorScriptService/GetScriptChangeRequest"
+	ExecutorScriptService_ApproveScriptChangeRequest_FullMethodName = "/executor.service.v1.ExecutorScriptService/ApproveScriptChangeRequest"
+	ExecutorScriptService_RejectScriptChangeRequest_FullMethodName  = "/executor.service.v1.ExecutorScriptService/RejectScriptChangeRequest"
+	ExecutorScriptService_CancelScriptChangeRequest_FullMethodName  = "/executor.service.v1.ExecutorScriptService/CancelScriptChangeRequest"
+	ExecutorScriptService_CommentScriptChangeRequest_FullMethodName = "/executor.service.v1.ExecutorScriptService/CommentScriptChangeRequest"
 )
 
 // ExecutorScriptServiceClient is the client API for ExecutorScriptService service.
@@ -35,14 +47,40 @@ const (
 type ExecutorScriptServiceClient interface {
 	// Create a new script
 	CreateScript(ctx context.Context, in *CreateScriptRequest, opts ...grpc.CallOption) (*CreateScriptResponse, error)
+	// Parse script content and run the lint rules over it without saving
+	ValidateScript(ctx context.Context, in *ValidateScriptRequest, opts ...grpc.CallOption) (*ValidateScriptResponse, error)
+	// List the lint rules and whether the tenant enables them
+	ListLintRules(ctx context.Context, in *ListLintRulesRequest, opts ...grpc.CallOption) (*ListLintRulesResponse, error)
 	// Get a script by ID
 	GetScript(ctx context.Context, in *GetScriptRequest, opts ...grpc.CallOption) (*GetScriptResponse, error)
 	// List scripts
 	ListScripts(ctx context.Context, in *ListScriptsRequest, opts ...grpc.CallOption) (*ListScriptsResponse, error)
-	// Update a script (password required when content changes)
+	// Update a script (password required when content changes). When the tenant
+	// requires script approval, a content change creates a change request instead.
 	UpdateScript(ctx context.Context, in *UpdateScriptRequest, opts ...grpc.CallOption) (*UpdateScriptResponse, error)
 	// Delete a script
 	DeleteScript(ctx context.Context, in *DeleteScriptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the content versions of a script, newest first
+	ListScriptVersions(ctx context.Context, in *ListScriptVersionsRequest, opts ...grpc.CallOption) (*ListScriptVersionsResponse, error)
+	// Get a content version of a script
+	GetScriptVersion(ctx context.Context, in *GetScriptVersionRequest, opts ...grpc.CallOption) (*GetScriptVersionResponse, error)
+	// Compare two content versions of a script
+	DiffScriptVersions(ctx context.Context, in *DiffScriptVersionsRequest, opts ...grpc.CallOption) (*DiffScriptVersionsResponse, error)
+	// Restore the content of an earlier version as a new version (password required)
+	RollbackScript(ctx context.Context, in *RollbackScriptRequest, opts ...grpc.CallOption) (*RollbackScriptResponse, error)
+	// List script change requests, newest first
+	ListScriptChangeRequests(ctx context.Context, in *ListScriptChangeRequestsRequest, opts ...grpc.CallOption) (*ListScriptChangeRequestsResponse, error)
+	// Get a script change request with its diff against the version it was made on
+	GetScriptChangeRequest(ctx context.Context, in *GetScriptChangeRequestRequest, opts ...grpc.CallOption) (*GetScriptChangeRequestResponse, error)
+	// Approve a change request so its content becomes the script's next version
+	// (approver role and password required; requesters cannot approve their own)
+	ApproveScriptChangeRequest(ctx context.Context, in *ApproveScriptChangeRequestRequest, opts ...grpc.CallOption) (*ApproveScriptChangeRequestResponse, error)
+	// Reject a change request (approver role required)
+	RejectScriptChangeRequest(ctx context.Context, in *RejectScriptChangeRequestRequest, opts ...grpc.CallOption) (*RejectScriptChangeRequestResponse, error)
+	// Withdraw a pending change request (requester only)
+	CancelScriptChangeRequest(ctx context.Context, in *CancelScriptChangeRequestRequest, opts ...grpc.CallOption) (*CancelScriptChangeRequestResponse, error)
+	// Comment on a change request
+	CommentScriptChangeRequest(ctx context.Context, in *CommentScriptChangeRequestRequest, opts ...grpc.CallOption) (*CommentScriptChangeRequestResponse, error)
 }
 
 type executorScriptServiceClient struct {
@@ -63,6 +101,26 @@ func (c *executorScriptServiceClient) CreateScript(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *executorScriptServiceClient) ValidateScript(ctx context.Context, in *ValidateScriptRequest, opts ...grpc.CallOption) (*ValidateScriptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateScriptResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_ValidateScript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) ListLintRules(ctx context.Context, in *ListLintRulesRequest, opts ...grpc.CallOption) (*ListLintRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLintRulesResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_ListLintRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorScriptServiceClient) GetScript(ctx context.Context, in *GetScriptRequest, opts ...grpc.CallOption) (*GetScriptResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetScriptResponse)
@@ -103,6 +161,106 @@ func (c *executorScriptServiceClient) DeleteScript(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *executorScriptServiceClient) ListScriptVersions(ctx context.Context, in *ListScriptVersionsRequest, opts ...grpc.CallOption) (*ListScriptVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScriptVersionsResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_ListScriptVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) GetScriptVersion(ctx context.Context, in *GetScriptVersionRequest, opts ...grpc.CallOption) (*GetScriptVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScriptVersionResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_GetScriptVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) DiffScriptVersions(ctx context.Context, in *DiffScriptVersionsRequest, opts ...grpc.CallOption) (*DiffScriptVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffScriptVersionsResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_DiffScriptVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) RollbackScript(ctx context.Context, in *RollbackScriptRequest, opts ...grpc.CallOption) (*RollbackScriptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackScriptResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_RollbackScript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) ListScriptChangeRequests(ctx context.Context, in *ListScriptChangeRequestsRequest, opts ...grpc.CallOption) (*ListScriptChangeRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScriptChangeRequestsResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_ListScriptChangeRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) GetScriptChangeRequest(ctx context.Context, in *GetScriptChangeRequestRequest, opts ...grpc.CallOption) (*GetScriptChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScriptChangeRequestResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_GetScriptChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) ApproveScriptChangeRequest(ctx context.Context, in *ApproveScriptChangeRequestRequest, opts ...grpc.CallOption) (*ApproveScriptChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveScriptChangeRequestResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_ApproveScriptChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) RejectScriptChangeRequest(ctx context.Context, in *RejectScriptChangeRequestRequest, opts ...grpc.CallOption) (*RejectScriptChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectScriptChangeRequestResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_RejectScriptChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) CancelScriptChangeRequest(ctx context.Context, in *CancelScriptChangeRequestRequest, opts ...grpc.CallOption) (*CancelScriptChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScriptChangeRequestResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_CancelScriptChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorScriptServiceClient) CommentScriptChangeRequest(ctx context.Context, in *CommentScriptChangeRequestRequest, opts ...grpc.CallOption) (*CommentScriptChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentScriptChangeRequestResponse)
+	err := c.cc.Invoke(ctx, ExecutorScriptService_CommentScriptChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecutorScriptServiceServer is the server API for ExecutorScriptService service.
 // All implementations must embed UnimplementedExecutorScriptServiceServer
 // for forward compatibility.
@@ -111,14 +269,40 @@ func (c *executorScriptServiceClient) DeleteScript(ctx context.Context, in *Dele
 type ExecutorScriptServiceServer interface {
 	// Create a new script
 	CreateScript(context.Context, *CreateScriptRequest) (*CreateScriptResponse, error)
+	// Parse script content and run the lint rules over it without saving
+	ValidateScript(context.Context, *ValidateScriptRequest) (*ValidateScriptResponse, error)
+	// List the lint rules and whether the tenant enables them
+	ListLintRules(context.Context, *ListLintRulesRequest) (*ListLintRulesResponse, error)
 	// Get a script by ID
 	GetScript(context.Context, *GetScriptRequest) (*GetScriptResponse, error)
 	// List scripts
 	ListScripts(context.Context, *ListScriptsRequest) (*ListScriptsResponse, error)
-	// Update a script (password required when content changes)
+	// Update a script (password required when content changes). When the tenant
+	// requires script approval, a content change creates a change request instead.
 	UpdateScript(context.Context, *UpdateScriptRequest) (*UpdateScriptResponse, error)
 	// Delete a script
 	DeleteScript(context.Context, *DeleteScriptRequest) (*emptypb.Empty, error)
+	// List the content versions of a script, newest first
+	ListScriptVersions(context.Context, *ListScriptVersionsRequest) (*ListScriptVersionsResponse, error)
+	// Get a content version of a script
+	GetScriptVersion(context.Context, *GetScriptVersionRequest) (*GetScriptVersionResponse, error)
+	// Compare two content versions of a script
+	DiffScriptVersions(context.Context, *DiffScriptVersionsRequest) (*DiffScriptVersionsResponse, error)
+	// Restore the content of an earlier version as a new version (password required)
+	RollbackScript(context.Context, *RollbackScriptRequest) (*RollbackScriptResponse, error)
+	// List script change requests, newest first
+	ListScriptChangeRequests(context.Context, *ListScriptChangeRequestsRequest) (*ListScriptChangeRequestsResponse, error)
+	// Get a script change request with its diff against the version it was made on
+	GetScriptChangeRequest(context.Context, *GetScriptChangeRequestRequest) (*GetScriptChangeRequestResponse, error)
+	// Approve a change request so its content becomes the script's next version
+	// (approver role and password required; requesters cannot approve their own)
+	ApproveScriptChangeRequest(context.Context, *ApproveScriptChangeRequestRequest) (*ApproveScriptChangeRequestResponse, error)
+	// Reject a change request (approver role required)
+	RejectScriptChangeRequest(context.Context, *RejectScriptChangeRequestRequest) (*RejectScriptChangeRequestResponse, error)
+	// Withdraw a pending change request (requester only)
+	CancelScriptChangeRequest(context.Context, *CancelScriptChangeRequestRequest) (*CancelScriptChangeRequestResponse, error)
+	// Comment on a change request
+	CommentScriptChangeRequest(context.Context, *CommentScriptChangeRequestRequest) (*CommentScriptChangeRequestResponse, error)
 	mustEmbedUnimplementedExecutorScriptServiceServer()
 }
 
@@ -132,6 +316,12 @@ type UnimplementedExecutorScriptServiceServer struct{}
 func (UnimplementedExecutorScriptServiceServer) CreateScript(context.Context, *CreateScriptRequest) (*CreateScriptResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateScript not implemented")
 }
+func (UnimplementedExecutorScriptServiceServer) ValidateScript(context.Context, *ValidateScriptRequest) (*ValidateScriptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateScript not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) ListLintRules(context.Context, *ListLintRulesRequest) (*ListLintRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLintRules not implemented")
+}
 func (UnimplementedExecutorScriptServiceServer) GetScript(context.Context, *GetScriptRequest) (*GetScriptResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetScript not implemented")
 }
@@ -144,6 +334,36 @@ func (UnimplementedExecutorScriptServiceServer) UpdateScript(context.Context, *U
 func (UnimplementedExecutorScriptServiceServer) DeleteScript(context.Context, *DeleteScriptRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteScript not implemented")
 }
+func (UnimplementedExecutorScriptServiceServer) ListScriptVersions(context.Context, *ListScriptVersionsRequest) (*ListScriptVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScriptVersions not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) GetScriptVersion(context.Context, *GetScriptVersionRequest) (*GetScriptVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScriptVersion not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) DiffScriptVersions(context.Context, *DiffScriptVersionsRequest) (*DiffScriptVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffScriptVersions not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) RollbackScript(context.Context, *RollbackScriptRequest) (*RollbackScriptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackScript not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) ListScriptChangeRequests(context.Context, *ListScriptChangeRequestsRequest) (*ListScriptChangeRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScriptChangeRequests not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) GetScriptChangeRequest(context.Context, *GetScriptChangeRequestRequest) (*GetScriptChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScriptChangeRequest not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) ApproveScriptChangeRequest(context.Context, *ApproveScriptChangeRequestRequest) (*ApproveScriptChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveScriptChangeRequest not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) RejectScriptChangeRequest(context.Context, *RejectScriptChangeRequestRequest) (*RejectScriptChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectScriptChangeRequest not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) CancelScriptChangeRequest(context.Context, *CancelScriptChangeRequestRequest) (*CancelScriptChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScriptChangeRequest not implemented")
+}
+func (UnimplementedExecutorScriptServiceServer) CommentScriptChangeRequest(context.Context, *CommentScriptChangeRequestRequest) (*CommentScriptChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommentScriptChangeRequest not implemented")
+}
 func (UnimplementedExecutorScriptServiceServer) mustEmbedUnimplementedExecutorScriptServiceServer() {}
 func (UnimplementedExecutorScriptServiceServer) testEmbeddedByValue()                               {}
 
@@ -183,6 +403,42 @@ func _ExecutorScriptService_CreateScript_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorScriptService_ValidateScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).ValidateScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_ValidateScript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).ValidateScript(ctx, req.(*ValidateScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_ListLintRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLintRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).ListLintRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_ListLintRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).ListLintRules(ctx, req.(*ListLintRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutorScriptService_GetScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetScriptRequest)
 	if err := dec(in); err != nil {
@@ -255,6 +511,186 @@ func _ExecutorScriptService_DeleteScript_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutorScriptService_ListScriptVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScriptVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).ListScriptVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_ListScriptVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).ListScriptVersions(ctx, req.(*ListScriptVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_GetScriptVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScriptVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).GetScriptVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_GetScriptVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).GetScriptVersion(ctx, req.(*GetScriptVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_DiffScriptVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffScriptVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).DiffScriptVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_DiffScriptVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).DiffScriptVersions(ctx, req.(*DiffScriptVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_RollbackScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).RollbackScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_RollbackScript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).RollbackScript(ctx, req.(*RollbackScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_ListScriptChangeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScriptChangeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).ListScriptChangeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_ListScriptChangeRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).ListScriptChangeRequests(ctx, req.(*ListScriptChangeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_GetScriptChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScriptChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).GetScriptChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_GetScriptChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).GetScriptChangeRequest(ctx, req.(*GetScriptChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_ApproveScriptChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveScriptChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).ApproveScriptChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_ApproveScriptChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).ApproveScriptChangeRequest(ctx, req.(*ApproveScriptChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_RejectScriptChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectScriptChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).RejectScriptChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_RejectScriptChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).RejectScriptChangeRequest(ctx, req.(*RejectScriptChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_CancelScriptChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScriptChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).CancelScriptChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_CancelScriptChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).CancelScriptChangeRequest(ctx, req.(*CancelScriptChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorScriptService_CommentScriptChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentScriptChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorScriptServiceServer).CommentScriptChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecutorScriptService_CommentScriptChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorScriptServiceServer).CommentScriptChangeRequest(ctx, req.(*CommentScriptChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExecutorScriptService_ServiceDesc is the grpc.ServiceDesc for ExecutorScriptService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -266,6 +702,14 @@ var ExecutorScriptService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateScript",
 			Handler:    _ExecutorScriptService_CreateScript_Handler,
 		},
+		{
+			MethodName: "ValidateScript",
+			Handler:    _ExecutorScriptService_ValidateScript_Handler,
+		},
+		{
+			MethodName: "ListLintRules",
+			Handler:    _ExecutorScriptService_ListLintRules_Handler,
+		},
 		{
 			MethodName: "GetScript",
 			Handler:    _ExecutorScriptService_GetScript_Handler,
@@ -282,6 +726,46 @@ var ExecutorScriptService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScript",
 			Handler:    _ExecutorScriptService_DeleteScript_Handler,
 		},
+		{
+			MethodName: "ListScriptVersions",
+			Handler:    _ExecutorScriptService_ListScriptVersions_Handler,
+		},
+		{
+			MethodName: "GetScriptVersion",
+			Handler:    _ExecutorScriptService_GetScriptVersion_Handler,
+		},
+		{
+			MethodName: "DiffScriptVersions",
+			Handler:    _ExecutorScriptService_DiffScriptVersions_Handler,
+		},
+		{
+			MethodName: "RollbackScript",
+			Handler:    _ExecutorScriptService_RollbackScript_Handler,
+		},
+		{
+			MethodName: "ListScriptChangeRequests",
+			Handler:    _ExecutorScriptService_ListScriptChangeRequests_Handler,
+		},
+		{
+			MethodName: "GetScriptChangeRequest",
+			Handler:    _ExecutorScriptService_GetScriptChangeRequest_Handler,
+		},
+		{
+			MethodName: "ApproveScriptChangeRequest",
+			Handler:    _ExecutorScriptService_ApproveScriptChangeRequest_Handler,
+		},
+		{
+			MethodName: "RejectScriptChangeRequest",
+			Handler:    _ExecutorScriptService_RejectScriptChangeRequest_Handler,
+		},
+		{
+			MethodName: "CancelScriptChangeRequest",
+			Handler:    _ExecutorScriptService_CancelScriptChangeRequest_Handler,
+		},
+		{
+			MethodName: "CommentScriptChangeRequest",
+			Handler:    _ExecutorScriptService_CommentScriptChangeRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "executor/service/v1/script.proto",
@@ -20,32 +20,82 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationExecutorScriptServiceApproveScriptChangeRequest = "/executor.service.v1.ExecutorScriptService/ApproveScriptChangeRequest"
+const OperationExecutorScriptServiceCancelScriptChangeRequest = "/executor.service.v1.ExecutorScriptService/CancelScriptChangeRequest"
+const OperationExecutorScriptServiceCommentScriptChangeRequest = "/executor.service.v1.ExecutorScriptService/CommentScriptChangeRequest"
 const OperationExecutorScriptServiceCreateScript = "/executor.service.v1.ExecutorScriptService/CreateScript"
 const OperationExecutorScriptServiceDeleteScript = "/executor.service.v1.ExecutorScriptService/DeleteScript"
+const OperationExecutorScriptServiceDiffScriptVersions = "/executor.service.v1.ExecutorScriptService/DiffScriptVersions"
 const OperationExecutorScriptServiceGetScript = "/executor.service.v1.ExecutorScriptService/GetScript"
+const OperationExecutorScriptServiceGetScriptChangeRequest = "/executor.service.v1.ExecutorScriptService/GetScriptChangeRequest"
+const OperationExecutorScriptServiceGetScriptVersion = "/executor.service.v1.ExecutorScriptService/GetScriptVersion"
+const OperationExecutorScriptServiceListLintRules = "/executor.service.v1.ExecutorScriptService/ListLintRules"
+const OperationExecutorScriptServiceListScriptChangeRequests = "/executor.service.v1.ExecutorScriptService/ListScriptChangeRequests"
+const OperationExecutorScriptServiceListScriptVersions = "/executor.service.v1.ExecutorScriptService/ListScriptVersions"
 const OperationExecutorScriptServiceListScripts = "/executor.service.v1.ExecutorScriptService/ListScripts"
+const OperationExecutorScriptServiceRejectScriptChangeRequest = "/executor.service.v1.ExecutorScriptService/RejectScriptChangeRequest"
+const OperationExecutorScriptServiceRollbackScript = "/executor.service.v1.ExecutorScriptService/RollbackScript"
 const OperationExecutorScriptServiceUpdateScript = "/executor.service.v1.ExecutorScriptService/UpdateScript"
+const OperationExecutorScriptServiceValidateScript = "/executor.service.v1.ExecutorScriptService/ValidateScript"
 
 type ExecutorScriptServiceHTTPServer interface {
+	// ApproveScriptChangeRequest Approve a change request so its content becomes the script's next version
+	// (approver role and password required; requesters cannot approve their own)
+	ApproveScriptChangeRequest(context.Context, *ApproveScriptChangeRequestRequest) (*ApproveScriptChangeRequestResponse, error)
+	// CancelScriptChangeRequest Withdraw a pending change request (requester only)
+	CancelScriptChangeRequest(context.Context, *CancelScriptChangeRequestRequest) (*CancelScriptChangeRequestResponse, error)
+	// CommentScriptChangeRequest Comment on a change request
+	CommentScriptChangeRequest(context.Context, *CommentScriptChangeRequestRequest) (*CommentScriptChangeRequestResponse, error)
 	// CreateScript Create a new script
 	CreateScript(context.Context, *CreateScriptRequest) (*CreateScriptResponse, error)
 	// DeleteScript Delete a script
 	DeleteScript(context.Context, *DeleteScriptRequest) (*emptypb.Empty, error)
+	// DiffScriptVersions Compare two content versions of a script
+	DiffScriptVersions(context.Context, *DiffScriptVersionsRequest) (*DiffScriptVersionsResponse, error)
 	// GetScript Get a script by ID
 	GetScript(context.Context, *GetScriptRequest) (*GetScriptResponse, error)
+	// GetScriptChangeRequest Get a script change request with its diff against the version it was made on
+	GetScriptChangeRequest(context.Context, *GetScriptChangeRequestRequest) (*GetScriptChangeRequestResponse, error)
+	// GetScriptVersion Get a content version of a script
+	GetScriptVersion(context.Context, *GetScriptVersionRequest) (*GetScriptVersionResponse, error)
+	// ListLintRules List the lint rules and whether the tenant enables them
+	ListLintRules(context.Context, *ListLintRulesRequest) (*ListLintRulesResponse, error)
+	// ListScriptChangeRequests List script change requests, newest first
+	ListScriptChangeRequests(context.Context, *ListScriptChangeRequestsRequest) (*ListScriptChangeRequestsResponse, error)
+	// ListScriptVersions List the content versions of a script, newest first
+	ListScriptVersions(context.Context, *ListScriptVersionsRequest) (*ListScriptVersionsResponse, error)
 	// ListScripts List scripts
 	ListScripts(context.Context, *ListScriptsRequest) (*ListScriptsResponse, error)
-	// UpdateScript Update a script (password required when content changes)
+	// RejectScriptChangeRequest Reject a change request (approver role required)
+	RejectScriptChangeRequest(context.Context, *RejectScriptChangeRequestRequest) (*RejectScriptChangeRequestResponse, error)
+	// RollbackScript Restore the content of an earlier version as a new version (password required)
+	RollbackScript(context.Context, *RollbackScriptRequest) (*RollbackScriptResponse, error)
+	// UpdateScript Update a script (password required when content changes). When the tenant
+	// requires script approval, a content change creates a change request instead.
 	UpdateScript(context.Context, *UpdateScriptRequest) (*UpdateScriptResponse, error)
+	// ValidateScript Parse script content and run the lint rules over it without saving
+	ValidateScript(context.Context, *ValidateScriptRequest) (*ValidateScriptResponse, error)
 }
 
 func RegisterExecutorScriptServiceHTTPServer(s *http.Server, srv ExecutorScriptServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/scripts", _ExecutorScriptService_CreateScript0_HTTP_Handler(srv))
+	r.POST("/v1/scripts/validate", _ExecutorScriptService_ValidateScript0_HTTP_Handler(srv))
+	r.GET("/v1/lint-rules", _ExecutorScriptService_ListLintRules0_HTTP_Handler(srv))
 	r.GET("/v1/scripts/{id}", _ExecutorScriptService_GetScript0_HTTP_Handler(srv))
 	r.GET("/v1/scripts", _ExecutorScriptService_ListScripts0_HTTP_Handler(srv))
 	r.PUT("/v1/scripts/{id}", _ExecutorScriptService_UpdateScript0_HTTP_Handler(srv))
 	r.DELETE("/v1/scripts/{id}", _ExecutorScriptService_DeleteScript0_HTTP_Handler(srv))
+	r.GET("/v1/scripts/{script_id}/versions", _ExecutorScriptService_ListScriptVersions0_HTTP_Handler(srv))
+	r.GET("/v1/scripts/{script_id}/versions/{version}", _ExecutorScriptService_GetScriptVersion0_HTTP_Handler(srv))
+	r.GET("/v1/scripts/{script_id}/diff", _ExecutorScriptService_DiffScriptVersions0_HTTP_Handler(srv))
+	r.POST("/v1/scripts/{id}/rollback", _ExecutorScriptService_RollbackScript0_HTTP_Handler(srv))
+	r.GET("/v1/script-change-requests", _ExecutorScriptService_ListScriptChangeRequests0_HTTP_Handler(srv))
+	r.GET("/v1/script-change-requests/{id}", _ExecutorScriptService_GetScriptChangeRequest0_HTTP_Handler(srv))
+	r.POST("/v1/script-change-requests/{id}/approve", _ExecutorScriptService_ApproveScriptChangeRequest0_HTTP_Handler(srv))
+	r.POST("/v1/script-change-requests/{id}/reject", _ExecutorScriptService_RejectScriptChangeRequest0_HTTP_Handler(srv))
+	r.POST("/v1/script-change-requests/{id}/cancel", _ExecutorScriptService_CancelScriptChangeRequest0_HTTP_Handler(srv))
+	r.POST("/v1/script-change-requests/{id}/comments", _ExecutorScriptService_CommentScriptChangeRequest0_HTTP_Handler(srv))
 }
 
 func _ExecutorScriptService_CreateScript0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
@@ -70,6 +120,47 @@ func _ExecutorScriptService_CreateScript0_HTTP_Handler(srv ExecutorScriptService
 	}
 }
 
+func _ExecutorScriptService_ValidateScript0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ValidateScriptRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceValidateScript)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ValidateScript(ctx, req.(*ValidateScriptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ValidateScriptResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_ListLintRules0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLintRulesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceListLintRules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLintRules(ctx, req.(*ListLintRulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLintRulesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ExecutorScriptService_GetScript0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetScriptRequest
@@ -158,17 +249,275 @@ func _ExecutorScriptService_DeleteScript0_HTTP_Handler(srv ExecutorScriptService
 	}
 }
 
+func _ExecutorScriptService_ListScriptVersions0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListScriptVersionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceListScriptVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListScriptVersions(ctx, req.(*ListScriptVersionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListScriptVersionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_GetScriptVersion0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetScriptVersionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceGetScriptVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetScriptVersion(ctx, req.(*GetScriptVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetScriptVersionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_DiffScriptVersions0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffScriptVersionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceDiffScriptVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffScriptVersions(ctx, req.(*DiffScriptVersionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffScriptVersionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_RollbackScript0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackScriptRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceRollbackScript)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackScript(ctx, req.(*RollbackScriptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RollbackScriptResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_ListScriptChangeRequests0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListScriptChangeRequestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceListScriptChangeRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListScriptChangeRequests(ctx, req.(*ListScriptChangeRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListScriptChangeRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_GetScriptChangeRequest0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetScriptChangeRequestRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceGetScriptChangeRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetScriptChangeRequest(ctx, req.(*GetScriptChangeRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetScriptChangeRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_ApproveScriptChangeRequest0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveScriptChangeRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceApproveScriptChangeRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveScriptChangeRequest(ctx, req.(*ApproveScriptChangeRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApproveScriptChangeRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_RejectScriptChangeRequest0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectScriptChangeRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceRejectScriptChangeRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectScriptChangeRequest(ctx, req.(*RejectScriptChangeRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RejectScriptChangeRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_CancelScriptChangeRequest0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelScriptChangeRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceCancelScriptChangeRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelScriptChangeRequest(ctx, req.(*CancelScriptChangeRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CancelScriptChangeRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExecutorScriptService_CommentScriptChangeRequest0_HTTP_Handler(srv ExecutorScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CommentScriptChangeRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExecutorScriptServiceCommentScriptChangeRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CommentScriptChangeRequest(ctx, req.(*CommentScriptChangeRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CommentScriptChangeRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ExecutorScriptServiceHTTPClient interface {
+	// ApproveScriptChangeRequest Approve a change request so its content becomes the script's next version
+	// (approver role and password required; requesters cannot approve their own)
+	ApproveScriptChangeRequest(ctx context.Context, req *ApproveScriptChangeRequestRequest, opts ...http.CallOption) (rsp *ApproveScriptChangeRequestResponse, err error)
+	// CancelScriptChangeRequest Withdraw a pending change request (requester only)
+	CancelScriptChangeRequest(ctx context.Context, req *CancelScriptChangeRequestRequest, opts ...http.CallOption) (rsp *CancelScriptChangeRequestResponse, err error)
+	// CommentScriptChangeRequest Comment on a change request
+	CommentScriptChangeRequest(ctx context.Context, req *CommentScriptChangeRequestRequest, opts ...http.CallOption) (rsp *CommentScriptChangeRequestResponse, err error)
 	// CreateScript Create a new script
 	CreateScript(ctx context.Context, req *CreateScriptRequest, opts ...http.CallOption) (rsp *CreateScriptResponse, err error)
 	// DeleteScript Delete a script
 	DeleteScript(ctx context.Context, req *DeleteScriptRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DiffScriptVersions Compare two content versions of a script
+	DiffScriptVersions(ctx context.Context, req *DiffScriptVersionsRequest, opts ...http.CallOption) (rsp *DiffScriptVersionsResponse, err error)
 	// GetScript Get a script by ID
 	GetScript(ctx context.Context, req *GetScriptRequest, opts ...http.CallOption) (rsp *GetScriptResponse, err error)
+	// GetScriptChangeRequest Get a script change request with its diff against the version it was made on
+	GetScriptChangeRequest(ctx context.Context, req *GetScriptChangeRequestRequest, opts ...http.CallOption) (rsp *GetScriptChangeRequestResponse, err error)
+	// GetScriptVersion Get a content version of a script
+	GetScriptVersion(ctx context.Context, req *GetScriptVersionRequest, opts ...http.CallOption) (rsp *GetScriptVersionResponse, err error)
+	// ListLintRules List the lint rules and whether the tenant enables them
+	ListLintRules(ctx context.Context, req *ListLintRulesRequest, opts ...http.CallOption) (rsp *ListLintRulesResponse, err error)
+	// ListScriptChangeRequests List script change requests, newest first
+	ListScriptChangeRequests(ctx context.Context, req *ListScriptChangeRequestsRequest, opts ...http.CallOption) (rsp *ListScriptChangeRequestsResponse, err error)
+	// ListScriptVersions List the content versions of a script, newest first
+	ListScriptVersions(ctx context.Context, req *ListScriptVersionsRequest, opts ...http.CallOption) (rsp *ListScriptVersionsResponse, err error)
 	// ListScripts List scripts
 	ListScripts(ctx context.Context, req *ListScriptsRequest, opts ...http.CallOption) (rsp *ListScriptsResponse, err error)
-	// UpdateScript Update a script (password required when content changes)
+	// RejectScriptChangeRequest Reject a change request (approver role required)
+	RejectScriptChangeRequest(ctx context.Context, req *RejectScriptChangeRequestRequest, opts ...http.CallOption) (rsp *RejectScriptChangeRequestResponse, err error)
+	// RollbackScript Restore the content of an earlier version as a new version (password required)
+	RollbackScript(ctx context.Context, req *RollbackScriptRequest, opts ...http.CallOption) (rsp *RollbackScriptResponse, err error)
+	// UpdateScript Update a script (password required when content changes). When the tenant
+	// requires script approval, a content change creates a change request instead.
 	UpdateScript(ctx context.Context, req *UpdateScriptRequest, opts ...http.CallOption) (rsp *UpdateScriptResponse, err error)
+	// ValidateScript Parse script content and run the lint rules over it without saving
+	ValidateScript(ctx context.Context, req *ValidateScriptRequest, opts ...http.CallOption) (rsp *ValidateScriptResponse, err error)
 }
 
 type ExecutorScriptServiceHTTPClientImpl struct {
@@ -179,6 +528,49 @@ func NewExecutorScriptServiceHTTPClient(client *http.Client) ExecutorScriptServi
 	return &ExecutorScriptServiceHTTPClientImpl{client}
 }
 
+// ApproveScriptChangeRequest Approve a change request so its content becomes the script's next version
+// (approver role and password required; requesters cannot approve their own)
+func (c *ExecutorScriptServiceHTTPClientImpl) ApproveScriptChangeRequest(ctx context.Context, in *ApproveScriptChangeRequestRequest, opts ...http.CallOption) (*ApproveScriptChangeRequestResponse, error) {
+	var out ApproveScriptChangeRequestResponse
+	pattern := "/v1/script-change-requests/{id}/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceApproveScriptChangeRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelScriptChangeRequest Withdraw a pending change request (requester only)
+func (c *ExecutorScriptServiceHTTPClientImpl) CancelScriptChangeRequest(ctx context.Context, in *CancelScriptChangeRequestRequest, opts ...http.CallOption) (*CancelScriptChangeRequestResponse, error) {
+	var out CancelScriptChangeRequestResponse
+	pattern := "/v1/script-change-requests/{id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceCancelScriptChangeRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CommentScriptChangeRequest Comment on a change request
+func (c *ExecutorScriptServiceHTTPClientImpl) CommentScriptChangeRequest(ctx context.Context, in *CommentScriptChangeRequestRequest, opts ...http.CallOption) (*CommentScriptChangeRequestResponse, error) {
+	var out CommentScriptChangeRequestResponse
+	pattern := "/v1/script-change-requests/{id}/comments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceCommentScriptChangeRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateScript Create a new script
 func (c *ExecutorScriptServiceHTTPClientImpl) CreateScript(ctx context.Context, in *CreateScriptRequest, opts ...http.CallOption) (*CreateScriptResponse, error) {
 	var out CreateScriptResponse
@@ -207,6 +599,20 @@ func (c *ExecutorScriptServiceHTTPClientImpl) DeleteScript(ctx context.Context,
 	return &out, nil
 }
 
+// DiffScriptVersions Compare two content versions of a script
+func (c *ExecutorScriptServiceHTTPClientImpl) DiffScriptVersions(ctx context.Context, in *DiffScriptVersionsRequest, opts ...http.CallOption) (*DiffScriptVersionsResponse, error) {
+	var out DiffScriptVersionsResponse
+	pattern := "/v1/scripts/{script_id}/diff"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceDiffScriptVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetScript Get a script by ID
 func (c *ExecutorScriptServiceHTTPClientImpl) GetScript(ctx context.Context, in *GetScriptRequest, opts ...http.CallOption) (*GetScriptResponse, error) {
 	var out GetScriptResponse
@@ -221,6 +627,76 @@ func (c *ExecutorScriptServiceHTTPClientImpl) GetScript(ctx context.Context, in
 	return &out, nil
 }
 
+// GetScriptChangeRequest Get a script change request with its diff against the version it was made on
+func (c *ExecutorScriptServiceHTTPClientImpl) GetScriptChangeRequest(ctx context.Context, in *GetScriptChangeRequestRequest, opts ...http.CallOption) (*GetScriptChangeRequestResponse, error) {
+	var out GetScriptChangeRequestResponse
+	pattern := "/v1/script-change-requests/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceGetScriptChangeRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetScriptVersion Get a content version of a script
+func (c *ExecutorScriptServiceHTTPClientImpl) GetScriptVersion(ctx context.Context, in *GetScriptVersionRequest, opts ...http.CallOption) (*GetScriptVersionResponse, error) {
+	var out GetScriptVersionResponse
+	pattern := "/v1/scripts/{script_id}/versions/{version}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceGetScriptVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListLintRules List the lint rules and whether the tenant enables them
+func (c *ExecutorScriptServiceHTTPClientImpl) ListLintRules(ctx context.Context, in *ListLintRulesRequest, opts ...http.CallOption) (*ListLintRulesResponse, error) {
+	var out ListLintRulesResponse
+	pattern := "/v1/lint-rules"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceListLintRules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListScriptChangeRequests List script change requests, newest first
+func (c *ExecutorScriptServiceHTTPClientImpl) ListScriptChangeRequests(ctx context.Context, in *ListScriptChangeRequestsRequest, opts ...http.CallOption) (*ListScriptChangeRequestsResponse, error) {
+	var out ListScriptChangeRequestsResponse
+	pattern := "/v1/script-change-requests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceListScriptChangeRequests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListScriptVersions List the content versions of a script, newest first
+func (c *ExecutorScriptServiceHTTPClientImpl) ListScriptVersions(ctx context.Context, in *ListScriptVersionsRequest, opts ...http.CallOption) (*ListScriptVersionsResponse, error) {
+	var out ListScriptVersionsResponse
+	pattern := "/v1/scripts/{script_id}/versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceListScriptVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListScripts List scripts
 func (c *ExecutorScriptServiceHTTPClientImpl) ListScripts(ctx context.Context, in *ListScriptsRequest, opts ...http.CallOption) (*ListScriptsResponse, error) {
 	var out ListScriptsResponse
@@ -235,7 +711,36 @@ func (c *ExecutorScriptServiceHTTPClientImpl) ListScripts(ctx context.Context, i
 	return &out, nil
 }
 
-// UpdateScript Update a script (password required when content changes)
+// RejectScriptChangeRequest Reject a change request (approver role required)
+func (c *ExecutorScriptServiceHTTPClientImpl) RejectScriptChangeRequest(ctx context.Context, in *RejectScriptChangeRequestRequest, opts ...http.CallOption) (*RejectScriptChangeRequestResponse, error) {
+	var out RejectScriptChangeRequestResponse
+	pattern := "/v1/script-change-requests/{id}/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceRejectScriptChangeRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RollbackScript Restore the content of an earlier version as a new version (password required)
+func (c *ExecutorScriptServiceHTTPClientImpl) RollbackScript(ctx context.Context, in *RollbackScriptRequest, opts ...http.CallOption) (*RollbackScriptResponse, error) {
+	var out RollbackScriptResponse
+	pattern := "/v1/scripts/{id}/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceRollbackScript))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateScript Update a script (password required when content changes). When the tenant
+// requires script approval, a content change creates a change request instead.
 func (c *ExecutorScriptServiceHTTPClientImpl) UpdateScript(ctx context.Context, in *UpdateScriptRequest, opts ...http.CallOption) (*UpdateScriptResponse, error) {
 	var out UpdateScriptResponse
 	pattern := "/v1/scripts/{id}"
@@ -248,3 +753,17 @@ func (c *ExecutorScriptServiceHTTPClientImpl) UpdateScript(ctx context.Context,
 	}
 	return &out, nil
 }
+
+// ValidateScript Parse script content and run the lint rules over it without saving
+func (c *ExecutorScriptServiceHTTPClientImpl) ValidateScript(ctx context.Context, in *ValidateScriptRequest, opts ...http.CallOption) (*ValidateScriptResponse, error) {
+	var out ValidateScriptResponse
+	pattern := "/v1/scripts/validate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExecutorScriptServiceValidateScript))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
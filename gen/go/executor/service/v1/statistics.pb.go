@@ -91,7 +91,11 @@ type GetStatisticsResponse struct {
 	ExecutionsLast_24H int64 `protobuf:"varint,12,opt,name=executions_last_24h,json=executionsLast24h,proto3" json:"executions_last_24h,omitempty"`
 	ExecutionsLast_7D  int64 `protobuf:"varint,13,opt,name=executions_last_7d,json=executionsLast7d,proto3" json:"executions_last_7d,omitempty"`
 	// Recent errors
-	RecentErrors  []*RecentError `protobuf:"bytes,14,rep,name=recent_errors,json=recentErrors,proto3" json:"recent_errors,omitempty"`
+	RecentErrors        []*RecentError `protobuf:"bytes,14,rep,name=recent_errors,json=recentErrors,proto3" json:"recent_errors,omitempty"`
+	TimedOutExecutions  int64          `protobuf:"varint,15,opt,name=timed_out_executions,json=timedOutExecutions,proto3" json:"timed_out_executions,omitempty"`
+	CancelledExecutions int64          `protobuf:"varint,16,opt,name=cancelled_executions,json=cancelledExecutions,proto3" json:"cancelled_executions,omitempty"`
+	// Scripts by type, keyed by BASH, JAVASCRIPT, LUA, PYTHON or POWERSHELL
+	ScriptsByType map[string]int64 `protobuf:"bytes,17,rep,name=scripts_by_type,json=scriptsByType,proto3" json:"scripts_by_type,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -224,6 +228,27 @@ func (x *GetStatisticsResponse) GetRecentErrors() []*RecentError {
 	return nil
 }
 
+func (x *GetStatisticsResponse) GetTimedOutExecutions() int64 {
+	if x != nil {
+		return x.TimedOutExecutions
+	}
+	return 0
+}
+
+func (x *GetStatisticsResponse) GetCancelledExecutions() int64 {
+	if x != nil {
+		return x.CancelledExecutions
+	}
+	return 0
+}
+
+func (x *GetStatisticsResponse) GetScriptsByType() map[string]int64 {
+	if x != nil {
+		return x.ScriptsByType
+	}
+	return nil
+}
+
 // RecentError represents a recent execution failure
 type RecentError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -317,7 +342,7 @@ const file_executor_service_v1_statistics_proto_rawDesc = "" +
 	"\x14GetStatisticsRequest\x12 \n" +
 	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
 	"\n" +
-	"_tenant_id\"\x9f\x05\n" +
+	"_tenant_id\"\xad\a\n" +
 	"\x15GetStatisticsResponse\x12#\n" +
 	"\rtotal_scripts\x18\x01 \x01(\x03R\ftotalScripts\x12'\n" +
 	"\x0fenabled_scripts\x18\x02 \x01(\x03R\x0eenabledScripts\x12)\n" +
@@ -333,7 +358,13 @@ const file_executor_service_v1_statistics_proto_rawDesc = "" +
 	"\fsuccess_rate\x18\v \x01(\x01R\vsuccessRate\x12.\n" +
 	"\x13executions_last_24h\x18\f \x01(\x03R\x11executionsLast24h\x12,\n" +
 	"\x12executions_last_7d\x18\r \x01(\x03R\x10executionsLast7d\x12E\n" +
-	"\rrecent_errors\x18\x0e \x03(\v2 .executor.service.v1.RecentErrorR\frecentErrors\"\xe4\x01\n" +
+	"\rrecent_errors\x18\x0e \x03(\v2 .executor.service.v1.RecentErrorR\frecentErrors\x120\n" +
+	"\x14timed_out_executions\x18\x0f \x01(\x03R\x12timedOutExecutions\x121\n" +
+	"\x14cancelled_executions\x18\x10 \x01(\x03R\x13cancelledExecutions\x12e\n" +
+	"\x0fscripts_by_type\x18\x11 \x03(\v2=.executor.service.v1.GetStatisticsResponse.ScriptsByTypeEntryR\rscriptsByType\x1a@\n" +
+	"\x12ScriptsByTypeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xe4\x01\n" +
 	"\vRecentError\x12!\n" +
 	"\fexecution_id\x18\x01 \x01(\tR\vexecutionId\x12\x1f\n" +
 	"\vscript_name\x18\x02 \x01(\tR\n" +
@@ -359,23 +390,25 @@ func file_executor_service_v1_statistics_proto_rawDescGZIP() []byte {
 	return file_executor_service_v1_statistics_proto_rawDescData
 }
 
-var file_executor_service_v1_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_executor_service_v1_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_executor_service_v1_statistics_proto_goTypes = []any{
 	(*GetStatisticsRequest)(nil),  // 0: executor.service.v1.GetStatisticsRequest
 	(*GetStatisticsResponse)(nil), // 1: executor.service.v1.GetStatisticsResponse
 	(*RecentError)(nil),           // 2: executor.service.v1.RecentError
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	nil,                           // 3: executor.service.v1.GetStatisticsResponse.ScriptsByTypeEntry
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_executor_service_v1_statistics_proto_depIdxs = []int32{
 	2, // 0: executor.service.v1.GetStatisticsResponse.recent_errors:type_name -> executor.service.v1.RecentError
-	3, // 1: executor.service.v1.RecentError.created_at:type_name -> google.protobuf.Timestamp
-	0, // 2: executor.service.v1.ExecutorStatisticsService.GetStatistics:input_type -> executor.service.v1.GetStatisticsRequest
-	1, // 3: executor.service.v1.ExecutorStatisticsService.GetStatistics:output_type -> executor.service.v1.GetStatisticsResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 1: executor.service.v1.GetStatisticsResponse.scripts_by_type:type_name -> executor.service.v1.GetStatisticsResponse.ScriptsByTypeEntry
+	4, // 2: executor.service.v1.RecentError.created_at:type_name -> google.protobuf.Timestamp
+	0, // 3: executor.service.v1.ExecutorStatisticsService.GetStatistics:input_type -> executor.service.v1.GetStatisticsRequest
+	1, // 4: executor.service.v1.ExecutorStatisticsService.GetStatistics:output_type -> executor.service.v1.GetStatisticsResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_executor_service_v1_statistics_proto_init() }
@@ -390,7 +423,7 @@ func file_executor_service_v1_statistics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_executor_service_v1_statistics_proto_rawDesc), len(file_executor_service_v1_statistics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -94,6 +94,12 @@ func (x *GetStatisticsResponse) Redact() string {
 	// Safe field: ExecutionsLast_7D
 
 	// Safe field: RecentErrors
+
+	// Safe field: TimedOutExecutions
+
+	// Safe field: CancelledExecutions
+
+	// Safe field: ScriptsByType
 	return x.String()
 }
 
@@ -223,6 +223,12 @@ func (m *GetStatisticsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for TimedOutExecutions
+
+	// no validation rules for CancelledExecutions
+
+	// no validation rules for ScriptsByType
+
 	if len(errors) > 0 {
 		return GetStatisticsResponseMultiError(errors)
 	}
@@ -31,17 +31,30 @@ func NewAssignmentRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent
 	}
 }
 
-// Create creates a new script assignment
-func (r *AssignmentRepo) Create(ctx context.Context, tenantID uint32, scriptID, clientID string, createdBy *uint32) (*ent.ScriptAssignment, error) {
+// Create creates a new script assignment. target is the client CN, label
+// selector or group ID, depending on targetType.
+func (r *AssignmentRepo) Create(ctx context.Context, tenantID uint32, scriptID string, targetType scriptassignment.TargetType, target string, parameters map[string]string, createdBy *uint32) (*ent.ScriptAssignment, error) {
 	id := uuid.New().String()
 
 	builder := r.entClient.Client().ScriptAssignment.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetScriptID(scriptID).
-		SetClientID(clientID).
+		SetTargetType(targetType).
 		SetCreateTime(time.Now())
 
+	switch targetType {
+	case scriptassignment.TargetTypeSELECTOR:
+		builder.SetSelector(target)
+	case scriptassignment.TargetTypeGROUP:
+		builder.SetGroupID(target)
+	default:
+		builder.SetClientID(target)
+	}
+
+	if len(parameters) > 0 {
+		builder.SetParameters(parameters)
+	}
 	if createdBy != nil {
 		builder.SetCreateBy(*createdBy)
 	}
@@ -55,15 +68,25 @@ func (r *AssignmentRepo) Create(ctx context.Context, tenantID uint32, scriptID,
 	return entity, nil
 }
 
-// Exists checks if an assignment exists
-func (r *AssignmentRepo) Exists(ctx context.Context, tenantID uint32, scriptID, clientID string) (bool, error) {
-	exists, err := r.entClient.Client().ScriptAssignment.Query().
+// Exists checks if an assignment of the script to the given target exists
+func (r *AssignmentRepo) Exists(ctx context.Context, tenantID uint32, scriptID string, targetType scriptassignment.TargetType, target string) (bool, error) {
+	query := r.entClient.Client().ScriptAssignment.Query().
 		Where(
 			scriptassignment.TenantIDEQ(tenantID),
 			scriptassignment.ScriptIDEQ(scriptID),
-			scriptassignment.ClientIDEQ(clientID),
-		).
-		Exist(ctx)
+			scriptassignment.TargetTypeEQ(targetType),
+		)
+
+	switch targetType {
+	case scriptassignment.TargetTypeSELECTOR:
+		query = query.Where(scriptassignment.SelectorEQ(target))
+	case scriptassignment.TargetTypeGROUP:
+		query = query.Where(scriptassignment.GroupIDEQ(target))
+	default:
+		query = query.Where(scriptassignment.ClientIDEQ(target))
+	}
+
+	exists, err := query.Exist(ctx)
 	if err != nil {
 		r.log.Errorf("check assignment exists failed: %s", err.Error())
 		return false, executorV1.ErrorInternalServerError("check assignment failed")
@@ -71,11 +94,12 @@ func (r *AssignmentRepo) Exists(ctx context.Context, tenantID uint32, scriptID,
 	return exists, nil
 }
 
-// ExistsAnyTenant checks if an assignment exists for any tenant (used by client service with mTLS)
+// ExistsAnyTenant checks if a direct assignment exists for any tenant (used by client service with mTLS)
 func (r *AssignmentRepo) ExistsAnyTenant(ctx context.Context, scriptID, clientID string) (bool, error) {
 	exists, err := r.entClient.Client().ScriptAssignment.Query().
 		Where(
 			scriptassignment.ScriptIDEQ(scriptID),
+			scriptassignment.TargetTypeEQ(scriptassignment.TargetTypeCLIENT),
 			scriptassignment.ClientIDEQ(clientID),
 		).
 		Exist(ctx)
@@ -86,12 +110,68 @@ func (r *AssignmentRepo) ExistsAnyTenant(ctx context.Context, scriptID, clientID
 	return exists, nil
 }
 
-// Delete deletes an assignment by script_id, client_id, and tenant_id
+// GetByID retrieves an assignment by ID
+func (r *AssignmentRepo) GetByID(ctx context.Context, id string) (*ent.ScriptAssignment, error) {
+	entity, err := r.entClient.Client().ScriptAssignment.Query().
+		Where(scriptassignment.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get assignment failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get assignment failed")
+	}
+	return entity, nil
+}
+
+// GetDirect retrieves the direct assignment of a script to a client
+func (r *AssignmentRepo) GetDirect(ctx context.Context, tenantID uint32, scriptID, clientID string) (*ent.ScriptAssignment, error) {
+	entity, err := r.entClient.Client().ScriptAssignment.Query().
+		Where(
+			scriptassignment.TenantIDEQ(tenantID),
+			scriptassignment.ScriptIDEQ(scriptID),
+			scriptassignment.TargetTypeEQ(scriptassignment.TargetTypeCLIENT),
+			scriptassignment.ClientIDEQ(clientID),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get assignment failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get assignment failed")
+	}
+	return entity, nil
+}
+
+// UpdateParameters replaces the parameter values of an assignment
+func (r *AssignmentRepo) UpdateParameters(ctx context.Context, id string, parameters map[string]string) (*ent.ScriptAssignment, error) {
+	builder := r.entClient.Client().ScriptAssignment.UpdateOneID(id)
+	if len(parameters) > 0 {
+		builder.SetParameters(parameters)
+	} else {
+		builder.ClearParameters()
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorAssignmentNotFound("assignment not found")
+		}
+		r.log.Errorf("update assignment failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update assignment failed")
+	}
+	return entity, nil
+}
+
+// Delete deletes a direct assignment by script_id, client_id, and tenant_id
 func (r *AssignmentRepo) Delete(ctx context.Context, tenantID uint32, scriptID, clientID string) error {
 	deleted, err := r.entClient.Client().ScriptAssignment.Delete().
 		Where(
 			scriptassignment.TenantIDEQ(tenantID),
 			scriptassignment.ScriptIDEQ(scriptID),
+			scriptassignment.TargetTypeEQ(scriptassignment.TargetTypeCLIENT),
 			scriptassignment.ClientIDEQ(clientID),
 		).
 		Exec(ctx)
@@ -105,6 +185,19 @@ func (r *AssignmentRepo) Delete(ctx context.Context, tenantID uint32, scriptID,
 	return nil
 }
 
+// DeleteByID deletes an assignment by ID
+func (r *AssignmentRepo) DeleteByID(ctx context.Context, id string) error {
+	err := r.entClient.Client().ScriptAssignment.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return executorV1.ErrorAssignmentNotFound("assignment not found")
+		}
+		r.log.Errorf("delete assignment failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete assignment failed")
+	}
+	return nil
+}
+
 // ListByScriptID lists assignments for a script
 func (r *AssignmentRepo) ListByScriptID(ctx context.Context, scriptID string) ([]*ent.ScriptAssignment, error) {
 	entities, err := r.entClient.Client().ScriptAssignment.Query().
@@ -118,10 +211,13 @@ func (r *AssignmentRepo) ListByScriptID(ctx context.Context, scriptID string) ([
 	return entities, nil
 }
 
-// ListByClientID lists assignments for a client
+// ListByClientID lists direct assignments for a client
 func (r *AssignmentRepo) ListByClientID(ctx context.Context, clientID string) ([]*ent.ScriptAssignment, error) {
 	entities, err := r.entClient.Client().ScriptAssignment.Query().
-		Where(scriptassignment.ClientIDEQ(clientID)).
+		Where(
+			scriptassignment.TargetTypeEQ(scriptassignment.TargetTypeCLIENT),
+			scriptassignment.ClientIDEQ(clientID),
+		).
 		Order(ent.Desc(scriptassignment.FieldCreateTime)).
 		All(ctx)
 	if err != nil {
@@ -131,6 +227,47 @@ func (r *AssignmentRepo) ListByClientID(ctx context.Context, clientID string) ([
 	return entities, nil
 }
 
+// ListIndirect lists selector and group assignments, optionally limited to a
+// tenant and a script. They are resolved against client labels by the caller.
+func (r *AssignmentRepo) ListIndirect(ctx context.Context, tenantID *uint32, scriptID *string) ([]*ent.ScriptAssignment, error) {
+	query := r.entClient.Client().ScriptAssignment.Query().
+		Where(scriptassignment.TargetTypeIn(
+			scriptassignment.TargetTypeSELECTOR,
+			scriptassignment.TargetTypeGROUP,
+		))
+
+	if tenantID != nil {
+		query = query.Where(scriptassignment.TenantIDEQ(*tenantID))
+	}
+	if scriptID != nil {
+		query = query.Where(scriptassignment.ScriptIDEQ(*scriptID))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(scriptassignment.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list indirect assignments failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list assignments failed")
+	}
+	return entities, nil
+}
+
+// DeleteByGroupID deletes all assignments targeting a client group
+func (r *AssignmentRepo) DeleteByGroupID(ctx context.Context, groupID string) error {
+	_, err := r.entClient.Client().ScriptAssignment.Delete().
+		Where(
+			scriptassignment.TargetTypeEQ(scriptassignment.TargetTypeGROUP),
+			scriptassignment.GroupIDEQ(groupID),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete assignments by group failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete assignments failed")
+	}
+	return nil
+}
+
 // DeleteByScriptID deletes all assignments for a script (used when deleting a script)
 func (r *AssignmentRepo) DeleteByScriptID(ctx context.Context, scriptID string) error {
 	_, err := r.entClient.Client().ScriptAssignment.Delete().
@@ -156,6 +293,18 @@ func (r *AssignmentRepo) ToProto(entity *ent.ScriptAssignment) *executorV1.Scrip
 		ClientId: entity.ClientID,
 	}
 
+	switch entity.TargetType {
+	case scriptassignment.TargetTypeCLIENT:
+		proto.TargetType = executorV1.AssignmentTargetType_ASSIGNMENT_TARGET_TYPE_CLIENT
+	case scriptassignment.TargetTypeSELECTOR:
+		proto.TargetType = executorV1.AssignmentTargetType_ASSIGNMENT_TARGET_TYPE_SELECTOR
+		proto.Selector = &entity.Selector
+	case scriptassignment.TargetTypeGROUP:
+		proto.TargetType = executorV1.AssignmentTargetType_ASSIGNMENT_TARGET_TYPE_GROUP
+		proto.GroupId = &entity.GroupID
+	}
+
+	proto.Parameters = entity.Parameters
 	if entity.CreateBy != nil {
 		proto.CreatedBy = entity.CreateBy
 	}
@@ -0,0 +1,200 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientgroup"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// ClientGroupRepo handles database operations for client groups
+type ClientGroupRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewClientGroupRepo creates a new ClientGroupRepo
+func NewClientGroupRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ClientGroupRepo {
+	return &ClientGroupRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/client_group"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new client group
+func (r *ClientGroupRepo) Create(ctx context.Context, tenantID uint32, name, description, selector string, clientIDs []string, createdBy *uint32) (*ent.ClientGroup, error) {
+	builder := r.entClient.Client().ClientGroup.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetDescription(description).
+		SetSelector(selector).
+		SetClientIds(clientIDs).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, executorV1.ErrorClientGroupAlreadyExists("a client group with this name already exists")
+		}
+		r.log.Errorf("create client group failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create client group failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a client group by ID
+func (r *ClientGroupRepo) GetByID(ctx context.Context, id string) (*ent.ClientGroup, error) {
+	entity, err := r.entClient.Client().ClientGroup.Query().
+		Where(clientgroup.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get client group failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get client group failed")
+	}
+	return entity, nil
+}
+
+// GetByIDs retrieves client groups by ID; missing groups are skipped
+func (r *ClientGroupRepo) GetByIDs(ctx context.Context, ids []string) ([]*ent.ClientGroup, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	entities, err := r.entClient.Client().ClientGroup.Query().
+		Where(clientgroup.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get client groups failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get client groups failed")
+	}
+	return entities, nil
+}
+
+// ListByTenant lists client groups with pagination and an optional name filter
+func (r *ClientGroupRepo) ListByTenant(ctx context.Context, tenantID uint32, name *string, page, pageSize uint32) ([]*ent.ClientGroup, int, error) {
+	query := r.entClient.Client().ClientGroup.Query().
+		Where(clientgroup.TenantIDEQ(tenantID))
+
+	if name != nil && *name != "" {
+		query = query.Where(clientgroup.NameContainsFold(*name))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count client groups failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count client groups failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(clientgroup.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list client groups failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list client groups failed")
+	}
+
+	return entities, total, nil
+}
+
+// Update updates a client group. A nil clientIDs slice leaves the static
+// members unchanged; an empty one clears them.
+func (r *ClientGroupRepo) Update(ctx context.Context, id string, name, description, selector *string, clientIDs []string, updatedBy *uint32) (*ent.ClientGroup, error) {
+	builder := r.entClient.Client().ClientGroup.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if description != nil {
+		builder.SetDescription(*description)
+	}
+	if selector != nil {
+		builder.SetSelector(*selector)
+	}
+	if clientIDs != nil {
+		builder.SetClientIds(clientIDs)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorClientGroupNotFound("client group not found")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, executorV1.ErrorClientGroupAlreadyExists("a client group with this name already exists")
+		}
+		r.log.Errorf("update client group failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update client group failed")
+	}
+	return entity, nil
+}
+
+// Delete deletes a client group
+func (r *ClientGroupRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().ClientGroup.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return executorV1.ErrorClientGroupNotFound("client group not found")
+		}
+		r.log.Errorf("delete client group failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete client group failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.ClientGroup to executorV1.ClientGroup
+func (r *ClientGroupRepo) ToProto(entity *ent.ClientGroup) *executorV1.ClientGroup {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.ClientGroup{
+		Id:        entity.ID,
+		TenantId:  derefUint32(entity.TenantID),
+		Name:      entity.Name,
+		ClientIds: entity.ClientIds,
+	}
+
+	if entity.Description != "" {
+		proto.Description = &entity.Description
+	}
+	if entity.Selector != "" {
+		proto.Selector = &entity.Selector
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
@@ -0,0 +1,393 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlabelset"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/managedclient"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// ClientRepo handles database operations for the client inventory
+type ClientRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewClientRepo creates a new ClientRepo
+func NewClientRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ClientRepo {
+	return &ClientRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/client"),
+		entClient: entClient,
+	}
+}
+
+// Upsert records a client connection, creating the inventory entry on first
+// contact. Facts are only overwritten when the agent reported them. Also
+// returns the entry as it was before the connection, nil on first contact.
+func (r *ClientRepo) Upsert(ctx context.Context, clientID, machineID, version string, facts *executorV1.ClientFacts) (*ent.ManagedClient, *ent.ManagedClient, error) {
+	now := time.Now()
+
+	existing, err := r.GetByClientID(ctx, clientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if existing == nil {
+		builder := r.entClient.Client().ManagedClient.Create().
+			SetID(uuid.New().String()).
+			SetClientID(clientID).
+			SetMachineID(machineID).
+			SetClientVersion(version).
+			SetFirstSeenAt(now).
+			SetLastSeenAt(now).
+			SetCreateTime(now)
+		if facts != nil {
+			builder.
+				SetHostname(facts.GetHostname()).
+				SetOs(facts.GetOs()).
+				SetOsVersion(facts.GetOsVersion()).
+				SetKernelVersion(facts.GetKernelVersion()).
+				SetArch(facts.GetArch()).
+				SetCPUCount(facts.GetCpuCount()).
+				SetMemoryBytes(facts.GetMemoryBytes()).
+				SetScriptTypes(scriptTypeNames(facts.GetScriptTypes()))
+		}
+
+		entity, createErr := builder.Save(ctx)
+		if createErr != nil {
+			if ent.IsConstraintError(createErr) {
+				// Created concurrently by another connection
+				return r.Upsert(ctx, clientID, machineID, version, facts)
+			}
+			r.log.Errorf("create client failed: %s", createErr.Error())
+			return nil, nil, executorV1.ErrorInternalServerError("create client failed")
+		}
+		return entity, nil, nil
+	}
+
+	builder := r.entClient.Client().ManagedClient.UpdateOneID(existing.ID).
+		SetClientVersion(version).
+		SetLastSeenAt(now)
+	if machineID != "" {
+		builder.SetMachineID(machineID)
+	}
+	if facts != nil {
+		builder.
+			SetHostname(facts.GetHostname()).
+			SetOs(facts.GetOs()).
+			SetOsVersion(facts.GetOsVersion()).
+			SetKernelVersion(facts.GetKernelVersion()).
+			SetArch(facts.GetArch()).
+			SetCPUCount(facts.GetCpuCount()).
+			SetMemoryBytes(facts.GetMemoryBytes()).
+			SetScriptTypes(scriptTypeNames(facts.GetScriptTypes()))
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update client failed: %s", err.Error())
+		return nil, nil, executorV1.ErrorInternalServerError("update client failed")
+	}
+	return entity, existing, nil
+}
+
+// Touch updates the last-seen time of a client
+func (r *ClientRepo) Touch(ctx context.Context, clientID string) error {
+	_, err := r.entClient.Client().ManagedClient.Update().
+		Where(managedclient.ClientIDEQ(clientID)).
+		SetLastSeenAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("touch client failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("touch client failed")
+	}
+	return nil
+}
+
+// GetByID retrieves a client by ID
+func (r *ClientRepo) GetByID(ctx context.Context, id string) (*ent.ManagedClient, error) {
+	entity, err := r.entClient.Client().ManagedClient.Query().
+		Where(managedclient.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get client failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get client failed")
+	}
+	return entity, nil
+}
+
+// GetByClientID retrieves a client by its mTLS CN
+func (r *ClientRepo) GetByClientID(ctx context.Context, clientID string) (*ent.ManagedClient, error) {
+	entity, err := r.entClient.Client().ManagedClient.Query().
+		Where(managedclient.ClientIDEQ(clientID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get client by client id failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get client by client id failed")
+	}
+	return entity, nil
+}
+
+// ListAll lists every client in the inventory
+func (r *ClientRepo) ListAll(ctx context.Context) ([]*ent.ManagedClient, error) {
+	entities, err := r.entClient.Client().ManagedClient.Query().
+		Order(ent.Asc(managedclient.FieldClientID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list clients failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list clients failed")
+	}
+	return entities, nil
+}
+
+// List lists clients with pagination and filters. When online is set, only
+// clients whose CN is (or is not) in connected are returned.
+func (r *ClientRepo) List(ctx context.Context, query *string, online *bool, connected []string, page, pageSize uint32) ([]*ent.ManagedClient, int, error) {
+	q := r.entClient.Client().ManagedClient.Query()
+
+	if query != nil && *query != "" {
+		q = q.Where(managedclient.Or(
+			managedclient.ClientIDContainsFold(*query),
+			managedclient.MachineIDContainsFold(*query),
+			managedclient.HostnameContainsFold(*query),
+		))
+	}
+	if online != nil {
+		if *online {
+			q = q.Where(managedclient.ClientIDIn(connected...))
+		} else {
+			q = q.Where(managedclient.ClientIDNotIn(connected...))
+		}
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count clients failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count clients failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		q = q.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := q.
+		Order(ent.Asc(managedclient.FieldClientID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list clients failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list clients failed")
+	}
+
+	return entities, total, nil
+}
+
+// Update updates the operator-managed fields of a client
+func (r *ClientRepo) Update(ctx context.Context, id string, description *string, updatedBy *uint32) (*ent.ManagedClient, error) {
+	builder := r.entClient.Client().ManagedClient.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if description != nil {
+		builder.SetDescription(*description)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorClientNotFound("client not found")
+		}
+		r.log.Errorf("update client failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update client failed")
+	}
+	return entity, nil
+}
+
+// Delete removes a client from the inventory
+func (r *ClientRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().ManagedClient.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return executorV1.ErrorClientNotFound("client not found")
+		}
+		r.log.Errorf("delete client failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete client failed")
+	}
+	return nil
+}
+
+// GetLabels returns the labels a tenant set on a client, nil when it set none
+func (r *ClientRepo) GetLabels(ctx context.Context, tenantID uint32, clientID string) (map[string]string, error) {
+	entity, err := r.entClient.Client().ClientLabelSet.Query().
+		Where(
+			clientlabelset.TenantIDEQ(tenantID),
+			clientlabelset.ClientIDEQ(clientID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get client labels failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get client labels failed")
+	}
+	return entity.Labels, nil
+}
+
+// ListLabels returns the labels of every client a tenant labelled, by CN
+func (r *ClientRepo) ListLabels(ctx context.Context, tenantID uint32) (map[string]map[string]string, error) {
+	entities, err := r.entClient.Client().ClientLabelSet.Query().
+		Where(clientlabelset.TenantIDEQ(tenantID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list client labels failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list client labels failed")
+	}
+
+	labels := make(map[string]map[string]string, len(entities))
+	for _, e := range entities {
+		labels[e.ClientID] = e.Labels
+	}
+	return labels, nil
+}
+
+// SetLabels replaces the labels a tenant set on a client; empty labels
+// remove them
+func (r *ClientRepo) SetLabels(ctx context.Context, tenantID uint32, clientID string, labels map[string]string, updatedBy *uint32) error {
+	var err error
+	if len(labels) == 0 {
+		_, err = r.entClient.Client().ClientLabelSet.Delete().
+			Where(
+				clientlabelset.TenantIDEQ(tenantID),
+				clientlabelset.ClientIDEQ(clientID),
+			).
+			Exec(ctx)
+	} else {
+		now := time.Now()
+		builder := r.entClient.Client().ClientLabelSet.Create().
+			SetID(uuid.New().String()).
+			SetTenantID(tenantID).
+			SetClientID(clientID).
+			SetLabels(labels).
+			SetCreateTime(now).
+			SetUpdateTime(now)
+		if updatedBy != nil {
+			builder.SetUpdateBy(*updatedBy)
+		}
+		err = builder.
+			OnConflictColumns(clientlabelset.FieldClientID, clientlabelset.FieldTenantID).
+			Update(func(u *ent.ClientLabelSetUpsert) {
+				u.UpdateLabels()
+				u.UpdateUpdateTime()
+				u.UpdateUpdateBy()
+			}).
+			Exec(ctx)
+	}
+	if err != nil {
+		r.log.Errorf("set client labels failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("set client labels failed")
+	}
+	return nil
+}
+
+// DeleteLabels removes the labels every tenant set on a client
+func (r *ClientRepo) DeleteLabels(ctx context.Context, clientID string) error {
+	_, err := r.entClient.Client().ClientLabelSet.Delete().
+		Where(clientlabelset.ClientIDEQ(clientID)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete client labels failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete client labels failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.ManagedClient to executorV1.Client with the labels
+// of the caller's tenant
+func (r *ClientRepo) ToProto(entity *ent.ManagedClient, labels map[string]string, online bool) *executorV1.Client {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.Client{
+		Id:            entity.ID,
+		ClientId:      entity.ClientID,
+		MachineId:     entity.MachineID,
+		ClientVersion: entity.ClientVersion,
+		Facts: &executorV1.ClientFacts{
+			Hostname:      entity.Hostname,
+			Os:            entity.Os,
+			OsVersion:     entity.OsVersion,
+			KernelVersion: entity.KernelVersion,
+			Arch:          entity.Arch,
+			CpuCount:      entity.CPUCount,
+			MemoryBytes:   entity.MemoryBytes,
+			ScriptTypes:   scriptTypesToProto(entity.ScriptTypes),
+		},
+		Labels:      labels,
+		Online:      online,
+		FirstSeenAt: timestamppb.New(entity.FirstSeenAt),
+		LastSeenAt:  timestamppb.New(entity.LastSeenAt),
+	}
+
+	if entity.Description != "" {
+		proto.Description = &entity.Description
+	}
+	if entity.UpdateBy != nil {
+		proto.UpdatedBy = entity.UpdateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
+
+// scriptTypeNames converts reported script types to the names scripts store
+// their type by, dropping unknown values
+func scriptTypeNames(types []executorV1.ScriptType) []string {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		if t == executorV1.ScriptType_SCRIPT_TYPE_UNSPECIFIED {
+			continue
+		}
+		if name, ok := executorV1.ScriptType_name[int32(t)]; ok {
+			names = append(names, strings.TrimPrefix(name, "SCRIPT_TYPE_"))
+		}
+	}
+	return names
+}
+
+// scriptTypesToProto converts stored script type names to the proto enum
+func scriptTypesToProto(names []string) []executorV1.ScriptType {
+	types := make([]executorV1.ScriptType, 0, len(names))
+	for _, name := range names {
+		if v, ok := executorV1.ScriptType_value["SCRIPT_TYPE_"+name]; ok {
+			types = append(types, executorV1.ScriptType(v))
+		}
+	}
+	return types
+}
@@ -0,0 +1,169 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// CommandRepo handles database operations for issued client commands
+type CommandRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewCommandRepo creates a new CommandRepo
+func NewCommandRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *CommandRepo {
+	return &CommandRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/command"),
+		entClient: entClient,
+	}
+}
+
+// Create records a command issued to a client
+func (r *CommandRepo) Create(ctx context.Context, tenantID uint32, clientID string, cmd *executorV1.ExecutionCommand) (*ent.Command, error) {
+	commandType := command.CommandTypeSCRIPT_EXECUTION
+	switch cmd.GetCommandType() {
+	case executorV1.CommandType_COMMAND_TYPE_CLIENT_UPDATE:
+		commandType = command.CommandTypeCLIENT_UPDATE
+	case executorV1.CommandType_COMMAND_TYPE_ABORT:
+		commandType = command.CommandTypeABORT
+	case executorV1.CommandType_COMMAND_TYPE_CANCEL:
+		commandType = command.CommandTypeCANCEL
+	}
+
+	builder := r.entClient.Client().Command.Create().
+		SetID(cmd.GetCommandId()).
+		SetTenantID(tenantID).
+		SetClientID(clientID).
+		SetCommandType(commandType).
+		SetCreateTime(time.Now())
+
+	if cmd.GetExecutionId() != "" {
+		builder.SetExecutionID(cmd.GetExecutionId())
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create command failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create command failed")
+	}
+
+	return entity, nil
+}
+
+// GetByID retrieves a command by ID
+func (r *CommandRepo) GetByID(ctx context.Context, id string) (*ent.Command, error) {
+	entity, err := r.entClient.Client().Command.Query().
+		Where(command.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get command failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get command failed")
+	}
+	return entity, nil
+}
+
+// GetLatestByExecutionID retrieves the most recent command of the given type issued for an execution
+func (r *CommandRepo) GetLatestByExecutionID(ctx context.Context, executionID string, commandType command.CommandType) (*ent.Command, error) {
+	entity, err := r.entClient.Client().Command.Query().
+		Where(
+			command.ExecutionIDEQ(executionID),
+			command.CommandTypeEQ(commandType),
+		).
+		Order(ent.Desc(command.FieldCreateTime)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get command by execution failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get command by execution failed")
+	}
+	return entity, nil
+}
+
+// MarkQueued records that the command is waiting for the client to reconnect.
+// Commands that already progressed past PENDING are left untouched.
+func (r *CommandRepo) MarkQueued(ctx context.Context, id string) error {
+	_, err := r.entClient.Client().Command.Update().
+		Where(
+			command.IDEQ(id),
+			command.StatusEQ(command.StatusPENDING),
+		).
+		SetStatus(command.StatusQUEUED).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark command queued failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("mark command queued failed")
+	}
+	return nil
+}
+
+// MarkSent records that the command was written to the client stream
+func (r *CommandRepo) MarkSent(ctx context.Context, id string) error {
+	_, err := r.entClient.Client().Command.UpdateOneID(id).
+		SetStatus(command.StatusSENT).
+		SetSentAt(time.Now()).
+		Save(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		r.log.Errorf("mark command sent failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("mark command sent failed")
+	}
+	return nil
+}
+
+// MarkAcked records the client's acknowledgement of the command
+func (r *CommandRepo) MarkAcked(ctx context.Context, id string, accepted bool, reason string) error {
+	builder := r.entClient.Client().Command.UpdateOneID(id).
+		SetAckedAt(time.Now())
+
+	if accepted {
+		builder.SetStatus(command.StatusACCEPTED)
+	} else {
+		builder.SetStatus(command.StatusREJECTED).SetRejectionReason(reason)
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		r.log.Errorf("mark command acked failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("mark command acked failed")
+	}
+	return nil
+}
+
+// MarkCompleted records that the client reported the result of the command
+func (r *CommandRepo) MarkCompleted(ctx context.Context, id string) error {
+	_, err := r.entClient.Client().Command.UpdateOneID(id).
+		SetStatus(command.StatusCOMPLETED).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark command completed failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("mark command completed failed")
+	}
+	return nil
+}
+
+// MarkExpired records that the command was never delivered
+func (r *CommandRepo) MarkExpired(ctx context.Context, id string) error {
+	_, err := r.entClient.Client().Command.UpdateOneID(id).
+		SetStatus(command.StatusEXPIRED).
+		SetExpiredAt(time.Now()).
+		Save(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		r.log.Errorf("mark command expired failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("mark command expired failed")
+	}
+	return nil
+}
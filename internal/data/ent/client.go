@@ -15,9 +15,28 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientgroup"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlabelset"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlock"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/managedclient"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/outputchunk"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/protectedclient"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/queuedcommand"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptassignment"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptchangerequest"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptversion"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/signingkey"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,12 +46,50 @@ type Client struct {
 	Schema *migrate.Schema
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// ClientGroup is the client for interacting with the ClientGroup builders.
+	ClientGroup *ClientGroupClient
+	// ClientLabelSet is the client for interacting with the ClientLabelSet builders.
+	ClientLabelSet *ClientLabelSetClient
+	// ClientLock is the client for interacting with the ClientLock builders.
+	ClientLock *ClientLockClient
+	// Command is the client for interacting with the Command builders.
+	Command *CommandClient
+	// EventRule is the client for interacting with the EventRule builders.
+	EventRule *EventRuleClient
 	// ExecutionLog is the client for interacting with the ExecutionLog builders.
 	ExecutionLog *ExecutionLogClient
+	// ExecutionRun is the client for interacting with the ExecutionRun builders.
+	ExecutionRun *ExecutionRunClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
+	// ManagedClient is the client for interacting with the ManagedClient builders.
+	ManagedClient *ManagedClientClient
+	// OutputChunk is the client for interacting with the OutputChunk builders.
+	OutputChunk *OutputChunkClient
+	// ProtectedClient is the client for interacting with the ProtectedClient builders.
+	ProtectedClient *ProtectedClientClient
+	// QueuedCommand is the client for interacting with the QueuedCommand builders.
+	QueuedCommand *QueuedCommandClient
+	// Schedule is the client for interacting with the Schedule builders.
+	Schedule *ScheduleClient
 	// Script is the client for interacting with the Script builders.
 	Script *ScriptClient
 	// ScriptAssignment is the client for interacting with the ScriptAssignment builders.
 	ScriptAssignment *ScriptAssignmentClient
+	// ScriptChangeRequest is the client for interacting with the ScriptChangeRequest builders.
+	ScriptChangeRequest *ScriptChangeRequestClient
+	// ScriptVersion is the client for interacting with the ScriptVersion builders.
+	ScriptVersion *ScriptVersionClient
+	// Secret is the client for interacting with the Secret builders.
+	Secret *SecretClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
+	// TenantSetting is the client for interacting with the TenantSetting builders.
+	TenantSetting *TenantSettingClient
+	// Workflow is the client for interacting with the Workflow builders.
+	Workflow *WorkflowClient
+	// WorkflowRun is the client for interacting with the WorkflowRun builders.
+	WorkflowRun *WorkflowRunClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -45,9 +102,28 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.ClientGroup = NewClientGroupClient(c.config)
+	c.ClientLabelSet = NewClientLabelSetClient(c.config)
+	c.ClientLock = NewClientLockClient(c.config)
+	c.Command = NewCommandClient(c.config)
+	c.EventRule = NewEventRuleClient(c.config)
 	c.ExecutionLog = NewExecutionLogClient(c.config)
+	c.ExecutionRun = NewExecutionRunClient(c.config)
+	c.MaintenanceWindow = NewMaintenanceWindowClient(c.config)
+	c.ManagedClient = NewManagedClientClient(c.config)
+	c.OutputChunk = NewOutputChunkClient(c.config)
+	c.ProtectedClient = NewProtectedClientClient(c.config)
+	c.QueuedCommand = NewQueuedCommandClient(c.config)
+	c.Schedule = NewScheduleClient(c.config)
 	c.Script = NewScriptClient(c.config)
 	c.ScriptAssignment = NewScriptAssignmentClient(c.config)
+	c.ScriptChangeRequest = NewScriptChangeRequestClient(c.config)
+	c.ScriptVersion = NewScriptVersionClient(c.config)
+	c.Secret = NewSecretClient(c.config)
+	c.SigningKey = NewSigningKeyClient(c.config)
+	c.TenantSetting = NewTenantSettingClient(c.config)
+	c.Workflow = NewWorkflowClient(c.config)
+	c.WorkflowRun = NewWorkflowRunClient(c.config)
 }
 
 type (
@@ -138,12 +214,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		AuditLog:         NewAuditLogClient(cfg),
-		ExecutionLog:     NewExecutionLogClient(cfg),
-		Script:           NewScriptClient(cfg),
-		ScriptAssignment: NewScriptAssignmentClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		AuditLog:            NewAuditLogClient(cfg),
+		ClientGroup:         NewClientGroupClient(cfg),
+		ClientLabelSet:      NewClientLabelSetClient(cfg),
+		ClientLock:          NewClientLockClient(cfg),
+		Command:             NewCommandClient(cfg),
+		EventRule:           NewEventRuleClient(cfg),
+		ExecutionLog:        NewExecutionLogClient(cfg),
+		ExecutionRun:        NewExecutionRunClient(cfg),
+		MaintenanceWindow:   NewMaintenanceWindowClient(cfg),
+		ManagedClient:       NewManagedClientClient(cfg),
+		OutputChunk:         NewOutputChunkClient(cfg),
+		ProtectedClient:     NewProtectedClientClient(cfg),
+		QueuedCommand:       NewQueuedCommandClient(cfg),
+		Schedule:            NewScheduleClient(cfg),
+		Script:              NewScriptClient(cfg),
+		ScriptAssignment:    NewScriptAssignmentClient(cfg),
+		ScriptChangeRequest: NewScriptChangeRequestClient(cfg),
+		ScriptVersion:       NewScriptVersionClient(cfg),
+		Secret:              NewSecretClient(cfg),
+		SigningKey:          NewSigningKeyClient(cfg),
+		TenantSetting:       NewTenantSettingClient(cfg),
+		Workflow:            NewWorkflowClient(cfg),
+		WorkflowRun:         NewWorkflowRunClient(cfg),
 	}, nil
 }
 
@@ -161,12 +256,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		AuditLog:         NewAuditLogClient(cfg),
-		ExecutionLog:     NewExecutionLogClient(cfg),
-		Script:           NewScriptClient(cfg),
-		ScriptAssignment: NewScriptAssignmentClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		AuditLog:            NewAuditLogClient(cfg),
+		ClientGroup:         NewClientGroupClient(cfg),
+		ClientLabelSet:      NewClientLabelSetClient(cfg),
+		ClientLock:          NewClientLockClient(cfg),
+		Command:             NewCommandClient(cfg),
+		EventRule:           NewEventRuleClient(cfg),
+		ExecutionLog:        NewExecutionLogClient(cfg),
+		ExecutionRun:        NewExecutionRunClient(cfg),
+		MaintenanceWindow:   NewMaintenanceWindowClient(cfg),
+		ManagedClient:       NewManagedClientClient(cfg),
+		OutputChunk:         NewOutputChunkClient(cfg),
+		ProtectedClient:     NewProtectedClientClient(cfg),
+		QueuedCommand:       NewQueuedCommandClient(cfg),
+		Schedule:            NewScheduleClient(cfg),
+		Script:              NewScriptClient(cfg),
+		ScriptAssignment:    NewScriptAssignmentClient(cfg),
+		ScriptChangeRequest: NewScriptChangeRequestClient(cfg),
+		ScriptVersion:       NewScriptVersionClient(cfg),
+		Secret:              NewSecretClient(cfg),
+		SigningKey:          NewSigningKeyClient(cfg),
+		TenantSetting:       NewTenantSettingClient(cfg),
+		Workflow:            NewWorkflowClient(cfg),
+		WorkflowRun:         NewWorkflowRunClient(cfg),
 	}, nil
 }
 
@@ -195,19 +309,29 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.AuditLog.Use(hooks...)
-	c.ExecutionLog.Use(hooks...)
-	c.Script.Use(hooks...)
-	c.ScriptAssignment.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditLog, c.ClientGroup, c.ClientLabelSet, c.ClientLock, c.Command,
+		c.EventRule, c.ExecutionLog, c.ExecutionRun, c.MaintenanceWindow,
+		c.ManagedClient, c.OutputChunk, c.ProtectedClient, c.QueuedCommand, c.Schedule,
+		c.Script, c.ScriptAssignment, c.ScriptChangeRequest, c.ScriptVersion, c.Secret,
+		c.SigningKey, c.TenantSetting, c.Workflow, c.WorkflowRun,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.AuditLog.Intercept(interceptors...)
-	c.ExecutionLog.Intercept(interceptors...)
-	c.Script.Intercept(interceptors...)
-	c.ScriptAssignment.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditLog, c.ClientGroup, c.ClientLabelSet, c.ClientLock, c.Command,
+		c.EventRule, c.ExecutionLog, c.ExecutionRun, c.MaintenanceWindow,
+		c.ManagedClient, c.OutputChunk, c.ProtectedClient, c.QueuedCommand, c.Schedule,
+		c.Script, c.ScriptAssignment, c.ScriptChangeRequest, c.ScriptVersion, c.Secret,
+		c.SigningKey, c.TenantSetting, c.Workflow, c.WorkflowRun,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -215,12 +339,50 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *ClientGroupMutation:
+		return c.ClientGroup.mutate(ctx, m)
+	case *ClientLabelSetMutation:
+		return c.ClientLabelSet.mutate(ctx, m)
+	case *ClientLockMutation:
+		return c.ClientLock.mutate(ctx, m)
+	case *CommandMutation:
+		return c.Command.mutate(ctx, m)
+	case *EventRuleMutation:
+		return c.EventRule.mutate(ctx, m)
 	case *ExecutionLogMutation:
 		return c.ExecutionLog.mutate(ctx, m)
+	case *ExecutionRunMutation:
+		return c.ExecutionRun.mutate(ctx, m)
+	case *MaintenanceWindowMutation:
+		return c.MaintenanceWindow.mutate(ctx, m)
+	case *ManagedClientMutation:
+		return c.ManagedClient.mutate(ctx, m)
+	case *OutputChunkMutation:
+		return c.OutputChunk.mutate(ctx, m)
+	case *ProtectedClientMutation:
+		return c.ProtectedClient.mutate(ctx, m)
+	case *QueuedCommandMutation:
+		return c.QueuedCommand.mutate(ctx, m)
+	case *ScheduleMutation:
+		return c.Schedule.mutate(ctx, m)
 	case *ScriptMutation:
 		return c.Script.mutate(ctx, m)
 	case *ScriptAssignmentMutation:
 		return c.ScriptAssignment.mutate(ctx, m)
+	case *ScriptChangeRequestMutation:
+		return c.ScriptChangeRequest.mutate(ctx, m)
+	case *ScriptVersionMutation:
+		return c.ScriptVersion.mutate(ctx, m)
+	case *SecretMutation:
+		return c.Secret.mutate(ctx, m)
+	case *SigningKeyMutation:
+		return c.SigningKey.mutate(ctx, m)
+	case *TenantSettingMutation:
+		return c.TenantSetting.mutate(ctx, m)
+	case *WorkflowMutation:
+		return c.Workflow.mutate(ctx, m)
+	case *WorkflowRunMutation:
+		return c.WorkflowRun.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -360,107 +522,107 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
-// ExecutionLogClient is a client for the ExecutionLog schema.
-type ExecutionLogClient struct {
+// ClientGroupClient is a client for the ClientGroup schema.
+type ClientGroupClient struct {
 	config
 }
 
-// NewExecutionLogClient returns a client for the ExecutionLog from the given config.
-func NewExecutionLogClient(c config) *ExecutionLogClient {
-	return &ExecutionLogClient{config: c}
+// NewClientGroupClient returns a client for the ClientGroup from the given config.
+func NewClientGroupClient(c config) *ClientGroupClient {
+	return &ClientGroupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `executionlog.Hooks(f(g(h())))`.
-func (c *ExecutionLogClient) Use(hooks ...Hook) {
-	c.hooks.ExecutionLog = append(c.hooks.ExecutionLog, hooks...)
+// A call to `Use(f, g, h)` equals to `clientgroup.Hooks(f(g(h())))`.
+func (c *ClientGroupClient) Use(hooks ...Hook) {
+	c.hooks.ClientGroup = append(c.hooks.ClientGroup, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `executionlog.Intercept(f(g(h())))`.
-func (c *ExecutionLogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ExecutionLog = append(c.inters.ExecutionLog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `clientgroup.Intercept(f(g(h())))`.
+func (c *ClientGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClientGroup = append(c.inters.ClientGroup, interceptors...)
 }
 
-// Create returns a builder for creating a ExecutionLog entity.
-func (c *ExecutionLogClient) Create() *ExecutionLogCreate {
-	mutation := newExecutionLogMutation(c.config, OpCreate)
-	return &ExecutionLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ClientGroup entity.
+func (c *ClientGroupClient) Create() *ClientGroupCreate {
+	mutation := newClientGroupMutation(c.config, OpCreate)
+	return &ClientGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ExecutionLog entities.
-func (c *ExecutionLogClient) CreateBulk(builders ...*ExecutionLogCreate) *ExecutionLogCreateBulk {
-	return &ExecutionLogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ClientGroup entities.
+func (c *ClientGroupClient) CreateBulk(builders ...*ClientGroupCreate) *ClientGroupCreateBulk {
+	return &ClientGroupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ExecutionLogClient) MapCreateBulk(slice any, setFunc func(*ExecutionLogCreate, int)) *ExecutionLogCreateBulk {
+func (c *ClientGroupClient) MapCreateBulk(slice any, setFunc func(*ClientGroupCreate, int)) *ClientGroupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ExecutionLogCreateBulk{err: fmt.Errorf("calling to ExecutionLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ClientGroupCreateBulk{err: fmt.Errorf("calling to ClientGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ExecutionLogCreate, rv.Len())
+	builders := make([]*ClientGroupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ExecutionLogCreateBulk{config: c.config, builders: builders}
+	return &ClientGroupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ExecutionLog.
-func (c *ExecutionLogClient) Update() *ExecutionLogUpdate {
-	mutation := newExecutionLogMutation(c.config, OpUpdate)
-	return &ExecutionLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ClientGroup.
+func (c *ClientGroupClient) Update() *ClientGroupUpdate {
+	mutation := newClientGroupMutation(c.config, OpUpdate)
+	return &ClientGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ExecutionLogClient) UpdateOne(_m *ExecutionLog) *ExecutionLogUpdateOne {
-	mutation := newExecutionLogMutation(c.config, OpUpdateOne, withExecutionLog(_m))
-	return &ExecutionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClientGroupClient) UpdateOne(_m *ClientGroup) *ClientGroupUpdateOne {
+	mutation := newClientGroupMutation(c.config, OpUpdateOne, withClientGroup(_m))
+	return &ClientGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ExecutionLogClient) UpdateOneID(id string) *ExecutionLogUpdateOne {
-	mutation := newExecutionLogMutation(c.config, OpUpdateOne, withExecutionLogID(id))
-	return &ExecutionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClientGroupClient) UpdateOneID(id string) *ClientGroupUpdateOne {
+	mutation := newClientGroupMutation(c.config, OpUpdateOne, withClientGroupID(id))
+	return &ClientGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ExecutionLog.
-func (c *ExecutionLogClient) Delete() *ExecutionLogDelete {
-	mutation := newExecutionLogMutation(c.config, OpDelete)
-	return &ExecutionLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ClientGroup.
+func (c *ClientGroupClient) Delete() *ClientGroupDelete {
+	mutation := newClientGroupMutation(c.config, OpDelete)
+	return &ClientGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ExecutionLogClient) DeleteOne(_m *ExecutionLog) *ExecutionLogDeleteOne {
+func (c *ClientGroupClient) DeleteOne(_m *ClientGroup) *ClientGroupDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ExecutionLogClient) DeleteOneID(id string) *ExecutionLogDeleteOne {
-	builder := c.Delete().Where(executionlog.ID(id))
+func (c *ClientGroupClient) DeleteOneID(id string) *ClientGroupDeleteOne {
+	builder := c.Delete().Where(clientgroup.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ExecutionLogDeleteOne{builder}
+	return &ClientGroupDeleteOne{builder}
 }
 
-// Query returns a query builder for ExecutionLog.
-func (c *ExecutionLogClient) Query() *ExecutionLogQuery {
-	return &ExecutionLogQuery{
+// Query returns a query builder for ClientGroup.
+func (c *ClientGroupClient) Query() *ClientGroupQuery {
+	return &ClientGroupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeExecutionLog},
+		ctx:    &QueryContext{Type: TypeClientGroup},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ExecutionLog entity by its id.
-func (c *ExecutionLogClient) Get(ctx context.Context, id string) (*ExecutionLog, error) {
-	return c.Query().Where(executionlog.ID(id)).Only(ctx)
+// Get returns a ClientGroup entity by its id.
+func (c *ClientGroupClient) Get(ctx context.Context, id string) (*ClientGroup, error) {
+	return c.Query().Where(clientgroup.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ExecutionLogClient) GetX(ctx context.Context, id string) *ExecutionLog {
+func (c *ClientGroupClient) GetX(ctx context.Context, id string) *ClientGroup {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -469,132 +631,132 @@ func (c *ExecutionLogClient) GetX(ctx context.Context, id string) *ExecutionLog
 }
 
 // Hooks returns the client hooks.
-func (c *ExecutionLogClient) Hooks() []Hook {
-	hooks := c.hooks.ExecutionLog
-	return append(hooks[:len(hooks):len(hooks)], executionlog.Hooks[:]...)
+func (c *ClientGroupClient) Hooks() []Hook {
+	hooks := c.hooks.ClientGroup
+	return append(hooks[:len(hooks):len(hooks)], clientgroup.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ExecutionLogClient) Interceptors() []Interceptor {
-	return c.inters.ExecutionLog
+func (c *ClientGroupClient) Interceptors() []Interceptor {
+	return c.inters.ClientGroup
 }
 
-func (c *ExecutionLogClient) mutate(ctx context.Context, m *ExecutionLogMutation) (Value, error) {
+func (c *ClientGroupClient) mutate(ctx context.Context, m *ClientGroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ExecutionLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ExecutionLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ExecutionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ExecutionLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ClientGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ExecutionLog mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ClientGroup mutation op: %q", m.Op())
 	}
 }
 
-// ScriptClient is a client for the Script schema.
-type ScriptClient struct {
+// ClientLabelSetClient is a client for the ClientLabelSet schema.
+type ClientLabelSetClient struct {
 	config
 }
 
-// NewScriptClient returns a client for the Script from the given config.
-func NewScriptClient(c config) *ScriptClient {
-	return &ScriptClient{config: c}
+// NewClientLabelSetClient returns a client for the ClientLabelSet from the given config.
+func NewClientLabelSetClient(c config) *ClientLabelSetClient {
+	return &ClientLabelSetClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `script.Hooks(f(g(h())))`.
-func (c *ScriptClient) Use(hooks ...Hook) {
-	c.hooks.Script = append(c.hooks.Script, hooks...)
+// A call to `Use(f, g, h)` equals to `clientlabelset.Hooks(f(g(h())))`.
+func (c *ClientLabelSetClient) Use(hooks ...Hook) {
+	c.hooks.ClientLabelSet = append(c.hooks.ClientLabelSet, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `script.Intercept(f(g(h())))`.
-func (c *ScriptClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Script = append(c.inters.Script, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `clientlabelset.Intercept(f(g(h())))`.
+func (c *ClientLabelSetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClientLabelSet = append(c.inters.ClientLabelSet, interceptors...)
 }
 
-// Create returns a builder for creating a Script entity.
-func (c *ScriptClient) Create() *ScriptCreate {
-	mutation := newScriptMutation(c.config, OpCreate)
-	return &ScriptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ClientLabelSet entity.
+func (c *ClientLabelSetClient) Create() *ClientLabelSetCreate {
+	mutation := newClientLabelSetMutation(c.config, OpCreate)
+	return &ClientLabelSetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Script entities.
-func (c *ScriptClient) CreateBulk(builders ...*ScriptCreate) *ScriptCreateBulk {
-	return &ScriptCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ClientLabelSet entities.
+func (c *ClientLabelSetClient) CreateBulk(builders ...*ClientLabelSetCreate) *ClientLabelSetCreateBulk {
+	return &ClientLabelSetCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ScriptClient) MapCreateBulk(slice any, setFunc func(*ScriptCreate, int)) *ScriptCreateBulk {
+func (c *ClientLabelSetClient) MapCreateBulk(slice any, setFunc func(*ClientLabelSetCreate, int)) *ClientLabelSetCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ScriptCreateBulk{err: fmt.Errorf("calling to ScriptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ClientLabelSetCreateBulk{err: fmt.Errorf("calling to ClientLabelSetClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ScriptCreate, rv.Len())
+	builders := make([]*ClientLabelSetCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ScriptCreateBulk{config: c.config, builders: builders}
+	return &ClientLabelSetCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Script.
-func (c *ScriptClient) Update() *ScriptUpdate {
-	mutation := newScriptMutation(c.config, OpUpdate)
-	return &ScriptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ClientLabelSet.
+func (c *ClientLabelSetClient) Update() *ClientLabelSetUpdate {
+	mutation := newClientLabelSetMutation(c.config, OpUpdate)
+	return &ClientLabelSetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ScriptClient) UpdateOne(_m *Script) *ScriptUpdateOne {
-	mutation := newScriptMutation(c.config, OpUpdateOne, withScript(_m))
-	return &ScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClientLabelSetClient) UpdateOne(_m *ClientLabelSet) *ClientLabelSetUpdateOne {
+	mutation := newClientLabelSetMutation(c.config, OpUpdateOne, withClientLabelSet(_m))
+	return &ClientLabelSetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ScriptClient) UpdateOneID(id string) *ScriptUpdateOne {
-	mutation := newScriptMutation(c.config, OpUpdateOne, withScriptID(id))
-	return &ScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClientLabelSetClient) UpdateOneID(id string) *ClientLabelSetUpdateOne {
+	mutation := newClientLabelSetMutation(c.config, OpUpdateOne, withClientLabelSetID(id))
+	return &ClientLabelSetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Script.
-func (c *ScriptClient) Delete() *ScriptDelete {
-	mutation := newScriptMutation(c.config, OpDelete)
-	return &ScriptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ClientLabelSet.
+func (c *ClientLabelSetClient) Delete() *ClientLabelSetDelete {
+	mutation := newClientLabelSetMutation(c.config, OpDelete)
+	return &ClientLabelSetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ScriptClient) DeleteOne(_m *Script) *ScriptDeleteOne {
+func (c *ClientLabelSetClient) DeleteOne(_m *ClientLabelSet) *ClientLabelSetDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ScriptClient) DeleteOneID(id string) *ScriptDeleteOne {
-	builder := c.Delete().Where(script.ID(id))
+func (c *ClientLabelSetClient) DeleteOneID(id string) *ClientLabelSetDeleteOne {
+	builder := c.Delete().Where(clientlabelset.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ScriptDeleteOne{builder}
+	return &ClientLabelSetDeleteOne{builder}
 }
 
-// Query returns a query builder for Script.
-func (c *ScriptClient) Query() *ScriptQuery {
-	return &ScriptQuery{
+// Query returns a query builder for ClientLabelSet.
+func (c *ClientLabelSetClient) Query() *ClientLabelSetQuery {
+	return &ClientLabelSetQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeScript},
+		ctx:    &QueryContext{Type: TypeClientLabelSet},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Script entity by its id.
-func (c *ScriptClient) Get(ctx context.Context, id string) (*Script, error) {
-	return c.Query().Where(script.ID(id)).Only(ctx)
+// Get returns a ClientLabelSet entity by its id.
+func (c *ClientLabelSetClient) Get(ctx context.Context, id string) (*ClientLabelSet, error) {
+	return c.Query().Where(clientlabelset.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ScriptClient) GetX(ctx context.Context, id string) *Script {
+func (c *ClientLabelSetClient) GetX(ctx context.Context, id string) *ClientLabelSet {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -603,132 +765,132 @@ func (c *ScriptClient) GetX(ctx context.Context, id string) *Script {
 }
 
 // Hooks returns the client hooks.
-func (c *ScriptClient) Hooks() []Hook {
-	hooks := c.hooks.Script
-	return append(hooks[:len(hooks):len(hooks)], script.Hooks[:]...)
+func (c *ClientLabelSetClient) Hooks() []Hook {
+	hooks := c.hooks.ClientLabelSet
+	return append(hooks[:len(hooks):len(hooks)], clientlabelset.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ScriptClient) Interceptors() []Interceptor {
-	return c.inters.Script
+func (c *ClientLabelSetClient) Interceptors() []Interceptor {
+	return c.inters.ClientLabelSet
 }
 
-func (c *ScriptClient) mutate(ctx context.Context, m *ScriptMutation) (Value, error) {
+func (c *ClientLabelSetClient) mutate(ctx context.Context, m *ClientLabelSetMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ScriptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientLabelSetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ScriptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientLabelSetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientLabelSetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ScriptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ClientLabelSetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Script mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ClientLabelSet mutation op: %q", m.Op())
 	}
 }
 
-// ScriptAssignmentClient is a client for the ScriptAssignment schema.
-type ScriptAssignmentClient struct {
+// ClientLockClient is a client for the ClientLock schema.
+type ClientLockClient struct {
 	config
 }
 
-// NewScriptAssignmentClient returns a client for the ScriptAssignment from the given config.
-func NewScriptAssignmentClient(c config) *ScriptAssignmentClient {
-	return &ScriptAssignmentClient{config: c}
+// NewClientLockClient returns a client for the ClientLock from the given config.
+func NewClientLockClient(c config) *ClientLockClient {
+	return &ClientLockClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `scriptassignment.Hooks(f(g(h())))`.
-func (c *ScriptAssignmentClient) Use(hooks ...Hook) {
-	c.hooks.ScriptAssignment = append(c.hooks.ScriptAssignment, hooks...)
+// A call to `Use(f, g, h)` equals to `clientlock.Hooks(f(g(h())))`.
+func (c *ClientLockClient) Use(hooks ...Hook) {
+	c.hooks.ClientLock = append(c.hooks.ClientLock, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `scriptassignment.Intercept(f(g(h())))`.
-func (c *ScriptAssignmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ScriptAssignment = append(c.inters.ScriptAssignment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `clientlock.Intercept(f(g(h())))`.
+func (c *ClientLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClientLock = append(c.inters.ClientLock, interceptors...)
 }
 
-// Create returns a builder for creating a ScriptAssignment entity.
-func (c *ScriptAssignmentClient) Create() *ScriptAssignmentCreate {
-	mutation := newScriptAssignmentMutation(c.config, OpCreate)
-	return &ScriptAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ClientLock entity.
+func (c *ClientLockClient) Create() *ClientLockCreate {
+	mutation := newClientLockMutation(c.config, OpCreate)
+	return &ClientLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ScriptAssignment entities.
-func (c *ScriptAssignmentClient) CreateBulk(builders ...*ScriptAssignmentCreate) *ScriptAssignmentCreateBulk {
-	return &ScriptAssignmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ClientLock entities.
+func (c *ClientLockClient) CreateBulk(builders ...*ClientLockCreate) *ClientLockCreateBulk {
+	return &ClientLockCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ScriptAssignmentClient) MapCreateBulk(slice any, setFunc func(*ScriptAssignmentCreate, int)) *ScriptAssignmentCreateBulk {
+func (c *ClientLockClient) MapCreateBulk(slice any, setFunc func(*ClientLockCreate, int)) *ClientLockCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ScriptAssignmentCreateBulk{err: fmt.Errorf("calling to ScriptAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ClientLockCreateBulk{err: fmt.Errorf("calling to ClientLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ScriptAssignmentCreate, rv.Len())
+	builders := make([]*ClientLockCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ScriptAssignmentCreateBulk{config: c.config, builders: builders}
+	return &ClientLockCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ScriptAssignment.
-func (c *ScriptAssignmentClient) Update() *ScriptAssignmentUpdate {
-	mutation := newScriptAssignmentMutation(c.config, OpUpdate)
-	return &ScriptAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ClientLock.
+func (c *ClientLockClient) Update() *ClientLockUpdate {
+	mutation := newClientLockMutation(c.config, OpUpdate)
+	return &ClientLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ScriptAssignmentClient) UpdateOne(_m *ScriptAssignment) *ScriptAssignmentUpdateOne {
-	mutation := newScriptAssignmentMutation(c.config, OpUpdateOne, withScriptAssignment(_m))
-	return &ScriptAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClientLockClient) UpdateOne(_m *ClientLock) *ClientLockUpdateOne {
+	mutation := newClientLockMutation(c.config, OpUpdateOne, withClientLock(_m))
+	return &ClientLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ScriptAssignmentClient) UpdateOneID(id string) *ScriptAssignmentUpdateOne {
-	mutation := newScriptAssignmentMutation(c.config, OpUpdateOne, withScriptAssignmentID(id))
-	return &ScriptAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClientLockClient) UpdateOneID(id string) *ClientLockUpdateOne {
+	mutation := newClientLockMutation(c.config, OpUpdateOne, withClientLockID(id))
+	return &ClientLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ScriptAssignment.
-func (c *ScriptAssignmentClient) Delete() *ScriptAssignmentDelete {
-	mutation := newScriptAssignmentMutation(c.config, OpDelete)
-	return &ScriptAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ClientLock.
+func (c *ClientLockClient) Delete() *ClientLockDelete {
+	mutation := newClientLockMutation(c.config, OpDelete)
+	return &ClientLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ScriptAssignmentClient) DeleteOne(_m *ScriptAssignment) *ScriptAssignmentDeleteOne {
+func (c *ClientLockClient) DeleteOne(_m *ClientLock) *ClientLockDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ScriptAssignmentClient) DeleteOneID(id string) *ScriptAssignmentDeleteOne {
-	builder := c.Delete().Where(scriptassignment.ID(id))
+func (c *ClientLockClient) DeleteOneID(id string) *ClientLockDeleteOne {
+	builder := c.Delete().Where(clientlock.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ScriptAssignmentDeleteOne{builder}
+	return &ClientLockDeleteOne{builder}
 }
 
-// Query returns a query builder for ScriptAssignment.
-func (c *ScriptAssignmentClient) Query() *ScriptAssignmentQuery {
-	return &ScriptAssignmentQuery{
+// Query returns a query builder for ClientLock.
+func (c *ClientLockClient) Query() *ClientLockQuery {
+	return &ClientLockQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeScriptAssignment},
+		ctx:    &QueryContext{Type: TypeClientLock},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ScriptAssignment entity by its id.
-func (c *ScriptAssignmentClient) Get(ctx context.Context, id string) (*ScriptAssignment, error) {
-	return c.Query().Where(scriptassignment.ID(id)).Only(ctx)
+// Get returns a ClientLock entity by its id.
+func (c *ClientLockClient) Get(ctx context.Context, id string) (*ClientLock, error) {
+	return c.Query().Where(clientlock.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ScriptAssignmentClient) GetX(ctx context.Context, id string) *ScriptAssignment {
+func (c *ClientLockClient) GetX(ctx context.Context, id string) *ClientLock {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -737,37 +899,2588 @@ func (c *ScriptAssignmentClient) GetX(ctx context.Context, id string) *ScriptAss
 }
 
 // Hooks returns the client hooks.
-func (c *ScriptAssignmentClient) Hooks() []Hook {
-	hooks := c.hooks.ScriptAssignment
-	return append(hooks[:len(hooks):len(hooks)], scriptassignment.Hooks[:]...)
+func (c *ClientLockClient) Hooks() []Hook {
+	return c.hooks.ClientLock
 }
 
 // Interceptors returns the client interceptors.
-func (c *ScriptAssignmentClient) Interceptors() []Interceptor {
-	return c.inters.ScriptAssignment
+func (c *ClientLockClient) Interceptors() []Interceptor {
+	return c.inters.ClientLock
 }
 
-func (c *ScriptAssignmentClient) mutate(ctx context.Context, m *ScriptAssignmentMutation) (Value, error) {
+func (c *ClientLockClient) mutate(ctx context.Context, m *ClientLockMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ScriptAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ScriptAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ScriptAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClientLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ScriptAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ClientLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ScriptAssignment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ClientLock mutation op: %q", m.Op())
+	}
+}
+
+// CommandClient is a client for the Command schema.
+type CommandClient struct {
+	config
+}
+
+// NewCommandClient returns a client for the Command from the given config.
+func NewCommandClient(c config) *CommandClient {
+	return &CommandClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `command.Hooks(f(g(h())))`.
+func (c *CommandClient) Use(hooks ...Hook) {
+	c.hooks.Command = append(c.hooks.Command, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `command.Intercept(f(g(h())))`.
+func (c *CommandClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Command = append(c.inters.Command, interceptors...)
+}
+
+// Create returns a builder for creating a Command entity.
+func (c *CommandClient) Create() *CommandCreate {
+	mutation := newCommandMutation(c.config, OpCreate)
+	return &CommandCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Command entities.
+func (c *CommandClient) CreateBulk(builders ...*CommandCreate) *CommandCreateBulk {
+	return &CommandCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommandClient) MapCreateBulk(slice any, setFunc func(*CommandCreate, int)) *CommandCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommandCreateBulk{err: fmt.Errorf("calling to CommandClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommandCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommandCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Command.
+func (c *CommandClient) Update() *CommandUpdate {
+	mutation := newCommandMutation(c.config, OpUpdate)
+	return &CommandUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommandClient) UpdateOne(_m *Command) *CommandUpdateOne {
+	mutation := newCommandMutation(c.config, OpUpdateOne, withCommand(_m))
+	return &CommandUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommandClient) UpdateOneID(id string) *CommandUpdateOne {
+	mutation := newCommandMutation(c.config, OpUpdateOne, withCommandID(id))
+	return &CommandUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Command.
+func (c *CommandClient) Delete() *CommandDelete {
+	mutation := newCommandMutation(c.config, OpDelete)
+	return &CommandDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommandClient) DeleteOne(_m *Command) *CommandDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommandClient) DeleteOneID(id string) *CommandDeleteOne {
+	builder := c.Delete().Where(command.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommandDeleteOne{builder}
+}
+
+// Query returns a query builder for Command.
+func (c *CommandClient) Query() *CommandQuery {
+	return &CommandQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommand},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Command entity by its id.
+func (c *CommandClient) Get(ctx context.Context, id string) (*Command, error) {
+	return c.Query().Where(command.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommandClient) GetX(ctx context.Context, id string) *Command {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommandClient) Hooks() []Hook {
+	hooks := c.hooks.Command
+	return append(hooks[:len(hooks):len(hooks)], command.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommandClient) Interceptors() []Interceptor {
+	return c.inters.Command
+}
+
+func (c *CommandClient) mutate(ctx context.Context, m *CommandMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommandCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommandUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommandUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommandDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Command mutation op: %q", m.Op())
+	}
+}
+
+// EventRuleClient is a client for the EventRule schema.
+type EventRuleClient struct {
+	config
+}
+
+// NewEventRuleClient returns a client for the EventRule from the given config.
+func NewEventRuleClient(c config) *EventRuleClient {
+	return &EventRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventrule.Hooks(f(g(h())))`.
+func (c *EventRuleClient) Use(hooks ...Hook) {
+	c.hooks.EventRule = append(c.hooks.EventRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventrule.Intercept(f(g(h())))`.
+func (c *EventRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventRule = append(c.inters.EventRule, interceptors...)
+}
+
+// Create returns a builder for creating a EventRule entity.
+func (c *EventRuleClient) Create() *EventRuleCreate {
+	mutation := newEventRuleMutation(c.config, OpCreate)
+	return &EventRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventRule entities.
+func (c *EventRuleClient) CreateBulk(builders ...*EventRuleCreate) *EventRuleCreateBulk {
+	return &EventRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventRuleClient) MapCreateBulk(slice any, setFunc func(*EventRuleCreate, int)) *EventRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventRuleCreateBulk{err: fmt.Errorf("calling to EventRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventRule.
+func (c *EventRuleClient) Update() *EventRuleUpdate {
+	mutation := newEventRuleMutation(c.config, OpUpdate)
+	return &EventRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventRuleClient) UpdateOne(_m *EventRule) *EventRuleUpdateOne {
+	mutation := newEventRuleMutation(c.config, OpUpdateOne, withEventRule(_m))
+	return &EventRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventRuleClient) UpdateOneID(id string) *EventRuleUpdateOne {
+	mutation := newEventRuleMutation(c.config, OpUpdateOne, withEventRuleID(id))
+	return &EventRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventRule.
+func (c *EventRuleClient) Delete() *EventRuleDelete {
+	mutation := newEventRuleMutation(c.config, OpDelete)
+	return &EventRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventRuleClient) DeleteOne(_m *EventRule) *EventRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventRuleClient) DeleteOneID(id string) *EventRuleDeleteOne {
+	builder := c.Delete().Where(eventrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for EventRule.
+func (c *EventRuleClient) Query() *EventRuleQuery {
+	return &EventRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventRule entity by its id.
+func (c *EventRuleClient) Get(ctx context.Context, id string) (*EventRule, error) {
+	return c.Query().Where(eventrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventRuleClient) GetX(ctx context.Context, id string) *EventRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventRuleClient) Hooks() []Hook {
+	hooks := c.hooks.EventRule
+	return append(hooks[:len(hooks):len(hooks)], eventrule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventRuleClient) Interceptors() []Interceptor {
+	return c.inters.EventRule
+}
+
+func (c *EventRuleClient) mutate(ctx context.Context, m *EventRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventRule mutation op: %q", m.Op())
+	}
+}
+
+// ExecutionLogClient is a client for the ExecutionLog schema.
+type ExecutionLogClient struct {
+	config
+}
+
+// NewExecutionLogClient returns a client for the ExecutionLog from the given config.
+func NewExecutionLogClient(c config) *ExecutionLogClient {
+	return &ExecutionLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `executionlog.Hooks(f(g(h())))`.
+func (c *ExecutionLogClient) Use(hooks ...Hook) {
+	c.hooks.ExecutionLog = append(c.hooks.ExecutionLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `executionlog.Intercept(f(g(h())))`.
+func (c *ExecutionLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExecutionLog = append(c.inters.ExecutionLog, interceptors...)
+}
+
+// Create returns a builder for creating a ExecutionLog entity.
+func (c *ExecutionLogClient) Create() *ExecutionLogCreate {
+	mutation := newExecutionLogMutation(c.config, OpCreate)
+	return &ExecutionLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExecutionLog entities.
+func (c *ExecutionLogClient) CreateBulk(builders ...*ExecutionLogCreate) *ExecutionLogCreateBulk {
+	return &ExecutionLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExecutionLogClient) MapCreateBulk(slice any, setFunc func(*ExecutionLogCreate, int)) *ExecutionLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExecutionLogCreateBulk{err: fmt.Errorf("calling to ExecutionLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExecutionLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExecutionLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExecutionLog.
+func (c *ExecutionLogClient) Update() *ExecutionLogUpdate {
+	mutation := newExecutionLogMutation(c.config, OpUpdate)
+	return &ExecutionLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExecutionLogClient) UpdateOne(_m *ExecutionLog) *ExecutionLogUpdateOne {
+	mutation := newExecutionLogMutation(c.config, OpUpdateOne, withExecutionLog(_m))
+	return &ExecutionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExecutionLogClient) UpdateOneID(id string) *ExecutionLogUpdateOne {
+	mutation := newExecutionLogMutation(c.config, OpUpdateOne, withExecutionLogID(id))
+	return &ExecutionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExecutionLog.
+func (c *ExecutionLogClient) Delete() *ExecutionLogDelete {
+	mutation := newExecutionLogMutation(c.config, OpDelete)
+	return &ExecutionLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExecutionLogClient) DeleteOne(_m *ExecutionLog) *ExecutionLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExecutionLogClient) DeleteOneID(id string) *ExecutionLogDeleteOne {
+	builder := c.Delete().Where(executionlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExecutionLogDeleteOne{builder}
+}
+
+// Query returns a query builder for ExecutionLog.
+func (c *ExecutionLogClient) Query() *ExecutionLogQuery {
+	return &ExecutionLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExecutionLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExecutionLog entity by its id.
+func (c *ExecutionLogClient) Get(ctx context.Context, id string) (*ExecutionLog, error) {
+	return c.Query().Where(executionlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExecutionLogClient) GetX(ctx context.Context, id string) *ExecutionLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExecutionLogClient) Hooks() []Hook {
+	hooks := c.hooks.ExecutionLog
+	return append(hooks[:len(hooks):len(hooks)], executionlog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExecutionLogClient) Interceptors() []Interceptor {
+	return c.inters.ExecutionLog
+}
+
+func (c *ExecutionLogClient) mutate(ctx context.Context, m *ExecutionLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExecutionLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExecutionLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExecutionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExecutionLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExecutionLog mutation op: %q", m.Op())
+	}
+}
+
+// ExecutionRunClient is a client for the ExecutionRun schema.
+type ExecutionRunClient struct {
+	config
+}
+
+// NewExecutionRunClient returns a client for the ExecutionRun from the given config.
+func NewExecutionRunClient(c config) *ExecutionRunClient {
+	return &ExecutionRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `executionrun.Hooks(f(g(h())))`.
+func (c *ExecutionRunClient) Use(hooks ...Hook) {
+	c.hooks.ExecutionRun = append(c.hooks.ExecutionRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `executionrun.Intercept(f(g(h())))`.
+func (c *ExecutionRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExecutionRun = append(c.inters.ExecutionRun, interceptors...)
+}
+
+// Create returns a builder for creating a ExecutionRun entity.
+func (c *ExecutionRunClient) Create() *ExecutionRunCreate {
+	mutation := newExecutionRunMutation(c.config, OpCreate)
+	return &ExecutionRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExecutionRun entities.
+func (c *ExecutionRunClient) CreateBulk(builders ...*ExecutionRunCreate) *ExecutionRunCreateBulk {
+	return &ExecutionRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExecutionRunClient) MapCreateBulk(slice any, setFunc func(*ExecutionRunCreate, int)) *ExecutionRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExecutionRunCreateBulk{err: fmt.Errorf("calling to ExecutionRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExecutionRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExecutionRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExecutionRun.
+func (c *ExecutionRunClient) Update() *ExecutionRunUpdate {
+	mutation := newExecutionRunMutation(c.config, OpUpdate)
+	return &ExecutionRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExecutionRunClient) UpdateOne(_m *ExecutionRun) *ExecutionRunUpdateOne {
+	mutation := newExecutionRunMutation(c.config, OpUpdateOne, withExecutionRun(_m))
+	return &ExecutionRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExecutionRunClient) UpdateOneID(id string) *ExecutionRunUpdateOne {
+	mutation := newExecutionRunMutation(c.config, OpUpdateOne, withExecutionRunID(id))
+	return &ExecutionRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExecutionRun.
+func (c *ExecutionRunClient) Delete() *ExecutionRunDelete {
+	mutation := newExecutionRunMutation(c.config, OpDelete)
+	return &ExecutionRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExecutionRunClient) DeleteOne(_m *ExecutionRun) *ExecutionRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExecutionRunClient) DeleteOneID(id string) *ExecutionRunDeleteOne {
+	builder := c.Delete().Where(executionrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExecutionRunDeleteOne{builder}
+}
+
+// Query returns a query builder for ExecutionRun.
+func (c *ExecutionRunClient) Query() *ExecutionRunQuery {
+	return &ExecutionRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExecutionRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExecutionRun entity by its id.
+func (c *ExecutionRunClient) Get(ctx context.Context, id string) (*ExecutionRun, error) {
+	return c.Query().Where(executionrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExecutionRunClient) GetX(ctx context.Context, id string) *ExecutionRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExecutionRunClient) Hooks() []Hook {
+	hooks := c.hooks.ExecutionRun
+	return append(hooks[:len(hooks):len(hooks)], executionrun.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExecutionRunClient) Interceptors() []Interceptor {
+	return c.inters.ExecutionRun
+}
+
+func (c *ExecutionRunClient) mutate(ctx context.Context, m *ExecutionRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExecutionRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExecutionRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExecutionRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExecutionRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExecutionRun mutation op: %q", m.Op())
+	}
+}
+
+// MaintenanceWindowClient is a client for the MaintenanceWindow schema.
+type MaintenanceWindowClient struct {
+	config
+}
+
+// NewMaintenanceWindowClient returns a client for the MaintenanceWindow from the given config.
+func NewMaintenanceWindowClient(c config) *MaintenanceWindowClient {
+	return &MaintenanceWindowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `maintenancewindow.Hooks(f(g(h())))`.
+func (c *MaintenanceWindowClient) Use(hooks ...Hook) {
+	c.hooks.MaintenanceWindow = append(c.hooks.MaintenanceWindow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `maintenancewindow.Intercept(f(g(h())))`.
+func (c *MaintenanceWindowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MaintenanceWindow = append(c.inters.MaintenanceWindow, interceptors...)
+}
+
+// Create returns a builder for creating a MaintenanceWindow entity.
+func (c *MaintenanceWindowClient) Create() *MaintenanceWindowCreate {
+	mutation := newMaintenanceWindowMutation(c.config, OpCreate)
+	return &MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MaintenanceWindow entities.
+func (c *MaintenanceWindowClient) CreateBulk(builders ...*MaintenanceWindowCreate) *MaintenanceWindowCreateBulk {
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MaintenanceWindowClient) MapCreateBulk(slice any, setFunc func(*MaintenanceWindowCreate, int)) *MaintenanceWindowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MaintenanceWindowCreateBulk{err: fmt.Errorf("calling to MaintenanceWindowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MaintenanceWindowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Update() *MaintenanceWindowUpdate {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdate)
+	return &MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MaintenanceWindowClient) UpdateOne(_m *MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindow(_m))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MaintenanceWindowClient) UpdateOneID(id string) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindowID(id))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Delete() *MaintenanceWindowDelete {
+	mutation := newMaintenanceWindowMutation(c.config, OpDelete)
+	return &MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MaintenanceWindowClient) DeleteOne(_m *MaintenanceWindow) *MaintenanceWindowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MaintenanceWindowClient) DeleteOneID(id string) *MaintenanceWindowDeleteOne {
+	builder := c.Delete().Where(maintenancewindow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MaintenanceWindowDeleteOne{builder}
+}
+
+// Query returns a query builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Query() *MaintenanceWindowQuery {
+	return &MaintenanceWindowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMaintenanceWindow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MaintenanceWindow entity by its id.
+func (c *MaintenanceWindowClient) Get(ctx context.Context, id string) (*MaintenanceWindow, error) {
+	return c.Query().Where(maintenancewindow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MaintenanceWindowClient) GetX(ctx context.Context, id string) *MaintenanceWindow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MaintenanceWindowClient) Hooks() []Hook {
+	hooks := c.hooks.MaintenanceWindow
+	return append(hooks[:len(hooks):len(hooks)], maintenancewindow.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MaintenanceWindowClient) Interceptors() []Interceptor {
+	return c.inters.MaintenanceWindow
+}
+
+func (c *MaintenanceWindowClient) mutate(ctx context.Context, m *MaintenanceWindowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MaintenanceWindow mutation op: %q", m.Op())
+	}
+}
+
+// ManagedClientClient is a client for the ManagedClient schema.
+type ManagedClientClient struct {
+	config
+}
+
+// NewManagedClientClient returns a client for the ManagedClient from the given config.
+func NewManagedClientClient(c config) *ManagedClientClient {
+	return &ManagedClientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `managedclient.Hooks(f(g(h())))`.
+func (c *ManagedClientClient) Use(hooks ...Hook) {
+	c.hooks.ManagedClient = append(c.hooks.ManagedClient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `managedclient.Intercept(f(g(h())))`.
+func (c *ManagedClientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ManagedClient = append(c.inters.ManagedClient, interceptors...)
+}
+
+// Create returns a builder for creating a ManagedClient entity.
+func (c *ManagedClientClient) Create() *ManagedClientCreate {
+	mutation := newManagedClientMutation(c.config, OpCreate)
+	return &ManagedClientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ManagedClient entities.
+func (c *ManagedClientClient) CreateBulk(builders ...*ManagedClientCreate) *ManagedClientCreateBulk {
+	return &ManagedClientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ManagedClientClient) MapCreateBulk(slice any, setFunc func(*ManagedClientCreate, int)) *ManagedClientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ManagedClientCreateBulk{err: fmt.Errorf("calling to ManagedClientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ManagedClientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ManagedClientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ManagedClient.
+func (c *ManagedClientClient) Update() *ManagedClientUpdate {
+	mutation := newManagedClientMutation(c.config, OpUpdate)
+	return &ManagedClientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ManagedClientClient) UpdateOne(_m *ManagedClient) *ManagedClientUpdateOne {
+	mutation := newManagedClientMutation(c.config, OpUpdateOne, withManagedClient(_m))
+	return &ManagedClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ManagedClientClient) UpdateOneID(id string) *ManagedClientUpdateOne {
+	mutation := newManagedClientMutation(c.config, OpUpdateOne, withManagedClientID(id))
+	return &ManagedClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ManagedClient.
+func (c *ManagedClientClient) Delete() *ManagedClientDelete {
+	mutation := newManagedClientMutation(c.config, OpDelete)
+	return &ManagedClientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ManagedClientClient) DeleteOne(_m *ManagedClient) *ManagedClientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ManagedClientClient) DeleteOneID(id string) *ManagedClientDeleteOne {
+	builder := c.Delete().Where(managedclient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ManagedClientDeleteOne{builder}
+}
+
+// Query returns a query builder for ManagedClient.
+func (c *ManagedClientClient) Query() *ManagedClientQuery {
+	return &ManagedClientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeManagedClient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ManagedClient entity by its id.
+func (c *ManagedClientClient) Get(ctx context.Context, id string) (*ManagedClient, error) {
+	return c.Query().Where(managedclient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ManagedClientClient) GetX(ctx context.Context, id string) *ManagedClient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ManagedClientClient) Hooks() []Hook {
+	return c.hooks.ManagedClient
+}
+
+// Interceptors returns the client interceptors.
+func (c *ManagedClientClient) Interceptors() []Interceptor {
+	return c.inters.ManagedClient
+}
+
+func (c *ManagedClientClient) mutate(ctx context.Context, m *ManagedClientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ManagedClientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ManagedClientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ManagedClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ManagedClientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ManagedClient mutation op: %q", m.Op())
+	}
+}
+
+// OutputChunkClient is a client for the OutputChunk schema.
+type OutputChunkClient struct {
+	config
+}
+
+// NewOutputChunkClient returns a client for the OutputChunk from the given config.
+func NewOutputChunkClient(c config) *OutputChunkClient {
+	return &OutputChunkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outputchunk.Hooks(f(g(h())))`.
+func (c *OutputChunkClient) Use(hooks ...Hook) {
+	c.hooks.OutputChunk = append(c.hooks.OutputChunk, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outputchunk.Intercept(f(g(h())))`.
+func (c *OutputChunkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutputChunk = append(c.inters.OutputChunk, interceptors...)
+}
+
+// Create returns a builder for creating a OutputChunk entity.
+func (c *OutputChunkClient) Create() *OutputChunkCreate {
+	mutation := newOutputChunkMutation(c.config, OpCreate)
+	return &OutputChunkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutputChunk entities.
+func (c *OutputChunkClient) CreateBulk(builders ...*OutputChunkCreate) *OutputChunkCreateBulk {
+	return &OutputChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutputChunkClient) MapCreateBulk(slice any, setFunc func(*OutputChunkCreate, int)) *OutputChunkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutputChunkCreateBulk{err: fmt.Errorf("calling to OutputChunkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutputChunkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutputChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutputChunk.
+func (c *OutputChunkClient) Update() *OutputChunkUpdate {
+	mutation := newOutputChunkMutation(c.config, OpUpdate)
+	return &OutputChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutputChunkClient) UpdateOne(_m *OutputChunk) *OutputChunkUpdateOne {
+	mutation := newOutputChunkMutation(c.config, OpUpdateOne, withOutputChunk(_m))
+	return &OutputChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutputChunkClient) UpdateOneID(id string) *OutputChunkUpdateOne {
+	mutation := newOutputChunkMutation(c.config, OpUpdateOne, withOutputChunkID(id))
+	return &OutputChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutputChunk.
+func (c *OutputChunkClient) Delete() *OutputChunkDelete {
+	mutation := newOutputChunkMutation(c.config, OpDelete)
+	return &OutputChunkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutputChunkClient) DeleteOne(_m *OutputChunk) *OutputChunkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutputChunkClient) DeleteOneID(id string) *OutputChunkDeleteOne {
+	builder := c.Delete().Where(outputchunk.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutputChunkDeleteOne{builder}
+}
+
+// Query returns a query builder for OutputChunk.
+func (c *OutputChunkClient) Query() *OutputChunkQuery {
+	return &OutputChunkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutputChunk},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutputChunk entity by its id.
+func (c *OutputChunkClient) Get(ctx context.Context, id string) (*OutputChunk, error) {
+	return c.Query().Where(outputchunk.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutputChunkClient) GetX(ctx context.Context, id string) *OutputChunk {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutputChunkClient) Hooks() []Hook {
+	hooks := c.hooks.OutputChunk
+	return append(hooks[:len(hooks):len(hooks)], outputchunk.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutputChunkClient) Interceptors() []Interceptor {
+	return c.inters.OutputChunk
+}
+
+func (c *OutputChunkClient) mutate(ctx context.Context, m *OutputChunkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutputChunkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutputChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutputChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutputChunkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutputChunk mutation op: %q", m.Op())
+	}
+}
+
+// ProtectedClientClient is a client for the ProtectedClient schema.
+type ProtectedClientClient struct {
+	config
+}
+
+// NewProtectedClientClient returns a client for the ProtectedClient from the given config.
+func NewProtectedClientClient(c config) *ProtectedClientClient {
+	return &ProtectedClientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `protectedclient.Hooks(f(g(h())))`.
+func (c *ProtectedClientClient) Use(hooks ...Hook) {
+	c.hooks.ProtectedClient = append(c.hooks.ProtectedClient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `protectedclient.Intercept(f(g(h())))`.
+func (c *ProtectedClientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProtectedClient = append(c.inters.ProtectedClient, interceptors...)
+}
+
+// Create returns a builder for creating a ProtectedClient entity.
+func (c *ProtectedClientClient) Create() *ProtectedClientCreate {
+	mutation := newProtectedClientMutation(c.config, OpCreate)
+	return &ProtectedClientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProtectedClient entities.
+func (c *ProtectedClientClient) CreateBulk(builders ...*ProtectedClientCreate) *ProtectedClientCreateBulk {
+	return &ProtectedClientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProtectedClientClient) MapCreateBulk(slice any, setFunc func(*ProtectedClientCreate, int)) *ProtectedClientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProtectedClientCreateBulk{err: fmt.Errorf("calling to ProtectedClientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProtectedClientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProtectedClientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProtectedClient.
+func (c *ProtectedClientClient) Update() *ProtectedClientUpdate {
+	mutation := newProtectedClientMutation(c.config, OpUpdate)
+	return &ProtectedClientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProtectedClientClient) UpdateOne(_m *ProtectedClient) *ProtectedClientUpdateOne {
+	mutation := newProtectedClientMutation(c.config, OpUpdateOne, withProtectedClient(_m))
+	return &ProtectedClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProtectedClientClient) UpdateOneID(id string) *ProtectedClientUpdateOne {
+	mutation := newProtectedClientMutation(c.config, OpUpdateOne, withProtectedClientID(id))
+	return &ProtectedClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProtectedClient.
+func (c *ProtectedClientClient) Delete() *ProtectedClientDelete {
+	mutation := newProtectedClientMutation(c.config, OpDelete)
+	return &ProtectedClientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProtectedClientClient) DeleteOne(_m *ProtectedClient) *ProtectedClientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProtectedClientClient) DeleteOneID(id string) *ProtectedClientDeleteOne {
+	builder := c.Delete().Where(protectedclient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProtectedClientDeleteOne{builder}
+}
+
+// Query returns a query builder for ProtectedClient.
+func (c *ProtectedClientClient) Query() *ProtectedClientQuery {
+	return &ProtectedClientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProtectedClient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProtectedClient entity by its id.
+func (c *ProtectedClientClient) Get(ctx context.Context, id string) (*ProtectedClient, error) {
+	return c.Query().Where(protectedclient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProtectedClientClient) GetX(ctx context.Context, id string) *ProtectedClient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProtectedClientClient) Hooks() []Hook {
+	hooks := c.hooks.ProtectedClient
+	return append(hooks[:len(hooks):len(hooks)], protectedclient.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProtectedClientClient) Interceptors() []Interceptor {
+	return c.inters.ProtectedClient
+}
+
+func (c *ProtectedClientClient) mutate(ctx context.Context, m *ProtectedClientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProtectedClientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProtectedClientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProtectedClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProtectedClientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProtectedClient mutation op: %q", m.Op())
+	}
+}
+
+// QueuedCommandClient is a client for the QueuedCommand schema.
+type QueuedCommandClient struct {
+	config
+}
+
+// NewQueuedCommandClient returns a client for the QueuedCommand from the given config.
+func NewQueuedCommandClient(c config) *QueuedCommandClient {
+	return &QueuedCommandClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `queuedcommand.Hooks(f(g(h())))`.
+func (c *QueuedCommandClient) Use(hooks ...Hook) {
+	c.hooks.QueuedCommand = append(c.hooks.QueuedCommand, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `queuedcommand.Intercept(f(g(h())))`.
+func (c *QueuedCommandClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QueuedCommand = append(c.inters.QueuedCommand, interceptors...)
+}
+
+// Create returns a builder for creating a QueuedCommand entity.
+func (c *QueuedCommandClient) Create() *QueuedCommandCreate {
+	mutation := newQueuedCommandMutation(c.config, OpCreate)
+	return &QueuedCommandCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QueuedCommand entities.
+func (c *QueuedCommandClient) CreateBulk(builders ...*QueuedCommandCreate) *QueuedCommandCreateBulk {
+	return &QueuedCommandCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QueuedCommandClient) MapCreateBulk(slice any, setFunc func(*QueuedCommandCreate, int)) *QueuedCommandCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QueuedCommandCreateBulk{err: fmt.Errorf("calling to QueuedCommandClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QueuedCommandCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QueuedCommandCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QueuedCommand.
+func (c *QueuedCommandClient) Update() *QueuedCommandUpdate {
+	mutation := newQueuedCommandMutation(c.config, OpUpdate)
+	return &QueuedCommandUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QueuedCommandClient) UpdateOne(_m *QueuedCommand) *QueuedCommandUpdateOne {
+	mutation := newQueuedCommandMutation(c.config, OpUpdateOne, withQueuedCommand(_m))
+	return &QueuedCommandUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QueuedCommandClient) UpdateOneID(id string) *QueuedCommandUpdateOne {
+	mutation := newQueuedCommandMutation(c.config, OpUpdateOne, withQueuedCommandID(id))
+	return &QueuedCommandUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QueuedCommand.
+func (c *QueuedCommandClient) Delete() *QueuedCommandDelete {
+	mutation := newQueuedCommandMutation(c.config, OpDelete)
+	return &QueuedCommandDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QueuedCommandClient) DeleteOne(_m *QueuedCommand) *QueuedCommandDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QueuedCommandClient) DeleteOneID(id string) *QueuedCommandDeleteOne {
+	builder := c.Delete().Where(queuedcommand.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QueuedCommandDeleteOne{builder}
+}
+
+// Query returns a query builder for QueuedCommand.
+func (c *QueuedCommandClient) Query() *QueuedCommandQuery {
+	return &QueuedCommandQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQueuedCommand},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QueuedCommand entity by its id.
+func (c *QueuedCommandClient) Get(ctx context.Context, id string) (*QueuedCommand, error) {
+	return c.Query().Where(queuedcommand.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QueuedCommandClient) GetX(ctx context.Context, id string) *QueuedCommand {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QueuedCommandClient) Hooks() []Hook {
+	hooks := c.hooks.QueuedCommand
+	return append(hooks[:len(hooks):len(hooks)], queuedcommand.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *QueuedCommandClient) Interceptors() []Interceptor {
+	return c.inters.QueuedCommand
+}
+
+func (c *QueuedCommandClient) mutate(ctx context.Context, m *QueuedCommandMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QueuedCommandCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QueuedCommandUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QueuedCommandUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QueuedCommandDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QueuedCommand mutation op: %q", m.Op())
+	}
+}
+
+// ScheduleClient is a client for the Schedule schema.
+type ScheduleClient struct {
+	config
+}
+
+// NewScheduleClient returns a client for the Schedule from the given config.
+func NewScheduleClient(c config) *ScheduleClient {
+	return &ScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schedule.Hooks(f(g(h())))`.
+func (c *ScheduleClient) Use(hooks ...Hook) {
+	c.hooks.Schedule = append(c.hooks.Schedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `schedule.Intercept(f(g(h())))`.
+func (c *ScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Schedule = append(c.inters.Schedule, interceptors...)
+}
+
+// Create returns a builder for creating a Schedule entity.
+func (c *ScheduleClient) Create() *ScheduleCreate {
+	mutation := newScheduleMutation(c.config, OpCreate)
+	return &ScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Schedule entities.
+func (c *ScheduleClient) CreateBulk(builders ...*ScheduleCreate) *ScheduleCreateBulk {
+	return &ScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduleClient) MapCreateBulk(slice any, setFunc func(*ScheduleCreate, int)) *ScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduleCreateBulk{err: fmt.Errorf("calling to ScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Schedule.
+func (c *ScheduleClient) Update() *ScheduleUpdate {
+	mutation := newScheduleMutation(c.config, OpUpdate)
+	return &ScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduleClient) UpdateOne(_m *Schedule) *ScheduleUpdateOne {
+	mutation := newScheduleMutation(c.config, OpUpdateOne, withSchedule(_m))
+	return &ScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduleClient) UpdateOneID(id string) *ScheduleUpdateOne {
+	mutation := newScheduleMutation(c.config, OpUpdateOne, withScheduleID(id))
+	return &ScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Schedule.
+func (c *ScheduleClient) Delete() *ScheduleDelete {
+	mutation := newScheduleMutation(c.config, OpDelete)
+	return &ScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduleClient) DeleteOne(_m *Schedule) *ScheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduleClient) DeleteOneID(id string) *ScheduleDeleteOne {
+	builder := c.Delete().Where(schedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for Schedule.
+func (c *ScheduleClient) Query() *ScheduleQuery {
+	return &ScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Schedule entity by its id.
+func (c *ScheduleClient) Get(ctx context.Context, id string) (*Schedule, error) {
+	return c.Query().Where(schedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduleClient) GetX(ctx context.Context, id string) *Schedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduleClient) Hooks() []Hook {
+	hooks := c.hooks.Schedule
+	return append(hooks[:len(hooks):len(hooks)], schedule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduleClient) Interceptors() []Interceptor {
+	return c.inters.Schedule
+}
+
+func (c *ScheduleClient) mutate(ctx context.Context, m *ScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Schedule mutation op: %q", m.Op())
+	}
+}
+
+// ScriptClient is a client for the Script schema.
+type ScriptClient struct {
+	config
+}
+
+// NewScriptClient returns a client for the Script from the given config.
+func NewScriptClient(c config) *ScriptClient {
+	return &ScriptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `script.Hooks(f(g(h())))`.
+func (c *ScriptClient) Use(hooks ...Hook) {
+	c.hooks.Script = append(c.hooks.Script, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `script.Intercept(f(g(h())))`.
+func (c *ScriptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Script = append(c.inters.Script, interceptors...)
+}
+
+// Create returns a builder for creating a Script entity.
+func (c *ScriptClient) Create() *ScriptCreate {
+	mutation := newScriptMutation(c.config, OpCreate)
+	return &ScriptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Script entities.
+func (c *ScriptClient) CreateBulk(builders ...*ScriptCreate) *ScriptCreateBulk {
+	return &ScriptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScriptClient) MapCreateBulk(slice any, setFunc func(*ScriptCreate, int)) *ScriptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScriptCreateBulk{err: fmt.Errorf("calling to ScriptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScriptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScriptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Script.
+func (c *ScriptClient) Update() *ScriptUpdate {
+	mutation := newScriptMutation(c.config, OpUpdate)
+	return &ScriptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScriptClient) UpdateOne(_m *Script) *ScriptUpdateOne {
+	mutation := newScriptMutation(c.config, OpUpdateOne, withScript(_m))
+	return &ScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScriptClient) UpdateOneID(id string) *ScriptUpdateOne {
+	mutation := newScriptMutation(c.config, OpUpdateOne, withScriptID(id))
+	return &ScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Script.
+func (c *ScriptClient) Delete() *ScriptDelete {
+	mutation := newScriptMutation(c.config, OpDelete)
+	return &ScriptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScriptClient) DeleteOne(_m *Script) *ScriptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScriptClient) DeleteOneID(id string) *ScriptDeleteOne {
+	builder := c.Delete().Where(script.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScriptDeleteOne{builder}
+}
+
+// Query returns a query builder for Script.
+func (c *ScriptClient) Query() *ScriptQuery {
+	return &ScriptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScript},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Script entity by its id.
+func (c *ScriptClient) Get(ctx context.Context, id string) (*Script, error) {
+	return c.Query().Where(script.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScriptClient) GetX(ctx context.Context, id string) *Script {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScriptClient) Hooks() []Hook {
+	hooks := c.hooks.Script
+	return append(hooks[:len(hooks):len(hooks)], script.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScriptClient) Interceptors() []Interceptor {
+	return c.inters.Script
+}
+
+func (c *ScriptClient) mutate(ctx context.Context, m *ScriptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScriptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScriptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScriptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Script mutation op: %q", m.Op())
+	}
+}
+
+// ScriptAssignmentClient is a client for the ScriptAssignment schema.
+type ScriptAssignmentClient struct {
+	config
+}
+
+// NewScriptAssignmentClient returns a client for the ScriptAssignment from the given config.
+func NewScriptAssignmentClient(c config) *ScriptAssignmentClient {
+	return &ScriptAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scriptassignment.Hooks(f(g(h())))`.
+func (c *ScriptAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.ScriptAssignment = append(c.hooks.ScriptAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scriptassignment.Intercept(f(g(h())))`.
+func (c *ScriptAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScriptAssignment = append(c.inters.ScriptAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a ScriptAssignment entity.
+func (c *ScriptAssignmentClient) Create() *ScriptAssignmentCreate {
+	mutation := newScriptAssignmentMutation(c.config, OpCreate)
+	return &ScriptAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScriptAssignment entities.
+func (c *ScriptAssignmentClient) CreateBulk(builders ...*ScriptAssignmentCreate) *ScriptAssignmentCreateBulk {
+	return &ScriptAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScriptAssignmentClient) MapCreateBulk(slice any, setFunc func(*ScriptAssignmentCreate, int)) *ScriptAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScriptAssignmentCreateBulk{err: fmt.Errorf("calling to ScriptAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScriptAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScriptAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScriptAssignment.
+func (c *ScriptAssignmentClient) Update() *ScriptAssignmentUpdate {
+	mutation := newScriptAssignmentMutation(c.config, OpUpdate)
+	return &ScriptAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScriptAssignmentClient) UpdateOne(_m *ScriptAssignment) *ScriptAssignmentUpdateOne {
+	mutation := newScriptAssignmentMutation(c.config, OpUpdateOne, withScriptAssignment(_m))
+	return &ScriptAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScriptAssignmentClient) UpdateOneID(id string) *ScriptAssignmentUpdateOne {
+	mutation := newScriptAssignmentMutation(c.config, OpUpdateOne, withScriptAssignmentID(id))
+	return &ScriptAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScriptAssignment.
+func (c *ScriptAssignmentClient) Delete() *ScriptAssignmentDelete {
+	mutation := newScriptAssignmentMutation(c.config, OpDelete)
+	return &ScriptAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScriptAssignmentClient) DeleteOne(_m *ScriptAssignment) *ScriptAssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScriptAssignmentClient) DeleteOneID(id string) *ScriptAssignmentDeleteOne {
+	builder := c.Delete().Where(scriptassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScriptAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for ScriptAssignment.
+func (c *ScriptAssignmentClient) Query() *ScriptAssignmentQuery {
+	return &ScriptAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScriptAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScriptAssignment entity by its id.
+func (c *ScriptAssignmentClient) Get(ctx context.Context, id string) (*ScriptAssignment, error) {
+	return c.Query().Where(scriptassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScriptAssignmentClient) GetX(ctx context.Context, id string) *ScriptAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScriptAssignmentClient) Hooks() []Hook {
+	hooks := c.hooks.ScriptAssignment
+	return append(hooks[:len(hooks):len(hooks)], scriptassignment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScriptAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.ScriptAssignment
+}
+
+func (c *ScriptAssignmentClient) mutate(ctx context.Context, m *ScriptAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScriptAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScriptAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScriptAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScriptAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScriptAssignment mutation op: %q", m.Op())
+	}
+}
+
+// ScriptChangeRequestClient is a client for the ScriptChangeRequest schema.
+type ScriptChangeRequestClient struct {
+	config
+}
+
+// NewScriptChangeRequestClient returns a client for the ScriptChangeRequest from the given config.
+func NewScriptChangeRequestClient(c config) *ScriptChangeRequestClient {
+	return &ScriptChangeRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scriptchangerequest.Hooks(f(g(h())))`.
+func (c *ScriptChangeRequestClient) Use(hooks ...Hook) {
+	c.hooks.ScriptChangeRequest = append(c.hooks.ScriptChangeRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scriptchangerequest.Intercept(f(g(h())))`.
+func (c *ScriptChangeRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScriptChangeRequest = append(c.inters.ScriptChangeRequest, interceptors...)
+}
+
+// Create returns a builder for creating a ScriptChangeRequest entity.
+func (c *ScriptChangeRequestClient) Create() *ScriptChangeRequestCreate {
+	mutation := newScriptChangeRequestMutation(c.config, OpCreate)
+	return &ScriptChangeRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScriptChangeRequest entities.
+func (c *ScriptChangeRequestClient) CreateBulk(builders ...*ScriptChangeRequestCreate) *ScriptChangeRequestCreateBulk {
+	return &ScriptChangeRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScriptChangeRequestClient) MapCreateBulk(slice any, setFunc func(*ScriptChangeRequestCreate, int)) *ScriptChangeRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScriptChangeRequestCreateBulk{err: fmt.Errorf("calling to ScriptChangeRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScriptChangeRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScriptChangeRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScriptChangeRequest.
+func (c *ScriptChangeRequestClient) Update() *ScriptChangeRequestUpdate {
+	mutation := newScriptChangeRequestMutation(c.config, OpUpdate)
+	return &ScriptChangeRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScriptChangeRequestClient) UpdateOne(_m *ScriptChangeRequest) *ScriptChangeRequestUpdateOne {
+	mutation := newScriptChangeRequestMutation(c.config, OpUpdateOne, withScriptChangeRequest(_m))
+	return &ScriptChangeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScriptChangeRequestClient) UpdateOneID(id string) *ScriptChangeRequestUpdateOne {
+	mutation := newScriptChangeRequestMutation(c.config, OpUpdateOne, withScriptChangeRequestID(id))
+	return &ScriptChangeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScriptChangeRequest.
+func (c *ScriptChangeRequestClient) Delete() *ScriptChangeRequestDelete {
+	mutation := newScriptChangeRequestMutation(c.config, OpDelete)
+	return &ScriptChangeRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScriptChangeRequestClient) DeleteOne(_m *ScriptChangeRequest) *ScriptChangeRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScriptChangeRequestClient) DeleteOneID(id string) *ScriptChangeRequestDeleteOne {
+	builder := c.Delete().Where(scriptchangerequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScriptChangeRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for ScriptChangeRequest.
+func (c *ScriptChangeRequestClient) Query() *ScriptChangeRequestQuery {
+	return &ScriptChangeRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScriptChangeRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScriptChangeRequest entity by its id.
+func (c *ScriptChangeRequestClient) Get(ctx context.Context, id string) (*ScriptChangeRequest, error) {
+	return c.Query().Where(scriptchangerequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScriptChangeRequestClient) GetX(ctx context.Context, id string) *ScriptChangeRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScriptChangeRequestClient) Hooks() []Hook {
+	hooks := c.hooks.ScriptChangeRequest
+	return append(hooks[:len(hooks):len(hooks)], scriptchangerequest.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScriptChangeRequestClient) Interceptors() []Interceptor {
+	return c.inters.ScriptChangeRequest
+}
+
+func (c *ScriptChangeRequestClient) mutate(ctx context.Context, m *ScriptChangeRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScriptChangeRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScriptChangeRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScriptChangeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScriptChangeRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScriptChangeRequest mutation op: %q", m.Op())
+	}
+}
+
+// ScriptVersionClient is a client for the ScriptVersion schema.
+type ScriptVersionClient struct {
+	config
+}
+
+// NewScriptVersionClient returns a client for the ScriptVersion from the given config.
+func NewScriptVersionClient(c config) *ScriptVersionClient {
+	return &ScriptVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scriptversion.Hooks(f(g(h())))`.
+func (c *ScriptVersionClient) Use(hooks ...Hook) {
+	c.hooks.ScriptVersion = append(c.hooks.ScriptVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scriptversion.Intercept(f(g(h())))`.
+func (c *ScriptVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScriptVersion = append(c.inters.ScriptVersion, interceptors...)
+}
+
+// Create returns a builder for creating a ScriptVersion entity.
+func (c *ScriptVersionClient) Create() *ScriptVersionCreate {
+	mutation := newScriptVersionMutation(c.config, OpCreate)
+	return &ScriptVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScriptVersion entities.
+func (c *ScriptVersionClient) CreateBulk(builders ...*ScriptVersionCreate) *ScriptVersionCreateBulk {
+	return &ScriptVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScriptVersionClient) MapCreateBulk(slice any, setFunc func(*ScriptVersionCreate, int)) *ScriptVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScriptVersionCreateBulk{err: fmt.Errorf("calling to ScriptVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScriptVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScriptVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScriptVersion.
+func (c *ScriptVersionClient) Update() *ScriptVersionUpdate {
+	mutation := newScriptVersionMutation(c.config, OpUpdate)
+	return &ScriptVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScriptVersionClient) UpdateOne(_m *ScriptVersion) *ScriptVersionUpdateOne {
+	mutation := newScriptVersionMutation(c.config, OpUpdateOne, withScriptVersion(_m))
+	return &ScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScriptVersionClient) UpdateOneID(id string) *ScriptVersionUpdateOne {
+	mutation := newScriptVersionMutation(c.config, OpUpdateOne, withScriptVersionID(id))
+	return &ScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScriptVersion.
+func (c *ScriptVersionClient) Delete() *ScriptVersionDelete {
+	mutation := newScriptVersionMutation(c.config, OpDelete)
+	return &ScriptVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScriptVersionClient) DeleteOne(_m *ScriptVersion) *ScriptVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScriptVersionClient) DeleteOneID(id string) *ScriptVersionDeleteOne {
+	builder := c.Delete().Where(scriptversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScriptVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for ScriptVersion.
+func (c *ScriptVersionClient) Query() *ScriptVersionQuery {
+	return &ScriptVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScriptVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScriptVersion entity by its id.
+func (c *ScriptVersionClient) Get(ctx context.Context, id string) (*ScriptVersion, error) {
+	return c.Query().Where(scriptversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScriptVersionClient) GetX(ctx context.Context, id string) *ScriptVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScriptVersionClient) Hooks() []Hook {
+	hooks := c.hooks.ScriptVersion
+	return append(hooks[:len(hooks):len(hooks)], scriptversion.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScriptVersionClient) Interceptors() []Interceptor {
+	return c.inters.ScriptVersion
+}
+
+func (c *ScriptVersionClient) mutate(ctx context.Context, m *ScriptVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScriptVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScriptVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScriptVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScriptVersion mutation op: %q", m.Op())
+	}
+}
+
+// SecretClient is a client for the Secret schema.
+type SecretClient struct {
+	config
+}
+
+// NewSecretClient returns a client for the Secret from the given config.
+func NewSecretClient(c config) *SecretClient {
+	return &SecretClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `secret.Hooks(f(g(h())))`.
+func (c *SecretClient) Use(hooks ...Hook) {
+	c.hooks.Secret = append(c.hooks.Secret, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `secret.Intercept(f(g(h())))`.
+func (c *SecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Secret = append(c.inters.Secret, interceptors...)
+}
+
+// Create returns a builder for creating a Secret entity.
+func (c *SecretClient) Create() *SecretCreate {
+	mutation := newSecretMutation(c.config, OpCreate)
+	return &SecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Secret entities.
+func (c *SecretClient) CreateBulk(builders ...*SecretCreate) *SecretCreateBulk {
+	return &SecretCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecretClient) MapCreateBulk(slice any, setFunc func(*SecretCreate, int)) *SecretCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecretCreateBulk{err: fmt.Errorf("calling to SecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecretCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecretCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Secret.
+func (c *SecretClient) Update() *SecretUpdate {
+	mutation := newSecretMutation(c.config, OpUpdate)
+	return &SecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecretClient) UpdateOne(_m *Secret) *SecretUpdateOne {
+	mutation := newSecretMutation(c.config, OpUpdateOne, withSecret(_m))
+	return &SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecretClient) UpdateOneID(id string) *SecretUpdateOne {
+	mutation := newSecretMutation(c.config, OpUpdateOne, withSecretID(id))
+	return &SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Secret.
+func (c *SecretClient) Delete() *SecretDelete {
+	mutation := newSecretMutation(c.config, OpDelete)
+	return &SecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecretClient) DeleteOne(_m *Secret) *SecretDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecretClient) DeleteOneID(id string) *SecretDeleteOne {
+	builder := c.Delete().Where(secret.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecretDeleteOne{builder}
+}
+
+// Query returns a query builder for Secret.
+func (c *SecretClient) Query() *SecretQuery {
+	return &SecretQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecret},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Secret entity by its id.
+func (c *SecretClient) Get(ctx context.Context, id string) (*Secret, error) {
+	return c.Query().Where(secret.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecretClient) GetX(ctx context.Context, id string) *Secret {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SecretClient) Hooks() []Hook {
+	hooks := c.hooks.Secret
+	return append(hooks[:len(hooks):len(hooks)], secret.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecretClient) Interceptors() []Interceptor {
+	return c.inters.Secret
+}
+
+func (c *SecretClient) mutate(ctx context.Context, m *SecretMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Secret mutation op: %q", m.Op())
+	}
+}
+
+// SigningKeyClient is a client for the SigningKey schema.
+type SigningKeyClient struct {
+	config
+}
+
+// NewSigningKeyClient returns a client for the SigningKey from the given config.
+func NewSigningKeyClient(c config) *SigningKeyClient {
+	return &SigningKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signingkey.Hooks(f(g(h())))`.
+func (c *SigningKeyClient) Use(hooks ...Hook) {
+	c.hooks.SigningKey = append(c.hooks.SigningKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signingkey.Intercept(f(g(h())))`.
+func (c *SigningKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SigningKey = append(c.inters.SigningKey, interceptors...)
+}
+
+// Create returns a builder for creating a SigningKey entity.
+func (c *SigningKeyClient) Create() *SigningKeyCreate {
+	mutation := newSigningKeyMutation(c.config, OpCreate)
+	return &SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SigningKey entities.
+func (c *SigningKeyClient) CreateBulk(builders ...*SigningKeyCreate) *SigningKeyCreateBulk {
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SigningKeyClient) MapCreateBulk(slice any, setFunc func(*SigningKeyCreate, int)) *SigningKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SigningKeyCreateBulk{err: fmt.Errorf("calling to SigningKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SigningKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SigningKey.
+func (c *SigningKeyClient) Update() *SigningKeyUpdate {
+	mutation := newSigningKeyMutation(c.config, OpUpdate)
+	return &SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SigningKeyClient) UpdateOne(_m *SigningKey) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKey(_m))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SigningKeyClient) UpdateOneID(id string) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKeyID(id))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SigningKey.
+func (c *SigningKeyClient) Delete() *SigningKeyDelete {
+	mutation := newSigningKeyMutation(c.config, OpDelete)
+	return &SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SigningKeyClient) DeleteOne(_m *SigningKey) *SigningKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SigningKeyClient) DeleteOneID(id string) *SigningKeyDeleteOne {
+	builder := c.Delete().Where(signingkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SigningKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for SigningKey.
+func (c *SigningKeyClient) Query() *SigningKeyQuery {
+	return &SigningKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSigningKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SigningKey entity by its id.
+func (c *SigningKeyClient) Get(ctx context.Context, id string) (*SigningKey, error) {
+	return c.Query().Where(signingkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SigningKeyClient) GetX(ctx context.Context, id string) *SigningKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SigningKeyClient) Hooks() []Hook {
+	return c.hooks.SigningKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *SigningKeyClient) Interceptors() []Interceptor {
+	return c.inters.SigningKey
+}
+
+func (c *SigningKeyClient) mutate(ctx context.Context, m *SigningKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SigningKey mutation op: %q", m.Op())
+	}
+}
+
+// TenantSettingClient is a client for the TenantSetting schema.
+type TenantSettingClient struct {
+	config
+}
+
+// NewTenantSettingClient returns a client for the TenantSetting from the given config.
+func NewTenantSettingClient(c config) *TenantSettingClient {
+	return &TenantSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantsetting.Hooks(f(g(h())))`.
+func (c *TenantSettingClient) Use(hooks ...Hook) {
+	c.hooks.TenantSetting = append(c.hooks.TenantSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantsetting.Intercept(f(g(h())))`.
+func (c *TenantSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantSetting = append(c.inters.TenantSetting, interceptors...)
+}
+
+// Create returns a builder for creating a TenantSetting entity.
+func (c *TenantSettingClient) Create() *TenantSettingCreate {
+	mutation := newTenantSettingMutation(c.config, OpCreate)
+	return &TenantSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantSetting entities.
+func (c *TenantSettingClient) CreateBulk(builders ...*TenantSettingCreate) *TenantSettingCreateBulk {
+	return &TenantSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantSettingClient) MapCreateBulk(slice any, setFunc func(*TenantSettingCreate, int)) *TenantSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantSettingCreateBulk{err: fmt.Errorf("calling to TenantSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantSetting.
+func (c *TenantSettingClient) Update() *TenantSettingUpdate {
+	mutation := newTenantSettingMutation(c.config, OpUpdate)
+	return &TenantSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantSettingClient) UpdateOne(_m *TenantSetting) *TenantSettingUpdateOne {
+	mutation := newTenantSettingMutation(c.config, OpUpdateOne, withTenantSetting(_m))
+	return &TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantSettingClient) UpdateOneID(id string) *TenantSettingUpdateOne {
+	mutation := newTenantSettingMutation(c.config, OpUpdateOne, withTenantSettingID(id))
+	return &TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantSetting.
+func (c *TenantSettingClient) Delete() *TenantSettingDelete {
+	mutation := newTenantSettingMutation(c.config, OpDelete)
+	return &TenantSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantSettingClient) DeleteOne(_m *TenantSetting) *TenantSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantSettingClient) DeleteOneID(id string) *TenantSettingDeleteOne {
+	builder := c.Delete().Where(tenantsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantSetting.
+func (c *TenantSettingClient) Query() *TenantSettingQuery {
+	return &TenantSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantSetting entity by its id.
+func (c *TenantSettingClient) Get(ctx context.Context, id string) (*TenantSetting, error) {
+	return c.Query().Where(tenantsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantSettingClient) GetX(ctx context.Context, id string) *TenantSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantSettingClient) Hooks() []Hook {
+	hooks := c.hooks.TenantSetting
+	return append(hooks[:len(hooks):len(hooks)], tenantsetting.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantSettingClient) Interceptors() []Interceptor {
+	return c.inters.TenantSetting
+}
+
+func (c *TenantSettingClient) mutate(ctx context.Context, m *TenantSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantSetting mutation op: %q", m.Op())
+	}
+}
+
+// WorkflowClient is a client for the Workflow schema.
+type WorkflowClient struct {
+	config
+}
+
+// NewWorkflowClient returns a client for the Workflow from the given config.
+func NewWorkflowClient(c config) *WorkflowClient {
+	return &WorkflowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workflow.Hooks(f(g(h())))`.
+func (c *WorkflowClient) Use(hooks ...Hook) {
+	c.hooks.Workflow = append(c.hooks.Workflow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workflow.Intercept(f(g(h())))`.
+func (c *WorkflowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Workflow = append(c.inters.Workflow, interceptors...)
+}
+
+// Create returns a builder for creating a Workflow entity.
+func (c *WorkflowClient) Create() *WorkflowCreate {
+	mutation := newWorkflowMutation(c.config, OpCreate)
+	return &WorkflowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Workflow entities.
+func (c *WorkflowClient) CreateBulk(builders ...*WorkflowCreate) *WorkflowCreateBulk {
+	return &WorkflowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkflowClient) MapCreateBulk(slice any, setFunc func(*WorkflowCreate, int)) *WorkflowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkflowCreateBulk{err: fmt.Errorf("calling to WorkflowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkflowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkflowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Workflow.
+func (c *WorkflowClient) Update() *WorkflowUpdate {
+	mutation := newWorkflowMutation(c.config, OpUpdate)
+	return &WorkflowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkflowClient) UpdateOne(_m *Workflow) *WorkflowUpdateOne {
+	mutation := newWorkflowMutation(c.config, OpUpdateOne, withWorkflow(_m))
+	return &WorkflowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkflowClient) UpdateOneID(id string) *WorkflowUpdateOne {
+	mutation := newWorkflowMutation(c.config, OpUpdateOne, withWorkflowID(id))
+	return &WorkflowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Workflow.
+func (c *WorkflowClient) Delete() *WorkflowDelete {
+	mutation := newWorkflowMutation(c.config, OpDelete)
+	return &WorkflowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkflowClient) DeleteOne(_m *Workflow) *WorkflowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkflowClient) DeleteOneID(id string) *WorkflowDeleteOne {
+	builder := c.Delete().Where(workflow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkflowDeleteOne{builder}
+}
+
+// Query returns a query builder for Workflow.
+func (c *WorkflowClient) Query() *WorkflowQuery {
+	return &WorkflowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkflow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Workflow entity by its id.
+func (c *WorkflowClient) Get(ctx context.Context, id string) (*Workflow, error) {
+	return c.Query().Where(workflow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkflowClient) GetX(ctx context.Context, id string) *Workflow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WorkflowClient) Hooks() []Hook {
+	hooks := c.hooks.Workflow
+	return append(hooks[:len(hooks):len(hooks)], workflow.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkflowClient) Interceptors() []Interceptor {
+	return c.inters.Workflow
+}
+
+func (c *WorkflowClient) mutate(ctx context.Context, m *WorkflowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkflowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkflowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkflowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkflowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Workflow mutation op: %q", m.Op())
+	}
+}
+
+// WorkflowRunClient is a client for the WorkflowRun schema.
+type WorkflowRunClient struct {
+	config
+}
+
+// NewWorkflowRunClient returns a client for the WorkflowRun from the given config.
+func NewWorkflowRunClient(c config) *WorkflowRunClient {
+	return &WorkflowRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workflowrun.Hooks(f(g(h())))`.
+func (c *WorkflowRunClient) Use(hooks ...Hook) {
+	c.hooks.WorkflowRun = append(c.hooks.WorkflowRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workflowrun.Intercept(f(g(h())))`.
+func (c *WorkflowRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkflowRun = append(c.inters.WorkflowRun, interceptors...)
+}
+
+// Create returns a builder for creating a WorkflowRun entity.
+func (c *WorkflowRunClient) Create() *WorkflowRunCreate {
+	mutation := newWorkflowRunMutation(c.config, OpCreate)
+	return &WorkflowRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkflowRun entities.
+func (c *WorkflowRunClient) CreateBulk(builders ...*WorkflowRunCreate) *WorkflowRunCreateBulk {
+	return &WorkflowRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkflowRunClient) MapCreateBulk(slice any, setFunc func(*WorkflowRunCreate, int)) *WorkflowRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkflowRunCreateBulk{err: fmt.Errorf("calling to WorkflowRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkflowRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkflowRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkflowRun.
+func (c *WorkflowRunClient) Update() *WorkflowRunUpdate {
+	mutation := newWorkflowRunMutation(c.config, OpUpdate)
+	return &WorkflowRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkflowRunClient) UpdateOne(_m *WorkflowRun) *WorkflowRunUpdateOne {
+	mutation := newWorkflowRunMutation(c.config, OpUpdateOne, withWorkflowRun(_m))
+	return &WorkflowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkflowRunClient) UpdateOneID(id string) *WorkflowRunUpdateOne {
+	mutation := newWorkflowRunMutation(c.config, OpUpdateOne, withWorkflowRunID(id))
+	return &WorkflowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkflowRun.
+func (c *WorkflowRunClient) Delete() *WorkflowRunDelete {
+	mutation := newWorkflowRunMutation(c.config, OpDelete)
+	return &WorkflowRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkflowRunClient) DeleteOne(_m *WorkflowRun) *WorkflowRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkflowRunClient) DeleteOneID(id string) *WorkflowRunDeleteOne {
+	builder := c.Delete().Where(workflowrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkflowRunDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkflowRun.
+func (c *WorkflowRunClient) Query() *WorkflowRunQuery {
+	return &WorkflowRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkflowRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkflowRun entity by its id.
+func (c *WorkflowRunClient) Get(ctx context.Context, id string) (*WorkflowRun, error) {
+	return c.Query().Where(workflowrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkflowRunClient) GetX(ctx context.Context, id string) *WorkflowRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WorkflowRunClient) Hooks() []Hook {
+	hooks := c.hooks.WorkflowRun
+	return append(hooks[:len(hooks):len(hooks)], workflowrun.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkflowRunClient) Interceptors() []Interceptor {
+	return c.inters.WorkflowRun
+}
+
+func (c *WorkflowRunClient) mutate(ctx context.Context, m *WorkflowRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkflowRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkflowRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkflowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkflowRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WorkflowRun mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditLog, ExecutionLog, Script, ScriptAssignment []ent.Hook
+		AuditLog, ClientGroup, ClientLabelSet, ClientLock, Command, EventRule,
+		ExecutionLog, ExecutionRun, MaintenanceWindow, ManagedClient, OutputChunk,
+		ProtectedClient, QueuedCommand, Schedule, Script, ScriptAssignment,
+		ScriptChangeRequest, ScriptVersion, Secret, SigningKey, TenantSetting,
+		Workflow, WorkflowRun []ent.Hook
 	}
 	inters struct {
-		AuditLog, ExecutionLog, Script, ScriptAssignment []ent.Interceptor
+		AuditLog, ClientGroup, ClientLabelSet, ClientLock, Command, EventRule,
+		ExecutionLog, ExecutionRun, MaintenanceWindow, ManagedClient, OutputChunk,
+		ProtectedClient, QueuedCommand, Schedule, Script, ScriptAssignment,
+		ScriptChangeRequest, ScriptVersion, Secret, SigningKey, TenantSetting,
+		Workflow, WorkflowRun []ent.Interceptor
 	}
 )
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientgroup"
+)
+
+// ClientGroup is the model entity for the ClientGroup schema.
+type ClientGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Group name
+	Name string `json:"name,omitempty"`
+	// Group description
+	Description string `json:"description,omitempty"`
+	// Label selector matched against client labels
+	Selector string `json:"selector,omitempty"`
+	// Static member CNs
+	ClientIds    []string `json:"client_ids,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clientgroup.FieldClientIds:
+			values[i] = new([]byte)
+		case clientgroup.FieldCreateBy, clientgroup.FieldUpdateBy, clientgroup.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case clientgroup.FieldID, clientgroup.FieldName, clientgroup.FieldDescription, clientgroup.FieldSelector:
+			values[i] = new(sql.NullString)
+		case clientgroup.FieldCreateTime, clientgroup.FieldUpdateTime, clientgroup.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientGroup fields.
+func (_m *ClientGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clientgroup.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case clientgroup.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case clientgroup.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case clientgroup.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case clientgroup.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case clientgroup.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case clientgroup.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case clientgroup.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case clientgroup.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case clientgroup.FieldSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selector", values[i])
+			} else if value.Valid {
+				_m.Selector = value.String
+			}
+		case clientgroup.FieldClientIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field client_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClientIds); err != nil {
+					return fmt.Errorf("unmarshal field client_ids: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClientGroup.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClientGroup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClientGroup.
+// Note that you need to call ClientGroup.Unwrap() before calling this method if this ClientGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClientGroup) Update() *ClientGroupUpdateOne {
+	return NewClientGroupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClientGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClientGroup) Unwrap() *ClientGroup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClientGroup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClientGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("selector=")
+	builder.WriteString(_m.Selector)
+	builder.WriteString(", ")
+	builder.WriteString("client_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientIds))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientGroups is a parsable slice of ClientGroup.
+type ClientGroups []*ClientGroup
@@ -0,0 +1,650 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clientgroup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldDescription, v))
+}
+
+// Selector applies equality check predicate on the "selector" field. It's identical to SelectorEQ.
+func Selector(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldSelector, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SelectorEQ applies the EQ predicate on the "selector" field.
+func SelectorEQ(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEQ(FieldSelector, v))
+}
+
+// SelectorNEQ applies the NEQ predicate on the "selector" field.
+func SelectorNEQ(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNEQ(FieldSelector, v))
+}
+
+// SelectorIn applies the In predicate on the "selector" field.
+func SelectorIn(vs ...string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIn(FieldSelector, vs...))
+}
+
+// SelectorNotIn applies the NotIn predicate on the "selector" field.
+func SelectorNotIn(vs ...string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotIn(FieldSelector, vs...))
+}
+
+// SelectorGT applies the GT predicate on the "selector" field.
+func SelectorGT(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGT(FieldSelector, v))
+}
+
+// SelectorGTE applies the GTE predicate on the "selector" field.
+func SelectorGTE(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldGTE(FieldSelector, v))
+}
+
+// SelectorLT applies the LT predicate on the "selector" field.
+func SelectorLT(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLT(FieldSelector, v))
+}
+
+// SelectorLTE applies the LTE predicate on the "selector" field.
+func SelectorLTE(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldLTE(FieldSelector, v))
+}
+
+// SelectorContains applies the Contains predicate on the "selector" field.
+func SelectorContains(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldContains(FieldSelector, v))
+}
+
+// SelectorHasPrefix applies the HasPrefix predicate on the "selector" field.
+func SelectorHasPrefix(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldHasPrefix(FieldSelector, v))
+}
+
+// SelectorHasSuffix applies the HasSuffix predicate on the "selector" field.
+func SelectorHasSuffix(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldHasSuffix(FieldSelector, v))
+}
+
+// SelectorIsNil applies the IsNil predicate on the "selector" field.
+func SelectorIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldSelector))
+}
+
+// SelectorNotNil applies the NotNil predicate on the "selector" field.
+func SelectorNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldSelector))
+}
+
+// SelectorEqualFold applies the EqualFold predicate on the "selector" field.
+func SelectorEqualFold(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldEqualFold(FieldSelector, v))
+}
+
+// SelectorContainsFold applies the ContainsFold predicate on the "selector" field.
+func SelectorContainsFold(v string) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldContainsFold(FieldSelector, v))
+}
+
+// ClientIdsIsNil applies the IsNil predicate on the "client_ids" field.
+func ClientIdsIsNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldIsNull(FieldClientIds))
+}
+
+// ClientIdsNotNil applies the NotNil predicate on the "client_ids" field.
+func ClientIdsNotNil() predicate.ClientGroup {
+	return predicate.ClientGroup(sql.FieldNotNull(FieldClientIds))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClientGroup) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClientGroup) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClientGroup) predicate.ClientGroup {
+	return predicate.ClientGroup(sql.NotPredicates(p))
+}
@@ -0,0 +1,1149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientgroup"
+)
+
+// ClientGroupCreate is the builder for creating a ClientGroup entity.
+type ClientGroupCreate struct {
+	config
+	mutation *ClientGroupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ClientGroupCreate) SetCreateBy(v uint32) *ClientGroupCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ClientGroupCreate) SetNillableCreateBy(v *uint32) *ClientGroupCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *ClientGroupCreate) SetUpdateBy(v uint32) *ClientGroupCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *ClientGroupCreate) SetNillableUpdateBy(v *uint32) *ClientGroupCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ClientGroupCreate) SetCreateTime(v time.Time) *ClientGroupCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ClientGroupCreate) SetNillableCreateTime(v *time.Time) *ClientGroupCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ClientGroupCreate) SetUpdateTime(v time.Time) *ClientGroupCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ClientGroupCreate) SetNillableUpdateTime(v *time.Time) *ClientGroupCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ClientGroupCreate) SetDeleteTime(v time.Time) *ClientGroupCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ClientGroupCreate) SetNillableDeleteTime(v *time.Time) *ClientGroupCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ClientGroupCreate) SetTenantID(v uint32) *ClientGroupCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ClientGroupCreate) SetNillableTenantID(v *uint32) *ClientGroupCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ClientGroupCreate) SetName(v string) *ClientGroupCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ClientGroupCreate) SetDescription(v string) *ClientGroupCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ClientGroupCreate) SetNillableDescription(v *string) *ClientGroupCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSelector sets the "selector" field.
+func (_c *ClientGroupCreate) SetSelector(v string) *ClientGroupCreate {
+	_c.mutation.SetSelector(v)
+	return _c
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_c *ClientGroupCreate) SetNillableSelector(v *string) *ClientGroupCreate {
+	if v != nil {
+		_c.SetSelector(*v)
+	}
+	return _c
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_c *ClientGroupCreate) SetClientIds(v []string) *ClientGroupCreate {
+	_c.mutation.SetClientIds(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ClientGroupCreate) SetID(v string) *ClientGroupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ClientGroupMutation object of the builder.
+func (_c *ClientGroupCreate) Mutation() *ClientGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the ClientGroup in the database.
+func (_c *ClientGroupCreate) Save(ctx context.Context) (*ClientGroup, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClientGroupCreate) SaveX(ctx context.Context) *ClientGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClientGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClientGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClientGroupCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := clientgroup.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClientGroupCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ClientGroup.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := clientgroup.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := clientgroup.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.description": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Selector(); ok {
+		if err := clientgroup.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.selector": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := clientgroup.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ClientGroupCreate) sqlSave(ctx context.Context) (*ClientGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ClientGroup.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClientGroupCreate) createSpec() (*ClientGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClientGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(clientgroup.Table, sqlgraph.NewFieldSpec(clientgroup.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(clientgroup.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(clientgroup.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(clientgroup.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(clientgroup.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(clientgroup.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(clientgroup.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(clientgroup.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(clientgroup.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Selector(); ok {
+		_spec.SetField(clientgroup.FieldSelector, field.TypeString, value)
+		_node.Selector = value
+	}
+	if value, ok := _c.mutation.ClientIds(); ok {
+		_spec.SetField(clientgroup.FieldClientIds, field.TypeJSON, value)
+		_node.ClientIds = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClientGroup.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClientGroupUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ClientGroupCreate) OnConflict(opts ...sql.ConflictOption) *ClientGroupUpsertOne {
+	_c.conflict = opts
+	return &ClientGroupUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClientGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ClientGroupCreate) OnConflictColumns(columns ...string) *ClientGroupUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ClientGroupUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ClientGroupUpsertOne is the builder for "upsert"-ing
+	//  one ClientGroup node.
+	ClientGroupUpsertOne struct {
+		create *ClientGroupCreate
+	}
+
+	// ClientGroupUpsert is the "OnConflict" setter.
+	ClientGroupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ClientGroupUpsert) SetCreateBy(v uint32) *ClientGroupUpsert {
+	u.Set(clientgroup.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ClientGroupUpsert) UpdateCreateBy() *ClientGroupUpsert {
+	u.SetExcluded(clientgroup.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ClientGroupUpsert) AddCreateBy(v uint32) *ClientGroupUpsert {
+	u.Add(clientgroup.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ClientGroupUpsert) ClearCreateBy() *ClientGroupUpsert {
+	u.SetNull(clientgroup.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ClientGroupUpsert) SetUpdateBy(v uint32) *ClientGroupUpsert {
+	u.Set(clientgroup.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ClientGroupUpsert) UpdateUpdateBy() *ClientGroupUpsert {
+	u.SetExcluded(clientgroup.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ClientGroupUpsert) AddUpdateBy(v uint32) *ClientGroupUpsert {
+	u.Add(clientgroup.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ClientGroupUpsert) ClearUpdateBy() *ClientGroupUpsert {
+	u.SetNull(clientgroup.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ClientGroupUpsert) SetUpdateTime(v time.Time) *ClientGroupUpsert {
+	u.Set(clientgroup.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ClientGroupUpsert) UpdateUpdateTime() *ClientGroupUpsert {
+	u.SetExcluded(clientgroup.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ClientGroupUpsert) ClearUpdateTime() *ClientGroupUpsert {
+	u.SetNull(clientgroup.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ClientGroupUpsert) SetDeleteTime(v time.Time) *ClientGroupUpsert {
+	u.Set(clientgroup.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ClientGroupUpsert) UpdateDeleteTime() *ClientGroupUpsert {
+	u.SetExcluded(clientgroup.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ClientGroupUpsert) ClearDeleteTime() *ClientGroupUpsert {
+	u.SetNull(clientgroup.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ClientGroupUpsert) SetName(v string) *ClientGroupUpsert {
+	u.Set(clientgroup.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ClientGroupUpsert) UpdateName() *ClientGroupUpsert {
+	u.SetExcluded(clientgroup.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ClientGroupUpsert) SetDescription(v string) *ClientGroupUpsert {
+	u.Set(clientgroup.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ClientGroupUpsert) UpdateDescription() *ClientGroupUpsert {
+	u.SetExcluded(clientgroup.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ClientGroupUpsert) ClearDescription() *ClientGroupUpsert {
+	u.SetNull(clientgroup.FieldDescription)
+	return u
+}
+
+// SetSelector sets the "selector" field.
+func (u *ClientGroupUpsert) SetSelector(v string) *ClientGroupUpsert {
+	u.Set(clientgroup.FieldSelector, v)
+	return u
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ClientGroupUpsert) UpdateSelector() *ClientGroupUpsert {
+	u.SetExcluded(clientgroup.FieldSelector)
+	return u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ClientGroupUpsert) ClearSelector() *ClientGroupUpsert {
+	u.SetNull(clientgroup.FieldSelector)
+	return u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ClientGroupUpsert) SetClientIds(v []string) *ClientGroupUpsert {
+	u.Set(clientgroup.FieldClientIds, v)
+	return u
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ClientGroupUpsert) UpdateClientIds() *ClientGroupUpsert {
+	u.SetExcluded(clientgroup.FieldClientIds)
+	return u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ClientGroupUpsert) ClearClientIds() *ClientGroupUpsert {
+	u.SetNull(clientgroup.FieldClientIds)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ClientGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(clientgroup.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ClientGroupUpsertOne) UpdateNewValues() *ClientGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(clientgroup.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(clientgroup.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(clientgroup.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClientGroup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ClientGroupUpsertOne) Ignore() *ClientGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClientGroupUpsertOne) DoNothing() *ClientGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClientGroupCreate.OnConflict
+// documentation for more info.
+func (u *ClientGroupUpsertOne) Update(set func(*ClientGroupUpsert)) *ClientGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClientGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ClientGroupUpsertOne) SetCreateBy(v uint32) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ClientGroupUpsertOne) AddCreateBy(v uint32) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ClientGroupUpsertOne) UpdateCreateBy() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ClientGroupUpsertOne) ClearCreateBy() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ClientGroupUpsertOne) SetUpdateBy(v uint32) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ClientGroupUpsertOne) AddUpdateBy(v uint32) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ClientGroupUpsertOne) UpdateUpdateBy() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ClientGroupUpsertOne) ClearUpdateBy() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ClientGroupUpsertOne) SetUpdateTime(v time.Time) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ClientGroupUpsertOne) UpdateUpdateTime() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ClientGroupUpsertOne) ClearUpdateTime() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ClientGroupUpsertOne) SetDeleteTime(v time.Time) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ClientGroupUpsertOne) UpdateDeleteTime() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ClientGroupUpsertOne) ClearDeleteTime() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ClientGroupUpsertOne) SetName(v string) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ClientGroupUpsertOne) UpdateName() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ClientGroupUpsertOne) SetDescription(v string) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ClientGroupUpsertOne) UpdateDescription() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ClientGroupUpsertOne) ClearDescription() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *ClientGroupUpsertOne) SetSelector(v string) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ClientGroupUpsertOne) UpdateSelector() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ClientGroupUpsertOne) ClearSelector() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ClientGroupUpsertOne) SetClientIds(v []string) *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetClientIds(v)
+	})
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ClientGroupUpsertOne) UpdateClientIds() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateClientIds()
+	})
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ClientGroupUpsertOne) ClearClientIds() *ClientGroupUpsertOne {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearClientIds()
+	})
+}
+
+// Exec executes the query.
+func (u *ClientGroupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClientGroupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClientGroupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ClientGroupUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ClientGroupUpsertOne.ID is not supported by MySQL driver. Use ClientGroupUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ClientGroupUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ClientGroupCreateBulk is the builder for creating many ClientGroup entities in bulk.
+type ClientGroupCreateBulk struct {
+	config
+	err      error
+	builders []*ClientGroupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ClientGroup entities in the database.
+func (_c *ClientGroupCreateBulk) Save(ctx context.Context) ([]*ClientGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClientGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClientGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClientGroupCreateBulk) SaveX(ctx context.Context) []*ClientGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClientGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClientGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClientGroup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClientGroupUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ClientGroupCreateBulk) OnConflict(opts ...sql.ConflictOption) *ClientGroupUpsertBulk {
+	_c.conflict = opts
+	return &ClientGroupUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClientGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ClientGroupCreateBulk) OnConflictColumns(columns ...string) *ClientGroupUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ClientGroupUpsertBulk{
+		create: _c,
+	}
+}
+
+// ClientGroupUpsertBulk is the builder for "upsert"-ing
+// a bulk of ClientGroup nodes.
+type ClientGroupUpsertBulk struct {
+	create *ClientGroupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ClientGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(clientgroup.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ClientGroupUpsertBulk) UpdateNewValues() *ClientGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(clientgroup.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(clientgroup.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(clientgroup.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClientGroup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ClientGroupUpsertBulk) Ignore() *ClientGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClientGroupUpsertBulk) DoNothing() *ClientGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClientGroupCreateBulk.OnConflict
+// documentation for more info.
+func (u *ClientGroupUpsertBulk) Update(set func(*ClientGroupUpsert)) *ClientGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClientGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ClientGroupUpsertBulk) SetCreateBy(v uint32) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ClientGroupUpsertBulk) AddCreateBy(v uint32) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ClientGroupUpsertBulk) UpdateCreateBy() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ClientGroupUpsertBulk) ClearCreateBy() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ClientGroupUpsertBulk) SetUpdateBy(v uint32) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ClientGroupUpsertBulk) AddUpdateBy(v uint32) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ClientGroupUpsertBulk) UpdateUpdateBy() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ClientGroupUpsertBulk) ClearUpdateBy() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ClientGroupUpsertBulk) SetUpdateTime(v time.Time) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ClientGroupUpsertBulk) UpdateUpdateTime() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ClientGroupUpsertBulk) ClearUpdateTime() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ClientGroupUpsertBulk) SetDeleteTime(v time.Time) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ClientGroupUpsertBulk) UpdateDeleteTime() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ClientGroupUpsertBulk) ClearDeleteTime() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ClientGroupUpsertBulk) SetName(v string) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ClientGroupUpsertBulk) UpdateName() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ClientGroupUpsertBulk) SetDescription(v string) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ClientGroupUpsertBulk) UpdateDescription() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ClientGroupUpsertBulk) ClearDescription() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *ClientGroupUpsertBulk) SetSelector(v string) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ClientGroupUpsertBulk) UpdateSelector() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ClientGroupUpsertBulk) ClearSelector() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ClientGroupUpsertBulk) SetClientIds(v []string) *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.SetClientIds(v)
+	})
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ClientGroupUpsertBulk) UpdateClientIds() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.UpdateClientIds()
+	})
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ClientGroupUpsertBulk) ClearClientIds() *ClientGroupUpsertBulk {
+	return u.Update(func(s *ClientGroupUpsert) {
+		s.ClearClientIds()
+	})
+}
+
+// Exec executes the query.
+func (u *ClientGroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ClientGroupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClientGroupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClientGroupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
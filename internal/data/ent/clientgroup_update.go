@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientgroup"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ClientGroupUpdate is the builder for updating ClientGroup entities.
+type ClientGroupUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ClientGroupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ClientGroupUpdate builder.
+func (_u *ClientGroupUpdate) Where(ps ...predicate.ClientGroup) *ClientGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ClientGroupUpdate) SetCreateBy(v uint32) *ClientGroupUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ClientGroupUpdate) SetNillableCreateBy(v *uint32) *ClientGroupUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ClientGroupUpdate) AddCreateBy(v int32) *ClientGroupUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ClientGroupUpdate) ClearCreateBy() *ClientGroupUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ClientGroupUpdate) SetUpdateBy(v uint32) *ClientGroupUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ClientGroupUpdate) SetNillableUpdateBy(v *uint32) *ClientGroupUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ClientGroupUpdate) AddUpdateBy(v int32) *ClientGroupUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ClientGroupUpdate) ClearUpdateBy() *ClientGroupUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ClientGroupUpdate) SetUpdateTime(v time.Time) *ClientGroupUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ClientGroupUpdate) SetNillableUpdateTime(v *time.Time) *ClientGroupUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ClientGroupUpdate) ClearUpdateTime() *ClientGroupUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ClientGroupUpdate) SetDeleteTime(v time.Time) *ClientGroupUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ClientGroupUpdate) SetNillableDeleteTime(v *time.Time) *ClientGroupUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ClientGroupUpdate) ClearDeleteTime() *ClientGroupUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ClientGroupUpdate) SetName(v string) *ClientGroupUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ClientGroupUpdate) SetNillableName(v *string) *ClientGroupUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ClientGroupUpdate) SetDescription(v string) *ClientGroupUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ClientGroupUpdate) SetNillableDescription(v *string) *ClientGroupUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ClientGroupUpdate) ClearDescription() *ClientGroupUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *ClientGroupUpdate) SetSelector(v string) *ClientGroupUpdate {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *ClientGroupUpdate) SetNillableSelector(v *string) *ClientGroupUpdate {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *ClientGroupUpdate) ClearSelector() *ClientGroupUpdate {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *ClientGroupUpdate) SetClientIds(v []string) *ClientGroupUpdate {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *ClientGroupUpdate) AppendClientIds(v []string) *ClientGroupUpdate {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *ClientGroupUpdate) ClearClientIds() *ClientGroupUpdate {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// Mutation returns the ClientGroupMutation object of the builder.
+func (_u *ClientGroupUpdate) Mutation() *ClientGroupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClientGroupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClientGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClientGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClientGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClientGroupUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := clientgroup.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := clientgroup.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := clientgroup.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.selector": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ClientGroupUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ClientGroupUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ClientGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientgroup.Table, clientgroup.Columns, sqlgraph.NewFieldSpec(clientgroup.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(clientgroup.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(clientgroup.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(clientgroup.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(clientgroup.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(clientgroup.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(clientgroup.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(clientgroup.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(clientgroup.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(clientgroup.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(clientgroup.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(clientgroup.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(clientgroup.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(clientgroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(clientgroup.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(clientgroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(clientgroup.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(clientgroup.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(clientgroup.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clientgroup.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(clientgroup.FieldClientIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientgroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClientGroupUpdateOne is the builder for updating a single ClientGroup entity.
+type ClientGroupUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ClientGroupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ClientGroupUpdateOne) SetCreateBy(v uint32) *ClientGroupUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ClientGroupUpdateOne) SetNillableCreateBy(v *uint32) *ClientGroupUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ClientGroupUpdateOne) AddCreateBy(v int32) *ClientGroupUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ClientGroupUpdateOne) ClearCreateBy() *ClientGroupUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ClientGroupUpdateOne) SetUpdateBy(v uint32) *ClientGroupUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ClientGroupUpdateOne) SetNillableUpdateBy(v *uint32) *ClientGroupUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ClientGroupUpdateOne) AddUpdateBy(v int32) *ClientGroupUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ClientGroupUpdateOne) ClearUpdateBy() *ClientGroupUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ClientGroupUpdateOne) SetUpdateTime(v time.Time) *ClientGroupUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ClientGroupUpdateOne) SetNillableUpdateTime(v *time.Time) *ClientGroupUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ClientGroupUpdateOne) ClearUpdateTime() *ClientGroupUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ClientGroupUpdateOne) SetDeleteTime(v time.Time) *ClientGroupUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ClientGroupUpdateOne) SetNillableDeleteTime(v *time.Time) *ClientGroupUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ClientGroupUpdateOne) ClearDeleteTime() *ClientGroupUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ClientGroupUpdateOne) SetName(v string) *ClientGroupUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ClientGroupUpdateOne) SetNillableName(v *string) *ClientGroupUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ClientGroupUpdateOne) SetDescription(v string) *ClientGroupUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ClientGroupUpdateOne) SetNillableDescription(v *string) *ClientGroupUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ClientGroupUpdateOne) ClearDescription() *ClientGroupUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *ClientGroupUpdateOne) SetSelector(v string) *ClientGroupUpdateOne {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *ClientGroupUpdateOne) SetNillableSelector(v *string) *ClientGroupUpdateOne {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *ClientGroupUpdateOne) ClearSelector() *ClientGroupUpdateOne {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *ClientGroupUpdateOne) SetClientIds(v []string) *ClientGroupUpdateOne {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *ClientGroupUpdateOne) AppendClientIds(v []string) *ClientGroupUpdateOne {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *ClientGroupUpdateOne) ClearClientIds() *ClientGroupUpdateOne {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// Mutation returns the ClientGroupMutation object of the builder.
+func (_u *ClientGroupUpdateOne) Mutation() *ClientGroupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ClientGroupUpdate builder.
+func (_u *ClientGroupUpdateOne) Where(ps ...predicate.ClientGroup) *ClientGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClientGroupUpdateOne) Select(field string, fields ...string) *ClientGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClientGroup entity.
+func (_u *ClientGroupUpdateOne) Save(ctx context.Context) (*ClientGroup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClientGroupUpdateOne) SaveX(ctx context.Context) *ClientGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClientGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClientGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClientGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := clientgroup.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := clientgroup.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := clientgroup.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ClientGroup.selector": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ClientGroupUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ClientGroupUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ClientGroupUpdateOne) sqlSave(ctx context.Context) (_node *ClientGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientgroup.Table, clientgroup.Columns, sqlgraph.NewFieldSpec(clientgroup.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClientGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientgroup.FieldID)
+		for _, f := range fields {
+			if !clientgroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != clientgroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(clientgroup.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(clientgroup.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(clientgroup.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(clientgroup.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(clientgroup.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(clientgroup.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(clientgroup.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(clientgroup.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(clientgroup.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(clientgroup.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(clientgroup.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(clientgroup.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(clientgroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(clientgroup.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(clientgroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(clientgroup.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(clientgroup.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(clientgroup.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clientgroup.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(clientgroup.FieldClientIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ClientGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientgroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlabelset"
+)
+
+// ClientLabelSet is the model entity for the ClientLabelSet schema.
+type ClientLabelSet struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// mTLS client CN
+	ClientID string `json:"client_id,omitempty"`
+	// Free-form labels set by operators of the tenant
+	Labels       map[string]string `json:"labels,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientLabelSet) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clientlabelset.FieldLabels:
+			values[i] = new([]byte)
+		case clientlabelset.FieldUpdateBy, clientlabelset.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case clientlabelset.FieldID, clientlabelset.FieldClientID:
+			values[i] = new(sql.NullString)
+		case clientlabelset.FieldCreateTime, clientlabelset.FieldUpdateTime, clientlabelset.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientLabelSet fields.
+func (_m *ClientLabelSet) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clientlabelset.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case clientlabelset.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case clientlabelset.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case clientlabelset.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case clientlabelset.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case clientlabelset.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case clientlabelset.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case clientlabelset.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClientLabelSet.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClientLabelSet) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClientLabelSet.
+// Note that you need to call ClientLabelSet.Unwrap() before calling this method if this ClientLabelSet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClientLabelSet) Update() *ClientLabelSetUpdateOne {
+	return NewClientLabelSetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClientLabelSet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClientLabelSet) Unwrap() *ClientLabelSet {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClientLabelSet is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClientLabelSet) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientLabelSet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Labels))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientLabelSets is a parsable slice of ClientLabelSet.
+type ClientLabelSets []*ClientLabelSet
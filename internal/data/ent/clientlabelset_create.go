@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlabelset"
+)
+
+// ClientLabelSetCreate is the builder for creating a ClientLabelSet entity.
+type ClientLabelSetCreate struct {
+	config
+	mutation *ClientLabelSetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *ClientLabelSetCreate) SetUpdateBy(v uint32) *ClientLabelSetCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *ClientLabelSetCreate) SetNillableUpdateBy(v *uint32) *ClientLabelSetCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ClientLabelSetCreate) SetCreateTime(v time.Time) *ClientLabelSetCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ClientLabelSetCreate) SetNillableCreateTime(v *time.Time) *ClientLabelSetCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ClientLabelSetCreate) SetUpdateTime(v time.Time) *ClientLabelSetCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ClientLabelSetCreate) SetNillableUpdateTime(v *time.Time) *ClientLabelSetCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ClientLabelSetCreate) SetDeleteTime(v time.Time) *ClientLabelSetCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ClientLabelSetCreate) SetNillableDeleteTime(v *time.Time) *ClientLabelSetCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ClientLabelSetCreate) SetTenantID(v uint32) *ClientLabelSetCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ClientLabelSetCreate) SetNillableTenantID(v *uint32) *ClientLabelSetCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *ClientLabelSetCreate) SetClientID(v string) *ClientLabelSetCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetLabels sets the "labels" field.
+func (_c *ClientLabelSetCreate) SetLabels(v map[string]string) *ClientLabelSetCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ClientLabelSetCreate) SetID(v string) *ClientLabelSetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ClientLabelSetMutation object of the builder.
+func (_c *ClientLabelSetCreate) Mutation() *ClientLabelSetMutation {
+	return _c.mutation
+}
+
+// Save creates the ClientLabelSet in the database.
+func (_c *ClientLabelSetCreate) Save(ctx context.Context) (*ClientLabelSet, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClientLabelSetCreate) SaveX(ctx context.Context) *ClientLabelSet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClientLabelSetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClientLabelSetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClientLabelSetCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := clientlabelset.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClientLabelSetCreate) check() error {
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "ClientLabelSet.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := clientlabelset.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ClientLabelSet.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Labels(); !ok {
+		return &ValidationError{Name: "labels", err: errors.New(`ent: missing required field "ClientLabelSet.labels"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := clientlabelset.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ClientLabelSet.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ClientLabelSetCreate) sqlSave(ctx context.Context) (*ClientLabelSet, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ClientLabelSet.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClientLabelSetCreate) createSpec() (*ClientLabelSet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClientLabelSet{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(clientlabelset.Table, sqlgraph.NewFieldSpec(clientlabelset.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(clientlabelset.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(clientlabelset.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(clientlabelset.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(clientlabelset.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(clientlabelset.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(clientlabelset.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(clientlabelset.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClientLabelSet.Create().
+//		SetUpdateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClientLabelSetUpsert) {
+//			SetUpdateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ClientLabelSetCreate) OnConflict(opts ...sql.ConflictOption) *ClientLabelSetUpsertOne {
+	_c.conflict = opts
+	return &ClientLabelSetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClientLabelSet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ClientLabelSetCreate) OnConflictColumns(columns ...string) *ClientLabelSetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ClientLabelSetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ClientLabelSetUpsertOne is the builder for "upsert"-ing
+	//  one ClientLabelSet node.
+	ClientLabelSetUpsertOne struct {
+		create *ClientLabelSetCreate
+	}
+
+	// ClientLabelSetUpsert is the "OnConflict" setter.
+	ClientLabelSetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ClientLabelSetUpsert) SetUpdateBy(v uint32) *ClientLabelSetUpsert {
+	u.Set(clientlabelset.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ClientLabelSetUpsert) UpdateUpdateBy() *ClientLabelSetUpsert {
+	u.SetExcluded(clientlabelset.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ClientLabelSetUpsert) AddUpdateBy(v uint32) *ClientLabelSetUpsert {
+	u.Add(clientlabelset.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ClientLabelSetUpsert) ClearUpdateBy() *ClientLabelSetUpsert {
+	u.SetNull(clientlabelset.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ClientLabelSetUpsert) SetUpdateTime(v time.Time) *ClientLabelSetUpsert {
+	u.Set(clientlabelset.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ClientLabelSetUpsert) UpdateUpdateTime() *ClientLabelSetUpsert {
+	u.SetExcluded(clientlabelset.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ClientLabelSetUpsert) ClearUpdateTime() *ClientLabelSetUpsert {
+	u.SetNull(clientlabelset.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ClientLabelSetUpsert) SetDeleteTime(v time.Time) *ClientLabelSetUpsert {
+	u.Set(clientlabelset.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ClientLabelSetUpsert) UpdateDeleteTime() *ClientLabelSetUpsert {
+	u.SetExcluded(clientlabelset.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ClientLabelSetUpsert) ClearDeleteTime() *ClientLabelSetUpsert {
+	u.SetNull(clientlabelset.FieldDeleteTime)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ClientLabelSetUpsert) SetClientID(v string) *ClientLabelSetUpsert {
+	u.Set(clientlabelset.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ClientLabelSetUpsert) UpdateClientID() *ClientLabelSetUpsert {
+	u.SetExcluded(clientlabelset.FieldClientID)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *ClientLabelSetUpsert) SetLabels(v map[string]string) *ClientLabelSetUpsert {
+	u.Set(clientlabelset.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ClientLabelSetUpsert) UpdateLabels() *ClientLabelSetUpsert {
+	u.SetExcluded(clientlabelset.FieldLabels)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ClientLabelSet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(clientlabelset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ClientLabelSetUpsertOne) UpdateNewValues() *ClientLabelSetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(clientlabelset.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(clientlabelset.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(clientlabelset.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClientLabelSet.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ClientLabelSetUpsertOne) Ignore() *ClientLabelSetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClientLabelSetUpsertOne) DoNothing() *ClientLabelSetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClientLabelSetCreate.OnConflict
+// documentation for more info.
+func (u *ClientLabelSetUpsertOne) Update(set func(*ClientLabelSetUpsert)) *ClientLabelSetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClientLabelSetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ClientLabelSetUpsertOne) SetUpdateBy(v uint32) *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ClientLabelSetUpsertOne) AddUpdateBy(v uint32) *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertOne) UpdateUpdateBy() *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ClientLabelSetUpsertOne) ClearUpdateBy() *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ClientLabelSetUpsertOne) SetUpdateTime(v time.Time) *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertOne) UpdateUpdateTime() *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ClientLabelSetUpsertOne) ClearUpdateTime() *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ClientLabelSetUpsertOne) SetDeleteTime(v time.Time) *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertOne) UpdateDeleteTime() *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ClientLabelSetUpsertOne) ClearDeleteTime() *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ClientLabelSetUpsertOne) SetClientID(v string) *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertOne) UpdateClientID() *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *ClientLabelSetUpsertOne) SetLabels(v map[string]string) *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertOne) UpdateLabels() *ClientLabelSetUpsertOne {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// Exec executes the query.
+func (u *ClientLabelSetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClientLabelSetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClientLabelSetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ClientLabelSetUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ClientLabelSetUpsertOne.ID is not supported by MySQL driver. Use ClientLabelSetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ClientLabelSetUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ClientLabelSetCreateBulk is the builder for creating many ClientLabelSet entities in bulk.
+type ClientLabelSetCreateBulk struct {
+	config
+	err      error
+	builders []*ClientLabelSetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ClientLabelSet entities in the database.
+func (_c *ClientLabelSetCreateBulk) Save(ctx context.Context) ([]*ClientLabelSet, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClientLabelSet, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClientLabelSetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClientLabelSetCreateBulk) SaveX(ctx context.Context) []*ClientLabelSet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClientLabelSetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClientLabelSetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClientLabelSet.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClientLabelSetUpsert) {
+//			SetUpdateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ClientLabelSetCreateBulk) OnConflict(opts ...sql.ConflictOption) *ClientLabelSetUpsertBulk {
+	_c.conflict = opts
+	return &ClientLabelSetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClientLabelSet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ClientLabelSetCreateBulk) OnConflictColumns(columns ...string) *ClientLabelSetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ClientLabelSetUpsertBulk{
+		create: _c,
+	}
+}
+
+// ClientLabelSetUpsertBulk is the builder for "upsert"-ing
+// a bulk of ClientLabelSet nodes.
+type ClientLabelSetUpsertBulk struct {
+	create *ClientLabelSetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ClientLabelSet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(clientlabelset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ClientLabelSetUpsertBulk) UpdateNewValues() *ClientLabelSetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(clientlabelset.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(clientlabelset.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(clientlabelset.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClientLabelSet.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ClientLabelSetUpsertBulk) Ignore() *ClientLabelSetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClientLabelSetUpsertBulk) DoNothing() *ClientLabelSetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClientLabelSetCreateBulk.OnConflict
+// documentation for more info.
+func (u *ClientLabelSetUpsertBulk) Update(set func(*ClientLabelSetUpsert)) *ClientLabelSetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClientLabelSetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ClientLabelSetUpsertBulk) SetUpdateBy(v uint32) *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ClientLabelSetUpsertBulk) AddUpdateBy(v uint32) *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertBulk) UpdateUpdateBy() *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ClientLabelSetUpsertBulk) ClearUpdateBy() *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ClientLabelSetUpsertBulk) SetUpdateTime(v time.Time) *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertBulk) UpdateUpdateTime() *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ClientLabelSetUpsertBulk) ClearUpdateTime() *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ClientLabelSetUpsertBulk) SetDeleteTime(v time.Time) *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertBulk) UpdateDeleteTime() *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ClientLabelSetUpsertBulk) ClearDeleteTime() *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ClientLabelSetUpsertBulk) SetClientID(v string) *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertBulk) UpdateClientID() *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *ClientLabelSetUpsertBulk) SetLabels(v map[string]string) *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ClientLabelSetUpsertBulk) UpdateLabels() *ClientLabelSetUpsertBulk {
+	return u.Update(func(s *ClientLabelSetUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// Exec executes the query.
+func (u *ClientLabelSetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ClientLabelSetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClientLabelSetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClientLabelSetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
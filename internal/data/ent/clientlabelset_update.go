@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlabelset"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ClientLabelSetUpdate is the builder for updating ClientLabelSet entities.
+type ClientLabelSetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ClientLabelSetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ClientLabelSetUpdate builder.
+func (_u *ClientLabelSetUpdate) Where(ps ...predicate.ClientLabelSet) *ClientLabelSetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ClientLabelSetUpdate) SetUpdateBy(v uint32) *ClientLabelSetUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ClientLabelSetUpdate) SetNillableUpdateBy(v *uint32) *ClientLabelSetUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ClientLabelSetUpdate) AddUpdateBy(v int32) *ClientLabelSetUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ClientLabelSetUpdate) ClearUpdateBy() *ClientLabelSetUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ClientLabelSetUpdate) SetUpdateTime(v time.Time) *ClientLabelSetUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ClientLabelSetUpdate) SetNillableUpdateTime(v *time.Time) *ClientLabelSetUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ClientLabelSetUpdate) ClearUpdateTime() *ClientLabelSetUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ClientLabelSetUpdate) SetDeleteTime(v time.Time) *ClientLabelSetUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ClientLabelSetUpdate) SetNillableDeleteTime(v *time.Time) *ClientLabelSetUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ClientLabelSetUpdate) ClearDeleteTime() *ClientLabelSetUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *ClientLabelSetUpdate) SetClientID(v string) *ClientLabelSetUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *ClientLabelSetUpdate) SetNillableClientID(v *string) *ClientLabelSetUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *ClientLabelSetUpdate) SetLabels(v map[string]string) *ClientLabelSetUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// Mutation returns the ClientLabelSetMutation object of the builder.
+func (_u *ClientLabelSetUpdate) Mutation() *ClientLabelSetMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClientLabelSetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClientLabelSetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClientLabelSetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClientLabelSetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClientLabelSetUpdate) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := clientlabelset.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ClientLabelSet.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ClientLabelSetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ClientLabelSetUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ClientLabelSetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientlabelset.Table, clientlabelset.Columns, sqlgraph.NewFieldSpec(clientlabelset.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(clientlabelset.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(clientlabelset.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(clientlabelset.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(clientlabelset.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(clientlabelset.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(clientlabelset.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(clientlabelset.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(clientlabelset.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(clientlabelset.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(clientlabelset.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(clientlabelset.FieldLabels, field.TypeJSON, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientlabelset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClientLabelSetUpdateOne is the builder for updating a single ClientLabelSet entity.
+type ClientLabelSetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ClientLabelSetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ClientLabelSetUpdateOne) SetUpdateBy(v uint32) *ClientLabelSetUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ClientLabelSetUpdateOne) SetNillableUpdateBy(v *uint32) *ClientLabelSetUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ClientLabelSetUpdateOne) AddUpdateBy(v int32) *ClientLabelSetUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ClientLabelSetUpdateOne) ClearUpdateBy() *ClientLabelSetUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ClientLabelSetUpdateOne) SetUpdateTime(v time.Time) *ClientLabelSetUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ClientLabelSetUpdateOne) SetNillableUpdateTime(v *time.Time) *ClientLabelSetUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ClientLabelSetUpdateOne) ClearUpdateTime() *ClientLabelSetUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ClientLabelSetUpdateOne) SetDeleteTime(v time.Time) *ClientLabelSetUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ClientLabelSetUpdateOne) SetNillableDeleteTime(v *time.Time) *ClientLabelSetUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ClientLabelSetUpdateOne) ClearDeleteTime() *ClientLabelSetUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *ClientLabelSetUpdateOne) SetClientID(v string) *ClientLabelSetUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *ClientLabelSetUpdateOne) SetNillableClientID(v *string) *ClientLabelSetUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *ClientLabelSetUpdateOne) SetLabels(v map[string]string) *ClientLabelSetUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// Mutation returns the ClientLabelSetMutation object of the builder.
+func (_u *ClientLabelSetUpdateOne) Mutation() *ClientLabelSetMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ClientLabelSetUpdate builder.
+func (_u *ClientLabelSetUpdateOne) Where(ps ...predicate.ClientLabelSet) *ClientLabelSetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClientLabelSetUpdateOne) Select(field string, fields ...string) *ClientLabelSetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClientLabelSet entity.
+func (_u *ClientLabelSetUpdateOne) Save(ctx context.Context) (*ClientLabelSet, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClientLabelSetUpdateOne) SaveX(ctx context.Context) *ClientLabelSet {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClientLabelSetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClientLabelSetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClientLabelSetUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := clientlabelset.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ClientLabelSet.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ClientLabelSetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ClientLabelSetUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ClientLabelSetUpdateOne) sqlSave(ctx context.Context) (_node *ClientLabelSet, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientlabelset.Table, clientlabelset.Columns, sqlgraph.NewFieldSpec(clientlabelset.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClientLabelSet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientlabelset.FieldID)
+		for _, f := range fields {
+			if !clientlabelset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != clientlabelset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(clientlabelset.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(clientlabelset.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(clientlabelset.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(clientlabelset.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(clientlabelset.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(clientlabelset.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(clientlabelset.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(clientlabelset.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(clientlabelset.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(clientlabelset.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(clientlabelset.FieldLabels, field.TypeJSON, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ClientLabelSet{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientlabelset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlock"
+)
+
+// ClientLock is the model entity for the ClientLock schema.
+type ClientLock struct {
+	config
+	// ID of the ent.
+	// mTLS client CN
+	ID           string `json:"id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientLock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clientlock.FieldID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientLock fields.
+func (_m *ClientLock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clientlock.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClientLock.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClientLock) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClientLock.
+// Note that you need to call ClientLock.Unwrap() before calling this method if this ClientLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClientLock) Update() *ClientLockUpdateOne {
+	return NewClientLockClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClientLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClientLock) Unwrap() *ClientLock {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClientLock is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClientLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientLock(")
+	builder.WriteString(fmt.Sprintf("id=%v", _m.ID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientLocks is a parsable slice of ClientLock.
+type ClientLocks []*ClientLock
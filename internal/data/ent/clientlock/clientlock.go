@@ -0,0 +1,44 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clientlock
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the clientlock type in the database.
+	Label = "client_lock"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// Table holds the table name of the clientlock in the database.
+	Table = "executor_client_locks"
+)
+
+// Columns holds all SQL columns for clientlock fields.
+var Columns = []string{
+	FieldID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ClientLock queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
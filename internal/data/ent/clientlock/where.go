@@ -0,0 +1,78 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clientlock
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ClientLock {
+	return predicate.ClientLock(sql.FieldContainsFold(FieldID, id))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClientLock) predicate.ClientLock {
+	return predicate.ClientLock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClientLock) predicate.ClientLock {
+	return predicate.ClientLock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClientLock) predicate.ClientLock {
+	return predicate.ClientLock(sql.NotPredicates(p))
+}
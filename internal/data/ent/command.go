@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+)
+
+// Command is the model entity for the Command schema.
+type Command struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Command ID (UUID primary key)
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// FK to executor_execution_logs, empty for non-execution commands
+	ExecutionID string `json:"execution_id,omitempty"`
+	// mTLS client CN the command is addressed to
+	ClientID string `json:"client_id,omitempty"`
+	// Type of the command
+	CommandType command.CommandType `json:"command_type,omitempty"`
+	// Delivery status of the command
+	Status command.Status `json:"status,omitempty"`
+	// Why the client rejected the command
+	RejectionReason string `json:"rejection_reason,omitempty"`
+	// When the command was written to the client stream
+	SentAt *time.Time `json:"sent_at,omitempty"`
+	// When the client accepted or rejected the command
+	AckedAt *time.Time `json:"acked_at,omitempty"`
+	// When the command expired before delivery
+	ExpiredAt    *time.Time `json:"expired_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Command) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case command.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case command.FieldID, command.FieldExecutionID, command.FieldClientID, command.FieldCommandType, command.FieldStatus, command.FieldRejectionReason:
+			values[i] = new(sql.NullString)
+		case command.FieldCreateTime, command.FieldUpdateTime, command.FieldDeleteTime, command.FieldSentAt, command.FieldAckedAt, command.FieldExpiredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Command fields.
+func (_m *Command) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case command.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case command.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case command.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case command.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case command.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case command.FieldExecutionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field execution_id", values[i])
+			} else if value.Valid {
+				_m.ExecutionID = value.String
+			}
+		case command.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case command.FieldCommandType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field command_type", values[i])
+			} else if value.Valid {
+				_m.CommandType = command.CommandType(value.String)
+			}
+		case command.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = command.Status(value.String)
+			}
+		case command.FieldRejectionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reason", values[i])
+			} else if value.Valid {
+				_m.RejectionReason = value.String
+			}
+		case command.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = new(time.Time)
+				*_m.SentAt = value.Time
+			}
+		case command.FieldAckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acked_at", values[i])
+			} else if value.Valid {
+				_m.AckedAt = new(time.Time)
+				*_m.AckedAt = value.Time
+			}
+		case command.FieldExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
+			} else if value.Valid {
+				_m.ExpiredAt = new(time.Time)
+				*_m.ExpiredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Command.
+// This includes values selected through modifiers, order, etc.
+func (_m *Command) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Command.
+// Note that you need to call Command.Unwrap() before calling this method if this Command
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Command) Update() *CommandUpdateOne {
+	return NewCommandClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Command entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Command) Unwrap() *Command {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Command is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Command) String() string {
+	var builder strings.Builder
+	builder.WriteString("Command(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("execution_id=")
+	builder.WriteString(_m.ExecutionID)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("command_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CommandType))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("rejection_reason=")
+	builder.WriteString(_m.RejectionReason)
+	builder.WriteString(", ")
+	if v := _m.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AckedAt; v != nil {
+		builder.WriteString("acked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiredAt; v != nil {
+		builder.WriteString("expired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Commands is a parsable slice of Command.
+type Commands []*Command
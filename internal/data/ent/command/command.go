@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package command
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the command type in the database.
+	Label = "command"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldExecutionID holds the string denoting the execution_id field in the database.
+	FieldExecutionID = "execution_id"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldCommandType holds the string denoting the command_type field in the database.
+	FieldCommandType = "command_type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRejectionReason holds the string denoting the rejection_reason field in the database.
+	FieldRejectionReason = "rejection_reason"
+	// FieldSentAt holds the string denoting the sent_at field in the database.
+	FieldSentAt = "sent_at"
+	// FieldAckedAt holds the string denoting the acked_at field in the database.
+	FieldAckedAt = "acked_at"
+	// FieldExpiredAt holds the string denoting the expired_at field in the database.
+	FieldExpiredAt = "expired_at"
+	// Table holds the table name of the command in the database.
+	Table = "executor_commands"
+)
+
+// Columns holds all SQL columns for command fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldExecutionID,
+	FieldClientID,
+	FieldCommandType,
+	FieldStatus,
+	FieldRejectionReason,
+	FieldSentAt,
+	FieldAckedAt,
+	FieldExpiredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-executor/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// ExecutionIDValidator is a validator for the "execution_id" field. It is called by the builders before save.
+	ExecutionIDValidator func(string) error
+	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ClientIDValidator func(string) error
+	// RejectionReasonValidator is a validator for the "rejection_reason" field. It is called by the builders before save.
+	RejectionReasonValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// CommandType defines the type for the "command_type" enum field.
+type CommandType string
+
+// CommandTypeSCRIPT_EXECUTION is the default value of the CommandType enum.
+const DefaultCommandType = CommandTypeSCRIPT_EXECUTION
+
+// CommandType values.
+const (
+	CommandTypeSCRIPT_EXECUTION CommandType = "SCRIPT_EXECUTION"
+	CommandTypeCLIENT_UPDATE    CommandType = "CLIENT_UPDATE"
+	CommandTypeABORT            CommandType = "ABORT"
+	CommandTypeCANCEL           CommandType = "CANCEL"
+)
+
+func (ct CommandType) String() string {
+	return string(ct)
+}
+
+// CommandTypeValidator is a validator for the "command_type" field enum values. It is called by the builders before save.
+func CommandTypeValidator(ct CommandType) error {
+	switch ct {
+	case CommandTypeSCRIPT_EXECUTION, CommandTypeCLIENT_UPDATE, CommandTypeABORT, CommandTypeCANCEL:
+		return nil
+	default:
+		return fmt.Errorf("command: invalid enum value for command_type field: %q", ct)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPENDING is the default value of the Status enum.
+const DefaultStatus = StatusPENDING
+
+// Status values.
+const (
+	StatusPENDING   Status = "PENDING"
+	StatusQUEUED    Status = "QUEUED"
+	StatusSENT      Status = "SENT"
+	StatusACCEPTED  Status = "ACCEPTED"
+	StatusREJECTED  Status = "REJECTED"
+	StatusCOMPLETED Status = "COMPLETED"
+	StatusEXPIRED   Status = "EXPIRED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPENDING, StatusQUEUED, StatusSENT, StatusACCEPTED, StatusREJECTED, StatusCOMPLETED, StatusEXPIRED:
+		return nil
+	default:
+		return fmt.Errorf("command: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Command queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByExecutionID orders the results by the execution_id field.
+func ByExecutionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutionID, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByCommandType orders the results by the command_type field.
+func ByCommandType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommandType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRejectionReason orders the results by the rejection_reason field.
+func ByRejectionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectionReason, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sent_at field.
+func BySentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByAckedAt orders the results by the acked_at field.
+func ByAckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAckedAt, opts...).ToFunc()
+}
+
+// ByExpiredAt orders the results by the expired_at field.
+func ByExpiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiredAt, opts...).ToFunc()
+}
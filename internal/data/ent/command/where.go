@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package command
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Command {
+	return predicate.Command(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Command {
+	return predicate.Command(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ExecutionID applies equality check predicate on the "execution_id" field. It's identical to ExecutionIDEQ.
+func ExecutionID(v string) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldExecutionID, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldClientID, v))
+}
+
+// RejectionReason applies equality check predicate on the "rejection_reason" field. It's identical to RejectionReasonEQ.
+func RejectionReason(v string) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldSentAt, v))
+}
+
+// AckedAt applies equality check predicate on the "acked_at" field. It's identical to AckedAtEQ.
+func AckedAt(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldAckedAt, v))
+}
+
+// ExpiredAt applies equality check predicate on the "expired_at" field. It's identical to ExpiredAtEQ.
+func ExpiredAt(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldTenantID))
+}
+
+// ExecutionIDEQ applies the EQ predicate on the "execution_id" field.
+func ExecutionIDEQ(v string) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldExecutionID, v))
+}
+
+// ExecutionIDNEQ applies the NEQ predicate on the "execution_id" field.
+func ExecutionIDNEQ(v string) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldExecutionID, v))
+}
+
+// ExecutionIDIn applies the In predicate on the "execution_id" field.
+func ExecutionIDIn(vs ...string) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldExecutionID, vs...))
+}
+
+// ExecutionIDNotIn applies the NotIn predicate on the "execution_id" field.
+func ExecutionIDNotIn(vs ...string) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldExecutionID, vs...))
+}
+
+// ExecutionIDGT applies the GT predicate on the "execution_id" field.
+func ExecutionIDGT(v string) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldExecutionID, v))
+}
+
+// ExecutionIDGTE applies the GTE predicate on the "execution_id" field.
+func ExecutionIDGTE(v string) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldExecutionID, v))
+}
+
+// ExecutionIDLT applies the LT predicate on the "execution_id" field.
+func ExecutionIDLT(v string) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldExecutionID, v))
+}
+
+// ExecutionIDLTE applies the LTE predicate on the "execution_id" field.
+func ExecutionIDLTE(v string) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldExecutionID, v))
+}
+
+// ExecutionIDContains applies the Contains predicate on the "execution_id" field.
+func ExecutionIDContains(v string) predicate.Command {
+	return predicate.Command(sql.FieldContains(FieldExecutionID, v))
+}
+
+// ExecutionIDHasPrefix applies the HasPrefix predicate on the "execution_id" field.
+func ExecutionIDHasPrefix(v string) predicate.Command {
+	return predicate.Command(sql.FieldHasPrefix(FieldExecutionID, v))
+}
+
+// ExecutionIDHasSuffix applies the HasSuffix predicate on the "execution_id" field.
+func ExecutionIDHasSuffix(v string) predicate.Command {
+	return predicate.Command(sql.FieldHasSuffix(FieldExecutionID, v))
+}
+
+// ExecutionIDIsNil applies the IsNil predicate on the "execution_id" field.
+func ExecutionIDIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldExecutionID))
+}
+
+// ExecutionIDNotNil applies the NotNil predicate on the "execution_id" field.
+func ExecutionIDNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldExecutionID))
+}
+
+// ExecutionIDEqualFold applies the EqualFold predicate on the "execution_id" field.
+func ExecutionIDEqualFold(v string) predicate.Command {
+	return predicate.Command(sql.FieldEqualFold(FieldExecutionID, v))
+}
+
+// ExecutionIDContainsFold applies the ContainsFold predicate on the "execution_id" field.
+func ExecutionIDContainsFold(v string) predicate.Command {
+	return predicate.Command(sql.FieldContainsFold(FieldExecutionID, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.Command {
+	return predicate.Command(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.Command {
+	return predicate.Command(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.Command {
+	return predicate.Command(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.Command {
+	return predicate.Command(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.Command {
+	return predicate.Command(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// CommandTypeEQ applies the EQ predicate on the "command_type" field.
+func CommandTypeEQ(v CommandType) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldCommandType, v))
+}
+
+// CommandTypeNEQ applies the NEQ predicate on the "command_type" field.
+func CommandTypeNEQ(v CommandType) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldCommandType, v))
+}
+
+// CommandTypeIn applies the In predicate on the "command_type" field.
+func CommandTypeIn(vs ...CommandType) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldCommandType, vs...))
+}
+
+// CommandTypeNotIn applies the NotIn predicate on the "command_type" field.
+func CommandTypeNotIn(vs ...CommandType) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldCommandType, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RejectionReasonEQ applies the EQ predicate on the "rejection_reason" field.
+func RejectionReasonEQ(v string) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonNEQ applies the NEQ predicate on the "rejection_reason" field.
+func RejectionReasonNEQ(v string) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonIn applies the In predicate on the "rejection_reason" field.
+func RejectionReasonIn(vs ...string) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonNotIn applies the NotIn predicate on the "rejection_reason" field.
+func RejectionReasonNotIn(vs ...string) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonGT applies the GT predicate on the "rejection_reason" field.
+func RejectionReasonGT(v string) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldRejectionReason, v))
+}
+
+// RejectionReasonGTE applies the GTE predicate on the "rejection_reason" field.
+func RejectionReasonGTE(v string) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonLT applies the LT predicate on the "rejection_reason" field.
+func RejectionReasonLT(v string) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldRejectionReason, v))
+}
+
+// RejectionReasonLTE applies the LTE predicate on the "rejection_reason" field.
+func RejectionReasonLTE(v string) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonContains applies the Contains predicate on the "rejection_reason" field.
+func RejectionReasonContains(v string) predicate.Command {
+	return predicate.Command(sql.FieldContains(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasPrefix applies the HasPrefix predicate on the "rejection_reason" field.
+func RejectionReasonHasPrefix(v string) predicate.Command {
+	return predicate.Command(sql.FieldHasPrefix(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasSuffix applies the HasSuffix predicate on the "rejection_reason" field.
+func RejectionReasonHasSuffix(v string) predicate.Command {
+	return predicate.Command(sql.FieldHasSuffix(FieldRejectionReason, v))
+}
+
+// RejectionReasonIsNil applies the IsNil predicate on the "rejection_reason" field.
+func RejectionReasonIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldRejectionReason))
+}
+
+// RejectionReasonNotNil applies the NotNil predicate on the "rejection_reason" field.
+func RejectionReasonNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldRejectionReason))
+}
+
+// RejectionReasonEqualFold applies the EqualFold predicate on the "rejection_reason" field.
+func RejectionReasonEqualFold(v string) predicate.Command {
+	return predicate.Command(sql.FieldEqualFold(FieldRejectionReason, v))
+}
+
+// RejectionReasonContainsFold applies the ContainsFold predicate on the "rejection_reason" field.
+func RejectionReasonContainsFold(v string) predicate.Command {
+	return predicate.Command(sql.FieldContainsFold(FieldRejectionReason, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldSentAt))
+}
+
+// AckedAtEQ applies the EQ predicate on the "acked_at" field.
+func AckedAtEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldAckedAt, v))
+}
+
+// AckedAtNEQ applies the NEQ predicate on the "acked_at" field.
+func AckedAtNEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldAckedAt, v))
+}
+
+// AckedAtIn applies the In predicate on the "acked_at" field.
+func AckedAtIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldAckedAt, vs...))
+}
+
+// AckedAtNotIn applies the NotIn predicate on the "acked_at" field.
+func AckedAtNotIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldAckedAt, vs...))
+}
+
+// AckedAtGT applies the GT predicate on the "acked_at" field.
+func AckedAtGT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldAckedAt, v))
+}
+
+// AckedAtGTE applies the GTE predicate on the "acked_at" field.
+func AckedAtGTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldAckedAt, v))
+}
+
+// AckedAtLT applies the LT predicate on the "acked_at" field.
+func AckedAtLT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldAckedAt, v))
+}
+
+// AckedAtLTE applies the LTE predicate on the "acked_at" field.
+func AckedAtLTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldAckedAt, v))
+}
+
+// AckedAtIsNil applies the IsNil predicate on the "acked_at" field.
+func AckedAtIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldAckedAt))
+}
+
+// AckedAtNotNil applies the NotNil predicate on the "acked_at" field.
+func AckedAtNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldAckedAt))
+}
+
+// ExpiredAtEQ applies the EQ predicate on the "expired_at" field.
+func ExpiredAtEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtNEQ applies the NEQ predicate on the "expired_at" field.
+func ExpiredAtNEQ(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtIn applies the In predicate on the "expired_at" field.
+func ExpiredAtIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtNotIn applies the NotIn predicate on the "expired_at" field.
+func ExpiredAtNotIn(vs ...time.Time) predicate.Command {
+	return predicate.Command(sql.FieldNotIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtGT applies the GT predicate on the "expired_at" field.
+func ExpiredAtGT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGT(FieldExpiredAt, v))
+}
+
+// ExpiredAtGTE applies the GTE predicate on the "expired_at" field.
+func ExpiredAtGTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldGTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtLT applies the LT predicate on the "expired_at" field.
+func ExpiredAtLT(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLT(FieldExpiredAt, v))
+}
+
+// ExpiredAtLTE applies the LTE predicate on the "expired_at" field.
+func ExpiredAtLTE(v time.Time) predicate.Command {
+	return predicate.Command(sql.FieldLTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtIsNil applies the IsNil predicate on the "expired_at" field.
+func ExpiredAtIsNil() predicate.Command {
+	return predicate.Command(sql.FieldIsNull(FieldExpiredAt))
+}
+
+// ExpiredAtNotNil applies the NotNil predicate on the "expired_at" field.
+func ExpiredAtNotNil() predicate.Command {
+	return predicate.Command(sql.FieldNotNull(FieldExpiredAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Command) predicate.Command {
+	return predicate.Command(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Command) predicate.Command {
+	return predicate.Command(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Command) predicate.Command {
+	return predicate.Command(sql.NotPredicates(p))
+}
@@ -0,0 +1,1257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+)
+
+// CommandCreate is the builder for creating a Command entity.
+type CommandCreate struct {
+	config
+	mutation *CommandMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *CommandCreate) SetCreateTime(v time.Time) *CommandCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableCreateTime(v *time.Time) *CommandCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *CommandCreate) SetUpdateTime(v time.Time) *CommandCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableUpdateTime(v *time.Time) *CommandCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *CommandCreate) SetDeleteTime(v time.Time) *CommandCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableDeleteTime(v *time.Time) *CommandCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CommandCreate) SetTenantID(v uint32) *CommandCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableTenantID(v *uint32) *CommandCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_c *CommandCreate) SetExecutionID(v string) *CommandCreate {
+	_c.mutation.SetExecutionID(v)
+	return _c
+}
+
+// SetNillableExecutionID sets the "execution_id" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableExecutionID(v *string) *CommandCreate {
+	if v != nil {
+		_c.SetExecutionID(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *CommandCreate) SetClientID(v string) *CommandCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetCommandType sets the "command_type" field.
+func (_c *CommandCreate) SetCommandType(v command.CommandType) *CommandCreate {
+	_c.mutation.SetCommandType(v)
+	return _c
+}
+
+// SetNillableCommandType sets the "command_type" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableCommandType(v *command.CommandType) *CommandCreate {
+	if v != nil {
+		_c.SetCommandType(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CommandCreate) SetStatus(v command.Status) *CommandCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableStatus(v *command.Status) *CommandCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_c *CommandCreate) SetRejectionReason(v string) *CommandCreate {
+	_c.mutation.SetRejectionReason(v)
+	return _c
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableRejectionReason(v *string) *CommandCreate {
+	if v != nil {
+		_c.SetRejectionReason(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *CommandCreate) SetSentAt(v time.Time) *CommandCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableSentAt(v *time.Time) *CommandCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetAckedAt sets the "acked_at" field.
+func (_c *CommandCreate) SetAckedAt(v time.Time) *CommandCreate {
+	_c.mutation.SetAckedAt(v)
+	return _c
+}
+
+// SetNillableAckedAt sets the "acked_at" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableAckedAt(v *time.Time) *CommandCreate {
+	if v != nil {
+		_c.SetAckedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_c *CommandCreate) SetExpiredAt(v time.Time) *CommandCreate {
+	_c.mutation.SetExpiredAt(v)
+	return _c
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_c *CommandCreate) SetNillableExpiredAt(v *time.Time) *CommandCreate {
+	if v != nil {
+		_c.SetExpiredAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CommandCreate) SetID(v string) *CommandCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CommandMutation object of the builder.
+func (_c *CommandCreate) Mutation() *CommandMutation {
+	return _c.mutation
+}
+
+// Save creates the Command in the database.
+func (_c *CommandCreate) Save(ctx context.Context) (*Command, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CommandCreate) SaveX(ctx context.Context) *Command {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommandCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommandCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CommandCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := command.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.CommandType(); !ok {
+		v := command.DefaultCommandType
+		_c.mutation.SetCommandType(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := command.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CommandCreate) check() error {
+	if v, ok := _c.mutation.ExecutionID(); ok {
+		if err := command.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "Command.execution_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "Command.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := command.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Command.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CommandType(); !ok {
+		return &ValidationError{Name: "command_type", err: errors.New(`ent: missing required field "Command.command_type"`)}
+	}
+	if v, ok := _c.mutation.CommandType(); ok {
+		if err := command.CommandTypeValidator(v); err != nil {
+			return &ValidationError{Name: "command_type", err: fmt.Errorf(`ent: validator failed for field "Command.command_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Command.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := command.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Command.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RejectionReason(); ok {
+		if err := command.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "Command.rejection_reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := command.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Command.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CommandCreate) sqlSave(ctx context.Context) (*Command, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Command.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CommandCreate) createSpec() (*Command, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Command{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(command.Table, sqlgraph.NewFieldSpec(command.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(command.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(command.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(command.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(command.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ExecutionID(); ok {
+		_spec.SetField(command.FieldExecutionID, field.TypeString, value)
+		_node.ExecutionID = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(command.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.CommandType(); ok {
+		_spec.SetField(command.FieldCommandType, field.TypeEnum, value)
+		_node.CommandType = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(command.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RejectionReason(); ok {
+		_spec.SetField(command.FieldRejectionReason, field.TypeString, value)
+		_node.RejectionReason = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(command.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.AckedAt(); ok {
+		_spec.SetField(command.FieldAckedAt, field.TypeTime, value)
+		_node.AckedAt = &value
+	}
+	if value, ok := _c.mutation.ExpiredAt(); ok {
+		_spec.SetField(command.FieldExpiredAt, field.TypeTime, value)
+		_node.ExpiredAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Command.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommandUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CommandCreate) OnConflict(opts ...sql.ConflictOption) *CommandUpsertOne {
+	_c.conflict = opts
+	return &CommandUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Command.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CommandCreate) OnConflictColumns(columns ...string) *CommandUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CommandUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CommandUpsertOne is the builder for "upsert"-ing
+	//  one Command node.
+	CommandUpsertOne struct {
+		create *CommandCreate
+	}
+
+	// CommandUpsert is the "OnConflict" setter.
+	CommandUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CommandUpsert) SetUpdateTime(v time.Time) *CommandUpsert {
+	u.Set(command.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateUpdateTime() *CommandUpsert {
+	u.SetExcluded(command.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CommandUpsert) ClearUpdateTime() *CommandUpsert {
+	u.SetNull(command.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CommandUpsert) SetDeleteTime(v time.Time) *CommandUpsert {
+	u.Set(command.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateDeleteTime() *CommandUpsert {
+	u.SetExcluded(command.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CommandUpsert) ClearDeleteTime() *CommandUpsert {
+	u.SetNull(command.FieldDeleteTime)
+	return u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *CommandUpsert) SetExecutionID(v string) *CommandUpsert {
+	u.Set(command.FieldExecutionID, v)
+	return u
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateExecutionID() *CommandUpsert {
+	u.SetExcluded(command.FieldExecutionID)
+	return u
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (u *CommandUpsert) ClearExecutionID() *CommandUpsert {
+	u.SetNull(command.FieldExecutionID)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *CommandUpsert) SetClientID(v string) *CommandUpsert {
+	u.Set(command.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateClientID() *CommandUpsert {
+	u.SetExcluded(command.FieldClientID)
+	return u
+}
+
+// SetCommandType sets the "command_type" field.
+func (u *CommandUpsert) SetCommandType(v command.CommandType) *CommandUpsert {
+	u.Set(command.FieldCommandType, v)
+	return u
+}
+
+// UpdateCommandType sets the "command_type" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateCommandType() *CommandUpsert {
+	u.SetExcluded(command.FieldCommandType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CommandUpsert) SetStatus(v command.Status) *CommandUpsert {
+	u.Set(command.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateStatus() *CommandUpsert {
+	u.SetExcluded(command.FieldStatus)
+	return u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CommandUpsert) SetRejectionReason(v string) *CommandUpsert {
+	u.Set(command.FieldRejectionReason, v)
+	return u
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateRejectionReason() *CommandUpsert {
+	u.SetExcluded(command.FieldRejectionReason)
+	return u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CommandUpsert) ClearRejectionReason() *CommandUpsert {
+	u.SetNull(command.FieldRejectionReason)
+	return u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *CommandUpsert) SetSentAt(v time.Time) *CommandUpsert {
+	u.Set(command.FieldSentAt, v)
+	return u
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateSentAt() *CommandUpsert {
+	u.SetExcluded(command.FieldSentAt)
+	return u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *CommandUpsert) ClearSentAt() *CommandUpsert {
+	u.SetNull(command.FieldSentAt)
+	return u
+}
+
+// SetAckedAt sets the "acked_at" field.
+func (u *CommandUpsert) SetAckedAt(v time.Time) *CommandUpsert {
+	u.Set(command.FieldAckedAt, v)
+	return u
+}
+
+// UpdateAckedAt sets the "acked_at" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateAckedAt() *CommandUpsert {
+	u.SetExcluded(command.FieldAckedAt)
+	return u
+}
+
+// ClearAckedAt clears the value of the "acked_at" field.
+func (u *CommandUpsert) ClearAckedAt() *CommandUpsert {
+	u.SetNull(command.FieldAckedAt)
+	return u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (u *CommandUpsert) SetExpiredAt(v time.Time) *CommandUpsert {
+	u.Set(command.FieldExpiredAt, v)
+	return u
+}
+
+// UpdateExpiredAt sets the "expired_at" field to the value that was provided on create.
+func (u *CommandUpsert) UpdateExpiredAt() *CommandUpsert {
+	u.SetExcluded(command.FieldExpiredAt)
+	return u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (u *CommandUpsert) ClearExpiredAt() *CommandUpsert {
+	u.SetNull(command.FieldExpiredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Command.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(command.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommandUpsertOne) UpdateNewValues() *CommandUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(command.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(command.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(command.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Command.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommandUpsertOne) Ignore() *CommandUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommandUpsertOne) DoNothing() *CommandUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommandCreate.OnConflict
+// documentation for more info.
+func (u *CommandUpsertOne) Update(set func(*CommandUpsert)) *CommandUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommandUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CommandUpsertOne) SetUpdateTime(v time.Time) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateUpdateTime() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CommandUpsertOne) ClearUpdateTime() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CommandUpsertOne) SetDeleteTime(v time.Time) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateDeleteTime() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CommandUpsertOne) ClearDeleteTime() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *CommandUpsertOne) SetExecutionID(v string) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetExecutionID(v)
+	})
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateExecutionID() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateExecutionID()
+	})
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (u *CommandUpsertOne) ClearExecutionID() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearExecutionID()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *CommandUpsertOne) SetClientID(v string) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateClientID() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetCommandType sets the "command_type" field.
+func (u *CommandUpsertOne) SetCommandType(v command.CommandType) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetCommandType(v)
+	})
+}
+
+// UpdateCommandType sets the "command_type" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateCommandType() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateCommandType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CommandUpsertOne) SetStatus(v command.Status) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateStatus() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CommandUpsertOne) SetRejectionReason(v string) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateRejectionReason() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CommandUpsertOne) ClearRejectionReason() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *CommandUpsertOne) SetSentAt(v time.Time) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateSentAt() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *CommandUpsertOne) ClearSentAt() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// SetAckedAt sets the "acked_at" field.
+func (u *CommandUpsertOne) SetAckedAt(v time.Time) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetAckedAt(v)
+	})
+}
+
+// UpdateAckedAt sets the "acked_at" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateAckedAt() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateAckedAt()
+	})
+}
+
+// ClearAckedAt clears the value of the "acked_at" field.
+func (u *CommandUpsertOne) ClearAckedAt() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearAckedAt()
+	})
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (u *CommandUpsertOne) SetExpiredAt(v time.Time) *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetExpiredAt(v)
+	})
+}
+
+// UpdateExpiredAt sets the "expired_at" field to the value that was provided on create.
+func (u *CommandUpsertOne) UpdateExpiredAt() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateExpiredAt()
+	})
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (u *CommandUpsertOne) ClearExpiredAt() *CommandUpsertOne {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearExpiredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommandUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommandCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommandUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CommandUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CommandUpsertOne.ID is not supported by MySQL driver. Use CommandUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommandUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CommandCreateBulk is the builder for creating many Command entities in bulk.
+type CommandCreateBulk struct {
+	config
+	err      error
+	builders []*CommandCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Command entities in the database.
+func (_c *CommandCreateBulk) Save(ctx context.Context) ([]*Command, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Command, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommandMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CommandCreateBulk) SaveX(ctx context.Context) []*Command {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommandCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommandCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Command.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommandUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CommandCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommandUpsertBulk {
+	_c.conflict = opts
+	return &CommandUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Command.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CommandCreateBulk) OnConflictColumns(columns ...string) *CommandUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CommandUpsertBulk{
+		create: _c,
+	}
+}
+
+// CommandUpsertBulk is the builder for "upsert"-ing
+// a bulk of Command nodes.
+type CommandUpsertBulk struct {
+	create *CommandCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Command.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(command.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommandUpsertBulk) UpdateNewValues() *CommandUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(command.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(command.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(command.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Command.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CommandUpsertBulk) Ignore() *CommandUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommandUpsertBulk) DoNothing() *CommandUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommandCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommandUpsertBulk) Update(set func(*CommandUpsert)) *CommandUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommandUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CommandUpsertBulk) SetUpdateTime(v time.Time) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateUpdateTime() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CommandUpsertBulk) ClearUpdateTime() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CommandUpsertBulk) SetDeleteTime(v time.Time) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateDeleteTime() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CommandUpsertBulk) ClearDeleteTime() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *CommandUpsertBulk) SetExecutionID(v string) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetExecutionID(v)
+	})
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateExecutionID() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateExecutionID()
+	})
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (u *CommandUpsertBulk) ClearExecutionID() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearExecutionID()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *CommandUpsertBulk) SetClientID(v string) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateClientID() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetCommandType sets the "command_type" field.
+func (u *CommandUpsertBulk) SetCommandType(v command.CommandType) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetCommandType(v)
+	})
+}
+
+// UpdateCommandType sets the "command_type" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateCommandType() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateCommandType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CommandUpsertBulk) SetStatus(v command.Status) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateStatus() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CommandUpsertBulk) SetRejectionReason(v string) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateRejectionReason() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CommandUpsertBulk) ClearRejectionReason() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *CommandUpsertBulk) SetSentAt(v time.Time) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateSentAt() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *CommandUpsertBulk) ClearSentAt() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// SetAckedAt sets the "acked_at" field.
+func (u *CommandUpsertBulk) SetAckedAt(v time.Time) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetAckedAt(v)
+	})
+}
+
+// UpdateAckedAt sets the "acked_at" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateAckedAt() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateAckedAt()
+	})
+}
+
+// ClearAckedAt clears the value of the "acked_at" field.
+func (u *CommandUpsertBulk) ClearAckedAt() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearAckedAt()
+	})
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (u *CommandUpsertBulk) SetExpiredAt(v time.Time) *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.SetExpiredAt(v)
+	})
+}
+
+// UpdateExpiredAt sets the "expired_at" field to the value that was provided on create.
+func (u *CommandUpsertBulk) UpdateExpiredAt() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.UpdateExpiredAt()
+	})
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (u *CommandUpsertBulk) ClearExpiredAt() *CommandUpsertBulk {
+	return u.Update(func(s *CommandUpsert) {
+		s.ClearExpiredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommandUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommandCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommandCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommandUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,736 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// CommandUpdate is the builder for updating Command entities.
+type CommandUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CommandMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CommandUpdate builder.
+func (_u *CommandUpdate) Where(ps ...predicate.Command) *CommandUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *CommandUpdate) SetUpdateTime(v time.Time) *CommandUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableUpdateTime(v *time.Time) *CommandUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *CommandUpdate) ClearUpdateTime() *CommandUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *CommandUpdate) SetDeleteTime(v time.Time) *CommandUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableDeleteTime(v *time.Time) *CommandUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *CommandUpdate) ClearDeleteTime() *CommandUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_u *CommandUpdate) SetExecutionID(v string) *CommandUpdate {
+	_u.mutation.SetExecutionID(v)
+	return _u
+}
+
+// SetNillableExecutionID sets the "execution_id" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableExecutionID(v *string) *CommandUpdate {
+	if v != nil {
+		_u.SetExecutionID(*v)
+	}
+	return _u
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (_u *CommandUpdate) ClearExecutionID() *CommandUpdate {
+	_u.mutation.ClearExecutionID()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *CommandUpdate) SetClientID(v string) *CommandUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableClientID(v *string) *CommandUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetCommandType sets the "command_type" field.
+func (_u *CommandUpdate) SetCommandType(v command.CommandType) *CommandUpdate {
+	_u.mutation.SetCommandType(v)
+	return _u
+}
+
+// SetNillableCommandType sets the "command_type" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableCommandType(v *command.CommandType) *CommandUpdate {
+	if v != nil {
+		_u.SetCommandType(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CommandUpdate) SetStatus(v command.Status) *CommandUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableStatus(v *command.Status) *CommandUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *CommandUpdate) SetRejectionReason(v string) *CommandUpdate {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableRejectionReason(v *string) *CommandUpdate {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *CommandUpdate) ClearRejectionReason() *CommandUpdate {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *CommandUpdate) SetSentAt(v time.Time) *CommandUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableSentAt(v *time.Time) *CommandUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *CommandUpdate) ClearSentAt() *CommandUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetAckedAt sets the "acked_at" field.
+func (_u *CommandUpdate) SetAckedAt(v time.Time) *CommandUpdate {
+	_u.mutation.SetAckedAt(v)
+	return _u
+}
+
+// SetNillableAckedAt sets the "acked_at" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableAckedAt(v *time.Time) *CommandUpdate {
+	if v != nil {
+		_u.SetAckedAt(*v)
+	}
+	return _u
+}
+
+// ClearAckedAt clears the value of the "acked_at" field.
+func (_u *CommandUpdate) ClearAckedAt() *CommandUpdate {
+	_u.mutation.ClearAckedAt()
+	return _u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_u *CommandUpdate) SetExpiredAt(v time.Time) *CommandUpdate {
+	_u.mutation.SetExpiredAt(v)
+	return _u
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_u *CommandUpdate) SetNillableExpiredAt(v *time.Time) *CommandUpdate {
+	if v != nil {
+		_u.SetExpiredAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (_u *CommandUpdate) ClearExpiredAt() *CommandUpdate {
+	_u.mutation.ClearExpiredAt()
+	return _u
+}
+
+// Mutation returns the CommandMutation object of the builder.
+func (_u *CommandUpdate) Mutation() *CommandMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CommandUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommandUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CommandUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommandUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommandUpdate) check() error {
+	if v, ok := _u.mutation.ExecutionID(); ok {
+		if err := command.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "Command.execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := command.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Command.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommandType(); ok {
+		if err := command.CommandTypeValidator(v); err != nil {
+			return &ValidationError{Name: "command_type", err: fmt.Errorf(`ent: validator failed for field "Command.command_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := command.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Command.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RejectionReason(); ok {
+		if err := command.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "Command.rejection_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CommandUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommandUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CommandUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(command.Table, command.Columns, sqlgraph.NewFieldSpec(command.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(command.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(command.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(command.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(command.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(command.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(command.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ExecutionID(); ok {
+		_spec.SetField(command.FieldExecutionID, field.TypeString, value)
+	}
+	if _u.mutation.ExecutionIDCleared() {
+		_spec.ClearField(command.FieldExecutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(command.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CommandType(); ok {
+		_spec.SetField(command.FieldCommandType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(command.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(command.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(command.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(command.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(command.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AckedAt(); ok {
+		_spec.SetField(command.FieldAckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AckedAtCleared() {
+		_spec.ClearField(command.FieldAckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiredAt(); ok {
+		_spec.SetField(command.FieldExpiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiredAtCleared() {
+		_spec.ClearField(command.FieldExpiredAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{command.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CommandUpdateOne is the builder for updating a single Command entity.
+type CommandUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CommandMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *CommandUpdateOne) SetUpdateTime(v time.Time) *CommandUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableUpdateTime(v *time.Time) *CommandUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *CommandUpdateOne) ClearUpdateTime() *CommandUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *CommandUpdateOne) SetDeleteTime(v time.Time) *CommandUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableDeleteTime(v *time.Time) *CommandUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *CommandUpdateOne) ClearDeleteTime() *CommandUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_u *CommandUpdateOne) SetExecutionID(v string) *CommandUpdateOne {
+	_u.mutation.SetExecutionID(v)
+	return _u
+}
+
+// SetNillableExecutionID sets the "execution_id" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableExecutionID(v *string) *CommandUpdateOne {
+	if v != nil {
+		_u.SetExecutionID(*v)
+	}
+	return _u
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (_u *CommandUpdateOne) ClearExecutionID() *CommandUpdateOne {
+	_u.mutation.ClearExecutionID()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *CommandUpdateOne) SetClientID(v string) *CommandUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableClientID(v *string) *CommandUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetCommandType sets the "command_type" field.
+func (_u *CommandUpdateOne) SetCommandType(v command.CommandType) *CommandUpdateOne {
+	_u.mutation.SetCommandType(v)
+	return _u
+}
+
+// SetNillableCommandType sets the "command_type" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableCommandType(v *command.CommandType) *CommandUpdateOne {
+	if v != nil {
+		_u.SetCommandType(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CommandUpdateOne) SetStatus(v command.Status) *CommandUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableStatus(v *command.Status) *CommandUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *CommandUpdateOne) SetRejectionReason(v string) *CommandUpdateOne {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableRejectionReason(v *string) *CommandUpdateOne {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *CommandUpdateOne) ClearRejectionReason() *CommandUpdateOne {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *CommandUpdateOne) SetSentAt(v time.Time) *CommandUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableSentAt(v *time.Time) *CommandUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *CommandUpdateOne) ClearSentAt() *CommandUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetAckedAt sets the "acked_at" field.
+func (_u *CommandUpdateOne) SetAckedAt(v time.Time) *CommandUpdateOne {
+	_u.mutation.SetAckedAt(v)
+	return _u
+}
+
+// SetNillableAckedAt sets the "acked_at" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableAckedAt(v *time.Time) *CommandUpdateOne {
+	if v != nil {
+		_u.SetAckedAt(*v)
+	}
+	return _u
+}
+
+// ClearAckedAt clears the value of the "acked_at" field.
+func (_u *CommandUpdateOne) ClearAckedAt() *CommandUpdateOne {
+	_u.mutation.ClearAckedAt()
+	return _u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_u *CommandUpdateOne) SetExpiredAt(v time.Time) *CommandUpdateOne {
+	_u.mutation.SetExpiredAt(v)
+	return _u
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_u *CommandUpdateOne) SetNillableExpiredAt(v *time.Time) *CommandUpdateOne {
+	if v != nil {
+		_u.SetExpiredAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (_u *CommandUpdateOne) ClearExpiredAt() *CommandUpdateOne {
+	_u.mutation.ClearExpiredAt()
+	return _u
+}
+
+// Mutation returns the CommandMutation object of the builder.
+func (_u *CommandUpdateOne) Mutation() *CommandMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CommandUpdate builder.
+func (_u *CommandUpdateOne) Where(ps ...predicate.Command) *CommandUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CommandUpdateOne) Select(field string, fields ...string) *CommandUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Command entity.
+func (_u *CommandUpdateOne) Save(ctx context.Context) (*Command, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommandUpdateOne) SaveX(ctx context.Context) *Command {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CommandUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommandUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommandUpdateOne) check() error {
+	if v, ok := _u.mutation.ExecutionID(); ok {
+		if err := command.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "Command.execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := command.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Command.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommandType(); ok {
+		if err := command.CommandTypeValidator(v); err != nil {
+			return &ValidationError{Name: "command_type", err: fmt.Errorf(`ent: validator failed for field "Command.command_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := command.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Command.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RejectionReason(); ok {
+		if err := command.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "Command.rejection_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CommandUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommandUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CommandUpdateOne) sqlSave(ctx context.Context) (_node *Command, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(command.Table, command.Columns, sqlgraph.NewFieldSpec(command.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Command.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, command.FieldID)
+		for _, f := range fields {
+			if !command.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != command.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(command.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(command.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(command.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(command.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(command.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(command.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ExecutionID(); ok {
+		_spec.SetField(command.FieldExecutionID, field.TypeString, value)
+	}
+	if _u.mutation.ExecutionIDCleared() {
+		_spec.ClearField(command.FieldExecutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(command.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CommandType(); ok {
+		_spec.SetField(command.FieldCommandType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(command.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(command.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(command.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(command.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(command.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AckedAt(); ok {
+		_spec.SetField(command.FieldAckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AckedAtCleared() {
+		_spec.ClearField(command.FieldAckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiredAt(); ok {
+		_spec.SetField(command.FieldExpiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiredAtCleared() {
+		_spec.ClearField(command.FieldExpiredAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Command{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{command.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
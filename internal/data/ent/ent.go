@@ -13,9 +13,28 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientgroup"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlabelset"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlock"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/managedclient"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/outputchunk"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/protectedclient"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/queuedcommand"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptassignment"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptchangerequest"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptversion"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/signingkey"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +95,29 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auditlog.Table:         auditlog.ValidColumn,
-			executionlog.Table:     executionlog.ValidColumn,
-			script.Table:           script.ValidColumn,
-			scriptassignment.Table: scriptassignment.ValidColumn,
+			auditlog.Table:            auditlog.ValidColumn,
+			clientgroup.Table:         clientgroup.ValidColumn,
+			clientlabelset.Table:      clientlabelset.ValidColumn,
+			clientlock.Table:          clientlock.ValidColumn,
+			command.Table:             command.ValidColumn,
+			eventrule.Table:           eventrule.ValidColumn,
+			executionlog.Table:        executionlog.ValidColumn,
+			executionrun.Table:        executionrun.ValidColumn,
+			maintenancewindow.Table:   maintenancewindow.ValidColumn,
+			managedclient.Table:       managedclient.ValidColumn,
+			outputchunk.Table:         outputchunk.ValidColumn,
+			protectedclient.Table:     protectedclient.ValidColumn,
+			queuedcommand.Table:       queuedcommand.ValidColumn,
+			schedule.Table:            schedule.ValidColumn,
+			script.Table:              script.ValidColumn,
+			scriptassignment.Table:    scriptassignment.ValidColumn,
+			scriptchangerequest.Table: scriptchangerequest.ValidColumn,
+			scriptversion.Table:       scriptversion.ValidColumn,
+			secret.Table:              secret.ValidColumn,
+			signingkey.Table:          signingkey.ValidColumn,
+			tenantsetting.Table:       tenantsetting.ValidColumn,
+			workflow.Table:            workflow.ValidColumn,
+			workflowrun.Table:         workflowrun.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
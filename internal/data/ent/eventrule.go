@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
+)
+
+// EventRule is the model entity for the EventRule schema.
+type EventRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Rule name
+	Name string `json:"name,omitempty"`
+	// Rule description
+	Description string `json:"description,omitempty"`
+	// Event the rule reacts to
+	EventType eventrule.EventType `json:"event_type,omitempty"`
+	// FK to executor_scripts, the script to run
+	ScriptID string `json:"script_id,omitempty"`
+	// Label selector limiting the clients the rule applies to
+	Selector string `json:"selector,omitempty"`
+	// For SCRIPT_FAILED, the script whose failure fires the rule
+	SourceScriptID *string `json:"source_script_id,omitempty"`
+	// Whether the rule fires
+	Enabled bool `json:"enabled,omitempty"`
+	// When the rule last started an execution
+	LastFiredAt  *time.Time `json:"last_fired_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case eventrule.FieldCreateBy, eventrule.FieldUpdateBy, eventrule.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case eventrule.FieldID, eventrule.FieldName, eventrule.FieldDescription, eventrule.FieldEventType, eventrule.FieldScriptID, eventrule.FieldSelector, eventrule.FieldSourceScriptID:
+			values[i] = new(sql.NullString)
+		case eventrule.FieldCreateTime, eventrule.FieldUpdateTime, eventrule.FieldDeleteTime, eventrule.FieldLastFiredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventRule fields.
+func (_m *EventRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventrule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case eventrule.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case eventrule.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case eventrule.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case eventrule.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case eventrule.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case eventrule.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case eventrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case eventrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case eventrule.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = eventrule.EventType(value.String)
+			}
+		case eventrule.FieldScriptID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field script_id", values[i])
+			} else if value.Valid {
+				_m.ScriptID = value.String
+			}
+		case eventrule.FieldSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selector", values[i])
+			} else if value.Valid {
+				_m.Selector = value.String
+			}
+		case eventrule.FieldSourceScriptID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_script_id", values[i])
+			} else if value.Valid {
+				_m.SourceScriptID = new(string)
+				*_m.SourceScriptID = value.String
+			}
+		case eventrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case eventrule.FieldLastFiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_fired_at", values[i])
+			} else if value.Valid {
+				_m.LastFiredAt = new(time.Time)
+				*_m.LastFiredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *EventRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventRule.
+// Note that you need to call EventRule.Unwrap() before calling this method if this EventRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EventRule) Update() *EventRuleUpdateOne {
+	return NewEventRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EventRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EventRule) Unwrap() *EventRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EventRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventType))
+	builder.WriteString(", ")
+	builder.WriteString("script_id=")
+	builder.WriteString(_m.ScriptID)
+	builder.WriteString(", ")
+	builder.WriteString("selector=")
+	builder.WriteString(_m.Selector)
+	builder.WriteString(", ")
+	if v := _m.SourceScriptID; v != nil {
+		builder.WriteString("source_script_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.LastFiredAt; v != nil {
+		builder.WriteString("last_fired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventRules is a parsable slice of EventRule.
+type EventRules []*EventRule
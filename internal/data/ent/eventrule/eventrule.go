@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventrule
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the eventrule type in the database.
+	Label = "event_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldUpdateBy holds the string denoting the update_by field in the database.
+	FieldUpdateBy = "update_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldScriptID holds the string denoting the script_id field in the database.
+	FieldScriptID = "script_id"
+	// FieldSelector holds the string denoting the selector field in the database.
+	FieldSelector = "selector"
+	// FieldSourceScriptID holds the string denoting the source_script_id field in the database.
+	FieldSourceScriptID = "source_script_id"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldLastFiredAt holds the string denoting the last_fired_at field in the database.
+	FieldLastFiredAt = "last_fired_at"
+	// Table holds the table name of the eventrule in the database.
+	Table = "executor_event_rules"
+)
+
+// Columns holds all SQL columns for eventrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldUpdateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldName,
+	FieldDescription,
+	FieldEventType,
+	FieldScriptID,
+	FieldSelector,
+	FieldSourceScriptID,
+	FieldEnabled,
+	FieldLastFiredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-executor/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	ScriptIDValidator func(string) error
+	// SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	SelectorValidator func(string) error
+	// SourceScriptIDValidator is a validator for the "source_script_id" field. It is called by the builders before save.
+	SourceScriptIDValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// EventType defines the type for the "event_type" enum field.
+type EventType string
+
+// EventType values.
+const (
+	EventTypeCLIENT_FIRST_CONNECT   EventType = "CLIENT_FIRST_CONNECT"
+	EventTypeCLIENT_VERSION_CHANGED EventType = "CLIENT_VERSION_CHANGED"
+	EventTypeCLIENT_LABELS_CHANGED  EventType = "CLIENT_LABELS_CHANGED"
+	EventTypeSCRIPT_FAILED          EventType = "SCRIPT_FAILED"
+)
+
+func (et EventType) String() string {
+	return string(et)
+}
+
+// EventTypeValidator is a validator for the "event_type" field enum values. It is called by the builders before save.
+func EventTypeValidator(et EventType) error {
+	switch et {
+	case EventTypeCLIENT_FIRST_CONNECT, EventTypeCLIENT_VERSION_CHANGED, EventTypeCLIENT_LABELS_CHANGED, EventTypeSCRIPT_FAILED:
+		return nil
+	default:
+		return fmt.Errorf("eventrule: invalid enum value for event_type field: %q", et)
+	}
+}
+
+// OrderOption defines the ordering options for the EventRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByUpdateBy orders the results by the update_by field.
+func ByUpdateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByScriptID orders the results by the script_id field.
+func ByScriptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScriptID, opts...).ToFunc()
+}
+
+// BySelector orders the results by the selector field.
+func BySelector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelector, opts...).ToFunc()
+}
+
+// BySourceScriptID orders the results by the source_script_id field.
+func BySourceScriptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceScriptID, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByLastFiredAt orders the results by the last_fired_at field.
+func ByLastFiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFiredAt, opts...).ToFunc()
+}
@@ -0,0 +1,880 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// ScriptID applies equality check predicate on the "script_id" field. It's identical to ScriptIDEQ.
+func ScriptID(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldScriptID, v))
+}
+
+// Selector applies equality check predicate on the "selector" field. It's identical to SelectorEQ.
+func Selector(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldSelector, v))
+}
+
+// SourceScriptID applies equality check predicate on the "source_script_id" field. It's identical to SourceScriptIDEQ.
+func SourceScriptID(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldSourceScriptID, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// LastFiredAt applies equality check predicate on the "last_fired_at" field. It's identical to LastFiredAtEQ.
+func LastFiredAt(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldLastFiredAt, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v EventType) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v EventType) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...EventType) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...EventType) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// ScriptIDEQ applies the EQ predicate on the "script_id" field.
+func ScriptIDEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldScriptID, v))
+}
+
+// ScriptIDNEQ applies the NEQ predicate on the "script_id" field.
+func ScriptIDNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldScriptID, v))
+}
+
+// ScriptIDIn applies the In predicate on the "script_id" field.
+func ScriptIDIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldScriptID, vs...))
+}
+
+// ScriptIDNotIn applies the NotIn predicate on the "script_id" field.
+func ScriptIDNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldScriptID, vs...))
+}
+
+// ScriptIDGT applies the GT predicate on the "script_id" field.
+func ScriptIDGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldScriptID, v))
+}
+
+// ScriptIDGTE applies the GTE predicate on the "script_id" field.
+func ScriptIDGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldScriptID, v))
+}
+
+// ScriptIDLT applies the LT predicate on the "script_id" field.
+func ScriptIDLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldScriptID, v))
+}
+
+// ScriptIDLTE applies the LTE predicate on the "script_id" field.
+func ScriptIDLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldScriptID, v))
+}
+
+// ScriptIDContains applies the Contains predicate on the "script_id" field.
+func ScriptIDContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldScriptID, v))
+}
+
+// ScriptIDHasPrefix applies the HasPrefix predicate on the "script_id" field.
+func ScriptIDHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldScriptID, v))
+}
+
+// ScriptIDHasSuffix applies the HasSuffix predicate on the "script_id" field.
+func ScriptIDHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldScriptID, v))
+}
+
+// ScriptIDEqualFold applies the EqualFold predicate on the "script_id" field.
+func ScriptIDEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldScriptID, v))
+}
+
+// ScriptIDContainsFold applies the ContainsFold predicate on the "script_id" field.
+func ScriptIDContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldScriptID, v))
+}
+
+// SelectorEQ applies the EQ predicate on the "selector" field.
+func SelectorEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldSelector, v))
+}
+
+// SelectorNEQ applies the NEQ predicate on the "selector" field.
+func SelectorNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldSelector, v))
+}
+
+// SelectorIn applies the In predicate on the "selector" field.
+func SelectorIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldSelector, vs...))
+}
+
+// SelectorNotIn applies the NotIn predicate on the "selector" field.
+func SelectorNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldSelector, vs...))
+}
+
+// SelectorGT applies the GT predicate on the "selector" field.
+func SelectorGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldSelector, v))
+}
+
+// SelectorGTE applies the GTE predicate on the "selector" field.
+func SelectorGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldSelector, v))
+}
+
+// SelectorLT applies the LT predicate on the "selector" field.
+func SelectorLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldSelector, v))
+}
+
+// SelectorLTE applies the LTE predicate on the "selector" field.
+func SelectorLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldSelector, v))
+}
+
+// SelectorContains applies the Contains predicate on the "selector" field.
+func SelectorContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldSelector, v))
+}
+
+// SelectorHasPrefix applies the HasPrefix predicate on the "selector" field.
+func SelectorHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldSelector, v))
+}
+
+// SelectorHasSuffix applies the HasSuffix predicate on the "selector" field.
+func SelectorHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldSelector, v))
+}
+
+// SelectorIsNil applies the IsNil predicate on the "selector" field.
+func SelectorIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldSelector))
+}
+
+// SelectorNotNil applies the NotNil predicate on the "selector" field.
+func SelectorNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldSelector))
+}
+
+// SelectorEqualFold applies the EqualFold predicate on the "selector" field.
+func SelectorEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldSelector, v))
+}
+
+// SelectorContainsFold applies the ContainsFold predicate on the "selector" field.
+func SelectorContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldSelector, v))
+}
+
+// SourceScriptIDEQ applies the EQ predicate on the "source_script_id" field.
+func SourceScriptIDEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDNEQ applies the NEQ predicate on the "source_script_id" field.
+func SourceScriptIDNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDIn applies the In predicate on the "source_script_id" field.
+func SourceScriptIDIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldSourceScriptID, vs...))
+}
+
+// SourceScriptIDNotIn applies the NotIn predicate on the "source_script_id" field.
+func SourceScriptIDNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldSourceScriptID, vs...))
+}
+
+// SourceScriptIDGT applies the GT predicate on the "source_script_id" field.
+func SourceScriptIDGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDGTE applies the GTE predicate on the "source_script_id" field.
+func SourceScriptIDGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDLT applies the LT predicate on the "source_script_id" field.
+func SourceScriptIDLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDLTE applies the LTE predicate on the "source_script_id" field.
+func SourceScriptIDLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDContains applies the Contains predicate on the "source_script_id" field.
+func SourceScriptIDContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDHasPrefix applies the HasPrefix predicate on the "source_script_id" field.
+func SourceScriptIDHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDHasSuffix applies the HasSuffix predicate on the "source_script_id" field.
+func SourceScriptIDHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDIsNil applies the IsNil predicate on the "source_script_id" field.
+func SourceScriptIDIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldSourceScriptID))
+}
+
+// SourceScriptIDNotNil applies the NotNil predicate on the "source_script_id" field.
+func SourceScriptIDNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldSourceScriptID))
+}
+
+// SourceScriptIDEqualFold applies the EqualFold predicate on the "source_script_id" field.
+func SourceScriptIDEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldSourceScriptID, v))
+}
+
+// SourceScriptIDContainsFold applies the ContainsFold predicate on the "source_script_id" field.
+func SourceScriptIDContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldSourceScriptID, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// LastFiredAtEQ applies the EQ predicate on the "last_fired_at" field.
+func LastFiredAtEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldLastFiredAt, v))
+}
+
+// LastFiredAtNEQ applies the NEQ predicate on the "last_fired_at" field.
+func LastFiredAtNEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldLastFiredAt, v))
+}
+
+// LastFiredAtIn applies the In predicate on the "last_fired_at" field.
+func LastFiredAtIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldLastFiredAt, vs...))
+}
+
+// LastFiredAtNotIn applies the NotIn predicate on the "last_fired_at" field.
+func LastFiredAtNotIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldLastFiredAt, vs...))
+}
+
+// LastFiredAtGT applies the GT predicate on the "last_fired_at" field.
+func LastFiredAtGT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldLastFiredAt, v))
+}
+
+// LastFiredAtGTE applies the GTE predicate on the "last_fired_at" field.
+func LastFiredAtGTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldLastFiredAt, v))
+}
+
+// LastFiredAtLT applies the LT predicate on the "last_fired_at" field.
+func LastFiredAtLT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldLastFiredAt, v))
+}
+
+// LastFiredAtLTE applies the LTE predicate on the "last_fired_at" field.
+func LastFiredAtLTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldLastFiredAt, v))
+}
+
+// LastFiredAtIsNil applies the IsNil predicate on the "last_fired_at" field.
+func LastFiredAtIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldLastFiredAt))
+}
+
+// LastFiredAtNotNil applies the NotNil predicate on the "last_fired_at" field.
+func LastFiredAtNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldLastFiredAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventRule) predicate.EventRule {
+	return predicate.EventRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventRule) predicate.EventRule {
+	return predicate.EventRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventRule) predicate.EventRule {
+	return predicate.EventRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
+)
+
+// EventRuleCreate is the builder for creating a EventRule entity.
+type EventRuleCreate struct {
+	config
+	mutation *EventRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *EventRuleCreate) SetCreateBy(v uint32) *EventRuleCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableCreateBy(v *uint32) *EventRuleCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *EventRuleCreate) SetUpdateBy(v uint32) *EventRuleCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableUpdateBy(v *uint32) *EventRuleCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *EventRuleCreate) SetCreateTime(v time.Time) *EventRuleCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableCreateTime(v *time.Time) *EventRuleCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *EventRuleCreate) SetUpdateTime(v time.Time) *EventRuleCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableUpdateTime(v *time.Time) *EventRuleCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *EventRuleCreate) SetDeleteTime(v time.Time) *EventRuleCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableDeleteTime(v *time.Time) *EventRuleCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *EventRuleCreate) SetTenantID(v uint32) *EventRuleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableTenantID(v *uint32) *EventRuleCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *EventRuleCreate) SetName(v string) *EventRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *EventRuleCreate) SetDescription(v string) *EventRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableDescription(v *string) *EventRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *EventRuleCreate) SetEventType(v eventrule.EventType) *EventRuleCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetScriptID sets the "script_id" field.
+func (_c *EventRuleCreate) SetScriptID(v string) *EventRuleCreate {
+	_c.mutation.SetScriptID(v)
+	return _c
+}
+
+// SetSelector sets the "selector" field.
+func (_c *EventRuleCreate) SetSelector(v string) *EventRuleCreate {
+	_c.mutation.SetSelector(v)
+	return _c
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableSelector(v *string) *EventRuleCreate {
+	if v != nil {
+		_c.SetSelector(*v)
+	}
+	return _c
+}
+
+// SetSourceScriptID sets the "source_script_id" field.
+func (_c *EventRuleCreate) SetSourceScriptID(v string) *EventRuleCreate {
+	_c.mutation.SetSourceScriptID(v)
+	return _c
+}
+
+// SetNillableSourceScriptID sets the "source_script_id" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableSourceScriptID(v *string) *EventRuleCreate {
+	if v != nil {
+		_c.SetSourceScriptID(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *EventRuleCreate) SetEnabled(v bool) *EventRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableEnabled(v *bool) *EventRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_c *EventRuleCreate) SetLastFiredAt(v time.Time) *EventRuleCreate {
+	_c.mutation.SetLastFiredAt(v)
+	return _c
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableLastFiredAt(v *time.Time) *EventRuleCreate {
+	if v != nil {
+		_c.SetLastFiredAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EventRuleCreate) SetID(v string) *EventRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EventRuleMutation object of the builder.
+func (_c *EventRuleCreate) Mutation() *EventRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the EventRule in the database.
+func (_c *EventRuleCreate) Save(ctx context.Context) (*EventRule, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventRuleCreate) SaveX(ctx context.Context) *EventRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventRuleCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := eventrule.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := eventrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventRuleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EventRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := eventrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EventRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := eventrule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "EventRule.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "EventRule.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := eventrule.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "EventRule.event_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScriptID(); !ok {
+		return &ValidationError{Name: "script_id", err: errors.New(`ent: missing required field "EventRule.script_id"`)}
+	}
+	if v, ok := _c.mutation.ScriptID(); ok {
+		if err := eventrule.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "EventRule.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Selector(); ok {
+		if err := eventrule.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "EventRule.selector": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SourceScriptID(); ok {
+		if err := eventrule.SourceScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_script_id", err: fmt.Errorf(`ent: validator failed for field "EventRule.source_script_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "EventRule.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := eventrule.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "EventRule.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EventRuleCreate) sqlSave(ctx context.Context) (*EventRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EventRule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventRuleCreate) createSpec() (*EventRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventrule.Table, sqlgraph.NewFieldSpec(eventrule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(eventrule.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(eventrule.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(eventrule.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(eventrule.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(eventrule.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(eventrule.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(eventrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(eventrule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(eventrule.FieldEventType, field.TypeEnum, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.ScriptID(); ok {
+		_spec.SetField(eventrule.FieldScriptID, field.TypeString, value)
+		_node.ScriptID = value
+	}
+	if value, ok := _c.mutation.Selector(); ok {
+		_spec.SetField(eventrule.FieldSelector, field.TypeString, value)
+		_node.Selector = value
+	}
+	if value, ok := _c.mutation.SourceScriptID(); ok {
+		_spec.SetField(eventrule.FieldSourceScriptID, field.TypeString, value)
+		_node.SourceScriptID = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(eventrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.LastFiredAt(); ok {
+		_spec.SetField(eventrule.FieldLastFiredAt, field.TypeTime, value)
+		_node.LastFiredAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventRule.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventRuleUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventRuleCreate) OnConflict(opts ...sql.ConflictOption) *EventRuleUpsertOne {
+	_c.conflict = opts
+	return &EventRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventRuleCreate) OnConflictColumns(columns ...string) *EventRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EventRuleUpsertOne is the builder for "upsert"-ing
+	//  one EventRule node.
+	EventRuleUpsertOne struct {
+		create *EventRuleCreate
+	}
+
+	// EventRuleUpsert is the "OnConflict" setter.
+	EventRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *EventRuleUpsert) SetCreateBy(v uint32) *EventRuleUpsert {
+	u.Set(eventrule.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateCreateBy() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *EventRuleUpsert) AddCreateBy(v uint32) *EventRuleUpsert {
+	u.Add(eventrule.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *EventRuleUpsert) ClearCreateBy() *EventRuleUpsert {
+	u.SetNull(eventrule.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *EventRuleUpsert) SetUpdateBy(v uint32) *EventRuleUpsert {
+	u.Set(eventrule.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateUpdateBy() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *EventRuleUpsert) AddUpdateBy(v uint32) *EventRuleUpsert {
+	u.Add(eventrule.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *EventRuleUpsert) ClearUpdateBy() *EventRuleUpsert {
+	u.SetNull(eventrule.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EventRuleUpsert) SetUpdateTime(v time.Time) *EventRuleUpsert {
+	u.Set(eventrule.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateUpdateTime() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *EventRuleUpsert) ClearUpdateTime() *EventRuleUpsert {
+	u.SetNull(eventrule.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *EventRuleUpsert) SetDeleteTime(v time.Time) *EventRuleUpsert {
+	u.Set(eventrule.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateDeleteTime() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *EventRuleUpsert) ClearDeleteTime() *EventRuleUpsert {
+	u.SetNull(eventrule.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EventRuleUpsert) SetName(v string) *EventRuleUpsert {
+	u.Set(eventrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateName() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *EventRuleUpsert) SetDescription(v string) *EventRuleUpsert {
+	u.Set(eventrule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateDescription() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventRuleUpsert) ClearDescription() *EventRuleUpsert {
+	u.SetNull(eventrule.FieldDescription)
+	return u
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventRuleUpsert) SetEventType(v eventrule.EventType) *EventRuleUpsert {
+	u.Set(eventrule.FieldEventType, v)
+	return u
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateEventType() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldEventType)
+	return u
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *EventRuleUpsert) SetScriptID(v string) *EventRuleUpsert {
+	u.Set(eventrule.FieldScriptID, v)
+	return u
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateScriptID() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldScriptID)
+	return u
+}
+
+// SetSelector sets the "selector" field.
+func (u *EventRuleUpsert) SetSelector(v string) *EventRuleUpsert {
+	u.Set(eventrule.FieldSelector, v)
+	return u
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateSelector() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldSelector)
+	return u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *EventRuleUpsert) ClearSelector() *EventRuleUpsert {
+	u.SetNull(eventrule.FieldSelector)
+	return u
+}
+
+// SetSourceScriptID sets the "source_script_id" field.
+func (u *EventRuleUpsert) SetSourceScriptID(v string) *EventRuleUpsert {
+	u.Set(eventrule.FieldSourceScriptID, v)
+	return u
+}
+
+// UpdateSourceScriptID sets the "source_script_id" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateSourceScriptID() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldSourceScriptID)
+	return u
+}
+
+// ClearSourceScriptID clears the value of the "source_script_id" field.
+func (u *EventRuleUpsert) ClearSourceScriptID() *EventRuleUpsert {
+	u.SetNull(eventrule.FieldSourceScriptID)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *EventRuleUpsert) SetEnabled(v bool) *EventRuleUpsert {
+	u.Set(eventrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateEnabled() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldEnabled)
+	return u
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (u *EventRuleUpsert) SetLastFiredAt(v time.Time) *EventRuleUpsert {
+	u.Set(eventrule.FieldLastFiredAt, v)
+	return u
+}
+
+// UpdateLastFiredAt sets the "last_fired_at" field to the value that was provided on create.
+func (u *EventRuleUpsert) UpdateLastFiredAt() *EventRuleUpsert {
+	u.SetExcluded(eventrule.FieldLastFiredAt)
+	return u
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (u *EventRuleUpsert) ClearLastFiredAt() *EventRuleUpsert {
+	u.SetNull(eventrule.FieldLastFiredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EventRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(eventrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EventRuleUpsertOne) UpdateNewValues() *EventRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(eventrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(eventrule.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(eventrule.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EventRuleUpsertOne) Ignore() *EventRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventRuleUpsertOne) DoNothing() *EventRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventRuleCreate.OnConflict
+// documentation for more info.
+func (u *EventRuleUpsertOne) Update(set func(*EventRuleUpsert)) *EventRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *EventRuleUpsertOne) SetCreateBy(v uint32) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *EventRuleUpsertOne) AddCreateBy(v uint32) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateCreateBy() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *EventRuleUpsertOne) ClearCreateBy() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *EventRuleUpsertOne) SetUpdateBy(v uint32) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *EventRuleUpsertOne) AddUpdateBy(v uint32) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateUpdateBy() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *EventRuleUpsertOne) ClearUpdateBy() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EventRuleUpsertOne) SetUpdateTime(v time.Time) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateUpdateTime() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *EventRuleUpsertOne) ClearUpdateTime() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *EventRuleUpsertOne) SetDeleteTime(v time.Time) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateDeleteTime() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *EventRuleUpsertOne) ClearDeleteTime() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EventRuleUpsertOne) SetName(v string) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateName() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventRuleUpsertOne) SetDescription(v string) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateDescription() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventRuleUpsertOne) ClearDescription() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventRuleUpsertOne) SetEventType(v eventrule.EventType) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateEventType() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *EventRuleUpsertOne) SetScriptID(v string) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateScriptID() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *EventRuleUpsertOne) SetSelector(v string) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateSelector() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *EventRuleUpsertOne) ClearSelector() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetSourceScriptID sets the "source_script_id" field.
+func (u *EventRuleUpsertOne) SetSourceScriptID(v string) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetSourceScriptID(v)
+	})
+}
+
+// UpdateSourceScriptID sets the "source_script_id" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateSourceScriptID() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateSourceScriptID()
+	})
+}
+
+// ClearSourceScriptID clears the value of the "source_script_id" field.
+func (u *EventRuleUpsertOne) ClearSourceScriptID() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearSourceScriptID()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *EventRuleUpsertOne) SetEnabled(v bool) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateEnabled() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (u *EventRuleUpsertOne) SetLastFiredAt(v time.Time) *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetLastFiredAt(v)
+	})
+}
+
+// UpdateLastFiredAt sets the "last_fired_at" field to the value that was provided on create.
+func (u *EventRuleUpsertOne) UpdateLastFiredAt() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateLastFiredAt()
+	})
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (u *EventRuleUpsertOne) ClearLastFiredAt() *EventRuleUpsertOne {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearLastFiredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EventRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EventRuleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EventRuleUpsertOne.ID is not supported by MySQL driver. Use EventRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EventRuleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EventRuleCreateBulk is the builder for creating many EventRule entities in bulk.
+type EventRuleCreateBulk struct {
+	config
+	err      error
+	builders []*EventRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EventRule entities in the database.
+func (_c *EventRuleCreateBulk) Save(ctx context.Context) ([]*EventRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventRuleCreateBulk) SaveX(ctx context.Context) []*EventRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventRuleUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventRuleUpsertBulk {
+	_c.conflict = opts
+	return &EventRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventRuleCreateBulk) OnConflictColumns(columns ...string) *EventRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// EventRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of EventRule nodes.
+type EventRuleUpsertBulk struct {
+	create *EventRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EventRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(eventrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EventRuleUpsertBulk) UpdateNewValues() *EventRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(eventrule.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(eventrule.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(eventrule.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EventRuleUpsertBulk) Ignore() *EventRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventRuleUpsertBulk) DoNothing() *EventRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *EventRuleUpsertBulk) Update(set func(*EventRuleUpsert)) *EventRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *EventRuleUpsertBulk) SetCreateBy(v uint32) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *EventRuleUpsertBulk) AddCreateBy(v uint32) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateCreateBy() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *EventRuleUpsertBulk) ClearCreateBy() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *EventRuleUpsertBulk) SetUpdateBy(v uint32) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *EventRuleUpsertBulk) AddUpdateBy(v uint32) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateUpdateBy() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *EventRuleUpsertBulk) ClearUpdateBy() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EventRuleUpsertBulk) SetUpdateTime(v time.Time) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateUpdateTime() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *EventRuleUpsertBulk) ClearUpdateTime() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *EventRuleUpsertBulk) SetDeleteTime(v time.Time) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateDeleteTime() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *EventRuleUpsertBulk) ClearDeleteTime() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EventRuleUpsertBulk) SetName(v string) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateName() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventRuleUpsertBulk) SetDescription(v string) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateDescription() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventRuleUpsertBulk) ClearDescription() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventRuleUpsertBulk) SetEventType(v eventrule.EventType) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateEventType() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *EventRuleUpsertBulk) SetScriptID(v string) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateScriptID() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *EventRuleUpsertBulk) SetSelector(v string) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateSelector() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *EventRuleUpsertBulk) ClearSelector() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetSourceScriptID sets the "source_script_id" field.
+func (u *EventRuleUpsertBulk) SetSourceScriptID(v string) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetSourceScriptID(v)
+	})
+}
+
+// UpdateSourceScriptID sets the "source_script_id" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateSourceScriptID() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateSourceScriptID()
+	})
+}
+
+// ClearSourceScriptID clears the value of the "source_script_id" field.
+func (u *EventRuleUpsertBulk) ClearSourceScriptID() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearSourceScriptID()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *EventRuleUpsertBulk) SetEnabled(v bool) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateEnabled() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (u *EventRuleUpsertBulk) SetLastFiredAt(v time.Time) *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.SetLastFiredAt(v)
+	})
+}
+
+// UpdateLastFiredAt sets the "last_fired_at" field to the value that was provided on create.
+func (u *EventRuleUpsertBulk) UpdateLastFiredAt() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.UpdateLastFiredAt()
+	})
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (u *EventRuleUpsertBulk) ClearLastFiredAt() *EventRuleUpsertBulk {
+	return u.Update(func(s *EventRuleUpsert) {
+		s.ClearLastFiredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EventRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EventRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,872 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// EventRuleUpdate is the builder for updating EventRule entities.
+type EventRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EventRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EventRuleUpdate builder.
+func (_u *EventRuleUpdate) Where(ps ...predicate.EventRule) *EventRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *EventRuleUpdate) SetCreateBy(v uint32) *EventRuleUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableCreateBy(v *uint32) *EventRuleUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *EventRuleUpdate) AddCreateBy(v int32) *EventRuleUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *EventRuleUpdate) ClearCreateBy() *EventRuleUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *EventRuleUpdate) SetUpdateBy(v uint32) *EventRuleUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableUpdateBy(v *uint32) *EventRuleUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *EventRuleUpdate) AddUpdateBy(v int32) *EventRuleUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *EventRuleUpdate) ClearUpdateBy() *EventRuleUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EventRuleUpdate) SetUpdateTime(v time.Time) *EventRuleUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableUpdateTime(v *time.Time) *EventRuleUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *EventRuleUpdate) ClearUpdateTime() *EventRuleUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *EventRuleUpdate) SetDeleteTime(v time.Time) *EventRuleUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableDeleteTime(v *time.Time) *EventRuleUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *EventRuleUpdate) ClearDeleteTime() *EventRuleUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EventRuleUpdate) SetName(v string) *EventRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableName(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventRuleUpdate) SetDescription(v string) *EventRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableDescription(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *EventRuleUpdate) ClearDescription() *EventRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *EventRuleUpdate) SetEventType(v eventrule.EventType) *EventRuleUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableEventType(v *eventrule.EventType) *EventRuleUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *EventRuleUpdate) SetScriptID(v string) *EventRuleUpdate {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableScriptID(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *EventRuleUpdate) SetSelector(v string) *EventRuleUpdate {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableSelector(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *EventRuleUpdate) ClearSelector() *EventRuleUpdate {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetSourceScriptID sets the "source_script_id" field.
+func (_u *EventRuleUpdate) SetSourceScriptID(v string) *EventRuleUpdate {
+	_u.mutation.SetSourceScriptID(v)
+	return _u
+}
+
+// SetNillableSourceScriptID sets the "source_script_id" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableSourceScriptID(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetSourceScriptID(*v)
+	}
+	return _u
+}
+
+// ClearSourceScriptID clears the value of the "source_script_id" field.
+func (_u *EventRuleUpdate) ClearSourceScriptID() *EventRuleUpdate {
+	_u.mutation.ClearSourceScriptID()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *EventRuleUpdate) SetEnabled(v bool) *EventRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableEnabled(v *bool) *EventRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_u *EventRuleUpdate) SetLastFiredAt(v time.Time) *EventRuleUpdate {
+	_u.mutation.SetLastFiredAt(v)
+	return _u
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableLastFiredAt(v *time.Time) *EventRuleUpdate {
+	if v != nil {
+		_u.SetLastFiredAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (_u *EventRuleUpdate) ClearLastFiredAt() *EventRuleUpdate {
+	_u.mutation.ClearLastFiredAt()
+	return _u
+}
+
+// Mutation returns the EventRuleMutation object of the builder.
+func (_u *EventRuleUpdate) Mutation() *EventRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := eventrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EventRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := eventrule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "EventRule.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := eventrule.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "EventRule.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := eventrule.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "EventRule.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := eventrule.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "EventRule.selector": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceScriptID(); ok {
+		if err := eventrule.SourceScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_script_id", err: fmt.Errorf(`ent: validator failed for field "EventRule.source_script_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EventRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventrule.Table, eventrule.Columns, sqlgraph.NewFieldSpec(eventrule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(eventrule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(eventrule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(eventrule.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(eventrule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(eventrule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(eventrule.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(eventrule.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(eventrule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(eventrule.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(eventrule.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(eventrule.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(eventrule.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(eventrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(eventrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(eventrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(eventrule.FieldEventType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(eventrule.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(eventrule.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(eventrule.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceScriptID(); ok {
+		_spec.SetField(eventrule.FieldSourceScriptID, field.TypeString, value)
+	}
+	if _u.mutation.SourceScriptIDCleared() {
+		_spec.ClearField(eventrule.FieldSourceScriptID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(eventrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastFiredAt(); ok {
+		_spec.SetField(eventrule.FieldLastFiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFiredAtCleared() {
+		_spec.ClearField(eventrule.FieldLastFiredAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventRuleUpdateOne is the builder for updating a single EventRule entity.
+type EventRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EventRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *EventRuleUpdateOne) SetCreateBy(v uint32) *EventRuleUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableCreateBy(v *uint32) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *EventRuleUpdateOne) AddCreateBy(v int32) *EventRuleUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *EventRuleUpdateOne) ClearCreateBy() *EventRuleUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *EventRuleUpdateOne) SetUpdateBy(v uint32) *EventRuleUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableUpdateBy(v *uint32) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *EventRuleUpdateOne) AddUpdateBy(v int32) *EventRuleUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *EventRuleUpdateOne) ClearUpdateBy() *EventRuleUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EventRuleUpdateOne) SetUpdateTime(v time.Time) *EventRuleUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableUpdateTime(v *time.Time) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *EventRuleUpdateOne) ClearUpdateTime() *EventRuleUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *EventRuleUpdateOne) SetDeleteTime(v time.Time) *EventRuleUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableDeleteTime(v *time.Time) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *EventRuleUpdateOne) ClearDeleteTime() *EventRuleUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EventRuleUpdateOne) SetName(v string) *EventRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableName(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventRuleUpdateOne) SetDescription(v string) *EventRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableDescription(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *EventRuleUpdateOne) ClearDescription() *EventRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *EventRuleUpdateOne) SetEventType(v eventrule.EventType) *EventRuleUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableEventType(v *eventrule.EventType) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *EventRuleUpdateOne) SetScriptID(v string) *EventRuleUpdateOne {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableScriptID(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *EventRuleUpdateOne) SetSelector(v string) *EventRuleUpdateOne {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableSelector(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *EventRuleUpdateOne) ClearSelector() *EventRuleUpdateOne {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetSourceScriptID sets the "source_script_id" field.
+func (_u *EventRuleUpdateOne) SetSourceScriptID(v string) *EventRuleUpdateOne {
+	_u.mutation.SetSourceScriptID(v)
+	return _u
+}
+
+// SetNillableSourceScriptID sets the "source_script_id" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableSourceScriptID(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetSourceScriptID(*v)
+	}
+	return _u
+}
+
+// ClearSourceScriptID clears the value of the "source_script_id" field.
+func (_u *EventRuleUpdateOne) ClearSourceScriptID() *EventRuleUpdateOne {
+	_u.mutation.ClearSourceScriptID()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *EventRuleUpdateOne) SetEnabled(v bool) *EventRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableEnabled(v *bool) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_u *EventRuleUpdateOne) SetLastFiredAt(v time.Time) *EventRuleUpdateOne {
+	_u.mutation.SetLastFiredAt(v)
+	return _u
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableLastFiredAt(v *time.Time) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetLastFiredAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (_u *EventRuleUpdateOne) ClearLastFiredAt() *EventRuleUpdateOne {
+	_u.mutation.ClearLastFiredAt()
+	return _u
+}
+
+// Mutation returns the EventRuleMutation object of the builder.
+func (_u *EventRuleUpdateOne) Mutation() *EventRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventRuleUpdate builder.
+func (_u *EventRuleUpdateOne) Where(ps ...predicate.EventRule) *EventRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventRuleUpdateOne) Select(field string, fields ...string) *EventRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventRule entity.
+func (_u *EventRuleUpdateOne) Save(ctx context.Context) (*EventRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventRuleUpdateOne) SaveX(ctx context.Context) *EventRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := eventrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EventRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := eventrule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "EventRule.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := eventrule.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "EventRule.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := eventrule.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "EventRule.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := eventrule.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "EventRule.selector": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceScriptID(); ok {
+		if err := eventrule.SourceScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_script_id", err: fmt.Errorf(`ent: validator failed for field "EventRule.source_script_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EventRuleUpdateOne) sqlSave(ctx context.Context) (_node *EventRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventrule.Table, eventrule.Columns, sqlgraph.NewFieldSpec(eventrule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventrule.FieldID)
+		for _, f := range fields {
+			if !eventrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(eventrule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(eventrule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(eventrule.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(eventrule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(eventrule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(eventrule.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(eventrule.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(eventrule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(eventrule.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(eventrule.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(eventrule.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(eventrule.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(eventrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(eventrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(eventrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(eventrule.FieldEventType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(eventrule.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(eventrule.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(eventrule.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceScriptID(); ok {
+		_spec.SetField(eventrule.FieldSourceScriptID, field.TypeString, value)
+	}
+	if _u.mutation.SourceScriptIDCleared() {
+		_spec.ClearField(eventrule.FieldSourceScriptID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(eventrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastFiredAt(); ok {
+		_spec.SetField(eventrule.FieldLastFiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFiredAtCleared() {
+		_spec.ClearField(eventrule.FieldLastFiredAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EventRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -53,8 +54,62 @@ type ExecutionLog struct {
 	// When execution completed on client
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
 	// Execution duration in milliseconds
-	DurationMs   *int64 `json:"duration_ms,omitempty"`
-	selectValues sql.SelectValues
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+	// When a user asked to cancel the execution
+	CancelRequestedAt *time.Time `json:"cancel_requested_at,omitempty"`
+	// User who cancelled the execution
+	CancelledBy *uint32 `json:"cancelled_by,omitempty"`
+	// Why the execution was cancelled
+	CancelReason string `json:"cancel_reason,omitempty"`
+	// Why the execution timed out
+	TimeoutReason string `json:"timeout_reason,omitempty"`
+	// FK to executor_execution_runs when part of a fan-out run
+	RunID *string `json:"run_id,omitempty"`
+	// FK to executor_event_rules when started by an event rule
+	EventRuleID *string `json:"event_rule_id,omitempty"`
+	// Event that started the execution
+	EventType *executionlog.EventType `json:"event_type,omitempty"`
+	// Details of the originating event
+	EventDetail string `json:"event_detail,omitempty"`
+	// Failed execution that fired a SCRIPT_FAILED rule
+	SourceExecutionID *string `json:"source_execution_id,omitempty"`
+	// FK to executor_workflow_runs when a step of a workflow run
+	WorkflowRunID *string `json:"workflow_run_id,omitempty"`
+	// Step of the workflow run
+	WorkflowStepID string `json:"workflow_step_id,omitempty"`
+	// Attempt number, 1 for the first attempt
+	Attempt int `json:"attempt,omitempty"`
+	// First attempt of the execution, set on retries
+	OriginalExecutionID *string `json:"original_execution_id,omitempty"`
+	// When the next attempt is due, set while a retry is pending
+	RetryAt *time.Time `json:"retry_at,omitempty"`
+	// Attempt that retried this execution
+	RetriedBy *string `json:"retried_by,omitempty"`
+	// PENDING execution held back until a concurrency slot on the client frees up
+	WaitingForSlot bool `json:"waiting_for_slot,omitempty"`
+	// Waiting execution held back until a maintenance window of the client opens
+	HeldUntil *time.Time `json:"held_until,omitempty"`
+	// Justification given for running outside the client's maintenance windows
+	MaintenanceOverride string `json:"maintenance_override,omitempty"`
+	// Parameter values the execution runs with, secret values masked
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// Encrypted values of the secret parameters, kept to deliver waiting executions and retries
+	SecretParametersCiphertext *[]byte `json:"-"`
+	// GCM nonce the secret parameter values were encrypted with
+	SecretParametersNonce []byte `json:"-"`
+	// Identifies the key the secret parameter values are encrypted with
+	SecretParametersKeyID string `json:"secret_parameters_key_id,omitempty"`
+	// Secrets the script content references, masked in the stored output
+	SecretNames []string `json:"secret_names,omitempty"`
+	// When an execution on a protected client stops waiting for approval
+	ApprovalExpiresAt *time.Time `json:"approval_expires_at,omitempty"`
+	// User who approved the execution
+	ApprovedBy *uint32 `json:"approved_by,omitempty"`
+	// When the execution was approved
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	// Comment the approver left
+	ApprovalComment string `json:"approval_comment,omitempty"`
+	selectValues    sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -62,11 +117,15 @@ func (*ExecutionLog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case executionlog.FieldCreateBy, executionlog.FieldTenantID, executionlog.FieldExitCode, executionlog.FieldDurationMs:
+		case executionlog.FieldParameters, executionlog.FieldSecretParametersCiphertext, executionlog.FieldSecretParametersNonce, executionlog.FieldSecretNames:
+			values[i] = new([]byte)
+		case executionlog.FieldWaitingForSlot:
+			values[i] = new(sql.NullBool)
+		case executionlog.FieldCreateBy, executionlog.FieldTenantID, executionlog.FieldExitCode, executionlog.FieldDurationMs, executionlog.FieldCancelledBy, executionlog.FieldAttempt, executionlog.FieldApprovedBy:
 			values[i] = new(sql.NullInt64)
-		case executionlog.FieldID, executionlog.FieldScriptID, executionlog.FieldScriptName, executionlog.FieldClientID, executionlog.FieldScriptHash, executionlog.FieldTriggerType, executionlog.FieldStatus, executionlog.FieldOutput, executionlog.FieldErrorOutput, executionlog.FieldRejectionReason:
+		case executionlog.FieldID, executionlog.FieldScriptID, executionlog.FieldScriptName, executionlog.FieldClientID, executionlog.FieldScriptHash, executionlog.FieldTriggerType, executionlog.FieldStatus, executionlog.FieldOutput, executionlog.FieldErrorOutput, executionlog.FieldRejectionReason, executionlog.FieldCancelReason, executionlog.FieldTimeoutReason, executionlog.FieldRunID, executionlog.FieldEventRuleID, executionlog.FieldEventType, executionlog.FieldEventDetail, executionlog.FieldSourceExecutionID, executionlog.FieldWorkflowRunID, executionlog.FieldWorkflowStepID, executionlog.FieldOriginalExecutionID, executionlog.FieldRetriedBy, executionlog.FieldMaintenanceOverride, executionlog.FieldSecretParametersKeyID, executionlog.FieldApprovalComment:
 			values[i] = new(sql.NullString)
-		case executionlog.FieldCreateTime, executionlog.FieldUpdateTime, executionlog.FieldDeleteTime, executionlog.FieldStartedAt, executionlog.FieldCompletedAt:
+		case executionlog.FieldCreateTime, executionlog.FieldUpdateTime, executionlog.FieldDeleteTime, executionlog.FieldStartedAt, executionlog.FieldCompletedAt, executionlog.FieldCancelRequestedAt, executionlog.FieldRetryAt, executionlog.FieldHeldUntil, executionlog.FieldApprovalExpiresAt, executionlog.FieldApprovedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -206,6 +265,186 @@ func (_m *ExecutionLog) assignValues(columns []string, values []any) error {
 				_m.DurationMs = new(int64)
 				*_m.DurationMs = value.Int64
 			}
+		case executionlog.FieldCancelRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_requested_at", values[i])
+			} else if value.Valid {
+				_m.CancelRequestedAt = new(time.Time)
+				*_m.CancelRequestedAt = value.Time
+			}
+		case executionlog.FieldCancelledBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_by", values[i])
+			} else if value.Valid {
+				_m.CancelledBy = new(uint32)
+				*_m.CancelledBy = uint32(value.Int64)
+			}
+		case executionlog.FieldCancelReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_reason", values[i])
+			} else if value.Valid {
+				_m.CancelReason = value.String
+			}
+		case executionlog.FieldTimeoutReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_reason", values[i])
+			} else if value.Valid {
+				_m.TimeoutReason = value.String
+			}
+		case executionlog.FieldRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				_m.RunID = new(string)
+				*_m.RunID = value.String
+			}
+		case executionlog.FieldEventRuleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_rule_id", values[i])
+			} else if value.Valid {
+				_m.EventRuleID = new(string)
+				*_m.EventRuleID = value.String
+			}
+		case executionlog.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = new(executionlog.EventType)
+				*_m.EventType = executionlog.EventType(value.String)
+			}
+		case executionlog.FieldEventDetail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_detail", values[i])
+			} else if value.Valid {
+				_m.EventDetail = value.String
+			}
+		case executionlog.FieldSourceExecutionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_execution_id", values[i])
+			} else if value.Valid {
+				_m.SourceExecutionID = new(string)
+				*_m.SourceExecutionID = value.String
+			}
+		case executionlog.FieldWorkflowRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_run_id", values[i])
+			} else if value.Valid {
+				_m.WorkflowRunID = new(string)
+				*_m.WorkflowRunID = value.String
+			}
+		case executionlog.FieldWorkflowStepID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_step_id", values[i])
+			} else if value.Valid {
+				_m.WorkflowStepID = value.String
+			}
+		case executionlog.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				_m.Attempt = int(value.Int64)
+			}
+		case executionlog.FieldOriginalExecutionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_execution_id", values[i])
+			} else if value.Valid {
+				_m.OriginalExecutionID = new(string)
+				*_m.OriginalExecutionID = value.String
+			}
+		case executionlog.FieldRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_at", values[i])
+			} else if value.Valid {
+				_m.RetryAt = new(time.Time)
+				*_m.RetryAt = value.Time
+			}
+		case executionlog.FieldRetriedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field retried_by", values[i])
+			} else if value.Valid {
+				_m.RetriedBy = new(string)
+				*_m.RetriedBy = value.String
+			}
+		case executionlog.FieldWaitingForSlot:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field waiting_for_slot", values[i])
+			} else if value.Valid {
+				_m.WaitingForSlot = value.Bool
+			}
+		case executionlog.FieldHeldUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field held_until", values[i])
+			} else if value.Valid {
+				_m.HeldUntil = new(time.Time)
+				*_m.HeldUntil = value.Time
+			}
+		case executionlog.FieldMaintenanceOverride:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance_override", values[i])
+			} else if value.Valid {
+				_m.MaintenanceOverride = value.String
+			}
+		case executionlog.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
+		case executionlog.FieldSecretParametersCiphertext:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_parameters_ciphertext", values[i])
+			} else if value != nil {
+				_m.SecretParametersCiphertext = value
+			}
+		case executionlog.FieldSecretParametersNonce:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_parameters_nonce", values[i])
+			} else if value != nil {
+				_m.SecretParametersNonce = *value
+			}
+		case executionlog.FieldSecretParametersKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_parameters_key_id", values[i])
+			} else if value.Valid {
+				_m.SecretParametersKeyID = value.String
+			}
+		case executionlog.FieldSecretNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SecretNames); err != nil {
+					return fmt.Errorf("unmarshal field secret_names: %w", err)
+				}
+			}
+		case executionlog.FieldApprovalExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_expires_at", values[i])
+			} else if value.Valid {
+				_m.ApprovalExpiresAt = new(time.Time)
+				*_m.ApprovalExpiresAt = value.Time
+			}
+		case executionlog.FieldApprovedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value.Valid {
+				_m.ApprovedBy = new(uint32)
+				*_m.ApprovedBy = uint32(value.Int64)
+			}
+		case executionlog.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				_m.ApprovedAt = new(time.Time)
+				*_m.ApprovedAt = value.Time
+			}
+		case executionlog.FieldApprovalComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_comment", values[i])
+			} else if value.Valid {
+				_m.ApprovalComment = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -313,6 +552,113 @@ func (_m *ExecutionLog) String() string {
 		builder.WriteString("duration_ms=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.CancelRequestedAt; v != nil {
+		builder.WriteString("cancel_requested_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelledBy; v != nil {
+		builder.WriteString("cancelled_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancel_reason=")
+	builder.WriteString(_m.CancelReason)
+	builder.WriteString(", ")
+	builder.WriteString("timeout_reason=")
+	builder.WriteString(_m.TimeoutReason)
+	builder.WriteString(", ")
+	if v := _m.RunID; v != nil {
+		builder.WriteString("run_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EventRuleID; v != nil {
+		builder.WriteString("event_rule_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EventType; v != nil {
+		builder.WriteString("event_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("event_detail=")
+	builder.WriteString(_m.EventDetail)
+	builder.WriteString(", ")
+	if v := _m.SourceExecutionID; v != nil {
+		builder.WriteString("source_execution_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.WorkflowRunID; v != nil {
+		builder.WriteString("workflow_run_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("workflow_step_id=")
+	builder.WriteString(_m.WorkflowStepID)
+	builder.WriteString(", ")
+	builder.WriteString("attempt=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempt))
+	builder.WriteString(", ")
+	if v := _m.OriginalExecutionID; v != nil {
+		builder.WriteString("original_execution_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RetryAt; v != nil {
+		builder.WriteString("retry_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RetriedBy; v != nil {
+		builder.WriteString("retried_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("waiting_for_slot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WaitingForSlot))
+	builder.WriteString(", ")
+	if v := _m.HeldUntil; v != nil {
+		builder.WriteString("held_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("maintenance_override=")
+	builder.WriteString(_m.MaintenanceOverride)
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Parameters))
+	builder.WriteString(", ")
+	builder.WriteString("secret_parameters_ciphertext=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("secret_parameters_nonce=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("secret_parameters_key_id=")
+	builder.WriteString(_m.SecretParametersKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("secret_names=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SecretNames))
+	builder.WriteString(", ")
+	if v := _m.ApprovalExpiresAt; v != nil {
+		builder.WriteString("approval_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ApprovedBy; v != nil {
+		builder.WriteString("approved_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ApprovedAt; v != nil {
+		builder.WriteString("approved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("approval_comment=")
+	builder.WriteString(_m.ApprovalComment)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -50,6 +50,60 @@ const (
 	FieldCompletedAt = "completed_at"
 	// FieldDurationMs holds the string denoting the duration_ms field in the database.
 	FieldDurationMs = "duration_ms"
+	// FieldCancelRequestedAt holds the string denoting the cancel_requested_at field in the database.
+	FieldCancelRequestedAt = "cancel_requested_at"
+	// FieldCancelledBy holds the string denoting the cancelled_by field in the database.
+	FieldCancelledBy = "cancelled_by"
+	// FieldCancelReason holds the string denoting the cancel_reason field in the database.
+	FieldCancelReason = "cancel_reason"
+	// FieldTimeoutReason holds the string denoting the timeout_reason field in the database.
+	FieldTimeoutReason = "timeout_reason"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// FieldEventRuleID holds the string denoting the event_rule_id field in the database.
+	FieldEventRuleID = "event_rule_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldEventDetail holds the string denoting the event_detail field in the database.
+	FieldEventDetail = "event_detail"
+	// FieldSourceExecutionID holds the string denoting the source_execution_id field in the database.
+	FieldSourceExecutionID = "source_execution_id"
+	// FieldWorkflowRunID holds the string denoting the workflow_run_id field in the database.
+	FieldWorkflowRunID = "workflow_run_id"
+	// FieldWorkflowStepID holds the string denoting the workflow_step_id field in the database.
+	FieldWorkflowStepID = "workflow_step_id"
+	// FieldAttempt holds the string denoting the attempt field in the database.
+	FieldAttempt = "attempt"
+	// FieldOriginalExecutionID holds the string denoting the original_execution_id field in the database.
+	FieldOriginalExecutionID = "original_execution_id"
+	// FieldRetryAt holds the string denoting the retry_at field in the database.
+	FieldRetryAt = "retry_at"
+	// FieldRetriedBy holds the string denoting the retried_by field in the database.
+	FieldRetriedBy = "retried_by"
+	// FieldWaitingForSlot holds the string denoting the waiting_for_slot field in the database.
+	FieldWaitingForSlot = "waiting_for_slot"
+	// FieldHeldUntil holds the string denoting the held_until field in the database.
+	FieldHeldUntil = "held_until"
+	// FieldMaintenanceOverride holds the string denoting the maintenance_override field in the database.
+	FieldMaintenanceOverride = "maintenance_override"
+	// FieldParameters holds the string denoting the parameters field in the database.
+	FieldParameters = "parameters"
+	// FieldSecretParametersCiphertext holds the string denoting the secret_parameters_ciphertext field in the database.
+	FieldSecretParametersCiphertext = "secret_parameters_ciphertext"
+	// FieldSecretParametersNonce holds the string denoting the secret_parameters_nonce field in the database.
+	FieldSecretParametersNonce = "secret_parameters_nonce"
+	// FieldSecretParametersKeyID holds the string denoting the secret_parameters_key_id field in the database.
+	FieldSecretParametersKeyID = "secret_parameters_key_id"
+	// FieldSecretNames holds the string denoting the secret_names field in the database.
+	FieldSecretNames = "secret_names"
+	// FieldApprovalExpiresAt holds the string denoting the approval_expires_at field in the database.
+	FieldApprovalExpiresAt = "approval_expires_at"
+	// FieldApprovedBy holds the string denoting the approved_by field in the database.
+	FieldApprovedBy = "approved_by"
+	// FieldApprovedAt holds the string denoting the approved_at field in the database.
+	FieldApprovedAt = "approved_at"
+	// FieldApprovalComment holds the string denoting the approval_comment field in the database.
+	FieldApprovalComment = "approval_comment"
 	// Table holds the table name of the executionlog in the database.
 	Table = "executor_execution_logs"
 )
@@ -75,6 +129,33 @@ var Columns = []string{
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldDurationMs,
+	FieldCancelRequestedAt,
+	FieldCancelledBy,
+	FieldCancelReason,
+	FieldTimeoutReason,
+	FieldRunID,
+	FieldEventRuleID,
+	FieldEventType,
+	FieldEventDetail,
+	FieldSourceExecutionID,
+	FieldWorkflowRunID,
+	FieldWorkflowStepID,
+	FieldAttempt,
+	FieldOriginalExecutionID,
+	FieldRetryAt,
+	FieldRetriedBy,
+	FieldWaitingForSlot,
+	FieldHeldUntil,
+	FieldMaintenanceOverride,
+	FieldParameters,
+	FieldSecretParametersCiphertext,
+	FieldSecretParametersNonce,
+	FieldSecretParametersKeyID,
+	FieldSecretNames,
+	FieldApprovalExpiresAt,
+	FieldApprovedBy,
+	FieldApprovedAt,
+	FieldApprovalComment,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -107,6 +188,38 @@ var (
 	ScriptHashValidator func(string) error
 	// RejectionReasonValidator is a validator for the "rejection_reason" field. It is called by the builders before save.
 	RejectionReasonValidator func(string) error
+	// CancelReasonValidator is a validator for the "cancel_reason" field. It is called by the builders before save.
+	CancelReasonValidator func(string) error
+	// TimeoutReasonValidator is a validator for the "timeout_reason" field. It is called by the builders before save.
+	TimeoutReasonValidator func(string) error
+	// RunIDValidator is a validator for the "run_id" field. It is called by the builders before save.
+	RunIDValidator func(string) error
+	// EventRuleIDValidator is a validator for the "event_rule_id" field. It is called by the builders before save.
+	EventRuleIDValidator func(string) error
+	// EventDetailValidator is a validator for the "event_detail" field. It is called by the builders before save.
+	EventDetailValidator func(string) error
+	// SourceExecutionIDValidator is a validator for the "source_execution_id" field. It is called by the builders before save.
+	SourceExecutionIDValidator func(string) error
+	// WorkflowRunIDValidator is a validator for the "workflow_run_id" field. It is called by the builders before save.
+	WorkflowRunIDValidator func(string) error
+	// WorkflowStepIDValidator is a validator for the "workflow_step_id" field. It is called by the builders before save.
+	WorkflowStepIDValidator func(string) error
+	// DefaultAttempt holds the default value on creation for the "attempt" field.
+	DefaultAttempt int
+	// AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	AttemptValidator func(int) error
+	// OriginalExecutionIDValidator is a validator for the "original_execution_id" field. It is called by the builders before save.
+	OriginalExecutionIDValidator func(string) error
+	// RetriedByValidator is a validator for the "retried_by" field. It is called by the builders before save.
+	RetriedByValidator func(string) error
+	// DefaultWaitingForSlot holds the default value on creation for the "waiting_for_slot" field.
+	DefaultWaitingForSlot bool
+	// MaintenanceOverrideValidator is a validator for the "maintenance_override" field. It is called by the builders before save.
+	MaintenanceOverrideValidator func(string) error
+	// SecretParametersKeyIDValidator is a validator for the "secret_parameters_key_id" field. It is called by the builders before save.
+	SecretParametersKeyIDValidator func(string) error
+	// ApprovalCommentValidator is a validator for the "approval_comment" field. It is called by the builders before save.
+	ApprovalCommentValidator func(string) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -118,6 +231,9 @@ type TriggerType string
 const (
 	TriggerTypeCLIENT_PULL TriggerType = "CLIENT_PULL"
 	TriggerTypeUI_PUSH     TriggerType = "UI_PUSH"
+	TriggerTypeSCHEDULED   TriggerType = "SCHEDULED"
+	TriggerTypeEVENT       TriggerType = "EVENT"
+	TriggerTypeWORKFLOW    TriggerType = "WORKFLOW"
 )
 
 func (tt TriggerType) String() string {
@@ -127,7 +243,7 @@ func (tt TriggerType) String() string {
 // TriggerTypeValidator is a validator for the "trigger_type" field enum values. It is called by the builders before save.
 func TriggerTypeValidator(tt TriggerType) error {
 	switch tt {
-	case TriggerTypeCLIENT_PULL, TriggerTypeUI_PUSH:
+	case TriggerTypeCLIENT_PULL, TriggerTypeUI_PUSH, TriggerTypeSCHEDULED, TriggerTypeEVENT, TriggerTypeWORKFLOW:
 		return nil
 	default:
 		return fmt.Errorf("executionlog: invalid enum value for trigger_type field: %q", tt)
@@ -149,6 +265,9 @@ const (
 	StatusREJECTED_HASH_MISMATCH Status = "REJECTED_HASH_MISMATCH"
 	StatusREJECTED_NOT_APPROVED  Status = "REJECTED_NOT_APPROVED"
 	StatusCLIENT_OFFLINE         Status = "CLIENT_OFFLINE"
+	StatusTIMED_OUT              Status = "TIMED_OUT"
+	StatusCANCELLED              Status = "CANCELLED"
+	StatusAWAITING_APPROVAL      Status = "AWAITING_APPROVAL"
 )
 
 func (s Status) String() string {
@@ -158,13 +277,38 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPENDING, StatusRUNNING, StatusCOMPLETED, StatusFAILED, StatusREJECTED_HASH_MISMATCH, StatusREJECTED_NOT_APPROVED, StatusCLIENT_OFFLINE:
+	case StatusPENDING, StatusRUNNING, StatusCOMPLETED, StatusFAILED, StatusREJECTED_HASH_MISMATCH, StatusREJECTED_NOT_APPROVED, StatusCLIENT_OFFLINE, StatusTIMED_OUT, StatusCANCELLED, StatusAWAITING_APPROVAL:
 		return nil
 	default:
 		return fmt.Errorf("executionlog: invalid enum value for status field: %q", s)
 	}
 }
 
+// EventType defines the type for the "event_type" enum field.
+type EventType string
+
+// EventType values.
+const (
+	EventTypeCLIENT_FIRST_CONNECT   EventType = "CLIENT_FIRST_CONNECT"
+	EventTypeCLIENT_VERSION_CHANGED EventType = "CLIENT_VERSION_CHANGED"
+	EventTypeCLIENT_LABELS_CHANGED  EventType = "CLIENT_LABELS_CHANGED"
+	EventTypeSCRIPT_FAILED          EventType = "SCRIPT_FAILED"
+)
+
+func (et EventType) String() string {
+	return string(et)
+}
+
+// EventTypeValidator is a validator for the "event_type" field enum values. It is called by the builders before save.
+func EventTypeValidator(et EventType) error {
+	switch et {
+	case EventTypeCLIENT_FIRST_CONNECT, EventTypeCLIENT_VERSION_CHANGED, EventTypeCLIENT_LABELS_CHANGED, EventTypeSCRIPT_FAILED:
+		return nil
+	default:
+		return fmt.Errorf("executionlog: invalid enum value for event_type field: %q", et)
+	}
+}
+
 // OrderOption defines the ordering options for the ExecutionLog queries.
 type OrderOption func(*sql.Selector)
 
@@ -262,3 +406,118 @@ func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
 }
+
+// ByCancelRequestedAt orders the results by the cancel_requested_at field.
+func ByCancelRequestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelRequestedAt, opts...).ToFunc()
+}
+
+// ByCancelledBy orders the results by the cancelled_by field.
+func ByCancelledBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledBy, opts...).ToFunc()
+}
+
+// ByCancelReason orders the results by the cancel_reason field.
+func ByCancelReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelReason, opts...).ToFunc()
+}
+
+// ByTimeoutReason orders the results by the timeout_reason field.
+func ByTimeoutReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeoutReason, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByEventRuleID orders the results by the event_rule_id field.
+func ByEventRuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventRuleID, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByEventDetail orders the results by the event_detail field.
+func ByEventDetail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventDetail, opts...).ToFunc()
+}
+
+// BySourceExecutionID orders the results by the source_execution_id field.
+func BySourceExecutionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceExecutionID, opts...).ToFunc()
+}
+
+// ByWorkflowRunID orders the results by the workflow_run_id field.
+func ByWorkflowRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowRunID, opts...).ToFunc()
+}
+
+// ByWorkflowStepID orders the results by the workflow_step_id field.
+func ByWorkflowStepID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowStepID, opts...).ToFunc()
+}
+
+// ByAttempt orders the results by the attempt field.
+func ByAttempt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempt, opts...).ToFunc()
+}
+
+// ByOriginalExecutionID orders the results by the original_execution_id field.
+func ByOriginalExecutionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalExecutionID, opts...).ToFunc()
+}
+
+// ByRetryAt orders the results by the retry_at field.
+func ByRetryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetryAt, opts...).ToFunc()
+}
+
+// ByRetriedBy orders the results by the retried_by field.
+func ByRetriedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetriedBy, opts...).ToFunc()
+}
+
+// ByWaitingForSlot orders the results by the waiting_for_slot field.
+func ByWaitingForSlot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaitingForSlot, opts...).ToFunc()
+}
+
+// ByHeldUntil orders the results by the held_until field.
+func ByHeldUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeldUntil, opts...).ToFunc()
+}
+
+// ByMaintenanceOverride orders the results by the maintenance_override field.
+func ByMaintenanceOverride(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaintenanceOverride, opts...).ToFunc()
+}
+
+// BySecretParametersKeyID orders the results by the secret_parameters_key_id field.
+func BySecretParametersKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecretParametersKeyID, opts...).ToFunc()
+}
+
+// ByApprovalExpiresAt orders the results by the approval_expires_at field.
+func ByApprovalExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalExpiresAt, opts...).ToFunc()
+}
+
+// ByApprovedBy orders the results by the approved_by field.
+func ByApprovedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedBy, opts...).ToFunc()
+}
+
+// ByApprovedAt orders the results by the approved_at field.
+func ByApprovedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedAt, opts...).ToFunc()
+}
+
+// ByApprovalComment orders the results by the approval_comment field.
+func ByApprovalComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalComment, opts...).ToFunc()
+}
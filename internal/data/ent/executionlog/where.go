@@ -144,6 +144,126 @@ func DurationMs(v int64) predicate.ExecutionLog {
 	return predicate.ExecutionLog(sql.FieldEQ(FieldDurationMs, v))
 }
 
+// CancelRequestedAt applies equality check predicate on the "cancel_requested_at" field. It's identical to CancelRequestedAtEQ.
+func CancelRequestedAt(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldCancelRequestedAt, v))
+}
+
+// CancelledBy applies equality check predicate on the "cancelled_by" field. It's identical to CancelledByEQ.
+func CancelledBy(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldCancelledBy, v))
+}
+
+// CancelReason applies equality check predicate on the "cancel_reason" field. It's identical to CancelReasonEQ.
+func CancelReason(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// TimeoutReason applies equality check predicate on the "timeout_reason" field. It's identical to TimeoutReasonEQ.
+func TimeoutReason(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldTimeoutReason, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldRunID, v))
+}
+
+// EventRuleID applies equality check predicate on the "event_rule_id" field. It's identical to EventRuleIDEQ.
+func EventRuleID(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldEventRuleID, v))
+}
+
+// EventDetail applies equality check predicate on the "event_detail" field. It's identical to EventDetailEQ.
+func EventDetail(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldEventDetail, v))
+}
+
+// SourceExecutionID applies equality check predicate on the "source_execution_id" field. It's identical to SourceExecutionIDEQ.
+func SourceExecutionID(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldSourceExecutionID, v))
+}
+
+// WorkflowRunID applies equality check predicate on the "workflow_run_id" field. It's identical to WorkflowRunIDEQ.
+func WorkflowRunID(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldWorkflowRunID, v))
+}
+
+// WorkflowStepID applies equality check predicate on the "workflow_step_id" field. It's identical to WorkflowStepIDEQ.
+func WorkflowStepID(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldWorkflowStepID, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldAttempt, v))
+}
+
+// OriginalExecutionID applies equality check predicate on the "original_execution_id" field. It's identical to OriginalExecutionIDEQ.
+func OriginalExecutionID(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldOriginalExecutionID, v))
+}
+
+// RetryAt applies equality check predicate on the "retry_at" field. It's identical to RetryAtEQ.
+func RetryAt(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldRetryAt, v))
+}
+
+// RetriedBy applies equality check predicate on the "retried_by" field. It's identical to RetriedByEQ.
+func RetriedBy(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldRetriedBy, v))
+}
+
+// WaitingForSlot applies equality check predicate on the "waiting_for_slot" field. It's identical to WaitingForSlotEQ.
+func WaitingForSlot(v bool) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldWaitingForSlot, v))
+}
+
+// HeldUntil applies equality check predicate on the "held_until" field. It's identical to HeldUntilEQ.
+func HeldUntil(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldHeldUntil, v))
+}
+
+// MaintenanceOverride applies equality check predicate on the "maintenance_override" field. It's identical to MaintenanceOverrideEQ.
+func MaintenanceOverride(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldMaintenanceOverride, v))
+}
+
+// SecretParametersCiphertext applies equality check predicate on the "secret_parameters_ciphertext" field. It's identical to SecretParametersCiphertextEQ.
+func SecretParametersCiphertext(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldSecretParametersCiphertext, v))
+}
+
+// SecretParametersNonce applies equality check predicate on the "secret_parameters_nonce" field. It's identical to SecretParametersNonceEQ.
+func SecretParametersNonce(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldSecretParametersNonce, v))
+}
+
+// SecretParametersKeyID applies equality check predicate on the "secret_parameters_key_id" field. It's identical to SecretParametersKeyIDEQ.
+func SecretParametersKeyID(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldSecretParametersKeyID, v))
+}
+
+// ApprovalExpiresAt applies equality check predicate on the "approval_expires_at" field. It's identical to ApprovalExpiresAtEQ.
+func ApprovalExpiresAt(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldApprovalExpiresAt, v))
+}
+
+// ApprovedBy applies equality check predicate on the "approved_by" field. It's identical to ApprovedByEQ.
+func ApprovedBy(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovalComment applies equality check predicate on the "approval_comment" field. It's identical to ApprovalCommentEQ.
+func ApprovalComment(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldApprovalComment, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.ExecutionLog {
 	return predicate.ExecutionLog(sql.FieldEQ(FieldCreateBy, v))
@@ -1119,6 +1239,1531 @@ func DurationMsNotNil() predicate.ExecutionLog {
 	return predicate.ExecutionLog(sql.FieldNotNull(FieldDurationMs))
 }
 
+// CancelRequestedAtEQ applies the EQ predicate on the "cancel_requested_at" field.
+func CancelRequestedAtEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldCancelRequestedAt, v))
+}
+
+// CancelRequestedAtNEQ applies the NEQ predicate on the "cancel_requested_at" field.
+func CancelRequestedAtNEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldCancelRequestedAt, v))
+}
+
+// CancelRequestedAtIn applies the In predicate on the "cancel_requested_at" field.
+func CancelRequestedAtIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldCancelRequestedAt, vs...))
+}
+
+// CancelRequestedAtNotIn applies the NotIn predicate on the "cancel_requested_at" field.
+func CancelRequestedAtNotIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldCancelRequestedAt, vs...))
+}
+
+// CancelRequestedAtGT applies the GT predicate on the "cancel_requested_at" field.
+func CancelRequestedAtGT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldCancelRequestedAt, v))
+}
+
+// CancelRequestedAtGTE applies the GTE predicate on the "cancel_requested_at" field.
+func CancelRequestedAtGTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldCancelRequestedAt, v))
+}
+
+// CancelRequestedAtLT applies the LT predicate on the "cancel_requested_at" field.
+func CancelRequestedAtLT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldCancelRequestedAt, v))
+}
+
+// CancelRequestedAtLTE applies the LTE predicate on the "cancel_requested_at" field.
+func CancelRequestedAtLTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldCancelRequestedAt, v))
+}
+
+// CancelRequestedAtIsNil applies the IsNil predicate on the "cancel_requested_at" field.
+func CancelRequestedAtIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldCancelRequestedAt))
+}
+
+// CancelRequestedAtNotNil applies the NotNil predicate on the "cancel_requested_at" field.
+func CancelRequestedAtNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldCancelRequestedAt))
+}
+
+// CancelledByEQ applies the EQ predicate on the "cancelled_by" field.
+func CancelledByEQ(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldCancelledBy, v))
+}
+
+// CancelledByNEQ applies the NEQ predicate on the "cancelled_by" field.
+func CancelledByNEQ(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldCancelledBy, v))
+}
+
+// CancelledByIn applies the In predicate on the "cancelled_by" field.
+func CancelledByIn(vs ...uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldCancelledBy, vs...))
+}
+
+// CancelledByNotIn applies the NotIn predicate on the "cancelled_by" field.
+func CancelledByNotIn(vs ...uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldCancelledBy, vs...))
+}
+
+// CancelledByGT applies the GT predicate on the "cancelled_by" field.
+func CancelledByGT(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldCancelledBy, v))
+}
+
+// CancelledByGTE applies the GTE predicate on the "cancelled_by" field.
+func CancelledByGTE(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldCancelledBy, v))
+}
+
+// CancelledByLT applies the LT predicate on the "cancelled_by" field.
+func CancelledByLT(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldCancelledBy, v))
+}
+
+// CancelledByLTE applies the LTE predicate on the "cancelled_by" field.
+func CancelledByLTE(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldCancelledBy, v))
+}
+
+// CancelledByIsNil applies the IsNil predicate on the "cancelled_by" field.
+func CancelledByIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldCancelledBy))
+}
+
+// CancelledByNotNil applies the NotNil predicate on the "cancelled_by" field.
+func CancelledByNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldCancelledBy))
+}
+
+// CancelReasonEQ applies the EQ predicate on the "cancel_reason" field.
+func CancelReasonEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// CancelReasonNEQ applies the NEQ predicate on the "cancel_reason" field.
+func CancelReasonNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldCancelReason, v))
+}
+
+// CancelReasonIn applies the In predicate on the "cancel_reason" field.
+func CancelReasonIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonNotIn applies the NotIn predicate on the "cancel_reason" field.
+func CancelReasonNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonGT applies the GT predicate on the "cancel_reason" field.
+func CancelReasonGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldCancelReason, v))
+}
+
+// CancelReasonGTE applies the GTE predicate on the "cancel_reason" field.
+func CancelReasonGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldCancelReason, v))
+}
+
+// CancelReasonLT applies the LT predicate on the "cancel_reason" field.
+func CancelReasonLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldCancelReason, v))
+}
+
+// CancelReasonLTE applies the LTE predicate on the "cancel_reason" field.
+func CancelReasonLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldCancelReason, v))
+}
+
+// CancelReasonContains applies the Contains predicate on the "cancel_reason" field.
+func CancelReasonContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldCancelReason, v))
+}
+
+// CancelReasonHasPrefix applies the HasPrefix predicate on the "cancel_reason" field.
+func CancelReasonHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldCancelReason, v))
+}
+
+// CancelReasonHasSuffix applies the HasSuffix predicate on the "cancel_reason" field.
+func CancelReasonHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldCancelReason, v))
+}
+
+// CancelReasonIsNil applies the IsNil predicate on the "cancel_reason" field.
+func CancelReasonIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldCancelReason))
+}
+
+// CancelReasonNotNil applies the NotNil predicate on the "cancel_reason" field.
+func CancelReasonNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldCancelReason))
+}
+
+// CancelReasonEqualFold applies the EqualFold predicate on the "cancel_reason" field.
+func CancelReasonEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldCancelReason, v))
+}
+
+// CancelReasonContainsFold applies the ContainsFold predicate on the "cancel_reason" field.
+func CancelReasonContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldCancelReason, v))
+}
+
+// TimeoutReasonEQ applies the EQ predicate on the "timeout_reason" field.
+func TimeoutReasonEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonNEQ applies the NEQ predicate on the "timeout_reason" field.
+func TimeoutReasonNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonIn applies the In predicate on the "timeout_reason" field.
+func TimeoutReasonIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldTimeoutReason, vs...))
+}
+
+// TimeoutReasonNotIn applies the NotIn predicate on the "timeout_reason" field.
+func TimeoutReasonNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldTimeoutReason, vs...))
+}
+
+// TimeoutReasonGT applies the GT predicate on the "timeout_reason" field.
+func TimeoutReasonGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonGTE applies the GTE predicate on the "timeout_reason" field.
+func TimeoutReasonGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonLT applies the LT predicate on the "timeout_reason" field.
+func TimeoutReasonLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonLTE applies the LTE predicate on the "timeout_reason" field.
+func TimeoutReasonLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonContains applies the Contains predicate on the "timeout_reason" field.
+func TimeoutReasonContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonHasPrefix applies the HasPrefix predicate on the "timeout_reason" field.
+func TimeoutReasonHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonHasSuffix applies the HasSuffix predicate on the "timeout_reason" field.
+func TimeoutReasonHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonIsNil applies the IsNil predicate on the "timeout_reason" field.
+func TimeoutReasonIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldTimeoutReason))
+}
+
+// TimeoutReasonNotNil applies the NotNil predicate on the "timeout_reason" field.
+func TimeoutReasonNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldTimeoutReason))
+}
+
+// TimeoutReasonEqualFold applies the EqualFold predicate on the "timeout_reason" field.
+func TimeoutReasonEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldTimeoutReason, v))
+}
+
+// TimeoutReasonContainsFold applies the ContainsFold predicate on the "timeout_reason" field.
+func TimeoutReasonContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldTimeoutReason, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// RunIDGT applies the GT predicate on the "run_id" field.
+func RunIDGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldRunID, v))
+}
+
+// RunIDGTE applies the GTE predicate on the "run_id" field.
+func RunIDGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldRunID, v))
+}
+
+// RunIDLT applies the LT predicate on the "run_id" field.
+func RunIDLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldRunID, v))
+}
+
+// RunIDLTE applies the LTE predicate on the "run_id" field.
+func RunIDLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldRunID, v))
+}
+
+// RunIDContains applies the Contains predicate on the "run_id" field.
+func RunIDContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldRunID, v))
+}
+
+// RunIDHasPrefix applies the HasPrefix predicate on the "run_id" field.
+func RunIDHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldRunID, v))
+}
+
+// RunIDHasSuffix applies the HasSuffix predicate on the "run_id" field.
+func RunIDHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldRunID, v))
+}
+
+// RunIDIsNil applies the IsNil predicate on the "run_id" field.
+func RunIDIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldRunID))
+}
+
+// RunIDNotNil applies the NotNil predicate on the "run_id" field.
+func RunIDNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldRunID))
+}
+
+// RunIDEqualFold applies the EqualFold predicate on the "run_id" field.
+func RunIDEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldRunID, v))
+}
+
+// RunIDContainsFold applies the ContainsFold predicate on the "run_id" field.
+func RunIDContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldRunID, v))
+}
+
+// EventRuleIDEQ applies the EQ predicate on the "event_rule_id" field.
+func EventRuleIDEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldEventRuleID, v))
+}
+
+// EventRuleIDNEQ applies the NEQ predicate on the "event_rule_id" field.
+func EventRuleIDNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldEventRuleID, v))
+}
+
+// EventRuleIDIn applies the In predicate on the "event_rule_id" field.
+func EventRuleIDIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldEventRuleID, vs...))
+}
+
+// EventRuleIDNotIn applies the NotIn predicate on the "event_rule_id" field.
+func EventRuleIDNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldEventRuleID, vs...))
+}
+
+// EventRuleIDGT applies the GT predicate on the "event_rule_id" field.
+func EventRuleIDGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldEventRuleID, v))
+}
+
+// EventRuleIDGTE applies the GTE predicate on the "event_rule_id" field.
+func EventRuleIDGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldEventRuleID, v))
+}
+
+// EventRuleIDLT applies the LT predicate on the "event_rule_id" field.
+func EventRuleIDLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldEventRuleID, v))
+}
+
+// EventRuleIDLTE applies the LTE predicate on the "event_rule_id" field.
+func EventRuleIDLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldEventRuleID, v))
+}
+
+// EventRuleIDContains applies the Contains predicate on the "event_rule_id" field.
+func EventRuleIDContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldEventRuleID, v))
+}
+
+// EventRuleIDHasPrefix applies the HasPrefix predicate on the "event_rule_id" field.
+func EventRuleIDHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldEventRuleID, v))
+}
+
+// EventRuleIDHasSuffix applies the HasSuffix predicate on the "event_rule_id" field.
+func EventRuleIDHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldEventRuleID, v))
+}
+
+// EventRuleIDIsNil applies the IsNil predicate on the "event_rule_id" field.
+func EventRuleIDIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldEventRuleID))
+}
+
+// EventRuleIDNotNil applies the NotNil predicate on the "event_rule_id" field.
+func EventRuleIDNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldEventRuleID))
+}
+
+// EventRuleIDEqualFold applies the EqualFold predicate on the "event_rule_id" field.
+func EventRuleIDEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldEventRuleID, v))
+}
+
+// EventRuleIDContainsFold applies the ContainsFold predicate on the "event_rule_id" field.
+func EventRuleIDContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldEventRuleID, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v EventType) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v EventType) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...EventType) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...EventType) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeIsNil applies the IsNil predicate on the "event_type" field.
+func EventTypeIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldEventType))
+}
+
+// EventTypeNotNil applies the NotNil predicate on the "event_type" field.
+func EventTypeNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldEventType))
+}
+
+// EventDetailEQ applies the EQ predicate on the "event_detail" field.
+func EventDetailEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldEventDetail, v))
+}
+
+// EventDetailNEQ applies the NEQ predicate on the "event_detail" field.
+func EventDetailNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldEventDetail, v))
+}
+
+// EventDetailIn applies the In predicate on the "event_detail" field.
+func EventDetailIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldEventDetail, vs...))
+}
+
+// EventDetailNotIn applies the NotIn predicate on the "event_detail" field.
+func EventDetailNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldEventDetail, vs...))
+}
+
+// EventDetailGT applies the GT predicate on the "event_detail" field.
+func EventDetailGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldEventDetail, v))
+}
+
+// EventDetailGTE applies the GTE predicate on the "event_detail" field.
+func EventDetailGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldEventDetail, v))
+}
+
+// EventDetailLT applies the LT predicate on the "event_detail" field.
+func EventDetailLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldEventDetail, v))
+}
+
+// EventDetailLTE applies the LTE predicate on the "event_detail" field.
+func EventDetailLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldEventDetail, v))
+}
+
+// EventDetailContains applies the Contains predicate on the "event_detail" field.
+func EventDetailContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldEventDetail, v))
+}
+
+// EventDetailHasPrefix applies the HasPrefix predicate on the "event_detail" field.
+func EventDetailHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldEventDetail, v))
+}
+
+// EventDetailHasSuffix applies the HasSuffix predicate on the "event_detail" field.
+func EventDetailHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldEventDetail, v))
+}
+
+// EventDetailIsNil applies the IsNil predicate on the "event_detail" field.
+func EventDetailIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldEventDetail))
+}
+
+// EventDetailNotNil applies the NotNil predicate on the "event_detail" field.
+func EventDetailNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldEventDetail))
+}
+
+// EventDetailEqualFold applies the EqualFold predicate on the "event_detail" field.
+func EventDetailEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldEventDetail, v))
+}
+
+// EventDetailContainsFold applies the ContainsFold predicate on the "event_detail" field.
+func EventDetailContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldEventDetail, v))
+}
+
+// SourceExecutionIDEQ applies the EQ predicate on the "source_execution_id" field.
+func SourceExecutionIDEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDNEQ applies the NEQ predicate on the "source_execution_id" field.
+func SourceExecutionIDNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDIn applies the In predicate on the "source_execution_id" field.
+func SourceExecutionIDIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldSourceExecutionID, vs...))
+}
+
+// SourceExecutionIDNotIn applies the NotIn predicate on the "source_execution_id" field.
+func SourceExecutionIDNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldSourceExecutionID, vs...))
+}
+
+// SourceExecutionIDGT applies the GT predicate on the "source_execution_id" field.
+func SourceExecutionIDGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDGTE applies the GTE predicate on the "source_execution_id" field.
+func SourceExecutionIDGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDLT applies the LT predicate on the "source_execution_id" field.
+func SourceExecutionIDLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDLTE applies the LTE predicate on the "source_execution_id" field.
+func SourceExecutionIDLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDContains applies the Contains predicate on the "source_execution_id" field.
+func SourceExecutionIDContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDHasPrefix applies the HasPrefix predicate on the "source_execution_id" field.
+func SourceExecutionIDHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDHasSuffix applies the HasSuffix predicate on the "source_execution_id" field.
+func SourceExecutionIDHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDIsNil applies the IsNil predicate on the "source_execution_id" field.
+func SourceExecutionIDIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldSourceExecutionID))
+}
+
+// SourceExecutionIDNotNil applies the NotNil predicate on the "source_execution_id" field.
+func SourceExecutionIDNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldSourceExecutionID))
+}
+
+// SourceExecutionIDEqualFold applies the EqualFold predicate on the "source_execution_id" field.
+func SourceExecutionIDEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldSourceExecutionID, v))
+}
+
+// SourceExecutionIDContainsFold applies the ContainsFold predicate on the "source_execution_id" field.
+func SourceExecutionIDContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldSourceExecutionID, v))
+}
+
+// WorkflowRunIDEQ applies the EQ predicate on the "workflow_run_id" field.
+func WorkflowRunIDEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDNEQ applies the NEQ predicate on the "workflow_run_id" field.
+func WorkflowRunIDNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDIn applies the In predicate on the "workflow_run_id" field.
+func WorkflowRunIDIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldWorkflowRunID, vs...))
+}
+
+// WorkflowRunIDNotIn applies the NotIn predicate on the "workflow_run_id" field.
+func WorkflowRunIDNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldWorkflowRunID, vs...))
+}
+
+// WorkflowRunIDGT applies the GT predicate on the "workflow_run_id" field.
+func WorkflowRunIDGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDGTE applies the GTE predicate on the "workflow_run_id" field.
+func WorkflowRunIDGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDLT applies the LT predicate on the "workflow_run_id" field.
+func WorkflowRunIDLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDLTE applies the LTE predicate on the "workflow_run_id" field.
+func WorkflowRunIDLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDContains applies the Contains predicate on the "workflow_run_id" field.
+func WorkflowRunIDContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDHasPrefix applies the HasPrefix predicate on the "workflow_run_id" field.
+func WorkflowRunIDHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDHasSuffix applies the HasSuffix predicate on the "workflow_run_id" field.
+func WorkflowRunIDHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDIsNil applies the IsNil predicate on the "workflow_run_id" field.
+func WorkflowRunIDIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldWorkflowRunID))
+}
+
+// WorkflowRunIDNotNil applies the NotNil predicate on the "workflow_run_id" field.
+func WorkflowRunIDNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldWorkflowRunID))
+}
+
+// WorkflowRunIDEqualFold applies the EqualFold predicate on the "workflow_run_id" field.
+func WorkflowRunIDEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDContainsFold applies the ContainsFold predicate on the "workflow_run_id" field.
+func WorkflowRunIDContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldWorkflowRunID, v))
+}
+
+// WorkflowStepIDEQ applies the EQ predicate on the "workflow_step_id" field.
+func WorkflowStepIDEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDNEQ applies the NEQ predicate on the "workflow_step_id" field.
+func WorkflowStepIDNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDIn applies the In predicate on the "workflow_step_id" field.
+func WorkflowStepIDIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldWorkflowStepID, vs...))
+}
+
+// WorkflowStepIDNotIn applies the NotIn predicate on the "workflow_step_id" field.
+func WorkflowStepIDNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldWorkflowStepID, vs...))
+}
+
+// WorkflowStepIDGT applies the GT predicate on the "workflow_step_id" field.
+func WorkflowStepIDGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDGTE applies the GTE predicate on the "workflow_step_id" field.
+func WorkflowStepIDGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDLT applies the LT predicate on the "workflow_step_id" field.
+func WorkflowStepIDLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDLTE applies the LTE predicate on the "workflow_step_id" field.
+func WorkflowStepIDLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDContains applies the Contains predicate on the "workflow_step_id" field.
+func WorkflowStepIDContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDHasPrefix applies the HasPrefix predicate on the "workflow_step_id" field.
+func WorkflowStepIDHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDHasSuffix applies the HasSuffix predicate on the "workflow_step_id" field.
+func WorkflowStepIDHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDIsNil applies the IsNil predicate on the "workflow_step_id" field.
+func WorkflowStepIDIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldWorkflowStepID))
+}
+
+// WorkflowStepIDNotNil applies the NotNil predicate on the "workflow_step_id" field.
+func WorkflowStepIDNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldWorkflowStepID))
+}
+
+// WorkflowStepIDEqualFold applies the EqualFold predicate on the "workflow_step_id" field.
+func WorkflowStepIDEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldWorkflowStepID, v))
+}
+
+// WorkflowStepIDContainsFold applies the ContainsFold predicate on the "workflow_step_id" field.
+func WorkflowStepIDContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldWorkflowStepID, v))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldAttempt, v))
+}
+
+// OriginalExecutionIDEQ applies the EQ predicate on the "original_execution_id" field.
+func OriginalExecutionIDEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDNEQ applies the NEQ predicate on the "original_execution_id" field.
+func OriginalExecutionIDNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDIn applies the In predicate on the "original_execution_id" field.
+func OriginalExecutionIDIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldOriginalExecutionID, vs...))
+}
+
+// OriginalExecutionIDNotIn applies the NotIn predicate on the "original_execution_id" field.
+func OriginalExecutionIDNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldOriginalExecutionID, vs...))
+}
+
+// OriginalExecutionIDGT applies the GT predicate on the "original_execution_id" field.
+func OriginalExecutionIDGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDGTE applies the GTE predicate on the "original_execution_id" field.
+func OriginalExecutionIDGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDLT applies the LT predicate on the "original_execution_id" field.
+func OriginalExecutionIDLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDLTE applies the LTE predicate on the "original_execution_id" field.
+func OriginalExecutionIDLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDContains applies the Contains predicate on the "original_execution_id" field.
+func OriginalExecutionIDContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDHasPrefix applies the HasPrefix predicate on the "original_execution_id" field.
+func OriginalExecutionIDHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDHasSuffix applies the HasSuffix predicate on the "original_execution_id" field.
+func OriginalExecutionIDHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDIsNil applies the IsNil predicate on the "original_execution_id" field.
+func OriginalExecutionIDIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldOriginalExecutionID))
+}
+
+// OriginalExecutionIDNotNil applies the NotNil predicate on the "original_execution_id" field.
+func OriginalExecutionIDNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldOriginalExecutionID))
+}
+
+// OriginalExecutionIDEqualFold applies the EqualFold predicate on the "original_execution_id" field.
+func OriginalExecutionIDEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldOriginalExecutionID, v))
+}
+
+// OriginalExecutionIDContainsFold applies the ContainsFold predicate on the "original_execution_id" field.
+func OriginalExecutionIDContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldOriginalExecutionID, v))
+}
+
+// RetryAtEQ applies the EQ predicate on the "retry_at" field.
+func RetryAtEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldRetryAt, v))
+}
+
+// RetryAtNEQ applies the NEQ predicate on the "retry_at" field.
+func RetryAtNEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldRetryAt, v))
+}
+
+// RetryAtIn applies the In predicate on the "retry_at" field.
+func RetryAtIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldRetryAt, vs...))
+}
+
+// RetryAtNotIn applies the NotIn predicate on the "retry_at" field.
+func RetryAtNotIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldRetryAt, vs...))
+}
+
+// RetryAtGT applies the GT predicate on the "retry_at" field.
+func RetryAtGT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldRetryAt, v))
+}
+
+// RetryAtGTE applies the GTE predicate on the "retry_at" field.
+func RetryAtGTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldRetryAt, v))
+}
+
+// RetryAtLT applies the LT predicate on the "retry_at" field.
+func RetryAtLT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldRetryAt, v))
+}
+
+// RetryAtLTE applies the LTE predicate on the "retry_at" field.
+func RetryAtLTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldRetryAt, v))
+}
+
+// RetryAtIsNil applies the IsNil predicate on the "retry_at" field.
+func RetryAtIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldRetryAt))
+}
+
+// RetryAtNotNil applies the NotNil predicate on the "retry_at" field.
+func RetryAtNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldRetryAt))
+}
+
+// RetriedByEQ applies the EQ predicate on the "retried_by" field.
+func RetriedByEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldRetriedBy, v))
+}
+
+// RetriedByNEQ applies the NEQ predicate on the "retried_by" field.
+func RetriedByNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldRetriedBy, v))
+}
+
+// RetriedByIn applies the In predicate on the "retried_by" field.
+func RetriedByIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldRetriedBy, vs...))
+}
+
+// RetriedByNotIn applies the NotIn predicate on the "retried_by" field.
+func RetriedByNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldRetriedBy, vs...))
+}
+
+// RetriedByGT applies the GT predicate on the "retried_by" field.
+func RetriedByGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldRetriedBy, v))
+}
+
+// RetriedByGTE applies the GTE predicate on the "retried_by" field.
+func RetriedByGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldRetriedBy, v))
+}
+
+// RetriedByLT applies the LT predicate on the "retried_by" field.
+func RetriedByLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldRetriedBy, v))
+}
+
+// RetriedByLTE applies the LTE predicate on the "retried_by" field.
+func RetriedByLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldRetriedBy, v))
+}
+
+// RetriedByContains applies the Contains predicate on the "retried_by" field.
+func RetriedByContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldRetriedBy, v))
+}
+
+// RetriedByHasPrefix applies the HasPrefix predicate on the "retried_by" field.
+func RetriedByHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldRetriedBy, v))
+}
+
+// RetriedByHasSuffix applies the HasSuffix predicate on the "retried_by" field.
+func RetriedByHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldRetriedBy, v))
+}
+
+// RetriedByIsNil applies the IsNil predicate on the "retried_by" field.
+func RetriedByIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldRetriedBy))
+}
+
+// RetriedByNotNil applies the NotNil predicate on the "retried_by" field.
+func RetriedByNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldRetriedBy))
+}
+
+// RetriedByEqualFold applies the EqualFold predicate on the "retried_by" field.
+func RetriedByEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldRetriedBy, v))
+}
+
+// RetriedByContainsFold applies the ContainsFold predicate on the "retried_by" field.
+func RetriedByContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldRetriedBy, v))
+}
+
+// WaitingForSlotEQ applies the EQ predicate on the "waiting_for_slot" field.
+func WaitingForSlotEQ(v bool) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldWaitingForSlot, v))
+}
+
+// WaitingForSlotNEQ applies the NEQ predicate on the "waiting_for_slot" field.
+func WaitingForSlotNEQ(v bool) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldWaitingForSlot, v))
+}
+
+// HeldUntilEQ applies the EQ predicate on the "held_until" field.
+func HeldUntilEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldHeldUntil, v))
+}
+
+// HeldUntilNEQ applies the NEQ predicate on the "held_until" field.
+func HeldUntilNEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldHeldUntil, v))
+}
+
+// HeldUntilIn applies the In predicate on the "held_until" field.
+func HeldUntilIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldHeldUntil, vs...))
+}
+
+// HeldUntilNotIn applies the NotIn predicate on the "held_until" field.
+func HeldUntilNotIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldHeldUntil, vs...))
+}
+
+// HeldUntilGT applies the GT predicate on the "held_until" field.
+func HeldUntilGT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldHeldUntil, v))
+}
+
+// HeldUntilGTE applies the GTE predicate on the "held_until" field.
+func HeldUntilGTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldHeldUntil, v))
+}
+
+// HeldUntilLT applies the LT predicate on the "held_until" field.
+func HeldUntilLT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldHeldUntil, v))
+}
+
+// HeldUntilLTE applies the LTE predicate on the "held_until" field.
+func HeldUntilLTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldHeldUntil, v))
+}
+
+// HeldUntilIsNil applies the IsNil predicate on the "held_until" field.
+func HeldUntilIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldHeldUntil))
+}
+
+// HeldUntilNotNil applies the NotNil predicate on the "held_until" field.
+func HeldUntilNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldHeldUntil))
+}
+
+// MaintenanceOverrideEQ applies the EQ predicate on the "maintenance_override" field.
+func MaintenanceOverrideEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideNEQ applies the NEQ predicate on the "maintenance_override" field.
+func MaintenanceOverrideNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideIn applies the In predicate on the "maintenance_override" field.
+func MaintenanceOverrideIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldMaintenanceOverride, vs...))
+}
+
+// MaintenanceOverrideNotIn applies the NotIn predicate on the "maintenance_override" field.
+func MaintenanceOverrideNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldMaintenanceOverride, vs...))
+}
+
+// MaintenanceOverrideGT applies the GT predicate on the "maintenance_override" field.
+func MaintenanceOverrideGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideGTE applies the GTE predicate on the "maintenance_override" field.
+func MaintenanceOverrideGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideLT applies the LT predicate on the "maintenance_override" field.
+func MaintenanceOverrideLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideLTE applies the LTE predicate on the "maintenance_override" field.
+func MaintenanceOverrideLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideContains applies the Contains predicate on the "maintenance_override" field.
+func MaintenanceOverrideContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideHasPrefix applies the HasPrefix predicate on the "maintenance_override" field.
+func MaintenanceOverrideHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideHasSuffix applies the HasSuffix predicate on the "maintenance_override" field.
+func MaintenanceOverrideHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideIsNil applies the IsNil predicate on the "maintenance_override" field.
+func MaintenanceOverrideIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldMaintenanceOverride))
+}
+
+// MaintenanceOverrideNotNil applies the NotNil predicate on the "maintenance_override" field.
+func MaintenanceOverrideNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldMaintenanceOverride))
+}
+
+// MaintenanceOverrideEqualFold applies the EqualFold predicate on the "maintenance_override" field.
+func MaintenanceOverrideEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldMaintenanceOverride, v))
+}
+
+// MaintenanceOverrideContainsFold applies the ContainsFold predicate on the "maintenance_override" field.
+func MaintenanceOverrideContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldMaintenanceOverride, v))
+}
+
+// ParametersIsNil applies the IsNil predicate on the "parameters" field.
+func ParametersIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldParameters))
+}
+
+// ParametersNotNil applies the NotNil predicate on the "parameters" field.
+func ParametersNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldParameters))
+}
+
+// SecretParametersCiphertextEQ applies the EQ predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextEQ(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldSecretParametersCiphertext, v))
+}
+
+// SecretParametersCiphertextNEQ applies the NEQ predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextNEQ(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldSecretParametersCiphertext, v))
+}
+
+// SecretParametersCiphertextIn applies the In predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextIn(vs ...[]byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldSecretParametersCiphertext, vs...))
+}
+
+// SecretParametersCiphertextNotIn applies the NotIn predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextNotIn(vs ...[]byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldSecretParametersCiphertext, vs...))
+}
+
+// SecretParametersCiphertextGT applies the GT predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextGT(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldSecretParametersCiphertext, v))
+}
+
+// SecretParametersCiphertextGTE applies the GTE predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextGTE(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldSecretParametersCiphertext, v))
+}
+
+// SecretParametersCiphertextLT applies the LT predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextLT(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldSecretParametersCiphertext, v))
+}
+
+// SecretParametersCiphertextLTE applies the LTE predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextLTE(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldSecretParametersCiphertext, v))
+}
+
+// SecretParametersCiphertextIsNil applies the IsNil predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldSecretParametersCiphertext))
+}
+
+// SecretParametersCiphertextNotNil applies the NotNil predicate on the "secret_parameters_ciphertext" field.
+func SecretParametersCiphertextNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldSecretParametersCiphertext))
+}
+
+// SecretParametersNonceEQ applies the EQ predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceEQ(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldSecretParametersNonce, v))
+}
+
+// SecretParametersNonceNEQ applies the NEQ predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceNEQ(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldSecretParametersNonce, v))
+}
+
+// SecretParametersNonceIn applies the In predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceIn(vs ...[]byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldSecretParametersNonce, vs...))
+}
+
+// SecretParametersNonceNotIn applies the NotIn predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceNotIn(vs ...[]byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldSecretParametersNonce, vs...))
+}
+
+// SecretParametersNonceGT applies the GT predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceGT(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldSecretParametersNonce, v))
+}
+
+// SecretParametersNonceGTE applies the GTE predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceGTE(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldSecretParametersNonce, v))
+}
+
+// SecretParametersNonceLT applies the LT predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceLT(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldSecretParametersNonce, v))
+}
+
+// SecretParametersNonceLTE applies the LTE predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceLTE(v []byte) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldSecretParametersNonce, v))
+}
+
+// SecretParametersNonceIsNil applies the IsNil predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldSecretParametersNonce))
+}
+
+// SecretParametersNonceNotNil applies the NotNil predicate on the "secret_parameters_nonce" field.
+func SecretParametersNonceNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldSecretParametersNonce))
+}
+
+// SecretParametersKeyIDEQ applies the EQ predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDNEQ applies the NEQ predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDIn applies the In predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldSecretParametersKeyID, vs...))
+}
+
+// SecretParametersKeyIDNotIn applies the NotIn predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldSecretParametersKeyID, vs...))
+}
+
+// SecretParametersKeyIDGT applies the GT predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDGTE applies the GTE predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDLT applies the LT predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDLTE applies the LTE predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDContains applies the Contains predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDHasPrefix applies the HasPrefix predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDHasSuffix applies the HasSuffix predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDIsNil applies the IsNil predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldSecretParametersKeyID))
+}
+
+// SecretParametersKeyIDNotNil applies the NotNil predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldSecretParametersKeyID))
+}
+
+// SecretParametersKeyIDEqualFold applies the EqualFold predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldSecretParametersKeyID, v))
+}
+
+// SecretParametersKeyIDContainsFold applies the ContainsFold predicate on the "secret_parameters_key_id" field.
+func SecretParametersKeyIDContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldSecretParametersKeyID, v))
+}
+
+// SecretNamesIsNil applies the IsNil predicate on the "secret_names" field.
+func SecretNamesIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldSecretNames))
+}
+
+// SecretNamesNotNil applies the NotNil predicate on the "secret_names" field.
+func SecretNamesNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldSecretNames))
+}
+
+// ApprovalExpiresAtEQ applies the EQ predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldApprovalExpiresAt, v))
+}
+
+// ApprovalExpiresAtNEQ applies the NEQ predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtNEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldApprovalExpiresAt, v))
+}
+
+// ApprovalExpiresAtIn applies the In predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldApprovalExpiresAt, vs...))
+}
+
+// ApprovalExpiresAtNotIn applies the NotIn predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtNotIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldApprovalExpiresAt, vs...))
+}
+
+// ApprovalExpiresAtGT applies the GT predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtGT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldApprovalExpiresAt, v))
+}
+
+// ApprovalExpiresAtGTE applies the GTE predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtGTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldApprovalExpiresAt, v))
+}
+
+// ApprovalExpiresAtLT applies the LT predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtLT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldApprovalExpiresAt, v))
+}
+
+// ApprovalExpiresAtLTE applies the LTE predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtLTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldApprovalExpiresAt, v))
+}
+
+// ApprovalExpiresAtIsNil applies the IsNil predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldApprovalExpiresAt))
+}
+
+// ApprovalExpiresAtNotNil applies the NotNil predicate on the "approval_expires_at" field.
+func ApprovalExpiresAtNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldApprovalExpiresAt))
+}
+
+// ApprovedByEQ applies the EQ predicate on the "approved_by" field.
+func ApprovedByEQ(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByNEQ applies the NEQ predicate on the "approved_by" field.
+func ApprovedByNEQ(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByIn applies the In predicate on the "approved_by" field.
+func ApprovedByIn(vs ...uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByNotIn applies the NotIn predicate on the "approved_by" field.
+func ApprovedByNotIn(vs ...uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByGT applies the GT predicate on the "approved_by" field.
+func ApprovedByGT(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldApprovedBy, v))
+}
+
+// ApprovedByGTE applies the GTE predicate on the "approved_by" field.
+func ApprovedByGTE(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldApprovedBy, v))
+}
+
+// ApprovedByLT applies the LT predicate on the "approved_by" field.
+func ApprovedByLT(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldApprovedBy, v))
+}
+
+// ApprovedByLTE applies the LTE predicate on the "approved_by" field.
+func ApprovedByLTE(v uint32) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldApprovedBy, v))
+}
+
+// ApprovedByIsNil applies the IsNil predicate on the "approved_by" field.
+func ApprovedByIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldApprovedBy))
+}
+
+// ApprovedByNotNil applies the NotNil predicate on the "approved_by" field.
+func ApprovedByNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldApprovedBy))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldApprovedAt))
+}
+
+// ApprovalCommentEQ applies the EQ predicate on the "approval_comment" field.
+func ApprovalCommentEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEQ(FieldApprovalComment, v))
+}
+
+// ApprovalCommentNEQ applies the NEQ predicate on the "approval_comment" field.
+func ApprovalCommentNEQ(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNEQ(FieldApprovalComment, v))
+}
+
+// ApprovalCommentIn applies the In predicate on the "approval_comment" field.
+func ApprovalCommentIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIn(FieldApprovalComment, vs...))
+}
+
+// ApprovalCommentNotIn applies the NotIn predicate on the "approval_comment" field.
+func ApprovalCommentNotIn(vs ...string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotIn(FieldApprovalComment, vs...))
+}
+
+// ApprovalCommentGT applies the GT predicate on the "approval_comment" field.
+func ApprovalCommentGT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGT(FieldApprovalComment, v))
+}
+
+// ApprovalCommentGTE applies the GTE predicate on the "approval_comment" field.
+func ApprovalCommentGTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldGTE(FieldApprovalComment, v))
+}
+
+// ApprovalCommentLT applies the LT predicate on the "approval_comment" field.
+func ApprovalCommentLT(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLT(FieldApprovalComment, v))
+}
+
+// ApprovalCommentLTE applies the LTE predicate on the "approval_comment" field.
+func ApprovalCommentLTE(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldLTE(FieldApprovalComment, v))
+}
+
+// ApprovalCommentContains applies the Contains predicate on the "approval_comment" field.
+func ApprovalCommentContains(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContains(FieldApprovalComment, v))
+}
+
+// ApprovalCommentHasPrefix applies the HasPrefix predicate on the "approval_comment" field.
+func ApprovalCommentHasPrefix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasPrefix(FieldApprovalComment, v))
+}
+
+// ApprovalCommentHasSuffix applies the HasSuffix predicate on the "approval_comment" field.
+func ApprovalCommentHasSuffix(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldHasSuffix(FieldApprovalComment, v))
+}
+
+// ApprovalCommentIsNil applies the IsNil predicate on the "approval_comment" field.
+func ApprovalCommentIsNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldIsNull(FieldApprovalComment))
+}
+
+// ApprovalCommentNotNil applies the NotNil predicate on the "approval_comment" field.
+func ApprovalCommentNotNil() predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldNotNull(FieldApprovalComment))
+}
+
+// ApprovalCommentEqualFold applies the EqualFold predicate on the "approval_comment" field.
+func ApprovalCommentEqualFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldEqualFold(FieldApprovalComment, v))
+}
+
+// ApprovalCommentContainsFold applies the ContainsFold predicate on the "approval_comment" field.
+func ApprovalCommentContainsFold(v string) predicate.ExecutionLog {
+	return predicate.ExecutionLog(sql.FieldContainsFold(FieldApprovalComment, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ExecutionLog) predicate.ExecutionLog {
 	return predicate.ExecutionLog(sql.AndPredicates(predicates...))
@@ -235,6 +235,352 @@ func (_c *ExecutionLogCreate) SetNillableDurationMs(v *int64) *ExecutionLogCreat
 	return _c
 }
 
+// SetCancelRequestedAt sets the "cancel_requested_at" field.
+func (_c *ExecutionLogCreate) SetCancelRequestedAt(v time.Time) *ExecutionLogCreate {
+	_c.mutation.SetCancelRequestedAt(v)
+	return _c
+}
+
+// SetNillableCancelRequestedAt sets the "cancel_requested_at" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableCancelRequestedAt(v *time.Time) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetCancelRequestedAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_c *ExecutionLogCreate) SetCancelledBy(v uint32) *ExecutionLogCreate {
+	_c.mutation.SetCancelledBy(v)
+	return _c
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableCancelledBy(v *uint32) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetCancelledBy(*v)
+	}
+	return _c
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_c *ExecutionLogCreate) SetCancelReason(v string) *ExecutionLogCreate {
+	_c.mutation.SetCancelReason(v)
+	return _c
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableCancelReason(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetCancelReason(*v)
+	}
+	return _c
+}
+
+// SetTimeoutReason sets the "timeout_reason" field.
+func (_c *ExecutionLogCreate) SetTimeoutReason(v string) *ExecutionLogCreate {
+	_c.mutation.SetTimeoutReason(v)
+	return _c
+}
+
+// SetNillableTimeoutReason sets the "timeout_reason" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableTimeoutReason(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetTimeoutReason(*v)
+	}
+	return _c
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *ExecutionLogCreate) SetRunID(v string) *ExecutionLogCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableRunID(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
+// SetEventRuleID sets the "event_rule_id" field.
+func (_c *ExecutionLogCreate) SetEventRuleID(v string) *ExecutionLogCreate {
+	_c.mutation.SetEventRuleID(v)
+	return _c
+}
+
+// SetNillableEventRuleID sets the "event_rule_id" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableEventRuleID(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetEventRuleID(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *ExecutionLogCreate) SetEventType(v executionlog.EventType) *ExecutionLogCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableEventType(v *executionlog.EventType) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetEventType(*v)
+	}
+	return _c
+}
+
+// SetEventDetail sets the "event_detail" field.
+func (_c *ExecutionLogCreate) SetEventDetail(v string) *ExecutionLogCreate {
+	_c.mutation.SetEventDetail(v)
+	return _c
+}
+
+// SetNillableEventDetail sets the "event_detail" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableEventDetail(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetEventDetail(*v)
+	}
+	return _c
+}
+
+// SetSourceExecutionID sets the "source_execution_id" field.
+func (_c *ExecutionLogCreate) SetSourceExecutionID(v string) *ExecutionLogCreate {
+	_c.mutation.SetSourceExecutionID(v)
+	return _c
+}
+
+// SetNillableSourceExecutionID sets the "source_execution_id" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableSourceExecutionID(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetSourceExecutionID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (_c *ExecutionLogCreate) SetWorkflowRunID(v string) *ExecutionLogCreate {
+	_c.mutation.SetWorkflowRunID(v)
+	return _c
+}
+
+// SetNillableWorkflowRunID sets the "workflow_run_id" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableWorkflowRunID(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetWorkflowRunID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowStepID sets the "workflow_step_id" field.
+func (_c *ExecutionLogCreate) SetWorkflowStepID(v string) *ExecutionLogCreate {
+	_c.mutation.SetWorkflowStepID(v)
+	return _c
+}
+
+// SetNillableWorkflowStepID sets the "workflow_step_id" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableWorkflowStepID(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetWorkflowStepID(*v)
+	}
+	return _c
+}
+
+// SetAttempt sets the "attempt" field.
+func (_c *ExecutionLogCreate) SetAttempt(v int) *ExecutionLogCreate {
+	_c.mutation.SetAttempt(v)
+	return _c
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableAttempt(v *int) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetAttempt(*v)
+	}
+	return _c
+}
+
+// SetOriginalExecutionID sets the "original_execution_id" field.
+func (_c *ExecutionLogCreate) SetOriginalExecutionID(v string) *ExecutionLogCreate {
+	_c.mutation.SetOriginalExecutionID(v)
+	return _c
+}
+
+// SetNillableOriginalExecutionID sets the "original_execution_id" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableOriginalExecutionID(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetOriginalExecutionID(*v)
+	}
+	return _c
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (_c *ExecutionLogCreate) SetRetryAt(v time.Time) *ExecutionLogCreate {
+	_c.mutation.SetRetryAt(v)
+	return _c
+}
+
+// SetNillableRetryAt sets the "retry_at" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableRetryAt(v *time.Time) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetRetryAt(*v)
+	}
+	return _c
+}
+
+// SetRetriedBy sets the "retried_by" field.
+func (_c *ExecutionLogCreate) SetRetriedBy(v string) *ExecutionLogCreate {
+	_c.mutation.SetRetriedBy(v)
+	return _c
+}
+
+// SetNillableRetriedBy sets the "retried_by" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableRetriedBy(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetRetriedBy(*v)
+	}
+	return _c
+}
+
+// SetWaitingForSlot sets the "waiting_for_slot" field.
+func (_c *ExecutionLogCreate) SetWaitingForSlot(v bool) *ExecutionLogCreate {
+	_c.mutation.SetWaitingForSlot(v)
+	return _c
+}
+
+// SetNillableWaitingForSlot sets the "waiting_for_slot" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableWaitingForSlot(v *bool) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetWaitingForSlot(*v)
+	}
+	return _c
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (_c *ExecutionLogCreate) SetHeldUntil(v time.Time) *ExecutionLogCreate {
+	_c.mutation.SetHeldUntil(v)
+	return _c
+}
+
+// SetNillableHeldUntil sets the "held_until" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableHeldUntil(v *time.Time) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetHeldUntil(*v)
+	}
+	return _c
+}
+
+// SetMaintenanceOverride sets the "maintenance_override" field.
+func (_c *ExecutionLogCreate) SetMaintenanceOverride(v string) *ExecutionLogCreate {
+	_c.mutation.SetMaintenanceOverride(v)
+	return _c
+}
+
+// SetNillableMaintenanceOverride sets the "maintenance_override" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableMaintenanceOverride(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetMaintenanceOverride(*v)
+	}
+	return _c
+}
+
+// SetParameters sets the "parameters" field.
+func (_c *ExecutionLogCreate) SetParameters(v map[string]string) *ExecutionLogCreate {
+	_c.mutation.SetParameters(v)
+	return _c
+}
+
+// SetSecretParametersCiphertext sets the "secret_parameters_ciphertext" field.
+func (_c *ExecutionLogCreate) SetSecretParametersCiphertext(v []byte) *ExecutionLogCreate {
+	_c.mutation.SetSecretParametersCiphertext(v)
+	return _c
+}
+
+// SetSecretParametersNonce sets the "secret_parameters_nonce" field.
+func (_c *ExecutionLogCreate) SetSecretParametersNonce(v []byte) *ExecutionLogCreate {
+	_c.mutation.SetSecretParametersNonce(v)
+	return _c
+}
+
+// SetSecretParametersKeyID sets the "secret_parameters_key_id" field.
+func (_c *ExecutionLogCreate) SetSecretParametersKeyID(v string) *ExecutionLogCreate {
+	_c.mutation.SetSecretParametersKeyID(v)
+	return _c
+}
+
+// SetNillableSecretParametersKeyID sets the "secret_parameters_key_id" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableSecretParametersKeyID(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetSecretParametersKeyID(*v)
+	}
+	return _c
+}
+
+// SetSecretNames sets the "secret_names" field.
+func (_c *ExecutionLogCreate) SetSecretNames(v []string) *ExecutionLogCreate {
+	_c.mutation.SetSecretNames(v)
+	return _c
+}
+
+// SetApprovalExpiresAt sets the "approval_expires_at" field.
+func (_c *ExecutionLogCreate) SetApprovalExpiresAt(v time.Time) *ExecutionLogCreate {
+	_c.mutation.SetApprovalExpiresAt(v)
+	return _c
+}
+
+// SetNillableApprovalExpiresAt sets the "approval_expires_at" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableApprovalExpiresAt(v *time.Time) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetApprovalExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_c *ExecutionLogCreate) SetApprovedBy(v uint32) *ExecutionLogCreate {
+	_c.mutation.SetApprovedBy(v)
+	return _c
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableApprovedBy(v *uint32) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetApprovedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *ExecutionLogCreate) SetApprovedAt(v time.Time) *ExecutionLogCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableApprovedAt(v *time.Time) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
+// SetApprovalComment sets the "approval_comment" field.
+func (_c *ExecutionLogCreate) SetApprovalComment(v string) *ExecutionLogCreate {
+	_c.mutation.SetApprovalComment(v)
+	return _c
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_c *ExecutionLogCreate) SetNillableApprovalComment(v *string) *ExecutionLogCreate {
+	if v != nil {
+		_c.SetApprovalComment(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ExecutionLogCreate) SetID(v string) *ExecutionLogCreate {
 	_c.mutation.SetID(v)
@@ -286,6 +632,14 @@ func (_c *ExecutionLogCreate) defaults() error {
 		v := executionlog.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Attempt(); !ok {
+		v := executionlog.DefaultAttempt
+		_c.mutation.SetAttempt(v)
+	}
+	if _, ok := _c.mutation.WaitingForSlot(); !ok {
+		v := executionlog.DefaultWaitingForSlot
+		_c.mutation.SetWaitingForSlot(v)
+	}
 	return nil
 }
 
@@ -344,6 +698,87 @@ func (_c *ExecutionLogCreate) check() error {
 			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.rejection_reason": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.CancelReason(); ok {
+		if err := executionlog.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.cancel_reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TimeoutReason(); ok {
+		if err := executionlog.TimeoutReasonValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.timeout_reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RunID(); ok {
+		if err := executionlog.RunIDValidator(v); err != nil {
+			return &ValidationError{Name: "run_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.run_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EventRuleID(); ok {
+		if err := executionlog.EventRuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_rule_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_rule_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := executionlog.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EventDetail(); ok {
+		if err := executionlog.EventDetailValidator(v); err != nil {
+			return &ValidationError{Name: "event_detail", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_detail": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SourceExecutionID(); ok {
+		if err := executionlog.SourceExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_execution_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.source_execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.WorkflowRunID(); ok {
+		if err := executionlog.WorkflowRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_run_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.workflow_run_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.WorkflowStepID(); ok {
+		if err := executionlog.WorkflowStepIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_step_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.workflow_step_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "ExecutionLog.attempt"`)}
+	}
+	if v, ok := _c.mutation.Attempt(); ok {
+		if err := executionlog.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.attempt": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OriginalExecutionID(); ok {
+		if err := executionlog.OriginalExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "original_execution_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.original_execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RetriedBy(); ok {
+		if err := executionlog.RetriedByValidator(v); err != nil {
+			return &ValidationError{Name: "retried_by", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.retried_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WaitingForSlot(); !ok {
+		return &ValidationError{Name: "waiting_for_slot", err: errors.New(`ent: missing required field "ExecutionLog.waiting_for_slot"`)}
+	}
+	if v, ok := _c.mutation.MaintenanceOverride(); ok {
+		if err := executionlog.MaintenanceOverrideValidator(v); err != nil {
+			return &ValidationError{Name: "maintenance_override", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.maintenance_override": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SecretParametersKeyID(); ok {
+		if err := executionlog.SecretParametersKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "secret_parameters_key_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.secret_parameters_key_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ApprovalComment(); ok {
+		if err := executionlog.ApprovalCommentValidator(v); err != nil {
+			return &ValidationError{Name: "approval_comment", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.approval_comment": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := executionlog.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.id": %w`, err)}
@@ -457,33 +892,141 @@ func (_c *ExecutionLogCreate) createSpec() (*ExecutionLog, *sqlgraph.CreateSpec)
 		_spec.SetField(executionlog.FieldDurationMs, field.TypeInt64, value)
 		_node.DurationMs = &value
 	}
-	return _node, _spec
-}
-
-// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
-// of the `INSERT` statement. For example:
-//
-//	client.ExecutionLog.Create().
-//		SetCreateBy(v).
-//		OnConflict(
-//			// Update the row with the new values
-//			// the was proposed for insertion.
-//			sql.ResolveWithNewValues(),
-//		).
-//		// Override some of the fields with custom
-//		// update values.
-//		Update(func(u *ent.ExecutionLogUpsert) {
-//			SetCreateBy(v+v).
-//		}).
-//		Exec(ctx)
-func (_c *ExecutionLogCreate) OnConflict(opts ...sql.ConflictOption) *ExecutionLogUpsertOne {
-	_c.conflict = opts
-	return &ExecutionLogUpsertOne{
-		create: _c,
+	if value, ok := _c.mutation.CancelRequestedAt(); ok {
+		_spec.SetField(executionlog.FieldCancelRequestedAt, field.TypeTime, value)
+		_node.CancelRequestedAt = &value
 	}
-}
-
-// OnConflictColumns calls `OnConflict` and configures the columns
+	if value, ok := _c.mutation.CancelledBy(); ok {
+		_spec.SetField(executionlog.FieldCancelledBy, field.TypeUint32, value)
+		_node.CancelledBy = &value
+	}
+	if value, ok := _c.mutation.CancelReason(); ok {
+		_spec.SetField(executionlog.FieldCancelReason, field.TypeString, value)
+		_node.CancelReason = value
+	}
+	if value, ok := _c.mutation.TimeoutReason(); ok {
+		_spec.SetField(executionlog.FieldTimeoutReason, field.TypeString, value)
+		_node.TimeoutReason = value
+	}
+	if value, ok := _c.mutation.RunID(); ok {
+		_spec.SetField(executionlog.FieldRunID, field.TypeString, value)
+		_node.RunID = &value
+	}
+	if value, ok := _c.mutation.EventRuleID(); ok {
+		_spec.SetField(executionlog.FieldEventRuleID, field.TypeString, value)
+		_node.EventRuleID = &value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(executionlog.FieldEventType, field.TypeEnum, value)
+		_node.EventType = &value
+	}
+	if value, ok := _c.mutation.EventDetail(); ok {
+		_spec.SetField(executionlog.FieldEventDetail, field.TypeString, value)
+		_node.EventDetail = value
+	}
+	if value, ok := _c.mutation.SourceExecutionID(); ok {
+		_spec.SetField(executionlog.FieldSourceExecutionID, field.TypeString, value)
+		_node.SourceExecutionID = &value
+	}
+	if value, ok := _c.mutation.WorkflowRunID(); ok {
+		_spec.SetField(executionlog.FieldWorkflowRunID, field.TypeString, value)
+		_node.WorkflowRunID = &value
+	}
+	if value, ok := _c.mutation.WorkflowStepID(); ok {
+		_spec.SetField(executionlog.FieldWorkflowStepID, field.TypeString, value)
+		_node.WorkflowStepID = value
+	}
+	if value, ok := _c.mutation.Attempt(); ok {
+		_spec.SetField(executionlog.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := _c.mutation.OriginalExecutionID(); ok {
+		_spec.SetField(executionlog.FieldOriginalExecutionID, field.TypeString, value)
+		_node.OriginalExecutionID = &value
+	}
+	if value, ok := _c.mutation.RetryAt(); ok {
+		_spec.SetField(executionlog.FieldRetryAt, field.TypeTime, value)
+		_node.RetryAt = &value
+	}
+	if value, ok := _c.mutation.RetriedBy(); ok {
+		_spec.SetField(executionlog.FieldRetriedBy, field.TypeString, value)
+		_node.RetriedBy = &value
+	}
+	if value, ok := _c.mutation.WaitingForSlot(); ok {
+		_spec.SetField(executionlog.FieldWaitingForSlot, field.TypeBool, value)
+		_node.WaitingForSlot = value
+	}
+	if value, ok := _c.mutation.HeldUntil(); ok {
+		_spec.SetField(executionlog.FieldHeldUntil, field.TypeTime, value)
+		_node.HeldUntil = &value
+	}
+	if value, ok := _c.mutation.MaintenanceOverride(); ok {
+		_spec.SetField(executionlog.FieldMaintenanceOverride, field.TypeString, value)
+		_node.MaintenanceOverride = value
+	}
+	if value, ok := _c.mutation.Parameters(); ok {
+		_spec.SetField(executionlog.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
+	if value, ok := _c.mutation.SecretParametersCiphertext(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersCiphertext, field.TypeBytes, value)
+		_node.SecretParametersCiphertext = &value
+	}
+	if value, ok := _c.mutation.SecretParametersNonce(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersNonce, field.TypeBytes, value)
+		_node.SecretParametersNonce = value
+	}
+	if value, ok := _c.mutation.SecretParametersKeyID(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersKeyID, field.TypeString, value)
+		_node.SecretParametersKeyID = value
+	}
+	if value, ok := _c.mutation.SecretNames(); ok {
+		_spec.SetField(executionlog.FieldSecretNames, field.TypeJSON, value)
+		_node.SecretNames = value
+	}
+	if value, ok := _c.mutation.ApprovalExpiresAt(); ok {
+		_spec.SetField(executionlog.FieldApprovalExpiresAt, field.TypeTime, value)
+		_node.ApprovalExpiresAt = &value
+	}
+	if value, ok := _c.mutation.ApprovedBy(); ok {
+		_spec.SetField(executionlog.FieldApprovedBy, field.TypeUint32, value)
+		_node.ApprovedBy = &value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(executionlog.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = &value
+	}
+	if value, ok := _c.mutation.ApprovalComment(); ok {
+		_spec.SetField(executionlog.FieldApprovalComment, field.TypeString, value)
+		_node.ApprovalComment = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExecutionLog.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExecutionLogUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExecutionLogCreate) OnConflict(opts ...sql.ConflictOption) *ExecutionLogUpsertOne {
+	_c.conflict = opts
+	return &ExecutionLogUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
 // as conflict target. Using this option is equivalent to using:
 //
 //	client.ExecutionLog.Create().
@@ -779,908 +1322,2548 @@ func (u *ExecutionLogUpsert) ClearDurationMs() *ExecutionLogUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.ExecutionLog.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(executionlog.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *ExecutionLogUpsertOne) UpdateNewValues() *ExecutionLogUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(executionlog.FieldID)
-		}
-		if _, exists := u.create.mutation.CreateTime(); exists {
-			s.SetIgnore(executionlog.FieldCreateTime)
-		}
-		if _, exists := u.create.mutation.TenantID(); exists {
-			s.SetIgnore(executionlog.FieldTenantID)
-		}
-	}))
+// SetCancelRequestedAt sets the "cancel_requested_at" field.
+func (u *ExecutionLogUpsert) SetCancelRequestedAt(v time.Time) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldCancelRequestedAt, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.ExecutionLog.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *ExecutionLogUpsertOne) Ignore() *ExecutionLogUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateCancelRequestedAt sets the "cancel_requested_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateCancelRequestedAt() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldCancelRequestedAt)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *ExecutionLogUpsertOne) DoNothing() *ExecutionLogUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+// ClearCancelRequestedAt clears the value of the "cancel_requested_at" field.
+func (u *ExecutionLogUpsert) ClearCancelRequestedAt() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldCancelRequestedAt)
 	return u
 }
 
-// Update allows overriding fields `UPDATE` values. See the ExecutionLogCreate.OnConflict
-// documentation for more info.
-func (u *ExecutionLogUpsertOne) Update(set func(*ExecutionLogUpsert)) *ExecutionLogUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&ExecutionLogUpsert{UpdateSet: update})
-	}))
+// SetCancelledBy sets the "cancelled_by" field.
+func (u *ExecutionLogUpsert) SetCancelledBy(v uint32) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldCancelledBy, v)
 	return u
 }
 
-// SetCreateBy sets the "create_by" field.
-func (u *ExecutionLogUpsertOne) SetCreateBy(v uint32) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetCreateBy(v)
-	})
+// UpdateCancelledBy sets the "cancelled_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateCancelledBy() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldCancelledBy)
+	return u
 }
 
-// AddCreateBy adds v to the "create_by" field.
-func (u *ExecutionLogUpsertOne) AddCreateBy(v uint32) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.AddCreateBy(v)
-	})
+// AddCancelledBy adds v to the "cancelled_by" field.
+func (u *ExecutionLogUpsert) AddCancelledBy(v uint32) *ExecutionLogUpsert {
+	u.Add(executionlog.FieldCancelledBy, v)
+	return u
 }
 
-// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateCreateBy() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateCreateBy()
-	})
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (u *ExecutionLogUpsert) ClearCancelledBy() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldCancelledBy)
+	return u
 }
 
-// ClearCreateBy clears the value of the "create_by" field.
-func (u *ExecutionLogUpsertOne) ClearCreateBy() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearCreateBy()
-	})
+// SetCancelReason sets the "cancel_reason" field.
+func (u *ExecutionLogUpsert) SetCancelReason(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldCancelReason, v)
+	return u
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (u *ExecutionLogUpsertOne) SetUpdateTime(v time.Time) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetUpdateTime(v)
-	})
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateCancelReason() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldCancelReason)
+	return u
 }
 
-// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateUpdateTime() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateUpdateTime()
-	})
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (u *ExecutionLogUpsert) ClearCancelReason() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldCancelReason)
+	return u
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (u *ExecutionLogUpsertOne) ClearUpdateTime() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearUpdateTime()
-	})
+// SetTimeoutReason sets the "timeout_reason" field.
+func (u *ExecutionLogUpsert) SetTimeoutReason(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldTimeoutReason, v)
+	return u
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (u *ExecutionLogUpsertOne) SetDeleteTime(v time.Time) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetDeleteTime(v)
-	})
+// UpdateTimeoutReason sets the "timeout_reason" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateTimeoutReason() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldTimeoutReason)
+	return u
 }
 
-// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateDeleteTime() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateDeleteTime()
-	})
+// ClearTimeoutReason clears the value of the "timeout_reason" field.
+func (u *ExecutionLogUpsert) ClearTimeoutReason() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldTimeoutReason)
+	return u
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (u *ExecutionLogUpsertOne) ClearDeleteTime() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearDeleteTime()
-	})
+// SetRunID sets the "run_id" field.
+func (u *ExecutionLogUpsert) SetRunID(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldRunID, v)
+	return u
 }
 
-// SetScriptID sets the "script_id" field.
-func (u *ExecutionLogUpsertOne) SetScriptID(v string) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetScriptID(v)
-	})
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateRunID() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldRunID)
+	return u
 }
 
-// UpdateScriptID sets the "script_id" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateScriptID() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateScriptID()
-	})
+// ClearRunID clears the value of the "run_id" field.
+func (u *ExecutionLogUpsert) ClearRunID() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldRunID)
+	return u
 }
 
-// SetScriptName sets the "script_name" field.
-func (u *ExecutionLogUpsertOne) SetScriptName(v string) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetScriptName(v)
-	})
+// SetEventRuleID sets the "event_rule_id" field.
+func (u *ExecutionLogUpsert) SetEventRuleID(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldEventRuleID, v)
+	return u
 }
 
-// UpdateScriptName sets the "script_name" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateScriptName() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateScriptName()
-	})
+// UpdateEventRuleID sets the "event_rule_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateEventRuleID() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldEventRuleID)
+	return u
 }
 
-// SetClientID sets the "client_id" field.
-func (u *ExecutionLogUpsertOne) SetClientID(v string) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetClientID(v)
-	})
+// ClearEventRuleID clears the value of the "event_rule_id" field.
+func (u *ExecutionLogUpsert) ClearEventRuleID() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldEventRuleID)
+	return u
 }
 
-// UpdateClientID sets the "client_id" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateClientID() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateClientID()
-	})
+// SetEventType sets the "event_type" field.
+func (u *ExecutionLogUpsert) SetEventType(v executionlog.EventType) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldEventType, v)
+	return u
 }
 
-// SetScriptHash sets the "script_hash" field.
-func (u *ExecutionLogUpsertOne) SetScriptHash(v string) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetScriptHash(v)
-	})
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateEventType() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldEventType)
+	return u
 }
 
-// UpdateScriptHash sets the "script_hash" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateScriptHash() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateScriptHash()
-	})
+// ClearEventType clears the value of the "event_type" field.
+func (u *ExecutionLogUpsert) ClearEventType() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldEventType)
+	return u
 }
 
-// SetTriggerType sets the "trigger_type" field.
-func (u *ExecutionLogUpsertOne) SetTriggerType(v executionlog.TriggerType) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetTriggerType(v)
-	})
+// SetEventDetail sets the "event_detail" field.
+func (u *ExecutionLogUpsert) SetEventDetail(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldEventDetail, v)
+	return u
 }
 
-// UpdateTriggerType sets the "trigger_type" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateTriggerType() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateTriggerType()
-	})
+// UpdateEventDetail sets the "event_detail" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateEventDetail() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldEventDetail)
+	return u
 }
 
-// SetStatus sets the "status" field.
-func (u *ExecutionLogUpsertOne) SetStatus(v executionlog.Status) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetStatus(v)
-	})
+// ClearEventDetail clears the value of the "event_detail" field.
+func (u *ExecutionLogUpsert) ClearEventDetail() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldEventDetail)
+	return u
 }
 
-// UpdateStatus sets the "status" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateStatus() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateStatus()
-	})
+// SetSourceExecutionID sets the "source_execution_id" field.
+func (u *ExecutionLogUpsert) SetSourceExecutionID(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldSourceExecutionID, v)
+	return u
 }
 
-// SetExitCode sets the "exit_code" field.
-func (u *ExecutionLogUpsertOne) SetExitCode(v int) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetExitCode(v)
-	})
+// UpdateSourceExecutionID sets the "source_execution_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateSourceExecutionID() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldSourceExecutionID)
+	return u
 }
 
-// AddExitCode adds v to the "exit_code" field.
-func (u *ExecutionLogUpsertOne) AddExitCode(v int) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.AddExitCode(v)
-	})
+// ClearSourceExecutionID clears the value of the "source_execution_id" field.
+func (u *ExecutionLogUpsert) ClearSourceExecutionID() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldSourceExecutionID)
+	return u
 }
 
-// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateExitCode() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateExitCode()
-	})
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (u *ExecutionLogUpsert) SetWorkflowRunID(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldWorkflowRunID, v)
+	return u
 }
 
-// ClearExitCode clears the value of the "exit_code" field.
-func (u *ExecutionLogUpsertOne) ClearExitCode() *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearExitCode()
-	})
+// UpdateWorkflowRunID sets the "workflow_run_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateWorkflowRunID() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldWorkflowRunID)
+	return u
 }
 
-// SetOutput sets the "output" field.
-func (u *ExecutionLogUpsertOne) SetOutput(v string) *ExecutionLogUpsertOne {
-	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetOutput(v)
-	})
+// ClearWorkflowRunID clears the value of the "workflow_run_id" field.
+func (u *ExecutionLogUpsert) ClearWorkflowRunID() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldWorkflowRunID)
+	return u
+}
+
+// SetWorkflowStepID sets the "workflow_step_id" field.
+func (u *ExecutionLogUpsert) SetWorkflowStepID(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldWorkflowStepID, v)
+	return u
+}
+
+// UpdateWorkflowStepID sets the "workflow_step_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateWorkflowStepID() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldWorkflowStepID)
+	return u
+}
+
+// ClearWorkflowStepID clears the value of the "workflow_step_id" field.
+func (u *ExecutionLogUpsert) ClearWorkflowStepID() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldWorkflowStepID)
+	return u
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *ExecutionLogUpsert) SetAttempt(v int) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldAttempt, v)
+	return u
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateAttempt() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldAttempt)
+	return u
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *ExecutionLogUpsert) AddAttempt(v int) *ExecutionLogUpsert {
+	u.Add(executionlog.FieldAttempt, v)
+	return u
+}
+
+// SetOriginalExecutionID sets the "original_execution_id" field.
+func (u *ExecutionLogUpsert) SetOriginalExecutionID(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldOriginalExecutionID, v)
+	return u
+}
+
+// UpdateOriginalExecutionID sets the "original_execution_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateOriginalExecutionID() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldOriginalExecutionID)
+	return u
+}
+
+// ClearOriginalExecutionID clears the value of the "original_execution_id" field.
+func (u *ExecutionLogUpsert) ClearOriginalExecutionID() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldOriginalExecutionID)
+	return u
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (u *ExecutionLogUpsert) SetRetryAt(v time.Time) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldRetryAt, v)
+	return u
+}
+
+// UpdateRetryAt sets the "retry_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateRetryAt() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldRetryAt)
+	return u
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (u *ExecutionLogUpsert) ClearRetryAt() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldRetryAt)
+	return u
+}
+
+// SetRetriedBy sets the "retried_by" field.
+func (u *ExecutionLogUpsert) SetRetriedBy(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldRetriedBy, v)
+	return u
+}
+
+// UpdateRetriedBy sets the "retried_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateRetriedBy() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldRetriedBy)
+	return u
+}
+
+// ClearRetriedBy clears the value of the "retried_by" field.
+func (u *ExecutionLogUpsert) ClearRetriedBy() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldRetriedBy)
+	return u
+}
+
+// SetWaitingForSlot sets the "waiting_for_slot" field.
+func (u *ExecutionLogUpsert) SetWaitingForSlot(v bool) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldWaitingForSlot, v)
+	return u
+}
+
+// UpdateWaitingForSlot sets the "waiting_for_slot" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateWaitingForSlot() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldWaitingForSlot)
+	return u
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (u *ExecutionLogUpsert) SetHeldUntil(v time.Time) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldHeldUntil, v)
+	return u
+}
+
+// UpdateHeldUntil sets the "held_until" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateHeldUntil() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldHeldUntil)
+	return u
+}
+
+// ClearHeldUntil clears the value of the "held_until" field.
+func (u *ExecutionLogUpsert) ClearHeldUntil() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldHeldUntil)
+	return u
+}
+
+// SetMaintenanceOverride sets the "maintenance_override" field.
+func (u *ExecutionLogUpsert) SetMaintenanceOverride(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldMaintenanceOverride, v)
+	return u
+}
+
+// UpdateMaintenanceOverride sets the "maintenance_override" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateMaintenanceOverride() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldMaintenanceOverride)
+	return u
+}
+
+// ClearMaintenanceOverride clears the value of the "maintenance_override" field.
+func (u *ExecutionLogUpsert) ClearMaintenanceOverride() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldMaintenanceOverride)
+	return u
+}
+
+// SetParameters sets the "parameters" field.
+func (u *ExecutionLogUpsert) SetParameters(v map[string]string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldParameters, v)
+	return u
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateParameters() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldParameters)
+	return u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *ExecutionLogUpsert) ClearParameters() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldParameters)
+	return u
+}
+
+// SetSecretParametersCiphertext sets the "secret_parameters_ciphertext" field.
+func (u *ExecutionLogUpsert) SetSecretParametersCiphertext(v []byte) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldSecretParametersCiphertext, v)
+	return u
+}
+
+// UpdateSecretParametersCiphertext sets the "secret_parameters_ciphertext" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateSecretParametersCiphertext() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldSecretParametersCiphertext)
+	return u
+}
+
+// ClearSecretParametersCiphertext clears the value of the "secret_parameters_ciphertext" field.
+func (u *ExecutionLogUpsert) ClearSecretParametersCiphertext() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldSecretParametersCiphertext)
+	return u
+}
+
+// SetSecretParametersNonce sets the "secret_parameters_nonce" field.
+func (u *ExecutionLogUpsert) SetSecretParametersNonce(v []byte) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldSecretParametersNonce, v)
+	return u
+}
+
+// UpdateSecretParametersNonce sets the "secret_parameters_nonce" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateSecretParametersNonce() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldSecretParametersNonce)
+	return u
+}
+
+// ClearSecretParametersNonce clears the value of the "secret_parameters_nonce" field.
+func (u *ExecutionLogUpsert) ClearSecretParametersNonce() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldSecretParametersNonce)
+	return u
+}
+
+// SetSecretParametersKeyID sets the "secret_parameters_key_id" field.
+func (u *ExecutionLogUpsert) SetSecretParametersKeyID(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldSecretParametersKeyID, v)
+	return u
+}
+
+// UpdateSecretParametersKeyID sets the "secret_parameters_key_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateSecretParametersKeyID() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldSecretParametersKeyID)
+	return u
+}
+
+// ClearSecretParametersKeyID clears the value of the "secret_parameters_key_id" field.
+func (u *ExecutionLogUpsert) ClearSecretParametersKeyID() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldSecretParametersKeyID)
+	return u
+}
+
+// SetSecretNames sets the "secret_names" field.
+func (u *ExecutionLogUpsert) SetSecretNames(v []string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldSecretNames, v)
+	return u
+}
+
+// UpdateSecretNames sets the "secret_names" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateSecretNames() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldSecretNames)
+	return u
+}
+
+// ClearSecretNames clears the value of the "secret_names" field.
+func (u *ExecutionLogUpsert) ClearSecretNames() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldSecretNames)
+	return u
+}
+
+// SetApprovalExpiresAt sets the "approval_expires_at" field.
+func (u *ExecutionLogUpsert) SetApprovalExpiresAt(v time.Time) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldApprovalExpiresAt, v)
+	return u
+}
+
+// UpdateApprovalExpiresAt sets the "approval_expires_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateApprovalExpiresAt() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldApprovalExpiresAt)
+	return u
+}
+
+// ClearApprovalExpiresAt clears the value of the "approval_expires_at" field.
+func (u *ExecutionLogUpsert) ClearApprovalExpiresAt() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldApprovalExpiresAt)
+	return u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *ExecutionLogUpsert) SetApprovedBy(v uint32) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldApprovedBy, v)
+	return u
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateApprovedBy() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldApprovedBy)
+	return u
+}
+
+// AddApprovedBy adds v to the "approved_by" field.
+func (u *ExecutionLogUpsert) AddApprovedBy(v uint32) *ExecutionLogUpsert {
+	u.Add(executionlog.FieldApprovedBy, v)
+	return u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *ExecutionLogUpsert) ClearApprovedBy() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldApprovedBy)
+	return u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *ExecutionLogUpsert) SetApprovedAt(v time.Time) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldApprovedAt, v)
+	return u
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateApprovedAt() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldApprovedAt)
+	return u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *ExecutionLogUpsert) ClearApprovedAt() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldApprovedAt)
+	return u
+}
+
+// SetApprovalComment sets the "approval_comment" field.
+func (u *ExecutionLogUpsert) SetApprovalComment(v string) *ExecutionLogUpsert {
+	u.Set(executionlog.FieldApprovalComment, v)
+	return u
+}
+
+// UpdateApprovalComment sets the "approval_comment" field to the value that was provided on create.
+func (u *ExecutionLogUpsert) UpdateApprovalComment() *ExecutionLogUpsert {
+	u.SetExcluded(executionlog.FieldApprovalComment)
+	return u
+}
+
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (u *ExecutionLogUpsert) ClearApprovalComment() *ExecutionLogUpsert {
+	u.SetNull(executionlog.FieldApprovalComment)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExecutionLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(executionlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExecutionLogUpsertOne) UpdateNewValues() *ExecutionLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(executionlog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(executionlog.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(executionlog.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExecutionLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExecutionLogUpsertOne) Ignore() *ExecutionLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExecutionLogUpsertOne) DoNothing() *ExecutionLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExecutionLogCreate.OnConflict
+// documentation for more info.
+func (u *ExecutionLogUpsertOne) Update(set func(*ExecutionLogUpsert)) *ExecutionLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExecutionLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ExecutionLogUpsertOne) SetCreateBy(v uint32) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ExecutionLogUpsertOne) AddCreateBy(v uint32) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateCreateBy() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ExecutionLogUpsertOne) ClearCreateBy() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ExecutionLogUpsertOne) SetUpdateTime(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateUpdateTime() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ExecutionLogUpsertOne) ClearUpdateTime() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ExecutionLogUpsertOne) SetDeleteTime(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateDeleteTime() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ExecutionLogUpsertOne) ClearDeleteTime() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ExecutionLogUpsertOne) SetScriptID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateScriptID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetScriptName sets the "script_name" field.
+func (u *ExecutionLogUpsertOne) SetScriptName(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetScriptName(v)
+	})
+}
+
+// UpdateScriptName sets the "script_name" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateScriptName() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateScriptName()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ExecutionLogUpsertOne) SetClientID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateClientID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetScriptHash sets the "script_hash" field.
+func (u *ExecutionLogUpsertOne) SetScriptHash(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetScriptHash(v)
+	})
+}
+
+// UpdateScriptHash sets the "script_hash" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateScriptHash() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateScriptHash()
+	})
+}
+
+// SetTriggerType sets the "trigger_type" field.
+func (u *ExecutionLogUpsertOne) SetTriggerType(v executionlog.TriggerType) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetTriggerType(v)
+	})
+}
+
+// UpdateTriggerType sets the "trigger_type" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateTriggerType() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateTriggerType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExecutionLogUpsertOne) SetStatus(v executionlog.Status) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateStatus() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *ExecutionLogUpsertOne) SetExitCode(v int) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetExitCode(v)
+	})
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *ExecutionLogUpsertOne) AddExitCode(v int) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddExitCode(v)
+	})
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateExitCode() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateExitCode()
+	})
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *ExecutionLogUpsertOne) ClearExitCode() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearExitCode()
+	})
+}
+
+// SetOutput sets the "output" field.
+func (u *ExecutionLogUpsertOne) SetOutput(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetOutput(v)
+	})
+}
+
+// UpdateOutput sets the "output" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateOutput() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateOutput()
+	})
+}
+
+// ClearOutput clears the value of the "output" field.
+func (u *ExecutionLogUpsertOne) ClearOutput() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearOutput()
+	})
+}
+
+// SetErrorOutput sets the "error_output" field.
+func (u *ExecutionLogUpsertOne) SetErrorOutput(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetErrorOutput(v)
+	})
+}
+
+// UpdateErrorOutput sets the "error_output" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateErrorOutput() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateErrorOutput()
+	})
+}
+
+// ClearErrorOutput clears the value of the "error_output" field.
+func (u *ExecutionLogUpsertOne) ClearErrorOutput() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearErrorOutput()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *ExecutionLogUpsertOne) SetRejectionReason(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateRejectionReason() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *ExecutionLogUpsertOne) ClearRejectionReason() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ExecutionLogUpsertOne) SetStartedAt(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateStartedAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *ExecutionLogUpsertOne) ClearStartedAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ExecutionLogUpsertOne) SetCompletedAt(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateCompletedAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ExecutionLogUpsertOne) ClearCompletedAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *ExecutionLogUpsertOne) SetDurationMs(v int64) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *ExecutionLogUpsertOne) AddDurationMs(v int64) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateDurationMs() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *ExecutionLogUpsertOne) ClearDurationMs() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetCancelRequestedAt sets the "cancel_requested_at" field.
+func (u *ExecutionLogUpsertOne) SetCancelRequestedAt(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCancelRequestedAt(v)
+	})
+}
+
+// UpdateCancelRequestedAt sets the "cancel_requested_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateCancelRequestedAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCancelRequestedAt()
+	})
+}
+
+// ClearCancelRequestedAt clears the value of the "cancel_requested_at" field.
+func (u *ExecutionLogUpsertOne) ClearCancelRequestedAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCancelRequestedAt()
+	})
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (u *ExecutionLogUpsertOne) SetCancelledBy(v uint32) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCancelledBy(v)
+	})
+}
+
+// AddCancelledBy adds v to the "cancelled_by" field.
+func (u *ExecutionLogUpsertOne) AddCancelledBy(v uint32) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddCancelledBy(v)
+	})
+}
+
+// UpdateCancelledBy sets the "cancelled_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateCancelledBy() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCancelledBy()
+	})
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (u *ExecutionLogUpsertOne) ClearCancelledBy() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCancelledBy()
+	})
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (u *ExecutionLogUpsertOne) SetCancelReason(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCancelReason(v)
+	})
+}
+
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateCancelReason() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCancelReason()
+	})
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (u *ExecutionLogUpsertOne) ClearCancelReason() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCancelReason()
+	})
+}
+
+// SetTimeoutReason sets the "timeout_reason" field.
+func (u *ExecutionLogUpsertOne) SetTimeoutReason(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetTimeoutReason(v)
+	})
+}
+
+// UpdateTimeoutReason sets the "timeout_reason" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateTimeoutReason() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateTimeoutReason()
+	})
+}
+
+// ClearTimeoutReason clears the value of the "timeout_reason" field.
+func (u *ExecutionLogUpsertOne) ClearTimeoutReason() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearTimeoutReason()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *ExecutionLogUpsertOne) SetRunID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateRunID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *ExecutionLogUpsertOne) ClearRunID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetEventRuleID sets the "event_rule_id" field.
+func (u *ExecutionLogUpsertOne) SetEventRuleID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetEventRuleID(v)
+	})
+}
+
+// UpdateEventRuleID sets the "event_rule_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateEventRuleID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateEventRuleID()
+	})
+}
+
+// ClearEventRuleID clears the value of the "event_rule_id" field.
+func (u *ExecutionLogUpsertOne) ClearEventRuleID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearEventRuleID()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *ExecutionLogUpsertOne) SetEventType(v executionlog.EventType) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateEventType() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (u *ExecutionLogUpsertOne) ClearEventType() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearEventType()
+	})
+}
+
+// SetEventDetail sets the "event_detail" field.
+func (u *ExecutionLogUpsertOne) SetEventDetail(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetEventDetail(v)
+	})
+}
+
+// UpdateEventDetail sets the "event_detail" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateEventDetail() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateEventDetail()
+	})
+}
+
+// ClearEventDetail clears the value of the "event_detail" field.
+func (u *ExecutionLogUpsertOne) ClearEventDetail() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearEventDetail()
+	})
+}
+
+// SetSourceExecutionID sets the "source_execution_id" field.
+func (u *ExecutionLogUpsertOne) SetSourceExecutionID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetSourceExecutionID(v)
+	})
+}
+
+// UpdateSourceExecutionID sets the "source_execution_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateSourceExecutionID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateSourceExecutionID()
+	})
+}
+
+// ClearSourceExecutionID clears the value of the "source_execution_id" field.
+func (u *ExecutionLogUpsertOne) ClearSourceExecutionID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearSourceExecutionID()
+	})
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (u *ExecutionLogUpsertOne) SetWorkflowRunID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetWorkflowRunID(v)
+	})
+}
+
+// UpdateWorkflowRunID sets the "workflow_run_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateWorkflowRunID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateWorkflowRunID()
+	})
+}
+
+// ClearWorkflowRunID clears the value of the "workflow_run_id" field.
+func (u *ExecutionLogUpsertOne) ClearWorkflowRunID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearWorkflowRunID()
+	})
+}
+
+// SetWorkflowStepID sets the "workflow_step_id" field.
+func (u *ExecutionLogUpsertOne) SetWorkflowStepID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetWorkflowStepID(v)
+	})
+}
+
+// UpdateWorkflowStepID sets the "workflow_step_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateWorkflowStepID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateWorkflowStepID()
+	})
+}
+
+// ClearWorkflowStepID clears the value of the "workflow_step_id" field.
+func (u *ExecutionLogUpsertOne) ClearWorkflowStepID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearWorkflowStepID()
+	})
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *ExecutionLogUpsertOne) SetAttempt(v int) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *ExecutionLogUpsertOne) AddAttempt(v int) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateAttempt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// SetOriginalExecutionID sets the "original_execution_id" field.
+func (u *ExecutionLogUpsertOne) SetOriginalExecutionID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetOriginalExecutionID(v)
+	})
+}
+
+// UpdateOriginalExecutionID sets the "original_execution_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateOriginalExecutionID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateOriginalExecutionID()
+	})
+}
+
+// ClearOriginalExecutionID clears the value of the "original_execution_id" field.
+func (u *ExecutionLogUpsertOne) ClearOriginalExecutionID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearOriginalExecutionID()
+	})
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (u *ExecutionLogUpsertOne) SetRetryAt(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetRetryAt(v)
+	})
+}
+
+// UpdateRetryAt sets the "retry_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateRetryAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateRetryAt()
+	})
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (u *ExecutionLogUpsertOne) ClearRetryAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearRetryAt()
+	})
+}
+
+// SetRetriedBy sets the "retried_by" field.
+func (u *ExecutionLogUpsertOne) SetRetriedBy(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetRetriedBy(v)
+	})
+}
+
+// UpdateRetriedBy sets the "retried_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateRetriedBy() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateRetriedBy()
+	})
+}
+
+// ClearRetriedBy clears the value of the "retried_by" field.
+func (u *ExecutionLogUpsertOne) ClearRetriedBy() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearRetriedBy()
+	})
+}
+
+// SetWaitingForSlot sets the "waiting_for_slot" field.
+func (u *ExecutionLogUpsertOne) SetWaitingForSlot(v bool) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetWaitingForSlot(v)
+	})
+}
+
+// UpdateWaitingForSlot sets the "waiting_for_slot" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateWaitingForSlot() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateWaitingForSlot()
+	})
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (u *ExecutionLogUpsertOne) SetHeldUntil(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetHeldUntil(v)
+	})
+}
+
+// UpdateHeldUntil sets the "held_until" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateHeldUntil() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateHeldUntil()
+	})
+}
+
+// ClearHeldUntil clears the value of the "held_until" field.
+func (u *ExecutionLogUpsertOne) ClearHeldUntil() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearHeldUntil()
+	})
+}
+
+// SetMaintenanceOverride sets the "maintenance_override" field.
+func (u *ExecutionLogUpsertOne) SetMaintenanceOverride(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetMaintenanceOverride(v)
+	})
+}
+
+// UpdateMaintenanceOverride sets the "maintenance_override" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateMaintenanceOverride() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateMaintenanceOverride()
+	})
+}
+
+// ClearMaintenanceOverride clears the value of the "maintenance_override" field.
+func (u *ExecutionLogUpsertOne) ClearMaintenanceOverride() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearMaintenanceOverride()
+	})
+}
+
+// SetParameters sets the "parameters" field.
+func (u *ExecutionLogUpsertOne) SetParameters(v map[string]string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetParameters(v)
+	})
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateParameters() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateParameters()
+	})
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *ExecutionLogUpsertOne) ClearParameters() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearParameters()
+	})
+}
+
+// SetSecretParametersCiphertext sets the "secret_parameters_ciphertext" field.
+func (u *ExecutionLogUpsertOne) SetSecretParametersCiphertext(v []byte) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetSecretParametersCiphertext(v)
+	})
+}
+
+// UpdateSecretParametersCiphertext sets the "secret_parameters_ciphertext" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateSecretParametersCiphertext() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateSecretParametersCiphertext()
+	})
+}
+
+// ClearSecretParametersCiphertext clears the value of the "secret_parameters_ciphertext" field.
+func (u *ExecutionLogUpsertOne) ClearSecretParametersCiphertext() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearSecretParametersCiphertext()
+	})
+}
+
+// SetSecretParametersNonce sets the "secret_parameters_nonce" field.
+func (u *ExecutionLogUpsertOne) SetSecretParametersNonce(v []byte) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetSecretParametersNonce(v)
+	})
+}
+
+// UpdateSecretParametersNonce sets the "secret_parameters_nonce" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateSecretParametersNonce() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateSecretParametersNonce()
+	})
+}
+
+// ClearSecretParametersNonce clears the value of the "secret_parameters_nonce" field.
+func (u *ExecutionLogUpsertOne) ClearSecretParametersNonce() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearSecretParametersNonce()
+	})
+}
+
+// SetSecretParametersKeyID sets the "secret_parameters_key_id" field.
+func (u *ExecutionLogUpsertOne) SetSecretParametersKeyID(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetSecretParametersKeyID(v)
+	})
+}
+
+// UpdateSecretParametersKeyID sets the "secret_parameters_key_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateSecretParametersKeyID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateSecretParametersKeyID()
+	})
+}
+
+// ClearSecretParametersKeyID clears the value of the "secret_parameters_key_id" field.
+func (u *ExecutionLogUpsertOne) ClearSecretParametersKeyID() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearSecretParametersKeyID()
+	})
+}
+
+// SetSecretNames sets the "secret_names" field.
+func (u *ExecutionLogUpsertOne) SetSecretNames(v []string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetSecretNames(v)
+	})
+}
+
+// UpdateSecretNames sets the "secret_names" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateSecretNames() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateSecretNames()
+	})
+}
+
+// ClearSecretNames clears the value of the "secret_names" field.
+func (u *ExecutionLogUpsertOne) ClearSecretNames() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearSecretNames()
+	})
+}
+
+// SetApprovalExpiresAt sets the "approval_expires_at" field.
+func (u *ExecutionLogUpsertOne) SetApprovalExpiresAt(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetApprovalExpiresAt(v)
+	})
+}
+
+// UpdateApprovalExpiresAt sets the "approval_expires_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateApprovalExpiresAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateApprovalExpiresAt()
+	})
+}
+
+// ClearApprovalExpiresAt clears the value of the "approval_expires_at" field.
+func (u *ExecutionLogUpsertOne) ClearApprovalExpiresAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearApprovalExpiresAt()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *ExecutionLogUpsertOne) SetApprovedBy(v uint32) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// AddApprovedBy adds v to the "approved_by" field.
+func (u *ExecutionLogUpsertOne) AddApprovedBy(v uint32) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateApprovedBy() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *ExecutionLogUpsertOne) ClearApprovedBy() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *ExecutionLogUpsertOne) SetApprovedAt(v time.Time) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateApprovedAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *ExecutionLogUpsertOne) ClearApprovedAt() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetApprovalComment sets the "approval_comment" field.
+func (u *ExecutionLogUpsertOne) SetApprovalComment(v string) *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetApprovalComment(v)
+	})
+}
+
+// UpdateApprovalComment sets the "approval_comment" field to the value that was provided on create.
+func (u *ExecutionLogUpsertOne) UpdateApprovalComment() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateApprovalComment()
+	})
+}
+
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (u *ExecutionLogUpsertOne) ClearApprovalComment() *ExecutionLogUpsertOne {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearApprovalComment()
+	})
+}
+
+// Exec executes the query.
+func (u *ExecutionLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExecutionLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExecutionLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExecutionLogUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ExecutionLogUpsertOne.ID is not supported by MySQL driver. Use ExecutionLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExecutionLogUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExecutionLogCreateBulk is the builder for creating many ExecutionLog entities in bulk.
+type ExecutionLogCreateBulk struct {
+	config
+	err      error
+	builders []*ExecutionLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExecutionLog entities in the database.
+func (_c *ExecutionLogCreateBulk) Save(ctx context.Context) ([]*ExecutionLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExecutionLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExecutionLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExecutionLogCreateBulk) SaveX(ctx context.Context) []*ExecutionLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExecutionLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExecutionLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExecutionLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExecutionLogUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExecutionLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExecutionLogUpsertBulk {
+	_c.conflict = opts
+	return &ExecutionLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExecutionLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExecutionLogCreateBulk) OnConflictColumns(columns ...string) *ExecutionLogUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExecutionLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExecutionLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExecutionLog nodes.
+type ExecutionLogUpsertBulk struct {
+	create *ExecutionLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExecutionLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(executionlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExecutionLogUpsertBulk) UpdateNewValues() *ExecutionLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(executionlog.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(executionlog.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(executionlog.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExecutionLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExecutionLogUpsertBulk) Ignore() *ExecutionLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExecutionLogUpsertBulk) DoNothing() *ExecutionLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExecutionLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExecutionLogUpsertBulk) Update(set func(*ExecutionLogUpsert)) *ExecutionLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExecutionLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ExecutionLogUpsertBulk) SetCreateBy(v uint32) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ExecutionLogUpsertBulk) AddCreateBy(v uint32) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateCreateBy() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ExecutionLogUpsertBulk) ClearCreateBy() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ExecutionLogUpsertBulk) SetUpdateTime(v time.Time) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateUpdateTime() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ExecutionLogUpsertBulk) ClearUpdateTime() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ExecutionLogUpsertBulk) SetDeleteTime(v time.Time) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateDeleteTime() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ExecutionLogUpsertBulk) ClearDeleteTime() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ExecutionLogUpsertBulk) SetScriptID(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateScriptID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetScriptName sets the "script_name" field.
+func (u *ExecutionLogUpsertBulk) SetScriptName(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetScriptName(v)
+	})
+}
+
+// UpdateScriptName sets the "script_name" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateScriptName() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateScriptName()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ExecutionLogUpsertBulk) SetClientID(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateClientID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetScriptHash sets the "script_hash" field.
+func (u *ExecutionLogUpsertBulk) SetScriptHash(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetScriptHash(v)
+	})
+}
+
+// UpdateScriptHash sets the "script_hash" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateScriptHash() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateScriptHash()
+	})
+}
+
+// SetTriggerType sets the "trigger_type" field.
+func (u *ExecutionLogUpsertBulk) SetTriggerType(v executionlog.TriggerType) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetTriggerType(v)
+	})
+}
+
+// UpdateTriggerType sets the "trigger_type" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateTriggerType() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateTriggerType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExecutionLogUpsertBulk) SetStatus(v executionlog.Status) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateStatus() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *ExecutionLogUpsertBulk) SetExitCode(v int) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetExitCode(v)
+	})
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *ExecutionLogUpsertBulk) AddExitCode(v int) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddExitCode(v)
+	})
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateExitCode() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateExitCode()
+	})
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *ExecutionLogUpsertBulk) ClearExitCode() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearExitCode()
+	})
+}
+
+// SetOutput sets the "output" field.
+func (u *ExecutionLogUpsertBulk) SetOutput(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetOutput(v)
+	})
 }
 
 // UpdateOutput sets the "output" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateOutput() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) UpdateOutput() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.UpdateOutput()
 	})
 }
 
 // ClearOutput clears the value of the "output" field.
-func (u *ExecutionLogUpsertOne) ClearOutput() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) ClearOutput() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.ClearOutput()
 	})
 }
 
 // SetErrorOutput sets the "error_output" field.
-func (u *ExecutionLogUpsertOne) SetErrorOutput(v string) *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) SetErrorOutput(v string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.SetErrorOutput(v)
 	})
 }
 
 // UpdateErrorOutput sets the "error_output" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateErrorOutput() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) UpdateErrorOutput() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.UpdateErrorOutput()
 	})
 }
 
 // ClearErrorOutput clears the value of the "error_output" field.
-func (u *ExecutionLogUpsertOne) ClearErrorOutput() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) ClearErrorOutput() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.ClearErrorOutput()
 	})
 }
 
 // SetRejectionReason sets the "rejection_reason" field.
-func (u *ExecutionLogUpsertOne) SetRejectionReason(v string) *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) SetRejectionReason(v string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.SetRejectionReason(v)
 	})
 }
 
 // UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateRejectionReason() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) UpdateRejectionReason() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.UpdateRejectionReason()
 	})
 }
 
 // ClearRejectionReason clears the value of the "rejection_reason" field.
-func (u *ExecutionLogUpsertOne) ClearRejectionReason() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) ClearRejectionReason() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.ClearRejectionReason()
 	})
 }
 
 // SetStartedAt sets the "started_at" field.
-func (u *ExecutionLogUpsertOne) SetStartedAt(v time.Time) *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) SetStartedAt(v time.Time) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.SetStartedAt(v)
 	})
 }
 
 // UpdateStartedAt sets the "started_at" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateStartedAt() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) UpdateStartedAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.UpdateStartedAt()
 	})
 }
 
 // ClearStartedAt clears the value of the "started_at" field.
-func (u *ExecutionLogUpsertOne) ClearStartedAt() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) ClearStartedAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.ClearStartedAt()
 	})
 }
 
 // SetCompletedAt sets the "completed_at" field.
-func (u *ExecutionLogUpsertOne) SetCompletedAt(v time.Time) *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) SetCompletedAt(v time.Time) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.SetCompletedAt(v)
 	})
 }
 
 // UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateCompletedAt() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) UpdateCompletedAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.UpdateCompletedAt()
 	})
 }
 
 // ClearCompletedAt clears the value of the "completed_at" field.
-func (u *ExecutionLogUpsertOne) ClearCompletedAt() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) ClearCompletedAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.ClearCompletedAt()
 	})
 }
 
 // SetDurationMs sets the "duration_ms" field.
-func (u *ExecutionLogUpsertOne) SetDurationMs(v int64) *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) SetDurationMs(v int64) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.SetDurationMs(v)
 	})
 }
 
 // AddDurationMs adds v to the "duration_ms" field.
-func (u *ExecutionLogUpsertOne) AddDurationMs(v int64) *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) AddDurationMs(v int64) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.AddDurationMs(v)
 	})
 }
 
 // UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
-func (u *ExecutionLogUpsertOne) UpdateDurationMs() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) UpdateDurationMs() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.UpdateDurationMs()
 	})
 }
 
 // ClearDurationMs clears the value of the "duration_ms" field.
-func (u *ExecutionLogUpsertOne) ClearDurationMs() *ExecutionLogUpsertOne {
+func (u *ExecutionLogUpsertBulk) ClearDurationMs() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
 		s.ClearDurationMs()
 	})
 }
 
-// Exec executes the query.
-func (u *ExecutionLogUpsertOne) Exec(ctx context.Context) error {
-	if len(u.create.conflict) == 0 {
-		return errors.New("ent: missing options for ExecutionLogCreate.OnConflict")
-	}
-	return u.create.Exec(ctx)
+// SetCancelRequestedAt sets the "cancel_requested_at" field.
+func (u *ExecutionLogUpsertBulk) SetCancelRequestedAt(v time.Time) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCancelRequestedAt(v)
+	})
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (u *ExecutionLogUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
-		panic(err)
-	}
+// UpdateCancelRequestedAt sets the "cancel_requested_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateCancelRequestedAt() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCancelRequestedAt()
+	})
 }
 
-// Exec executes the UPSERT query and returns the inserted/updated ID.
-func (u *ExecutionLogUpsertOne) ID(ctx context.Context) (id string, err error) {
-	if u.create.driver.Dialect() == dialect.MySQL {
-		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
-		// fields from the database since MySQL does not support the RETURNING clause.
-		return id, errors.New("ent: ExecutionLogUpsertOne.ID is not supported by MySQL driver. Use ExecutionLogUpsertOne.Exec instead")
-	}
-	node, err := u.create.Save(ctx)
-	if err != nil {
-		return id, err
-	}
-	return node.ID, nil
+// ClearCancelRequestedAt clears the value of the "cancel_requested_at" field.
+func (u *ExecutionLogUpsertBulk) ClearCancelRequestedAt() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCancelRequestedAt()
+	})
 }
 
-// IDX is like ID, but panics if an error occurs.
-func (u *ExecutionLogUpsertOne) IDX(ctx context.Context) string {
-	id, err := u.ID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
+// SetCancelledBy sets the "cancelled_by" field.
+func (u *ExecutionLogUpsertBulk) SetCancelledBy(v uint32) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCancelledBy(v)
+	})
 }
 
-// ExecutionLogCreateBulk is the builder for creating many ExecutionLog entities in bulk.
-type ExecutionLogCreateBulk struct {
-	config
-	err      error
-	builders []*ExecutionLogCreate
-	conflict []sql.ConflictOption
+// AddCancelledBy adds v to the "cancelled_by" field.
+func (u *ExecutionLogUpsertBulk) AddCancelledBy(v uint32) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddCancelledBy(v)
+	})
 }
 
-// Save creates the ExecutionLog entities in the database.
-func (_c *ExecutionLogCreateBulk) Save(ctx context.Context) ([]*ExecutionLog, error) {
-	if _c.err != nil {
-		return nil, _c.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*ExecutionLog, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*ExecutionLogMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					spec.OnConflict = _c.conflict
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
-	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
-	}
-	return nodes, nil
+// UpdateCancelledBy sets the "cancelled_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateCancelledBy() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCancelledBy()
+	})
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (u *ExecutionLogUpsertBulk) ClearCancelledBy() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCancelledBy()
+	})
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (u *ExecutionLogUpsertBulk) SetCancelReason(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetCancelReason(v)
+	})
+}
+
+// UpdateCancelReason sets the "cancel_reason" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateCancelReason() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateCancelReason()
+	})
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (u *ExecutionLogUpsertBulk) ClearCancelReason() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearCancelReason()
+	})
+}
+
+// SetTimeoutReason sets the "timeout_reason" field.
+func (u *ExecutionLogUpsertBulk) SetTimeoutReason(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetTimeoutReason(v)
+	})
+}
+
+// UpdateTimeoutReason sets the "timeout_reason" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateTimeoutReason() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateTimeoutReason()
+	})
+}
+
+// ClearTimeoutReason clears the value of the "timeout_reason" field.
+func (u *ExecutionLogUpsertBulk) ClearTimeoutReason() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearTimeoutReason()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *ExecutionLogUpsertBulk) SetRunID(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateRunID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *ExecutionLogUpsertBulk) ClearRunID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetEventRuleID sets the "event_rule_id" field.
+func (u *ExecutionLogUpsertBulk) SetEventRuleID(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetEventRuleID(v)
+	})
+}
+
+// UpdateEventRuleID sets the "event_rule_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateEventRuleID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateEventRuleID()
+	})
+}
+
+// ClearEventRuleID clears the value of the "event_rule_id" field.
+func (u *ExecutionLogUpsertBulk) ClearEventRuleID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearEventRuleID()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *ExecutionLogUpsertBulk) SetEventType(v executionlog.EventType) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateEventType() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (u *ExecutionLogUpsertBulk) ClearEventType() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearEventType()
+	})
+}
+
+// SetEventDetail sets the "event_detail" field.
+func (u *ExecutionLogUpsertBulk) SetEventDetail(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetEventDetail(v)
+	})
+}
+
+// UpdateEventDetail sets the "event_detail" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateEventDetail() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateEventDetail()
+	})
+}
+
+// ClearEventDetail clears the value of the "event_detail" field.
+func (u *ExecutionLogUpsertBulk) ClearEventDetail() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearEventDetail()
+	})
+}
+
+// SetSourceExecutionID sets the "source_execution_id" field.
+func (u *ExecutionLogUpsertBulk) SetSourceExecutionID(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetSourceExecutionID(v)
+	})
+}
+
+// UpdateSourceExecutionID sets the "source_execution_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateSourceExecutionID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateSourceExecutionID()
+	})
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *ExecutionLogCreateBulk) SaveX(ctx context.Context) []*ExecutionLog {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// ClearSourceExecutionID clears the value of the "source_execution_id" field.
+func (u *ExecutionLogUpsertBulk) ClearSourceExecutionID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearSourceExecutionID()
+	})
 }
 
-// Exec executes the query.
-func (_c *ExecutionLogCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (u *ExecutionLogUpsertBulk) SetWorkflowRunID(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetWorkflowRunID(v)
+	})
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *ExecutionLogCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
-		panic(err)
-	}
+// UpdateWorkflowRunID sets the "workflow_run_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateWorkflowRunID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateWorkflowRunID()
+	})
 }
 
-// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
-// of the `INSERT` statement. For example:
-//
-//	client.ExecutionLog.CreateBulk(builders...).
-//		OnConflict(
-//			// Update the row with the new values
-//			// the was proposed for insertion.
-//			sql.ResolveWithNewValues(),
-//		).
-//		// Override some of the fields with custom
-//		// update values.
-//		Update(func(u *ent.ExecutionLogUpsert) {
-//			SetCreateBy(v+v).
-//		}).
-//		Exec(ctx)
-func (_c *ExecutionLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExecutionLogUpsertBulk {
-	_c.conflict = opts
-	return &ExecutionLogUpsertBulk{
-		create: _c,
-	}
+// ClearWorkflowRunID clears the value of the "workflow_run_id" field.
+func (u *ExecutionLogUpsertBulk) ClearWorkflowRunID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearWorkflowRunID()
+	})
 }
 
-// OnConflictColumns calls `OnConflict` and configures the columns
-// as conflict target. Using this option is equivalent to using:
-//
-//	client.ExecutionLog.Create().
-//		OnConflict(sql.ConflictColumns(columns...)).
-//		Exec(ctx)
-func (_c *ExecutionLogCreateBulk) OnConflictColumns(columns ...string) *ExecutionLogUpsertBulk {
-	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
-	return &ExecutionLogUpsertBulk{
-		create: _c,
-	}
+// SetWorkflowStepID sets the "workflow_step_id" field.
+func (u *ExecutionLogUpsertBulk) SetWorkflowStepID(v string) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetWorkflowStepID(v)
+	})
 }
 
-// ExecutionLogUpsertBulk is the builder for "upsert"-ing
-// a bulk of ExecutionLog nodes.
-type ExecutionLogUpsertBulk struct {
-	create *ExecutionLogCreateBulk
+// UpdateWorkflowStepID sets the "workflow_step_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateWorkflowStepID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateWorkflowStepID()
+	})
 }
 
-// UpdateNewValues updates the mutable fields using the new values that
-// were set on create. Using this option is equivalent to using:
-//
-//	client.ExecutionLog.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(executionlog.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *ExecutionLogUpsertBulk) UpdateNewValues() *ExecutionLogUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		for _, b := range u.create.builders {
-			if _, exists := b.mutation.ID(); exists {
-				s.SetIgnore(executionlog.FieldID)
-			}
-			if _, exists := b.mutation.CreateTime(); exists {
-				s.SetIgnore(executionlog.FieldCreateTime)
-			}
-			if _, exists := b.mutation.TenantID(); exists {
-				s.SetIgnore(executionlog.FieldTenantID)
-			}
-		}
-	}))
-	return u
+// ClearWorkflowStepID clears the value of the "workflow_step_id" field.
+func (u *ExecutionLogUpsertBulk) ClearWorkflowStepID() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.ClearWorkflowStepID()
+	})
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.ExecutionLog.Create().
-//		OnConflict(sql.ResolveWithIgnore()).
-//		Exec(ctx)
-func (u *ExecutionLogUpsertBulk) Ignore() *ExecutionLogUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
-	return u
+// SetAttempt sets the "attempt" field.
+func (u *ExecutionLogUpsertBulk) SetAttempt(v int) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.SetAttempt(v)
+	})
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *ExecutionLogUpsertBulk) DoNothing() *ExecutionLogUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
-	return u
+// AddAttempt adds v to the "attempt" field.
+func (u *ExecutionLogUpsertBulk) AddAttempt(v int) *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.AddAttempt(v)
+	})
 }
 
-// Update allows overriding fields `UPDATE` values. See the ExecutionLogCreateBulk.OnConflict
-// documentation for more info.
-func (u *ExecutionLogUpsertBulk) Update(set func(*ExecutionLogUpsert)) *ExecutionLogUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&ExecutionLogUpsert{UpdateSet: update})
-	}))
-	return u
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateAttempt() *ExecutionLogUpsertBulk {
+	return u.Update(func(s *ExecutionLogUpsert) {
+		s.UpdateAttempt()
+	})
 }
 
-// SetCreateBy sets the "create_by" field.
-func (u *ExecutionLogUpsertBulk) SetCreateBy(v uint32) *ExecutionLogUpsertBulk {
+// SetOriginalExecutionID sets the "original_execution_id" field.
+func (u *ExecutionLogUpsertBulk) SetOriginalExecutionID(v string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetCreateBy(v)
+		s.SetOriginalExecutionID(v)
 	})
 }
 
-// AddCreateBy adds v to the "create_by" field.
-func (u *ExecutionLogUpsertBulk) AddCreateBy(v uint32) *ExecutionLogUpsertBulk {
+// UpdateOriginalExecutionID sets the "original_execution_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateOriginalExecutionID() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.AddCreateBy(v)
+		s.UpdateOriginalExecutionID()
 	})
 }
 
-// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateCreateBy() *ExecutionLogUpsertBulk {
+// ClearOriginalExecutionID clears the value of the "original_execution_id" field.
+func (u *ExecutionLogUpsertBulk) ClearOriginalExecutionID() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateCreateBy()
+		s.ClearOriginalExecutionID()
 	})
 }
 
-// ClearCreateBy clears the value of the "create_by" field.
-func (u *ExecutionLogUpsertBulk) ClearCreateBy() *ExecutionLogUpsertBulk {
+// SetRetryAt sets the "retry_at" field.
+func (u *ExecutionLogUpsertBulk) SetRetryAt(v time.Time) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearCreateBy()
+		s.SetRetryAt(v)
 	})
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (u *ExecutionLogUpsertBulk) SetUpdateTime(v time.Time) *ExecutionLogUpsertBulk {
+// UpdateRetryAt sets the "retry_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateRetryAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetUpdateTime(v)
+		s.UpdateRetryAt()
 	})
 }
 
-// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateUpdateTime() *ExecutionLogUpsertBulk {
+// ClearRetryAt clears the value of the "retry_at" field.
+func (u *ExecutionLogUpsertBulk) ClearRetryAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateUpdateTime()
+		s.ClearRetryAt()
 	})
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (u *ExecutionLogUpsertBulk) ClearUpdateTime() *ExecutionLogUpsertBulk {
+// SetRetriedBy sets the "retried_by" field.
+func (u *ExecutionLogUpsertBulk) SetRetriedBy(v string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearUpdateTime()
+		s.SetRetriedBy(v)
 	})
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (u *ExecutionLogUpsertBulk) SetDeleteTime(v time.Time) *ExecutionLogUpsertBulk {
+// UpdateRetriedBy sets the "retried_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateRetriedBy() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetDeleteTime(v)
+		s.UpdateRetriedBy()
 	})
 }
 
-// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateDeleteTime() *ExecutionLogUpsertBulk {
+// ClearRetriedBy clears the value of the "retried_by" field.
+func (u *ExecutionLogUpsertBulk) ClearRetriedBy() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateDeleteTime()
+		s.ClearRetriedBy()
 	})
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (u *ExecutionLogUpsertBulk) ClearDeleteTime() *ExecutionLogUpsertBulk {
+// SetWaitingForSlot sets the "waiting_for_slot" field.
+func (u *ExecutionLogUpsertBulk) SetWaitingForSlot(v bool) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearDeleteTime()
+		s.SetWaitingForSlot(v)
 	})
 }
 
-// SetScriptID sets the "script_id" field.
-func (u *ExecutionLogUpsertBulk) SetScriptID(v string) *ExecutionLogUpsertBulk {
+// UpdateWaitingForSlot sets the "waiting_for_slot" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateWaitingForSlot() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetScriptID(v)
+		s.UpdateWaitingForSlot()
 	})
 }
 
-// UpdateScriptID sets the "script_id" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateScriptID() *ExecutionLogUpsertBulk {
+// SetHeldUntil sets the "held_until" field.
+func (u *ExecutionLogUpsertBulk) SetHeldUntil(v time.Time) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateScriptID()
+		s.SetHeldUntil(v)
 	})
 }
 
-// SetScriptName sets the "script_name" field.
-func (u *ExecutionLogUpsertBulk) SetScriptName(v string) *ExecutionLogUpsertBulk {
+// UpdateHeldUntil sets the "held_until" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateHeldUntil() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetScriptName(v)
+		s.UpdateHeldUntil()
 	})
 }
 
-// UpdateScriptName sets the "script_name" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateScriptName() *ExecutionLogUpsertBulk {
+// ClearHeldUntil clears the value of the "held_until" field.
+func (u *ExecutionLogUpsertBulk) ClearHeldUntil() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateScriptName()
+		s.ClearHeldUntil()
 	})
 }
 
-// SetClientID sets the "client_id" field.
-func (u *ExecutionLogUpsertBulk) SetClientID(v string) *ExecutionLogUpsertBulk {
+// SetMaintenanceOverride sets the "maintenance_override" field.
+func (u *ExecutionLogUpsertBulk) SetMaintenanceOverride(v string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetClientID(v)
+		s.SetMaintenanceOverride(v)
 	})
 }
 
-// UpdateClientID sets the "client_id" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateClientID() *ExecutionLogUpsertBulk {
+// UpdateMaintenanceOverride sets the "maintenance_override" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateMaintenanceOverride() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateClientID()
+		s.UpdateMaintenanceOverride()
 	})
 }
 
-// SetScriptHash sets the "script_hash" field.
-func (u *ExecutionLogUpsertBulk) SetScriptHash(v string) *ExecutionLogUpsertBulk {
+// ClearMaintenanceOverride clears the value of the "maintenance_override" field.
+func (u *ExecutionLogUpsertBulk) ClearMaintenanceOverride() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetScriptHash(v)
+		s.ClearMaintenanceOverride()
 	})
 }
 
-// UpdateScriptHash sets the "script_hash" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateScriptHash() *ExecutionLogUpsertBulk {
+// SetParameters sets the "parameters" field.
+func (u *ExecutionLogUpsertBulk) SetParameters(v map[string]string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateScriptHash()
+		s.SetParameters(v)
 	})
 }
 
-// SetTriggerType sets the "trigger_type" field.
-func (u *ExecutionLogUpsertBulk) SetTriggerType(v executionlog.TriggerType) *ExecutionLogUpsertBulk {
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateParameters() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetTriggerType(v)
+		s.UpdateParameters()
 	})
 }
 
-// UpdateTriggerType sets the "trigger_type" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateTriggerType() *ExecutionLogUpsertBulk {
+// ClearParameters clears the value of the "parameters" field.
+func (u *ExecutionLogUpsertBulk) ClearParameters() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateTriggerType()
+		s.ClearParameters()
 	})
 }
 
-// SetStatus sets the "status" field.
-func (u *ExecutionLogUpsertBulk) SetStatus(v executionlog.Status) *ExecutionLogUpsertBulk {
+// SetSecretParametersCiphertext sets the "secret_parameters_ciphertext" field.
+func (u *ExecutionLogUpsertBulk) SetSecretParametersCiphertext(v []byte) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetStatus(v)
+		s.SetSecretParametersCiphertext(v)
 	})
 }
 
-// UpdateStatus sets the "status" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateStatus() *ExecutionLogUpsertBulk {
+// UpdateSecretParametersCiphertext sets the "secret_parameters_ciphertext" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateSecretParametersCiphertext() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateStatus()
+		s.UpdateSecretParametersCiphertext()
 	})
 }
 
-// SetExitCode sets the "exit_code" field.
-func (u *ExecutionLogUpsertBulk) SetExitCode(v int) *ExecutionLogUpsertBulk {
+// ClearSecretParametersCiphertext clears the value of the "secret_parameters_ciphertext" field.
+func (u *ExecutionLogUpsertBulk) ClearSecretParametersCiphertext() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetExitCode(v)
+		s.ClearSecretParametersCiphertext()
 	})
 }
 
-// AddExitCode adds v to the "exit_code" field.
-func (u *ExecutionLogUpsertBulk) AddExitCode(v int) *ExecutionLogUpsertBulk {
+// SetSecretParametersNonce sets the "secret_parameters_nonce" field.
+func (u *ExecutionLogUpsertBulk) SetSecretParametersNonce(v []byte) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.AddExitCode(v)
+		s.SetSecretParametersNonce(v)
 	})
 }
 
-// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateExitCode() *ExecutionLogUpsertBulk {
+// UpdateSecretParametersNonce sets the "secret_parameters_nonce" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateSecretParametersNonce() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateExitCode()
+		s.UpdateSecretParametersNonce()
 	})
 }
 
-// ClearExitCode clears the value of the "exit_code" field.
-func (u *ExecutionLogUpsertBulk) ClearExitCode() *ExecutionLogUpsertBulk {
+// ClearSecretParametersNonce clears the value of the "secret_parameters_nonce" field.
+func (u *ExecutionLogUpsertBulk) ClearSecretParametersNonce() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearExitCode()
+		s.ClearSecretParametersNonce()
 	})
 }
 
-// SetOutput sets the "output" field.
-func (u *ExecutionLogUpsertBulk) SetOutput(v string) *ExecutionLogUpsertBulk {
+// SetSecretParametersKeyID sets the "secret_parameters_key_id" field.
+func (u *ExecutionLogUpsertBulk) SetSecretParametersKeyID(v string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetOutput(v)
+		s.SetSecretParametersKeyID(v)
 	})
 }
 
-// UpdateOutput sets the "output" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateOutput() *ExecutionLogUpsertBulk {
+// UpdateSecretParametersKeyID sets the "secret_parameters_key_id" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateSecretParametersKeyID() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateOutput()
+		s.UpdateSecretParametersKeyID()
 	})
 }
 
-// ClearOutput clears the value of the "output" field.
-func (u *ExecutionLogUpsertBulk) ClearOutput() *ExecutionLogUpsertBulk {
+// ClearSecretParametersKeyID clears the value of the "secret_parameters_key_id" field.
+func (u *ExecutionLogUpsertBulk) ClearSecretParametersKeyID() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearOutput()
+		s.ClearSecretParametersKeyID()
 	})
 }
 
-// SetErrorOutput sets the "error_output" field.
-func (u *ExecutionLogUpsertBulk) SetErrorOutput(v string) *ExecutionLogUpsertBulk {
+// SetSecretNames sets the "secret_names" field.
+func (u *ExecutionLogUpsertBulk) SetSecretNames(v []string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetErrorOutput(v)
+		s.SetSecretNames(v)
 	})
 }
 
-// UpdateErrorOutput sets the "error_output" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateErrorOutput() *ExecutionLogUpsertBulk {
+// UpdateSecretNames sets the "secret_names" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateSecretNames() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateErrorOutput()
+		s.UpdateSecretNames()
 	})
 }
 
-// ClearErrorOutput clears the value of the "error_output" field.
-func (u *ExecutionLogUpsertBulk) ClearErrorOutput() *ExecutionLogUpsertBulk {
+// ClearSecretNames clears the value of the "secret_names" field.
+func (u *ExecutionLogUpsertBulk) ClearSecretNames() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearErrorOutput()
+		s.ClearSecretNames()
 	})
 }
 
-// SetRejectionReason sets the "rejection_reason" field.
-func (u *ExecutionLogUpsertBulk) SetRejectionReason(v string) *ExecutionLogUpsertBulk {
+// SetApprovalExpiresAt sets the "approval_expires_at" field.
+func (u *ExecutionLogUpsertBulk) SetApprovalExpiresAt(v time.Time) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetRejectionReason(v)
+		s.SetApprovalExpiresAt(v)
 	})
 }
 
-// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateRejectionReason() *ExecutionLogUpsertBulk {
+// UpdateApprovalExpiresAt sets the "approval_expires_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateApprovalExpiresAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateRejectionReason()
+		s.UpdateApprovalExpiresAt()
 	})
 }
 
-// ClearRejectionReason clears the value of the "rejection_reason" field.
-func (u *ExecutionLogUpsertBulk) ClearRejectionReason() *ExecutionLogUpsertBulk {
+// ClearApprovalExpiresAt clears the value of the "approval_expires_at" field.
+func (u *ExecutionLogUpsertBulk) ClearApprovalExpiresAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearRejectionReason()
+		s.ClearApprovalExpiresAt()
 	})
 }
 
-// SetStartedAt sets the "started_at" field.
-func (u *ExecutionLogUpsertBulk) SetStartedAt(v time.Time) *ExecutionLogUpsertBulk {
+// SetApprovedBy sets the "approved_by" field.
+func (u *ExecutionLogUpsertBulk) SetApprovedBy(v uint32) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetStartedAt(v)
+		s.SetApprovedBy(v)
 	})
 }
 
-// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateStartedAt() *ExecutionLogUpsertBulk {
+// AddApprovedBy adds v to the "approved_by" field.
+func (u *ExecutionLogUpsertBulk) AddApprovedBy(v uint32) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateStartedAt()
+		s.AddApprovedBy(v)
 	})
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (u *ExecutionLogUpsertBulk) ClearStartedAt() *ExecutionLogUpsertBulk {
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateApprovedBy() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearStartedAt()
+		s.UpdateApprovedBy()
 	})
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (u *ExecutionLogUpsertBulk) SetCompletedAt(v time.Time) *ExecutionLogUpsertBulk {
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *ExecutionLogUpsertBulk) ClearApprovedBy() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetCompletedAt(v)
+		s.ClearApprovedBy()
 	})
 }
 
-// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateCompletedAt() *ExecutionLogUpsertBulk {
+// SetApprovedAt sets the "approved_at" field.
+func (u *ExecutionLogUpsertBulk) SetApprovedAt(v time.Time) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateCompletedAt()
+		s.SetApprovedAt(v)
 	})
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (u *ExecutionLogUpsertBulk) ClearCompletedAt() *ExecutionLogUpsertBulk {
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateApprovedAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearCompletedAt()
+		s.UpdateApprovedAt()
 	})
 }
 
-// SetDurationMs sets the "duration_ms" field.
-func (u *ExecutionLogUpsertBulk) SetDurationMs(v int64) *ExecutionLogUpsertBulk {
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *ExecutionLogUpsertBulk) ClearApprovedAt() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.SetDurationMs(v)
+		s.ClearApprovedAt()
 	})
 }
 
-// AddDurationMs adds v to the "duration_ms" field.
-func (u *ExecutionLogUpsertBulk) AddDurationMs(v int64) *ExecutionLogUpsertBulk {
+// SetApprovalComment sets the "approval_comment" field.
+func (u *ExecutionLogUpsertBulk) SetApprovalComment(v string) *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.AddDurationMs(v)
+		s.SetApprovalComment(v)
 	})
 }
 
-// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
-func (u *ExecutionLogUpsertBulk) UpdateDurationMs() *ExecutionLogUpsertBulk {
+// UpdateApprovalComment sets the "approval_comment" field to the value that was provided on create.
+func (u *ExecutionLogUpsertBulk) UpdateApprovalComment() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.UpdateDurationMs()
+		s.UpdateApprovalComment()
 	})
 }
 
-// ClearDurationMs clears the value of the "duration_ms" field.
-func (u *ExecutionLogUpsertBulk) ClearDurationMs() *ExecutionLogUpsertBulk {
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (u *ExecutionLogUpsertBulk) ClearApprovalComment() *ExecutionLogUpsertBulk {
 	return u.Update(func(s *ExecutionLogUpsert) {
-		s.ClearDurationMs()
+		s.ClearApprovalComment()
 	})
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
@@ -334,134 +335,732 @@ func (_u *ExecutionLogUpdate) ClearDurationMs() *ExecutionLogUpdate {
 	return _u
 }
 
-// Mutation returns the ExecutionLogMutation object of the builder.
-func (_u *ExecutionLogUpdate) Mutation() *ExecutionLogMutation {
-	return _u.mutation
+// SetCancelRequestedAt sets the "cancel_requested_at" field.
+func (_u *ExecutionLogUpdate) SetCancelRequestedAt(v time.Time) *ExecutionLogUpdate {
+	_u.mutation.SetCancelRequestedAt(v)
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *ExecutionLogUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetNillableCancelRequestedAt sets the "cancel_requested_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableCancelRequestedAt(v *time.Time) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetCancelRequestedAt(*v)
+	}
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *ExecutionLogUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// ClearCancelRequestedAt clears the value of the "cancel_requested_at" field.
+func (_u *ExecutionLogUpdate) ClearCancelRequestedAt() *ExecutionLogUpdate {
+	_u.mutation.ClearCancelRequestedAt()
+	return _u
 }
 
-// Exec executes the query.
-func (_u *ExecutionLogUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// SetCancelledBy sets the "cancelled_by" field.
+func (_u *ExecutionLogUpdate) SetCancelledBy(v uint32) *ExecutionLogUpdate {
+	_u.mutation.ResetCancelledBy()
+	_u.mutation.SetCancelledBy(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *ExecutionLogUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableCancelledBy(v *uint32) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetCancelledBy(*v)
 	}
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *ExecutionLogUpdate) check() error {
-	if v, ok := _u.mutation.ScriptID(); ok {
-		if err := executionlog.ScriptIDValidator(v); err != nil {
-			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.script_id": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.ScriptName(); ok {
-		if err := executionlog.ScriptNameValidator(v); err != nil {
-			return &ValidationError{Name: "script_name", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.script_name": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.ClientID(); ok {
-		if err := executionlog.ClientIDValidator(v); err != nil {
-			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.client_id": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.ScriptHash(); ok {
-		if err := executionlog.ScriptHashValidator(v); err != nil {
-			return &ValidationError{Name: "script_hash", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.script_hash": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.TriggerType(); ok {
-		if err := executionlog.TriggerTypeValidator(v); err != nil {
-			return &ValidationError{Name: "trigger_type", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.trigger_type": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.Status(); ok {
-		if err := executionlog.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.status": %w`, err)}
-		}
+// AddCancelledBy adds value to the "cancelled_by" field.
+func (_u *ExecutionLogUpdate) AddCancelledBy(v int32) *ExecutionLogUpdate {
+	_u.mutation.AddCancelledBy(v)
+	return _u
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (_u *ExecutionLogUpdate) ClearCancelledBy() *ExecutionLogUpdate {
+	_u.mutation.ClearCancelledBy()
+	return _u
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_u *ExecutionLogUpdate) SetCancelReason(v string) *ExecutionLogUpdate {
+	_u.mutation.SetCancelReason(v)
+	return _u
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableCancelReason(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetCancelReason(*v)
 	}
-	if v, ok := _u.mutation.RejectionReason(); ok {
-		if err := executionlog.RejectionReasonValidator(v); err != nil {
-			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.rejection_reason": %w`, err)}
-		}
+	return _u
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (_u *ExecutionLogUpdate) ClearCancelReason() *ExecutionLogUpdate {
+	_u.mutation.ClearCancelReason()
+	return _u
+}
+
+// SetTimeoutReason sets the "timeout_reason" field.
+func (_u *ExecutionLogUpdate) SetTimeoutReason(v string) *ExecutionLogUpdate {
+	_u.mutation.SetTimeoutReason(v)
+	return _u
+}
+
+// SetNillableTimeoutReason sets the "timeout_reason" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableTimeoutReason(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetTimeoutReason(*v)
 	}
-	return nil
+	return _u
 }
 
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *ExecutionLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExecutionLogUpdate {
-	_u.modifiers = append(_u.modifiers, modifiers...)
+// ClearTimeoutReason clears the value of the "timeout_reason" field.
+func (_u *ExecutionLogUpdate) ClearTimeoutReason() *ExecutionLogUpdate {
+	_u.mutation.ClearTimeoutReason()
 	return _u
 }
 
-func (_u *ExecutionLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// SetRunID sets the "run_id" field.
+func (_u *ExecutionLogUpdate) SetRunID(v string) *ExecutionLogUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableRunID(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
 	}
-	_spec := sqlgraph.NewUpdateSpec(executionlog.Table, executionlog.Columns, sqlgraph.NewFieldSpec(executionlog.FieldID, field.TypeString))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *ExecutionLogUpdate) ClearRunID() *ExecutionLogUpdate {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetEventRuleID sets the "event_rule_id" field.
+func (_u *ExecutionLogUpdate) SetEventRuleID(v string) *ExecutionLogUpdate {
+	_u.mutation.SetEventRuleID(v)
+	return _u
+}
+
+// SetNillableEventRuleID sets the "event_rule_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableEventRuleID(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetEventRuleID(*v)
 	}
-	if value, ok := _u.mutation.CreateBy(); ok {
-		_spec.SetField(executionlog.FieldCreateBy, field.TypeUint32, value)
+	return _u
+}
+
+// ClearEventRuleID clears the value of the "event_rule_id" field.
+func (_u *ExecutionLogUpdate) ClearEventRuleID() *ExecutionLogUpdate {
+	_u.mutation.ClearEventRuleID()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *ExecutionLogUpdate) SetEventType(v executionlog.EventType) *ExecutionLogUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableEventType(v *executionlog.EventType) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
 	}
-	if value, ok := _u.mutation.AddedCreateBy(); ok {
-		_spec.AddField(executionlog.FieldCreateBy, field.TypeUint32, value)
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *ExecutionLogUpdate) ClearEventType() *ExecutionLogUpdate {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetEventDetail sets the "event_detail" field.
+func (_u *ExecutionLogUpdate) SetEventDetail(v string) *ExecutionLogUpdate {
+	_u.mutation.SetEventDetail(v)
+	return _u
+}
+
+// SetNillableEventDetail sets the "event_detail" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableEventDetail(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetEventDetail(*v)
 	}
-	if _u.mutation.CreateByCleared() {
-		_spec.ClearField(executionlog.FieldCreateBy, field.TypeUint32)
+	return _u
+}
+
+// ClearEventDetail clears the value of the "event_detail" field.
+func (_u *ExecutionLogUpdate) ClearEventDetail() *ExecutionLogUpdate {
+	_u.mutation.ClearEventDetail()
+	return _u
+}
+
+// SetSourceExecutionID sets the "source_execution_id" field.
+func (_u *ExecutionLogUpdate) SetSourceExecutionID(v string) *ExecutionLogUpdate {
+	_u.mutation.SetSourceExecutionID(v)
+	return _u
+}
+
+// SetNillableSourceExecutionID sets the "source_execution_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableSourceExecutionID(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetSourceExecutionID(*v)
 	}
-	if _u.mutation.CreateTimeCleared() {
-		_spec.ClearField(executionlog.FieldCreateTime, field.TypeTime)
+	return _u
+}
+
+// ClearSourceExecutionID clears the value of the "source_execution_id" field.
+func (_u *ExecutionLogUpdate) ClearSourceExecutionID() *ExecutionLogUpdate {
+	_u.mutation.ClearSourceExecutionID()
+	return _u
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (_u *ExecutionLogUpdate) SetWorkflowRunID(v string) *ExecutionLogUpdate {
+	_u.mutation.SetWorkflowRunID(v)
+	return _u
+}
+
+// SetNillableWorkflowRunID sets the "workflow_run_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableWorkflowRunID(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetWorkflowRunID(*v)
 	}
-	if value, ok := _u.mutation.UpdateTime(); ok {
-		_spec.SetField(executionlog.FieldUpdateTime, field.TypeTime, value)
+	return _u
+}
+
+// ClearWorkflowRunID clears the value of the "workflow_run_id" field.
+func (_u *ExecutionLogUpdate) ClearWorkflowRunID() *ExecutionLogUpdate {
+	_u.mutation.ClearWorkflowRunID()
+	return _u
+}
+
+// SetWorkflowStepID sets the "workflow_step_id" field.
+func (_u *ExecutionLogUpdate) SetWorkflowStepID(v string) *ExecutionLogUpdate {
+	_u.mutation.SetWorkflowStepID(v)
+	return _u
+}
+
+// SetNillableWorkflowStepID sets the "workflow_step_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableWorkflowStepID(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetWorkflowStepID(*v)
 	}
-	if _u.mutation.UpdateTimeCleared() {
-		_spec.ClearField(executionlog.FieldUpdateTime, field.TypeTime)
+	return _u
+}
+
+// ClearWorkflowStepID clears the value of the "workflow_step_id" field.
+func (_u *ExecutionLogUpdate) ClearWorkflowStepID() *ExecutionLogUpdate {
+	_u.mutation.ClearWorkflowStepID()
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *ExecutionLogUpdate) SetAttempt(v int) *ExecutionLogUpdate {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableAttempt(v *int) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetAttempt(*v)
 	}
-	if value, ok := _u.mutation.DeleteTime(); ok {
-		_spec.SetField(executionlog.FieldDeleteTime, field.TypeTime, value)
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *ExecutionLogUpdate) AddAttempt(v int) *ExecutionLogUpdate {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// SetOriginalExecutionID sets the "original_execution_id" field.
+func (_u *ExecutionLogUpdate) SetOriginalExecutionID(v string) *ExecutionLogUpdate {
+	_u.mutation.SetOriginalExecutionID(v)
+	return _u
+}
+
+// SetNillableOriginalExecutionID sets the "original_execution_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableOriginalExecutionID(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetOriginalExecutionID(*v)
 	}
-	if _u.mutation.DeleteTimeCleared() {
-		_spec.ClearField(executionlog.FieldDeleteTime, field.TypeTime)
+	return _u
+}
+
+// ClearOriginalExecutionID clears the value of the "original_execution_id" field.
+func (_u *ExecutionLogUpdate) ClearOriginalExecutionID() *ExecutionLogUpdate {
+	_u.mutation.ClearOriginalExecutionID()
+	return _u
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (_u *ExecutionLogUpdate) SetRetryAt(v time.Time) *ExecutionLogUpdate {
+	_u.mutation.SetRetryAt(v)
+	return _u
+}
+
+// SetNillableRetryAt sets the "retry_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableRetryAt(v *time.Time) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetRetryAt(*v)
 	}
-	if _u.mutation.TenantIDCleared() {
-		_spec.ClearField(executionlog.FieldTenantID, field.TypeUint32)
+	return _u
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (_u *ExecutionLogUpdate) ClearRetryAt() *ExecutionLogUpdate {
+	_u.mutation.ClearRetryAt()
+	return _u
+}
+
+// SetRetriedBy sets the "retried_by" field.
+func (_u *ExecutionLogUpdate) SetRetriedBy(v string) *ExecutionLogUpdate {
+	_u.mutation.SetRetriedBy(v)
+	return _u
+}
+
+// SetNillableRetriedBy sets the "retried_by" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableRetriedBy(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetRetriedBy(*v)
 	}
-	if value, ok := _u.mutation.ScriptID(); ok {
-		_spec.SetField(executionlog.FieldScriptID, field.TypeString, value)
+	return _u
+}
+
+// ClearRetriedBy clears the value of the "retried_by" field.
+func (_u *ExecutionLogUpdate) ClearRetriedBy() *ExecutionLogUpdate {
+	_u.mutation.ClearRetriedBy()
+	return _u
+}
+
+// SetWaitingForSlot sets the "waiting_for_slot" field.
+func (_u *ExecutionLogUpdate) SetWaitingForSlot(v bool) *ExecutionLogUpdate {
+	_u.mutation.SetWaitingForSlot(v)
+	return _u
+}
+
+// SetNillableWaitingForSlot sets the "waiting_for_slot" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableWaitingForSlot(v *bool) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetWaitingForSlot(*v)
 	}
-	if value, ok := _u.mutation.ScriptName(); ok {
-		_spec.SetField(executionlog.FieldScriptName, field.TypeString, value)
+	return _u
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (_u *ExecutionLogUpdate) SetHeldUntil(v time.Time) *ExecutionLogUpdate {
+	_u.mutation.SetHeldUntil(v)
+	return _u
+}
+
+// SetNillableHeldUntil sets the "held_until" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableHeldUntil(v *time.Time) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetHeldUntil(*v)
 	}
-	if value, ok := _u.mutation.ClientID(); ok {
-		_spec.SetField(executionlog.FieldClientID, field.TypeString, value)
+	return _u
+}
+
+// ClearHeldUntil clears the value of the "held_until" field.
+func (_u *ExecutionLogUpdate) ClearHeldUntil() *ExecutionLogUpdate {
+	_u.mutation.ClearHeldUntil()
+	return _u
+}
+
+// SetMaintenanceOverride sets the "maintenance_override" field.
+func (_u *ExecutionLogUpdate) SetMaintenanceOverride(v string) *ExecutionLogUpdate {
+	_u.mutation.SetMaintenanceOverride(v)
+	return _u
+}
+
+// SetNillableMaintenanceOverride sets the "maintenance_override" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableMaintenanceOverride(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetMaintenanceOverride(*v)
 	}
-	if value, ok := _u.mutation.ScriptHash(); ok {
-		_spec.SetField(executionlog.FieldScriptHash, field.TypeString, value)
+	return _u
+}
+
+// ClearMaintenanceOverride clears the value of the "maintenance_override" field.
+func (_u *ExecutionLogUpdate) ClearMaintenanceOverride() *ExecutionLogUpdate {
+	_u.mutation.ClearMaintenanceOverride()
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *ExecutionLogUpdate) SetParameters(v map[string]string) *ExecutionLogUpdate {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *ExecutionLogUpdate) ClearParameters() *ExecutionLogUpdate {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
+// SetSecretParametersCiphertext sets the "secret_parameters_ciphertext" field.
+func (_u *ExecutionLogUpdate) SetSecretParametersCiphertext(v []byte) *ExecutionLogUpdate {
+	_u.mutation.SetSecretParametersCiphertext(v)
+	return _u
+}
+
+// ClearSecretParametersCiphertext clears the value of the "secret_parameters_ciphertext" field.
+func (_u *ExecutionLogUpdate) ClearSecretParametersCiphertext() *ExecutionLogUpdate {
+	_u.mutation.ClearSecretParametersCiphertext()
+	return _u
+}
+
+// SetSecretParametersNonce sets the "secret_parameters_nonce" field.
+func (_u *ExecutionLogUpdate) SetSecretParametersNonce(v []byte) *ExecutionLogUpdate {
+	_u.mutation.SetSecretParametersNonce(v)
+	return _u
+}
+
+// ClearSecretParametersNonce clears the value of the "secret_parameters_nonce" field.
+func (_u *ExecutionLogUpdate) ClearSecretParametersNonce() *ExecutionLogUpdate {
+	_u.mutation.ClearSecretParametersNonce()
+	return _u
+}
+
+// SetSecretParametersKeyID sets the "secret_parameters_key_id" field.
+func (_u *ExecutionLogUpdate) SetSecretParametersKeyID(v string) *ExecutionLogUpdate {
+	_u.mutation.SetSecretParametersKeyID(v)
+	return _u
+}
+
+// SetNillableSecretParametersKeyID sets the "secret_parameters_key_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableSecretParametersKeyID(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetSecretParametersKeyID(*v)
+	}
+	return _u
+}
+
+// ClearSecretParametersKeyID clears the value of the "secret_parameters_key_id" field.
+func (_u *ExecutionLogUpdate) ClearSecretParametersKeyID() *ExecutionLogUpdate {
+	_u.mutation.ClearSecretParametersKeyID()
+	return _u
+}
+
+// SetSecretNames sets the "secret_names" field.
+func (_u *ExecutionLogUpdate) SetSecretNames(v []string) *ExecutionLogUpdate {
+	_u.mutation.SetSecretNames(v)
+	return _u
+}
+
+// AppendSecretNames appends value to the "secret_names" field.
+func (_u *ExecutionLogUpdate) AppendSecretNames(v []string) *ExecutionLogUpdate {
+	_u.mutation.AppendSecretNames(v)
+	return _u
+}
+
+// ClearSecretNames clears the value of the "secret_names" field.
+func (_u *ExecutionLogUpdate) ClearSecretNames() *ExecutionLogUpdate {
+	_u.mutation.ClearSecretNames()
+	return _u
+}
+
+// SetApprovalExpiresAt sets the "approval_expires_at" field.
+func (_u *ExecutionLogUpdate) SetApprovalExpiresAt(v time.Time) *ExecutionLogUpdate {
+	_u.mutation.SetApprovalExpiresAt(v)
+	return _u
+}
+
+// SetNillableApprovalExpiresAt sets the "approval_expires_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableApprovalExpiresAt(v *time.Time) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetApprovalExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovalExpiresAt clears the value of the "approval_expires_at" field.
+func (_u *ExecutionLogUpdate) ClearApprovalExpiresAt() *ExecutionLogUpdate {
+	_u.mutation.ClearApprovalExpiresAt()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *ExecutionLogUpdate) SetApprovedBy(v uint32) *ExecutionLogUpdate {
+	_u.mutation.ResetApprovedBy()
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableApprovedBy(v *uint32) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// AddApprovedBy adds value to the "approved_by" field.
+func (_u *ExecutionLogUpdate) AddApprovedBy(v int32) *ExecutionLogUpdate {
+	_u.mutation.AddApprovedBy(v)
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *ExecutionLogUpdate) ClearApprovedBy() *ExecutionLogUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *ExecutionLogUpdate) SetApprovedAt(v time.Time) *ExecutionLogUpdate {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableApprovedAt(v *time.Time) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *ExecutionLogUpdate) ClearApprovedAt() *ExecutionLogUpdate {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetApprovalComment sets the "approval_comment" field.
+func (_u *ExecutionLogUpdate) SetApprovalComment(v string) *ExecutionLogUpdate {
+	_u.mutation.SetApprovalComment(v)
+	return _u
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_u *ExecutionLogUpdate) SetNillableApprovalComment(v *string) *ExecutionLogUpdate {
+	if v != nil {
+		_u.SetApprovalComment(*v)
+	}
+	return _u
+}
+
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (_u *ExecutionLogUpdate) ClearApprovalComment() *ExecutionLogUpdate {
+	_u.mutation.ClearApprovalComment()
+	return _u
+}
+
+// Mutation returns the ExecutionLogMutation object of the builder.
+func (_u *ExecutionLogUpdate) Mutation() *ExecutionLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExecutionLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExecutionLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExecutionLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExecutionLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExecutionLogUpdate) check() error {
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := executionlog.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptName(); ok {
+		if err := executionlog.ScriptNameValidator(v); err != nil {
+			return &ValidationError{Name: "script_name", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.script_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := executionlog.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptHash(); ok {
+		if err := executionlog.ScriptHashValidator(v); err != nil {
+			return &ValidationError{Name: "script_hash", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.script_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TriggerType(); ok {
+		if err := executionlog.TriggerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "trigger_type", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.trigger_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := executionlog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RejectionReason(); ok {
+		if err := executionlog.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.rejection_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelReason(); ok {
+		if err := executionlog.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.cancel_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeoutReason(); ok {
+		if err := executionlog.TimeoutReasonValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.timeout_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RunID(); ok {
+		if err := executionlog.RunIDValidator(v); err != nil {
+			return &ValidationError{Name: "run_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.run_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventRuleID(); ok {
+		if err := executionlog.EventRuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_rule_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_rule_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := executionlog.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventDetail(); ok {
+		if err := executionlog.EventDetailValidator(v); err != nil {
+			return &ValidationError{Name: "event_detail", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_detail": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceExecutionID(); ok {
+		if err := executionlog.SourceExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_execution_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.source_execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowRunID(); ok {
+		if err := executionlog.WorkflowRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_run_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.workflow_run_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowStepID(); ok {
+		if err := executionlog.WorkflowStepIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_step_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.workflow_step_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempt(); ok {
+		if err := executionlog.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.attempt": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OriginalExecutionID(); ok {
+		if err := executionlog.OriginalExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "original_execution_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.original_execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetriedBy(); ok {
+		if err := executionlog.RetriedByValidator(v); err != nil {
+			return &ValidationError{Name: "retried_by", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.retried_by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaintenanceOverride(); ok {
+		if err := executionlog.MaintenanceOverrideValidator(v); err != nil {
+			return &ValidationError{Name: "maintenance_override", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.maintenance_override": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecretParametersKeyID(); ok {
+		if err := executionlog.SecretParametersKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "secret_parameters_key_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.secret_parameters_key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApprovalComment(); ok {
+		if err := executionlog.ApprovalCommentValidator(v); err != nil {
+			return &ValidationError{Name: "approval_comment", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.approval_comment": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExecutionLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExecutionLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExecutionLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(executionlog.Table, executionlog.Columns, sqlgraph.NewFieldSpec(executionlog.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(executionlog.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(executionlog.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(executionlog.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(executionlog.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(executionlog.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(executionlog.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(executionlog.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(executionlog.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(executionlog.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(executionlog.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScriptName(); ok {
+		_spec.SetField(executionlog.FieldScriptName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(executionlog.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScriptHash(); ok {
+		_spec.SetField(executionlog.FieldScriptHash, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.TriggerType(); ok {
 		_spec.SetField(executionlog.FieldTriggerType, field.TypeEnum, value)
@@ -517,13 +1116,183 @@ func (_u *ExecutionLogUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.DurationMsCleared() {
 		_spec.ClearField(executionlog.FieldDurationMs, field.TypeInt64)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{executionlog.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
+	if value, ok := _u.mutation.CancelRequestedAt(); ok {
+		_spec.SetField(executionlog.FieldCancelRequestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelRequestedAtCleared() {
+		_spec.ClearField(executionlog.FieldCancelRequestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledBy(); ok {
+		_spec.SetField(executionlog.FieldCancelledBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCancelledBy(); ok {
+		_spec.AddField(executionlog.FieldCancelledBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CancelledByCleared() {
+		_spec.ClearField(executionlog.FieldCancelledBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CancelReason(); ok {
+		_spec.SetField(executionlog.FieldCancelReason, field.TypeString, value)
+	}
+	if _u.mutation.CancelReasonCleared() {
+		_spec.ClearField(executionlog.FieldCancelReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.TimeoutReason(); ok {
+		_spec.SetField(executionlog.FieldTimeoutReason, field.TypeString, value)
+	}
+	if _u.mutation.TimeoutReasonCleared() {
+		_spec.ClearField(executionlog.FieldTimeoutReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(executionlog.FieldRunID, field.TypeString, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(executionlog.FieldRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventRuleID(); ok {
+		_spec.SetField(executionlog.FieldEventRuleID, field.TypeString, value)
+	}
+	if _u.mutation.EventRuleIDCleared() {
+		_spec.ClearField(executionlog.FieldEventRuleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(executionlog.FieldEventType, field.TypeEnum, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(executionlog.FieldEventType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.EventDetail(); ok {
+		_spec.SetField(executionlog.FieldEventDetail, field.TypeString, value)
+	}
+	if _u.mutation.EventDetailCleared() {
+		_spec.ClearField(executionlog.FieldEventDetail, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceExecutionID(); ok {
+		_spec.SetField(executionlog.FieldSourceExecutionID, field.TypeString, value)
+	}
+	if _u.mutation.SourceExecutionIDCleared() {
+		_spec.ClearField(executionlog.FieldSourceExecutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkflowRunID(); ok {
+		_spec.SetField(executionlog.FieldWorkflowRunID, field.TypeString, value)
+	}
+	if _u.mutation.WorkflowRunIDCleared() {
+		_spec.ClearField(executionlog.FieldWorkflowRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkflowStepID(); ok {
+		_spec.SetField(executionlog.FieldWorkflowStepID, field.TypeString, value)
+	}
+	if _u.mutation.WorkflowStepIDCleared() {
+		_spec.ClearField(executionlog.FieldWorkflowStepID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(executionlog.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(executionlog.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OriginalExecutionID(); ok {
+		_spec.SetField(executionlog.FieldOriginalExecutionID, field.TypeString, value)
+	}
+	if _u.mutation.OriginalExecutionIDCleared() {
+		_spec.ClearField(executionlog.FieldOriginalExecutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetryAt(); ok {
+		_spec.SetField(executionlog.FieldRetryAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetryAtCleared() {
+		_spec.ClearField(executionlog.FieldRetryAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RetriedBy(); ok {
+		_spec.SetField(executionlog.FieldRetriedBy, field.TypeString, value)
+	}
+	if _u.mutation.RetriedByCleared() {
+		_spec.ClearField(executionlog.FieldRetriedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.WaitingForSlot(); ok {
+		_spec.SetField(executionlog.FieldWaitingForSlot, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HeldUntil(); ok {
+		_spec.SetField(executionlog.FieldHeldUntil, field.TypeTime, value)
+	}
+	if _u.mutation.HeldUntilCleared() {
+		_spec.ClearField(executionlog.FieldHeldUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaintenanceOverride(); ok {
+		_spec.SetField(executionlog.FieldMaintenanceOverride, field.TypeString, value)
+	}
+	if _u.mutation.MaintenanceOverrideCleared() {
+		_spec.ClearField(executionlog.FieldMaintenanceOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(executionlog.FieldParameters, field.TypeJSON, value)
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(executionlog.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SecretParametersCiphertext(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersCiphertext, field.TypeBytes, value)
+	}
+	if _u.mutation.SecretParametersCiphertextCleared() {
+		_spec.ClearField(executionlog.FieldSecretParametersCiphertext, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SecretParametersNonce(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersNonce, field.TypeBytes, value)
+	}
+	if _u.mutation.SecretParametersNonceCleared() {
+		_spec.ClearField(executionlog.FieldSecretParametersNonce, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SecretParametersKeyID(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersKeyID, field.TypeString, value)
+	}
+	if _u.mutation.SecretParametersKeyIDCleared() {
+		_spec.ClearField(executionlog.FieldSecretParametersKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecretNames(); ok {
+		_spec.SetField(executionlog.FieldSecretNames, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSecretNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, executionlog.FieldSecretNames, value)
+		})
+	}
+	if _u.mutation.SecretNamesCleared() {
+		_spec.ClearField(executionlog.FieldSecretNames, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ApprovalExpiresAt(); ok {
+		_spec.SetField(executionlog.FieldApprovalExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovalExpiresAtCleared() {
+		_spec.ClearField(executionlog.FieldApprovalExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(executionlog.FieldApprovedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedApprovedBy(); ok {
+		_spec.AddField(executionlog.FieldApprovedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(executionlog.FieldApprovedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(executionlog.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(executionlog.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ApprovalComment(); ok {
+		_spec.SetField(executionlog.FieldApprovalComment, field.TypeString, value)
+	}
+	if _u.mutation.ApprovalCommentCleared() {
+		_spec.ClearField(executionlog.FieldApprovalComment, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{executionlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
 		return 0, err
 	}
 	_u.mutation.done = true
@@ -539,308 +1308,831 @@ type ExecutionLogUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
-// SetCreateBy sets the "create_by" field.
-func (_u *ExecutionLogUpdateOne) SetCreateBy(v uint32) *ExecutionLogUpdateOne {
-	_u.mutation.ResetCreateBy()
-	_u.mutation.SetCreateBy(v)
+// SetCreateBy sets the "create_by" field.
+func (_u *ExecutionLogUpdateOne) SetCreateBy(v uint32) *ExecutionLogUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableCreateBy(v *uint32) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ExecutionLogUpdateOne) AddCreateBy(v int32) *ExecutionLogUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ExecutionLogUpdateOne) ClearCreateBy() *ExecutionLogUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ExecutionLogUpdateOne) SetUpdateTime(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableUpdateTime(v *time.Time) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ExecutionLogUpdateOne) ClearUpdateTime() *ExecutionLogUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ExecutionLogUpdateOne) SetDeleteTime(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableDeleteTime(v *time.Time) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ExecutionLogUpdateOne) ClearDeleteTime() *ExecutionLogUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ExecutionLogUpdateOne) SetScriptID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableScriptID(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetScriptName sets the "script_name" field.
+func (_u *ExecutionLogUpdateOne) SetScriptName(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetScriptName(v)
+	return _u
+}
+
+// SetNillableScriptName sets the "script_name" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableScriptName(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetScriptName(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *ExecutionLogUpdateOne) SetClientID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableClientID(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetScriptHash sets the "script_hash" field.
+func (_u *ExecutionLogUpdateOne) SetScriptHash(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetScriptHash(v)
+	return _u
+}
+
+// SetNillableScriptHash sets the "script_hash" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableScriptHash(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetScriptHash(*v)
+	}
+	return _u
+}
+
+// SetTriggerType sets the "trigger_type" field.
+func (_u *ExecutionLogUpdateOne) SetTriggerType(v executionlog.TriggerType) *ExecutionLogUpdateOne {
+	_u.mutation.SetTriggerType(v)
+	return _u
+}
+
+// SetNillableTriggerType sets the "trigger_type" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableTriggerType(v *executionlog.TriggerType) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetTriggerType(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExecutionLogUpdateOne) SetStatus(v executionlog.Status) *ExecutionLogUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableStatus(v *executionlog.Status) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_u *ExecutionLogUpdateOne) SetExitCode(v int) *ExecutionLogUpdateOne {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableExitCode(v *int) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *ExecutionLogUpdateOne) AddExitCode(v int) *ExecutionLogUpdateOne {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (_u *ExecutionLogUpdateOne) ClearExitCode() *ExecutionLogUpdateOne {
+	_u.mutation.ClearExitCode()
+	return _u
+}
+
+// SetOutput sets the "output" field.
+func (_u *ExecutionLogUpdateOne) SetOutput(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetOutput(v)
+	return _u
+}
+
+// SetNillableOutput sets the "output" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableOutput(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetOutput(*v)
+	}
+	return _u
+}
+
+// ClearOutput clears the value of the "output" field.
+func (_u *ExecutionLogUpdateOne) ClearOutput() *ExecutionLogUpdateOne {
+	_u.mutation.ClearOutput()
+	return _u
+}
+
+// SetErrorOutput sets the "error_output" field.
+func (_u *ExecutionLogUpdateOne) SetErrorOutput(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetErrorOutput(v)
+	return _u
+}
+
+// SetNillableErrorOutput sets the "error_output" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableErrorOutput(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetErrorOutput(*v)
+	}
+	return _u
+}
+
+// ClearErrorOutput clears the value of the "error_output" field.
+func (_u *ExecutionLogUpdateOne) ClearErrorOutput() *ExecutionLogUpdateOne {
+	_u.mutation.ClearErrorOutput()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *ExecutionLogUpdateOne) SetRejectionReason(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableRejectionReason(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *ExecutionLogUpdateOne) ClearRejectionReason() *ExecutionLogUpdateOne {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ExecutionLogUpdateOne) SetStartedAt(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableStartedAt(v *time.Time) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ExecutionLogUpdateOne) ClearStartedAt() *ExecutionLogUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ExecutionLogUpdateOne) SetCompletedAt(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableCompletedAt(v *time.Time) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ExecutionLogUpdateOne) ClearCompletedAt() *ExecutionLogUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *ExecutionLogUpdateOne) SetDurationMs(v int64) *ExecutionLogUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableDurationMs(v *int64) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *ExecutionLogUpdateOne) AddDurationMs(v int64) *ExecutionLogUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *ExecutionLogUpdateOne) ClearDurationMs() *ExecutionLogUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetCancelRequestedAt sets the "cancel_requested_at" field.
+func (_u *ExecutionLogUpdateOne) SetCancelRequestedAt(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetCancelRequestedAt(v)
+	return _u
+}
+
+// SetNillableCancelRequestedAt sets the "cancel_requested_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableCancelRequestedAt(v *time.Time) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetCancelRequestedAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelRequestedAt clears the value of the "cancel_requested_at" field.
+func (_u *ExecutionLogUpdateOne) ClearCancelRequestedAt() *ExecutionLogUpdateOne {
+	_u.mutation.ClearCancelRequestedAt()
+	return _u
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_u *ExecutionLogUpdateOne) SetCancelledBy(v uint32) *ExecutionLogUpdateOne {
+	_u.mutation.ResetCancelledBy()
+	_u.mutation.SetCancelledBy(v)
+	return _u
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableCancelledBy(v *uint32) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetCancelledBy(*v)
+	}
+	return _u
+}
+
+// AddCancelledBy adds value to the "cancelled_by" field.
+func (_u *ExecutionLogUpdateOne) AddCancelledBy(v int32) *ExecutionLogUpdateOne {
+	_u.mutation.AddCancelledBy(v)
+	return _u
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (_u *ExecutionLogUpdateOne) ClearCancelledBy() *ExecutionLogUpdateOne {
+	_u.mutation.ClearCancelledBy()
+	return _u
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_u *ExecutionLogUpdateOne) SetCancelReason(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetCancelReason(v)
+	return _u
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableCancelReason(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetCancelReason(*v)
+	}
+	return _u
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (_u *ExecutionLogUpdateOne) ClearCancelReason() *ExecutionLogUpdateOne {
+	_u.mutation.ClearCancelReason()
+	return _u
+}
+
+// SetTimeoutReason sets the "timeout_reason" field.
+func (_u *ExecutionLogUpdateOne) SetTimeoutReason(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetTimeoutReason(v)
+	return _u
+}
+
+// SetNillableTimeoutReason sets the "timeout_reason" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableTimeoutReason(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetTimeoutReason(*v)
+	}
+	return _u
+}
+
+// ClearTimeoutReason clears the value of the "timeout_reason" field.
+func (_u *ExecutionLogUpdateOne) ClearTimeoutReason() *ExecutionLogUpdateOne {
+	_u.mutation.ClearTimeoutReason()
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *ExecutionLogUpdateOne) SetRunID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableRunID(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *ExecutionLogUpdateOne) ClearRunID() *ExecutionLogUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetEventRuleID sets the "event_rule_id" field.
+func (_u *ExecutionLogUpdateOne) SetEventRuleID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetEventRuleID(v)
+	return _u
+}
+
+// SetNillableEventRuleID sets the "event_rule_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableEventRuleID(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetEventRuleID(*v)
+	}
+	return _u
+}
+
+// ClearEventRuleID clears the value of the "event_rule_id" field.
+func (_u *ExecutionLogUpdateOne) ClearEventRuleID() *ExecutionLogUpdateOne {
+	_u.mutation.ClearEventRuleID()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *ExecutionLogUpdateOne) SetEventType(v executionlog.EventType) *ExecutionLogUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableEventType(v *executionlog.EventType) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *ExecutionLogUpdateOne) ClearEventType() *ExecutionLogUpdateOne {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetEventDetail sets the "event_detail" field.
+func (_u *ExecutionLogUpdateOne) SetEventDetail(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetEventDetail(v)
+	return _u
+}
+
+// SetNillableEventDetail sets the "event_detail" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableEventDetail(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetEventDetail(*v)
+	}
+	return _u
+}
+
+// ClearEventDetail clears the value of the "event_detail" field.
+func (_u *ExecutionLogUpdateOne) ClearEventDetail() *ExecutionLogUpdateOne {
+	_u.mutation.ClearEventDetail()
+	return _u
+}
+
+// SetSourceExecutionID sets the "source_execution_id" field.
+func (_u *ExecutionLogUpdateOne) SetSourceExecutionID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetSourceExecutionID(v)
+	return _u
+}
+
+// SetNillableSourceExecutionID sets the "source_execution_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableSourceExecutionID(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetSourceExecutionID(*v)
+	}
+	return _u
+}
+
+// ClearSourceExecutionID clears the value of the "source_execution_id" field.
+func (_u *ExecutionLogUpdateOne) ClearSourceExecutionID() *ExecutionLogUpdateOne {
+	_u.mutation.ClearSourceExecutionID()
+	return _u
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (_u *ExecutionLogUpdateOne) SetWorkflowRunID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetWorkflowRunID(v)
 	return _u
 }
 
-// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableCreateBy(v *uint32) *ExecutionLogUpdateOne {
+// SetNillableWorkflowRunID sets the "workflow_run_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableWorkflowRunID(v *string) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetCreateBy(*v)
+		_u.SetWorkflowRunID(*v)
 	}
 	return _u
 }
 
-// AddCreateBy adds value to the "create_by" field.
-func (_u *ExecutionLogUpdateOne) AddCreateBy(v int32) *ExecutionLogUpdateOne {
-	_u.mutation.AddCreateBy(v)
-	return _u
-}
-
-// ClearCreateBy clears the value of the "create_by" field.
-func (_u *ExecutionLogUpdateOne) ClearCreateBy() *ExecutionLogUpdateOne {
-	_u.mutation.ClearCreateBy()
+// ClearWorkflowRunID clears the value of the "workflow_run_id" field.
+func (_u *ExecutionLogUpdateOne) ClearWorkflowRunID() *ExecutionLogUpdateOne {
+	_u.mutation.ClearWorkflowRunID()
 	return _u
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (_u *ExecutionLogUpdateOne) SetUpdateTime(v time.Time) *ExecutionLogUpdateOne {
-	_u.mutation.SetUpdateTime(v)
+// SetWorkflowStepID sets the "workflow_step_id" field.
+func (_u *ExecutionLogUpdateOne) SetWorkflowStepID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetWorkflowStepID(v)
 	return _u
 }
 
-// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableUpdateTime(v *time.Time) *ExecutionLogUpdateOne {
+// SetNillableWorkflowStepID sets the "workflow_step_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableWorkflowStepID(v *string) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetUpdateTime(*v)
+		_u.SetWorkflowStepID(*v)
 	}
 	return _u
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (_u *ExecutionLogUpdateOne) ClearUpdateTime() *ExecutionLogUpdateOne {
-	_u.mutation.ClearUpdateTime()
+// ClearWorkflowStepID clears the value of the "workflow_step_id" field.
+func (_u *ExecutionLogUpdateOne) ClearWorkflowStepID() *ExecutionLogUpdateOne {
+	_u.mutation.ClearWorkflowStepID()
 	return _u
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (_u *ExecutionLogUpdateOne) SetDeleteTime(v time.Time) *ExecutionLogUpdateOne {
-	_u.mutation.SetDeleteTime(v)
+// SetAttempt sets the "attempt" field.
+func (_u *ExecutionLogUpdateOne) SetAttempt(v int) *ExecutionLogUpdateOne {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
 	return _u
 }
 
-// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableDeleteTime(v *time.Time) *ExecutionLogUpdateOne {
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableAttempt(v *int) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetDeleteTime(*v)
+		_u.SetAttempt(*v)
 	}
 	return _u
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (_u *ExecutionLogUpdateOne) ClearDeleteTime() *ExecutionLogUpdateOne {
-	_u.mutation.ClearDeleteTime()
+// AddAttempt adds value to the "attempt" field.
+func (_u *ExecutionLogUpdateOne) AddAttempt(v int) *ExecutionLogUpdateOne {
+	_u.mutation.AddAttempt(v)
 	return _u
 }
 
-// SetScriptID sets the "script_id" field.
-func (_u *ExecutionLogUpdateOne) SetScriptID(v string) *ExecutionLogUpdateOne {
-	_u.mutation.SetScriptID(v)
+// SetOriginalExecutionID sets the "original_execution_id" field.
+func (_u *ExecutionLogUpdateOne) SetOriginalExecutionID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetOriginalExecutionID(v)
 	return _u
 }
 
-// SetNillableScriptID sets the "script_id" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableScriptID(v *string) *ExecutionLogUpdateOne {
+// SetNillableOriginalExecutionID sets the "original_execution_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableOriginalExecutionID(v *string) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetScriptID(*v)
+		_u.SetOriginalExecutionID(*v)
 	}
 	return _u
 }
 
-// SetScriptName sets the "script_name" field.
-func (_u *ExecutionLogUpdateOne) SetScriptName(v string) *ExecutionLogUpdateOne {
-	_u.mutation.SetScriptName(v)
+// ClearOriginalExecutionID clears the value of the "original_execution_id" field.
+func (_u *ExecutionLogUpdateOne) ClearOriginalExecutionID() *ExecutionLogUpdateOne {
+	_u.mutation.ClearOriginalExecutionID()
 	return _u
 }
 
-// SetNillableScriptName sets the "script_name" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableScriptName(v *string) *ExecutionLogUpdateOne {
+// SetRetryAt sets the "retry_at" field.
+func (_u *ExecutionLogUpdateOne) SetRetryAt(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetRetryAt(v)
+	return _u
+}
+
+// SetNillableRetryAt sets the "retry_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableRetryAt(v *time.Time) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetScriptName(*v)
+		_u.SetRetryAt(*v)
 	}
 	return _u
 }
 
-// SetClientID sets the "client_id" field.
-func (_u *ExecutionLogUpdateOne) SetClientID(v string) *ExecutionLogUpdateOne {
-	_u.mutation.SetClientID(v)
+// ClearRetryAt clears the value of the "retry_at" field.
+func (_u *ExecutionLogUpdateOne) ClearRetryAt() *ExecutionLogUpdateOne {
+	_u.mutation.ClearRetryAt()
 	return _u
 }
 
-// SetNillableClientID sets the "client_id" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableClientID(v *string) *ExecutionLogUpdateOne {
+// SetRetriedBy sets the "retried_by" field.
+func (_u *ExecutionLogUpdateOne) SetRetriedBy(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetRetriedBy(v)
+	return _u
+}
+
+// SetNillableRetriedBy sets the "retried_by" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableRetriedBy(v *string) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetClientID(*v)
+		_u.SetRetriedBy(*v)
 	}
 	return _u
 }
 
-// SetScriptHash sets the "script_hash" field.
-func (_u *ExecutionLogUpdateOne) SetScriptHash(v string) *ExecutionLogUpdateOne {
-	_u.mutation.SetScriptHash(v)
+// ClearRetriedBy clears the value of the "retried_by" field.
+func (_u *ExecutionLogUpdateOne) ClearRetriedBy() *ExecutionLogUpdateOne {
+	_u.mutation.ClearRetriedBy()
 	return _u
 }
 
-// SetNillableScriptHash sets the "script_hash" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableScriptHash(v *string) *ExecutionLogUpdateOne {
+// SetWaitingForSlot sets the "waiting_for_slot" field.
+func (_u *ExecutionLogUpdateOne) SetWaitingForSlot(v bool) *ExecutionLogUpdateOne {
+	_u.mutation.SetWaitingForSlot(v)
+	return _u
+}
+
+// SetNillableWaitingForSlot sets the "waiting_for_slot" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableWaitingForSlot(v *bool) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetScriptHash(*v)
+		_u.SetWaitingForSlot(*v)
 	}
 	return _u
 }
 
-// SetTriggerType sets the "trigger_type" field.
-func (_u *ExecutionLogUpdateOne) SetTriggerType(v executionlog.TriggerType) *ExecutionLogUpdateOne {
-	_u.mutation.SetTriggerType(v)
+// SetHeldUntil sets the "held_until" field.
+func (_u *ExecutionLogUpdateOne) SetHeldUntil(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetHeldUntil(v)
 	return _u
 }
 
-// SetNillableTriggerType sets the "trigger_type" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableTriggerType(v *executionlog.TriggerType) *ExecutionLogUpdateOne {
+// SetNillableHeldUntil sets the "held_until" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableHeldUntil(v *time.Time) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetTriggerType(*v)
+		_u.SetHeldUntil(*v)
 	}
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *ExecutionLogUpdateOne) SetStatus(v executionlog.Status) *ExecutionLogUpdateOne {
-	_u.mutation.SetStatus(v)
+// ClearHeldUntil clears the value of the "held_until" field.
+func (_u *ExecutionLogUpdateOne) ClearHeldUntil() *ExecutionLogUpdateOne {
+	_u.mutation.ClearHeldUntil()
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableStatus(v *executionlog.Status) *ExecutionLogUpdateOne {
+// SetMaintenanceOverride sets the "maintenance_override" field.
+func (_u *ExecutionLogUpdateOne) SetMaintenanceOverride(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetMaintenanceOverride(v)
+	return _u
+}
+
+// SetNillableMaintenanceOverride sets the "maintenance_override" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableMaintenanceOverride(v *string) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetMaintenanceOverride(*v)
 	}
 	return _u
 }
 
-// SetExitCode sets the "exit_code" field.
-func (_u *ExecutionLogUpdateOne) SetExitCode(v int) *ExecutionLogUpdateOne {
-	_u.mutation.ResetExitCode()
-	_u.mutation.SetExitCode(v)
+// ClearMaintenanceOverride clears the value of the "maintenance_override" field.
+func (_u *ExecutionLogUpdateOne) ClearMaintenanceOverride() *ExecutionLogUpdateOne {
+	_u.mutation.ClearMaintenanceOverride()
 	return _u
 }
 
-// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableExitCode(v *int) *ExecutionLogUpdateOne {
-	if v != nil {
-		_u.SetExitCode(*v)
-	}
+// SetParameters sets the "parameters" field.
+func (_u *ExecutionLogUpdateOne) SetParameters(v map[string]string) *ExecutionLogUpdateOne {
+	_u.mutation.SetParameters(v)
 	return _u
 }
 
-// AddExitCode adds value to the "exit_code" field.
-func (_u *ExecutionLogUpdateOne) AddExitCode(v int) *ExecutionLogUpdateOne {
-	_u.mutation.AddExitCode(v)
+// ClearParameters clears the value of the "parameters" field.
+func (_u *ExecutionLogUpdateOne) ClearParameters() *ExecutionLogUpdateOne {
+	_u.mutation.ClearParameters()
 	return _u
 }
 
-// ClearExitCode clears the value of the "exit_code" field.
-func (_u *ExecutionLogUpdateOne) ClearExitCode() *ExecutionLogUpdateOne {
-	_u.mutation.ClearExitCode()
+// SetSecretParametersCiphertext sets the "secret_parameters_ciphertext" field.
+func (_u *ExecutionLogUpdateOne) SetSecretParametersCiphertext(v []byte) *ExecutionLogUpdateOne {
+	_u.mutation.SetSecretParametersCiphertext(v)
 	return _u
 }
 
-// SetOutput sets the "output" field.
-func (_u *ExecutionLogUpdateOne) SetOutput(v string) *ExecutionLogUpdateOne {
-	_u.mutation.SetOutput(v)
+// ClearSecretParametersCiphertext clears the value of the "secret_parameters_ciphertext" field.
+func (_u *ExecutionLogUpdateOne) ClearSecretParametersCiphertext() *ExecutionLogUpdateOne {
+	_u.mutation.ClearSecretParametersCiphertext()
 	return _u
 }
 
-// SetNillableOutput sets the "output" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableOutput(v *string) *ExecutionLogUpdateOne {
-	if v != nil {
-		_u.SetOutput(*v)
-	}
+// SetSecretParametersNonce sets the "secret_parameters_nonce" field.
+func (_u *ExecutionLogUpdateOne) SetSecretParametersNonce(v []byte) *ExecutionLogUpdateOne {
+	_u.mutation.SetSecretParametersNonce(v)
 	return _u
 }
 
-// ClearOutput clears the value of the "output" field.
-func (_u *ExecutionLogUpdateOne) ClearOutput() *ExecutionLogUpdateOne {
-	_u.mutation.ClearOutput()
+// ClearSecretParametersNonce clears the value of the "secret_parameters_nonce" field.
+func (_u *ExecutionLogUpdateOne) ClearSecretParametersNonce() *ExecutionLogUpdateOne {
+	_u.mutation.ClearSecretParametersNonce()
 	return _u
 }
 
-// SetErrorOutput sets the "error_output" field.
-func (_u *ExecutionLogUpdateOne) SetErrorOutput(v string) *ExecutionLogUpdateOne {
-	_u.mutation.SetErrorOutput(v)
+// SetSecretParametersKeyID sets the "secret_parameters_key_id" field.
+func (_u *ExecutionLogUpdateOne) SetSecretParametersKeyID(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetSecretParametersKeyID(v)
 	return _u
 }
 
-// SetNillableErrorOutput sets the "error_output" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableErrorOutput(v *string) *ExecutionLogUpdateOne {
+// SetNillableSecretParametersKeyID sets the "secret_parameters_key_id" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableSecretParametersKeyID(v *string) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetErrorOutput(*v)
+		_u.SetSecretParametersKeyID(*v)
 	}
 	return _u
 }
 
-// ClearErrorOutput clears the value of the "error_output" field.
-func (_u *ExecutionLogUpdateOne) ClearErrorOutput() *ExecutionLogUpdateOne {
-	_u.mutation.ClearErrorOutput()
+// ClearSecretParametersKeyID clears the value of the "secret_parameters_key_id" field.
+func (_u *ExecutionLogUpdateOne) ClearSecretParametersKeyID() *ExecutionLogUpdateOne {
+	_u.mutation.ClearSecretParametersKeyID()
 	return _u
 }
 
-// SetRejectionReason sets the "rejection_reason" field.
-func (_u *ExecutionLogUpdateOne) SetRejectionReason(v string) *ExecutionLogUpdateOne {
-	_u.mutation.SetRejectionReason(v)
+// SetSecretNames sets the "secret_names" field.
+func (_u *ExecutionLogUpdateOne) SetSecretNames(v []string) *ExecutionLogUpdateOne {
+	_u.mutation.SetSecretNames(v)
 	return _u
 }
 
-// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableRejectionReason(v *string) *ExecutionLogUpdateOne {
-	if v != nil {
-		_u.SetRejectionReason(*v)
-	}
+// AppendSecretNames appends value to the "secret_names" field.
+func (_u *ExecutionLogUpdateOne) AppendSecretNames(v []string) *ExecutionLogUpdateOne {
+	_u.mutation.AppendSecretNames(v)
 	return _u
 }
 
-// ClearRejectionReason clears the value of the "rejection_reason" field.
-func (_u *ExecutionLogUpdateOne) ClearRejectionReason() *ExecutionLogUpdateOne {
-	_u.mutation.ClearRejectionReason()
+// ClearSecretNames clears the value of the "secret_names" field.
+func (_u *ExecutionLogUpdateOne) ClearSecretNames() *ExecutionLogUpdateOne {
+	_u.mutation.ClearSecretNames()
 	return _u
 }
 
-// SetStartedAt sets the "started_at" field.
-func (_u *ExecutionLogUpdateOne) SetStartedAt(v time.Time) *ExecutionLogUpdateOne {
-	_u.mutation.SetStartedAt(v)
+// SetApprovalExpiresAt sets the "approval_expires_at" field.
+func (_u *ExecutionLogUpdateOne) SetApprovalExpiresAt(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetApprovalExpiresAt(v)
 	return _u
 }
 
-// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableStartedAt(v *time.Time) *ExecutionLogUpdateOne {
+// SetNillableApprovalExpiresAt sets the "approval_expires_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableApprovalExpiresAt(v *time.Time) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetStartedAt(*v)
+		_u.SetApprovalExpiresAt(*v)
 	}
 	return _u
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (_u *ExecutionLogUpdateOne) ClearStartedAt() *ExecutionLogUpdateOne {
-	_u.mutation.ClearStartedAt()
+// ClearApprovalExpiresAt clears the value of the "approval_expires_at" field.
+func (_u *ExecutionLogUpdateOne) ClearApprovalExpiresAt() *ExecutionLogUpdateOne {
+	_u.mutation.ClearApprovalExpiresAt()
 	return _u
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (_u *ExecutionLogUpdateOne) SetCompletedAt(v time.Time) *ExecutionLogUpdateOne {
-	_u.mutation.SetCompletedAt(v)
+// SetApprovedBy sets the "approved_by" field.
+func (_u *ExecutionLogUpdateOne) SetApprovedBy(v uint32) *ExecutionLogUpdateOne {
+	_u.mutation.ResetApprovedBy()
+	_u.mutation.SetApprovedBy(v)
 	return _u
 }
 
-// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableCompletedAt(v *time.Time) *ExecutionLogUpdateOne {
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableApprovedBy(v *uint32) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetCompletedAt(*v)
+		_u.SetApprovedBy(*v)
 	}
 	return _u
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (_u *ExecutionLogUpdateOne) ClearCompletedAt() *ExecutionLogUpdateOne {
-	_u.mutation.ClearCompletedAt()
+// AddApprovedBy adds value to the "approved_by" field.
+func (_u *ExecutionLogUpdateOne) AddApprovedBy(v int32) *ExecutionLogUpdateOne {
+	_u.mutation.AddApprovedBy(v)
 	return _u
 }
 
-// SetDurationMs sets the "duration_ms" field.
-func (_u *ExecutionLogUpdateOne) SetDurationMs(v int64) *ExecutionLogUpdateOne {
-	_u.mutation.ResetDurationMs()
-	_u.mutation.SetDurationMs(v)
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *ExecutionLogUpdateOne) ClearApprovedBy() *ExecutionLogUpdateOne {
+	_u.mutation.ClearApprovedBy()
 	return _u
 }
 
-// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
-func (_u *ExecutionLogUpdateOne) SetNillableDurationMs(v *int64) *ExecutionLogUpdateOne {
+// SetApprovedAt sets the "approved_at" field.
+func (_u *ExecutionLogUpdateOne) SetApprovedAt(v time.Time) *ExecutionLogUpdateOne {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableApprovedAt(v *time.Time) *ExecutionLogUpdateOne {
 	if v != nil {
-		_u.SetDurationMs(*v)
+		_u.SetApprovedAt(*v)
 	}
 	return _u
 }
 
-// AddDurationMs adds value to the "duration_ms" field.
-func (_u *ExecutionLogUpdateOne) AddDurationMs(v int64) *ExecutionLogUpdateOne {
-	_u.mutation.AddDurationMs(v)
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *ExecutionLogUpdateOne) ClearApprovedAt() *ExecutionLogUpdateOne {
+	_u.mutation.ClearApprovedAt()
 	return _u
 }
 
-// ClearDurationMs clears the value of the "duration_ms" field.
-func (_u *ExecutionLogUpdateOne) ClearDurationMs() *ExecutionLogUpdateOne {
-	_u.mutation.ClearDurationMs()
+// SetApprovalComment sets the "approval_comment" field.
+func (_u *ExecutionLogUpdateOne) SetApprovalComment(v string) *ExecutionLogUpdateOne {
+	_u.mutation.SetApprovalComment(v)
+	return _u
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_u *ExecutionLogUpdateOne) SetNillableApprovalComment(v *string) *ExecutionLogUpdateOne {
+	if v != nil {
+		_u.SetApprovalComment(*v)
+	}
+	return _u
+}
+
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (_u *ExecutionLogUpdateOne) ClearApprovalComment() *ExecutionLogUpdateOne {
+	_u.mutation.ClearApprovalComment()
 	return _u
 }
 
@@ -926,6 +2218,81 @@ func (_u *ExecutionLogUpdateOne) check() error {
 			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.rejection_reason": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CancelReason(); ok {
+		if err := executionlog.CancelReasonValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.cancel_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeoutReason(); ok {
+		if err := executionlog.TimeoutReasonValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.timeout_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RunID(); ok {
+		if err := executionlog.RunIDValidator(v); err != nil {
+			return &ValidationError{Name: "run_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.run_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventRuleID(); ok {
+		if err := executionlog.EventRuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_rule_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_rule_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := executionlog.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventDetail(); ok {
+		if err := executionlog.EventDetailValidator(v); err != nil {
+			return &ValidationError{Name: "event_detail", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.event_detail": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceExecutionID(); ok {
+		if err := executionlog.SourceExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_execution_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.source_execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowRunID(); ok {
+		if err := executionlog.WorkflowRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_run_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.workflow_run_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowStepID(); ok {
+		if err := executionlog.WorkflowStepIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_step_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.workflow_step_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempt(); ok {
+		if err := executionlog.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.attempt": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OriginalExecutionID(); ok {
+		if err := executionlog.OriginalExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "original_execution_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.original_execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetriedBy(); ok {
+		if err := executionlog.RetriedByValidator(v); err != nil {
+			return &ValidationError{Name: "retried_by", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.retried_by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaintenanceOverride(); ok {
+		if err := executionlog.MaintenanceOverrideValidator(v); err != nil {
+			return &ValidationError{Name: "maintenance_override", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.maintenance_override": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecretParametersKeyID(); ok {
+		if err := executionlog.SecretParametersKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "secret_parameters_key_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.secret_parameters_key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApprovalComment(); ok {
+		if err := executionlog.ApprovalCommentValidator(v); err != nil {
+			return &ValidationError{Name: "approval_comment", err: fmt.Errorf(`ent: validator failed for field "ExecutionLog.approval_comment": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1057,6 +2424,176 @@ func (_u *ExecutionLogUpdateOne) sqlSave(ctx context.Context) (_node *ExecutionL
 	if _u.mutation.DurationMsCleared() {
 		_spec.ClearField(executionlog.FieldDurationMs, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.CancelRequestedAt(); ok {
+		_spec.SetField(executionlog.FieldCancelRequestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelRequestedAtCleared() {
+		_spec.ClearField(executionlog.FieldCancelRequestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledBy(); ok {
+		_spec.SetField(executionlog.FieldCancelledBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCancelledBy(); ok {
+		_spec.AddField(executionlog.FieldCancelledBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CancelledByCleared() {
+		_spec.ClearField(executionlog.FieldCancelledBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CancelReason(); ok {
+		_spec.SetField(executionlog.FieldCancelReason, field.TypeString, value)
+	}
+	if _u.mutation.CancelReasonCleared() {
+		_spec.ClearField(executionlog.FieldCancelReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.TimeoutReason(); ok {
+		_spec.SetField(executionlog.FieldTimeoutReason, field.TypeString, value)
+	}
+	if _u.mutation.TimeoutReasonCleared() {
+		_spec.ClearField(executionlog.FieldTimeoutReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(executionlog.FieldRunID, field.TypeString, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(executionlog.FieldRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventRuleID(); ok {
+		_spec.SetField(executionlog.FieldEventRuleID, field.TypeString, value)
+	}
+	if _u.mutation.EventRuleIDCleared() {
+		_spec.ClearField(executionlog.FieldEventRuleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(executionlog.FieldEventType, field.TypeEnum, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(executionlog.FieldEventType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.EventDetail(); ok {
+		_spec.SetField(executionlog.FieldEventDetail, field.TypeString, value)
+	}
+	if _u.mutation.EventDetailCleared() {
+		_spec.ClearField(executionlog.FieldEventDetail, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceExecutionID(); ok {
+		_spec.SetField(executionlog.FieldSourceExecutionID, field.TypeString, value)
+	}
+	if _u.mutation.SourceExecutionIDCleared() {
+		_spec.ClearField(executionlog.FieldSourceExecutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkflowRunID(); ok {
+		_spec.SetField(executionlog.FieldWorkflowRunID, field.TypeString, value)
+	}
+	if _u.mutation.WorkflowRunIDCleared() {
+		_spec.ClearField(executionlog.FieldWorkflowRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkflowStepID(); ok {
+		_spec.SetField(executionlog.FieldWorkflowStepID, field.TypeString, value)
+	}
+	if _u.mutation.WorkflowStepIDCleared() {
+		_spec.ClearField(executionlog.FieldWorkflowStepID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(executionlog.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(executionlog.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OriginalExecutionID(); ok {
+		_spec.SetField(executionlog.FieldOriginalExecutionID, field.TypeString, value)
+	}
+	if _u.mutation.OriginalExecutionIDCleared() {
+		_spec.ClearField(executionlog.FieldOriginalExecutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetryAt(); ok {
+		_spec.SetField(executionlog.FieldRetryAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetryAtCleared() {
+		_spec.ClearField(executionlog.FieldRetryAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RetriedBy(); ok {
+		_spec.SetField(executionlog.FieldRetriedBy, field.TypeString, value)
+	}
+	if _u.mutation.RetriedByCleared() {
+		_spec.ClearField(executionlog.FieldRetriedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.WaitingForSlot(); ok {
+		_spec.SetField(executionlog.FieldWaitingForSlot, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HeldUntil(); ok {
+		_spec.SetField(executionlog.FieldHeldUntil, field.TypeTime, value)
+	}
+	if _u.mutation.HeldUntilCleared() {
+		_spec.ClearField(executionlog.FieldHeldUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaintenanceOverride(); ok {
+		_spec.SetField(executionlog.FieldMaintenanceOverride, field.TypeString, value)
+	}
+	if _u.mutation.MaintenanceOverrideCleared() {
+		_spec.ClearField(executionlog.FieldMaintenanceOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(executionlog.FieldParameters, field.TypeJSON, value)
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(executionlog.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SecretParametersCiphertext(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersCiphertext, field.TypeBytes, value)
+	}
+	if _u.mutation.SecretParametersCiphertextCleared() {
+		_spec.ClearField(executionlog.FieldSecretParametersCiphertext, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SecretParametersNonce(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersNonce, field.TypeBytes, value)
+	}
+	if _u.mutation.SecretParametersNonceCleared() {
+		_spec.ClearField(executionlog.FieldSecretParametersNonce, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SecretParametersKeyID(); ok {
+		_spec.SetField(executionlog.FieldSecretParametersKeyID, field.TypeString, value)
+	}
+	if _u.mutation.SecretParametersKeyIDCleared() {
+		_spec.ClearField(executionlog.FieldSecretParametersKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecretNames(); ok {
+		_spec.SetField(executionlog.FieldSecretNames, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSecretNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, executionlog.FieldSecretNames, value)
+		})
+	}
+	if _u.mutation.SecretNamesCleared() {
+		_spec.ClearField(executionlog.FieldSecretNames, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ApprovalExpiresAt(); ok {
+		_spec.SetField(executionlog.FieldApprovalExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovalExpiresAtCleared() {
+		_spec.ClearField(executionlog.FieldApprovalExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(executionlog.FieldApprovedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedApprovedBy(); ok {
+		_spec.AddField(executionlog.FieldApprovedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(executionlog.FieldApprovedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(executionlog.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(executionlog.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ApprovalComment(); ok {
+		_spec.SetField(executionlog.FieldApprovalComment, field.TypeString, value)
+	}
+	if _u.mutation.ApprovalCommentCleared() {
+		_spec.ClearField(executionlog.FieldApprovalComment, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &ExecutionLog{config: _u.config}
 	_spec.Assign = _node.assignValues
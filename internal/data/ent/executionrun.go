@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+)
+
+// ExecutionRun is the model entity for the ExecutionRun schema.
+type ExecutionRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// FK to executor_scripts
+	ScriptID string `json:"script_id,omitempty"`
+	// Denormalized script name for audit readability
+	ScriptName string `json:"script_name,omitempty"`
+	// Label selector the targets were resolved from
+	Selector string `json:"selector,omitempty"`
+	// Client CNs requested explicitly
+	ClientIds []string `json:"client_ids,omitempty"`
+	// Schedule that started the run, nil for manual runs
+	ScheduleID *string `json:"schedule_id,omitempty"`
+	// Assigned client CNs in rollout order
+	Targets []string `json:"targets,omitempty"`
+	// Number of executions created for the run
+	Total int `json:"total,omitempty"`
+	// Number of targets handed out to batches so far
+	Dispatched int `json:"dispatched,omitempty"`
+	// Targets per batch, 0 for everything at once
+	BatchSize int `json:"batch_size,omitempty"`
+	// Size of the first batch that must fully succeed, 0 for no canary
+	CanarySize int `json:"canary_size,omitempty"`
+	// Wait between the end of a batch and the next one
+	BatchPauseSeconds int `json:"batch_pause_seconds,omitempty"`
+	// Failed executions tolerated before the run halts, nil for no limit
+	MaxFailures *int `json:"max_failures,omitempty"`
+	// 1-based number of the last dispatched batch
+	CurrentBatch int `json:"current_batch,omitempty"`
+	// When the next batch is due, set once the current batch finished
+	NextBatchAt *time.Time `json:"next_batch_at,omitempty"`
+	// RUNNING until every batch was dispatched and finished
+	Status executionrun.Status `json:"status,omitempty"`
+	// Why the run was halted or aborted
+	HaltReason string `json:"halt_reason,omitempty"`
+	// When the last execution of the run finished
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExecutionRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case executionrun.FieldClientIds, executionrun.FieldTargets:
+			values[i] = new([]byte)
+		case executionrun.FieldCreateBy, executionrun.FieldTenantID, executionrun.FieldTotal, executionrun.FieldDispatched, executionrun.FieldBatchSize, executionrun.FieldCanarySize, executionrun.FieldBatchPauseSeconds, executionrun.FieldMaxFailures, executionrun.FieldCurrentBatch:
+			values[i] = new(sql.NullInt64)
+		case executionrun.FieldID, executionrun.FieldScriptID, executionrun.FieldScriptName, executionrun.FieldSelector, executionrun.FieldScheduleID, executionrun.FieldStatus, executionrun.FieldHaltReason:
+			values[i] = new(sql.NullString)
+		case executionrun.FieldCreateTime, executionrun.FieldUpdateTime, executionrun.FieldDeleteTime, executionrun.FieldNextBatchAt, executionrun.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExecutionRun fields.
+func (_m *ExecutionRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case executionrun.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case executionrun.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case executionrun.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case executionrun.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case executionrun.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case executionrun.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case executionrun.FieldScriptID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field script_id", values[i])
+			} else if value.Valid {
+				_m.ScriptID = value.String
+			}
+		case executionrun.FieldScriptName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field script_name", values[i])
+			} else if value.Valid {
+				_m.ScriptName = value.String
+			}
+		case executionrun.FieldSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selector", values[i])
+			} else if value.Valid {
+				_m.Selector = value.String
+			}
+		case executionrun.FieldClientIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field client_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClientIds); err != nil {
+					return fmt.Errorf("unmarshal field client_ids: %w", err)
+				}
+			}
+		case executionrun.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				_m.ScheduleID = new(string)
+				*_m.ScheduleID = value.String
+			}
+		case executionrun.FieldTargets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field targets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Targets); err != nil {
+					return fmt.Errorf("unmarshal field targets: %w", err)
+				}
+			}
+		case executionrun.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				_m.Total = int(value.Int64)
+			}
+		case executionrun.FieldDispatched:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dispatched", values[i])
+			} else if value.Valid {
+				_m.Dispatched = int(value.Int64)
+			}
+		case executionrun.FieldBatchSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_size", values[i])
+			} else if value.Valid {
+				_m.BatchSize = int(value.Int64)
+			}
+		case executionrun.FieldCanarySize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field canary_size", values[i])
+			} else if value.Valid {
+				_m.CanarySize = int(value.Int64)
+			}
+		case executionrun.FieldBatchPauseSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_pause_seconds", values[i])
+			} else if value.Valid {
+				_m.BatchPauseSeconds = int(value.Int64)
+			}
+		case executionrun.FieldMaxFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_failures", values[i])
+			} else if value.Valid {
+				_m.MaxFailures = new(int)
+				*_m.MaxFailures = int(value.Int64)
+			}
+		case executionrun.FieldCurrentBatch:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field current_batch", values[i])
+			} else if value.Valid {
+				_m.CurrentBatch = int(value.Int64)
+			}
+		case executionrun.FieldNextBatchAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_batch_at", values[i])
+			} else if value.Valid {
+				_m.NextBatchAt = new(time.Time)
+				*_m.NextBatchAt = value.Time
+			}
+		case executionrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = executionrun.Status(value.String)
+			}
+		case executionrun.FieldHaltReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field halt_reason", values[i])
+			} else if value.Valid {
+				_m.HaltReason = value.String
+			}
+		case executionrun.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExecutionRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExecutionRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExecutionRun.
+// Note that you need to call ExecutionRun.Unwrap() before calling this method if this ExecutionRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExecutionRun) Update() *ExecutionRunUpdateOne {
+	return NewExecutionRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExecutionRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExecutionRun) Unwrap() *ExecutionRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExecutionRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExecutionRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExecutionRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("script_id=")
+	builder.WriteString(_m.ScriptID)
+	builder.WriteString(", ")
+	builder.WriteString("script_name=")
+	builder.WriteString(_m.ScriptName)
+	builder.WriteString(", ")
+	builder.WriteString("selector=")
+	builder.WriteString(_m.Selector)
+	builder.WriteString(", ")
+	builder.WriteString("client_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientIds))
+	builder.WriteString(", ")
+	if v := _m.ScheduleID; v != nil {
+		builder.WriteString("schedule_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("targets=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Targets))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("dispatched=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Dispatched))
+	builder.WriteString(", ")
+	builder.WriteString("batch_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatchSize))
+	builder.WriteString(", ")
+	builder.WriteString("canary_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanarySize))
+	builder.WriteString(", ")
+	builder.WriteString("batch_pause_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatchPauseSeconds))
+	builder.WriteString(", ")
+	if v := _m.MaxFailures; v != nil {
+		builder.WriteString("max_failures=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("current_batch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CurrentBatch))
+	builder.WriteString(", ")
+	if v := _m.NextBatchAt; v != nil {
+		builder.WriteString("next_batch_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("halt_reason=")
+	builder.WriteString(_m.HaltReason)
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExecutionRuns is a parsable slice of ExecutionRun.
+type ExecutionRuns []*ExecutionRun
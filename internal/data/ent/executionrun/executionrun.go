@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package executionrun
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the executionrun type in the database.
+	Label = "execution_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldScriptID holds the string denoting the script_id field in the database.
+	FieldScriptID = "script_id"
+	// FieldScriptName holds the string denoting the script_name field in the database.
+	FieldScriptName = "script_name"
+	// FieldSelector holds the string denoting the selector field in the database.
+	FieldSelector = "selector"
+	// FieldClientIds holds the string denoting the client_ids field in the database.
+	FieldClientIds = "client_ids"
+	// FieldScheduleID holds the string denoting the schedule_id field in the database.
+	FieldScheduleID = "schedule_id"
+	// FieldTargets holds the string denoting the targets field in the database.
+	FieldTargets = "targets"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldDispatched holds the string denoting the dispatched field in the database.
+	FieldDispatched = "dispatched"
+	// FieldBatchSize holds the string denoting the batch_size field in the database.
+	FieldBatchSize = "batch_size"
+	// FieldCanarySize holds the string denoting the canary_size field in the database.
+	FieldCanarySize = "canary_size"
+	// FieldBatchPauseSeconds holds the string denoting the batch_pause_seconds field in the database.
+	FieldBatchPauseSeconds = "batch_pause_seconds"
+	// FieldMaxFailures holds the string denoting the max_failures field in the database.
+	FieldMaxFailures = "max_failures"
+	// FieldCurrentBatch holds the string denoting the current_batch field in the database.
+	FieldCurrentBatch = "current_batch"
+	// FieldNextBatchAt holds the string denoting the next_batch_at field in the database.
+	FieldNextBatchAt = "next_batch_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldHaltReason holds the string denoting the halt_reason field in the database.
+	FieldHaltReason = "halt_reason"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// Table holds the table name of the executionrun in the database.
+	Table = "executor_execution_runs"
+)
+
+// Columns holds all SQL columns for executionrun fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldScriptID,
+	FieldScriptName,
+	FieldSelector,
+	FieldClientIds,
+	FieldScheduleID,
+	FieldTargets,
+	FieldTotal,
+	FieldDispatched,
+	FieldBatchSize,
+	FieldCanarySize,
+	FieldBatchPauseSeconds,
+	FieldMaxFailures,
+	FieldCurrentBatch,
+	FieldNextBatchAt,
+	FieldStatus,
+	FieldHaltReason,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-executor/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	ScriptIDValidator func(string) error
+	// ScriptNameValidator is a validator for the "script_name" field. It is called by the builders before save.
+	ScriptNameValidator func(string) error
+	// SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	SelectorValidator func(string) error
+	// ScheduleIDValidator is a validator for the "schedule_id" field. It is called by the builders before save.
+	ScheduleIDValidator func(string) error
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal int
+	// TotalValidator is a validator for the "total" field. It is called by the builders before save.
+	TotalValidator func(int) error
+	// DefaultDispatched holds the default value on creation for the "dispatched" field.
+	DefaultDispatched int
+	// DispatchedValidator is a validator for the "dispatched" field. It is called by the builders before save.
+	DispatchedValidator func(int) error
+	// DefaultBatchSize holds the default value on creation for the "batch_size" field.
+	DefaultBatchSize int
+	// BatchSizeValidator is a validator for the "batch_size" field. It is called by the builders before save.
+	BatchSizeValidator func(int) error
+	// DefaultCanarySize holds the default value on creation for the "canary_size" field.
+	DefaultCanarySize int
+	// CanarySizeValidator is a validator for the "canary_size" field. It is called by the builders before save.
+	CanarySizeValidator func(int) error
+	// DefaultBatchPauseSeconds holds the default value on creation for the "batch_pause_seconds" field.
+	DefaultBatchPauseSeconds int
+	// BatchPauseSecondsValidator is a validator for the "batch_pause_seconds" field. It is called by the builders before save.
+	BatchPauseSecondsValidator func(int) error
+	// MaxFailuresValidator is a validator for the "max_failures" field. It is called by the builders before save.
+	MaxFailuresValidator func(int) error
+	// DefaultCurrentBatch holds the default value on creation for the "current_batch" field.
+	DefaultCurrentBatch int
+	// CurrentBatchValidator is a validator for the "current_batch" field. It is called by the builders before save.
+	CurrentBatchValidator func(int) error
+	// HaltReasonValidator is a validator for the "halt_reason" field. It is called by the builders before save.
+	HaltReasonValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusRUNNING is the default value of the Status enum.
+const DefaultStatus = StatusRUNNING
+
+// Status values.
+const (
+	StatusRUNNING   Status = "RUNNING"
+	StatusPAUSED    Status = "PAUSED"
+	StatusHALTED    Status = "HALTED"
+	StatusABORTED   Status = "ABORTED"
+	StatusCOMPLETED Status = "COMPLETED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRUNNING, StatusPAUSED, StatusHALTED, StatusABORTED, StatusCOMPLETED:
+		return nil
+	default:
+		return fmt.Errorf("executionrun: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ExecutionRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByScriptID orders the results by the script_id field.
+func ByScriptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScriptID, opts...).ToFunc()
+}
+
+// ByScriptName orders the results by the script_name field.
+func ByScriptName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScriptName, opts...).ToFunc()
+}
+
+// BySelector orders the results by the selector field.
+func BySelector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelector, opts...).ToFunc()
+}
+
+// ByScheduleID orders the results by the schedule_id field.
+func ByScheduleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduleID, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByDispatched orders the results by the dispatched field.
+func ByDispatched(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDispatched, opts...).ToFunc()
+}
+
+// ByBatchSize orders the results by the batch_size field.
+func ByBatchSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchSize, opts...).ToFunc()
+}
+
+// ByCanarySize orders the results by the canary_size field.
+func ByCanarySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanarySize, opts...).ToFunc()
+}
+
+// ByBatchPauseSeconds orders the results by the batch_pause_seconds field.
+func ByBatchPauseSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchPauseSeconds, opts...).ToFunc()
+}
+
+// ByMaxFailures orders the results by the max_failures field.
+func ByMaxFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxFailures, opts...).ToFunc()
+}
+
+// ByCurrentBatch orders the results by the current_batch field.
+func ByCurrentBatch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentBatch, opts...).ToFunc()
+}
+
+// ByNextBatchAt orders the results by the next_batch_at field.
+func ByNextBatchAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextBatchAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByHaltReason orders the results by the halt_reason field.
+func ByHaltReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHaltReason, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package executionrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ScriptID applies equality check predicate on the "script_id" field. It's identical to ScriptIDEQ.
+func ScriptID(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldScriptID, v))
+}
+
+// ScriptName applies equality check predicate on the "script_name" field. It's identical to ScriptNameEQ.
+func ScriptName(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldScriptName, v))
+}
+
+// Selector applies equality check predicate on the "selector" field. It's identical to SelectorEQ.
+func Selector(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldSelector, v))
+}
+
+// ScheduleID applies equality check predicate on the "schedule_id" field. It's identical to ScheduleIDEQ.
+func ScheduleID(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldTotal, v))
+}
+
+// Dispatched applies equality check predicate on the "dispatched" field. It's identical to DispatchedEQ.
+func Dispatched(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldDispatched, v))
+}
+
+// BatchSize applies equality check predicate on the "batch_size" field. It's identical to BatchSizeEQ.
+func BatchSize(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldBatchSize, v))
+}
+
+// CanarySize applies equality check predicate on the "canary_size" field. It's identical to CanarySizeEQ.
+func CanarySize(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCanarySize, v))
+}
+
+// BatchPauseSeconds applies equality check predicate on the "batch_pause_seconds" field. It's identical to BatchPauseSecondsEQ.
+func BatchPauseSeconds(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldBatchPauseSeconds, v))
+}
+
+// MaxFailures applies equality check predicate on the "max_failures" field. It's identical to MaxFailuresEQ.
+func MaxFailures(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldMaxFailures, v))
+}
+
+// CurrentBatch applies equality check predicate on the "current_batch" field. It's identical to CurrentBatchEQ.
+func CurrentBatch(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCurrentBatch, v))
+}
+
+// NextBatchAt applies equality check predicate on the "next_batch_at" field. It's identical to NextBatchAtEQ.
+func NextBatchAt(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldNextBatchAt, v))
+}
+
+// HaltReason applies equality check predicate on the "halt_reason" field. It's identical to HaltReasonEQ.
+func HaltReason(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldHaltReason, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldCreateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldTenantID))
+}
+
+// ScriptIDEQ applies the EQ predicate on the "script_id" field.
+func ScriptIDEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldScriptID, v))
+}
+
+// ScriptIDNEQ applies the NEQ predicate on the "script_id" field.
+func ScriptIDNEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldScriptID, v))
+}
+
+// ScriptIDIn applies the In predicate on the "script_id" field.
+func ScriptIDIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldScriptID, vs...))
+}
+
+// ScriptIDNotIn applies the NotIn predicate on the "script_id" field.
+func ScriptIDNotIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldScriptID, vs...))
+}
+
+// ScriptIDGT applies the GT predicate on the "script_id" field.
+func ScriptIDGT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldScriptID, v))
+}
+
+// ScriptIDGTE applies the GTE predicate on the "script_id" field.
+func ScriptIDGTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldScriptID, v))
+}
+
+// ScriptIDLT applies the LT predicate on the "script_id" field.
+func ScriptIDLT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldScriptID, v))
+}
+
+// ScriptIDLTE applies the LTE predicate on the "script_id" field.
+func ScriptIDLTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldScriptID, v))
+}
+
+// ScriptIDContains applies the Contains predicate on the "script_id" field.
+func ScriptIDContains(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContains(FieldScriptID, v))
+}
+
+// ScriptIDHasPrefix applies the HasPrefix predicate on the "script_id" field.
+func ScriptIDHasPrefix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasPrefix(FieldScriptID, v))
+}
+
+// ScriptIDHasSuffix applies the HasSuffix predicate on the "script_id" field.
+func ScriptIDHasSuffix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasSuffix(FieldScriptID, v))
+}
+
+// ScriptIDEqualFold applies the EqualFold predicate on the "script_id" field.
+func ScriptIDEqualFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEqualFold(FieldScriptID, v))
+}
+
+// ScriptIDContainsFold applies the ContainsFold predicate on the "script_id" field.
+func ScriptIDContainsFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContainsFold(FieldScriptID, v))
+}
+
+// ScriptNameEQ applies the EQ predicate on the "script_name" field.
+func ScriptNameEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldScriptName, v))
+}
+
+// ScriptNameNEQ applies the NEQ predicate on the "script_name" field.
+func ScriptNameNEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldScriptName, v))
+}
+
+// ScriptNameIn applies the In predicate on the "script_name" field.
+func ScriptNameIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldScriptName, vs...))
+}
+
+// ScriptNameNotIn applies the NotIn predicate on the "script_name" field.
+func ScriptNameNotIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldScriptName, vs...))
+}
+
+// ScriptNameGT applies the GT predicate on the "script_name" field.
+func ScriptNameGT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldScriptName, v))
+}
+
+// ScriptNameGTE applies the GTE predicate on the "script_name" field.
+func ScriptNameGTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldScriptName, v))
+}
+
+// ScriptNameLT applies the LT predicate on the "script_name" field.
+func ScriptNameLT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldScriptName, v))
+}
+
+// ScriptNameLTE applies the LTE predicate on the "script_name" field.
+func ScriptNameLTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldScriptName, v))
+}
+
+// ScriptNameContains applies the Contains predicate on the "script_name" field.
+func ScriptNameContains(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContains(FieldScriptName, v))
+}
+
+// ScriptNameHasPrefix applies the HasPrefix predicate on the "script_name" field.
+func ScriptNameHasPrefix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasPrefix(FieldScriptName, v))
+}
+
+// ScriptNameHasSuffix applies the HasSuffix predicate on the "script_name" field.
+func ScriptNameHasSuffix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasSuffix(FieldScriptName, v))
+}
+
+// ScriptNameEqualFold applies the EqualFold predicate on the "script_name" field.
+func ScriptNameEqualFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEqualFold(FieldScriptName, v))
+}
+
+// ScriptNameContainsFold applies the ContainsFold predicate on the "script_name" field.
+func ScriptNameContainsFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContainsFold(FieldScriptName, v))
+}
+
+// SelectorEQ applies the EQ predicate on the "selector" field.
+func SelectorEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldSelector, v))
+}
+
+// SelectorNEQ applies the NEQ predicate on the "selector" field.
+func SelectorNEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldSelector, v))
+}
+
+// SelectorIn applies the In predicate on the "selector" field.
+func SelectorIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldSelector, vs...))
+}
+
+// SelectorNotIn applies the NotIn predicate on the "selector" field.
+func SelectorNotIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldSelector, vs...))
+}
+
+// SelectorGT applies the GT predicate on the "selector" field.
+func SelectorGT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldSelector, v))
+}
+
+// SelectorGTE applies the GTE predicate on the "selector" field.
+func SelectorGTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldSelector, v))
+}
+
+// SelectorLT applies the LT predicate on the "selector" field.
+func SelectorLT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldSelector, v))
+}
+
+// SelectorLTE applies the LTE predicate on the "selector" field.
+func SelectorLTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldSelector, v))
+}
+
+// SelectorContains applies the Contains predicate on the "selector" field.
+func SelectorContains(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContains(FieldSelector, v))
+}
+
+// SelectorHasPrefix applies the HasPrefix predicate on the "selector" field.
+func SelectorHasPrefix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasPrefix(FieldSelector, v))
+}
+
+// SelectorHasSuffix applies the HasSuffix predicate on the "selector" field.
+func SelectorHasSuffix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasSuffix(FieldSelector, v))
+}
+
+// SelectorIsNil applies the IsNil predicate on the "selector" field.
+func SelectorIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldSelector))
+}
+
+// SelectorNotNil applies the NotNil predicate on the "selector" field.
+func SelectorNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldSelector))
+}
+
+// SelectorEqualFold applies the EqualFold predicate on the "selector" field.
+func SelectorEqualFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEqualFold(FieldSelector, v))
+}
+
+// SelectorContainsFold applies the ContainsFold predicate on the "selector" field.
+func SelectorContainsFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContainsFold(FieldSelector, v))
+}
+
+// ClientIdsIsNil applies the IsNil predicate on the "client_ids" field.
+func ClientIdsIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldClientIds))
+}
+
+// ClientIdsNotNil applies the NotNil predicate on the "client_ids" field.
+func ClientIdsNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldClientIds))
+}
+
+// ScheduleIDEQ applies the EQ predicate on the "schedule_id" field.
+func ScheduleIDEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDNEQ applies the NEQ predicate on the "schedule_id" field.
+func ScheduleIDNEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDIn applies the In predicate on the "schedule_id" field.
+func ScheduleIDIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDNotIn applies the NotIn predicate on the "schedule_id" field.
+func ScheduleIDNotIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDGT applies the GT predicate on the "schedule_id" field.
+func ScheduleIDGT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldScheduleID, v))
+}
+
+// ScheduleIDGTE applies the GTE predicate on the "schedule_id" field.
+func ScheduleIDGTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldScheduleID, v))
+}
+
+// ScheduleIDLT applies the LT predicate on the "schedule_id" field.
+func ScheduleIDLT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldScheduleID, v))
+}
+
+// ScheduleIDLTE applies the LTE predicate on the "schedule_id" field.
+func ScheduleIDLTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldScheduleID, v))
+}
+
+// ScheduleIDContains applies the Contains predicate on the "schedule_id" field.
+func ScheduleIDContains(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContains(FieldScheduleID, v))
+}
+
+// ScheduleIDHasPrefix applies the HasPrefix predicate on the "schedule_id" field.
+func ScheduleIDHasPrefix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasPrefix(FieldScheduleID, v))
+}
+
+// ScheduleIDHasSuffix applies the HasSuffix predicate on the "schedule_id" field.
+func ScheduleIDHasSuffix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasSuffix(FieldScheduleID, v))
+}
+
+// ScheduleIDIsNil applies the IsNil predicate on the "schedule_id" field.
+func ScheduleIDIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldScheduleID))
+}
+
+// ScheduleIDNotNil applies the NotNil predicate on the "schedule_id" field.
+func ScheduleIDNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldScheduleID))
+}
+
+// ScheduleIDEqualFold applies the EqualFold predicate on the "schedule_id" field.
+func ScheduleIDEqualFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEqualFold(FieldScheduleID, v))
+}
+
+// ScheduleIDContainsFold applies the ContainsFold predicate on the "schedule_id" field.
+func ScheduleIDContainsFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContainsFold(FieldScheduleID, v))
+}
+
+// TargetsIsNil applies the IsNil predicate on the "targets" field.
+func TargetsIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldTargets))
+}
+
+// TargetsNotNil applies the NotNil predicate on the "targets" field.
+func TargetsNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldTargets))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldTotal, v))
+}
+
+// DispatchedEQ applies the EQ predicate on the "dispatched" field.
+func DispatchedEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldDispatched, v))
+}
+
+// DispatchedNEQ applies the NEQ predicate on the "dispatched" field.
+func DispatchedNEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldDispatched, v))
+}
+
+// DispatchedIn applies the In predicate on the "dispatched" field.
+func DispatchedIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldDispatched, vs...))
+}
+
+// DispatchedNotIn applies the NotIn predicate on the "dispatched" field.
+func DispatchedNotIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldDispatched, vs...))
+}
+
+// DispatchedGT applies the GT predicate on the "dispatched" field.
+func DispatchedGT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldDispatched, v))
+}
+
+// DispatchedGTE applies the GTE predicate on the "dispatched" field.
+func DispatchedGTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldDispatched, v))
+}
+
+// DispatchedLT applies the LT predicate on the "dispatched" field.
+func DispatchedLT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldDispatched, v))
+}
+
+// DispatchedLTE applies the LTE predicate on the "dispatched" field.
+func DispatchedLTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldDispatched, v))
+}
+
+// BatchSizeEQ applies the EQ predicate on the "batch_size" field.
+func BatchSizeEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldBatchSize, v))
+}
+
+// BatchSizeNEQ applies the NEQ predicate on the "batch_size" field.
+func BatchSizeNEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldBatchSize, v))
+}
+
+// BatchSizeIn applies the In predicate on the "batch_size" field.
+func BatchSizeIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldBatchSize, vs...))
+}
+
+// BatchSizeNotIn applies the NotIn predicate on the "batch_size" field.
+func BatchSizeNotIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldBatchSize, vs...))
+}
+
+// BatchSizeGT applies the GT predicate on the "batch_size" field.
+func BatchSizeGT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldBatchSize, v))
+}
+
+// BatchSizeGTE applies the GTE predicate on the "batch_size" field.
+func BatchSizeGTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldBatchSize, v))
+}
+
+// BatchSizeLT applies the LT predicate on the "batch_size" field.
+func BatchSizeLT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldBatchSize, v))
+}
+
+// BatchSizeLTE applies the LTE predicate on the "batch_size" field.
+func BatchSizeLTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldBatchSize, v))
+}
+
+// CanarySizeEQ applies the EQ predicate on the "canary_size" field.
+func CanarySizeEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCanarySize, v))
+}
+
+// CanarySizeNEQ applies the NEQ predicate on the "canary_size" field.
+func CanarySizeNEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldCanarySize, v))
+}
+
+// CanarySizeIn applies the In predicate on the "canary_size" field.
+func CanarySizeIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldCanarySize, vs...))
+}
+
+// CanarySizeNotIn applies the NotIn predicate on the "canary_size" field.
+func CanarySizeNotIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldCanarySize, vs...))
+}
+
+// CanarySizeGT applies the GT predicate on the "canary_size" field.
+func CanarySizeGT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldCanarySize, v))
+}
+
+// CanarySizeGTE applies the GTE predicate on the "canary_size" field.
+func CanarySizeGTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldCanarySize, v))
+}
+
+// CanarySizeLT applies the LT predicate on the "canary_size" field.
+func CanarySizeLT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldCanarySize, v))
+}
+
+// CanarySizeLTE applies the LTE predicate on the "canary_size" field.
+func CanarySizeLTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldCanarySize, v))
+}
+
+// BatchPauseSecondsEQ applies the EQ predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsNEQ applies the NEQ predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsNEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsIn applies the In predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldBatchPauseSeconds, vs...))
+}
+
+// BatchPauseSecondsNotIn applies the NotIn predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsNotIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldBatchPauseSeconds, vs...))
+}
+
+// BatchPauseSecondsGT applies the GT predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsGT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsGTE applies the GTE predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsGTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsLT applies the LT predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsLT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsLTE applies the LTE predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsLTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldBatchPauseSeconds, v))
+}
+
+// MaxFailuresEQ applies the EQ predicate on the "max_failures" field.
+func MaxFailuresEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldMaxFailures, v))
+}
+
+// MaxFailuresNEQ applies the NEQ predicate on the "max_failures" field.
+func MaxFailuresNEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldMaxFailures, v))
+}
+
+// MaxFailuresIn applies the In predicate on the "max_failures" field.
+func MaxFailuresIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldMaxFailures, vs...))
+}
+
+// MaxFailuresNotIn applies the NotIn predicate on the "max_failures" field.
+func MaxFailuresNotIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldMaxFailures, vs...))
+}
+
+// MaxFailuresGT applies the GT predicate on the "max_failures" field.
+func MaxFailuresGT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldMaxFailures, v))
+}
+
+// MaxFailuresGTE applies the GTE predicate on the "max_failures" field.
+func MaxFailuresGTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldMaxFailures, v))
+}
+
+// MaxFailuresLT applies the LT predicate on the "max_failures" field.
+func MaxFailuresLT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldMaxFailures, v))
+}
+
+// MaxFailuresLTE applies the LTE predicate on the "max_failures" field.
+func MaxFailuresLTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldMaxFailures, v))
+}
+
+// MaxFailuresIsNil applies the IsNil predicate on the "max_failures" field.
+func MaxFailuresIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldMaxFailures))
+}
+
+// MaxFailuresNotNil applies the NotNil predicate on the "max_failures" field.
+func MaxFailuresNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldMaxFailures))
+}
+
+// CurrentBatchEQ applies the EQ predicate on the "current_batch" field.
+func CurrentBatchEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCurrentBatch, v))
+}
+
+// CurrentBatchNEQ applies the NEQ predicate on the "current_batch" field.
+func CurrentBatchNEQ(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldCurrentBatch, v))
+}
+
+// CurrentBatchIn applies the In predicate on the "current_batch" field.
+func CurrentBatchIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldCurrentBatch, vs...))
+}
+
+// CurrentBatchNotIn applies the NotIn predicate on the "current_batch" field.
+func CurrentBatchNotIn(vs ...int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldCurrentBatch, vs...))
+}
+
+// CurrentBatchGT applies the GT predicate on the "current_batch" field.
+func CurrentBatchGT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldCurrentBatch, v))
+}
+
+// CurrentBatchGTE applies the GTE predicate on the "current_batch" field.
+func CurrentBatchGTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldCurrentBatch, v))
+}
+
+// CurrentBatchLT applies the LT predicate on the "current_batch" field.
+func CurrentBatchLT(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldCurrentBatch, v))
+}
+
+// CurrentBatchLTE applies the LTE predicate on the "current_batch" field.
+func CurrentBatchLTE(v int) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldCurrentBatch, v))
+}
+
+// NextBatchAtEQ applies the EQ predicate on the "next_batch_at" field.
+func NextBatchAtEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldNextBatchAt, v))
+}
+
+// NextBatchAtNEQ applies the NEQ predicate on the "next_batch_at" field.
+func NextBatchAtNEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldNextBatchAt, v))
+}
+
+// NextBatchAtIn applies the In predicate on the "next_batch_at" field.
+func NextBatchAtIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldNextBatchAt, vs...))
+}
+
+// NextBatchAtNotIn applies the NotIn predicate on the "next_batch_at" field.
+func NextBatchAtNotIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldNextBatchAt, vs...))
+}
+
+// NextBatchAtGT applies the GT predicate on the "next_batch_at" field.
+func NextBatchAtGT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldNextBatchAt, v))
+}
+
+// NextBatchAtGTE applies the GTE predicate on the "next_batch_at" field.
+func NextBatchAtGTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldNextBatchAt, v))
+}
+
+// NextBatchAtLT applies the LT predicate on the "next_batch_at" field.
+func NextBatchAtLT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldNextBatchAt, v))
+}
+
+// NextBatchAtLTE applies the LTE predicate on the "next_batch_at" field.
+func NextBatchAtLTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldNextBatchAt, v))
+}
+
+// NextBatchAtIsNil applies the IsNil predicate on the "next_batch_at" field.
+func NextBatchAtIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldNextBatchAt))
+}
+
+// NextBatchAtNotNil applies the NotNil predicate on the "next_batch_at" field.
+func NextBatchAtNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldNextBatchAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HaltReasonEQ applies the EQ predicate on the "halt_reason" field.
+func HaltReasonEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldHaltReason, v))
+}
+
+// HaltReasonNEQ applies the NEQ predicate on the "halt_reason" field.
+func HaltReasonNEQ(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldHaltReason, v))
+}
+
+// HaltReasonIn applies the In predicate on the "halt_reason" field.
+func HaltReasonIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldHaltReason, vs...))
+}
+
+// HaltReasonNotIn applies the NotIn predicate on the "halt_reason" field.
+func HaltReasonNotIn(vs ...string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldHaltReason, vs...))
+}
+
+// HaltReasonGT applies the GT predicate on the "halt_reason" field.
+func HaltReasonGT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldHaltReason, v))
+}
+
+// HaltReasonGTE applies the GTE predicate on the "halt_reason" field.
+func HaltReasonGTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldHaltReason, v))
+}
+
+// HaltReasonLT applies the LT predicate on the "halt_reason" field.
+func HaltReasonLT(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldHaltReason, v))
+}
+
+// HaltReasonLTE applies the LTE predicate on the "halt_reason" field.
+func HaltReasonLTE(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldHaltReason, v))
+}
+
+// HaltReasonContains applies the Contains predicate on the "halt_reason" field.
+func HaltReasonContains(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContains(FieldHaltReason, v))
+}
+
+// HaltReasonHasPrefix applies the HasPrefix predicate on the "halt_reason" field.
+func HaltReasonHasPrefix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasPrefix(FieldHaltReason, v))
+}
+
+// HaltReasonHasSuffix applies the HasSuffix predicate on the "halt_reason" field.
+func HaltReasonHasSuffix(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldHasSuffix(FieldHaltReason, v))
+}
+
+// HaltReasonIsNil applies the IsNil predicate on the "halt_reason" field.
+func HaltReasonIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldHaltReason))
+}
+
+// HaltReasonNotNil applies the NotNil predicate on the "halt_reason" field.
+func HaltReasonNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldHaltReason))
+}
+
+// HaltReasonEqualFold applies the EqualFold predicate on the "halt_reason" field.
+func HaltReasonEqualFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEqualFold(FieldHaltReason, v))
+}
+
+// HaltReasonContainsFold applies the ContainsFold predicate on the "halt_reason" field.
+func HaltReasonContainsFold(v string) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldContainsFold(FieldHaltReason, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExecutionRun) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExecutionRun) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExecutionRun) predicate.ExecutionRun {
+	return predicate.ExecutionRun(sql.NotPredicates(p))
+}
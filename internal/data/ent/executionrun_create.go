@@ -0,0 +1,2131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+)
+
+// ExecutionRunCreate is the builder for creating a ExecutionRun entity.
+type ExecutionRunCreate struct {
+	config
+	mutation *ExecutionRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ExecutionRunCreate) SetCreateBy(v uint32) *ExecutionRunCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableCreateBy(v *uint32) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ExecutionRunCreate) SetCreateTime(v time.Time) *ExecutionRunCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableCreateTime(v *time.Time) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ExecutionRunCreate) SetUpdateTime(v time.Time) *ExecutionRunCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableUpdateTime(v *time.Time) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ExecutionRunCreate) SetDeleteTime(v time.Time) *ExecutionRunCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableDeleteTime(v *time.Time) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ExecutionRunCreate) SetTenantID(v uint32) *ExecutionRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableTenantID(v *uint32) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetScriptID sets the "script_id" field.
+func (_c *ExecutionRunCreate) SetScriptID(v string) *ExecutionRunCreate {
+	_c.mutation.SetScriptID(v)
+	return _c
+}
+
+// SetScriptName sets the "script_name" field.
+func (_c *ExecutionRunCreate) SetScriptName(v string) *ExecutionRunCreate {
+	_c.mutation.SetScriptName(v)
+	return _c
+}
+
+// SetSelector sets the "selector" field.
+func (_c *ExecutionRunCreate) SetSelector(v string) *ExecutionRunCreate {
+	_c.mutation.SetSelector(v)
+	return _c
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableSelector(v *string) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetSelector(*v)
+	}
+	return _c
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_c *ExecutionRunCreate) SetClientIds(v []string) *ExecutionRunCreate {
+	_c.mutation.SetClientIds(v)
+	return _c
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_c *ExecutionRunCreate) SetScheduleID(v string) *ExecutionRunCreate {
+	_c.mutation.SetScheduleID(v)
+	return _c
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableScheduleID(v *string) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetScheduleID(*v)
+	}
+	return _c
+}
+
+// SetTargets sets the "targets" field.
+func (_c *ExecutionRunCreate) SetTargets(v []string) *ExecutionRunCreate {
+	_c.mutation.SetTargets(v)
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *ExecutionRunCreate) SetTotal(v int) *ExecutionRunCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableTotal(v *int) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetDispatched sets the "dispatched" field.
+func (_c *ExecutionRunCreate) SetDispatched(v int) *ExecutionRunCreate {
+	_c.mutation.SetDispatched(v)
+	return _c
+}
+
+// SetNillableDispatched sets the "dispatched" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableDispatched(v *int) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetDispatched(*v)
+	}
+	return _c
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_c *ExecutionRunCreate) SetBatchSize(v int) *ExecutionRunCreate {
+	_c.mutation.SetBatchSize(v)
+	return _c
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableBatchSize(v *int) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetBatchSize(*v)
+	}
+	return _c
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (_c *ExecutionRunCreate) SetCanarySize(v int) *ExecutionRunCreate {
+	_c.mutation.SetCanarySize(v)
+	return _c
+}
+
+// SetNillableCanarySize sets the "canary_size" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableCanarySize(v *int) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetCanarySize(*v)
+	}
+	return _c
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (_c *ExecutionRunCreate) SetBatchPauseSeconds(v int) *ExecutionRunCreate {
+	_c.mutation.SetBatchPauseSeconds(v)
+	return _c
+}
+
+// SetNillableBatchPauseSeconds sets the "batch_pause_seconds" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableBatchPauseSeconds(v *int) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetBatchPauseSeconds(*v)
+	}
+	return _c
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (_c *ExecutionRunCreate) SetMaxFailures(v int) *ExecutionRunCreate {
+	_c.mutation.SetMaxFailures(v)
+	return _c
+}
+
+// SetNillableMaxFailures sets the "max_failures" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableMaxFailures(v *int) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetMaxFailures(*v)
+	}
+	return _c
+}
+
+// SetCurrentBatch sets the "current_batch" field.
+func (_c *ExecutionRunCreate) SetCurrentBatch(v int) *ExecutionRunCreate {
+	_c.mutation.SetCurrentBatch(v)
+	return _c
+}
+
+// SetNillableCurrentBatch sets the "current_batch" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableCurrentBatch(v *int) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetCurrentBatch(*v)
+	}
+	return _c
+}
+
+// SetNextBatchAt sets the "next_batch_at" field.
+func (_c *ExecutionRunCreate) SetNextBatchAt(v time.Time) *ExecutionRunCreate {
+	_c.mutation.SetNextBatchAt(v)
+	return _c
+}
+
+// SetNillableNextBatchAt sets the "next_batch_at" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableNextBatchAt(v *time.Time) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetNextBatchAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ExecutionRunCreate) SetStatus(v executionrun.Status) *ExecutionRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableStatus(v *executionrun.Status) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetHaltReason sets the "halt_reason" field.
+func (_c *ExecutionRunCreate) SetHaltReason(v string) *ExecutionRunCreate {
+	_c.mutation.SetHaltReason(v)
+	return _c
+}
+
+// SetNillableHaltReason sets the "halt_reason" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableHaltReason(v *string) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetHaltReason(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ExecutionRunCreate) SetCompletedAt(v time.Time) *ExecutionRunCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ExecutionRunCreate) SetNillableCompletedAt(v *time.Time) *ExecutionRunCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExecutionRunCreate) SetID(v string) *ExecutionRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ExecutionRunMutation object of the builder.
+func (_c *ExecutionRunCreate) Mutation() *ExecutionRunMutation {
+	return _c.mutation
+}
+
+// Save creates the ExecutionRun in the database.
+func (_c *ExecutionRunCreate) Save(ctx context.Context) (*ExecutionRun, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExecutionRunCreate) SaveX(ctx context.Context) *ExecutionRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExecutionRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExecutionRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExecutionRunCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := executionrun.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		v := executionrun.DefaultTotal
+		_c.mutation.SetTotal(v)
+	}
+	if _, ok := _c.mutation.Dispatched(); !ok {
+		v := executionrun.DefaultDispatched
+		_c.mutation.SetDispatched(v)
+	}
+	if _, ok := _c.mutation.BatchSize(); !ok {
+		v := executionrun.DefaultBatchSize
+		_c.mutation.SetBatchSize(v)
+	}
+	if _, ok := _c.mutation.CanarySize(); !ok {
+		v := executionrun.DefaultCanarySize
+		_c.mutation.SetCanarySize(v)
+	}
+	if _, ok := _c.mutation.BatchPauseSeconds(); !ok {
+		v := executionrun.DefaultBatchPauseSeconds
+		_c.mutation.SetBatchPauseSeconds(v)
+	}
+	if _, ok := _c.mutation.CurrentBatch(); !ok {
+		v := executionrun.DefaultCurrentBatch
+		_c.mutation.SetCurrentBatch(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := executionrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExecutionRunCreate) check() error {
+	if _, ok := _c.mutation.ScriptID(); !ok {
+		return &ValidationError{Name: "script_id", err: errors.New(`ent: missing required field "ExecutionRun.script_id"`)}
+	}
+	if v, ok := _c.mutation.ScriptID(); ok {
+		if err := executionrun.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.script_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScriptName(); !ok {
+		return &ValidationError{Name: "script_name", err: errors.New(`ent: missing required field "ExecutionRun.script_name"`)}
+	}
+	if v, ok := _c.mutation.ScriptName(); ok {
+		if err := executionrun.ScriptNameValidator(v); err != nil {
+			return &ValidationError{Name: "script_name", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.script_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Selector(); ok {
+		if err := executionrun.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.selector": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ScheduleID(); ok {
+		if err := executionrun.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.schedule_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "ExecutionRun.total"`)}
+	}
+	if v, ok := _c.mutation.Total(); ok {
+		if err := executionrun.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.total": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Dispatched(); !ok {
+		return &ValidationError{Name: "dispatched", err: errors.New(`ent: missing required field "ExecutionRun.dispatched"`)}
+	}
+	if v, ok := _c.mutation.Dispatched(); ok {
+		if err := executionrun.DispatchedValidator(v); err != nil {
+			return &ValidationError{Name: "dispatched", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.dispatched": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BatchSize(); !ok {
+		return &ValidationError{Name: "batch_size", err: errors.New(`ent: missing required field "ExecutionRun.batch_size"`)}
+	}
+	if v, ok := _c.mutation.BatchSize(); ok {
+		if err := executionrun.BatchSizeValidator(v); err != nil {
+			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.batch_size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CanarySize(); !ok {
+		return &ValidationError{Name: "canary_size", err: errors.New(`ent: missing required field "ExecutionRun.canary_size"`)}
+	}
+	if v, ok := _c.mutation.CanarySize(); ok {
+		if err := executionrun.CanarySizeValidator(v); err != nil {
+			return &ValidationError{Name: "canary_size", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.canary_size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BatchPauseSeconds(); !ok {
+		return &ValidationError{Name: "batch_pause_seconds", err: errors.New(`ent: missing required field "ExecutionRun.batch_pause_seconds"`)}
+	}
+	if v, ok := _c.mutation.BatchPauseSeconds(); ok {
+		if err := executionrun.BatchPauseSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_pause_seconds", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.batch_pause_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxFailures(); ok {
+		if err := executionrun.MaxFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "max_failures", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.max_failures": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CurrentBatch(); !ok {
+		return &ValidationError{Name: "current_batch", err: errors.New(`ent: missing required field "ExecutionRun.current_batch"`)}
+	}
+	if v, ok := _c.mutation.CurrentBatch(); ok {
+		if err := executionrun.CurrentBatchValidator(v); err != nil {
+			return &ValidationError{Name: "current_batch", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.current_batch": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExecutionRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := executionrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.HaltReason(); ok {
+		if err := executionrun.HaltReasonValidator(v); err != nil {
+			return &ValidationError{Name: "halt_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.halt_reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := executionrun.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ExecutionRunCreate) sqlSave(ctx context.Context) (*ExecutionRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ExecutionRun.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExecutionRunCreate) createSpec() (*ExecutionRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExecutionRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(executionrun.Table, sqlgraph.NewFieldSpec(executionrun.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(executionrun.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(executionrun.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(executionrun.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(executionrun.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(executionrun.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ScriptID(); ok {
+		_spec.SetField(executionrun.FieldScriptID, field.TypeString, value)
+		_node.ScriptID = value
+	}
+	if value, ok := _c.mutation.ScriptName(); ok {
+		_spec.SetField(executionrun.FieldScriptName, field.TypeString, value)
+		_node.ScriptName = value
+	}
+	if value, ok := _c.mutation.Selector(); ok {
+		_spec.SetField(executionrun.FieldSelector, field.TypeString, value)
+		_node.Selector = value
+	}
+	if value, ok := _c.mutation.ClientIds(); ok {
+		_spec.SetField(executionrun.FieldClientIds, field.TypeJSON, value)
+		_node.ClientIds = value
+	}
+	if value, ok := _c.mutation.ScheduleID(); ok {
+		_spec.SetField(executionrun.FieldScheduleID, field.TypeString, value)
+		_node.ScheduleID = &value
+	}
+	if value, ok := _c.mutation.Targets(); ok {
+		_spec.SetField(executionrun.FieldTargets, field.TypeJSON, value)
+		_node.Targets = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(executionrun.FieldTotal, field.TypeInt, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.Dispatched(); ok {
+		_spec.SetField(executionrun.FieldDispatched, field.TypeInt, value)
+		_node.Dispatched = value
+	}
+	if value, ok := _c.mutation.BatchSize(); ok {
+		_spec.SetField(executionrun.FieldBatchSize, field.TypeInt, value)
+		_node.BatchSize = value
+	}
+	if value, ok := _c.mutation.CanarySize(); ok {
+		_spec.SetField(executionrun.FieldCanarySize, field.TypeInt, value)
+		_node.CanarySize = value
+	}
+	if value, ok := _c.mutation.BatchPauseSeconds(); ok {
+		_spec.SetField(executionrun.FieldBatchPauseSeconds, field.TypeInt, value)
+		_node.BatchPauseSeconds = value
+	}
+	if value, ok := _c.mutation.MaxFailures(); ok {
+		_spec.SetField(executionrun.FieldMaxFailures, field.TypeInt, value)
+		_node.MaxFailures = &value
+	}
+	if value, ok := _c.mutation.CurrentBatch(); ok {
+		_spec.SetField(executionrun.FieldCurrentBatch, field.TypeInt, value)
+		_node.CurrentBatch = value
+	}
+	if value, ok := _c.mutation.NextBatchAt(); ok {
+		_spec.SetField(executionrun.FieldNextBatchAt, field.TypeTime, value)
+		_node.NextBatchAt = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(executionrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.HaltReason(); ok {
+		_spec.SetField(executionrun.FieldHaltReason, field.TypeString, value)
+		_node.HaltReason = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(executionrun.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExecutionRun.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExecutionRunUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExecutionRunCreate) OnConflict(opts ...sql.ConflictOption) *ExecutionRunUpsertOne {
+	_c.conflict = opts
+	return &ExecutionRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExecutionRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExecutionRunCreate) OnConflictColumns(columns ...string) *ExecutionRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExecutionRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExecutionRunUpsertOne is the builder for "upsert"-ing
+	//  one ExecutionRun node.
+	ExecutionRunUpsertOne struct {
+		create *ExecutionRunCreate
+	}
+
+	// ExecutionRunUpsert is the "OnConflict" setter.
+	ExecutionRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ExecutionRunUpsert) SetCreateBy(v uint32) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateCreateBy() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ExecutionRunUpsert) AddCreateBy(v uint32) *ExecutionRunUpsert {
+	u.Add(executionrun.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ExecutionRunUpsert) ClearCreateBy() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ExecutionRunUpsert) SetUpdateTime(v time.Time) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateUpdateTime() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ExecutionRunUpsert) ClearUpdateTime() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ExecutionRunUpsert) SetDeleteTime(v time.Time) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateDeleteTime() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ExecutionRunUpsert) ClearDeleteTime() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldDeleteTime)
+	return u
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ExecutionRunUpsert) SetScriptID(v string) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldScriptID, v)
+	return u
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateScriptID() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldScriptID)
+	return u
+}
+
+// SetScriptName sets the "script_name" field.
+func (u *ExecutionRunUpsert) SetScriptName(v string) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldScriptName, v)
+	return u
+}
+
+// UpdateScriptName sets the "script_name" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateScriptName() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldScriptName)
+	return u
+}
+
+// SetSelector sets the "selector" field.
+func (u *ExecutionRunUpsert) SetSelector(v string) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldSelector, v)
+	return u
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateSelector() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldSelector)
+	return u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ExecutionRunUpsert) ClearSelector() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldSelector)
+	return u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ExecutionRunUpsert) SetClientIds(v []string) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldClientIds, v)
+	return u
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateClientIds() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldClientIds)
+	return u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ExecutionRunUpsert) ClearClientIds() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldClientIds)
+	return u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ExecutionRunUpsert) SetScheduleID(v string) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldScheduleID, v)
+	return u
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateScheduleID() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldScheduleID)
+	return u
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (u *ExecutionRunUpsert) ClearScheduleID() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldScheduleID)
+	return u
+}
+
+// SetTargets sets the "targets" field.
+func (u *ExecutionRunUpsert) SetTargets(v []string) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldTargets, v)
+	return u
+}
+
+// UpdateTargets sets the "targets" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateTargets() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldTargets)
+	return u
+}
+
+// ClearTargets clears the value of the "targets" field.
+func (u *ExecutionRunUpsert) ClearTargets() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldTargets)
+	return u
+}
+
+// SetTotal sets the "total" field.
+func (u *ExecutionRunUpsert) SetTotal(v int) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldTotal, v)
+	return u
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateTotal() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldTotal)
+	return u
+}
+
+// AddTotal adds v to the "total" field.
+func (u *ExecutionRunUpsert) AddTotal(v int) *ExecutionRunUpsert {
+	u.Add(executionrun.FieldTotal, v)
+	return u
+}
+
+// SetDispatched sets the "dispatched" field.
+func (u *ExecutionRunUpsert) SetDispatched(v int) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldDispatched, v)
+	return u
+}
+
+// UpdateDispatched sets the "dispatched" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateDispatched() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldDispatched)
+	return u
+}
+
+// AddDispatched adds v to the "dispatched" field.
+func (u *ExecutionRunUpsert) AddDispatched(v int) *ExecutionRunUpsert {
+	u.Add(executionrun.FieldDispatched, v)
+	return u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *ExecutionRunUpsert) SetBatchSize(v int) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldBatchSize, v)
+	return u
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateBatchSize() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldBatchSize)
+	return u
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *ExecutionRunUpsert) AddBatchSize(v int) *ExecutionRunUpsert {
+	u.Add(executionrun.FieldBatchSize, v)
+	return u
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (u *ExecutionRunUpsert) SetCanarySize(v int) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldCanarySize, v)
+	return u
+}
+
+// UpdateCanarySize sets the "canary_size" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateCanarySize() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldCanarySize)
+	return u
+}
+
+// AddCanarySize adds v to the "canary_size" field.
+func (u *ExecutionRunUpsert) AddCanarySize(v int) *ExecutionRunUpsert {
+	u.Add(executionrun.FieldCanarySize, v)
+	return u
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (u *ExecutionRunUpsert) SetBatchPauseSeconds(v int) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldBatchPauseSeconds, v)
+	return u
+}
+
+// UpdateBatchPauseSeconds sets the "batch_pause_seconds" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateBatchPauseSeconds() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldBatchPauseSeconds)
+	return u
+}
+
+// AddBatchPauseSeconds adds v to the "batch_pause_seconds" field.
+func (u *ExecutionRunUpsert) AddBatchPauseSeconds(v int) *ExecutionRunUpsert {
+	u.Add(executionrun.FieldBatchPauseSeconds, v)
+	return u
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (u *ExecutionRunUpsert) SetMaxFailures(v int) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldMaxFailures, v)
+	return u
+}
+
+// UpdateMaxFailures sets the "max_failures" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateMaxFailures() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldMaxFailures)
+	return u
+}
+
+// AddMaxFailures adds v to the "max_failures" field.
+func (u *ExecutionRunUpsert) AddMaxFailures(v int) *ExecutionRunUpsert {
+	u.Add(executionrun.FieldMaxFailures, v)
+	return u
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (u *ExecutionRunUpsert) ClearMaxFailures() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldMaxFailures)
+	return u
+}
+
+// SetCurrentBatch sets the "current_batch" field.
+func (u *ExecutionRunUpsert) SetCurrentBatch(v int) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldCurrentBatch, v)
+	return u
+}
+
+// UpdateCurrentBatch sets the "current_batch" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateCurrentBatch() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldCurrentBatch)
+	return u
+}
+
+// AddCurrentBatch adds v to the "current_batch" field.
+func (u *ExecutionRunUpsert) AddCurrentBatch(v int) *ExecutionRunUpsert {
+	u.Add(executionrun.FieldCurrentBatch, v)
+	return u
+}
+
+// SetNextBatchAt sets the "next_batch_at" field.
+func (u *ExecutionRunUpsert) SetNextBatchAt(v time.Time) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldNextBatchAt, v)
+	return u
+}
+
+// UpdateNextBatchAt sets the "next_batch_at" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateNextBatchAt() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldNextBatchAt)
+	return u
+}
+
+// ClearNextBatchAt clears the value of the "next_batch_at" field.
+func (u *ExecutionRunUpsert) ClearNextBatchAt() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldNextBatchAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ExecutionRunUpsert) SetStatus(v executionrun.Status) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateStatus() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldStatus)
+	return u
+}
+
+// SetHaltReason sets the "halt_reason" field.
+func (u *ExecutionRunUpsert) SetHaltReason(v string) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldHaltReason, v)
+	return u
+}
+
+// UpdateHaltReason sets the "halt_reason" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateHaltReason() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldHaltReason)
+	return u
+}
+
+// ClearHaltReason clears the value of the "halt_reason" field.
+func (u *ExecutionRunUpsert) ClearHaltReason() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldHaltReason)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ExecutionRunUpsert) SetCompletedAt(v time.Time) *ExecutionRunUpsert {
+	u.Set(executionrun.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ExecutionRunUpsert) UpdateCompletedAt() *ExecutionRunUpsert {
+	u.SetExcluded(executionrun.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ExecutionRunUpsert) ClearCompletedAt() *ExecutionRunUpsert {
+	u.SetNull(executionrun.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExecutionRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(executionrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExecutionRunUpsertOne) UpdateNewValues() *ExecutionRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(executionrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(executionrun.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(executionrun.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExecutionRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExecutionRunUpsertOne) Ignore() *ExecutionRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExecutionRunUpsertOne) DoNothing() *ExecutionRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExecutionRunCreate.OnConflict
+// documentation for more info.
+func (u *ExecutionRunUpsertOne) Update(set func(*ExecutionRunUpsert)) *ExecutionRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExecutionRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ExecutionRunUpsertOne) SetCreateBy(v uint32) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ExecutionRunUpsertOne) AddCreateBy(v uint32) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateCreateBy() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ExecutionRunUpsertOne) ClearCreateBy() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ExecutionRunUpsertOne) SetUpdateTime(v time.Time) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateUpdateTime() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ExecutionRunUpsertOne) ClearUpdateTime() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ExecutionRunUpsertOne) SetDeleteTime(v time.Time) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateDeleteTime() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ExecutionRunUpsertOne) ClearDeleteTime() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ExecutionRunUpsertOne) SetScriptID(v string) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateScriptID() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetScriptName sets the "script_name" field.
+func (u *ExecutionRunUpsertOne) SetScriptName(v string) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetScriptName(v)
+	})
+}
+
+// UpdateScriptName sets the "script_name" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateScriptName() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateScriptName()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *ExecutionRunUpsertOne) SetSelector(v string) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateSelector() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ExecutionRunUpsertOne) ClearSelector() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ExecutionRunUpsertOne) SetClientIds(v []string) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetClientIds(v)
+	})
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateClientIds() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateClientIds()
+	})
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ExecutionRunUpsertOne) ClearClientIds() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearClientIds()
+	})
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ExecutionRunUpsertOne) SetScheduleID(v string) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateScheduleID() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (u *ExecutionRunUpsertOne) ClearScheduleID() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearScheduleID()
+	})
+}
+
+// SetTargets sets the "targets" field.
+func (u *ExecutionRunUpsertOne) SetTargets(v []string) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetTargets(v)
+	})
+}
+
+// UpdateTargets sets the "targets" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateTargets() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateTargets()
+	})
+}
+
+// ClearTargets clears the value of the "targets" field.
+func (u *ExecutionRunUpsertOne) ClearTargets() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearTargets()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *ExecutionRunUpsertOne) SetTotal(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *ExecutionRunUpsertOne) AddTotal(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateTotal() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetDispatched sets the "dispatched" field.
+func (u *ExecutionRunUpsertOne) SetDispatched(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetDispatched(v)
+	})
+}
+
+// AddDispatched adds v to the "dispatched" field.
+func (u *ExecutionRunUpsertOne) AddDispatched(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddDispatched(v)
+	})
+}
+
+// UpdateDispatched sets the "dispatched" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateDispatched() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateDispatched()
+	})
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *ExecutionRunUpsertOne) SetBatchSize(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetBatchSize(v)
+	})
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *ExecutionRunUpsertOne) AddBatchSize(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddBatchSize(v)
+	})
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateBatchSize() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateBatchSize()
+	})
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (u *ExecutionRunUpsertOne) SetCanarySize(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetCanarySize(v)
+	})
+}
+
+// AddCanarySize adds v to the "canary_size" field.
+func (u *ExecutionRunUpsertOne) AddCanarySize(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddCanarySize(v)
+	})
+}
+
+// UpdateCanarySize sets the "canary_size" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateCanarySize() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateCanarySize()
+	})
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (u *ExecutionRunUpsertOne) SetBatchPauseSeconds(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetBatchPauseSeconds(v)
+	})
+}
+
+// AddBatchPauseSeconds adds v to the "batch_pause_seconds" field.
+func (u *ExecutionRunUpsertOne) AddBatchPauseSeconds(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddBatchPauseSeconds(v)
+	})
+}
+
+// UpdateBatchPauseSeconds sets the "batch_pause_seconds" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateBatchPauseSeconds() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateBatchPauseSeconds()
+	})
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (u *ExecutionRunUpsertOne) SetMaxFailures(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetMaxFailures(v)
+	})
+}
+
+// AddMaxFailures adds v to the "max_failures" field.
+func (u *ExecutionRunUpsertOne) AddMaxFailures(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddMaxFailures(v)
+	})
+}
+
+// UpdateMaxFailures sets the "max_failures" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateMaxFailures() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateMaxFailures()
+	})
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (u *ExecutionRunUpsertOne) ClearMaxFailures() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearMaxFailures()
+	})
+}
+
+// SetCurrentBatch sets the "current_batch" field.
+func (u *ExecutionRunUpsertOne) SetCurrentBatch(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetCurrentBatch(v)
+	})
+}
+
+// AddCurrentBatch adds v to the "current_batch" field.
+func (u *ExecutionRunUpsertOne) AddCurrentBatch(v int) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddCurrentBatch(v)
+	})
+}
+
+// UpdateCurrentBatch sets the "current_batch" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateCurrentBatch() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateCurrentBatch()
+	})
+}
+
+// SetNextBatchAt sets the "next_batch_at" field.
+func (u *ExecutionRunUpsertOne) SetNextBatchAt(v time.Time) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetNextBatchAt(v)
+	})
+}
+
+// UpdateNextBatchAt sets the "next_batch_at" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateNextBatchAt() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateNextBatchAt()
+	})
+}
+
+// ClearNextBatchAt clears the value of the "next_batch_at" field.
+func (u *ExecutionRunUpsertOne) ClearNextBatchAt() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearNextBatchAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExecutionRunUpsertOne) SetStatus(v executionrun.Status) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateStatus() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHaltReason sets the "halt_reason" field.
+func (u *ExecutionRunUpsertOne) SetHaltReason(v string) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetHaltReason(v)
+	})
+}
+
+// UpdateHaltReason sets the "halt_reason" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateHaltReason() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateHaltReason()
+	})
+}
+
+// ClearHaltReason clears the value of the "halt_reason" field.
+func (u *ExecutionRunUpsertOne) ClearHaltReason() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearHaltReason()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ExecutionRunUpsertOne) SetCompletedAt(v time.Time) *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ExecutionRunUpsertOne) UpdateCompletedAt() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ExecutionRunUpsertOne) ClearCompletedAt() *ExecutionRunUpsertOne {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExecutionRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExecutionRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExecutionRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExecutionRunUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ExecutionRunUpsertOne.ID is not supported by MySQL driver. Use ExecutionRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExecutionRunUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExecutionRunCreateBulk is the builder for creating many ExecutionRun entities in bulk.
+type ExecutionRunCreateBulk struct {
+	config
+	err      error
+	builders []*ExecutionRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExecutionRun entities in the database.
+func (_c *ExecutionRunCreateBulk) Save(ctx context.Context) ([]*ExecutionRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExecutionRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExecutionRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExecutionRunCreateBulk) SaveX(ctx context.Context) []*ExecutionRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExecutionRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExecutionRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExecutionRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExecutionRunUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExecutionRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExecutionRunUpsertBulk {
+	_c.conflict = opts
+	return &ExecutionRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExecutionRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExecutionRunCreateBulk) OnConflictColumns(columns ...string) *ExecutionRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExecutionRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExecutionRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExecutionRun nodes.
+type ExecutionRunUpsertBulk struct {
+	create *ExecutionRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExecutionRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(executionrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExecutionRunUpsertBulk) UpdateNewValues() *ExecutionRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(executionrun.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(executionrun.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(executionrun.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExecutionRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExecutionRunUpsertBulk) Ignore() *ExecutionRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExecutionRunUpsertBulk) DoNothing() *ExecutionRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExecutionRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExecutionRunUpsertBulk) Update(set func(*ExecutionRunUpsert)) *ExecutionRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExecutionRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ExecutionRunUpsertBulk) SetCreateBy(v uint32) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ExecutionRunUpsertBulk) AddCreateBy(v uint32) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateCreateBy() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ExecutionRunUpsertBulk) ClearCreateBy() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ExecutionRunUpsertBulk) SetUpdateTime(v time.Time) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateUpdateTime() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ExecutionRunUpsertBulk) ClearUpdateTime() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ExecutionRunUpsertBulk) SetDeleteTime(v time.Time) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateDeleteTime() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ExecutionRunUpsertBulk) ClearDeleteTime() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ExecutionRunUpsertBulk) SetScriptID(v string) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateScriptID() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetScriptName sets the "script_name" field.
+func (u *ExecutionRunUpsertBulk) SetScriptName(v string) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetScriptName(v)
+	})
+}
+
+// UpdateScriptName sets the "script_name" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateScriptName() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateScriptName()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *ExecutionRunUpsertBulk) SetSelector(v string) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateSelector() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ExecutionRunUpsertBulk) ClearSelector() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ExecutionRunUpsertBulk) SetClientIds(v []string) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetClientIds(v)
+	})
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateClientIds() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateClientIds()
+	})
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ExecutionRunUpsertBulk) ClearClientIds() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearClientIds()
+	})
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ExecutionRunUpsertBulk) SetScheduleID(v string) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateScheduleID() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (u *ExecutionRunUpsertBulk) ClearScheduleID() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearScheduleID()
+	})
+}
+
+// SetTargets sets the "targets" field.
+func (u *ExecutionRunUpsertBulk) SetTargets(v []string) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetTargets(v)
+	})
+}
+
+// UpdateTargets sets the "targets" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateTargets() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateTargets()
+	})
+}
+
+// ClearTargets clears the value of the "targets" field.
+func (u *ExecutionRunUpsertBulk) ClearTargets() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearTargets()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *ExecutionRunUpsertBulk) SetTotal(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *ExecutionRunUpsertBulk) AddTotal(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateTotal() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetDispatched sets the "dispatched" field.
+func (u *ExecutionRunUpsertBulk) SetDispatched(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetDispatched(v)
+	})
+}
+
+// AddDispatched adds v to the "dispatched" field.
+func (u *ExecutionRunUpsertBulk) AddDispatched(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddDispatched(v)
+	})
+}
+
+// UpdateDispatched sets the "dispatched" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateDispatched() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateDispatched()
+	})
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *ExecutionRunUpsertBulk) SetBatchSize(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetBatchSize(v)
+	})
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *ExecutionRunUpsertBulk) AddBatchSize(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddBatchSize(v)
+	})
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateBatchSize() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateBatchSize()
+	})
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (u *ExecutionRunUpsertBulk) SetCanarySize(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetCanarySize(v)
+	})
+}
+
+// AddCanarySize adds v to the "canary_size" field.
+func (u *ExecutionRunUpsertBulk) AddCanarySize(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddCanarySize(v)
+	})
+}
+
+// UpdateCanarySize sets the "canary_size" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateCanarySize() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateCanarySize()
+	})
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (u *ExecutionRunUpsertBulk) SetBatchPauseSeconds(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetBatchPauseSeconds(v)
+	})
+}
+
+// AddBatchPauseSeconds adds v to the "batch_pause_seconds" field.
+func (u *ExecutionRunUpsertBulk) AddBatchPauseSeconds(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddBatchPauseSeconds(v)
+	})
+}
+
+// UpdateBatchPauseSeconds sets the "batch_pause_seconds" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateBatchPauseSeconds() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateBatchPauseSeconds()
+	})
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (u *ExecutionRunUpsertBulk) SetMaxFailures(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetMaxFailures(v)
+	})
+}
+
+// AddMaxFailures adds v to the "max_failures" field.
+func (u *ExecutionRunUpsertBulk) AddMaxFailures(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddMaxFailures(v)
+	})
+}
+
+// UpdateMaxFailures sets the "max_failures" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateMaxFailures() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateMaxFailures()
+	})
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (u *ExecutionRunUpsertBulk) ClearMaxFailures() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearMaxFailures()
+	})
+}
+
+// SetCurrentBatch sets the "current_batch" field.
+func (u *ExecutionRunUpsertBulk) SetCurrentBatch(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetCurrentBatch(v)
+	})
+}
+
+// AddCurrentBatch adds v to the "current_batch" field.
+func (u *ExecutionRunUpsertBulk) AddCurrentBatch(v int) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.AddCurrentBatch(v)
+	})
+}
+
+// UpdateCurrentBatch sets the "current_batch" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateCurrentBatch() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateCurrentBatch()
+	})
+}
+
+// SetNextBatchAt sets the "next_batch_at" field.
+func (u *ExecutionRunUpsertBulk) SetNextBatchAt(v time.Time) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetNextBatchAt(v)
+	})
+}
+
+// UpdateNextBatchAt sets the "next_batch_at" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateNextBatchAt() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateNextBatchAt()
+	})
+}
+
+// ClearNextBatchAt clears the value of the "next_batch_at" field.
+func (u *ExecutionRunUpsertBulk) ClearNextBatchAt() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearNextBatchAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExecutionRunUpsertBulk) SetStatus(v executionrun.Status) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateStatus() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHaltReason sets the "halt_reason" field.
+func (u *ExecutionRunUpsertBulk) SetHaltReason(v string) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetHaltReason(v)
+	})
+}
+
+// UpdateHaltReason sets the "halt_reason" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateHaltReason() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateHaltReason()
+	})
+}
+
+// ClearHaltReason clears the value of the "halt_reason" field.
+func (u *ExecutionRunUpsertBulk) ClearHaltReason() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearHaltReason()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ExecutionRunUpsertBulk) SetCompletedAt(v time.Time) *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ExecutionRunUpsertBulk) UpdateCompletedAt() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ExecutionRunUpsertBulk) ClearCompletedAt() *ExecutionRunUpsertBulk {
+	return u.Update(func(s *ExecutionRunUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExecutionRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExecutionRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExecutionRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExecutionRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
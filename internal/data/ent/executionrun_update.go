@@ -0,0 +1,1401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ExecutionRunUpdate is the builder for updating ExecutionRun entities.
+type ExecutionRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ExecutionRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ExecutionRunUpdate builder.
+func (_u *ExecutionRunUpdate) Where(ps ...predicate.ExecutionRun) *ExecutionRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ExecutionRunUpdate) SetCreateBy(v uint32) *ExecutionRunUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableCreateBy(v *uint32) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ExecutionRunUpdate) AddCreateBy(v int32) *ExecutionRunUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ExecutionRunUpdate) ClearCreateBy() *ExecutionRunUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ExecutionRunUpdate) SetUpdateTime(v time.Time) *ExecutionRunUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableUpdateTime(v *time.Time) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ExecutionRunUpdate) ClearUpdateTime() *ExecutionRunUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ExecutionRunUpdate) SetDeleteTime(v time.Time) *ExecutionRunUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableDeleteTime(v *time.Time) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ExecutionRunUpdate) ClearDeleteTime() *ExecutionRunUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ExecutionRunUpdate) SetScriptID(v string) *ExecutionRunUpdate {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableScriptID(v *string) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetScriptName sets the "script_name" field.
+func (_u *ExecutionRunUpdate) SetScriptName(v string) *ExecutionRunUpdate {
+	_u.mutation.SetScriptName(v)
+	return _u
+}
+
+// SetNillableScriptName sets the "script_name" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableScriptName(v *string) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetScriptName(*v)
+	}
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *ExecutionRunUpdate) SetSelector(v string) *ExecutionRunUpdate {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableSelector(v *string) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *ExecutionRunUpdate) ClearSelector() *ExecutionRunUpdate {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *ExecutionRunUpdate) SetClientIds(v []string) *ExecutionRunUpdate {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *ExecutionRunUpdate) AppendClientIds(v []string) *ExecutionRunUpdate {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *ExecutionRunUpdate) ClearClientIds() *ExecutionRunUpdate {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *ExecutionRunUpdate) SetScheduleID(v string) *ExecutionRunUpdate {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableScheduleID(v *string) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (_u *ExecutionRunUpdate) ClearScheduleID() *ExecutionRunUpdate {
+	_u.mutation.ClearScheduleID()
+	return _u
+}
+
+// SetTargets sets the "targets" field.
+func (_u *ExecutionRunUpdate) SetTargets(v []string) *ExecutionRunUpdate {
+	_u.mutation.SetTargets(v)
+	return _u
+}
+
+// AppendTargets appends value to the "targets" field.
+func (_u *ExecutionRunUpdate) AppendTargets(v []string) *ExecutionRunUpdate {
+	_u.mutation.AppendTargets(v)
+	return _u
+}
+
+// ClearTargets clears the value of the "targets" field.
+func (_u *ExecutionRunUpdate) ClearTargets() *ExecutionRunUpdate {
+	_u.mutation.ClearTargets()
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *ExecutionRunUpdate) SetTotal(v int) *ExecutionRunUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableTotal(v *int) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *ExecutionRunUpdate) AddTotal(v int) *ExecutionRunUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetDispatched sets the "dispatched" field.
+func (_u *ExecutionRunUpdate) SetDispatched(v int) *ExecutionRunUpdate {
+	_u.mutation.ResetDispatched()
+	_u.mutation.SetDispatched(v)
+	return _u
+}
+
+// SetNillableDispatched sets the "dispatched" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableDispatched(v *int) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetDispatched(*v)
+	}
+	return _u
+}
+
+// AddDispatched adds value to the "dispatched" field.
+func (_u *ExecutionRunUpdate) AddDispatched(v int) *ExecutionRunUpdate {
+	_u.mutation.AddDispatched(v)
+	return _u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_u *ExecutionRunUpdate) SetBatchSize(v int) *ExecutionRunUpdate {
+	_u.mutation.ResetBatchSize()
+	_u.mutation.SetBatchSize(v)
+	return _u
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableBatchSize(v *int) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetBatchSize(*v)
+	}
+	return _u
+}
+
+// AddBatchSize adds value to the "batch_size" field.
+func (_u *ExecutionRunUpdate) AddBatchSize(v int) *ExecutionRunUpdate {
+	_u.mutation.AddBatchSize(v)
+	return _u
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (_u *ExecutionRunUpdate) SetCanarySize(v int) *ExecutionRunUpdate {
+	_u.mutation.ResetCanarySize()
+	_u.mutation.SetCanarySize(v)
+	return _u
+}
+
+// SetNillableCanarySize sets the "canary_size" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableCanarySize(v *int) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetCanarySize(*v)
+	}
+	return _u
+}
+
+// AddCanarySize adds value to the "canary_size" field.
+func (_u *ExecutionRunUpdate) AddCanarySize(v int) *ExecutionRunUpdate {
+	_u.mutation.AddCanarySize(v)
+	return _u
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (_u *ExecutionRunUpdate) SetBatchPauseSeconds(v int) *ExecutionRunUpdate {
+	_u.mutation.ResetBatchPauseSeconds()
+	_u.mutation.SetBatchPauseSeconds(v)
+	return _u
+}
+
+// SetNillableBatchPauseSeconds sets the "batch_pause_seconds" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableBatchPauseSeconds(v *int) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetBatchPauseSeconds(*v)
+	}
+	return _u
+}
+
+// AddBatchPauseSeconds adds value to the "batch_pause_seconds" field.
+func (_u *ExecutionRunUpdate) AddBatchPauseSeconds(v int) *ExecutionRunUpdate {
+	_u.mutation.AddBatchPauseSeconds(v)
+	return _u
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (_u *ExecutionRunUpdate) SetMaxFailures(v int) *ExecutionRunUpdate {
+	_u.mutation.ResetMaxFailures()
+	_u.mutation.SetMaxFailures(v)
+	return _u
+}
+
+// SetNillableMaxFailures sets the "max_failures" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableMaxFailures(v *int) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetMaxFailures(*v)
+	}
+	return _u
+}
+
+// AddMaxFailures adds value to the "max_failures" field.
+func (_u *ExecutionRunUpdate) AddMaxFailures(v int) *ExecutionRunUpdate {
+	_u.mutation.AddMaxFailures(v)
+	return _u
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (_u *ExecutionRunUpdate) ClearMaxFailures() *ExecutionRunUpdate {
+	_u.mutation.ClearMaxFailures()
+	return _u
+}
+
+// SetCurrentBatch sets the "current_batch" field.
+func (_u *ExecutionRunUpdate) SetCurrentBatch(v int) *ExecutionRunUpdate {
+	_u.mutation.ResetCurrentBatch()
+	_u.mutation.SetCurrentBatch(v)
+	return _u
+}
+
+// SetNillableCurrentBatch sets the "current_batch" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableCurrentBatch(v *int) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetCurrentBatch(*v)
+	}
+	return _u
+}
+
+// AddCurrentBatch adds value to the "current_batch" field.
+func (_u *ExecutionRunUpdate) AddCurrentBatch(v int) *ExecutionRunUpdate {
+	_u.mutation.AddCurrentBatch(v)
+	return _u
+}
+
+// SetNextBatchAt sets the "next_batch_at" field.
+func (_u *ExecutionRunUpdate) SetNextBatchAt(v time.Time) *ExecutionRunUpdate {
+	_u.mutation.SetNextBatchAt(v)
+	return _u
+}
+
+// SetNillableNextBatchAt sets the "next_batch_at" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableNextBatchAt(v *time.Time) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetNextBatchAt(*v)
+	}
+	return _u
+}
+
+// ClearNextBatchAt clears the value of the "next_batch_at" field.
+func (_u *ExecutionRunUpdate) ClearNextBatchAt() *ExecutionRunUpdate {
+	_u.mutation.ClearNextBatchAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExecutionRunUpdate) SetStatus(v executionrun.Status) *ExecutionRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableStatus(v *executionrun.Status) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHaltReason sets the "halt_reason" field.
+func (_u *ExecutionRunUpdate) SetHaltReason(v string) *ExecutionRunUpdate {
+	_u.mutation.SetHaltReason(v)
+	return _u
+}
+
+// SetNillableHaltReason sets the "halt_reason" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableHaltReason(v *string) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetHaltReason(*v)
+	}
+	return _u
+}
+
+// ClearHaltReason clears the value of the "halt_reason" field.
+func (_u *ExecutionRunUpdate) ClearHaltReason() *ExecutionRunUpdate {
+	_u.mutation.ClearHaltReason()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ExecutionRunUpdate) SetCompletedAt(v time.Time) *ExecutionRunUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ExecutionRunUpdate) SetNillableCompletedAt(v *time.Time) *ExecutionRunUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ExecutionRunUpdate) ClearCompletedAt() *ExecutionRunUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the ExecutionRunMutation object of the builder.
+func (_u *ExecutionRunUpdate) Mutation() *ExecutionRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExecutionRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExecutionRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExecutionRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExecutionRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExecutionRunUpdate) check() error {
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := executionrun.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptName(); ok {
+		if err := executionrun.ScriptNameValidator(v); err != nil {
+			return &ValidationError{Name: "script_name", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.script_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := executionrun.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.selector": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleID(); ok {
+		if err := executionrun.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.schedule_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Total(); ok {
+		if err := executionrun.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Dispatched(); ok {
+		if err := executionrun.DispatchedValidator(v); err != nil {
+			return &ValidationError{Name: "dispatched", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.dispatched": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchSize(); ok {
+		if err := executionrun.BatchSizeValidator(v); err != nil {
+			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.batch_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CanarySize(); ok {
+		if err := executionrun.CanarySizeValidator(v); err != nil {
+			return &ValidationError{Name: "canary_size", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.canary_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchPauseSeconds(); ok {
+		if err := executionrun.BatchPauseSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_pause_seconds", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.batch_pause_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxFailures(); ok {
+		if err := executionrun.MaxFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "max_failures", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.max_failures": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CurrentBatch(); ok {
+		if err := executionrun.CurrentBatchValidator(v); err != nil {
+			return &ValidationError{Name: "current_batch", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.current_batch": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := executionrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HaltReason(); ok {
+		if err := executionrun.HaltReasonValidator(v); err != nil {
+			return &ValidationError{Name: "halt_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.halt_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExecutionRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExecutionRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExecutionRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(executionrun.Table, executionrun.Columns, sqlgraph.NewFieldSpec(executionrun.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(executionrun.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(executionrun.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(executionrun.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(executionrun.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(executionrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(executionrun.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(executionrun.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(executionrun.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(executionrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(executionrun.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScriptName(); ok {
+		_spec.SetField(executionrun.FieldScriptName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(executionrun.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(executionrun.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(executionrun.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, executionrun.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(executionrun.FieldClientIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScheduleID(); ok {
+		_spec.SetField(executionrun.FieldScheduleID, field.TypeString, value)
+	}
+	if _u.mutation.ScheduleIDCleared() {
+		_spec.ClearField(executionrun.FieldScheduleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Targets(); ok {
+		_spec.SetField(executionrun.FieldTargets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, executionrun.FieldTargets, value)
+		})
+	}
+	if _u.mutation.TargetsCleared() {
+		_spec.ClearField(executionrun.FieldTargets, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(executionrun.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(executionrun.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Dispatched(); ok {
+		_spec.SetField(executionrun.FieldDispatched, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDispatched(); ok {
+		_spec.AddField(executionrun.FieldDispatched, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchSize(); ok {
+		_spec.SetField(executionrun.FieldBatchSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchSize(); ok {
+		_spec.AddField(executionrun.FieldBatchSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CanarySize(); ok {
+		_spec.SetField(executionrun.FieldCanarySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCanarySize(); ok {
+		_spec.AddField(executionrun.FieldCanarySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchPauseSeconds(); ok {
+		_spec.SetField(executionrun.FieldBatchPauseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchPauseSeconds(); ok {
+		_spec.AddField(executionrun.FieldBatchPauseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxFailures(); ok {
+		_spec.SetField(executionrun.FieldMaxFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFailures(); ok {
+		_spec.AddField(executionrun.FieldMaxFailures, field.TypeInt, value)
+	}
+	if _u.mutation.MaxFailuresCleared() {
+		_spec.ClearField(executionrun.FieldMaxFailures, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CurrentBatch(); ok {
+		_spec.SetField(executionrun.FieldCurrentBatch, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentBatch(); ok {
+		_spec.AddField(executionrun.FieldCurrentBatch, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextBatchAt(); ok {
+		_spec.SetField(executionrun.FieldNextBatchAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextBatchAtCleared() {
+		_spec.ClearField(executionrun.FieldNextBatchAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(executionrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HaltReason(); ok {
+		_spec.SetField(executionrun.FieldHaltReason, field.TypeString, value)
+	}
+	if _u.mutation.HaltReasonCleared() {
+		_spec.ClearField(executionrun.FieldHaltReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(executionrun.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(executionrun.FieldCompletedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{executionrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExecutionRunUpdateOne is the builder for updating a single ExecutionRun entity.
+type ExecutionRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ExecutionRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ExecutionRunUpdateOne) SetCreateBy(v uint32) *ExecutionRunUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableCreateBy(v *uint32) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ExecutionRunUpdateOne) AddCreateBy(v int32) *ExecutionRunUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ExecutionRunUpdateOne) ClearCreateBy() *ExecutionRunUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ExecutionRunUpdateOne) SetUpdateTime(v time.Time) *ExecutionRunUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableUpdateTime(v *time.Time) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ExecutionRunUpdateOne) ClearUpdateTime() *ExecutionRunUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ExecutionRunUpdateOne) SetDeleteTime(v time.Time) *ExecutionRunUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableDeleteTime(v *time.Time) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ExecutionRunUpdateOne) ClearDeleteTime() *ExecutionRunUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ExecutionRunUpdateOne) SetScriptID(v string) *ExecutionRunUpdateOne {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableScriptID(v *string) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetScriptName sets the "script_name" field.
+func (_u *ExecutionRunUpdateOne) SetScriptName(v string) *ExecutionRunUpdateOne {
+	_u.mutation.SetScriptName(v)
+	return _u
+}
+
+// SetNillableScriptName sets the "script_name" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableScriptName(v *string) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetScriptName(*v)
+	}
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *ExecutionRunUpdateOne) SetSelector(v string) *ExecutionRunUpdateOne {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableSelector(v *string) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *ExecutionRunUpdateOne) ClearSelector() *ExecutionRunUpdateOne {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *ExecutionRunUpdateOne) SetClientIds(v []string) *ExecutionRunUpdateOne {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *ExecutionRunUpdateOne) AppendClientIds(v []string) *ExecutionRunUpdateOne {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *ExecutionRunUpdateOne) ClearClientIds() *ExecutionRunUpdateOne {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *ExecutionRunUpdateOne) SetScheduleID(v string) *ExecutionRunUpdateOne {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableScheduleID(v *string) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (_u *ExecutionRunUpdateOne) ClearScheduleID() *ExecutionRunUpdateOne {
+	_u.mutation.ClearScheduleID()
+	return _u
+}
+
+// SetTargets sets the "targets" field.
+func (_u *ExecutionRunUpdateOne) SetTargets(v []string) *ExecutionRunUpdateOne {
+	_u.mutation.SetTargets(v)
+	return _u
+}
+
+// AppendTargets appends value to the "targets" field.
+func (_u *ExecutionRunUpdateOne) AppendTargets(v []string) *ExecutionRunUpdateOne {
+	_u.mutation.AppendTargets(v)
+	return _u
+}
+
+// ClearTargets clears the value of the "targets" field.
+func (_u *ExecutionRunUpdateOne) ClearTargets() *ExecutionRunUpdateOne {
+	_u.mutation.ClearTargets()
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *ExecutionRunUpdateOne) SetTotal(v int) *ExecutionRunUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableTotal(v *int) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *ExecutionRunUpdateOne) AddTotal(v int) *ExecutionRunUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetDispatched sets the "dispatched" field.
+func (_u *ExecutionRunUpdateOne) SetDispatched(v int) *ExecutionRunUpdateOne {
+	_u.mutation.ResetDispatched()
+	_u.mutation.SetDispatched(v)
+	return _u
+}
+
+// SetNillableDispatched sets the "dispatched" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableDispatched(v *int) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetDispatched(*v)
+	}
+	return _u
+}
+
+// AddDispatched adds value to the "dispatched" field.
+func (_u *ExecutionRunUpdateOne) AddDispatched(v int) *ExecutionRunUpdateOne {
+	_u.mutation.AddDispatched(v)
+	return _u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_u *ExecutionRunUpdateOne) SetBatchSize(v int) *ExecutionRunUpdateOne {
+	_u.mutation.ResetBatchSize()
+	_u.mutation.SetBatchSize(v)
+	return _u
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableBatchSize(v *int) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetBatchSize(*v)
+	}
+	return _u
+}
+
+// AddBatchSize adds value to the "batch_size" field.
+func (_u *ExecutionRunUpdateOne) AddBatchSize(v int) *ExecutionRunUpdateOne {
+	_u.mutation.AddBatchSize(v)
+	return _u
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (_u *ExecutionRunUpdateOne) SetCanarySize(v int) *ExecutionRunUpdateOne {
+	_u.mutation.ResetCanarySize()
+	_u.mutation.SetCanarySize(v)
+	return _u
+}
+
+// SetNillableCanarySize sets the "canary_size" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableCanarySize(v *int) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetCanarySize(*v)
+	}
+	return _u
+}
+
+// AddCanarySize adds value to the "canary_size" field.
+func (_u *ExecutionRunUpdateOne) AddCanarySize(v int) *ExecutionRunUpdateOne {
+	_u.mutation.AddCanarySize(v)
+	return _u
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (_u *ExecutionRunUpdateOne) SetBatchPauseSeconds(v int) *ExecutionRunUpdateOne {
+	_u.mutation.ResetBatchPauseSeconds()
+	_u.mutation.SetBatchPauseSeconds(v)
+	return _u
+}
+
+// SetNillableBatchPauseSeconds sets the "batch_pause_seconds" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableBatchPauseSeconds(v *int) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetBatchPauseSeconds(*v)
+	}
+	return _u
+}
+
+// AddBatchPauseSeconds adds value to the "batch_pause_seconds" field.
+func (_u *ExecutionRunUpdateOne) AddBatchPauseSeconds(v int) *ExecutionRunUpdateOne {
+	_u.mutation.AddBatchPauseSeconds(v)
+	return _u
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (_u *ExecutionRunUpdateOne) SetMaxFailures(v int) *ExecutionRunUpdateOne {
+	_u.mutation.ResetMaxFailures()
+	_u.mutation.SetMaxFailures(v)
+	return _u
+}
+
+// SetNillableMaxFailures sets the "max_failures" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableMaxFailures(v *int) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetMaxFailures(*v)
+	}
+	return _u
+}
+
+// AddMaxFailures adds value to the "max_failures" field.
+func (_u *ExecutionRunUpdateOne) AddMaxFailures(v int) *ExecutionRunUpdateOne {
+	_u.mutation.AddMaxFailures(v)
+	return _u
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (_u *ExecutionRunUpdateOne) ClearMaxFailures() *ExecutionRunUpdateOne {
+	_u.mutation.ClearMaxFailures()
+	return _u
+}
+
+// SetCurrentBatch sets the "current_batch" field.
+func (_u *ExecutionRunUpdateOne) SetCurrentBatch(v int) *ExecutionRunUpdateOne {
+	_u.mutation.ResetCurrentBatch()
+	_u.mutation.SetCurrentBatch(v)
+	return _u
+}
+
+// SetNillableCurrentBatch sets the "current_batch" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableCurrentBatch(v *int) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetCurrentBatch(*v)
+	}
+	return _u
+}
+
+// AddCurrentBatch adds value to the "current_batch" field.
+func (_u *ExecutionRunUpdateOne) AddCurrentBatch(v int) *ExecutionRunUpdateOne {
+	_u.mutation.AddCurrentBatch(v)
+	return _u
+}
+
+// SetNextBatchAt sets the "next_batch_at" field.
+func (_u *ExecutionRunUpdateOne) SetNextBatchAt(v time.Time) *ExecutionRunUpdateOne {
+	_u.mutation.SetNextBatchAt(v)
+	return _u
+}
+
+// SetNillableNextBatchAt sets the "next_batch_at" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableNextBatchAt(v *time.Time) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetNextBatchAt(*v)
+	}
+	return _u
+}
+
+// ClearNextBatchAt clears the value of the "next_batch_at" field.
+func (_u *ExecutionRunUpdateOne) ClearNextBatchAt() *ExecutionRunUpdateOne {
+	_u.mutation.ClearNextBatchAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExecutionRunUpdateOne) SetStatus(v executionrun.Status) *ExecutionRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableStatus(v *executionrun.Status) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHaltReason sets the "halt_reason" field.
+func (_u *ExecutionRunUpdateOne) SetHaltReason(v string) *ExecutionRunUpdateOne {
+	_u.mutation.SetHaltReason(v)
+	return _u
+}
+
+// SetNillableHaltReason sets the "halt_reason" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableHaltReason(v *string) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetHaltReason(*v)
+	}
+	return _u
+}
+
+// ClearHaltReason clears the value of the "halt_reason" field.
+func (_u *ExecutionRunUpdateOne) ClearHaltReason() *ExecutionRunUpdateOne {
+	_u.mutation.ClearHaltReason()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ExecutionRunUpdateOne) SetCompletedAt(v time.Time) *ExecutionRunUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ExecutionRunUpdateOne) SetNillableCompletedAt(v *time.Time) *ExecutionRunUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ExecutionRunUpdateOne) ClearCompletedAt() *ExecutionRunUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the ExecutionRunMutation object of the builder.
+func (_u *ExecutionRunUpdateOne) Mutation() *ExecutionRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExecutionRunUpdate builder.
+func (_u *ExecutionRunUpdateOne) Where(ps ...predicate.ExecutionRun) *ExecutionRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExecutionRunUpdateOne) Select(field string, fields ...string) *ExecutionRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExecutionRun entity.
+func (_u *ExecutionRunUpdateOne) Save(ctx context.Context) (*ExecutionRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExecutionRunUpdateOne) SaveX(ctx context.Context) *ExecutionRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExecutionRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExecutionRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExecutionRunUpdateOne) check() error {
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := executionrun.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptName(); ok {
+		if err := executionrun.ScriptNameValidator(v); err != nil {
+			return &ValidationError{Name: "script_name", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.script_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := executionrun.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.selector": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleID(); ok {
+		if err := executionrun.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.schedule_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Total(); ok {
+		if err := executionrun.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Dispatched(); ok {
+		if err := executionrun.DispatchedValidator(v); err != nil {
+			return &ValidationError{Name: "dispatched", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.dispatched": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchSize(); ok {
+		if err := executionrun.BatchSizeValidator(v); err != nil {
+			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.batch_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CanarySize(); ok {
+		if err := executionrun.CanarySizeValidator(v); err != nil {
+			return &ValidationError{Name: "canary_size", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.canary_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchPauseSeconds(); ok {
+		if err := executionrun.BatchPauseSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_pause_seconds", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.batch_pause_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxFailures(); ok {
+		if err := executionrun.MaxFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "max_failures", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.max_failures": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CurrentBatch(); ok {
+		if err := executionrun.CurrentBatchValidator(v); err != nil {
+			return &ValidationError{Name: "current_batch", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.current_batch": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := executionrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HaltReason(); ok {
+		if err := executionrun.HaltReasonValidator(v); err != nil {
+			return &ValidationError{Name: "halt_reason", err: fmt.Errorf(`ent: validator failed for field "ExecutionRun.halt_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExecutionRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExecutionRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExecutionRunUpdateOne) sqlSave(ctx context.Context) (_node *ExecutionRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(executionrun.Table, executionrun.Columns, sqlgraph.NewFieldSpec(executionrun.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExecutionRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, executionrun.FieldID)
+		for _, f := range fields {
+			if !executionrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != executionrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(executionrun.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(executionrun.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(executionrun.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(executionrun.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(executionrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(executionrun.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(executionrun.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(executionrun.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(executionrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(executionrun.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScriptName(); ok {
+		_spec.SetField(executionrun.FieldScriptName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(executionrun.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(executionrun.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(executionrun.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, executionrun.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(executionrun.FieldClientIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScheduleID(); ok {
+		_spec.SetField(executionrun.FieldScheduleID, field.TypeString, value)
+	}
+	if _u.mutation.ScheduleIDCleared() {
+		_spec.ClearField(executionrun.FieldScheduleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Targets(); ok {
+		_spec.SetField(executionrun.FieldTargets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, executionrun.FieldTargets, value)
+		})
+	}
+	if _u.mutation.TargetsCleared() {
+		_spec.ClearField(executionrun.FieldTargets, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(executionrun.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(executionrun.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Dispatched(); ok {
+		_spec.SetField(executionrun.FieldDispatched, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDispatched(); ok {
+		_spec.AddField(executionrun.FieldDispatched, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchSize(); ok {
+		_spec.SetField(executionrun.FieldBatchSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchSize(); ok {
+		_spec.AddField(executionrun.FieldBatchSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CanarySize(); ok {
+		_spec.SetField(executionrun.FieldCanarySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCanarySize(); ok {
+		_spec.AddField(executionrun.FieldCanarySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchPauseSeconds(); ok {
+		_spec.SetField(executionrun.FieldBatchPauseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchPauseSeconds(); ok {
+		_spec.AddField(executionrun.FieldBatchPauseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxFailures(); ok {
+		_spec.SetField(executionrun.FieldMaxFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFailures(); ok {
+		_spec.AddField(executionrun.FieldMaxFailures, field.TypeInt, value)
+	}
+	if _u.mutation.MaxFailuresCleared() {
+		_spec.ClearField(executionrun.FieldMaxFailures, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CurrentBatch(); ok {
+		_spec.SetField(executionrun.FieldCurrentBatch, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentBatch(); ok {
+		_spec.AddField(executionrun.FieldCurrentBatch, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextBatchAt(); ok {
+		_spec.SetField(executionrun.FieldNextBatchAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextBatchAtCleared() {
+		_spec.ClearField(executionrun.FieldNextBatchAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(executionrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HaltReason(); ok {
+		_spec.SetField(executionrun.FieldHaltReason, field.TypeString, value)
+	}
+	if _u.mutation.HaltReasonCleared() {
+		_spec.ClearField(executionrun.FieldHaltReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(executionrun.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(executionrun.FieldCompletedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ExecutionRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{executionrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,66 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The ClientGroupFunc type is an adapter to allow the use of ordinary
+// function as ClientGroup mutator.
+type ClientGroupFunc func(context.Context, *ent.ClientGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClientGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClientGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClientGroupMutation", m)
+}
+
+// The ClientLabelSetFunc type is an adapter to allow the use of ordinary
+// function as ClientLabelSet mutator.
+type ClientLabelSetFunc func(context.Context, *ent.ClientLabelSetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClientLabelSetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClientLabelSetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClientLabelSetMutation", m)
+}
+
+// The ClientLockFunc type is an adapter to allow the use of ordinary
+// function as ClientLock mutator.
+type ClientLockFunc func(context.Context, *ent.ClientLockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClientLockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClientLockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClientLockMutation", m)
+}
+
+// The CommandFunc type is an adapter to allow the use of ordinary
+// function as Command mutator.
+type CommandFunc func(context.Context, *ent.CommandMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommandFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommandMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommandMutation", m)
+}
+
+// The EventRuleFunc type is an adapter to allow the use of ordinary
+// function as EventRule mutator.
+type EventRuleFunc func(context.Context, *ent.EventRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EventRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventRuleMutation", m)
+}
+
 // The ExecutionLogFunc type is an adapter to allow the use of ordinary
 // function as ExecutionLog mutator.
 type ExecutionLogFunc func(context.Context, *ent.ExecutionLogMutation) (ent.Value, error)
@@ -33,6 +93,90 @@ func (f ExecutionLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExecutionLogMutation", m)
 }
 
+// The ExecutionRunFunc type is an adapter to allow the use of ordinary
+// function as ExecutionRun mutator.
+type ExecutionRunFunc func(context.Context, *ent.ExecutionRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExecutionRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExecutionRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExecutionRunMutation", m)
+}
+
+// The MaintenanceWindowFunc type is an adapter to allow the use of ordinary
+// function as MaintenanceWindow mutator.
+type MaintenanceWindowFunc func(context.Context, *ent.MaintenanceWindowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MaintenanceWindowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MaintenanceWindowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MaintenanceWindowMutation", m)
+}
+
+// The ManagedClientFunc type is an adapter to allow the use of ordinary
+// function as ManagedClient mutator.
+type ManagedClientFunc func(context.Context, *ent.ManagedClientMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ManagedClientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ManagedClientMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ManagedClientMutation", m)
+}
+
+// The OutputChunkFunc type is an adapter to allow the use of ordinary
+// function as OutputChunk mutator.
+type OutputChunkFunc func(context.Context, *ent.OutputChunkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutputChunkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OutputChunkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutputChunkMutation", m)
+}
+
+// The ProtectedClientFunc type is an adapter to allow the use of ordinary
+// function as ProtectedClient mutator.
+type ProtectedClientFunc func(context.Context, *ent.ProtectedClientMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProtectedClientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProtectedClientMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProtectedClientMutation", m)
+}
+
+// The QueuedCommandFunc type is an adapter to allow the use of ordinary
+// function as QueuedCommand mutator.
+type QueuedCommandFunc func(context.Context, *ent.QueuedCommandMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QueuedCommandFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QueuedCommandMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QueuedCommandMutation", m)
+}
+
+// The ScheduleFunc type is an adapter to allow the use of ordinary
+// function as Schedule mutator.
+type ScheduleFunc func(context.Context, *ent.ScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduleMutation", m)
+}
+
 // The ScriptFunc type is an adapter to allow the use of ordinary
 // function as Script mutator.
 type ScriptFunc func(context.Context, *ent.ScriptMutation) (ent.Value, error)
@@ -57,6 +201,90 @@ func (f ScriptAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScriptAssignmentMutation", m)
 }
 
+// The ScriptChangeRequestFunc type is an adapter to allow the use of ordinary
+// function as ScriptChangeRequest mutator.
+type ScriptChangeRequestFunc func(context.Context, *ent.ScriptChangeRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScriptChangeRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScriptChangeRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScriptChangeRequestMutation", m)
+}
+
+// The ScriptVersionFunc type is an adapter to allow the use of ordinary
+// function as ScriptVersion mutator.
+type ScriptVersionFunc func(context.Context, *ent.ScriptVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScriptVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScriptVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScriptVersionMutation", m)
+}
+
+// The SecretFunc type is an adapter to allow the use of ordinary
+// function as Secret mutator.
+type SecretFunc func(context.Context, *ent.SecretMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecretFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SecretMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SecretMutation", m)
+}
+
+// The SigningKeyFunc type is an adapter to allow the use of ordinary
+// function as SigningKey mutator.
+type SigningKeyFunc func(context.Context, *ent.SigningKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SigningKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SigningKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SigningKeyMutation", m)
+}
+
+// The TenantSettingFunc type is an adapter to allow the use of ordinary
+// function as TenantSetting mutator.
+type TenantSettingFunc func(context.Context, *ent.TenantSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantSettingMutation", m)
+}
+
+// The WorkflowFunc type is an adapter to allow the use of ordinary
+// function as Workflow mutator.
+type WorkflowFunc func(context.Context, *ent.WorkflowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkflowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkflowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkflowMutation", m)
+}
+
+// The WorkflowRunFunc type is an adapter to allow the use of ordinary
+// function as WorkflowRun mutator.
+type WorkflowRunFunc func(context.Context, *ent.WorkflowRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkflowRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkflowRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkflowRunMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+)
+
+// MaintenanceWindow is the model entity for the MaintenanceWindow schema.
+type MaintenanceWindow struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Window name
+	Name string `json:"name,omitempty"`
+	// Window description
+	Description string `json:"description,omitempty"`
+	// Client CNs or glob patterns the window applies to
+	ClientPatterns []string `json:"client_patterns,omitempty"`
+	// 5-field cron expression or macro at which the window opens
+	CronExpression string `json:"cron_expression,omitempty"`
+	// How long the window stays open
+	DurationMinutes int `json:"duration_minutes,omitempty"`
+	// IANA timezone the expression is evaluated in
+	Timezone string `json:"timezone,omitempty"`
+	// What happens to executions triggered outside the window
+	Action maintenancewindow.Action `json:"action,omitempty"`
+	// Disabled windows do not restrict their clients
+	Enabled      bool `json:"enabled,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MaintenanceWindow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldClientPatterns:
+			values[i] = new([]byte)
+		case maintenancewindow.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case maintenancewindow.FieldCreateBy, maintenancewindow.FieldUpdateBy, maintenancewindow.FieldTenantID, maintenancewindow.FieldDurationMinutes:
+			values[i] = new(sql.NullInt64)
+		case maintenancewindow.FieldID, maintenancewindow.FieldName, maintenancewindow.FieldDescription, maintenancewindow.FieldCronExpression, maintenancewindow.FieldTimezone, maintenancewindow.FieldAction:
+			values[i] = new(sql.NullString)
+		case maintenancewindow.FieldCreateTime, maintenancewindow.FieldUpdateTime, maintenancewindow.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MaintenanceWindow fields.
+func (_m *MaintenanceWindow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case maintenancewindow.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case maintenancewindow.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case maintenancewindow.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case maintenancewindow.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case maintenancewindow.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case maintenancewindow.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case maintenancewindow.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case maintenancewindow.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case maintenancewindow.FieldClientPatterns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field client_patterns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClientPatterns); err != nil {
+					return fmt.Errorf("unmarshal field client_patterns: %w", err)
+				}
+			}
+		case maintenancewindow.FieldCronExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_expression", values[i])
+			} else if value.Valid {
+				_m.CronExpression = value.String
+			}
+		case maintenancewindow.FieldDurationMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_minutes", values[i])
+			} else if value.Valid {
+				_m.DurationMinutes = int(value.Int64)
+			}
+		case maintenancewindow.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case maintenancewindow.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = maintenancewindow.Action(value.String)
+			}
+		case maintenancewindow.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MaintenanceWindow.
+// This includes values selected through modifiers, order, etc.
+func (_m *MaintenanceWindow) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MaintenanceWindow.
+// Note that you need to call MaintenanceWindow.Unwrap() before calling this method if this MaintenanceWindow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MaintenanceWindow) Update() *MaintenanceWindowUpdateOne {
+	return NewMaintenanceWindowClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MaintenanceWindow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MaintenanceWindow) Unwrap() *MaintenanceWindow {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MaintenanceWindow is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MaintenanceWindow) String() string {
+	var builder strings.Builder
+	builder.WriteString("MaintenanceWindow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("client_patterns=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientPatterns))
+	builder.WriteString(", ")
+	builder.WriteString("cron_expression=")
+	builder.WriteString(_m.CronExpression)
+	builder.WriteString(", ")
+	builder.WriteString("duration_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MaintenanceWindows is a parsable slice of MaintenanceWindow.
+type MaintenanceWindows []*MaintenanceWindow
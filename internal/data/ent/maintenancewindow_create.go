@@ -0,0 +1,1390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+)
+
+// MaintenanceWindowCreate is the builder for creating a MaintenanceWindow entity.
+type MaintenanceWindowCreate struct {
+	config
+	mutation *MaintenanceWindowMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *MaintenanceWindowCreate) SetCreateBy(v uint32) *MaintenanceWindowCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableCreateBy(v *uint32) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *MaintenanceWindowCreate) SetUpdateBy(v uint32) *MaintenanceWindowCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableUpdateBy(v *uint32) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *MaintenanceWindowCreate) SetCreateTime(v time.Time) *MaintenanceWindowCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableCreateTime(v *time.Time) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *MaintenanceWindowCreate) SetUpdateTime(v time.Time) *MaintenanceWindowCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableUpdateTime(v *time.Time) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *MaintenanceWindowCreate) SetDeleteTime(v time.Time) *MaintenanceWindowCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableDeleteTime(v *time.Time) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *MaintenanceWindowCreate) SetTenantID(v uint32) *MaintenanceWindowCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableTenantID(v *uint32) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *MaintenanceWindowCreate) SetName(v string) *MaintenanceWindowCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *MaintenanceWindowCreate) SetDescription(v string) *MaintenanceWindowCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableDescription(v *string) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetClientPatterns sets the "client_patterns" field.
+func (_c *MaintenanceWindowCreate) SetClientPatterns(v []string) *MaintenanceWindowCreate {
+	_c.mutation.SetClientPatterns(v)
+	return _c
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_c *MaintenanceWindowCreate) SetCronExpression(v string) *MaintenanceWindowCreate {
+	_c.mutation.SetCronExpression(v)
+	return _c
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_c *MaintenanceWindowCreate) SetDurationMinutes(v int) *MaintenanceWindowCreate {
+	_c.mutation.SetDurationMinutes(v)
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *MaintenanceWindowCreate) SetTimezone(v string) *MaintenanceWindowCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableTimezone(v *string) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *MaintenanceWindowCreate) SetAction(v maintenancewindow.Action) *MaintenanceWindowCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableAction(v *maintenancewindow.Action) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *MaintenanceWindowCreate) SetEnabled(v bool) *MaintenanceWindowCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *MaintenanceWindowCreate) SetNillableEnabled(v *bool) *MaintenanceWindowCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MaintenanceWindowCreate) SetID(v string) *MaintenanceWindowCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (_c *MaintenanceWindowCreate) Mutation() *MaintenanceWindowMutation {
+	return _c.mutation
+}
+
+// Save creates the MaintenanceWindow in the database.
+func (_c *MaintenanceWindowCreate) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MaintenanceWindowCreate) SaveX(ctx context.Context) *MaintenanceWindow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MaintenanceWindowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MaintenanceWindowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MaintenanceWindowCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := maintenancewindow.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := maintenancewindow.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		v := maintenancewindow.DefaultAction
+		_c.mutation.SetAction(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := maintenancewindow.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MaintenanceWindowCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MaintenanceWindow.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := maintenancewindow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := maintenancewindow.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientPatterns(); !ok {
+		return &ValidationError{Name: "client_patterns", err: errors.New(`ent: missing required field "MaintenanceWindow.client_patterns"`)}
+	}
+	if _, ok := _c.mutation.CronExpression(); !ok {
+		return &ValidationError{Name: "cron_expression", err: errors.New(`ent: missing required field "MaintenanceWindow.cron_expression"`)}
+	}
+	if v, ok := _c.mutation.CronExpression(); ok {
+		if err := maintenancewindow.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.cron_expression": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DurationMinutes(); !ok {
+		return &ValidationError{Name: "duration_minutes", err: errors.New(`ent: missing required field "MaintenanceWindow.duration_minutes"`)}
+	}
+	if v, ok := _c.mutation.DurationMinutes(); ok {
+		if err := maintenancewindow.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.duration_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "MaintenanceWindow.timezone"`)}
+	}
+	if v, ok := _c.mutation.Timezone(); ok {
+		if err := maintenancewindow.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.timezone": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "MaintenanceWindow.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := maintenancewindow.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "MaintenanceWindow.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := maintenancewindow.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *MaintenanceWindowCreate) sqlSave(ctx context.Context) (*MaintenanceWindow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected MaintenanceWindow.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MaintenanceWindowCreate) createSpec() (*MaintenanceWindow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MaintenanceWindow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(maintenancewindow.Table, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(maintenancewindow.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(maintenancewindow.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(maintenancewindow.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(maintenancewindow.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(maintenancewindow.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(maintenancewindow.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(maintenancewindow.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.ClientPatterns(); ok {
+		_spec.SetField(maintenancewindow.FieldClientPatterns, field.TypeJSON, value)
+		_node.ClientPatterns = value
+	}
+	if value, ok := _c.mutation.CronExpression(); ok {
+		_spec.SetField(maintenancewindow.FieldCronExpression, field.TypeString, value)
+		_node.CronExpression = value
+	}
+	if value, ok := _c.mutation.DurationMinutes(); ok {
+		_spec.SetField(maintenancewindow.FieldDurationMinutes, field.TypeInt, value)
+		_node.DurationMinutes = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(maintenancewindow.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(maintenancewindow.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(maintenancewindow.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MaintenanceWindow.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MaintenanceWindowUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MaintenanceWindowCreate) OnConflict(opts ...sql.ConflictOption) *MaintenanceWindowUpsertOne {
+	_c.conflict = opts
+	return &MaintenanceWindowUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MaintenanceWindowCreate) OnConflictColumns(columns ...string) *MaintenanceWindowUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MaintenanceWindowUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MaintenanceWindowUpsertOne is the builder for "upsert"-ing
+	//  one MaintenanceWindow node.
+	MaintenanceWindowUpsertOne struct {
+		create *MaintenanceWindowCreate
+	}
+
+	// MaintenanceWindowUpsert is the "OnConflict" setter.
+	MaintenanceWindowUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *MaintenanceWindowUpsert) SetCreateBy(v uint32) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateCreateBy() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *MaintenanceWindowUpsert) AddCreateBy(v uint32) *MaintenanceWindowUpsert {
+	u.Add(maintenancewindow.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *MaintenanceWindowUpsert) ClearCreateBy() *MaintenanceWindowUpsert {
+	u.SetNull(maintenancewindow.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *MaintenanceWindowUpsert) SetUpdateBy(v uint32) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateUpdateBy() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *MaintenanceWindowUpsert) AddUpdateBy(v uint32) *MaintenanceWindowUpsert {
+	u.Add(maintenancewindow.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *MaintenanceWindowUpsert) ClearUpdateBy() *MaintenanceWindowUpsert {
+	u.SetNull(maintenancewindow.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *MaintenanceWindowUpsert) SetUpdateTime(v time.Time) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateUpdateTime() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *MaintenanceWindowUpsert) ClearUpdateTime() *MaintenanceWindowUpsert {
+	u.SetNull(maintenancewindow.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *MaintenanceWindowUpsert) SetDeleteTime(v time.Time) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateDeleteTime() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *MaintenanceWindowUpsert) ClearDeleteTime() *MaintenanceWindowUpsert {
+	u.SetNull(maintenancewindow.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MaintenanceWindowUpsert) SetName(v string) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateName() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *MaintenanceWindowUpsert) SetDescription(v string) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateDescription() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *MaintenanceWindowUpsert) ClearDescription() *MaintenanceWindowUpsert {
+	u.SetNull(maintenancewindow.FieldDescription)
+	return u
+}
+
+// SetClientPatterns sets the "client_patterns" field.
+func (u *MaintenanceWindowUpsert) SetClientPatterns(v []string) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldClientPatterns, v)
+	return u
+}
+
+// UpdateClientPatterns sets the "client_patterns" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateClientPatterns() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldClientPatterns)
+	return u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *MaintenanceWindowUpsert) SetCronExpression(v string) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldCronExpression, v)
+	return u
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateCronExpression() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldCronExpression)
+	return u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *MaintenanceWindowUpsert) SetDurationMinutes(v int) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldDurationMinutes, v)
+	return u
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateDurationMinutes() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldDurationMinutes)
+	return u
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *MaintenanceWindowUpsert) AddDurationMinutes(v int) *MaintenanceWindowUpsert {
+	u.Add(maintenancewindow.FieldDurationMinutes, v)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *MaintenanceWindowUpsert) SetTimezone(v string) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateTimezone() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldTimezone)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *MaintenanceWindowUpsert) SetAction(v maintenancewindow.Action) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateAction() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldAction)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MaintenanceWindowUpsert) SetEnabled(v bool) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateEnabled() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(maintenancewindow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MaintenanceWindowUpsertOne) UpdateNewValues() *MaintenanceWindowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(maintenancewindow.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(maintenancewindow.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(maintenancewindow.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MaintenanceWindowUpsertOne) Ignore() *MaintenanceWindowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MaintenanceWindowUpsertOne) DoNothing() *MaintenanceWindowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MaintenanceWindowCreate.OnConflict
+// documentation for more info.
+func (u *MaintenanceWindowUpsertOne) Update(set func(*MaintenanceWindowUpsert)) *MaintenanceWindowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MaintenanceWindowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *MaintenanceWindowUpsertOne) SetCreateBy(v uint32) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *MaintenanceWindowUpsertOne) AddCreateBy(v uint32) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateCreateBy() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *MaintenanceWindowUpsertOne) ClearCreateBy() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *MaintenanceWindowUpsertOne) SetUpdateBy(v uint32) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *MaintenanceWindowUpsertOne) AddUpdateBy(v uint32) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateUpdateBy() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *MaintenanceWindowUpsertOne) ClearUpdateBy() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *MaintenanceWindowUpsertOne) SetUpdateTime(v time.Time) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateUpdateTime() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *MaintenanceWindowUpsertOne) ClearUpdateTime() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *MaintenanceWindowUpsertOne) SetDeleteTime(v time.Time) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateDeleteTime() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *MaintenanceWindowUpsertOne) ClearDeleteTime() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MaintenanceWindowUpsertOne) SetName(v string) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateName() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *MaintenanceWindowUpsertOne) SetDescription(v string) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateDescription() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *MaintenanceWindowUpsertOne) ClearDescription() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetClientPatterns sets the "client_patterns" field.
+func (u *MaintenanceWindowUpsertOne) SetClientPatterns(v []string) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetClientPatterns(v)
+	})
+}
+
+// UpdateClientPatterns sets the "client_patterns" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateClientPatterns() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateClientPatterns()
+	})
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *MaintenanceWindowUpsertOne) SetCronExpression(v string) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetCronExpression(v)
+	})
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateCronExpression() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateCronExpression()
+	})
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *MaintenanceWindowUpsertOne) SetDurationMinutes(v int) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetDurationMinutes(v)
+	})
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *MaintenanceWindowUpsertOne) AddDurationMinutes(v int) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.AddDurationMinutes(v)
+	})
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateDurationMinutes() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateDurationMinutes()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *MaintenanceWindowUpsertOne) SetTimezone(v string) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateTimezone() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *MaintenanceWindowUpsertOne) SetAction(v maintenancewindow.Action) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateAction() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MaintenanceWindowUpsertOne) SetEnabled(v bool) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateEnabled() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *MaintenanceWindowUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MaintenanceWindowCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MaintenanceWindowUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MaintenanceWindowUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MaintenanceWindowUpsertOne.ID is not supported by MySQL driver. Use MaintenanceWindowUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MaintenanceWindowUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MaintenanceWindowCreateBulk is the builder for creating many MaintenanceWindow entities in bulk.
+type MaintenanceWindowCreateBulk struct {
+	config
+	err      error
+	builders []*MaintenanceWindowCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MaintenanceWindow entities in the database.
+func (_c *MaintenanceWindowCreateBulk) Save(ctx context.Context) ([]*MaintenanceWindow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MaintenanceWindow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MaintenanceWindowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MaintenanceWindowCreateBulk) SaveX(ctx context.Context) []*MaintenanceWindow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MaintenanceWindowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MaintenanceWindowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MaintenanceWindow.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MaintenanceWindowUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MaintenanceWindowCreateBulk) OnConflict(opts ...sql.ConflictOption) *MaintenanceWindowUpsertBulk {
+	_c.conflict = opts
+	return &MaintenanceWindowUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MaintenanceWindowCreateBulk) OnConflictColumns(columns ...string) *MaintenanceWindowUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MaintenanceWindowUpsertBulk{
+		create: _c,
+	}
+}
+
+// MaintenanceWindowUpsertBulk is the builder for "upsert"-ing
+// a bulk of MaintenanceWindow nodes.
+type MaintenanceWindowUpsertBulk struct {
+	create *MaintenanceWindowCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(maintenancewindow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MaintenanceWindowUpsertBulk) UpdateNewValues() *MaintenanceWindowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(maintenancewindow.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(maintenancewindow.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(maintenancewindow.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MaintenanceWindowUpsertBulk) Ignore() *MaintenanceWindowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MaintenanceWindowUpsertBulk) DoNothing() *MaintenanceWindowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MaintenanceWindowCreateBulk.OnConflict
+// documentation for more info.
+func (u *MaintenanceWindowUpsertBulk) Update(set func(*MaintenanceWindowUpsert)) *MaintenanceWindowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MaintenanceWindowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *MaintenanceWindowUpsertBulk) SetCreateBy(v uint32) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *MaintenanceWindowUpsertBulk) AddCreateBy(v uint32) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateCreateBy() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *MaintenanceWindowUpsertBulk) ClearCreateBy() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *MaintenanceWindowUpsertBulk) SetUpdateBy(v uint32) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *MaintenanceWindowUpsertBulk) AddUpdateBy(v uint32) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateUpdateBy() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *MaintenanceWindowUpsertBulk) ClearUpdateBy() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *MaintenanceWindowUpsertBulk) SetUpdateTime(v time.Time) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateUpdateTime() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *MaintenanceWindowUpsertBulk) ClearUpdateTime() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *MaintenanceWindowUpsertBulk) SetDeleteTime(v time.Time) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateDeleteTime() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *MaintenanceWindowUpsertBulk) ClearDeleteTime() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MaintenanceWindowUpsertBulk) SetName(v string) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateName() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *MaintenanceWindowUpsertBulk) SetDescription(v string) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateDescription() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *MaintenanceWindowUpsertBulk) ClearDescription() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetClientPatterns sets the "client_patterns" field.
+func (u *MaintenanceWindowUpsertBulk) SetClientPatterns(v []string) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetClientPatterns(v)
+	})
+}
+
+// UpdateClientPatterns sets the "client_patterns" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateClientPatterns() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateClientPatterns()
+	})
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *MaintenanceWindowUpsertBulk) SetCronExpression(v string) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetCronExpression(v)
+	})
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateCronExpression() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateCronExpression()
+	})
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *MaintenanceWindowUpsertBulk) SetDurationMinutes(v int) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetDurationMinutes(v)
+	})
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *MaintenanceWindowUpsertBulk) AddDurationMinutes(v int) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.AddDurationMinutes(v)
+	})
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateDurationMinutes() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateDurationMinutes()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *MaintenanceWindowUpsertBulk) SetTimezone(v string) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateTimezone() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *MaintenanceWindowUpsertBulk) SetAction(v maintenancewindow.Action) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateAction() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *MaintenanceWindowUpsertBulk) SetEnabled(v bool) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateEnabled() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *MaintenanceWindowUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MaintenanceWindowCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MaintenanceWindowCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MaintenanceWindowUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
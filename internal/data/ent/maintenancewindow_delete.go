@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// MaintenanceWindowDelete is the builder for deleting a MaintenanceWindow entity.
+type MaintenanceWindowDelete struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowDelete builder.
+func (_d *MaintenanceWindowDelete) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MaintenanceWindowDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MaintenanceWindowDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MaintenanceWindowDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(maintenancewindow.Table, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MaintenanceWindowDeleteOne is the builder for deleting a single MaintenanceWindow entity.
+type MaintenanceWindowDeleteOne struct {
+	_d *MaintenanceWindowDelete
+}
+
+// Where appends a list predicates to the MaintenanceWindowDelete builder.
+func (_d *MaintenanceWindowDeleteOne) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MaintenanceWindowDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{maintenancewindow.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MaintenanceWindowDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
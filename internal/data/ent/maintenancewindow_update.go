@@ -0,0 +1,845 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// MaintenanceWindowUpdate is the builder for updating MaintenanceWindow entities.
+type MaintenanceWindowUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MaintenanceWindowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (_u *MaintenanceWindowUpdate) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *MaintenanceWindowUpdate) SetCreateBy(v uint32) *MaintenanceWindowUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableCreateBy(v *uint32) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *MaintenanceWindowUpdate) AddCreateBy(v int32) *MaintenanceWindowUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *MaintenanceWindowUpdate) ClearCreateBy() *MaintenanceWindowUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *MaintenanceWindowUpdate) SetUpdateBy(v uint32) *MaintenanceWindowUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableUpdateBy(v *uint32) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *MaintenanceWindowUpdate) AddUpdateBy(v int32) *MaintenanceWindowUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *MaintenanceWindowUpdate) ClearUpdateBy() *MaintenanceWindowUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MaintenanceWindowUpdate) SetUpdateTime(v time.Time) *MaintenanceWindowUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableUpdateTime(v *time.Time) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *MaintenanceWindowUpdate) ClearUpdateTime() *MaintenanceWindowUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *MaintenanceWindowUpdate) SetDeleteTime(v time.Time) *MaintenanceWindowUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableDeleteTime(v *time.Time) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *MaintenanceWindowUpdate) ClearDeleteTime() *MaintenanceWindowUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MaintenanceWindowUpdate) SetName(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableName(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MaintenanceWindowUpdate) SetDescription(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableDescription(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MaintenanceWindowUpdate) ClearDescription() *MaintenanceWindowUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetClientPatterns sets the "client_patterns" field.
+func (_u *MaintenanceWindowUpdate) SetClientPatterns(v []string) *MaintenanceWindowUpdate {
+	_u.mutation.SetClientPatterns(v)
+	return _u
+}
+
+// AppendClientPatterns appends value to the "client_patterns" field.
+func (_u *MaintenanceWindowUpdate) AppendClientPatterns(v []string) *MaintenanceWindowUpdate {
+	_u.mutation.AppendClientPatterns(v)
+	return _u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_u *MaintenanceWindowUpdate) SetCronExpression(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetCronExpression(v)
+	return _u
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableCronExpression(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetCronExpression(*v)
+	}
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *MaintenanceWindowUpdate) SetDurationMinutes(v int) *MaintenanceWindowUpdate {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableDurationMinutes(v *int) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *MaintenanceWindowUpdate) AddDurationMinutes(v int) *MaintenanceWindowUpdate {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *MaintenanceWindowUpdate) SetTimezone(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableTimezone(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *MaintenanceWindowUpdate) SetAction(v maintenancewindow.Action) *MaintenanceWindowUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableAction(v *maintenancewindow.Action) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *MaintenanceWindowUpdate) SetEnabled(v bool) *MaintenanceWindowUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableEnabled(v *bool) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (_u *MaintenanceWindowUpdate) Mutation() *MaintenanceWindowMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MaintenanceWindowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MaintenanceWindowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MaintenanceWindowUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := maintenancewindow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := maintenancewindow.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CronExpression(); ok {
+		if err := maintenancewindow.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.cron_expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMinutes(); ok {
+		if err := maintenancewindow.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.duration_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := maintenancewindow.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := maintenancewindow.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MaintenanceWindowUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MaintenanceWindowUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MaintenanceWindowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(maintenancewindow.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(maintenancewindow.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(maintenancewindow.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(maintenancewindow.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(maintenancewindow.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(maintenancewindow.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(maintenancewindow.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(maintenancewindow.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(maintenancewindow.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(maintenancewindow.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(maintenancewindow.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(maintenancewindow.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(maintenancewindow.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(maintenancewindow.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientPatterns(); ok {
+		_spec.SetField(maintenancewindow.FieldClientPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, maintenancewindow.FieldClientPatterns, value)
+		})
+	}
+	if value, ok := _u.mutation.CronExpression(); ok {
+		_spec.SetField(maintenancewindow.FieldCronExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(maintenancewindow.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(maintenancewindow.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(maintenancewindow.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(maintenancewindow.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(maintenancewindow.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MaintenanceWindowUpdateOne is the builder for updating a single MaintenanceWindow entity.
+type MaintenanceWindowUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MaintenanceWindowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *MaintenanceWindowUpdateOne) SetCreateBy(v uint32) *MaintenanceWindowUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableCreateBy(v *uint32) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *MaintenanceWindowUpdateOne) AddCreateBy(v int32) *MaintenanceWindowUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *MaintenanceWindowUpdateOne) ClearCreateBy() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *MaintenanceWindowUpdateOne) SetUpdateBy(v uint32) *MaintenanceWindowUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableUpdateBy(v *uint32) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *MaintenanceWindowUpdateOne) AddUpdateBy(v int32) *MaintenanceWindowUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *MaintenanceWindowUpdateOne) ClearUpdateBy() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MaintenanceWindowUpdateOne) SetUpdateTime(v time.Time) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableUpdateTime(v *time.Time) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *MaintenanceWindowUpdateOne) ClearUpdateTime() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *MaintenanceWindowUpdateOne) SetDeleteTime(v time.Time) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableDeleteTime(v *time.Time) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *MaintenanceWindowUpdateOne) ClearDeleteTime() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MaintenanceWindowUpdateOne) SetName(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableName(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MaintenanceWindowUpdateOne) SetDescription(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableDescription(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MaintenanceWindowUpdateOne) ClearDescription() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetClientPatterns sets the "client_patterns" field.
+func (_u *MaintenanceWindowUpdateOne) SetClientPatterns(v []string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetClientPatterns(v)
+	return _u
+}
+
+// AppendClientPatterns appends value to the "client_patterns" field.
+func (_u *MaintenanceWindowUpdateOne) AppendClientPatterns(v []string) *MaintenanceWindowUpdateOne {
+	_u.mutation.AppendClientPatterns(v)
+	return _u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_u *MaintenanceWindowUpdateOne) SetCronExpression(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetCronExpression(v)
+	return _u
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableCronExpression(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetCronExpression(*v)
+	}
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *MaintenanceWindowUpdateOne) SetDurationMinutes(v int) *MaintenanceWindowUpdateOne {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableDurationMinutes(v *int) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *MaintenanceWindowUpdateOne) AddDurationMinutes(v int) *MaintenanceWindowUpdateOne {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *MaintenanceWindowUpdateOne) SetTimezone(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableTimezone(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *MaintenanceWindowUpdateOne) SetAction(v maintenancewindow.Action) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableAction(v *maintenancewindow.Action) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *MaintenanceWindowUpdateOne) SetEnabled(v bool) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableEnabled(v *bool) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (_u *MaintenanceWindowUpdateOne) Mutation() *MaintenanceWindowMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (_u *MaintenanceWindowUpdateOne) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MaintenanceWindowUpdateOne) Select(field string, fields ...string) *MaintenanceWindowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MaintenanceWindow entity.
+func (_u *MaintenanceWindowUpdateOne) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdateOne) SaveX(ctx context.Context) *MaintenanceWindow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MaintenanceWindowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MaintenanceWindowUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := maintenancewindow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := maintenancewindow.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CronExpression(); ok {
+		if err := maintenancewindow.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.cron_expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMinutes(); ok {
+		if err := maintenancewindow.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.duration_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := maintenancewindow.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := maintenancewindow.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "MaintenanceWindow.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MaintenanceWindowUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MaintenanceWindowUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MaintenanceWindowUpdateOne) sqlSave(ctx context.Context) (_node *MaintenanceWindow, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MaintenanceWindow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancewindow.FieldID)
+		for _, f := range fields {
+			if !maintenancewindow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != maintenancewindow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(maintenancewindow.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(maintenancewindow.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(maintenancewindow.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(maintenancewindow.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(maintenancewindow.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(maintenancewindow.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(maintenancewindow.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(maintenancewindow.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(maintenancewindow.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(maintenancewindow.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(maintenancewindow.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(maintenancewindow.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(maintenancewindow.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(maintenancewindow.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(maintenancewindow.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientPatterns(); ok {
+		_spec.SetField(maintenancewindow.FieldClientPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, maintenancewindow.FieldClientPatterns, value)
+		})
+	}
+	if value, ok := _u.mutation.CronExpression(); ok {
+		_spec.SetField(maintenancewindow.FieldCronExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(maintenancewindow.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(maintenancewindow.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(maintenancewindow.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(maintenancewindow.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(maintenancewindow.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &MaintenanceWindow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
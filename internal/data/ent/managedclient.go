@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/managedclient"
+)
+
+// ManagedClient is the model entity for the ManagedClient schema.
+type ManagedClient struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// mTLS client CN
+	ClientID string `json:"client_id,omitempty"`
+	// Machine ID reported by the agent
+	MachineID string `json:"machine_id,omitempty"`
+	// Agent version
+	ClientVersion string `json:"client_version,omitempty"`
+	// Hostname reported by the agent
+	Hostname string `json:"hostname,omitempty"`
+	// Operating system, e.g. linux or windows
+	Os string `json:"os,omitempty"`
+	// Operating system version
+	OsVersion string `json:"os_version,omitempty"`
+	// Kernel version
+	KernelVersion string `json:"kernel_version,omitempty"`
+	// CPU architecture
+	Arch string `json:"arch,omitempty"`
+	// Number of CPUs
+	CPUCount int32 `json:"cpu_count,omitempty"`
+	// Total memory in bytes
+	MemoryBytes int64 `json:"memory_bytes,omitempty"`
+	// Script types the agent has an interpreter for, empty for agents that do not report them
+	ScriptTypes []string `json:"script_types,omitempty"`
+	// Operator notes about the client
+	Description string `json:"description,omitempty"`
+	// When the client connected for the first time
+	FirstSeenAt time.Time `json:"first_seen_at,omitempty"`
+	// When the client was last connected or sent a heartbeat
+	LastSeenAt   time.Time `json:"last_seen_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ManagedClient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case managedclient.FieldScriptTypes:
+			values[i] = new([]byte)
+		case managedclient.FieldUpdateBy, managedclient.FieldCPUCount, managedclient.FieldMemoryBytes:
+			values[i] = new(sql.NullInt64)
+		case managedclient.FieldID, managedclient.FieldClientID, managedclient.FieldMachineID, managedclient.FieldClientVersion, managedclient.FieldHostname, managedclient.FieldOs, managedclient.FieldOsVersion, managedclient.FieldKernelVersion, managedclient.FieldArch, managedclient.FieldDescription:
+			values[i] = new(sql.NullString)
+		case managedclient.FieldCreateTime, managedclient.FieldUpdateTime, managedclient.FieldDeleteTime, managedclient.FieldFirstSeenAt, managedclient.FieldLastSeenAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ManagedClient fields.
+func (_m *ManagedClient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case managedclient.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case managedclient.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case managedclient.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case managedclient.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case managedclient.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case managedclient.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case managedclient.FieldMachineID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value.Valid {
+				_m.MachineID = value.String
+			}
+		case managedclient.FieldClientVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_version", values[i])
+			} else if value.Valid {
+				_m.ClientVersion = value.String
+			}
+		case managedclient.FieldHostname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hostname", values[i])
+			} else if value.Valid {
+				_m.Hostname = value.String
+			}
+		case managedclient.FieldOs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os", values[i])
+			} else if value.Valid {
+				_m.Os = value.String
+			}
+		case managedclient.FieldOsVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_version", values[i])
+			} else if value.Valid {
+				_m.OsVersion = value.String
+			}
+		case managedclient.FieldKernelVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kernel_version", values[i])
+			} else if value.Valid {
+				_m.KernelVersion = value.String
+			}
+		case managedclient.FieldArch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arch", values[i])
+			} else if value.Valid {
+				_m.Arch = value.String
+			}
+		case managedclient.FieldCPUCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cpu_count", values[i])
+			} else if value.Valid {
+				_m.CPUCount = int32(value.Int64)
+			}
+		case managedclient.FieldMemoryBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_bytes", values[i])
+			} else if value.Valid {
+				_m.MemoryBytes = value.Int64
+			}
+		case managedclient.FieldScriptTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field script_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ScriptTypes); err != nil {
+					return fmt.Errorf("unmarshal field script_types: %w", err)
+				}
+			}
+		case managedclient.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case managedclient.FieldFirstSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen_at", values[i])
+			} else if value.Valid {
+				_m.FirstSeenAt = value.Time
+			}
+		case managedclient.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ManagedClient.
+// This includes values selected through modifiers, order, etc.
+func (_m *ManagedClient) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ManagedClient.
+// Note that you need to call ManagedClient.Unwrap() before calling this method if this ManagedClient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ManagedClient) Update() *ManagedClientUpdateOne {
+	return NewManagedClientClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ManagedClient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ManagedClient) Unwrap() *ManagedClient {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ManagedClient is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ManagedClient) String() string {
+	var builder strings.Builder
+	builder.WriteString("ManagedClient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("machine_id=")
+	builder.WriteString(_m.MachineID)
+	builder.WriteString(", ")
+	builder.WriteString("client_version=")
+	builder.WriteString(_m.ClientVersion)
+	builder.WriteString(", ")
+	builder.WriteString("hostname=")
+	builder.WriteString(_m.Hostname)
+	builder.WriteString(", ")
+	builder.WriteString("os=")
+	builder.WriteString(_m.Os)
+	builder.WriteString(", ")
+	builder.WriteString("os_version=")
+	builder.WriteString(_m.OsVersion)
+	builder.WriteString(", ")
+	builder.WriteString("kernel_version=")
+	builder.WriteString(_m.KernelVersion)
+	builder.WriteString(", ")
+	builder.WriteString("arch=")
+	builder.WriteString(_m.Arch)
+	builder.WriteString(", ")
+	builder.WriteString("cpu_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CPUCount))
+	builder.WriteString(", ")
+	builder.WriteString("memory_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MemoryBytes))
+	builder.WriteString(", ")
+	builder.WriteString("script_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScriptTypes))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("first_seen_at=")
+	builder.WriteString(_m.FirstSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ManagedClients is a parsable slice of ManagedClient.
+type ManagedClients []*ManagedClient
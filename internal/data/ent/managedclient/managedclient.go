@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package managedclient
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the managedclient type in the database.
+	Label = "managed_client"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdateBy holds the string denoting the update_by field in the database.
+	FieldUpdateBy = "update_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldMachineID holds the string denoting the machine_id field in the database.
+	FieldMachineID = "machine_id"
+	// FieldClientVersion holds the string denoting the client_version field in the database.
+	FieldClientVersion = "client_version"
+	// FieldHostname holds the string denoting the hostname field in the database.
+	FieldHostname = "hostname"
+	// FieldOs holds the string denoting the os field in the database.
+	FieldOs = "os"
+	// FieldOsVersion holds the string denoting the os_version field in the database.
+	FieldOsVersion = "os_version"
+	// FieldKernelVersion holds the string denoting the kernel_version field in the database.
+	FieldKernelVersion = "kernel_version"
+	// FieldArch holds the string denoting the arch field in the database.
+	FieldArch = "arch"
+	// FieldCPUCount holds the string denoting the cpu_count field in the database.
+	FieldCPUCount = "cpu_count"
+	// FieldMemoryBytes holds the string denoting the memory_bytes field in the database.
+	FieldMemoryBytes = "memory_bytes"
+	// FieldScriptTypes holds the string denoting the script_types field in the database.
+	FieldScriptTypes = "script_types"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldFirstSeenAt holds the string denoting the first_seen_at field in the database.
+	FieldFirstSeenAt = "first_seen_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// Table holds the table name of the managedclient in the database.
+	Table = "executor_clients"
+)
+
+// Columns holds all SQL columns for managedclient fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldClientID,
+	FieldMachineID,
+	FieldClientVersion,
+	FieldHostname,
+	FieldOs,
+	FieldOsVersion,
+	FieldKernelVersion,
+	FieldArch,
+	FieldCPUCount,
+	FieldMemoryBytes,
+	FieldScriptTypes,
+	FieldDescription,
+	FieldFirstSeenAt,
+	FieldLastSeenAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ClientIDValidator func(string) error
+	// MachineIDValidator is a validator for the "machine_id" field. It is called by the builders before save.
+	MachineIDValidator func(string) error
+	// ClientVersionValidator is a validator for the "client_version" field. It is called by the builders before save.
+	ClientVersionValidator func(string) error
+	// HostnameValidator is a validator for the "hostname" field. It is called by the builders before save.
+	HostnameValidator func(string) error
+	// OsValidator is a validator for the "os" field. It is called by the builders before save.
+	OsValidator func(string) error
+	// OsVersionValidator is a validator for the "os_version" field. It is called by the builders before save.
+	OsVersionValidator func(string) error
+	// KernelVersionValidator is a validator for the "kernel_version" field. It is called by the builders before save.
+	KernelVersionValidator func(string) error
+	// ArchValidator is a validator for the "arch" field. It is called by the builders before save.
+	ArchValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ManagedClient queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdateBy orders the results by the update_by field.
+func ByUpdateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByMachineID orders the results by the machine_id field.
+func ByMachineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachineID, opts...).ToFunc()
+}
+
+// ByClientVersion orders the results by the client_version field.
+func ByClientVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientVersion, opts...).ToFunc()
+}
+
+// ByHostname orders the results by the hostname field.
+func ByHostname(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostname, opts...).ToFunc()
+}
+
+// ByOs orders the results by the os field.
+func ByOs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOs, opts...).ToFunc()
+}
+
+// ByOsVersion orders the results by the os_version field.
+func ByOsVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOsVersion, opts...).ToFunc()
+}
+
+// ByKernelVersion orders the results by the kernel_version field.
+func ByKernelVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKernelVersion, opts...).ToFunc()
+}
+
+// ByArch orders the results by the arch field.
+func ByArch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArch, opts...).ToFunc()
+}
+
+// ByCPUCount orders the results by the cpu_count field.
+func ByCPUCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCPUCount, opts...).ToFunc()
+}
+
+// ByMemoryBytes orders the results by the memory_bytes field.
+func ByMemoryBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryBytes, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByFirstSeenAt orders the results by the first_seen_at field.
+func ByFirstSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeenAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
@@ -0,0 +1,1220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package managedclient
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldID, id))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldClientID, v))
+}
+
+// MachineID applies equality check predicate on the "machine_id" field. It's identical to MachineIDEQ.
+func MachineID(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldMachineID, v))
+}
+
+// ClientVersion applies equality check predicate on the "client_version" field. It's identical to ClientVersionEQ.
+func ClientVersion(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldClientVersion, v))
+}
+
+// Hostname applies equality check predicate on the "hostname" field. It's identical to HostnameEQ.
+func Hostname(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldHostname, v))
+}
+
+// Os applies equality check predicate on the "os" field. It's identical to OsEQ.
+func Os(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldOs, v))
+}
+
+// OsVersion applies equality check predicate on the "os_version" field. It's identical to OsVersionEQ.
+func OsVersion(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldOsVersion, v))
+}
+
+// KernelVersion applies equality check predicate on the "kernel_version" field. It's identical to KernelVersionEQ.
+func KernelVersion(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldKernelVersion, v))
+}
+
+// Arch applies equality check predicate on the "arch" field. It's identical to ArchEQ.
+func Arch(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldArch, v))
+}
+
+// CPUCount applies equality check predicate on the "cpu_count" field. It's identical to CPUCountEQ.
+func CPUCount(v int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldCPUCount, v))
+}
+
+// MemoryBytes applies equality check predicate on the "memory_bytes" field. It's identical to MemoryBytesEQ.
+func MemoryBytes(v int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldMemoryBytes, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldDescription, v))
+}
+
+// FirstSeenAt applies equality check predicate on the "first_seen_at" field. It's identical to FirstSeenAtEQ.
+func FirstSeenAt(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// MachineIDEQ applies the EQ predicate on the "machine_id" field.
+func MachineIDEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldMachineID, v))
+}
+
+// MachineIDNEQ applies the NEQ predicate on the "machine_id" field.
+func MachineIDNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldMachineID, v))
+}
+
+// MachineIDIn applies the In predicate on the "machine_id" field.
+func MachineIDIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldMachineID, vs...))
+}
+
+// MachineIDNotIn applies the NotIn predicate on the "machine_id" field.
+func MachineIDNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldMachineID, vs...))
+}
+
+// MachineIDGT applies the GT predicate on the "machine_id" field.
+func MachineIDGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldMachineID, v))
+}
+
+// MachineIDGTE applies the GTE predicate on the "machine_id" field.
+func MachineIDGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldMachineID, v))
+}
+
+// MachineIDLT applies the LT predicate on the "machine_id" field.
+func MachineIDLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldMachineID, v))
+}
+
+// MachineIDLTE applies the LTE predicate on the "machine_id" field.
+func MachineIDLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldMachineID, v))
+}
+
+// MachineIDContains applies the Contains predicate on the "machine_id" field.
+func MachineIDContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldMachineID, v))
+}
+
+// MachineIDHasPrefix applies the HasPrefix predicate on the "machine_id" field.
+func MachineIDHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldMachineID, v))
+}
+
+// MachineIDHasSuffix applies the HasSuffix predicate on the "machine_id" field.
+func MachineIDHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldMachineID, v))
+}
+
+// MachineIDIsNil applies the IsNil predicate on the "machine_id" field.
+func MachineIDIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldMachineID))
+}
+
+// MachineIDNotNil applies the NotNil predicate on the "machine_id" field.
+func MachineIDNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldMachineID))
+}
+
+// MachineIDEqualFold applies the EqualFold predicate on the "machine_id" field.
+func MachineIDEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldMachineID, v))
+}
+
+// MachineIDContainsFold applies the ContainsFold predicate on the "machine_id" field.
+func MachineIDContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldMachineID, v))
+}
+
+// ClientVersionEQ applies the EQ predicate on the "client_version" field.
+func ClientVersionEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldClientVersion, v))
+}
+
+// ClientVersionNEQ applies the NEQ predicate on the "client_version" field.
+func ClientVersionNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldClientVersion, v))
+}
+
+// ClientVersionIn applies the In predicate on the "client_version" field.
+func ClientVersionIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldClientVersion, vs...))
+}
+
+// ClientVersionNotIn applies the NotIn predicate on the "client_version" field.
+func ClientVersionNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldClientVersion, vs...))
+}
+
+// ClientVersionGT applies the GT predicate on the "client_version" field.
+func ClientVersionGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldClientVersion, v))
+}
+
+// ClientVersionGTE applies the GTE predicate on the "client_version" field.
+func ClientVersionGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldClientVersion, v))
+}
+
+// ClientVersionLT applies the LT predicate on the "client_version" field.
+func ClientVersionLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldClientVersion, v))
+}
+
+// ClientVersionLTE applies the LTE predicate on the "client_version" field.
+func ClientVersionLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldClientVersion, v))
+}
+
+// ClientVersionContains applies the Contains predicate on the "client_version" field.
+func ClientVersionContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldClientVersion, v))
+}
+
+// ClientVersionHasPrefix applies the HasPrefix predicate on the "client_version" field.
+func ClientVersionHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldClientVersion, v))
+}
+
+// ClientVersionHasSuffix applies the HasSuffix predicate on the "client_version" field.
+func ClientVersionHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldClientVersion, v))
+}
+
+// ClientVersionIsNil applies the IsNil predicate on the "client_version" field.
+func ClientVersionIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldClientVersion))
+}
+
+// ClientVersionNotNil applies the NotNil predicate on the "client_version" field.
+func ClientVersionNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldClientVersion))
+}
+
+// ClientVersionEqualFold applies the EqualFold predicate on the "client_version" field.
+func ClientVersionEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldClientVersion, v))
+}
+
+// ClientVersionContainsFold applies the ContainsFold predicate on the "client_version" field.
+func ClientVersionContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldClientVersion, v))
+}
+
+// HostnameEQ applies the EQ predicate on the "hostname" field.
+func HostnameEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldHostname, v))
+}
+
+// HostnameNEQ applies the NEQ predicate on the "hostname" field.
+func HostnameNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldHostname, v))
+}
+
+// HostnameIn applies the In predicate on the "hostname" field.
+func HostnameIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldHostname, vs...))
+}
+
+// HostnameNotIn applies the NotIn predicate on the "hostname" field.
+func HostnameNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldHostname, vs...))
+}
+
+// HostnameGT applies the GT predicate on the "hostname" field.
+func HostnameGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldHostname, v))
+}
+
+// HostnameGTE applies the GTE predicate on the "hostname" field.
+func HostnameGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldHostname, v))
+}
+
+// HostnameLT applies the LT predicate on the "hostname" field.
+func HostnameLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldHostname, v))
+}
+
+// HostnameLTE applies the LTE predicate on the "hostname" field.
+func HostnameLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldHostname, v))
+}
+
+// HostnameContains applies the Contains predicate on the "hostname" field.
+func HostnameContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldHostname, v))
+}
+
+// HostnameHasPrefix applies the HasPrefix predicate on the "hostname" field.
+func HostnameHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldHostname, v))
+}
+
+// HostnameHasSuffix applies the HasSuffix predicate on the "hostname" field.
+func HostnameHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldHostname, v))
+}
+
+// HostnameIsNil applies the IsNil predicate on the "hostname" field.
+func HostnameIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldHostname))
+}
+
+// HostnameNotNil applies the NotNil predicate on the "hostname" field.
+func HostnameNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldHostname))
+}
+
+// HostnameEqualFold applies the EqualFold predicate on the "hostname" field.
+func HostnameEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldHostname, v))
+}
+
+// HostnameContainsFold applies the ContainsFold predicate on the "hostname" field.
+func HostnameContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldHostname, v))
+}
+
+// OsEQ applies the EQ predicate on the "os" field.
+func OsEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldOs, v))
+}
+
+// OsNEQ applies the NEQ predicate on the "os" field.
+func OsNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldOs, v))
+}
+
+// OsIn applies the In predicate on the "os" field.
+func OsIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldOs, vs...))
+}
+
+// OsNotIn applies the NotIn predicate on the "os" field.
+func OsNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldOs, vs...))
+}
+
+// OsGT applies the GT predicate on the "os" field.
+func OsGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldOs, v))
+}
+
+// OsGTE applies the GTE predicate on the "os" field.
+func OsGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldOs, v))
+}
+
+// OsLT applies the LT predicate on the "os" field.
+func OsLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldOs, v))
+}
+
+// OsLTE applies the LTE predicate on the "os" field.
+func OsLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldOs, v))
+}
+
+// OsContains applies the Contains predicate on the "os" field.
+func OsContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldOs, v))
+}
+
+// OsHasPrefix applies the HasPrefix predicate on the "os" field.
+func OsHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldOs, v))
+}
+
+// OsHasSuffix applies the HasSuffix predicate on the "os" field.
+func OsHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldOs, v))
+}
+
+// OsIsNil applies the IsNil predicate on the "os" field.
+func OsIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldOs))
+}
+
+// OsNotNil applies the NotNil predicate on the "os" field.
+func OsNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldOs))
+}
+
+// OsEqualFold applies the EqualFold predicate on the "os" field.
+func OsEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldOs, v))
+}
+
+// OsContainsFold applies the ContainsFold predicate on the "os" field.
+func OsContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldOs, v))
+}
+
+// OsVersionEQ applies the EQ predicate on the "os_version" field.
+func OsVersionEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldOsVersion, v))
+}
+
+// OsVersionNEQ applies the NEQ predicate on the "os_version" field.
+func OsVersionNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldOsVersion, v))
+}
+
+// OsVersionIn applies the In predicate on the "os_version" field.
+func OsVersionIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldOsVersion, vs...))
+}
+
+// OsVersionNotIn applies the NotIn predicate on the "os_version" field.
+func OsVersionNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldOsVersion, vs...))
+}
+
+// OsVersionGT applies the GT predicate on the "os_version" field.
+func OsVersionGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldOsVersion, v))
+}
+
+// OsVersionGTE applies the GTE predicate on the "os_version" field.
+func OsVersionGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldOsVersion, v))
+}
+
+// OsVersionLT applies the LT predicate on the "os_version" field.
+func OsVersionLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldOsVersion, v))
+}
+
+// OsVersionLTE applies the LTE predicate on the "os_version" field.
+func OsVersionLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldOsVersion, v))
+}
+
+// OsVersionContains applies the Contains predicate on the "os_version" field.
+func OsVersionContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldOsVersion, v))
+}
+
+// OsVersionHasPrefix applies the HasPrefix predicate on the "os_version" field.
+func OsVersionHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldOsVersion, v))
+}
+
+// OsVersionHasSuffix applies the HasSuffix predicate on the "os_version" field.
+func OsVersionHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldOsVersion, v))
+}
+
+// OsVersionIsNil applies the IsNil predicate on the "os_version" field.
+func OsVersionIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldOsVersion))
+}
+
+// OsVersionNotNil applies the NotNil predicate on the "os_version" field.
+func OsVersionNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldOsVersion))
+}
+
+// OsVersionEqualFold applies the EqualFold predicate on the "os_version" field.
+func OsVersionEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldOsVersion, v))
+}
+
+// OsVersionContainsFold applies the ContainsFold predicate on the "os_version" field.
+func OsVersionContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldOsVersion, v))
+}
+
+// KernelVersionEQ applies the EQ predicate on the "kernel_version" field.
+func KernelVersionEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldKernelVersion, v))
+}
+
+// KernelVersionNEQ applies the NEQ predicate on the "kernel_version" field.
+func KernelVersionNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldKernelVersion, v))
+}
+
+// KernelVersionIn applies the In predicate on the "kernel_version" field.
+func KernelVersionIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldKernelVersion, vs...))
+}
+
+// KernelVersionNotIn applies the NotIn predicate on the "kernel_version" field.
+func KernelVersionNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldKernelVersion, vs...))
+}
+
+// KernelVersionGT applies the GT predicate on the "kernel_version" field.
+func KernelVersionGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldKernelVersion, v))
+}
+
+// KernelVersionGTE applies the GTE predicate on the "kernel_version" field.
+func KernelVersionGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldKernelVersion, v))
+}
+
+// KernelVersionLT applies the LT predicate on the "kernel_version" field.
+func KernelVersionLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldKernelVersion, v))
+}
+
+// KernelVersionLTE applies the LTE predicate on the "kernel_version" field.
+func KernelVersionLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldKernelVersion, v))
+}
+
+// KernelVersionContains applies the Contains predicate on the "kernel_version" field.
+func KernelVersionContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldKernelVersion, v))
+}
+
+// KernelVersionHasPrefix applies the HasPrefix predicate on the "kernel_version" field.
+func KernelVersionHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldKernelVersion, v))
+}
+
+// KernelVersionHasSuffix applies the HasSuffix predicate on the "kernel_version" field.
+func KernelVersionHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldKernelVersion, v))
+}
+
+// KernelVersionIsNil applies the IsNil predicate on the "kernel_version" field.
+func KernelVersionIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldKernelVersion))
+}
+
+// KernelVersionNotNil applies the NotNil predicate on the "kernel_version" field.
+func KernelVersionNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldKernelVersion))
+}
+
+// KernelVersionEqualFold applies the EqualFold predicate on the "kernel_version" field.
+func KernelVersionEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldKernelVersion, v))
+}
+
+// KernelVersionContainsFold applies the ContainsFold predicate on the "kernel_version" field.
+func KernelVersionContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldKernelVersion, v))
+}
+
+// ArchEQ applies the EQ predicate on the "arch" field.
+func ArchEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldArch, v))
+}
+
+// ArchNEQ applies the NEQ predicate on the "arch" field.
+func ArchNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldArch, v))
+}
+
+// ArchIn applies the In predicate on the "arch" field.
+func ArchIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldArch, vs...))
+}
+
+// ArchNotIn applies the NotIn predicate on the "arch" field.
+func ArchNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldArch, vs...))
+}
+
+// ArchGT applies the GT predicate on the "arch" field.
+func ArchGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldArch, v))
+}
+
+// ArchGTE applies the GTE predicate on the "arch" field.
+func ArchGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldArch, v))
+}
+
+// ArchLT applies the LT predicate on the "arch" field.
+func ArchLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldArch, v))
+}
+
+// ArchLTE applies the LTE predicate on the "arch" field.
+func ArchLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldArch, v))
+}
+
+// ArchContains applies the Contains predicate on the "arch" field.
+func ArchContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldArch, v))
+}
+
+// ArchHasPrefix applies the HasPrefix predicate on the "arch" field.
+func ArchHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldArch, v))
+}
+
+// ArchHasSuffix applies the HasSuffix predicate on the "arch" field.
+func ArchHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldArch, v))
+}
+
+// ArchIsNil applies the IsNil predicate on the "arch" field.
+func ArchIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldArch))
+}
+
+// ArchNotNil applies the NotNil predicate on the "arch" field.
+func ArchNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldArch))
+}
+
+// ArchEqualFold applies the EqualFold predicate on the "arch" field.
+func ArchEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldArch, v))
+}
+
+// ArchContainsFold applies the ContainsFold predicate on the "arch" field.
+func ArchContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldArch, v))
+}
+
+// CPUCountEQ applies the EQ predicate on the "cpu_count" field.
+func CPUCountEQ(v int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldCPUCount, v))
+}
+
+// CPUCountNEQ applies the NEQ predicate on the "cpu_count" field.
+func CPUCountNEQ(v int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldCPUCount, v))
+}
+
+// CPUCountIn applies the In predicate on the "cpu_count" field.
+func CPUCountIn(vs ...int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldCPUCount, vs...))
+}
+
+// CPUCountNotIn applies the NotIn predicate on the "cpu_count" field.
+func CPUCountNotIn(vs ...int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldCPUCount, vs...))
+}
+
+// CPUCountGT applies the GT predicate on the "cpu_count" field.
+func CPUCountGT(v int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldCPUCount, v))
+}
+
+// CPUCountGTE applies the GTE predicate on the "cpu_count" field.
+func CPUCountGTE(v int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldCPUCount, v))
+}
+
+// CPUCountLT applies the LT predicate on the "cpu_count" field.
+func CPUCountLT(v int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldCPUCount, v))
+}
+
+// CPUCountLTE applies the LTE predicate on the "cpu_count" field.
+func CPUCountLTE(v int32) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldCPUCount, v))
+}
+
+// CPUCountIsNil applies the IsNil predicate on the "cpu_count" field.
+func CPUCountIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldCPUCount))
+}
+
+// CPUCountNotNil applies the NotNil predicate on the "cpu_count" field.
+func CPUCountNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldCPUCount))
+}
+
+// MemoryBytesEQ applies the EQ predicate on the "memory_bytes" field.
+func MemoryBytesEQ(v int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldMemoryBytes, v))
+}
+
+// MemoryBytesNEQ applies the NEQ predicate on the "memory_bytes" field.
+func MemoryBytesNEQ(v int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldMemoryBytes, v))
+}
+
+// MemoryBytesIn applies the In predicate on the "memory_bytes" field.
+func MemoryBytesIn(vs ...int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldMemoryBytes, vs...))
+}
+
+// MemoryBytesNotIn applies the NotIn predicate on the "memory_bytes" field.
+func MemoryBytesNotIn(vs ...int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldMemoryBytes, vs...))
+}
+
+// MemoryBytesGT applies the GT predicate on the "memory_bytes" field.
+func MemoryBytesGT(v int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldMemoryBytes, v))
+}
+
+// MemoryBytesGTE applies the GTE predicate on the "memory_bytes" field.
+func MemoryBytesGTE(v int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldMemoryBytes, v))
+}
+
+// MemoryBytesLT applies the LT predicate on the "memory_bytes" field.
+func MemoryBytesLT(v int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldMemoryBytes, v))
+}
+
+// MemoryBytesLTE applies the LTE predicate on the "memory_bytes" field.
+func MemoryBytesLTE(v int64) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldMemoryBytes, v))
+}
+
+// MemoryBytesIsNil applies the IsNil predicate on the "memory_bytes" field.
+func MemoryBytesIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldMemoryBytes))
+}
+
+// MemoryBytesNotNil applies the NotNil predicate on the "memory_bytes" field.
+func MemoryBytesNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldMemoryBytes))
+}
+
+// ScriptTypesIsNil applies the IsNil predicate on the "script_types" field.
+func ScriptTypesIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldScriptTypes))
+}
+
+// ScriptTypesNotNil applies the NotNil predicate on the "script_types" field.
+func ScriptTypesNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldScriptTypes))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// FirstSeenAtEQ applies the EQ predicate on the "first_seen_at" field.
+func FirstSeenAtEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtNEQ applies the NEQ predicate on the "first_seen_at" field.
+func FirstSeenAtNEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtIn applies the In predicate on the "first_seen_at" field.
+func FirstSeenAtIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtNotIn applies the NotIn predicate on the "first_seen_at" field.
+func FirstSeenAtNotIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtGT applies the GT predicate on the "first_seen_at" field.
+func FirstSeenAtGT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtGTE applies the GTE predicate on the "first_seen_at" field.
+func FirstSeenAtGTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLT applies the LT predicate on the "first_seen_at" field.
+func FirstSeenAtLT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLTE applies the LTE predicate on the "first_seen_at" field.
+func FirstSeenAtLTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldFirstSeenAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ManagedClient) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ManagedClient) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ManagedClient) predicate.ManagedClient {
+	return predicate.ManagedClient(sql.NotPredicates(p))
+}
@@ -0,0 +1,1814 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/managedclient"
+)
+
+// ManagedClientCreate is the builder for creating a ManagedClient entity.
+type ManagedClientCreate struct {
+	config
+	mutation *ManagedClientMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *ManagedClientCreate) SetUpdateBy(v uint32) *ManagedClientCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableUpdateBy(v *uint32) *ManagedClientCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ManagedClientCreate) SetCreateTime(v time.Time) *ManagedClientCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableCreateTime(v *time.Time) *ManagedClientCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ManagedClientCreate) SetUpdateTime(v time.Time) *ManagedClientCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableUpdateTime(v *time.Time) *ManagedClientCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ManagedClientCreate) SetDeleteTime(v time.Time) *ManagedClientCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableDeleteTime(v *time.Time) *ManagedClientCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *ManagedClientCreate) SetClientID(v string) *ManagedClientCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *ManagedClientCreate) SetMachineID(v string) *ManagedClientCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableMachineID(v *string) *ManagedClientCreate {
+	if v != nil {
+		_c.SetMachineID(*v)
+	}
+	return _c
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_c *ManagedClientCreate) SetClientVersion(v string) *ManagedClientCreate {
+	_c.mutation.SetClientVersion(v)
+	return _c
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableClientVersion(v *string) *ManagedClientCreate {
+	if v != nil {
+		_c.SetClientVersion(*v)
+	}
+	return _c
+}
+
+// SetHostname sets the "hostname" field.
+func (_c *ManagedClientCreate) SetHostname(v string) *ManagedClientCreate {
+	_c.mutation.SetHostname(v)
+	return _c
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableHostname(v *string) *ManagedClientCreate {
+	if v != nil {
+		_c.SetHostname(*v)
+	}
+	return _c
+}
+
+// SetOs sets the "os" field.
+func (_c *ManagedClientCreate) SetOs(v string) *ManagedClientCreate {
+	_c.mutation.SetOs(v)
+	return _c
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableOs(v *string) *ManagedClientCreate {
+	if v != nil {
+		_c.SetOs(*v)
+	}
+	return _c
+}
+
+// SetOsVersion sets the "os_version" field.
+func (_c *ManagedClientCreate) SetOsVersion(v string) *ManagedClientCreate {
+	_c.mutation.SetOsVersion(v)
+	return _c
+}
+
+// SetNillableOsVersion sets the "os_version" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableOsVersion(v *string) *ManagedClientCreate {
+	if v != nil {
+		_c.SetOsVersion(*v)
+	}
+	return _c
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_c *ManagedClientCreate) SetKernelVersion(v string) *ManagedClientCreate {
+	_c.mutation.SetKernelVersion(v)
+	return _c
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableKernelVersion(v *string) *ManagedClientCreate {
+	if v != nil {
+		_c.SetKernelVersion(*v)
+	}
+	return _c
+}
+
+// SetArch sets the "arch" field.
+func (_c *ManagedClientCreate) SetArch(v string) *ManagedClientCreate {
+	_c.mutation.SetArch(v)
+	return _c
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableArch(v *string) *ManagedClientCreate {
+	if v != nil {
+		_c.SetArch(*v)
+	}
+	return _c
+}
+
+// SetCPUCount sets the "cpu_count" field.
+func (_c *ManagedClientCreate) SetCPUCount(v int32) *ManagedClientCreate {
+	_c.mutation.SetCPUCount(v)
+	return _c
+}
+
+// SetNillableCPUCount sets the "cpu_count" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableCPUCount(v *int32) *ManagedClientCreate {
+	if v != nil {
+		_c.SetCPUCount(*v)
+	}
+	return _c
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (_c *ManagedClientCreate) SetMemoryBytes(v int64) *ManagedClientCreate {
+	_c.mutation.SetMemoryBytes(v)
+	return _c
+}
+
+// SetNillableMemoryBytes sets the "memory_bytes" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableMemoryBytes(v *int64) *ManagedClientCreate {
+	if v != nil {
+		_c.SetMemoryBytes(*v)
+	}
+	return _c
+}
+
+// SetScriptTypes sets the "script_types" field.
+func (_c *ManagedClientCreate) SetScriptTypes(v []string) *ManagedClientCreate {
+	_c.mutation.SetScriptTypes(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ManagedClientCreate) SetDescription(v string) *ManagedClientCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ManagedClientCreate) SetNillableDescription(v *string) *ManagedClientCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_c *ManagedClientCreate) SetFirstSeenAt(v time.Time) *ManagedClientCreate {
+	_c.mutation.SetFirstSeenAt(v)
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *ManagedClientCreate) SetLastSeenAt(v time.Time) *ManagedClientCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ManagedClientCreate) SetID(v string) *ManagedClientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ManagedClientMutation object of the builder.
+func (_c *ManagedClientCreate) Mutation() *ManagedClientMutation {
+	return _c.mutation
+}
+
+// Save creates the ManagedClient in the database.
+func (_c *ManagedClientCreate) Save(ctx context.Context) (*ManagedClient, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ManagedClientCreate) SaveX(ctx context.Context) *ManagedClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ManagedClientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ManagedClientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ManagedClientCreate) check() error {
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "ManagedClient.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := managedclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MachineID(); ok {
+		if err := managedclient.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.machine_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientVersion(); ok {
+		if err := managedclient.ClientVersionValidator(v); err != nil {
+			return &ValidationError{Name: "client_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.client_version": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Hostname(); ok {
+		if err := managedclient.HostnameValidator(v); err != nil {
+			return &ValidationError{Name: "hostname", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.hostname": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Os(); ok {
+		if err := managedclient.OsValidator(v); err != nil {
+			return &ValidationError{Name: "os", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.os": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OsVersion(); ok {
+		if err := managedclient.OsVersionValidator(v); err != nil {
+			return &ValidationError{Name: "os_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.os_version": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.KernelVersion(); ok {
+		if err := managedclient.KernelVersionValidator(v); err != nil {
+			return &ValidationError{Name: "kernel_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.kernel_version": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Arch(); ok {
+		if err := managedclient.ArchValidator(v); err != nil {
+			return &ValidationError{Name: "arch", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.arch": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := managedclient.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FirstSeenAt(); !ok {
+		return &ValidationError{Name: "first_seen_at", err: errors.New(`ent: missing required field "ManagedClient.first_seen_at"`)}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "ManagedClient.last_seen_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := managedclient.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ManagedClientCreate) sqlSave(ctx context.Context) (*ManagedClient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ManagedClient.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ManagedClientCreate) createSpec() (*ManagedClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ManagedClient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(managedclient.Table, sqlgraph.NewFieldSpec(managedclient.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(managedclient.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(managedclient.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(managedclient.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(managedclient.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(managedclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.MachineID(); ok {
+		_spec.SetField(managedclient.FieldMachineID, field.TypeString, value)
+		_node.MachineID = value
+	}
+	if value, ok := _c.mutation.ClientVersion(); ok {
+		_spec.SetField(managedclient.FieldClientVersion, field.TypeString, value)
+		_node.ClientVersion = value
+	}
+	if value, ok := _c.mutation.Hostname(); ok {
+		_spec.SetField(managedclient.FieldHostname, field.TypeString, value)
+		_node.Hostname = value
+	}
+	if value, ok := _c.mutation.Os(); ok {
+		_spec.SetField(managedclient.FieldOs, field.TypeString, value)
+		_node.Os = value
+	}
+	if value, ok := _c.mutation.OsVersion(); ok {
+		_spec.SetField(managedclient.FieldOsVersion, field.TypeString, value)
+		_node.OsVersion = value
+	}
+	if value, ok := _c.mutation.KernelVersion(); ok {
+		_spec.SetField(managedclient.FieldKernelVersion, field.TypeString, value)
+		_node.KernelVersion = value
+	}
+	if value, ok := _c.mutation.Arch(); ok {
+		_spec.SetField(managedclient.FieldArch, field.TypeString, value)
+		_node.Arch = value
+	}
+	if value, ok := _c.mutation.CPUCount(); ok {
+		_spec.SetField(managedclient.FieldCPUCount, field.TypeInt32, value)
+		_node.CPUCount = value
+	}
+	if value, ok := _c.mutation.MemoryBytes(); ok {
+		_spec.SetField(managedclient.FieldMemoryBytes, field.TypeInt64, value)
+		_node.MemoryBytes = value
+	}
+	if value, ok := _c.mutation.ScriptTypes(); ok {
+		_spec.SetField(managedclient.FieldScriptTypes, field.TypeJSON, value)
+		_node.ScriptTypes = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(managedclient.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.FirstSeenAt(); ok {
+		_spec.SetField(managedclient.FieldFirstSeenAt, field.TypeTime, value)
+		_node.FirstSeenAt = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(managedclient.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ManagedClient.Create().
+//		SetUpdateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ManagedClientUpsert) {
+//			SetUpdateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ManagedClientCreate) OnConflict(opts ...sql.ConflictOption) *ManagedClientUpsertOne {
+	_c.conflict = opts
+	return &ManagedClientUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ManagedClient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ManagedClientCreate) OnConflictColumns(columns ...string) *ManagedClientUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ManagedClientUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ManagedClientUpsertOne is the builder for "upsert"-ing
+	//  one ManagedClient node.
+	ManagedClientUpsertOne struct {
+		create *ManagedClientCreate
+	}
+
+	// ManagedClientUpsert is the "OnConflict" setter.
+	ManagedClientUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ManagedClientUpsert) SetUpdateBy(v uint32) *ManagedClientUpsert {
+	u.Set(managedclient.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateUpdateBy() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ManagedClientUpsert) AddUpdateBy(v uint32) *ManagedClientUpsert {
+	u.Add(managedclient.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ManagedClientUpsert) ClearUpdateBy() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ManagedClientUpsert) SetUpdateTime(v time.Time) *ManagedClientUpsert {
+	u.Set(managedclient.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateUpdateTime() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ManagedClientUpsert) ClearUpdateTime() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ManagedClientUpsert) SetDeleteTime(v time.Time) *ManagedClientUpsert {
+	u.Set(managedclient.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateDeleteTime() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ManagedClientUpsert) ClearDeleteTime() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldDeleteTime)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ManagedClientUpsert) SetClientID(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateClientID() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldClientID)
+	return u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (u *ManagedClientUpsert) SetMachineID(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldMachineID, v)
+	return u
+}
+
+// UpdateMachineID sets the "machine_id" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateMachineID() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldMachineID)
+	return u
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (u *ManagedClientUpsert) ClearMachineID() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldMachineID)
+	return u
+}
+
+// SetClientVersion sets the "client_version" field.
+func (u *ManagedClientUpsert) SetClientVersion(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldClientVersion, v)
+	return u
+}
+
+// UpdateClientVersion sets the "client_version" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateClientVersion() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldClientVersion)
+	return u
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (u *ManagedClientUpsert) ClearClientVersion() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldClientVersion)
+	return u
+}
+
+// SetHostname sets the "hostname" field.
+func (u *ManagedClientUpsert) SetHostname(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldHostname, v)
+	return u
+}
+
+// UpdateHostname sets the "hostname" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateHostname() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldHostname)
+	return u
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (u *ManagedClientUpsert) ClearHostname() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldHostname)
+	return u
+}
+
+// SetOs sets the "os" field.
+func (u *ManagedClientUpsert) SetOs(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldOs, v)
+	return u
+}
+
+// UpdateOs sets the "os" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateOs() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldOs)
+	return u
+}
+
+// ClearOs clears the value of the "os" field.
+func (u *ManagedClientUpsert) ClearOs() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldOs)
+	return u
+}
+
+// SetOsVersion sets the "os_version" field.
+func (u *ManagedClientUpsert) SetOsVersion(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldOsVersion, v)
+	return u
+}
+
+// UpdateOsVersion sets the "os_version" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateOsVersion() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldOsVersion)
+	return u
+}
+
+// ClearOsVersion clears the value of the "os_version" field.
+func (u *ManagedClientUpsert) ClearOsVersion() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldOsVersion)
+	return u
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (u *ManagedClientUpsert) SetKernelVersion(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldKernelVersion, v)
+	return u
+}
+
+// UpdateKernelVersion sets the "kernel_version" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateKernelVersion() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldKernelVersion)
+	return u
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (u *ManagedClientUpsert) ClearKernelVersion() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldKernelVersion)
+	return u
+}
+
+// SetArch sets the "arch" field.
+func (u *ManagedClientUpsert) SetArch(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldArch, v)
+	return u
+}
+
+// UpdateArch sets the "arch" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateArch() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldArch)
+	return u
+}
+
+// ClearArch clears the value of the "arch" field.
+func (u *ManagedClientUpsert) ClearArch() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldArch)
+	return u
+}
+
+// SetCPUCount sets the "cpu_count" field.
+func (u *ManagedClientUpsert) SetCPUCount(v int32) *ManagedClientUpsert {
+	u.Set(managedclient.FieldCPUCount, v)
+	return u
+}
+
+// UpdateCPUCount sets the "cpu_count" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateCPUCount() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldCPUCount)
+	return u
+}
+
+// AddCPUCount adds v to the "cpu_count" field.
+func (u *ManagedClientUpsert) AddCPUCount(v int32) *ManagedClientUpsert {
+	u.Add(managedclient.FieldCPUCount, v)
+	return u
+}
+
+// ClearCPUCount clears the value of the "cpu_count" field.
+func (u *ManagedClientUpsert) ClearCPUCount() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldCPUCount)
+	return u
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (u *ManagedClientUpsert) SetMemoryBytes(v int64) *ManagedClientUpsert {
+	u.Set(managedclient.FieldMemoryBytes, v)
+	return u
+}
+
+// UpdateMemoryBytes sets the "memory_bytes" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateMemoryBytes() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldMemoryBytes)
+	return u
+}
+
+// AddMemoryBytes adds v to the "memory_bytes" field.
+func (u *ManagedClientUpsert) AddMemoryBytes(v int64) *ManagedClientUpsert {
+	u.Add(managedclient.FieldMemoryBytes, v)
+	return u
+}
+
+// ClearMemoryBytes clears the value of the "memory_bytes" field.
+func (u *ManagedClientUpsert) ClearMemoryBytes() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldMemoryBytes)
+	return u
+}
+
+// SetScriptTypes sets the "script_types" field.
+func (u *ManagedClientUpsert) SetScriptTypes(v []string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldScriptTypes, v)
+	return u
+}
+
+// UpdateScriptTypes sets the "script_types" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateScriptTypes() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldScriptTypes)
+	return u
+}
+
+// ClearScriptTypes clears the value of the "script_types" field.
+func (u *ManagedClientUpsert) ClearScriptTypes() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldScriptTypes)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ManagedClientUpsert) SetDescription(v string) *ManagedClientUpsert {
+	u.Set(managedclient.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateDescription() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ManagedClientUpsert) ClearDescription() *ManagedClientUpsert {
+	u.SetNull(managedclient.FieldDescription)
+	return u
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (u *ManagedClientUpsert) SetFirstSeenAt(v time.Time) *ManagedClientUpsert {
+	u.Set(managedclient.FieldFirstSeenAt, v)
+	return u
+}
+
+// UpdateFirstSeenAt sets the "first_seen_at" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateFirstSeenAt() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldFirstSeenAt)
+	return u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *ManagedClientUpsert) SetLastSeenAt(v time.Time) *ManagedClientUpsert {
+	u.Set(managedclient.FieldLastSeenAt, v)
+	return u
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *ManagedClientUpsert) UpdateLastSeenAt() *ManagedClientUpsert {
+	u.SetExcluded(managedclient.FieldLastSeenAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ManagedClient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(managedclient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ManagedClientUpsertOne) UpdateNewValues() *ManagedClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(managedclient.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(managedclient.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ManagedClient.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ManagedClientUpsertOne) Ignore() *ManagedClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ManagedClientUpsertOne) DoNothing() *ManagedClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ManagedClientCreate.OnConflict
+// documentation for more info.
+func (u *ManagedClientUpsertOne) Update(set func(*ManagedClientUpsert)) *ManagedClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ManagedClientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ManagedClientUpsertOne) SetUpdateBy(v uint32) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ManagedClientUpsertOne) AddUpdateBy(v uint32) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateUpdateBy() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ManagedClientUpsertOne) ClearUpdateBy() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ManagedClientUpsertOne) SetUpdateTime(v time.Time) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateUpdateTime() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ManagedClientUpsertOne) ClearUpdateTime() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ManagedClientUpsertOne) SetDeleteTime(v time.Time) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateDeleteTime() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ManagedClientUpsertOne) ClearDeleteTime() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ManagedClientUpsertOne) SetClientID(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateClientID() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetMachineID sets the "machine_id" field.
+func (u *ManagedClientUpsertOne) SetMachineID(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetMachineID(v)
+	})
+}
+
+// UpdateMachineID sets the "machine_id" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateMachineID() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateMachineID()
+	})
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (u *ManagedClientUpsertOne) ClearMachineID() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearMachineID()
+	})
+}
+
+// SetClientVersion sets the "client_version" field.
+func (u *ManagedClientUpsertOne) SetClientVersion(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetClientVersion(v)
+	})
+}
+
+// UpdateClientVersion sets the "client_version" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateClientVersion() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateClientVersion()
+	})
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (u *ManagedClientUpsertOne) ClearClientVersion() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearClientVersion()
+	})
+}
+
+// SetHostname sets the "hostname" field.
+func (u *ManagedClientUpsertOne) SetHostname(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetHostname(v)
+	})
+}
+
+// UpdateHostname sets the "hostname" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateHostname() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateHostname()
+	})
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (u *ManagedClientUpsertOne) ClearHostname() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearHostname()
+	})
+}
+
+// SetOs sets the "os" field.
+func (u *ManagedClientUpsertOne) SetOs(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetOs(v)
+	})
+}
+
+// UpdateOs sets the "os" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateOs() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateOs()
+	})
+}
+
+// ClearOs clears the value of the "os" field.
+func (u *ManagedClientUpsertOne) ClearOs() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearOs()
+	})
+}
+
+// SetOsVersion sets the "os_version" field.
+func (u *ManagedClientUpsertOne) SetOsVersion(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetOsVersion(v)
+	})
+}
+
+// UpdateOsVersion sets the "os_version" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateOsVersion() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateOsVersion()
+	})
+}
+
+// ClearOsVersion clears the value of the "os_version" field.
+func (u *ManagedClientUpsertOne) ClearOsVersion() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearOsVersion()
+	})
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (u *ManagedClientUpsertOne) SetKernelVersion(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetKernelVersion(v)
+	})
+}
+
+// UpdateKernelVersion sets the "kernel_version" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateKernelVersion() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateKernelVersion()
+	})
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (u *ManagedClientUpsertOne) ClearKernelVersion() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearKernelVersion()
+	})
+}
+
+// SetArch sets the "arch" field.
+func (u *ManagedClientUpsertOne) SetArch(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetArch(v)
+	})
+}
+
+// UpdateArch sets the "arch" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateArch() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateArch()
+	})
+}
+
+// ClearArch clears the value of the "arch" field.
+func (u *ManagedClientUpsertOne) ClearArch() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearArch()
+	})
+}
+
+// SetCPUCount sets the "cpu_count" field.
+func (u *ManagedClientUpsertOne) SetCPUCount(v int32) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetCPUCount(v)
+	})
+}
+
+// AddCPUCount adds v to the "cpu_count" field.
+func (u *ManagedClientUpsertOne) AddCPUCount(v int32) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.AddCPUCount(v)
+	})
+}
+
+// UpdateCPUCount sets the "cpu_count" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateCPUCount() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateCPUCount()
+	})
+}
+
+// ClearCPUCount clears the value of the "cpu_count" field.
+func (u *ManagedClientUpsertOne) ClearCPUCount() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearCPUCount()
+	})
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (u *ManagedClientUpsertOne) SetMemoryBytes(v int64) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetMemoryBytes(v)
+	})
+}
+
+// AddMemoryBytes adds v to the "memory_bytes" field.
+func (u *ManagedClientUpsertOne) AddMemoryBytes(v int64) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.AddMemoryBytes(v)
+	})
+}
+
+// UpdateMemoryBytes sets the "memory_bytes" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateMemoryBytes() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateMemoryBytes()
+	})
+}
+
+// ClearMemoryBytes clears the value of the "memory_bytes" field.
+func (u *ManagedClientUpsertOne) ClearMemoryBytes() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearMemoryBytes()
+	})
+}
+
+// SetScriptTypes sets the "script_types" field.
+func (u *ManagedClientUpsertOne) SetScriptTypes(v []string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetScriptTypes(v)
+	})
+}
+
+// UpdateScriptTypes sets the "script_types" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateScriptTypes() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateScriptTypes()
+	})
+}
+
+// ClearScriptTypes clears the value of the "script_types" field.
+func (u *ManagedClientUpsertOne) ClearScriptTypes() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearScriptTypes()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ManagedClientUpsertOne) SetDescription(v string) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateDescription() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ManagedClientUpsertOne) ClearDescription() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (u *ManagedClientUpsertOne) SetFirstSeenAt(v time.Time) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetFirstSeenAt(v)
+	})
+}
+
+// UpdateFirstSeenAt sets the "first_seen_at" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateFirstSeenAt() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateFirstSeenAt()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *ManagedClientUpsertOne) SetLastSeenAt(v time.Time) *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *ManagedClientUpsertOne) UpdateLastSeenAt() *ManagedClientUpsertOne {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ManagedClientUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ManagedClientCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ManagedClientUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ManagedClientUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ManagedClientUpsertOne.ID is not supported by MySQL driver. Use ManagedClientUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ManagedClientUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ManagedClientCreateBulk is the builder for creating many ManagedClient entities in bulk.
+type ManagedClientCreateBulk struct {
+	config
+	err      error
+	builders []*ManagedClientCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ManagedClient entities in the database.
+func (_c *ManagedClientCreateBulk) Save(ctx context.Context) ([]*ManagedClient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ManagedClient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ManagedClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ManagedClientCreateBulk) SaveX(ctx context.Context) []*ManagedClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ManagedClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ManagedClientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ManagedClient.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ManagedClientUpsert) {
+//			SetUpdateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ManagedClientCreateBulk) OnConflict(opts ...sql.ConflictOption) *ManagedClientUpsertBulk {
+	_c.conflict = opts
+	return &ManagedClientUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ManagedClient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ManagedClientCreateBulk) OnConflictColumns(columns ...string) *ManagedClientUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ManagedClientUpsertBulk{
+		create: _c,
+	}
+}
+
+// ManagedClientUpsertBulk is the builder for "upsert"-ing
+// a bulk of ManagedClient nodes.
+type ManagedClientUpsertBulk struct {
+	create *ManagedClientCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ManagedClient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(managedclient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ManagedClientUpsertBulk) UpdateNewValues() *ManagedClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(managedclient.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(managedclient.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ManagedClient.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ManagedClientUpsertBulk) Ignore() *ManagedClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ManagedClientUpsertBulk) DoNothing() *ManagedClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ManagedClientCreateBulk.OnConflict
+// documentation for more info.
+func (u *ManagedClientUpsertBulk) Update(set func(*ManagedClientUpsert)) *ManagedClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ManagedClientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ManagedClientUpsertBulk) SetUpdateBy(v uint32) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ManagedClientUpsertBulk) AddUpdateBy(v uint32) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateUpdateBy() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ManagedClientUpsertBulk) ClearUpdateBy() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ManagedClientUpsertBulk) SetUpdateTime(v time.Time) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateUpdateTime() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ManagedClientUpsertBulk) ClearUpdateTime() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ManagedClientUpsertBulk) SetDeleteTime(v time.Time) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateDeleteTime() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ManagedClientUpsertBulk) ClearDeleteTime() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ManagedClientUpsertBulk) SetClientID(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateClientID() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetMachineID sets the "machine_id" field.
+func (u *ManagedClientUpsertBulk) SetMachineID(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetMachineID(v)
+	})
+}
+
+// UpdateMachineID sets the "machine_id" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateMachineID() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateMachineID()
+	})
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (u *ManagedClientUpsertBulk) ClearMachineID() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearMachineID()
+	})
+}
+
+// SetClientVersion sets the "client_version" field.
+func (u *ManagedClientUpsertBulk) SetClientVersion(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetClientVersion(v)
+	})
+}
+
+// UpdateClientVersion sets the "client_version" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateClientVersion() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateClientVersion()
+	})
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (u *ManagedClientUpsertBulk) ClearClientVersion() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearClientVersion()
+	})
+}
+
+// SetHostname sets the "hostname" field.
+func (u *ManagedClientUpsertBulk) SetHostname(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetHostname(v)
+	})
+}
+
+// UpdateHostname sets the "hostname" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateHostname() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateHostname()
+	})
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (u *ManagedClientUpsertBulk) ClearHostname() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearHostname()
+	})
+}
+
+// SetOs sets the "os" field.
+func (u *ManagedClientUpsertBulk) SetOs(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetOs(v)
+	})
+}
+
+// UpdateOs sets the "os" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateOs() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateOs()
+	})
+}
+
+// ClearOs clears the value of the "os" field.
+func (u *ManagedClientUpsertBulk) ClearOs() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearOs()
+	})
+}
+
+// SetOsVersion sets the "os_version" field.
+func (u *ManagedClientUpsertBulk) SetOsVersion(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetOsVersion(v)
+	})
+}
+
+// UpdateOsVersion sets the "os_version" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateOsVersion() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateOsVersion()
+	})
+}
+
+// ClearOsVersion clears the value of the "os_version" field.
+func (u *ManagedClientUpsertBulk) ClearOsVersion() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearOsVersion()
+	})
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (u *ManagedClientUpsertBulk) SetKernelVersion(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetKernelVersion(v)
+	})
+}
+
+// UpdateKernelVersion sets the "kernel_version" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateKernelVersion() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateKernelVersion()
+	})
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (u *ManagedClientUpsertBulk) ClearKernelVersion() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearKernelVersion()
+	})
+}
+
+// SetArch sets the "arch" field.
+func (u *ManagedClientUpsertBulk) SetArch(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetArch(v)
+	})
+}
+
+// UpdateArch sets the "arch" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateArch() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateArch()
+	})
+}
+
+// ClearArch clears the value of the "arch" field.
+func (u *ManagedClientUpsertBulk) ClearArch() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearArch()
+	})
+}
+
+// SetCPUCount sets the "cpu_count" field.
+func (u *ManagedClientUpsertBulk) SetCPUCount(v int32) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetCPUCount(v)
+	})
+}
+
+// AddCPUCount adds v to the "cpu_count" field.
+func (u *ManagedClientUpsertBulk) AddCPUCount(v int32) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.AddCPUCount(v)
+	})
+}
+
+// UpdateCPUCount sets the "cpu_count" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateCPUCount() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateCPUCount()
+	})
+}
+
+// ClearCPUCount clears the value of the "cpu_count" field.
+func (u *ManagedClientUpsertBulk) ClearCPUCount() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearCPUCount()
+	})
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (u *ManagedClientUpsertBulk) SetMemoryBytes(v int64) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetMemoryBytes(v)
+	})
+}
+
+// AddMemoryBytes adds v to the "memory_bytes" field.
+func (u *ManagedClientUpsertBulk) AddMemoryBytes(v int64) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.AddMemoryBytes(v)
+	})
+}
+
+// UpdateMemoryBytes sets the "memory_bytes" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateMemoryBytes() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateMemoryBytes()
+	})
+}
+
+// ClearMemoryBytes clears the value of the "memory_bytes" field.
+func (u *ManagedClientUpsertBulk) ClearMemoryBytes() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearMemoryBytes()
+	})
+}
+
+// SetScriptTypes sets the "script_types" field.
+func (u *ManagedClientUpsertBulk) SetScriptTypes(v []string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetScriptTypes(v)
+	})
+}
+
+// UpdateScriptTypes sets the "script_types" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateScriptTypes() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateScriptTypes()
+	})
+}
+
+// ClearScriptTypes clears the value of the "script_types" field.
+func (u *ManagedClientUpsertBulk) ClearScriptTypes() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearScriptTypes()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ManagedClientUpsertBulk) SetDescription(v string) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateDescription() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ManagedClientUpsertBulk) ClearDescription() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (u *ManagedClientUpsertBulk) SetFirstSeenAt(v time.Time) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetFirstSeenAt(v)
+	})
+}
+
+// UpdateFirstSeenAt sets the "first_seen_at" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateFirstSeenAt() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateFirstSeenAt()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *ManagedClientUpsertBulk) SetLastSeenAt(v time.Time) *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *ManagedClientUpsertBulk) UpdateLastSeenAt() *ManagedClientUpsertBulk {
+	return u.Update(func(s *ManagedClientUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ManagedClientUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ManagedClientCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ManagedClientCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ManagedClientUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/managedclient"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ManagedClientUpdate is the builder for updating ManagedClient entities.
+type ManagedClientUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ManagedClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ManagedClientUpdate builder.
+func (_u *ManagedClientUpdate) Where(ps ...predicate.ManagedClient) *ManagedClientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ManagedClientUpdate) SetUpdateBy(v uint32) *ManagedClientUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableUpdateBy(v *uint32) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ManagedClientUpdate) AddUpdateBy(v int32) *ManagedClientUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ManagedClientUpdate) ClearUpdateBy() *ManagedClientUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ManagedClientUpdate) SetUpdateTime(v time.Time) *ManagedClientUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableUpdateTime(v *time.Time) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ManagedClientUpdate) ClearUpdateTime() *ManagedClientUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ManagedClientUpdate) SetDeleteTime(v time.Time) *ManagedClientUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableDeleteTime(v *time.Time) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ManagedClientUpdate) ClearDeleteTime() *ManagedClientUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *ManagedClientUpdate) SetClientID(v string) *ManagedClientUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableClientID(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *ManagedClientUpdate) SetMachineID(v string) *ManagedClientUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableMachineID(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (_u *ManagedClientUpdate) ClearMachineID() *ManagedClientUpdate {
+	_u.mutation.ClearMachineID()
+	return _u
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_u *ManagedClientUpdate) SetClientVersion(v string) *ManagedClientUpdate {
+	_u.mutation.SetClientVersion(v)
+	return _u
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableClientVersion(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetClientVersion(*v)
+	}
+	return _u
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (_u *ManagedClientUpdate) ClearClientVersion() *ManagedClientUpdate {
+	_u.mutation.ClearClientVersion()
+	return _u
+}
+
+// SetHostname sets the "hostname" field.
+func (_u *ManagedClientUpdate) SetHostname(v string) *ManagedClientUpdate {
+	_u.mutation.SetHostname(v)
+	return _u
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableHostname(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetHostname(*v)
+	}
+	return _u
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (_u *ManagedClientUpdate) ClearHostname() *ManagedClientUpdate {
+	_u.mutation.ClearHostname()
+	return _u
+}
+
+// SetOs sets the "os" field.
+func (_u *ManagedClientUpdate) SetOs(v string) *ManagedClientUpdate {
+	_u.mutation.SetOs(v)
+	return _u
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableOs(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetOs(*v)
+	}
+	return _u
+}
+
+// ClearOs clears the value of the "os" field.
+func (_u *ManagedClientUpdate) ClearOs() *ManagedClientUpdate {
+	_u.mutation.ClearOs()
+	return _u
+}
+
+// SetOsVersion sets the "os_version" field.
+func (_u *ManagedClientUpdate) SetOsVersion(v string) *ManagedClientUpdate {
+	_u.mutation.SetOsVersion(v)
+	return _u
+}
+
+// SetNillableOsVersion sets the "os_version" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableOsVersion(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetOsVersion(*v)
+	}
+	return _u
+}
+
+// ClearOsVersion clears the value of the "os_version" field.
+func (_u *ManagedClientUpdate) ClearOsVersion() *ManagedClientUpdate {
+	_u.mutation.ClearOsVersion()
+	return _u
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_u *ManagedClientUpdate) SetKernelVersion(v string) *ManagedClientUpdate {
+	_u.mutation.SetKernelVersion(v)
+	return _u
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableKernelVersion(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetKernelVersion(*v)
+	}
+	return _u
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (_u *ManagedClientUpdate) ClearKernelVersion() *ManagedClientUpdate {
+	_u.mutation.ClearKernelVersion()
+	return _u
+}
+
+// SetArch sets the "arch" field.
+func (_u *ManagedClientUpdate) SetArch(v string) *ManagedClientUpdate {
+	_u.mutation.SetArch(v)
+	return _u
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableArch(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetArch(*v)
+	}
+	return _u
+}
+
+// ClearArch clears the value of the "arch" field.
+func (_u *ManagedClientUpdate) ClearArch() *ManagedClientUpdate {
+	_u.mutation.ClearArch()
+	return _u
+}
+
+// SetCPUCount sets the "cpu_count" field.
+func (_u *ManagedClientUpdate) SetCPUCount(v int32) *ManagedClientUpdate {
+	_u.mutation.ResetCPUCount()
+	_u.mutation.SetCPUCount(v)
+	return _u
+}
+
+// SetNillableCPUCount sets the "cpu_count" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableCPUCount(v *int32) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetCPUCount(*v)
+	}
+	return _u
+}
+
+// AddCPUCount adds value to the "cpu_count" field.
+func (_u *ManagedClientUpdate) AddCPUCount(v int32) *ManagedClientUpdate {
+	_u.mutation.AddCPUCount(v)
+	return _u
+}
+
+// ClearCPUCount clears the value of the "cpu_count" field.
+func (_u *ManagedClientUpdate) ClearCPUCount() *ManagedClientUpdate {
+	_u.mutation.ClearCPUCount()
+	return _u
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (_u *ManagedClientUpdate) SetMemoryBytes(v int64) *ManagedClientUpdate {
+	_u.mutation.ResetMemoryBytes()
+	_u.mutation.SetMemoryBytes(v)
+	return _u
+}
+
+// SetNillableMemoryBytes sets the "memory_bytes" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableMemoryBytes(v *int64) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetMemoryBytes(*v)
+	}
+	return _u
+}
+
+// AddMemoryBytes adds value to the "memory_bytes" field.
+func (_u *ManagedClientUpdate) AddMemoryBytes(v int64) *ManagedClientUpdate {
+	_u.mutation.AddMemoryBytes(v)
+	return _u
+}
+
+// ClearMemoryBytes clears the value of the "memory_bytes" field.
+func (_u *ManagedClientUpdate) ClearMemoryBytes() *ManagedClientUpdate {
+	_u.mutation.ClearMemoryBytes()
+	return _u
+}
+
+// SetScriptTypes sets the "script_types" field.
+func (_u *ManagedClientUpdate) SetScriptTypes(v []string) *ManagedClientUpdate {
+	_u.mutation.SetScriptTypes(v)
+	return _u
+}
+
+// AppendScriptTypes appends value to the "script_types" field.
+func (_u *ManagedClientUpdate) AppendScriptTypes(v []string) *ManagedClientUpdate {
+	_u.mutation.AppendScriptTypes(v)
+	return _u
+}
+
+// ClearScriptTypes clears the value of the "script_types" field.
+func (_u *ManagedClientUpdate) ClearScriptTypes() *ManagedClientUpdate {
+	_u.mutation.ClearScriptTypes()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ManagedClientUpdate) SetDescription(v string) *ManagedClientUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableDescription(v *string) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ManagedClientUpdate) ClearDescription() *ManagedClientUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_u *ManagedClientUpdate) SetFirstSeenAt(v time.Time) *ManagedClientUpdate {
+	_u.mutation.SetFirstSeenAt(v)
+	return _u
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableFirstSeenAt(v *time.Time) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetFirstSeenAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *ManagedClientUpdate) SetLastSeenAt(v time.Time) *ManagedClientUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *ManagedClientUpdate) SetNillableLastSeenAt(v *time.Time) *ManagedClientUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ManagedClientMutation object of the builder.
+func (_u *ManagedClientUpdate) Mutation() *ManagedClientMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ManagedClientUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ManagedClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ManagedClientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ManagedClientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ManagedClientUpdate) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := managedclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MachineID(); ok {
+		if err := managedclient.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.machine_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientVersion(); ok {
+		if err := managedclient.ClientVersionValidator(v); err != nil {
+			return &ValidationError{Name: "client_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.client_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hostname(); ok {
+		if err := managedclient.HostnameValidator(v); err != nil {
+			return &ValidationError{Name: "hostname", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.hostname": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Os(); ok {
+		if err := managedclient.OsValidator(v); err != nil {
+			return &ValidationError{Name: "os", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.os": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OsVersion(); ok {
+		if err := managedclient.OsVersionValidator(v); err != nil {
+			return &ValidationError{Name: "os_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.os_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.KernelVersion(); ok {
+		if err := managedclient.KernelVersionValidator(v); err != nil {
+			return &ValidationError{Name: "kernel_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.kernel_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arch(); ok {
+		if err := managedclient.ArchValidator(v); err != nil {
+			return &ValidationError{Name: "arch", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.arch": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := managedclient.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ManagedClientUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ManagedClientUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ManagedClientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(managedclient.Table, managedclient.Columns, sqlgraph.NewFieldSpec(managedclient.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(managedclient.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(managedclient.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(managedclient.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(managedclient.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(managedclient.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(managedclient.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(managedclient.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(managedclient.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(managedclient.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(managedclient.FieldMachineID, field.TypeString, value)
+	}
+	if _u.mutation.MachineIDCleared() {
+		_spec.ClearField(managedclient.FieldMachineID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientVersion(); ok {
+		_spec.SetField(managedclient.FieldClientVersion, field.TypeString, value)
+	}
+	if _u.mutation.ClientVersionCleared() {
+		_spec.ClearField(managedclient.FieldClientVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hostname(); ok {
+		_spec.SetField(managedclient.FieldHostname, field.TypeString, value)
+	}
+	if _u.mutation.HostnameCleared() {
+		_spec.ClearField(managedclient.FieldHostname, field.TypeString)
+	}
+	if value, ok := _u.mutation.Os(); ok {
+		_spec.SetField(managedclient.FieldOs, field.TypeString, value)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(managedclient.FieldOs, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsVersion(); ok {
+		_spec.SetField(managedclient.FieldOsVersion, field.TypeString, value)
+	}
+	if _u.mutation.OsVersionCleared() {
+		_spec.ClearField(managedclient.FieldOsVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.KernelVersion(); ok {
+		_spec.SetField(managedclient.FieldKernelVersion, field.TypeString, value)
+	}
+	if _u.mutation.KernelVersionCleared() {
+		_spec.ClearField(managedclient.FieldKernelVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Arch(); ok {
+		_spec.SetField(managedclient.FieldArch, field.TypeString, value)
+	}
+	if _u.mutation.ArchCleared() {
+		_spec.ClearField(managedclient.FieldArch, field.TypeString)
+	}
+	if value, ok := _u.mutation.CPUCount(); ok {
+		_spec.SetField(managedclient.FieldCPUCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedCPUCount(); ok {
+		_spec.AddField(managedclient.FieldCPUCount, field.TypeInt32, value)
+	}
+	if _u.mutation.CPUCountCleared() {
+		_spec.ClearField(managedclient.FieldCPUCount, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.MemoryBytes(); ok {
+		_spec.SetField(managedclient.FieldMemoryBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMemoryBytes(); ok {
+		_spec.AddField(managedclient.FieldMemoryBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MemoryBytesCleared() {
+		_spec.ClearField(managedclient.FieldMemoryBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ScriptTypes(); ok {
+		_spec.SetField(managedclient.FieldScriptTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScriptTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, managedclient.FieldScriptTypes, value)
+		})
+	}
+	if _u.mutation.ScriptTypesCleared() {
+		_spec.ClearField(managedclient.FieldScriptTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(managedclient.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(managedclient.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FirstSeenAt(); ok {
+		_spec.SetField(managedclient.FieldFirstSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(managedclient.FieldLastSeenAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{managedclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ManagedClientUpdateOne is the builder for updating a single ManagedClient entity.
+type ManagedClientUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ManagedClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ManagedClientUpdateOne) SetUpdateBy(v uint32) *ManagedClientUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableUpdateBy(v *uint32) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ManagedClientUpdateOne) AddUpdateBy(v int32) *ManagedClientUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ManagedClientUpdateOne) ClearUpdateBy() *ManagedClientUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ManagedClientUpdateOne) SetUpdateTime(v time.Time) *ManagedClientUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableUpdateTime(v *time.Time) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ManagedClientUpdateOne) ClearUpdateTime() *ManagedClientUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ManagedClientUpdateOne) SetDeleteTime(v time.Time) *ManagedClientUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableDeleteTime(v *time.Time) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ManagedClientUpdateOne) ClearDeleteTime() *ManagedClientUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *ManagedClientUpdateOne) SetClientID(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableClientID(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *ManagedClientUpdateOne) SetMachineID(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableMachineID(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (_u *ManagedClientUpdateOne) ClearMachineID() *ManagedClientUpdateOne {
+	_u.mutation.ClearMachineID()
+	return _u
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_u *ManagedClientUpdateOne) SetClientVersion(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetClientVersion(v)
+	return _u
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableClientVersion(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetClientVersion(*v)
+	}
+	return _u
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (_u *ManagedClientUpdateOne) ClearClientVersion() *ManagedClientUpdateOne {
+	_u.mutation.ClearClientVersion()
+	return _u
+}
+
+// SetHostname sets the "hostname" field.
+func (_u *ManagedClientUpdateOne) SetHostname(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetHostname(v)
+	return _u
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableHostname(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetHostname(*v)
+	}
+	return _u
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (_u *ManagedClientUpdateOne) ClearHostname() *ManagedClientUpdateOne {
+	_u.mutation.ClearHostname()
+	return _u
+}
+
+// SetOs sets the "os" field.
+func (_u *ManagedClientUpdateOne) SetOs(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetOs(v)
+	return _u
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableOs(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetOs(*v)
+	}
+	return _u
+}
+
+// ClearOs clears the value of the "os" field.
+func (_u *ManagedClientUpdateOne) ClearOs() *ManagedClientUpdateOne {
+	_u.mutation.ClearOs()
+	return _u
+}
+
+// SetOsVersion sets the "os_version" field.
+func (_u *ManagedClientUpdateOne) SetOsVersion(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetOsVersion(v)
+	return _u
+}
+
+// SetNillableOsVersion sets the "os_version" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableOsVersion(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetOsVersion(*v)
+	}
+	return _u
+}
+
+// ClearOsVersion clears the value of the "os_version" field.
+func (_u *ManagedClientUpdateOne) ClearOsVersion() *ManagedClientUpdateOne {
+	_u.mutation.ClearOsVersion()
+	return _u
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_u *ManagedClientUpdateOne) SetKernelVersion(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetKernelVersion(v)
+	return _u
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableKernelVersion(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetKernelVersion(*v)
+	}
+	return _u
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (_u *ManagedClientUpdateOne) ClearKernelVersion() *ManagedClientUpdateOne {
+	_u.mutation.ClearKernelVersion()
+	return _u
+}
+
+// SetArch sets the "arch" field.
+func (_u *ManagedClientUpdateOne) SetArch(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetArch(v)
+	return _u
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableArch(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetArch(*v)
+	}
+	return _u
+}
+
+// ClearArch clears the value of the "arch" field.
+func (_u *ManagedClientUpdateOne) ClearArch() *ManagedClientUpdateOne {
+	_u.mutation.ClearArch()
+	return _u
+}
+
+// SetCPUCount sets the "cpu_count" field.
+func (_u *ManagedClientUpdateOne) SetCPUCount(v int32) *ManagedClientUpdateOne {
+	_u.mutation.ResetCPUCount()
+	_u.mutation.SetCPUCount(v)
+	return _u
+}
+
+// SetNillableCPUCount sets the "cpu_count" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableCPUCount(v *int32) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetCPUCount(*v)
+	}
+	return _u
+}
+
+// AddCPUCount adds value to the "cpu_count" field.
+func (_u *ManagedClientUpdateOne) AddCPUCount(v int32) *ManagedClientUpdateOne {
+	_u.mutation.AddCPUCount(v)
+	return _u
+}
+
+// ClearCPUCount clears the value of the "cpu_count" field.
+func (_u *ManagedClientUpdateOne) ClearCPUCount() *ManagedClientUpdateOne {
+	_u.mutation.ClearCPUCount()
+	return _u
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (_u *ManagedClientUpdateOne) SetMemoryBytes(v int64) *ManagedClientUpdateOne {
+	_u.mutation.ResetMemoryBytes()
+	_u.mutation.SetMemoryBytes(v)
+	return _u
+}
+
+// SetNillableMemoryBytes sets the "memory_bytes" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableMemoryBytes(v *int64) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetMemoryBytes(*v)
+	}
+	return _u
+}
+
+// AddMemoryBytes adds value to the "memory_bytes" field.
+func (_u *ManagedClientUpdateOne) AddMemoryBytes(v int64) *ManagedClientUpdateOne {
+	_u.mutation.AddMemoryBytes(v)
+	return _u
+}
+
+// ClearMemoryBytes clears the value of the "memory_bytes" field.
+func (_u *ManagedClientUpdateOne) ClearMemoryBytes() *ManagedClientUpdateOne {
+	_u.mutation.ClearMemoryBytes()
+	return _u
+}
+
+// SetScriptTypes sets the "script_types" field.
+func (_u *ManagedClientUpdateOne) SetScriptTypes(v []string) *ManagedClientUpdateOne {
+	_u.mutation.SetScriptTypes(v)
+	return _u
+}
+
+// AppendScriptTypes appends value to the "script_types" field.
+func (_u *ManagedClientUpdateOne) AppendScriptTypes(v []string) *ManagedClientUpdateOne {
+	_u.mutation.AppendScriptTypes(v)
+	return _u
+}
+
+// ClearScriptTypes clears the value of the "script_types" field.
+func (_u *ManagedClientUpdateOne) ClearScriptTypes() *ManagedClientUpdateOne {
+	_u.mutation.ClearScriptTypes()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ManagedClientUpdateOne) SetDescription(v string) *ManagedClientUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableDescription(v *string) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ManagedClientUpdateOne) ClearDescription() *ManagedClientUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_u *ManagedClientUpdateOne) SetFirstSeenAt(v time.Time) *ManagedClientUpdateOne {
+	_u.mutation.SetFirstSeenAt(v)
+	return _u
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableFirstSeenAt(v *time.Time) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetFirstSeenAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *ManagedClientUpdateOne) SetLastSeenAt(v time.Time) *ManagedClientUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *ManagedClientUpdateOne) SetNillableLastSeenAt(v *time.Time) *ManagedClientUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ManagedClientMutation object of the builder.
+func (_u *ManagedClientUpdateOne) Mutation() *ManagedClientMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ManagedClientUpdate builder.
+func (_u *ManagedClientUpdateOne) Where(ps ...predicate.ManagedClient) *ManagedClientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ManagedClientUpdateOne) Select(field string, fields ...string) *ManagedClientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ManagedClient entity.
+func (_u *ManagedClientUpdateOne) Save(ctx context.Context) (*ManagedClient, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ManagedClientUpdateOne) SaveX(ctx context.Context) *ManagedClient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ManagedClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ManagedClientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ManagedClientUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := managedclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MachineID(); ok {
+		if err := managedclient.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.machine_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientVersion(); ok {
+		if err := managedclient.ClientVersionValidator(v); err != nil {
+			return &ValidationError{Name: "client_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.client_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hostname(); ok {
+		if err := managedclient.HostnameValidator(v); err != nil {
+			return &ValidationError{Name: "hostname", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.hostname": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Os(); ok {
+		if err := managedclient.OsValidator(v); err != nil {
+			return &ValidationError{Name: "os", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.os": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OsVersion(); ok {
+		if err := managedclient.OsVersionValidator(v); err != nil {
+			return &ValidationError{Name: "os_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.os_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.KernelVersion(); ok {
+		if err := managedclient.KernelVersionValidator(v); err != nil {
+			return &ValidationError{Name: "kernel_version", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.kernel_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arch(); ok {
+		if err := managedclient.ArchValidator(v); err != nil {
+			return &ValidationError{Name: "arch", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.arch": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := managedclient.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "ManagedClient.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ManagedClientUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ManagedClientUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ManagedClientUpdateOne) sqlSave(ctx context.Context) (_node *ManagedClient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(managedclient.Table, managedclient.Columns, sqlgraph.NewFieldSpec(managedclient.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ManagedClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, managedclient.FieldID)
+		for _, f := range fields {
+			if !managedclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != managedclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(managedclient.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(managedclient.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(managedclient.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(managedclient.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(managedclient.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(managedclient.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(managedclient.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(managedclient.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(managedclient.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(managedclient.FieldMachineID, field.TypeString, value)
+	}
+	if _u.mutation.MachineIDCleared() {
+		_spec.ClearField(managedclient.FieldMachineID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientVersion(); ok {
+		_spec.SetField(managedclient.FieldClientVersion, field.TypeString, value)
+	}
+	if _u.mutation.ClientVersionCleared() {
+		_spec.ClearField(managedclient.FieldClientVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hostname(); ok {
+		_spec.SetField(managedclient.FieldHostname, field.TypeString, value)
+	}
+	if _u.mutation.HostnameCleared() {
+		_spec.ClearField(managedclient.FieldHostname, field.TypeString)
+	}
+	if value, ok := _u.mutation.Os(); ok {
+		_spec.SetField(managedclient.FieldOs, field.TypeString, value)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(managedclient.FieldOs, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsVersion(); ok {
+		_spec.SetField(managedclient.FieldOsVersion, field.TypeString, value)
+	}
+	if _u.mutation.OsVersionCleared() {
+		_spec.ClearField(managedclient.FieldOsVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.KernelVersion(); ok {
+		_spec.SetField(managedclient.FieldKernelVersion, field.TypeString, value)
+	}
+	if _u.mutation.KernelVersionCleared() {
+		_spec.ClearField(managedclient.FieldKernelVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Arch(); ok {
+		_spec.SetField(managedclient.FieldArch, field.TypeString, value)
+	}
+	if _u.mutation.ArchCleared() {
+		_spec.ClearField(managedclient.FieldArch, field.TypeString)
+	}
+	if value, ok := _u.mutation.CPUCount(); ok {
+		_spec.SetField(managedclient.FieldCPUCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedCPUCount(); ok {
+		_spec.AddField(managedclient.FieldCPUCount, field.TypeInt32, value)
+	}
+	if _u.mutation.CPUCountCleared() {
+		_spec.ClearField(managedclient.FieldCPUCount, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.MemoryBytes(); ok {
+		_spec.SetField(managedclient.FieldMemoryBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMemoryBytes(); ok {
+		_spec.AddField(managedclient.FieldMemoryBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MemoryBytesCleared() {
+		_spec.ClearField(managedclient.FieldMemoryBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ScriptTypes(); ok {
+		_spec.SetField(managedclient.FieldScriptTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScriptTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, managedclient.FieldScriptTypes, value)
+		})
+	}
+	if _u.mutation.ScriptTypesCleared() {
+		_spec.ClearField(managedclient.FieldScriptTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(managedclient.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(managedclient.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FirstSeenAt(); ok {
+		_spec.SetField(managedclient.FieldFirstSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(managedclient.FieldLastSeenAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ManagedClient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{managedclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -83,6 +83,157 @@ var (
 			},
 		},
 	}
+	// ExecutorClientGroupsColumns holds the columns for the "executor_client_groups" table.
+	ExecutorClientGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Group name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Group description"},
+		{Name: "selector", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Label selector matched against client labels"},
+		{Name: "client_ids", Type: field.TypeJSON, Nullable: true, Comment: "Static member CNs"},
+	}
+	// ExecutorClientGroupsTable holds the schema information for the "executor_client_groups" table.
+	ExecutorClientGroupsTable = &schema.Table{
+		Name:       "executor_client_groups",
+		Columns:    ExecutorClientGroupsColumns,
+		PrimaryKey: []*schema.Column{ExecutorClientGroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "executor_client_group_name_unique",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorClientGroupsColumns[7], ExecutorClientGroupsColumns[6]},
+			},
+			{
+				Name:    "clientgroup_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorClientGroupsColumns[6]},
+			},
+		},
+	}
+	// ExecutorClientLabelsColumns holds the columns for the "executor_client_labels" table.
+	ExecutorClientLabelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "client_id", Type: field.TypeString, Size: 255, Comment: "mTLS client CN"},
+		{Name: "labels", Type: field.TypeJSON, Comment: "Free-form labels set by operators of the tenant"},
+	}
+	// ExecutorClientLabelsTable holds the schema information for the "executor_client_labels" table.
+	ExecutorClientLabelsTable = &schema.Table{
+		Name:       "executor_client_labels",
+		Columns:    ExecutorClientLabelsColumns,
+		PrimaryKey: []*schema.Column{ExecutorClientLabelsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "executor_client_label_client_unique",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorClientLabelsColumns[6], ExecutorClientLabelsColumns[5]},
+			},
+			{
+				Name:    "clientlabelset_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorClientLabelsColumns[5]},
+			},
+		},
+	}
+	// ExecutorClientLocksColumns holds the columns for the "executor_client_locks" table.
+	ExecutorClientLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 255, Comment: "mTLS client CN"},
+	}
+	// ExecutorClientLocksTable holds the schema information for the "executor_client_locks" table.
+	ExecutorClientLocksTable = &schema.Table{
+		Name:       "executor_client_locks",
+		Columns:    ExecutorClientLocksColumns,
+		PrimaryKey: []*schema.Column{ExecutorClientLocksColumns[0]},
+	}
+	// ExecutorCommandsColumns holds the columns for the "executor_commands" table.
+	ExecutorCommandsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Command ID (UUID primary key)"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "execution_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "FK to executor_execution_logs, empty for non-execution commands"},
+		{Name: "client_id", Type: field.TypeString, Size: 255, Comment: "mTLS client CN the command is addressed to"},
+		{Name: "command_type", Type: field.TypeEnum, Comment: "Type of the command", Enums: []string{"SCRIPT_EXECUTION", "CLIENT_UPDATE", "ABORT", "CANCEL"}, Default: "SCRIPT_EXECUTION"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Delivery status of the command", Enums: []string{"PENDING", "QUEUED", "SENT", "ACCEPTED", "REJECTED", "COMPLETED", "EXPIRED"}, Default: "PENDING"},
+		{Name: "rejection_reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the client rejected the command"},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true, Comment: "When the command was written to the client stream"},
+		{Name: "acked_at", Type: field.TypeTime, Nullable: true, Comment: "When the client accepted or rejected the command"},
+		{Name: "expired_at", Type: field.TypeTime, Nullable: true, Comment: "When the command expired before delivery"},
+	}
+	// ExecutorCommandsTable holds the schema information for the "executor_commands" table.
+	ExecutorCommandsTable = &schema.Table{
+		Name:       "executor_commands",
+		Columns:    ExecutorCommandsColumns,
+		PrimaryKey: []*schema.Column{ExecutorCommandsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "command_execution_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorCommandsColumns[5]},
+			},
+			{
+				Name:    "command_tenant_id_client_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorCommandsColumns[4], ExecutorCommandsColumns[6]},
+			},
+			{
+				Name:    "command_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorCommandsColumns[8]},
+			},
+		},
+	}
+	// ExecutorEventRulesColumns holds the columns for the "executor_event_rules" table.
+	ExecutorEventRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Rule name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Rule description"},
+		{Name: "event_type", Type: field.TypeEnum, Comment: "Event the rule reacts to", Enums: []string{"CLIENT_FIRST_CONNECT", "CLIENT_VERSION_CHANGED", "CLIENT_LABELS_CHANGED", "SCRIPT_FAILED"}},
+		{Name: "script_id", Type: field.TypeString, Size: 36, Comment: "FK to executor_scripts, the script to run"},
+		{Name: "selector", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Label selector limiting the clients the rule applies to"},
+		{Name: "source_script_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "For SCRIPT_FAILED, the script whose failure fires the rule"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether the rule fires", Default: true},
+		{Name: "last_fired_at", Type: field.TypeTime, Nullable: true, Comment: "When the rule last started an execution"},
+	}
+	// ExecutorEventRulesTable holds the schema information for the "executor_event_rules" table.
+	ExecutorEventRulesTable = &schema.Table{
+		Name:       "executor_event_rules",
+		Columns:    ExecutorEventRulesColumns,
+		PrimaryKey: []*schema.Column{ExecutorEventRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventrule_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorEventRulesColumns[6]},
+			},
+			{
+				Name:    "eventrule_tenant_id_script_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorEventRulesColumns[6], ExecutorEventRulesColumns[10]},
+			},
+			{
+				Name:    "eventrule_event_type_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorEventRulesColumns[9], ExecutorEventRulesColumns[13]},
+			},
+		},
+	}
 	// ExecutorExecutionLogsColumns holds the columns for the "executor_execution_logs" table.
 	ExecutorExecutionLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
@@ -95,8 +246,8 @@ var (
 		{Name: "script_name", Type: field.TypeString, Size: 255, Comment: "Denormalized script name for audit readability"},
 		{Name: "client_id", Type: field.TypeString, Size: 255, Comment: "mTLS client CN"},
 		{Name: "script_hash", Type: field.TypeString, Size: 64, Comment: "Script content hash at execution time"},
-		{Name: "trigger_type", Type: field.TypeEnum, Comment: "Who initiated the execution", Enums: []string{"CLIENT_PULL", "UI_PUSH"}},
-		{Name: "status", Type: field.TypeEnum, Comment: "Current execution status", Enums: []string{"PENDING", "RUNNING", "COMPLETED", "FAILED", "REJECTED_HASH_MISMATCH", "REJECTED_NOT_APPROVED", "CLIENT_OFFLINE"}, Default: "PENDING"},
+		{Name: "trigger_type", Type: field.TypeEnum, Comment: "Who initiated the execution", Enums: []string{"CLIENT_PULL", "UI_PUSH", "SCHEDULED", "EVENT", "WORKFLOW"}},
+		{Name: "status", Type: field.TypeEnum, Comment: "Current execution status", Enums: []string{"PENDING", "RUNNING", "COMPLETED", "FAILED", "REJECTED_HASH_MISMATCH", "REJECTED_NOT_APPROVED", "CLIENT_OFFLINE", "TIMED_OUT", "CANCELLED", "AWAITING_APPROVAL"}, Default: "PENDING"},
 		{Name: "exit_code", Type: field.TypeInt, Nullable: true, Comment: "Process exit code"},
 		{Name: "output", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Script stdout"},
 		{Name: "error_output", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Script stderr"},
@@ -104,6 +255,33 @@ var (
 		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "When execution started on client"},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true, Comment: "When execution completed on client"},
 		{Name: "duration_ms", Type: field.TypeInt64, Nullable: true, Comment: "Execution duration in milliseconds"},
+		{Name: "cancel_requested_at", Type: field.TypeTime, Nullable: true, Comment: "When a user asked to cancel the execution"},
+		{Name: "cancelled_by", Type: field.TypeUint32, Nullable: true, Comment: "User who cancelled the execution"},
+		{Name: "cancel_reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the execution was cancelled"},
+		{Name: "timeout_reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the execution timed out"},
+		{Name: "run_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "FK to executor_execution_runs when part of a fan-out run"},
+		{Name: "event_rule_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "FK to executor_event_rules when started by an event rule"},
+		{Name: "event_type", Type: field.TypeEnum, Nullable: true, Comment: "Event that started the execution", Enums: []string{"CLIENT_FIRST_CONNECT", "CLIENT_VERSION_CHANGED", "CLIENT_LABELS_CHANGED", "SCRIPT_FAILED"}},
+		{Name: "event_detail", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Details of the originating event"},
+		{Name: "source_execution_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "Failed execution that fired a SCRIPT_FAILED rule"},
+		{Name: "workflow_run_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "FK to executor_workflow_runs when a step of a workflow run"},
+		{Name: "workflow_step_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Step of the workflow run"},
+		{Name: "attempt", Type: field.TypeInt, Comment: "Attempt number, 1 for the first attempt", Default: 1},
+		{Name: "original_execution_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "First attempt of the execution, set on retries"},
+		{Name: "retry_at", Type: field.TypeTime, Nullable: true, Comment: "When the next attempt is due, set while a retry is pending"},
+		{Name: "retried_by", Type: field.TypeString, Nullable: true, Size: 36, Comment: "Attempt that retried this execution"},
+		{Name: "waiting_for_slot", Type: field.TypeBool, Comment: "PENDING execution held back until a concurrency slot on the client frees up", Default: false},
+		{Name: "held_until", Type: field.TypeTime, Nullable: true, Comment: "Waiting execution held back until a maintenance window of the client opens"},
+		{Name: "maintenance_override", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Justification given for running outside the client's maintenance windows"},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true, Comment: "Parameter values the execution runs with, secret values masked"},
+		{Name: "secret_parameters_ciphertext", Type: field.TypeBytes, Nullable: true, Comment: "Encrypted values of the secret parameters, kept to deliver waiting executions and retries"},
+		{Name: "secret_parameters_nonce", Type: field.TypeBytes, Nullable: true, Comment: "GCM nonce the secret parameter values were encrypted with"},
+		{Name: "secret_parameters_key_id", Type: field.TypeString, Nullable: true, Size: 32, Comment: "Identifies the key the secret parameter values are encrypted with"},
+		{Name: "secret_names", Type: field.TypeJSON, Nullable: true, Comment: "Secrets the script content references, masked in the stored output"},
+		{Name: "approval_expires_at", Type: field.TypeTime, Nullable: true, Comment: "When an execution on a protected client stops waiting for approval"},
+		{Name: "approved_by", Type: field.TypeUint32, Nullable: true, Comment: "User who approved the execution"},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true, Comment: "When the execution was approved"},
+		{Name: "approval_comment", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Comment the approver left"},
 	}
 	// ExecutorExecutionLogsTable holds the schema information for the "executor_execution_logs" table.
 	ExecutorExecutionLogsTable = &schema.Table{
@@ -131,6 +309,36 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{ExecutorExecutionLogsColumns[11]},
 			},
+			{
+				Name:    "executionlog_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionLogsColumns[23]},
+			},
+			{
+				Name:    "executionlog_event_rule_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionLogsColumns[24]},
+			},
+			{
+				Name:    "executionlog_workflow_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionLogsColumns[28]},
+			},
+			{
+				Name:    "executionlog_original_execution_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionLogsColumns[31]},
+			},
+			{
+				Name:    "executionlog_retry_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionLogsColumns[32]},
+			},
+			{
+				Name:    "executionlog_client_id_waiting_for_slot",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionLogsColumns[8], ExecutorExecutionLogsColumns[34]},
+			},
 			{
 				Name:    "executionlog_tenant_id_script_id",
 				Unique:  false,
@@ -146,6 +354,281 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{ExecutorExecutionLogsColumns[5], ExecutorExecutionLogsColumns[11]},
 			},
+			{
+				Name:    "executionlog_status_approval_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionLogsColumns[11], ExecutorExecutionLogsColumns[42]},
+			},
+		},
+	}
+	// ExecutorExecutionRunsColumns holds the columns for the "executor_execution_runs" table.
+	ExecutorExecutionRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "script_id", Type: field.TypeString, Size: 36, Comment: "FK to executor_scripts"},
+		{Name: "script_name", Type: field.TypeString, Size: 255, Comment: "Denormalized script name for audit readability"},
+		{Name: "selector", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Label selector the targets were resolved from"},
+		{Name: "client_ids", Type: field.TypeJSON, Nullable: true, Comment: "Client CNs requested explicitly"},
+		{Name: "schedule_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "Schedule that started the run, nil for manual runs"},
+		{Name: "targets", Type: field.TypeJSON, Nullable: true, Comment: "Assigned client CNs in rollout order"},
+		{Name: "total", Type: field.TypeInt, Comment: "Number of executions created for the run", Default: 0},
+		{Name: "dispatched", Type: field.TypeInt, Comment: "Number of targets handed out to batches so far", Default: 0},
+		{Name: "batch_size", Type: field.TypeInt, Comment: "Targets per batch, 0 for everything at once", Default: 0},
+		{Name: "canary_size", Type: field.TypeInt, Comment: "Size of the first batch that must fully succeed, 0 for no canary", Default: 0},
+		{Name: "batch_pause_seconds", Type: field.TypeInt, Comment: "Wait between the end of a batch and the next one", Default: 0},
+		{Name: "max_failures", Type: field.TypeInt, Nullable: true, Comment: "Failed executions tolerated before the run halts, nil for no limit"},
+		{Name: "current_batch", Type: field.TypeInt, Comment: "1-based number of the last dispatched batch", Default: 0},
+		{Name: "next_batch_at", Type: field.TypeTime, Nullable: true, Comment: "When the next batch is due, set once the current batch finished"},
+		{Name: "status", Type: field.TypeEnum, Comment: "RUNNING until every batch was dispatched and finished", Enums: []string{"RUNNING", "PAUSED", "HALTED", "ABORTED", "COMPLETED"}, Default: "RUNNING"},
+		{Name: "halt_reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the run was halted or aborted"},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true, Comment: "When the last execution of the run finished"},
+	}
+	// ExecutorExecutionRunsTable holds the schema information for the "executor_execution_runs" table.
+	ExecutorExecutionRunsTable = &schema.Table{
+		Name:       "executor_execution_runs",
+		Columns:    ExecutorExecutionRunsColumns,
+		PrimaryKey: []*schema.Column{ExecutorExecutionRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "executionrun_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionRunsColumns[5]},
+			},
+			{
+				Name:    "executionrun_tenant_id_script_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionRunsColumns[5], ExecutorExecutionRunsColumns[6]},
+			},
+			{
+				Name:    "executionrun_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionRunsColumns[5], ExecutorExecutionRunsColumns[20]},
+			},
+			{
+				Name:    "executionrun_schedule_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorExecutionRunsColumns[10]},
+			},
+		},
+	}
+	// ExecutorMaintenanceWindowsColumns holds the columns for the "executor_maintenance_windows" table.
+	ExecutorMaintenanceWindowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Window name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Window description"},
+		{Name: "client_patterns", Type: field.TypeJSON, Comment: "Client CNs or glob patterns the window applies to"},
+		{Name: "cron_expression", Type: field.TypeString, Size: 255, Comment: "5-field cron expression or macro at which the window opens"},
+		{Name: "duration_minutes", Type: field.TypeInt, Comment: "How long the window stays open"},
+		{Name: "timezone", Type: field.TypeString, Size: 64, Comment: "IANA timezone the expression is evaluated in", Default: "UTC"},
+		{Name: "action", Type: field.TypeEnum, Comment: "What happens to executions triggered outside the window", Enums: []string{"REJECT", "QUEUE", "REQUIRE_OVERRIDE"}, Default: "REJECT"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Disabled windows do not restrict their clients", Default: true},
+	}
+	// ExecutorMaintenanceWindowsTable holds the schema information for the "executor_maintenance_windows" table.
+	ExecutorMaintenanceWindowsTable = &schema.Table{
+		Name:       "executor_maintenance_windows",
+		Columns:    ExecutorMaintenanceWindowsColumns,
+		PrimaryKey: []*schema.Column{ExecutorMaintenanceWindowsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "maintenancewindow_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorMaintenanceWindowsColumns[6]},
+			},
+			{
+				Name:    "maintenancewindow_tenant_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorMaintenanceWindowsColumns[6], ExecutorMaintenanceWindowsColumns[14]},
+			},
+		},
+	}
+	// ExecutorClientsColumns holds the columns for the "executor_clients" table.
+	ExecutorClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "client_id", Type: field.TypeString, Size: 255, Comment: "mTLS client CN"},
+		{Name: "machine_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Machine ID reported by the agent"},
+		{Name: "client_version", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Agent version"},
+		{Name: "hostname", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Hostname reported by the agent"},
+		{Name: "os", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Operating system, e.g. linux or windows"},
+		{Name: "os_version", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Operating system version"},
+		{Name: "kernel_version", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Kernel version"},
+		{Name: "arch", Type: field.TypeString, Nullable: true, Size: 32, Comment: "CPU architecture"},
+		{Name: "cpu_count", Type: field.TypeInt32, Nullable: true, Comment: "Number of CPUs"},
+		{Name: "memory_bytes", Type: field.TypeInt64, Nullable: true, Comment: "Total memory in bytes"},
+		{Name: "script_types", Type: field.TypeJSON, Nullable: true, Comment: "Script types the agent has an interpreter for, empty for agents that do not report them"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Operator notes about the client"},
+		{Name: "first_seen_at", Type: field.TypeTime, Comment: "When the client connected for the first time"},
+		{Name: "last_seen_at", Type: field.TypeTime, Comment: "When the client was last connected or sent a heartbeat"},
+	}
+	// ExecutorClientsTable holds the schema information for the "executor_clients" table.
+	ExecutorClientsTable = &schema.Table{
+		Name:       "executor_clients",
+		Columns:    ExecutorClientsColumns,
+		PrimaryKey: []*schema.Column{ExecutorClientsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "managedclient_client_id",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorClientsColumns[5]},
+			},
+			{
+				Name:    "managedclient_hostname",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorClientsColumns[8]},
+			},
+			{
+				Name:    "managedclient_last_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorClientsColumns[18]},
+			},
+		},
+	}
+	// ExecutorOutputChunksColumns holds the columns for the "executor_output_chunks" table.
+	ExecutorOutputChunksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "execution_id", Type: field.TypeString, Size: 36, Comment: "FK to executor_execution_logs"},
+		{Name: "seq", Type: field.TypeInt64, Comment: "Client-assigned sequence number, shared by stdout and stderr"},
+		{Name: "stream", Type: field.TypeEnum, Comment: "Output stream the chunk belongs to", Enums: []string{"STDOUT", "STDERR"}},
+		{Name: "data", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Chunk content"},
+	}
+	// ExecutorOutputChunksTable holds the schema information for the "executor_output_chunks" table.
+	ExecutorOutputChunksTable = &schema.Table{
+		Name:       "executor_output_chunks",
+		Columns:    ExecutorOutputChunksColumns,
+		PrimaryKey: []*schema.Column{ExecutorOutputChunksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outputchunk_execution_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorOutputChunksColumns[5], ExecutorOutputChunksColumns[6]},
+			},
+		},
+	}
+	// ExecutorProtectedClientsColumns holds the columns for the "executor_protected_clients" table.
+	ExecutorProtectedClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "client_id", Type: field.TypeString, Size: 255, Comment: "mTLS client CN"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the client is protected"},
+	}
+	// ExecutorProtectedClientsTable holds the schema information for the "executor_protected_clients" table.
+	ExecutorProtectedClientsTable = &schema.Table{
+		Name:       "executor_protected_clients",
+		Columns:    ExecutorProtectedClientsColumns,
+		PrimaryKey: []*schema.Column{ExecutorProtectedClientsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "protectedclient_tenant_id_client_id",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorProtectedClientsColumns[5], ExecutorProtectedClientsColumns[6]},
+			},
+		},
+	}
+	// ExecutorQueuedCommandsColumns holds the columns for the "executor_queued_commands" table.
+	ExecutorQueuedCommandsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Command ID (UUID primary key)"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "client_id", Type: field.TypeString, Size: 255, Comment: "mTLS client CN the command is addressed to"},
+		{Name: "execution_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "FK to executor_execution_logs, empty for non-execution commands"},
+		{Name: "command_type", Type: field.TypeEnum, Comment: "Type of the queued command", Enums: []string{"SCRIPT_EXECUTION", "CLIENT_UPDATE", "CANCEL"}, Default: "SCRIPT_EXECUTION"},
+		{Name: "payload", Type: field.TypeBytes, Comment: "Serialized ExecutionCommand delivered to the client"},
+		{Name: "expires_at", Type: field.TypeTime, Comment: "When the command is discarded if still undelivered"},
+	}
+	// ExecutorQueuedCommandsTable holds the schema information for the "executor_queued_commands" table.
+	ExecutorQueuedCommandsTable = &schema.Table{
+		Name:       "executor_queued_commands",
+		Columns:    ExecutorQueuedCommandsColumns,
+		PrimaryKey: []*schema.Column{ExecutorQueuedCommandsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "queuedcommand_client_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorQueuedCommandsColumns[5], ExecutorQueuedCommandsColumns[1]},
+			},
+			{
+				Name:    "queuedcommand_execution_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorQueuedCommandsColumns[6]},
+			},
+			{
+				Name:    "queuedcommand_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorQueuedCommandsColumns[9]},
+			},
+		},
+	}
+	// ExecutorSchedulesColumns holds the columns for the "executor_schedules" table.
+	ExecutorSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Schedule name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Schedule description"},
+		{Name: "script_id", Type: field.TypeString, Size: 36, Comment: "FK to executor_scripts"},
+		{Name: "cron_expression", Type: field.TypeString, Size: 255, Comment: "5-field cron expression or macro such as @daily"},
+		{Name: "timezone", Type: field.TypeString, Size: 64, Comment: "IANA timezone the expression is evaluated in", Default: "UTC"},
+		{Name: "client_ids", Type: field.TypeJSON, Nullable: true, Comment: "Client CNs targeted explicitly"},
+		{Name: "selector", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Label selector resolved at each occurrence"},
+		{Name: "batch_size", Type: field.TypeInt, Comment: "Run strategy: targets per batch", Default: 0},
+		{Name: "canary_size", Type: field.TypeInt, Comment: "Run strategy: canary batch size", Default: 0},
+		{Name: "batch_pause_seconds", Type: field.TypeInt, Comment: "Run strategy: wait between batches", Default: 0},
+		{Name: "max_failures", Type: field.TypeInt, Nullable: true, Comment: "Run strategy: failures tolerated before halting"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Disabled schedules never fire", Default: true},
+		{Name: "catch_up_policy", Type: field.TypeEnum, Comment: "What to do about occurrences missed during downtime", Enums: []string{"SKIP", "RUN_ONCE", "RUN_ALL"}, Default: "SKIP"},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true, Comment: "Next occurrence, nil while disabled"},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true, Comment: "Occurrence the last run was started for"},
+		{Name: "last_run_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "Run started by the last occurrence"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the last occurrence did not start a run"},
+	}
+	// ExecutorSchedulesTable holds the schema information for the "executor_schedules" table.
+	ExecutorSchedulesTable = &schema.Table{
+		Name:       "executor_schedules",
+		Columns:    ExecutorSchedulesColumns,
+		PrimaryKey: []*schema.Column{ExecutorSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedule_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorSchedulesColumns[6]},
+			},
+			{
+				Name:    "schedule_tenant_id_script_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorSchedulesColumns[6], ExecutorSchedulesColumns[9]},
+			},
+			{
+				Name:    "schedule_enabled_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorSchedulesColumns[18], ExecutorSchedulesColumns[20]},
+			},
 		},
 	}
 	// ExecutorScriptsColumns holds the columns for the "executor_scripts" table.
@@ -159,11 +642,16 @@ var (
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Script name"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Script description"},
-		{Name: "script_type", Type: field.TypeEnum, Comment: "Script execution engine type", Enums: []string{"BASH", "JAVASCRIPT", "LUA"}},
+		{Name: "script_type", Type: field.TypeEnum, Comment: "Script execution engine type", Enums: []string{"BASH", "JAVASCRIPT", "LUA", "PYTHON", "POWERSHELL"}},
 		{Name: "content", Type: field.TypeString, Size: 2147483647, Comment: "Script body content"},
 		{Name: "content_hash", Type: field.TypeString, Size: 64, Comment: "SHA256 hex digest of content"},
 		{Name: "version", Type: field.TypeInt, Comment: "Content version, incremented on update", Default: 1},
 		{Name: "enabled", Type: field.TypeBool, Comment: "Whether the script is active", Default: true},
+		{Name: "timeout_seconds", Type: field.TypeInt, Nullable: true, Comment: "Execution timeout in seconds, falls back to the tenant default when unset"},
+		{Name: "retry_policy", Type: field.TypeJSON, Nullable: true, Comment: "How failed or undelivered executions are retried, no retries when unset"},
+		{Name: "singleton", Type: field.TypeBool, Comment: "Only one active execution of the script per client", Default: false},
+		{Name: "concurrency_limit_action", Type: field.TypeEnum, Comment: "Whether executions over a concurrency limit are rejected or wait for a slot", Enums: []string{"REJECT", "QUEUE"}, Default: "REJECT"},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true, Comment: "Inputs the script declares, supplied by assignments and triggers"},
 	}
 	// ExecutorScriptsTable holds the schema information for the "executor_scripts" table.
 	ExecutorScriptsTable = &schema.Table{
@@ -202,7 +690,11 @@ var (
 		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "script_id", Type: field.TypeString, Size: 36, Comment: "FK to executor_scripts"},
-		{Name: "client_id", Type: field.TypeString, Size: 255, Comment: "mTLS client CN"},
+		{Name: "target_type", Type: field.TypeEnum, Comment: "What the assignment targets", Enums: []string{"CLIENT", "SELECTOR", "GROUP"}, Default: "CLIENT"},
+		{Name: "client_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "mTLS client CN, for CLIENT assignments", Default: ""},
+		{Name: "selector", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Label selector, for SELECTOR assignments", Default: ""},
+		{Name: "group_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "FK to executor_client_groups, for GROUP assignments", Default: ""},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true, Comment: "Values of the script's parameters for the targeted clients"},
 	}
 	// ExecutorScriptAssignmentsTable holds the schema information for the "executor_script_assignments" table.
 	ExecutorScriptAssignmentsTable = &schema.Table{
@@ -213,7 +705,7 @@ var (
 			{
 				Name:    "executor_assignment_unique",
 				Unique:  true,
-				Columns: []*schema.Column{ExecutorScriptAssignmentsColumns[6], ExecutorScriptAssignmentsColumns[7], ExecutorScriptAssignmentsColumns[5]},
+				Columns: []*schema.Column{ExecutorScriptAssignmentsColumns[6], ExecutorScriptAssignmentsColumns[8], ExecutorScriptAssignmentsColumns[9], ExecutorScriptAssignmentsColumns[10], ExecutorScriptAssignmentsColumns[5]},
 			},
 			{
 				Name:    "scriptassignment_script_id",
@@ -223,7 +715,12 @@ var (
 			{
 				Name:    "scriptassignment_client_id",
 				Unique:  false,
-				Columns: []*schema.Column{ExecutorScriptAssignmentsColumns[7]},
+				Columns: []*schema.Column{ExecutorScriptAssignmentsColumns[8]},
+			},
+			{
+				Name:    "scriptassignment_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorScriptAssignmentsColumns[10]},
 			},
 			{
 				Name:    "scriptassignment_tenant_id",
@@ -232,12 +729,276 @@ var (
 			},
 		},
 	}
+	// ExecutorScriptChangeRequestsColumns holds the columns for the "executor_script_change_requests" table.
+	ExecutorScriptChangeRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "script_id", Type: field.TypeString, Size: 36, Comment: "FK to executor_scripts"},
+		{Name: "base_version", Type: field.TypeInt, Comment: "Script version the change was made against"},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Comment: "Proposed script body content"},
+		{Name: "content_hash", Type: field.TypeString, Size: 64, Comment: "SHA256 hex digest of the proposed content"},
+		{Name: "change_note", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the content is changed"},
+		{Name: "restored_from_version", Type: field.TypeInt, Nullable: true, Comment: "Version whose content a rollback restores"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Review state of the change", Enums: []string{"PENDING", "APPROVED", "REJECTED", "CANCELLED"}, Default: "PENDING"},
+		{Name: "reviewed_by", Type: field.TypeUint32, Nullable: true, Comment: "User who approved or rejected the change"},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true, Comment: "When the change was approved or rejected"},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Comment left by the approver"},
+		{Name: "rejection_reason", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Why the change was rejected"},
+		{Name: "applied_version", Type: field.TypeInt, Nullable: true, Comment: "Script version created when the change was approved"},
+		{Name: "comments", Type: field.TypeJSON, Nullable: true, Comment: "Discussion of the change, oldest first"},
+	}
+	// ExecutorScriptChangeRequestsTable holds the schema information for the "executor_script_change_requests" table.
+	ExecutorScriptChangeRequestsTable = &schema.Table{
+		Name:       "executor_script_change_requests",
+		Columns:    ExecutorScriptChangeRequestsColumns,
+		PrimaryKey: []*schema.Column{ExecutorScriptChangeRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scriptchangerequest_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorScriptChangeRequestsColumns[6], ExecutorScriptChangeRequestsColumns[13]},
+			},
+			{
+				Name:    "scriptchangerequest_script_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorScriptChangeRequestsColumns[7], ExecutorScriptChangeRequestsColumns[13]},
+			},
+		},
+	}
+	// ExecutorScriptVersionsColumns holds the columns for the "executor_script_versions" table.
+	ExecutorScriptVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "script_id", Type: field.TypeString, Size: 36, Comment: "FK to executor_scripts"},
+		{Name: "version", Type: field.TypeInt, Comment: "Script version this content was saved as"},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Comment: "Script body content"},
+		{Name: "content_hash", Type: field.TypeString, Size: 64, Comment: "SHA256 hex digest of content"},
+		{Name: "change_note", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the content was changed"},
+		{Name: "restored_from_version", Type: field.TypeInt, Nullable: true, Comment: "Version whose content a rollback restored"},
+		{Name: "approved_by", Type: field.TypeUint32, Nullable: true, Comment: "User who approved the change request this version came from"},
+		{Name: "change_request_id", Type: field.TypeString, Nullable: true, Size: 36, Comment: "Change request this version came from"},
+	}
+	// ExecutorScriptVersionsTable holds the schema information for the "executor_script_versions" table.
+	ExecutorScriptVersionsTable = &schema.Table{
+		Name:       "executor_script_versions",
+		Columns:    ExecutorScriptVersionsColumns,
+		PrimaryKey: []*schema.Column{ExecutorScriptVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scriptversion_script_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorScriptVersionsColumns[6], ExecutorScriptVersionsColumns[7]},
+			},
+			{
+				Name:    "scriptversion_script_id_content_hash",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorScriptVersionsColumns[6], ExecutorScriptVersionsColumns[9]},
+			},
+			{
+				Name:    "scriptversion_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorScriptVersionsColumns[5]},
+			},
+		},
+	}
+	// ExecutorSecretsColumns holds the columns for the "executor_secrets" table.
+	ExecutorSecretsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 64, Comment: "Name scripts reference the secret by"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Secret description"},
+		{Name: "ciphertext", Type: field.TypeBytes, Comment: "Encrypted value"},
+		{Name: "nonce", Type: field.TypeBytes, Comment: "GCM nonce the value was encrypted with"},
+		{Name: "key_id", Type: field.TypeString, Size: 32, Comment: "Identifies the key the value is encrypted with"},
+	}
+	// ExecutorSecretsTable holds the schema information for the "executor_secrets" table.
+	ExecutorSecretsTable = &schema.Table{
+		Name:       "executor_secrets",
+		Columns:    ExecutorSecretsColumns,
+		PrimaryKey: []*schema.Column{ExecutorSecretsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "secret_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorSecretsColumns[6], ExecutorSecretsColumns[7]},
+			},
+		},
+	}
+	// ExecutorSigningKeysColumns holds the columns for the "executor_signing_keys" table.
+	ExecutorSigningKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "Key ID derived from the public key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "algorithm", Type: field.TypeEnum, Comment: "Signature algorithm", Enums: []string{"ED25519", "ECDSA_P256"}},
+		{Name: "public_key", Type: field.TypeBytes, Comment: "PKIX DER encoded public key"},
+		{Name: "private_key", Type: field.TypeBytes, Comment: "Sealed PKCS #8 private key"},
+		{Name: "nonce", Type: field.TypeBytes, Comment: "GCM nonce the private key was sealed with"},
+		{Name: "sealed_with", Type: field.TypeString, Size: 32, Comment: "ID of the secret key the private key is sealed with"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Lifecycle status", Enums: []string{"ACTIVE", "RETIRED", "REVOKED"}, Default: "ACTIVE"},
+		{Name: "retired_at", Type: field.TypeTime, Nullable: true, Comment: "When a newer key replaced this one"},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true, Comment: "When the key was revoked"},
+	}
+	// ExecutorSigningKeysTable holds the schema information for the "executor_signing_keys" table.
+	ExecutorSigningKeysTable = &schema.Table{
+		Name:       "executor_signing_keys",
+		Columns:    ExecutorSigningKeysColumns,
+		PrimaryKey: []*schema.Column{ExecutorSigningKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signingkey_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorSigningKeysColumns[10]},
+			},
+		},
+	}
+	// ExecutorTenantSettingsColumns holds the columns for the "executor_tenant_settings" table.
+	ExecutorTenantSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "default_timeout_seconds", Type: field.TypeInt, Comment: "Execution timeout for scripts without their own timeout", Default: 3600},
+		{Name: "max_concurrent_per_client", Type: field.TypeInt, Comment: "Active executions a client may have at once, 0 is unlimited", Default: 0},
+		{Name: "require_script_approval", Type: field.TypeBool, Comment: "Whether script content changes need approval by a second person", Default: false},
+		{Name: "script_approver_role", Type: field.TypeString, Nullable: true, Size: 128, Comment: "Role allowed to approve script changes and executions on protected clients, the default role when empty"},
+		{Name: "execution_approval_ttl_minutes", Type: field.TypeInt, Comment: "How long an execution on a protected client waits for approval", Default: 60},
+		{Name: "script_block_severity", Type: field.TypeEnum, Comment: "Lowest diagnostic severity that refuses a script save, NONE never blocks", Enums: []string{"NONE", "INFO", "WARNING", "ERROR"}, Default: "NONE"},
+		{Name: "disabled_lint_rules", Type: field.TypeJSON, Nullable: true, Comment: "Lint rules not applied to scripts of the tenant"},
+	}
+	// ExecutorTenantSettingsTable holds the schema information for the "executor_tenant_settings" table.
+	ExecutorTenantSettingsTable = &schema.Table{
+		Name:       "executor_tenant_settings",
+		Columns:    ExecutorTenantSettingsColumns,
+		PrimaryKey: []*schema.Column{ExecutorTenantSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantsetting_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorTenantSettingsColumns[5]},
+			},
+		},
+	}
+	// ExecutorWorkflowsColumns holds the columns for the "executor_workflows" table.
+	ExecutorWorkflowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Workflow name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2048, Comment: "Workflow description"},
+		{Name: "steps", Type: field.TypeJSON, Comment: "Steps with their dependencies and conditions"},
+	}
+	// ExecutorWorkflowsTable holds the schema information for the "executor_workflows" table.
+	ExecutorWorkflowsTable = &schema.Table{
+		Name:       "executor_workflows",
+		Columns:    ExecutorWorkflowsColumns,
+		PrimaryKey: []*schema.Column{ExecutorWorkflowsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflow_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorWorkflowsColumns[6]},
+			},
+			{
+				Name:    "workflow_tenant_id_name",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorWorkflowsColumns[6], ExecutorWorkflowsColumns[7]},
+			},
+		},
+	}
+	// ExecutorWorkflowRunsColumns holds the columns for the "executor_workflow_runs" table.
+	ExecutorWorkflowRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "workflow_id", Type: field.TypeString, Size: 36, Comment: "FK to executor_workflows"},
+		{Name: "workflow_name", Type: field.TypeString, Size: 255, Comment: "Denormalized workflow name for audit readability"},
+		{Name: "client_id", Type: field.TypeString, Size: 255, Comment: "mTLS client CN the steps run on"},
+		{Name: "steps", Type: field.TypeJSON, Comment: "Steps of the workflow when the run started"},
+		{Name: "started_steps", Type: field.TypeJSON, Nullable: true, Comment: "Steps whose execution was dispatched"},
+		{Name: "skipped_steps", Type: field.TypeJSON, Nullable: true, Comment: "Steps whose condition did not hold"},
+		{Name: "failed_steps", Type: field.TypeJSON, Nullable: true, Comment: "Steps whose execution could not be created"},
+		{Name: "revision", Type: field.TypeInt, Comment: "Incremented on every step change, for compare-and-set", Default: 0},
+		{Name: "status", Type: field.TypeEnum, Comment: "Run status", Enums: []string{"RUNNING", "SUCCEEDED", "FAILED"}, Default: "RUNNING"},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true, Comment: "When every step had finished or was skipped"},
+	}
+	// ExecutorWorkflowRunsTable holds the schema information for the "executor_workflow_runs" table.
+	ExecutorWorkflowRunsTable = &schema.Table{
+		Name:       "executor_workflow_runs",
+		Columns:    ExecutorWorkflowRunsColumns,
+		PrimaryKey: []*schema.Column{ExecutorWorkflowRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflowrun_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorWorkflowRunsColumns[5]},
+			},
+			{
+				Name:    "workflowrun_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorWorkflowRunsColumns[14]},
+			},
+			{
+				Name:    "workflowrun_tenant_id_workflow_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorWorkflowRunsColumns[5], ExecutorWorkflowRunsColumns[6]},
+			},
+			{
+				Name:    "workflowrun_tenant_id_client_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExecutorWorkflowRunsColumns[5], ExecutorWorkflowRunsColumns[8]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ExecutorAuditLogsTable,
+		ExecutorClientGroupsTable,
+		ExecutorClientLabelsTable,
+		ExecutorClientLocksTable,
+		ExecutorCommandsTable,
+		ExecutorEventRulesTable,
 		ExecutorExecutionLogsTable,
+		ExecutorExecutionRunsTable,
+		ExecutorMaintenanceWindowsTable,
+		ExecutorClientsTable,
+		ExecutorOutputChunksTable,
+		ExecutorProtectedClientsTable,
+		ExecutorQueuedCommandsTable,
+		ExecutorSchedulesTable,
 		ExecutorScriptsTable,
 		ExecutorScriptAssignmentsTable,
+		ExecutorScriptChangeRequestsTable,
+		ExecutorScriptVersionsTable,
+		ExecutorSecretsTable,
+		ExecutorSigningKeysTable,
+		ExecutorTenantSettingsTable,
+		ExecutorWorkflowsTable,
+		ExecutorWorkflowRunsTable,
 	}
 )
 
@@ -245,13 +1006,70 @@ func init() {
 	ExecutorAuditLogsTable.Annotation = &entsql.Annotation{
 		Table: "executor_audit_logs",
 	}
+	ExecutorClientGroupsTable.Annotation = &entsql.Annotation{
+		Table: "executor_client_groups",
+	}
+	ExecutorClientLabelsTable.Annotation = &entsql.Annotation{
+		Table: "executor_client_labels",
+	}
+	ExecutorClientLocksTable.Annotation = &entsql.Annotation{
+		Table: "executor_client_locks",
+	}
+	ExecutorCommandsTable.Annotation = &entsql.Annotation{
+		Table: "executor_commands",
+	}
+	ExecutorEventRulesTable.Annotation = &entsql.Annotation{
+		Table: "executor_event_rules",
+	}
 	ExecutorExecutionLogsTable.Annotation = &entsql.Annotation{
 		Table: "executor_execution_logs",
 	}
+	ExecutorExecutionRunsTable.Annotation = &entsql.Annotation{
+		Table: "executor_execution_runs",
+	}
+	ExecutorMaintenanceWindowsTable.Annotation = &entsql.Annotation{
+		Table: "executor_maintenance_windows",
+	}
+	ExecutorClientsTable.Annotation = &entsql.Annotation{
+		Table: "executor_clients",
+	}
+	ExecutorOutputChunksTable.Annotation = &entsql.Annotation{
+		Table: "executor_output_chunks",
+	}
+	ExecutorProtectedClientsTable.Annotation = &entsql.Annotation{
+		Table: "executor_protected_clients",
+	}
+	ExecutorQueuedCommandsTable.Annotation = &entsql.Annotation{
+		Table: "executor_queued_commands",
+	}
+	ExecutorSchedulesTable.Annotation = &entsql.Annotation{
+		Table: "executor_schedules",
+	}
 	ExecutorScriptsTable.Annotation = &entsql.Annotation{
 		Table: "executor_scripts",
 	}
 	ExecutorScriptAssignmentsTable.Annotation = &entsql.Annotation{
 		Table: "executor_script_assignments",
 	}
+	ExecutorScriptChangeRequestsTable.Annotation = &entsql.Annotation{
+		Table: "executor_script_change_requests",
+	}
+	ExecutorScriptVersionsTable.Annotation = &entsql.Annotation{
+		Table: "executor_script_versions",
+	}
+	ExecutorSecretsTable.Annotation = &entsql.Annotation{
+		Table: "executor_secrets",
+	}
+	ExecutorSigningKeysTable.Annotation = &entsql.Annotation{
+		Table: "executor_signing_keys",
+	}
+	ExecutorTenantSettingsTable.Annotation = &entsql.Annotation{
+		Table: "executor_tenant_settings",
+	}
+	ExecutorWorkflowsTable.Annotation = &entsql.Annotation{
+		Table: "executor_workflows",
+	}
+	ExecutorWorkflowRunsTable.Annotation = &entsql.Annotation{
+		Table: "executor_workflow_runs",
+	}
 }
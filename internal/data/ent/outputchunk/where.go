@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package outputchunk
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ExecutionID applies equality check predicate on the "execution_id" field. It's identical to ExecutionIDEQ.
+func ExecutionID(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldExecutionID, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldSeq, v))
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldData, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotNull(FieldTenantID))
+}
+
+// ExecutionIDEQ applies the EQ predicate on the "execution_id" field.
+func ExecutionIDEQ(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldExecutionID, v))
+}
+
+// ExecutionIDNEQ applies the NEQ predicate on the "execution_id" field.
+func ExecutionIDNEQ(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldExecutionID, v))
+}
+
+// ExecutionIDIn applies the In predicate on the "execution_id" field.
+func ExecutionIDIn(vs ...string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldExecutionID, vs...))
+}
+
+// ExecutionIDNotIn applies the NotIn predicate on the "execution_id" field.
+func ExecutionIDNotIn(vs ...string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldExecutionID, vs...))
+}
+
+// ExecutionIDGT applies the GT predicate on the "execution_id" field.
+func ExecutionIDGT(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGT(FieldExecutionID, v))
+}
+
+// ExecutionIDGTE applies the GTE predicate on the "execution_id" field.
+func ExecutionIDGTE(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGTE(FieldExecutionID, v))
+}
+
+// ExecutionIDLT applies the LT predicate on the "execution_id" field.
+func ExecutionIDLT(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLT(FieldExecutionID, v))
+}
+
+// ExecutionIDLTE applies the LTE predicate on the "execution_id" field.
+func ExecutionIDLTE(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLTE(FieldExecutionID, v))
+}
+
+// ExecutionIDContains applies the Contains predicate on the "execution_id" field.
+func ExecutionIDContains(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldContains(FieldExecutionID, v))
+}
+
+// ExecutionIDHasPrefix applies the HasPrefix predicate on the "execution_id" field.
+func ExecutionIDHasPrefix(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldHasPrefix(FieldExecutionID, v))
+}
+
+// ExecutionIDHasSuffix applies the HasSuffix predicate on the "execution_id" field.
+func ExecutionIDHasSuffix(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldHasSuffix(FieldExecutionID, v))
+}
+
+// ExecutionIDEqualFold applies the EqualFold predicate on the "execution_id" field.
+func ExecutionIDEqualFold(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEqualFold(FieldExecutionID, v))
+}
+
+// ExecutionIDContainsFold applies the ContainsFold predicate on the "execution_id" field.
+func ExecutionIDContainsFold(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldContainsFold(FieldExecutionID, v))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int64) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLTE(FieldSeq, v))
+}
+
+// StreamEQ applies the EQ predicate on the "stream" field.
+func StreamEQ(v Stream) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldStream, v))
+}
+
+// StreamNEQ applies the NEQ predicate on the "stream" field.
+func StreamNEQ(v Stream) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldStream, v))
+}
+
+// StreamIn applies the In predicate on the "stream" field.
+func StreamIn(vs ...Stream) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldStream, vs...))
+}
+
+// StreamNotIn applies the NotIn predicate on the "stream" field.
+func StreamNotIn(vs ...Stream) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldStream, vs...))
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldLTE(FieldData, v))
+}
+
+// DataContains applies the Contains predicate on the "data" field.
+func DataContains(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldContains(FieldData, v))
+}
+
+// DataHasPrefix applies the HasPrefix predicate on the "data" field.
+func DataHasPrefix(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldHasPrefix(FieldData, v))
+}
+
+// DataHasSuffix applies the HasSuffix predicate on the "data" field.
+func DataHasSuffix(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldHasSuffix(FieldData, v))
+}
+
+// DataIsNil applies the IsNil predicate on the "data" field.
+func DataIsNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldIsNull(FieldData))
+}
+
+// DataNotNil applies the NotNil predicate on the "data" field.
+func DataNotNil() predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldNotNull(FieldData))
+}
+
+// DataEqualFold applies the EqualFold predicate on the "data" field.
+func DataEqualFold(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldEqualFold(FieldData, v))
+}
+
+// DataContainsFold applies the ContainsFold predicate on the "data" field.
+func DataContainsFold(v string) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.FieldContainsFold(FieldData, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OutputChunk) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OutputChunk) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OutputChunk) predicate.OutputChunk {
+	return predicate.OutputChunk(sql.NotPredicates(p))
+}
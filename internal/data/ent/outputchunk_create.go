@@ -0,0 +1,931 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/outputchunk"
+)
+
+// OutputChunkCreate is the builder for creating a OutputChunk entity.
+type OutputChunkCreate struct {
+	config
+	mutation *OutputChunkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *OutputChunkCreate) SetCreateTime(v time.Time) *OutputChunkCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *OutputChunkCreate) SetNillableCreateTime(v *time.Time) *OutputChunkCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *OutputChunkCreate) SetUpdateTime(v time.Time) *OutputChunkCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *OutputChunkCreate) SetNillableUpdateTime(v *time.Time) *OutputChunkCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *OutputChunkCreate) SetDeleteTime(v time.Time) *OutputChunkCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *OutputChunkCreate) SetNillableDeleteTime(v *time.Time) *OutputChunkCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OutputChunkCreate) SetTenantID(v uint32) *OutputChunkCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *OutputChunkCreate) SetNillableTenantID(v *uint32) *OutputChunkCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_c *OutputChunkCreate) SetExecutionID(v string) *OutputChunkCreate {
+	_c.mutation.SetExecutionID(v)
+	return _c
+}
+
+// SetSeq sets the "seq" field.
+func (_c *OutputChunkCreate) SetSeq(v int64) *OutputChunkCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetStream sets the "stream" field.
+func (_c *OutputChunkCreate) SetStream(v outputchunk.Stream) *OutputChunkCreate {
+	_c.mutation.SetStream(v)
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *OutputChunkCreate) SetData(v string) *OutputChunkCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetNillableData sets the "data" field if the given value is not nil.
+func (_c *OutputChunkCreate) SetNillableData(v *string) *OutputChunkCreate {
+	if v != nil {
+		_c.SetData(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OutputChunkCreate) SetID(v string) *OutputChunkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OutputChunkMutation object of the builder.
+func (_c *OutputChunkCreate) Mutation() *OutputChunkMutation {
+	return _c.mutation
+}
+
+// Save creates the OutputChunk in the database.
+func (_c *OutputChunkCreate) Save(ctx context.Context) (*OutputChunk, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OutputChunkCreate) SaveX(ctx context.Context) *OutputChunk {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OutputChunkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OutputChunkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OutputChunkCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := outputchunk.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OutputChunkCreate) check() error {
+	if _, ok := _c.mutation.ExecutionID(); !ok {
+		return &ValidationError{Name: "execution_id", err: errors.New(`ent: missing required field "OutputChunk.execution_id"`)}
+	}
+	if v, ok := _c.mutation.ExecutionID(); ok {
+		if err := outputchunk.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.execution_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "OutputChunk.seq"`)}
+	}
+	if v, ok := _c.mutation.Seq(); ok {
+		if err := outputchunk.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.seq": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Stream(); !ok {
+		return &ValidationError{Name: "stream", err: errors.New(`ent: missing required field "OutputChunk.stream"`)}
+	}
+	if v, ok := _c.mutation.Stream(); ok {
+		if err := outputchunk.StreamValidator(v); err != nil {
+			return &ValidationError{Name: "stream", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.stream": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := outputchunk.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OutputChunkCreate) sqlSave(ctx context.Context) (*OutputChunk, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OutputChunk.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OutputChunkCreate) createSpec() (*OutputChunk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutputChunk{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(outputchunk.Table, sqlgraph.NewFieldSpec(outputchunk.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(outputchunk.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(outputchunk.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(outputchunk.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(outputchunk.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ExecutionID(); ok {
+		_spec.SetField(outputchunk.FieldExecutionID, field.TypeString, value)
+		_node.ExecutionID = value
+	}
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(outputchunk.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
+	if value, ok := _c.mutation.Stream(); ok {
+		_spec.SetField(outputchunk.FieldStream, field.TypeEnum, value)
+		_node.Stream = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(outputchunk.FieldData, field.TypeString, value)
+		_node.Data = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OutputChunk.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OutputChunkUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OutputChunkCreate) OnConflict(opts ...sql.ConflictOption) *OutputChunkUpsertOne {
+	_c.conflict = opts
+	return &OutputChunkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OutputChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OutputChunkCreate) OnConflictColumns(columns ...string) *OutputChunkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OutputChunkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OutputChunkUpsertOne is the builder for "upsert"-ing
+	//  one OutputChunk node.
+	OutputChunkUpsertOne struct {
+		create *OutputChunkCreate
+	}
+
+	// OutputChunkUpsert is the "OnConflict" setter.
+	OutputChunkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OutputChunkUpsert) SetUpdateTime(v time.Time) *OutputChunkUpsert {
+	u.Set(outputchunk.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OutputChunkUpsert) UpdateUpdateTime() *OutputChunkUpsert {
+	u.SetExcluded(outputchunk.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *OutputChunkUpsert) ClearUpdateTime() *OutputChunkUpsert {
+	u.SetNull(outputchunk.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *OutputChunkUpsert) SetDeleteTime(v time.Time) *OutputChunkUpsert {
+	u.Set(outputchunk.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *OutputChunkUpsert) UpdateDeleteTime() *OutputChunkUpsert {
+	u.SetExcluded(outputchunk.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *OutputChunkUpsert) ClearDeleteTime() *OutputChunkUpsert {
+	u.SetNull(outputchunk.FieldDeleteTime)
+	return u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *OutputChunkUpsert) SetExecutionID(v string) *OutputChunkUpsert {
+	u.Set(outputchunk.FieldExecutionID, v)
+	return u
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *OutputChunkUpsert) UpdateExecutionID() *OutputChunkUpsert {
+	u.SetExcluded(outputchunk.FieldExecutionID)
+	return u
+}
+
+// SetSeq sets the "seq" field.
+func (u *OutputChunkUpsert) SetSeq(v int64) *OutputChunkUpsert {
+	u.Set(outputchunk.FieldSeq, v)
+	return u
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *OutputChunkUpsert) UpdateSeq() *OutputChunkUpsert {
+	u.SetExcluded(outputchunk.FieldSeq)
+	return u
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *OutputChunkUpsert) AddSeq(v int64) *OutputChunkUpsert {
+	u.Add(outputchunk.FieldSeq, v)
+	return u
+}
+
+// SetStream sets the "stream" field.
+func (u *OutputChunkUpsert) SetStream(v outputchunk.Stream) *OutputChunkUpsert {
+	u.Set(outputchunk.FieldStream, v)
+	return u
+}
+
+// UpdateStream sets the "stream" field to the value that was provided on create.
+func (u *OutputChunkUpsert) UpdateStream() *OutputChunkUpsert {
+	u.SetExcluded(outputchunk.FieldStream)
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *OutputChunkUpsert) SetData(v string) *OutputChunkUpsert {
+	u.Set(outputchunk.FieldData, v)
+	return u
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *OutputChunkUpsert) UpdateData() *OutputChunkUpsert {
+	u.SetExcluded(outputchunk.FieldData)
+	return u
+}
+
+// ClearData clears the value of the "data" field.
+func (u *OutputChunkUpsert) ClearData() *OutputChunkUpsert {
+	u.SetNull(outputchunk.FieldData)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OutputChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(outputchunk.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OutputChunkUpsertOne) UpdateNewValues() *OutputChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(outputchunk.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(outputchunk.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(outputchunk.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OutputChunk.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OutputChunkUpsertOne) Ignore() *OutputChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OutputChunkUpsertOne) DoNothing() *OutputChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OutputChunkCreate.OnConflict
+// documentation for more info.
+func (u *OutputChunkUpsertOne) Update(set func(*OutputChunkUpsert)) *OutputChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OutputChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OutputChunkUpsertOne) SetUpdateTime(v time.Time) *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OutputChunkUpsertOne) UpdateUpdateTime() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *OutputChunkUpsertOne) ClearUpdateTime() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *OutputChunkUpsertOne) SetDeleteTime(v time.Time) *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *OutputChunkUpsertOne) UpdateDeleteTime() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *OutputChunkUpsertOne) ClearDeleteTime() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *OutputChunkUpsertOne) SetExecutionID(v string) *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetExecutionID(v)
+	})
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *OutputChunkUpsertOne) UpdateExecutionID() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateExecutionID()
+	})
+}
+
+// SetSeq sets the "seq" field.
+func (u *OutputChunkUpsertOne) SetSeq(v int64) *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetSeq(v)
+	})
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *OutputChunkUpsertOne) AddSeq(v int64) *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.AddSeq(v)
+	})
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *OutputChunkUpsertOne) UpdateSeq() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateSeq()
+	})
+}
+
+// SetStream sets the "stream" field.
+func (u *OutputChunkUpsertOne) SetStream(v outputchunk.Stream) *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetStream(v)
+	})
+}
+
+// UpdateStream sets the "stream" field to the value that was provided on create.
+func (u *OutputChunkUpsertOne) UpdateStream() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateStream()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *OutputChunkUpsertOne) SetData(v string) *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *OutputChunkUpsertOne) UpdateData() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateData()
+	})
+}
+
+// ClearData clears the value of the "data" field.
+func (u *OutputChunkUpsertOne) ClearData() *OutputChunkUpsertOne {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.ClearData()
+	})
+}
+
+// Exec executes the query.
+func (u *OutputChunkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OutputChunkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OutputChunkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OutputChunkUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OutputChunkUpsertOne.ID is not supported by MySQL driver. Use OutputChunkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OutputChunkUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OutputChunkCreateBulk is the builder for creating many OutputChunk entities in bulk.
+type OutputChunkCreateBulk struct {
+	config
+	err      error
+	builders []*OutputChunkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OutputChunk entities in the database.
+func (_c *OutputChunkCreateBulk) Save(ctx context.Context) ([]*OutputChunk, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OutputChunk, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutputChunkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OutputChunkCreateBulk) SaveX(ctx context.Context) []*OutputChunk {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OutputChunkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OutputChunkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OutputChunk.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OutputChunkUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OutputChunkCreateBulk) OnConflict(opts ...sql.ConflictOption) *OutputChunkUpsertBulk {
+	_c.conflict = opts
+	return &OutputChunkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OutputChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OutputChunkCreateBulk) OnConflictColumns(columns ...string) *OutputChunkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OutputChunkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OutputChunkUpsertBulk is the builder for "upsert"-ing
+// a bulk of OutputChunk nodes.
+type OutputChunkUpsertBulk struct {
+	create *OutputChunkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OutputChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(outputchunk.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OutputChunkUpsertBulk) UpdateNewValues() *OutputChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(outputchunk.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(outputchunk.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(outputchunk.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OutputChunk.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OutputChunkUpsertBulk) Ignore() *OutputChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OutputChunkUpsertBulk) DoNothing() *OutputChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OutputChunkCreateBulk.OnConflict
+// documentation for more info.
+func (u *OutputChunkUpsertBulk) Update(set func(*OutputChunkUpsert)) *OutputChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OutputChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OutputChunkUpsertBulk) SetUpdateTime(v time.Time) *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OutputChunkUpsertBulk) UpdateUpdateTime() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *OutputChunkUpsertBulk) ClearUpdateTime() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *OutputChunkUpsertBulk) SetDeleteTime(v time.Time) *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *OutputChunkUpsertBulk) UpdateDeleteTime() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *OutputChunkUpsertBulk) ClearDeleteTime() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *OutputChunkUpsertBulk) SetExecutionID(v string) *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetExecutionID(v)
+	})
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *OutputChunkUpsertBulk) UpdateExecutionID() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateExecutionID()
+	})
+}
+
+// SetSeq sets the "seq" field.
+func (u *OutputChunkUpsertBulk) SetSeq(v int64) *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetSeq(v)
+	})
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *OutputChunkUpsertBulk) AddSeq(v int64) *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.AddSeq(v)
+	})
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *OutputChunkUpsertBulk) UpdateSeq() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateSeq()
+	})
+}
+
+// SetStream sets the "stream" field.
+func (u *OutputChunkUpsertBulk) SetStream(v outputchunk.Stream) *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetStream(v)
+	})
+}
+
+// UpdateStream sets the "stream" field to the value that was provided on create.
+func (u *OutputChunkUpsertBulk) UpdateStream() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateStream()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *OutputChunkUpsertBulk) SetData(v string) *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *OutputChunkUpsertBulk) UpdateData() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.UpdateData()
+	})
+}
+
+// ClearData clears the value of the "data" field.
+func (u *OutputChunkUpsertBulk) ClearData() *OutputChunkUpsertBulk {
+	return u.Update(func(s *OutputChunkUpsert) {
+		s.ClearData()
+	})
+}
+
+// Exec executes the query.
+func (u *OutputChunkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OutputChunkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OutputChunkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OutputChunkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
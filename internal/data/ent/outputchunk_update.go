@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/outputchunk"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// OutputChunkUpdate is the builder for updating OutputChunk entities.
+type OutputChunkUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OutputChunkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OutputChunkUpdate builder.
+func (_u *OutputChunkUpdate) Where(ps ...predicate.OutputChunk) *OutputChunkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OutputChunkUpdate) SetUpdateTime(v time.Time) *OutputChunkUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *OutputChunkUpdate) SetNillableUpdateTime(v *time.Time) *OutputChunkUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *OutputChunkUpdate) ClearUpdateTime() *OutputChunkUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *OutputChunkUpdate) SetDeleteTime(v time.Time) *OutputChunkUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *OutputChunkUpdate) SetNillableDeleteTime(v *time.Time) *OutputChunkUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *OutputChunkUpdate) ClearDeleteTime() *OutputChunkUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_u *OutputChunkUpdate) SetExecutionID(v string) *OutputChunkUpdate {
+	_u.mutation.SetExecutionID(v)
+	return _u
+}
+
+// SetNillableExecutionID sets the "execution_id" field if the given value is not nil.
+func (_u *OutputChunkUpdate) SetNillableExecutionID(v *string) *OutputChunkUpdate {
+	if v != nil {
+		_u.SetExecutionID(*v)
+	}
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *OutputChunkUpdate) SetSeq(v int64) *OutputChunkUpdate {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *OutputChunkUpdate) SetNillableSeq(v *int64) *OutputChunkUpdate {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *OutputChunkUpdate) AddSeq(v int64) *OutputChunkUpdate {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetStream sets the "stream" field.
+func (_u *OutputChunkUpdate) SetStream(v outputchunk.Stream) *OutputChunkUpdate {
+	_u.mutation.SetStream(v)
+	return _u
+}
+
+// SetNillableStream sets the "stream" field if the given value is not nil.
+func (_u *OutputChunkUpdate) SetNillableStream(v *outputchunk.Stream) *OutputChunkUpdate {
+	if v != nil {
+		_u.SetStream(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *OutputChunkUpdate) SetData(v string) *OutputChunkUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetNillableData sets the "data" field if the given value is not nil.
+func (_u *OutputChunkUpdate) SetNillableData(v *string) *OutputChunkUpdate {
+	if v != nil {
+		_u.SetData(*v)
+	}
+	return _u
+}
+
+// ClearData clears the value of the "data" field.
+func (_u *OutputChunkUpdate) ClearData() *OutputChunkUpdate {
+	_u.mutation.ClearData()
+	return _u
+}
+
+// Mutation returns the OutputChunkMutation object of the builder.
+func (_u *OutputChunkUpdate) Mutation() *OutputChunkMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OutputChunkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutputChunkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OutputChunkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutputChunkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OutputChunkUpdate) check() error {
+	if v, ok := _u.mutation.ExecutionID(); ok {
+		if err := outputchunk.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Seq(); ok {
+		if err := outputchunk.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.seq": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Stream(); ok {
+		if err := outputchunk.StreamValidator(v); err != nil {
+			return &ValidationError{Name: "stream", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.stream": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OutputChunkUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OutputChunkUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OutputChunkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outputchunk.Table, outputchunk.Columns, sqlgraph.NewFieldSpec(outputchunk.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(outputchunk.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(outputchunk.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(outputchunk.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(outputchunk.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(outputchunk.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(outputchunk.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ExecutionID(); ok {
+		_spec.SetField(outputchunk.FieldExecutionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(outputchunk.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(outputchunk.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Stream(); ok {
+		_spec.SetField(outputchunk.FieldStream, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(outputchunk.FieldData, field.TypeString, value)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(outputchunk.FieldData, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outputchunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OutputChunkUpdateOne is the builder for updating a single OutputChunk entity.
+type OutputChunkUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OutputChunkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OutputChunkUpdateOne) SetUpdateTime(v time.Time) *OutputChunkUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *OutputChunkUpdateOne) SetNillableUpdateTime(v *time.Time) *OutputChunkUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *OutputChunkUpdateOne) ClearUpdateTime() *OutputChunkUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *OutputChunkUpdateOne) SetDeleteTime(v time.Time) *OutputChunkUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *OutputChunkUpdateOne) SetNillableDeleteTime(v *time.Time) *OutputChunkUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *OutputChunkUpdateOne) ClearDeleteTime() *OutputChunkUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_u *OutputChunkUpdateOne) SetExecutionID(v string) *OutputChunkUpdateOne {
+	_u.mutation.SetExecutionID(v)
+	return _u
+}
+
+// SetNillableExecutionID sets the "execution_id" field if the given value is not nil.
+func (_u *OutputChunkUpdateOne) SetNillableExecutionID(v *string) *OutputChunkUpdateOne {
+	if v != nil {
+		_u.SetExecutionID(*v)
+	}
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *OutputChunkUpdateOne) SetSeq(v int64) *OutputChunkUpdateOne {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *OutputChunkUpdateOne) SetNillableSeq(v *int64) *OutputChunkUpdateOne {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *OutputChunkUpdateOne) AddSeq(v int64) *OutputChunkUpdateOne {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetStream sets the "stream" field.
+func (_u *OutputChunkUpdateOne) SetStream(v outputchunk.Stream) *OutputChunkUpdateOne {
+	_u.mutation.SetStream(v)
+	return _u
+}
+
+// SetNillableStream sets the "stream" field if the given value is not nil.
+func (_u *OutputChunkUpdateOne) SetNillableStream(v *outputchunk.Stream) *OutputChunkUpdateOne {
+	if v != nil {
+		_u.SetStream(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *OutputChunkUpdateOne) SetData(v string) *OutputChunkUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetNillableData sets the "data" field if the given value is not nil.
+func (_u *OutputChunkUpdateOne) SetNillableData(v *string) *OutputChunkUpdateOne {
+	if v != nil {
+		_u.SetData(*v)
+	}
+	return _u
+}
+
+// ClearData clears the value of the "data" field.
+func (_u *OutputChunkUpdateOne) ClearData() *OutputChunkUpdateOne {
+	_u.mutation.ClearData()
+	return _u
+}
+
+// Mutation returns the OutputChunkMutation object of the builder.
+func (_u *OutputChunkUpdateOne) Mutation() *OutputChunkMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OutputChunkUpdate builder.
+func (_u *OutputChunkUpdateOne) Where(ps ...predicate.OutputChunk) *OutputChunkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OutputChunkUpdateOne) Select(field string, fields ...string) *OutputChunkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OutputChunk entity.
+func (_u *OutputChunkUpdateOne) Save(ctx context.Context) (*OutputChunk, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutputChunkUpdateOne) SaveX(ctx context.Context) *OutputChunk {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OutputChunkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutputChunkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OutputChunkUpdateOne) check() error {
+	if v, ok := _u.mutation.ExecutionID(); ok {
+		if err := outputchunk.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Seq(); ok {
+		if err := outputchunk.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.seq": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Stream(); ok {
+		if err := outputchunk.StreamValidator(v); err != nil {
+			return &ValidationError{Name: "stream", err: fmt.Errorf(`ent: validator failed for field "OutputChunk.stream": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OutputChunkUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OutputChunkUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OutputChunkUpdateOne) sqlSave(ctx context.Context) (_node *OutputChunk, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outputchunk.Table, outputchunk.Columns, sqlgraph.NewFieldSpec(outputchunk.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutputChunk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outputchunk.FieldID)
+		for _, f := range fields {
+			if !outputchunk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outputchunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(outputchunk.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(outputchunk.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(outputchunk.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(outputchunk.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(outputchunk.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(outputchunk.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ExecutionID(); ok {
+		_spec.SetField(outputchunk.FieldExecutionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(outputchunk.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(outputchunk.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Stream(); ok {
+		_spec.SetField(outputchunk.FieldStream, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(outputchunk.FieldData, field.TypeString, value)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(outputchunk.FieldData, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &OutputChunk{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outputchunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
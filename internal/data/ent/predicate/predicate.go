@@ -9,11 +9,68 @@ import (
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// ClientGroup is the predicate function for clientgroup builders.
+type ClientGroup func(*sql.Selector)
+
+// ClientLabelSet is the predicate function for clientlabelset builders.
+type ClientLabelSet func(*sql.Selector)
+
+// ClientLock is the predicate function for clientlock builders.
+type ClientLock func(*sql.Selector)
+
+// Command is the predicate function for command builders.
+type Command func(*sql.Selector)
+
+// EventRule is the predicate function for eventrule builders.
+type EventRule func(*sql.Selector)
+
 // ExecutionLog is the predicate function for executionlog builders.
 type ExecutionLog func(*sql.Selector)
 
+// ExecutionRun is the predicate function for executionrun builders.
+type ExecutionRun func(*sql.Selector)
+
+// MaintenanceWindow is the predicate function for maintenancewindow builders.
+type MaintenanceWindow func(*sql.Selector)
+
+// ManagedClient is the predicate function for managedclient builders.
+type ManagedClient func(*sql.Selector)
+
+// OutputChunk is the predicate function for outputchunk builders.
+type OutputChunk func(*sql.Selector)
+
+// ProtectedClient is the predicate function for protectedclient builders.
+type ProtectedClient func(*sql.Selector)
+
+// QueuedCommand is the predicate function for queuedcommand builders.
+type QueuedCommand func(*sql.Selector)
+
+// Schedule is the predicate function for schedule builders.
+type Schedule func(*sql.Selector)
+
 // Script is the predicate function for script builders.
 type Script func(*sql.Selector)
 
 // ScriptAssignment is the predicate function for scriptassignment builders.
 type ScriptAssignment func(*sql.Selector)
+
+// ScriptChangeRequest is the predicate function for scriptchangerequest builders.
+type ScriptChangeRequest func(*sql.Selector)
+
+// ScriptVersion is the predicate function for scriptversion builders.
+type ScriptVersion func(*sql.Selector)
+
+// Secret is the predicate function for secret builders.
+type Secret func(*sql.Selector)
+
+// SigningKey is the predicate function for signingkey builders.
+type SigningKey func(*sql.Selector)
+
+// TenantSetting is the predicate function for tenantsetting builders.
+type TenantSetting func(*sql.Selector)
+
+// Workflow is the predicate function for workflow builders.
+type Workflow func(*sql.Selector)
+
+// WorkflowRun is the predicate function for workflowrun builders.
+type WorkflowRun func(*sql.Selector)
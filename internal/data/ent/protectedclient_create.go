@@ -0,0 +1,898 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/protectedclient"
+)
+
+// ProtectedClientCreate is the builder for creating a ProtectedClient entity.
+type ProtectedClientCreate struct {
+	config
+	mutation *ProtectedClientMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ProtectedClientCreate) SetCreateBy(v uint32) *ProtectedClientCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ProtectedClientCreate) SetNillableCreateBy(v *uint32) *ProtectedClientCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ProtectedClientCreate) SetCreateTime(v time.Time) *ProtectedClientCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ProtectedClientCreate) SetNillableCreateTime(v *time.Time) *ProtectedClientCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ProtectedClientCreate) SetUpdateTime(v time.Time) *ProtectedClientCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ProtectedClientCreate) SetNillableUpdateTime(v *time.Time) *ProtectedClientCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ProtectedClientCreate) SetDeleteTime(v time.Time) *ProtectedClientCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ProtectedClientCreate) SetNillableDeleteTime(v *time.Time) *ProtectedClientCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ProtectedClientCreate) SetTenantID(v uint32) *ProtectedClientCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ProtectedClientCreate) SetNillableTenantID(v *uint32) *ProtectedClientCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *ProtectedClientCreate) SetClientID(v string) *ProtectedClientCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ProtectedClientCreate) SetReason(v string) *ProtectedClientCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ProtectedClientCreate) SetNillableReason(v *string) *ProtectedClientCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProtectedClientCreate) SetID(v string) *ProtectedClientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ProtectedClientMutation object of the builder.
+func (_c *ProtectedClientCreate) Mutation() *ProtectedClientMutation {
+	return _c.mutation
+}
+
+// Save creates the ProtectedClient in the database.
+func (_c *ProtectedClientCreate) Save(ctx context.Context) (*ProtectedClient, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProtectedClientCreate) SaveX(ctx context.Context) *ProtectedClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProtectedClientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProtectedClientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProtectedClientCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := protectedclient.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProtectedClientCreate) check() error {
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "ProtectedClient.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := protectedclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ProtectedClient.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := protectedclient.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ProtectedClient.reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := protectedclient.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ProtectedClient.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ProtectedClientCreate) sqlSave(ctx context.Context) (*ProtectedClient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ProtectedClient.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProtectedClientCreate) createSpec() (*ProtectedClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProtectedClient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(protectedclient.Table, sqlgraph.NewFieldSpec(protectedclient.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(protectedclient.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(protectedclient.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(protectedclient.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(protectedclient.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(protectedclient.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(protectedclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(protectedclient.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProtectedClient.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProtectedClientUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProtectedClientCreate) OnConflict(opts ...sql.ConflictOption) *ProtectedClientUpsertOne {
+	_c.conflict = opts
+	return &ProtectedClientUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProtectedClient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProtectedClientCreate) OnConflictColumns(columns ...string) *ProtectedClientUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProtectedClientUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProtectedClientUpsertOne is the builder for "upsert"-ing
+	//  one ProtectedClient node.
+	ProtectedClientUpsertOne struct {
+		create *ProtectedClientCreate
+	}
+
+	// ProtectedClientUpsert is the "OnConflict" setter.
+	ProtectedClientUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ProtectedClientUpsert) SetCreateBy(v uint32) *ProtectedClientUpsert {
+	u.Set(protectedclient.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ProtectedClientUpsert) UpdateCreateBy() *ProtectedClientUpsert {
+	u.SetExcluded(protectedclient.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ProtectedClientUpsert) AddCreateBy(v uint32) *ProtectedClientUpsert {
+	u.Add(protectedclient.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ProtectedClientUpsert) ClearCreateBy() *ProtectedClientUpsert {
+	u.SetNull(protectedclient.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProtectedClientUpsert) SetUpdateTime(v time.Time) *ProtectedClientUpsert {
+	u.Set(protectedclient.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProtectedClientUpsert) UpdateUpdateTime() *ProtectedClientUpsert {
+	u.SetExcluded(protectedclient.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ProtectedClientUpsert) ClearUpdateTime() *ProtectedClientUpsert {
+	u.SetNull(protectedclient.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ProtectedClientUpsert) SetDeleteTime(v time.Time) *ProtectedClientUpsert {
+	u.Set(protectedclient.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ProtectedClientUpsert) UpdateDeleteTime() *ProtectedClientUpsert {
+	u.SetExcluded(protectedclient.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ProtectedClientUpsert) ClearDeleteTime() *ProtectedClientUpsert {
+	u.SetNull(protectedclient.FieldDeleteTime)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ProtectedClientUpsert) SetClientID(v string) *ProtectedClientUpsert {
+	u.Set(protectedclient.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ProtectedClientUpsert) UpdateClientID() *ProtectedClientUpsert {
+	u.SetExcluded(protectedclient.FieldClientID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ProtectedClientUpsert) SetReason(v string) *ProtectedClientUpsert {
+	u.Set(protectedclient.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ProtectedClientUpsert) UpdateReason() *ProtectedClientUpsert {
+	u.SetExcluded(protectedclient.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ProtectedClientUpsert) ClearReason() *ProtectedClientUpsert {
+	u.SetNull(protectedclient.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProtectedClient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(protectedclient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProtectedClientUpsertOne) UpdateNewValues() *ProtectedClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(protectedclient.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(protectedclient.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(protectedclient.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProtectedClient.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProtectedClientUpsertOne) Ignore() *ProtectedClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProtectedClientUpsertOne) DoNothing() *ProtectedClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProtectedClientCreate.OnConflict
+// documentation for more info.
+func (u *ProtectedClientUpsertOne) Update(set func(*ProtectedClientUpsert)) *ProtectedClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProtectedClientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ProtectedClientUpsertOne) SetCreateBy(v uint32) *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ProtectedClientUpsertOne) AddCreateBy(v uint32) *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ProtectedClientUpsertOne) UpdateCreateBy() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ProtectedClientUpsertOne) ClearCreateBy() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProtectedClientUpsertOne) SetUpdateTime(v time.Time) *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProtectedClientUpsertOne) UpdateUpdateTime() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ProtectedClientUpsertOne) ClearUpdateTime() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ProtectedClientUpsertOne) SetDeleteTime(v time.Time) *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ProtectedClientUpsertOne) UpdateDeleteTime() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ProtectedClientUpsertOne) ClearDeleteTime() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ProtectedClientUpsertOne) SetClientID(v string) *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ProtectedClientUpsertOne) UpdateClientID() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ProtectedClientUpsertOne) SetReason(v string) *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ProtectedClientUpsertOne) UpdateReason() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ProtectedClientUpsertOne) ClearReason() *ProtectedClientUpsertOne {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *ProtectedClientUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProtectedClientCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProtectedClientUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProtectedClientUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProtectedClientUpsertOne.ID is not supported by MySQL driver. Use ProtectedClientUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProtectedClientUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProtectedClientCreateBulk is the builder for creating many ProtectedClient entities in bulk.
+type ProtectedClientCreateBulk struct {
+	config
+	err      error
+	builders []*ProtectedClientCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProtectedClient entities in the database.
+func (_c *ProtectedClientCreateBulk) Save(ctx context.Context) ([]*ProtectedClient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProtectedClient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProtectedClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProtectedClientCreateBulk) SaveX(ctx context.Context) []*ProtectedClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProtectedClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProtectedClientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProtectedClient.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProtectedClientUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProtectedClientCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProtectedClientUpsertBulk {
+	_c.conflict = opts
+	return &ProtectedClientUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProtectedClient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProtectedClientCreateBulk) OnConflictColumns(columns ...string) *ProtectedClientUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProtectedClientUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProtectedClientUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProtectedClient nodes.
+type ProtectedClientUpsertBulk struct {
+	create *ProtectedClientCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProtectedClient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(protectedclient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProtectedClientUpsertBulk) UpdateNewValues() *ProtectedClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(protectedclient.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(protectedclient.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(protectedclient.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProtectedClient.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProtectedClientUpsertBulk) Ignore() *ProtectedClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProtectedClientUpsertBulk) DoNothing() *ProtectedClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProtectedClientCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProtectedClientUpsertBulk) Update(set func(*ProtectedClientUpsert)) *ProtectedClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProtectedClientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ProtectedClientUpsertBulk) SetCreateBy(v uint32) *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ProtectedClientUpsertBulk) AddCreateBy(v uint32) *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ProtectedClientUpsertBulk) UpdateCreateBy() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ProtectedClientUpsertBulk) ClearCreateBy() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProtectedClientUpsertBulk) SetUpdateTime(v time.Time) *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProtectedClientUpsertBulk) UpdateUpdateTime() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ProtectedClientUpsertBulk) ClearUpdateTime() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ProtectedClientUpsertBulk) SetDeleteTime(v time.Time) *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ProtectedClientUpsertBulk) UpdateDeleteTime() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ProtectedClientUpsertBulk) ClearDeleteTime() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *ProtectedClientUpsertBulk) SetClientID(v string) *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *ProtectedClientUpsertBulk) UpdateClientID() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ProtectedClientUpsertBulk) SetReason(v string) *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ProtectedClientUpsertBulk) UpdateReason() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ProtectedClientUpsertBulk) ClearReason() *ProtectedClientUpsertBulk {
+	return u.Update(func(s *ProtectedClientUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *ProtectedClientUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProtectedClientCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProtectedClientCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProtectedClientUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
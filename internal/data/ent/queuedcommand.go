@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/queuedcommand"
+)
+
+// QueuedCommand is the model entity for the QueuedCommand schema.
+type QueuedCommand struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Command ID (UUID primary key)
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// mTLS client CN the command is addressed to
+	ClientID string `json:"client_id,omitempty"`
+	// FK to executor_execution_logs, empty for non-execution commands
+	ExecutionID string `json:"execution_id,omitempty"`
+	// Type of the queued command
+	CommandType queuedcommand.CommandType `json:"command_type,omitempty"`
+	// Serialized ExecutionCommand delivered to the client
+	Payload []byte `json:"payload,omitempty"`
+	// When the command is discarded if still undelivered
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QueuedCommand) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case queuedcommand.FieldPayload:
+			values[i] = new([]byte)
+		case queuedcommand.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case queuedcommand.FieldID, queuedcommand.FieldClientID, queuedcommand.FieldExecutionID, queuedcommand.FieldCommandType:
+			values[i] = new(sql.NullString)
+		case queuedcommand.FieldCreateTime, queuedcommand.FieldUpdateTime, queuedcommand.FieldDeleteTime, queuedcommand.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QueuedCommand fields.
+func (_m *QueuedCommand) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case queuedcommand.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case queuedcommand.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case queuedcommand.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case queuedcommand.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case queuedcommand.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case queuedcommand.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case queuedcommand.FieldExecutionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field execution_id", values[i])
+			} else if value.Valid {
+				_m.ExecutionID = value.String
+			}
+		case queuedcommand.FieldCommandType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field command_type", values[i])
+			} else if value.Valid {
+				_m.CommandType = queuedcommand.CommandType(value.String)
+			}
+		case queuedcommand.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				_m.Payload = *value
+			}
+		case queuedcommand.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QueuedCommand.
+// This includes values selected through modifiers, order, etc.
+func (_m *QueuedCommand) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QueuedCommand.
+// Note that you need to call QueuedCommand.Unwrap() before calling this method if this QueuedCommand
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QueuedCommand) Update() *QueuedCommandUpdateOne {
+	return NewQueuedCommandClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QueuedCommand entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QueuedCommand) Unwrap() *QueuedCommand {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QueuedCommand is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QueuedCommand) String() string {
+	var builder strings.Builder
+	builder.WriteString("QueuedCommand(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("execution_id=")
+	builder.WriteString(_m.ExecutionID)
+	builder.WriteString(", ")
+	builder.WriteString("command_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CommandType))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QueuedCommands is a parsable slice of QueuedCommand.
+type QueuedCommands []*QueuedCommand
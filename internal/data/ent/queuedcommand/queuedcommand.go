@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queuedcommand
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the queuedcommand type in the database.
+	Label = "queued_command"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldExecutionID holds the string denoting the execution_id field in the database.
+	FieldExecutionID = "execution_id"
+	// FieldCommandType holds the string denoting the command_type field in the database.
+	FieldCommandType = "command_type"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the queuedcommand in the database.
+	Table = "executor_queued_commands"
+)
+
+// Columns holds all SQL columns for queuedcommand fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldClientID,
+	FieldExecutionID,
+	FieldCommandType,
+	FieldPayload,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-executor/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ClientIDValidator func(string) error
+	// ExecutionIDValidator is a validator for the "execution_id" field. It is called by the builders before save.
+	ExecutionIDValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// CommandType defines the type for the "command_type" enum field.
+type CommandType string
+
+// CommandTypeSCRIPT_EXECUTION is the default value of the CommandType enum.
+const DefaultCommandType = CommandTypeSCRIPT_EXECUTION
+
+// CommandType values.
+const (
+	CommandTypeSCRIPT_EXECUTION CommandType = "SCRIPT_EXECUTION"
+	CommandTypeCLIENT_UPDATE    CommandType = "CLIENT_UPDATE"
+	CommandTypeCANCEL           CommandType = "CANCEL"
+)
+
+func (ct CommandType) String() string {
+	return string(ct)
+}
+
+// CommandTypeValidator is a validator for the "command_type" field enum values. It is called by the builders before save.
+func CommandTypeValidator(ct CommandType) error {
+	switch ct {
+	case CommandTypeSCRIPT_EXECUTION, CommandTypeCLIENT_UPDATE, CommandTypeCANCEL:
+		return nil
+	default:
+		return fmt.Errorf("queuedcommand: invalid enum value for command_type field: %q", ct)
+	}
+}
+
+// OrderOption defines the ordering options for the QueuedCommand queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByExecutionID orders the results by the execution_id field.
+func ByExecutionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutionID, opts...).ToFunc()
+}
+
+// ByCommandType orders the results by the command_type field.
+func ByCommandType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommandType, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
@@ -0,0 +1,976 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/queuedcommand"
+)
+
+// QueuedCommandCreate is the builder for creating a QueuedCommand entity.
+type QueuedCommandCreate struct {
+	config
+	mutation *QueuedCommandMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *QueuedCommandCreate) SetCreateTime(v time.Time) *QueuedCommandCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *QueuedCommandCreate) SetNillableCreateTime(v *time.Time) *QueuedCommandCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *QueuedCommandCreate) SetUpdateTime(v time.Time) *QueuedCommandCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *QueuedCommandCreate) SetNillableUpdateTime(v *time.Time) *QueuedCommandCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *QueuedCommandCreate) SetDeleteTime(v time.Time) *QueuedCommandCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *QueuedCommandCreate) SetNillableDeleteTime(v *time.Time) *QueuedCommandCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *QueuedCommandCreate) SetTenantID(v uint32) *QueuedCommandCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *QueuedCommandCreate) SetNillableTenantID(v *uint32) *QueuedCommandCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *QueuedCommandCreate) SetClientID(v string) *QueuedCommandCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_c *QueuedCommandCreate) SetExecutionID(v string) *QueuedCommandCreate {
+	_c.mutation.SetExecutionID(v)
+	return _c
+}
+
+// SetNillableExecutionID sets the "execution_id" field if the given value is not nil.
+func (_c *QueuedCommandCreate) SetNillableExecutionID(v *string) *QueuedCommandCreate {
+	if v != nil {
+		_c.SetExecutionID(*v)
+	}
+	return _c
+}
+
+// SetCommandType sets the "command_type" field.
+func (_c *QueuedCommandCreate) SetCommandType(v queuedcommand.CommandType) *QueuedCommandCreate {
+	_c.mutation.SetCommandType(v)
+	return _c
+}
+
+// SetNillableCommandType sets the "command_type" field if the given value is not nil.
+func (_c *QueuedCommandCreate) SetNillableCommandType(v *queuedcommand.CommandType) *QueuedCommandCreate {
+	if v != nil {
+		_c.SetCommandType(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *QueuedCommandCreate) SetPayload(v []byte) *QueuedCommandCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *QueuedCommandCreate) SetExpiresAt(v time.Time) *QueuedCommandCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QueuedCommandCreate) SetID(v string) *QueuedCommandCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the QueuedCommandMutation object of the builder.
+func (_c *QueuedCommandCreate) Mutation() *QueuedCommandMutation {
+	return _c.mutation
+}
+
+// Save creates the QueuedCommand in the database.
+func (_c *QueuedCommandCreate) Save(ctx context.Context) (*QueuedCommand, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QueuedCommandCreate) SaveX(ctx context.Context) *QueuedCommand {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QueuedCommandCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QueuedCommandCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QueuedCommandCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := queuedcommand.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.CommandType(); !ok {
+		v := queuedcommand.DefaultCommandType
+		_c.mutation.SetCommandType(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QueuedCommandCreate) check() error {
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "QueuedCommand.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := queuedcommand.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ExecutionID(); ok {
+		if err := queuedcommand.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.execution_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CommandType(); !ok {
+		return &ValidationError{Name: "command_type", err: errors.New(`ent: missing required field "QueuedCommand.command_type"`)}
+	}
+	if v, ok := _c.mutation.CommandType(); ok {
+		if err := queuedcommand.CommandTypeValidator(v); err != nil {
+			return &ValidationError{Name: "command_type", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.command_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "QueuedCommand.payload"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "QueuedCommand.expires_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := queuedcommand.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *QueuedCommandCreate) sqlSave(ctx context.Context) (*QueuedCommand, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected QueuedCommand.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QueuedCommandCreate) createSpec() (*QueuedCommand, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QueuedCommand{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(queuedcommand.Table, sqlgraph.NewFieldSpec(queuedcommand.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(queuedcommand.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(queuedcommand.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(queuedcommand.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(queuedcommand.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(queuedcommand.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.ExecutionID(); ok {
+		_spec.SetField(queuedcommand.FieldExecutionID, field.TypeString, value)
+		_node.ExecutionID = value
+	}
+	if value, ok := _c.mutation.CommandType(); ok {
+		_spec.SetField(queuedcommand.FieldCommandType, field.TypeEnum, value)
+		_node.CommandType = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(queuedcommand.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(queuedcommand.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QueuedCommand.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QueuedCommandUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QueuedCommandCreate) OnConflict(opts ...sql.ConflictOption) *QueuedCommandUpsertOne {
+	_c.conflict = opts
+	return &QueuedCommandUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QueuedCommand.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QueuedCommandCreate) OnConflictColumns(columns ...string) *QueuedCommandUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QueuedCommandUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// QueuedCommandUpsertOne is the builder for "upsert"-ing
+	//  one QueuedCommand node.
+	QueuedCommandUpsertOne struct {
+		create *QueuedCommandCreate
+	}
+
+	// QueuedCommandUpsert is the "OnConflict" setter.
+	QueuedCommandUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *QueuedCommandUpsert) SetUpdateTime(v time.Time) *QueuedCommandUpsert {
+	u.Set(queuedcommand.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *QueuedCommandUpsert) UpdateUpdateTime() *QueuedCommandUpsert {
+	u.SetExcluded(queuedcommand.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *QueuedCommandUpsert) ClearUpdateTime() *QueuedCommandUpsert {
+	u.SetNull(queuedcommand.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *QueuedCommandUpsert) SetDeleteTime(v time.Time) *QueuedCommandUpsert {
+	u.Set(queuedcommand.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *QueuedCommandUpsert) UpdateDeleteTime() *QueuedCommandUpsert {
+	u.SetExcluded(queuedcommand.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *QueuedCommandUpsert) ClearDeleteTime() *QueuedCommandUpsert {
+	u.SetNull(queuedcommand.FieldDeleteTime)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *QueuedCommandUpsert) SetClientID(v string) *QueuedCommandUpsert {
+	u.Set(queuedcommand.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *QueuedCommandUpsert) UpdateClientID() *QueuedCommandUpsert {
+	u.SetExcluded(queuedcommand.FieldClientID)
+	return u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *QueuedCommandUpsert) SetExecutionID(v string) *QueuedCommandUpsert {
+	u.Set(queuedcommand.FieldExecutionID, v)
+	return u
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *QueuedCommandUpsert) UpdateExecutionID() *QueuedCommandUpsert {
+	u.SetExcluded(queuedcommand.FieldExecutionID)
+	return u
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (u *QueuedCommandUpsert) ClearExecutionID() *QueuedCommandUpsert {
+	u.SetNull(queuedcommand.FieldExecutionID)
+	return u
+}
+
+// SetCommandType sets the "command_type" field.
+func (u *QueuedCommandUpsert) SetCommandType(v queuedcommand.CommandType) *QueuedCommandUpsert {
+	u.Set(queuedcommand.FieldCommandType, v)
+	return u
+}
+
+// UpdateCommandType sets the "command_type" field to the value that was provided on create.
+func (u *QueuedCommandUpsert) UpdateCommandType() *QueuedCommandUpsert {
+	u.SetExcluded(queuedcommand.FieldCommandType)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *QueuedCommandUpsert) SetPayload(v []byte) *QueuedCommandUpsert {
+	u.Set(queuedcommand.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *QueuedCommandUpsert) UpdatePayload() *QueuedCommandUpsert {
+	u.SetExcluded(queuedcommand.FieldPayload)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *QueuedCommandUpsert) SetExpiresAt(v time.Time) *QueuedCommandUpsert {
+	u.Set(queuedcommand.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *QueuedCommandUpsert) UpdateExpiresAt() *QueuedCommandUpsert {
+	u.SetExcluded(queuedcommand.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.QueuedCommand.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(queuedcommand.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QueuedCommandUpsertOne) UpdateNewValues() *QueuedCommandUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(queuedcommand.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(queuedcommand.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(queuedcommand.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QueuedCommand.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QueuedCommandUpsertOne) Ignore() *QueuedCommandUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QueuedCommandUpsertOne) DoNothing() *QueuedCommandUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QueuedCommandCreate.OnConflict
+// documentation for more info.
+func (u *QueuedCommandUpsertOne) Update(set func(*QueuedCommandUpsert)) *QueuedCommandUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QueuedCommandUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *QueuedCommandUpsertOne) SetUpdateTime(v time.Time) *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *QueuedCommandUpsertOne) UpdateUpdateTime() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *QueuedCommandUpsertOne) ClearUpdateTime() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *QueuedCommandUpsertOne) SetDeleteTime(v time.Time) *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *QueuedCommandUpsertOne) UpdateDeleteTime() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *QueuedCommandUpsertOne) ClearDeleteTime() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *QueuedCommandUpsertOne) SetClientID(v string) *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *QueuedCommandUpsertOne) UpdateClientID() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *QueuedCommandUpsertOne) SetExecutionID(v string) *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetExecutionID(v)
+	})
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *QueuedCommandUpsertOne) UpdateExecutionID() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateExecutionID()
+	})
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (u *QueuedCommandUpsertOne) ClearExecutionID() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.ClearExecutionID()
+	})
+}
+
+// SetCommandType sets the "command_type" field.
+func (u *QueuedCommandUpsertOne) SetCommandType(v queuedcommand.CommandType) *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetCommandType(v)
+	})
+}
+
+// UpdateCommandType sets the "command_type" field to the value that was provided on create.
+func (u *QueuedCommandUpsertOne) UpdateCommandType() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateCommandType()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *QueuedCommandUpsertOne) SetPayload(v []byte) *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *QueuedCommandUpsertOne) UpdatePayload() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *QueuedCommandUpsertOne) SetExpiresAt(v time.Time) *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *QueuedCommandUpsertOne) UpdateExpiresAt() *QueuedCommandUpsertOne {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QueuedCommandUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QueuedCommandCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QueuedCommandUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QueuedCommandUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QueuedCommandUpsertOne.ID is not supported by MySQL driver. Use QueuedCommandUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QueuedCommandUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QueuedCommandCreateBulk is the builder for creating many QueuedCommand entities in bulk.
+type QueuedCommandCreateBulk struct {
+	config
+	err      error
+	builders []*QueuedCommandCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the QueuedCommand entities in the database.
+func (_c *QueuedCommandCreateBulk) Save(ctx context.Context) ([]*QueuedCommand, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QueuedCommand, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QueuedCommandMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QueuedCommandCreateBulk) SaveX(ctx context.Context) []*QueuedCommand {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QueuedCommandCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QueuedCommandCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QueuedCommand.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QueuedCommandUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QueuedCommandCreateBulk) OnConflict(opts ...sql.ConflictOption) *QueuedCommandUpsertBulk {
+	_c.conflict = opts
+	return &QueuedCommandUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QueuedCommand.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QueuedCommandCreateBulk) OnConflictColumns(columns ...string) *QueuedCommandUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QueuedCommandUpsertBulk{
+		create: _c,
+	}
+}
+
+// QueuedCommandUpsertBulk is the builder for "upsert"-ing
+// a bulk of QueuedCommand nodes.
+type QueuedCommandUpsertBulk struct {
+	create *QueuedCommandCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.QueuedCommand.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(queuedcommand.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QueuedCommandUpsertBulk) UpdateNewValues() *QueuedCommandUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(queuedcommand.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(queuedcommand.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(queuedcommand.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QueuedCommand.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QueuedCommandUpsertBulk) Ignore() *QueuedCommandUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QueuedCommandUpsertBulk) DoNothing() *QueuedCommandUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QueuedCommandCreateBulk.OnConflict
+// documentation for more info.
+func (u *QueuedCommandUpsertBulk) Update(set func(*QueuedCommandUpsert)) *QueuedCommandUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QueuedCommandUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *QueuedCommandUpsertBulk) SetUpdateTime(v time.Time) *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *QueuedCommandUpsertBulk) UpdateUpdateTime() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *QueuedCommandUpsertBulk) ClearUpdateTime() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *QueuedCommandUpsertBulk) SetDeleteTime(v time.Time) *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *QueuedCommandUpsertBulk) UpdateDeleteTime() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *QueuedCommandUpsertBulk) ClearDeleteTime() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *QueuedCommandUpsertBulk) SetClientID(v string) *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *QueuedCommandUpsertBulk) UpdateClientID() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (u *QueuedCommandUpsertBulk) SetExecutionID(v string) *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetExecutionID(v)
+	})
+}
+
+// UpdateExecutionID sets the "execution_id" field to the value that was provided on create.
+func (u *QueuedCommandUpsertBulk) UpdateExecutionID() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateExecutionID()
+	})
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (u *QueuedCommandUpsertBulk) ClearExecutionID() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.ClearExecutionID()
+	})
+}
+
+// SetCommandType sets the "command_type" field.
+func (u *QueuedCommandUpsertBulk) SetCommandType(v queuedcommand.CommandType) *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetCommandType(v)
+	})
+}
+
+// UpdateCommandType sets the "command_type" field to the value that was provided on create.
+func (u *QueuedCommandUpsertBulk) UpdateCommandType() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateCommandType()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *QueuedCommandUpsertBulk) SetPayload(v []byte) *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *QueuedCommandUpsertBulk) UpdatePayload() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *QueuedCommandUpsertBulk) SetExpiresAt(v time.Time) *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *QueuedCommandUpsertBulk) UpdateExpiresAt() *QueuedCommandUpsertBulk {
+	return u.Update(func(s *QueuedCommandUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QueuedCommandUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QueuedCommandCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QueuedCommandCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QueuedCommandUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/queuedcommand"
+)
+
+// QueuedCommandUpdate is the builder for updating QueuedCommand entities.
+type QueuedCommandUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QueuedCommandMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QueuedCommandUpdate builder.
+func (_u *QueuedCommandUpdate) Where(ps ...predicate.QueuedCommand) *QueuedCommandUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *QueuedCommandUpdate) SetUpdateTime(v time.Time) *QueuedCommandUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *QueuedCommandUpdate) SetNillableUpdateTime(v *time.Time) *QueuedCommandUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *QueuedCommandUpdate) ClearUpdateTime() *QueuedCommandUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *QueuedCommandUpdate) SetDeleteTime(v time.Time) *QueuedCommandUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *QueuedCommandUpdate) SetNillableDeleteTime(v *time.Time) *QueuedCommandUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *QueuedCommandUpdate) ClearDeleteTime() *QueuedCommandUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *QueuedCommandUpdate) SetClientID(v string) *QueuedCommandUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *QueuedCommandUpdate) SetNillableClientID(v *string) *QueuedCommandUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_u *QueuedCommandUpdate) SetExecutionID(v string) *QueuedCommandUpdate {
+	_u.mutation.SetExecutionID(v)
+	return _u
+}
+
+// SetNillableExecutionID sets the "execution_id" field if the given value is not nil.
+func (_u *QueuedCommandUpdate) SetNillableExecutionID(v *string) *QueuedCommandUpdate {
+	if v != nil {
+		_u.SetExecutionID(*v)
+	}
+	return _u
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (_u *QueuedCommandUpdate) ClearExecutionID() *QueuedCommandUpdate {
+	_u.mutation.ClearExecutionID()
+	return _u
+}
+
+// SetCommandType sets the "command_type" field.
+func (_u *QueuedCommandUpdate) SetCommandType(v queuedcommand.CommandType) *QueuedCommandUpdate {
+	_u.mutation.SetCommandType(v)
+	return _u
+}
+
+// SetNillableCommandType sets the "command_type" field if the given value is not nil.
+func (_u *QueuedCommandUpdate) SetNillableCommandType(v *queuedcommand.CommandType) *QueuedCommandUpdate {
+	if v != nil {
+		_u.SetCommandType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *QueuedCommandUpdate) SetPayload(v []byte) *QueuedCommandUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *QueuedCommandUpdate) SetExpiresAt(v time.Time) *QueuedCommandUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *QueuedCommandUpdate) SetNillableExpiresAt(v *time.Time) *QueuedCommandUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the QueuedCommandMutation object of the builder.
+func (_u *QueuedCommandUpdate) Mutation() *QueuedCommandMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QueuedCommandUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QueuedCommandUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QueuedCommandUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QueuedCommandUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QueuedCommandUpdate) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := queuedcommand.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExecutionID(); ok {
+		if err := queuedcommand.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommandType(); ok {
+		if err := queuedcommand.CommandTypeValidator(v); err != nil {
+			return &ValidationError{Name: "command_type", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.command_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QueuedCommandUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QueuedCommandUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QueuedCommandUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(queuedcommand.Table, queuedcommand.Columns, sqlgraph.NewFieldSpec(queuedcommand.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(queuedcommand.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(queuedcommand.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(queuedcommand.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(queuedcommand.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(queuedcommand.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(queuedcommand.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(queuedcommand.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExecutionID(); ok {
+		_spec.SetField(queuedcommand.FieldExecutionID, field.TypeString, value)
+	}
+	if _u.mutation.ExecutionIDCleared() {
+		_spec.ClearField(queuedcommand.FieldExecutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommandType(); ok {
+		_spec.SetField(queuedcommand.FieldCommandType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(queuedcommand.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(queuedcommand.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuedcommand.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QueuedCommandUpdateOne is the builder for updating a single QueuedCommand entity.
+type QueuedCommandUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QueuedCommandMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *QueuedCommandUpdateOne) SetUpdateTime(v time.Time) *QueuedCommandUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *QueuedCommandUpdateOne) SetNillableUpdateTime(v *time.Time) *QueuedCommandUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *QueuedCommandUpdateOne) ClearUpdateTime() *QueuedCommandUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *QueuedCommandUpdateOne) SetDeleteTime(v time.Time) *QueuedCommandUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *QueuedCommandUpdateOne) SetNillableDeleteTime(v *time.Time) *QueuedCommandUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *QueuedCommandUpdateOne) ClearDeleteTime() *QueuedCommandUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *QueuedCommandUpdateOne) SetClientID(v string) *QueuedCommandUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *QueuedCommandUpdateOne) SetNillableClientID(v *string) *QueuedCommandUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (_u *QueuedCommandUpdateOne) SetExecutionID(v string) *QueuedCommandUpdateOne {
+	_u.mutation.SetExecutionID(v)
+	return _u
+}
+
+// SetNillableExecutionID sets the "execution_id" field if the given value is not nil.
+func (_u *QueuedCommandUpdateOne) SetNillableExecutionID(v *string) *QueuedCommandUpdateOne {
+	if v != nil {
+		_u.SetExecutionID(*v)
+	}
+	return _u
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (_u *QueuedCommandUpdateOne) ClearExecutionID() *QueuedCommandUpdateOne {
+	_u.mutation.ClearExecutionID()
+	return _u
+}
+
+// SetCommandType sets the "command_type" field.
+func (_u *QueuedCommandUpdateOne) SetCommandType(v queuedcommand.CommandType) *QueuedCommandUpdateOne {
+	_u.mutation.SetCommandType(v)
+	return _u
+}
+
+// SetNillableCommandType sets the "command_type" field if the given value is not nil.
+func (_u *QueuedCommandUpdateOne) SetNillableCommandType(v *queuedcommand.CommandType) *QueuedCommandUpdateOne {
+	if v != nil {
+		_u.SetCommandType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *QueuedCommandUpdateOne) SetPayload(v []byte) *QueuedCommandUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *QueuedCommandUpdateOne) SetExpiresAt(v time.Time) *QueuedCommandUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *QueuedCommandUpdateOne) SetNillableExpiresAt(v *time.Time) *QueuedCommandUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the QueuedCommandMutation object of the builder.
+func (_u *QueuedCommandUpdateOne) Mutation() *QueuedCommandMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the QueuedCommandUpdate builder.
+func (_u *QueuedCommandUpdateOne) Where(ps ...predicate.QueuedCommand) *QueuedCommandUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QueuedCommandUpdateOne) Select(field string, fields ...string) *QueuedCommandUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QueuedCommand entity.
+func (_u *QueuedCommandUpdateOne) Save(ctx context.Context) (*QueuedCommand, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QueuedCommandUpdateOne) SaveX(ctx context.Context) *QueuedCommand {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QueuedCommandUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QueuedCommandUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QueuedCommandUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := queuedcommand.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExecutionID(); ok {
+		if err := queuedcommand.ExecutionIDValidator(v); err != nil {
+			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.execution_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommandType(); ok {
+		if err := queuedcommand.CommandTypeValidator(v); err != nil {
+			return &ValidationError{Name: "command_type", err: fmt.Errorf(`ent: validator failed for field "QueuedCommand.command_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QueuedCommandUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QueuedCommandUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QueuedCommandUpdateOne) sqlSave(ctx context.Context) (_node *QueuedCommand, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(queuedcommand.Table, queuedcommand.Columns, sqlgraph.NewFieldSpec(queuedcommand.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QueuedCommand.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuedcommand.FieldID)
+		for _, f := range fields {
+			if !queuedcommand.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != queuedcommand.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(queuedcommand.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(queuedcommand.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(queuedcommand.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(queuedcommand.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(queuedcommand.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(queuedcommand.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(queuedcommand.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExecutionID(); ok {
+		_spec.SetField(queuedcommand.FieldExecutionID, field.TypeString, value)
+	}
+	if _u.mutation.ExecutionIDCleared() {
+		_spec.ClearField(queuedcommand.FieldExecutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommandType(); ok {
+		_spec.SetField(queuedcommand.FieldCommandType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(queuedcommand.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(queuedcommand.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &QueuedCommand{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuedcommand.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
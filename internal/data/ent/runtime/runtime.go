@@ -6,10 +6,29 @@ import (
 	"context"
 
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientgroup"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlabelset"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlock"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/managedclient"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/outputchunk"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/protectedclient"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/queuedcommand"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptassignment"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptchangerequest"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptversion"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/secret"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/signingkey"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
 
 	"entgo.io/ent"
 	"entgo.io/ent/privacy"
@@ -67,6 +86,236 @@ func init() {
 	auditlogDescID := auditlogMixinFields0[0].Descriptor()
 	// auditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	auditlog.IDValidator = auditlogDescID.Validators[0].(func(uint32) error)
+	clientgroupMixin := schema.ClientGroup{}.Mixin()
+	clientgroup.Policy = privacy.NewPolicies(clientgroupMixin[3], schema.ClientGroup{})
+	clientgroup.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := clientgroup.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	clientgroupMixinFields3 := clientgroupMixin[3].Fields()
+	_ = clientgroupMixinFields3
+	clientgroupFields := schema.ClientGroup{}.Fields()
+	_ = clientgroupFields
+	// clientgroupDescTenantID is the schema descriptor for tenant_id field.
+	clientgroupDescTenantID := clientgroupMixinFields3[0].Descriptor()
+	// clientgroup.DefaultTenantID holds the default value on creation for the tenant_id field.
+	clientgroup.DefaultTenantID = clientgroupDescTenantID.Default.(uint32)
+	// clientgroupDescName is the schema descriptor for name field.
+	clientgroupDescName := clientgroupFields[1].Descriptor()
+	// clientgroup.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	clientgroup.NameValidator = func() func(string) error {
+		validators := clientgroupDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// clientgroupDescDescription is the schema descriptor for description field.
+	clientgroupDescDescription := clientgroupFields[2].Descriptor()
+	// clientgroup.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	clientgroup.DescriptionValidator = clientgroupDescDescription.Validators[0].(func(string) error)
+	// clientgroupDescSelector is the schema descriptor for selector field.
+	clientgroupDescSelector := clientgroupFields[3].Descriptor()
+	// clientgroup.SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	clientgroup.SelectorValidator = clientgroupDescSelector.Validators[0].(func(string) error)
+	// clientgroupDescID is the schema descriptor for id field.
+	clientgroupDescID := clientgroupFields[0].Descriptor()
+	// clientgroup.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	clientgroup.IDValidator = clientgroupDescID.Validators[0].(func(string) error)
+	clientlabelsetMixin := schema.ClientLabelSet{}.Mixin()
+	clientlabelset.Policy = privacy.NewPolicies(clientlabelsetMixin[2], schema.ClientLabelSet{})
+	clientlabelset.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := clientlabelset.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	clientlabelsetMixinFields2 := clientlabelsetMixin[2].Fields()
+	_ = clientlabelsetMixinFields2
+	clientlabelsetFields := schema.ClientLabelSet{}.Fields()
+	_ = clientlabelsetFields
+	// clientlabelsetDescTenantID is the schema descriptor for tenant_id field.
+	clientlabelsetDescTenantID := clientlabelsetMixinFields2[0].Descriptor()
+	// clientlabelset.DefaultTenantID holds the default value on creation for the tenant_id field.
+	clientlabelset.DefaultTenantID = clientlabelsetDescTenantID.Default.(uint32)
+	// clientlabelsetDescClientID is the schema descriptor for client_id field.
+	clientlabelsetDescClientID := clientlabelsetFields[1].Descriptor()
+	// clientlabelset.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	clientlabelset.ClientIDValidator = func() func(string) error {
+		validators := clientlabelsetDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// clientlabelsetDescID is the schema descriptor for id field.
+	clientlabelsetDescID := clientlabelsetFields[0].Descriptor()
+	// clientlabelset.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	clientlabelset.IDValidator = clientlabelsetDescID.Validators[0].(func(string) error)
+	clientlockFields := schema.ClientLock{}.Fields()
+	_ = clientlockFields
+	// clientlockDescID is the schema descriptor for id field.
+	clientlockDescID := clientlockFields[0].Descriptor()
+	// clientlock.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	clientlock.IDValidator = func() func(string) error {
+		validators := clientlockDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	commandMixin := schema.Command{}.Mixin()
+	command.Policy = privacy.NewPolicies(commandMixin[1], schema.Command{})
+	command.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := command.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	commandMixinFields1 := commandMixin[1].Fields()
+	_ = commandMixinFields1
+	commandFields := schema.Command{}.Fields()
+	_ = commandFields
+	// commandDescTenantID is the schema descriptor for tenant_id field.
+	commandDescTenantID := commandMixinFields1[0].Descriptor()
+	// command.DefaultTenantID holds the default value on creation for the tenant_id field.
+	command.DefaultTenantID = commandDescTenantID.Default.(uint32)
+	// commandDescExecutionID is the schema descriptor for execution_id field.
+	commandDescExecutionID := commandFields[1].Descriptor()
+	// command.ExecutionIDValidator is a validator for the "execution_id" field. It is called by the builders before save.
+	command.ExecutionIDValidator = commandDescExecutionID.Validators[0].(func(string) error)
+	// commandDescClientID is the schema descriptor for client_id field.
+	commandDescClientID := commandFields[2].Descriptor()
+	// command.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	command.ClientIDValidator = func() func(string) error {
+		validators := commandDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// commandDescRejectionReason is the schema descriptor for rejection_reason field.
+	commandDescRejectionReason := commandFields[5].Descriptor()
+	// command.RejectionReasonValidator is a validator for the "rejection_reason" field. It is called by the builders before save.
+	command.RejectionReasonValidator = commandDescRejectionReason.Validators[0].(func(string) error)
+	// commandDescID is the schema descriptor for id field.
+	commandDescID := commandFields[0].Descriptor()
+	// command.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	command.IDValidator = commandDescID.Validators[0].(func(string) error)
+	eventruleMixin := schema.EventRule{}.Mixin()
+	eventrule.Policy = privacy.NewPolicies(eventruleMixin[3], schema.EventRule{})
+	eventrule.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := eventrule.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	eventruleMixinFields3 := eventruleMixin[3].Fields()
+	_ = eventruleMixinFields3
+	eventruleFields := schema.EventRule{}.Fields()
+	_ = eventruleFields
+	// eventruleDescTenantID is the schema descriptor for tenant_id field.
+	eventruleDescTenantID := eventruleMixinFields3[0].Descriptor()
+	// eventrule.DefaultTenantID holds the default value on creation for the tenant_id field.
+	eventrule.DefaultTenantID = eventruleDescTenantID.Default.(uint32)
+	// eventruleDescName is the schema descriptor for name field.
+	eventruleDescName := eventruleFields[1].Descriptor()
+	// eventrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	eventrule.NameValidator = func() func(string) error {
+		validators := eventruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// eventruleDescDescription is the schema descriptor for description field.
+	eventruleDescDescription := eventruleFields[2].Descriptor()
+	// eventrule.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	eventrule.DescriptionValidator = eventruleDescDescription.Validators[0].(func(string) error)
+	// eventruleDescScriptID is the schema descriptor for script_id field.
+	eventruleDescScriptID := eventruleFields[4].Descriptor()
+	// eventrule.ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	eventrule.ScriptIDValidator = func() func(string) error {
+		validators := eventruleDescScriptID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(script_id string) error {
+			for _, fn := range fns {
+				if err := fn(script_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// eventruleDescSelector is the schema descriptor for selector field.
+	eventruleDescSelector := eventruleFields[5].Descriptor()
+	// eventrule.SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	eventrule.SelectorValidator = eventruleDescSelector.Validators[0].(func(string) error)
+	// eventruleDescSourceScriptID is the schema descriptor for source_script_id field.
+	eventruleDescSourceScriptID := eventruleFields[6].Descriptor()
+	// eventrule.SourceScriptIDValidator is a validator for the "source_script_id" field. It is called by the builders before save.
+	eventrule.SourceScriptIDValidator = eventruleDescSourceScriptID.Validators[0].(func(string) error)
+	// eventruleDescEnabled is the schema descriptor for enabled field.
+	eventruleDescEnabled := eventruleFields[7].Descriptor()
+	// eventrule.DefaultEnabled holds the default value on creation for the enabled field.
+	eventrule.DefaultEnabled = eventruleDescEnabled.Default.(bool)
+	// eventruleDescID is the schema descriptor for id field.
+	eventruleDescID := eventruleFields[0].Descriptor()
+	// eventrule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	eventrule.IDValidator = eventruleDescID.Validators[0].(func(string) error)
 	executionlogMixin := schema.ExecutionLog{}.Mixin()
 	executionlog.Policy = privacy.NewPolicies(executionlogMixin[2], schema.ExecutionLog{})
 	executionlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -161,10 +410,598 @@ func init() {
 	executionlogDescRejectionReason := executionlogFields[10].Descriptor()
 	// executionlog.RejectionReasonValidator is a validator for the "rejection_reason" field. It is called by the builders before save.
 	executionlog.RejectionReasonValidator = executionlogDescRejectionReason.Validators[0].(func(string) error)
+	// executionlogDescCancelReason is the schema descriptor for cancel_reason field.
+	executionlogDescCancelReason := executionlogFields[16].Descriptor()
+	// executionlog.CancelReasonValidator is a validator for the "cancel_reason" field. It is called by the builders before save.
+	executionlog.CancelReasonValidator = executionlogDescCancelReason.Validators[0].(func(string) error)
+	// executionlogDescTimeoutReason is the schema descriptor for timeout_reason field.
+	executionlogDescTimeoutReason := executionlogFields[17].Descriptor()
+	// executionlog.TimeoutReasonValidator is a validator for the "timeout_reason" field. It is called by the builders before save.
+	executionlog.TimeoutReasonValidator = executionlogDescTimeoutReason.Validators[0].(func(string) error)
+	// executionlogDescRunID is the schema descriptor for run_id field.
+	executionlogDescRunID := executionlogFields[18].Descriptor()
+	// executionlog.RunIDValidator is a validator for the "run_id" field. It is called by the builders before save.
+	executionlog.RunIDValidator = executionlogDescRunID.Validators[0].(func(string) error)
+	// executionlogDescEventRuleID is the schema descriptor for event_rule_id field.
+	executionlogDescEventRuleID := executionlogFields[19].Descriptor()
+	// executionlog.EventRuleIDValidator is a validator for the "event_rule_id" field. It is called by the builders before save.
+	executionlog.EventRuleIDValidator = executionlogDescEventRuleID.Validators[0].(func(string) error)
+	// executionlogDescEventDetail is the schema descriptor for event_detail field.
+	executionlogDescEventDetail := executionlogFields[21].Descriptor()
+	// executionlog.EventDetailValidator is a validator for the "event_detail" field. It is called by the builders before save.
+	executionlog.EventDetailValidator = executionlogDescEventDetail.Validators[0].(func(string) error)
+	// executionlogDescSourceExecutionID is the schema descriptor for source_execution_id field.
+	executionlogDescSourceExecutionID := executionlogFields[22].Descriptor()
+	// executionlog.SourceExecutionIDValidator is a validator for the "source_execution_id" field. It is called by the builders before save.
+	executionlog.SourceExecutionIDValidator = executionlogDescSourceExecutionID.Validators[0].(func(string) error)
+	// executionlogDescWorkflowRunID is the schema descriptor for workflow_run_id field.
+	executionlogDescWorkflowRunID := executionlogFields[23].Descriptor()
+	// executionlog.WorkflowRunIDValidator is a validator for the "workflow_run_id" field. It is called by the builders before save.
+	executionlog.WorkflowRunIDValidator = executionlogDescWorkflowRunID.Validators[0].(func(string) error)
+	// executionlogDescWorkflowStepID is the schema descriptor for workflow_step_id field.
+	executionlogDescWorkflowStepID := executionlogFields[24].Descriptor()
+	// executionlog.WorkflowStepIDValidator is a validator for the "workflow_step_id" field. It is called by the builders before save.
+	executionlog.WorkflowStepIDValidator = executionlogDescWorkflowStepID.Validators[0].(func(string) error)
+	// executionlogDescAttempt is the schema descriptor for attempt field.
+	executionlogDescAttempt := executionlogFields[25].Descriptor()
+	// executionlog.DefaultAttempt holds the default value on creation for the attempt field.
+	executionlog.DefaultAttempt = executionlogDescAttempt.Default.(int)
+	// executionlog.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	executionlog.AttemptValidator = executionlogDescAttempt.Validators[0].(func(int) error)
+	// executionlogDescOriginalExecutionID is the schema descriptor for original_execution_id field.
+	executionlogDescOriginalExecutionID := executionlogFields[26].Descriptor()
+	// executionlog.OriginalExecutionIDValidator is a validator for the "original_execution_id" field. It is called by the builders before save.
+	executionlog.OriginalExecutionIDValidator = executionlogDescOriginalExecutionID.Validators[0].(func(string) error)
+	// executionlogDescRetriedBy is the schema descriptor for retried_by field.
+	executionlogDescRetriedBy := executionlogFields[28].Descriptor()
+	// executionlog.RetriedByValidator is a validator for the "retried_by" field. It is called by the builders before save.
+	executionlog.RetriedByValidator = executionlogDescRetriedBy.Validators[0].(func(string) error)
+	// executionlogDescWaitingForSlot is the schema descriptor for waiting_for_slot field.
+	executionlogDescWaitingForSlot := executionlogFields[29].Descriptor()
+	// executionlog.DefaultWaitingForSlot holds the default value on creation for the waiting_for_slot field.
+	executionlog.DefaultWaitingForSlot = executionlogDescWaitingForSlot.Default.(bool)
+	// executionlogDescMaintenanceOverride is the schema descriptor for maintenance_override field.
+	executionlogDescMaintenanceOverride := executionlogFields[31].Descriptor()
+	// executionlog.MaintenanceOverrideValidator is a validator for the "maintenance_override" field. It is called by the builders before save.
+	executionlog.MaintenanceOverrideValidator = executionlogDescMaintenanceOverride.Validators[0].(func(string) error)
+	// executionlogDescSecretParametersKeyID is the schema descriptor for secret_parameters_key_id field.
+	executionlogDescSecretParametersKeyID := executionlogFields[35].Descriptor()
+	// executionlog.SecretParametersKeyIDValidator is a validator for the "secret_parameters_key_id" field. It is called by the builders before save.
+	executionlog.SecretParametersKeyIDValidator = executionlogDescSecretParametersKeyID.Validators[0].(func(string) error)
+	// executionlogDescApprovalComment is the schema descriptor for approval_comment field.
+	executionlogDescApprovalComment := executionlogFields[40].Descriptor()
+	// executionlog.ApprovalCommentValidator is a validator for the "approval_comment" field. It is called by the builders before save.
+	executionlog.ApprovalCommentValidator = executionlogDescApprovalComment.Validators[0].(func(string) error)
 	// executionlogDescID is the schema descriptor for id field.
 	executionlogDescID := executionlogFields[0].Descriptor()
 	// executionlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	executionlog.IDValidator = executionlogDescID.Validators[0].(func(string) error)
+	executionrunMixin := schema.ExecutionRun{}.Mixin()
+	executionrun.Policy = privacy.NewPolicies(executionrunMixin[2], schema.ExecutionRun{})
+	executionrun.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := executionrun.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	executionrunMixinFields2 := executionrunMixin[2].Fields()
+	_ = executionrunMixinFields2
+	executionrunFields := schema.ExecutionRun{}.Fields()
+	_ = executionrunFields
+	// executionrunDescTenantID is the schema descriptor for tenant_id field.
+	executionrunDescTenantID := executionrunMixinFields2[0].Descriptor()
+	// executionrun.DefaultTenantID holds the default value on creation for the tenant_id field.
+	executionrun.DefaultTenantID = executionrunDescTenantID.Default.(uint32)
+	// executionrunDescScriptID is the schema descriptor for script_id field.
+	executionrunDescScriptID := executionrunFields[1].Descriptor()
+	// executionrun.ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	executionrun.ScriptIDValidator = func() func(string) error {
+		validators := executionrunDescScriptID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(script_id string) error {
+			for _, fn := range fns {
+				if err := fn(script_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// executionrunDescScriptName is the schema descriptor for script_name field.
+	executionrunDescScriptName := executionrunFields[2].Descriptor()
+	// executionrun.ScriptNameValidator is a validator for the "script_name" field. It is called by the builders before save.
+	executionrun.ScriptNameValidator = func() func(string) error {
+		validators := executionrunDescScriptName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(script_name string) error {
+			for _, fn := range fns {
+				if err := fn(script_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// executionrunDescSelector is the schema descriptor for selector field.
+	executionrunDescSelector := executionrunFields[3].Descriptor()
+	// executionrun.SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	executionrun.SelectorValidator = executionrunDescSelector.Validators[0].(func(string) error)
+	// executionrunDescScheduleID is the schema descriptor for schedule_id field.
+	executionrunDescScheduleID := executionrunFields[5].Descriptor()
+	// executionrun.ScheduleIDValidator is a validator for the "schedule_id" field. It is called by the builders before save.
+	executionrun.ScheduleIDValidator = executionrunDescScheduleID.Validators[0].(func(string) error)
+	// executionrunDescTotal is the schema descriptor for total field.
+	executionrunDescTotal := executionrunFields[7].Descriptor()
+	// executionrun.DefaultTotal holds the default value on creation for the total field.
+	executionrun.DefaultTotal = executionrunDescTotal.Default.(int)
+	// executionrun.TotalValidator is a validator for the "total" field. It is called by the builders before save.
+	executionrun.TotalValidator = executionrunDescTotal.Validators[0].(func(int) error)
+	// executionrunDescDispatched is the schema descriptor for dispatched field.
+	executionrunDescDispatched := executionrunFields[8].Descriptor()
+	// executionrun.DefaultDispatched holds the default value on creation for the dispatched field.
+	executionrun.DefaultDispatched = executionrunDescDispatched.Default.(int)
+	// executionrun.DispatchedValidator is a validator for the "dispatched" field. It is called by the builders before save.
+	executionrun.DispatchedValidator = executionrunDescDispatched.Validators[0].(func(int) error)
+	// executionrunDescBatchSize is the schema descriptor for batch_size field.
+	executionrunDescBatchSize := executionrunFields[9].Descriptor()
+	// executionrun.DefaultBatchSize holds the default value on creation for the batch_size field.
+	executionrun.DefaultBatchSize = executionrunDescBatchSize.Default.(int)
+	// executionrun.BatchSizeValidator is a validator for the "batch_size" field. It is called by the builders before save.
+	executionrun.BatchSizeValidator = executionrunDescBatchSize.Validators[0].(func(int) error)
+	// executionrunDescCanarySize is the schema descriptor for canary_size field.
+	executionrunDescCanarySize := executionrunFields[10].Descriptor()
+	// executionrun.DefaultCanarySize holds the default value on creation for the canary_size field.
+	executionrun.DefaultCanarySize = executionrunDescCanarySize.Default.(int)
+	// executionrun.CanarySizeValidator is a validator for the "canary_size" field. It is called by the builders before save.
+	executionrun.CanarySizeValidator = executionrunDescCanarySize.Validators[0].(func(int) error)
+	// executionrunDescBatchPauseSeconds is the schema descriptor for batch_pause_seconds field.
+	executionrunDescBatchPauseSeconds := executionrunFields[11].Descriptor()
+	// executionrun.DefaultBatchPauseSeconds holds the default value on creation for the batch_pause_seconds field.
+	executionrun.DefaultBatchPauseSeconds = executionrunDescBatchPauseSeconds.Default.(int)
+	// executionrun.BatchPauseSecondsValidator is a validator for the "batch_pause_seconds" field. It is called by the builders before save.
+	executionrun.BatchPauseSecondsValidator = executionrunDescBatchPauseSeconds.Validators[0].(func(int) error)
+	// executionrunDescMaxFailures is the schema descriptor for max_failures field.
+	executionrunDescMaxFailures := executionrunFields[12].Descriptor()
+	// executionrun.MaxFailuresValidator is a validator for the "max_failures" field. It is called by the builders before save.
+	executionrun.MaxFailuresValidator = executionrunDescMaxFailures.Validators[0].(func(int) error)
+	// executionrunDescCurrentBatch is the schema descriptor for current_batch field.
+	executionrunDescCurrentBatch := executionrunFields[13].Descriptor()
+	// executionrun.DefaultCurrentBatch holds the default value on creation for the current_batch field.
+	executionrun.DefaultCurrentBatch = executionrunDescCurrentBatch.Default.(int)
+	// executionrun.CurrentBatchValidator is a validator for the "current_batch" field. It is called by the builders before save.
+	executionrun.CurrentBatchValidator = executionrunDescCurrentBatch.Validators[0].(func(int) error)
+	// executionrunDescHaltReason is the schema descriptor for halt_reason field.
+	executionrunDescHaltReason := executionrunFields[16].Descriptor()
+	// executionrun.HaltReasonValidator is a validator for the "halt_reason" field. It is called by the builders before save.
+	executionrun.HaltReasonValidator = executionrunDescHaltReason.Validators[0].(func(string) error)
+	// executionrunDescID is the schema descriptor for id field.
+	executionrunDescID := executionrunFields[0].Descriptor()
+	// executionrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	executionrun.IDValidator = executionrunDescID.Validators[0].(func(string) error)
+	maintenancewindowMixin := schema.MaintenanceWindow{}.Mixin()
+	maintenancewindow.Policy = privacy.NewPolicies(maintenancewindowMixin[3], schema.MaintenanceWindow{})
+	maintenancewindow.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := maintenancewindow.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	maintenancewindowMixinFields3 := maintenancewindowMixin[3].Fields()
+	_ = maintenancewindowMixinFields3
+	maintenancewindowFields := schema.MaintenanceWindow{}.Fields()
+	_ = maintenancewindowFields
+	// maintenancewindowDescTenantID is the schema descriptor for tenant_id field.
+	maintenancewindowDescTenantID := maintenancewindowMixinFields3[0].Descriptor()
+	// maintenancewindow.DefaultTenantID holds the default value on creation for the tenant_id field.
+	maintenancewindow.DefaultTenantID = maintenancewindowDescTenantID.Default.(uint32)
+	// maintenancewindowDescName is the schema descriptor for name field.
+	maintenancewindowDescName := maintenancewindowFields[1].Descriptor()
+	// maintenancewindow.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	maintenancewindow.NameValidator = func() func(string) error {
+		validators := maintenancewindowDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// maintenancewindowDescDescription is the schema descriptor for description field.
+	maintenancewindowDescDescription := maintenancewindowFields[2].Descriptor()
+	// maintenancewindow.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	maintenancewindow.DescriptionValidator = maintenancewindowDescDescription.Validators[0].(func(string) error)
+	// maintenancewindowDescCronExpression is the schema descriptor for cron_expression field.
+	maintenancewindowDescCronExpression := maintenancewindowFields[4].Descriptor()
+	// maintenancewindow.CronExpressionValidator is a validator for the "cron_expression" field. It is called by the builders before save.
+	maintenancewindow.CronExpressionValidator = func() func(string) error {
+		validators := maintenancewindowDescCronExpression.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(cron_expression string) error {
+			for _, fn := range fns {
+				if err := fn(cron_expression); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// maintenancewindowDescDurationMinutes is the schema descriptor for duration_minutes field.
+	maintenancewindowDescDurationMinutes := maintenancewindowFields[5].Descriptor()
+	// maintenancewindow.DurationMinutesValidator is a validator for the "duration_minutes" field. It is called by the builders before save.
+	maintenancewindow.DurationMinutesValidator = maintenancewindowDescDurationMinutes.Validators[0].(func(int) error)
+	// maintenancewindowDescTimezone is the schema descriptor for timezone field.
+	maintenancewindowDescTimezone := maintenancewindowFields[6].Descriptor()
+	// maintenancewindow.DefaultTimezone holds the default value on creation for the timezone field.
+	maintenancewindow.DefaultTimezone = maintenancewindowDescTimezone.Default.(string)
+	// maintenancewindow.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	maintenancewindow.TimezoneValidator = func() func(string) error {
+		validators := maintenancewindowDescTimezone.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(timezone string) error {
+			for _, fn := range fns {
+				if err := fn(timezone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// maintenancewindowDescEnabled is the schema descriptor for enabled field.
+	maintenancewindowDescEnabled := maintenancewindowFields[8].Descriptor()
+	// maintenancewindow.DefaultEnabled holds the default value on creation for the enabled field.
+	maintenancewindow.DefaultEnabled = maintenancewindowDescEnabled.Default.(bool)
+	// maintenancewindowDescID is the schema descriptor for id field.
+	maintenancewindowDescID := maintenancewindowFields[0].Descriptor()
+	// maintenancewindow.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	maintenancewindow.IDValidator = maintenancewindowDescID.Validators[0].(func(string) error)
+	managedclientFields := schema.ManagedClient{}.Fields()
+	_ = managedclientFields
+	// managedclientDescClientID is the schema descriptor for client_id field.
+	managedclientDescClientID := managedclientFields[1].Descriptor()
+	// managedclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	managedclient.ClientIDValidator = func() func(string) error {
+		validators := managedclientDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// managedclientDescMachineID is the schema descriptor for machine_id field.
+	managedclientDescMachineID := managedclientFields[2].Descriptor()
+	// managedclient.MachineIDValidator is a validator for the "machine_id" field. It is called by the builders before save.
+	managedclient.MachineIDValidator = managedclientDescMachineID.Validators[0].(func(string) error)
+	// managedclientDescClientVersion is the schema descriptor for client_version field.
+	managedclientDescClientVersion := managedclientFields[3].Descriptor()
+	// managedclient.ClientVersionValidator is a validator for the "client_version" field. It is called by the builders before save.
+	managedclient.ClientVersionValidator = managedclientDescClientVersion.Validators[0].(func(string) error)
+	// managedclientDescHostname is the schema descriptor for hostname field.
+	managedclientDescHostname := managedclientFields[4].Descriptor()
+	// managedclient.HostnameValidator is a validator for the "hostname" field. It is called by the builders before save.
+	managedclient.HostnameValidator = managedclientDescHostname.Validators[0].(func(string) error)
+	// managedclientDescOs is the schema descriptor for os field.
+	managedclientDescOs := managedclientFields[5].Descriptor()
+	// managedclient.OsValidator is a validator for the "os" field. It is called by the builders before save.
+	managedclient.OsValidator = managedclientDescOs.Validators[0].(func(string) error)
+	// managedclientDescOsVersion is the schema descriptor for os_version field.
+	managedclientDescOsVersion := managedclientFields[6].Descriptor()
+	// managedclient.OsVersionValidator is a validator for the "os_version" field. It is called by the builders before save.
+	managedclient.OsVersionValidator = managedclientDescOsVersion.Validators[0].(func(string) error)
+	// managedclientDescKernelVersion is the schema descriptor for kernel_version field.
+	managedclientDescKernelVersion := managedclientFields[7].Descriptor()
+	// managedclient.KernelVersionValidator is a validator for the "kernel_version" field. It is called by the builders before save.
+	managedclient.KernelVersionValidator = managedclientDescKernelVersion.Validators[0].(func(string) error)
+	// managedclientDescArch is the schema descriptor for arch field.
+	managedclientDescArch := managedclientFields[8].Descriptor()
+	// managedclient.ArchValidator is a validator for the "arch" field. It is called by the builders before save.
+	managedclient.ArchValidator = managedclientDescArch.Validators[0].(func(string) error)
+	// managedclientDescDescription is the schema descriptor for description field.
+	managedclientDescDescription := managedclientFields[12].Descriptor()
+	// managedclient.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	managedclient.DescriptionValidator = managedclientDescDescription.Validators[0].(func(string) error)
+	// managedclientDescID is the schema descriptor for id field.
+	managedclientDescID := managedclientFields[0].Descriptor()
+	// managedclient.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	managedclient.IDValidator = managedclientDescID.Validators[0].(func(string) error)
+	outputchunkMixin := schema.OutputChunk{}.Mixin()
+	outputchunk.Policy = privacy.NewPolicies(outputchunkMixin[1], schema.OutputChunk{})
+	outputchunk.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := outputchunk.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	outputchunkMixinFields1 := outputchunkMixin[1].Fields()
+	_ = outputchunkMixinFields1
+	outputchunkFields := schema.OutputChunk{}.Fields()
+	_ = outputchunkFields
+	// outputchunkDescTenantID is the schema descriptor for tenant_id field.
+	outputchunkDescTenantID := outputchunkMixinFields1[0].Descriptor()
+	// outputchunk.DefaultTenantID holds the default value on creation for the tenant_id field.
+	outputchunk.DefaultTenantID = outputchunkDescTenantID.Default.(uint32)
+	// outputchunkDescExecutionID is the schema descriptor for execution_id field.
+	outputchunkDescExecutionID := outputchunkFields[1].Descriptor()
+	// outputchunk.ExecutionIDValidator is a validator for the "execution_id" field. It is called by the builders before save.
+	outputchunk.ExecutionIDValidator = func() func(string) error {
+		validators := outputchunkDescExecutionID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(execution_id string) error {
+			for _, fn := range fns {
+				if err := fn(execution_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// outputchunkDescSeq is the schema descriptor for seq field.
+	outputchunkDescSeq := outputchunkFields[2].Descriptor()
+	// outputchunk.SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	outputchunk.SeqValidator = outputchunkDescSeq.Validators[0].(func(int64) error)
+	// outputchunkDescID is the schema descriptor for id field.
+	outputchunkDescID := outputchunkFields[0].Descriptor()
+	// outputchunk.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	outputchunk.IDValidator = outputchunkDescID.Validators[0].(func(string) error)
+	protectedclientMixin := schema.ProtectedClient{}.Mixin()
+	protectedclient.Policy = privacy.NewPolicies(protectedclientMixin[2], schema.ProtectedClient{})
+	protectedclient.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := protectedclient.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	protectedclientMixinFields2 := protectedclientMixin[2].Fields()
+	_ = protectedclientMixinFields2
+	protectedclientFields := schema.ProtectedClient{}.Fields()
+	_ = protectedclientFields
+	// protectedclientDescTenantID is the schema descriptor for tenant_id field.
+	protectedclientDescTenantID := protectedclientMixinFields2[0].Descriptor()
+	// protectedclient.DefaultTenantID holds the default value on creation for the tenant_id field.
+	protectedclient.DefaultTenantID = protectedclientDescTenantID.Default.(uint32)
+	// protectedclientDescClientID is the schema descriptor for client_id field.
+	protectedclientDescClientID := protectedclientFields[1].Descriptor()
+	// protectedclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	protectedclient.ClientIDValidator = func() func(string) error {
+		validators := protectedclientDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// protectedclientDescReason is the schema descriptor for reason field.
+	protectedclientDescReason := protectedclientFields[2].Descriptor()
+	// protectedclient.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	protectedclient.ReasonValidator = protectedclientDescReason.Validators[0].(func(string) error)
+	// protectedclientDescID is the schema descriptor for id field.
+	protectedclientDescID := protectedclientFields[0].Descriptor()
+	// protectedclient.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	protectedclient.IDValidator = protectedclientDescID.Validators[0].(func(string) error)
+	queuedcommandMixin := schema.QueuedCommand{}.Mixin()
+	queuedcommand.Policy = privacy.NewPolicies(queuedcommandMixin[1], schema.QueuedCommand{})
+	queuedcommand.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := queuedcommand.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	queuedcommandMixinFields1 := queuedcommandMixin[1].Fields()
+	_ = queuedcommandMixinFields1
+	queuedcommandFields := schema.QueuedCommand{}.Fields()
+	_ = queuedcommandFields
+	// queuedcommandDescTenantID is the schema descriptor for tenant_id field.
+	queuedcommandDescTenantID := queuedcommandMixinFields1[0].Descriptor()
+	// queuedcommand.DefaultTenantID holds the default value on creation for the tenant_id field.
+	queuedcommand.DefaultTenantID = queuedcommandDescTenantID.Default.(uint32)
+	// queuedcommandDescClientID is the schema descriptor for client_id field.
+	queuedcommandDescClientID := queuedcommandFields[1].Descriptor()
+	// queuedcommand.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	queuedcommand.ClientIDValidator = func() func(string) error {
+		validators := queuedcommandDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// queuedcommandDescExecutionID is the schema descriptor for execution_id field.
+	queuedcommandDescExecutionID := queuedcommandFields[2].Descriptor()
+	// queuedcommand.ExecutionIDValidator is a validator for the "execution_id" field. It is called by the builders before save.
+	queuedcommand.ExecutionIDValidator = queuedcommandDescExecutionID.Validators[0].(func(string) error)
+	// queuedcommandDescID is the schema descriptor for id field.
+	queuedcommandDescID := queuedcommandFields[0].Descriptor()
+	// queuedcommand.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	queuedcommand.IDValidator = queuedcommandDescID.Validators[0].(func(string) error)
+	scheduleMixin := schema.Schedule{}.Mixin()
+	schedule.Policy = privacy.NewPolicies(scheduleMixin[3], schema.Schedule{})
+	schedule.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := schedule.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	scheduleMixinFields3 := scheduleMixin[3].Fields()
+	_ = scheduleMixinFields3
+	scheduleFields := schema.Schedule{}.Fields()
+	_ = scheduleFields
+	// scheduleDescTenantID is the schema descriptor for tenant_id field.
+	scheduleDescTenantID := scheduleMixinFields3[0].Descriptor()
+	// schedule.DefaultTenantID holds the default value on creation for the tenant_id field.
+	schedule.DefaultTenantID = scheduleDescTenantID.Default.(uint32)
+	// scheduleDescName is the schema descriptor for name field.
+	scheduleDescName := scheduleFields[1].Descriptor()
+	// schedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	schedule.NameValidator = func() func(string) error {
+		validators := scheduleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduleDescDescription is the schema descriptor for description field.
+	scheduleDescDescription := scheduleFields[2].Descriptor()
+	// schedule.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	schedule.DescriptionValidator = scheduleDescDescription.Validators[0].(func(string) error)
+	// scheduleDescScriptID is the schema descriptor for script_id field.
+	scheduleDescScriptID := scheduleFields[3].Descriptor()
+	// schedule.ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	schedule.ScriptIDValidator = func() func(string) error {
+		validators := scheduleDescScriptID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(script_id string) error {
+			for _, fn := range fns {
+				if err := fn(script_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduleDescCronExpression is the schema descriptor for cron_expression field.
+	scheduleDescCronExpression := scheduleFields[4].Descriptor()
+	// schedule.CronExpressionValidator is a validator for the "cron_expression" field. It is called by the builders before save.
+	schedule.CronExpressionValidator = func() func(string) error {
+		validators := scheduleDescCronExpression.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(cron_expression string) error {
+			for _, fn := range fns {
+				if err := fn(cron_expression); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduleDescTimezone is the schema descriptor for timezone field.
+	scheduleDescTimezone := scheduleFields[5].Descriptor()
+	// schedule.DefaultTimezone holds the default value on creation for the timezone field.
+	schedule.DefaultTimezone = scheduleDescTimezone.Default.(string)
+	// schedule.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	schedule.TimezoneValidator = func() func(string) error {
+		validators := scheduleDescTimezone.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(timezone string) error {
+			for _, fn := range fns {
+				if err := fn(timezone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduleDescSelector is the schema descriptor for selector field.
+	scheduleDescSelector := scheduleFields[7].Descriptor()
+	// schedule.SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	schedule.SelectorValidator = scheduleDescSelector.Validators[0].(func(string) error)
+	// scheduleDescBatchSize is the schema descriptor for batch_size field.
+	scheduleDescBatchSize := scheduleFields[8].Descriptor()
+	// schedule.DefaultBatchSize holds the default value on creation for the batch_size field.
+	schedule.DefaultBatchSize = scheduleDescBatchSize.Default.(int)
+	// schedule.BatchSizeValidator is a validator for the "batch_size" field. It is called by the builders before save.
+	schedule.BatchSizeValidator = scheduleDescBatchSize.Validators[0].(func(int) error)
+	// scheduleDescCanarySize is the schema descriptor for canary_size field.
+	scheduleDescCanarySize := scheduleFields[9].Descriptor()
+	// schedule.DefaultCanarySize holds the default value on creation for the canary_size field.
+	schedule.DefaultCanarySize = scheduleDescCanarySize.Default.(int)
+	// schedule.CanarySizeValidator is a validator for the "canary_size" field. It is called by the builders before save.
+	schedule.CanarySizeValidator = scheduleDescCanarySize.Validators[0].(func(int) error)
+	// scheduleDescBatchPauseSeconds is the schema descriptor for batch_pause_seconds field.
+	scheduleDescBatchPauseSeconds := scheduleFields[10].Descriptor()
+	// schedule.DefaultBatchPauseSeconds holds the default value on creation for the batch_pause_seconds field.
+	schedule.DefaultBatchPauseSeconds = scheduleDescBatchPauseSeconds.Default.(int)
+	// schedule.BatchPauseSecondsValidator is a validator for the "batch_pause_seconds" field. It is called by the builders before save.
+	schedule.BatchPauseSecondsValidator = scheduleDescBatchPauseSeconds.Validators[0].(func(int) error)
+	// scheduleDescMaxFailures is the schema descriptor for max_failures field.
+	scheduleDescMaxFailures := scheduleFields[11].Descriptor()
+	// schedule.MaxFailuresValidator is a validator for the "max_failures" field. It is called by the builders before save.
+	schedule.MaxFailuresValidator = scheduleDescMaxFailures.Validators[0].(func(int) error)
+	// scheduleDescEnabled is the schema descriptor for enabled field.
+	scheduleDescEnabled := scheduleFields[12].Descriptor()
+	// schedule.DefaultEnabled holds the default value on creation for the enabled field.
+	schedule.DefaultEnabled = scheduleDescEnabled.Default.(bool)
+	// scheduleDescLastRunID is the schema descriptor for last_run_id field.
+	scheduleDescLastRunID := scheduleFields[16].Descriptor()
+	// schedule.LastRunIDValidator is a validator for the "last_run_id" field. It is called by the builders before save.
+	schedule.LastRunIDValidator = scheduleDescLastRunID.Validators[0].(func(string) error)
+	// scheduleDescLastError is the schema descriptor for last_error field.
+	scheduleDescLastError := scheduleFields[17].Descriptor()
+	// schedule.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	schedule.LastErrorValidator = scheduleDescLastError.Validators[0].(func(string) error)
+	// scheduleDescID is the schema descriptor for id field.
+	scheduleDescID := scheduleFields[0].Descriptor()
+	// schedule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	schedule.IDValidator = scheduleDescID.Validators[0].(func(string) error)
 	scriptMixin := schema.Script{}.Mixin()
 	script.Policy = privacy.NewPolicies(scriptMixin[3], schema.Script{})
 	script.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -231,6 +1068,14 @@ func init() {
 	scriptDescEnabled := scriptFields[7].Descriptor()
 	// script.DefaultEnabled holds the default value on creation for the enabled field.
 	script.DefaultEnabled = scriptDescEnabled.Default.(bool)
+	// scriptDescTimeoutSeconds is the schema descriptor for timeout_seconds field.
+	scriptDescTimeoutSeconds := scriptFields[8].Descriptor()
+	// script.TimeoutSecondsValidator is a validator for the "timeout_seconds" field. It is called by the builders before save.
+	script.TimeoutSecondsValidator = scriptDescTimeoutSeconds.Validators[0].(func(int) error)
+	// scriptDescSingleton is the schema descriptor for singleton field.
+	scriptDescSingleton := scriptFields[10].Descriptor()
+	// script.DefaultSingleton holds the default value on creation for the singleton field.
+	script.DefaultSingleton = scriptDescSingleton.Default.(bool)
 	// scriptDescID is the schema descriptor for id field.
 	scriptDescID := scriptFields[0].Descriptor()
 	// script.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -272,10 +1117,426 @@ func init() {
 		}
 	}()
 	// scriptassignmentDescClientID is the schema descriptor for client_id field.
-	scriptassignmentDescClientID := scriptassignmentFields[2].Descriptor()
+	scriptassignmentDescClientID := scriptassignmentFields[3].Descriptor()
+	// scriptassignment.DefaultClientID holds the default value on creation for the client_id field.
+	scriptassignment.DefaultClientID = scriptassignmentDescClientID.Default.(string)
 	// scriptassignment.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
-	scriptassignment.ClientIDValidator = func() func(string) error {
-		validators := scriptassignmentDescClientID.Validators
+	scriptassignment.ClientIDValidator = scriptassignmentDescClientID.Validators[0].(func(string) error)
+	// scriptassignmentDescSelector is the schema descriptor for selector field.
+	scriptassignmentDescSelector := scriptassignmentFields[4].Descriptor()
+	// scriptassignment.DefaultSelector holds the default value on creation for the selector field.
+	scriptassignment.DefaultSelector = scriptassignmentDescSelector.Default.(string)
+	// scriptassignment.SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	scriptassignment.SelectorValidator = scriptassignmentDescSelector.Validators[0].(func(string) error)
+	// scriptassignmentDescGroupID is the schema descriptor for group_id field.
+	scriptassignmentDescGroupID := scriptassignmentFields[5].Descriptor()
+	// scriptassignment.DefaultGroupID holds the default value on creation for the group_id field.
+	scriptassignment.DefaultGroupID = scriptassignmentDescGroupID.Default.(string)
+	// scriptassignment.GroupIDValidator is a validator for the "group_id" field. It is called by the builders before save.
+	scriptassignment.GroupIDValidator = scriptassignmentDescGroupID.Validators[0].(func(string) error)
+	// scriptassignmentDescID is the schema descriptor for id field.
+	scriptassignmentDescID := scriptassignmentFields[0].Descriptor()
+	// scriptassignment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scriptassignment.IDValidator = scriptassignmentDescID.Validators[0].(func(string) error)
+	scriptchangerequestMixin := schema.ScriptChangeRequest{}.Mixin()
+	scriptchangerequest.Policy = privacy.NewPolicies(scriptchangerequestMixin[3], schema.ScriptChangeRequest{})
+	scriptchangerequest.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := scriptchangerequest.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	scriptchangerequestMixinFields3 := scriptchangerequestMixin[3].Fields()
+	_ = scriptchangerequestMixinFields3
+	scriptchangerequestFields := schema.ScriptChangeRequest{}.Fields()
+	_ = scriptchangerequestFields
+	// scriptchangerequestDescTenantID is the schema descriptor for tenant_id field.
+	scriptchangerequestDescTenantID := scriptchangerequestMixinFields3[0].Descriptor()
+	// scriptchangerequest.DefaultTenantID holds the default value on creation for the tenant_id field.
+	scriptchangerequest.DefaultTenantID = scriptchangerequestDescTenantID.Default.(uint32)
+	// scriptchangerequestDescScriptID is the schema descriptor for script_id field.
+	scriptchangerequestDescScriptID := scriptchangerequestFields[1].Descriptor()
+	// scriptchangerequest.ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	scriptchangerequest.ScriptIDValidator = func() func(string) error {
+		validators := scriptchangerequestDescScriptID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(script_id string) error {
+			for _, fn := range fns {
+				if err := fn(script_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scriptchangerequestDescBaseVersion is the schema descriptor for base_version field.
+	scriptchangerequestDescBaseVersion := scriptchangerequestFields[2].Descriptor()
+	// scriptchangerequest.BaseVersionValidator is a validator for the "base_version" field. It is called by the builders before save.
+	scriptchangerequest.BaseVersionValidator = scriptchangerequestDescBaseVersion.Validators[0].(func(int) error)
+	// scriptchangerequestDescContentHash is the schema descriptor for content_hash field.
+	scriptchangerequestDescContentHash := scriptchangerequestFields[4].Descriptor()
+	// scriptchangerequest.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	scriptchangerequest.ContentHashValidator = func() func(string) error {
+		validators := scriptchangerequestDescContentHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content_hash string) error {
+			for _, fn := range fns {
+				if err := fn(content_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scriptchangerequestDescChangeNote is the schema descriptor for change_note field.
+	scriptchangerequestDescChangeNote := scriptchangerequestFields[5].Descriptor()
+	// scriptchangerequest.ChangeNoteValidator is a validator for the "change_note" field. It is called by the builders before save.
+	scriptchangerequest.ChangeNoteValidator = scriptchangerequestDescChangeNote.Validators[0].(func(string) error)
+	// scriptchangerequestDescReviewComment is the schema descriptor for review_comment field.
+	scriptchangerequestDescReviewComment := scriptchangerequestFields[10].Descriptor()
+	// scriptchangerequest.ReviewCommentValidator is a validator for the "review_comment" field. It is called by the builders before save.
+	scriptchangerequest.ReviewCommentValidator = scriptchangerequestDescReviewComment.Validators[0].(func(string) error)
+	// scriptchangerequestDescRejectionReason is the schema descriptor for rejection_reason field.
+	scriptchangerequestDescRejectionReason := scriptchangerequestFields[11].Descriptor()
+	// scriptchangerequest.RejectionReasonValidator is a validator for the "rejection_reason" field. It is called by the builders before save.
+	scriptchangerequest.RejectionReasonValidator = scriptchangerequestDescRejectionReason.Validators[0].(func(string) error)
+	// scriptchangerequestDescID is the schema descriptor for id field.
+	scriptchangerequestDescID := scriptchangerequestFields[0].Descriptor()
+	// scriptchangerequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scriptchangerequest.IDValidator = scriptchangerequestDescID.Validators[0].(func(string) error)
+	scriptversionMixin := schema.ScriptVersion{}.Mixin()
+	scriptversion.Policy = privacy.NewPolicies(scriptversionMixin[2], schema.ScriptVersion{})
+	scriptversion.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := scriptversion.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	scriptversionMixinFields2 := scriptversionMixin[2].Fields()
+	_ = scriptversionMixinFields2
+	scriptversionFields := schema.ScriptVersion{}.Fields()
+	_ = scriptversionFields
+	// scriptversionDescTenantID is the schema descriptor for tenant_id field.
+	scriptversionDescTenantID := scriptversionMixinFields2[0].Descriptor()
+	// scriptversion.DefaultTenantID holds the default value on creation for the tenant_id field.
+	scriptversion.DefaultTenantID = scriptversionDescTenantID.Default.(uint32)
+	// scriptversionDescScriptID is the schema descriptor for script_id field.
+	scriptversionDescScriptID := scriptversionFields[1].Descriptor()
+	// scriptversion.ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	scriptversion.ScriptIDValidator = func() func(string) error {
+		validators := scriptversionDescScriptID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(script_id string) error {
+			for _, fn := range fns {
+				if err := fn(script_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scriptversionDescVersion is the schema descriptor for version field.
+	scriptversionDescVersion := scriptversionFields[2].Descriptor()
+	// scriptversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	scriptversion.VersionValidator = scriptversionDescVersion.Validators[0].(func(int) error)
+	// scriptversionDescContentHash is the schema descriptor for content_hash field.
+	scriptversionDescContentHash := scriptversionFields[4].Descriptor()
+	// scriptversion.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	scriptversion.ContentHashValidator = func() func(string) error {
+		validators := scriptversionDescContentHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content_hash string) error {
+			for _, fn := range fns {
+				if err := fn(content_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scriptversionDescChangeNote is the schema descriptor for change_note field.
+	scriptversionDescChangeNote := scriptversionFields[5].Descriptor()
+	// scriptversion.ChangeNoteValidator is a validator for the "change_note" field. It is called by the builders before save.
+	scriptversion.ChangeNoteValidator = scriptversionDescChangeNote.Validators[0].(func(string) error)
+	// scriptversionDescChangeRequestID is the schema descriptor for change_request_id field.
+	scriptversionDescChangeRequestID := scriptversionFields[8].Descriptor()
+	// scriptversion.ChangeRequestIDValidator is a validator for the "change_request_id" field. It is called by the builders before save.
+	scriptversion.ChangeRequestIDValidator = scriptversionDescChangeRequestID.Validators[0].(func(string) error)
+	// scriptversionDescID is the schema descriptor for id field.
+	scriptversionDescID := scriptversionFields[0].Descriptor()
+	// scriptversion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scriptversion.IDValidator = scriptversionDescID.Validators[0].(func(string) error)
+	secretMixin := schema.Secret{}.Mixin()
+	secret.Policy = privacy.NewPolicies(secretMixin[3], schema.Secret{})
+	secret.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := secret.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	secretMixinFields3 := secretMixin[3].Fields()
+	_ = secretMixinFields3
+	secretFields := schema.Secret{}.Fields()
+	_ = secretFields
+	// secretDescTenantID is the schema descriptor for tenant_id field.
+	secretDescTenantID := secretMixinFields3[0].Descriptor()
+	// secret.DefaultTenantID holds the default value on creation for the tenant_id field.
+	secret.DefaultTenantID = secretDescTenantID.Default.(uint32)
+	// secretDescName is the schema descriptor for name field.
+	secretDescName := secretFields[1].Descriptor()
+	// secret.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	secret.NameValidator = func() func(string) error {
+		validators := secretDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// secretDescDescription is the schema descriptor for description field.
+	secretDescDescription := secretFields[2].Descriptor()
+	// secret.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	secret.DescriptionValidator = secretDescDescription.Validators[0].(func(string) error)
+	// secretDescKeyID is the schema descriptor for key_id field.
+	secretDescKeyID := secretFields[5].Descriptor()
+	// secret.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	secret.KeyIDValidator = func() func(string) error {
+		validators := secretDescKeyID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key_id string) error {
+			for _, fn := range fns {
+				if err := fn(key_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// secretDescID is the schema descriptor for id field.
+	secretDescID := secretFields[0].Descriptor()
+	// secret.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	secret.IDValidator = secretDescID.Validators[0].(func(string) error)
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescPublicKey is the schema descriptor for public_key field.
+	signingkeyDescPublicKey := signingkeyFields[2].Descriptor()
+	// signingkey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	signingkey.PublicKeyValidator = signingkeyDescPublicKey.Validators[0].(func([]byte) error)
+	// signingkeyDescSealedWith is the schema descriptor for sealed_with field.
+	signingkeyDescSealedWith := signingkeyFields[5].Descriptor()
+	// signingkey.SealedWithValidator is a validator for the "sealed_with" field. It is called by the builders before save.
+	signingkey.SealedWithValidator = func() func(string) error {
+		validators := signingkeyDescSealedWith.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(sealed_with string) error {
+			for _, fn := range fns {
+				if err := fn(sealed_with); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// signingkeyDescID is the schema descriptor for id field.
+	signingkeyDescID := signingkeyFields[0].Descriptor()
+	// signingkey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	signingkey.IDValidator = func() func(string) error {
+		validators := signingkeyDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	tenantsettingMixin := schema.TenantSetting{}.Mixin()
+	tenantsetting.Policy = privacy.NewPolicies(tenantsettingMixin[2], schema.TenantSetting{})
+	tenantsetting.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tenantsetting.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tenantsettingMixinFields2 := tenantsettingMixin[2].Fields()
+	_ = tenantsettingMixinFields2
+	tenantsettingFields := schema.TenantSetting{}.Fields()
+	_ = tenantsettingFields
+	// tenantsettingDescTenantID is the schema descriptor for tenant_id field.
+	tenantsettingDescTenantID := tenantsettingMixinFields2[0].Descriptor()
+	// tenantsetting.DefaultTenantID holds the default value on creation for the tenant_id field.
+	tenantsetting.DefaultTenantID = tenantsettingDescTenantID.Default.(uint32)
+	// tenantsettingDescDefaultTimeoutSeconds is the schema descriptor for default_timeout_seconds field.
+	tenantsettingDescDefaultTimeoutSeconds := tenantsettingFields[1].Descriptor()
+	// tenantsetting.DefaultDefaultTimeoutSeconds holds the default value on creation for the default_timeout_seconds field.
+	tenantsetting.DefaultDefaultTimeoutSeconds = tenantsettingDescDefaultTimeoutSeconds.Default.(int)
+	// tenantsetting.DefaultTimeoutSecondsValidator is a validator for the "default_timeout_seconds" field. It is called by the builders before save.
+	tenantsetting.DefaultTimeoutSecondsValidator = tenantsettingDescDefaultTimeoutSeconds.Validators[0].(func(int) error)
+	// tenantsettingDescMaxConcurrentPerClient is the schema descriptor for max_concurrent_per_client field.
+	tenantsettingDescMaxConcurrentPerClient := tenantsettingFields[2].Descriptor()
+	// tenantsetting.DefaultMaxConcurrentPerClient holds the default value on creation for the max_concurrent_per_client field.
+	tenantsetting.DefaultMaxConcurrentPerClient = tenantsettingDescMaxConcurrentPerClient.Default.(int)
+	// tenantsetting.MaxConcurrentPerClientValidator is a validator for the "max_concurrent_per_client" field. It is called by the builders before save.
+	tenantsetting.MaxConcurrentPerClientValidator = tenantsettingDescMaxConcurrentPerClient.Validators[0].(func(int) error)
+	// tenantsettingDescRequireScriptApproval is the schema descriptor for require_script_approval field.
+	tenantsettingDescRequireScriptApproval := tenantsettingFields[3].Descriptor()
+	// tenantsetting.DefaultRequireScriptApproval holds the default value on creation for the require_script_approval field.
+	tenantsetting.DefaultRequireScriptApproval = tenantsettingDescRequireScriptApproval.Default.(bool)
+	// tenantsettingDescScriptApproverRole is the schema descriptor for script_approver_role field.
+	tenantsettingDescScriptApproverRole := tenantsettingFields[4].Descriptor()
+	// tenantsetting.ScriptApproverRoleValidator is a validator for the "script_approver_role" field. It is called by the builders before save.
+	tenantsetting.ScriptApproverRoleValidator = tenantsettingDescScriptApproverRole.Validators[0].(func(string) error)
+	// tenantsettingDescExecutionApprovalTTLMinutes is the schema descriptor for execution_approval_ttl_minutes field.
+	tenantsettingDescExecutionApprovalTTLMinutes := tenantsettingFields[5].Descriptor()
+	// tenantsetting.DefaultExecutionApprovalTTLMinutes holds the default value on creation for the execution_approval_ttl_minutes field.
+	tenantsetting.DefaultExecutionApprovalTTLMinutes = tenantsettingDescExecutionApprovalTTLMinutes.Default.(int)
+	// tenantsetting.ExecutionApprovalTTLMinutesValidator is a validator for the "execution_approval_ttl_minutes" field. It is called by the builders before save.
+	tenantsetting.ExecutionApprovalTTLMinutesValidator = tenantsettingDescExecutionApprovalTTLMinutes.Validators[0].(func(int) error)
+	// tenantsettingDescID is the schema descriptor for id field.
+	tenantsettingDescID := tenantsettingFields[0].Descriptor()
+	// tenantsetting.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantsetting.IDValidator = tenantsettingDescID.Validators[0].(func(string) error)
+	workflowMixin := schema.Workflow{}.Mixin()
+	workflow.Policy = privacy.NewPolicies(workflowMixin[3], schema.Workflow{})
+	workflow.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := workflow.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	workflowMixinFields3 := workflowMixin[3].Fields()
+	_ = workflowMixinFields3
+	workflowFields := schema.Workflow{}.Fields()
+	_ = workflowFields
+	// workflowDescTenantID is the schema descriptor for tenant_id field.
+	workflowDescTenantID := workflowMixinFields3[0].Descriptor()
+	// workflow.DefaultTenantID holds the default value on creation for the tenant_id field.
+	workflow.DefaultTenantID = workflowDescTenantID.Default.(uint32)
+	// workflowDescName is the schema descriptor for name field.
+	workflowDescName := workflowFields[1].Descriptor()
+	// workflow.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	workflow.NameValidator = func() func(string) error {
+		validators := workflowDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workflowDescDescription is the schema descriptor for description field.
+	workflowDescDescription := workflowFields[2].Descriptor()
+	// workflow.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	workflow.DescriptionValidator = workflowDescDescription.Validators[0].(func(string) error)
+	// workflowDescID is the schema descriptor for id field.
+	workflowDescID := workflowFields[0].Descriptor()
+	// workflow.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	workflow.IDValidator = workflowDescID.Validators[0].(func(string) error)
+	workflowrunMixin := schema.WorkflowRun{}.Mixin()
+	workflowrun.Policy = privacy.NewPolicies(workflowrunMixin[2], schema.WorkflowRun{})
+	workflowrun.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := workflowrun.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	workflowrunMixinFields2 := workflowrunMixin[2].Fields()
+	_ = workflowrunMixinFields2
+	workflowrunFields := schema.WorkflowRun{}.Fields()
+	_ = workflowrunFields
+	// workflowrunDescTenantID is the schema descriptor for tenant_id field.
+	workflowrunDescTenantID := workflowrunMixinFields2[0].Descriptor()
+	// workflowrun.DefaultTenantID holds the default value on creation for the tenant_id field.
+	workflowrun.DefaultTenantID = workflowrunDescTenantID.Default.(uint32)
+	// workflowrunDescWorkflowID is the schema descriptor for workflow_id field.
+	workflowrunDescWorkflowID := workflowrunFields[1].Descriptor()
+	// workflowrun.WorkflowIDValidator is a validator for the "workflow_id" field. It is called by the builders before save.
+	workflowrun.WorkflowIDValidator = func() func(string) error {
+		validators := workflowrunDescWorkflowID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(workflow_id string) error {
+			for _, fn := range fns {
+				if err := fn(workflow_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workflowrunDescWorkflowName is the schema descriptor for workflow_name field.
+	workflowrunDescWorkflowName := workflowrunFields[2].Descriptor()
+	// workflowrun.WorkflowNameValidator is a validator for the "workflow_name" field. It is called by the builders before save.
+	workflowrun.WorkflowNameValidator = func() func(string) error {
+		validators := workflowrunDescWorkflowName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(workflow_name string) error {
+			for _, fn := range fns {
+				if err := fn(workflow_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workflowrunDescClientID is the schema descriptor for client_id field.
+	workflowrunDescClientID := workflowrunFields[3].Descriptor()
+	// workflowrun.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	workflowrun.ClientIDValidator = func() func(string) error {
+		validators := workflowrunDescClientID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -289,10 +1550,14 @@ func init() {
 			return nil
 		}
 	}()
-	// scriptassignmentDescID is the schema descriptor for id field.
-	scriptassignmentDescID := scriptassignmentFields[0].Descriptor()
-	// scriptassignment.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	scriptassignment.IDValidator = scriptassignmentDescID.Validators[0].(func(string) error)
+	// workflowrunDescRevision is the schema descriptor for revision field.
+	workflowrunDescRevision := workflowrunFields[8].Descriptor()
+	// workflowrun.DefaultRevision holds the default value on creation for the revision field.
+	workflowrun.DefaultRevision = workflowrunDescRevision.Default.(int)
+	// workflowrunDescID is the schema descriptor for id field.
+	workflowrunDescID := workflowrunFields[0].Descriptor()
+	// workflowrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	workflowrun.IDValidator = workflowrunDescID.Validators[0].(func(string) error)
 }
 
 const (
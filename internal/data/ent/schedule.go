@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
+)
+
+// Schedule is the model entity for the Schedule schema.
+type Schedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Schedule name
+	Name string `json:"name,omitempty"`
+	// Schedule description
+	Description string `json:"description,omitempty"`
+	// FK to executor_scripts
+	ScriptID string `json:"script_id,omitempty"`
+	// 5-field cron expression or macro such as @daily
+	CronExpression string `json:"cron_expression,omitempty"`
+	// IANA timezone the expression is evaluated in
+	Timezone string `json:"timezone,omitempty"`
+	// Client CNs targeted explicitly
+	ClientIds []string `json:"client_ids,omitempty"`
+	// Label selector resolved at each occurrence
+	Selector string `json:"selector,omitempty"`
+	// Run strategy: targets per batch
+	BatchSize int `json:"batch_size,omitempty"`
+	// Run strategy: canary batch size
+	CanarySize int `json:"canary_size,omitempty"`
+	// Run strategy: wait between batches
+	BatchPauseSeconds int `json:"batch_pause_seconds,omitempty"`
+	// Run strategy: failures tolerated before halting
+	MaxFailures *int `json:"max_failures,omitempty"`
+	// Disabled schedules never fire
+	Enabled bool `json:"enabled,omitempty"`
+	// What to do about occurrences missed during downtime
+	CatchUpPolicy schedule.CatchUpPolicy `json:"catch_up_policy,omitempty"`
+	// Next occurrence, nil while disabled
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// Occurrence the last run was started for
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// Run started by the last occurrence
+	LastRunID *string `json:"last_run_id,omitempty"`
+	// Why the last occurrence did not start a run
+	LastError    string `json:"last_error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Schedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldClientIds:
+			values[i] = new([]byte)
+		case schedule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case schedule.FieldCreateBy, schedule.FieldUpdateBy, schedule.FieldTenantID, schedule.FieldBatchSize, schedule.FieldCanarySize, schedule.FieldBatchPauseSeconds, schedule.FieldMaxFailures:
+			values[i] = new(sql.NullInt64)
+		case schedule.FieldID, schedule.FieldName, schedule.FieldDescription, schedule.FieldScriptID, schedule.FieldCronExpression, schedule.FieldTimezone, schedule.FieldSelector, schedule.FieldCatchUpPolicy, schedule.FieldLastRunID, schedule.FieldLastError:
+			values[i] = new(sql.NullString)
+		case schedule.FieldCreateTime, schedule.FieldUpdateTime, schedule.FieldDeleteTime, schedule.FieldNextRunAt, schedule.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Schedule fields.
+func (_m *Schedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case schedule.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case schedule.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case schedule.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case schedule.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case schedule.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case schedule.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case schedule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case schedule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case schedule.FieldScriptID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field script_id", values[i])
+			} else if value.Valid {
+				_m.ScriptID = value.String
+			}
+		case schedule.FieldCronExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_expression", values[i])
+			} else if value.Valid {
+				_m.CronExpression = value.String
+			}
+		case schedule.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case schedule.FieldClientIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field client_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ClientIds); err != nil {
+					return fmt.Errorf("unmarshal field client_ids: %w", err)
+				}
+			}
+		case schedule.FieldSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selector", values[i])
+			} else if value.Valid {
+				_m.Selector = value.String
+			}
+		case schedule.FieldBatchSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_size", values[i])
+			} else if value.Valid {
+				_m.BatchSize = int(value.Int64)
+			}
+		case schedule.FieldCanarySize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field canary_size", values[i])
+			} else if value.Valid {
+				_m.CanarySize = int(value.Int64)
+			}
+		case schedule.FieldBatchPauseSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_pause_seconds", values[i])
+			} else if value.Valid {
+				_m.BatchPauseSeconds = int(value.Int64)
+			}
+		case schedule.FieldMaxFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_failures", values[i])
+			} else if value.Valid {
+				_m.MaxFailures = new(int)
+				*_m.MaxFailures = int(value.Int64)
+			}
+		case schedule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case schedule.FieldCatchUpPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field catch_up_policy", values[i])
+			} else if value.Valid {
+				_m.CatchUpPolicy = schedule.CatchUpPolicy(value.String)
+			}
+		case schedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				_m.NextRunAt = new(time.Time)
+				*_m.NextRunAt = value.Time
+			}
+		case schedule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = new(time.Time)
+				*_m.LastRunAt = value.Time
+			}
+		case schedule.FieldLastRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_id", values[i])
+			} else if value.Valid {
+				_m.LastRunID = new(string)
+				*_m.LastRunID = value.String
+			}
+		case schedule.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Schedule.
+// This includes values selected through modifiers, order, etc.
+func (_m *Schedule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Schedule.
+// Note that you need to call Schedule.Unwrap() before calling this method if this Schedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Schedule) Update() *ScheduleUpdateOne {
+	return NewScheduleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Schedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Schedule) Unwrap() *Schedule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Schedule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Schedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Schedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("script_id=")
+	builder.WriteString(_m.ScriptID)
+	builder.WriteString(", ")
+	builder.WriteString("cron_expression=")
+	builder.WriteString(_m.CronExpression)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("client_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientIds))
+	builder.WriteString(", ")
+	builder.WriteString("selector=")
+	builder.WriteString(_m.Selector)
+	builder.WriteString(", ")
+	builder.WriteString("batch_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatchSize))
+	builder.WriteString(", ")
+	builder.WriteString("canary_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanarySize))
+	builder.WriteString(", ")
+	builder.WriteString("batch_pause_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatchPauseSeconds))
+	builder.WriteString(", ")
+	if v := _m.MaxFailures; v != nil {
+		builder.WriteString("max_failures=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("catch_up_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CatchUpPolicy))
+	builder.WriteString(", ")
+	if v := _m.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastRunID; v != nil {
+		builder.WriteString("last_run_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Schedules is a parsable slice of Schedule.
+type Schedules []*Schedule
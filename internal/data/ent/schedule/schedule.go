@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schedule
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the schedule type in the database.
+	Label = "schedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldUpdateBy holds the string denoting the update_by field in the database.
+	FieldUpdateBy = "update_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldScriptID holds the string denoting the script_id field in the database.
+	FieldScriptID = "script_id"
+	// FieldCronExpression holds the string denoting the cron_expression field in the database.
+	FieldCronExpression = "cron_expression"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldClientIds holds the string denoting the client_ids field in the database.
+	FieldClientIds = "client_ids"
+	// FieldSelector holds the string denoting the selector field in the database.
+	FieldSelector = "selector"
+	// FieldBatchSize holds the string denoting the batch_size field in the database.
+	FieldBatchSize = "batch_size"
+	// FieldCanarySize holds the string denoting the canary_size field in the database.
+	FieldCanarySize = "canary_size"
+	// FieldBatchPauseSeconds holds the string denoting the batch_pause_seconds field in the database.
+	FieldBatchPauseSeconds = "batch_pause_seconds"
+	// FieldMaxFailures holds the string denoting the max_failures field in the database.
+	FieldMaxFailures = "max_failures"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCatchUpPolicy holds the string denoting the catch_up_policy field in the database.
+	FieldCatchUpPolicy = "catch_up_policy"
+	// FieldNextRunAt holds the string denoting the next_run_at field in the database.
+	FieldNextRunAt = "next_run_at"
+	// FieldLastRunAt holds the string denoting the last_run_at field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldLastRunID holds the string denoting the last_run_id field in the database.
+	FieldLastRunID = "last_run_id"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// Table holds the table name of the schedule in the database.
+	Table = "executor_schedules"
+)
+
+// Columns holds all SQL columns for schedule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldUpdateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldName,
+	FieldDescription,
+	FieldScriptID,
+	FieldCronExpression,
+	FieldTimezone,
+	FieldClientIds,
+	FieldSelector,
+	FieldBatchSize,
+	FieldCanarySize,
+	FieldBatchPauseSeconds,
+	FieldMaxFailures,
+	FieldEnabled,
+	FieldCatchUpPolicy,
+	FieldNextRunAt,
+	FieldLastRunAt,
+	FieldLastRunID,
+	FieldLastError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-executor/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	ScriptIDValidator func(string) error
+	// CronExpressionValidator is a validator for the "cron_expression" field. It is called by the builders before save.
+	CronExpressionValidator func(string) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
+	// SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	SelectorValidator func(string) error
+	// DefaultBatchSize holds the default value on creation for the "batch_size" field.
+	DefaultBatchSize int
+	// BatchSizeValidator is a validator for the "batch_size" field. It is called by the builders before save.
+	BatchSizeValidator func(int) error
+	// DefaultCanarySize holds the default value on creation for the "canary_size" field.
+	DefaultCanarySize int
+	// CanarySizeValidator is a validator for the "canary_size" field. It is called by the builders before save.
+	CanarySizeValidator func(int) error
+	// DefaultBatchPauseSeconds holds the default value on creation for the "batch_pause_seconds" field.
+	DefaultBatchPauseSeconds int
+	// BatchPauseSecondsValidator is a validator for the "batch_pause_seconds" field. It is called by the builders before save.
+	BatchPauseSecondsValidator func(int) error
+	// MaxFailuresValidator is a validator for the "max_failures" field. It is called by the builders before save.
+	MaxFailuresValidator func(int) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// LastRunIDValidator is a validator for the "last_run_id" field. It is called by the builders before save.
+	LastRunIDValidator func(string) error
+	// LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	LastErrorValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// CatchUpPolicy defines the type for the "catch_up_policy" enum field.
+type CatchUpPolicy string
+
+// CatchUpPolicySKIP is the default value of the CatchUpPolicy enum.
+const DefaultCatchUpPolicy = CatchUpPolicySKIP
+
+// CatchUpPolicy values.
+const (
+	CatchUpPolicySKIP     CatchUpPolicy = "SKIP"
+	CatchUpPolicyRUN_ONCE CatchUpPolicy = "RUN_ONCE"
+	CatchUpPolicyRUN_ALL  CatchUpPolicy = "RUN_ALL"
+)
+
+func (cup CatchUpPolicy) String() string {
+	return string(cup)
+}
+
+// CatchUpPolicyValidator is a validator for the "catch_up_policy" field enum values. It is called by the builders before save.
+func CatchUpPolicyValidator(cup CatchUpPolicy) error {
+	switch cup {
+	case CatchUpPolicySKIP, CatchUpPolicyRUN_ONCE, CatchUpPolicyRUN_ALL:
+		return nil
+	default:
+		return fmt.Errorf("schedule: invalid enum value for catch_up_policy field: %q", cup)
+	}
+}
+
+// OrderOption defines the ordering options for the Schedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByUpdateBy orders the results by the update_by field.
+func ByUpdateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByScriptID orders the results by the script_id field.
+func ByScriptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScriptID, opts...).ToFunc()
+}
+
+// ByCronExpression orders the results by the cron_expression field.
+func ByCronExpression(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCronExpression, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// BySelector orders the results by the selector field.
+func BySelector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelector, opts...).ToFunc()
+}
+
+// ByBatchSize orders the results by the batch_size field.
+func ByBatchSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchSize, opts...).ToFunc()
+}
+
+// ByCanarySize orders the results by the canary_size field.
+func ByCanarySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanarySize, opts...).ToFunc()
+}
+
+// ByBatchPauseSeconds orders the results by the batch_pause_seconds field.
+func ByBatchPauseSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchPauseSeconds, opts...).ToFunc()
+}
+
+// ByMaxFailures orders the results by the max_failures field.
+func ByMaxFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxFailures, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCatchUpPolicy orders the results by the catch_up_policy field.
+func ByCatchUpPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatchUpPolicy, opts...).ToFunc()
+}
+
+// ByNextRunAt orders the results by the next_run_at field.
+func ByNextRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRunAt, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the last_run_at field.
+func ByLastRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByLastRunID orders the results by the last_run_id field.
+func ByLastRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunID, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
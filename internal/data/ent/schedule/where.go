@@ -0,0 +1,1355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldDescription, v))
+}
+
+// ScriptID applies equality check predicate on the "script_id" field. It's identical to ScriptIDEQ.
+func ScriptID(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldScriptID, v))
+}
+
+// CronExpression applies equality check predicate on the "cron_expression" field. It's identical to CronExpressionEQ.
+func CronExpression(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCronExpression, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// Selector applies equality check predicate on the "selector" field. It's identical to SelectorEQ.
+func Selector(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldSelector, v))
+}
+
+// BatchSize applies equality check predicate on the "batch_size" field. It's identical to BatchSizeEQ.
+func BatchSize(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldBatchSize, v))
+}
+
+// CanarySize applies equality check predicate on the "canary_size" field. It's identical to CanarySizeEQ.
+func CanarySize(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCanarySize, v))
+}
+
+// BatchPauseSeconds applies equality check predicate on the "batch_pause_seconds" field. It's identical to BatchPauseSecondsEQ.
+func BatchPauseSeconds(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldBatchPauseSeconds, v))
+}
+
+// MaxFailures applies equality check predicate on the "max_failures" field. It's identical to MaxFailuresEQ.
+func MaxFailures(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldMaxFailures, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunID applies equality check predicate on the "last_run_id" field. It's identical to LastRunIDEQ.
+func LastRunID(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldLastRunID, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ScriptIDEQ applies the EQ predicate on the "script_id" field.
+func ScriptIDEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldScriptID, v))
+}
+
+// ScriptIDNEQ applies the NEQ predicate on the "script_id" field.
+func ScriptIDNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldScriptID, v))
+}
+
+// ScriptIDIn applies the In predicate on the "script_id" field.
+func ScriptIDIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldScriptID, vs...))
+}
+
+// ScriptIDNotIn applies the NotIn predicate on the "script_id" field.
+func ScriptIDNotIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldScriptID, vs...))
+}
+
+// ScriptIDGT applies the GT predicate on the "script_id" field.
+func ScriptIDGT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldScriptID, v))
+}
+
+// ScriptIDGTE applies the GTE predicate on the "script_id" field.
+func ScriptIDGTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldScriptID, v))
+}
+
+// ScriptIDLT applies the LT predicate on the "script_id" field.
+func ScriptIDLT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldScriptID, v))
+}
+
+// ScriptIDLTE applies the LTE predicate on the "script_id" field.
+func ScriptIDLTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldScriptID, v))
+}
+
+// ScriptIDContains applies the Contains predicate on the "script_id" field.
+func ScriptIDContains(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContains(FieldScriptID, v))
+}
+
+// ScriptIDHasPrefix applies the HasPrefix predicate on the "script_id" field.
+func ScriptIDHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasPrefix(FieldScriptID, v))
+}
+
+// ScriptIDHasSuffix applies the HasSuffix predicate on the "script_id" field.
+func ScriptIDHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasSuffix(FieldScriptID, v))
+}
+
+// ScriptIDEqualFold applies the EqualFold predicate on the "script_id" field.
+func ScriptIDEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldScriptID, v))
+}
+
+// ScriptIDContainsFold applies the ContainsFold predicate on the "script_id" field.
+func ScriptIDContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldScriptID, v))
+}
+
+// CronExpressionEQ applies the EQ predicate on the "cron_expression" field.
+func CronExpressionEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCronExpression, v))
+}
+
+// CronExpressionNEQ applies the NEQ predicate on the "cron_expression" field.
+func CronExpressionNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldCronExpression, v))
+}
+
+// CronExpressionIn applies the In predicate on the "cron_expression" field.
+func CronExpressionIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldCronExpression, vs...))
+}
+
+// CronExpressionNotIn applies the NotIn predicate on the "cron_expression" field.
+func CronExpressionNotIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldCronExpression, vs...))
+}
+
+// CronExpressionGT applies the GT predicate on the "cron_expression" field.
+func CronExpressionGT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldCronExpression, v))
+}
+
+// CronExpressionGTE applies the GTE predicate on the "cron_expression" field.
+func CronExpressionGTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldCronExpression, v))
+}
+
+// CronExpressionLT applies the LT predicate on the "cron_expression" field.
+func CronExpressionLT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldCronExpression, v))
+}
+
+// CronExpressionLTE applies the LTE predicate on the "cron_expression" field.
+func CronExpressionLTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldCronExpression, v))
+}
+
+// CronExpressionContains applies the Contains predicate on the "cron_expression" field.
+func CronExpressionContains(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContains(FieldCronExpression, v))
+}
+
+// CronExpressionHasPrefix applies the HasPrefix predicate on the "cron_expression" field.
+func CronExpressionHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasPrefix(FieldCronExpression, v))
+}
+
+// CronExpressionHasSuffix applies the HasSuffix predicate on the "cron_expression" field.
+func CronExpressionHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasSuffix(FieldCronExpression, v))
+}
+
+// CronExpressionEqualFold applies the EqualFold predicate on the "cron_expression" field.
+func CronExpressionEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldCronExpression, v))
+}
+
+// CronExpressionContainsFold applies the ContainsFold predicate on the "cron_expression" field.
+func CronExpressionContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldCronExpression, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// ClientIdsIsNil applies the IsNil predicate on the "client_ids" field.
+func ClientIdsIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldClientIds))
+}
+
+// ClientIdsNotNil applies the NotNil predicate on the "client_ids" field.
+func ClientIdsNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldClientIds))
+}
+
+// SelectorEQ applies the EQ predicate on the "selector" field.
+func SelectorEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldSelector, v))
+}
+
+// SelectorNEQ applies the NEQ predicate on the "selector" field.
+func SelectorNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldSelector, v))
+}
+
+// SelectorIn applies the In predicate on the "selector" field.
+func SelectorIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldSelector, vs...))
+}
+
+// SelectorNotIn applies the NotIn predicate on the "selector" field.
+func SelectorNotIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldSelector, vs...))
+}
+
+// SelectorGT applies the GT predicate on the "selector" field.
+func SelectorGT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldSelector, v))
+}
+
+// SelectorGTE applies the GTE predicate on the "selector" field.
+func SelectorGTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldSelector, v))
+}
+
+// SelectorLT applies the LT predicate on the "selector" field.
+func SelectorLT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldSelector, v))
+}
+
+// SelectorLTE applies the LTE predicate on the "selector" field.
+func SelectorLTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldSelector, v))
+}
+
+// SelectorContains applies the Contains predicate on the "selector" field.
+func SelectorContains(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContains(FieldSelector, v))
+}
+
+// SelectorHasPrefix applies the HasPrefix predicate on the "selector" field.
+func SelectorHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasPrefix(FieldSelector, v))
+}
+
+// SelectorHasSuffix applies the HasSuffix predicate on the "selector" field.
+func SelectorHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasSuffix(FieldSelector, v))
+}
+
+// SelectorIsNil applies the IsNil predicate on the "selector" field.
+func SelectorIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldSelector))
+}
+
+// SelectorNotNil applies the NotNil predicate on the "selector" field.
+func SelectorNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldSelector))
+}
+
+// SelectorEqualFold applies the EqualFold predicate on the "selector" field.
+func SelectorEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldSelector, v))
+}
+
+// SelectorContainsFold applies the ContainsFold predicate on the "selector" field.
+func SelectorContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldSelector, v))
+}
+
+// BatchSizeEQ applies the EQ predicate on the "batch_size" field.
+func BatchSizeEQ(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldBatchSize, v))
+}
+
+// BatchSizeNEQ applies the NEQ predicate on the "batch_size" field.
+func BatchSizeNEQ(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldBatchSize, v))
+}
+
+// BatchSizeIn applies the In predicate on the "batch_size" field.
+func BatchSizeIn(vs ...int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldBatchSize, vs...))
+}
+
+// BatchSizeNotIn applies the NotIn predicate on the "batch_size" field.
+func BatchSizeNotIn(vs ...int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldBatchSize, vs...))
+}
+
+// BatchSizeGT applies the GT predicate on the "batch_size" field.
+func BatchSizeGT(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldBatchSize, v))
+}
+
+// BatchSizeGTE applies the GTE predicate on the "batch_size" field.
+func BatchSizeGTE(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldBatchSize, v))
+}
+
+// BatchSizeLT applies the LT predicate on the "batch_size" field.
+func BatchSizeLT(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldBatchSize, v))
+}
+
+// BatchSizeLTE applies the LTE predicate on the "batch_size" field.
+func BatchSizeLTE(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldBatchSize, v))
+}
+
+// CanarySizeEQ applies the EQ predicate on the "canary_size" field.
+func CanarySizeEQ(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCanarySize, v))
+}
+
+// CanarySizeNEQ applies the NEQ predicate on the "canary_size" field.
+func CanarySizeNEQ(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldCanarySize, v))
+}
+
+// CanarySizeIn applies the In predicate on the "canary_size" field.
+func CanarySizeIn(vs ...int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldCanarySize, vs...))
+}
+
+// CanarySizeNotIn applies the NotIn predicate on the "canary_size" field.
+func CanarySizeNotIn(vs ...int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldCanarySize, vs...))
+}
+
+// CanarySizeGT applies the GT predicate on the "canary_size" field.
+func CanarySizeGT(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldCanarySize, v))
+}
+
+// CanarySizeGTE applies the GTE predicate on the "canary_size" field.
+func CanarySizeGTE(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldCanarySize, v))
+}
+
+// CanarySizeLT applies the LT predicate on the "canary_size" field.
+func CanarySizeLT(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldCanarySize, v))
+}
+
+// CanarySizeLTE applies the LTE predicate on the "canary_size" field.
+func CanarySizeLTE(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldCanarySize, v))
+}
+
+// BatchPauseSecondsEQ applies the EQ predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsEQ(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsNEQ applies the NEQ predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsNEQ(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsIn applies the In predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsIn(vs ...int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldBatchPauseSeconds, vs...))
+}
+
+// BatchPauseSecondsNotIn applies the NotIn predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsNotIn(vs ...int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldBatchPauseSeconds, vs...))
+}
+
+// BatchPauseSecondsGT applies the GT predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsGT(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsGTE applies the GTE predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsGTE(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsLT applies the LT predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsLT(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldBatchPauseSeconds, v))
+}
+
+// BatchPauseSecondsLTE applies the LTE predicate on the "batch_pause_seconds" field.
+func BatchPauseSecondsLTE(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldBatchPauseSeconds, v))
+}
+
+// MaxFailuresEQ applies the EQ predicate on the "max_failures" field.
+func MaxFailuresEQ(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldMaxFailures, v))
+}
+
+// MaxFailuresNEQ applies the NEQ predicate on the "max_failures" field.
+func MaxFailuresNEQ(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldMaxFailures, v))
+}
+
+// MaxFailuresIn applies the In predicate on the "max_failures" field.
+func MaxFailuresIn(vs ...int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldMaxFailures, vs...))
+}
+
+// MaxFailuresNotIn applies the NotIn predicate on the "max_failures" field.
+func MaxFailuresNotIn(vs ...int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldMaxFailures, vs...))
+}
+
+// MaxFailuresGT applies the GT predicate on the "max_failures" field.
+func MaxFailuresGT(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldMaxFailures, v))
+}
+
+// MaxFailuresGTE applies the GTE predicate on the "max_failures" field.
+func MaxFailuresGTE(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldMaxFailures, v))
+}
+
+// MaxFailuresLT applies the LT predicate on the "max_failures" field.
+func MaxFailuresLT(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldMaxFailures, v))
+}
+
+// MaxFailuresLTE applies the LTE predicate on the "max_failures" field.
+func MaxFailuresLTE(v int) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldMaxFailures, v))
+}
+
+// MaxFailuresIsNil applies the IsNil predicate on the "max_failures" field.
+func MaxFailuresIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldMaxFailures))
+}
+
+// MaxFailuresNotNil applies the NotNil predicate on the "max_failures" field.
+func MaxFailuresNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldMaxFailures))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CatchUpPolicyEQ applies the EQ predicate on the "catch_up_policy" field.
+func CatchUpPolicyEQ(v CatchUpPolicy) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldCatchUpPolicy, v))
+}
+
+// CatchUpPolicyNEQ applies the NEQ predicate on the "catch_up_policy" field.
+func CatchUpPolicyNEQ(v CatchUpPolicy) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldCatchUpPolicy, v))
+}
+
+// CatchUpPolicyIn applies the In predicate on the "catch_up_policy" field.
+func CatchUpPolicyIn(vs ...CatchUpPolicy) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldCatchUpPolicy, vs...))
+}
+
+// CatchUpPolicyNotIn applies the NotIn predicate on the "catch_up_policy" field.
+func CatchUpPolicyNotIn(vs ...CatchUpPolicy) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldCatchUpPolicy, vs...))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// NextRunAtIsNil applies the IsNil predicate on the "next_run_at" field.
+func NextRunAtIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldNextRunAt))
+}
+
+// NextRunAtNotNil applies the NotNil predicate on the "next_run_at" field.
+func NextRunAtNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldNextRunAt))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastRunIDEQ applies the EQ predicate on the "last_run_id" field.
+func LastRunIDEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldLastRunID, v))
+}
+
+// LastRunIDNEQ applies the NEQ predicate on the "last_run_id" field.
+func LastRunIDNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldLastRunID, v))
+}
+
+// LastRunIDIn applies the In predicate on the "last_run_id" field.
+func LastRunIDIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldLastRunID, vs...))
+}
+
+// LastRunIDNotIn applies the NotIn predicate on the "last_run_id" field.
+func LastRunIDNotIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldLastRunID, vs...))
+}
+
+// LastRunIDGT applies the GT predicate on the "last_run_id" field.
+func LastRunIDGT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldLastRunID, v))
+}
+
+// LastRunIDGTE applies the GTE predicate on the "last_run_id" field.
+func LastRunIDGTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldLastRunID, v))
+}
+
+// LastRunIDLT applies the LT predicate on the "last_run_id" field.
+func LastRunIDLT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldLastRunID, v))
+}
+
+// LastRunIDLTE applies the LTE predicate on the "last_run_id" field.
+func LastRunIDLTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldLastRunID, v))
+}
+
+// LastRunIDContains applies the Contains predicate on the "last_run_id" field.
+func LastRunIDContains(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContains(FieldLastRunID, v))
+}
+
+// LastRunIDHasPrefix applies the HasPrefix predicate on the "last_run_id" field.
+func LastRunIDHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasPrefix(FieldLastRunID, v))
+}
+
+// LastRunIDHasSuffix applies the HasSuffix predicate on the "last_run_id" field.
+func LastRunIDHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasSuffix(FieldLastRunID, v))
+}
+
+// LastRunIDIsNil applies the IsNil predicate on the "last_run_id" field.
+func LastRunIDIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldLastRunID))
+}
+
+// LastRunIDNotNil applies the NotNil predicate on the "last_run_id" field.
+func LastRunIDNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldLastRunID))
+}
+
+// LastRunIDEqualFold applies the EqualFold predicate on the "last_run_id" field.
+func LastRunIDEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldLastRunID, v))
+}
+
+// LastRunIDContainsFold applies the ContainsFold predicate on the "last_run_id" field.
+func LastRunIDContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldLastRunID, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Schedule {
+	return predicate.Schedule(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Schedule) predicate.Schedule {
+	return predicate.Schedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Schedule) predicate.Schedule {
+	return predicate.Schedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Schedule) predicate.Schedule {
+	return predicate.Schedule(sql.NotPredicates(p))
+}
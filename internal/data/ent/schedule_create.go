@@ -0,0 +1,2165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
+)
+
+// ScheduleCreate is the builder for creating a Schedule entity.
+type ScheduleCreate struct {
+	config
+	mutation *ScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ScheduleCreate) SetCreateBy(v uint32) *ScheduleCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableCreateBy(v *uint32) *ScheduleCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *ScheduleCreate) SetUpdateBy(v uint32) *ScheduleCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableUpdateBy(v *uint32) *ScheduleCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ScheduleCreate) SetCreateTime(v time.Time) *ScheduleCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableCreateTime(v *time.Time) *ScheduleCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ScheduleCreate) SetUpdateTime(v time.Time) *ScheduleCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableUpdateTime(v *time.Time) *ScheduleCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ScheduleCreate) SetDeleteTime(v time.Time) *ScheduleCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableDeleteTime(v *time.Time) *ScheduleCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ScheduleCreate) SetTenantID(v uint32) *ScheduleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableTenantID(v *uint32) *ScheduleCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ScheduleCreate) SetName(v string) *ScheduleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ScheduleCreate) SetDescription(v string) *ScheduleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableDescription(v *string) *ScheduleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetScriptID sets the "script_id" field.
+func (_c *ScheduleCreate) SetScriptID(v string) *ScheduleCreate {
+	_c.mutation.SetScriptID(v)
+	return _c
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_c *ScheduleCreate) SetCronExpression(v string) *ScheduleCreate {
+	_c.mutation.SetCronExpression(v)
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *ScheduleCreate) SetTimezone(v string) *ScheduleCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableTimezone(v *string) *ScheduleCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_c *ScheduleCreate) SetClientIds(v []string) *ScheduleCreate {
+	_c.mutation.SetClientIds(v)
+	return _c
+}
+
+// SetSelector sets the "selector" field.
+func (_c *ScheduleCreate) SetSelector(v string) *ScheduleCreate {
+	_c.mutation.SetSelector(v)
+	return _c
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableSelector(v *string) *ScheduleCreate {
+	if v != nil {
+		_c.SetSelector(*v)
+	}
+	return _c
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_c *ScheduleCreate) SetBatchSize(v int) *ScheduleCreate {
+	_c.mutation.SetBatchSize(v)
+	return _c
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableBatchSize(v *int) *ScheduleCreate {
+	if v != nil {
+		_c.SetBatchSize(*v)
+	}
+	return _c
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (_c *ScheduleCreate) SetCanarySize(v int) *ScheduleCreate {
+	_c.mutation.SetCanarySize(v)
+	return _c
+}
+
+// SetNillableCanarySize sets the "canary_size" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableCanarySize(v *int) *ScheduleCreate {
+	if v != nil {
+		_c.SetCanarySize(*v)
+	}
+	return _c
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (_c *ScheduleCreate) SetBatchPauseSeconds(v int) *ScheduleCreate {
+	_c.mutation.SetBatchPauseSeconds(v)
+	return _c
+}
+
+// SetNillableBatchPauseSeconds sets the "batch_pause_seconds" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableBatchPauseSeconds(v *int) *ScheduleCreate {
+	if v != nil {
+		_c.SetBatchPauseSeconds(*v)
+	}
+	return _c
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (_c *ScheduleCreate) SetMaxFailures(v int) *ScheduleCreate {
+	_c.mutation.SetMaxFailures(v)
+	return _c
+}
+
+// SetNillableMaxFailures sets the "max_failures" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableMaxFailures(v *int) *ScheduleCreate {
+	if v != nil {
+		_c.SetMaxFailures(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ScheduleCreate) SetEnabled(v bool) *ScheduleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableEnabled(v *bool) *ScheduleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_c *ScheduleCreate) SetCatchUpPolicy(v schedule.CatchUpPolicy) *ScheduleCreate {
+	_c.mutation.SetCatchUpPolicy(v)
+	return _c
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableCatchUpPolicy(v *schedule.CatchUpPolicy) *ScheduleCreate {
+	if v != nil {
+		_c.SetCatchUpPolicy(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *ScheduleCreate) SetNextRunAt(v time.Time) *ScheduleCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableNextRunAt(v *time.Time) *ScheduleCreate {
+	if v != nil {
+		_c.SetNextRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *ScheduleCreate) SetLastRunAt(v time.Time) *ScheduleCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableLastRunAt(v *time.Time) *ScheduleCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastRunID sets the "last_run_id" field.
+func (_c *ScheduleCreate) SetLastRunID(v string) *ScheduleCreate {
+	_c.mutation.SetLastRunID(v)
+	return _c
+}
+
+// SetNillableLastRunID sets the "last_run_id" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableLastRunID(v *string) *ScheduleCreate {
+	if v != nil {
+		_c.SetLastRunID(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ScheduleCreate) SetLastError(v string) *ScheduleCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ScheduleCreate) SetNillableLastError(v *string) *ScheduleCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScheduleCreate) SetID(v string) *ScheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (_c *ScheduleCreate) Mutation() *ScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the Schedule in the database.
+func (_c *ScheduleCreate) Save(ctx context.Context) (*Schedule, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduleCreate) SaveX(ctx context.Context) *Schedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduleCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := schedule.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := schedule.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
+	if _, ok := _c.mutation.BatchSize(); !ok {
+		v := schedule.DefaultBatchSize
+		_c.mutation.SetBatchSize(v)
+	}
+	if _, ok := _c.mutation.CanarySize(); !ok {
+		v := schedule.DefaultCanarySize
+		_c.mutation.SetCanarySize(v)
+	}
+	if _, ok := _c.mutation.BatchPauseSeconds(); !ok {
+		v := schedule.DefaultBatchPauseSeconds
+		_c.mutation.SetBatchPauseSeconds(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := schedule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CatchUpPolicy(); !ok {
+		v := schedule.DefaultCatchUpPolicy
+		_c.mutation.SetCatchUpPolicy(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Schedule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := schedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Schedule.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := schedule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Schedule.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScriptID(); !ok {
+		return &ValidationError{Name: "script_id", err: errors.New(`ent: missing required field "Schedule.script_id"`)}
+	}
+	if v, ok := _c.mutation.ScriptID(); ok {
+		if err := schedule.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "Schedule.script_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CronExpression(); !ok {
+		return &ValidationError{Name: "cron_expression", err: errors.New(`ent: missing required field "Schedule.cron_expression"`)}
+	}
+	if v, ok := _c.mutation.CronExpression(); ok {
+		if err := schedule.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron_expression": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "Schedule.timezone"`)}
+	}
+	if v, ok := _c.mutation.Timezone(); ok {
+		if err := schedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Schedule.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Selector(); ok {
+		if err := schedule.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "Schedule.selector": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BatchSize(); !ok {
+		return &ValidationError{Name: "batch_size", err: errors.New(`ent: missing required field "Schedule.batch_size"`)}
+	}
+	if v, ok := _c.mutation.BatchSize(); ok {
+		if err := schedule.BatchSizeValidator(v); err != nil {
+			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "Schedule.batch_size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CanarySize(); !ok {
+		return &ValidationError{Name: "canary_size", err: errors.New(`ent: missing required field "Schedule.canary_size"`)}
+	}
+	if v, ok := _c.mutation.CanarySize(); ok {
+		if err := schedule.CanarySizeValidator(v); err != nil {
+			return &ValidationError{Name: "canary_size", err: fmt.Errorf(`ent: validator failed for field "Schedule.canary_size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BatchPauseSeconds(); !ok {
+		return &ValidationError{Name: "batch_pause_seconds", err: errors.New(`ent: missing required field "Schedule.batch_pause_seconds"`)}
+	}
+	if v, ok := _c.mutation.BatchPauseSeconds(); ok {
+		if err := schedule.BatchPauseSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_pause_seconds", err: fmt.Errorf(`ent: validator failed for field "Schedule.batch_pause_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxFailures(); ok {
+		if err := schedule.MaxFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "max_failures", err: fmt.Errorf(`ent: validator failed for field "Schedule.max_failures": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Schedule.enabled"`)}
+	}
+	if _, ok := _c.mutation.CatchUpPolicy(); !ok {
+		return &ValidationError{Name: "catch_up_policy", err: errors.New(`ent: missing required field "Schedule.catch_up_policy"`)}
+	}
+	if v, ok := _c.mutation.CatchUpPolicy(); ok {
+		if err := schedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "Schedule.catch_up_policy": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastRunID(); ok {
+		if err := schedule.LastRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_run_id", err: fmt.Errorf(`ent: validator failed for field "Schedule.last_run_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := schedule.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "Schedule.last_error": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := schedule.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Schedule.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScheduleCreate) sqlSave(ctx context.Context) (*Schedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Schedule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduleCreate) createSpec() (*Schedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Schedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(schedule.Table, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(schedule.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(schedule.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(schedule.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(schedule.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(schedule.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(schedule.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(schedule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.ScriptID(); ok {
+		_spec.SetField(schedule.FieldScriptID, field.TypeString, value)
+		_node.ScriptID = value
+	}
+	if value, ok := _c.mutation.CronExpression(); ok {
+		_spec.SetField(schedule.FieldCronExpression, field.TypeString, value)
+		_node.CronExpression = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(schedule.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.ClientIds(); ok {
+		_spec.SetField(schedule.FieldClientIds, field.TypeJSON, value)
+		_node.ClientIds = value
+	}
+	if value, ok := _c.mutation.Selector(); ok {
+		_spec.SetField(schedule.FieldSelector, field.TypeString, value)
+		_node.Selector = value
+	}
+	if value, ok := _c.mutation.BatchSize(); ok {
+		_spec.SetField(schedule.FieldBatchSize, field.TypeInt, value)
+		_node.BatchSize = value
+	}
+	if value, ok := _c.mutation.CanarySize(); ok {
+		_spec.SetField(schedule.FieldCanarySize, field.TypeInt, value)
+		_node.CanarySize = value
+	}
+	if value, ok := _c.mutation.BatchPauseSeconds(); ok {
+		_spec.SetField(schedule.FieldBatchPauseSeconds, field.TypeInt, value)
+		_node.BatchPauseSeconds = value
+	}
+	if value, ok := _c.mutation.MaxFailures(); ok {
+		_spec.SetField(schedule.FieldMaxFailures, field.TypeInt, value)
+		_node.MaxFailures = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(schedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(schedule.FieldCatchUpPolicy, field.TypeEnum, value)
+		_node.CatchUpPolicy = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastRunID(); ok {
+		_spec.SetField(schedule.FieldLastRunID, field.TypeString, value)
+		_node.LastRunID = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(schedule.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Schedule.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScheduleCreate) OnConflict(opts ...sql.ConflictOption) *ScheduleUpsertOne {
+	_c.conflict = opts
+	return &ScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScheduleCreate) OnConflictColumns(columns ...string) *ScheduleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ScheduleUpsertOne is the builder for "upsert"-ing
+	//  one Schedule node.
+	ScheduleUpsertOne struct {
+		create *ScheduleCreate
+	}
+
+	// ScheduleUpsert is the "OnConflict" setter.
+	ScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScheduleUpsert) SetCreateBy(v uint32) *ScheduleUpsert {
+	u.Set(schedule.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateCreateBy() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScheduleUpsert) AddCreateBy(v uint32) *ScheduleUpsert {
+	u.Add(schedule.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScheduleUpsert) ClearCreateBy() *ScheduleUpsert {
+	u.SetNull(schedule.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ScheduleUpsert) SetUpdateBy(v uint32) *ScheduleUpsert {
+	u.Set(schedule.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateUpdateBy() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ScheduleUpsert) AddUpdateBy(v uint32) *ScheduleUpsert {
+	u.Add(schedule.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ScheduleUpsert) ClearUpdateBy() *ScheduleUpsert {
+	u.SetNull(schedule.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScheduleUpsert) SetUpdateTime(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateUpdateTime() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScheduleUpsert) ClearUpdateTime() *ScheduleUpsert {
+	u.SetNull(schedule.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScheduleUpsert) SetDeleteTime(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateDeleteTime() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScheduleUpsert) ClearDeleteTime() *ScheduleUpsert {
+	u.SetNull(schedule.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ScheduleUpsert) SetName(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateName() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ScheduleUpsert) SetDescription(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateDescription() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScheduleUpsert) ClearDescription() *ScheduleUpsert {
+	u.SetNull(schedule.FieldDescription)
+	return u
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScheduleUpsert) SetScriptID(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldScriptID, v)
+	return u
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateScriptID() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldScriptID)
+	return u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *ScheduleUpsert) SetCronExpression(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldCronExpression, v)
+	return u
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateCronExpression() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldCronExpression)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ScheduleUpsert) SetTimezone(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateTimezone() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldTimezone)
+	return u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ScheduleUpsert) SetClientIds(v []string) *ScheduleUpsert {
+	u.Set(schedule.FieldClientIds, v)
+	return u
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateClientIds() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldClientIds)
+	return u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ScheduleUpsert) ClearClientIds() *ScheduleUpsert {
+	u.SetNull(schedule.FieldClientIds)
+	return u
+}
+
+// SetSelector sets the "selector" field.
+func (u *ScheduleUpsert) SetSelector(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldSelector, v)
+	return u
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateSelector() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldSelector)
+	return u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ScheduleUpsert) ClearSelector() *ScheduleUpsert {
+	u.SetNull(schedule.FieldSelector)
+	return u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *ScheduleUpsert) SetBatchSize(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldBatchSize, v)
+	return u
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateBatchSize() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldBatchSize)
+	return u
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *ScheduleUpsert) AddBatchSize(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldBatchSize, v)
+	return u
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (u *ScheduleUpsert) SetCanarySize(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldCanarySize, v)
+	return u
+}
+
+// UpdateCanarySize sets the "canary_size" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateCanarySize() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldCanarySize)
+	return u
+}
+
+// AddCanarySize adds v to the "canary_size" field.
+func (u *ScheduleUpsert) AddCanarySize(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldCanarySize, v)
+	return u
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (u *ScheduleUpsert) SetBatchPauseSeconds(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldBatchPauseSeconds, v)
+	return u
+}
+
+// UpdateBatchPauseSeconds sets the "batch_pause_seconds" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateBatchPauseSeconds() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldBatchPauseSeconds)
+	return u
+}
+
+// AddBatchPauseSeconds adds v to the "batch_pause_seconds" field.
+func (u *ScheduleUpsert) AddBatchPauseSeconds(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldBatchPauseSeconds, v)
+	return u
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (u *ScheduleUpsert) SetMaxFailures(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldMaxFailures, v)
+	return u
+}
+
+// UpdateMaxFailures sets the "max_failures" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateMaxFailures() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldMaxFailures)
+	return u
+}
+
+// AddMaxFailures adds v to the "max_failures" field.
+func (u *ScheduleUpsert) AddMaxFailures(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldMaxFailures, v)
+	return u
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (u *ScheduleUpsert) ClearMaxFailures() *ScheduleUpsert {
+	u.SetNull(schedule.FieldMaxFailures)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduleUpsert) SetEnabled(v bool) *ScheduleUpsert {
+	u.Set(schedule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateEnabled() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldEnabled)
+	return u
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (u *ScheduleUpsert) SetCatchUpPolicy(v schedule.CatchUpPolicy) *ScheduleUpsert {
+	u.Set(schedule.FieldCatchUpPolicy, v)
+	return u
+}
+
+// UpdateCatchUpPolicy sets the "catch_up_policy" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateCatchUpPolicy() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldCatchUpPolicy)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsert) SetNextRunAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateNextRunAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsert) ClearNextRunAt() *ScheduleUpsert {
+	u.SetNull(schedule.FieldNextRunAt)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsert) SetLastRunAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateLastRunAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsert) ClearLastRunAt() *ScheduleUpsert {
+	u.SetNull(schedule.FieldLastRunAt)
+	return u
+}
+
+// SetLastRunID sets the "last_run_id" field.
+func (u *ScheduleUpsert) SetLastRunID(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldLastRunID, v)
+	return u
+}
+
+// UpdateLastRunID sets the "last_run_id" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateLastRunID() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldLastRunID)
+	return u
+}
+
+// ClearLastRunID clears the value of the "last_run_id" field.
+func (u *ScheduleUpsert) ClearLastRunID() *ScheduleUpsert {
+	u.SetNull(schedule.FieldLastRunID)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduleUpsert) SetLastError(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateLastError() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduleUpsert) ClearLastError() *ScheduleUpsert {
+	u.SetNull(schedule.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(schedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduleUpsertOne) UpdateNewValues() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(schedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(schedule.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(schedule.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduleUpsertOne) Ignore() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleUpsertOne) DoNothing() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleCreate.OnConflict
+// documentation for more info.
+func (u *ScheduleUpsertOne) Update(set func(*ScheduleUpsert)) *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScheduleUpsertOne) SetCreateBy(v uint32) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScheduleUpsertOne) AddCreateBy(v uint32) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateCreateBy() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScheduleUpsertOne) ClearCreateBy() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ScheduleUpsertOne) SetUpdateBy(v uint32) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ScheduleUpsertOne) AddUpdateBy(v uint32) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateUpdateBy() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ScheduleUpsertOne) ClearUpdateBy() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScheduleUpsertOne) SetUpdateTime(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateUpdateTime() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScheduleUpsertOne) ClearUpdateTime() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScheduleUpsertOne) SetDeleteTime(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateDeleteTime() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScheduleUpsertOne) ClearDeleteTime() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScheduleUpsertOne) SetName(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateName() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ScheduleUpsertOne) SetDescription(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateDescription() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScheduleUpsertOne) ClearDescription() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScheduleUpsertOne) SetScriptID(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateScriptID() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *ScheduleUpsertOne) SetCronExpression(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCronExpression(v)
+	})
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateCronExpression() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCronExpression()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ScheduleUpsertOne) SetTimezone(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateTimezone() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ScheduleUpsertOne) SetClientIds(v []string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetClientIds(v)
+	})
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateClientIds() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateClientIds()
+	})
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ScheduleUpsertOne) ClearClientIds() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearClientIds()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *ScheduleUpsertOne) SetSelector(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateSelector() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ScheduleUpsertOne) ClearSelector() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *ScheduleUpsertOne) SetBatchSize(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetBatchSize(v)
+	})
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *ScheduleUpsertOne) AddBatchSize(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddBatchSize(v)
+	})
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateBatchSize() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateBatchSize()
+	})
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (u *ScheduleUpsertOne) SetCanarySize(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCanarySize(v)
+	})
+}
+
+// AddCanarySize adds v to the "canary_size" field.
+func (u *ScheduleUpsertOne) AddCanarySize(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddCanarySize(v)
+	})
+}
+
+// UpdateCanarySize sets the "canary_size" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateCanarySize() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCanarySize()
+	})
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (u *ScheduleUpsertOne) SetBatchPauseSeconds(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetBatchPauseSeconds(v)
+	})
+}
+
+// AddBatchPauseSeconds adds v to the "batch_pause_seconds" field.
+func (u *ScheduleUpsertOne) AddBatchPauseSeconds(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddBatchPauseSeconds(v)
+	})
+}
+
+// UpdateBatchPauseSeconds sets the "batch_pause_seconds" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateBatchPauseSeconds() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateBatchPauseSeconds()
+	})
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (u *ScheduleUpsertOne) SetMaxFailures(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetMaxFailures(v)
+	})
+}
+
+// AddMaxFailures adds v to the "max_failures" field.
+func (u *ScheduleUpsertOne) AddMaxFailures(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddMaxFailures(v)
+	})
+}
+
+// UpdateMaxFailures sets the "max_failures" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateMaxFailures() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateMaxFailures()
+	})
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (u *ScheduleUpsertOne) ClearMaxFailures() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearMaxFailures()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduleUpsertOne) SetEnabled(v bool) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateEnabled() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (u *ScheduleUpsertOne) SetCatchUpPolicy(v schedule.CatchUpPolicy) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCatchUpPolicy(v)
+	})
+}
+
+// UpdateCatchUpPolicy sets the "catch_up_policy" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateCatchUpPolicy() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCatchUpPolicy()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsertOne) SetNextRunAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateNextRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsertOne) ClearNextRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsertOne) SetLastRunAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateLastRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsertOne) ClearLastRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetLastRunID sets the "last_run_id" field.
+func (u *ScheduleUpsertOne) SetLastRunID(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastRunID(v)
+	})
+}
+
+// UpdateLastRunID sets the "last_run_id" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateLastRunID() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastRunID()
+	})
+}
+
+// ClearLastRunID clears the value of the "last_run_id" field.
+func (u *ScheduleUpsertOne) ClearLastRunID() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastRunID()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduleUpsertOne) SetLastError(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateLastError() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduleUpsertOne) ClearLastError() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ScheduleUpsertOne.ID is not supported by MySQL driver. Use ScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduleCreateBulk is the builder for creating many Schedule entities in bulk.
+type ScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Schedule entities in the database.
+func (_c *ScheduleCreateBulk) Save(ctx context.Context) ([]*Schedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Schedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduleCreateBulk) SaveX(ctx context.Context) []*Schedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Schedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduleUpsertBulk {
+	_c.conflict = opts
+	return &ScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScheduleCreateBulk) OnConflictColumns(columns ...string) *ScheduleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// ScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Schedule nodes.
+type ScheduleUpsertBulk struct {
+	create *ScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(schedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduleUpsertBulk) UpdateNewValues() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(schedule.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(schedule.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(schedule.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduleUpsertBulk) Ignore() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleUpsertBulk) DoNothing() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduleUpsertBulk) Update(set func(*ScheduleUpsert)) *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScheduleUpsertBulk) SetCreateBy(v uint32) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScheduleUpsertBulk) AddCreateBy(v uint32) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateCreateBy() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScheduleUpsertBulk) ClearCreateBy() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ScheduleUpsertBulk) SetUpdateBy(v uint32) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ScheduleUpsertBulk) AddUpdateBy(v uint32) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateUpdateBy() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ScheduleUpsertBulk) ClearUpdateBy() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScheduleUpsertBulk) SetUpdateTime(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateUpdateTime() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScheduleUpsertBulk) ClearUpdateTime() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScheduleUpsertBulk) SetDeleteTime(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateDeleteTime() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScheduleUpsertBulk) ClearDeleteTime() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScheduleUpsertBulk) SetName(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateName() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ScheduleUpsertBulk) SetDescription(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateDescription() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScheduleUpsertBulk) ClearDescription() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScheduleUpsertBulk) SetScriptID(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateScriptID() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (u *ScheduleUpsertBulk) SetCronExpression(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCronExpression(v)
+	})
+}
+
+// UpdateCronExpression sets the "cron_expression" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateCronExpression() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCronExpression()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ScheduleUpsertBulk) SetTimezone(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateTimezone() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetClientIds sets the "client_ids" field.
+func (u *ScheduleUpsertBulk) SetClientIds(v []string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetClientIds(v)
+	})
+}
+
+// UpdateClientIds sets the "client_ids" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateClientIds() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateClientIds()
+	})
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (u *ScheduleUpsertBulk) ClearClientIds() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearClientIds()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *ScheduleUpsertBulk) SetSelector(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateSelector() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ScheduleUpsertBulk) ClearSelector() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *ScheduleUpsertBulk) SetBatchSize(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetBatchSize(v)
+	})
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *ScheduleUpsertBulk) AddBatchSize(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddBatchSize(v)
+	})
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateBatchSize() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateBatchSize()
+	})
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (u *ScheduleUpsertBulk) SetCanarySize(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCanarySize(v)
+	})
+}
+
+// AddCanarySize adds v to the "canary_size" field.
+func (u *ScheduleUpsertBulk) AddCanarySize(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddCanarySize(v)
+	})
+}
+
+// UpdateCanarySize sets the "canary_size" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateCanarySize() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCanarySize()
+	})
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (u *ScheduleUpsertBulk) SetBatchPauseSeconds(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetBatchPauseSeconds(v)
+	})
+}
+
+// AddBatchPauseSeconds adds v to the "batch_pause_seconds" field.
+func (u *ScheduleUpsertBulk) AddBatchPauseSeconds(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddBatchPauseSeconds(v)
+	})
+}
+
+// UpdateBatchPauseSeconds sets the "batch_pause_seconds" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateBatchPauseSeconds() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateBatchPauseSeconds()
+	})
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (u *ScheduleUpsertBulk) SetMaxFailures(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetMaxFailures(v)
+	})
+}
+
+// AddMaxFailures adds v to the "max_failures" field.
+func (u *ScheduleUpsertBulk) AddMaxFailures(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddMaxFailures(v)
+	})
+}
+
+// UpdateMaxFailures sets the "max_failures" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateMaxFailures() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateMaxFailures()
+	})
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (u *ScheduleUpsertBulk) ClearMaxFailures() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearMaxFailures()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduleUpsertBulk) SetEnabled(v bool) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateEnabled() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (u *ScheduleUpsertBulk) SetCatchUpPolicy(v schedule.CatchUpPolicy) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCatchUpPolicy(v)
+	})
+}
+
+// UpdateCatchUpPolicy sets the "catch_up_policy" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateCatchUpPolicy() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCatchUpPolicy()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsertBulk) SetNextRunAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateNextRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsertBulk) ClearNextRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsertBulk) SetLastRunAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateLastRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsertBulk) ClearLastRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetLastRunID sets the "last_run_id" field.
+func (u *ScheduleUpsertBulk) SetLastRunID(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastRunID(v)
+	})
+}
+
+// UpdateLastRunID sets the "last_run_id" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateLastRunID() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastRunID()
+	})
+}
+
+// ClearLastRunID clears the value of the "last_run_id" field.
+func (u *ScheduleUpsertBulk) ClearLastRunID() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastRunID()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduleUpsertBulk) SetLastError(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateLastError() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduleUpsertBulk) ClearLastError() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
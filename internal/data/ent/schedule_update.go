@@ -0,0 +1,1407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
+)
+
+// ScheduleUpdate is the builder for updating Schedule entities.
+type ScheduleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (_u *ScheduleUpdate) Where(ps ...predicate.Schedule) *ScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ScheduleUpdate) SetCreateBy(v uint32) *ScheduleUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableCreateBy(v *uint32) *ScheduleUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ScheduleUpdate) AddCreateBy(v int32) *ScheduleUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ScheduleUpdate) ClearCreateBy() *ScheduleUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ScheduleUpdate) SetUpdateBy(v uint32) *ScheduleUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableUpdateBy(v *uint32) *ScheduleUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ScheduleUpdate) AddUpdateBy(v int32) *ScheduleUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ScheduleUpdate) ClearUpdateBy() *ScheduleUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScheduleUpdate) SetUpdateTime(v time.Time) *ScheduleUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableUpdateTime(v *time.Time) *ScheduleUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ScheduleUpdate) ClearUpdateTime() *ScheduleUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ScheduleUpdate) SetDeleteTime(v time.Time) *ScheduleUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableDeleteTime(v *time.Time) *ScheduleUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ScheduleUpdate) ClearDeleteTime() *ScheduleUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScheduleUpdate) SetName(v string) *ScheduleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableName(v *string) *ScheduleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ScheduleUpdate) SetDescription(v string) *ScheduleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableDescription(v *string) *ScheduleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ScheduleUpdate) ClearDescription() *ScheduleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ScheduleUpdate) SetScriptID(v string) *ScheduleUpdate {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableScriptID(v *string) *ScheduleUpdate {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_u *ScheduleUpdate) SetCronExpression(v string) *ScheduleUpdate {
+	_u.mutation.SetCronExpression(v)
+	return _u
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableCronExpression(v *string) *ScheduleUpdate {
+	if v != nil {
+		_u.SetCronExpression(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *ScheduleUpdate) SetTimezone(v string) *ScheduleUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableTimezone(v *string) *ScheduleUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *ScheduleUpdate) SetClientIds(v []string) *ScheduleUpdate {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *ScheduleUpdate) AppendClientIds(v []string) *ScheduleUpdate {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *ScheduleUpdate) ClearClientIds() *ScheduleUpdate {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *ScheduleUpdate) SetSelector(v string) *ScheduleUpdate {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableSelector(v *string) *ScheduleUpdate {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *ScheduleUpdate) ClearSelector() *ScheduleUpdate {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_u *ScheduleUpdate) SetBatchSize(v int) *ScheduleUpdate {
+	_u.mutation.ResetBatchSize()
+	_u.mutation.SetBatchSize(v)
+	return _u
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableBatchSize(v *int) *ScheduleUpdate {
+	if v != nil {
+		_u.SetBatchSize(*v)
+	}
+	return _u
+}
+
+// AddBatchSize adds value to the "batch_size" field.
+func (_u *ScheduleUpdate) AddBatchSize(v int) *ScheduleUpdate {
+	_u.mutation.AddBatchSize(v)
+	return _u
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (_u *ScheduleUpdate) SetCanarySize(v int) *ScheduleUpdate {
+	_u.mutation.ResetCanarySize()
+	_u.mutation.SetCanarySize(v)
+	return _u
+}
+
+// SetNillableCanarySize sets the "canary_size" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableCanarySize(v *int) *ScheduleUpdate {
+	if v != nil {
+		_u.SetCanarySize(*v)
+	}
+	return _u
+}
+
+// AddCanarySize adds value to the "canary_size" field.
+func (_u *ScheduleUpdate) AddCanarySize(v int) *ScheduleUpdate {
+	_u.mutation.AddCanarySize(v)
+	return _u
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (_u *ScheduleUpdate) SetBatchPauseSeconds(v int) *ScheduleUpdate {
+	_u.mutation.ResetBatchPauseSeconds()
+	_u.mutation.SetBatchPauseSeconds(v)
+	return _u
+}
+
+// SetNillableBatchPauseSeconds sets the "batch_pause_seconds" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableBatchPauseSeconds(v *int) *ScheduleUpdate {
+	if v != nil {
+		_u.SetBatchPauseSeconds(*v)
+	}
+	return _u
+}
+
+// AddBatchPauseSeconds adds value to the "batch_pause_seconds" field.
+func (_u *ScheduleUpdate) AddBatchPauseSeconds(v int) *ScheduleUpdate {
+	_u.mutation.AddBatchPauseSeconds(v)
+	return _u
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (_u *ScheduleUpdate) SetMaxFailures(v int) *ScheduleUpdate {
+	_u.mutation.ResetMaxFailures()
+	_u.mutation.SetMaxFailures(v)
+	return _u
+}
+
+// SetNillableMaxFailures sets the "max_failures" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableMaxFailures(v *int) *ScheduleUpdate {
+	if v != nil {
+		_u.SetMaxFailures(*v)
+	}
+	return _u
+}
+
+// AddMaxFailures adds value to the "max_failures" field.
+func (_u *ScheduleUpdate) AddMaxFailures(v int) *ScheduleUpdate {
+	_u.mutation.AddMaxFailures(v)
+	return _u
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (_u *ScheduleUpdate) ClearMaxFailures() *ScheduleUpdate {
+	_u.mutation.ClearMaxFailures()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ScheduleUpdate) SetEnabled(v bool) *ScheduleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableEnabled(v *bool) *ScheduleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_u *ScheduleUpdate) SetCatchUpPolicy(v schedule.CatchUpPolicy) *ScheduleUpdate {
+	_u.mutation.SetCatchUpPolicy(v)
+	return _u
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableCatchUpPolicy(v *schedule.CatchUpPolicy) *ScheduleUpdate {
+	if v != nil {
+		_u.SetCatchUpPolicy(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScheduleUpdate) SetNextRunAt(v time.Time) *ScheduleUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableNextRunAt(v *time.Time) *ScheduleUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (_u *ScheduleUpdate) ClearNextRunAt() *ScheduleUpdate {
+	_u.mutation.ClearNextRunAt()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduleUpdate) SetLastRunAt(v time.Time) *ScheduleUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableLastRunAt(v *time.Time) *ScheduleUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduleUpdate) ClearLastRunAt() *ScheduleUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastRunID sets the "last_run_id" field.
+func (_u *ScheduleUpdate) SetLastRunID(v string) *ScheduleUpdate {
+	_u.mutation.SetLastRunID(v)
+	return _u
+}
+
+// SetNillableLastRunID sets the "last_run_id" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableLastRunID(v *string) *ScheduleUpdate {
+	if v != nil {
+		_u.SetLastRunID(*v)
+	}
+	return _u
+}
+
+// ClearLastRunID clears the value of the "last_run_id" field.
+func (_u *ScheduleUpdate) ClearLastRunID() *ScheduleUpdate {
+	_u.mutation.ClearLastRunID()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduleUpdate) SetLastError(v string) *ScheduleUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduleUpdate) SetNillableLastError(v *string) *ScheduleUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ScheduleUpdate) ClearLastError() *ScheduleUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (_u *ScheduleUpdate) Mutation() *ScheduleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := schedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Schedule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := schedule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Schedule.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := schedule.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "Schedule.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CronExpression(); ok {
+		if err := schedule.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron_expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := schedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Schedule.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := schedule.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "Schedule.selector": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchSize(); ok {
+		if err := schedule.BatchSizeValidator(v); err != nil {
+			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "Schedule.batch_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CanarySize(); ok {
+		if err := schedule.CanarySizeValidator(v); err != nil {
+			return &ValidationError{Name: "canary_size", err: fmt.Errorf(`ent: validator failed for field "Schedule.canary_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchPauseSeconds(); ok {
+		if err := schedule.BatchPauseSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_pause_seconds", err: fmt.Errorf(`ent: validator failed for field "Schedule.batch_pause_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxFailures(); ok {
+		if err := schedule.MaxFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "max_failures", err: fmt.Errorf(`ent: validator failed for field "Schedule.max_failures": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpPolicy(); ok {
+		if err := schedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "Schedule.catch_up_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastRunID(); ok {
+		if err := schedule.LastRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_run_id", err: fmt.Errorf(`ent: validator failed for field "Schedule.last_run_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := schedule.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "Schedule.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScheduleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(schedule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(schedule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(schedule.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(schedule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(schedule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(schedule.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(schedule.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(schedule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(schedule.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(schedule.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(schedule.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(schedule.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(schedule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(schedule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(schedule.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CronExpression(); ok {
+		_spec.SetField(schedule.FieldCronExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(schedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(schedule.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedule.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(schedule.FieldClientIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(schedule.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(schedule.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.BatchSize(); ok {
+		_spec.SetField(schedule.FieldBatchSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchSize(); ok {
+		_spec.AddField(schedule.FieldBatchSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CanarySize(); ok {
+		_spec.SetField(schedule.FieldCanarySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCanarySize(); ok {
+		_spec.AddField(schedule.FieldCanarySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchPauseSeconds(); ok {
+		_spec.SetField(schedule.FieldBatchPauseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchPauseSeconds(); ok {
+		_spec.AddField(schedule.FieldBatchPauseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxFailures(); ok {
+		_spec.SetField(schedule.FieldMaxFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFailures(); ok {
+		_spec.AddField(schedule.FieldMaxFailures, field.TypeInt, value)
+	}
+	if _u.mutation.MaxFailuresCleared() {
+		_spec.ClearField(schedule.FieldMaxFailures, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(schedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(schedule.FieldCatchUpPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(schedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRunID(); ok {
+		_spec.SetField(schedule.FieldLastRunID, field.TypeString, value)
+	}
+	if _u.mutation.LastRunIDCleared() {
+		_spec.ClearField(schedule.FieldLastRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(schedule.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(schedule.FieldLastError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduleUpdateOne is the builder for updating a single Schedule entity.
+type ScheduleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ScheduleUpdateOne) SetCreateBy(v uint32) *ScheduleUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableCreateBy(v *uint32) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ScheduleUpdateOne) AddCreateBy(v int32) *ScheduleUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ScheduleUpdateOne) ClearCreateBy() *ScheduleUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ScheduleUpdateOne) SetUpdateBy(v uint32) *ScheduleUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableUpdateBy(v *uint32) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ScheduleUpdateOne) AddUpdateBy(v int32) *ScheduleUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ScheduleUpdateOne) ClearUpdateBy() *ScheduleUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScheduleUpdateOne) SetUpdateTime(v time.Time) *ScheduleUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableUpdateTime(v *time.Time) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ScheduleUpdateOne) ClearUpdateTime() *ScheduleUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ScheduleUpdateOne) SetDeleteTime(v time.Time) *ScheduleUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableDeleteTime(v *time.Time) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ScheduleUpdateOne) ClearDeleteTime() *ScheduleUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScheduleUpdateOne) SetName(v string) *ScheduleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableName(v *string) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ScheduleUpdateOne) SetDescription(v string) *ScheduleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableDescription(v *string) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ScheduleUpdateOne) ClearDescription() *ScheduleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ScheduleUpdateOne) SetScriptID(v string) *ScheduleUpdateOne {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableScriptID(v *string) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_u *ScheduleUpdateOne) SetCronExpression(v string) *ScheduleUpdateOne {
+	_u.mutation.SetCronExpression(v)
+	return _u
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableCronExpression(v *string) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetCronExpression(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *ScheduleUpdateOne) SetTimezone(v string) *ScheduleUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableTimezone(v *string) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetClientIds sets the "client_ids" field.
+func (_u *ScheduleUpdateOne) SetClientIds(v []string) *ScheduleUpdateOne {
+	_u.mutation.SetClientIds(v)
+	return _u
+}
+
+// AppendClientIds appends value to the "client_ids" field.
+func (_u *ScheduleUpdateOne) AppendClientIds(v []string) *ScheduleUpdateOne {
+	_u.mutation.AppendClientIds(v)
+	return _u
+}
+
+// ClearClientIds clears the value of the "client_ids" field.
+func (_u *ScheduleUpdateOne) ClearClientIds() *ScheduleUpdateOne {
+	_u.mutation.ClearClientIds()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *ScheduleUpdateOne) SetSelector(v string) *ScheduleUpdateOne {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableSelector(v *string) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *ScheduleUpdateOne) ClearSelector() *ScheduleUpdateOne {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_u *ScheduleUpdateOne) SetBatchSize(v int) *ScheduleUpdateOne {
+	_u.mutation.ResetBatchSize()
+	_u.mutation.SetBatchSize(v)
+	return _u
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableBatchSize(v *int) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetBatchSize(*v)
+	}
+	return _u
+}
+
+// AddBatchSize adds value to the "batch_size" field.
+func (_u *ScheduleUpdateOne) AddBatchSize(v int) *ScheduleUpdateOne {
+	_u.mutation.AddBatchSize(v)
+	return _u
+}
+
+// SetCanarySize sets the "canary_size" field.
+func (_u *ScheduleUpdateOne) SetCanarySize(v int) *ScheduleUpdateOne {
+	_u.mutation.ResetCanarySize()
+	_u.mutation.SetCanarySize(v)
+	return _u
+}
+
+// SetNillableCanarySize sets the "canary_size" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableCanarySize(v *int) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetCanarySize(*v)
+	}
+	return _u
+}
+
+// AddCanarySize adds value to the "canary_size" field.
+func (_u *ScheduleUpdateOne) AddCanarySize(v int) *ScheduleUpdateOne {
+	_u.mutation.AddCanarySize(v)
+	return _u
+}
+
+// SetBatchPauseSeconds sets the "batch_pause_seconds" field.
+func (_u *ScheduleUpdateOne) SetBatchPauseSeconds(v int) *ScheduleUpdateOne {
+	_u.mutation.ResetBatchPauseSeconds()
+	_u.mutation.SetBatchPauseSeconds(v)
+	return _u
+}
+
+// SetNillableBatchPauseSeconds sets the "batch_pause_seconds" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableBatchPauseSeconds(v *int) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetBatchPauseSeconds(*v)
+	}
+	return _u
+}
+
+// AddBatchPauseSeconds adds value to the "batch_pause_seconds" field.
+func (_u *ScheduleUpdateOne) AddBatchPauseSeconds(v int) *ScheduleUpdateOne {
+	_u.mutation.AddBatchPauseSeconds(v)
+	return _u
+}
+
+// SetMaxFailures sets the "max_failures" field.
+func (_u *ScheduleUpdateOne) SetMaxFailures(v int) *ScheduleUpdateOne {
+	_u.mutation.ResetMaxFailures()
+	_u.mutation.SetMaxFailures(v)
+	return _u
+}
+
+// SetNillableMaxFailures sets the "max_failures" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableMaxFailures(v *int) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetMaxFailures(*v)
+	}
+	return _u
+}
+
+// AddMaxFailures adds value to the "max_failures" field.
+func (_u *ScheduleUpdateOne) AddMaxFailures(v int) *ScheduleUpdateOne {
+	_u.mutation.AddMaxFailures(v)
+	return _u
+}
+
+// ClearMaxFailures clears the value of the "max_failures" field.
+func (_u *ScheduleUpdateOne) ClearMaxFailures() *ScheduleUpdateOne {
+	_u.mutation.ClearMaxFailures()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ScheduleUpdateOne) SetEnabled(v bool) *ScheduleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableEnabled(v *bool) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_u *ScheduleUpdateOne) SetCatchUpPolicy(v schedule.CatchUpPolicy) *ScheduleUpdateOne {
+	_u.mutation.SetCatchUpPolicy(v)
+	return _u
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableCatchUpPolicy(v *schedule.CatchUpPolicy) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetCatchUpPolicy(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScheduleUpdateOne) SetNextRunAt(v time.Time) *ScheduleUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableNextRunAt(v *time.Time) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (_u *ScheduleUpdateOne) ClearNextRunAt() *ScheduleUpdateOne {
+	_u.mutation.ClearNextRunAt()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduleUpdateOne) SetLastRunAt(v time.Time) *ScheduleUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableLastRunAt(v *time.Time) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduleUpdateOne) ClearLastRunAt() *ScheduleUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastRunID sets the "last_run_id" field.
+func (_u *ScheduleUpdateOne) SetLastRunID(v string) *ScheduleUpdateOne {
+	_u.mutation.SetLastRunID(v)
+	return _u
+}
+
+// SetNillableLastRunID sets the "last_run_id" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableLastRunID(v *string) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastRunID(*v)
+	}
+	return _u
+}
+
+// ClearLastRunID clears the value of the "last_run_id" field.
+func (_u *ScheduleUpdateOne) ClearLastRunID() *ScheduleUpdateOne {
+	_u.mutation.ClearLastRunID()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduleUpdateOne) SetLastError(v string) *ScheduleUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduleUpdateOne) SetNillableLastError(v *string) *ScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ScheduleUpdateOne) ClearLastError() *ScheduleUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (_u *ScheduleUpdateOne) Mutation() *ScheduleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (_u *ScheduleUpdateOne) Where(ps ...predicate.Schedule) *ScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduleUpdateOne) Select(field string, fields ...string) *ScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Schedule entity.
+func (_u *ScheduleUpdateOne) Save(ctx context.Context) (*Schedule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduleUpdateOne) SaveX(ctx context.Context) *Schedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := schedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Schedule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := schedule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Schedule.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := schedule.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "Schedule.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CronExpression(); ok {
+		if err := schedule.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron_expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := schedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Schedule.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := schedule.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "Schedule.selector": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchSize(); ok {
+		if err := schedule.BatchSizeValidator(v); err != nil {
+			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "Schedule.batch_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CanarySize(); ok {
+		if err := schedule.CanarySizeValidator(v); err != nil {
+			return &ValidationError{Name: "canary_size", err: fmt.Errorf(`ent: validator failed for field "Schedule.canary_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchPauseSeconds(); ok {
+		if err := schedule.BatchPauseSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_pause_seconds", err: fmt.Errorf(`ent: validator failed for field "Schedule.batch_pause_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxFailures(); ok {
+		if err := schedule.MaxFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "max_failures", err: fmt.Errorf(`ent: validator failed for field "Schedule.max_failures": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpPolicy(); ok {
+		if err := schedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "Schedule.catch_up_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastRunID(); ok {
+		if err := schedule.LastRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_run_id", err: fmt.Errorf(`ent: validator failed for field "Schedule.last_run_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := schedule.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "Schedule.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScheduleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScheduleUpdateOne) sqlSave(ctx context.Context) (_node *Schedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Schedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedule.FieldID)
+		for _, f := range fields {
+			if !schedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(schedule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(schedule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(schedule.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(schedule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(schedule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(schedule.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(schedule.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(schedule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(schedule.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(schedule.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(schedule.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(schedule.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(schedule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(schedule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(schedule.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CronExpression(); ok {
+		_spec.SetField(schedule.FieldCronExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(schedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientIds(); ok {
+		_spec.SetField(schedule.FieldClientIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClientIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedule.FieldClientIds, value)
+		})
+	}
+	if _u.mutation.ClientIdsCleared() {
+		_spec.ClearField(schedule.FieldClientIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(schedule.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(schedule.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.BatchSize(); ok {
+		_spec.SetField(schedule.FieldBatchSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchSize(); ok {
+		_spec.AddField(schedule.FieldBatchSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CanarySize(); ok {
+		_spec.SetField(schedule.FieldCanarySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCanarySize(); ok {
+		_spec.AddField(schedule.FieldCanarySize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchPauseSeconds(); ok {
+		_spec.SetField(schedule.FieldBatchPauseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchPauseSeconds(); ok {
+		_spec.AddField(schedule.FieldBatchPauseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxFailures(); ok {
+		_spec.SetField(schedule.FieldMaxFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFailures(); ok {
+		_spec.AddField(schedule.FieldMaxFailures, field.TypeInt, value)
+	}
+	if _u.mutation.MaxFailuresCleared() {
+		_spec.ClearField(schedule.FieldMaxFailures, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(schedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(schedule.FieldCatchUpPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(schedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRunID(); ok {
+		_spec.SetField(schedule.FieldLastRunID, field.TypeString, value)
+	}
+	if _u.mutation.LastRunIDCleared() {
+		_spec.ClearField(schedule.FieldLastRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(schedule.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(schedule.FieldLastError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Schedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ClientGroup holds the schema definition for the ClientGroup entity.
+// A group contains every inventory client whose labels match its selector
+// plus an explicit list of member CNs.
+type ClientGroup struct {
+	ent.Schema
+}
+
+// Annotations of the ClientGroup.
+func (ClientGroup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_client_groups"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ClientGroup.
+func (ClientGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Group name"),
+
+		field.String("description").
+			Optional().
+			MaxLen(2048).
+			Comment("Group description"),
+
+		field.String("selector").
+			Optional().
+			MaxLen(1024).
+			Comment("Label selector matched against client labels"),
+
+		field.JSON("client_ids", []string{}).
+			Optional().
+			Comment("Static member CNs"),
+	}
+}
+
+// Edges of the ClientGroup.
+func (ClientGroup) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ClientGroup.
+func (ClientGroup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ClientGroup.
+func (ClientGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "tenant_id").
+			Unique().
+			StorageKey("executor_client_group_name_unique"),
+		index.Fields("tenant_id"),
+	}
+}
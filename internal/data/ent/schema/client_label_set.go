@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ClientLabelSet holds the schema definition for the ClientLabelSet entity.
+// Clients are shared between tenants but labels are not: each tenant labels
+// clients for its own selectors, groups and event rules.
+type ClientLabelSet struct {
+	ent.Schema
+}
+
+// Annotations of the ClientLabelSet.
+func (ClientLabelSet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_client_labels"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ClientLabelSet.
+func (ClientLabelSet) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("client_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("mTLS client CN"),
+
+		field.JSON("labels", map[string]string{}).
+			Comment("Free-form labels set by operators of the tenant"),
+	}
+}
+
+// Edges of the ClientLabelSet.
+func (ClientLabelSet) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ClientLabelSet.
+func (ClientLabelSet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ClientLabelSet.
+func (ClientLabelSet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id", "tenant_id").
+			Unique().
+			StorageKey("executor_client_label_client_unique"),
+		index.Fields("tenant_id"),
+	}
+}
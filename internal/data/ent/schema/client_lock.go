@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ClientLock holds the schema definition for the ClientLock entity.
+// Executions are admitted to a client under a row lock on its entry, which is
+// created on first use, so clients not yet in the inventory are locked too.
+type ClientLock struct {
+	ent.Schema
+}
+
+// Annotations of the ClientLock.
+func (ClientLock) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_client_locks"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ClientLock.
+func (ClientLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			MaxLen(255).
+			Comment("mTLS client CN"),
+	}
+}
+
+// Edges of the ClientLock.
+func (ClientLock) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Command holds the schema definition for the Command entity.
+// Every command issued to a client is recorded here so acknowledgements
+// and results can be traced back to the execution they belong to.
+type Command struct {
+	ent.Schema
+}
+
+// Annotations of the Command.
+func (Command) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_commands"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Command.
+func (Command) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Command ID (UUID primary key)"),
+
+		field.String("execution_id").
+			Optional().
+			MaxLen(36).
+			Comment("FK to executor_execution_logs, empty for non-execution commands"),
+
+		field.String("client_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("mTLS client CN the command is addressed to"),
+
+		field.Enum("command_type").
+			Values("SCRIPT_EXECUTION", "CLIENT_UPDATE", "ABORT", "CANCEL").
+			Default("SCRIPT_EXECUTION").
+			Comment("Type of the command"),
+
+		field.Enum("status").
+			Values("PENDING", "QUEUED", "SENT", "ACCEPTED", "REJECTED", "COMPLETED", "EXPIRED").
+			Default("PENDING").
+			Comment("Delivery status of the command"),
+
+		field.String("rejection_reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the client rejected the command"),
+
+		field.Time("sent_at").
+			Optional().
+			Nillable().
+			Comment("When the command was written to the client stream"),
+
+		field.Time("acked_at").
+			Optional().
+			Nillable().
+			Comment("When the client accepted or rejected the command"),
+
+		field.Time("expired_at").
+			Optional().
+			Nillable().
+			Comment("When the command expired before delivery"),
+	}
+}
+
+// Edges of the Command.
+func (Command) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the Command.
+func (Command) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the Command.
+func (Command) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("execution_id"),
+		index.Fields("tenant_id", "client_id"),
+		index.Fields("status"),
+	}
+}
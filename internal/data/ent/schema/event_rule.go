@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// EventRule holds the schema definition for the EventRule entity.
+// When its event happens to a client, the rule's script is executed there.
+type EventRule struct {
+	ent.Schema
+}
+
+// Annotations of the EventRule.
+func (EventRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_event_rules"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the EventRule.
+func (EventRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Rule name"),
+
+		field.String("description").
+			Optional().
+			MaxLen(2048).
+			Comment("Rule description"),
+
+		field.Enum("event_type").
+			Values("CLIENT_FIRST_CONNECT", "CLIENT_VERSION_CHANGED", "CLIENT_LABELS_CHANGED", "SCRIPT_FAILED").
+			Comment("Event the rule reacts to"),
+
+		field.String("script_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to executor_scripts, the script to run"),
+
+		field.String("selector").
+			Optional().
+			MaxLen(1024).
+			Comment("Label selector limiting the clients the rule applies to"),
+
+		field.String("source_script_id").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("For SCRIPT_FAILED, the script whose failure fires the rule"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether the rule fires"),
+
+		field.Time("last_fired_at").
+			Optional().
+			Nillable().
+			Comment("When the rule last started an execution"),
+	}
+}
+
+// Edges of the EventRule.
+func (EventRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the EventRule.
+func (EventRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the EventRule.
+func (EventRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("tenant_id", "script_id"),
+		index.Fields("event_type", "enabled"),
+	}
+}
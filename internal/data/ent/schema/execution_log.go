@@ -51,12 +51,13 @@ func (ExecutionLog) Fields() []ent.Field {
 			Comment("Script content hash at execution time"),
 
 		field.Enum("trigger_type").
-			Values("CLIENT_PULL", "UI_PUSH").
+			Values("CLIENT_PULL", "UI_PUSH", "SCHEDULED", "EVENT", "WORKFLOW").
 			Comment("Who initiated the execution"),
 
 		field.Enum("status").
 			Values("PENDING", "RUNNING", "COMPLETED", "FAILED",
-				"REJECTED_HASH_MISMATCH", "REJECTED_NOT_APPROVED", "CLIENT_OFFLINE").
+				"REJECTED_HASH_MISMATCH", "REJECTED_NOT_APPROVED", "CLIENT_OFFLINE",
+				"TIMED_OUT", "CANCELLED", "AWAITING_APPROVAL").
 			Default("PENDING").
 			Comment("Current execution status"),
 
@@ -92,6 +93,146 @@ func (ExecutionLog) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Execution duration in milliseconds"),
+
+		field.Time("cancel_requested_at").
+			Optional().
+			Nillable().
+			Comment("When a user asked to cancel the execution"),
+
+		field.Uint32("cancelled_by").
+			Optional().
+			Nillable().
+			Comment("User who cancelled the execution"),
+
+		field.String("cancel_reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the execution was cancelled"),
+
+		field.String("timeout_reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the execution timed out"),
+
+		field.String("run_id").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("FK to executor_execution_runs when part of a fan-out run"),
+
+		field.String("event_rule_id").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("FK to executor_event_rules when started by an event rule"),
+
+		field.Enum("event_type").
+			Values("CLIENT_FIRST_CONNECT", "CLIENT_VERSION_CHANGED", "CLIENT_LABELS_CHANGED", "SCRIPT_FAILED").
+			Optional().
+			Nillable().
+			Comment("Event that started the execution"),
+
+		field.String("event_detail").
+			Optional().
+			MaxLen(1024).
+			Comment("Details of the originating event"),
+
+		field.String("source_execution_id").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("Failed execution that fired a SCRIPT_FAILED rule"),
+
+		field.String("workflow_run_id").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("FK to executor_workflow_runs when a step of a workflow run"),
+
+		field.String("workflow_step_id").
+			Optional().
+			MaxLen(64).
+			Comment("Step of the workflow run"),
+
+		field.Int("attempt").
+			Default(1).
+			Positive().
+			Comment("Attempt number, 1 for the first attempt"),
+
+		field.String("original_execution_id").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("First attempt of the execution, set on retries"),
+
+		field.Time("retry_at").
+			Optional().
+			Nillable().
+			Comment("When the next attempt is due, set while a retry is pending"),
+
+		field.String("retried_by").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("Attempt that retried this execution"),
+
+		field.Bool("waiting_for_slot").
+			Default(false).
+			Comment("PENDING execution held back until a concurrency slot on the client frees up"),
+
+		field.Time("held_until").
+			Optional().
+			Nillable().
+			Comment("Waiting execution held back until a maintenance window of the client opens"),
+
+		field.String("maintenance_override").
+			Optional().
+			MaxLen(1024).
+			Comment("Justification given for running outside the client's maintenance windows"),
+
+		field.JSON("parameters", map[string]string{}).
+			Optional().
+			Comment("Parameter values the execution runs with, secret values masked"),
+
+		field.Bytes("secret_parameters_ciphertext").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("Encrypted values of the secret parameters, kept to deliver waiting executions and retries"),
+
+		field.Bytes("secret_parameters_nonce").
+			Optional().
+			Sensitive().
+			Comment("GCM nonce the secret parameter values were encrypted with"),
+
+		field.String("secret_parameters_key_id").
+			Optional().
+			MaxLen(32).
+			Comment("Identifies the key the secret parameter values are encrypted with"),
+
+		field.JSON("secret_names", []string{}).
+			Optional().
+			Comment("Secrets the script content references, masked in the stored output"),
+
+		field.Time("approval_expires_at").
+			Optional().
+			Nillable().
+			Comment("When an execution on a protected client stops waiting for approval"),
+
+		field.Uint32("approved_by").
+			Optional().
+			Nillable().
+			Comment("User who approved the execution"),
+
+		field.Time("approved_at").
+			Optional().
+			Nillable().
+			Comment("When the execution was approved"),
+
+		field.String("approval_comment").
+			Optional().
+			MaxLen(1024).
+			Comment("Comment the approver left"),
 	}
 }
 
@@ -116,8 +257,15 @@ func (ExecutionLog) Indexes() []ent.Index {
 		index.Fields("script_id"),
 		index.Fields("client_id"),
 		index.Fields("status"),
+		index.Fields("run_id"),
+		index.Fields("event_rule_id"),
+		index.Fields("workflow_run_id"),
+		index.Fields("original_execution_id"),
+		index.Fields("retry_at"),
+		index.Fields("client_id", "waiting_for_slot"),
 		index.Fields("tenant_id", "script_id"),
 		index.Fields("tenant_id", "client_id"),
 		index.Fields("tenant_id", "status"),
+		index.Fields("status", "approval_expires_at"),
 	}
 }
@@ -0,0 +1,144 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ExecutionRun holds the schema definition for the ExecutionRun entity.
+// A run fans one script out to many clients; each client gets its own
+// ExecutionLog row pointing back to the run.
+type ExecutionRun struct {
+	ent.Schema
+}
+
+// Annotations of the ExecutionRun.
+func (ExecutionRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_execution_runs"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ExecutionRun.
+func (ExecutionRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("script_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to executor_scripts"),
+
+		field.String("script_name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Denormalized script name for audit readability"),
+
+		field.String("selector").
+			Optional().
+			MaxLen(1024).
+			Comment("Label selector the targets were resolved from"),
+
+		field.JSON("client_ids", []string{}).
+			Optional().
+			Comment("Client CNs requested explicitly"),
+
+		field.String("schedule_id").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("Schedule that started the run, nil for manual runs"),
+
+		field.JSON("targets", []string{}).
+			Optional().
+			Comment("Assigned client CNs in rollout order"),
+
+		field.Int("total").
+			NonNegative().
+			Default(0).
+			Comment("Number of executions created for the run"),
+
+		field.Int("dispatched").
+			NonNegative().
+			Default(0).
+			Comment("Number of targets handed out to batches so far"),
+
+		field.Int("batch_size").
+			NonNegative().
+			Default(0).
+			Comment("Targets per batch, 0 for everything at once"),
+
+		field.Int("canary_size").
+			NonNegative().
+			Default(0).
+			Comment("Size of the first batch that must fully succeed, 0 for no canary"),
+
+		field.Int("batch_pause_seconds").
+			NonNegative().
+			Default(0).
+			Comment("Wait between the end of a batch and the next one"),
+
+		field.Int("max_failures").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Failed executions tolerated before the run halts, nil for no limit"),
+
+		field.Int("current_batch").
+			NonNegative().
+			Default(0).
+			Comment("1-based number of the last dispatched batch"),
+
+		field.Time("next_batch_at").
+			Optional().
+			Nillable().
+			Comment("When the next batch is due, set once the current batch finished"),
+
+		field.Enum("status").
+			Values("RUNNING", "PAUSED", "HALTED", "ABORTED", "COMPLETED").
+			Default("RUNNING").
+			Comment("RUNNING until every batch was dispatched and finished"),
+
+		field.String("halt_reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the run was halted or aborted"),
+
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When the last execution of the run finished"),
+	}
+}
+
+// Edges of the ExecutionRun.
+func (ExecutionRun) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ExecutionRun.
+func (ExecutionRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ExecutionRun.
+func (ExecutionRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("tenant_id", "script_id"),
+		index.Fields("tenant_id", "status"),
+		index.Fields("schedule_id"),
+	}
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// MaintenanceWindow holds the schema definition for the MaintenanceWindow entity.
+// A window opens at every occurrence of its cron expression and stays open
+// for its duration; ad-hoc executions on matching clients outside of it are
+// rejected, queued or need an override.
+type MaintenanceWindow struct {
+	ent.Schema
+}
+
+// Annotations of the MaintenanceWindow.
+func (MaintenanceWindow) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_maintenance_windows"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the MaintenanceWindow.
+func (MaintenanceWindow) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Window name"),
+
+		field.String("description").
+			Optional().
+			MaxLen(2048).
+			Comment("Window description"),
+
+		field.JSON("client_patterns", []string{}).
+			Comment("Client CNs or glob patterns the window applies to"),
+
+		field.String("cron_expression").
+			NotEmpty().
+			MaxLen(255).
+			Comment("5-field cron expression or macro at which the window opens"),
+
+		field.Int("duration_minutes").
+			Positive().
+			Comment("How long the window stays open"),
+
+		field.String("timezone").
+			NotEmpty().
+			MaxLen(64).
+			Default("UTC").
+			Comment("IANA timezone the expression is evaluated in"),
+
+		field.Enum("action").
+			Values("REJECT", "QUEUE", "REQUIRE_OVERRIDE").
+			Default("REJECT").
+			Comment("What happens to executions triggered outside the window"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Disabled windows do not restrict their clients"),
+	}
+}
+
+// Edges of the MaintenanceWindow.
+func (MaintenanceWindow) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the MaintenanceWindow.
+func (MaintenanceWindow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the MaintenanceWindow.
+func (MaintenanceWindow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("tenant_id", "enabled"),
+	}
+}
@@ -0,0 +1,121 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ManagedClient holds the schema definition for the ManagedClient entity.
+// Every agent that ever connected is recorded here, so offline machines stay
+// visible. Clients are identified by their mTLS CN across tenants, the same
+// way the command registry and assignments address them.
+type ManagedClient struct {
+	ent.Schema
+}
+
+// Annotations of the ManagedClient.
+func (ManagedClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_clients"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ManagedClient.
+func (ManagedClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("client_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("mTLS client CN"),
+
+		field.String("machine_id").
+			Optional().
+			MaxLen(255).
+			Comment("Machine ID reported by the agent"),
+
+		field.String("client_version").
+			Optional().
+			MaxLen(64).
+			Comment("Agent version"),
+
+		field.String("hostname").
+			Optional().
+			MaxLen(255).
+			Comment("Hostname reported by the agent"),
+
+		field.String("os").
+			Optional().
+			MaxLen(64).
+			Comment("Operating system, e.g. linux or windows"),
+
+		field.String("os_version").
+			Optional().
+			MaxLen(255).
+			Comment("Operating system version"),
+
+		field.String("kernel_version").
+			Optional().
+			MaxLen(255).
+			Comment("Kernel version"),
+
+		field.String("arch").
+			Optional().
+			MaxLen(32).
+			Comment("CPU architecture"),
+
+		field.Int32("cpu_count").
+			Optional().
+			Comment("Number of CPUs"),
+
+		field.Int64("memory_bytes").
+			Optional().
+			Comment("Total memory in bytes"),
+
+		field.JSON("script_types", []string{}).
+			Optional().
+			Comment("Script types the agent has an interpreter for, empty for agents that do not report them"),
+
+		field.String("description").
+			Optional().
+			MaxLen(2048).
+			Comment("Operator notes about the client"),
+
+		field.Time("first_seen_at").
+			Comment("When the client connected for the first time"),
+
+		field.Time("last_seen_at").
+			Comment("When the client was last connected or sent a heartbeat"),
+	}
+}
+
+// Edges of the ManagedClient.
+func (ManagedClient) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ManagedClient.
+func (ManagedClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateBy{},
+		mixin.Time{},
+	}
+}
+
+// Indexes of the ManagedClient.
+func (ManagedClient) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id").Unique(),
+		index.Fields("hostname"),
+		index.Fields("last_seen_at"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// OutputChunk holds the schema definition for the OutputChunk entity.
+// Clients stream stdout/stderr of running executions in chunks, which are
+// stored as they arrive so the output can be followed live.
+type OutputChunk struct {
+	ent.Schema
+}
+
+// Annotations of the OutputChunk.
+func (OutputChunk) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_output_chunks"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the OutputChunk.
+func (OutputChunk) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("execution_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to executor_execution_logs"),
+
+		field.Int64("seq").
+			Positive().
+			Comment("Client-assigned sequence number, shared by stdout and stderr"),
+
+		field.Enum("stream").
+			Values("STDOUT", "STDERR").
+			Comment("Output stream the chunk belongs to"),
+
+		field.Text("data").
+			Optional().
+			Comment("Chunk content"),
+	}
+}
+
+// Edges of the OutputChunk.
+func (OutputChunk) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the OutputChunk.
+func (OutputChunk) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the OutputChunk.
+func (OutputChunk) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("execution_id", "seq").Unique(),
+	}
+}
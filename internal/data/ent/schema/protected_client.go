@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ProtectedClient holds the schema definition for the ProtectedClient entity.
+// Executions on a protected client wait for a second person's approval
+// before they are dispatched.
+type ProtectedClient struct {
+	ent.Schema
+}
+
+// Annotations of the ProtectedClient.
+func (ProtectedClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_protected_clients"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ProtectedClient.
+func (ProtectedClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("client_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("mTLS client CN"),
+
+		field.String("reason").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the client is protected"),
+	}
+}
+
+// Edges of the ProtectedClient.
+func (ProtectedClient) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ProtectedClient.
+func (ProtectedClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ProtectedClient.
+func (ProtectedClient) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "client_id").Unique(),
+	}
+}
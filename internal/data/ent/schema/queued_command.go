@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// QueuedCommand holds the schema definition for the QueuedCommand entity.
+// Commands for clients that are not connected wait here until the client
+// opens its command stream again or the command expires.
+type QueuedCommand struct {
+	ent.Schema
+}
+
+// Annotations of the QueuedCommand.
+func (QueuedCommand) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_queued_commands"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the QueuedCommand.
+func (QueuedCommand) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Command ID (UUID primary key)"),
+
+		field.String("client_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("mTLS client CN the command is addressed to"),
+
+		field.String("execution_id").
+			Optional().
+			MaxLen(36).
+			Comment("FK to executor_execution_logs, empty for non-execution commands"),
+
+		field.Enum("command_type").
+			Values("SCRIPT_EXECUTION", "CLIENT_UPDATE", "CANCEL").
+			Default("SCRIPT_EXECUTION").
+			Comment("Type of the queued command"),
+
+		field.Bytes("payload").
+			Comment("Serialized ExecutionCommand delivered to the client"),
+
+		field.Time("expires_at").
+			Comment("When the command is discarded if still undelivered"),
+	}
+}
+
+// Edges of the QueuedCommand.
+func (QueuedCommand) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the QueuedCommand.
+func (QueuedCommand) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the QueuedCommand.
+func (QueuedCommand) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id", "create_time"),
+		index.Fields("execution_id"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,145 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Schedule holds the schema definition for the Schedule entity.
+// Every occurrence of the cron expression starts an ExecutionRun over the
+// schedule's targets.
+type Schedule struct {
+	ent.Schema
+}
+
+// Annotations of the Schedule.
+func (Schedule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_schedules"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Schedule.
+func (Schedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Schedule name"),
+
+		field.String("description").
+			Optional().
+			MaxLen(2048).
+			Comment("Schedule description"),
+
+		field.String("script_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to executor_scripts"),
+
+		field.String("cron_expression").
+			NotEmpty().
+			MaxLen(255).
+			Comment("5-field cron expression or macro such as @daily"),
+
+		field.String("timezone").
+			NotEmpty().
+			MaxLen(64).
+			Default("UTC").
+			Comment("IANA timezone the expression is evaluated in"),
+
+		field.JSON("client_ids", []string{}).
+			Optional().
+			Comment("Client CNs targeted explicitly"),
+
+		field.String("selector").
+			Optional().
+			MaxLen(1024).
+			Comment("Label selector resolved at each occurrence"),
+
+		field.Int("batch_size").
+			NonNegative().
+			Default(0).
+			Comment("Run strategy: targets per batch"),
+
+		field.Int("canary_size").
+			NonNegative().
+			Default(0).
+			Comment("Run strategy: canary batch size"),
+
+		field.Int("batch_pause_seconds").
+			NonNegative().
+			Default(0).
+			Comment("Run strategy: wait between batches"),
+
+		field.Int("max_failures").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Run strategy: failures tolerated before halting"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Disabled schedules never fire"),
+
+		field.Enum("catch_up_policy").
+			Values("SKIP", "RUN_ONCE", "RUN_ALL").
+			Default("SKIP").
+			Comment("What to do about occurrences missed during downtime"),
+
+		field.Time("next_run_at").
+			Optional().
+			Nillable().
+			Comment("Next occurrence, nil while disabled"),
+
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("Occurrence the last run was started for"),
+
+		field.String("last_run_id").
+			Optional().
+			Nillable().
+			MaxLen(36).
+			Comment("Run started by the last occurrence"),
+
+		field.String("last_error").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the last occurrence did not start a run"),
+	}
+}
+
+// Edges of the Schedule.
+func (Schedule) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the Schedule.
+func (Schedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the Schedule.
+func (Schedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("tenant_id", "script_id"),
+		index.Fields("enabled", "next_run_at"),
+	}
+}
@@ -41,7 +41,7 @@ func (Script) Fields() []ent.Field {
 			Comment("Script description"),
 
 		field.Enum("script_type").
-			Values("BASH", "JAVASCRIPT", "LUA").
+			Values("BASH", "JAVASCRIPT", "LUA", "PYTHON", "POWERSHELL").
 			Comment("Script execution engine type"),
 
 		field.Text("content").
@@ -59,6 +59,29 @@ func (Script) Fields() []ent.Field {
 		field.Bool("enabled").
 			Default(true).
 			Comment("Whether the script is active"),
+
+		field.Int("timeout_seconds").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Execution timeout in seconds, falls back to the tenant default when unset"),
+
+		field.JSON("retry_policy", &RetryPolicy{}).
+			Optional().
+			Comment("How failed or undelivered executions are retried, no retries when unset"),
+
+		field.Bool("singleton").
+			Default(false).
+			Comment("Only one active execution of the script per client"),
+
+		field.Enum("concurrency_limit_action").
+			Values("REJECT", "QUEUE").
+			Default("REJECT").
+			Comment("Whether executions over a concurrency limit are rejected or wait for a slot"),
+
+		field.JSON("parameters", []ScriptParameter{}).
+			Optional().
+			Comment("Inputs the script declares, supplied by assignments and triggers"),
 	}
 }
 
@@ -86,3 +109,26 @@ func (Script) Indexes() []ent.Index {
 		index.Fields("tenant_id", "enabled"),
 	}
 }
+
+// RetryPolicy is how the failed or undelivered server-initiated executions of
+// a script are retried. Statuses hold execution log status values.
+type RetryPolicy struct {
+	MaxAttempts        int      `json:"max_attempts"`
+	BackoffSeconds     int      `json:"backoff_seconds"`
+	MaxBackoffSeconds  int      `json:"max_backoff_seconds,omitempty"`
+	RetryableExitCodes []int32  `json:"retryable_exit_codes,omitempty"`
+	RetryableStatuses  []string `json:"retryable_statuses,omitempty"`
+}
+
+// ScriptParameter is an input a script declares. Type is STRING, INTEGER,
+// NUMBER or BOOLEAN; Delivery is ENV or ARG.
+type ScriptParameter struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Description   string   `json:"description,omitempty"`
+	Required      bool     `json:"required,omitempty"`
+	Default       *string  `json:"default,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+	Secret        bool     `json:"secret,omitempty"`
+	Delivery      string   `json:"delivery"`
+}
@@ -35,10 +35,32 @@ func (ScriptAssignment) Fields() []ent.Field {
 			MaxLen(36).
 			Comment("FK to executor_scripts"),
 
+		field.Enum("target_type").
+			Values("CLIENT", "SELECTOR", "GROUP").
+			Default("CLIENT").
+			Comment("What the assignment targets"),
+
 		field.String("client_id").
-			NotEmpty().
+			Optional().
+			Default("").
 			MaxLen(255).
-			Comment("mTLS client CN"),
+			Comment("mTLS client CN, for CLIENT assignments"),
+
+		field.String("selector").
+			Optional().
+			Default("").
+			MaxLen(1024).
+			Comment("Label selector, for SELECTOR assignments"),
+
+		field.String("group_id").
+			Optional().
+			Default("").
+			MaxLen(36).
+			Comment("FK to executor_client_groups, for GROUP assignments"),
+
+		field.JSON("parameters", map[string]string{}).
+			Optional().
+			Comment("Values of the script's parameters for the targeted clients"),
 	}
 }
 
@@ -59,11 +81,12 @@ func (ScriptAssignment) Mixin() []ent.Mixin {
 // Indexes of the ScriptAssignment.
 func (ScriptAssignment) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("script_id", "client_id", "tenant_id").
+		index.Fields("script_id", "client_id", "selector", "group_id", "tenant_id").
 			Unique().
 			StorageKey("executor_assignment_unique"),
 		index.Fields("script_id"),
 		index.Fields("client_id"),
+		index.Fields("group_id"),
 		index.Fields("tenant_id"),
 	}
 }
@@ -0,0 +1,129 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ScriptChangeRequest holds the schema definition for the ScriptChangeRequest
+// entity. When a tenant requires script approval, new content waits here
+// until a second person approves it.
+type ScriptChangeRequest struct {
+	ent.Schema
+}
+
+// Annotations of the ScriptChangeRequest.
+func (ScriptChangeRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_script_change_requests"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ScriptChangeRequest.
+func (ScriptChangeRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("script_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to executor_scripts"),
+
+		field.Int("base_version").
+			Positive().
+			Comment("Script version the change was made against"),
+
+		field.Text("content").
+			Comment("Proposed script body content"),
+
+		field.String("content_hash").
+			NotEmpty().
+			MaxLen(64).
+			Comment("SHA256 hex digest of the proposed content"),
+
+		field.String("change_note").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the content is changed"),
+
+		field.Int("restored_from_version").
+			Optional().
+			Nillable().
+			Comment("Version whose content a rollback restores"),
+
+		field.Enum("status").
+			Values("PENDING", "APPROVED", "REJECTED", "CANCELLED").
+			Default("PENDING").
+			Comment("Review state of the change"),
+
+		field.Uint32("reviewed_by").
+			Optional().
+			Nillable().
+			Comment("User who approved or rejected the change"),
+
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			Comment("When the change was approved or rejected"),
+
+		field.String("review_comment").
+			Optional().
+			MaxLen(2048).
+			Comment("Comment left by the approver"),
+
+		field.String("rejection_reason").
+			Optional().
+			MaxLen(2048).
+			Comment("Why the change was rejected"),
+
+		field.Int("applied_version").
+			Optional().
+			Nillable().
+			Comment("Script version created when the change was approved"),
+
+		field.JSON("comments", []ScriptChangeComment{}).
+			Optional().
+			Comment("Discussion of the change, oldest first"),
+	}
+}
+
+// Edges of the ScriptChangeRequest.
+func (ScriptChangeRequest) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ScriptChangeRequest.
+func (ScriptChangeRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ScriptChangeRequest.
+func (ScriptChangeRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "status"),
+		index.Fields("script_id", "status"),
+	}
+}
+
+// ScriptChangeComment is a comment left on a change request
+type ScriptChangeComment struct {
+	AuthorID   *uint32   `json:"author_id,omitempty"`
+	AuthorName string    `json:"author_name,omitempty"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ScriptVersion holds the schema definition for the ScriptVersion entity.
+// Every content of a script is kept as a version, so the content an
+// execution ran can be found by its hash long after the script changed.
+type ScriptVersion struct {
+	ent.Schema
+}
+
+// Annotations of the ScriptVersion.
+func (ScriptVersion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_script_versions"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ScriptVersion.
+func (ScriptVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("script_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to executor_scripts"),
+
+		field.Int("version").
+			Positive().
+			Comment("Script version this content was saved as"),
+
+		field.Text("content").
+			Comment("Script body content"),
+
+		field.String("content_hash").
+			NotEmpty().
+			MaxLen(64).
+			Comment("SHA256 hex digest of content"),
+
+		field.String("change_note").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the content was changed"),
+
+		field.Int("restored_from_version").
+			Optional().
+			Nillable().
+			Comment("Version whose content a rollback restored"),
+
+		field.Uint32("approved_by").
+			Optional().
+			Nillable().
+			Comment("User who approved the change request this version came from"),
+
+		field.String("change_request_id").
+			Optional().
+			MaxLen(36).
+			Comment("Change request this version came from"),
+	}
+}
+
+// Edges of the ScriptVersion.
+func (ScriptVersion) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the ScriptVersion.
+func (ScriptVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ScriptVersion.
+func (ScriptVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("script_id", "version").Unique(),
+		index.Fields("script_id", "content_hash"),
+		index.Fields("tenant_id"),
+	}
+}
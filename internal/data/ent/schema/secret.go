@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Secret holds the schema definition for the Secret entity.
+// The value is stored encrypted with AES-256-GCM under the server's secret
+// key and is only decrypted when a command referencing it is sent.
+type Secret struct {
+	ent.Schema
+}
+
+// Annotations of the Secret.
+func (Secret) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_secrets"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Secret.
+func (Secret) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(64).
+			Immutable().
+			Comment("Name scripts reference the secret by"),
+
+		field.String("description").
+			Optional().
+			MaxLen(2048).
+			Comment("Secret description"),
+
+		field.Bytes("ciphertext").
+			Sensitive().
+			Comment("Encrypted value"),
+
+		field.Bytes("nonce").
+			Sensitive().
+			Comment("GCM nonce the value was encrypted with"),
+
+		field.String("key_id").
+			NotEmpty().
+			MaxLen(32).
+			Comment("Identifies the key the value is encrypted with"),
+	}
+}
+
+// Edges of the Secret.
+func (Secret) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the Secret.
+func (Secret) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the Secret.
+func (Secret) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
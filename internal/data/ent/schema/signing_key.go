@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity.
+// Keys are shared by all tenants. The private key is sealed with the
+// server's secret key; only one key is active at a time.
+type SigningKey struct {
+	ent.Schema
+}
+
+// Annotations of the SigningKey.
+func (SigningKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_signing_keys"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			MaxLen(64).
+			Comment("Key ID derived from the public key"),
+
+		field.Enum("algorithm").
+			Values("ED25519", "ECDSA_P256").
+			Comment("Signature algorithm"),
+
+		field.Bytes("public_key").
+			NotEmpty().
+			Comment("PKIX DER encoded public key"),
+
+		field.Bytes("private_key").
+			Sensitive().
+			Comment("Sealed PKCS #8 private key"),
+
+		field.Bytes("nonce").
+			Sensitive().
+			Comment("GCM nonce the private key was sealed with"),
+
+		field.String("sealed_with").
+			NotEmpty().
+			MaxLen(32).
+			Comment("ID of the secret key the private key is sealed with"),
+
+		field.Enum("status").
+			Values("ACTIVE", "RETIRED", "REVOKED").
+			Default("ACTIVE").
+			Comment("Lifecycle status"),
+
+		field.Time("retired_at").
+			Optional().
+			Nillable().
+			Comment("When a newer key replaced this one"),
+
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("When the key was revoked"),
+	}
+}
+
+// Edges of the SigningKey.
+func (SigningKey) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the SigningKey.
+func (SigningKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+	}
+}
+
+// Indexes of the SigningKey.
+func (SigningKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
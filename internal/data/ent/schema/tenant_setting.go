@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TenantSetting holds the schema definition for the TenantSetting entity.
+// One row per tenant; missing rows mean the built-in defaults apply.
+type TenantSetting struct {
+	ent.Schema
+}
+
+// Annotations of the TenantSetting.
+func (TenantSetting) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_tenant_settings"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the TenantSetting.
+func (TenantSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.Int("default_timeout_seconds").
+			Positive().
+			Default(3600).
+			Comment("Execution timeout for scripts without their own timeout"),
+
+		field.Int("max_concurrent_per_client").
+			NonNegative().
+			Default(0).
+			Comment("Active executions a client may have at once, 0 is unlimited"),
+
+		field.Bool("require_script_approval").
+			Default(false).
+			Comment("Whether script content changes need approval by a second person"),
+
+		field.String("script_approver_role").
+			Optional().
+			MaxLen(128).
+			Comment("Role allowed to approve script changes and executions on protected clients, the default role when empty"),
+
+		field.Int("execution_approval_ttl_minutes").
+			Positive().
+			Default(60).
+			Comment("How long an execution on a protected client waits for approval"),
+
+		field.Enum("script_block_severity").
+			Values("NONE", "INFO", "WARNING", "ERROR").
+			Default("NONE").
+			Comment("Lowest diagnostic severity that refuses a script save, NONE never blocks"),
+
+		field.JSON("disabled_lint_rules", []string{}).
+			Optional().
+			Comment("Lint rules not applied to scripts of the tenant"),
+	}
+}
+
+// Edges of the TenantSetting.
+func (TenantSetting) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the TenantSetting.
+func (TenantSetting) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the TenantSetting.
+func (TenantSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique(),
+	}
+}
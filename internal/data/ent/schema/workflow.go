@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WorkflowStep is a step of a workflow as stored in the steps column
+type WorkflowStep struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name,omitempty"`
+	ScriptID         string   `json:"script_id"`
+	DependsOn        []string `json:"depends_on,omitempty"`
+	Condition        string   `json:"condition,omitempty"` // ON_SUCCESS, ON_FAILURE or ALWAYS
+	SuccessExitCodes []int32  `json:"success_exit_codes,omitempty"`
+	OutputPattern    string   `json:"output_pattern,omitempty"`
+}
+
+// Workflow holds the schema definition for the Workflow entity.
+// A workflow is a DAG of script steps run together on one client.
+type Workflow struct {
+	ent.Schema
+}
+
+// Annotations of the Workflow.
+func (Workflow) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_workflows"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Workflow.
+func (Workflow) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Workflow name"),
+
+		field.String("description").
+			Optional().
+			MaxLen(2048).
+			Comment("Workflow description"),
+
+		field.JSON("steps", []WorkflowStep{}).
+			Comment("Steps with their dependencies and conditions"),
+	}
+}
+
+// Edges of the Workflow.
+func (Workflow) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the Workflow.
+func (Workflow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the Workflow.
+func (Workflow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("tenant_id", "name"),
+	}
+}
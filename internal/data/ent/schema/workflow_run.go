@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WorkflowRun holds the schema definition for the WorkflowRun entity.
+// The status of each step is derived from the executions linked to the run;
+// the run itself records which steps were started or skipped.
+type WorkflowRun struct {
+	ent.Schema
+}
+
+// Annotations of the WorkflowRun.
+func (WorkflowRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "executor_workflow_runs"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the WorkflowRun.
+func (WorkflowRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("workflow_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("FK to executor_workflows"),
+
+		field.String("workflow_name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Denormalized workflow name for audit readability"),
+
+		field.String("client_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("mTLS client CN the steps run on"),
+
+		field.JSON("steps", []WorkflowStep{}).
+			Comment("Steps of the workflow when the run started"),
+
+		field.JSON("started_steps", []string{}).
+			Optional().
+			Comment("Steps whose execution was dispatched"),
+
+		field.JSON("skipped_steps", []string{}).
+			Optional().
+			Comment("Steps whose condition did not hold"),
+
+		field.JSON("failed_steps", []string{}).
+			Optional().
+			Comment("Steps whose execution could not be created"),
+
+		field.Int("revision").
+			Default(0).
+			Comment("Incremented on every step change, for compare-and-set"),
+
+		field.Enum("status").
+			Values("RUNNING", "SUCCEEDED", "FAILED").
+			Default("RUNNING").
+			Comment("Run status"),
+
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When every step had finished or was skipped"),
+	}
+}
+
+// Edges of the WorkflowRun.
+func (WorkflowRun) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the WorkflowRun.
+func (WorkflowRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the WorkflowRun.
+func (WorkflowRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("status"),
+		index.Fields("tenant_id", "workflow_id"),
+		index.Fields("tenant_id", "client_id"),
+	}
+}
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
 )
 
@@ -43,7 +45,17 @@ type Script struct {
 	// Content version, incremented on update
 	Version int `json:"version,omitempty"`
 	// Whether the script is active
-	Enabled      bool `json:"enabled,omitempty"`
+	Enabled bool `json:"enabled,omitempty"`
+	// Execution timeout in seconds, falls back to the tenant default when unset
+	TimeoutSeconds *int `json:"timeout_seconds,omitempty"`
+	// How failed or undelivered executions are retried, no retries when unset
+	RetryPolicy *schema.RetryPolicy `json:"retry_policy,omitempty"`
+	// Only one active execution of the script per client
+	Singleton bool `json:"singleton,omitempty"`
+	// Whether executions over a concurrency limit are rejected or wait for a slot
+	ConcurrencyLimitAction script.ConcurrencyLimitAction `json:"concurrency_limit_action,omitempty"`
+	// Inputs the script declares, supplied by assignments and triggers
+	Parameters   []schema.ScriptParameter `json:"parameters,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -52,11 +64,13 @@ func (*Script) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case script.FieldEnabled:
+		case script.FieldRetryPolicy, script.FieldParameters:
+			values[i] = new([]byte)
+		case script.FieldEnabled, script.FieldSingleton:
 			values[i] = new(sql.NullBool)
-		case script.FieldCreateBy, script.FieldUpdateBy, script.FieldTenantID, script.FieldVersion:
+		case script.FieldCreateBy, script.FieldUpdateBy, script.FieldTenantID, script.FieldVersion, script.FieldTimeoutSeconds:
 			values[i] = new(sql.NullInt64)
-		case script.FieldID, script.FieldName, script.FieldDescription, script.FieldScriptType, script.FieldContent, script.FieldContentHash:
+		case script.FieldID, script.FieldName, script.FieldDescription, script.FieldScriptType, script.FieldContent, script.FieldContentHash, script.FieldConcurrencyLimitAction:
 			values[i] = new(sql.NullString)
 		case script.FieldCreateTime, script.FieldUpdateTime, script.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
@@ -165,6 +179,41 @@ func (_m *Script) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Enabled = value.Bool
 			}
+		case script.FieldTimeoutSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_seconds", values[i])
+			} else if value.Valid {
+				_m.TimeoutSeconds = new(int)
+				*_m.TimeoutSeconds = int(value.Int64)
+			}
+		case script.FieldRetryPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RetryPolicy); err != nil {
+					return fmt.Errorf("unmarshal field retry_policy: %w", err)
+				}
+			}
+		case script.FieldSingleton:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field singleton", values[i])
+			} else if value.Valid {
+				_m.Singleton = value.Bool
+			}
+		case script.FieldConcurrencyLimitAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field concurrency_limit_action", values[i])
+			} else if value.Valid {
+				_m.ConcurrencyLimitAction = script.ConcurrencyLimitAction(value.String)
+			}
+		case script.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -251,6 +300,23 @@ func (_m *Script) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.TimeoutSeconds; v != nil {
+		builder.WriteString("timeout_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("retry_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetryPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("singleton=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Singleton))
+	builder.WriteString(", ")
+	builder.WriteString("concurrency_limit_action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConcurrencyLimitAction))
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Parameters))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -40,6 +40,16 @@ const (
 	FieldVersion = "version"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldTimeoutSeconds holds the string denoting the timeout_seconds field in the database.
+	FieldTimeoutSeconds = "timeout_seconds"
+	// FieldRetryPolicy holds the string denoting the retry_policy field in the database.
+	FieldRetryPolicy = "retry_policy"
+	// FieldSingleton holds the string denoting the singleton field in the database.
+	FieldSingleton = "singleton"
+	// FieldConcurrencyLimitAction holds the string denoting the concurrency_limit_action field in the database.
+	FieldConcurrencyLimitAction = "concurrency_limit_action"
+	// FieldParameters holds the string denoting the parameters field in the database.
+	FieldParameters = "parameters"
 	// Table holds the table name of the script in the database.
 	Table = "executor_scripts"
 )
@@ -60,6 +70,11 @@ var Columns = []string{
 	FieldContentHash,
 	FieldVersion,
 	FieldEnabled,
+	FieldTimeoutSeconds,
+	FieldRetryPolicy,
+	FieldSingleton,
+	FieldConcurrencyLimitAction,
+	FieldParameters,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -92,6 +107,10 @@ var (
 	DefaultVersion int
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
+	// TimeoutSecondsValidator is a validator for the "timeout_seconds" field. It is called by the builders before save.
+	TimeoutSecondsValidator func(int) error
+	// DefaultSingleton holds the default value on creation for the "singleton" field.
+	DefaultSingleton bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -104,6 +123,8 @@ const (
 	ScriptTypeBASH       ScriptType = "BASH"
 	ScriptTypeJAVASCRIPT ScriptType = "JAVASCRIPT"
 	ScriptTypeLUA        ScriptType = "LUA"
+	ScriptTypePYTHON     ScriptType = "PYTHON"
+	ScriptTypePOWERSHELL ScriptType = "POWERSHELL"
 )
 
 func (st ScriptType) String() string {
@@ -113,13 +134,39 @@ func (st ScriptType) String() string {
 // ScriptTypeValidator is a validator for the "script_type" field enum values. It is called by the builders before save.
 func ScriptTypeValidator(st ScriptType) error {
 	switch st {
-	case ScriptTypeBASH, ScriptTypeJAVASCRIPT, ScriptTypeLUA:
+	case ScriptTypeBASH, ScriptTypeJAVASCRIPT, ScriptTypeLUA, ScriptTypePYTHON, ScriptTypePOWERSHELL:
 		return nil
 	default:
 		return fmt.Errorf("script: invalid enum value for script_type field: %q", st)
 	}
 }
 
+// ConcurrencyLimitAction defines the type for the "concurrency_limit_action" enum field.
+type ConcurrencyLimitAction string
+
+// ConcurrencyLimitActionREJECT is the default value of the ConcurrencyLimitAction enum.
+const DefaultConcurrencyLimitAction = ConcurrencyLimitActionREJECT
+
+// ConcurrencyLimitAction values.
+const (
+	ConcurrencyLimitActionREJECT ConcurrencyLimitAction = "REJECT"
+	ConcurrencyLimitActionQUEUE  ConcurrencyLimitAction = "QUEUE"
+)
+
+func (cla ConcurrencyLimitAction) String() string {
+	return string(cla)
+}
+
+// ConcurrencyLimitActionValidator is a validator for the "concurrency_limit_action" field enum values. It is called by the builders before save.
+func ConcurrencyLimitActionValidator(cla ConcurrencyLimitAction) error {
+	switch cla {
+	case ConcurrencyLimitActionREJECT, ConcurrencyLimitActionQUEUE:
+		return nil
+	default:
+		return fmt.Errorf("script: invalid enum value for concurrency_limit_action field: %q", cla)
+	}
+}
+
 // OrderOption defines the ordering options for the Script queries.
 type OrderOption func(*sql.Selector)
 
@@ -192,3 +239,18 @@ func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
+
+// ByTimeoutSeconds orders the results by the timeout_seconds field.
+func ByTimeoutSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeoutSeconds, opts...).ToFunc()
+}
+
+// BySingleton orders the results by the singleton field.
+func BySingleton(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSingleton, opts...).ToFunc()
+}
+
+// ByConcurrencyLimitAction orders the results by the concurrency_limit_action field.
+func ByConcurrencyLimitAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConcurrencyLimitAction, opts...).ToFunc()
+}
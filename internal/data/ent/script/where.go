@@ -124,6 +124,16 @@ func Enabled(v bool) predicate.Script {
 	return predicate.Script(sql.FieldEQ(FieldEnabled, v))
 }
 
+// TimeoutSeconds applies equality check predicate on the "timeout_seconds" field. It's identical to TimeoutSecondsEQ.
+func TimeoutSeconds(v int) predicate.Script {
+	return predicate.Script(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// Singleton applies equality check predicate on the "singleton" field. It's identical to SingletonEQ.
+func Singleton(v bool) predicate.Script {
+	return predicate.Script(sql.FieldEQ(FieldSingleton, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.Script {
 	return predicate.Script(sql.FieldEQ(FieldCreateBy, v))
@@ -764,6 +774,106 @@ func EnabledNEQ(v bool) predicate.Script {
 	return predicate.Script(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// TimeoutSecondsEQ applies the EQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsEQ(v int) predicate.Script {
+	return predicate.Script(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsNEQ applies the NEQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsNEQ(v int) predicate.Script {
+	return predicate.Script(sql.FieldNEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsIn applies the In predicate on the "timeout_seconds" field.
+func TimeoutSecondsIn(vs ...int) predicate.Script {
+	return predicate.Script(sql.FieldIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsNotIn applies the NotIn predicate on the "timeout_seconds" field.
+func TimeoutSecondsNotIn(vs ...int) predicate.Script {
+	return predicate.Script(sql.FieldNotIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsGT applies the GT predicate on the "timeout_seconds" field.
+func TimeoutSecondsGT(v int) predicate.Script {
+	return predicate.Script(sql.FieldGT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsGTE applies the GTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsGTE(v int) predicate.Script {
+	return predicate.Script(sql.FieldGTE(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLT applies the LT predicate on the "timeout_seconds" field.
+func TimeoutSecondsLT(v int) predicate.Script {
+	return predicate.Script(sql.FieldLT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLTE applies the LTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsLTE(v int) predicate.Script {
+	return predicate.Script(sql.FieldLTE(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsIsNil applies the IsNil predicate on the "timeout_seconds" field.
+func TimeoutSecondsIsNil() predicate.Script {
+	return predicate.Script(sql.FieldIsNull(FieldTimeoutSeconds))
+}
+
+// TimeoutSecondsNotNil applies the NotNil predicate on the "timeout_seconds" field.
+func TimeoutSecondsNotNil() predicate.Script {
+	return predicate.Script(sql.FieldNotNull(FieldTimeoutSeconds))
+}
+
+// RetryPolicyIsNil applies the IsNil predicate on the "retry_policy" field.
+func RetryPolicyIsNil() predicate.Script {
+	return predicate.Script(sql.FieldIsNull(FieldRetryPolicy))
+}
+
+// RetryPolicyNotNil applies the NotNil predicate on the "retry_policy" field.
+func RetryPolicyNotNil() predicate.Script {
+	return predicate.Script(sql.FieldNotNull(FieldRetryPolicy))
+}
+
+// SingletonEQ applies the EQ predicate on the "singleton" field.
+func SingletonEQ(v bool) predicate.Script {
+	return predicate.Script(sql.FieldEQ(FieldSingleton, v))
+}
+
+// SingletonNEQ applies the NEQ predicate on the "singleton" field.
+func SingletonNEQ(v bool) predicate.Script {
+	return predicate.Script(sql.FieldNEQ(FieldSingleton, v))
+}
+
+// ConcurrencyLimitActionEQ applies the EQ predicate on the "concurrency_limit_action" field.
+func ConcurrencyLimitActionEQ(v ConcurrencyLimitAction) predicate.Script {
+	return predicate.Script(sql.FieldEQ(FieldConcurrencyLimitAction, v))
+}
+
+// ConcurrencyLimitActionNEQ applies the NEQ predicate on the "concurrency_limit_action" field.
+func ConcurrencyLimitActionNEQ(v ConcurrencyLimitAction) predicate.Script {
+	return predicate.Script(sql.FieldNEQ(FieldConcurrencyLimitAction, v))
+}
+
+// ConcurrencyLimitActionIn applies the In predicate on the "concurrency_limit_action" field.
+func ConcurrencyLimitActionIn(vs ...ConcurrencyLimitAction) predicate.Script {
+	return predicate.Script(sql.FieldIn(FieldConcurrencyLimitAction, vs...))
+}
+
+// ConcurrencyLimitActionNotIn applies the NotIn predicate on the "concurrency_limit_action" field.
+func ConcurrencyLimitActionNotIn(vs ...ConcurrencyLimitAction) predicate.Script {
+	return predicate.Script(sql.FieldNotIn(FieldConcurrencyLimitAction, vs...))
+}
+
+// ParametersIsNil applies the IsNil predicate on the "parameters" field.
+func ParametersIsNil() predicate.Script {
+	return predicate.Script(sql.FieldIsNull(FieldParameters))
+}
+
+// ParametersNotNil applies the NotNil predicate on the "parameters" field.
+func ParametersNotNil() predicate.Script {
+	return predicate.Script(sql.FieldNotNull(FieldParameters))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Script) predicate.Script {
 	return predicate.Script(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
 )
 
@@ -173,6 +174,60 @@ func (_c *ScriptCreate) SetNillableEnabled(v *bool) *ScriptCreate {
 	return _c
 }
 
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_c *ScriptCreate) SetTimeoutSeconds(v int) *ScriptCreate {
+	_c.mutation.SetTimeoutSeconds(v)
+	return _c
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_c *ScriptCreate) SetNillableTimeoutSeconds(v *int) *ScriptCreate {
+	if v != nil {
+		_c.SetTimeoutSeconds(*v)
+	}
+	return _c
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (_c *ScriptCreate) SetRetryPolicy(v *schema.RetryPolicy) *ScriptCreate {
+	_c.mutation.SetRetryPolicy(v)
+	return _c
+}
+
+// SetSingleton sets the "singleton" field.
+func (_c *ScriptCreate) SetSingleton(v bool) *ScriptCreate {
+	_c.mutation.SetSingleton(v)
+	return _c
+}
+
+// SetNillableSingleton sets the "singleton" field if the given value is not nil.
+func (_c *ScriptCreate) SetNillableSingleton(v *bool) *ScriptCreate {
+	if v != nil {
+		_c.SetSingleton(*v)
+	}
+	return _c
+}
+
+// SetConcurrencyLimitAction sets the "concurrency_limit_action" field.
+func (_c *ScriptCreate) SetConcurrencyLimitAction(v script.ConcurrencyLimitAction) *ScriptCreate {
+	_c.mutation.SetConcurrencyLimitAction(v)
+	return _c
+}
+
+// SetNillableConcurrencyLimitAction sets the "concurrency_limit_action" field if the given value is not nil.
+func (_c *ScriptCreate) SetNillableConcurrencyLimitAction(v *script.ConcurrencyLimitAction) *ScriptCreate {
+	if v != nil {
+		_c.SetConcurrencyLimitAction(*v)
+	}
+	return _c
+}
+
+// SetParameters sets the "parameters" field.
+func (_c *ScriptCreate) SetParameters(v []schema.ScriptParameter) *ScriptCreate {
+	_c.mutation.SetParameters(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ScriptCreate) SetID(v string) *ScriptCreate {
 	_c.mutation.SetID(v)
@@ -228,6 +283,14 @@ func (_c *ScriptCreate) defaults() error {
 		v := script.DefaultEnabled
 		_c.mutation.SetEnabled(v)
 	}
+	if _, ok := _c.mutation.Singleton(); !ok {
+		v := script.DefaultSingleton
+		_c.mutation.SetSingleton(v)
+	}
+	if _, ok := _c.mutation.ConcurrencyLimitAction(); !ok {
+		v := script.DefaultConcurrencyLimitAction
+		_c.mutation.SetConcurrencyLimitAction(v)
+	}
 	return nil
 }
 
@@ -271,6 +334,22 @@ func (_c *ScriptCreate) check() error {
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Script.enabled"`)}
 	}
+	if v, ok := _c.mutation.TimeoutSeconds(); ok {
+		if err := script.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "Script.timeout_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Singleton(); !ok {
+		return &ValidationError{Name: "singleton", err: errors.New(`ent: missing required field "Script.singleton"`)}
+	}
+	if _, ok := _c.mutation.ConcurrencyLimitAction(); !ok {
+		return &ValidationError{Name: "concurrency_limit_action", err: errors.New(`ent: missing required field "Script.concurrency_limit_action"`)}
+	}
+	if v, ok := _c.mutation.ConcurrencyLimitAction(); ok {
+		if err := script.ConcurrencyLimitActionValidator(v); err != nil {
+			return &ValidationError{Name: "concurrency_limit_action", err: fmt.Errorf(`ent: validator failed for field "Script.concurrency_limit_action": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := script.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Script.id": %w`, err)}
@@ -364,6 +443,26 @@ func (_c *ScriptCreate) createSpec() (*Script, *sqlgraph.CreateSpec) {
 		_spec.SetField(script.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := _c.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(script.FieldTimeoutSeconds, field.TypeInt, value)
+		_node.TimeoutSeconds = &value
+	}
+	if value, ok := _c.mutation.RetryPolicy(); ok {
+		_spec.SetField(script.FieldRetryPolicy, field.TypeJSON, value)
+		_node.RetryPolicy = value
+	}
+	if value, ok := _c.mutation.Singleton(); ok {
+		_spec.SetField(script.FieldSingleton, field.TypeBool, value)
+		_node.Singleton = value
+	}
+	if value, ok := _c.mutation.ConcurrencyLimitAction(); ok {
+		_spec.SetField(script.FieldConcurrencyLimitAction, field.TypeEnum, value)
+		_node.ConcurrencyLimitAction = value
+	}
+	if value, ok := _c.mutation.Parameters(); ok {
+		_spec.SetField(script.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
 	return _node, _spec
 }
 
@@ -596,6 +695,90 @@ func (u *ScriptUpsert) UpdateEnabled() *ScriptUpsert {
 	return u
 }
 
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ScriptUpsert) SetTimeoutSeconds(v int) *ScriptUpsert {
+	u.Set(script.FieldTimeoutSeconds, v)
+	return u
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ScriptUpsert) UpdateTimeoutSeconds() *ScriptUpsert {
+	u.SetExcluded(script.FieldTimeoutSeconds)
+	return u
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ScriptUpsert) AddTimeoutSeconds(v int) *ScriptUpsert {
+	u.Add(script.FieldTimeoutSeconds, v)
+	return u
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ScriptUpsert) ClearTimeoutSeconds() *ScriptUpsert {
+	u.SetNull(script.FieldTimeoutSeconds)
+	return u
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *ScriptUpsert) SetRetryPolicy(v *schema.RetryPolicy) *ScriptUpsert {
+	u.Set(script.FieldRetryPolicy, v)
+	return u
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *ScriptUpsert) UpdateRetryPolicy() *ScriptUpsert {
+	u.SetExcluded(script.FieldRetryPolicy)
+	return u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *ScriptUpsert) ClearRetryPolicy() *ScriptUpsert {
+	u.SetNull(script.FieldRetryPolicy)
+	return u
+}
+
+// SetSingleton sets the "singleton" field.
+func (u *ScriptUpsert) SetSingleton(v bool) *ScriptUpsert {
+	u.Set(script.FieldSingleton, v)
+	return u
+}
+
+// UpdateSingleton sets the "singleton" field to the value that was provided on create.
+func (u *ScriptUpsert) UpdateSingleton() *ScriptUpsert {
+	u.SetExcluded(script.FieldSingleton)
+	return u
+}
+
+// SetConcurrencyLimitAction sets the "concurrency_limit_action" field.
+func (u *ScriptUpsert) SetConcurrencyLimitAction(v script.ConcurrencyLimitAction) *ScriptUpsert {
+	u.Set(script.FieldConcurrencyLimitAction, v)
+	return u
+}
+
+// UpdateConcurrencyLimitAction sets the "concurrency_limit_action" field to the value that was provided on create.
+func (u *ScriptUpsert) UpdateConcurrencyLimitAction() *ScriptUpsert {
+	u.SetExcluded(script.FieldConcurrencyLimitAction)
+	return u
+}
+
+// SetParameters sets the "parameters" field.
+func (u *ScriptUpsert) SetParameters(v []schema.ScriptParameter) *ScriptUpsert {
+	u.Set(script.FieldParameters, v)
+	return u
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ScriptUpsert) UpdateParameters() *ScriptUpsert {
+	u.SetExcluded(script.FieldParameters)
+	return u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *ScriptUpsert) ClearParameters() *ScriptUpsert {
+	u.SetNull(script.FieldParameters)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -860,6 +1043,104 @@ func (u *ScriptUpsertOne) UpdateEnabled() *ScriptUpsertOne {
 	})
 }
 
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ScriptUpsertOne) SetTimeoutSeconds(v int) *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetTimeoutSeconds(v)
+	})
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ScriptUpsertOne) AddTimeoutSeconds(v int) *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.AddTimeoutSeconds(v)
+	})
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ScriptUpsertOne) UpdateTimeoutSeconds() *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateTimeoutSeconds()
+	})
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ScriptUpsertOne) ClearTimeoutSeconds() *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.ClearTimeoutSeconds()
+	})
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *ScriptUpsertOne) SetRetryPolicy(v *schema.RetryPolicy) *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *ScriptUpsertOne) UpdateRetryPolicy() *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *ScriptUpsertOne) ClearRetryPolicy() *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
+// SetSingleton sets the "singleton" field.
+func (u *ScriptUpsertOne) SetSingleton(v bool) *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetSingleton(v)
+	})
+}
+
+// UpdateSingleton sets the "singleton" field to the value that was provided on create.
+func (u *ScriptUpsertOne) UpdateSingleton() *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateSingleton()
+	})
+}
+
+// SetConcurrencyLimitAction sets the "concurrency_limit_action" field.
+func (u *ScriptUpsertOne) SetConcurrencyLimitAction(v script.ConcurrencyLimitAction) *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetConcurrencyLimitAction(v)
+	})
+}
+
+// UpdateConcurrencyLimitAction sets the "concurrency_limit_action" field to the value that was provided on create.
+func (u *ScriptUpsertOne) UpdateConcurrencyLimitAction() *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateConcurrencyLimitAction()
+	})
+}
+
+// SetParameters sets the "parameters" field.
+func (u *ScriptUpsertOne) SetParameters(v []schema.ScriptParameter) *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetParameters(v)
+	})
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ScriptUpsertOne) UpdateParameters() *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateParameters()
+	})
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *ScriptUpsertOne) ClearParameters() *ScriptUpsertOne {
+	return u.Update(func(s *ScriptUpsert) {
+		s.ClearParameters()
+	})
+}
+
 // Exec executes the query.
 func (u *ScriptUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1291,6 +1572,104 @@ func (u *ScriptUpsertBulk) UpdateEnabled() *ScriptUpsertBulk {
 	})
 }
 
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ScriptUpsertBulk) SetTimeoutSeconds(v int) *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetTimeoutSeconds(v)
+	})
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ScriptUpsertBulk) AddTimeoutSeconds(v int) *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.AddTimeoutSeconds(v)
+	})
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ScriptUpsertBulk) UpdateTimeoutSeconds() *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateTimeoutSeconds()
+	})
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ScriptUpsertBulk) ClearTimeoutSeconds() *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.ClearTimeoutSeconds()
+	})
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *ScriptUpsertBulk) SetRetryPolicy(v *schema.RetryPolicy) *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *ScriptUpsertBulk) UpdateRetryPolicy() *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *ScriptUpsertBulk) ClearRetryPolicy() *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
+// SetSingleton sets the "singleton" field.
+func (u *ScriptUpsertBulk) SetSingleton(v bool) *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetSingleton(v)
+	})
+}
+
+// UpdateSingleton sets the "singleton" field to the value that was provided on create.
+func (u *ScriptUpsertBulk) UpdateSingleton() *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateSingleton()
+	})
+}
+
+// SetConcurrencyLimitAction sets the "concurrency_limit_action" field.
+func (u *ScriptUpsertBulk) SetConcurrencyLimitAction(v script.ConcurrencyLimitAction) *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetConcurrencyLimitAction(v)
+	})
+}
+
+// UpdateConcurrencyLimitAction sets the "concurrency_limit_action" field to the value that was provided on create.
+func (u *ScriptUpsertBulk) UpdateConcurrencyLimitAction() *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateConcurrencyLimitAction()
+	})
+}
+
+// SetParameters sets the "parameters" field.
+func (u *ScriptUpsertBulk) SetParameters(v []schema.ScriptParameter) *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.SetParameters(v)
+	})
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ScriptUpsertBulk) UpdateParameters() *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.UpdateParameters()
+	})
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *ScriptUpsertBulk) ClearParameters() *ScriptUpsertBulk {
+	return u.Update(func(s *ScriptUpsert) {
+		s.ClearParameters()
+	})
+}
+
 // Exec executes the query.
 func (u *ScriptUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
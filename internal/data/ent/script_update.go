@@ -10,8 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
 )
 
@@ -234,6 +236,91 @@ func (_u *ScriptUpdate) SetNillableEnabled(v *bool) *ScriptUpdate {
 	return _u
 }
 
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_u *ScriptUpdate) SetTimeoutSeconds(v int) *ScriptUpdate {
+	_u.mutation.ResetTimeoutSeconds()
+	_u.mutation.SetTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_u *ScriptUpdate) SetNillableTimeoutSeconds(v *int) *ScriptUpdate {
+	if v != nil {
+		_u.SetTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeoutSeconds adds value to the "timeout_seconds" field.
+func (_u *ScriptUpdate) AddTimeoutSeconds(v int) *ScriptUpdate {
+	_u.mutation.AddTimeoutSeconds(v)
+	return _u
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (_u *ScriptUpdate) ClearTimeoutSeconds() *ScriptUpdate {
+	_u.mutation.ClearTimeoutSeconds()
+	return _u
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *ScriptUpdate) SetRetryPolicy(v *schema.RetryPolicy) *ScriptUpdate {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *ScriptUpdate) ClearRetryPolicy() *ScriptUpdate {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
+// SetSingleton sets the "singleton" field.
+func (_u *ScriptUpdate) SetSingleton(v bool) *ScriptUpdate {
+	_u.mutation.SetSingleton(v)
+	return _u
+}
+
+// SetNillableSingleton sets the "singleton" field if the given value is not nil.
+func (_u *ScriptUpdate) SetNillableSingleton(v *bool) *ScriptUpdate {
+	if v != nil {
+		_u.SetSingleton(*v)
+	}
+	return _u
+}
+
+// SetConcurrencyLimitAction sets the "concurrency_limit_action" field.
+func (_u *ScriptUpdate) SetConcurrencyLimitAction(v script.ConcurrencyLimitAction) *ScriptUpdate {
+	_u.mutation.SetConcurrencyLimitAction(v)
+	return _u
+}
+
+// SetNillableConcurrencyLimitAction sets the "concurrency_limit_action" field if the given value is not nil.
+func (_u *ScriptUpdate) SetNillableConcurrencyLimitAction(v *script.ConcurrencyLimitAction) *ScriptUpdate {
+	if v != nil {
+		_u.SetConcurrencyLimitAction(*v)
+	}
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *ScriptUpdate) SetParameters(v []schema.ScriptParameter) *ScriptUpdate {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// AppendParameters appends value to the "parameters" field.
+func (_u *ScriptUpdate) AppendParameters(v []schema.ScriptParameter) *ScriptUpdate {
+	_u.mutation.AppendParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *ScriptUpdate) ClearParameters() *ScriptUpdate {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
 // Mutation returns the ScriptMutation object of the builder.
 func (_u *ScriptUpdate) Mutation() *ScriptMutation {
 	return _u.mutation
@@ -288,6 +375,16 @@ func (_u *ScriptUpdate) check() error {
 			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "Script.content_hash": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TimeoutSeconds(); ok {
+		if err := script.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "Script.timeout_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConcurrencyLimitAction(); ok {
+		if err := script.ConcurrencyLimitActionValidator(v); err != nil {
+			return &ValidationError{Name: "concurrency_limit_action", err: fmt.Errorf(`ent: validator failed for field "Script.concurrency_limit_action": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -372,6 +469,38 @@ func (_u *ScriptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(script.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(script.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(script.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.TimeoutSecondsCleared() {
+		_spec.ClearField(script.FieldTimeoutSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(script.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(script.FieldRetryPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Singleton(); ok {
+		_spec.SetField(script.FieldSingleton, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ConcurrencyLimitAction(); ok {
+		_spec.SetField(script.FieldConcurrencyLimitAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(script.FieldParameters, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParameters(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, script.FieldParameters, value)
+		})
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(script.FieldParameters, field.TypeJSON)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -599,6 +728,91 @@ func (_u *ScriptUpdateOne) SetNillableEnabled(v *bool) *ScriptUpdateOne {
 	return _u
 }
 
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_u *ScriptUpdateOne) SetTimeoutSeconds(v int) *ScriptUpdateOne {
+	_u.mutation.ResetTimeoutSeconds()
+	_u.mutation.SetTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_u *ScriptUpdateOne) SetNillableTimeoutSeconds(v *int) *ScriptUpdateOne {
+	if v != nil {
+		_u.SetTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeoutSeconds adds value to the "timeout_seconds" field.
+func (_u *ScriptUpdateOne) AddTimeoutSeconds(v int) *ScriptUpdateOne {
+	_u.mutation.AddTimeoutSeconds(v)
+	return _u
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (_u *ScriptUpdateOne) ClearTimeoutSeconds() *ScriptUpdateOne {
+	_u.mutation.ClearTimeoutSeconds()
+	return _u
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *ScriptUpdateOne) SetRetryPolicy(v *schema.RetryPolicy) *ScriptUpdateOne {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *ScriptUpdateOne) ClearRetryPolicy() *ScriptUpdateOne {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
+// SetSingleton sets the "singleton" field.
+func (_u *ScriptUpdateOne) SetSingleton(v bool) *ScriptUpdateOne {
+	_u.mutation.SetSingleton(v)
+	return _u
+}
+
+// SetNillableSingleton sets the "singleton" field if the given value is not nil.
+func (_u *ScriptUpdateOne) SetNillableSingleton(v *bool) *ScriptUpdateOne {
+	if v != nil {
+		_u.SetSingleton(*v)
+	}
+	return _u
+}
+
+// SetConcurrencyLimitAction sets the "concurrency_limit_action" field.
+func (_u *ScriptUpdateOne) SetConcurrencyLimitAction(v script.ConcurrencyLimitAction) *ScriptUpdateOne {
+	_u.mutation.SetConcurrencyLimitAction(v)
+	return _u
+}
+
+// SetNillableConcurrencyLimitAction sets the "concurrency_limit_action" field if the given value is not nil.
+func (_u *ScriptUpdateOne) SetNillableConcurrencyLimitAction(v *script.ConcurrencyLimitAction) *ScriptUpdateOne {
+	if v != nil {
+		_u.SetConcurrencyLimitAction(*v)
+	}
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *ScriptUpdateOne) SetParameters(v []schema.ScriptParameter) *ScriptUpdateOne {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// AppendParameters appends value to the "parameters" field.
+func (_u *ScriptUpdateOne) AppendParameters(v []schema.ScriptParameter) *ScriptUpdateOne {
+	_u.mutation.AppendParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *ScriptUpdateOne) ClearParameters() *ScriptUpdateOne {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
 // Mutation returns the ScriptMutation object of the builder.
 func (_u *ScriptUpdateOne) Mutation() *ScriptMutation {
 	return _u.mutation
@@ -666,6 +880,16 @@ func (_u *ScriptUpdateOne) check() error {
 			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "Script.content_hash": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TimeoutSeconds(); ok {
+		if err := script.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "Script.timeout_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConcurrencyLimitAction(); ok {
+		if err := script.ConcurrencyLimitActionValidator(v); err != nil {
+			return &ValidationError{Name: "concurrency_limit_action", err: fmt.Errorf(`ent: validator failed for field "Script.concurrency_limit_action": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -767,6 +991,38 @@ func (_u *ScriptUpdateOne) sqlSave(ctx context.Context) (_node *Script, err erro
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(script.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(script.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(script.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.TimeoutSecondsCleared() {
+		_spec.ClearField(script.FieldTimeoutSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(script.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(script.FieldRetryPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Singleton(); ok {
+		_spec.SetField(script.FieldSingleton, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ConcurrencyLimitAction(); ok {
+		_spec.SetField(script.FieldConcurrencyLimitAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(script.FieldParameters, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParameters(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, script.FieldParameters, value)
+		})
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(script.FieldParameters, field.TypeJSON)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Script{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -30,8 +31,16 @@ type ScriptAssignment struct {
 	TenantID *uint32 `json:"tenant_id,omitempty"`
 	// FK to executor_scripts
 	ScriptID string `json:"script_id,omitempty"`
-	// mTLS client CN
-	ClientID     string `json:"client_id,omitempty"`
+	// What the assignment targets
+	TargetType scriptassignment.TargetType `json:"target_type,omitempty"`
+	// mTLS client CN, for CLIENT assignments
+	ClientID string `json:"client_id,omitempty"`
+	// Label selector, for SELECTOR assignments
+	Selector string `json:"selector,omitempty"`
+	// FK to executor_client_groups, for GROUP assignments
+	GroupID string `json:"group_id,omitempty"`
+	// Values of the script's parameters for the targeted clients
+	Parameters   map[string]string `json:"parameters,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -40,9 +49,11 @@ func (*ScriptAssignment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case scriptassignment.FieldParameters:
+			values[i] = new([]byte)
 		case scriptassignment.FieldCreateBy, scriptassignment.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case scriptassignment.FieldID, scriptassignment.FieldScriptID, scriptassignment.FieldClientID:
+		case scriptassignment.FieldID, scriptassignment.FieldScriptID, scriptassignment.FieldTargetType, scriptassignment.FieldClientID, scriptassignment.FieldSelector, scriptassignment.FieldGroupID:
 			values[i] = new(sql.NullString)
 		case scriptassignment.FieldCreateTime, scriptassignment.FieldUpdateTime, scriptassignment.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
@@ -108,12 +119,38 @@ func (_m *ScriptAssignment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ScriptID = value.String
 			}
+		case scriptassignment.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				_m.TargetType = scriptassignment.TargetType(value.String)
+			}
 		case scriptassignment.FieldClientID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field client_id", values[i])
 			} else if value.Valid {
 				_m.ClientID = value.String
 			}
+		case scriptassignment.FieldSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selector", values[i])
+			} else if value.Valid {
+				_m.Selector = value.String
+			}
+		case scriptassignment.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case scriptassignment.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -178,8 +215,20 @@ func (_m *ScriptAssignment) String() string {
 	builder.WriteString("script_id=")
 	builder.WriteString(_m.ScriptID)
 	builder.WriteString(", ")
+	builder.WriteString("target_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetType))
+	builder.WriteString(", ")
 	builder.WriteString("client_id=")
 	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("selector=")
+	builder.WriteString(_m.Selector)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Parameters))
 	builder.WriteByte(')')
 	return builder.String()
 }
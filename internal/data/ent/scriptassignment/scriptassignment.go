@@ -3,6 +3,8 @@
 package scriptassignment
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
@@ -24,8 +26,16 @@ const (
 	FieldTenantID = "tenant_id"
 	// FieldScriptID holds the string denoting the script_id field in the database.
 	FieldScriptID = "script_id"
+	// FieldTargetType holds the string denoting the target_type field in the database.
+	FieldTargetType = "target_type"
 	// FieldClientID holds the string denoting the client_id field in the database.
 	FieldClientID = "client_id"
+	// FieldSelector holds the string denoting the selector field in the database.
+	FieldSelector = "selector"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldParameters holds the string denoting the parameters field in the database.
+	FieldParameters = "parameters"
 	// Table holds the table name of the scriptassignment in the database.
 	Table = "executor_script_assignments"
 )
@@ -39,7 +49,11 @@ var Columns = []string{
 	FieldDeleteTime,
 	FieldTenantID,
 	FieldScriptID,
+	FieldTargetType,
 	FieldClientID,
+	FieldSelector,
+	FieldGroupID,
+	FieldParameters,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -64,12 +78,49 @@ var (
 	DefaultTenantID uint32
 	// ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
 	ScriptIDValidator func(string) error
+	// DefaultClientID holds the default value on creation for the "client_id" field.
+	DefaultClientID string
 	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
 	ClientIDValidator func(string) error
+	// DefaultSelector holds the default value on creation for the "selector" field.
+	DefaultSelector string
+	// SelectorValidator is a validator for the "selector" field. It is called by the builders before save.
+	SelectorValidator func(string) error
+	// DefaultGroupID holds the default value on creation for the "group_id" field.
+	DefaultGroupID string
+	// GroupIDValidator is a validator for the "group_id" field. It is called by the builders before save.
+	GroupIDValidator func(string) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
 
+// TargetType defines the type for the "target_type" enum field.
+type TargetType string
+
+// TargetTypeCLIENT is the default value of the TargetType enum.
+const DefaultTargetType = TargetTypeCLIENT
+
+// TargetType values.
+const (
+	TargetTypeCLIENT   TargetType = "CLIENT"
+	TargetTypeSELECTOR TargetType = "SELECTOR"
+	TargetTypeGROUP    TargetType = "GROUP"
+)
+
+func (tt TargetType) String() string {
+	return string(tt)
+}
+
+// TargetTypeValidator is a validator for the "target_type" field enum values. It is called by the builders before save.
+func TargetTypeValidator(tt TargetType) error {
+	switch tt {
+	case TargetTypeCLIENT, TargetTypeSELECTOR, TargetTypeGROUP:
+		return nil
+	default:
+		return fmt.Errorf("scriptassignment: invalid enum value for target_type field: %q", tt)
+	}
+}
+
 // OrderOption defines the ordering options for the ScriptAssignment queries.
 type OrderOption func(*sql.Selector)
 
@@ -108,7 +159,22 @@ func ByScriptID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScriptID, opts...).ToFunc()
 }
 
+// ByTargetType orders the results by the target_type field.
+func ByTargetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetType, opts...).ToFunc()
+}
+
 // ByClientID orders the results by the client_id field.
 func ByClientID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClientID, opts...).ToFunc()
 }
+
+// BySelector orders the results by the selector field.
+func BySelector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelector, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
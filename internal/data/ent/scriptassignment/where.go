@@ -99,6 +99,16 @@ func ClientID(v string) predicate.ScriptAssignment {
 	return predicate.ScriptAssignment(sql.FieldEQ(FieldClientID, v))
 }
 
+// Selector applies equality check predicate on the "selector" field. It's identical to SelectorEQ.
+func Selector(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldEQ(FieldSelector, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldEQ(FieldGroupID, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.ScriptAssignment {
 	return predicate.ScriptAssignment(sql.FieldEQ(FieldCreateBy, v))
@@ -414,6 +424,26 @@ func ScriptIDContainsFold(v string) predicate.ScriptAssignment {
 	return predicate.ScriptAssignment(sql.FieldContainsFold(FieldScriptID, v))
 }
 
+// TargetTypeEQ applies the EQ predicate on the "target_type" field.
+func TargetTypeEQ(v TargetType) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetTypeNEQ applies the NEQ predicate on the "target_type" field.
+func TargetTypeNEQ(v TargetType) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNEQ(FieldTargetType, v))
+}
+
+// TargetTypeIn applies the In predicate on the "target_type" field.
+func TargetTypeIn(vs ...TargetType) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldIn(FieldTargetType, vs...))
+}
+
+// TargetTypeNotIn applies the NotIn predicate on the "target_type" field.
+func TargetTypeNotIn(vs ...TargetType) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNotIn(FieldTargetType, vs...))
+}
+
 // ClientIDEQ applies the EQ predicate on the "client_id" field.
 func ClientIDEQ(v string) predicate.ScriptAssignment {
 	return predicate.ScriptAssignment(sql.FieldEQ(FieldClientID, v))
@@ -469,6 +499,16 @@ func ClientIDHasSuffix(v string) predicate.ScriptAssignment {
 	return predicate.ScriptAssignment(sql.FieldHasSuffix(FieldClientID, v))
 }
 
+// ClientIDIsNil applies the IsNil predicate on the "client_id" field.
+func ClientIDIsNil() predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldIsNull(FieldClientID))
+}
+
+// ClientIDNotNil applies the NotNil predicate on the "client_id" field.
+func ClientIDNotNil() predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNotNull(FieldClientID))
+}
+
 // ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
 func ClientIDEqualFold(v string) predicate.ScriptAssignment {
 	return predicate.ScriptAssignment(sql.FieldEqualFold(FieldClientID, v))
@@ -479,6 +519,166 @@ func ClientIDContainsFold(v string) predicate.ScriptAssignment {
 	return predicate.ScriptAssignment(sql.FieldContainsFold(FieldClientID, v))
 }
 
+// SelectorEQ applies the EQ predicate on the "selector" field.
+func SelectorEQ(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldEQ(FieldSelector, v))
+}
+
+// SelectorNEQ applies the NEQ predicate on the "selector" field.
+func SelectorNEQ(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNEQ(FieldSelector, v))
+}
+
+// SelectorIn applies the In predicate on the "selector" field.
+func SelectorIn(vs ...string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldIn(FieldSelector, vs...))
+}
+
+// SelectorNotIn applies the NotIn predicate on the "selector" field.
+func SelectorNotIn(vs ...string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNotIn(FieldSelector, vs...))
+}
+
+// SelectorGT applies the GT predicate on the "selector" field.
+func SelectorGT(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldGT(FieldSelector, v))
+}
+
+// SelectorGTE applies the GTE predicate on the "selector" field.
+func SelectorGTE(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldGTE(FieldSelector, v))
+}
+
+// SelectorLT applies the LT predicate on the "selector" field.
+func SelectorLT(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldLT(FieldSelector, v))
+}
+
+// SelectorLTE applies the LTE predicate on the "selector" field.
+func SelectorLTE(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldLTE(FieldSelector, v))
+}
+
+// SelectorContains applies the Contains predicate on the "selector" field.
+func SelectorContains(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldContains(FieldSelector, v))
+}
+
+// SelectorHasPrefix applies the HasPrefix predicate on the "selector" field.
+func SelectorHasPrefix(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldHasPrefix(FieldSelector, v))
+}
+
+// SelectorHasSuffix applies the HasSuffix predicate on the "selector" field.
+func SelectorHasSuffix(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldHasSuffix(FieldSelector, v))
+}
+
+// SelectorIsNil applies the IsNil predicate on the "selector" field.
+func SelectorIsNil() predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldIsNull(FieldSelector))
+}
+
+// SelectorNotNil applies the NotNil predicate on the "selector" field.
+func SelectorNotNil() predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNotNull(FieldSelector))
+}
+
+// SelectorEqualFold applies the EqualFold predicate on the "selector" field.
+func SelectorEqualFold(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldEqualFold(FieldSelector, v))
+}
+
+// SelectorContainsFold applies the ContainsFold predicate on the "selector" field.
+func SelectorContainsFold(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldContainsFold(FieldSelector, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNotNull(FieldGroupID))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// ParametersIsNil applies the IsNil predicate on the "parameters" field.
+func ParametersIsNil() predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldIsNull(FieldParameters))
+}
+
+// ParametersNotNil applies the NotNil predicate on the "parameters" field.
+func ParametersNotNil() predicate.ScriptAssignment {
+	return predicate.ScriptAssignment(sql.FieldNotNull(FieldParameters))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ScriptAssignment) predicate.ScriptAssignment {
 	return predicate.ScriptAssignment(sql.AndPredicates(predicates...))
@@ -99,12 +99,68 @@ func (_c *ScriptAssignmentCreate) SetScriptID(v string) *ScriptAssignmentCreate
 	return _c
 }
 
+// SetTargetType sets the "target_type" field.
+func (_c *ScriptAssignmentCreate) SetTargetType(v scriptassignment.TargetType) *ScriptAssignmentCreate {
+	_c.mutation.SetTargetType(v)
+	return _c
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_c *ScriptAssignmentCreate) SetNillableTargetType(v *scriptassignment.TargetType) *ScriptAssignmentCreate {
+	if v != nil {
+		_c.SetTargetType(*v)
+	}
+	return _c
+}
+
 // SetClientID sets the "client_id" field.
 func (_c *ScriptAssignmentCreate) SetClientID(v string) *ScriptAssignmentCreate {
 	_c.mutation.SetClientID(v)
 	return _c
 }
 
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_c *ScriptAssignmentCreate) SetNillableClientID(v *string) *ScriptAssignmentCreate {
+	if v != nil {
+		_c.SetClientID(*v)
+	}
+	return _c
+}
+
+// SetSelector sets the "selector" field.
+func (_c *ScriptAssignmentCreate) SetSelector(v string) *ScriptAssignmentCreate {
+	_c.mutation.SetSelector(v)
+	return _c
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_c *ScriptAssignmentCreate) SetNillableSelector(v *string) *ScriptAssignmentCreate {
+	if v != nil {
+		_c.SetSelector(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *ScriptAssignmentCreate) SetGroupID(v string) *ScriptAssignmentCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *ScriptAssignmentCreate) SetNillableGroupID(v *string) *ScriptAssignmentCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetParameters sets the "parameters" field.
+func (_c *ScriptAssignmentCreate) SetParameters(v map[string]string) *ScriptAssignmentCreate {
+	_c.mutation.SetParameters(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ScriptAssignmentCreate) SetID(v string) *ScriptAssignmentCreate {
 	_c.mutation.SetID(v)
@@ -152,6 +208,22 @@ func (_c *ScriptAssignmentCreate) defaults() error {
 		v := scriptassignment.DefaultTenantID
 		_c.mutation.SetTenantID(v)
 	}
+	if _, ok := _c.mutation.TargetType(); !ok {
+		v := scriptassignment.DefaultTargetType
+		_c.mutation.SetTargetType(v)
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		v := scriptassignment.DefaultClientID
+		_c.mutation.SetClientID(v)
+	}
+	if _, ok := _c.mutation.Selector(); !ok {
+		v := scriptassignment.DefaultSelector
+		_c.mutation.SetSelector(v)
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		v := scriptassignment.DefaultGroupID
+		_c.mutation.SetGroupID(v)
+	}
 	return nil
 }
 
@@ -165,14 +237,29 @@ func (_c *ScriptAssignmentCreate) check() error {
 			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.script_id": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.ClientID(); !ok {
-		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "ScriptAssignment.client_id"`)}
+	if _, ok := _c.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "ScriptAssignment.target_type"`)}
+	}
+	if v, ok := _c.mutation.TargetType(); ok {
+		if err := scriptassignment.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.target_type": %w`, err)}
+		}
 	}
 	if v, ok := _c.mutation.ClientID(); ok {
 		if err := scriptassignment.ClientIDValidator(v); err != nil {
 			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.client_id": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Selector(); ok {
+		if err := scriptassignment.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.selector": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupID(); ok {
+		if err := scriptassignment.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.group_id": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := scriptassignment.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.id": %w`, err)}
@@ -238,10 +325,26 @@ func (_c *ScriptAssignmentCreate) createSpec() (*ScriptAssignment, *sqlgraph.Cre
 		_spec.SetField(scriptassignment.FieldScriptID, field.TypeString, value)
 		_node.ScriptID = value
 	}
+	if value, ok := _c.mutation.TargetType(); ok {
+		_spec.SetField(scriptassignment.FieldTargetType, field.TypeEnum, value)
+		_node.TargetType = value
+	}
 	if value, ok := _c.mutation.ClientID(); ok {
 		_spec.SetField(scriptassignment.FieldClientID, field.TypeString, value)
 		_node.ClientID = value
 	}
+	if value, ok := _c.mutation.Selector(); ok {
+		_spec.SetField(scriptassignment.FieldSelector, field.TypeString, value)
+		_node.Selector = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(scriptassignment.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.Parameters(); ok {
+		_spec.SetField(scriptassignment.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
 	return _node, _spec
 }
 
@@ -366,6 +469,18 @@ func (u *ScriptAssignmentUpsert) UpdateScriptID() *ScriptAssignmentUpsert {
 	return u
 }
 
+// SetTargetType sets the "target_type" field.
+func (u *ScriptAssignmentUpsert) SetTargetType(v scriptassignment.TargetType) *ScriptAssignmentUpsert {
+	u.Set(scriptassignment.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsert) UpdateTargetType() *ScriptAssignmentUpsert {
+	u.SetExcluded(scriptassignment.FieldTargetType)
+	return u
+}
+
 // SetClientID sets the "client_id" field.
 func (u *ScriptAssignmentUpsert) SetClientID(v string) *ScriptAssignmentUpsert {
 	u.Set(scriptassignment.FieldClientID, v)
@@ -378,6 +493,66 @@ func (u *ScriptAssignmentUpsert) UpdateClientID() *ScriptAssignmentUpsert {
 	return u
 }
 
+// ClearClientID clears the value of the "client_id" field.
+func (u *ScriptAssignmentUpsert) ClearClientID() *ScriptAssignmentUpsert {
+	u.SetNull(scriptassignment.FieldClientID)
+	return u
+}
+
+// SetSelector sets the "selector" field.
+func (u *ScriptAssignmentUpsert) SetSelector(v string) *ScriptAssignmentUpsert {
+	u.Set(scriptassignment.FieldSelector, v)
+	return u
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsert) UpdateSelector() *ScriptAssignmentUpsert {
+	u.SetExcluded(scriptassignment.FieldSelector)
+	return u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ScriptAssignmentUpsert) ClearSelector() *ScriptAssignmentUpsert {
+	u.SetNull(scriptassignment.FieldSelector)
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *ScriptAssignmentUpsert) SetGroupID(v string) *ScriptAssignmentUpsert {
+	u.Set(scriptassignment.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsert) UpdateGroupID() *ScriptAssignmentUpsert {
+	u.SetExcluded(scriptassignment.FieldGroupID)
+	return u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *ScriptAssignmentUpsert) ClearGroupID() *ScriptAssignmentUpsert {
+	u.SetNull(scriptassignment.FieldGroupID)
+	return u
+}
+
+// SetParameters sets the "parameters" field.
+func (u *ScriptAssignmentUpsert) SetParameters(v map[string]string) *ScriptAssignmentUpsert {
+	u.Set(scriptassignment.FieldParameters, v)
+	return u
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsert) UpdateParameters() *ScriptAssignmentUpsert {
+	u.SetExcluded(scriptassignment.FieldParameters)
+	return u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *ScriptAssignmentUpsert) ClearParameters() *ScriptAssignmentUpsert {
+	u.SetNull(scriptassignment.FieldParameters)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -516,6 +691,20 @@ func (u *ScriptAssignmentUpsertOne) UpdateScriptID() *ScriptAssignmentUpsertOne
 	})
 }
 
+// SetTargetType sets the "target_type" field.
+func (u *ScriptAssignmentUpsertOne) SetTargetType(v scriptassignment.TargetType) *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsertOne) UpdateTargetType() *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
 // SetClientID sets the "client_id" field.
 func (u *ScriptAssignmentUpsertOne) SetClientID(v string) *ScriptAssignmentUpsertOne {
 	return u.Update(func(s *ScriptAssignmentUpsert) {
@@ -530,6 +719,76 @@ func (u *ScriptAssignmentUpsertOne) UpdateClientID() *ScriptAssignmentUpsertOne
 	})
 }
 
+// ClearClientID clears the value of the "client_id" field.
+func (u *ScriptAssignmentUpsertOne) ClearClientID() *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.ClearClientID()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *ScriptAssignmentUpsertOne) SetSelector(v string) *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsertOne) UpdateSelector() *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ScriptAssignmentUpsertOne) ClearSelector() *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *ScriptAssignmentUpsertOne) SetGroupID(v string) *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsertOne) UpdateGroupID() *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *ScriptAssignmentUpsertOne) ClearGroupID() *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetParameters sets the "parameters" field.
+func (u *ScriptAssignmentUpsertOne) SetParameters(v map[string]string) *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.SetParameters(v)
+	})
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsertOne) UpdateParameters() *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.UpdateParameters()
+	})
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *ScriptAssignmentUpsertOne) ClearParameters() *ScriptAssignmentUpsertOne {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.ClearParameters()
+	})
+}
+
 // Exec executes the query.
 func (u *ScriptAssignmentUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -835,6 +1094,20 @@ func (u *ScriptAssignmentUpsertBulk) UpdateScriptID() *ScriptAssignmentUpsertBul
 	})
 }
 
+// SetTargetType sets the "target_type" field.
+func (u *ScriptAssignmentUpsertBulk) SetTargetType(v scriptassignment.TargetType) *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsertBulk) UpdateTargetType() *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
 // SetClientID sets the "client_id" field.
 func (u *ScriptAssignmentUpsertBulk) SetClientID(v string) *ScriptAssignmentUpsertBulk {
 	return u.Update(func(s *ScriptAssignmentUpsert) {
@@ -849,6 +1122,76 @@ func (u *ScriptAssignmentUpsertBulk) UpdateClientID() *ScriptAssignmentUpsertBul
 	})
 }
 
+// ClearClientID clears the value of the "client_id" field.
+func (u *ScriptAssignmentUpsertBulk) ClearClientID() *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.ClearClientID()
+	})
+}
+
+// SetSelector sets the "selector" field.
+func (u *ScriptAssignmentUpsertBulk) SetSelector(v string) *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.SetSelector(v)
+	})
+}
+
+// UpdateSelector sets the "selector" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsertBulk) UpdateSelector() *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.UpdateSelector()
+	})
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (u *ScriptAssignmentUpsertBulk) ClearSelector() *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.ClearSelector()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *ScriptAssignmentUpsertBulk) SetGroupID(v string) *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsertBulk) UpdateGroupID() *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *ScriptAssignmentUpsertBulk) ClearGroupID() *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetParameters sets the "parameters" field.
+func (u *ScriptAssignmentUpsertBulk) SetParameters(v map[string]string) *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.SetParameters(v)
+	})
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *ScriptAssignmentUpsertBulk) UpdateParameters() *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.UpdateParameters()
+	})
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *ScriptAssignmentUpsertBulk) ClearParameters() *ScriptAssignmentUpsertBulk {
+	return u.Update(func(s *ScriptAssignmentUpsert) {
+		s.ClearParameters()
+	})
+}
+
 // Exec executes the query.
 func (u *ScriptAssignmentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -110,6 +110,20 @@ func (_u *ScriptAssignmentUpdate) SetNillableScriptID(v *string) *ScriptAssignme
 	return _u
 }
 
+// SetTargetType sets the "target_type" field.
+func (_u *ScriptAssignmentUpdate) SetTargetType(v scriptassignment.TargetType) *ScriptAssignmentUpdate {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *ScriptAssignmentUpdate) SetNillableTargetType(v *scriptassignment.TargetType) *ScriptAssignmentUpdate {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
 // SetClientID sets the "client_id" field.
 func (_u *ScriptAssignmentUpdate) SetClientID(v string) *ScriptAssignmentUpdate {
 	_u.mutation.SetClientID(v)
@@ -124,6 +138,64 @@ func (_u *ScriptAssignmentUpdate) SetNillableClientID(v *string) *ScriptAssignme
 	return _u
 }
 
+// ClearClientID clears the value of the "client_id" field.
+func (_u *ScriptAssignmentUpdate) ClearClientID() *ScriptAssignmentUpdate {
+	_u.mutation.ClearClientID()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *ScriptAssignmentUpdate) SetSelector(v string) *ScriptAssignmentUpdate {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *ScriptAssignmentUpdate) SetNillableSelector(v *string) *ScriptAssignmentUpdate {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *ScriptAssignmentUpdate) ClearSelector() *ScriptAssignmentUpdate {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ScriptAssignmentUpdate) SetGroupID(v string) *ScriptAssignmentUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ScriptAssignmentUpdate) SetNillableGroupID(v *string) *ScriptAssignmentUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *ScriptAssignmentUpdate) ClearGroupID() *ScriptAssignmentUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *ScriptAssignmentUpdate) SetParameters(v map[string]string) *ScriptAssignmentUpdate {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *ScriptAssignmentUpdate) ClearParameters() *ScriptAssignmentUpdate {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
 // Mutation returns the ScriptAssignmentMutation object of the builder.
 func (_u *ScriptAssignmentUpdate) Mutation() *ScriptAssignmentMutation {
 	return _u.mutation
@@ -163,11 +235,26 @@ func (_u *ScriptAssignmentUpdate) check() error {
 			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.script_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := scriptassignment.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.target_type": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.ClientID(); ok {
 		if err := scriptassignment.ClientIDValidator(v); err != nil {
 			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.client_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := scriptassignment.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.selector": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupID(); ok {
+		if err := scriptassignment.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.group_id": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -219,9 +306,33 @@ func (_u *ScriptAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if value, ok := _u.mutation.ScriptID(); ok {
 		_spec.SetField(scriptassignment.FieldScriptID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(scriptassignment.FieldTargetType, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.ClientID(); ok {
 		_spec.SetField(scriptassignment.FieldClientID, field.TypeString, value)
 	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(scriptassignment.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(scriptassignment.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(scriptassignment.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(scriptassignment.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(scriptassignment.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(scriptassignment.FieldParameters, field.TypeJSON, value)
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(scriptassignment.FieldParameters, field.TypeJSON)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -325,6 +436,20 @@ func (_u *ScriptAssignmentUpdateOne) SetNillableScriptID(v *string) *ScriptAssig
 	return _u
 }
 
+// SetTargetType sets the "target_type" field.
+func (_u *ScriptAssignmentUpdateOne) SetTargetType(v scriptassignment.TargetType) *ScriptAssignmentUpdateOne {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *ScriptAssignmentUpdateOne) SetNillableTargetType(v *scriptassignment.TargetType) *ScriptAssignmentUpdateOne {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
 // SetClientID sets the "client_id" field.
 func (_u *ScriptAssignmentUpdateOne) SetClientID(v string) *ScriptAssignmentUpdateOne {
 	_u.mutation.SetClientID(v)
@@ -339,6 +464,64 @@ func (_u *ScriptAssignmentUpdateOne) SetNillableClientID(v *string) *ScriptAssig
 	return _u
 }
 
+// ClearClientID clears the value of the "client_id" field.
+func (_u *ScriptAssignmentUpdateOne) ClearClientID() *ScriptAssignmentUpdateOne {
+	_u.mutation.ClearClientID()
+	return _u
+}
+
+// SetSelector sets the "selector" field.
+func (_u *ScriptAssignmentUpdateOne) SetSelector(v string) *ScriptAssignmentUpdateOne {
+	_u.mutation.SetSelector(v)
+	return _u
+}
+
+// SetNillableSelector sets the "selector" field if the given value is not nil.
+func (_u *ScriptAssignmentUpdateOne) SetNillableSelector(v *string) *ScriptAssignmentUpdateOne {
+	if v != nil {
+		_u.SetSelector(*v)
+	}
+	return _u
+}
+
+// ClearSelector clears the value of the "selector" field.
+func (_u *ScriptAssignmentUpdateOne) ClearSelector() *ScriptAssignmentUpdateOne {
+	_u.mutation.ClearSelector()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ScriptAssignmentUpdateOne) SetGroupID(v string) *ScriptAssignmentUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ScriptAssignmentUpdateOne) SetNillableGroupID(v *string) *ScriptAssignmentUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *ScriptAssignmentUpdateOne) ClearGroupID() *ScriptAssignmentUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *ScriptAssignmentUpdateOne) SetParameters(v map[string]string) *ScriptAssignmentUpdateOne {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *ScriptAssignmentUpdateOne) ClearParameters() *ScriptAssignmentUpdateOne {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
 // Mutation returns the ScriptAssignmentMutation object of the builder.
 func (_u *ScriptAssignmentUpdateOne) Mutation() *ScriptAssignmentMutation {
 	return _u.mutation
@@ -391,11 +574,26 @@ func (_u *ScriptAssignmentUpdateOne) check() error {
 			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.script_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := scriptassignment.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.target_type": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.ClientID(); ok {
 		if err := scriptassignment.ClientIDValidator(v); err != nil {
 			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.client_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Selector(); ok {
+		if err := scriptassignment.SelectorValidator(v); err != nil {
+			return &ValidationError{Name: "selector", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.selector": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupID(); ok {
+		if err := scriptassignment.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "ScriptAssignment.group_id": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -464,9 +662,33 @@ func (_u *ScriptAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *Script
 	if value, ok := _u.mutation.ScriptID(); ok {
 		_spec.SetField(scriptassignment.FieldScriptID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(scriptassignment.FieldTargetType, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.ClientID(); ok {
 		_spec.SetField(scriptassignment.FieldClientID, field.TypeString, value)
 	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(scriptassignment.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Selector(); ok {
+		_spec.SetField(scriptassignment.FieldSelector, field.TypeString, value)
+	}
+	if _u.mutation.SelectorCleared() {
+		_spec.ClearField(scriptassignment.FieldSelector, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(scriptassignment.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(scriptassignment.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(scriptassignment.FieldParameters, field.TypeJSON, value)
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(scriptassignment.FieldParameters, field.TypeJSON)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &ScriptAssignment{config: _u.config}
 	_spec.Assign = _node.assignValues
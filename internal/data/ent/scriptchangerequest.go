@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptchangerequest"
+)
+
+// ScriptChangeRequest is the model entity for the ScriptChangeRequest schema.
+type ScriptChangeRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// FK to executor_scripts
+	ScriptID string `json:"script_id,omitempty"`
+	// Script version the change was made against
+	BaseVersion int `json:"base_version,omitempty"`
+	// Proposed script body content
+	Content string `json:"content,omitempty"`
+	// SHA256 hex digest of the proposed content
+	ContentHash string `json:"content_hash,omitempty"`
+	// Why the content is changed
+	ChangeNote string `json:"change_note,omitempty"`
+	// Version whose content a rollback restores
+	RestoredFromVersion *int `json:"restored_from_version,omitempty"`
+	// Review state of the change
+	Status scriptchangerequest.Status `json:"status,omitempty"`
+	// User who approved or rejected the change
+	ReviewedBy *uint32 `json:"reviewed_by,omitempty"`
+	// When the change was approved or rejected
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Comment left by the approver
+	ReviewComment string `json:"review_comment,omitempty"`
+	// Why the change was rejected
+	RejectionReason string `json:"rejection_reason,omitempty"`
+	// Script version created when the change was approved
+	AppliedVersion *int `json:"applied_version,omitempty"`
+	// Discussion of the change, oldest first
+	Comments     []schema.ScriptChangeComment `json:"comments,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScriptChangeRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scriptchangerequest.FieldComments:
+			values[i] = new([]byte)
+		case scriptchangerequest.FieldCreateBy, scriptchangerequest.FieldUpdateBy, scriptchangerequest.FieldTenantID, scriptchangerequest.FieldBaseVersion, scriptchangerequest.FieldRestoredFromVersion, scriptchangerequest.FieldReviewedBy, scriptchangerequest.FieldAppliedVersion:
+			values[i] = new(sql.NullInt64)
+		case scriptchangerequest.FieldID, scriptchangerequest.FieldScriptID, scriptchangerequest.FieldContent, scriptchangerequest.FieldContentHash, scriptchangerequest.FieldChangeNote, scriptchangerequest.FieldStatus, scriptchangerequest.FieldReviewComment, scriptchangerequest.FieldRejectionReason:
+			values[i] = new(sql.NullString)
+		case scriptchangerequest.FieldCreateTime, scriptchangerequest.FieldUpdateTime, scriptchangerequest.FieldDeleteTime, scriptchangerequest.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScriptChangeRequest fields.
+func (_m *ScriptChangeRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scriptchangerequest.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case scriptchangerequest.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case scriptchangerequest.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case scriptchangerequest.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case scriptchangerequest.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case scriptchangerequest.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case scriptchangerequest.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case scriptchangerequest.FieldScriptID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field script_id", values[i])
+			} else if value.Valid {
+				_m.ScriptID = value.String
+			}
+		case scriptchangerequest.FieldBaseVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field base_version", values[i])
+			} else if value.Valid {
+				_m.BaseVersion = int(value.Int64)
+			}
+		case scriptchangerequest.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case scriptchangerequest.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = value.String
+			}
+		case scriptchangerequest.FieldChangeNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field change_note", values[i])
+			} else if value.Valid {
+				_m.ChangeNote = value.String
+			}
+		case scriptchangerequest.FieldRestoredFromVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_from_version", values[i])
+			} else if value.Valid {
+				_m.RestoredFromVersion = new(int)
+				*_m.RestoredFromVersion = int(value.Int64)
+			}
+		case scriptchangerequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = scriptchangerequest.Status(value.String)
+			}
+		case scriptchangerequest.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = new(uint32)
+				*_m.ReviewedBy = uint32(value.Int64)
+			}
+		case scriptchangerequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case scriptchangerequest.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				_m.ReviewComment = value.String
+			}
+		case scriptchangerequest.FieldRejectionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reason", values[i])
+			} else if value.Valid {
+				_m.RejectionReason = value.String
+			}
+		case scriptchangerequest.FieldAppliedVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_version", values[i])
+			} else if value.Valid {
+				_m.AppliedVersion = new(int)
+				*_m.AppliedVersion = int(value.Int64)
+			}
+		case scriptchangerequest.FieldComments:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field comments", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Comments); err != nil {
+					return fmt.Errorf("unmarshal field comments: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScriptChangeRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScriptChangeRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScriptChangeRequest.
+// Note that you need to call ScriptChangeRequest.Unwrap() before calling this method if this ScriptChangeRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScriptChangeRequest) Update() *ScriptChangeRequestUpdateOne {
+	return NewScriptChangeRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScriptChangeRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScriptChangeRequest) Unwrap() *ScriptChangeRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScriptChangeRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScriptChangeRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScriptChangeRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("script_id=")
+	builder.WriteString(_m.ScriptID)
+	builder.WriteString(", ")
+	builder.WriteString("base_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BaseVersion))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(_m.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("change_note=")
+	builder.WriteString(_m.ChangeNote)
+	builder.WriteString(", ")
+	if v := _m.RestoredFromVersion; v != nil {
+		builder.WriteString("restored_from_version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_comment=")
+	builder.WriteString(_m.ReviewComment)
+	builder.WriteString(", ")
+	builder.WriteString("rejection_reason=")
+	builder.WriteString(_m.RejectionReason)
+	builder.WriteString(", ")
+	if v := _m.AppliedVersion; v != nil {
+		builder.WriteString("applied_version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("comments=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Comments))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScriptChangeRequests is a parsable slice of ScriptChangeRequest.
+type ScriptChangeRequests []*ScriptChangeRequest
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scriptchangerequest
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the scriptchangerequest type in the database.
+	Label = "script_change_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldUpdateBy holds the string denoting the update_by field in the database.
+	FieldUpdateBy = "update_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldScriptID holds the string denoting the script_id field in the database.
+	FieldScriptID = "script_id"
+	// FieldBaseVersion holds the string denoting the base_version field in the database.
+	FieldBaseVersion = "base_version"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldChangeNote holds the string denoting the change_note field in the database.
+	FieldChangeNote = "change_note"
+	// FieldRestoredFromVersion holds the string denoting the restored_from_version field in the database.
+	FieldRestoredFromVersion = "restored_from_version"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldReviewComment holds the string denoting the review_comment field in the database.
+	FieldReviewComment = "review_comment"
+	// FieldRejectionReason holds the string denoting the rejection_reason field in the database.
+	FieldRejectionReason = "rejection_reason"
+	// FieldAppliedVersion holds the string denoting the applied_version field in the database.
+	FieldAppliedVersion = "applied_version"
+	// FieldComments holds the string denoting the comments field in the database.
+	FieldComments = "comments"
+	// Table holds the table name of the scriptchangerequest in the database.
+	Table = "executor_script_change_requests"
+)
+
+// Columns holds all SQL columns for scriptchangerequest fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldUpdateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldScriptID,
+	FieldBaseVersion,
+	FieldContent,
+	FieldContentHash,
+	FieldChangeNote,
+	FieldRestoredFromVersion,
+	FieldStatus,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldReviewComment,
+	FieldRejectionReason,
+	FieldAppliedVersion,
+	FieldComments,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-executor/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// ScriptIDValidator is a validator for the "script_id" field. It is called by the builders before save.
+	ScriptIDValidator func(string) error
+	// BaseVersionValidator is a validator for the "base_version" field. It is called by the builders before save.
+	BaseVersionValidator func(int) error
+	// ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	ContentHashValidator func(string) error
+	// ChangeNoteValidator is a validator for the "change_note" field. It is called by the builders before save.
+	ChangeNoteValidator func(string) error
+	// ReviewCommentValidator is a validator for the "review_comment" field. It is called by the builders before save.
+	ReviewCommentValidator func(string) error
+	// RejectionReasonValidator is a validator for the "rejection_reason" field. It is called by the builders before save.
+	RejectionReasonValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPENDING is the default value of the Status enum.
+const DefaultStatus = StatusPENDING
+
+// Status values.
+const (
+	StatusPENDING   Status = "PENDING"
+	StatusAPPROVED  Status = "APPROVED"
+	StatusREJECTED  Status = "REJECTED"
+	StatusCANCELLED Status = "CANCELLED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPENDING, StatusAPPROVED, StatusREJECTED, StatusCANCELLED:
+		return nil
+	default:
+		return fmt.Errorf("scriptchangerequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ScriptChangeRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByUpdateBy orders the results by the update_by field.
+func ByUpdateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByScriptID orders the results by the script_id field.
+func ByScriptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScriptID, opts...).ToFunc()
+}
+
+// ByBaseVersion orders the results by the base_version field.
+func ByBaseVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseVersion, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByChangeNote orders the results by the change_note field.
+func ByChangeNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangeNote, opts...).ToFunc()
+}
+
+// ByRestoredFromVersion orders the results by the restored_from_version field.
+func ByRestoredFromVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestoredFromVersion, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByReviewComment orders the results by the review_comment field.
+func ByReviewComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewComment, opts...).ToFunc()
+}
+
+// ByRejectionReason orders the results by the rejection_reason field.
+func ByRejectionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectionReason, opts...).ToFunc()
+}
+
+// ByAppliedVersion orders the results by the applied_version field.
+func ByAppliedVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedVersion, opts...).ToFunc()
+}
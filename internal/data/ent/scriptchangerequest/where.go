@@ -0,0 +1,1155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scriptchangerequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ScriptID applies equality check predicate on the "script_id" field. It's identical to ScriptIDEQ.
+func ScriptID(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldScriptID, v))
+}
+
+// BaseVersion applies equality check predicate on the "base_version" field. It's identical to BaseVersionEQ.
+func BaseVersion(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldBaseVersion, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ChangeNote applies equality check predicate on the "change_note" field. It's identical to ChangeNoteEQ.
+func ChangeNote(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldChangeNote, v))
+}
+
+// RestoredFromVersion applies equality check predicate on the "restored_from_version" field. It's identical to RestoredFromVersionEQ.
+func RestoredFromVersion(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldRestoredFromVersion, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// RejectionReason applies equality check predicate on the "rejection_reason" field. It's identical to RejectionReasonEQ.
+func RejectionReason(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// AppliedVersion applies equality check predicate on the "applied_version" field. It's identical to AppliedVersionEQ.
+func AppliedVersion(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldAppliedVersion, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldTenantID))
+}
+
+// ScriptIDEQ applies the EQ predicate on the "script_id" field.
+func ScriptIDEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldScriptID, v))
+}
+
+// ScriptIDNEQ applies the NEQ predicate on the "script_id" field.
+func ScriptIDNEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldScriptID, v))
+}
+
+// ScriptIDIn applies the In predicate on the "script_id" field.
+func ScriptIDIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldScriptID, vs...))
+}
+
+// ScriptIDNotIn applies the NotIn predicate on the "script_id" field.
+func ScriptIDNotIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldScriptID, vs...))
+}
+
+// ScriptIDGT applies the GT predicate on the "script_id" field.
+func ScriptIDGT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldScriptID, v))
+}
+
+// ScriptIDGTE applies the GTE predicate on the "script_id" field.
+func ScriptIDGTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldScriptID, v))
+}
+
+// ScriptIDLT applies the LT predicate on the "script_id" field.
+func ScriptIDLT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldScriptID, v))
+}
+
+// ScriptIDLTE applies the LTE predicate on the "script_id" field.
+func ScriptIDLTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldScriptID, v))
+}
+
+// ScriptIDContains applies the Contains predicate on the "script_id" field.
+func ScriptIDContains(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContains(FieldScriptID, v))
+}
+
+// ScriptIDHasPrefix applies the HasPrefix predicate on the "script_id" field.
+func ScriptIDHasPrefix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasPrefix(FieldScriptID, v))
+}
+
+// ScriptIDHasSuffix applies the HasSuffix predicate on the "script_id" field.
+func ScriptIDHasSuffix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasSuffix(FieldScriptID, v))
+}
+
+// ScriptIDEqualFold applies the EqualFold predicate on the "script_id" field.
+func ScriptIDEqualFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEqualFold(FieldScriptID, v))
+}
+
+// ScriptIDContainsFold applies the ContainsFold predicate on the "script_id" field.
+func ScriptIDContainsFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContainsFold(FieldScriptID, v))
+}
+
+// BaseVersionEQ applies the EQ predicate on the "base_version" field.
+func BaseVersionEQ(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldBaseVersion, v))
+}
+
+// BaseVersionNEQ applies the NEQ predicate on the "base_version" field.
+func BaseVersionNEQ(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldBaseVersion, v))
+}
+
+// BaseVersionIn applies the In predicate on the "base_version" field.
+func BaseVersionIn(vs ...int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldBaseVersion, vs...))
+}
+
+// BaseVersionNotIn applies the NotIn predicate on the "base_version" field.
+func BaseVersionNotIn(vs ...int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldBaseVersion, vs...))
+}
+
+// BaseVersionGT applies the GT predicate on the "base_version" field.
+func BaseVersionGT(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldBaseVersion, v))
+}
+
+// BaseVersionGTE applies the GTE predicate on the "base_version" field.
+func BaseVersionGTE(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldBaseVersion, v))
+}
+
+// BaseVersionLT applies the LT predicate on the "base_version" field.
+func BaseVersionLT(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldBaseVersion, v))
+}
+
+// BaseVersionLTE applies the LTE predicate on the "base_version" field.
+func BaseVersionLTE(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldBaseVersion, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// ChangeNoteEQ applies the EQ predicate on the "change_note" field.
+func ChangeNoteEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldChangeNote, v))
+}
+
+// ChangeNoteNEQ applies the NEQ predicate on the "change_note" field.
+func ChangeNoteNEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldChangeNote, v))
+}
+
+// ChangeNoteIn applies the In predicate on the "change_note" field.
+func ChangeNoteIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldChangeNote, vs...))
+}
+
+// ChangeNoteNotIn applies the NotIn predicate on the "change_note" field.
+func ChangeNoteNotIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldChangeNote, vs...))
+}
+
+// ChangeNoteGT applies the GT predicate on the "change_note" field.
+func ChangeNoteGT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldChangeNote, v))
+}
+
+// ChangeNoteGTE applies the GTE predicate on the "change_note" field.
+func ChangeNoteGTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldChangeNote, v))
+}
+
+// ChangeNoteLT applies the LT predicate on the "change_note" field.
+func ChangeNoteLT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldChangeNote, v))
+}
+
+// ChangeNoteLTE applies the LTE predicate on the "change_note" field.
+func ChangeNoteLTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldChangeNote, v))
+}
+
+// ChangeNoteContains applies the Contains predicate on the "change_note" field.
+func ChangeNoteContains(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContains(FieldChangeNote, v))
+}
+
+// ChangeNoteHasPrefix applies the HasPrefix predicate on the "change_note" field.
+func ChangeNoteHasPrefix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasPrefix(FieldChangeNote, v))
+}
+
+// ChangeNoteHasSuffix applies the HasSuffix predicate on the "change_note" field.
+func ChangeNoteHasSuffix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasSuffix(FieldChangeNote, v))
+}
+
+// ChangeNoteIsNil applies the IsNil predicate on the "change_note" field.
+func ChangeNoteIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldChangeNote))
+}
+
+// ChangeNoteNotNil applies the NotNil predicate on the "change_note" field.
+func ChangeNoteNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldChangeNote))
+}
+
+// ChangeNoteEqualFold applies the EqualFold predicate on the "change_note" field.
+func ChangeNoteEqualFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEqualFold(FieldChangeNote, v))
+}
+
+// ChangeNoteContainsFold applies the ContainsFold predicate on the "change_note" field.
+func ChangeNoteContainsFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContainsFold(FieldChangeNote, v))
+}
+
+// RestoredFromVersionEQ applies the EQ predicate on the "restored_from_version" field.
+func RestoredFromVersionEQ(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionNEQ applies the NEQ predicate on the "restored_from_version" field.
+func RestoredFromVersionNEQ(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionIn applies the In predicate on the "restored_from_version" field.
+func RestoredFromVersionIn(vs ...int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldRestoredFromVersion, vs...))
+}
+
+// RestoredFromVersionNotIn applies the NotIn predicate on the "restored_from_version" field.
+func RestoredFromVersionNotIn(vs ...int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldRestoredFromVersion, vs...))
+}
+
+// RestoredFromVersionGT applies the GT predicate on the "restored_from_version" field.
+func RestoredFromVersionGT(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionGTE applies the GTE predicate on the "restored_from_version" field.
+func RestoredFromVersionGTE(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionLT applies the LT predicate on the "restored_from_version" field.
+func RestoredFromVersionLT(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionLTE applies the LTE predicate on the "restored_from_version" field.
+func RestoredFromVersionLTE(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionIsNil applies the IsNil predicate on the "restored_from_version" field.
+func RestoredFromVersionIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldRestoredFromVersion))
+}
+
+// RestoredFromVersionNotNil applies the NotNil predicate on the "restored_from_version" field.
+func RestoredFromVersionNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldRestoredFromVersion))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uint32) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentIsNil applies the IsNil predicate on the "review_comment" field.
+func ReviewCommentIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldReviewComment))
+}
+
+// ReviewCommentNotNil applies the NotNil predicate on the "review_comment" field.
+func ReviewCommentNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldReviewComment))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// RejectionReasonEQ applies the EQ predicate on the "rejection_reason" field.
+func RejectionReasonEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonNEQ applies the NEQ predicate on the "rejection_reason" field.
+func RejectionReasonNEQ(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonIn applies the In predicate on the "rejection_reason" field.
+func RejectionReasonIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonNotIn applies the NotIn predicate on the "rejection_reason" field.
+func RejectionReasonNotIn(vs ...string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonGT applies the GT predicate on the "rejection_reason" field.
+func RejectionReasonGT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldRejectionReason, v))
+}
+
+// RejectionReasonGTE applies the GTE predicate on the "rejection_reason" field.
+func RejectionReasonGTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonLT applies the LT predicate on the "rejection_reason" field.
+func RejectionReasonLT(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldRejectionReason, v))
+}
+
+// RejectionReasonLTE applies the LTE predicate on the "rejection_reason" field.
+func RejectionReasonLTE(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonContains applies the Contains predicate on the "rejection_reason" field.
+func RejectionReasonContains(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContains(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasPrefix applies the HasPrefix predicate on the "rejection_reason" field.
+func RejectionReasonHasPrefix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasPrefix(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasSuffix applies the HasSuffix predicate on the "rejection_reason" field.
+func RejectionReasonHasSuffix(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldHasSuffix(FieldRejectionReason, v))
+}
+
+// RejectionReasonIsNil applies the IsNil predicate on the "rejection_reason" field.
+func RejectionReasonIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldRejectionReason))
+}
+
+// RejectionReasonNotNil applies the NotNil predicate on the "rejection_reason" field.
+func RejectionReasonNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldRejectionReason))
+}
+
+// RejectionReasonEqualFold applies the EqualFold predicate on the "rejection_reason" field.
+func RejectionReasonEqualFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEqualFold(FieldRejectionReason, v))
+}
+
+// RejectionReasonContainsFold applies the ContainsFold predicate on the "rejection_reason" field.
+func RejectionReasonContainsFold(v string) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldContainsFold(FieldRejectionReason, v))
+}
+
+// AppliedVersionEQ applies the EQ predicate on the "applied_version" field.
+func AppliedVersionEQ(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldEQ(FieldAppliedVersion, v))
+}
+
+// AppliedVersionNEQ applies the NEQ predicate on the "applied_version" field.
+func AppliedVersionNEQ(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNEQ(FieldAppliedVersion, v))
+}
+
+// AppliedVersionIn applies the In predicate on the "applied_version" field.
+func AppliedVersionIn(vs ...int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIn(FieldAppliedVersion, vs...))
+}
+
+// AppliedVersionNotIn applies the NotIn predicate on the "applied_version" field.
+func AppliedVersionNotIn(vs ...int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotIn(FieldAppliedVersion, vs...))
+}
+
+// AppliedVersionGT applies the GT predicate on the "applied_version" field.
+func AppliedVersionGT(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGT(FieldAppliedVersion, v))
+}
+
+// AppliedVersionGTE applies the GTE predicate on the "applied_version" field.
+func AppliedVersionGTE(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldGTE(FieldAppliedVersion, v))
+}
+
+// AppliedVersionLT applies the LT predicate on the "applied_version" field.
+func AppliedVersionLT(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLT(FieldAppliedVersion, v))
+}
+
+// AppliedVersionLTE applies the LTE predicate on the "applied_version" field.
+func AppliedVersionLTE(v int) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldLTE(FieldAppliedVersion, v))
+}
+
+// AppliedVersionIsNil applies the IsNil predicate on the "applied_version" field.
+func AppliedVersionIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldAppliedVersion))
+}
+
+// AppliedVersionNotNil applies the NotNil predicate on the "applied_version" field.
+func AppliedVersionNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldAppliedVersion))
+}
+
+// CommentsIsNil applies the IsNil predicate on the "comments" field.
+func CommentsIsNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldIsNull(FieldComments))
+}
+
+// CommentsNotNil applies the NotNil predicate on the "comments" field.
+func CommentsNotNil() predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.FieldNotNull(FieldComments))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScriptChangeRequest) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScriptChangeRequest) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScriptChangeRequest) predicate.ScriptChangeRequest {
+	return predicate.ScriptChangeRequest(sql.NotPredicates(p))
+}
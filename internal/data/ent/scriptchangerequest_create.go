@@ -0,0 +1,1864 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptchangerequest"
+)
+
+// ScriptChangeRequestCreate is the builder for creating a ScriptChangeRequest entity.
+type ScriptChangeRequestCreate struct {
+	config
+	mutation *ScriptChangeRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ScriptChangeRequestCreate) SetCreateBy(v uint32) *ScriptChangeRequestCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableCreateBy(v *uint32) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *ScriptChangeRequestCreate) SetUpdateBy(v uint32) *ScriptChangeRequestCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableUpdateBy(v *uint32) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ScriptChangeRequestCreate) SetCreateTime(v time.Time) *ScriptChangeRequestCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableCreateTime(v *time.Time) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ScriptChangeRequestCreate) SetUpdateTime(v time.Time) *ScriptChangeRequestCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableUpdateTime(v *time.Time) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ScriptChangeRequestCreate) SetDeleteTime(v time.Time) *ScriptChangeRequestCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableDeleteTime(v *time.Time) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ScriptChangeRequestCreate) SetTenantID(v uint32) *ScriptChangeRequestCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableTenantID(v *uint32) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetScriptID sets the "script_id" field.
+func (_c *ScriptChangeRequestCreate) SetScriptID(v string) *ScriptChangeRequestCreate {
+	_c.mutation.SetScriptID(v)
+	return _c
+}
+
+// SetBaseVersion sets the "base_version" field.
+func (_c *ScriptChangeRequestCreate) SetBaseVersion(v int) *ScriptChangeRequestCreate {
+	_c.mutation.SetBaseVersion(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *ScriptChangeRequestCreate) SetContent(v string) *ScriptChangeRequestCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *ScriptChangeRequestCreate) SetContentHash(v string) *ScriptChangeRequestCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetChangeNote sets the "change_note" field.
+func (_c *ScriptChangeRequestCreate) SetChangeNote(v string) *ScriptChangeRequestCreate {
+	_c.mutation.SetChangeNote(v)
+	return _c
+}
+
+// SetNillableChangeNote sets the "change_note" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableChangeNote(v *string) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetChangeNote(*v)
+	}
+	return _c
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (_c *ScriptChangeRequestCreate) SetRestoredFromVersion(v int) *ScriptChangeRequestCreate {
+	_c.mutation.SetRestoredFromVersion(v)
+	return _c
+}
+
+// SetNillableRestoredFromVersion sets the "restored_from_version" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableRestoredFromVersion(v *int) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetRestoredFromVersion(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScriptChangeRequestCreate) SetStatus(v scriptchangerequest.Status) *ScriptChangeRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableStatus(v *scriptchangerequest.Status) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *ScriptChangeRequestCreate) SetReviewedBy(v uint32) *ScriptChangeRequestCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableReviewedBy(v *uint32) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *ScriptChangeRequestCreate) SetReviewedAt(v time.Time) *ScriptChangeRequestCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableReviewedAt(v *time.Time) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_c *ScriptChangeRequestCreate) SetReviewComment(v string) *ScriptChangeRequestCreate {
+	_c.mutation.SetReviewComment(v)
+	return _c
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableReviewComment(v *string) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetReviewComment(*v)
+	}
+	return _c
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_c *ScriptChangeRequestCreate) SetRejectionReason(v string) *ScriptChangeRequestCreate {
+	_c.mutation.SetRejectionReason(v)
+	return _c
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableRejectionReason(v *string) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetRejectionReason(*v)
+	}
+	return _c
+}
+
+// SetAppliedVersion sets the "applied_version" field.
+func (_c *ScriptChangeRequestCreate) SetAppliedVersion(v int) *ScriptChangeRequestCreate {
+	_c.mutation.SetAppliedVersion(v)
+	return _c
+}
+
+// SetNillableAppliedVersion sets the "applied_version" field if the given value is not nil.
+func (_c *ScriptChangeRequestCreate) SetNillableAppliedVersion(v *int) *ScriptChangeRequestCreate {
+	if v != nil {
+		_c.SetAppliedVersion(*v)
+	}
+	return _c
+}
+
+// SetComments sets the "comments" field.
+func (_c *ScriptChangeRequestCreate) SetComments(v []schema.ScriptChangeComment) *ScriptChangeRequestCreate {
+	_c.mutation.SetComments(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScriptChangeRequestCreate) SetID(v string) *ScriptChangeRequestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ScriptChangeRequestMutation object of the builder.
+func (_c *ScriptChangeRequestCreate) Mutation() *ScriptChangeRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the ScriptChangeRequest in the database.
+func (_c *ScriptChangeRequestCreate) Save(ctx context.Context) (*ScriptChangeRequest, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScriptChangeRequestCreate) SaveX(ctx context.Context) *ScriptChangeRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScriptChangeRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScriptChangeRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScriptChangeRequestCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := scriptchangerequest.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scriptchangerequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScriptChangeRequestCreate) check() error {
+	if _, ok := _c.mutation.ScriptID(); !ok {
+		return &ValidationError{Name: "script_id", err: errors.New(`ent: missing required field "ScriptChangeRequest.script_id"`)}
+	}
+	if v, ok := _c.mutation.ScriptID(); ok {
+		if err := scriptchangerequest.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.script_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BaseVersion(); !ok {
+		return &ValidationError{Name: "base_version", err: errors.New(`ent: missing required field "ScriptChangeRequest.base_version"`)}
+	}
+	if v, ok := _c.mutation.BaseVersion(); ok {
+		if err := scriptchangerequest.BaseVersionValidator(v); err != nil {
+			return &ValidationError{Name: "base_version", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.base_version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ScriptChangeRequest.content"`)}
+	}
+	if _, ok := _c.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "ScriptChangeRequest.content_hash"`)}
+	}
+	if v, ok := _c.mutation.ContentHash(); ok {
+		if err := scriptchangerequest.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ChangeNote(); ok {
+		if err := scriptchangerequest.ChangeNoteValidator(v); err != nil {
+			return &ValidationError{Name: "change_note", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.change_note": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScriptChangeRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scriptchangerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReviewComment(); ok {
+		if err := scriptchangerequest.ReviewCommentValidator(v); err != nil {
+			return &ValidationError{Name: "review_comment", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.review_comment": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RejectionReason(); ok {
+		if err := scriptchangerequest.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.rejection_reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := scriptchangerequest.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScriptChangeRequestCreate) sqlSave(ctx context.Context) (*ScriptChangeRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ScriptChangeRequest.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScriptChangeRequestCreate) createSpec() (*ScriptChangeRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScriptChangeRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scriptchangerequest.Table, sqlgraph.NewFieldSpec(scriptchangerequest.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(scriptchangerequest.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(scriptchangerequest.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(scriptchangerequest.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(scriptchangerequest.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ScriptID(); ok {
+		_spec.SetField(scriptchangerequest.FieldScriptID, field.TypeString, value)
+		_node.ScriptID = value
+	}
+	if value, ok := _c.mutation.BaseVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldBaseVersion, field.TypeInt, value)
+		_node.BaseVersion = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(scriptchangerequest.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(scriptchangerequest.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := _c.mutation.ChangeNote(); ok {
+		_spec.SetField(scriptchangerequest.FieldChangeNote, field.TypeString, value)
+		_node.ChangeNote = value
+	}
+	if value, ok := _c.mutation.RestoredFromVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldRestoredFromVersion, field.TypeInt, value)
+		_node.RestoredFromVersion = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scriptchangerequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewedBy, field.TypeUint32, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.ReviewComment(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = value
+	}
+	if value, ok := _c.mutation.RejectionReason(); ok {
+		_spec.SetField(scriptchangerequest.FieldRejectionReason, field.TypeString, value)
+		_node.RejectionReason = value
+	}
+	if value, ok := _c.mutation.AppliedVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldAppliedVersion, field.TypeInt, value)
+		_node.AppliedVersion = &value
+	}
+	if value, ok := _c.mutation.Comments(); ok {
+		_spec.SetField(scriptchangerequest.FieldComments, field.TypeJSON, value)
+		_node.Comments = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScriptChangeRequest.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScriptChangeRequestUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScriptChangeRequestCreate) OnConflict(opts ...sql.ConflictOption) *ScriptChangeRequestUpsertOne {
+	_c.conflict = opts
+	return &ScriptChangeRequestUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScriptChangeRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScriptChangeRequestCreate) OnConflictColumns(columns ...string) *ScriptChangeRequestUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScriptChangeRequestUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ScriptChangeRequestUpsertOne is the builder for "upsert"-ing
+	//  one ScriptChangeRequest node.
+	ScriptChangeRequestUpsertOne struct {
+		create *ScriptChangeRequestCreate
+	}
+
+	// ScriptChangeRequestUpsert is the "OnConflict" setter.
+	ScriptChangeRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScriptChangeRequestUpsert) SetCreateBy(v uint32) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateCreateBy() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScriptChangeRequestUpsert) AddCreateBy(v uint32) *ScriptChangeRequestUpsert {
+	u.Add(scriptchangerequest.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScriptChangeRequestUpsert) ClearCreateBy() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ScriptChangeRequestUpsert) SetUpdateBy(v uint32) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateUpdateBy() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ScriptChangeRequestUpsert) AddUpdateBy(v uint32) *ScriptChangeRequestUpsert {
+	u.Add(scriptchangerequest.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ScriptChangeRequestUpsert) ClearUpdateBy() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScriptChangeRequestUpsert) SetUpdateTime(v time.Time) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateUpdateTime() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScriptChangeRequestUpsert) ClearUpdateTime() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScriptChangeRequestUpsert) SetDeleteTime(v time.Time) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateDeleteTime() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScriptChangeRequestUpsert) ClearDeleteTime() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldDeleteTime)
+	return u
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScriptChangeRequestUpsert) SetScriptID(v string) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldScriptID, v)
+	return u
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateScriptID() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldScriptID)
+	return u
+}
+
+// SetBaseVersion sets the "base_version" field.
+func (u *ScriptChangeRequestUpsert) SetBaseVersion(v int) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldBaseVersion, v)
+	return u
+}
+
+// UpdateBaseVersion sets the "base_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateBaseVersion() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldBaseVersion)
+	return u
+}
+
+// AddBaseVersion adds v to the "base_version" field.
+func (u *ScriptChangeRequestUpsert) AddBaseVersion(v int) *ScriptChangeRequestUpsert {
+	u.Add(scriptchangerequest.FieldBaseVersion, v)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ScriptChangeRequestUpsert) SetContent(v string) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateContent() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldContent)
+	return u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ScriptChangeRequestUpsert) SetContentHash(v string) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateContentHash() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldContentHash)
+	return u
+}
+
+// SetChangeNote sets the "change_note" field.
+func (u *ScriptChangeRequestUpsert) SetChangeNote(v string) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldChangeNote, v)
+	return u
+}
+
+// UpdateChangeNote sets the "change_note" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateChangeNote() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldChangeNote)
+	return u
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (u *ScriptChangeRequestUpsert) ClearChangeNote() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldChangeNote)
+	return u
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsert) SetRestoredFromVersion(v int) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldRestoredFromVersion, v)
+	return u
+}
+
+// UpdateRestoredFromVersion sets the "restored_from_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateRestoredFromVersion() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldRestoredFromVersion)
+	return u
+}
+
+// AddRestoredFromVersion adds v to the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsert) AddRestoredFromVersion(v int) *ScriptChangeRequestUpsert {
+	u.Add(scriptchangerequest.FieldRestoredFromVersion, v)
+	return u
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsert) ClearRestoredFromVersion() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldRestoredFromVersion)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScriptChangeRequestUpsert) SetStatus(v scriptchangerequest.Status) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateStatus() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldStatus)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsert) SetReviewedBy(v uint32) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateReviewedBy() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldReviewedBy)
+	return u
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsert) AddReviewedBy(v uint32) *ScriptChangeRequestUpsert {
+	u.Add(scriptchangerequest.FieldReviewedBy, v)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsert) ClearReviewedBy() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldReviewedBy)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ScriptChangeRequestUpsert) SetReviewedAt(v time.Time) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateReviewedAt() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ScriptChangeRequestUpsert) ClearReviewedAt() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldReviewedAt)
+	return u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *ScriptChangeRequestUpsert) SetReviewComment(v string) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldReviewComment, v)
+	return u
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateReviewComment() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldReviewComment)
+	return u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *ScriptChangeRequestUpsert) ClearReviewComment() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldReviewComment)
+	return u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *ScriptChangeRequestUpsert) SetRejectionReason(v string) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldRejectionReason, v)
+	return u
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateRejectionReason() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldRejectionReason)
+	return u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *ScriptChangeRequestUpsert) ClearRejectionReason() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldRejectionReason)
+	return u
+}
+
+// SetAppliedVersion sets the "applied_version" field.
+func (u *ScriptChangeRequestUpsert) SetAppliedVersion(v int) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldAppliedVersion, v)
+	return u
+}
+
+// UpdateAppliedVersion sets the "applied_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateAppliedVersion() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldAppliedVersion)
+	return u
+}
+
+// AddAppliedVersion adds v to the "applied_version" field.
+func (u *ScriptChangeRequestUpsert) AddAppliedVersion(v int) *ScriptChangeRequestUpsert {
+	u.Add(scriptchangerequest.FieldAppliedVersion, v)
+	return u
+}
+
+// ClearAppliedVersion clears the value of the "applied_version" field.
+func (u *ScriptChangeRequestUpsert) ClearAppliedVersion() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldAppliedVersion)
+	return u
+}
+
+// SetComments sets the "comments" field.
+func (u *ScriptChangeRequestUpsert) SetComments(v []schema.ScriptChangeComment) *ScriptChangeRequestUpsert {
+	u.Set(scriptchangerequest.FieldComments, v)
+	return u
+}
+
+// UpdateComments sets the "comments" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsert) UpdateComments() *ScriptChangeRequestUpsert {
+	u.SetExcluded(scriptchangerequest.FieldComments)
+	return u
+}
+
+// ClearComments clears the value of the "comments" field.
+func (u *ScriptChangeRequestUpsert) ClearComments() *ScriptChangeRequestUpsert {
+	u.SetNull(scriptchangerequest.FieldComments)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScriptChangeRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scriptchangerequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScriptChangeRequestUpsertOne) UpdateNewValues() *ScriptChangeRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scriptchangerequest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(scriptchangerequest.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(scriptchangerequest.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScriptChangeRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScriptChangeRequestUpsertOne) Ignore() *ScriptChangeRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScriptChangeRequestUpsertOne) DoNothing() *ScriptChangeRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScriptChangeRequestCreate.OnConflict
+// documentation for more info.
+func (u *ScriptChangeRequestUpsertOne) Update(set func(*ScriptChangeRequestUpsert)) *ScriptChangeRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScriptChangeRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScriptChangeRequestUpsertOne) SetCreateBy(v uint32) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScriptChangeRequestUpsertOne) AddCreateBy(v uint32) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateCreateBy() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScriptChangeRequestUpsertOne) ClearCreateBy() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ScriptChangeRequestUpsertOne) SetUpdateBy(v uint32) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ScriptChangeRequestUpsertOne) AddUpdateBy(v uint32) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateUpdateBy() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ScriptChangeRequestUpsertOne) ClearUpdateBy() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScriptChangeRequestUpsertOne) SetUpdateTime(v time.Time) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateUpdateTime() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScriptChangeRequestUpsertOne) ClearUpdateTime() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScriptChangeRequestUpsertOne) SetDeleteTime(v time.Time) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateDeleteTime() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScriptChangeRequestUpsertOne) ClearDeleteTime() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScriptChangeRequestUpsertOne) SetScriptID(v string) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateScriptID() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetBaseVersion sets the "base_version" field.
+func (u *ScriptChangeRequestUpsertOne) SetBaseVersion(v int) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetBaseVersion(v)
+	})
+}
+
+// AddBaseVersion adds v to the "base_version" field.
+func (u *ScriptChangeRequestUpsertOne) AddBaseVersion(v int) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddBaseVersion(v)
+	})
+}
+
+// UpdateBaseVersion sets the "base_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateBaseVersion() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateBaseVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ScriptChangeRequestUpsertOne) SetContent(v string) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateContent() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ScriptChangeRequestUpsertOne) SetContentHash(v string) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateContentHash() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetChangeNote sets the "change_note" field.
+func (u *ScriptChangeRequestUpsertOne) SetChangeNote(v string) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetChangeNote(v)
+	})
+}
+
+// UpdateChangeNote sets the "change_note" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateChangeNote() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateChangeNote()
+	})
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (u *ScriptChangeRequestUpsertOne) ClearChangeNote() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearChangeNote()
+	})
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsertOne) SetRestoredFromVersion(v int) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetRestoredFromVersion(v)
+	})
+}
+
+// AddRestoredFromVersion adds v to the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsertOne) AddRestoredFromVersion(v int) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddRestoredFromVersion(v)
+	})
+}
+
+// UpdateRestoredFromVersion sets the "restored_from_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateRestoredFromVersion() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateRestoredFromVersion()
+	})
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsertOne) ClearRestoredFromVersion() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearRestoredFromVersion()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScriptChangeRequestUpsertOne) SetStatus(v scriptchangerequest.Status) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateStatus() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsertOne) SetReviewedBy(v uint32) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsertOne) AddReviewedBy(v uint32) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateReviewedBy() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsertOne) ClearReviewedBy() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ScriptChangeRequestUpsertOne) SetReviewedAt(v time.Time) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateReviewedAt() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ScriptChangeRequestUpsertOne) ClearReviewedAt() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *ScriptChangeRequestUpsertOne) SetReviewComment(v string) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateReviewComment() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *ScriptChangeRequestUpsertOne) ClearReviewComment() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *ScriptChangeRequestUpsertOne) SetRejectionReason(v string) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateRejectionReason() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *ScriptChangeRequestUpsertOne) ClearRejectionReason() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetAppliedVersion sets the "applied_version" field.
+func (u *ScriptChangeRequestUpsertOne) SetAppliedVersion(v int) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetAppliedVersion(v)
+	})
+}
+
+// AddAppliedVersion adds v to the "applied_version" field.
+func (u *ScriptChangeRequestUpsertOne) AddAppliedVersion(v int) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddAppliedVersion(v)
+	})
+}
+
+// UpdateAppliedVersion sets the "applied_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateAppliedVersion() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateAppliedVersion()
+	})
+}
+
+// ClearAppliedVersion clears the value of the "applied_version" field.
+func (u *ScriptChangeRequestUpsertOne) ClearAppliedVersion() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearAppliedVersion()
+	})
+}
+
+// SetComments sets the "comments" field.
+func (u *ScriptChangeRequestUpsertOne) SetComments(v []schema.ScriptChangeComment) *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetComments(v)
+	})
+}
+
+// UpdateComments sets the "comments" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertOne) UpdateComments() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateComments()
+	})
+}
+
+// ClearComments clears the value of the "comments" field.
+func (u *ScriptChangeRequestUpsertOne) ClearComments() *ScriptChangeRequestUpsertOne {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearComments()
+	})
+}
+
+// Exec executes the query.
+func (u *ScriptChangeRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScriptChangeRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScriptChangeRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScriptChangeRequestUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ScriptChangeRequestUpsertOne.ID is not supported by MySQL driver. Use ScriptChangeRequestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScriptChangeRequestUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScriptChangeRequestCreateBulk is the builder for creating many ScriptChangeRequest entities in bulk.
+type ScriptChangeRequestCreateBulk struct {
+	config
+	err      error
+	builders []*ScriptChangeRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScriptChangeRequest entities in the database.
+func (_c *ScriptChangeRequestCreateBulk) Save(ctx context.Context) ([]*ScriptChangeRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScriptChangeRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScriptChangeRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScriptChangeRequestCreateBulk) SaveX(ctx context.Context) []*ScriptChangeRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScriptChangeRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScriptChangeRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScriptChangeRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScriptChangeRequestUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScriptChangeRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScriptChangeRequestUpsertBulk {
+	_c.conflict = opts
+	return &ScriptChangeRequestUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScriptChangeRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScriptChangeRequestCreateBulk) OnConflictColumns(columns ...string) *ScriptChangeRequestUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScriptChangeRequestUpsertBulk{
+		create: _c,
+	}
+}
+
+// ScriptChangeRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScriptChangeRequest nodes.
+type ScriptChangeRequestUpsertBulk struct {
+	create *ScriptChangeRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScriptChangeRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scriptchangerequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScriptChangeRequestUpsertBulk) UpdateNewValues() *ScriptChangeRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scriptchangerequest.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(scriptchangerequest.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(scriptchangerequest.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScriptChangeRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScriptChangeRequestUpsertBulk) Ignore() *ScriptChangeRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScriptChangeRequestUpsertBulk) DoNothing() *ScriptChangeRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScriptChangeRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScriptChangeRequestUpsertBulk) Update(set func(*ScriptChangeRequestUpsert)) *ScriptChangeRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScriptChangeRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScriptChangeRequestUpsertBulk) SetCreateBy(v uint32) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScriptChangeRequestUpsertBulk) AddCreateBy(v uint32) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateCreateBy() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearCreateBy() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ScriptChangeRequestUpsertBulk) SetUpdateBy(v uint32) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ScriptChangeRequestUpsertBulk) AddUpdateBy(v uint32) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateUpdateBy() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearUpdateBy() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScriptChangeRequestUpsertBulk) SetUpdateTime(v time.Time) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateUpdateTime() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearUpdateTime() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScriptChangeRequestUpsertBulk) SetDeleteTime(v time.Time) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateDeleteTime() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearDeleteTime() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScriptChangeRequestUpsertBulk) SetScriptID(v string) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateScriptID() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetBaseVersion sets the "base_version" field.
+func (u *ScriptChangeRequestUpsertBulk) SetBaseVersion(v int) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetBaseVersion(v)
+	})
+}
+
+// AddBaseVersion adds v to the "base_version" field.
+func (u *ScriptChangeRequestUpsertBulk) AddBaseVersion(v int) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddBaseVersion(v)
+	})
+}
+
+// UpdateBaseVersion sets the "base_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateBaseVersion() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateBaseVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ScriptChangeRequestUpsertBulk) SetContent(v string) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateContent() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ScriptChangeRequestUpsertBulk) SetContentHash(v string) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateContentHash() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetChangeNote sets the "change_note" field.
+func (u *ScriptChangeRequestUpsertBulk) SetChangeNote(v string) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetChangeNote(v)
+	})
+}
+
+// UpdateChangeNote sets the "change_note" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateChangeNote() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateChangeNote()
+	})
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearChangeNote() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearChangeNote()
+	})
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsertBulk) SetRestoredFromVersion(v int) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetRestoredFromVersion(v)
+	})
+}
+
+// AddRestoredFromVersion adds v to the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsertBulk) AddRestoredFromVersion(v int) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddRestoredFromVersion(v)
+	})
+}
+
+// UpdateRestoredFromVersion sets the "restored_from_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateRestoredFromVersion() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateRestoredFromVersion()
+	})
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearRestoredFromVersion() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearRestoredFromVersion()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScriptChangeRequestUpsertBulk) SetStatus(v scriptchangerequest.Status) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateStatus() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsertBulk) SetReviewedBy(v uint32) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsertBulk) AddReviewedBy(v uint32) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateReviewedBy() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearReviewedBy() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ScriptChangeRequestUpsertBulk) SetReviewedAt(v time.Time) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateReviewedAt() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearReviewedAt() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *ScriptChangeRequestUpsertBulk) SetReviewComment(v string) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateReviewComment() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearReviewComment() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *ScriptChangeRequestUpsertBulk) SetRejectionReason(v string) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateRejectionReason() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearRejectionReason() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetAppliedVersion sets the "applied_version" field.
+func (u *ScriptChangeRequestUpsertBulk) SetAppliedVersion(v int) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetAppliedVersion(v)
+	})
+}
+
+// AddAppliedVersion adds v to the "applied_version" field.
+func (u *ScriptChangeRequestUpsertBulk) AddAppliedVersion(v int) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.AddAppliedVersion(v)
+	})
+}
+
+// UpdateAppliedVersion sets the "applied_version" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateAppliedVersion() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateAppliedVersion()
+	})
+}
+
+// ClearAppliedVersion clears the value of the "applied_version" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearAppliedVersion() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearAppliedVersion()
+	})
+}
+
+// SetComments sets the "comments" field.
+func (u *ScriptChangeRequestUpsertBulk) SetComments(v []schema.ScriptChangeComment) *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.SetComments(v)
+	})
+}
+
+// UpdateComments sets the "comments" field to the value that was provided on create.
+func (u *ScriptChangeRequestUpsertBulk) UpdateComments() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.UpdateComments()
+	})
+}
+
+// ClearComments clears the value of the "comments" field.
+func (u *ScriptChangeRequestUpsertBulk) ClearComments() *ScriptChangeRequestUpsertBulk {
+	return u.Update(func(s *ScriptChangeRequestUpsert) {
+		s.ClearComments()
+	})
+}
+
+// Exec executes the query.
+func (u *ScriptChangeRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScriptChangeRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScriptChangeRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScriptChangeRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
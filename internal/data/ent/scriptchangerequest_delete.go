@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptchangerequest"
+)
+
+// ScriptChangeRequestDelete is the builder for deleting a ScriptChangeRequest entity.
+type ScriptChangeRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScriptChangeRequestMutation
+}
+
+// Where appends a list predicates to the ScriptChangeRequestDelete builder.
+func (_d *ScriptChangeRequestDelete) Where(ps ...predicate.ScriptChangeRequest) *ScriptChangeRequestDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ScriptChangeRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ScriptChangeRequestDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ScriptChangeRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(scriptchangerequest.Table, sqlgraph.NewFieldSpec(scriptchangerequest.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ScriptChangeRequestDeleteOne is the builder for deleting a single ScriptChangeRequest entity.
+type ScriptChangeRequestDeleteOne struct {
+	_d *ScriptChangeRequestDelete
+}
+
+// Where appends a list predicates to the ScriptChangeRequestDelete builder.
+func (_d *ScriptChangeRequestDeleteOne) Where(ps ...predicate.ScriptChangeRequest) *ScriptChangeRequestDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ScriptChangeRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{scriptchangerequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ScriptChangeRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptchangerequest"
+)
+
+// ScriptChangeRequestUpdate is the builder for updating ScriptChangeRequest entities.
+type ScriptChangeRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScriptChangeRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScriptChangeRequestUpdate builder.
+func (_u *ScriptChangeRequestUpdate) Where(ps ...predicate.ScriptChangeRequest) *ScriptChangeRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ScriptChangeRequestUpdate) SetCreateBy(v uint32) *ScriptChangeRequestUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableCreateBy(v *uint32) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ScriptChangeRequestUpdate) AddCreateBy(v int32) *ScriptChangeRequestUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ScriptChangeRequestUpdate) ClearCreateBy() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ScriptChangeRequestUpdate) SetUpdateBy(v uint32) *ScriptChangeRequestUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableUpdateBy(v *uint32) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ScriptChangeRequestUpdate) AddUpdateBy(v int32) *ScriptChangeRequestUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ScriptChangeRequestUpdate) ClearUpdateBy() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScriptChangeRequestUpdate) SetUpdateTime(v time.Time) *ScriptChangeRequestUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableUpdateTime(v *time.Time) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ScriptChangeRequestUpdate) ClearUpdateTime() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ScriptChangeRequestUpdate) SetDeleteTime(v time.Time) *ScriptChangeRequestUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableDeleteTime(v *time.Time) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ScriptChangeRequestUpdate) ClearDeleteTime() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ScriptChangeRequestUpdate) SetScriptID(v string) *ScriptChangeRequestUpdate {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableScriptID(v *string) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetBaseVersion sets the "base_version" field.
+func (_u *ScriptChangeRequestUpdate) SetBaseVersion(v int) *ScriptChangeRequestUpdate {
+	_u.mutation.ResetBaseVersion()
+	_u.mutation.SetBaseVersion(v)
+	return _u
+}
+
+// SetNillableBaseVersion sets the "base_version" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableBaseVersion(v *int) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetBaseVersion(*v)
+	}
+	return _u
+}
+
+// AddBaseVersion adds value to the "base_version" field.
+func (_u *ScriptChangeRequestUpdate) AddBaseVersion(v int) *ScriptChangeRequestUpdate {
+	_u.mutation.AddBaseVersion(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ScriptChangeRequestUpdate) SetContent(v string) *ScriptChangeRequestUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableContent(v *string) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ScriptChangeRequestUpdate) SetContentHash(v string) *ScriptChangeRequestUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableContentHash(v *string) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetChangeNote sets the "change_note" field.
+func (_u *ScriptChangeRequestUpdate) SetChangeNote(v string) *ScriptChangeRequestUpdate {
+	_u.mutation.SetChangeNote(v)
+	return _u
+}
+
+// SetNillableChangeNote sets the "change_note" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableChangeNote(v *string) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetChangeNote(*v)
+	}
+	return _u
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (_u *ScriptChangeRequestUpdate) ClearChangeNote() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearChangeNote()
+	return _u
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (_u *ScriptChangeRequestUpdate) SetRestoredFromVersion(v int) *ScriptChangeRequestUpdate {
+	_u.mutation.ResetRestoredFromVersion()
+	_u.mutation.SetRestoredFromVersion(v)
+	return _u
+}
+
+// SetNillableRestoredFromVersion sets the "restored_from_version" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableRestoredFromVersion(v *int) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetRestoredFromVersion(*v)
+	}
+	return _u
+}
+
+// AddRestoredFromVersion adds value to the "restored_from_version" field.
+func (_u *ScriptChangeRequestUpdate) AddRestoredFromVersion(v int) *ScriptChangeRequestUpdate {
+	_u.mutation.AddRestoredFromVersion(v)
+	return _u
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (_u *ScriptChangeRequestUpdate) ClearRestoredFromVersion() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearRestoredFromVersion()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScriptChangeRequestUpdate) SetStatus(v scriptchangerequest.Status) *ScriptChangeRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableStatus(v *scriptchangerequest.Status) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *ScriptChangeRequestUpdate) SetReviewedBy(v uint32) *ScriptChangeRequestUpdate {
+	_u.mutation.ResetReviewedBy()
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableReviewedBy(v *uint32) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// AddReviewedBy adds value to the "reviewed_by" field.
+func (_u *ScriptChangeRequestUpdate) AddReviewedBy(v int32) *ScriptChangeRequestUpdate {
+	_u.mutation.AddReviewedBy(v)
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *ScriptChangeRequestUpdate) ClearReviewedBy() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *ScriptChangeRequestUpdate) SetReviewedAt(v time.Time) *ScriptChangeRequestUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableReviewedAt(v *time.Time) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *ScriptChangeRequestUpdate) ClearReviewedAt() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_u *ScriptChangeRequestUpdate) SetReviewComment(v string) *ScriptChangeRequestUpdate {
+	_u.mutation.SetReviewComment(v)
+	return _u
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableReviewComment(v *string) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetReviewComment(*v)
+	}
+	return _u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (_u *ScriptChangeRequestUpdate) ClearReviewComment() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearReviewComment()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *ScriptChangeRequestUpdate) SetRejectionReason(v string) *ScriptChangeRequestUpdate {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableRejectionReason(v *string) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *ScriptChangeRequestUpdate) ClearRejectionReason() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetAppliedVersion sets the "applied_version" field.
+func (_u *ScriptChangeRequestUpdate) SetAppliedVersion(v int) *ScriptChangeRequestUpdate {
+	_u.mutation.ResetAppliedVersion()
+	_u.mutation.SetAppliedVersion(v)
+	return _u
+}
+
+// SetNillableAppliedVersion sets the "applied_version" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdate) SetNillableAppliedVersion(v *int) *ScriptChangeRequestUpdate {
+	if v != nil {
+		_u.SetAppliedVersion(*v)
+	}
+	return _u
+}
+
+// AddAppliedVersion adds value to the "applied_version" field.
+func (_u *ScriptChangeRequestUpdate) AddAppliedVersion(v int) *ScriptChangeRequestUpdate {
+	_u.mutation.AddAppliedVersion(v)
+	return _u
+}
+
+// ClearAppliedVersion clears the value of the "applied_version" field.
+func (_u *ScriptChangeRequestUpdate) ClearAppliedVersion() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearAppliedVersion()
+	return _u
+}
+
+// SetComments sets the "comments" field.
+func (_u *ScriptChangeRequestUpdate) SetComments(v []schema.ScriptChangeComment) *ScriptChangeRequestUpdate {
+	_u.mutation.SetComments(v)
+	return _u
+}
+
+// AppendComments appends value to the "comments" field.
+func (_u *ScriptChangeRequestUpdate) AppendComments(v []schema.ScriptChangeComment) *ScriptChangeRequestUpdate {
+	_u.mutation.AppendComments(v)
+	return _u
+}
+
+// ClearComments clears the value of the "comments" field.
+func (_u *ScriptChangeRequestUpdate) ClearComments() *ScriptChangeRequestUpdate {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// Mutation returns the ScriptChangeRequestMutation object of the builder.
+func (_u *ScriptChangeRequestUpdate) Mutation() *ScriptChangeRequestMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScriptChangeRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScriptChangeRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScriptChangeRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScriptChangeRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScriptChangeRequestUpdate) check() error {
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := scriptchangerequest.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseVersion(); ok {
+		if err := scriptchangerequest.BaseVersionValidator(v); err != nil {
+			return &ValidationError{Name: "base_version", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.base_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := scriptchangerequest.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChangeNote(); ok {
+		if err := scriptchangerequest.ChangeNoteValidator(v); err != nil {
+			return &ValidationError{Name: "change_note", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.change_note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scriptchangerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReviewComment(); ok {
+		if err := scriptchangerequest.ReviewCommentValidator(v); err != nil {
+			return &ValidationError{Name: "review_comment", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.review_comment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RejectionReason(); ok {
+		if err := scriptchangerequest.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.rejection_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScriptChangeRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScriptChangeRequestUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScriptChangeRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scriptchangerequest.Table, scriptchangerequest.Columns, sqlgraph.NewFieldSpec(scriptchangerequest.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(scriptchangerequest.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(scriptchangerequest.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(scriptchangerequest.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(scriptchangerequest.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(scriptchangerequest.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scriptchangerequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(scriptchangerequest.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(scriptchangerequest.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(scriptchangerequest.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(scriptchangerequest.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(scriptchangerequest.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BaseVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldBaseVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBaseVersion(); ok {
+		_spec.AddField(scriptchangerequest.FieldBaseVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(scriptchangerequest.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(scriptchangerequest.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangeNote(); ok {
+		_spec.SetField(scriptchangerequest.FieldChangeNote, field.TypeString, value)
+	}
+	if _u.mutation.ChangeNoteCleared() {
+		_spec.ClearField(scriptchangerequest.FieldChangeNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestoredFromVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldRestoredFromVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRestoredFromVersion(); ok {
+		_spec.AddField(scriptchangerequest.FieldRestoredFromVersion, field.TypeInt, value)
+	}
+	if _u.mutation.RestoredFromVersionCleared() {
+		_spec.ClearField(scriptchangerequest.FieldRestoredFromVersion, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scriptchangerequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(scriptchangerequest.FieldReviewedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(scriptchangerequest.FieldReviewedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(scriptchangerequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewComment(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewComment, field.TypeString, value)
+	}
+	if _u.mutation.ReviewCommentCleared() {
+		_spec.ClearField(scriptchangerequest.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(scriptchangerequest.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(scriptchangerequest.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppliedVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldAppliedVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedVersion(); ok {
+		_spec.AddField(scriptchangerequest.FieldAppliedVersion, field.TypeInt, value)
+	}
+	if _u.mutation.AppliedVersionCleared() {
+		_spec.ClearField(scriptchangerequest.FieldAppliedVersion, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Comments(); ok {
+		_spec.SetField(scriptchangerequest.FieldComments, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedComments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scriptchangerequest.FieldComments, value)
+		})
+	}
+	if _u.mutation.CommentsCleared() {
+		_spec.ClearField(scriptchangerequest.FieldComments, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scriptchangerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScriptChangeRequestUpdateOne is the builder for updating a single ScriptChangeRequest entity.
+type ScriptChangeRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScriptChangeRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ScriptChangeRequestUpdateOne) SetCreateBy(v uint32) *ScriptChangeRequestUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableCreateBy(v *uint32) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ScriptChangeRequestUpdateOne) AddCreateBy(v int32) *ScriptChangeRequestUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearCreateBy() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ScriptChangeRequestUpdateOne) SetUpdateBy(v uint32) *ScriptChangeRequestUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableUpdateBy(v *uint32) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ScriptChangeRequestUpdateOne) AddUpdateBy(v int32) *ScriptChangeRequestUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearUpdateBy() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScriptChangeRequestUpdateOne) SetUpdateTime(v time.Time) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableUpdateTime(v *time.Time) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearUpdateTime() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ScriptChangeRequestUpdateOne) SetDeleteTime(v time.Time) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableDeleteTime(v *time.Time) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearDeleteTime() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ScriptChangeRequestUpdateOne) SetScriptID(v string) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableScriptID(v *string) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetBaseVersion sets the "base_version" field.
+func (_u *ScriptChangeRequestUpdateOne) SetBaseVersion(v int) *ScriptChangeRequestUpdateOne {
+	_u.mutation.ResetBaseVersion()
+	_u.mutation.SetBaseVersion(v)
+	return _u
+}
+
+// SetNillableBaseVersion sets the "base_version" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableBaseVersion(v *int) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetBaseVersion(*v)
+	}
+	return _u
+}
+
+// AddBaseVersion adds value to the "base_version" field.
+func (_u *ScriptChangeRequestUpdateOne) AddBaseVersion(v int) *ScriptChangeRequestUpdateOne {
+	_u.mutation.AddBaseVersion(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ScriptChangeRequestUpdateOne) SetContent(v string) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableContent(v *string) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ScriptChangeRequestUpdateOne) SetContentHash(v string) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableContentHash(v *string) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetChangeNote sets the "change_note" field.
+func (_u *ScriptChangeRequestUpdateOne) SetChangeNote(v string) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetChangeNote(v)
+	return _u
+}
+
+// SetNillableChangeNote sets the "change_note" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableChangeNote(v *string) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetChangeNote(*v)
+	}
+	return _u
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearChangeNote() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearChangeNote()
+	return _u
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (_u *ScriptChangeRequestUpdateOne) SetRestoredFromVersion(v int) *ScriptChangeRequestUpdateOne {
+	_u.mutation.ResetRestoredFromVersion()
+	_u.mutation.SetRestoredFromVersion(v)
+	return _u
+}
+
+// SetNillableRestoredFromVersion sets the "restored_from_version" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableRestoredFromVersion(v *int) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetRestoredFromVersion(*v)
+	}
+	return _u
+}
+
+// AddRestoredFromVersion adds value to the "restored_from_version" field.
+func (_u *ScriptChangeRequestUpdateOne) AddRestoredFromVersion(v int) *ScriptChangeRequestUpdateOne {
+	_u.mutation.AddRestoredFromVersion(v)
+	return _u
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearRestoredFromVersion() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearRestoredFromVersion()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScriptChangeRequestUpdateOne) SetStatus(v scriptchangerequest.Status) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableStatus(v *scriptchangerequest.Status) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *ScriptChangeRequestUpdateOne) SetReviewedBy(v uint32) *ScriptChangeRequestUpdateOne {
+	_u.mutation.ResetReviewedBy()
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableReviewedBy(v *uint32) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// AddReviewedBy adds value to the "reviewed_by" field.
+func (_u *ScriptChangeRequestUpdateOne) AddReviewedBy(v int32) *ScriptChangeRequestUpdateOne {
+	_u.mutation.AddReviewedBy(v)
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearReviewedBy() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *ScriptChangeRequestUpdateOne) SetReviewedAt(v time.Time) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableReviewedAt(v *time.Time) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearReviewedAt() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_u *ScriptChangeRequestUpdateOne) SetReviewComment(v string) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetReviewComment(v)
+	return _u
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableReviewComment(v *string) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewComment(*v)
+	}
+	return _u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearReviewComment() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearReviewComment()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *ScriptChangeRequestUpdateOne) SetRejectionReason(v string) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableRejectionReason(v *string) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearRejectionReason() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetAppliedVersion sets the "applied_version" field.
+func (_u *ScriptChangeRequestUpdateOne) SetAppliedVersion(v int) *ScriptChangeRequestUpdateOne {
+	_u.mutation.ResetAppliedVersion()
+	_u.mutation.SetAppliedVersion(v)
+	return _u
+}
+
+// SetNillableAppliedVersion sets the "applied_version" field if the given value is not nil.
+func (_u *ScriptChangeRequestUpdateOne) SetNillableAppliedVersion(v *int) *ScriptChangeRequestUpdateOne {
+	if v != nil {
+		_u.SetAppliedVersion(*v)
+	}
+	return _u
+}
+
+// AddAppliedVersion adds value to the "applied_version" field.
+func (_u *ScriptChangeRequestUpdateOne) AddAppliedVersion(v int) *ScriptChangeRequestUpdateOne {
+	_u.mutation.AddAppliedVersion(v)
+	return _u
+}
+
+// ClearAppliedVersion clears the value of the "applied_version" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearAppliedVersion() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearAppliedVersion()
+	return _u
+}
+
+// SetComments sets the "comments" field.
+func (_u *ScriptChangeRequestUpdateOne) SetComments(v []schema.ScriptChangeComment) *ScriptChangeRequestUpdateOne {
+	_u.mutation.SetComments(v)
+	return _u
+}
+
+// AppendComments appends value to the "comments" field.
+func (_u *ScriptChangeRequestUpdateOne) AppendComments(v []schema.ScriptChangeComment) *ScriptChangeRequestUpdateOne {
+	_u.mutation.AppendComments(v)
+	return _u
+}
+
+// ClearComments clears the value of the "comments" field.
+func (_u *ScriptChangeRequestUpdateOne) ClearComments() *ScriptChangeRequestUpdateOne {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// Mutation returns the ScriptChangeRequestMutation object of the builder.
+func (_u *ScriptChangeRequestUpdateOne) Mutation() *ScriptChangeRequestMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScriptChangeRequestUpdate builder.
+func (_u *ScriptChangeRequestUpdateOne) Where(ps ...predicate.ScriptChangeRequest) *ScriptChangeRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScriptChangeRequestUpdateOne) Select(field string, fields ...string) *ScriptChangeRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScriptChangeRequest entity.
+func (_u *ScriptChangeRequestUpdateOne) Save(ctx context.Context) (*ScriptChangeRequest, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScriptChangeRequestUpdateOne) SaveX(ctx context.Context) *ScriptChangeRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScriptChangeRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScriptChangeRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScriptChangeRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := scriptchangerequest.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseVersion(); ok {
+		if err := scriptchangerequest.BaseVersionValidator(v); err != nil {
+			return &ValidationError{Name: "base_version", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.base_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := scriptchangerequest.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChangeNote(); ok {
+		if err := scriptchangerequest.ChangeNoteValidator(v); err != nil {
+			return &ValidationError{Name: "change_note", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.change_note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scriptchangerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReviewComment(); ok {
+		if err := scriptchangerequest.ReviewCommentValidator(v); err != nil {
+			return &ValidationError{Name: "review_comment", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.review_comment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RejectionReason(); ok {
+		if err := scriptchangerequest.RejectionReasonValidator(v); err != nil {
+			return &ValidationError{Name: "rejection_reason", err: fmt.Errorf(`ent: validator failed for field "ScriptChangeRequest.rejection_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScriptChangeRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScriptChangeRequestUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScriptChangeRequestUpdateOne) sqlSave(ctx context.Context) (_node *ScriptChangeRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scriptchangerequest.Table, scriptchangerequest.Columns, sqlgraph.NewFieldSpec(scriptchangerequest.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScriptChangeRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scriptchangerequest.FieldID)
+		for _, f := range fields {
+			if !scriptchangerequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scriptchangerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(scriptchangerequest.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(scriptchangerequest.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(scriptchangerequest.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(scriptchangerequest.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(scriptchangerequest.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scriptchangerequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(scriptchangerequest.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(scriptchangerequest.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(scriptchangerequest.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(scriptchangerequest.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(scriptchangerequest.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BaseVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldBaseVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBaseVersion(); ok {
+		_spec.AddField(scriptchangerequest.FieldBaseVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(scriptchangerequest.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(scriptchangerequest.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangeNote(); ok {
+		_spec.SetField(scriptchangerequest.FieldChangeNote, field.TypeString, value)
+	}
+	if _u.mutation.ChangeNoteCleared() {
+		_spec.ClearField(scriptchangerequest.FieldChangeNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestoredFromVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldRestoredFromVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRestoredFromVersion(); ok {
+		_spec.AddField(scriptchangerequest.FieldRestoredFromVersion, field.TypeInt, value)
+	}
+	if _u.mutation.RestoredFromVersionCleared() {
+		_spec.ClearField(scriptchangerequest.FieldRestoredFromVersion, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scriptchangerequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(scriptchangerequest.FieldReviewedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(scriptchangerequest.FieldReviewedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(scriptchangerequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewComment(); ok {
+		_spec.SetField(scriptchangerequest.FieldReviewComment, field.TypeString, value)
+	}
+	if _u.mutation.ReviewCommentCleared() {
+		_spec.ClearField(scriptchangerequest.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(scriptchangerequest.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(scriptchangerequest.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppliedVersion(); ok {
+		_spec.SetField(scriptchangerequest.FieldAppliedVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedVersion(); ok {
+		_spec.AddField(scriptchangerequest.FieldAppliedVersion, field.TypeInt, value)
+	}
+	if _u.mutation.AppliedVersionCleared() {
+		_spec.ClearField(scriptchangerequest.FieldAppliedVersion, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Comments(); ok {
+		_spec.SetField(scriptchangerequest.FieldComments, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedComments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scriptchangerequest.FieldComments, value)
+		})
+	}
+	if _u.mutation.CommentsCleared() {
+		_spec.ClearField(scriptchangerequest.FieldComments, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ScriptChangeRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scriptchangerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,880 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scriptversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ScriptID applies equality check predicate on the "script_id" field. It's identical to ScriptIDEQ.
+func ScriptID(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldScriptID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ChangeNote applies equality check predicate on the "change_note" field. It's identical to ChangeNoteEQ.
+func ChangeNote(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldChangeNote, v))
+}
+
+// RestoredFromVersion applies equality check predicate on the "restored_from_version" field. It's identical to RestoredFromVersionEQ.
+func RestoredFromVersion(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldRestoredFromVersion, v))
+}
+
+// ApprovedBy applies equality check predicate on the "approved_by" field. It's identical to ApprovedByEQ.
+func ApprovedBy(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ChangeRequestID applies equality check predicate on the "change_request_id" field. It's identical to ChangeRequestIDEQ.
+func ChangeRequestID(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldChangeRequestID, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldCreateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldTenantID))
+}
+
+// ScriptIDEQ applies the EQ predicate on the "script_id" field.
+func ScriptIDEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldScriptID, v))
+}
+
+// ScriptIDNEQ applies the NEQ predicate on the "script_id" field.
+func ScriptIDNEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldScriptID, v))
+}
+
+// ScriptIDIn applies the In predicate on the "script_id" field.
+func ScriptIDIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldScriptID, vs...))
+}
+
+// ScriptIDNotIn applies the NotIn predicate on the "script_id" field.
+func ScriptIDNotIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldScriptID, vs...))
+}
+
+// ScriptIDGT applies the GT predicate on the "script_id" field.
+func ScriptIDGT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldScriptID, v))
+}
+
+// ScriptIDGTE applies the GTE predicate on the "script_id" field.
+func ScriptIDGTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldScriptID, v))
+}
+
+// ScriptIDLT applies the LT predicate on the "script_id" field.
+func ScriptIDLT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldScriptID, v))
+}
+
+// ScriptIDLTE applies the LTE predicate on the "script_id" field.
+func ScriptIDLTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldScriptID, v))
+}
+
+// ScriptIDContains applies the Contains predicate on the "script_id" field.
+func ScriptIDContains(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContains(FieldScriptID, v))
+}
+
+// ScriptIDHasPrefix applies the HasPrefix predicate on the "script_id" field.
+func ScriptIDHasPrefix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasPrefix(FieldScriptID, v))
+}
+
+// ScriptIDHasSuffix applies the HasSuffix predicate on the "script_id" field.
+func ScriptIDHasSuffix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasSuffix(FieldScriptID, v))
+}
+
+// ScriptIDEqualFold applies the EqualFold predicate on the "script_id" field.
+func ScriptIDEqualFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEqualFold(FieldScriptID, v))
+}
+
+// ScriptIDContainsFold applies the ContainsFold predicate on the "script_id" field.
+func ScriptIDContainsFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContainsFold(FieldScriptID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// ChangeNoteEQ applies the EQ predicate on the "change_note" field.
+func ChangeNoteEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldChangeNote, v))
+}
+
+// ChangeNoteNEQ applies the NEQ predicate on the "change_note" field.
+func ChangeNoteNEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldChangeNote, v))
+}
+
+// ChangeNoteIn applies the In predicate on the "change_note" field.
+func ChangeNoteIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldChangeNote, vs...))
+}
+
+// ChangeNoteNotIn applies the NotIn predicate on the "change_note" field.
+func ChangeNoteNotIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldChangeNote, vs...))
+}
+
+// ChangeNoteGT applies the GT predicate on the "change_note" field.
+func ChangeNoteGT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldChangeNote, v))
+}
+
+// ChangeNoteGTE applies the GTE predicate on the "change_note" field.
+func ChangeNoteGTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldChangeNote, v))
+}
+
+// ChangeNoteLT applies the LT predicate on the "change_note" field.
+func ChangeNoteLT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldChangeNote, v))
+}
+
+// ChangeNoteLTE applies the LTE predicate on the "change_note" field.
+func ChangeNoteLTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldChangeNote, v))
+}
+
+// ChangeNoteContains applies the Contains predicate on the "change_note" field.
+func ChangeNoteContains(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContains(FieldChangeNote, v))
+}
+
+// ChangeNoteHasPrefix applies the HasPrefix predicate on the "change_note" field.
+func ChangeNoteHasPrefix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasPrefix(FieldChangeNote, v))
+}
+
+// ChangeNoteHasSuffix applies the HasSuffix predicate on the "change_note" field.
+func ChangeNoteHasSuffix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasSuffix(FieldChangeNote, v))
+}
+
+// ChangeNoteIsNil applies the IsNil predicate on the "change_note" field.
+func ChangeNoteIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldChangeNote))
+}
+
+// ChangeNoteNotNil applies the NotNil predicate on the "change_note" field.
+func ChangeNoteNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldChangeNote))
+}
+
+// ChangeNoteEqualFold applies the EqualFold predicate on the "change_note" field.
+func ChangeNoteEqualFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEqualFold(FieldChangeNote, v))
+}
+
+// ChangeNoteContainsFold applies the ContainsFold predicate on the "change_note" field.
+func ChangeNoteContainsFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContainsFold(FieldChangeNote, v))
+}
+
+// RestoredFromVersionEQ applies the EQ predicate on the "restored_from_version" field.
+func RestoredFromVersionEQ(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionNEQ applies the NEQ predicate on the "restored_from_version" field.
+func RestoredFromVersionNEQ(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionIn applies the In predicate on the "restored_from_version" field.
+func RestoredFromVersionIn(vs ...int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldRestoredFromVersion, vs...))
+}
+
+// RestoredFromVersionNotIn applies the NotIn predicate on the "restored_from_version" field.
+func RestoredFromVersionNotIn(vs ...int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldRestoredFromVersion, vs...))
+}
+
+// RestoredFromVersionGT applies the GT predicate on the "restored_from_version" field.
+func RestoredFromVersionGT(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionGTE applies the GTE predicate on the "restored_from_version" field.
+func RestoredFromVersionGTE(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionLT applies the LT predicate on the "restored_from_version" field.
+func RestoredFromVersionLT(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionLTE applies the LTE predicate on the "restored_from_version" field.
+func RestoredFromVersionLTE(v int) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldRestoredFromVersion, v))
+}
+
+// RestoredFromVersionIsNil applies the IsNil predicate on the "restored_from_version" field.
+func RestoredFromVersionIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldRestoredFromVersion))
+}
+
+// RestoredFromVersionNotNil applies the NotNil predicate on the "restored_from_version" field.
+func RestoredFromVersionNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldRestoredFromVersion))
+}
+
+// ApprovedByEQ applies the EQ predicate on the "approved_by" field.
+func ApprovedByEQ(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByNEQ applies the NEQ predicate on the "approved_by" field.
+func ApprovedByNEQ(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByIn applies the In predicate on the "approved_by" field.
+func ApprovedByIn(vs ...uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByNotIn applies the NotIn predicate on the "approved_by" field.
+func ApprovedByNotIn(vs ...uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByGT applies the GT predicate on the "approved_by" field.
+func ApprovedByGT(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldApprovedBy, v))
+}
+
+// ApprovedByGTE applies the GTE predicate on the "approved_by" field.
+func ApprovedByGTE(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldApprovedBy, v))
+}
+
+// ApprovedByLT applies the LT predicate on the "approved_by" field.
+func ApprovedByLT(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldApprovedBy, v))
+}
+
+// ApprovedByLTE applies the LTE predicate on the "approved_by" field.
+func ApprovedByLTE(v uint32) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldApprovedBy, v))
+}
+
+// ApprovedByIsNil applies the IsNil predicate on the "approved_by" field.
+func ApprovedByIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldApprovedBy))
+}
+
+// ApprovedByNotNil applies the NotNil predicate on the "approved_by" field.
+func ApprovedByNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldApprovedBy))
+}
+
+// ChangeRequestIDEQ applies the EQ predicate on the "change_request_id" field.
+func ChangeRequestIDEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEQ(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDNEQ applies the NEQ predicate on the "change_request_id" field.
+func ChangeRequestIDNEQ(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNEQ(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDIn applies the In predicate on the "change_request_id" field.
+func ChangeRequestIDIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIn(FieldChangeRequestID, vs...))
+}
+
+// ChangeRequestIDNotIn applies the NotIn predicate on the "change_request_id" field.
+func ChangeRequestIDNotIn(vs ...string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotIn(FieldChangeRequestID, vs...))
+}
+
+// ChangeRequestIDGT applies the GT predicate on the "change_request_id" field.
+func ChangeRequestIDGT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGT(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDGTE applies the GTE predicate on the "change_request_id" field.
+func ChangeRequestIDGTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldGTE(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDLT applies the LT predicate on the "change_request_id" field.
+func ChangeRequestIDLT(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLT(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDLTE applies the LTE predicate on the "change_request_id" field.
+func ChangeRequestIDLTE(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldLTE(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDContains applies the Contains predicate on the "change_request_id" field.
+func ChangeRequestIDContains(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContains(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDHasPrefix applies the HasPrefix predicate on the "change_request_id" field.
+func ChangeRequestIDHasPrefix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasPrefix(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDHasSuffix applies the HasSuffix predicate on the "change_request_id" field.
+func ChangeRequestIDHasSuffix(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldHasSuffix(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDIsNil applies the IsNil predicate on the "change_request_id" field.
+func ChangeRequestIDIsNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldIsNull(FieldChangeRequestID))
+}
+
+// ChangeRequestIDNotNil applies the NotNil predicate on the "change_request_id" field.
+func ChangeRequestIDNotNil() predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldNotNull(FieldChangeRequestID))
+}
+
+// ChangeRequestIDEqualFold applies the EqualFold predicate on the "change_request_id" field.
+func ChangeRequestIDEqualFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldEqualFold(FieldChangeRequestID, v))
+}
+
+// ChangeRequestIDContainsFold applies the ContainsFold predicate on the "change_request_id" field.
+func ChangeRequestIDContainsFold(v string) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.FieldContainsFold(FieldChangeRequestID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScriptVersion) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScriptVersion) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScriptVersion) predicate.ScriptVersion {
+	return predicate.ScriptVersion(sql.NotPredicates(p))
+}
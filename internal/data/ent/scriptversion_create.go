@@ -0,0 +1,1366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptversion"
+)
+
+// ScriptVersionCreate is the builder for creating a ScriptVersion entity.
+type ScriptVersionCreate struct {
+	config
+	mutation *ScriptVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ScriptVersionCreate) SetCreateBy(v uint32) *ScriptVersionCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableCreateBy(v *uint32) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ScriptVersionCreate) SetCreateTime(v time.Time) *ScriptVersionCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableCreateTime(v *time.Time) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ScriptVersionCreate) SetUpdateTime(v time.Time) *ScriptVersionCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableUpdateTime(v *time.Time) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ScriptVersionCreate) SetDeleteTime(v time.Time) *ScriptVersionCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableDeleteTime(v *time.Time) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ScriptVersionCreate) SetTenantID(v uint32) *ScriptVersionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableTenantID(v *uint32) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetScriptID sets the "script_id" field.
+func (_c *ScriptVersionCreate) SetScriptID(v string) *ScriptVersionCreate {
+	_c.mutation.SetScriptID(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *ScriptVersionCreate) SetVersion(v int) *ScriptVersionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *ScriptVersionCreate) SetContent(v string) *ScriptVersionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *ScriptVersionCreate) SetContentHash(v string) *ScriptVersionCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetChangeNote sets the "change_note" field.
+func (_c *ScriptVersionCreate) SetChangeNote(v string) *ScriptVersionCreate {
+	_c.mutation.SetChangeNote(v)
+	return _c
+}
+
+// SetNillableChangeNote sets the "change_note" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableChangeNote(v *string) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetChangeNote(*v)
+	}
+	return _c
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (_c *ScriptVersionCreate) SetRestoredFromVersion(v int) *ScriptVersionCreate {
+	_c.mutation.SetRestoredFromVersion(v)
+	return _c
+}
+
+// SetNillableRestoredFromVersion sets the "restored_from_version" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableRestoredFromVersion(v *int) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetRestoredFromVersion(*v)
+	}
+	return _c
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_c *ScriptVersionCreate) SetApprovedBy(v uint32) *ScriptVersionCreate {
+	_c.mutation.SetApprovedBy(v)
+	return _c
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableApprovedBy(v *uint32) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetApprovedBy(*v)
+	}
+	return _c
+}
+
+// SetChangeRequestID sets the "change_request_id" field.
+func (_c *ScriptVersionCreate) SetChangeRequestID(v string) *ScriptVersionCreate {
+	_c.mutation.SetChangeRequestID(v)
+	return _c
+}
+
+// SetNillableChangeRequestID sets the "change_request_id" field if the given value is not nil.
+func (_c *ScriptVersionCreate) SetNillableChangeRequestID(v *string) *ScriptVersionCreate {
+	if v != nil {
+		_c.SetChangeRequestID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScriptVersionCreate) SetID(v string) *ScriptVersionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ScriptVersionMutation object of the builder.
+func (_c *ScriptVersionCreate) Mutation() *ScriptVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the ScriptVersion in the database.
+func (_c *ScriptVersionCreate) Save(ctx context.Context) (*ScriptVersion, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScriptVersionCreate) SaveX(ctx context.Context) *ScriptVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScriptVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScriptVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScriptVersionCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := scriptversion.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScriptVersionCreate) check() error {
+	if _, ok := _c.mutation.ScriptID(); !ok {
+		return &ValidationError{Name: "script_id", err: errors.New(`ent: missing required field "ScriptVersion.script_id"`)}
+	}
+	if v, ok := _c.mutation.ScriptID(); ok {
+		if err := scriptversion.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.script_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ScriptVersion.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := scriptversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ScriptVersion.content"`)}
+	}
+	if _, ok := _c.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "ScriptVersion.content_hash"`)}
+	}
+	if v, ok := _c.mutation.ContentHash(); ok {
+		if err := scriptversion.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ChangeNote(); ok {
+		if err := scriptversion.ChangeNoteValidator(v); err != nil {
+			return &ValidationError{Name: "change_note", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.change_note": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ChangeRequestID(); ok {
+		if err := scriptversion.ChangeRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "change_request_id", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.change_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := scriptversion.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScriptVersionCreate) sqlSave(ctx context.Context) (*ScriptVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ScriptVersion.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScriptVersionCreate) createSpec() (*ScriptVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScriptVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scriptversion.Table, sqlgraph.NewFieldSpec(scriptversion.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(scriptversion.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(scriptversion.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(scriptversion.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(scriptversion.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(scriptversion.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ScriptID(); ok {
+		_spec.SetField(scriptversion.FieldScriptID, field.TypeString, value)
+		_node.ScriptID = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(scriptversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(scriptversion.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(scriptversion.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := _c.mutation.ChangeNote(); ok {
+		_spec.SetField(scriptversion.FieldChangeNote, field.TypeString, value)
+		_node.ChangeNote = value
+	}
+	if value, ok := _c.mutation.RestoredFromVersion(); ok {
+		_spec.SetField(scriptversion.FieldRestoredFromVersion, field.TypeInt, value)
+		_node.RestoredFromVersion = &value
+	}
+	if value, ok := _c.mutation.ApprovedBy(); ok {
+		_spec.SetField(scriptversion.FieldApprovedBy, field.TypeUint32, value)
+		_node.ApprovedBy = &value
+	}
+	if value, ok := _c.mutation.ChangeRequestID(); ok {
+		_spec.SetField(scriptversion.FieldChangeRequestID, field.TypeString, value)
+		_node.ChangeRequestID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScriptVersion.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScriptVersionUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScriptVersionCreate) OnConflict(opts ...sql.ConflictOption) *ScriptVersionUpsertOne {
+	_c.conflict = opts
+	return &ScriptVersionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScriptVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScriptVersionCreate) OnConflictColumns(columns ...string) *ScriptVersionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScriptVersionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ScriptVersionUpsertOne is the builder for "upsert"-ing
+	//  one ScriptVersion node.
+	ScriptVersionUpsertOne struct {
+		create *ScriptVersionCreate
+	}
+
+	// ScriptVersionUpsert is the "OnConflict" setter.
+	ScriptVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScriptVersionUpsert) SetCreateBy(v uint32) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateCreateBy() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScriptVersionUpsert) AddCreateBy(v uint32) *ScriptVersionUpsert {
+	u.Add(scriptversion.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScriptVersionUpsert) ClearCreateBy() *ScriptVersionUpsert {
+	u.SetNull(scriptversion.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScriptVersionUpsert) SetUpdateTime(v time.Time) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateUpdateTime() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScriptVersionUpsert) ClearUpdateTime() *ScriptVersionUpsert {
+	u.SetNull(scriptversion.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScriptVersionUpsert) SetDeleteTime(v time.Time) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateDeleteTime() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScriptVersionUpsert) ClearDeleteTime() *ScriptVersionUpsert {
+	u.SetNull(scriptversion.FieldDeleteTime)
+	return u
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScriptVersionUpsert) SetScriptID(v string) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldScriptID, v)
+	return u
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateScriptID() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldScriptID)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ScriptVersionUpsert) SetVersion(v int) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateVersion() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ScriptVersionUpsert) AddVersion(v int) *ScriptVersionUpsert {
+	u.Add(scriptversion.FieldVersion, v)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ScriptVersionUpsert) SetContent(v string) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateContent() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldContent)
+	return u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ScriptVersionUpsert) SetContentHash(v string) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateContentHash() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldContentHash)
+	return u
+}
+
+// SetChangeNote sets the "change_note" field.
+func (u *ScriptVersionUpsert) SetChangeNote(v string) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldChangeNote, v)
+	return u
+}
+
+// UpdateChangeNote sets the "change_note" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateChangeNote() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldChangeNote)
+	return u
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (u *ScriptVersionUpsert) ClearChangeNote() *ScriptVersionUpsert {
+	u.SetNull(scriptversion.FieldChangeNote)
+	return u
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (u *ScriptVersionUpsert) SetRestoredFromVersion(v int) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldRestoredFromVersion, v)
+	return u
+}
+
+// UpdateRestoredFromVersion sets the "restored_from_version" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateRestoredFromVersion() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldRestoredFromVersion)
+	return u
+}
+
+// AddRestoredFromVersion adds v to the "restored_from_version" field.
+func (u *ScriptVersionUpsert) AddRestoredFromVersion(v int) *ScriptVersionUpsert {
+	u.Add(scriptversion.FieldRestoredFromVersion, v)
+	return u
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (u *ScriptVersionUpsert) ClearRestoredFromVersion() *ScriptVersionUpsert {
+	u.SetNull(scriptversion.FieldRestoredFromVersion)
+	return u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *ScriptVersionUpsert) SetApprovedBy(v uint32) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldApprovedBy, v)
+	return u
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateApprovedBy() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldApprovedBy)
+	return u
+}
+
+// AddApprovedBy adds v to the "approved_by" field.
+func (u *ScriptVersionUpsert) AddApprovedBy(v uint32) *ScriptVersionUpsert {
+	u.Add(scriptversion.FieldApprovedBy, v)
+	return u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *ScriptVersionUpsert) ClearApprovedBy() *ScriptVersionUpsert {
+	u.SetNull(scriptversion.FieldApprovedBy)
+	return u
+}
+
+// SetChangeRequestID sets the "change_request_id" field.
+func (u *ScriptVersionUpsert) SetChangeRequestID(v string) *ScriptVersionUpsert {
+	u.Set(scriptversion.FieldChangeRequestID, v)
+	return u
+}
+
+// UpdateChangeRequestID sets the "change_request_id" field to the value that was provided on create.
+func (u *ScriptVersionUpsert) UpdateChangeRequestID() *ScriptVersionUpsert {
+	u.SetExcluded(scriptversion.FieldChangeRequestID)
+	return u
+}
+
+// ClearChangeRequestID clears the value of the "change_request_id" field.
+func (u *ScriptVersionUpsert) ClearChangeRequestID() *ScriptVersionUpsert {
+	u.SetNull(scriptversion.FieldChangeRequestID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScriptVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scriptversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScriptVersionUpsertOne) UpdateNewValues() *ScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scriptversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(scriptversion.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(scriptversion.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScriptVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScriptVersionUpsertOne) Ignore() *ScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScriptVersionUpsertOne) DoNothing() *ScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScriptVersionCreate.OnConflict
+// documentation for more info.
+func (u *ScriptVersionUpsertOne) Update(set func(*ScriptVersionUpsert)) *ScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScriptVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScriptVersionUpsertOne) SetCreateBy(v uint32) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScriptVersionUpsertOne) AddCreateBy(v uint32) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateCreateBy() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScriptVersionUpsertOne) ClearCreateBy() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScriptVersionUpsertOne) SetUpdateTime(v time.Time) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateUpdateTime() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScriptVersionUpsertOne) ClearUpdateTime() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScriptVersionUpsertOne) SetDeleteTime(v time.Time) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateDeleteTime() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScriptVersionUpsertOne) ClearDeleteTime() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScriptVersionUpsertOne) SetScriptID(v string) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateScriptID() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ScriptVersionUpsertOne) SetVersion(v int) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ScriptVersionUpsertOne) AddVersion(v int) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateVersion() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ScriptVersionUpsertOne) SetContent(v string) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateContent() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ScriptVersionUpsertOne) SetContentHash(v string) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateContentHash() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetChangeNote sets the "change_note" field.
+func (u *ScriptVersionUpsertOne) SetChangeNote(v string) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetChangeNote(v)
+	})
+}
+
+// UpdateChangeNote sets the "change_note" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateChangeNote() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateChangeNote()
+	})
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (u *ScriptVersionUpsertOne) ClearChangeNote() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearChangeNote()
+	})
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (u *ScriptVersionUpsertOne) SetRestoredFromVersion(v int) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetRestoredFromVersion(v)
+	})
+}
+
+// AddRestoredFromVersion adds v to the "restored_from_version" field.
+func (u *ScriptVersionUpsertOne) AddRestoredFromVersion(v int) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.AddRestoredFromVersion(v)
+	})
+}
+
+// UpdateRestoredFromVersion sets the "restored_from_version" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateRestoredFromVersion() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateRestoredFromVersion()
+	})
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (u *ScriptVersionUpsertOne) ClearRestoredFromVersion() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearRestoredFromVersion()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *ScriptVersionUpsertOne) SetApprovedBy(v uint32) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// AddApprovedBy adds v to the "approved_by" field.
+func (u *ScriptVersionUpsertOne) AddApprovedBy(v uint32) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.AddApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateApprovedBy() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *ScriptVersionUpsertOne) ClearApprovedBy() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetChangeRequestID sets the "change_request_id" field.
+func (u *ScriptVersionUpsertOne) SetChangeRequestID(v string) *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetChangeRequestID(v)
+	})
+}
+
+// UpdateChangeRequestID sets the "change_request_id" field to the value that was provided on create.
+func (u *ScriptVersionUpsertOne) UpdateChangeRequestID() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateChangeRequestID()
+	})
+}
+
+// ClearChangeRequestID clears the value of the "change_request_id" field.
+func (u *ScriptVersionUpsertOne) ClearChangeRequestID() *ScriptVersionUpsertOne {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearChangeRequestID()
+	})
+}
+
+// Exec executes the query.
+func (u *ScriptVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScriptVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScriptVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScriptVersionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ScriptVersionUpsertOne.ID is not supported by MySQL driver. Use ScriptVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScriptVersionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScriptVersionCreateBulk is the builder for creating many ScriptVersion entities in bulk.
+type ScriptVersionCreateBulk struct {
+	config
+	err      error
+	builders []*ScriptVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScriptVersion entities in the database.
+func (_c *ScriptVersionCreateBulk) Save(ctx context.Context) ([]*ScriptVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScriptVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScriptVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScriptVersionCreateBulk) SaveX(ctx context.Context) []*ScriptVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScriptVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScriptVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScriptVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScriptVersionUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScriptVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScriptVersionUpsertBulk {
+	_c.conflict = opts
+	return &ScriptVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScriptVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScriptVersionCreateBulk) OnConflictColumns(columns ...string) *ScriptVersionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScriptVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// ScriptVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScriptVersion nodes.
+type ScriptVersionUpsertBulk struct {
+	create *ScriptVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScriptVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scriptversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScriptVersionUpsertBulk) UpdateNewValues() *ScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scriptversion.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(scriptversion.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(scriptversion.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScriptVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScriptVersionUpsertBulk) Ignore() *ScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScriptVersionUpsertBulk) DoNothing() *ScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScriptVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScriptVersionUpsertBulk) Update(set func(*ScriptVersionUpsert)) *ScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScriptVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ScriptVersionUpsertBulk) SetCreateBy(v uint32) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ScriptVersionUpsertBulk) AddCreateBy(v uint32) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateCreateBy() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ScriptVersionUpsertBulk) ClearCreateBy() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ScriptVersionUpsertBulk) SetUpdateTime(v time.Time) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateUpdateTime() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ScriptVersionUpsertBulk) ClearUpdateTime() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ScriptVersionUpsertBulk) SetDeleteTime(v time.Time) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateDeleteTime() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ScriptVersionUpsertBulk) ClearDeleteTime() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *ScriptVersionUpsertBulk) SetScriptID(v string) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateScriptID() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ScriptVersionUpsertBulk) SetVersion(v int) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ScriptVersionUpsertBulk) AddVersion(v int) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateVersion() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ScriptVersionUpsertBulk) SetContent(v string) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateContent() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ScriptVersionUpsertBulk) SetContentHash(v string) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateContentHash() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetChangeNote sets the "change_note" field.
+func (u *ScriptVersionUpsertBulk) SetChangeNote(v string) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetChangeNote(v)
+	})
+}
+
+// UpdateChangeNote sets the "change_note" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateChangeNote() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateChangeNote()
+	})
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (u *ScriptVersionUpsertBulk) ClearChangeNote() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearChangeNote()
+	})
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (u *ScriptVersionUpsertBulk) SetRestoredFromVersion(v int) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetRestoredFromVersion(v)
+	})
+}
+
+// AddRestoredFromVersion adds v to the "restored_from_version" field.
+func (u *ScriptVersionUpsertBulk) AddRestoredFromVersion(v int) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.AddRestoredFromVersion(v)
+	})
+}
+
+// UpdateRestoredFromVersion sets the "restored_from_version" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateRestoredFromVersion() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateRestoredFromVersion()
+	})
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (u *ScriptVersionUpsertBulk) ClearRestoredFromVersion() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearRestoredFromVersion()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *ScriptVersionUpsertBulk) SetApprovedBy(v uint32) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// AddApprovedBy adds v to the "approved_by" field.
+func (u *ScriptVersionUpsertBulk) AddApprovedBy(v uint32) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.AddApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateApprovedBy() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *ScriptVersionUpsertBulk) ClearApprovedBy() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetChangeRequestID sets the "change_request_id" field.
+func (u *ScriptVersionUpsertBulk) SetChangeRequestID(v string) *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.SetChangeRequestID(v)
+	})
+}
+
+// UpdateChangeRequestID sets the "change_request_id" field to the value that was provided on create.
+func (u *ScriptVersionUpsertBulk) UpdateChangeRequestID() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.UpdateChangeRequestID()
+	})
+}
+
+// ClearChangeRequestID clears the value of the "change_request_id" field.
+func (u *ScriptVersionUpsertBulk) ClearChangeRequestID() *ScriptVersionUpsertBulk {
+	return u.Update(func(s *ScriptVersionUpsert) {
+		s.ClearChangeRequestID()
+	})
+}
+
+// Exec executes the query.
+func (u *ScriptVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScriptVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScriptVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScriptVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptversion"
+)
+
+// ScriptVersionUpdate is the builder for updating ScriptVersion entities.
+type ScriptVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScriptVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScriptVersionUpdate builder.
+func (_u *ScriptVersionUpdate) Where(ps ...predicate.ScriptVersion) *ScriptVersionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ScriptVersionUpdate) SetCreateBy(v uint32) *ScriptVersionUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableCreateBy(v *uint32) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ScriptVersionUpdate) AddCreateBy(v int32) *ScriptVersionUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ScriptVersionUpdate) ClearCreateBy() *ScriptVersionUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScriptVersionUpdate) SetUpdateTime(v time.Time) *ScriptVersionUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableUpdateTime(v *time.Time) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ScriptVersionUpdate) ClearUpdateTime() *ScriptVersionUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ScriptVersionUpdate) SetDeleteTime(v time.Time) *ScriptVersionUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableDeleteTime(v *time.Time) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ScriptVersionUpdate) ClearDeleteTime() *ScriptVersionUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ScriptVersionUpdate) SetScriptID(v string) *ScriptVersionUpdate {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableScriptID(v *string) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *ScriptVersionUpdate) SetVersion(v int) *ScriptVersionUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableVersion(v *int) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ScriptVersionUpdate) AddVersion(v int) *ScriptVersionUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ScriptVersionUpdate) SetContent(v string) *ScriptVersionUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableContent(v *string) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ScriptVersionUpdate) SetContentHash(v string) *ScriptVersionUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableContentHash(v *string) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetChangeNote sets the "change_note" field.
+func (_u *ScriptVersionUpdate) SetChangeNote(v string) *ScriptVersionUpdate {
+	_u.mutation.SetChangeNote(v)
+	return _u
+}
+
+// SetNillableChangeNote sets the "change_note" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableChangeNote(v *string) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetChangeNote(*v)
+	}
+	return _u
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (_u *ScriptVersionUpdate) ClearChangeNote() *ScriptVersionUpdate {
+	_u.mutation.ClearChangeNote()
+	return _u
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (_u *ScriptVersionUpdate) SetRestoredFromVersion(v int) *ScriptVersionUpdate {
+	_u.mutation.ResetRestoredFromVersion()
+	_u.mutation.SetRestoredFromVersion(v)
+	return _u
+}
+
+// SetNillableRestoredFromVersion sets the "restored_from_version" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableRestoredFromVersion(v *int) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetRestoredFromVersion(*v)
+	}
+	return _u
+}
+
+// AddRestoredFromVersion adds value to the "restored_from_version" field.
+func (_u *ScriptVersionUpdate) AddRestoredFromVersion(v int) *ScriptVersionUpdate {
+	_u.mutation.AddRestoredFromVersion(v)
+	return _u
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (_u *ScriptVersionUpdate) ClearRestoredFromVersion() *ScriptVersionUpdate {
+	_u.mutation.ClearRestoredFromVersion()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *ScriptVersionUpdate) SetApprovedBy(v uint32) *ScriptVersionUpdate {
+	_u.mutation.ResetApprovedBy()
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableApprovedBy(v *uint32) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// AddApprovedBy adds value to the "approved_by" field.
+func (_u *ScriptVersionUpdate) AddApprovedBy(v int32) *ScriptVersionUpdate {
+	_u.mutation.AddApprovedBy(v)
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *ScriptVersionUpdate) ClearApprovedBy() *ScriptVersionUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetChangeRequestID sets the "change_request_id" field.
+func (_u *ScriptVersionUpdate) SetChangeRequestID(v string) *ScriptVersionUpdate {
+	_u.mutation.SetChangeRequestID(v)
+	return _u
+}
+
+// SetNillableChangeRequestID sets the "change_request_id" field if the given value is not nil.
+func (_u *ScriptVersionUpdate) SetNillableChangeRequestID(v *string) *ScriptVersionUpdate {
+	if v != nil {
+		_u.SetChangeRequestID(*v)
+	}
+	return _u
+}
+
+// ClearChangeRequestID clears the value of the "change_request_id" field.
+func (_u *ScriptVersionUpdate) ClearChangeRequestID() *ScriptVersionUpdate {
+	_u.mutation.ClearChangeRequestID()
+	return _u
+}
+
+// Mutation returns the ScriptVersionMutation object of the builder.
+func (_u *ScriptVersionUpdate) Mutation() *ScriptVersionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScriptVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScriptVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScriptVersionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScriptVersionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScriptVersionUpdate) check() error {
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := scriptversion.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := scriptversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := scriptversion.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChangeNote(); ok {
+		if err := scriptversion.ChangeNoteValidator(v); err != nil {
+			return &ValidationError{Name: "change_note", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.change_note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChangeRequestID(); ok {
+		if err := scriptversion.ChangeRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "change_request_id", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.change_request_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScriptVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScriptVersionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScriptVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scriptversion.Table, scriptversion.Columns, sqlgraph.NewFieldSpec(scriptversion.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(scriptversion.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(scriptversion.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(scriptversion.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(scriptversion.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scriptversion.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(scriptversion.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(scriptversion.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(scriptversion.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(scriptversion.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(scriptversion.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(scriptversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(scriptversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(scriptversion.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(scriptversion.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangeNote(); ok {
+		_spec.SetField(scriptversion.FieldChangeNote, field.TypeString, value)
+	}
+	if _u.mutation.ChangeNoteCleared() {
+		_spec.ClearField(scriptversion.FieldChangeNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestoredFromVersion(); ok {
+		_spec.SetField(scriptversion.FieldRestoredFromVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRestoredFromVersion(); ok {
+		_spec.AddField(scriptversion.FieldRestoredFromVersion, field.TypeInt, value)
+	}
+	if _u.mutation.RestoredFromVersionCleared() {
+		_spec.ClearField(scriptversion.FieldRestoredFromVersion, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(scriptversion.FieldApprovedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedApprovedBy(); ok {
+		_spec.AddField(scriptversion.FieldApprovedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(scriptversion.FieldApprovedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ChangeRequestID(); ok {
+		_spec.SetField(scriptversion.FieldChangeRequestID, field.TypeString, value)
+	}
+	if _u.mutation.ChangeRequestIDCleared() {
+		_spec.ClearField(scriptversion.FieldChangeRequestID, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scriptversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScriptVersionUpdateOne is the builder for updating a single ScriptVersion entity.
+type ScriptVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScriptVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ScriptVersionUpdateOne) SetCreateBy(v uint32) *ScriptVersionUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableCreateBy(v *uint32) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ScriptVersionUpdateOne) AddCreateBy(v int32) *ScriptVersionUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ScriptVersionUpdateOne) ClearCreateBy() *ScriptVersionUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScriptVersionUpdateOne) SetUpdateTime(v time.Time) *ScriptVersionUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableUpdateTime(v *time.Time) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ScriptVersionUpdateOne) ClearUpdateTime() *ScriptVersionUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ScriptVersionUpdateOne) SetDeleteTime(v time.Time) *ScriptVersionUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableDeleteTime(v *time.Time) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ScriptVersionUpdateOne) ClearDeleteTime() *ScriptVersionUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *ScriptVersionUpdateOne) SetScriptID(v string) *ScriptVersionUpdateOne {
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableScriptID(v *string) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *ScriptVersionUpdateOne) SetVersion(v int) *ScriptVersionUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableVersion(v *int) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ScriptVersionUpdateOne) AddVersion(v int) *ScriptVersionUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ScriptVersionUpdateOne) SetContent(v string) *ScriptVersionUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableContent(v *string) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ScriptVersionUpdateOne) SetContentHash(v string) *ScriptVersionUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableContentHash(v *string) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetChangeNote sets the "change_note" field.
+func (_u *ScriptVersionUpdateOne) SetChangeNote(v string) *ScriptVersionUpdateOne {
+	_u.mutation.SetChangeNote(v)
+	return _u
+}
+
+// SetNillableChangeNote sets the "change_note" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableChangeNote(v *string) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetChangeNote(*v)
+	}
+	return _u
+}
+
+// ClearChangeNote clears the value of the "change_note" field.
+func (_u *ScriptVersionUpdateOne) ClearChangeNote() *ScriptVersionUpdateOne {
+	_u.mutation.ClearChangeNote()
+	return _u
+}
+
+// SetRestoredFromVersion sets the "restored_from_version" field.
+func (_u *ScriptVersionUpdateOne) SetRestoredFromVersion(v int) *ScriptVersionUpdateOne {
+	_u.mutation.ResetRestoredFromVersion()
+	_u.mutation.SetRestoredFromVersion(v)
+	return _u
+}
+
+// SetNillableRestoredFromVersion sets the "restored_from_version" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableRestoredFromVersion(v *int) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetRestoredFromVersion(*v)
+	}
+	return _u
+}
+
+// AddRestoredFromVersion adds value to the "restored_from_version" field.
+func (_u *ScriptVersionUpdateOne) AddRestoredFromVersion(v int) *ScriptVersionUpdateOne {
+	_u.mutation.AddRestoredFromVersion(v)
+	return _u
+}
+
+// ClearRestoredFromVersion clears the value of the "restored_from_version" field.
+func (_u *ScriptVersionUpdateOne) ClearRestoredFromVersion() *ScriptVersionUpdateOne {
+	_u.mutation.ClearRestoredFromVersion()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *ScriptVersionUpdateOne) SetApprovedBy(v uint32) *ScriptVersionUpdateOne {
+	_u.mutation.ResetApprovedBy()
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableApprovedBy(v *uint32) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// AddApprovedBy adds value to the "approved_by" field.
+func (_u *ScriptVersionUpdateOne) AddApprovedBy(v int32) *ScriptVersionUpdateOne {
+	_u.mutation.AddApprovedBy(v)
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *ScriptVersionUpdateOne) ClearApprovedBy() *ScriptVersionUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetChangeRequestID sets the "change_request_id" field.
+func (_u *ScriptVersionUpdateOne) SetChangeRequestID(v string) *ScriptVersionUpdateOne {
+	_u.mutation.SetChangeRequestID(v)
+	return _u
+}
+
+// SetNillableChangeRequestID sets the "change_request_id" field if the given value is not nil.
+func (_u *ScriptVersionUpdateOne) SetNillableChangeRequestID(v *string) *ScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetChangeRequestID(*v)
+	}
+	return _u
+}
+
+// ClearChangeRequestID clears the value of the "change_request_id" field.
+func (_u *ScriptVersionUpdateOne) ClearChangeRequestID() *ScriptVersionUpdateOne {
+	_u.mutation.ClearChangeRequestID()
+	return _u
+}
+
+// Mutation returns the ScriptVersionMutation object of the builder.
+func (_u *ScriptVersionUpdateOne) Mutation() *ScriptVersionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScriptVersionUpdate builder.
+func (_u *ScriptVersionUpdateOne) Where(ps ...predicate.ScriptVersion) *ScriptVersionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScriptVersionUpdateOne) Select(field string, fields ...string) *ScriptVersionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScriptVersion entity.
+func (_u *ScriptVersionUpdateOne) Save(ctx context.Context) (*ScriptVersion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScriptVersionUpdateOne) SaveX(ctx context.Context) *ScriptVersion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScriptVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScriptVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScriptVersionUpdateOne) check() error {
+	if v, ok := _u.mutation.ScriptID(); ok {
+		if err := scriptversion.ScriptIDValidator(v); err != nil {
+			return &ValidationError{Name: "script_id", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.script_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := scriptversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := scriptversion.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChangeNote(); ok {
+		if err := scriptversion.ChangeNoteValidator(v); err != nil {
+			return &ValidationError{Name: "change_note", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.change_note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChangeRequestID(); ok {
+		if err := scriptversion.ChangeRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "change_request_id", err: fmt.Errorf(`ent: validator failed for field "ScriptVersion.change_request_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScriptVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScriptVersionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScriptVersionUpdateOne) sqlSave(ctx context.Context) (_node *ScriptVersion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scriptversion.Table, scriptversion.Columns, sqlgraph.NewFieldSpec(scriptversion.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScriptVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scriptversion.FieldID)
+		for _, f := range fields {
+			if !scriptversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scriptversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(scriptversion.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(scriptversion.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(scriptversion.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(scriptversion.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scriptversion.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(scriptversion.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(scriptversion.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(scriptversion.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(scriptversion.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(scriptversion.FieldScriptID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(scriptversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(scriptversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(scriptversion.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(scriptversion.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangeNote(); ok {
+		_spec.SetField(scriptversion.FieldChangeNote, field.TypeString, value)
+	}
+	if _u.mutation.ChangeNoteCleared() {
+		_spec.ClearField(scriptversion.FieldChangeNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestoredFromVersion(); ok {
+		_spec.SetField(scriptversion.FieldRestoredFromVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRestoredFromVersion(); ok {
+		_spec.AddField(scriptversion.FieldRestoredFromVersion, field.TypeInt, value)
+	}
+	if _u.mutation.RestoredFromVersionCleared() {
+		_spec.ClearField(scriptversion.FieldRestoredFromVersion, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(scriptversion.FieldApprovedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedApprovedBy(); ok {
+		_spec.AddField(scriptversion.FieldApprovedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(scriptversion.FieldApprovedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ChangeRequestID(); ok {
+		_spec.SetField(scriptversion.FieldChangeRequestID, field.TypeString, value)
+	}
+	if _u.mutation.ChangeRequestIDCleared() {
+		_spec.ClearField(scriptversion.FieldChangeRequestID, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ScriptVersion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scriptversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
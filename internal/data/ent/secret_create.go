@@ -0,0 +1,1126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/secret"
+)
+
+// SecretCreate is the builder for creating a Secret entity.
+type SecretCreate struct {
+	config
+	mutation *SecretMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *SecretCreate) SetCreateBy(v uint32) *SecretCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableCreateBy(v *uint32) *SecretCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *SecretCreate) SetUpdateBy(v uint32) *SecretCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableUpdateBy(v *uint32) *SecretCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SecretCreate) SetCreateTime(v time.Time) *SecretCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableCreateTime(v *time.Time) *SecretCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SecretCreate) SetUpdateTime(v time.Time) *SecretCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableUpdateTime(v *time.Time) *SecretCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *SecretCreate) SetDeleteTime(v time.Time) *SecretCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableDeleteTime(v *time.Time) *SecretCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SecretCreate) SetTenantID(v uint32) *SecretCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableTenantID(v *uint32) *SecretCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SecretCreate) SetName(v string) *SecretCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *SecretCreate) SetDescription(v string) *SecretCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableDescription(v *string) *SecretCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (_c *SecretCreate) SetCiphertext(v []byte) *SecretCreate {
+	_c.mutation.SetCiphertext(v)
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *SecretCreate) SetNonce(v []byte) *SecretCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *SecretCreate) SetKeyID(v string) *SecretCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SecretCreate) SetID(v string) *SecretCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the SecretMutation object of the builder.
+func (_c *SecretCreate) Mutation() *SecretMutation {
+	return _c.mutation
+}
+
+// Save creates the Secret in the database.
+func (_c *SecretCreate) Save(ctx context.Context) (*Secret, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SecretCreate) SaveX(ctx context.Context) *Secret {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SecretCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SecretCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SecretCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := secret.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SecretCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Secret.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := secret.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Secret.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := secret.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Secret.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Ciphertext(); !ok {
+		return &ValidationError{Name: "ciphertext", err: errors.New(`ent: missing required field "Secret.ciphertext"`)}
+	}
+	if _, ok := _c.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "Secret.nonce"`)}
+	}
+	if _, ok := _c.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "Secret.key_id"`)}
+	}
+	if v, ok := _c.mutation.KeyID(); ok {
+		if err := secret.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "Secret.key_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := secret.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Secret.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SecretCreate) sqlSave(ctx context.Context) (*Secret, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Secret.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SecretCreate) createSpec() (*Secret, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Secret{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(secret.Table, sqlgraph.NewFieldSpec(secret.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(secret.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(secret.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(secret.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(secret.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(secret.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(secret.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(secret.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(secret.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Ciphertext(); ok {
+		_spec.SetField(secret.FieldCiphertext, field.TypeBytes, value)
+		_node.Ciphertext = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(secret.FieldNonce, field.TypeBytes, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(secret.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Secret.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecretUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SecretCreate) OnConflict(opts ...sql.ConflictOption) *SecretUpsertOne {
+	_c.conflict = opts
+	return &SecretUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SecretCreate) OnConflictColumns(columns ...string) *SecretUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SecretUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SecretUpsertOne is the builder for "upsert"-ing
+	//  one Secret node.
+	SecretUpsertOne struct {
+		create *SecretCreate
+	}
+
+	// SecretUpsert is the "OnConflict" setter.
+	SecretUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *SecretUpsert) SetCreateBy(v uint32) *SecretUpsert {
+	u.Set(secret.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateCreateBy() *SecretUpsert {
+	u.SetExcluded(secret.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SecretUpsert) AddCreateBy(v uint32) *SecretUpsert {
+	u.Add(secret.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SecretUpsert) ClearCreateBy() *SecretUpsert {
+	u.SetNull(secret.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *SecretUpsert) SetUpdateBy(v uint32) *SecretUpsert {
+	u.Set(secret.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateUpdateBy() *SecretUpsert {
+	u.SetExcluded(secret.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *SecretUpsert) AddUpdateBy(v uint32) *SecretUpsert {
+	u.Add(secret.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *SecretUpsert) ClearUpdateBy() *SecretUpsert {
+	u.SetNull(secret.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SecretUpsert) SetUpdateTime(v time.Time) *SecretUpsert {
+	u.Set(secret.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateUpdateTime() *SecretUpsert {
+	u.SetExcluded(secret.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SecretUpsert) ClearUpdateTime() *SecretUpsert {
+	u.SetNull(secret.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SecretUpsert) SetDeleteTime(v time.Time) *SecretUpsert {
+	u.Set(secret.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateDeleteTime() *SecretUpsert {
+	u.SetExcluded(secret.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SecretUpsert) ClearDeleteTime() *SecretUpsert {
+	u.SetNull(secret.FieldDeleteTime)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsert) SetDescription(v string) *SecretUpsert {
+	u.Set(secret.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateDescription() *SecretUpsert {
+	u.SetExcluded(secret.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsert) ClearDescription() *SecretUpsert {
+	u.SetNull(secret.FieldDescription)
+	return u
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *SecretUpsert) SetCiphertext(v []byte) *SecretUpsert {
+	u.Set(secret.FieldCiphertext, v)
+	return u
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateCiphertext() *SecretUpsert {
+	u.SetExcluded(secret.FieldCiphertext)
+	return u
+}
+
+// SetNonce sets the "nonce" field.
+func (u *SecretUpsert) SetNonce(v []byte) *SecretUpsert {
+	u.Set(secret.FieldNonce, v)
+	return u
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateNonce() *SecretUpsert {
+	u.SetExcluded(secret.FieldNonce)
+	return u
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *SecretUpsert) SetKeyID(v string) *SecretUpsert {
+	u.Set(secret.FieldKeyID, v)
+	return u
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateKeyID() *SecretUpsert {
+	u.SetExcluded(secret.FieldKeyID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(secret.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecretUpsertOne) UpdateNewValues() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(secret.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(secret.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(secret.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(secret.FieldName)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SecretUpsertOne) Ignore() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecretUpsertOne) DoNothing() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecretCreate.OnConflict
+// documentation for more info.
+func (u *SecretUpsertOne) Update(set func(*SecretUpsert)) *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SecretUpsertOne) SetCreateBy(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SecretUpsertOne) AddCreateBy(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateCreateBy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SecretUpsertOne) ClearCreateBy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *SecretUpsertOne) SetUpdateBy(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *SecretUpsertOne) AddUpdateBy(v uint32) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateUpdateBy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *SecretUpsertOne) ClearUpdateBy() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SecretUpsertOne) SetUpdateTime(v time.Time) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateUpdateTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SecretUpsertOne) ClearUpdateTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SecretUpsertOne) SetDeleteTime(v time.Time) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateDeleteTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SecretUpsertOne) ClearDeleteTime() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsertOne) SetDescription(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateDescription() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsertOne) ClearDescription() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *SecretUpsertOne) SetCiphertext(v []byte) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCiphertext(v)
+	})
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateCiphertext() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCiphertext()
+	})
+}
+
+// SetNonce sets the "nonce" field.
+func (u *SecretUpsertOne) SetNonce(v []byte) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetNonce(v)
+	})
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateNonce() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateNonce()
+	})
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *SecretUpsertOne) SetKeyID(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateKeyID() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// Exec executes the query.
+func (u *SecretUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SecretCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecretUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SecretUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SecretUpsertOne.ID is not supported by MySQL driver. Use SecretUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SecretUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SecretCreateBulk is the builder for creating many Secret entities in bulk.
+type SecretCreateBulk struct {
+	config
+	err      error
+	builders []*SecretCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Secret entities in the database.
+func (_c *SecretCreateBulk) Save(ctx context.Context) ([]*Secret, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Secret, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecretMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SecretCreateBulk) SaveX(ctx context.Context) []*Secret {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SecretCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SecretCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Secret.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecretUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SecretCreateBulk) OnConflict(opts ...sql.ConflictOption) *SecretUpsertBulk {
+	_c.conflict = opts
+	return &SecretUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SecretCreateBulk) OnConflictColumns(columns ...string) *SecretUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SecretUpsertBulk{
+		create: _c,
+	}
+}
+
+// SecretUpsertBulk is the builder for "upsert"-ing
+// a bulk of Secret nodes.
+type SecretUpsertBulk struct {
+	create *SecretCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(secret.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecretUpsertBulk) UpdateNewValues() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(secret.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(secret.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(secret.FieldTenantID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(secret.FieldName)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SecretUpsertBulk) Ignore() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecretUpsertBulk) DoNothing() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecretCreateBulk.OnConflict
+// documentation for more info.
+func (u *SecretUpsertBulk) Update(set func(*SecretUpsert)) *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SecretUpsertBulk) SetCreateBy(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SecretUpsertBulk) AddCreateBy(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateCreateBy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SecretUpsertBulk) ClearCreateBy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *SecretUpsertBulk) SetUpdateBy(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *SecretUpsertBulk) AddUpdateBy(v uint32) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateUpdateBy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *SecretUpsertBulk) ClearUpdateBy() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SecretUpsertBulk) SetUpdateTime(v time.Time) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateUpdateTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SecretUpsertBulk) ClearUpdateTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SecretUpsertBulk) SetDeleteTime(v time.Time) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateDeleteTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SecretUpsertBulk) ClearDeleteTime() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsertBulk) SetDescription(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateDescription() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsertBulk) ClearDescription() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *SecretUpsertBulk) SetCiphertext(v []byte) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCiphertext(v)
+	})
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateCiphertext() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCiphertext()
+	})
+}
+
+// SetNonce sets the "nonce" field.
+func (u *SecretUpsertBulk) SetNonce(v []byte) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetNonce(v)
+	})
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateNonce() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateNonce()
+	})
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *SecretUpsertBulk) SetKeyID(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateKeyID() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// Exec executes the query.
+func (u *SecretUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SecretCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SecretCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecretUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
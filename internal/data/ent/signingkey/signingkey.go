@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signingkey
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the signingkey type in the database.
+	Label = "signing_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldAlgorithm holds the string denoting the algorithm field in the database.
+	FieldAlgorithm = "algorithm"
+	// FieldPublicKey holds the string denoting the public_key field in the database.
+	FieldPublicKey = "public_key"
+	// FieldPrivateKey holds the string denoting the private_key field in the database.
+	FieldPrivateKey = "private_key"
+	// FieldNonce holds the string denoting the nonce field in the database.
+	FieldNonce = "nonce"
+	// FieldSealedWith holds the string denoting the sealed_with field in the database.
+	FieldSealedWith = "sealed_with"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRetiredAt holds the string denoting the retired_at field in the database.
+	FieldRetiredAt = "retired_at"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// Table holds the table name of the signingkey in the database.
+	Table = "executor_signing_keys"
+)
+
+// Columns holds all SQL columns for signingkey fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldAlgorithm,
+	FieldPublicKey,
+	FieldPrivateKey,
+	FieldNonce,
+	FieldSealedWith,
+	FieldStatus,
+	FieldRetiredAt,
+	FieldRevokedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	PublicKeyValidator func([]byte) error
+	// SealedWithValidator is a validator for the "sealed_with" field. It is called by the builders before save.
+	SealedWithValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Algorithm defines the type for the "algorithm" enum field.
+type Algorithm string
+
+// Algorithm values.
+const (
+	AlgorithmED25519    Algorithm = "ED25519"
+	AlgorithmECDSA_P256 Algorithm = "ECDSA_P256"
+)
+
+func (a Algorithm) String() string {
+	return string(a)
+}
+
+// AlgorithmValidator is a validator for the "algorithm" field enum values. It is called by the builders before save.
+func AlgorithmValidator(a Algorithm) error {
+	switch a {
+	case AlgorithmED25519, AlgorithmECDSA_P256:
+		return nil
+	default:
+		return fmt.Errorf("signingkey: invalid enum value for algorithm field: %q", a)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusACTIVE is the default value of the Status enum.
+const DefaultStatus = StatusACTIVE
+
+// Status values.
+const (
+	StatusACTIVE  Status = "ACTIVE"
+	StatusRETIRED Status = "RETIRED"
+	StatusREVOKED Status = "REVOKED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusACTIVE, StatusRETIRED, StatusREVOKED:
+		return nil
+	default:
+		return fmt.Errorf("signingkey: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SigningKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByAlgorithm orders the results by the algorithm field.
+func ByAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlgorithm, opts...).ToFunc()
+}
+
+// BySealedWith orders the results by the sealed_with field.
+func BySealedWith(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSealedWith, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRetiredAt orders the results by the retired_at field.
+func ByRetiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetiredAt, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/signingkey"
+)
+
+// SigningKeyCreate is the builder for creating a SigningKey entity.
+type SigningKeyCreate struct {
+	config
+	mutation *SigningKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *SigningKeyCreate) SetCreateBy(v uint32) *SigningKeyCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *SigningKeyCreate) SetNillableCreateBy(v *uint32) *SigningKeyCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SigningKeyCreate) SetCreateTime(v time.Time) *SigningKeyCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SigningKeyCreate) SetNillableCreateTime(v *time.Time) *SigningKeyCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SigningKeyCreate) SetUpdateTime(v time.Time) *SigningKeyCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SigningKeyCreate) SetNillableUpdateTime(v *time.Time) *SigningKeyCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *SigningKeyCreate) SetDeleteTime(v time.Time) *SigningKeyCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *SigningKeyCreate) SetNillableDeleteTime(v *time.Time) *SigningKeyCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_c *SigningKeyCreate) SetAlgorithm(v signingkey.Algorithm) *SigningKeyCreate {
+	_c.mutation.SetAlgorithm(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *SigningKeyCreate) SetPublicKey(v []byte) *SigningKeyCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_c *SigningKeyCreate) SetPrivateKey(v []byte) *SigningKeyCreate {
+	_c.mutation.SetPrivateKey(v)
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *SigningKeyCreate) SetNonce(v []byte) *SigningKeyCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetSealedWith sets the "sealed_with" field.
+func (_c *SigningKeyCreate) SetSealedWith(v string) *SigningKeyCreate {
+	_c.mutation.SetSealedWith(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SigningKeyCreate) SetStatus(v signingkey.Status) *SigningKeyCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SigningKeyCreate) SetNillableStatus(v *signingkey.Status) *SigningKeyCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_c *SigningKeyCreate) SetRetiredAt(v time.Time) *SigningKeyCreate {
+	_c.mutation.SetRetiredAt(v)
+	return _c
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_c *SigningKeyCreate) SetNillableRetiredAt(v *time.Time) *SigningKeyCreate {
+	if v != nil {
+		_c.SetRetiredAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *SigningKeyCreate) SetRevokedAt(v time.Time) *SigningKeyCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *SigningKeyCreate) SetNillableRevokedAt(v *time.Time) *SigningKeyCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SigningKeyCreate) SetID(v string) *SigningKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (_c *SigningKeyCreate) Mutation() *SigningKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the SigningKey in the database.
+func (_c *SigningKeyCreate) Save(ctx context.Context) (*SigningKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SigningKeyCreate) SaveX(ctx context.Context) *SigningKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SigningKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SigningKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SigningKeyCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := signingkey.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SigningKeyCreate) check() error {
+	if _, ok := _c.mutation.Algorithm(); !ok {
+		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "SigningKey.algorithm"`)}
+	}
+	if v, ok := _c.mutation.Algorithm(); ok {
+		if err := signingkey.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SigningKey.algorithm": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "SigningKey.public_key"`)}
+	}
+	if v, ok := _c.mutation.PublicKey(); ok {
+		if err := signingkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SigningKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "private_key", err: errors.New(`ent: missing required field "SigningKey.private_key"`)}
+	}
+	if _, ok := _c.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "SigningKey.nonce"`)}
+	}
+	if _, ok := _c.mutation.SealedWith(); !ok {
+		return &ValidationError{Name: "sealed_with", err: errors.New(`ent: missing required field "SigningKey.sealed_with"`)}
+	}
+	if v, ok := _c.mutation.SealedWith(); ok {
+		if err := signingkey.SealedWithValidator(v); err != nil {
+			return &ValidationError{Name: "sealed_with", err: fmt.Errorf(`ent: validator failed for field "SigningKey.sealed_with": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SigningKey.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := signingkey.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SigningKey.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SigningKeyCreate) sqlSave(ctx context.Context) (*SigningKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SigningKey.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SigningKeyCreate) createSpec() (*SigningKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SigningKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(signingkey.Table, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(signingkey.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(signingkey.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(signingkey.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(signingkey.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.Algorithm(); ok {
+		_spec.SetField(signingkey.FieldAlgorithm, field.TypeEnum, value)
+		_node.Algorithm = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(signingkey.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeBytes, value)
+		_node.PrivateKey = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(signingkey.FieldNonce, field.TypeBytes, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.SealedWith(); ok {
+		_spec.SetField(signingkey.FieldSealedWith, field.TypeString, value)
+		_node.SealedWith = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(signingkey.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RetiredAt(); ok {
+		_spec.SetField(signingkey.FieldRetiredAt, field.TypeTime, value)
+		_node.RetiredAt = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(signingkey.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SigningKey.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SigningKeyUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SigningKeyCreate) OnConflict(opts ...sql.ConflictOption) *SigningKeyUpsertOne {
+	_c.conflict = opts
+	return &SigningKeyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SigningKeyCreate) OnConflictColumns(columns ...string) *SigningKeyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SigningKeyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SigningKeyUpsertOne is the builder for "upsert"-ing
+	//  one SigningKey node.
+	SigningKeyUpsertOne struct {
+		create *SigningKeyCreate
+	}
+
+	// SigningKeyUpsert is the "OnConflict" setter.
+	SigningKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *SigningKeyUpsert) SetCreateBy(v uint32) *SigningKeyUpsert {
+	u.Set(signingkey.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateCreateBy() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SigningKeyUpsert) AddCreateBy(v uint32) *SigningKeyUpsert {
+	u.Add(signingkey.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SigningKeyUpsert) ClearCreateBy() *SigningKeyUpsert {
+	u.SetNull(signingkey.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SigningKeyUpsert) SetUpdateTime(v time.Time) *SigningKeyUpsert {
+	u.Set(signingkey.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateUpdateTime() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SigningKeyUpsert) ClearUpdateTime() *SigningKeyUpsert {
+	u.SetNull(signingkey.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SigningKeyUpsert) SetDeleteTime(v time.Time) *SigningKeyUpsert {
+	u.Set(signingkey.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateDeleteTime() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SigningKeyUpsert) ClearDeleteTime() *SigningKeyUpsert {
+	u.SetNull(signingkey.FieldDeleteTime)
+	return u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (u *SigningKeyUpsert) SetAlgorithm(v signingkey.Algorithm) *SigningKeyUpsert {
+	u.Set(signingkey.FieldAlgorithm, v)
+	return u
+}
+
+// UpdateAlgorithm sets the "algorithm" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateAlgorithm() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldAlgorithm)
+	return u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *SigningKeyUpsert) SetPublicKey(v []byte) *SigningKeyUpsert {
+	u.Set(signingkey.FieldPublicKey, v)
+	return u
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdatePublicKey() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldPublicKey)
+	return u
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (u *SigningKeyUpsert) SetPrivateKey(v []byte) *SigningKeyUpsert {
+	u.Set(signingkey.FieldPrivateKey, v)
+	return u
+}
+
+// UpdatePrivateKey sets the "private_key" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdatePrivateKey() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldPrivateKey)
+	return u
+}
+
+// SetNonce sets the "nonce" field.
+func (u *SigningKeyUpsert) SetNonce(v []byte) *SigningKeyUpsert {
+	u.Set(signingkey.FieldNonce, v)
+	return u
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateNonce() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldNonce)
+	return u
+}
+
+// SetSealedWith sets the "sealed_with" field.
+func (u *SigningKeyUpsert) SetSealedWith(v string) *SigningKeyUpsert {
+	u.Set(signingkey.FieldSealedWith, v)
+	return u
+}
+
+// UpdateSealedWith sets the "sealed_with" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateSealedWith() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldSealedWith)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SigningKeyUpsert) SetStatus(v signingkey.Status) *SigningKeyUpsert {
+	u.Set(signingkey.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateStatus() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldStatus)
+	return u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (u *SigningKeyUpsert) SetRetiredAt(v time.Time) *SigningKeyUpsert {
+	u.Set(signingkey.FieldRetiredAt, v)
+	return u
+}
+
+// UpdateRetiredAt sets the "retired_at" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateRetiredAt() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldRetiredAt)
+	return u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (u *SigningKeyUpsert) ClearRetiredAt() *SigningKeyUpsert {
+	u.SetNull(signingkey.FieldRetiredAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *SigningKeyUpsert) SetRevokedAt(v time.Time) *SigningKeyUpsert {
+	u.Set(signingkey.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateRevokedAt() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *SigningKeyUpsert) ClearRevokedAt() *SigningKeyUpsert {
+	u.SetNull(signingkey.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(signingkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SigningKeyUpsertOne) UpdateNewValues() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(signingkey.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(signingkey.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SigningKeyUpsertOne) Ignore() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SigningKeyUpsertOne) DoNothing() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SigningKeyCreate.OnConflict
+// documentation for more info.
+func (u *SigningKeyUpsertOne) Update(set func(*SigningKeyUpsert)) *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SigningKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SigningKeyUpsertOne) SetCreateBy(v uint32) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SigningKeyUpsertOne) AddCreateBy(v uint32) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateCreateBy() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SigningKeyUpsertOne) ClearCreateBy() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SigningKeyUpsertOne) SetUpdateTime(v time.Time) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateUpdateTime() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SigningKeyUpsertOne) ClearUpdateTime() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SigningKeyUpsertOne) SetDeleteTime(v time.Time) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateDeleteTime() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SigningKeyUpsertOne) ClearDeleteTime() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (u *SigningKeyUpsertOne) SetAlgorithm(v signingkey.Algorithm) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetAlgorithm(v)
+	})
+}
+
+// UpdateAlgorithm sets the "algorithm" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateAlgorithm() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateAlgorithm()
+	})
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *SigningKeyUpsertOne) SetPublicKey(v []byte) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetPublicKey(v)
+	})
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdatePublicKey() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdatePublicKey()
+	})
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (u *SigningKeyUpsertOne) SetPrivateKey(v []byte) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetPrivateKey(v)
+	})
+}
+
+// UpdatePrivateKey sets the "private_key" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdatePrivateKey() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdatePrivateKey()
+	})
+}
+
+// SetNonce sets the "nonce" field.
+func (u *SigningKeyUpsertOne) SetNonce(v []byte) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetNonce(v)
+	})
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateNonce() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateNonce()
+	})
+}
+
+// SetSealedWith sets the "sealed_with" field.
+func (u *SigningKeyUpsertOne) SetSealedWith(v string) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetSealedWith(v)
+	})
+}
+
+// UpdateSealedWith sets the "sealed_with" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateSealedWith() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateSealedWith()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SigningKeyUpsertOne) SetStatus(v signingkey.Status) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateStatus() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (u *SigningKeyUpsertOne) SetRetiredAt(v time.Time) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetRetiredAt(v)
+	})
+}
+
+// UpdateRetiredAt sets the "retired_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateRetiredAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateRetiredAt()
+	})
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (u *SigningKeyUpsertOne) ClearRetiredAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearRetiredAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *SigningKeyUpsertOne) SetRevokedAt(v time.Time) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateRevokedAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *SigningKeyUpsertOne) ClearRevokedAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SigningKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SigningKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SigningKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SigningKeyUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SigningKeyUpsertOne.ID is not supported by MySQL driver. Use SigningKeyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SigningKeyUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SigningKeyCreateBulk is the builder for creating many SigningKey entities in bulk.
+type SigningKeyCreateBulk struct {
+	config
+	err      error
+	builders []*SigningKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SigningKey entities in the database.
+func (_c *SigningKeyCreateBulk) Save(ctx context.Context) ([]*SigningKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SigningKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SigningKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SigningKeyCreateBulk) SaveX(ctx context.Context) []*SigningKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SigningKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SigningKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SigningKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SigningKeyUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SigningKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *SigningKeyUpsertBulk {
+	_c.conflict = opts
+	return &SigningKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SigningKeyCreateBulk) OnConflictColumns(columns ...string) *SigningKeyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SigningKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// SigningKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of SigningKey nodes.
+type SigningKeyUpsertBulk struct {
+	create *SigningKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(signingkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SigningKeyUpsertBulk) UpdateNewValues() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(signingkey.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(signingkey.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SigningKeyUpsertBulk) Ignore() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SigningKeyUpsertBulk) DoNothing() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SigningKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *SigningKeyUpsertBulk) Update(set func(*SigningKeyUpsert)) *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SigningKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SigningKeyUpsertBulk) SetCreateBy(v uint32) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SigningKeyUpsertBulk) AddCreateBy(v uint32) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateCreateBy() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SigningKeyUpsertBulk) ClearCreateBy() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SigningKeyUpsertBulk) SetUpdateTime(v time.Time) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateUpdateTime() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SigningKeyUpsertBulk) ClearUpdateTime() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SigningKeyUpsertBulk) SetDeleteTime(v time.Time) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateDeleteTime() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SigningKeyUpsertBulk) ClearDeleteTime() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (u *SigningKeyUpsertBulk) SetAlgorithm(v signingkey.Algorithm) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetAlgorithm(v)
+	})
+}
+
+// UpdateAlgorithm sets the "algorithm" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateAlgorithm() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateAlgorithm()
+	})
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *SigningKeyUpsertBulk) SetPublicKey(v []byte) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetPublicKey(v)
+	})
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdatePublicKey() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdatePublicKey()
+	})
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (u *SigningKeyUpsertBulk) SetPrivateKey(v []byte) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetPrivateKey(v)
+	})
+}
+
+// UpdatePrivateKey sets the "private_key" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdatePrivateKey() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdatePrivateKey()
+	})
+}
+
+// SetNonce sets the "nonce" field.
+func (u *SigningKeyUpsertBulk) SetNonce(v []byte) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetNonce(v)
+	})
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateNonce() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateNonce()
+	})
+}
+
+// SetSealedWith sets the "sealed_with" field.
+func (u *SigningKeyUpsertBulk) SetSealedWith(v string) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetSealedWith(v)
+	})
+}
+
+// UpdateSealedWith sets the "sealed_with" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateSealedWith() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateSealedWith()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SigningKeyUpsertBulk) SetStatus(v signingkey.Status) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateStatus() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (u *SigningKeyUpsertBulk) SetRetiredAt(v time.Time) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetRetiredAt(v)
+	})
+}
+
+// UpdateRetiredAt sets the "retired_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateRetiredAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateRetiredAt()
+	})
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (u *SigningKeyUpsertBulk) ClearRetiredAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearRetiredAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *SigningKeyUpsertBulk) SetRevokedAt(v time.Time) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateRevokedAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *SigningKeyUpsertBulk) ClearRevokedAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SigningKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SigningKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SigningKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SigningKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/signingkey"
+)
+
+// SigningKeyUpdate is the builder for updating SigningKey entities.
+type SigningKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SigningKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (_u *SigningKeyUpdate) Where(ps ...predicate.SigningKey) *SigningKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *SigningKeyUpdate) SetCreateBy(v uint32) *SigningKeyUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableCreateBy(v *uint32) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *SigningKeyUpdate) AddCreateBy(v int32) *SigningKeyUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *SigningKeyUpdate) ClearCreateBy() *SigningKeyUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SigningKeyUpdate) SetUpdateTime(v time.Time) *SigningKeyUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableUpdateTime(v *time.Time) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *SigningKeyUpdate) ClearUpdateTime() *SigningKeyUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *SigningKeyUpdate) SetDeleteTime(v time.Time) *SigningKeyUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableDeleteTime(v *time.Time) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *SigningKeyUpdate) ClearDeleteTime() *SigningKeyUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *SigningKeyUpdate) SetAlgorithm(v signingkey.Algorithm) *SigningKeyUpdate {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableAlgorithm(v *signingkey.Algorithm) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *SigningKeyUpdate) SetPublicKey(v []byte) *SigningKeyUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_u *SigningKeyUpdate) SetPrivateKey(v []byte) *SigningKeyUpdate {
+	_u.mutation.SetPrivateKey(v)
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *SigningKeyUpdate) SetNonce(v []byte) *SigningKeyUpdate {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetSealedWith sets the "sealed_with" field.
+func (_u *SigningKeyUpdate) SetSealedWith(v string) *SigningKeyUpdate {
+	_u.mutation.SetSealedWith(v)
+	return _u
+}
+
+// SetNillableSealedWith sets the "sealed_with" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableSealedWith(v *string) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetSealedWith(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SigningKeyUpdate) SetStatus(v signingkey.Status) *SigningKeyUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableStatus(v *signingkey.Status) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_u *SigningKeyUpdate) SetRetiredAt(v time.Time) *SigningKeyUpdate {
+	_u.mutation.SetRetiredAt(v)
+	return _u
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableRetiredAt(v *time.Time) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetRetiredAt(*v)
+	}
+	return _u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (_u *SigningKeyUpdate) ClearRetiredAt() *SigningKeyUpdate {
+	_u.mutation.ClearRetiredAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *SigningKeyUpdate) SetRevokedAt(v time.Time) *SigningKeyUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableRevokedAt(v *time.Time) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *SigningKeyUpdate) ClearRevokedAt() *SigningKeyUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (_u *SigningKeyUpdate) Mutation() *SigningKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SigningKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SigningKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SigningKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SigningKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SigningKeyUpdate) check() error {
+	if v, ok := _u.mutation.Algorithm(); ok {
+		if err := signingkey.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SigningKey.algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PublicKey(); ok {
+		if err := signingkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SigningKey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SealedWith(); ok {
+		if err := signingkey.SealedWithValidator(v); err != nil {
+			return &ValidationError{Name: "sealed_with", err: fmt.Errorf(`ent: validator failed for field "SigningKey.sealed_with": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SigningKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SigningKeyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SigningKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(signingkey.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(signingkey.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(signingkey.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(signingkey.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(signingkey.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(signingkey.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(signingkey.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(signingkey.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(signingkey.FieldAlgorithm, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(signingkey.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(signingkey.FieldNonce, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.SealedWith(); ok {
+		_spec.SetField(signingkey.FieldSealedWith, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(signingkey.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RetiredAt(); ok {
+		_spec.SetField(signingkey.FieldRetiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetiredAtCleared() {
+		_spec.ClearField(signingkey.FieldRetiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(signingkey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(signingkey.FieldRevokedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SigningKeyUpdateOne is the builder for updating a single SigningKey entity.
+type SigningKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SigningKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *SigningKeyUpdateOne) SetCreateBy(v uint32) *SigningKeyUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableCreateBy(v *uint32) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *SigningKeyUpdateOne) AddCreateBy(v int32) *SigningKeyUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *SigningKeyUpdateOne) ClearCreateBy() *SigningKeyUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SigningKeyUpdateOne) SetUpdateTime(v time.Time) *SigningKeyUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableUpdateTime(v *time.Time) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *SigningKeyUpdateOne) ClearUpdateTime() *SigningKeyUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *SigningKeyUpdateOne) SetDeleteTime(v time.Time) *SigningKeyUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableDeleteTime(v *time.Time) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *SigningKeyUpdateOne) ClearDeleteTime() *SigningKeyUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *SigningKeyUpdateOne) SetAlgorithm(v signingkey.Algorithm) *SigningKeyUpdateOne {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableAlgorithm(v *signingkey.Algorithm) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *SigningKeyUpdateOne) SetPublicKey(v []byte) *SigningKeyUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_u *SigningKeyUpdateOne) SetPrivateKey(v []byte) *SigningKeyUpdateOne {
+	_u.mutation.SetPrivateKey(v)
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *SigningKeyUpdateOne) SetNonce(v []byte) *SigningKeyUpdateOne {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetSealedWith sets the "sealed_with" field.
+func (_u *SigningKeyUpdateOne) SetSealedWith(v string) *SigningKeyUpdateOne {
+	_u.mutation.SetSealedWith(v)
+	return _u
+}
+
+// SetNillableSealedWith sets the "sealed_with" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableSealedWith(v *string) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetSealedWith(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SigningKeyUpdateOne) SetStatus(v signingkey.Status) *SigningKeyUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableStatus(v *signingkey.Status) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_u *SigningKeyUpdateOne) SetRetiredAt(v time.Time) *SigningKeyUpdateOne {
+	_u.mutation.SetRetiredAt(v)
+	return _u
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableRetiredAt(v *time.Time) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetRetiredAt(*v)
+	}
+	return _u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (_u *SigningKeyUpdateOne) ClearRetiredAt() *SigningKeyUpdateOne {
+	_u.mutation.ClearRetiredAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *SigningKeyUpdateOne) SetRevokedAt(v time.Time) *SigningKeyUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableRevokedAt(v *time.Time) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *SigningKeyUpdateOne) ClearRevokedAt() *SigningKeyUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (_u *SigningKeyUpdateOne) Mutation() *SigningKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (_u *SigningKeyUpdateOne) Where(ps ...predicate.SigningKey) *SigningKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SigningKeyUpdateOne) Select(field string, fields ...string) *SigningKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SigningKey entity.
+func (_u *SigningKeyUpdateOne) Save(ctx context.Context) (*SigningKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SigningKeyUpdateOne) SaveX(ctx context.Context) *SigningKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SigningKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SigningKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SigningKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.Algorithm(); ok {
+		if err := signingkey.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SigningKey.algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PublicKey(); ok {
+		if err := signingkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SigningKey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SealedWith(); ok {
+		if err := signingkey.SealedWithValidator(v); err != nil {
+			return &ValidationError{Name: "sealed_with", err: fmt.Errorf(`ent: validator failed for field "SigningKey.sealed_with": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SigningKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SigningKeyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SigningKeyUpdateOne) sqlSave(ctx context.Context) (_node *SigningKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingkey.FieldID)
+		for _, f := range fields {
+			if !signingkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(signingkey.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(signingkey.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(signingkey.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(signingkey.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(signingkey.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(signingkey.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(signingkey.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(signingkey.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(signingkey.FieldAlgorithm, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(signingkey.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(signingkey.FieldNonce, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.SealedWith(); ok {
+		_spec.SetField(signingkey.FieldSealedWith, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(signingkey.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RetiredAt(); ok {
+		_spec.SetField(signingkey.FieldRetiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetiredAtCleared() {
+		_spec.ClearField(signingkey.FieldRetiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(signingkey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(signingkey.FieldRevokedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SigningKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
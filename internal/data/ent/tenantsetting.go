@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/tenantsetting"
+)
+
+// TenantSetting is the model entity for the TenantSetting schema.
+type TenantSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Execution timeout for scripts without their own timeout
+	DefaultTimeoutSeconds int `json:"default_timeout_seconds,omitempty"`
+	// Active executions a client may have at once, 0 is unlimited
+	MaxConcurrentPerClient int `json:"max_concurrent_per_client,omitempty"`
+	// Whether script content changes need approval by a second person
+	RequireScriptApproval bool `json:"require_script_approval,omitempty"`
+	// Role allowed to approve script changes and executions on protected clients, the default role when empty
+	ScriptApproverRole string `json:"script_approver_role,omitempty"`
+	// How long an execution on a protected client waits for approval
+	ExecutionApprovalTTLMinutes int `json:"execution_approval_ttl_minutes,omitempty"`
+	// Lowest diagnostic severity that refuses a script save, NONE never blocks
+	ScriptBlockSeverity tenantsetting.ScriptBlockSeverity `json:"script_block_severity,omitempty"`
+	// Lint rules not applied to scripts of the tenant
+	DisabledLintRules []string `json:"disabled_lint_rules,omitempty"`
+	selectValues      sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantsetting.FieldDisabledLintRules:
+			values[i] = new([]byte)
+		case tenantsetting.FieldRequireScriptApproval:
+			values[i] = new(sql.NullBool)
+		case tenantsetting.FieldUpdateBy, tenantsetting.FieldTenantID, tenantsetting.FieldDefaultTimeoutSeconds, tenantsetting.FieldMaxConcurrentPerClient, tenantsetting.FieldExecutionApprovalTTLMinutes:
+			values[i] = new(sql.NullInt64)
+		case tenantsetting.FieldID, tenantsetting.FieldScriptApproverRole, tenantsetting.FieldScriptBlockSeverity:
+			values[i] = new(sql.NullString)
+		case tenantsetting.FieldCreateTime, tenantsetting.FieldUpdateTime, tenantsetting.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantSetting fields.
+func (_m *TenantSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantsetting.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case tenantsetting.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case tenantsetting.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case tenantsetting.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case tenantsetting.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case tenantsetting.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case tenantsetting.FieldDefaultTimeoutSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_timeout_seconds", values[i])
+			} else if value.Valid {
+				_m.DefaultTimeoutSeconds = int(value.Int64)
+			}
+		case tenantsetting.FieldMaxConcurrentPerClient:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_concurrent_per_client", values[i])
+			} else if value.Valid {
+				_m.MaxConcurrentPerClient = int(value.Int64)
+			}
+		case tenantsetting.FieldRequireScriptApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_script_approval", values[i])
+			} else if value.Valid {
+				_m.RequireScriptApproval = value.Bool
+			}
+		case tenantsetting.FieldScriptApproverRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field script_approver_role", values[i])
+			} else if value.Valid {
+				_m.ScriptApproverRole = value.String
+			}
+		case tenantsetting.FieldExecutionApprovalTTLMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field execution_approval_ttl_minutes", values[i])
+			} else if value.Valid {
+				_m.ExecutionApprovalTTLMinutes = int(value.Int64)
+			}
+		case tenantsetting.FieldScriptBlockSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field script_block_severity", values[i])
+			} else if value.Valid {
+				_m.ScriptBlockSeverity = tenantsetting.ScriptBlockSeverity(value.String)
+			}
+		case tenantsetting.FieldDisabledLintRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_lint_rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DisabledLintRules); err != nil {
+					return fmt.Errorf("unmarshal field disabled_lint_rules: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantSetting.
+// Note that you need to call TenantSetting.Unwrap() before calling this method if this TenantSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantSetting) Update() *TenantSettingUpdateOne {
+	return NewTenantSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantSetting) Unwrap() *TenantSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("default_timeout_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultTimeoutSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("max_concurrent_per_client=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxConcurrentPerClient))
+	builder.WriteString(", ")
+	builder.WriteString("require_script_approval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireScriptApproval))
+	builder.WriteString(", ")
+	builder.WriteString("script_approver_role=")
+	builder.WriteString(_m.ScriptApproverRole)
+	builder.WriteString(", ")
+	builder.WriteString("execution_approval_ttl_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExecutionApprovalTTLMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("script_block_severity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScriptBlockSeverity))
+	builder.WriteString(", ")
+	builder.WriteString("disabled_lint_rules=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisabledLintRules))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantSettings is a parsable slice of TenantSetting.
+type TenantSettings []*TenantSetting
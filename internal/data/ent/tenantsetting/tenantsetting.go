@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantsetting
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tenantsetting type in the database.
+	Label = "tenant_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdateBy holds the string denoting the update_by field in the database.
+	FieldUpdateBy = "update_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldDefaultTimeoutSeconds holds the string denoting the default_timeout_seconds field in the database.
+	FieldDefaultTimeoutSeconds = "default_timeout_seconds"
+	// FieldMaxConcurrentPerClient holds the string denoting the max_concurrent_per_client field in the database.
+	FieldMaxConcurrentPerClient = "max_concurrent_per_client"
+	// FieldRequireScriptApproval holds the string denoting the require_script_approval field in the database.
+	FieldRequireScriptApproval = "require_script_approval"
+	// FieldScriptApproverRole holds the string denoting the script_approver_role field in the database.
+	FieldScriptApproverRole = "script_approver_role"
+	// FieldExecutionApprovalTTLMinutes holds the string denoting the execution_approval_ttl_minutes field in the database.
+	FieldExecutionApprovalTTLMinutes = "execution_approval_ttl_minutes"
+	// FieldScriptBlockSeverity holds the string denoting the script_block_severity field in the database.
+	FieldScriptBlockSeverity = "script_block_severity"
+	// FieldDisabledLintRules holds the string denoting the disabled_lint_rules field in the database.
+	FieldDisabledLintRules = "disabled_lint_rules"
+	// Table holds the table name of the tenantsetting in the database.
+	Table = "executor_tenant_settings"
+)
+
+// Columns holds all SQL columns for tenantsetting fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldDefaultTimeoutSeconds,
+	FieldMaxConcurrentPerClient,
+	FieldRequireScriptApproval,
+	FieldScriptApproverRole,
+	FieldExecutionApprovalTTLMinutes,
+	FieldScriptBlockSeverity,
+	FieldDisabledLintRules,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-executor/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DefaultDefaultTimeoutSeconds holds the default value on creation for the "default_timeout_seconds" field.
+	DefaultDefaultTimeoutSeconds int
+	// DefaultTimeoutSecondsValidator is a validator for the "default_timeout_seconds" field. It is called by the builders before save.
+	DefaultTimeoutSecondsValidator func(int) error
+	// DefaultMaxConcurrentPerClient holds the default value on creation for the "max_concurrent_per_client" field.
+	DefaultMaxConcurrentPerClient int
+	// MaxConcurrentPerClientValidator is a validator for the "max_concurrent_per_client" field. It is called by the builders before save.
+	MaxConcurrentPerClientValidator func(int) error
+	// DefaultRequireScriptApproval holds the default value on creation for the "require_script_approval" field.
+	DefaultRequireScriptApproval bool
+	// ScriptApproverRoleValidator is a validator for the "script_approver_role" field. It is called by the builders before save.
+	ScriptApproverRoleValidator func(string) error
+	// DefaultExecutionApprovalTTLMinutes holds the default value on creation for the "execution_approval_ttl_minutes" field.
+	DefaultExecutionApprovalTTLMinutes int
+	// ExecutionApprovalTTLMinutesValidator is a validator for the "execution_approval_ttl_minutes" field. It is called by the builders before save.
+	ExecutionApprovalTTLMinutesValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// ScriptBlockSeverity defines the type for the "script_block_severity" enum field.
+type ScriptBlockSeverity string
+
+// ScriptBlockSeverityNONE is the default value of the ScriptBlockSeverity enum.
+const DefaultScriptBlockSeverity = ScriptBlockSeverityNONE
+
+// ScriptBlockSeverity values.
+const (
+	ScriptBlockSeverityNONE    ScriptBlockSeverity = "NONE"
+	ScriptBlockSeverityINFO    ScriptBlockSeverity = "INFO"
+	ScriptBlockSeverityWARNING ScriptBlockSeverity = "WARNING"
+	ScriptBlockSeverityERROR   ScriptBlockSeverity = "ERROR"
+)
+
+func (sbs ScriptBlockSeverity) String() string {
+	return string(sbs)
+}
+
+// ScriptBlockSeverityValidator is a validator for the "script_block_severity" field enum values. It is called by the builders before save.
+func ScriptBlockSeverityValidator(sbs ScriptBlockSeverity) error {
+	switch sbs {
+	case ScriptBlockSeverityNONE, ScriptBlockSeverityINFO, ScriptBlockSeverityWARNING, ScriptBlockSeverityERROR:
+		return nil
+	default:
+		return fmt.Errorf("tenantsetting: invalid enum value for script_block_severity field: %q", sbs)
+	}
+}
+
+// OrderOption defines the ordering options for the TenantSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdateBy orders the results by the update_by field.
+func ByUpdateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByDefaultTimeoutSeconds orders the results by the default_timeout_seconds field.
+func ByDefaultTimeoutSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultTimeoutSeconds, opts...).ToFunc()
+}
+
+// ByMaxConcurrentPerClient orders the results by the max_concurrent_per_client field.
+func ByMaxConcurrentPerClient(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxConcurrentPerClient, opts...).ToFunc()
+}
+
+// ByRequireScriptApproval orders the results by the require_script_approval field.
+func ByRequireScriptApproval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireScriptApproval, opts...).ToFunc()
+}
+
+// ByScriptApproverRole orders the results by the script_approver_role field.
+func ByScriptApproverRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScriptApproverRole, opts...).ToFunc()
+}
+
+// ByExecutionApprovalTTLMinutes orders the results by the execution_approval_ttl_minutes field.
+func ByExecutionApprovalTTLMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutionApprovalTTLMinutes, opts...).ToFunc()
+}
+
+// ByScriptBlockSeverity orders the results by the script_block_severity field.
+func ByScriptBlockSeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScriptBlockSeverity, opts...).ToFunc()
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantsetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContainsFold(FieldID, id))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// DefaultTimeoutSeconds applies equality check predicate on the "default_timeout_seconds" field. It's identical to DefaultTimeoutSecondsEQ.
+func DefaultTimeoutSeconds(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldDefaultTimeoutSeconds, v))
+}
+
+// MaxConcurrentPerClient applies equality check predicate on the "max_concurrent_per_client" field. It's identical to MaxConcurrentPerClientEQ.
+func MaxConcurrentPerClient(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldMaxConcurrentPerClient, v))
+}
+
+// RequireScriptApproval applies equality check predicate on the "require_script_approval" field. It's identical to RequireScriptApprovalEQ.
+func RequireScriptApproval(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldRequireScriptApproval, v))
+}
+
+// ScriptApproverRole applies equality check predicate on the "script_approver_role" field. It's identical to ScriptApproverRoleEQ.
+func ScriptApproverRole(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldScriptApproverRole, v))
+}
+
+// ExecutionApprovalTTLMinutes applies equality check predicate on the "execution_approval_ttl_minutes" field. It's identical to ExecutionApprovalTTLMinutesEQ.
+func ExecutionApprovalTTLMinutes(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldExecutionApprovalTTLMinutes, v))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldTenantID))
+}
+
+// DefaultTimeoutSecondsEQ applies the EQ predicate on the "default_timeout_seconds" field.
+func DefaultTimeoutSecondsEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldDefaultTimeoutSeconds, v))
+}
+
+// DefaultTimeoutSecondsNEQ applies the NEQ predicate on the "default_timeout_seconds" field.
+func DefaultTimeoutSecondsNEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldDefaultTimeoutSeconds, v))
+}
+
+// DefaultTimeoutSecondsIn applies the In predicate on the "default_timeout_seconds" field.
+func DefaultTimeoutSecondsIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldDefaultTimeoutSeconds, vs...))
+}
+
+// DefaultTimeoutSecondsNotIn applies the NotIn predicate on the "default_timeout_seconds" field.
+func DefaultTimeoutSecondsNotIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldDefaultTimeoutSeconds, vs...))
+}
+
+// DefaultTimeoutSecondsGT applies the GT predicate on the "default_timeout_seconds" field.
+func DefaultTimeoutSecondsGT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldDefaultTimeoutSeconds, v))
+}
+
+// DefaultTimeoutSecondsGTE applies the GTE predicate on the "default_timeout_seconds" field.
+func DefaultTimeoutSecondsGTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldDefaultTimeoutSeconds, v))
+}
+
+// DefaultTimeoutSecondsLT applies the LT predicate on the "default_timeout_seconds" field.
+func DefaultTimeoutSecondsLT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldDefaultTimeoutSeconds, v))
+}
+
+// DefaultTimeoutSecondsLTE applies the LTE predicate on the "default_timeout_seconds" field.
+func DefaultTimeoutSecondsLTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldDefaultTimeoutSeconds, v))
+}
+
+// MaxConcurrentPerClientEQ applies the EQ predicate on the "max_concurrent_per_client" field.
+func MaxConcurrentPerClientEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldMaxConcurrentPerClient, v))
+}
+
+// MaxConcurrentPerClientNEQ applies the NEQ predicate on the "max_concurrent_per_client" field.
+func MaxConcurrentPerClientNEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldMaxConcurrentPerClient, v))
+}
+
+// MaxConcurrentPerClientIn applies the In predicate on the "max_concurrent_per_client" field.
+func MaxConcurrentPerClientIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldMaxConcurrentPerClient, vs...))
+}
+
+// MaxConcurrentPerClientNotIn applies the NotIn predicate on the "max_concurrent_per_client" field.
+func MaxConcurrentPerClientNotIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldMaxConcurrentPerClient, vs...))
+}
+
+// MaxConcurrentPerClientGT applies the GT predicate on the "max_concurrent_per_client" field.
+func MaxConcurrentPerClientGT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldMaxConcurrentPerClient, v))
+}
+
+// MaxConcurrentPerClientGTE applies the GTE predicate on the "max_concurrent_per_client" field.
+func MaxConcurrentPerClientGTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldMaxConcurrentPerClient, v))
+}
+
+// MaxConcurrentPerClientLT applies the LT predicate on the "max_concurrent_per_client" field.
+func MaxConcurrentPerClientLT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldMaxConcurrentPerClient, v))
+}
+
+// MaxConcurrentPerClientLTE applies the LTE predicate on the "max_concurrent_per_client" field.
+func MaxConcurrentPerClientLTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldMaxConcurrentPerClient, v))
+}
+
+// RequireScriptApprovalEQ applies the EQ predicate on the "require_script_approval" field.
+func RequireScriptApprovalEQ(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldRequireScriptApproval, v))
+}
+
+// RequireScriptApprovalNEQ applies the NEQ predicate on the "require_script_approval" field.
+func RequireScriptApprovalNEQ(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldRequireScriptApproval, v))
+}
+
+// ScriptApproverRoleEQ applies the EQ predicate on the "script_approver_role" field.
+func ScriptApproverRoleEQ(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleNEQ applies the NEQ predicate on the "script_approver_role" field.
+func ScriptApproverRoleNEQ(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleIn applies the In predicate on the "script_approver_role" field.
+func ScriptApproverRoleIn(vs ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldScriptApproverRole, vs...))
+}
+
+// ScriptApproverRoleNotIn applies the NotIn predicate on the "script_approver_role" field.
+func ScriptApproverRoleNotIn(vs ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldScriptApproverRole, vs...))
+}
+
+// ScriptApproverRoleGT applies the GT predicate on the "script_approver_role" field.
+func ScriptApproverRoleGT(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleGTE applies the GTE predicate on the "script_approver_role" field.
+func ScriptApproverRoleGTE(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleLT applies the LT predicate on the "script_approver_role" field.
+func ScriptApproverRoleLT(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleLTE applies the LTE predicate on the "script_approver_role" field.
+func ScriptApproverRoleLTE(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleContains applies the Contains predicate on the "script_approver_role" field.
+func ScriptApproverRoleContains(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContains(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleHasPrefix applies the HasPrefix predicate on the "script_approver_role" field.
+func ScriptApproverRoleHasPrefix(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldHasPrefix(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleHasSuffix applies the HasSuffix predicate on the "script_approver_role" field.
+func ScriptApproverRoleHasSuffix(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldHasSuffix(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleIsNil applies the IsNil predicate on the "script_approver_role" field.
+func ScriptApproverRoleIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldScriptApproverRole))
+}
+
+// ScriptApproverRoleNotNil applies the NotNil predicate on the "script_approver_role" field.
+func ScriptApproverRoleNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldScriptApproverRole))
+}
+
+// ScriptApproverRoleEqualFold applies the EqualFold predicate on the "script_approver_role" field.
+func ScriptApproverRoleEqualFold(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEqualFold(FieldScriptApproverRole, v))
+}
+
+// ScriptApproverRoleContainsFold applies the ContainsFold predicate on the "script_approver_role" field.
+func ScriptApproverRoleContainsFold(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContainsFold(FieldScriptApproverRole, v))
+}
+
+// ExecutionApprovalTTLMinutesEQ applies the EQ predicate on the "execution_approval_ttl_minutes" field.
+func ExecutionApprovalTTLMinutesEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldExecutionApprovalTTLMinutes, v))
+}
+
+// ExecutionApprovalTTLMinutesNEQ applies the NEQ predicate on the "execution_approval_ttl_minutes" field.
+func ExecutionApprovalTTLMinutesNEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldExecutionApprovalTTLMinutes, v))
+}
+
+// ExecutionApprovalTTLMinutesIn applies the In predicate on the "execution_approval_ttl_minutes" field.
+func ExecutionApprovalTTLMinutesIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldExecutionApprovalTTLMinutes, vs...))
+}
+
+// ExecutionApprovalTTLMinutesNotIn applies the NotIn predicate on the "execution_approval_ttl_minutes" field.
+func ExecutionApprovalTTLMinutesNotIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldExecutionApprovalTTLMinutes, vs...))
+}
+
+// ExecutionApprovalTTLMinutesGT applies the GT predicate on the "execution_approval_ttl_minutes" field.
+func ExecutionApprovalTTLMinutesGT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldExecutionApprovalTTLMinutes, v))
+}
+
+// ExecutionApprovalTTLMinutesGTE applies the GTE predicate on the "execution_approval_ttl_minutes" field.
+func ExecutionApprovalTTLMinutesGTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldExecutionApprovalTTLMinutes, v))
+}
+
+// ExecutionApprovalTTLMinutesLT applies the LT predicate on the "execution_approval_ttl_minutes" field.
+func ExecutionApprovalTTLMinutesLT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldExecutionApprovalTTLMinutes, v))
+}
+
+// ExecutionApprovalTTLMinutesLTE applies the LTE predicate on the "execution_approval_ttl_minutes" field.
+func ExecutionApprovalTTLMinutesLTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldExecutionApprovalTTLMinutes, v))
+}
+
+// ScriptBlockSeverityEQ applies the EQ predicate on the "script_block_severity" field.
+func ScriptBlockSeverityEQ(v ScriptBlockSeverity) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldScriptBlockSeverity, v))
+}
+
+// ScriptBlockSeverityNEQ applies the NEQ predicate on the "script_block_severity" field.
+func ScriptBlockSeverityNEQ(v ScriptBlockSeverity) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldScriptBlockSeverity, v))
+}
+
+// ScriptBlockSeverityIn applies the In predicate on the "script_block_severity" field.
+func ScriptBlockSeverityIn(vs ...ScriptBlockSeverity) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldScriptBlockSeverity, vs...))
+}
+
+// ScriptBlockSeverityNotIn applies the NotIn predicate on the "script_block_severity" field.
+func ScriptBlockSeverityNotIn(vs ...ScriptBlockSeverity) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldScriptBlockSeverity, vs...))
+}
+
+// DisabledLintRulesIsNil applies the IsNil predicate on the "disabled_lint_rules" field.
+func DisabledLintRulesIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldDisabledLintRules))
+}
+
+// DisabledLintRulesNotNil applies the NotNil predicate on the "disabled_lint_rules" field.
+func DisabledLintRulesNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldDisabledLintRules))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantSetting) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantSetting) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantSetting) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.NotPredicates(p))
+}
@@ -0,0 +1,1315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/tenantsetting"
+)
+
+// TenantSettingCreate is the builder for creating a TenantSetting entity.
+type TenantSettingCreate struct {
+	config
+	mutation *TenantSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *TenantSettingCreate) SetUpdateBy(v uint32) *TenantSettingCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableUpdateBy(v *uint32) *TenantSettingCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TenantSettingCreate) SetCreateTime(v time.Time) *TenantSettingCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableCreateTime(v *time.Time) *TenantSettingCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TenantSettingCreate) SetUpdateTime(v time.Time) *TenantSettingCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableUpdateTime(v *time.Time) *TenantSettingCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *TenantSettingCreate) SetDeleteTime(v time.Time) *TenantSettingCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableDeleteTime(v *time.Time) *TenantSettingCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantSettingCreate) SetTenantID(v uint32) *TenantSettingCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableTenantID(v *uint32) *TenantSettingCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetDefaultTimeoutSeconds sets the "default_timeout_seconds" field.
+func (_c *TenantSettingCreate) SetDefaultTimeoutSeconds(v int) *TenantSettingCreate {
+	_c.mutation.SetDefaultTimeoutSeconds(v)
+	return _c
+}
+
+// SetNillableDefaultTimeoutSeconds sets the "default_timeout_seconds" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableDefaultTimeoutSeconds(v *int) *TenantSettingCreate {
+	if v != nil {
+		_c.SetDefaultTimeoutSeconds(*v)
+	}
+	return _c
+}
+
+// SetMaxConcurrentPerClient sets the "max_concurrent_per_client" field.
+func (_c *TenantSettingCreate) SetMaxConcurrentPerClient(v int) *TenantSettingCreate {
+	_c.mutation.SetMaxConcurrentPerClient(v)
+	return _c
+}
+
+// SetNillableMaxConcurrentPerClient sets the "max_concurrent_per_client" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableMaxConcurrentPerClient(v *int) *TenantSettingCreate {
+	if v != nil {
+		_c.SetMaxConcurrentPerClient(*v)
+	}
+	return _c
+}
+
+// SetRequireScriptApproval sets the "require_script_approval" field.
+func (_c *TenantSettingCreate) SetRequireScriptApproval(v bool) *TenantSettingCreate {
+	_c.mutation.SetRequireScriptApproval(v)
+	return _c
+}
+
+// SetNillableRequireScriptApproval sets the "require_script_approval" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableRequireScriptApproval(v *bool) *TenantSettingCreate {
+	if v != nil {
+		_c.SetRequireScriptApproval(*v)
+	}
+	return _c
+}
+
+// SetScriptApproverRole sets the "script_approver_role" field.
+func (_c *TenantSettingCreate) SetScriptApproverRole(v string) *TenantSettingCreate {
+	_c.mutation.SetScriptApproverRole(v)
+	return _c
+}
+
+// SetNillableScriptApproverRole sets the "script_approver_role" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableScriptApproverRole(v *string) *TenantSettingCreate {
+	if v != nil {
+		_c.SetScriptApproverRole(*v)
+	}
+	return _c
+}
+
+// SetExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field.
+func (_c *TenantSettingCreate) SetExecutionApprovalTTLMinutes(v int) *TenantSettingCreate {
+	_c.mutation.SetExecutionApprovalTTLMinutes(v)
+	return _c
+}
+
+// SetNillableExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableExecutionApprovalTTLMinutes(v *int) *TenantSettingCreate {
+	if v != nil {
+		_c.SetExecutionApprovalTTLMinutes(*v)
+	}
+	return _c
+}
+
+// SetScriptBlockSeverity sets the "script_block_severity" field.
+func (_c *TenantSettingCreate) SetScriptBlockSeverity(v tenantsetting.ScriptBlockSeverity) *TenantSettingCreate {
+	_c.mutation.SetScriptBlockSeverity(v)
+	return _c
+}
+
+// SetNillableScriptBlockSeverity sets the "script_block_severity" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableScriptBlockSeverity(v *tenantsetting.ScriptBlockSeverity) *TenantSettingCreate {
+	if v != nil {
+		_c.SetScriptBlockSeverity(*v)
+	}
+	return _c
+}
+
+// SetDisabledLintRules sets the "disabled_lint_rules" field.
+func (_c *TenantSettingCreate) SetDisabledLintRules(v []string) *TenantSettingCreate {
+	_c.mutation.SetDisabledLintRules(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantSettingCreate) SetID(v string) *TenantSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (_c *TenantSettingCreate) Mutation() *TenantSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantSetting in the database.
+func (_c *TenantSettingCreate) Save(ctx context.Context) (*TenantSetting, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantSettingCreate) SaveX(ctx context.Context) *TenantSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantSettingCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := tenantsetting.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.DefaultTimeoutSeconds(); !ok {
+		v := tenantsetting.DefaultDefaultTimeoutSeconds
+		_c.mutation.SetDefaultTimeoutSeconds(v)
+	}
+	if _, ok := _c.mutation.MaxConcurrentPerClient(); !ok {
+		v := tenantsetting.DefaultMaxConcurrentPerClient
+		_c.mutation.SetMaxConcurrentPerClient(v)
+	}
+	if _, ok := _c.mutation.RequireScriptApproval(); !ok {
+		v := tenantsetting.DefaultRequireScriptApproval
+		_c.mutation.SetRequireScriptApproval(v)
+	}
+	if _, ok := _c.mutation.ExecutionApprovalTTLMinutes(); !ok {
+		v := tenantsetting.DefaultExecutionApprovalTTLMinutes
+		_c.mutation.SetExecutionApprovalTTLMinutes(v)
+	}
+	if _, ok := _c.mutation.ScriptBlockSeverity(); !ok {
+		v := tenantsetting.DefaultScriptBlockSeverity
+		_c.mutation.SetScriptBlockSeverity(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantSettingCreate) check() error {
+	if _, ok := _c.mutation.DefaultTimeoutSeconds(); !ok {
+		return &ValidationError{Name: "default_timeout_seconds", err: errors.New(`ent: missing required field "TenantSetting.default_timeout_seconds"`)}
+	}
+	if v, ok := _c.mutation.DefaultTimeoutSeconds(); ok {
+		if err := tenantsetting.DefaultTimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "default_timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_timeout_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxConcurrentPerClient(); !ok {
+		return &ValidationError{Name: "max_concurrent_per_client", err: errors.New(`ent: missing required field "TenantSetting.max_concurrent_per_client"`)}
+	}
+	if v, ok := _c.mutation.MaxConcurrentPerClient(); ok {
+		if err := tenantsetting.MaxConcurrentPerClientValidator(v); err != nil {
+			return &ValidationError{Name: "max_concurrent_per_client", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.max_concurrent_per_client": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequireScriptApproval(); !ok {
+		return &ValidationError{Name: "require_script_approval", err: errors.New(`ent: missing required field "TenantSetting.require_script_approval"`)}
+	}
+	if v, ok := _c.mutation.ScriptApproverRole(); ok {
+		if err := tenantsetting.ScriptApproverRoleValidator(v); err != nil {
+			return &ValidationError{Name: "script_approver_role", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.script_approver_role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExecutionApprovalTTLMinutes(); !ok {
+		return &ValidationError{Name: "execution_approval_ttl_minutes", err: errors.New(`ent: missing required field "TenantSetting.execution_approval_ttl_minutes"`)}
+	}
+	if v, ok := _c.mutation.ExecutionApprovalTTLMinutes(); ok {
+		if err := tenantsetting.ExecutionApprovalTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "execution_approval_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.execution_approval_ttl_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScriptBlockSeverity(); !ok {
+		return &ValidationError{Name: "script_block_severity", err: errors.New(`ent: missing required field "TenantSetting.script_block_severity"`)}
+	}
+	if v, ok := _c.mutation.ScriptBlockSeverity(); ok {
+		if err := tenantsetting.ScriptBlockSeverityValidator(v); err != nil {
+			return &ValidationError{Name: "script_block_severity", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.script_block_severity": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tenantsetting.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TenantSettingCreate) sqlSave(ctx context.Context) (*TenantSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TenantSetting.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantSettingCreate) createSpec() (*TenantSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantsetting.Table, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tenantsetting.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantsetting.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenantsetting.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.DefaultTimeoutSeconds(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultTimeoutSeconds, field.TypeInt, value)
+		_node.DefaultTimeoutSeconds = value
+	}
+	if value, ok := _c.mutation.MaxConcurrentPerClient(); ok {
+		_spec.SetField(tenantsetting.FieldMaxConcurrentPerClient, field.TypeInt, value)
+		_node.MaxConcurrentPerClient = value
+	}
+	if value, ok := _c.mutation.RequireScriptApproval(); ok {
+		_spec.SetField(tenantsetting.FieldRequireScriptApproval, field.TypeBool, value)
+		_node.RequireScriptApproval = value
+	}
+	if value, ok := _c.mutation.ScriptApproverRole(); ok {
+		_spec.SetField(tenantsetting.FieldScriptApproverRole, field.TypeString, value)
+		_node.ScriptApproverRole = value
+	}
+	if value, ok := _c.mutation.ExecutionApprovalTTLMinutes(); ok {
+		_spec.SetField(tenantsetting.FieldExecutionApprovalTTLMinutes, field.TypeInt, value)
+		_node.ExecutionApprovalTTLMinutes = value
+	}
+	if value, ok := _c.mutation.ScriptBlockSeverity(); ok {
+		_spec.SetField(tenantsetting.FieldScriptBlockSeverity, field.TypeEnum, value)
+		_node.ScriptBlockSeverity = value
+	}
+	if value, ok := _c.mutation.DisabledLintRules(); ok {
+		_spec.SetField(tenantsetting.FieldDisabledLintRules, field.TypeJSON, value)
+		_node.DisabledLintRules = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSetting.Create().
+//		SetUpdateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSettingUpsert) {
+//			SetUpdateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantSettingCreate) OnConflict(opts ...sql.ConflictOption) *TenantSettingUpsertOne {
+	_c.conflict = opts
+	return &TenantSettingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantSettingCreate) OnConflictColumns(columns ...string) *TenantSettingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantSettingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantSettingUpsertOne is the builder for "upsert"-ing
+	//  one TenantSetting node.
+	TenantSettingUpsertOne struct {
+		create *TenantSettingCreate
+	}
+
+	// TenantSettingUpsert is the "OnConflict" setter.
+	TenantSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateBy sets the "update_by" field.
+func (u *TenantSettingUpsert) SetUpdateBy(v uint32) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateUpdateBy() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *TenantSettingUpsert) AddUpdateBy(v uint32) *TenantSettingUpsert {
+	u.Add(tenantsetting.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *TenantSettingUpsert) ClearUpdateBy() *TenantSettingUpsert {
+	u.SetNull(tenantsetting.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantSettingUpsert) SetUpdateTime(v time.Time) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateUpdateTime() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantSettingUpsert) ClearUpdateTime() *TenantSettingUpsert {
+	u.SetNull(tenantsetting.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantSettingUpsert) SetDeleteTime(v time.Time) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateDeleteTime() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantSettingUpsert) ClearDeleteTime() *TenantSettingUpsert {
+	u.SetNull(tenantsetting.FieldDeleteTime)
+	return u
+}
+
+// SetDefaultTimeoutSeconds sets the "default_timeout_seconds" field.
+func (u *TenantSettingUpsert) SetDefaultTimeoutSeconds(v int) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldDefaultTimeoutSeconds, v)
+	return u
+}
+
+// UpdateDefaultTimeoutSeconds sets the "default_timeout_seconds" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateDefaultTimeoutSeconds() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldDefaultTimeoutSeconds)
+	return u
+}
+
+// AddDefaultTimeoutSeconds adds v to the "default_timeout_seconds" field.
+func (u *TenantSettingUpsert) AddDefaultTimeoutSeconds(v int) *TenantSettingUpsert {
+	u.Add(tenantsetting.FieldDefaultTimeoutSeconds, v)
+	return u
+}
+
+// SetMaxConcurrentPerClient sets the "max_concurrent_per_client" field.
+func (u *TenantSettingUpsert) SetMaxConcurrentPerClient(v int) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldMaxConcurrentPerClient, v)
+	return u
+}
+
+// UpdateMaxConcurrentPerClient sets the "max_concurrent_per_client" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateMaxConcurrentPerClient() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldMaxConcurrentPerClient)
+	return u
+}
+
+// AddMaxConcurrentPerClient adds v to the "max_concurrent_per_client" field.
+func (u *TenantSettingUpsert) AddMaxConcurrentPerClient(v int) *TenantSettingUpsert {
+	u.Add(tenantsetting.FieldMaxConcurrentPerClient, v)
+	return u
+}
+
+// SetRequireScriptApproval sets the "require_script_approval" field.
+func (u *TenantSettingUpsert) SetRequireScriptApproval(v bool) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldRequireScriptApproval, v)
+	return u
+}
+
+// UpdateRequireScriptApproval sets the "require_script_approval" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateRequireScriptApproval() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldRequireScriptApproval)
+	return u
+}
+
+// SetScriptApproverRole sets the "script_approver_role" field.
+func (u *TenantSettingUpsert) SetScriptApproverRole(v string) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldScriptApproverRole, v)
+	return u
+}
+
+// UpdateScriptApproverRole sets the "script_approver_role" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateScriptApproverRole() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldScriptApproverRole)
+	return u
+}
+
+// ClearScriptApproverRole clears the value of the "script_approver_role" field.
+func (u *TenantSettingUpsert) ClearScriptApproverRole() *TenantSettingUpsert {
+	u.SetNull(tenantsetting.FieldScriptApproverRole)
+	return u
+}
+
+// SetExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field.
+func (u *TenantSettingUpsert) SetExecutionApprovalTTLMinutes(v int) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldExecutionApprovalTTLMinutes, v)
+	return u
+}
+
+// UpdateExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateExecutionApprovalTTLMinutes() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldExecutionApprovalTTLMinutes)
+	return u
+}
+
+// AddExecutionApprovalTTLMinutes adds v to the "execution_approval_ttl_minutes" field.
+func (u *TenantSettingUpsert) AddExecutionApprovalTTLMinutes(v int) *TenantSettingUpsert {
+	u.Add(tenantsetting.FieldExecutionApprovalTTLMinutes, v)
+	return u
+}
+
+// SetScriptBlockSeverity sets the "script_block_severity" field.
+func (u *TenantSettingUpsert) SetScriptBlockSeverity(v tenantsetting.ScriptBlockSeverity) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldScriptBlockSeverity, v)
+	return u
+}
+
+// UpdateScriptBlockSeverity sets the "script_block_severity" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateScriptBlockSeverity() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldScriptBlockSeverity)
+	return u
+}
+
+// SetDisabledLintRules sets the "disabled_lint_rules" field.
+func (u *TenantSettingUpsert) SetDisabledLintRules(v []string) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldDisabledLintRules, v)
+	return u
+}
+
+// UpdateDisabledLintRules sets the "disabled_lint_rules" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateDisabledLintRules() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldDisabledLintRules)
+	return u
+}
+
+// ClearDisabledLintRules clears the value of the "disabled_lint_rules" field.
+func (u *TenantSettingUpsert) ClearDisabledLintRules() *TenantSettingUpsert {
+	u.SetNull(tenantsetting.FieldDisabledLintRules)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantSettingUpsertOne) UpdateNewValues() *TenantSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantsetting.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(tenantsetting.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantsetting.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantSettingUpsertOne) Ignore() *TenantSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSettingUpsertOne) DoNothing() *TenantSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSettingCreate.OnConflict
+// documentation for more info.
+func (u *TenantSettingUpsertOne) Update(set func(*TenantSettingUpsert)) *TenantSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *TenantSettingUpsertOne) SetUpdateBy(v uint32) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *TenantSettingUpsertOne) AddUpdateBy(v uint32) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateUpdateBy() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *TenantSettingUpsertOne) ClearUpdateBy() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantSettingUpsertOne) SetUpdateTime(v time.Time) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateUpdateTime() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantSettingUpsertOne) ClearUpdateTime() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantSettingUpsertOne) SetDeleteTime(v time.Time) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateDeleteTime() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantSettingUpsertOne) ClearDeleteTime() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDefaultTimeoutSeconds sets the "default_timeout_seconds" field.
+func (u *TenantSettingUpsertOne) SetDefaultTimeoutSeconds(v int) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetDefaultTimeoutSeconds(v)
+	})
+}
+
+// AddDefaultTimeoutSeconds adds v to the "default_timeout_seconds" field.
+func (u *TenantSettingUpsertOne) AddDefaultTimeoutSeconds(v int) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddDefaultTimeoutSeconds(v)
+	})
+}
+
+// UpdateDefaultTimeoutSeconds sets the "default_timeout_seconds" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateDefaultTimeoutSeconds() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateDefaultTimeoutSeconds()
+	})
+}
+
+// SetMaxConcurrentPerClient sets the "max_concurrent_per_client" field.
+func (u *TenantSettingUpsertOne) SetMaxConcurrentPerClient(v int) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetMaxConcurrentPerClient(v)
+	})
+}
+
+// AddMaxConcurrentPerClient adds v to the "max_concurrent_per_client" field.
+func (u *TenantSettingUpsertOne) AddMaxConcurrentPerClient(v int) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddMaxConcurrentPerClient(v)
+	})
+}
+
+// UpdateMaxConcurrentPerClient sets the "max_concurrent_per_client" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateMaxConcurrentPerClient() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateMaxConcurrentPerClient()
+	})
+}
+
+// SetRequireScriptApproval sets the "require_script_approval" field.
+func (u *TenantSettingUpsertOne) SetRequireScriptApproval(v bool) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetRequireScriptApproval(v)
+	})
+}
+
+// UpdateRequireScriptApproval sets the "require_script_approval" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateRequireScriptApproval() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateRequireScriptApproval()
+	})
+}
+
+// SetScriptApproverRole sets the "script_approver_role" field.
+func (u *TenantSettingUpsertOne) SetScriptApproverRole(v string) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetScriptApproverRole(v)
+	})
+}
+
+// UpdateScriptApproverRole sets the "script_approver_role" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateScriptApproverRole() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateScriptApproverRole()
+	})
+}
+
+// ClearScriptApproverRole clears the value of the "script_approver_role" field.
+func (u *TenantSettingUpsertOne) ClearScriptApproverRole() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearScriptApproverRole()
+	})
+}
+
+// SetExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field.
+func (u *TenantSettingUpsertOne) SetExecutionApprovalTTLMinutes(v int) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetExecutionApprovalTTLMinutes(v)
+	})
+}
+
+// AddExecutionApprovalTTLMinutes adds v to the "execution_approval_ttl_minutes" field.
+func (u *TenantSettingUpsertOne) AddExecutionApprovalTTLMinutes(v int) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddExecutionApprovalTTLMinutes(v)
+	})
+}
+
+// UpdateExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateExecutionApprovalTTLMinutes() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateExecutionApprovalTTLMinutes()
+	})
+}
+
+// SetScriptBlockSeverity sets the "script_block_severity" field.
+func (u *TenantSettingUpsertOne) SetScriptBlockSeverity(v tenantsetting.ScriptBlockSeverity) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetScriptBlockSeverity(v)
+	})
+}
+
+// UpdateScriptBlockSeverity sets the "script_block_severity" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateScriptBlockSeverity() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateScriptBlockSeverity()
+	})
+}
+
+// SetDisabledLintRules sets the "disabled_lint_rules" field.
+func (u *TenantSettingUpsertOne) SetDisabledLintRules(v []string) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetDisabledLintRules(v)
+	})
+}
+
+// UpdateDisabledLintRules sets the "disabled_lint_rules" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateDisabledLintRules() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateDisabledLintRules()
+	})
+}
+
+// ClearDisabledLintRules clears the value of the "disabled_lint_rules" field.
+func (u *TenantSettingUpsertOne) ClearDisabledLintRules() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearDisabledLintRules()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantSettingUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TenantSettingUpsertOne.ID is not supported by MySQL driver. Use TenantSettingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantSettingUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantSettingCreateBulk is the builder for creating many TenantSetting entities in bulk.
+type TenantSettingCreateBulk struct {
+	config
+	err      error
+	builders []*TenantSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantSetting entities in the database.
+func (_c *TenantSettingCreateBulk) Save(ctx context.Context) ([]*TenantSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantSettingCreateBulk) SaveX(ctx context.Context) []*TenantSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSettingUpsert) {
+//			SetUpdateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantSettingUpsertBulk {
+	_c.conflict = opts
+	return &TenantSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantSettingCreateBulk) OnConflictColumns(columns ...string) *TenantSettingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantSetting nodes.
+type TenantSettingUpsertBulk struct {
+	create *TenantSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantSettingUpsertBulk) UpdateNewValues() *TenantSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantsetting.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(tenantsetting.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantsetting.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantSettingUpsertBulk) Ignore() *TenantSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSettingUpsertBulk) DoNothing() *TenantSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantSettingUpsertBulk) Update(set func(*TenantSettingUpsert)) *TenantSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *TenantSettingUpsertBulk) SetUpdateBy(v uint32) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *TenantSettingUpsertBulk) AddUpdateBy(v uint32) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateUpdateBy() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *TenantSettingUpsertBulk) ClearUpdateBy() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantSettingUpsertBulk) SetUpdateTime(v time.Time) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateUpdateTime() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantSettingUpsertBulk) ClearUpdateTime() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantSettingUpsertBulk) SetDeleteTime(v time.Time) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateDeleteTime() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantSettingUpsertBulk) ClearDeleteTime() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDefaultTimeoutSeconds sets the "default_timeout_seconds" field.
+func (u *TenantSettingUpsertBulk) SetDefaultTimeoutSeconds(v int) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetDefaultTimeoutSeconds(v)
+	})
+}
+
+// AddDefaultTimeoutSeconds adds v to the "default_timeout_seconds" field.
+func (u *TenantSettingUpsertBulk) AddDefaultTimeoutSeconds(v int) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddDefaultTimeoutSeconds(v)
+	})
+}
+
+// UpdateDefaultTimeoutSeconds sets the "default_timeout_seconds" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateDefaultTimeoutSeconds() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateDefaultTimeoutSeconds()
+	})
+}
+
+// SetMaxConcurrentPerClient sets the "max_concurrent_per_client" field.
+func (u *TenantSettingUpsertBulk) SetMaxConcurrentPerClient(v int) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetMaxConcurrentPerClient(v)
+	})
+}
+
+// AddMaxConcurrentPerClient adds v to the "max_concurrent_per_client" field.
+func (u *TenantSettingUpsertBulk) AddMaxConcurrentPerClient(v int) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddMaxConcurrentPerClient(v)
+	})
+}
+
+// UpdateMaxConcurrentPerClient sets the "max_concurrent_per_client" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateMaxConcurrentPerClient() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateMaxConcurrentPerClient()
+	})
+}
+
+// SetRequireScriptApproval sets the "require_script_approval" field.
+func (u *TenantSettingUpsertBulk) SetRequireScriptApproval(v bool) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetRequireScriptApproval(v)
+	})
+}
+
+// UpdateRequireScriptApproval sets the "require_script_approval" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateRequireScriptApproval() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateRequireScriptApproval()
+	})
+}
+
+// SetScriptApproverRole sets the "script_approver_role" field.
+func (u *TenantSettingUpsertBulk) SetScriptApproverRole(v string) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetScriptApproverRole(v)
+	})
+}
+
+// UpdateScriptApproverRole sets the "script_approver_role" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateScriptApproverRole() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateScriptApproverRole()
+	})
+}
+
+// ClearScriptApproverRole clears the value of the "script_approver_role" field.
+func (u *TenantSettingUpsertBulk) ClearScriptApproverRole() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearScriptApproverRole()
+	})
+}
+
+// SetExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field.
+func (u *TenantSettingUpsertBulk) SetExecutionApprovalTTLMinutes(v int) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetExecutionApprovalTTLMinutes(v)
+	})
+}
+
+// AddExecutionApprovalTTLMinutes adds v to the "execution_approval_ttl_minutes" field.
+func (u *TenantSettingUpsertBulk) AddExecutionApprovalTTLMinutes(v int) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddExecutionApprovalTTLMinutes(v)
+	})
+}
+
+// UpdateExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateExecutionApprovalTTLMinutes() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateExecutionApprovalTTLMinutes()
+	})
+}
+
+// SetScriptBlockSeverity sets the "script_block_severity" field.
+func (u *TenantSettingUpsertBulk) SetScriptBlockSeverity(v tenantsetting.ScriptBlockSeverity) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetScriptBlockSeverity(v)
+	})
+}
+
+// UpdateScriptBlockSeverity sets the "script_block_severity" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateScriptBlockSeverity() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateScriptBlockSeverity()
+	})
+}
+
+// SetDisabledLintRules sets the "disabled_lint_rules" field.
+func (u *TenantSettingUpsertBulk) SetDisabledLintRules(v []string) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetDisabledLintRules(v)
+	})
+}
+
+// UpdateDisabledLintRules sets the "disabled_lint_rules" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateDisabledLintRules() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateDisabledLintRules()
+	})
+}
+
+// ClearDisabledLintRules clears the value of the "disabled_lint_rules" field.
+func (u *TenantSettingUpsertBulk) ClearDisabledLintRules() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearDisabledLintRules()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,787 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/tenantsetting"
+)
+
+// TenantSettingUpdate is the builder for updating TenantSetting entities.
+type TenantSettingUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantSettingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantSettingUpdate builder.
+func (_u *TenantSettingUpdate) Where(ps ...predicate.TenantSetting) *TenantSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *TenantSettingUpdate) SetUpdateBy(v uint32) *TenantSettingUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableUpdateBy(v *uint32) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *TenantSettingUpdate) AddUpdateBy(v int32) *TenantSettingUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *TenantSettingUpdate) ClearUpdateBy() *TenantSettingUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantSettingUpdate) SetUpdateTime(v time.Time) *TenantSettingUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableUpdateTime(v *time.Time) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantSettingUpdate) ClearUpdateTime() *TenantSettingUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantSettingUpdate) SetDeleteTime(v time.Time) *TenantSettingUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableDeleteTime(v *time.Time) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantSettingUpdate) ClearDeleteTime() *TenantSettingUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDefaultTimeoutSeconds sets the "default_timeout_seconds" field.
+func (_u *TenantSettingUpdate) SetDefaultTimeoutSeconds(v int) *TenantSettingUpdate {
+	_u.mutation.ResetDefaultTimeoutSeconds()
+	_u.mutation.SetDefaultTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableDefaultTimeoutSeconds sets the "default_timeout_seconds" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableDefaultTimeoutSeconds(v *int) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetDefaultTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddDefaultTimeoutSeconds adds value to the "default_timeout_seconds" field.
+func (_u *TenantSettingUpdate) AddDefaultTimeoutSeconds(v int) *TenantSettingUpdate {
+	_u.mutation.AddDefaultTimeoutSeconds(v)
+	return _u
+}
+
+// SetMaxConcurrentPerClient sets the "max_concurrent_per_client" field.
+func (_u *TenantSettingUpdate) SetMaxConcurrentPerClient(v int) *TenantSettingUpdate {
+	_u.mutation.ResetMaxConcurrentPerClient()
+	_u.mutation.SetMaxConcurrentPerClient(v)
+	return _u
+}
+
+// SetNillableMaxConcurrentPerClient sets the "max_concurrent_per_client" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableMaxConcurrentPerClient(v *int) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetMaxConcurrentPerClient(*v)
+	}
+	return _u
+}
+
+// AddMaxConcurrentPerClient adds value to the "max_concurrent_per_client" field.
+func (_u *TenantSettingUpdate) AddMaxConcurrentPerClient(v int) *TenantSettingUpdate {
+	_u.mutation.AddMaxConcurrentPerClient(v)
+	return _u
+}
+
+// SetRequireScriptApproval sets the "require_script_approval" field.
+func (_u *TenantSettingUpdate) SetRequireScriptApproval(v bool) *TenantSettingUpdate {
+	_u.mutation.SetRequireScriptApproval(v)
+	return _u
+}
+
+// SetNillableRequireScriptApproval sets the "require_script_approval" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableRequireScriptApproval(v *bool) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetRequireScriptApproval(*v)
+	}
+	return _u
+}
+
+// SetScriptApproverRole sets the "script_approver_role" field.
+func (_u *TenantSettingUpdate) SetScriptApproverRole(v string) *TenantSettingUpdate {
+	_u.mutation.SetScriptApproverRole(v)
+	return _u
+}
+
+// SetNillableScriptApproverRole sets the "script_approver_role" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableScriptApproverRole(v *string) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetScriptApproverRole(*v)
+	}
+	return _u
+}
+
+// ClearScriptApproverRole clears the value of the "script_approver_role" field.
+func (_u *TenantSettingUpdate) ClearScriptApproverRole() *TenantSettingUpdate {
+	_u.mutation.ClearScriptApproverRole()
+	return _u
+}
+
+// SetExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field.
+func (_u *TenantSettingUpdate) SetExecutionApprovalTTLMinutes(v int) *TenantSettingUpdate {
+	_u.mutation.ResetExecutionApprovalTTLMinutes()
+	_u.mutation.SetExecutionApprovalTTLMinutes(v)
+	return _u
+}
+
+// SetNillableExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableExecutionApprovalTTLMinutes(v *int) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetExecutionApprovalTTLMinutes(*v)
+	}
+	return _u
+}
+
+// AddExecutionApprovalTTLMinutes adds value to the "execution_approval_ttl_minutes" field.
+func (_u *TenantSettingUpdate) AddExecutionApprovalTTLMinutes(v int) *TenantSettingUpdate {
+	_u.mutation.AddExecutionApprovalTTLMinutes(v)
+	return _u
+}
+
+// SetScriptBlockSeverity sets the "script_block_severity" field.
+func (_u *TenantSettingUpdate) SetScriptBlockSeverity(v tenantsetting.ScriptBlockSeverity) *TenantSettingUpdate {
+	_u.mutation.SetScriptBlockSeverity(v)
+	return _u
+}
+
+// SetNillableScriptBlockSeverity sets the "script_block_severity" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableScriptBlockSeverity(v *tenantsetting.ScriptBlockSeverity) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetScriptBlockSeverity(*v)
+	}
+	return _u
+}
+
+// SetDisabledLintRules sets the "disabled_lint_rules" field.
+func (_u *TenantSettingUpdate) SetDisabledLintRules(v []string) *TenantSettingUpdate {
+	_u.mutation.SetDisabledLintRules(v)
+	return _u
+}
+
+// AppendDisabledLintRules appends value to the "disabled_lint_rules" field.
+func (_u *TenantSettingUpdate) AppendDisabledLintRules(v []string) *TenantSettingUpdate {
+	_u.mutation.AppendDisabledLintRules(v)
+	return _u
+}
+
+// ClearDisabledLintRules clears the value of the "disabled_lint_rules" field.
+func (_u *TenantSettingUpdate) ClearDisabledLintRules() *TenantSettingUpdate {
+	_u.mutation.ClearDisabledLintRules()
+	return _u
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (_u *TenantSettingUpdate) Mutation() *TenantSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantSettingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSettingUpdate) check() error {
+	if v, ok := _u.mutation.DefaultTimeoutSeconds(); ok {
+		if err := tenantsetting.DefaultTimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "default_timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_timeout_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxConcurrentPerClient(); ok {
+		if err := tenantsetting.MaxConcurrentPerClientValidator(v); err != nil {
+			return &ValidationError{Name: "max_concurrent_per_client", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.max_concurrent_per_client": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptApproverRole(); ok {
+		if err := tenantsetting.ScriptApproverRoleValidator(v); err != nil {
+			return &ValidationError{Name: "script_approver_role", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.script_approver_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExecutionApprovalTTLMinutes(); ok {
+		if err := tenantsetting.ExecutionApprovalTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "execution_approval_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.execution_approval_ttl_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptBlockSeverity(); ok {
+		if err := tenantsetting.ScriptBlockSeverityValidator(v); err != nil {
+			return &ValidationError{Name: "script_block_severity", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.script_block_severity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantSettingUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantSettingUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(tenantsetting.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(tenantsetting.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantsetting.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantsetting.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantsetting.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantsetting.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantsetting.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DefaultTimeoutSeconds(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultTimeoutSeconds(); ok {
+		_spec.AddField(tenantsetting.FieldDefaultTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxConcurrentPerClient(); ok {
+		_spec.SetField(tenantsetting.FieldMaxConcurrentPerClient, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxConcurrentPerClient(); ok {
+		_spec.AddField(tenantsetting.FieldMaxConcurrentPerClient, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RequireScriptApproval(); ok {
+		_spec.SetField(tenantsetting.FieldRequireScriptApproval, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScriptApproverRole(); ok {
+		_spec.SetField(tenantsetting.FieldScriptApproverRole, field.TypeString, value)
+	}
+	if _u.mutation.ScriptApproverRoleCleared() {
+		_spec.ClearField(tenantsetting.FieldScriptApproverRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExecutionApprovalTTLMinutes(); ok {
+		_spec.SetField(tenantsetting.FieldExecutionApprovalTTLMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExecutionApprovalTTLMinutes(); ok {
+		_spec.AddField(tenantsetting.FieldExecutionApprovalTTLMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScriptBlockSeverity(); ok {
+		_spec.SetField(tenantsetting.FieldScriptBlockSeverity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DisabledLintRules(); ok {
+		_spec.SetField(tenantsetting.FieldDisabledLintRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDisabledLintRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldDisabledLintRules, value)
+		})
+	}
+	if _u.mutation.DisabledLintRulesCleared() {
+		_spec.ClearField(tenantsetting.FieldDisabledLintRules, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantSettingUpdateOne is the builder for updating a single TenantSetting entity.
+type TenantSettingUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantSettingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *TenantSettingUpdateOne) SetUpdateBy(v uint32) *TenantSettingUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableUpdateBy(v *uint32) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *TenantSettingUpdateOne) AddUpdateBy(v int32) *TenantSettingUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *TenantSettingUpdateOne) ClearUpdateBy() *TenantSettingUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantSettingUpdateOne) SetUpdateTime(v time.Time) *TenantSettingUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableUpdateTime(v *time.Time) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantSettingUpdateOne) ClearUpdateTime() *TenantSettingUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantSettingUpdateOne) SetDeleteTime(v time.Time) *TenantSettingUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableDeleteTime(v *time.Time) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantSettingUpdateOne) ClearDeleteTime() *TenantSettingUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDefaultTimeoutSeconds sets the "default_timeout_seconds" field.
+func (_u *TenantSettingUpdateOne) SetDefaultTimeoutSeconds(v int) *TenantSettingUpdateOne {
+	_u.mutation.ResetDefaultTimeoutSeconds()
+	_u.mutation.SetDefaultTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableDefaultTimeoutSeconds sets the "default_timeout_seconds" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableDefaultTimeoutSeconds(v *int) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetDefaultTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddDefaultTimeoutSeconds adds value to the "default_timeout_seconds" field.
+func (_u *TenantSettingUpdateOne) AddDefaultTimeoutSeconds(v int) *TenantSettingUpdateOne {
+	_u.mutation.AddDefaultTimeoutSeconds(v)
+	return _u
+}
+
+// SetMaxConcurrentPerClient sets the "max_concurrent_per_client" field.
+func (_u *TenantSettingUpdateOne) SetMaxConcurrentPerClient(v int) *TenantSettingUpdateOne {
+	_u.mutation.ResetMaxConcurrentPerClient()
+	_u.mutation.SetMaxConcurrentPerClient(v)
+	return _u
+}
+
+// SetNillableMaxConcurrentPerClient sets the "max_concurrent_per_client" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableMaxConcurrentPerClient(v *int) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetMaxConcurrentPerClient(*v)
+	}
+	return _u
+}
+
+// AddMaxConcurrentPerClient adds value to the "max_concurrent_per_client" field.
+func (_u *TenantSettingUpdateOne) AddMaxConcurrentPerClient(v int) *TenantSettingUpdateOne {
+	_u.mutation.AddMaxConcurrentPerClient(v)
+	return _u
+}
+
+// SetRequireScriptApproval sets the "require_script_approval" field.
+func (_u *TenantSettingUpdateOne) SetRequireScriptApproval(v bool) *TenantSettingUpdateOne {
+	_u.mutation.SetRequireScriptApproval(v)
+	return _u
+}
+
+// SetNillableRequireScriptApproval sets the "require_script_approval" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableRequireScriptApproval(v *bool) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetRequireScriptApproval(*v)
+	}
+	return _u
+}
+
+// SetScriptApproverRole sets the "script_approver_role" field.
+func (_u *TenantSettingUpdateOne) SetScriptApproverRole(v string) *TenantSettingUpdateOne {
+	_u.mutation.SetScriptApproverRole(v)
+	return _u
+}
+
+// SetNillableScriptApproverRole sets the "script_approver_role" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableScriptApproverRole(v *string) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetScriptApproverRole(*v)
+	}
+	return _u
+}
+
+// ClearScriptApproverRole clears the value of the "script_approver_role" field.
+func (_u *TenantSettingUpdateOne) ClearScriptApproverRole() *TenantSettingUpdateOne {
+	_u.mutation.ClearScriptApproverRole()
+	return _u
+}
+
+// SetExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field.
+func (_u *TenantSettingUpdateOne) SetExecutionApprovalTTLMinutes(v int) *TenantSettingUpdateOne {
+	_u.mutation.ResetExecutionApprovalTTLMinutes()
+	_u.mutation.SetExecutionApprovalTTLMinutes(v)
+	return _u
+}
+
+// SetNillableExecutionApprovalTTLMinutes sets the "execution_approval_ttl_minutes" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableExecutionApprovalTTLMinutes(v *int) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetExecutionApprovalTTLMinutes(*v)
+	}
+	return _u
+}
+
+// AddExecutionApprovalTTLMinutes adds value to the "execution_approval_ttl_minutes" field.
+func (_u *TenantSettingUpdateOne) AddExecutionApprovalTTLMinutes(v int) *TenantSettingUpdateOne {
+	_u.mutation.AddExecutionApprovalTTLMinutes(v)
+	return _u
+}
+
+// SetScriptBlockSeverity sets the "script_block_severity" field.
+func (_u *TenantSettingUpdateOne) SetScriptBlockSeverity(v tenantsetting.ScriptBlockSeverity) *TenantSettingUpdateOne {
+	_u.mutation.SetScriptBlockSeverity(v)
+	return _u
+}
+
+// SetNillableScriptBlockSeverity sets the "script_block_severity" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableScriptBlockSeverity(v *tenantsetting.ScriptBlockSeverity) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetScriptBlockSeverity(*v)
+	}
+	return _u
+}
+
+// SetDisabledLintRules sets the "disabled_lint_rules" field.
+func (_u *TenantSettingUpdateOne) SetDisabledLintRules(v []string) *TenantSettingUpdateOne {
+	_u.mutation.SetDisabledLintRules(v)
+	return _u
+}
+
+// AppendDisabledLintRules appends value to the "disabled_lint_rules" field.
+func (_u *TenantSettingUpdateOne) AppendDisabledLintRules(v []string) *TenantSettingUpdateOne {
+	_u.mutation.AppendDisabledLintRules(v)
+	return _u
+}
+
+// ClearDisabledLintRules clears the value of the "disabled_lint_rules" field.
+func (_u *TenantSettingUpdateOne) ClearDisabledLintRules() *TenantSettingUpdateOne {
+	_u.mutation.ClearDisabledLintRules()
+	return _u
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (_u *TenantSettingUpdateOne) Mutation() *TenantSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantSettingUpdate builder.
+func (_u *TenantSettingUpdateOne) Where(ps ...predicate.TenantSetting) *TenantSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantSettingUpdateOne) Select(field string, fields ...string) *TenantSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantSetting entity.
+func (_u *TenantSettingUpdateOne) Save(ctx context.Context) (*TenantSetting, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSettingUpdateOne) SaveX(ctx context.Context) *TenantSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSettingUpdateOne) check() error {
+	if v, ok := _u.mutation.DefaultTimeoutSeconds(); ok {
+		if err := tenantsetting.DefaultTimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "default_timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_timeout_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxConcurrentPerClient(); ok {
+		if err := tenantsetting.MaxConcurrentPerClientValidator(v); err != nil {
+			return &ValidationError{Name: "max_concurrent_per_client", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.max_concurrent_per_client": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptApproverRole(); ok {
+		if err := tenantsetting.ScriptApproverRoleValidator(v); err != nil {
+			return &ValidationError{Name: "script_approver_role", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.script_approver_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExecutionApprovalTTLMinutes(); ok {
+		if err := tenantsetting.ExecutionApprovalTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "execution_approval_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.execution_approval_ttl_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScriptBlockSeverity(); ok {
+		if err := tenantsetting.ScriptBlockSeverityValidator(v); err != nil {
+			return &ValidationError{Name: "script_block_severity", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.script_block_severity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantSettingUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantSettingUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantSettingUpdateOne) sqlSave(ctx context.Context) (_node *TenantSetting, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsetting.FieldID)
+		for _, f := range fields {
+			if !tenantsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(tenantsetting.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(tenantsetting.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantsetting.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantsetting.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantsetting.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantsetting.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantsetting.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DefaultTimeoutSeconds(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultTimeoutSeconds(); ok {
+		_spec.AddField(tenantsetting.FieldDefaultTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxConcurrentPerClient(); ok {
+		_spec.SetField(tenantsetting.FieldMaxConcurrentPerClient, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxConcurrentPerClient(); ok {
+		_spec.AddField(tenantsetting.FieldMaxConcurrentPerClient, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RequireScriptApproval(); ok {
+		_spec.SetField(tenantsetting.FieldRequireScriptApproval, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScriptApproverRole(); ok {
+		_spec.SetField(tenantsetting.FieldScriptApproverRole, field.TypeString, value)
+	}
+	if _u.mutation.ScriptApproverRoleCleared() {
+		_spec.ClearField(tenantsetting.FieldScriptApproverRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExecutionApprovalTTLMinutes(); ok {
+		_spec.SetField(tenantsetting.FieldExecutionApprovalTTLMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExecutionApprovalTTLMinutes(); ok {
+		_spec.AddField(tenantsetting.FieldExecutionApprovalTTLMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScriptBlockSeverity(); ok {
+		_spec.SetField(tenantsetting.FieldScriptBlockSeverity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DisabledLintRules(); ok {
+		_spec.SetField(tenantsetting.FieldDisabledLintRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDisabledLintRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldDisabledLintRules, value)
+		})
+	}
+	if _u.mutation.DisabledLintRulesCleared() {
+		_spec.ClearField(tenantsetting.FieldDisabledLintRules, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
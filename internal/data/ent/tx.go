@@ -14,12 +14,50 @@ type Tx struct {
 	config
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// ClientGroup is the client for interacting with the ClientGroup builders.
+	ClientGroup *ClientGroupClient
+	// ClientLabelSet is the client for interacting with the ClientLabelSet builders.
+	ClientLabelSet *ClientLabelSetClient
+	// ClientLock is the client for interacting with the ClientLock builders.
+	ClientLock *ClientLockClient
+	// Command is the client for interacting with the Command builders.
+	Command *CommandClient
+	// EventRule is the client for interacting with the EventRule builders.
+	EventRule *EventRuleClient
 	// ExecutionLog is the client for interacting with the ExecutionLog builders.
 	ExecutionLog *ExecutionLogClient
+	// ExecutionRun is the client for interacting with the ExecutionRun builders.
+	ExecutionRun *ExecutionRunClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
+	// ManagedClient is the client for interacting with the ManagedClient builders.
+	ManagedClient *ManagedClientClient
+	// OutputChunk is the client for interacting with the OutputChunk builders.
+	OutputChunk *OutputChunkClient
+	// ProtectedClient is the client for interacting with the ProtectedClient builders.
+	ProtectedClient *ProtectedClientClient
+	// QueuedCommand is the client for interacting with the QueuedCommand builders.
+	QueuedCommand *QueuedCommandClient
+	// Schedule is the client for interacting with the Schedule builders.
+	Schedule *ScheduleClient
 	// Script is the client for interacting with the Script builders.
 	Script *ScriptClient
 	// ScriptAssignment is the client for interacting with the ScriptAssignment builders.
 	ScriptAssignment *ScriptAssignmentClient
+	// ScriptChangeRequest is the client for interacting with the ScriptChangeRequest builders.
+	ScriptChangeRequest *ScriptChangeRequestClient
+	// ScriptVersion is the client for interacting with the ScriptVersion builders.
+	ScriptVersion *ScriptVersionClient
+	// Secret is the client for interacting with the Secret builders.
+	Secret *SecretClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
+	// TenantSetting is the client for interacting with the TenantSetting builders.
+	TenantSetting *TenantSettingClient
+	// Workflow is the client for interacting with the Workflow builders.
+	Workflow *WorkflowClient
+	// WorkflowRun is the client for interacting with the WorkflowRun builders.
+	WorkflowRun *WorkflowRunClient
 
 	// lazily loaded.
 	client     *Client
@@ -152,9 +190,28 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.ClientGroup = NewClientGroupClient(tx.config)
+	tx.ClientLabelSet = NewClientLabelSetClient(tx.config)
+	tx.ClientLock = NewClientLockClient(tx.config)
+	tx.Command = NewCommandClient(tx.config)
+	tx.EventRule = NewEventRuleClient(tx.config)
 	tx.ExecutionLog = NewExecutionLogClient(tx.config)
+	tx.ExecutionRun = NewExecutionRunClient(tx.config)
+	tx.MaintenanceWindow = NewMaintenanceWindowClient(tx.config)
+	tx.ManagedClient = NewManagedClientClient(tx.config)
+	tx.OutputChunk = NewOutputChunkClient(tx.config)
+	tx.ProtectedClient = NewProtectedClientClient(tx.config)
+	tx.QueuedCommand = NewQueuedCommandClient(tx.config)
+	tx.Schedule = NewScheduleClient(tx.config)
 	tx.Script = NewScriptClient(tx.config)
 	tx.ScriptAssignment = NewScriptAssignmentClient(tx.config)
+	tx.ScriptChangeRequest = NewScriptChangeRequestClient(tx.config)
+	tx.ScriptVersion = NewScriptVersionClient(tx.config)
+	tx.Secret = NewSecretClient(tx.config)
+	tx.SigningKey = NewSigningKeyClient(tx.config)
+	tx.TenantSetting = NewTenantSettingClient(tx.config)
+	tx.Workflow = NewWorkflowClient(tx.config)
+	tx.WorkflowRun = NewWorkflowRunClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
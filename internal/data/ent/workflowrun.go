@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
+)
+
+// WorkflowRun is the model entity for the WorkflowRun schema.
+type WorkflowRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// FK to executor_workflows
+	WorkflowID string `json:"workflow_id,omitempty"`
+	// Denormalized workflow name for audit readability
+	WorkflowName string `json:"workflow_name,omitempty"`
+	// mTLS client CN the steps run on
+	ClientID string `json:"client_id,omitempty"`
+	// Steps of the workflow when the run started
+	Steps []schema.WorkflowStep `json:"steps,omitempty"`
+	// Steps whose execution was dispatched
+	StartedSteps []string `json:"started_steps,omitempty"`
+	// Steps whose condition did not hold
+	SkippedSteps []string `json:"skipped_steps,omitempty"`
+	// Steps whose execution could not be created
+	FailedSteps []string `json:"failed_steps,omitempty"`
+	// Incremented on every step change, for compare-and-set
+	Revision int `json:"revision,omitempty"`
+	// Run status
+	Status workflowrun.Status `json:"status,omitempty"`
+	// When every step had finished or was skipped
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkflowRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workflowrun.FieldSteps, workflowrun.FieldStartedSteps, workflowrun.FieldSkippedSteps, workflowrun.FieldFailedSteps:
+			values[i] = new([]byte)
+		case workflowrun.FieldCreateBy, workflowrun.FieldTenantID, workflowrun.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case workflowrun.FieldID, workflowrun.FieldWorkflowID, workflowrun.FieldWorkflowName, workflowrun.FieldClientID, workflowrun.FieldStatus:
+			values[i] = new(sql.NullString)
+		case workflowrun.FieldCreateTime, workflowrun.FieldUpdateTime, workflowrun.FieldDeleteTime, workflowrun.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkflowRun fields.
+func (_m *WorkflowRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workflowrun.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case workflowrun.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case workflowrun.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case workflowrun.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case workflowrun.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case workflowrun.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case workflowrun.FieldWorkflowID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value.Valid {
+				_m.WorkflowID = value.String
+			}
+		case workflowrun.FieldWorkflowName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_name", values[i])
+			} else if value.Valid {
+				_m.WorkflowName = value.String
+			}
+		case workflowrun.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case workflowrun.FieldSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Steps); err != nil {
+					return fmt.Errorf("unmarshal field steps: %w", err)
+				}
+			}
+		case workflowrun.FieldStartedSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field started_steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.StartedSteps); err != nil {
+					return fmt.Errorf("unmarshal field started_steps: %w", err)
+				}
+			}
+		case workflowrun.FieldSkippedSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped_steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SkippedSteps); err != nil {
+					return fmt.Errorf("unmarshal field skipped_steps: %w", err)
+				}
+			}
+		case workflowrun.FieldFailedSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FailedSteps); err != nil {
+					return fmt.Errorf("unmarshal field failed_steps: %w", err)
+				}
+			}
+		case workflowrun.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case workflowrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = workflowrun.Status(value.String)
+			}
+		case workflowrun.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkflowRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *WorkflowRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WorkflowRun.
+// Note that you need to call WorkflowRun.Unwrap() before calling this method if this WorkflowRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WorkflowRun) Update() *WorkflowRunUpdateOne {
+	return NewWorkflowRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WorkflowRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WorkflowRun) Unwrap() *WorkflowRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkflowRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WorkflowRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkflowRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("workflow_id=")
+	builder.WriteString(_m.WorkflowID)
+	builder.WriteString(", ")
+	builder.WriteString("workflow_name=")
+	builder.WriteString(_m.WorkflowName)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Steps))
+	builder.WriteString(", ")
+	builder.WriteString("started_steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartedSteps))
+	builder.WriteString(", ")
+	builder.WriteString("skipped_steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkippedSteps))
+	builder.WriteString(", ")
+	builder.WriteString("failed_steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedSteps))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkflowRuns is a parsable slice of WorkflowRun.
+type WorkflowRuns []*WorkflowRun
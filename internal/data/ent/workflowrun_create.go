@@ -0,0 +1,1428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
+)
+
+// WorkflowRunCreate is the builder for creating a WorkflowRun entity.
+type WorkflowRunCreate struct {
+	config
+	mutation *WorkflowRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *WorkflowRunCreate) SetCreateBy(v uint32) *WorkflowRunCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableCreateBy(v *uint32) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *WorkflowRunCreate) SetCreateTime(v time.Time) *WorkflowRunCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableCreateTime(v *time.Time) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *WorkflowRunCreate) SetUpdateTime(v time.Time) *WorkflowRunCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableUpdateTime(v *time.Time) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *WorkflowRunCreate) SetDeleteTime(v time.Time) *WorkflowRunCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableDeleteTime(v *time.Time) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WorkflowRunCreate) SetTenantID(v uint32) *WorkflowRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableTenantID(v *uint32) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_c *WorkflowRunCreate) SetWorkflowID(v string) *WorkflowRunCreate {
+	_c.mutation.SetWorkflowID(v)
+	return _c
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (_c *WorkflowRunCreate) SetWorkflowName(v string) *WorkflowRunCreate {
+	_c.mutation.SetWorkflowName(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *WorkflowRunCreate) SetClientID(v string) *WorkflowRunCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetSteps sets the "steps" field.
+func (_c *WorkflowRunCreate) SetSteps(v []schema.WorkflowStep) *WorkflowRunCreate {
+	_c.mutation.SetSteps(v)
+	return _c
+}
+
+// SetStartedSteps sets the "started_steps" field.
+func (_c *WorkflowRunCreate) SetStartedSteps(v []string) *WorkflowRunCreate {
+	_c.mutation.SetStartedSteps(v)
+	return _c
+}
+
+// SetSkippedSteps sets the "skipped_steps" field.
+func (_c *WorkflowRunCreate) SetSkippedSteps(v []string) *WorkflowRunCreate {
+	_c.mutation.SetSkippedSteps(v)
+	return _c
+}
+
+// SetFailedSteps sets the "failed_steps" field.
+func (_c *WorkflowRunCreate) SetFailedSteps(v []string) *WorkflowRunCreate {
+	_c.mutation.SetFailedSteps(v)
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *WorkflowRunCreate) SetRevision(v int) *WorkflowRunCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableRevision(v *int) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WorkflowRunCreate) SetStatus(v workflowrun.Status) *WorkflowRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableStatus(v *workflowrun.Status) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *WorkflowRunCreate) SetCompletedAt(v time.Time) *WorkflowRunCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableCompletedAt(v *time.Time) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WorkflowRunCreate) SetID(v string) *WorkflowRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the WorkflowRunMutation object of the builder.
+func (_c *WorkflowRunCreate) Mutation() *WorkflowRunMutation {
+	return _c.mutation
+}
+
+// Save creates the WorkflowRun in the database.
+func (_c *WorkflowRunCreate) Save(ctx context.Context) (*WorkflowRun, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkflowRunCreate) SaveX(ctx context.Context) *WorkflowRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkflowRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkflowRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkflowRunCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := workflowrun.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := workflowrun.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := workflowrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkflowRunCreate) check() error {
+	if _, ok := _c.mutation.WorkflowID(); !ok {
+		return &ValidationError{Name: "workflow_id", err: errors.New(`ent: missing required field "WorkflowRun.workflow_id"`)}
+	}
+	if v, ok := _c.mutation.WorkflowID(); ok {
+		if err := workflowrun.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.workflow_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WorkflowName(); !ok {
+		return &ValidationError{Name: "workflow_name", err: errors.New(`ent: missing required field "WorkflowRun.workflow_name"`)}
+	}
+	if v, ok := _c.mutation.WorkflowName(); ok {
+		if err := workflowrun.WorkflowNameValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_name", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.workflow_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "WorkflowRun.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := workflowrun.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Steps(); !ok {
+		return &ValidationError{Name: "steps", err: errors.New(`ent: missing required field "WorkflowRun.steps"`)}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "WorkflowRun.revision"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WorkflowRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := workflowrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := workflowrun.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *WorkflowRunCreate) sqlSave(ctx context.Context) (*WorkflowRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WorkflowRun.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkflowRunCreate) createSpec() (*WorkflowRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkflowRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(workflowrun.Table, sqlgraph.NewFieldSpec(workflowrun.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(workflowrun.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(workflowrun.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(workflowrun.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(workflowrun.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(workflowrun.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.WorkflowID(); ok {
+		_spec.SetField(workflowrun.FieldWorkflowID, field.TypeString, value)
+		_node.WorkflowID = value
+	}
+	if value, ok := _c.mutation.WorkflowName(); ok {
+		_spec.SetField(workflowrun.FieldWorkflowName, field.TypeString, value)
+		_node.WorkflowName = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(workflowrun.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Steps(); ok {
+		_spec.SetField(workflowrun.FieldSteps, field.TypeJSON, value)
+		_node.Steps = value
+	}
+	if value, ok := _c.mutation.StartedSteps(); ok {
+		_spec.SetField(workflowrun.FieldStartedSteps, field.TypeJSON, value)
+		_node.StartedSteps = value
+	}
+	if value, ok := _c.mutation.SkippedSteps(); ok {
+		_spec.SetField(workflowrun.FieldSkippedSteps, field.TypeJSON, value)
+		_node.SkippedSteps = value
+	}
+	if value, ok := _c.mutation.FailedSteps(); ok {
+		_spec.SetField(workflowrun.FieldFailedSteps, field.TypeJSON, value)
+		_node.FailedSteps = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(workflowrun.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(workflowrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(workflowrun.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkflowRun.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkflowRunUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkflowRunCreate) OnConflict(opts ...sql.ConflictOption) *WorkflowRunUpsertOne {
+	_c.conflict = opts
+	return &WorkflowRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkflowRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkflowRunCreate) OnConflictColumns(columns ...string) *WorkflowRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkflowRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WorkflowRunUpsertOne is the builder for "upsert"-ing
+	//  one WorkflowRun node.
+	WorkflowRunUpsertOne struct {
+		create *WorkflowRunCreate
+	}
+
+	// WorkflowRunUpsert is the "OnConflict" setter.
+	WorkflowRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkflowRunUpsert) SetCreateBy(v uint32) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateCreateBy() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkflowRunUpsert) AddCreateBy(v uint32) *WorkflowRunUpsert {
+	u.Add(workflowrun.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkflowRunUpsert) ClearCreateBy() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkflowRunUpsert) SetUpdateTime(v time.Time) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateUpdateTime() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkflowRunUpsert) ClearUpdateTime() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkflowRunUpsert) SetDeleteTime(v time.Time) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateDeleteTime() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkflowRunUpsert) ClearDeleteTime() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldDeleteTime)
+	return u
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (u *WorkflowRunUpsert) SetWorkflowID(v string) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldWorkflowID, v)
+	return u
+}
+
+// UpdateWorkflowID sets the "workflow_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateWorkflowID() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldWorkflowID)
+	return u
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (u *WorkflowRunUpsert) SetWorkflowName(v string) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldWorkflowName, v)
+	return u
+}
+
+// UpdateWorkflowName sets the "workflow_name" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateWorkflowName() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldWorkflowName)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *WorkflowRunUpsert) SetClientID(v string) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateClientID() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldClientID)
+	return u
+}
+
+// SetSteps sets the "steps" field.
+func (u *WorkflowRunUpsert) SetSteps(v []schema.WorkflowStep) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldSteps, v)
+	return u
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateSteps() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldSteps)
+	return u
+}
+
+// SetStartedSteps sets the "started_steps" field.
+func (u *WorkflowRunUpsert) SetStartedSteps(v []string) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldStartedSteps, v)
+	return u
+}
+
+// UpdateStartedSteps sets the "started_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateStartedSteps() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldStartedSteps)
+	return u
+}
+
+// ClearStartedSteps clears the value of the "started_steps" field.
+func (u *WorkflowRunUpsert) ClearStartedSteps() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldStartedSteps)
+	return u
+}
+
+// SetSkippedSteps sets the "skipped_steps" field.
+func (u *WorkflowRunUpsert) SetSkippedSteps(v []string) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldSkippedSteps, v)
+	return u
+}
+
+// UpdateSkippedSteps sets the "skipped_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateSkippedSteps() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldSkippedSteps)
+	return u
+}
+
+// ClearSkippedSteps clears the value of the "skipped_steps" field.
+func (u *WorkflowRunUpsert) ClearSkippedSteps() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldSkippedSteps)
+	return u
+}
+
+// SetFailedSteps sets the "failed_steps" field.
+func (u *WorkflowRunUpsert) SetFailedSteps(v []string) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldFailedSteps, v)
+	return u
+}
+
+// UpdateFailedSteps sets the "failed_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateFailedSteps() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldFailedSteps)
+	return u
+}
+
+// ClearFailedSteps clears the value of the "failed_steps" field.
+func (u *WorkflowRunUpsert) ClearFailedSteps() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldFailedSteps)
+	return u
+}
+
+// SetRevision sets the "revision" field.
+func (u *WorkflowRunUpsert) SetRevision(v int) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldRevision, v)
+	return u
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateRevision() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldRevision)
+	return u
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *WorkflowRunUpsert) AddRevision(v int) *WorkflowRunUpsert {
+	u.Add(workflowrun.FieldRevision, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WorkflowRunUpsert) SetStatus(v workflowrun.Status) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateStatus() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldStatus)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *WorkflowRunUpsert) SetCompletedAt(v time.Time) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateCompletedAt() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *WorkflowRunUpsert) ClearCompletedAt() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workflowrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkflowRunUpsertOne) UpdateNewValues() *WorkflowRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(workflowrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(workflowrun.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(workflowrun.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WorkflowRunUpsertOne) Ignore() *WorkflowRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkflowRunUpsertOne) DoNothing() *WorkflowRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkflowRunCreate.OnConflict
+// documentation for more info.
+func (u *WorkflowRunUpsertOne) Update(set func(*WorkflowRunUpsert)) *WorkflowRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkflowRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkflowRunUpsertOne) SetCreateBy(v uint32) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkflowRunUpsertOne) AddCreateBy(v uint32) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateCreateBy() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkflowRunUpsertOne) ClearCreateBy() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkflowRunUpsertOne) SetUpdateTime(v time.Time) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateUpdateTime() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkflowRunUpsertOne) ClearUpdateTime() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkflowRunUpsertOne) SetDeleteTime(v time.Time) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateDeleteTime() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkflowRunUpsertOne) ClearDeleteTime() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (u *WorkflowRunUpsertOne) SetWorkflowID(v string) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetWorkflowID(v)
+	})
+}
+
+// UpdateWorkflowID sets the "workflow_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateWorkflowID() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateWorkflowID()
+	})
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (u *WorkflowRunUpsertOne) SetWorkflowName(v string) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetWorkflowName(v)
+	})
+}
+
+// UpdateWorkflowName sets the "workflow_name" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateWorkflowName() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateWorkflowName()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *WorkflowRunUpsertOne) SetClientID(v string) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateClientID() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetSteps sets the "steps" field.
+func (u *WorkflowRunUpsertOne) SetSteps(v []schema.WorkflowStep) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetSteps(v)
+	})
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateSteps() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateSteps()
+	})
+}
+
+// SetStartedSteps sets the "started_steps" field.
+func (u *WorkflowRunUpsertOne) SetStartedSteps(v []string) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetStartedSteps(v)
+	})
+}
+
+// UpdateStartedSteps sets the "started_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateStartedSteps() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateStartedSteps()
+	})
+}
+
+// ClearStartedSteps clears the value of the "started_steps" field.
+func (u *WorkflowRunUpsertOne) ClearStartedSteps() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearStartedSteps()
+	})
+}
+
+// SetSkippedSteps sets the "skipped_steps" field.
+func (u *WorkflowRunUpsertOne) SetSkippedSteps(v []string) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetSkippedSteps(v)
+	})
+}
+
+// UpdateSkippedSteps sets the "skipped_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateSkippedSteps() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateSkippedSteps()
+	})
+}
+
+// ClearSkippedSteps clears the value of the "skipped_steps" field.
+func (u *WorkflowRunUpsertOne) ClearSkippedSteps() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearSkippedSteps()
+	})
+}
+
+// SetFailedSteps sets the "failed_steps" field.
+func (u *WorkflowRunUpsertOne) SetFailedSteps(v []string) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetFailedSteps(v)
+	})
+}
+
+// UpdateFailedSteps sets the "failed_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateFailedSteps() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateFailedSteps()
+	})
+}
+
+// ClearFailedSteps clears the value of the "failed_steps" field.
+func (u *WorkflowRunUpsertOne) ClearFailedSteps() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearFailedSteps()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *WorkflowRunUpsertOne) SetRevision(v int) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *WorkflowRunUpsertOne) AddRevision(v int) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateRevision() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WorkflowRunUpsertOne) SetStatus(v workflowrun.Status) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateStatus() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *WorkflowRunUpsertOne) SetCompletedAt(v time.Time) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateCompletedAt() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *WorkflowRunUpsertOne) ClearCompletedAt() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkflowRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkflowRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkflowRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WorkflowRunUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WorkflowRunUpsertOne.ID is not supported by MySQL driver. Use WorkflowRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WorkflowRunUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WorkflowRunCreateBulk is the builder for creating many WorkflowRun entities in bulk.
+type WorkflowRunCreateBulk struct {
+	config
+	err      error
+	builders []*WorkflowRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WorkflowRun entities in the database.
+func (_c *WorkflowRunCreateBulk) Save(ctx context.Context) ([]*WorkflowRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WorkflowRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkflowRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkflowRunCreateBulk) SaveX(ctx context.Context) []*WorkflowRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkflowRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkflowRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkflowRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkflowRunUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkflowRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *WorkflowRunUpsertBulk {
+	_c.conflict = opts
+	return &WorkflowRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkflowRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkflowRunCreateBulk) OnConflictColumns(columns ...string) *WorkflowRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkflowRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// WorkflowRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of WorkflowRun nodes.
+type WorkflowRunUpsertBulk struct {
+	create *WorkflowRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WorkflowRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workflowrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkflowRunUpsertBulk) UpdateNewValues() *WorkflowRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(workflowrun.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(workflowrun.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(workflowrun.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WorkflowRunUpsertBulk) Ignore() *WorkflowRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkflowRunUpsertBulk) DoNothing() *WorkflowRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkflowRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *WorkflowRunUpsertBulk) Update(set func(*WorkflowRunUpsert)) *WorkflowRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkflowRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkflowRunUpsertBulk) SetCreateBy(v uint32) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkflowRunUpsertBulk) AddCreateBy(v uint32) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateCreateBy() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkflowRunUpsertBulk) ClearCreateBy() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkflowRunUpsertBulk) SetUpdateTime(v time.Time) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateUpdateTime() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkflowRunUpsertBulk) ClearUpdateTime() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkflowRunUpsertBulk) SetDeleteTime(v time.Time) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateDeleteTime() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkflowRunUpsertBulk) ClearDeleteTime() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (u *WorkflowRunUpsertBulk) SetWorkflowID(v string) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetWorkflowID(v)
+	})
+}
+
+// UpdateWorkflowID sets the "workflow_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateWorkflowID() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateWorkflowID()
+	})
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (u *WorkflowRunUpsertBulk) SetWorkflowName(v string) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetWorkflowName(v)
+	})
+}
+
+// UpdateWorkflowName sets the "workflow_name" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateWorkflowName() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateWorkflowName()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *WorkflowRunUpsertBulk) SetClientID(v string) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateClientID() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetSteps sets the "steps" field.
+func (u *WorkflowRunUpsertBulk) SetSteps(v []schema.WorkflowStep) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetSteps(v)
+	})
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateSteps() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateSteps()
+	})
+}
+
+// SetStartedSteps sets the "started_steps" field.
+func (u *WorkflowRunUpsertBulk) SetStartedSteps(v []string) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetStartedSteps(v)
+	})
+}
+
+// UpdateStartedSteps sets the "started_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateStartedSteps() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateStartedSteps()
+	})
+}
+
+// ClearStartedSteps clears the value of the "started_steps" field.
+func (u *WorkflowRunUpsertBulk) ClearStartedSteps() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearStartedSteps()
+	})
+}
+
+// SetSkippedSteps sets the "skipped_steps" field.
+func (u *WorkflowRunUpsertBulk) SetSkippedSteps(v []string) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetSkippedSteps(v)
+	})
+}
+
+// UpdateSkippedSteps sets the "skipped_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateSkippedSteps() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateSkippedSteps()
+	})
+}
+
+// ClearSkippedSteps clears the value of the "skipped_steps" field.
+func (u *WorkflowRunUpsertBulk) ClearSkippedSteps() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearSkippedSteps()
+	})
+}
+
+// SetFailedSteps sets the "failed_steps" field.
+func (u *WorkflowRunUpsertBulk) SetFailedSteps(v []string) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetFailedSteps(v)
+	})
+}
+
+// UpdateFailedSteps sets the "failed_steps" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateFailedSteps() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateFailedSteps()
+	})
+}
+
+// ClearFailedSteps clears the value of the "failed_steps" field.
+func (u *WorkflowRunUpsertBulk) ClearFailedSteps() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearFailedSteps()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *WorkflowRunUpsertBulk) SetRevision(v int) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *WorkflowRunUpsertBulk) AddRevision(v int) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateRevision() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WorkflowRunUpsertBulk) SetStatus(v workflowrun.Status) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateStatus() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *WorkflowRunUpsertBulk) SetCompletedAt(v time.Time) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateCompletedAt() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *WorkflowRunUpsertBulk) ClearCompletedAt() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkflowRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WorkflowRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkflowRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkflowRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
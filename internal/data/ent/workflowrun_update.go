@@ -0,0 +1,894 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
+)
+
+// WorkflowRunUpdate is the builder for updating WorkflowRun entities.
+type WorkflowRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WorkflowRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WorkflowRunUpdate builder.
+func (_u *WorkflowRunUpdate) Where(ps ...predicate.WorkflowRun) *WorkflowRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *WorkflowRunUpdate) SetCreateBy(v uint32) *WorkflowRunUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableCreateBy(v *uint32) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *WorkflowRunUpdate) AddCreateBy(v int32) *WorkflowRunUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *WorkflowRunUpdate) ClearCreateBy() *WorkflowRunUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *WorkflowRunUpdate) SetUpdateTime(v time.Time) *WorkflowRunUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableUpdateTime(v *time.Time) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *WorkflowRunUpdate) ClearUpdateTime() *WorkflowRunUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *WorkflowRunUpdate) SetDeleteTime(v time.Time) *WorkflowRunUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableDeleteTime(v *time.Time) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *WorkflowRunUpdate) ClearDeleteTime() *WorkflowRunUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_u *WorkflowRunUpdate) SetWorkflowID(v string) *WorkflowRunUpdate {
+	_u.mutation.SetWorkflowID(v)
+	return _u
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableWorkflowID(v *string) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetWorkflowID(*v)
+	}
+	return _u
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (_u *WorkflowRunUpdate) SetWorkflowName(v string) *WorkflowRunUpdate {
+	_u.mutation.SetWorkflowName(v)
+	return _u
+}
+
+// SetNillableWorkflowName sets the "workflow_name" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableWorkflowName(v *string) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetWorkflowName(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *WorkflowRunUpdate) SetClientID(v string) *WorkflowRunUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableClientID(v *string) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *WorkflowRunUpdate) SetSteps(v []schema.WorkflowStep) *WorkflowRunUpdate {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *WorkflowRunUpdate) AppendSteps(v []schema.WorkflowStep) *WorkflowRunUpdate {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// SetStartedSteps sets the "started_steps" field.
+func (_u *WorkflowRunUpdate) SetStartedSteps(v []string) *WorkflowRunUpdate {
+	_u.mutation.SetStartedSteps(v)
+	return _u
+}
+
+// AppendStartedSteps appends value to the "started_steps" field.
+func (_u *WorkflowRunUpdate) AppendStartedSteps(v []string) *WorkflowRunUpdate {
+	_u.mutation.AppendStartedSteps(v)
+	return _u
+}
+
+// ClearStartedSteps clears the value of the "started_steps" field.
+func (_u *WorkflowRunUpdate) ClearStartedSteps() *WorkflowRunUpdate {
+	_u.mutation.ClearStartedSteps()
+	return _u
+}
+
+// SetSkippedSteps sets the "skipped_steps" field.
+func (_u *WorkflowRunUpdate) SetSkippedSteps(v []string) *WorkflowRunUpdate {
+	_u.mutation.SetSkippedSteps(v)
+	return _u
+}
+
+// AppendSkippedSteps appends value to the "skipped_steps" field.
+func (_u *WorkflowRunUpdate) AppendSkippedSteps(v []string) *WorkflowRunUpdate {
+	_u.mutation.AppendSkippedSteps(v)
+	return _u
+}
+
+// ClearSkippedSteps clears the value of the "skipped_steps" field.
+func (_u *WorkflowRunUpdate) ClearSkippedSteps() *WorkflowRunUpdate {
+	_u.mutation.ClearSkippedSteps()
+	return _u
+}
+
+// SetFailedSteps sets the "failed_steps" field.
+func (_u *WorkflowRunUpdate) SetFailedSteps(v []string) *WorkflowRunUpdate {
+	_u.mutation.SetFailedSteps(v)
+	return _u
+}
+
+// AppendFailedSteps appends value to the "failed_steps" field.
+func (_u *WorkflowRunUpdate) AppendFailedSteps(v []string) *WorkflowRunUpdate {
+	_u.mutation.AppendFailedSteps(v)
+	return _u
+}
+
+// ClearFailedSteps clears the value of the "failed_steps" field.
+func (_u *WorkflowRunUpdate) ClearFailedSteps() *WorkflowRunUpdate {
+	_u.mutation.ClearFailedSteps()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *WorkflowRunUpdate) SetRevision(v int) *WorkflowRunUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableRevision(v *int) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *WorkflowRunUpdate) AddRevision(v int) *WorkflowRunUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WorkflowRunUpdate) SetStatus(v workflowrun.Status) *WorkflowRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableStatus(v *workflowrun.Status) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *WorkflowRunUpdate) SetCompletedAt(v time.Time) *WorkflowRunUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableCompletedAt(v *time.Time) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *WorkflowRunUpdate) ClearCompletedAt() *WorkflowRunUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the WorkflowRunMutation object of the builder.
+func (_u *WorkflowRunUpdate) Mutation() *WorkflowRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WorkflowRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkflowRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WorkflowRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkflowRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkflowRunUpdate) check() error {
+	if v, ok := _u.mutation.WorkflowID(); ok {
+		if err := workflowrun.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.workflow_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowName(); ok {
+		if err := workflowrun.WorkflowNameValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_name", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.workflow_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := workflowrun.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := workflowrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WorkflowRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowrun.Table, workflowrun.Columns, sqlgraph.NewFieldSpec(workflowrun.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(workflowrun.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(workflowrun.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(workflowrun.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(workflowrun.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(workflowrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(workflowrun.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(workflowrun.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(workflowrun.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(workflowrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.WorkflowID(); ok {
+		_spec.SetField(workflowrun.FieldWorkflowID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WorkflowName(); ok {
+		_spec.SetField(workflowrun.FieldWorkflowName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(workflowrun.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(workflowrun.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowrun.FieldSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.StartedSteps(); ok {
+		_spec.SetField(workflowrun.FieldStartedSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStartedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowrun.FieldStartedSteps, value)
+		})
+	}
+	if _u.mutation.StartedStepsCleared() {
+		_spec.ClearField(workflowrun.FieldStartedSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SkippedSteps(); ok {
+		_spec.SetField(workflowrun.FieldSkippedSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSkippedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowrun.FieldSkippedSteps, value)
+		})
+	}
+	if _u.mutation.SkippedStepsCleared() {
+		_spec.ClearField(workflowrun.FieldSkippedSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FailedSteps(); ok {
+		_spec.SetField(workflowrun.FieldFailedSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFailedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowrun.FieldFailedSteps, value)
+		})
+	}
+	if _u.mutation.FailedStepsCleared() {
+		_spec.ClearField(workflowrun.FieldFailedSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(workflowrun.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(workflowrun.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(workflowrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(workflowrun.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(workflowrun.FieldCompletedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WorkflowRunUpdateOne is the builder for updating a single WorkflowRun entity.
+type WorkflowRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkflowRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *WorkflowRunUpdateOne) SetCreateBy(v uint32) *WorkflowRunUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableCreateBy(v *uint32) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *WorkflowRunUpdateOne) AddCreateBy(v int32) *WorkflowRunUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *WorkflowRunUpdateOne) ClearCreateBy() *WorkflowRunUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *WorkflowRunUpdateOne) SetUpdateTime(v time.Time) *WorkflowRunUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableUpdateTime(v *time.Time) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *WorkflowRunUpdateOne) ClearUpdateTime() *WorkflowRunUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *WorkflowRunUpdateOne) SetDeleteTime(v time.Time) *WorkflowRunUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableDeleteTime(v *time.Time) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *WorkflowRunUpdateOne) ClearDeleteTime() *WorkflowRunUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_u *WorkflowRunUpdateOne) SetWorkflowID(v string) *WorkflowRunUpdateOne {
+	_u.mutation.SetWorkflowID(v)
+	return _u
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableWorkflowID(v *string) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetWorkflowID(*v)
+	}
+	return _u
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (_u *WorkflowRunUpdateOne) SetWorkflowName(v string) *WorkflowRunUpdateOne {
+	_u.mutation.SetWorkflowName(v)
+	return _u
+}
+
+// SetNillableWorkflowName sets the "workflow_name" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableWorkflowName(v *string) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetWorkflowName(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *WorkflowRunUpdateOne) SetClientID(v string) *WorkflowRunUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableClientID(v *string) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *WorkflowRunUpdateOne) SetSteps(v []schema.WorkflowStep) *WorkflowRunUpdateOne {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *WorkflowRunUpdateOne) AppendSteps(v []schema.WorkflowStep) *WorkflowRunUpdateOne {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// SetStartedSteps sets the "started_steps" field.
+func (_u *WorkflowRunUpdateOne) SetStartedSteps(v []string) *WorkflowRunUpdateOne {
+	_u.mutation.SetStartedSteps(v)
+	return _u
+}
+
+// AppendStartedSteps appends value to the "started_steps" field.
+func (_u *WorkflowRunUpdateOne) AppendStartedSteps(v []string) *WorkflowRunUpdateOne {
+	_u.mutation.AppendStartedSteps(v)
+	return _u
+}
+
+// ClearStartedSteps clears the value of the "started_steps" field.
+func (_u *WorkflowRunUpdateOne) ClearStartedSteps() *WorkflowRunUpdateOne {
+	_u.mutation.ClearStartedSteps()
+	return _u
+}
+
+// SetSkippedSteps sets the "skipped_steps" field.
+func (_u *WorkflowRunUpdateOne) SetSkippedSteps(v []string) *WorkflowRunUpdateOne {
+	_u.mutation.SetSkippedSteps(v)
+	return _u
+}
+
+// AppendSkippedSteps appends value to the "skipped_steps" field.
+func (_u *WorkflowRunUpdateOne) AppendSkippedSteps(v []string) *WorkflowRunUpdateOne {
+	_u.mutation.AppendSkippedSteps(v)
+	return _u
+}
+
+// ClearSkippedSteps clears the value of the "skipped_steps" field.
+func (_u *WorkflowRunUpdateOne) ClearSkippedSteps() *WorkflowRunUpdateOne {
+	_u.mutation.ClearSkippedSteps()
+	return _u
+}
+
+// SetFailedSteps sets the "failed_steps" field.
+func (_u *WorkflowRunUpdateOne) SetFailedSteps(v []string) *WorkflowRunUpdateOne {
+	_u.mutation.SetFailedSteps(v)
+	return _u
+}
+
+// AppendFailedSteps appends value to the "failed_steps" field.
+func (_u *WorkflowRunUpdateOne) AppendFailedSteps(v []string) *WorkflowRunUpdateOne {
+	_u.mutation.AppendFailedSteps(v)
+	return _u
+}
+
+// ClearFailedSteps clears the value of the "failed_steps" field.
+func (_u *WorkflowRunUpdateOne) ClearFailedSteps() *WorkflowRunUpdateOne {
+	_u.mutation.ClearFailedSteps()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *WorkflowRunUpdateOne) SetRevision(v int) *WorkflowRunUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableRevision(v *int) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *WorkflowRunUpdateOne) AddRevision(v int) *WorkflowRunUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WorkflowRunUpdateOne) SetStatus(v workflowrun.Status) *WorkflowRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableStatus(v *workflowrun.Status) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *WorkflowRunUpdateOne) SetCompletedAt(v time.Time) *WorkflowRunUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableCompletedAt(v *time.Time) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *WorkflowRunUpdateOne) ClearCompletedAt() *WorkflowRunUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the WorkflowRunMutation object of the builder.
+func (_u *WorkflowRunUpdateOne) Mutation() *WorkflowRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WorkflowRunUpdate builder.
+func (_u *WorkflowRunUpdateOne) Where(ps ...predicate.WorkflowRun) *WorkflowRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WorkflowRunUpdateOne) Select(field string, fields ...string) *WorkflowRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WorkflowRun entity.
+func (_u *WorkflowRunUpdateOne) Save(ctx context.Context) (*WorkflowRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkflowRunUpdateOne) SaveX(ctx context.Context) *WorkflowRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WorkflowRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkflowRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkflowRunUpdateOne) check() error {
+	if v, ok := _u.mutation.WorkflowID(); ok {
+		if err := workflowrun.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.workflow_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowName(); ok {
+		if err := workflowrun.WorkflowNameValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_name", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.workflow_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := workflowrun.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := workflowrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkflowRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WorkflowRunUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowrun.Table, workflowrun.Columns, sqlgraph.NewFieldSpec(workflowrun.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkflowRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowrun.FieldID)
+		for _, f := range fields {
+			if !workflowrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workflowrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(workflowrun.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(workflowrun.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(workflowrun.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(workflowrun.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(workflowrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(workflowrun.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(workflowrun.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(workflowrun.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(workflowrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.WorkflowID(); ok {
+		_spec.SetField(workflowrun.FieldWorkflowID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WorkflowName(); ok {
+		_spec.SetField(workflowrun.FieldWorkflowName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(workflowrun.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(workflowrun.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowrun.FieldSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.StartedSteps(); ok {
+		_spec.SetField(workflowrun.FieldStartedSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStartedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowrun.FieldStartedSteps, value)
+		})
+	}
+	if _u.mutation.StartedStepsCleared() {
+		_spec.ClearField(workflowrun.FieldStartedSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SkippedSteps(); ok {
+		_spec.SetField(workflowrun.FieldSkippedSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSkippedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowrun.FieldSkippedSteps, value)
+		})
+	}
+	if _u.mutation.SkippedStepsCleared() {
+		_spec.ClearField(workflowrun.FieldSkippedSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FailedSteps(); ok {
+		_spec.SetField(workflowrun.FieldFailedSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFailedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowrun.FieldFailedSteps, value)
+		})
+	}
+	if _u.mutation.FailedStepsCleared() {
+		_spec.ClearField(workflowrun.FieldFailedSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(workflowrun.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(workflowrun.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(workflowrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(workflowrun.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(workflowrun.FieldCompletedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &WorkflowRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
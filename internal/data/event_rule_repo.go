@@ -0,0 +1,276 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// EventRuleRepo handles database operations for event rules
+type EventRuleRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewEventRuleRepo creates a new EventRuleRepo
+func NewEventRuleRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *EventRuleRepo {
+	return &EventRuleRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/event_rule"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new event rule. The request's optional fields must already
+// hold their defaults.
+func (r *EventRuleRepo) Create(ctx context.Context, tenantID uint32, req *executorV1.CreateEventRuleRequest, createdBy *uint32) (*ent.EventRule, error) {
+	builder := r.entClient.Client().EventRule.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetName(req.Name).
+		SetDescription(req.GetDescription()).
+		SetEventType(eventTypeFromProto(req.EventType)).
+		SetScriptID(req.ScriptId).
+		SetSelector(req.GetSelector()).
+		SetEnabled(req.GetEnabled()).
+		SetCreateTime(time.Now())
+
+	if req.GetSourceScriptId() != "" {
+		builder.SetSourceScriptID(req.GetSourceScriptId())
+	}
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create event rule failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create event rule failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves an event rule by ID
+func (r *EventRuleRepo) GetByID(ctx context.Context, id string) (*ent.EventRule, error) {
+	entity, err := r.entClient.Client().EventRule.Query().
+		Where(eventrule.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get event rule failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get event rule failed")
+	}
+	return entity, nil
+}
+
+// ListByTenant lists event rules with pagination and filters
+func (r *EventRuleRepo) ListByTenant(ctx context.Context, tenantID uint32, eventType *executorV1.EventType, scriptID *string, enabled *bool, page, pageSize uint32) ([]*ent.EventRule, int, error) {
+	query := r.entClient.Client().EventRule.Query().
+		Where(eventrule.TenantIDEQ(tenantID))
+
+	if eventType != nil && *eventType != executorV1.EventType_EVENT_TYPE_UNSPECIFIED {
+		query = query.Where(eventrule.EventTypeEQ(eventTypeFromProto(*eventType)))
+	}
+	if scriptID != nil && *scriptID != "" {
+		query = query.Where(eventrule.ScriptIDEQ(*scriptID))
+	}
+	if enabled != nil {
+		query = query.Where(eventrule.EnabledEQ(*enabled))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count event rules failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count event rules failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(eventrule.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list event rules failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list event rules failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListEnabled returns the enabled rules for an event. A nil tenantID returns
+// the rules of all tenants, for events of clients, which are shared.
+func (r *EventRuleRepo) ListEnabled(ctx context.Context, tenantID *uint32, eventType eventrule.EventType) ([]*ent.EventRule, error) {
+	query := r.entClient.Client().EventRule.Query().
+		Where(
+			eventrule.EventTypeEQ(eventType),
+			eventrule.EnabledEQ(true),
+		)
+
+	if tenantID != nil {
+		query = query.Where(eventrule.TenantIDEQ(*tenantID))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(eventrule.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list enabled event rules failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list event rules failed")
+	}
+	return entities, nil
+}
+
+// Update updates an event rule. An empty source script ID clears it.
+func (r *EventRuleRepo) Update(ctx context.Context, id string, req *executorV1.UpdateEventRuleRequest, updatedBy *uint32) (*ent.EventRule, error) {
+	builder := r.entClient.Client().EventRule.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if req.Name != nil {
+		builder.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		builder.SetDescription(*req.Description)
+	}
+	if req.ScriptId != nil {
+		builder.SetScriptID(*req.ScriptId)
+	}
+	if req.Selector != nil {
+		builder.SetSelector(*req.Selector)
+	}
+	if req.SourceScriptId != nil {
+		if *req.SourceScriptId != "" {
+			builder.SetSourceScriptID(*req.SourceScriptId)
+		} else {
+			builder.ClearSourceScriptID()
+		}
+	}
+	if req.Enabled != nil {
+		builder.SetEnabled(*req.Enabled)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorEventRuleNotFound("event rule not found")
+		}
+		r.log.Errorf("update event rule failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update event rule failed")
+	}
+	return entity, nil
+}
+
+// MarkFired records that the rule started an execution
+func (r *EventRuleRepo) MarkFired(ctx context.Context, id string) error {
+	err := r.entClient.Client().EventRule.UpdateOneID(id).
+		SetLastFiredAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		r.log.Errorf("mark event rule fired failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("update event rule failed")
+	}
+	return nil
+}
+
+// Delete deletes an event rule
+func (r *EventRuleRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().EventRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return executorV1.ErrorEventRuleNotFound("event rule not found")
+		}
+		r.log.Errorf("delete event rule failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete event rule failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.EventRule to executorV1.EventRule
+func (r *EventRuleRepo) ToProto(entity *ent.EventRule) *executorV1.EventRule {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.EventRule{
+		Id:             entity.ID,
+		TenantId:       derefUint32(entity.TenantID),
+		Name:           entity.Name,
+		EventType:      eventTypeToProto(string(entity.EventType)),
+		ScriptId:       entity.ScriptID,
+		SourceScriptId: entity.SourceScriptID,
+		Enabled:        entity.Enabled,
+	}
+
+	if entity.Description != "" {
+		proto.Description = &entity.Description
+	}
+	if entity.Selector != "" {
+		proto.Selector = &entity.Selector
+	}
+	if entity.LastFiredAt != nil {
+		proto.LastFiredAt = timestamppb.New(*entity.LastFiredAt)
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
+
+// eventTypeFromProto maps a proto event type to the stored value
+func eventTypeFromProto(t executorV1.EventType) eventrule.EventType {
+	switch t {
+	case executorV1.EventType_EVENT_TYPE_CLIENT_FIRST_CONNECT:
+		return eventrule.EventTypeCLIENT_FIRST_CONNECT
+	case executorV1.EventType_EVENT_TYPE_CLIENT_VERSION_CHANGED:
+		return eventrule.EventTypeCLIENT_VERSION_CHANGED
+	case executorV1.EventType_EVENT_TYPE_CLIENT_LABELS_CHANGED:
+		return eventrule.EventTypeCLIENT_LABELS_CHANGED
+	case executorV1.EventType_EVENT_TYPE_SCRIPT_FAILED:
+		return eventrule.EventTypeSCRIPT_FAILED
+	default:
+		return ""
+	}
+}
+
+// eventTypeToProto maps a stored event type, of a rule or an execution, to proto
+func eventTypeToProto(t string) executorV1.EventType {
+	switch t {
+	case "CLIENT_FIRST_CONNECT":
+		return executorV1.EventType_EVENT_TYPE_CLIENT_FIRST_CONNECT
+	case "CLIENT_VERSION_CHANGED":
+		return executorV1.EventType_EVENT_TYPE_CLIENT_VERSION_CHANGED
+	case "CLIENT_LABELS_CHANGED":
+		return executorV1.EventType_EVENT_TYPE_CLIENT_LABELS_CHANGED
+	case "SCRIPT_FAILED":
+		return executorV1.EventType_EVENT_TYPE_SCRIPT_FAILED
+	default:
+		return executorV1.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
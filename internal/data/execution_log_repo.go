@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -12,30 +13,381 @@ import (
 	entCrud "github.com/tx7do/go-crud/entgo"
 
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientlock"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
 
 	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
 )
 
-// ExecutionLogRepo handles database operations for execution logs
+// ExecutionLogRepo handles database operations for execution logs. The values
+// of secret parameters are encrypted with the SecretCipher, bound to the
+// execution ID, and cleared once the execution is final with no retry pending.
 type ExecutionLogRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
+	cipher    *SecretCipher
 }
 
 // NewExecutionLogRepo creates a new ExecutionLogRepo
-func NewExecutionLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ExecutionLogRepo {
+func NewExecutionLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], cipher *SecretCipher) *ExecutionLogRepo {
 	return &ExecutionLogRepo{
 		log:       ctx.NewLoggerHelper("executor/repo/execution_log"),
 		entClient: entClient,
+		cipher:    cipher,
 	}
 }
 
-// Create creates a new execution log entry
-func (r *ExecutionLogRepo) Create(ctx context.Context, tenantID uint32, scriptID, scriptName, clientID, scriptHash, triggerType, status string, createdBy *uint32) (*ent.ExecutionLog, error) {
+// ExecutionOrigin links a server-initiated execution to what started it
+type ExecutionOrigin struct {
+	// RunID is the fan-out run the execution is part of
+	RunID *string
+	// Event is the event that fired the event rule which started it
+	Event *executorV1.ExecutionEvent
+	// WorkflowRunID is the workflow run the execution is a step of
+	WorkflowRunID *string
+	// WorkflowStepID is the step of the workflow run
+	WorkflowStepID string
+	// Attempt is set when the execution retries an earlier attempt
+	Attempt *ExecutionAttempt
+	// HeldUntil holds the execution back until a maintenance window opens
+	HeldUntil *time.Time
+	// MaintenanceOverride is the justification for running outside the
+	// client's maintenance windows
+	MaintenanceOverride string
+	// Parameters are the values of the script's parameters. Triggers set the
+	// values they supply; the execution is created with the resolved values,
+	// secret ones masked.
+	Parameters map[string]string
+	// SecretParameters are the values of the secret parameters, kept apart
+	// from the recorded ones
+	SecretParameters map[string]string
+	// SecretNames are the secrets the script content references
+	SecretNames []string
+	// ApprovalExpiresAt is set on executions created AWAITING_APPROVAL
+	ApprovalExpiresAt *time.Time
+}
+
+// ExecutionAttempt identifies a retry of an execution
+type ExecutionAttempt struct {
+	// ID is the execution ID reserved when the retry was claimed
+	ID string
+	// Number is the attempt number, 2 for the first retry
+	Number int
+	// OriginalExecutionID is the first attempt
+	OriginalExecutionID string
+}
+
+// Create creates a new execution log entry. origin may be nil.
+func (r *ExecutionLogRepo) Create(ctx context.Context, tenantID uint32, scriptID, scriptName, clientID, scriptHash, triggerType, status string, createdBy *uint32, origin *ExecutionOrigin) (*ent.ExecutionLog, error) {
+	builder, err := r.newExecutionLog(r.entClient.Client(), tenantID, scriptID, scriptName, clientID, scriptHash, triggerType, status, createdBy, origin)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create execution log failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create execution log failed")
+	}
+
+	return entity, nil
+}
+
+// ConcurrencyLimits bounds the executions active at once on a client
+type ConcurrencyLimits struct {
+	// MaxPerClient is how many executions a client may have active; 0 is unlimited
+	MaxPerClient int
+	// Singleton allows one active execution of the script per client
+	Singleton bool
+	// Queue makes an execution over a limit wait for a slot instead of failing
+	Queue bool
+}
+
+// CreateWithinLimits creates a PENDING execution log if the client's
+// concurrency limits allow it. Over a limit the execution is rejected with
+// CONCURRENCY_LIMIT_REACHED, or created waiting for a slot if the limits
+// queue. Executions are admitted under a row lock per client, so concurrent
+// triggers on the same client cannot both take the last slot.
+// Executions held until a maintenance window opens always start out waiting.
+func (r *ExecutionLogRepo) CreateWithinLimits(ctx context.Context, tenantID uint32, scriptID, scriptName, clientID, scriptHash, triggerType string, createdBy *uint32, origin *ExecutionOrigin, limits ConcurrencyLimits) (*ent.ExecutionLog, error) {
+	if limits.MaxPerClient <= 0 && !limits.Singleton {
+		return r.Create(ctx, tenantID, scriptID, scriptName, clientID, scriptHash, triggerType, "PENDING", createdBy, origin)
+	}
+
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin execution admission failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create execution log failed")
+	}
+
+	var waiting bool
+	err = r.lockClient(ctx, tx.Client(), clientID)
+	if err == nil {
+		waiting, err = r.admit(ctx, tx.Client(), scriptID, clientID, limits)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	builder, err := r.newExecutionLog(tx.Client(), tenantID, scriptID, scriptName, clientID, scriptHash, triggerType, "PENDING", createdBy, origin)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if waiting {
+		builder.SetWaitingForSlot(true)
+	}
+	entity, err := builder.Save(ctx)
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		_ = tx.Rollback()
+	}
+	if err != nil {
+		r.log.Errorf("create execution log failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create execution log failed")
+	}
+
+	return entity, nil
+}
+
+// AdmitWaiting starts the executions waiting for a slot on the client, oldest
+// first, as far as the limits allow. Executions held until a maintenance
+// window opens keep waiting until then. singleton reports whether a script
+// allows one active execution per client. Returns the executions that stopped
+// waiting; their commands still have to be sent.
+func (r *ExecutionLogRepo) AdmitWaiting(ctx context.Context, clientID string, maxPerClient int, singleton func(scriptID string) bool) ([]*ent.ExecutionLog, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin execution admission failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update execution log failed")
+	}
+
+	admitted, err := r.admitWaiting(ctx, tx.Client(), clientID, maxPerClient, singleton)
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		_ = tx.Rollback()
+	}
+	if err != nil {
+		r.log.Errorf("admit waiting executions failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update execution log failed")
+	}
+	return admitted, nil
+}
+
+func (r *ExecutionLogRepo) admitWaiting(ctx context.Context, client *ent.Client, clientID string, maxPerClient int, singleton func(scriptID string) bool) ([]*ent.ExecutionLog, error) {
+	if err := r.lockClient(ctx, client, clientID); err != nil {
+		return nil, err
+	}
+
+	active, err := client.ExecutionLog.Query().
+		Where(
+			executionlog.ClientIDEQ(clientID),
+			executionlog.StatusIn(executionlog.StatusPENDING, executionlog.StatusRUNNING),
+		).
+		Order(ent.Asc(executionlog.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	running := 0
+	busy := make(map[string]bool)
+	for _, e := range active {
+		if !e.WaitingForSlot {
+			running++
+			busy[e.ScriptID] = true
+		}
+	}
+
+	now := time.Now()
+	var admitted []*ent.ExecutionLog
+	for _, e := range active {
+		if !e.WaitingForSlot || (e.HeldUntil != nil && e.HeldUntil.After(now)) {
+			continue
+		}
+		if maxPerClient > 0 && running >= maxPerClient {
+			break
+		}
+		if busy[e.ScriptID] && singleton(e.ScriptID) {
+			continue
+		}
+		n, err := client.ExecutionLog.Update().
+			Where(
+				executionlog.IDEQ(e.ID),
+				executionlog.StatusEQ(executionlog.StatusPENDING),
+				executionlog.WaitingForSlot(true),
+			).
+			SetWaitingForSlot(false).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+		e.WaitingForSlot = false
+		running++
+		busy[e.ScriptID] = true
+		admitted = append(admitted, e)
+	}
+	return admitted, nil
+}
+
+// ListWaitingClients returns the clients with executions waiting for a slot
+// that are not held back by a maintenance window
+func (r *ExecutionLogRepo) ListWaitingClients(ctx context.Context) ([]string, error) {
+	clientIDs, err := r.entClient.Client().ExecutionLog.Query().
+		Where(
+			executionlog.StatusEQ(executionlog.StatusPENDING),
+			executionlog.WaitingForSlot(true),
+			executionlog.Or(
+				executionlog.HeldUntilIsNil(),
+				executionlog.HeldUntilLTE(time.Now()),
+			),
+		).
+		Unique(true).
+		Select(executionlog.FieldClientID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("list clients with waiting executions failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list execution logs failed")
+	}
+	return clientIDs, nil
+}
+
+// ListWaitingByClient returns the executions waiting for a slot on the client
+func (r *ExecutionLogRepo) ListWaitingByClient(ctx context.Context, clientID string) ([]*ent.ExecutionLog, error) {
+	entities, err := r.entClient.Client().ExecutionLog.Query().
+		Where(
+			executionlog.ClientIDEQ(clientID),
+			executionlog.StatusEQ(executionlog.StatusPENDING),
+			executionlog.WaitingForSlot(true),
+		).
+		Order(ent.Asc(executionlog.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list waiting executions failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list execution logs failed")
+	}
+	return entities, nil
+}
+
+// CancelWaiting cancels an execution that is still waiting for a slot.
+// Returns false if it started or finished meanwhile.
+func (r *ExecutionLogRepo) CancelWaiting(ctx context.Context, id string, cancelledBy *uint32, reason string) (bool, error) {
+	now := time.Now()
+	builder := clearSecretParameters(r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusEQ(executionlog.StatusPENDING),
+			executionlog.WaitingForSlot(true),
+		)).
+		SetStatus(executionlog.StatusCANCELLED).
+		SetWaitingForSlot(false).
+		SetCancelRequestedAt(now).
+		SetCancelReason(reason).
+		SetCompletedAt(now)
+	if cancelledBy != nil {
+		builder.SetCancelledBy(*cancelledBy)
+	}
+
+	n, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("cancel waiting execution failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update execution log failed")
+	}
+	return n > 0, nil
+}
+
+// lockClient takes a row lock on the client's lock entry for the rest of the
+// transaction, creating the entry first for clients seen for the first time
+func (r *ExecutionLogRepo) lockClient(ctx context.Context, client *ent.Client, clientID string) error {
+	err := client.ClientLock.Create().
+		SetID(clientID).
+		OnConflictColumns(clientlock.FieldID).
+		Ignore().
+		Exec(ctx)
+	if err == nil {
+		_, err = client.ClientLock.Query().
+			Where(clientlock.IDEQ(clientID)).
+			ForUpdate().
+			IDs(ctx)
+	}
+	if err != nil {
+		r.log.Errorf("lock client for execution admission failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("create execution log failed")
+	}
+	return nil
+}
+
+// admit decides whether a new execution of the script may start on the
+// client now. Returns true if it has to wait for a slot.
+func (r *ExecutionLogRepo) admit(ctx context.Context, client *ent.Client, scriptID, clientID string, limits ConcurrencyLimits) (bool, error) {
+	active := client.ExecutionLog.Query().
+		Where(
+			executionlog.ClientIDEQ(clientID),
+			executionlog.StatusIn(executionlog.StatusPENDING, executionlog.StatusRUNNING),
+		)
+
+	// Waiting executions count too, so singletons start in order
+	if limits.Singleton {
+		busy, err := active.Clone().Where(executionlog.ScriptIDEQ(scriptID)).Exist(ctx)
+		if err != nil {
+			r.log.Errorf("count active executions failed: %s", err.Error())
+			return false, executorV1.ErrorInternalServerError("create execution log failed")
+		}
+		if busy {
+			if !limits.Queue {
+				return false, executorV1.ErrorConcurrencyLimitReached("script is already running on client %s", clientID)
+			}
+			return true, nil
+		}
+	}
+
+	if limits.MaxPerClient > 0 {
+		running, err := active.Clone().Where(executionlog.WaitingForSlot(false)).Count(ctx)
+		if err != nil {
+			r.log.Errorf("count active executions failed: %s", err.Error())
+			return false, executorV1.ErrorInternalServerError("create execution log failed")
+		}
+		if running >= limits.MaxPerClient {
+			if !limits.Queue {
+				return false, executorV1.ErrorConcurrencyLimitReached("client %s already runs %d executions", clientID, running)
+			}
+			return true, nil
+		}
+		// Executions held for a maintenance window do not hold up the queue
+		if limits.Queue {
+			queued, err := active.Clone().
+				Where(
+					executionlog.WaitingForSlot(true),
+					executionlog.Or(
+						executionlog.HeldUntilIsNil(),
+						executionlog.HeldUntilLTE(time.Now()),
+					),
+				).
+				Exist(ctx)
+			if err != nil {
+				r.log.Errorf("count active executions failed: %s", err.Error())
+				return false, executorV1.ErrorInternalServerError("create execution log failed")
+			}
+			return queued, nil
+		}
+	}
+
+	return false, nil
+}
+
+// newExecutionLog prepares the creation of an execution log with the given client
+func (r *ExecutionLogRepo) newExecutionLog(client *ent.Client, tenantID uint32, scriptID, scriptName, clientID, scriptHash, triggerType, status string, createdBy *uint32, origin *ExecutionOrigin) (*ent.ExecutionLogCreate, error) {
 	id := uuid.New().String()
+	if origin != nil && origin.Attempt != nil {
+		id = origin.Attempt.ID
+	}
 
-	builder := r.entClient.Client().ExecutionLog.Create().
+	builder := client.ExecutionLog.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetScriptID(scriptID).
@@ -49,14 +401,136 @@ func (r *ExecutionLogRepo) Create(ctx context.Context, tenantID uint32, scriptID
 	if createdBy != nil {
 		builder.SetCreateBy(*createdBy)
 	}
+	if origin != nil {
+		builder.SetNillableRunID(origin.RunID)
+		if ev := origin.Event; ev != nil {
+			builder.
+				SetEventRuleID(ev.RuleId).
+				SetEventType(executionlog.EventType(eventTypeFromProto(ev.Type))).
+				SetEventDetail(ev.GetDetail()).
+				SetNillableSourceExecutionID(ev.SourceExecutionId)
+		}
+		if origin.WorkflowRunID != nil {
+			builder.
+				SetWorkflowRunID(*origin.WorkflowRunID).
+				SetWorkflowStepID(origin.WorkflowStepID)
+		}
+		if origin.Attempt != nil {
+			builder.
+				SetAttempt(origin.Attempt.Number).
+				SetOriginalExecutionID(origin.Attempt.OriginalExecutionID)
+		}
+		if origin.HeldUntil != nil {
+			builder.
+				SetHeldUntil(*origin.HeldUntil).
+				SetWaitingForSlot(true)
+		}
+		if origin.MaintenanceOverride != "" {
+			builder.SetMaintenanceOverride(origin.MaintenanceOverride)
+		}
+		if len(origin.Parameters) > 0 {
+			builder.SetParameters(origin.Parameters)
+		}
+		if len(origin.SecretParameters) > 0 {
+			keyID, nonce, ciphertext, err := r.encryptSecretParameters(id, origin.SecretParameters)
+			if err != nil {
+				return nil, err
+			}
+			builder.
+				SetSecretParametersCiphertext(ciphertext).
+				SetSecretParametersNonce(nonce).
+				SetSecretParametersKeyID(keyID)
+		}
+		if len(origin.SecretNames) > 0 {
+			builder.SetSecretNames(origin.SecretNames)
+		}
+		builder.SetNillableApprovalExpiresAt(origin.ApprovalExpiresAt)
+	}
+	return builder, nil
+}
 
-	entity, err := builder.Save(ctx)
+// encryptSecretParameters encrypts the values of the secret parameters of
+// the execution with the given ID
+func (r *ExecutionLogRepo) encryptSecretParameters(id string, values map[string]string) (string, []byte, []byte, error) {
+	if !r.cipher.Configured() {
+		return "", nil, nil, executorV1.ErrorSecretsNotConfigured("secret parameters cannot be stored, secrets are not configured on this server")
+	}
+	plaintext, err := json.Marshal(values)
 	if err != nil {
-		r.log.Errorf("create execution log failed: %s", err.Error())
-		return nil, executorV1.ErrorInternalServerError("create execution log failed")
+		r.log.Errorf("encode secret parameters failed: %s", err.Error())
+		return "", nil, nil, executorV1.ErrorInternalServerError("encrypt secret parameters failed")
+	}
+	keyID, nonce, ciphertext, err := r.cipher.Encrypt(plaintext, []byte(id))
+	if err != nil {
+		r.log.Errorf("encrypt secret parameters failed: %s", err.Error())
+		return "", nil, nil, executorV1.ErrorInternalServerError("encrypt secret parameters failed")
 	}
+	return keyID, nonce, ciphertext, nil
+}
 
-	return entity, nil
+// SecretParameterValues decrypts the values of the secret parameters of an
+// execution. Returns nil once they have been cleared.
+func (r *ExecutionLogRepo) SecretParameterValues(entity *ent.ExecutionLog) (map[string]string, error) {
+	if entity.SecretParametersCiphertext == nil {
+		return nil, nil
+	}
+	plaintext, err := r.cipher.Decrypt(entity.SecretParametersKeyID, entity.SecretParametersNonce, *entity.SecretParametersCiphertext, []byte(entity.ID))
+	if err != nil {
+		r.log.Errorf("decrypt secret parameters of execution %s failed: %s", entity.ID, err.Error())
+		return nil, executorV1.ErrorInternalServerError("secret parameters of execution %s could not be decrypted", entity.ID)
+	}
+	var values map[string]string
+	if err = json.Unmarshal(plaintext, &values); err != nil {
+		r.log.Errorf("decode secret parameters of execution %s failed: %s", entity.ID, err.Error())
+		return nil, executorV1.ErrorInternalServerError("secret parameters of execution %s could not be decrypted", entity.ID)
+	}
+	return values, nil
+}
+
+// ParameterValues returns the parameter values an execution runs with,
+// secret values included
+func (r *ExecutionLogRepo) ParameterValues(entity *ent.ExecutionLog) (map[string]string, error) {
+	secretValues, err := r.SecretParameterValues(entity)
+	if err != nil {
+		return nil, err
+	}
+	if len(entity.Parameters) == 0 && len(secretValues) == 0 {
+		return nil, nil
+	}
+	values := make(map[string]string, len(entity.Parameters)+len(secretValues))
+	for name, v := range entity.Parameters {
+		values[name] = v
+	}
+	for name, v := range secretValues {
+		values[name] = v
+	}
+	return values, nil
+}
+
+// ClearSecretParameters drops the secret parameter values of an execution
+// that is final with no retry pending
+func (r *ExecutionLogRepo) ClearSecretParameters(ctx context.Context, id string) error {
+	err := clearSecretParameters(r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusNotIn(executionlog.StatusPENDING, executionlog.StatusRUNNING, executionlog.StatusAWAITING_APPROVAL),
+			executionlog.RetryAtIsNil(),
+			executionlog.SecretParametersCiphertextNotNil(),
+		)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("clear secret parameters failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("update execution log failed")
+	}
+	return nil
+}
+
+// clearSecretParameters adds dropping the secret parameter values to an update
+func clearSecretParameters(u *ent.ExecutionLogUpdate) *ent.ExecutionLogUpdate {
+	return u.
+		ClearSecretParametersCiphertext().
+		ClearSecretParametersNonce().
+		ClearSecretParametersKeyID()
 }
 
 // GetByID retrieves an execution log by ID
@@ -86,59 +560,275 @@ func (r *ExecutionLogRepo) UpdateStatus(ctx context.Context, id, status string)
 	return nil
 }
 
-// UpdateRejection updates an execution log with rejection info
-func (r *ExecutionLogRepo) UpdateRejection(ctx context.Context, id, status, reason string) error {
-	_, err := r.entClient.Client().ExecutionLog.UpdateOneID(id).
+// UpdateRejection moves a PENDING execution to a rejection status. Returns
+// false when it has moved on in the meantime.
+func (r *ExecutionLogRepo) UpdateRejection(ctx context.Context, id, status, reason string) (bool, error) {
+	n, err := clearSecretParameters(r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusEQ(executionlog.StatusPENDING),
+		)).
 		SetStatus(executionlog.Status(status)).
 		SetRejectionReason(reason).
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("update execution log rejection failed: %s", err.Error())
-		return executorV1.ErrorInternalServerError("update execution log rejection failed")
+		return false, executorV1.ErrorInternalServerError("update execution log rejection failed")
 	}
-	return nil
+	return n > 0, nil
 }
 
-// UpdateResult updates an execution log with the execution result
-func (r *ExecutionLogRepo) UpdateResult(ctx context.Context, id string, exitCode int, output, errorOutput string, durationMs int64) error {
+// UpdateResult stores the result of an execution that is still PENDING or
+// RUNNING. Returns false when it has moved on in the meantime (e.g. timed out
+// or cancelled).
+func (r *ExecutionLogRepo) UpdateResult(ctx context.Context, id string, exitCode int, output, errorOutput string, durationMs int64) (bool, error) {
 	now := time.Now()
 	status := "COMPLETED"
 	if exitCode != 0 {
 		status = "FAILED"
 	}
 
-	builder := r.entClient.Client().ExecutionLog.UpdateOneID(id).
+	builder := r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusIn(executionlog.StatusPENDING, executionlog.StatusRUNNING),
+		).
 		SetStatus(executionlog.Status(status)).
 		SetExitCode(exitCode).
 		SetOutput(output).
 		SetErrorOutput(errorOutput).
 		SetDurationMs(durationMs).
 		SetCompletedAt(now)
+	// Failed executions keep their secret values until their retry is decided
+	if exitCode == 0 {
+		clearSecretParameters(builder)
+	}
 
-	_, err := builder.Save(ctx)
+	n, err := builder.Save(ctx)
 	if err != nil {
 		r.log.Errorf("update execution log result failed: %s", err.Error())
-		return executorV1.ErrorInternalServerError("update execution log result failed")
+		return false, executorV1.ErrorInternalServerError("update execution log result failed")
 	}
-	return nil
+	return n > 0, nil
 }
 
-// SetStartedAt marks an execution as running
-func (r *ExecutionLogRepo) SetStartedAt(ctx context.Context, id string) error {
+// SetStartedAt marks a PENDING execution as running. Returns false when it
+// has moved on in the meantime.
+func (r *ExecutionLogRepo) SetStartedAt(ctx context.Context, id string) (bool, error) {
 	now := time.Now()
-	_, err := r.entClient.Client().ExecutionLog.UpdateOneID(id).
+	n, err := r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusEQ(executionlog.StatusPENDING),
+		).
 		SetStatus(executionlog.StatusRUNNING).
 		SetStartedAt(now).
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("set execution started_at failed: %s", err.Error())
-		return executorV1.ErrorInternalServerError("set execution started_at failed")
+		return false, executorV1.ErrorInternalServerError("set execution started_at failed")
 	}
-	return nil
+	return n > 0, nil
+}
+
+// MarkTimedOut moves an execution to TIMED_OUT if it is still in the given status.
+// Returns false when the execution has moved on in the meantime (e.g. a late result).
+// The output streamed so far is kept, so the reason is stored on its own.
+func (r *ExecutionLogRepo) MarkTimedOut(ctx context.Context, id string, from executionlog.Status, reason string) (bool, error) {
+	n, err := r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusEQ(from),
+		).
+		SetStatus(executionlog.StatusTIMED_OUT).
+		SetTimeoutReason(reason).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark execution timed out failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("mark execution timed out failed")
+	}
+	return n > 0, nil
+}
+
+// MarkCancelled moves a PENDING, RUNNING or AWAITING_APPROVAL execution
+// straight to CANCELLED. Used when the command never reached the client, so
+// there is nothing to kill.
+func (r *ExecutionLogRepo) MarkCancelled(ctx context.Context, id string, cancelledBy *uint32, reason string) (bool, error) {
+	return r.markCancelled(ctx, id, cancelledBy, reason,
+		executionlog.StatusPENDING, executionlog.StatusRUNNING, executionlog.StatusAWAITING_APPROVAL)
+}
+
+// CloseAwaitingApproval cancels an execution that is still awaiting approval,
+// on denial or expiry. Returns false if it was decided in the meantime.
+func (r *ExecutionLogRepo) CloseAwaitingApproval(ctx context.Context, id string, closedBy *uint32, reason string) (bool, error) {
+	return r.markCancelled(ctx, id, closedBy, reason, executionlog.StatusAWAITING_APPROVAL)
+}
+
+// markCancelled moves an execution in one of the given statuses to CANCELLED
+func (r *ExecutionLogRepo) markCancelled(ctx context.Context, id string, cancelledBy *uint32, reason string, from ...executionlog.Status) (bool, error) {
+	now := time.Now()
+	builder := clearSecretParameters(r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusIn(from...),
+		)).
+		SetStatus(executionlog.StatusCANCELLED).
+		SetCancelRequestedAt(now).
+		SetCancelReason(reason).
+		SetCompletedAt(now)
+	if cancelledBy != nil {
+		builder.SetCancelledBy(*cancelledBy)
+	}
+
+	n, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark execution cancelled failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("mark execution cancelled failed")
+	}
+	return n > 0, nil
+}
+
+// RequestCancel records a cancel request on a PENDING or RUNNING execution.
+// The status stays unchanged until the client confirms termination.
+func (r *ExecutionLogRepo) RequestCancel(ctx context.Context, id string, cancelledBy *uint32, reason string) (bool, error) {
+	builder := r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusIn(executionlog.StatusPENDING, executionlog.StatusRUNNING),
+		).
+		SetCancelRequestedAt(time.Now()).
+		SetCancelReason(reason)
+	if cancelledBy != nil {
+		builder.SetCancelledBy(*cancelledBy)
+	}
+
+	n, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("request execution cancel failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("request execution cancel failed")
+	}
+	return n > 0, nil
+}
+
+// ConfirmCancelled moves an execution with a pending cancel request to CANCELLED
+// and stores the output captured before the client terminated it.
+func (r *ExecutionLogRepo) ConfirmCancelled(ctx context.Context, id string, output, errorOutput string, durationMs int64) (bool, error) {
+	n, err := clearSecretParameters(r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusIn(executionlog.StatusPENDING, executionlog.StatusRUNNING),
+			executionlog.CancelRequestedAtNotNil(),
+		)).
+		SetStatus(executionlog.StatusCANCELLED).
+		SetOutput(output).
+		SetErrorOutput(errorOutput).
+		SetDurationMs(durationMs).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("confirm execution cancelled failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("confirm execution cancelled failed")
+	}
+	return n > 0, nil
+}
+
+// Approve moves an execution awaiting approval to PENDING, waiting for a
+// concurrency slot on its client. Returns false if the execution was decided
+// in the meantime or its approval expired.
+func (r *ExecutionLogRepo) Approve(ctx context.Context, id string, approvedBy *uint32, comment string) (bool, error) {
+	now := time.Now()
+	builder := r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusEQ(executionlog.StatusAWAITING_APPROVAL),
+			executionlog.Or(
+				executionlog.ApprovalExpiresAtIsNil(),
+				executionlog.ApprovalExpiresAtGT(now),
+			),
+		).
+		SetStatus(executionlog.StatusPENDING).
+		SetWaitingForSlot(true).
+		SetApprovedAt(now).
+		SetApprovalComment(comment)
+	if approvedBy != nil {
+		builder.SetApprovedBy(*approvedBy)
+	}
+
+	n, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("approve execution failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("approve execution failed")
+	}
+	return n > 0, nil
+}
+
+// ListApprovalExpired lists executions across all tenants whose approval
+// expired before now
+func (r *ExecutionLogRepo) ListApprovalExpired(ctx context.Context, now time.Time) ([]*ent.ExecutionLog, error) {
+	entities, err := r.entClient.Client().ExecutionLog.Query().
+		Where(
+			executionlog.StatusEQ(executionlog.StatusAWAITING_APPROVAL),
+			executionlog.ApprovalExpiresAtLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list expired execution approvals failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list expired execution approvals failed")
+	}
+	return entities, nil
+}
+
+// ListActive lists PENDING and RUNNING executions across all tenants
+func (r *ExecutionLogRepo) ListActive(ctx context.Context) ([]*ent.ExecutionLog, error) {
+	entities, err := r.entClient.Client().ExecutionLog.Query().
+		Where(executionlog.StatusIn(executionlog.StatusPENDING, executionlog.StatusRUNNING)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list active execution logs failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list active execution logs failed")
+	}
+	return entities, nil
+}
+
+// ListActiveByRun returns the pending, running and awaiting executions of a
+// run, and those waiting to be retried
+func (r *ExecutionLogRepo) ListActiveByRun(ctx context.Context, runID string) ([]*ent.ExecutionLog, error) {
+	entities, err := r.entClient.Client().ExecutionLog.Query().
+		Where(
+			executionlog.RunIDEQ(runID),
+			executionlog.Or(
+				executionlog.StatusIn(executionlog.StatusPENDING, executionlog.StatusRUNNING, executionlog.StatusAWAITING_APPROVAL),
+				executionlog.RetryAtNotNil(),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list active execution logs of run failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list active execution logs failed")
+	}
+	return entities, nil
+}
+
+// ListByWorkflowRuns returns the executions of the steps of workflow runs,
+// oldest first
+func (r *ExecutionLogRepo) ListByWorkflowRuns(ctx context.Context, runIDs ...string) ([]*ent.ExecutionLog, error) {
+	if len(runIDs) == 0 {
+		return nil, nil
+	}
+	entities, err := r.entClient.Client().ExecutionLog.Query().
+		Where(executionlog.WorkflowRunIDIn(runIDs...)).
+		Order(ent.Asc(executionlog.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list execution logs of workflow runs failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list execution logs failed")
+	}
+	return entities, nil
 }
 
 // ListByTenant lists execution logs with pagination and filters
-func (r *ExecutionLogRepo) ListByTenant(ctx context.Context, tenantID uint32, scriptID, clientID *string, status *string, page, pageSize uint32) ([]*ent.ExecutionLog, int, error) {
+func (r *ExecutionLogRepo) ListByTenant(ctx context.Context, tenantID uint32, scriptID, clientID, status, runID, originalExecutionID *string, page, pageSize uint32) ([]*ent.ExecutionLog, int, error) {
 	query := r.entClient.Client().ExecutionLog.Query().
 		Where(executionlog.TenantIDEQ(tenantID))
 
@@ -151,6 +841,15 @@ func (r *ExecutionLogRepo) ListByTenant(ctx context.Context, tenantID uint32, sc
 	if status != nil && *status != "" {
 		query = query.Where(executionlog.StatusEQ(executionlog.Status(*status)))
 	}
+	if runID != nil && *runID != "" {
+		query = query.Where(executionlog.RunIDEQ(*runID))
+	}
+	if originalExecutionID != nil && *originalExecutionID != "" {
+		query = query.Where(executionlog.Or(
+			executionlog.IDEQ(*originalExecutionID),
+			executionlog.OriginalExecutionIDEQ(*originalExecutionID),
+		))
+	}
 
 	total, err := query.Clone().Count(ctx)
 	if err != nil {
@@ -174,6 +873,189 @@ func (r *ExecutionLogRepo) ListByTenant(ctx context.Context, tenantID uint32, sc
 	return entities, total, nil
 }
 
+// CountByRun counts the executions of each run per status. Only the latest
+// attempt of an execution counts; attempts waiting to be retried count as
+// PENDING.
+func (r *ExecutionLogRepo) CountByRun(ctx context.Context, runIDs []string) (map[string]map[executionlog.Status]int, error) {
+	var rows []struct {
+		RunID  string              `json:"run_id"`
+		Status executionlog.Status `json:"status"`
+		Count  int                 `json:"count"`
+	}
+	var retrying []struct {
+		RunID string `json:"run_id"`
+		Count int    `json:"count"`
+	}
+	if len(runIDs) > 0 {
+		err := r.entClient.Client().ExecutionLog.Query().
+			Where(
+				executionlog.RunIDIn(runIDs...),
+				executionlog.RetryAtIsNil(),
+				executionlog.RetriedByIsNil(),
+			).
+			GroupBy(executionlog.FieldRunID, executionlog.FieldStatus).
+			Aggregate(ent.Count()).
+			Scan(ctx, &rows)
+		if err == nil {
+			err = r.entClient.Client().ExecutionLog.Query().
+				Where(
+					executionlog.RunIDIn(runIDs...),
+					executionlog.RetryAtNotNil(),
+				).
+				GroupBy(executionlog.FieldRunID).
+				Aggregate(ent.Count()).
+				Scan(ctx, &retrying)
+		}
+		if err != nil {
+			r.log.Errorf("count executions by run failed: %s", err.Error())
+			return nil, executorV1.ErrorInternalServerError("count executions by run failed")
+		}
+	}
+
+	counts := make(map[string]map[executionlog.Status]int, len(runIDs))
+	for _, row := range rows {
+		if counts[row.RunID] == nil {
+			counts[row.RunID] = make(map[executionlog.Status]int)
+		}
+		counts[row.RunID][row.Status] = row.Count
+	}
+	for _, row := range retrying {
+		if counts[row.RunID] == nil {
+			counts[row.RunID] = make(map[executionlog.Status]int)
+		}
+		counts[row.RunID][executionlog.StatusPENDING] += row.Count
+	}
+	return counts, nil
+}
+
+// RetryOrigin returns the origin of the attempt that retries the execution:
+// the same run, event and workflow step, under the reserved attempt ID
+func (r *ExecutionLogRepo) RetryOrigin(entity *ent.ExecutionLog, attemptID string) (*ExecutionOrigin, error) {
+	original := entity.ID
+	if entity.OriginalExecutionID != nil {
+		original = *entity.OriginalExecutionID
+	}
+
+	origin := &ExecutionOrigin{
+		RunID:         entity.RunID,
+		WorkflowRunID: entity.WorkflowRunID,
+		Attempt: &ExecutionAttempt{
+			ID:                  attemptID,
+			Number:              entity.Attempt + 1,
+			OriginalExecutionID: original,
+		},
+	}
+	if entity.WorkflowRunID != nil {
+		origin.WorkflowStepID = entity.WorkflowStepID
+	}
+	if entity.EventRuleID != nil {
+		origin.Event = &executorV1.ExecutionEvent{
+			RuleId:            *entity.EventRuleID,
+			SourceExecutionId: entity.SourceExecutionID,
+		}
+		if entity.EventType != nil {
+			origin.Event.Type = eventTypeToProto(string(*entity.EventType))
+		}
+		if entity.EventDetail != "" {
+			origin.Event.Detail = &entity.EventDetail
+		}
+	}
+	// Retries run with the values of the attempt they retry
+	values, err := r.ParameterValues(entity)
+	if err != nil {
+		return nil, err
+	}
+	origin.Parameters = values
+	return origin, nil
+}
+
+// ScheduleRetry records that the execution, final with the given status, is
+// retried at the given time. Returns false if the execution is no longer in
+// that status or its retry was already decided.
+func (r *ExecutionLogRepo) ScheduleRetry(ctx context.Context, id string, status executionlog.Status, at time.Time) (bool, error) {
+	n, err := r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.StatusEQ(status),
+			executionlog.RetryAtIsNil(),
+			executionlog.RetriedByIsNil(),
+		).
+		SetRetryAt(at).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("schedule execution retry failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update execution log failed")
+	}
+	return n > 0, nil
+}
+
+// ListRetryDue returns the executions whose retry is due, across all tenants
+func (r *ExecutionLogRepo) ListRetryDue(ctx context.Context, now time.Time) ([]*ent.ExecutionLog, error) {
+	entities, err := r.entClient.Client().ExecutionLog.Query().
+		Where(executionlog.RetryAtLTE(now)).
+		Order(ent.Asc(executionlog.FieldRetryAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due execution retries failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list execution logs failed")
+	}
+	return entities, nil
+}
+
+// ClaimRetry hands the due retry of an execution to the attempt with the
+// given ID. Returns false if another replica claimed or cancelled it first.
+// The secret parameter values pass to the attempt, so the claim drops them;
+// callers take them from the execution loaded before the claim.
+func (r *ExecutionLogRepo) ClaimRetry(ctx context.Context, id string, dueAt time.Time, attemptID string) (bool, error) {
+	n, err := clearSecretParameters(r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.RetryAtEQ(dueAt),
+		)).
+		ClearRetryAt().
+		SetRetriedBy(attemptID).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("claim execution retry failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update execution log failed")
+	}
+	return n > 0, nil
+}
+
+// ReleaseRetry takes back a claimed retry whose attempt could not be created,
+// leaving the execution final
+func (r *ExecutionLogRepo) ReleaseRetry(ctx context.Context, id, attemptID string) error {
+	err := r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.RetriedByEQ(attemptID),
+		).
+		ClearRetriedBy().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("release execution retry failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("update execution log failed")
+	}
+	return nil
+}
+
+// CancelRetry drops the pending retry of an execution, and with it the secret
+// parameter values kept for it. Returns false if no retry was pending.
+func (r *ExecutionLogRepo) CancelRetry(ctx context.Context, id string) (bool, error) {
+	n, err := clearSecretParameters(r.entClient.Client().ExecutionLog.Update().
+		Where(
+			executionlog.IDEQ(id),
+			executionlog.RetryAtNotNil(),
+		)).
+		ClearRetryAt().
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("cancel execution retry failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update execution log failed")
+	}
+	return n > 0, nil
+}
+
 // ToProto converts an ent.ExecutionLog to executorV1.ExecutionLog
 func (r *ExecutionLogRepo) ToProto(entity *ent.ExecutionLog) *executorV1.ExecutionLog {
 	if entity == nil {
@@ -194,6 +1076,12 @@ func (r *ExecutionLogRepo) ToProto(entity *ent.ExecutionLog) *executorV1.Executi
 		proto.TriggerType = executorV1.TriggerType_TRIGGER_TYPE_CLIENT_PULL
 	case executionlog.TriggerTypeUI_PUSH:
 		proto.TriggerType = executorV1.TriggerType_TRIGGER_TYPE_UI_PUSH
+	case executionlog.TriggerTypeSCHEDULED:
+		proto.TriggerType = executorV1.TriggerType_TRIGGER_TYPE_SCHEDULED
+	case executionlog.TriggerTypeEVENT:
+		proto.TriggerType = executorV1.TriggerType_TRIGGER_TYPE_EVENT
+	case executionlog.TriggerTypeWORKFLOW:
+		proto.TriggerType = executorV1.TriggerType_TRIGGER_TYPE_WORKFLOW
 	}
 
 	switch entity.Status {
@@ -211,6 +1099,12 @@ func (r *ExecutionLogRepo) ToProto(entity *ent.ExecutionLog) *executorV1.Executi
 		proto.Status = executorV1.ExecutionStatus_EXECUTION_STATUS_REJECTED_NOT_APPROVED
 	case executionlog.StatusCLIENT_OFFLINE:
 		proto.Status = executorV1.ExecutionStatus_EXECUTION_STATUS_CLIENT_OFFLINE
+	case executionlog.StatusTIMED_OUT:
+		proto.Status = executorV1.ExecutionStatus_EXECUTION_STATUS_TIMED_OUT
+	case executionlog.StatusCANCELLED:
+		proto.Status = executorV1.ExecutionStatus_EXECUTION_STATUS_CANCELLED
+	case executionlog.StatusAWAITING_APPROVAL:
+		proto.Status = executorV1.ExecutionStatus_EXECUTION_STATUS_AWAITING_APPROVAL
 	}
 
 	if entity.ExitCode != nil {
@@ -222,6 +1116,40 @@ func (r *ExecutionLogRepo) ToProto(entity *ent.ExecutionLog) *executorV1.Executi
 	if entity.ErrorOutput != "" {
 		proto.ErrorOutput = &entity.ErrorOutput
 	}
+	if entity.RunID != nil {
+		proto.RunId = entity.RunID
+	}
+	if entity.EventRuleID != nil {
+		proto.Event = &executorV1.ExecutionEvent{
+			RuleId:            *entity.EventRuleID,
+			SourceExecutionId: entity.SourceExecutionID,
+		}
+		if entity.EventType != nil {
+			proto.Event.Type = eventTypeToProto(string(*entity.EventType))
+		}
+		if entity.EventDetail != "" {
+			proto.Event.Detail = &entity.EventDetail
+		}
+	}
+	if entity.WorkflowRunID != nil {
+		proto.WorkflowRunId = entity.WorkflowRunID
+		proto.WorkflowStepId = &entity.WorkflowStepID
+	}
+	proto.WaitingForSlot = entity.WaitingForSlot
+	if entity.HeldUntil != nil {
+		proto.HeldUntil = timestamppb.New(*entity.HeldUntil)
+	}
+	if entity.MaintenanceOverride != "" {
+		proto.MaintenanceOverride = &entity.MaintenanceOverride
+	}
+	proto.Parameters = entity.Parameters
+	proto.SecretNames = entity.SecretNames
+	proto.Attempt = uint32(entity.Attempt)
+	proto.OriginalExecutionId = entity.OriginalExecutionID
+	proto.RetriedByExecutionId = entity.RetriedBy
+	if entity.RetryAt != nil {
+		proto.NextRetryAt = timestamppb.New(*entity.RetryAt)
+	}
 	if entity.RejectionReason != "" {
 		proto.RejectionReason = &entity.RejectionReason
 	}
@@ -241,6 +1169,28 @@ func (r *ExecutionLogRepo) ToProto(entity *ent.ExecutionLog) *executorV1.Executi
 	if entity.CompletedAt != nil && !entity.CompletedAt.IsZero() {
 		proto.CompletedAt = timestamppb.New(*entity.CompletedAt)
 	}
+	if entity.CancelRequestedAt != nil && !entity.CancelRequestedAt.IsZero() {
+		proto.CancelRequestedAt = timestamppb.New(*entity.CancelRequestedAt)
+	}
+	if entity.CancelledBy != nil {
+		proto.CancelledBy = entity.CancelledBy
+	}
+	if entity.CancelReason != "" {
+		proto.CancelReason = &entity.CancelReason
+	}
+	if entity.TimeoutReason != "" {
+		proto.TimeoutReason = &entity.TimeoutReason
+	}
+	if entity.ApprovalExpiresAt != nil {
+		proto.ApprovalExpiresAt = timestamppb.New(*entity.ApprovalExpiresAt)
+	}
+	proto.ApprovedBy = entity.ApprovedBy
+	if entity.ApprovedAt != nil {
+		proto.ApproveTime = timestamppb.New(*entity.ApprovedAt)
+	}
+	if entity.ApprovalComment != "" {
+		proto.ApprovalComment = &entity.ApprovalComment
+	}
 
 	return proto
 }
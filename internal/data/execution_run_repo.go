@@ -0,0 +1,291 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// ExecutionRunRepo handles database operations for fan-out runs
+type ExecutionRunRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewExecutionRunRepo creates a new ExecutionRunRepo
+func NewExecutionRunRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ExecutionRunRepo {
+	return &ExecutionRunRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/execution_run"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new run rolling out to the targets with the given strategy
+func (r *ExecutionRunRepo) Create(ctx context.Context, tenantID uint32, scriptID, scriptName, selector string, clientIDs, targets []string, strategy *executorV1.RunStrategy, scheduleID *string, createdBy *uint32) (*ent.ExecutionRun, error) {
+	builder := r.entClient.Client().ExecutionRun.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetScriptID(scriptID).
+		SetScriptName(scriptName).
+		SetSelector(selector).
+		SetClientIds(clientIDs).
+		SetTargets(targets).
+		SetNillableScheduleID(scheduleID).
+		SetCreateTime(time.Now())
+
+	if strategy != nil {
+		builder.
+			SetBatchSize(int(strategy.BatchSize)).
+			SetCanarySize(int(strategy.CanarySize)).
+			SetBatchPauseSeconds(int(strategy.BatchPauseSeconds))
+		if strategy.MaxFailures != nil {
+			builder.SetMaxFailures(int(*strategy.MaxFailures))
+		}
+	}
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create execution run failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create execution run failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a run by ID
+func (r *ExecutionRunRepo) GetByID(ctx context.Context, id string) (*ent.ExecutionRun, error) {
+	entity, err := r.entClient.Client().ExecutionRun.Query().
+		Where(executionrun.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get execution run failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get execution run failed")
+	}
+	return entity, nil
+}
+
+// ListRunning returns the RUNNING runs of all tenants
+func (r *ExecutionRunRepo) ListRunning(ctx context.Context) ([]*ent.ExecutionRun, error) {
+	entities, err := r.entClient.Client().ExecutionRun.Query().
+		Where(executionrun.StatusEQ(executionrun.StatusRUNNING)).
+		Order(ent.Asc(executionrun.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list running execution runs failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list execution runs failed")
+	}
+	return entities, nil
+}
+
+// ClaimBatch hands the targets [from, to) to a new batch. The executions are
+// counted up front so the run cannot look finished while they are created.
+// Returns false if the run is no longer RUNNING or another replica claimed
+// the batch first.
+func (r *ExecutionRunRepo) ClaimBatch(ctx context.Context, id string, from, to, batch int) (bool, error) {
+	n, err := r.entClient.Client().ExecutionRun.Update().
+		Where(
+			executionrun.IDEQ(id),
+			executionrun.StatusEQ(executionrun.StatusRUNNING),
+			executionrun.DispatchedEQ(from),
+		).
+		SetDispatched(to).
+		AddTotal(to - from).
+		SetCurrentBatch(batch).
+		ClearNextBatchAt().
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("claim execution run batch failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update execution run failed")
+	}
+	return n > 0, nil
+}
+
+// ReleaseTarget takes back a claimed target whose execution could not be created
+func (r *ExecutionRunRepo) ReleaseTarget(ctx context.Context, id string) error {
+	err := r.entClient.Client().ExecutionRun.UpdateOneID(id).
+		AddTotal(-1).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("release execution run target failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("update execution run failed")
+	}
+	return nil
+}
+
+// ScheduleNextBatch records when the next batch is due, unless it already is
+func (r *ExecutionRunRepo) ScheduleNextBatch(ctx context.Context, id string, at time.Time) error {
+	err := r.entClient.Client().ExecutionRun.Update().
+		Where(
+			executionrun.IDEQ(id),
+			executionrun.StatusEQ(executionrun.StatusRUNNING),
+			executionrun.NextBatchAtIsNil(),
+		).
+		SetNextBatchAt(at).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("schedule execution run batch failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("update execution run failed")
+	}
+	return nil
+}
+
+// Transition moves a run from one of the given statuses to another. The
+// reason is recorded when halting or aborting. Returns false if the run was
+// not in any of the from statuses.
+func (r *ExecutionRunRepo) Transition(ctx context.Context, id string, from []executionrun.Status, to executionrun.Status, reason string) (bool, error) {
+	builder := r.entClient.Client().ExecutionRun.Update().
+		Where(
+			executionrun.IDEQ(id),
+			executionrun.StatusIn(from...),
+		).
+		SetStatus(to).
+		SetUpdateTime(time.Now())
+
+	if reason != "" {
+		builder.SetHaltReason(reason)
+	}
+
+	n, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update execution run status failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update execution run failed")
+	}
+	return n > 0, nil
+}
+
+// MarkCompleted moves a RUNNING run to COMPLETED. Returns false if the run
+// was already completed.
+func (r *ExecutionRunRepo) MarkCompleted(ctx context.Context, id string) (bool, error) {
+	now := time.Now()
+	n, err := r.entClient.Client().ExecutionRun.Update().
+		Where(
+			executionrun.IDEQ(id),
+			executionrun.StatusEQ(executionrun.StatusRUNNING),
+		).
+		SetStatus(executionrun.StatusCOMPLETED).
+		SetCompletedAt(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("mark execution run completed failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update execution run failed")
+	}
+	return n > 0, nil
+}
+
+// ListByTenant lists runs with pagination and filters
+func (r *ExecutionRunRepo) ListByTenant(ctx context.Context, tenantID uint32, scriptID, status *string, page, pageSize uint32) ([]*ent.ExecutionRun, int, error) {
+	query := r.entClient.Client().ExecutionRun.Query().
+		Where(executionrun.TenantIDEQ(tenantID))
+
+	if scriptID != nil && *scriptID != "" {
+		query = query.Where(executionrun.ScriptIDEQ(*scriptID))
+	}
+	if status != nil && *status != "" {
+		query = query.Where(executionrun.StatusEQ(executionrun.Status(*status)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count execution runs failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count execution runs failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(executionrun.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list execution runs failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list execution runs failed")
+	}
+
+	return entities, total, nil
+}
+
+// ToProto converts an ent.ExecutionRun to executorV1.ExecutionRun
+func (r *ExecutionRunRepo) ToProto(entity *ent.ExecutionRun, progress *executorV1.RunProgress) *executorV1.ExecutionRun {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.ExecutionRun{
+		Id:         entity.ID,
+		TenantId:   derefUint32(entity.TenantID),
+		ScriptId:   entity.ScriptID,
+		ScriptName: entity.ScriptName,
+		ClientIds:  entity.ClientIds,
+		Progress:   progress,
+		Strategy: &executorV1.RunStrategy{
+			BatchSize:         uint32(entity.BatchSize),
+			CanarySize:        uint32(entity.CanarySize),
+			BatchPauseSeconds: uint32(entity.BatchPauseSeconds),
+		},
+		ScheduleId:   entity.ScheduleID,
+		TargetCount:  uint32(len(entity.Targets)),
+		Dispatched:   uint32(entity.Dispatched),
+		CurrentBatch: uint32(entity.CurrentBatch),
+	}
+
+	switch entity.Status {
+	case executionrun.StatusRUNNING:
+		proto.Status = executorV1.RunStatus_RUN_STATUS_RUNNING
+	case executionrun.StatusPAUSED:
+		proto.Status = executorV1.RunStatus_RUN_STATUS_PAUSED
+	case executionrun.StatusHALTED:
+		proto.Status = executorV1.RunStatus_RUN_STATUS_HALTED
+	case executionrun.StatusABORTED:
+		proto.Status = executorV1.RunStatus_RUN_STATUS_ABORTED
+	case executionrun.StatusCOMPLETED:
+		proto.Status = executorV1.RunStatus_RUN_STATUS_COMPLETED
+	}
+
+	if entity.MaxFailures != nil {
+		maxFailures := uint32(*entity.MaxFailures)
+		proto.Strategy.MaxFailures = &maxFailures
+	}
+	if entity.NextBatchAt != nil {
+		proto.NextBatchAt = timestamppb.New(*entity.NextBatchAt)
+	}
+	if entity.HaltReason != "" {
+		proto.HaltReason = &entity.HaltReason
+	}
+
+	if entity.Selector != "" {
+		proto.Selector = &entity.Selector
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.CompletedAt != nil {
+		proto.CompletedAt = timestamppb.New(*entity.CompletedAt)
+	}
+
+	return proto
+}
@@ -0,0 +1,278 @@
+package data
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// MaintenanceWindowRepo handles database operations for maintenance windows
+type MaintenanceWindowRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewMaintenanceWindowRepo creates a new MaintenanceWindowRepo
+func NewMaintenanceWindowRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *MaintenanceWindowRepo {
+	return &MaintenanceWindowRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/maintenance_window"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new maintenance window. The request's optional fields must
+// already hold their defaults.
+func (r *MaintenanceWindowRepo) Create(ctx context.Context, tenantID uint32, req *executorV1.CreateMaintenanceWindowRequest, createdBy *uint32) (*ent.MaintenanceWindow, error) {
+	builder := r.entClient.Client().MaintenanceWindow.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetName(req.Name).
+		SetDescription(req.GetDescription()).
+		SetClientPatterns(req.ClientPatterns).
+		SetCronExpression(req.CronExpression).
+		SetDurationMinutes(int(req.DurationMinutes)).
+		SetTimezone(req.GetTimezone()).
+		SetAction(maintenanceWindowActionFromProto(req.GetAction())).
+		SetEnabled(req.GetEnabled()).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create maintenance window failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create maintenance window failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a maintenance window by ID
+func (r *MaintenanceWindowRepo) GetByID(ctx context.Context, id string) (*ent.MaintenanceWindow, error) {
+	entity, err := r.entClient.Client().MaintenanceWindow.Query().
+		Where(maintenancewindow.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get maintenance window failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get maintenance window failed")
+	}
+	return entity, nil
+}
+
+// ListByTenant lists maintenance windows with pagination and filters. With a
+// client ID only the windows matching that client are returned.
+func (r *MaintenanceWindowRepo) ListByTenant(ctx context.Context, tenantID uint32, enabled *bool, clientID *string, page, pageSize uint32) ([]*ent.MaintenanceWindow, int, error) {
+	query := r.entClient.Client().MaintenanceWindow.Query().
+		Where(maintenancewindow.TenantIDEQ(tenantID))
+
+	if enabled != nil {
+		query = query.Where(maintenancewindow.EnabledEQ(*enabled))
+	}
+
+	// Patterns are matched here, so the client filter pages in memory
+	if clientID != nil && *clientID != "" {
+		entities, err := query.
+			Order(ent.Asc(maintenancewindow.FieldName)).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("list maintenance windows failed: %s", err.Error())
+			return nil, 0, executorV1.ErrorInternalServerError("list maintenance windows failed")
+		}
+		entities = filterWindowsByClient(entities, *clientID)
+		total := len(entities)
+		if page > 0 && pageSize > 0 {
+			start := min(int((page-1)*pageSize), total)
+			end := min(start+int(pageSize), total)
+			entities = entities[start:end]
+		}
+		return entities, total, nil
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count maintenance windows failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count maintenance windows failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(maintenancewindow.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list maintenance windows failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list maintenance windows failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListEnabledForClient returns the tenant's enabled windows that match the client
+func (r *MaintenanceWindowRepo) ListEnabledForClient(ctx context.Context, tenantID uint32, clientID string) ([]*ent.MaintenanceWindow, error) {
+	entities, err := r.entClient.Client().MaintenanceWindow.Query().
+		Where(
+			maintenancewindow.TenantIDEQ(tenantID),
+			maintenancewindow.EnabledEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list maintenance windows failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list maintenance windows failed")
+	}
+	return filterWindowsByClient(entities, clientID), nil
+}
+
+// Update updates a maintenance window. A nil clientPatterns slice leaves the
+// patterns unchanged.
+func (r *MaintenanceWindowRepo) Update(ctx context.Context, id string, req *executorV1.UpdateMaintenanceWindowRequest, clientPatterns []string, updatedBy *uint32) (*ent.MaintenanceWindow, error) {
+	builder := r.entClient.Client().MaintenanceWindow.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if req.Name != nil {
+		builder.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		builder.SetDescription(*req.Description)
+	}
+	if clientPatterns != nil {
+		builder.SetClientPatterns(clientPatterns)
+	}
+	if req.CronExpression != nil {
+		builder.SetCronExpression(*req.CronExpression)
+	}
+	if req.DurationMinutes != nil {
+		builder.SetDurationMinutes(int(*req.DurationMinutes))
+	}
+	if req.Timezone != nil {
+		builder.SetTimezone(*req.Timezone)
+	}
+	if req.Action != nil {
+		builder.SetAction(maintenanceWindowActionFromProto(*req.Action))
+	}
+	if req.Enabled != nil {
+		builder.SetEnabled(*req.Enabled)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorMaintenanceWindowNotFound("maintenance window not found")
+		}
+		r.log.Errorf("update maintenance window failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update maintenance window failed")
+	}
+	return entity, nil
+}
+
+// Delete deletes a maintenance window
+func (r *MaintenanceWindowRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().MaintenanceWindow.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return executorV1.ErrorMaintenanceWindowNotFound("maintenance window not found")
+		}
+		r.log.Errorf("delete maintenance window failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete maintenance window failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.MaintenanceWindow to executorV1.MaintenanceWindow.
+// Whether the window is open depends on the clock and is left to the caller.
+func (r *MaintenanceWindowRepo) ToProto(entity *ent.MaintenanceWindow) *executorV1.MaintenanceWindow {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.MaintenanceWindow{
+		Id:              entity.ID,
+		TenantId:        derefUint32(entity.TenantID),
+		Name:            entity.Name,
+		ClientPatterns:  entity.ClientPatterns,
+		CronExpression:  entity.CronExpression,
+		DurationMinutes: uint32(entity.DurationMinutes),
+		Timezone:        entity.Timezone,
+		Enabled:         entity.Enabled,
+	}
+
+	switch entity.Action {
+	case maintenancewindow.ActionREJECT:
+		proto.Action = executorV1.MaintenanceWindowAction_MAINTENANCE_WINDOW_ACTION_REJECT
+	case maintenancewindow.ActionQUEUE:
+		proto.Action = executorV1.MaintenanceWindowAction_MAINTENANCE_WINDOW_ACTION_QUEUE
+	case maintenancewindow.ActionREQUIRE_OVERRIDE:
+		proto.Action = executorV1.MaintenanceWindowAction_MAINTENANCE_WINDOW_ACTION_REQUIRE_OVERRIDE
+	}
+
+	if entity.Description != "" {
+		proto.Description = &entity.Description
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
+
+// MatchesClient reports whether one of the window's patterns matches the
+// client. Patterns are client IDs or globs in path.Match syntax.
+func MatchesClient(window *ent.MaintenanceWindow, clientID string) bool {
+	for _, pattern := range window.ClientPatterns {
+		if pattern == clientID {
+			return true
+		}
+		if ok, err := path.Match(pattern, clientID); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func filterWindowsByClient(entities []*ent.MaintenanceWindow, clientID string) []*ent.MaintenanceWindow {
+	matching := entities[:0]
+	for _, e := range entities {
+		if MatchesClient(e, clientID) {
+			matching = append(matching, e)
+		}
+	}
+	return matching
+}
+
+func maintenanceWindowActionFromProto(a executorV1.MaintenanceWindowAction) maintenancewindow.Action {
+	switch a {
+	case executorV1.MaintenanceWindowAction_MAINTENANCE_WINDOW_ACTION_QUEUE:
+		return maintenancewindow.ActionQUEUE
+	case executorV1.MaintenanceWindowAction_MAINTENANCE_WINDOW_ACTION_REQUIRE_OVERRIDE:
+		return maintenancewindow.ActionREQUIRE_OVERRIDE
+	default:
+		return maintenancewindow.ActionREJECT
+	}
+}
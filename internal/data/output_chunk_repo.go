@@ -0,0 +1,125 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/outputchunk"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// OutputChunkRepo handles database operations for live execution output
+type OutputChunkRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewOutputChunkRepo creates a new OutputChunkRepo
+func NewOutputChunkRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *OutputChunkRepo {
+	return &OutputChunkRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/output_chunk"),
+		entClient: entClient,
+	}
+}
+
+// Append stores an output chunk. Returns false if a chunk with the same
+// sequence number was already stored for the execution (client resend).
+func (r *OutputChunkRepo) Append(ctx context.Context, tenantID uint32, chunk *executorV1.OutputChunk) (bool, error) {
+	stream := outputchunk.StreamSTDOUT
+	if chunk.GetStream() == executorV1.OutputStream_OUTPUT_STREAM_STDERR {
+		stream = outputchunk.StreamSTDERR
+	}
+
+	_, err := r.entClient.Client().OutputChunk.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetExecutionID(chunk.GetExecutionId()).
+		SetSeq(chunk.GetSeq()).
+		SetStream(stream).
+		SetData(chunk.GetData()).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		r.log.Errorf("append output chunk failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("append output chunk failed")
+	}
+	return true, nil
+}
+
+// ListAfter returns up to limit chunks of an execution with a sequence number above afterSeq
+func (r *OutputChunkRepo) ListAfter(ctx context.Context, executionID string, afterSeq int64, limit int) ([]*ent.OutputChunk, error) {
+	entities, err := r.entClient.Client().OutputChunk.Query().
+		Where(
+			outputchunk.ExecutionIDEQ(executionID),
+			outputchunk.SeqGT(afterSeq),
+		).
+		Order(ent.Asc(outputchunk.FieldSeq)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list output chunks failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list output chunks failed")
+	}
+	return entities, nil
+}
+
+// Assemble joins all stored chunks of an execution into its stdout and stderr
+func (r *OutputChunkRepo) Assemble(ctx context.Context, executionID string) (string, string, error) {
+	entities, err := r.entClient.Client().OutputChunk.Query().
+		Where(outputchunk.ExecutionIDEQ(executionID)).
+		Order(ent.Asc(outputchunk.FieldSeq)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("assemble output chunks failed: %s", err.Error())
+		return "", "", executorV1.ErrorInternalServerError("assemble output chunks failed")
+	}
+
+	var stdout, stderr strings.Builder
+	for _, e := range entities {
+		if e.Stream == outputchunk.StreamSTDERR {
+			stderr.WriteString(e.Data)
+		} else {
+			stdout.WriteString(e.Data)
+		}
+	}
+	return stdout.String(), stderr.String(), nil
+}
+
+// ToProto converts an ent.OutputChunk to executorV1.OutputChunk
+func (r *OutputChunkRepo) ToProto(entity *ent.OutputChunk) *executorV1.OutputChunk {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.OutputChunk{
+		ExecutionId: entity.ExecutionID,
+		Seq:         entity.Seq,
+		Data:        entity.Data,
+	}
+
+	switch entity.Stream {
+	case outputchunk.StreamSTDOUT:
+		proto.Stream = executorV1.OutputStream_OUTPUT_STREAM_STDOUT
+	case outputchunk.StreamSTDERR:
+		proto.Stream = executorV1.OutputStream_OUTPUT_STREAM_STDERR
+	}
+
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
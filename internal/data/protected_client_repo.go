@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/protectedclient"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// ProtectedClientRepo handles database operations for protected clients
+type ProtectedClientRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewProtectedClientRepo creates a new ProtectedClientRepo
+func NewProtectedClientRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ProtectedClientRepo {
+	return &ProtectedClientRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/protected_client"),
+		entClient: entClient,
+	}
+}
+
+// Create puts a client of the tenant on the protected list
+func (r *ProtectedClientRepo) Create(ctx context.Context, tenantID uint32, clientID, reason string, createdBy *uint32) (*ent.ProtectedClient, error) {
+	builder := r.entClient.Client().ProtectedClient.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetClientID(clientID).
+		SetReason(reason).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, executorV1.ErrorProtectedClientAlreadyExists("client %s is already protected", clientID)
+		}
+		r.log.Errorf("create protected client failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create protected client failed")
+	}
+	return entity, nil
+}
+
+// IsProtected reports whether a client is on the tenant's protected list
+func (r *ProtectedClientRepo) IsProtected(ctx context.Context, tenantID uint32, clientID string) (bool, error) {
+	exists, err := r.entClient.Client().ProtectedClient.Query().
+		Where(
+			protectedclient.TenantIDEQ(tenantID),
+			protectedclient.ClientIDEQ(clientID),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check protected client failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("check protected client failed")
+	}
+	return exists, nil
+}
+
+// ExistsForTenant reports whether the tenant protects any client
+func (r *ProtectedClientRepo) ExistsForTenant(ctx context.Context, tenantID uint32) (bool, error) {
+	exists, err := r.entClient.Client().ProtectedClient.Query().
+		Where(protectedclient.TenantIDEQ(tenantID)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check protected clients failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("check protected clients failed")
+	}
+	return exists, nil
+}
+
+// ListByTenant lists the protected clients of a tenant with pagination
+func (r *ProtectedClientRepo) ListByTenant(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.ProtectedClient, int, error) {
+	query := r.entClient.Client().ProtectedClient.Query().
+		Where(protectedclient.TenantIDEQ(tenantID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count protected clients failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count protected clients failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(protectedclient.FieldClientID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list protected clients failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list protected clients failed")
+	}
+
+	return entities, total, nil
+}
+
+// Delete removes a client from the tenant's protected list
+func (r *ProtectedClientRepo) Delete(ctx context.Context, tenantID uint32, clientID string) error {
+	n, err := r.entClient.Client().ProtectedClient.Delete().
+		Where(
+			protectedclient.TenantIDEQ(tenantID),
+			protectedclient.ClientIDEQ(clientID),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete protected client failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete protected client failed")
+	}
+	if n == 0 {
+		return executorV1.ErrorProtectedClientNotFound("client %s is not protected", clientID)
+	}
+	return nil
+}
+
+// ToProto converts an ent.ProtectedClient to executorV1.ProtectedClient
+func (r *ProtectedClientRepo) ToProto(entity *ent.ProtectedClient) *executorV1.ProtectedClient {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.ProtectedClient{
+		Id:       entity.ID,
+		TenantId: derefUint32(entity.TenantID),
+		ClientId: entity.ClientID,
+	}
+
+	if entity.Reason != "" {
+		proto.Reason = &entity.Reason
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+
+	return proto
+}
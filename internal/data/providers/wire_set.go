@@ -18,8 +18,26 @@ var ProviderSet = wire.NewSet(
 	data.NewRegistrationClient,
 	data.NewPortalClient,
 	data.NewScriptRepo,
+	data.NewScriptVersionRepo,
+	data.NewScriptChangeRequestRepo,
 	data.NewAssignmentRepo,
 	data.NewExecutionLogRepo,
+	data.NewQueuedCommandRepo,
+	data.NewCommandRepo,
+	data.NewOutputChunkRepo,
+	data.NewExecutionRunRepo,
+	data.NewScheduleRepo,
+	data.NewEventRuleRepo,
+	data.NewWorkflowRepo,
+	data.NewWorkflowRunRepo,
+	data.NewMaintenanceWindowRepo,
+	data.NewProtectedClientRepo,
+	data.NewSecretCipher,
+	data.NewSecretRepo,
+	data.NewSigningKeyRepo,
+	data.NewClientRepo,
+	data.NewClientGroupRepo,
+	data.NewTenantSettingRepo,
 	data.NewAuditLogRepo,
 	data.NewStatisticsRepo,
 )
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/queuedcommand"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// QueuedCommandRepo handles database operations for commands waiting on offline clients
+type QueuedCommandRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewQueuedCommandRepo creates a new QueuedCommandRepo
+func NewQueuedCommandRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *QueuedCommandRepo {
+	return &QueuedCommandRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/queued_command"),
+		entClient: entClient,
+	}
+}
+
+// Enqueue stores a command for later delivery to the given client
+func (r *QueuedCommandRepo) Enqueue(ctx context.Context, tenantID uint32, clientID string, cmd *executorV1.ExecutionCommand, expiresAt time.Time) (*ent.QueuedCommand, error) {
+	payload, err := proto.Marshal(cmd)
+	if err != nil {
+		r.log.Errorf("marshal queued command failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("marshal queued command failed")
+	}
+
+	commandType := queuedcommand.CommandTypeSCRIPT_EXECUTION
+	switch cmd.GetCommandType() {
+	case executorV1.CommandType_COMMAND_TYPE_CLIENT_UPDATE:
+		commandType = queuedcommand.CommandTypeCLIENT_UPDATE
+	case executorV1.CommandType_COMMAND_TYPE_CANCEL:
+		commandType = queuedcommand.CommandTypeCANCEL
+	}
+
+	builder := r.entClient.Client().QueuedCommand.Create().
+		SetID(cmd.GetCommandId()).
+		SetTenantID(tenantID).
+		SetClientID(clientID).
+		SetCommandType(commandType).
+		SetPayload(payload).
+		SetExpiresAt(expiresAt).
+		SetCreateTime(time.Now())
+
+	if cmd.GetExecutionId() != "" {
+		builder.SetExecutionID(cmd.GetExecutionId())
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("enqueue command failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("enqueue command failed")
+	}
+
+	return entity, nil
+}
+
+// ListPending returns the unexpired commands for a client in the order they were queued
+func (r *QueuedCommandRepo) ListPending(ctx context.Context, clientID string, now time.Time) ([]*ent.QueuedCommand, error) {
+	entities, err := r.entClient.Client().QueuedCommand.Query().
+		Where(
+			queuedcommand.ClientIDEQ(clientID),
+			queuedcommand.ExpiresAtGT(now),
+		).
+		Order(ent.Asc(queuedcommand.FieldCreateTime), ent.Asc(queuedcommand.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list queued commands failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list queued commands failed")
+	}
+	return entities, nil
+}
+
+// ListExpired returns commands whose TTL elapsed before they could be delivered
+func (r *QueuedCommandRepo) ListExpired(ctx context.Context, now time.Time) ([]*ent.QueuedCommand, error) {
+	entities, err := r.entClient.Client().QueuedCommand.Query().
+		Where(queuedcommand.ExpiresAtLTE(now)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list expired commands failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list expired commands failed")
+	}
+	return entities, nil
+}
+
+// Delete removes a queued command (after delivery or expiry)
+func (r *QueuedCommandRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().QueuedCommand.DeleteOneID(id).Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		r.log.Errorf("delete queued command failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete queued command failed")
+	}
+	return nil
+}
+
+// Decode unmarshals the stored command payload
+func (r *QueuedCommandRepo) Decode(entity *ent.QueuedCommand) (*executorV1.ExecutionCommand, error) {
+	cmd := &executorV1.ExecutionCommand{}
+	if err := proto.Unmarshal(entity.Payload, cmd); err != nil {
+		r.log.Errorf("unmarshal queued command %s failed: %s", entity.ID, err.Error())
+		return nil, executorV1.ErrorInternalServerError("unmarshal queued command failed")
+	}
+	return cmd, nil
+}
@@ -0,0 +1,333 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// ScheduleRepo handles database operations for schedules
+type ScheduleRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewScheduleRepo creates a new ScheduleRepo
+func NewScheduleRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ScheduleRepo {
+	return &ScheduleRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/schedule"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new schedule. The request's optional fields must already
+// hold their defaults.
+func (r *ScheduleRepo) Create(ctx context.Context, tenantID uint32, req *executorV1.CreateScheduleRequest, nextRunAt *time.Time, createdBy *uint32) (*ent.Schedule, error) {
+	builder := r.entClient.Client().Schedule.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetName(req.Name).
+		SetDescription(req.GetDescription()).
+		SetScriptID(req.ScriptId).
+		SetCronExpression(req.CronExpression).
+		SetTimezone(req.GetTimezone()).
+		SetClientIds(req.ClientIds).
+		SetSelector(req.GetSelector()).
+		SetEnabled(req.GetEnabled()).
+		SetCatchUpPolicy(catchUpPolicyFromProto(req.GetCatchUpPolicy())).
+		SetNillableNextRunAt(nextRunAt).
+		SetCreateTime(time.Now())
+
+	if req.Strategy != nil {
+		builder.
+			SetBatchSize(int(req.Strategy.BatchSize)).
+			SetCanarySize(int(req.Strategy.CanarySize)).
+			SetBatchPauseSeconds(int(req.Strategy.BatchPauseSeconds))
+		if req.Strategy.MaxFailures != nil {
+			builder.SetMaxFailures(int(*req.Strategy.MaxFailures))
+		}
+	}
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create schedule failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create schedule failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a schedule by ID
+func (r *ScheduleRepo) GetByID(ctx context.Context, id string) (*ent.Schedule, error) {
+	entity, err := r.entClient.Client().Schedule.Query().
+		Where(schedule.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get schedule failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get schedule failed")
+	}
+	return entity, nil
+}
+
+// ListByTenant lists schedules with pagination and filters
+func (r *ScheduleRepo) ListByTenant(ctx context.Context, tenantID uint32, scriptID *string, enabled *bool, page, pageSize uint32) ([]*ent.Schedule, int, error) {
+	query := r.entClient.Client().Schedule.Query().
+		Where(schedule.TenantIDEQ(tenantID))
+
+	if scriptID != nil && *scriptID != "" {
+		query = query.Where(schedule.ScriptIDEQ(*scriptID))
+	}
+	if enabled != nil {
+		query = query.Where(schedule.EnabledEQ(*enabled))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count schedules failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count schedules failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(schedule.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list schedules failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list schedules failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListDue returns the enabled schedules of all tenants whose next occurrence has come
+func (r *ScheduleRepo) ListDue(ctx context.Context, now time.Time) ([]*ent.Schedule, error) {
+	entities, err := r.entClient.Client().Schedule.Query().
+		Where(
+			schedule.EnabledEQ(true),
+			schedule.NextRunAtLTE(now),
+		).
+		Order(ent.Asc(schedule.FieldNextRunAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due schedules failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list due schedules failed")
+	}
+	return entities, nil
+}
+
+// Update updates a schedule. A nil clientIDs slice leaves the listed clients
+// unchanged; an empty one clears them. With reschedule set the next
+// occurrence is replaced by nextRunAt, and cleared when that is nil.
+func (r *ScheduleRepo) Update(ctx context.Context, id string, req *executorV1.UpdateScheduleRequest, clientIDs []string, reschedule bool, nextRunAt *time.Time, updatedBy *uint32) (*ent.Schedule, error) {
+	builder := r.entClient.Client().Schedule.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if req.Name != nil {
+		builder.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		builder.SetDescription(*req.Description)
+	}
+	if req.CronExpression != nil {
+		builder.SetCronExpression(*req.CronExpression)
+	}
+	if req.Timezone != nil {
+		builder.SetTimezone(*req.Timezone)
+	}
+	if clientIDs != nil {
+		builder.SetClientIds(clientIDs)
+	}
+	if req.Selector != nil {
+		builder.SetSelector(*req.Selector)
+	}
+	if req.Strategy != nil {
+		builder.
+			SetBatchSize(int(req.Strategy.BatchSize)).
+			SetCanarySize(int(req.Strategy.CanarySize)).
+			SetBatchPauseSeconds(int(req.Strategy.BatchPauseSeconds))
+		if req.Strategy.MaxFailures != nil {
+			builder.SetMaxFailures(int(*req.Strategy.MaxFailures))
+		} else {
+			builder.ClearMaxFailures()
+		}
+	}
+	if req.Enabled != nil {
+		builder.SetEnabled(*req.Enabled)
+	}
+	if req.CatchUpPolicy != nil {
+		builder.SetCatchUpPolicy(catchUpPolicyFromProto(*req.CatchUpPolicy))
+	}
+	if reschedule {
+		if nextRunAt != nil {
+			builder.SetNextRunAt(*nextRunAt)
+		} else {
+			builder.ClearNextRunAt()
+		}
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorScheduleNotFound("schedule not found")
+		}
+		r.log.Errorf("update schedule failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update schedule failed")
+	}
+	return entity, nil
+}
+
+// Advance moves the next occurrence from due to next, or clears it when next
+// is nil. Returns false if another replica already advanced the schedule.
+func (r *ScheduleRepo) Advance(ctx context.Context, id string, due time.Time, next *time.Time) (bool, error) {
+	builder := r.entClient.Client().Schedule.Update().
+		Where(
+			schedule.IDEQ(id),
+			schedule.EnabledEQ(true),
+			schedule.NextRunAtEQ(due),
+		)
+
+	if next != nil {
+		builder.SetNextRunAt(*next)
+	} else {
+		builder.ClearNextRunAt()
+	}
+
+	n, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("advance schedule failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update schedule failed")
+	}
+	return n > 0, nil
+}
+
+// RecordRun stores the outcome of an occurrence: the run it started, or the
+// reason it did not start one
+func (r *ScheduleRepo) RecordRun(ctx context.Context, id string, occurrence time.Time, runID *string, lastError string) error {
+	builder := r.entClient.Client().Schedule.UpdateOneID(id).
+		SetLastRunAt(occurrence).
+		SetLastError(lastError)
+
+	if runID != nil {
+		builder.SetLastRunID(*runID)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		r.log.Errorf("record schedule run failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("update schedule failed")
+	}
+	return nil
+}
+
+// Delete deletes a schedule
+func (r *ScheduleRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().Schedule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return executorV1.ErrorScheduleNotFound("schedule not found")
+		}
+		r.log.Errorf("delete schedule failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete schedule failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.Schedule to executorV1.Schedule
+func (r *ScheduleRepo) ToProto(entity *ent.Schedule) *executorV1.Schedule {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.Schedule{
+		Id:             entity.ID,
+		TenantId:       derefUint32(entity.TenantID),
+		Name:           entity.Name,
+		ScriptId:       entity.ScriptID,
+		CronExpression: entity.CronExpression,
+		Timezone:       entity.Timezone,
+		ClientIds:      entity.ClientIds,
+		Strategy: &executorV1.RunStrategy{
+			BatchSize:         uint32(entity.BatchSize),
+			CanarySize:        uint32(entity.CanarySize),
+			BatchPauseSeconds: uint32(entity.BatchPauseSeconds),
+		},
+		Enabled:   entity.Enabled,
+		LastRunId: entity.LastRunID,
+	}
+
+	switch entity.CatchUpPolicy {
+	case schedule.CatchUpPolicySKIP:
+		proto.CatchUpPolicy = executorV1.CatchUpPolicy_CATCH_UP_POLICY_SKIP
+	case schedule.CatchUpPolicyRUN_ONCE:
+		proto.CatchUpPolicy = executorV1.CatchUpPolicy_CATCH_UP_POLICY_RUN_ONCE
+	case schedule.CatchUpPolicyRUN_ALL:
+		proto.CatchUpPolicy = executorV1.CatchUpPolicy_CATCH_UP_POLICY_RUN_ALL
+	}
+
+	if entity.MaxFailures != nil {
+		maxFailures := uint32(*entity.MaxFailures)
+		proto.Strategy.MaxFailures = &maxFailures
+	}
+	if entity.Description != "" {
+		proto.Description = &entity.Description
+	}
+	if entity.Selector != "" {
+		proto.Selector = &entity.Selector
+	}
+	if entity.LastError != "" {
+		proto.LastError = &entity.LastError
+	}
+	if entity.NextRunAt != nil {
+		proto.NextRunAt = timestamppb.New(*entity.NextRunAt)
+	}
+	if entity.LastRunAt != nil {
+		proto.LastRunAt = timestamppb.New(*entity.LastRunAt)
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
+
+func catchUpPolicyFromProto(p executorV1.CatchUpPolicy) schedule.CatchUpPolicy {
+	switch p {
+	case executorV1.CatchUpPolicy_CATCH_UP_POLICY_RUN_ONCE:
+		return schedule.CatchUpPolicyRUN_ONCE
+	case executorV1.CatchUpPolicy_CATCH_UP_POLICY_RUN_ALL:
+		return schedule.CatchUpPolicyRUN_ALL
+	default:
+		return schedule.CatchUpPolicySKIP
+	}
+}
@@ -0,0 +1,435 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptchangerequest"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// ScriptChangeRequestRepo handles database operations for script change
+// requests. Approving a request saves its content through ScriptRepo in the
+// same transaction, so a request is never approved without its version.
+type ScriptChangeRequestRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	scripts   *ScriptRepo
+	log       *log.Helper
+}
+
+// NewScriptChangeRequestRepo creates a new ScriptChangeRequestRepo
+func NewScriptChangeRequestRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], scripts *ScriptRepo) *ScriptChangeRequestRepo {
+	return &ScriptChangeRequestRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/script_change_request"),
+		entClient: entClient,
+		scripts:   scripts,
+	}
+}
+
+// Create records a content change of a script for approval. A script has at
+// most one pending change request; the script row is locked while checking.
+func (r *ScriptChangeRequestRepo) Create(ctx context.Context, scriptID string, change *ContentChange, requestedBy *uint32) (*ent.ScriptChangeRequest, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create script change request failed")
+	}
+
+	current, err := tx.Script.Query().
+		Where(script.IDEQ(scriptID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorScriptNotFound("script not found")
+		}
+		r.log.Errorf("lock script failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create script change request failed")
+	}
+
+	pending, err := tx.ScriptChangeRequest.Query().
+		Where(
+			scriptchangerequest.ScriptIDEQ(scriptID),
+			scriptchangerequest.StatusEQ(scriptchangerequest.StatusPENDING),
+		).
+		Exist(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("check pending script change requests failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create script change request failed")
+	}
+	if pending {
+		_ = tx.Rollback()
+		return nil, executorV1.ErrorScriptChangeConflict("script already has a pending change request")
+	}
+
+	now := time.Now()
+	builder := tx.ScriptChangeRequest.Create().
+		SetID(uuid.New().String()).
+		SetNillableTenantID(current.TenantID).
+		SetScriptID(scriptID).
+		SetBaseVersion(current.Version).
+		SetContent(change.Content).
+		SetContentHash(change.ContentHash).
+		SetChangeNote(change.ChangeNote).
+		SetNillableRestoredFromVersion(change.RestoredFromVersion).
+		SetStatus(scriptchangerequest.StatusPENDING).
+		SetCreateTime(now).
+		SetUpdateTime(now)
+	if requestedBy != nil {
+		builder.SetCreateBy(*requestedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("create script change request failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create script change request failed")
+	}
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit script change request failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create script change request failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a change request by ID
+func (r *ScriptChangeRequestRepo) GetByID(ctx context.Context, id string) (*ent.ScriptChangeRequest, error) {
+	entity, err := r.entClient.Client().ScriptChangeRequest.Query().
+		Where(scriptchangerequest.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get script change request failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get script change request failed")
+	}
+	return entity, nil
+}
+
+// ListByTenant lists the change requests of a tenant, newest first
+func (r *ScriptChangeRequestRepo) ListByTenant(ctx context.Context, tenantID uint32, scriptID, status *string, page, pageSize uint32) ([]*ent.ScriptChangeRequest, int, error) {
+	query := r.entClient.Client().ScriptChangeRequest.Query().
+		Where(scriptchangerequest.TenantIDEQ(tenantID))
+
+	if scriptID != nil && *scriptID != "" {
+		query = query.Where(scriptchangerequest.ScriptIDEQ(*scriptID))
+	}
+	if status != nil && *status != "" {
+		query = query.Where(scriptchangerequest.StatusEQ(scriptchangerequest.Status(*status)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count script change requests failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count script change requests failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(scriptchangerequest.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list script change requests failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list script change requests failed")
+	}
+
+	return entities, total, nil
+}
+
+// Approve saves the content of a pending change request as the next version
+// of its script. The request must have been made against the current version.
+func (r *ScriptChangeRequestRepo) Approve(ctx context.Context, id string, reviewedBy *uint32, comment string) (*ent.ScriptChangeRequest, *ent.Script, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, nil, executorV1.ErrorInternalServerError("approve script change request failed")
+	}
+
+	request, err := tx.ScriptChangeRequest.Query().
+		Where(scriptchangerequest.IDEQ(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, executorV1.ErrorScriptChangeRequestNotFound("script change request not found")
+		}
+		r.log.Errorf("lock script change request failed: %s", err.Error())
+		return nil, nil, executorV1.ErrorInternalServerError("approve script change request failed")
+	}
+	if request.Status != scriptchangerequest.StatusPENDING {
+		_ = tx.Rollback()
+		return nil, nil, notPendingError(request)
+	}
+
+	current, err := tx.Script.Query().
+		Where(script.IDEQ(request.ScriptID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, executorV1.ErrorScriptNotFound("script not found")
+		}
+		r.log.Errorf("lock script failed: %s", err.Error())
+		return nil, nil, executorV1.ErrorInternalServerError("approve script change request failed")
+	}
+	if current.Version != request.BaseVersion {
+		_ = tx.Rollback()
+		return nil, nil, executorV1.ErrorScriptChangeConflict("script changed since the request was made (version %d, now %d)", request.BaseVersion, current.Version)
+	}
+
+	updated, err := r.scripts.update(ctx, tx.Client(), request.ScriptID, nil, nil, &ContentChange{
+		Content:             request.Content,
+		ContentHash:         request.ContentHash,
+		ChangeNote:          request.ChangeNote,
+		RestoredFromVersion: request.RestoredFromVersion,
+		ApprovedBy:          reviewedBy,
+		ChangeRequestID:     request.ID,
+	}, nil, nil, nil, nil, nil, nil, request.CreateBy)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("apply script change request failed: %s", err.Error())
+		return nil, nil, executorV1.ErrorInternalServerError("approve script change request failed")
+	}
+
+	now := time.Now()
+	approved, err := tx.ScriptChangeRequest.UpdateOneID(id).
+		SetStatus(scriptchangerequest.StatusAPPROVED).
+		SetNillableReviewedBy(reviewedBy).
+		SetReviewedAt(now).
+		SetReviewComment(comment).
+		SetAppliedVersion(updated.Version).
+		SetNillableUpdateBy(reviewedBy).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("approve script change request failed: %s", err.Error())
+		return nil, nil, executorV1.ErrorInternalServerError("approve script change request failed")
+	}
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit script change request approval failed: %s", err.Error())
+		return nil, nil, executorV1.ErrorInternalServerError("approve script change request failed")
+	}
+	return approved, updated, nil
+}
+
+// Reject closes a pending change request with a reason
+func (r *ScriptChangeRequestRepo) Reject(ctx context.Context, id string, reviewedBy *uint32, reason string) (*ent.ScriptChangeRequest, error) {
+	now := time.Now()
+	return r.closePending(ctx, id, r.entClient.Client().ScriptChangeRequest.Update().
+		SetStatus(scriptchangerequest.StatusREJECTED).
+		SetNillableReviewedBy(reviewedBy).
+		SetReviewedAt(now).
+		SetRejectionReason(reason).
+		SetNillableUpdateBy(reviewedBy).
+		SetUpdateTime(now))
+}
+
+// Cancel withdraws a pending change request
+func (r *ScriptChangeRequestRepo) Cancel(ctx context.Context, id string, cancelledBy *uint32) (*ent.ScriptChangeRequest, error) {
+	return r.closePending(ctx, id, r.entClient.Client().ScriptChangeRequest.Update().
+		SetStatus(scriptchangerequest.StatusCANCELLED).
+		SetNillableUpdateBy(cancelledBy).
+		SetUpdateTime(time.Now()))
+}
+
+// CancelPendingByScript withdraws the pending change request of a script,
+// as when the script is deleted
+func (r *ScriptChangeRequestRepo) CancelPendingByScript(ctx context.Context, scriptID string, cancelledBy *uint32) error {
+	_, err := r.entClient.Client().ScriptChangeRequest.Update().
+		Where(
+			scriptchangerequest.ScriptIDEQ(scriptID),
+			scriptchangerequest.StatusEQ(scriptchangerequest.StatusPENDING),
+		).
+		SetStatus(scriptchangerequest.StatusCANCELLED).
+		SetNillableUpdateBy(cancelledBy).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("cancel script change requests failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("cancel script change requests failed")
+	}
+	return nil
+}
+
+// closePending applies an update to a change request if it is still pending
+func (r *ScriptChangeRequestRepo) closePending(ctx context.Context, id string, update *ent.ScriptChangeRequestUpdate) (*ent.ScriptChangeRequest, error) {
+	n, err := update.
+		Where(
+			scriptchangerequest.IDEQ(id),
+			scriptchangerequest.StatusEQ(scriptchangerequest.StatusPENDING),
+		).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("update script change request failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update script change request failed")
+	}
+
+	entity, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, executorV1.ErrorScriptChangeRequestNotFound("script change request not found")
+	}
+	if n == 0 {
+		return nil, notPendingError(entity)
+	}
+	return entity, nil
+}
+
+// AddComment appends a comment to a change request
+func (r *ScriptChangeRequestRepo) AddComment(ctx context.Context, id string, comment schema.ScriptChangeComment) (*ent.ScriptChangeRequest, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("comment on script change request failed")
+	}
+
+	request, err := tx.ScriptChangeRequest.Query().
+		Where(scriptchangerequest.IDEQ(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorScriptChangeRequestNotFound("script change request not found")
+		}
+		r.log.Errorf("lock script change request failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("comment on script change request failed")
+	}
+
+	entity, err := tx.ScriptChangeRequest.UpdateOneID(id).
+		SetComments(append(request.Comments, comment)).
+		SetUpdateTime(comment.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("comment on script change request failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("comment on script change request failed")
+	}
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit script change request comment failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("comment on script change request failed")
+	}
+	return entity, nil
+}
+
+// ToProto converts an ent.ScriptChangeRequest to
+// executorV1.ScriptChangeRequest, leaving out the content unless withContent
+// is set
+func (r *ScriptChangeRequestRepo) ToProto(entity *ent.ScriptChangeRequest, withContent bool) *executorV1.ScriptChangeRequest {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.ScriptChangeRequest{
+		Id:          entity.ID,
+		TenantId:    derefUint32(entity.TenantID),
+		ScriptId:    entity.ScriptID,
+		BaseVersion: uint32(entity.BaseVersion),
+		ContentHash: entity.ContentHash,
+		Status:      scriptChangeRequestStatusToProto(entity.Status),
+		RequestedBy: entity.CreateBy,
+		ReviewedBy:  entity.ReviewedBy,
+	}
+
+	if withContent {
+		proto.Content = entity.Content
+	}
+	if entity.ChangeNote != "" {
+		proto.ChangeNote = &entity.ChangeNote
+	}
+	if entity.RestoredFromVersion != nil {
+		v := uint32(*entity.RestoredFromVersion)
+		proto.RestoredFromVersion = &v
+	}
+	if entity.ReviewedAt != nil {
+		proto.ReviewTime = timestamppb.New(*entity.ReviewedAt)
+	}
+	if entity.ReviewComment != "" {
+		proto.ReviewComment = &entity.ReviewComment
+	}
+	if entity.RejectionReason != "" {
+		proto.RejectionReason = &entity.RejectionReason
+	}
+	if entity.AppliedVersion != nil {
+		v := uint32(*entity.AppliedVersion)
+		proto.AppliedVersion = &v
+	}
+	for _, c := range entity.Comments {
+		proto.Comments = append(proto.Comments, &executorV1.ScriptChangeComment{
+			AuthorId:   c.AuthorID,
+			AuthorName: c.AuthorName,
+			Body:       c.Body,
+			CreateTime: timestamppb.New(c.CreatedAt),
+		})
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
+
+// scriptChangeRequestStatusToProto converts an ent status to the proto enum
+func scriptChangeRequestStatusToProto(status scriptchangerequest.Status) executorV1.ScriptChangeRequestStatus {
+	switch status {
+	case scriptchangerequest.StatusPENDING:
+		return executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_PENDING
+	case scriptchangerequest.StatusAPPROVED:
+		return executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_APPROVED
+	case scriptchangerequest.StatusREJECTED:
+		return executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_REJECTED
+	case scriptchangerequest.StatusCANCELLED:
+		return executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_CANCELLED
+	default:
+		return executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+// ScriptChangeRequestStatusFromProto converts the proto enum to an ent status
+// value, empty for UNSPECIFIED
+func ScriptChangeRequestStatusFromProto(status executorV1.ScriptChangeRequestStatus) string {
+	switch status {
+	case executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_PENDING:
+		return string(scriptchangerequest.StatusPENDING)
+	case executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_APPROVED:
+		return string(scriptchangerequest.StatusAPPROVED)
+	case executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_REJECTED:
+		return string(scriptchangerequest.StatusREJECTED)
+	case executorV1.ScriptChangeRequestStatus_SCRIPT_CHANGE_REQUEST_STATUS_CANCELLED:
+		return string(scriptchangerequest.StatusCANCELLED)
+	default:
+		return ""
+	}
+}
+
+// notPendingError reports a change request that was already closed
+func notPendingError(entity *ent.ScriptChangeRequest) error {
+	return executorV1.ErrorScriptChangeConflict("script change request is already %s", strings.ToLower(string(entity.Status)))
+}
@@ -12,6 +12,8 @@ import (
 	entCrud "github.com/tx7do/go-crud/entgo"
 
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
 
 	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
@@ -31,12 +33,16 @@ func NewScriptRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Cli
 	}
 }
 
-// Create creates a new script
-func (r *ScriptRepo) Create(ctx context.Context, tenantID uint32, name, description, scriptType, content, contentHash string, enabled bool, createdBy *uint32) (*ent.Script, error) {
-	id := uuid.New().String()
+// Create creates a new script and records its content as version 1
+func (r *ScriptRepo) Create(ctx context.Context, tenantID uint32, name, description, scriptType, content, contentHash string, enabled bool, timeoutSeconds *int, retryPolicy *executorV1.RetryPolicy, singleton bool, limitAction executorV1.ConcurrencyLimitAction, parameters []*executorV1.ScriptParameter, changeNote string, createdBy *uint32) (*ent.Script, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin create script failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create script failed")
+	}
 
-	builder := r.entClient.Client().Script.Create().
-		SetID(id).
+	builder := tx.Script.Create().
+		SetID(uuid.New().String()).
 		SetTenantID(tenantID).
 		SetName(name).
 		SetScriptType(script.ScriptType(scriptType)).
@@ -44,16 +50,35 @@ func (r *ScriptRepo) Create(ctx context.Context, tenantID uint32, name, descript
 		SetContentHash(contentHash).
 		SetVersion(1).
 		SetEnabled(enabled).
+		SetSingleton(singleton).
+		SetConcurrencyLimitAction(concurrencyLimitActionFromProto(limitAction)).
 		SetCreateTime(time.Now())
 
 	if description != "" {
 		builder.SetDescription(description)
 	}
+	if timeoutSeconds != nil && *timeoutSeconds > 0 {
+		builder.SetTimeoutSeconds(*timeoutSeconds)
+	}
+	if policy := RetryPolicyFromProto(retryPolicy); policy != nil {
+		builder.SetRetryPolicy(policy)
+	}
+	if len(parameters) > 0 {
+		builder.SetParameters(ScriptParametersFromProto(parameters))
+	}
 	if createdBy != nil {
 		builder.SetCreateBy(*createdBy)
 	}
 
 	entity, err := builder.Save(ctx)
+	if err == nil {
+		_, err = newScriptVersion(tx.Client(), entity, changeNote, nil, createdBy).Save(ctx)
+	}
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		_ = tx.Rollback()
+	}
 	if err != nil {
 		r.log.Errorf("create script failed: %s", err.Error())
 		return nil, executorV1.ErrorInternalServerError("create script failed")
@@ -114,28 +139,103 @@ func (r *ScriptRepo) ListByTenant(ctx context.Context, tenantID uint32, scriptTy
 	return entities, total, nil
 }
 
-// Update updates a script
-func (r *ScriptRepo) Update(ctx context.Context, id string, name, description, content, contentHash *string, enabled *bool, version *int, updatedBy *uint32) (*ent.Script, error) {
-	builder := r.entClient.Client().Script.UpdateOneID(id).
+// ContentChange is a new content of a script, saved as its next version
+type ContentChange struct {
+	Content     string
+	ContentHash string
+	ChangeNote  string
+	// RestoredFromVersion is set when a rollback restores an earlier version
+	RestoredFromVersion *int
+	// ApprovedBy and ChangeRequestID are set when an approved change request
+	// is applied
+	ApprovedBy      *uint32
+	ChangeRequestID string
+}
+
+// Update updates a script. A zero timeoutSeconds clears the script timeout; a
+// retry policy without retries clears the policy. A content change bumps the
+// version and is recorded as a new ScriptVersion; the script row is locked
+// meanwhile, so concurrent changes get distinct versions.
+func (r *ScriptRepo) Update(ctx context.Context, id string, name, description *string, change *ContentChange, enabled *bool, timeoutSeconds *int, retryPolicy *executorV1.RetryPolicy, singleton *bool, limitAction *executorV1.ConcurrencyLimitAction, parameters *executorV1.ScriptParameterList, updatedBy *uint32) (*ent.Script, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin update script failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update script failed")
+	}
+
+	entity, err := r.update(ctx, tx.Client(), id, name, description, change, enabled, timeoutSeconds, retryPolicy, singleton, limitAction, parameters, updatedBy)
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		_ = tx.Rollback()
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorScriptNotFound("script not found")
+		}
+		r.log.Errorf("update script failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update script failed")
+	}
+
+	return entity, nil
+}
+
+func (r *ScriptRepo) update(ctx context.Context, client *ent.Client, id string, name, description *string, change *ContentChange, enabled *bool, timeoutSeconds *int, retryPolicy *executorV1.RetryPolicy, singleton *bool, limitAction *executorV1.ConcurrencyLimitAction, parameters *executorV1.ScriptParameterList, updatedBy *uint32) (*ent.Script, error) {
+	builder := client.Script.UpdateOneID(id).
 		SetUpdateTime(time.Now())
 
+	if change != nil {
+		current, err := client.Script.Query().
+			Where(script.IDEQ(id)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err = ensureScriptVersion(ctx, client, current); err != nil {
+			return nil, err
+		}
+		builder.
+			SetContent(change.Content).
+			SetContentHash(change.ContentHash).
+			SetVersion(current.Version + 1)
+	}
+
 	if name != nil {
 		builder.SetName(*name)
 	}
 	if description != nil {
 		builder.SetDescription(*description)
 	}
-	if content != nil {
-		builder.SetContent(*content)
-	}
-	if contentHash != nil {
-		builder.SetContentHash(*contentHash)
-	}
 	if enabled != nil {
 		builder.SetEnabled(*enabled)
 	}
-	if version != nil {
-		builder.SetVersion(*version)
+	if timeoutSeconds != nil {
+		if *timeoutSeconds > 0 {
+			builder.SetTimeoutSeconds(*timeoutSeconds)
+		} else {
+			builder.ClearTimeoutSeconds()
+		}
+	}
+	if retryPolicy != nil {
+		if policy := RetryPolicyFromProto(retryPolicy); policy != nil {
+			builder.SetRetryPolicy(policy)
+		} else {
+			builder.ClearRetryPolicy()
+		}
+	}
+	if singleton != nil {
+		builder.SetSingleton(*singleton)
+	}
+	if limitAction != nil {
+		builder.SetConcurrencyLimitAction(concurrencyLimitActionFromProto(*limitAction))
+	}
+	if parameters != nil {
+		if len(parameters.GetValues()) > 0 {
+			builder.SetParameters(ScriptParametersFromProto(parameters.GetValues()))
+		} else {
+			builder.ClearParameters()
+		}
 	}
 	if updatedBy != nil {
 		builder.SetUpdateBy(*updatedBy)
@@ -143,11 +243,16 @@ func (r *ScriptRepo) Update(ctx context.Context, id string, name, description, c
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, executorV1.ErrorScriptNotFound("script not found")
+		return nil, err
+	}
+
+	if change != nil {
+		if _, err = newScriptVersion(client, entity, change.ChangeNote, change.RestoredFromVersion, updatedBy).
+			SetNillableApprovedBy(change.ApprovedBy).
+			SetChangeRequestID(change.ChangeRequestID).
+			Save(ctx); err != nil {
+			return nil, err
 		}
-		r.log.Errorf("update script failed: %s", err.Error())
-		return nil, executorV1.ErrorInternalServerError("update script failed")
 	}
 
 	return entity, nil
@@ -181,6 +286,7 @@ func (r *ScriptRepo) ToProto(entity *ent.Script) *executorV1.Script {
 		ContentHash: entity.ContentHash,
 		Version:     int32(entity.Version),
 		Enabled:     entity.Enabled,
+		Singleton:   entity.Singleton,
 	}
 
 	switch entity.ScriptType {
@@ -190,8 +296,26 @@ func (r *ScriptRepo) ToProto(entity *ent.Script) *executorV1.Script {
 		proto.ScriptType = executorV1.ScriptType_SCRIPT_TYPE_JAVASCRIPT
 	case script.ScriptTypeLUA:
 		proto.ScriptType = executorV1.ScriptType_SCRIPT_TYPE_LUA
+	case script.ScriptTypePYTHON:
+		proto.ScriptType = executorV1.ScriptType_SCRIPT_TYPE_PYTHON
+	case script.ScriptTypePOWERSHELL:
+		proto.ScriptType = executorV1.ScriptType_SCRIPT_TYPE_POWERSHELL
+	}
+
+	switch entity.ConcurrencyLimitAction {
+	case script.ConcurrencyLimitActionREJECT:
+		proto.ConcurrencyLimitAction = executorV1.ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_REJECT
+	case script.ConcurrencyLimitActionQUEUE:
+		proto.ConcurrencyLimitAction = executorV1.ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_QUEUE
 	}
 
+	if entity.TimeoutSeconds != nil {
+		proto.TimeoutSeconds = intPtr32(*entity.TimeoutSeconds)
+	}
+	if entity.RetryPolicy != nil {
+		proto.RetryPolicy = RetryPolicyToProto(entity.RetryPolicy)
+	}
+	proto.Parameters = ScriptParametersToProto(entity.Parameters)
 	if entity.CreateBy != nil {
 		proto.CreatedBy = entity.CreateBy
 	}
@@ -208,6 +332,123 @@ func (r *ScriptRepo) ToProto(entity *ent.Script) *executorV1.Script {
 	return proto
 }
 
+// concurrencyLimitActionFromProto maps a concurrency limit action; unset rejects
+func concurrencyLimitActionFromProto(a executorV1.ConcurrencyLimitAction) script.ConcurrencyLimitAction {
+	if a == executorV1.ConcurrencyLimitAction_CONCURRENCY_LIMIT_ACTION_QUEUE {
+		return script.ConcurrencyLimitActionQUEUE
+	}
+	return script.ConcurrencyLimitActionREJECT
+}
+
+// RetryPolicyFromProto converts a retry policy to its stored form. Policies
+// that allow no retries convert to nil.
+func RetryPolicyFromProto(p *executorV1.RetryPolicy) *schema.RetryPolicy {
+	if p == nil || p.MaxAttempts <= 1 {
+		return nil
+	}
+
+	policy := &schema.RetryPolicy{
+		MaxAttempts:        int(p.MaxAttempts),
+		BackoffSeconds:     int(p.BackoffSeconds),
+		MaxBackoffSeconds:  int(p.MaxBackoffSeconds),
+		RetryableExitCodes: p.RetryableExitCodes,
+	}
+	for _, st := range p.RetryableStatuses {
+		switch st {
+		case executorV1.ExecutionStatus_EXECUTION_STATUS_FAILED:
+			policy.RetryableStatuses = append(policy.RetryableStatuses, string(executionlog.StatusFAILED))
+		case executorV1.ExecutionStatus_EXECUTION_STATUS_CLIENT_OFFLINE:
+			policy.RetryableStatuses = append(policy.RetryableStatuses, string(executionlog.StatusCLIENT_OFFLINE))
+		case executorV1.ExecutionStatus_EXECUTION_STATUS_TIMED_OUT:
+			policy.RetryableStatuses = append(policy.RetryableStatuses, string(executionlog.StatusTIMED_OUT))
+		}
+	}
+	return policy
+}
+
+// RetryPolicyToProto converts a stored retry policy to proto
+func RetryPolicyToProto(policy *schema.RetryPolicy) *executorV1.RetryPolicy {
+	p := &executorV1.RetryPolicy{
+		MaxAttempts:        uint32(policy.MaxAttempts),
+		BackoffSeconds:     uint32(policy.BackoffSeconds),
+		MaxBackoffSeconds:  uint32(policy.MaxBackoffSeconds),
+		RetryableExitCodes: policy.RetryableExitCodes,
+	}
+	for _, st := range policy.RetryableStatuses {
+		switch executionlog.Status(st) {
+		case executionlog.StatusFAILED:
+			p.RetryableStatuses = append(p.RetryableStatuses, executorV1.ExecutionStatus_EXECUTION_STATUS_FAILED)
+		case executionlog.StatusCLIENT_OFFLINE:
+			p.RetryableStatuses = append(p.RetryableStatuses, executorV1.ExecutionStatus_EXECUTION_STATUS_CLIENT_OFFLINE)
+		case executionlog.StatusTIMED_OUT:
+			p.RetryableStatuses = append(p.RetryableStatuses, executorV1.ExecutionStatus_EXECUTION_STATUS_TIMED_OUT)
+		}
+	}
+	return p
+}
+
+// ScriptParametersFromProto converts script parameters to their stored form
+func ScriptParametersFromProto(params []*executorV1.ScriptParameter) []schema.ScriptParameter {
+	stored := make([]schema.ScriptParameter, 0, len(params))
+	for _, p := range params {
+		sp := schema.ScriptParameter{
+			Name:          p.GetName(),
+			Type:          "STRING",
+			Description:   p.GetDescription(),
+			Required:      p.GetRequired(),
+			Default:       p.DefaultValue,
+			AllowedValues: p.GetAllowedValues(),
+			Secret:        p.GetSecret(),
+			Delivery:      "ENV",
+		}
+		switch p.GetType() {
+		case executorV1.ParameterType_PARAMETER_TYPE_INTEGER:
+			sp.Type = "INTEGER"
+		case executorV1.ParameterType_PARAMETER_TYPE_NUMBER:
+			sp.Type = "NUMBER"
+		case executorV1.ParameterType_PARAMETER_TYPE_BOOLEAN:
+			sp.Type = "BOOLEAN"
+		}
+		if p.GetDelivery() == executorV1.ParameterDelivery_PARAMETER_DELIVERY_ARG {
+			sp.Delivery = "ARG"
+		}
+		stored = append(stored, sp)
+	}
+	return stored
+}
+
+// ScriptParametersToProto converts stored script parameters to proto
+func ScriptParametersToProto(params []schema.ScriptParameter) []*executorV1.ScriptParameter {
+	protos := make([]*executorV1.ScriptParameter, 0, len(params))
+	for _, sp := range params {
+		p := &executorV1.ScriptParameter{
+			Name:          sp.Name,
+			Type:          executorV1.ParameterType_PARAMETER_TYPE_STRING,
+			Required:      sp.Required,
+			DefaultValue:  sp.Default,
+			AllowedValues: sp.AllowedValues,
+			Secret:        sp.Secret,
+			Delivery:      executorV1.ParameterDelivery_PARAMETER_DELIVERY_ENV,
+		}
+		switch sp.Type {
+		case "INTEGER":
+			p.Type = executorV1.ParameterType_PARAMETER_TYPE_INTEGER
+		case "NUMBER":
+			p.Type = executorV1.ParameterType_PARAMETER_TYPE_NUMBER
+		case "BOOLEAN":
+			p.Type = executorV1.ParameterType_PARAMETER_TYPE_BOOLEAN
+		}
+		if sp.Delivery == "ARG" {
+			p.Delivery = executorV1.ParameterDelivery_PARAMETER_DELIVERY_ARG
+		}
+		if sp.Description != "" {
+			p.Description = &sp.Description
+		}
+		protos = append(protos, p)
+	}
+	return protos
+}
+
 // derefUint32 safely dereferences a *uint32 pointer
 func derefUint32(v *uint32) uint32 {
 	if v == nil {
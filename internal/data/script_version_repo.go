@@ -0,0 +1,203 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptversion"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// ScriptVersionRepo handles database operations for script versions. Versions
+// are written by ScriptRepo together with the content they record, and are
+// kept when their script is deleted so executions can still be traced back.
+type ScriptVersionRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewScriptVersionRepo creates a new ScriptVersionRepo
+func NewScriptVersionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ScriptVersionRepo {
+	return &ScriptVersionRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/script_version"),
+		entClient: entClient,
+	}
+}
+
+// ListByScript lists the versions of a script, newest first
+func (r *ScriptVersionRepo) ListByScript(ctx context.Context, scriptID string, page, pageSize uint32) ([]*ent.ScriptVersion, int, error) {
+	query := r.entClient.Client().ScriptVersion.Query().
+		Where(scriptversion.ScriptIDEQ(scriptID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count script versions failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count script versions failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(scriptversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list script versions failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list script versions failed")
+	}
+
+	return entities, total, nil
+}
+
+// GetByVersion retrieves a version of a script
+func (r *ScriptVersionRepo) GetByVersion(ctx context.Context, scriptID string, version int) (*ent.ScriptVersion, error) {
+	entity, err := r.entClient.Client().ScriptVersion.Query().
+		Where(
+			scriptversion.ScriptIDEQ(scriptID),
+			scriptversion.VersionEQ(version),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get script version failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get script version failed")
+	}
+	return entity, nil
+}
+
+// FindByHash returns the first version of a script with the given content
+// hash, nil if no version has it
+func (r *ScriptVersionRepo) FindByHash(ctx context.Context, scriptID, contentHash string) (*ent.ScriptVersion, error) {
+	entity, err := r.entClient.Client().ScriptVersion.Query().
+		Where(
+			scriptversion.ScriptIDEQ(scriptID),
+			scriptversion.ContentHashEQ(contentHash),
+		).
+		Order(ent.Asc(scriptversion.FieldVersion)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("find script version failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get script version failed")
+	}
+	return entity, nil
+}
+
+// EnsureCurrent records the current content of a script as a version if it is
+// missing, as it is for scripts saved before versions were kept
+func (r *ScriptVersionRepo) EnsureCurrent(ctx context.Context, entity *ent.Script) error {
+	if err := ensureScriptVersion(ctx, r.entClient.Client(), entity); err != nil {
+		if ent.IsConstraintError(err) {
+			// Recorded concurrently
+			return nil
+		}
+		r.log.Errorf("record script version failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("record script version failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.ScriptVersion to executorV1.ScriptVersion, leaving
+// out the content unless withContent is set
+func (r *ScriptVersionRepo) ToProto(entity *ent.ScriptVersion, withContent bool) *executorV1.ScriptVersion {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.ScriptVersion{
+		Id:          entity.ID,
+		ScriptId:    entity.ScriptID,
+		Version:     uint32(entity.Version),
+		ContentHash: entity.ContentHash,
+	}
+
+	if withContent {
+		proto.Content = entity.Content
+	}
+	if entity.ChangeNote != "" {
+		proto.ChangeNote = &entity.ChangeNote
+	}
+	if entity.RestoredFromVersion != nil {
+		v := uint32(*entity.RestoredFromVersion)
+		proto.RestoredFromVersion = &v
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.ApprovedBy != nil {
+		proto.ApprovedBy = entity.ApprovedBy
+	}
+	if entity.ChangeRequestID != "" {
+		proto.ChangeRequestId = &entity.ChangeRequestID
+	}
+
+	return proto
+}
+
+// newScriptVersion prepares the version record of a script's current content
+func newScriptVersion(client *ent.Client, entity *ent.Script, changeNote string, restoredFrom *int, createdBy *uint32) *ent.ScriptVersionCreate {
+	builder := client.ScriptVersion.Create().
+		SetID(uuid.New().String()).
+		SetNillableTenantID(entity.TenantID).
+		SetScriptID(entity.ID).
+		SetVersion(entity.Version).
+		SetContent(entity.Content).
+		SetContentHash(entity.ContentHash).
+		SetChangeNote(changeNote).
+		SetNillableRestoredFromVersion(restoredFrom).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+	return builder
+}
+
+// ensureScriptVersion records the current content of a script as its version
+// unless that version exists, attributing it to whoever saved it last
+func ensureScriptVersion(ctx context.Context, client *ent.Client, entity *ent.Script) error {
+	exists, err := client.ScriptVersion.Query().
+		Where(
+			scriptversion.ScriptIDEQ(entity.ID),
+			scriptversion.VersionEQ(entity.Version),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	author, savedAt := entity.CreateBy, entity.CreateTime
+	if entity.Version > 1 {
+		if entity.UpdateBy != nil {
+			author = entity.UpdateBy
+		}
+		if entity.UpdateTime != nil {
+			savedAt = entity.UpdateTime
+		}
+	}
+
+	builder := newScriptVersion(client, entity, "", nil, author)
+	if savedAt != nil {
+		builder.SetCreateTime(*savedAt)
+	}
+	_, err = builder.Save(ctx)
+	return err
+}
@@ -0,0 +1,130 @@
+package data
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+// secretKeySize is the size of an AES-256 key
+const secretKeySize = 32
+
+// SecretCipher encrypts secret values and seals the private signing keys with
+// AES-256-GCM. The key is read from EXECUTOR_SECRET_KEY as 32 base64-encoded
+// bytes. Keys that were replaced can be listed in
+// EXECUTOR_SECRET_PREVIOUS_KEYS, comma separated, so values encrypted with
+// them can still be decrypted until they are saved again. Without a key,
+// secrets cannot be stored or used and nothing is signed.
+type SecretCipher struct {
+	log     *log.Helper
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// NewSecretCipher creates a new SecretCipher from the configured keys
+func NewSecretCipher(ctx *bootstrap.Context) (*SecretCipher, error) {
+	c := &SecretCipher{
+		log:  ctx.NewLoggerHelper("executor/data/secret_cipher"),
+		keys: make(map[string]cipher.AEAD),
+	}
+
+	v := os.Getenv("EXECUTOR_SECRET_KEY")
+	if v == "" {
+		c.log.Warn("EXECUTOR_SECRET_KEY is not set, secrets are disabled")
+		return c, nil
+	}
+	id, err := c.addKey(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EXECUTOR_SECRET_KEY: %w", err)
+	}
+	c.current = id
+
+	for _, prev := range strings.Split(os.Getenv("EXECUTOR_SECRET_PREVIOUS_KEYS"), ",") {
+		if prev = strings.TrimSpace(prev); prev == "" {
+			continue
+		}
+		if _, err = c.addKey(prev); err != nil {
+			return nil, fmt.Errorf("invalid key in EXECUTOR_SECRET_PREVIOUS_KEYS: %w", err)
+		}
+	}
+
+	c.log.Infof("Secrets are encrypted with key %s (%d key(s) loaded)", c.current, len(c.keys))
+	return c, nil
+}
+
+// addKey decodes a base64 key and returns its ID
+func (c *SecretCipher) addKey(encoded string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", err
+	}
+	if len(key) != secretKeySize {
+		return "", fmt.Errorf("key must be %d bytes, got %d", secretKeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(key)
+	id := hex.EncodeToString(sum[:8])
+	c.keys[id] = aead
+	return id, nil
+}
+
+// Configured reports whether a key is configured
+func (c *SecretCipher) Configured() bool {
+	return c.current != ""
+}
+
+// CurrentKeyID returns the ID of the key new values are encrypted with
+func (c *SecretCipher) CurrentKeyID() string {
+	return c.current
+}
+
+// Encrypt encrypts a value with the current key, binding it to aad. Returns
+// the ID of the key used, the nonce and the ciphertext.
+func (c *SecretCipher) Encrypt(plaintext, aad []byte) (string, []byte, []byte, error) {
+	aead, ok := c.keys[c.current]
+	if !ok {
+		return "", nil, nil, fmt.Errorf("no secret key configured")
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, nil, err
+	}
+	return c.current, nonce, aead.Seal(nil, nonce, plaintext, aad), nil
+}
+
+// Decrypt decrypts a value encrypted with the given key
+func (c *SecretCipher) Decrypt(keyID string, nonce, ciphertext, aad []byte) ([]byte, error) {
+	aead, ok := c.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %s is not configured", keyID)
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce")
+	}
+	return aead.Open(nil, nonce, ciphertext, aad)
+}
+
+// HasKey reports whether the key with the given ID is loaded
+func (c *SecretCipher) HasKey(keyID string) bool {
+	_, ok := c.keys[keyID]
+	return ok
+}
@@ -0,0 +1,264 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/secret"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// SecretRepo handles database operations for secrets. Values are encrypted
+// before they are written and only leave the repo through Reveal.
+type SecretRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	cipher    *SecretCipher
+	log       *log.Helper
+}
+
+// NewSecretRepo creates a new SecretRepo
+func NewSecretRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], cipher *SecretCipher) *SecretRepo {
+	return &SecretRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/secret"),
+		entClient: entClient,
+		cipher:    cipher,
+	}
+}
+
+// Create creates a new secret
+func (r *SecretRepo) Create(ctx context.Context, tenantID uint32, name, description, value string, createdBy *uint32) (*ent.Secret, error) {
+	id := uuid.New().String()
+	keyID, nonce, ciphertext, err := r.encrypt(id, value)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := r.entClient.Client().Secret.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetDescription(description).
+		SetCiphertext(ciphertext).
+		SetNonce(nonce).
+		SetKeyID(keyID).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, executorV1.ErrorSecretAlreadyExists("secret %s already exists", name)
+		}
+		r.log.Errorf("create secret failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create secret failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a secret by ID
+func (r *SecretRepo) GetByID(ctx context.Context, id string) (*ent.Secret, error) {
+	entity, err := r.entClient.Client().Secret.Query().
+		Where(secret.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get secret failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get secret failed")
+	}
+	return entity, nil
+}
+
+// ListByTenant lists secrets with pagination, ordered by name
+func (r *SecretRepo) ListByTenant(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.Secret, int, error) {
+	query := r.entClient.Client().Secret.Query().
+		Where(secret.TenantIDEQ(tenantID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count secrets failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count secrets failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(secret.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list secrets failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list secrets failed")
+	}
+
+	return entities, total, nil
+}
+
+// Update updates the description of a secret and, when value is set,
+// re-encrypts it with the current key
+func (r *SecretRepo) Update(ctx context.Context, id string, description, value *string, updatedBy *uint32) (*ent.Secret, error) {
+	builder := r.entClient.Client().Secret.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if description != nil {
+		builder.SetDescription(*description)
+	}
+	if value != nil {
+		keyID, nonce, ciphertext, err := r.encrypt(id, *value)
+		if err != nil {
+			return nil, err
+		}
+		builder.SetCiphertext(ciphertext).
+			SetNonce(nonce).
+			SetKeyID(keyID)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorSecretNotFound("secret not found")
+		}
+		r.log.Errorf("update secret failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update secret failed")
+	}
+	return entity, nil
+}
+
+// Delete deletes a secret
+func (r *SecretRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().Secret.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return executorV1.ErrorSecretNotFound("secret not found")
+		}
+		r.log.Errorf("delete secret failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete secret failed")
+	}
+	return nil
+}
+
+// Check verifies that the tenant has all the named secrets and that their
+// keys are loaded, without decrypting them
+func (r *SecretRepo) Check(ctx context.Context, tenantID uint32, names []string) error {
+	_, err := r.load(ctx, tenantID, names)
+	return err
+}
+
+// Reveal decrypts the named secrets of a tenant. Every name must exist.
+func (r *SecretRepo) Reveal(ctx context.Context, tenantID uint32, names []string) (map[string]string, error) {
+	entities, err := r.load(ctx, tenantID, names)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(entities))
+	for _, e := range entities {
+		plaintext, decErr := r.cipher.Decrypt(e.KeyID, e.Nonce, e.Ciphertext, []byte(e.ID))
+		if decErr != nil {
+			r.log.Errorf("decrypt secret %s failed: %s", e.ID, decErr.Error())
+			return nil, executorV1.ErrorInternalServerError("secret %s could not be decrypted", e.Name)
+		}
+		values[e.Name] = string(plaintext)
+	}
+	return values, nil
+}
+
+// load fetches the named secrets of a tenant, failing on missing ones and on
+// ones encrypted with a key that is not loaded
+func (r *SecretRepo) load(ctx context.Context, tenantID uint32, names []string) ([]*ent.Secret, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	if !r.cipher.Configured() {
+		return nil, executorV1.ErrorSecretsNotConfigured("secrets are not configured on this server")
+	}
+
+	entities, err := r.entClient.Client().Secret.Query().
+		Where(
+			secret.TenantIDEQ(tenantID),
+			secret.NameIn(names...),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("load secrets failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("load secrets failed")
+	}
+
+	found := make(map[string]bool, len(entities))
+	for _, e := range entities {
+		if !r.cipher.HasKey(e.KeyID) {
+			return nil, executorV1.ErrorSecretsNotConfigured("the key secret %s is encrypted with is not configured", e.Name)
+		}
+		found[e.Name] = true
+	}
+	for _, name := range names {
+		if !found[name] {
+			return nil, executorV1.ErrorSecretNotFound("secret %s not found", name)
+		}
+	}
+	return entities, nil
+}
+
+// encrypt encrypts a value for the secret with the given ID
+func (r *SecretRepo) encrypt(id, value string) (string, []byte, []byte, error) {
+	if !r.cipher.Configured() {
+		return "", nil, nil, executorV1.ErrorSecretsNotConfigured("secrets are not configured on this server")
+	}
+	keyID, nonce, ciphertext, err := r.cipher.Encrypt([]byte(value), []byte(id))
+	if err != nil {
+		r.log.Errorf("encrypt secret failed: %s", err.Error())
+		return "", nil, nil, executorV1.ErrorInternalServerError("encrypt secret failed")
+	}
+	return keyID, nonce, ciphertext, nil
+}
+
+// ToProto converts an ent.Secret to executorV1.Secret. The value is never
+// part of it.
+func (r *SecretRepo) ToProto(entity *ent.Secret) *executorV1.Secret {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.Secret{
+		Id:       entity.ID,
+		TenantId: derefUint32(entity.TenantID),
+		Name:     entity.Name,
+		KeyId:    entity.KeyID,
+	}
+
+	if entity.Description != "" {
+		proto.Description = &entity.Description
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.UpdateBy != nil {
+		proto.UpdatedBy = entity.UpdateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
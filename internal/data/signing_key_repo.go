@@ -0,0 +1,254 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/signingkey"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// SigningKeyRepo handles database operations for signing keys. Private keys
+// are sealed with the SecretCipher before they are written.
+type SigningKeyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	cipher    *SecretCipher
+	log       *log.Helper
+}
+
+// NewSigningKeyRepo creates a new SigningKeyRepo
+func NewSigningKeyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], cipher *SecretCipher) *SigningKeyRepo {
+	return &SigningKeyRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/signing_key"),
+		entClient: entClient,
+		cipher:    cipher,
+	}
+}
+
+// Rotate stores a new active key and retires the keys that were active.
+// replaces is the active key the rotation was decided on, nil when there was
+// none. Returns nil without rotating when that no longer holds, e.g. because
+// another replica rotated first.
+func (r *SigningKeyRepo) Rotate(ctx context.Context, replaces *string, id, algorithm string, publicKey, privateKey []byte, createdBy *uint32) (*ent.SigningKey, error) {
+	if !r.cipher.Configured() {
+		return nil, executorV1.ErrorSigningNotConfigured("signing requires a secret key to be configured")
+	}
+	sealedWith, nonce, sealed, err := r.cipher.Encrypt(privateKey, []byte(id))
+	if err != nil {
+		r.log.Errorf("seal signing key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("seal signing key failed")
+	}
+
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("rotate signing key failed")
+	}
+
+	now := time.Now()
+	current := true
+	if replaces == nil {
+		var exists bool
+		exists, err = tx.SigningKey.Query().
+			Where(signingkey.StatusEQ(signingkey.StatusACTIVE)).
+			Exist(ctx)
+		current = !exists
+	} else {
+		var n int
+		n, err = tx.SigningKey.Update().
+			Where(
+				signingkey.IDEQ(*replaces),
+				signingkey.StatusEQ(signingkey.StatusACTIVE),
+			).
+			SetStatus(signingkey.StatusRETIRED).
+			SetRetiredAt(now).
+			Save(ctx)
+		current = n > 0
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("check active signing key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("rotate signing key failed")
+	}
+	if !current {
+		_ = tx.Rollback()
+		return nil, nil
+	}
+
+	// Keys created concurrently while there was no active key are retired too
+	if _, err = tx.SigningKey.Update().
+		Where(signingkey.StatusEQ(signingkey.StatusACTIVE)).
+		SetStatus(signingkey.StatusRETIRED).
+		SetRetiredAt(now).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("retire signing keys failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("rotate signing key failed")
+	}
+
+	builder := tx.SigningKey.Create().
+		SetID(id).
+		SetAlgorithm(signingkey.Algorithm(algorithm)).
+		SetPublicKey(publicKey).
+		SetPrivateKey(sealed).
+		SetNonce(nonce).
+		SetSealedWith(sealedWith).
+		SetStatus(signingkey.StatusACTIVE).
+		SetCreateTime(now)
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.log.Errorf("create signing key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("rotate signing key failed")
+	}
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit signing key rotation failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("rotate signing key failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a signing key by ID
+func (r *SigningKeyRepo) GetByID(ctx context.Context, id string) (*ent.SigningKey, error) {
+	entity, err := r.entClient.Client().SigningKey.Query().
+		Where(signingkey.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get signing key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get signing key failed")
+	}
+	return entity, nil
+}
+
+// GetActive returns the newest active key, nil if there is none
+func (r *SigningKeyRepo) GetActive(ctx context.Context) (*ent.SigningKey, error) {
+	entity, err := r.entClient.Client().SigningKey.Query().
+		Where(signingkey.StatusEQ(signingkey.StatusACTIVE)).
+		Order(ent.Desc(signingkey.FieldCreateTime)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get active signing key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get active signing key failed")
+	}
+	return entity, nil
+}
+
+// List lists signing keys, newest first
+func (r *SigningKeyRepo) List(ctx context.Context, includeRevoked bool) ([]*ent.SigningKey, error) {
+	query := r.entClient.Client().SigningKey.Query()
+	if !includeRevoked {
+		query = query.Where(signingkey.StatusNEQ(signingkey.StatusREVOKED))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(signingkey.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list signing keys failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list signing keys failed")
+	}
+	return entities, nil
+}
+
+// ListPublished lists the active keys and the keys retired after the given
+// time, newest first
+func (r *SigningKeyRepo) ListPublished(ctx context.Context, retiredAfter time.Time) ([]*ent.SigningKey, error) {
+	entities, err := r.entClient.Client().SigningKey.Query().
+		Where(signingkey.Or(
+			signingkey.StatusEQ(signingkey.StatusACTIVE),
+			signingkey.And(
+				signingkey.StatusEQ(signingkey.StatusRETIRED),
+				signingkey.RetiredAtGT(retiredAfter),
+			),
+		)).
+		Order(ent.Desc(signingkey.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list published signing keys failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list signing keys failed")
+	}
+	return entities, nil
+}
+
+// Revoke marks a key as revoked
+func (r *SigningKeyRepo) Revoke(ctx context.Context, id string) (*ent.SigningKey, error) {
+	entity, err := r.entClient.Client().SigningKey.UpdateOneID(id).
+		SetStatus(signingkey.StatusREVOKED).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorSigningKeyNotFound("signing key not found")
+		}
+		r.log.Errorf("revoke signing key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("revoke signing key failed")
+	}
+	return entity, nil
+}
+
+// PrivateKey unseals the PKCS #8 private key of a signing key
+func (r *SigningKeyRepo) PrivateKey(entity *ent.SigningKey) ([]byte, error) {
+	der, err := r.cipher.Decrypt(entity.SealedWith, entity.Nonce, entity.PrivateKey, []byte(entity.ID))
+	if err != nil {
+		r.log.Errorf("unseal signing key %s failed: %s", entity.ID, err.Error())
+		return nil, executorV1.ErrorSigningNotConfigured("signing key %s cannot be unsealed", entity.ID)
+	}
+	return der, nil
+}
+
+// ToProto converts an ent.SigningKey to executorV1.SigningKey. The public
+// key PEM is left to the caller.
+func (r *SigningKeyRepo) ToProto(entity *ent.SigningKey) *executorV1.SigningKey {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.SigningKey{
+		Id: entity.ID,
+	}
+
+	switch entity.Algorithm {
+	case signingkey.AlgorithmED25519:
+		proto.Algorithm = executorV1.SigningAlgorithm_SIGNING_ALGORITHM_ED25519
+	case signingkey.AlgorithmECDSA_P256:
+		proto.Algorithm = executorV1.SigningAlgorithm_SIGNING_ALGORITHM_ECDSA_P256
+	}
+	switch entity.Status {
+	case signingkey.StatusACTIVE:
+		proto.Status = executorV1.SigningKeyStatus_SIGNING_KEY_STATUS_ACTIVE
+	case signingkey.StatusRETIRED:
+		proto.Status = executorV1.SigningKeyStatus_SIGNING_KEY_STATUS_RETIRED
+	case signingkey.StatusREVOKED:
+		proto.Status = executorV1.SigningKeyStatus_SIGNING_KEY_STATUS_REVOKED
+	}
+
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.RetiredAt != nil {
+		proto.RetiredAt = timestamppb.New(*entity.RetiredAt)
+	}
+	if entity.RevokedAt != nil {
+		proto.RevokedAt = timestamppb.New(*entity.RevokedAt)
+	}
+
+	return proto
+}
@@ -53,6 +53,33 @@ func (r *StatisticsRepo) GetScriptCountByEnabled(ctx context.Context, tenantID u
 	return int64(count), nil
 }
 
+// GetScriptCountByType returns the count of scripts of a tenant grouped by script type
+func (r *StatisticsRepo) GetScriptCountByType(ctx context.Context, tenantID uint32) (map[string]int64, error) {
+	result := make(map[string]int64)
+	types := []script.ScriptType{
+		script.ScriptTypeBASH,
+		script.ScriptTypeJAVASCRIPT,
+		script.ScriptTypeLUA,
+		script.ScriptTypePYTHON,
+		script.ScriptTypePOWERSHELL,
+	}
+	for _, scriptType := range types {
+		count, err := r.entClient.Client().Script.Query().
+			Where(
+				script.TenantIDEQ(tenantID),
+				script.ScriptTypeEQ(scriptType),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			result[string(scriptType)] = int64(count)
+		}
+	}
+	return result, nil
+}
+
 // GetAssignmentCount returns the total number of assignments for a tenant
 func (r *StatisticsRepo) GetAssignmentCount(ctx context.Context, tenantID uint32) (int64, error) {
 	count, err := r.entClient.Client().ScriptAssignment.Query().
@@ -158,6 +185,9 @@ func (r *StatisticsRepo) GetGlobalExecutionCountByStatus(ctx context.Context) (m
 		executionlog.StatusREJECTED_HASH_MISMATCH,
 		executionlog.StatusREJECTED_NOT_APPROVED,
 		executionlog.StatusCLIENT_OFFLINE,
+		executionlog.StatusTIMED_OUT,
+		executionlog.StatusCANCELLED,
+		executionlog.StatusAWAITING_APPROVAL,
 	}
 	for _, status := range statuses {
 		count, err := r.entClient.Client().ExecutionLog.Query().
@@ -182,6 +212,7 @@ func (r *StatisticsRepo) GetRecentErrors(ctx context.Context, tenantID uint32, l
 				executionlog.StatusFAILED,
 				executionlog.StatusREJECTED_HASH_MISMATCH,
 				executionlog.StatusREJECTED_NOT_APPROVED,
+				executionlog.StatusTIMED_OUT,
 			),
 		).
 		Order(ent.Desc(executionlog.FieldCreateTime)).
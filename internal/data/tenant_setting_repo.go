@@ -0,0 +1,252 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/tenantsetting"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// DefaultExecutionTimeoutSeconds applies to tenants that never changed their settings
+const DefaultExecutionTimeoutSeconds = 3600
+
+// DefaultScriptApproverRole approves script changes of tenants that did not
+// choose their own approver role
+const DefaultScriptApproverRole = "executor:approver"
+
+// DefaultExecutionApprovalTTLMinutes is how long executions on protected
+// clients wait for approval unless the tenant chose otherwise
+const DefaultExecutionApprovalTTLMinutes = 60
+
+// TenantSettingRepo handles database operations for per-tenant settings
+type TenantSettingRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewTenantSettingRepo creates a new TenantSettingRepo
+func NewTenantSettingRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TenantSettingRepo {
+	return &TenantSettingRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/tenant_setting"),
+		entClient: entClient,
+	}
+}
+
+// Get retrieves the settings of a tenant, returning nil if the tenant has none stored
+func (r *TenantSettingRepo) Get(ctx context.Context, tenantID uint32) (*ent.TenantSetting, error) {
+	entity, err := r.entClient.Client().TenantSetting.Query().
+		Where(tenantsetting.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get tenant settings failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get tenant settings failed")
+	}
+	return entity, nil
+}
+
+// GetDefaultTimeoutSeconds returns the tenant's default execution timeout
+func (r *TenantSettingRepo) GetDefaultTimeoutSeconds(ctx context.Context, tenantID uint32) (int, error) {
+	entity, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	if entity == nil {
+		return DefaultExecutionTimeoutSeconds, nil
+	}
+	return entity.DefaultTimeoutSeconds, nil
+}
+
+// GetMaxConcurrentPerClient returns how many executions a client of the tenant
+// may have active at once, 0 meaning no limit
+func (r *TenantSettingRepo) GetMaxConcurrentPerClient(ctx context.Context, tenantID uint32) (int, error) {
+	entity, err := r.Get(ctx, tenantID)
+	if err != nil || entity == nil {
+		return 0, err
+	}
+	return entity.MaxConcurrentPerClient, nil
+}
+
+// GetScriptApproval reports whether script content changes of the tenant need
+// approval, and the role allowed to approve them
+func (r *TenantSettingRepo) GetScriptApproval(ctx context.Context, tenantID uint32) (bool, string, error) {
+	entity, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return false, "", err
+	}
+	if entity == nil {
+		return false, DefaultScriptApproverRole, nil
+	}
+	return entity.RequireScriptApproval, EffectiveScriptApproverRole(entity.ScriptApproverRole), nil
+}
+
+// GetExecutionApproval returns the role allowed to approve executions on
+// protected clients of the tenant, and how long executions wait for it
+func (r *TenantSettingRepo) GetExecutionApproval(ctx context.Context, tenantID uint32) (string, time.Duration, error) {
+	entity, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return "", 0, err
+	}
+	if entity == nil {
+		return DefaultScriptApproverRole, DefaultExecutionApprovalTTLMinutes * time.Minute, nil
+	}
+	return EffectiveScriptApproverRole(entity.ScriptApproverRole), time.Duration(entity.ExecutionApprovalTTLMinutes) * time.Minute, nil
+}
+
+// GetScriptValidation returns the lowest diagnostic severity that refuses
+// script saves of the tenant, UNSPECIFIED when saves are never refused, and
+// the lint rules the tenant switched off
+func (r *TenantSettingRepo) GetScriptValidation(ctx context.Context, tenantID uint32) (executorV1.DiagnosticSeverity, []string, error) {
+	entity, err := r.Get(ctx, tenantID)
+	if err != nil || entity == nil {
+		return executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_UNSPECIFIED, nil, err
+	}
+	return diagnosticSeverityToProto(entity.ScriptBlockSeverity), entity.DisabledLintRules, nil
+}
+
+// Update stores the given settings for a tenant, creating the row on first
+// use. An empty script approver role restores the default role, and a
+// non-nil lint rule list replaces the disabled rules.
+func (r *TenantSettingRepo) Update(ctx context.Context, tenantID uint32, defaultTimeoutSeconds, maxConcurrentPerClient *int, requireScriptApproval *bool, approverRole *string, approvalTTLMinutes *int, blockSeverity *executorV1.DiagnosticSeverity, disabledLintRules *[]string, updatedBy *uint32) (*ent.TenantSetting, error) {
+	existing, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		builder := r.entClient.Client().TenantSetting.Create().
+			SetID(uuid.New().String()).
+			SetTenantID(tenantID).
+			SetNillableDefaultTimeoutSeconds(defaultTimeoutSeconds).
+			SetNillableMaxConcurrentPerClient(maxConcurrentPerClient).
+			SetNillableRequireScriptApproval(requireScriptApproval).
+			SetNillableScriptApproverRole(approverRole).
+			SetNillableExecutionApprovalTTLMinutes(approvalTTLMinutes).
+			SetCreateTime(time.Now()).
+			SetUpdateTime(time.Now())
+		if blockSeverity != nil {
+			builder.SetScriptBlockSeverity(diagnosticSeverityFromProto(*blockSeverity))
+		}
+		if disabledLintRules != nil {
+			builder.SetDisabledLintRules(*disabledLintRules)
+		}
+		if updatedBy != nil {
+			builder.SetUpdateBy(*updatedBy)
+		}
+
+		entity, createErr := builder.Save(ctx)
+		if createErr != nil {
+			r.log.Errorf("create tenant settings failed: %s", createErr.Error())
+			return nil, executorV1.ErrorInternalServerError("create tenant settings failed")
+		}
+		return entity, nil
+	}
+
+	builder := r.entClient.Client().TenantSetting.UpdateOneID(existing.ID).
+		SetNillableDefaultTimeoutSeconds(defaultTimeoutSeconds).
+		SetNillableMaxConcurrentPerClient(maxConcurrentPerClient).
+		SetNillableRequireScriptApproval(requireScriptApproval).
+		SetNillableScriptApproverRole(approverRole).
+		SetNillableExecutionApprovalTTLMinutes(approvalTTLMinutes).
+		SetUpdateTime(time.Now())
+	if blockSeverity != nil {
+		builder.SetScriptBlockSeverity(diagnosticSeverityFromProto(*blockSeverity))
+	}
+	if disabledLintRules != nil {
+		builder.SetDisabledLintRules(*disabledLintRules)
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update tenant settings failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update tenant settings failed")
+	}
+	return entity, nil
+}
+
+// ToProto converts an ent.TenantSetting to executorV1.TenantSettings.
+// A nil entity yields the built-in defaults for the tenant.
+func (r *TenantSettingRepo) ToProto(tenantID uint32, entity *ent.TenantSetting) *executorV1.TenantSettings {
+	if entity == nil {
+		return &executorV1.TenantSettings{
+			TenantId:                    tenantID,
+			DefaultTimeoutSeconds:       DefaultExecutionTimeoutSeconds,
+			ScriptApproverRole:          DefaultScriptApproverRole,
+			ExecutionApprovalTtlMinutes: DefaultExecutionApprovalTTLMinutes,
+		}
+	}
+
+	proto := &executorV1.TenantSettings{
+		TenantId:               derefUint32(entity.TenantID),
+		DefaultTimeoutSeconds:  int32(entity.DefaultTimeoutSeconds),
+		MaxConcurrentPerClient: uint32(entity.MaxConcurrentPerClient),
+		RequireScriptApproval:  entity.RequireScriptApproval,
+		ScriptApproverRole:     EffectiveScriptApproverRole(entity.ScriptApproverRole),
+
+		ExecutionApprovalTtlMinutes: uint32(entity.ExecutionApprovalTTLMinutes),
+		ScriptBlockSeverity:         diagnosticSeverityToProto(entity.ScriptBlockSeverity),
+		DisabledLintRules:           entity.DisabledLintRules,
+	}
+
+	if entity.UpdateBy != nil {
+		proto.UpdatedBy = entity.UpdateBy
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
+
+// EffectiveScriptApproverRole returns the approver role a stored role stands
+// for, the default role when it is empty
+func EffectiveScriptApproverRole(role string) string {
+	if role == "" {
+		return DefaultScriptApproverRole
+	}
+	return role
+}
+
+// diagnosticSeverityFromProto converts the proto enum to a block severity
+// value, NONE for UNSPECIFIED
+func diagnosticSeverityFromProto(severity executorV1.DiagnosticSeverity) tenantsetting.ScriptBlockSeverity {
+	switch severity {
+	case executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_INFO:
+		return tenantsetting.ScriptBlockSeverityINFO
+	case executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING:
+		return tenantsetting.ScriptBlockSeverityWARNING
+	case executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR:
+		return tenantsetting.ScriptBlockSeverityERROR
+	default:
+		return tenantsetting.ScriptBlockSeverityNONE
+	}
+}
+
+// diagnosticSeverityToProto converts a block severity value to the proto enum
+func diagnosticSeverityToProto(severity tenantsetting.ScriptBlockSeverity) executorV1.DiagnosticSeverity {
+	switch severity {
+	case tenantsetting.ScriptBlockSeverityINFO:
+		return executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_INFO
+	case tenantsetting.ScriptBlockSeverityWARNING:
+		return executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING
+	case tenantsetting.ScriptBlockSeverityERROR:
+		return executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR
+	default:
+		return executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_UNSPECIFIED
+	}
+}
@@ -0,0 +1,224 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflow"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// WorkflowRepo handles database operations for workflows
+type WorkflowRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewWorkflowRepo creates a new WorkflowRepo
+func NewWorkflowRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WorkflowRepo {
+	return &WorkflowRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/workflow"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new workflow
+func (r *WorkflowRepo) Create(ctx context.Context, tenantID uint32, name, description string, steps []*executorV1.WorkflowStep, createdBy *uint32) (*ent.Workflow, error) {
+	builder := r.entClient.Client().Workflow.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetDescription(description).
+		SetSteps(WorkflowStepsFromProto(steps)).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create workflow failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create workflow failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a workflow by ID
+func (r *WorkflowRepo) GetByID(ctx context.Context, id string) (*ent.Workflow, error) {
+	entity, err := r.entClient.Client().Workflow.Query().
+		Where(workflow.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get workflow failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get workflow failed")
+	}
+	return entity, nil
+}
+
+// ListByTenant lists workflows with pagination
+func (r *WorkflowRepo) ListByTenant(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.Workflow, int, error) {
+	query := r.entClient.Client().Workflow.Query().
+		Where(workflow.TenantIDEQ(tenantID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count workflows failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count workflows failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(workflow.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list workflows failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list workflows failed")
+	}
+
+	return entities, total, nil
+}
+
+// Update updates a workflow. Nil steps leave the steps unchanged.
+func (r *WorkflowRepo) Update(ctx context.Context, id string, name, description *string, steps []*executorV1.WorkflowStep, updatedBy *uint32) (*ent.Workflow, error) {
+	builder := r.entClient.Client().Workflow.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if description != nil {
+		builder.SetDescription(*description)
+	}
+	if steps != nil {
+		builder.SetSteps(WorkflowStepsFromProto(steps))
+	}
+	if updatedBy != nil {
+		builder.SetUpdateBy(*updatedBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, executorV1.ErrorWorkflowNotFound("workflow not found")
+		}
+		r.log.Errorf("update workflow failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("update workflow failed")
+	}
+	return entity, nil
+}
+
+// Delete deletes a workflow
+func (r *WorkflowRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().Workflow.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return executorV1.ErrorWorkflowNotFound("workflow not found")
+		}
+		r.log.Errorf("delete workflow failed: %s", err.Error())
+		return executorV1.ErrorInternalServerError("delete workflow failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.Workflow to executorV1.Workflow
+func (r *WorkflowRepo) ToProto(entity *ent.Workflow) *executorV1.Workflow {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.Workflow{
+		Id:       entity.ID,
+		TenantId: derefUint32(entity.TenantID),
+		Name:     entity.Name,
+		Steps:    WorkflowStepsToProto(entity.Steps),
+	}
+
+	if entity.Description != "" {
+		proto.Description = &entity.Description
+	}
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+
+	return proto
+}
+
+// WorkflowStepsFromProto converts proto steps to their stored form
+func WorkflowStepsFromProto(steps []*executorV1.WorkflowStep) []schema.WorkflowStep {
+	stored := make([]schema.WorkflowStep, 0, len(steps))
+	for _, step := range steps {
+		s := schema.WorkflowStep{
+			ID:               step.Id,
+			Name:             step.GetName(),
+			ScriptID:         step.ScriptId,
+			DependsOn:        step.DependsOn,
+			SuccessExitCodes: step.SuccessExitCodes,
+			OutputPattern:    step.GetOutputPattern(),
+		}
+		switch step.Condition {
+		case executorV1.WorkflowStepCondition_WORKFLOW_STEP_CONDITION_ON_FAILURE:
+			s.Condition = "ON_FAILURE"
+		case executorV1.WorkflowStepCondition_WORKFLOW_STEP_CONDITION_ALWAYS:
+			s.Condition = "ALWAYS"
+		default:
+			s.Condition = "ON_SUCCESS"
+		}
+		stored = append(stored, s)
+	}
+	return stored
+}
+
+// WorkflowStepsToProto converts stored steps to proto
+func WorkflowStepsToProto(steps []schema.WorkflowStep) []*executorV1.WorkflowStep {
+	protos := make([]*executorV1.WorkflowStep, 0, len(steps))
+	for _, s := range steps {
+		step := &executorV1.WorkflowStep{
+			Id:               s.ID,
+			ScriptId:         s.ScriptID,
+			DependsOn:        s.DependsOn,
+			SuccessExitCodes: s.SuccessExitCodes,
+		}
+		switch s.Condition {
+		case "ON_FAILURE":
+			step.Condition = executorV1.WorkflowStepCondition_WORKFLOW_STEP_CONDITION_ON_FAILURE
+		case "ALWAYS":
+			step.Condition = executorV1.WorkflowStepCondition_WORKFLOW_STEP_CONDITION_ALWAYS
+		default:
+			step.Condition = executorV1.WorkflowStepCondition_WORKFLOW_STEP_CONDITION_ON_SUCCESS
+		}
+		if s.Name != "" {
+			name := s.Name
+			step.Name = &name
+		}
+		if s.OutputPattern != "" {
+			pattern := s.OutputPattern
+			step.OutputPattern = &pattern
+		}
+		protos = append(protos, step)
+	}
+	return protos
+}
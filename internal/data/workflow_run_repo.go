@@ -0,0 +1,211 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// WorkflowRunRepo handles database operations for workflow runs
+type WorkflowRunRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewWorkflowRunRepo creates a new WorkflowRunRepo
+func NewWorkflowRunRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WorkflowRunRepo {
+	return &WorkflowRunRepo{
+		log:       ctx.NewLoggerHelper("executor/repo/workflow_run"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a new run of the workflow on a client with a snapshot of its steps
+func (r *WorkflowRunRepo) Create(ctx context.Context, wf *ent.Workflow, clientID string, createdBy *uint32) (*ent.WorkflowRun, error) {
+	builder := r.entClient.Client().WorkflowRun.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(derefUint32(wf.TenantID)).
+		SetWorkflowID(wf.ID).
+		SetWorkflowName(wf.Name).
+		SetClientID(clientID).
+		SetSteps(wf.Steps).
+		SetStartedSteps([]string{}).
+		SetSkippedSteps([]string{}).
+		SetFailedSteps([]string{}).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create workflow run failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("create workflow run failed")
+	}
+	return entity, nil
+}
+
+// GetByID retrieves a workflow run by ID
+func (r *WorkflowRunRepo) GetByID(ctx context.Context, id string) (*ent.WorkflowRun, error) {
+	entity, err := r.entClient.Client().WorkflowRun.Query().
+		Where(workflowrun.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get workflow run failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("get workflow run failed")
+	}
+	return entity, nil
+}
+
+// ListRunning returns the RUNNING workflow runs of all tenants
+func (r *WorkflowRunRepo) ListRunning(ctx context.Context) ([]*ent.WorkflowRun, error) {
+	entities, err := r.entClient.Client().WorkflowRun.Query().
+		Where(workflowrun.StatusEQ(workflowrun.StatusRUNNING)).
+		Order(ent.Asc(workflowrun.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list running workflow runs failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("list workflow runs failed")
+	}
+	return entities, nil
+}
+
+// UpdateSteps records the started, skipped and failed steps of a run. It only
+// applies if the run is RUNNING and still at the given revision, so steps are
+// claimed once even with several replicas advancing the same run.
+func (r *WorkflowRunRepo) UpdateSteps(ctx context.Context, id string, revision int, started, skipped, failed []string) (bool, error) {
+	n, err := r.entClient.Client().WorkflowRun.Update().
+		Where(
+			workflowrun.IDEQ(id),
+			workflowrun.StatusEQ(workflowrun.StatusRUNNING),
+			workflowrun.RevisionEQ(revision),
+		).
+		SetStartedSteps(started).
+		SetSkippedSteps(skipped).
+		SetFailedSteps(failed).
+		AddRevision(1).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("update workflow run steps failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update workflow run failed")
+	}
+	return n > 0, nil
+}
+
+// Finish moves a RUNNING run to its final status. Returns false if the run
+// had already finished.
+func (r *WorkflowRunRepo) Finish(ctx context.Context, id string, status workflowrun.Status) (bool, error) {
+	now := time.Now()
+	n, err := r.entClient.Client().WorkflowRun.Update().
+		Where(
+			workflowrun.IDEQ(id),
+			workflowrun.StatusEQ(workflowrun.StatusRUNNING),
+		).
+		SetStatus(status).
+		SetCompletedAt(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("finish workflow run failed: %s", err.Error())
+		return false, executorV1.ErrorInternalServerError("update workflow run failed")
+	}
+	return n > 0, nil
+}
+
+// ListByTenant lists workflow runs with pagination and filters
+func (r *WorkflowRunRepo) ListByTenant(ctx context.Context, tenantID uint32, workflowID, clientID *string, status *executorV1.WorkflowRunStatus, page, pageSize uint32) ([]*ent.WorkflowRun, int, error) {
+	query := r.entClient.Client().WorkflowRun.Query().
+		Where(workflowrun.TenantIDEQ(tenantID))
+
+	if workflowID != nil && *workflowID != "" {
+		query = query.Where(workflowrun.WorkflowIDEQ(*workflowID))
+	}
+	if clientID != nil && *clientID != "" {
+		query = query.Where(workflowrun.ClientIDEQ(*clientID))
+	}
+	if status != nil {
+		switch *status {
+		case executorV1.WorkflowRunStatus_WORKFLOW_RUN_STATUS_RUNNING:
+			query = query.Where(workflowrun.StatusEQ(workflowrun.StatusRUNNING))
+		case executorV1.WorkflowRunStatus_WORKFLOW_RUN_STATUS_SUCCEEDED:
+			query = query.Where(workflowrun.StatusEQ(workflowrun.StatusSUCCEEDED))
+		case executorV1.WorkflowRunStatus_WORKFLOW_RUN_STATUS_FAILED:
+			query = query.Where(workflowrun.StatusEQ(workflowrun.StatusFAILED))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count workflow runs failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("count workflow runs failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		offset := int((page - 1) * pageSize)
+		query = query.Offset(offset).Limit(int(pageSize))
+	}
+
+	entities, err := query.
+		Order(ent.Desc(workflowrun.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list workflow runs failed: %s", err.Error())
+		return nil, 0, executorV1.ErrorInternalServerError("list workflow runs failed")
+	}
+
+	return entities, total, nil
+}
+
+// ToProto converts an ent.WorkflowRun to executorV1.WorkflowRun
+func (r *WorkflowRunRepo) ToProto(entity *ent.WorkflowRun, states []*executorV1.WorkflowStepState) *executorV1.WorkflowRun {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &executorV1.WorkflowRun{
+		Id:           entity.ID,
+		TenantId:     derefUint32(entity.TenantID),
+		WorkflowId:   entity.WorkflowID,
+		WorkflowName: entity.WorkflowName,
+		ClientId:     entity.ClientID,
+		Steps:        WorkflowStepsToProto(entity.Steps),
+		StepStates:   states,
+	}
+
+	switch entity.Status {
+	case workflowrun.StatusRUNNING:
+		proto.Status = executorV1.WorkflowRunStatus_WORKFLOW_RUN_STATUS_RUNNING
+	case workflowrun.StatusSUCCEEDED:
+		proto.Status = executorV1.WorkflowRunStatus_WORKFLOW_RUN_STATUS_SUCCEEDED
+	case workflowrun.StatusFAILED:
+		proto.Status = executorV1.WorkflowRunStatus_WORKFLOW_RUN_STATUS_FAILED
+	}
+
+	if entity.CreateBy != nil {
+		proto.CreatedBy = entity.CreateBy
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.CompletedAt != nil {
+		proto.CompletedAt = timestamppb.New(*entity.CompletedAt)
+	}
+
+	return proto
+}
@@ -30,6 +30,7 @@ type Collector struct {
 	// Execution metrics
 	ExecutionsTotal    prometheus.Gauge
 	ExecutionsByStatus *prometheus.GaugeVec
+	ExecutionsTimedOut prometheus.Counter
 
 	// gRPC request metrics
 	RequestDuration *prometheus.HistogramVec
@@ -76,6 +77,13 @@ func NewCollector(ctx *bootstrap.Context) *Collector {
 			Help:      "Number of executions by status.",
 		}, []string{"status"}),
 
+		ExecutionsTimedOut: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "executions_timed_out_total",
+			Help:      "Total number of executions moved to TIMED_OUT by the reaper.",
+		}),
+
 		RequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
@@ -98,6 +106,7 @@ func NewCollector(ctx *bootstrap.Context) *Collector {
 		c.AssignmentsTotal,
 		c.ExecutionsTotal,
 		c.ExecutionsByStatus,
+		c.ExecutionsTimedOut,
 		c.RequestDuration,
 		c.RequestsTotal,
 	)
@@ -170,6 +179,12 @@ func (c *Collector) ExecutionStatusChanged(oldStatus, newStatus string) {
 	c.ExecutionsByStatus.WithLabelValues(newStatus).Inc()
 }
 
+// ExecutionTimedOut records an execution that exceeded its timeout.
+func (c *Collector) ExecutionTimedOut(oldStatus string) {
+	c.ExecutionStatusChanged(oldStatus, "TIMED_OUT")
+	c.ExecutionsTimedOut.Inc()
+}
+
 // Middleware returns a Kratos middleware that records gRPC request metrics.
 func (c *Collector) Middleware() middleware.Middleware {
 	return commonMetrics.NewServerMiddleware(c.RequestDuration, c.RequestsTotal)
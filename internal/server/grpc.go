@@ -44,6 +44,15 @@ func NewGRPCServer(
 	clientSvc *service.ClientService,
 	statsSvc *service.StatisticsService,
 	backupSvc *service.BackupService,
+	settingsSvc *service.SettingsService,
+	inventorySvc *service.InventoryService,
+	scheduleSvc *service.ScheduleService,
+	eventRuleSvc *service.EventRuleService,
+	workflowSvc *service.WorkflowService,
+	maintenanceWindowSvc *service.MaintenanceWindowService,
+	secretSvc *service.SecretService,
+	signingKeySvc *service.SigningKeyService,
+	executionApprovalSvc *service.ExecutionApprovalService,
 ) *grpc.Server {
 	cfg := ctx.GetConfig()
 	l := ctx.NewLoggerHelper("executor/grpc")
@@ -119,6 +128,15 @@ func NewGRPCServer(
 	executorV1.RegisterRedactedExecutorClientServiceServer(srv, clientSvc, nil)
 	executorV1.RegisterRedactedExecutorStatisticsServiceServer(srv, statsSvc, nil)
 	executorV1.RegisterRedactedBackupServiceServer(srv, backupSvc, nil)
+	executorV1.RegisterRedactedExecutorSettingsServiceServer(srv, settingsSvc, nil)
+	executorV1.RegisterRedactedExecutorInventoryServiceServer(srv, inventorySvc, nil)
+	executorV1.RegisterRedactedExecutorScheduleServiceServer(srv, scheduleSvc, nil)
+	executorV1.RegisterRedactedExecutorEventRuleServiceServer(srv, eventRuleSvc, nil)
+	executorV1.RegisterRedactedExecutorWorkflowServiceServer(srv, workflowSvc, nil)
+	executorV1.RegisterRedactedExecutorMaintenanceWindowServiceServer(srv, maintenanceWindowSvc, nil)
+	executorV1.RegisterRedactedExecutorSecretServiceServer(srv, secretSvc, nil)
+	executorV1.RegisterRedactedExecutorSigningKeyServiceServer(srv, signingKeySvc, nil)
+	executorV1.RegisterRedactedExecutorExecutionApprovalServiceServer(srv, executionApprovalSvc, nil)
 
 	return srv
 }
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptassignment"
+)
+
+// AssignmentResolver decides whether a script is assigned to a client, either
+// directly or through a label selector or client group. Indirect assignments
+// are evaluated against the labels the assignment's tenant currently has on
+// the client on every check, so machines joining a group pick up its scripts
+// without any upkeep, and only clients a tenant labelled match its selectors.
+type AssignmentResolver struct {
+	log        *log.Helper
+	assignRepo *data.AssignmentRepo
+	clientRepo *data.ClientRepo
+	groupRepo  *data.ClientGroupRepo
+}
+
+// NewAssignmentResolver creates a new AssignmentResolver
+func NewAssignmentResolver(
+	ctx *bootstrap.Context,
+	assignRepo *data.AssignmentRepo,
+	clientRepo *data.ClientRepo,
+	groupRepo *data.ClientGroupRepo,
+) *AssignmentResolver {
+	return &AssignmentResolver{
+		log:        ctx.NewLoggerHelper("executor/service/assignment_resolver"),
+		assignRepo: assignRepo,
+		clientRepo: clientRepo,
+		groupRepo:  groupRepo,
+	}
+}
+
+// IsAssigned reports whether the script is assigned to the client. A nil
+// tenantID checks assignments of any tenant (client-initiated calls over mTLS).
+func (r *AssignmentResolver) IsAssigned(ctx context.Context, tenantID *uint32, scriptID, clientID string) (bool, error) {
+	var direct bool
+	var err error
+	if tenantID == nil {
+		direct, err = r.assignRepo.ExistsAnyTenant(ctx, scriptID, clientID)
+	} else {
+		direct, err = r.assignRepo.Exists(ctx, *tenantID, scriptID, scriptassignment.TargetTypeCLIENT, clientID)
+	}
+	if err != nil || direct {
+		return direct, err
+	}
+
+	indirect, err := r.assignRepo.ListIndirect(ctx, tenantID, &scriptID)
+	if err != nil {
+		return false, err
+	}
+	if len(indirect) == 0 {
+		return false, nil
+	}
+
+	m, err := r.newMatcher(ctx, clientID, indirect)
+	if err != nil {
+		return false, err
+	}
+	for _, a := range indirect {
+		if m.matches(a) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListForClient returns the direct assignments of a client followed by the
+// selector and group assignments that currently match it
+func (r *AssignmentResolver) ListForClient(ctx context.Context, tenantID *uint32, clientID string) ([]*ent.ScriptAssignment, error) {
+	assignments, err := r.assignRepo.ListByClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	indirect, err := r.assignRepo.ListIndirect(ctx, tenantID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(indirect) == 0 {
+		return assignments, nil
+	}
+
+	m, err := r.newMatcher(ctx, clientID, indirect)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range indirect {
+		if m.matches(a) {
+			assignments = append(assignments, a)
+		}
+	}
+	return assignments, nil
+}
+
+// ParameterValues merges the parameter values of the assignments that reach
+// the client with the script: selector assignments first, then group ones,
+// each oldest first, and the direct assignment last so it takes precedence
+func (r *AssignmentResolver) ParameterValues(ctx context.Context, tenantID uint32, scriptID, clientID string) (map[string]string, error) {
+	values := make(map[string]string)
+
+	indirect, err := r.assignRepo.ListIndirect(ctx, &tenantID, &scriptID)
+	if err != nil {
+		return nil, err
+	}
+	indirect = slices.DeleteFunc(indirect, func(a *ent.ScriptAssignment) bool { return len(a.Parameters) == 0 })
+	if len(indirect) > 0 {
+		m, err := r.newMatcher(ctx, clientID, indirect)
+		if err != nil {
+			return nil, err
+		}
+		for _, targetType := range []scriptassignment.TargetType{scriptassignment.TargetTypeSELECTOR, scriptassignment.TargetTypeGROUP} {
+			// Listed newest first
+			for i := len(indirect) - 1; i >= 0; i-- {
+				if a := indirect[i]; a.TargetType == targetType && m.matches(a) {
+					maps.Copy(values, a.Parameters)
+				}
+			}
+		}
+	}
+
+	direct, err := r.assignRepo.GetDirect(ctx, tenantID, scriptID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if direct != nil {
+		maps.Copy(values, direct.Parameters)
+	}
+	return values, nil
+}
+
+// GroupContains reports whether a client with the given labels of the
+// group's tenant belongs to a group
+func (r *AssignmentResolver) GroupContains(group *ent.ClientGroup, clientID string, labels map[string]string) bool {
+	if slices.Contains(group.ClientIds, clientID) {
+		return true
+	}
+	if group.Selector == "" || len(labels) == 0 {
+		return false
+	}
+	sel, err := parseLabelSelector(group.Selector)
+	if err != nil {
+		r.log.Warnf("Ignoring invalid selector %q on client group %s: %v", group.Selector, group.ID, err)
+		return false
+	}
+	return sel.Matches(labels)
+}
+
+// assignmentMatcher evaluates indirect assignments for one client, with the
+// client's labels of each assignment tenant and referenced groups loaded once
+type assignmentMatcher struct {
+	resolver *AssignmentResolver
+	clientID string
+	labels   map[uint32]map[string]string
+	groups   map[string]*ent.ClientGroup
+}
+
+func (r *AssignmentResolver) newMatcher(ctx context.Context, clientID string, assignments []*ent.ScriptAssignment) (*assignmentMatcher, error) {
+	m := &assignmentMatcher{
+		resolver: r,
+		clientID: clientID,
+		labels:   make(map[uint32]map[string]string),
+	}
+
+	var groupIDs []string
+	for _, a := range assignments {
+		if a.TargetType == scriptassignment.TargetTypeGROUP && !slices.Contains(groupIDs, a.GroupID) {
+			groupIDs = append(groupIDs, a.GroupID)
+		}
+
+		tenantID := derefTenantID(a.TenantID)
+		if _, ok := m.labels[tenantID]; ok {
+			continue
+		}
+		labels, err := r.clientRepo.GetLabels(ctx, tenantID, clientID)
+		if err != nil {
+			return nil, err
+		}
+		m.labels[tenantID] = labels
+	}
+	groups, err := r.groupRepo.GetByIDs(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	m.groups = make(map[string]*ent.ClientGroup, len(groups))
+	for _, g := range groups {
+		m.groups[g.ID] = g
+	}
+	return m, nil
+}
+
+func (m *assignmentMatcher) matches(a *ent.ScriptAssignment) bool {
+	switch a.TargetType {
+	case scriptassignment.TargetTypeSELECTOR:
+		// Clients the tenant never labelled are not its clients to select,
+		// even for selectors that only exclude labels
+		labels := m.labels[derefTenantID(a.TenantID)]
+		if len(labels) == 0 {
+			return false
+		}
+		sel, err := parseLabelSelector(a.Selector)
+		if err != nil {
+			m.resolver.log.Warnf("Ignoring invalid selector %q on assignment %s: %v", a.Selector, a.ID, err)
+			return false
+		}
+		return sel.Matches(labels)
+	case scriptassignment.TargetTypeGROUP:
+		group, ok := m.groups[a.GroupID]
+		if !ok || derefTenantID(group.TenantID) != derefTenantID(a.TenantID) {
+			return false
+		}
+		return m.resolver.GroupContains(group, m.clientID, m.labels[derefTenantID(a.TenantID)])
+	default:
+		return a.ClientID == m.clientID
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptassignment"
 
 	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
 )
@@ -19,6 +21,8 @@ type AssignmentService struct {
 	log        *log.Helper
 	assignRepo *data.AssignmentRepo
 	scriptRepo *data.ScriptRepo
+	groupRepo  *data.ClientGroupRepo
+	resolver   *AssignmentResolver
 }
 
 // NewAssignmentService creates a new AssignmentService
@@ -26,19 +30,28 @@ func NewAssignmentService(
 	ctx *bootstrap.Context,
 	assignRepo *data.AssignmentRepo,
 	scriptRepo *data.ScriptRepo,
+	groupRepo *data.ClientGroupRepo,
+	resolver *AssignmentResolver,
 ) *AssignmentService {
 	return &AssignmentService{
 		log:        ctx.NewLoggerHelper("executor/service/assignment"),
 		assignRepo: assignRepo,
 		scriptRepo: scriptRepo,
+		groupRepo:  groupRepo,
+		resolver:   resolver,
 	}
 }
 
-// AssignScript assigns a script to a client
+// AssignScript assigns a script to a client, a label selector or a client group
 func (s *AssignmentService) AssignScript(ctx context.Context, req *executorV1.AssignScriptRequest) (*executorV1.AssignScriptResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
 	createdBy := getUserIDAsUint32(ctx)
 
+	targetType, target, err := s.assignmentTarget(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+
 	// Verify script exists
 	script, err := s.scriptRepo.GetByID(ctx, req.ScriptId)
 	if err != nil {
@@ -48,25 +61,144 @@ func (s *AssignmentService) AssignScript(ctx context.Context, req *executorV1.As
 		return nil, executorV1.ErrorScriptNotFound("script not found")
 	}
 
+	parameters, err := validateParameterValues(script.Parameters, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check for duplicate
-	exists, err := s.assignRepo.Exists(ctx, tenantID, req.ScriptId, req.ClientId)
+	exists, err := s.assignRepo.Exists(ctx, tenantID, req.ScriptId, targetType, target)
 	if err != nil {
 		return nil, err
 	}
 	if exists {
-		return nil, executorV1.ErrorAssignmentAlreadyExists("script is already assigned to this client")
+		return nil, executorV1.ErrorAssignmentAlreadyExists("script is already assigned to this target")
 	}
 
-	entity, err := s.assignRepo.Create(ctx, tenantID, req.ScriptId, req.ClientId, createdBy)
+	entity, err := s.assignRepo.Create(ctx, tenantID, req.ScriptId, targetType, target, parameters, createdBy)
 	if err != nil {
 		return nil, err
 	}
 
 	return &executorV1.AssignScriptResponse{
-		Assignment: s.assignRepo.ToProto(entity),
+		Assignment: s.toProto(entity, script),
+	}, nil
+}
+
+// UpdateAssignment replaces the parameter values of an assignment
+func (s *AssignmentService) UpdateAssignment(ctx context.Context, req *executorV1.UpdateAssignmentRequest) (*executorV1.UpdateAssignmentResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.assignRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != tenantID {
+		return nil, executorV1.ErrorAssignmentNotFound("assignment not found")
+	}
+
+	script, err := s.scriptRepo.GetByID(ctx, entity.ScriptID)
+	if err != nil {
+		return nil, err
+	}
+	if script == nil {
+		return nil, executorV1.ErrorScriptNotFound("script not found")
+	}
+
+	// Secret values come back masked from the UI; keep what is stored
+	values := make(map[string]string, len(req.Parameters))
+	for name, v := range req.Parameters {
+		if stored, ok := entity.Parameters[name]; ok && v == secretParameterMask && isSecretParameter(script.Parameters, name) {
+			v = stored
+		}
+		values[name] = v
+	}
+	parameters, err := validateParameterValues(script.Parameters, values)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.assignRepo.UpdateParameters(ctx, entity.ID, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.UpdateAssignmentResponse{
+		Assignment: s.toProto(updated, script),
 	}, nil
 }
 
+// toProto converts an assignment with the secret values of its script's
+// parameters masked
+func (s *AssignmentService) toProto(entity *ent.ScriptAssignment, script *ent.Script) *executorV1.ScriptAssignment {
+	proto := s.assignRepo.ToProto(entity)
+	if script != nil {
+		proto.Parameters, _ = maskParameterValues(script.Parameters, entity.Parameters)
+		return proto
+	}
+	// Without the script no value is known not to be secret
+	proto.Parameters = make(map[string]string, len(entity.Parameters))
+	for name := range entity.Parameters {
+		proto.Parameters[name] = secretParameterMask
+	}
+	return proto
+}
+
+// assignmentTarget validates that exactly one target is set and returns it
+func (s *AssignmentService) assignmentTarget(ctx context.Context, tenantID uint32, req *executorV1.AssignScriptRequest) (scriptassignment.TargetType, string, error) {
+	set := 0
+	if req.ClientId != "" {
+		set++
+	}
+	if req.Selector != nil {
+		set++
+	}
+	if req.GroupId != nil {
+		set++
+	}
+	if set != 1 {
+		return "", "", executorV1.ErrorBadRequest("exactly one of client_id, selector or group_id must be set")
+	}
+
+	switch {
+	case req.Selector != nil:
+		if err := validateLabelSelector(*req.Selector); err != nil {
+			return "", "", err
+		}
+		return scriptassignment.TargetTypeSELECTOR, *req.Selector, nil
+	case req.GroupId != nil:
+		group, err := s.groupRepo.GetByID(ctx, *req.GroupId)
+		if err != nil {
+			return "", "", err
+		}
+		if group == nil || derefTenantID(group.TenantID) != tenantID {
+			return "", "", executorV1.ErrorClientGroupNotFound("client group not found")
+		}
+		return scriptassignment.TargetTypeGROUP, group.ID, nil
+	default:
+		return scriptassignment.TargetTypeCLIENT, req.ClientId, nil
+	}
+}
+
+// DeleteAssignment removes an assignment of any target type
+func (s *AssignmentService) DeleteAssignment(ctx context.Context, req *executorV1.DeleteAssignmentRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.assignRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != tenantID {
+		return nil, executorV1.ErrorAssignmentNotFound("assignment not found")
+	}
+
+	if err = s.assignRepo.DeleteByID(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // UnassignScript removes a script assignment from a client
 func (s *AssignmentService) UnassignScript(ctx context.Context, req *executorV1.UnassignScriptRequest) (*emptypb.Empty, error) {
 	tenantID := getTenantIDFromContext(ctx)
@@ -85,9 +217,14 @@ func (s *AssignmentService) ListAssignments(ctx context.Context, req *executorV1
 		return nil, err
 	}
 
+	script, err := s.scriptRepo.GetByID(ctx, req.ScriptId)
+	if err != nil {
+		return nil, err
+	}
+
 	assignments := make([]*executorV1.ScriptAssignment, 0, len(entities))
 	for _, e := range entities {
-		assignments = append(assignments, s.assignRepo.ToProto(e))
+		assignments = append(assignments, s.toProto(e, script))
 	}
 
 	return &executorV1.ListAssignmentsResponse{
@@ -95,19 +232,25 @@ func (s *AssignmentService) ListAssignments(ctx context.Context, req *executorV1
 	}, nil
 }
 
-// ListClientScripts lists scripts assigned to a client
+// ListClientScripts lists scripts assigned to a client, including those
+// reaching it through a selector or group
 func (s *AssignmentService) ListClientScripts(ctx context.Context, req *executorV1.ListClientScriptsRequest) (*executorV1.ListClientScriptsResponse, error) {
-	entities, err := s.assignRepo.ListByClientID(ctx, req.ClientId)
+	tenantID := getTenantIDFromContext(ctx)
+
+	entities, err := s.resolver.ListForClient(ctx, &tenantID, req.ClientId)
 	if err != nil {
 		return nil, err
 	}
 
 	assignments := make([]*executorV1.ScriptAssignment, 0, len(entities))
 	for _, e := range entities {
-		proto := s.assignRepo.ToProto(e)
 		// Optionally populate script details
 		script, sErr := s.scriptRepo.GetByID(ctx, e.ScriptID)
-		if sErr == nil && script != nil {
+		if sErr != nil {
+			script = nil
+		}
+		proto := s.toProto(e, script)
+		if script != nil {
 			proto.Script = s.scriptRepo.ToProto(script)
 		}
 		assignments = append(assignments, proto)
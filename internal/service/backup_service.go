@@ -16,9 +16,19 @@ import (
 
 	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/clientgroup"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/protectedclient"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/script"
 	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptassignment"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/scriptversion"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/tenantsetting"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflow"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
 )
 
 const (
@@ -49,10 +59,23 @@ type backupData struct {
 	Data       backupEntities `json:"data"`
 }
 
+// backupEntities holds the exported entities. Secrets are left out: their
+// values are encrypted with this server's key and execution logs only keep
+// their names. Script change requests are left out too; the versions they
+// produced record who approved them.
 type backupEntities struct {
-	Scripts           []json.RawMessage `json:"scripts,omitempty"`
-	ScriptAssignments []json.RawMessage `json:"scriptAssignments,omitempty"`
-	ExecutionLogs     []json.RawMessage `json:"executionLogs,omitempty"`
+	Scripts            []json.RawMessage `json:"scripts,omitempty"`
+	ScriptVersions     []json.RawMessage `json:"scriptVersions,omitempty"`
+	ClientGroups       []json.RawMessage `json:"clientGroups,omitempty"`
+	ScriptAssignments  []json.RawMessage `json:"scriptAssignments,omitempty"`
+	Schedules          []json.RawMessage `json:"schedules,omitempty"`
+	MaintenanceWindows []json.RawMessage `json:"maintenanceWindows,omitempty"`
+	ProtectedClients   []json.RawMessage `json:"protectedClients,omitempty"`
+	EventRules         []json.RawMessage `json:"eventRules,omitempty"`
+	Workflows          []json.RawMessage `json:"workflows,omitempty"`
+	WorkflowRuns       []json.RawMessage `json:"workflowRuns,omitempty"`
+	ExecutionRuns      []json.RawMessage `json:"executionRuns,omitempty"`
+	ExecutionLogs      []json.RawMessage `json:"executionLogs,omitempty"`
 }
 
 func marshalEntities[T any](entities []*T) ([]json.RawMessage, error) {
@@ -87,10 +110,46 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *executorV1.Export
 	if err != nil {
 		return nil, fmt.Errorf("export scripts: %w", err)
 	}
+	scriptVersions, err := s.exportScriptVersions(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export script versions: %w", err)
+	}
+	clientGroups, err := s.exportClientGroups(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export client groups: %w", err)
+	}
 	scriptAssignments, err := s.exportScriptAssignments(ctx, client, tenantID, full)
 	if err != nil {
 		return nil, fmt.Errorf("export script assignments: %w", err)
 	}
+	schedules, err := s.exportSchedules(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export schedules: %w", err)
+	}
+	maintenanceWindows, err := s.exportMaintenanceWindows(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export maintenance windows: %w", err)
+	}
+	protectedClients, err := s.exportProtectedClients(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export protected clients: %w", err)
+	}
+	eventRules, err := s.exportEventRules(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export event rules: %w", err)
+	}
+	workflows, err := s.exportWorkflows(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export workflows: %w", err)
+	}
+	workflowRuns, err := s.exportWorkflowRuns(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export workflow runs: %w", err)
+	}
+	executionRuns, err := s.exportExecutionRuns(ctx, client, tenantID, full)
+	if err != nil {
+		return nil, fmt.Errorf("export execution runs: %w", err)
+	}
 	executionLogs, err := s.exportExecutionLogs(ctx, client, tenantID, full)
 	if err != nil {
 		return nil, fmt.Errorf("export execution logs: %w", err)
@@ -103,9 +162,18 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *executorV1.Export
 		TenantID:   tenantID,
 		FullBackup: full,
 		Data: backupEntities{
-			Scripts:           scripts,
-			ScriptAssignments: scriptAssignments,
-			ExecutionLogs:     executionLogs,
+			Scripts:            scripts,
+			ScriptVersions:     scriptVersions,
+			ClientGroups:       clientGroups,
+			ScriptAssignments:  scriptAssignments,
+			Schedules:          schedules,
+			MaintenanceWindows: maintenanceWindows,
+			ProtectedClients:   protectedClients,
+			EventRules:         eventRules,
+			Workflows:          workflows,
+			WorkflowRuns:       workflowRuns,
+			ExecutionRuns:      executionRuns,
+			ExecutionLogs:      executionLogs,
 		},
 	}
 
@@ -115,9 +183,17 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *executorV1.Export
 	}
 
 	entityCounts := map[string]int64{
-		"scripts":           int64(len(scripts)),
-		"scriptAssignments": int64(len(scriptAssignments)),
-		"executionLogs":     int64(len(executionLogs)),
+		"scripts":            int64(len(scripts)),
+		"scriptVersions":     int64(len(scriptVersions)),
+		"clientGroups":       int64(len(clientGroups)),
+		"scriptAssignments":  int64(len(scriptAssignments)),
+		"schedules":          int64(len(schedules)),
+		"maintenanceWindows": int64(len(maintenanceWindows)),
+		"eventRules":         int64(len(eventRules)),
+		"workflows":          int64(len(workflows)),
+		"workflowRuns":       int64(len(workflowRuns)),
+		"executionRuns":      int64(len(executionRuns)),
+		"executionLogs":      int64(len(executionLogs)),
 	}
 
 	s.log.Infof("exported backup: module=%s tenant=%d full=%v entities=%v", backupModule, tenantID, full, entityCounts)
@@ -160,6 +236,23 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *executorV1.Import
 	}
 
 	client := s.entClient.Client()
+
+	// Restoring scripts would change their content without approval
+	if len(backup.Data.Scripts) > 0 && !isPlatformAdmin {
+		required, err := client.TenantSetting.Query().
+			Where(
+				tenantsetting.TenantIDEQ(tenantID),
+				tenantsetting.RequireScriptApproval(true),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check script approval: %w", err)
+		}
+		if required {
+			return nil, fmt.Errorf("only platform admins can restore scripts while script approval is required")
+		}
+	}
+
 	var results []*executorV1.EntityImportResult
 	var warnings []string
 
@@ -169,7 +262,16 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *executorV1.Import
 		fn    func(context.Context, *ent.Client, []json.RawMessage, uint32, bool, executorV1.RestoreMode) (*executorV1.EntityImportResult, []string)
 	}{
 		{"scripts", backup.Data.Scripts, s.importScripts},
+		{"scriptVersions", backup.Data.ScriptVersions, s.importScriptVersions},
+		{"clientGroups", backup.Data.ClientGroups, s.importClientGroups},
 		{"scriptAssignments", backup.Data.ScriptAssignments, s.importScriptAssignments},
+		{"schedules", backup.Data.Schedules, s.importSchedules},
+		{"maintenanceWindows", backup.Data.MaintenanceWindows, s.importMaintenanceWindows},
+		{"protectedClients", backup.Data.ProtectedClients, s.importProtectedClients},
+		{"eventRules", backup.Data.EventRules, s.importEventRules},
+		{"workflows", backup.Data.Workflows, s.importWorkflows},
+		{"workflowRuns", backup.Data.WorkflowRuns, s.importWorkflowRuns},
+		{"executionRuns", backup.Data.ExecutionRuns, s.importExecutionRuns},
 		{"executionLogs", backup.Data.ExecutionLogs, s.importExecutionLogs},
 	}
 
@@ -207,6 +309,30 @@ func (s *BackupService) exportScripts(ctx context.Context, client *ent.Client, t
 	return marshalEntities(entities)
 }
 
+func (s *BackupService) exportScriptVersions(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.ScriptVersion.Query()
+	if !full {
+		query = query.Where(scriptversion.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
+func (s *BackupService) exportClientGroups(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.ClientGroup.Query()
+	if !full {
+		query = query.Where(clientgroup.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
 func (s *BackupService) exportScriptAssignments(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
 	query := client.ScriptAssignment.Query()
 	if !full {
@@ -219,6 +345,90 @@ func (s *BackupService) exportScriptAssignments(ctx context.Context, client *ent
 	return marshalEntities(entities)
 }
 
+func (s *BackupService) exportSchedules(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.Schedule.Query()
+	if !full {
+		query = query.Where(schedule.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
+func (s *BackupService) exportMaintenanceWindows(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.MaintenanceWindow.Query()
+	if !full {
+		query = query.Where(maintenancewindow.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
+func (s *BackupService) exportProtectedClients(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.ProtectedClient.Query()
+	if !full {
+		query = query.Where(protectedclient.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
+func (s *BackupService) exportEventRules(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.EventRule.Query()
+	if !full {
+		query = query.Where(eventrule.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
+func (s *BackupService) exportWorkflows(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.Workflow.Query()
+	if !full {
+		query = query.Where(workflow.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
+func (s *BackupService) exportWorkflowRuns(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.WorkflowRun.Query()
+	if !full {
+		query = query.Where(workflowrun.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
+func (s *BackupService) exportExecutionRuns(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+	query := client.ExecutionRun.Query()
+	if !full {
+		query = query.Where(executionrun.TenantID(tenantID))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalEntities(entities)
+}
+
 func (s *BackupService) exportExecutionLogs(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
 	query := client.ExecutionLog.Query()
 	if !full {
@@ -264,6 +474,11 @@ func (s *BackupService) importScripts(ctx context.Context, client *ent.Client, i
 				SetContentHash(e.ContentHash).
 				SetVersion(e.Version).
 				SetEnabled(e.Enabled).
+				SetNillableTimeoutSeconds(e.TimeoutSeconds).
+				SetRetryPolicy(e.RetryPolicy).
+				SetSingleton(e.Singleton).
+				SetConcurrencyLimitAction(e.ConcurrencyLimitAction).
+				SetParameters(e.Parameters).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				Save(ctx)
@@ -284,6 +499,11 @@ func (s *BackupService) importScripts(ctx context.Context, client *ent.Client, i
 				SetContentHash(e.ContentHash).
 				SetVersion(e.Version).
 				SetEnabled(e.Enabled).
+				SetNillableTimeoutSeconds(e.TimeoutSeconds).
+				SetRetryPolicy(e.RetryPolicy).
+				SetSingleton(e.Singleton).
+				SetConcurrencyLimitAction(e.ConcurrencyLimitAction).
+				SetParameters(e.Parameters).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				SetNillableCreateTime(e.CreateTime).
@@ -300,6 +520,134 @@ func (s *BackupService) importScripts(ctx context.Context, client *ent.Client, i
 	return result, warnings
 }
 
+func (s *BackupService) importScriptVersions(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "scriptVersions", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.ScriptVersion
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("scriptVersions: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.ScriptVersion.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.ScriptVersion.UpdateOneID(e.ID).
+				SetScriptID(e.ScriptID).
+				SetVersion(e.Version).
+				SetContent(e.Content).
+				SetContentHash(e.ContentHash).
+				SetChangeNote(e.ChangeNote).
+				SetNillableRestoredFromVersion(e.RestoredFromVersion).
+				SetNillableApprovedBy(e.ApprovedBy).
+				SetChangeRequestID(e.ChangeRequestID).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("scriptVersions: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.ScriptVersion.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetScriptID(e.ScriptID).
+				SetVersion(e.Version).
+				SetContent(e.Content).
+				SetContentHash(e.ContentHash).
+				SetChangeNote(e.ChangeNote).
+				SetNillableRestoredFromVersion(e.RestoredFromVersion).
+				SetNillableApprovedBy(e.ApprovedBy).
+				SetChangeRequestID(e.ChangeRequestID).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("scriptVersions: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importClientGroups(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "clientGroups", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.ClientGroup
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("clientGroups: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.ClientGroup.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.ClientGroup.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetSelector(e.Selector).
+				SetClientIds(e.ClientIds).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("clientGroups: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.ClientGroup.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetSelector(e.Selector).
+				SetClientIds(e.ClientIds).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("clientGroups: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
 func (s *BackupService) importScriptAssignments(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
 	result := &executorV1.EntityImportResult{EntityType: "scriptAssignments", Total: int64(len(items))}
 	var warnings []string
@@ -317,6 +665,11 @@ func (s *BackupService) importScriptAssignments(ctx context.Context, client *ent
 			tid = *e.TenantID
 		}
 
+		// Backups taken before selector and group targets only hold client assignments
+		if e.TargetType == "" {
+			e.TargetType = scriptassignment.TargetTypeCLIENT
+		}
+
 		existing, _ := client.ScriptAssignment.Get(ctx, e.ID)
 		if existing != nil {
 			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
@@ -325,7 +678,11 @@ func (s *BackupService) importScriptAssignments(ctx context.Context, client *ent
 			}
 			_, err := client.ScriptAssignment.UpdateOneID(e.ID).
 				SetScriptID(e.ScriptID).
+				SetTargetType(e.TargetType).
 				SetClientID(e.ClientID).
+				SetSelector(e.Selector).
+				SetGroupID(e.GroupID).
+				SetParameters(e.Parameters).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
 			if err != nil {
@@ -339,7 +696,11 @@ func (s *BackupService) importScriptAssignments(ctx context.Context, client *ent
 				SetID(e.ID).
 				SetNillableTenantID(&tid).
 				SetScriptID(e.ScriptID).
+				SetTargetType(e.TargetType).
 				SetClientID(e.ClientID).
+				SetSelector(e.Selector).
+				SetGroupID(e.GroupID).
+				SetParameters(e.Parameters).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
@@ -355,14 +716,14 @@ func (s *BackupService) importScriptAssignments(ctx context.Context, client *ent
 	return result, warnings
 }
 
-func (s *BackupService) importExecutionLogs(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
-	result := &executorV1.EntityImportResult{EntityType: "executionLogs", Total: int64(len(items))}
+func (s *BackupService) importSchedules(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "schedules", Total: int64(len(items))}
 	var warnings []string
 
 	for _, raw := range items {
-		var e ent.ExecutionLog
+		var e ent.Schedule
 		if err := json.Unmarshal(raw, &e); err != nil {
-			warnings = append(warnings, fmt.Sprintf("executionLogs: unmarshal error: %v", err))
+			warnings = append(warnings, fmt.Sprintf("schedules: unmarshal error: %v", err))
 			result.Failed++
 			continue
 		}
@@ -372,6 +733,508 @@ func (s *BackupService) importExecutionLogs(ctx context.Context, client *ent.Cli
 			tid = *e.TenantID
 		}
 
+		existing, _ := client.Schedule.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.Schedule.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetScriptID(e.ScriptID).
+				SetCronExpression(e.CronExpression).
+				SetTimezone(e.Timezone).
+				SetClientIds(e.ClientIds).
+				SetSelector(e.Selector).
+				SetBatchSize(e.BatchSize).
+				SetCanarySize(e.CanarySize).
+				SetBatchPauseSeconds(e.BatchPauseSeconds).
+				SetNillableMaxFailures(e.MaxFailures).
+				SetEnabled(e.Enabled).
+				SetCatchUpPolicy(e.CatchUpPolicy).
+				SetNillableNextRunAt(e.NextRunAt).
+				SetNillableLastRunAt(e.LastRunAt).
+				SetNillableLastRunID(e.LastRunID).
+				SetLastError(e.LastError).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("schedules: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.Schedule.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetScriptID(e.ScriptID).
+				SetCronExpression(e.CronExpression).
+				SetTimezone(e.Timezone).
+				SetClientIds(e.ClientIds).
+				SetSelector(e.Selector).
+				SetBatchSize(e.BatchSize).
+				SetCanarySize(e.CanarySize).
+				SetBatchPauseSeconds(e.BatchPauseSeconds).
+				SetNillableMaxFailures(e.MaxFailures).
+				SetEnabled(e.Enabled).
+				SetCatchUpPolicy(e.CatchUpPolicy).
+				SetNillableNextRunAt(e.NextRunAt).
+				SetNillableLastRunAt(e.LastRunAt).
+				SetNillableLastRunID(e.LastRunID).
+				SetLastError(e.LastError).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("schedules: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importMaintenanceWindows(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "maintenanceWindows", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.MaintenanceWindow
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("maintenanceWindows: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.MaintenanceWindow.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.MaintenanceWindow.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetClientPatterns(e.ClientPatterns).
+				SetCronExpression(e.CronExpression).
+				SetDurationMinutes(e.DurationMinutes).
+				SetTimezone(e.Timezone).
+				SetAction(e.Action).
+				SetEnabled(e.Enabled).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("maintenanceWindows: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.MaintenanceWindow.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetClientPatterns(e.ClientPatterns).
+				SetCronExpression(e.CronExpression).
+				SetDurationMinutes(e.DurationMinutes).
+				SetTimezone(e.Timezone).
+				SetAction(e.Action).
+				SetEnabled(e.Enabled).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("maintenanceWindows: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importProtectedClients(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "protectedClients", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.ProtectedClient
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("protectedClients: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.ProtectedClient.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.ProtectedClient.UpdateOneID(e.ID).
+				SetReason(e.Reason).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("protectedClients: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.ProtectedClient.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetClientID(e.ClientID).
+				SetReason(e.Reason).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				if ent.IsConstraintError(err) {
+					// The client is already protected under another ID
+					result.Skipped++
+					continue
+				}
+				warnings = append(warnings, fmt.Sprintf("protectedClients: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importEventRules(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "eventRules", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.EventRule
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("eventRules: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.EventRule.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.EventRule.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetEventType(e.EventType).
+				SetScriptID(e.ScriptID).
+				SetSelector(e.Selector).
+				SetNillableSourceScriptID(e.SourceScriptID).
+				SetEnabled(e.Enabled).
+				SetNillableLastFiredAt(e.LastFiredAt).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("eventRules: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.EventRule.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetEventType(e.EventType).
+				SetScriptID(e.ScriptID).
+				SetSelector(e.Selector).
+				SetNillableSourceScriptID(e.SourceScriptID).
+				SetEnabled(e.Enabled).
+				SetNillableLastFiredAt(e.LastFiredAt).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("eventRules: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importWorkflows(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "workflows", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.Workflow
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("workflows: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.Workflow.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.Workflow.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetSteps(e.Steps).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("workflows: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.Workflow.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetSteps(e.Steps).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableUpdateBy(e.UpdateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("workflows: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importWorkflowRuns(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "workflowRuns", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.WorkflowRun
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("workflowRuns: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.WorkflowRun.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.WorkflowRun.UpdateOneID(e.ID).
+				SetWorkflowID(e.WorkflowID).
+				SetWorkflowName(e.WorkflowName).
+				SetClientID(e.ClientID).
+				SetSteps(e.Steps).
+				SetStartedSteps(e.StartedSteps).
+				SetSkippedSteps(e.SkippedSteps).
+				SetFailedSteps(e.FailedSteps).
+				SetRevision(e.Revision).
+				SetStatus(e.Status).
+				SetNillableCompletedAt(e.CompletedAt).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("workflowRuns: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.WorkflowRun.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetWorkflowID(e.WorkflowID).
+				SetWorkflowName(e.WorkflowName).
+				SetClientID(e.ClientID).
+				SetSteps(e.Steps).
+				SetStartedSteps(e.StartedSteps).
+				SetSkippedSteps(e.SkippedSteps).
+				SetFailedSteps(e.FailedSteps).
+				SetRevision(e.Revision).
+				SetStatus(e.Status).
+				SetNillableCompletedAt(e.CompletedAt).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("workflowRuns: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importExecutionRuns(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "executionRuns", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.ExecutionRun
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("executionRuns: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, _ := client.ExecutionRun.Get(ctx, e.ID)
+		if existing != nil {
+			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
+				result.Skipped++
+				continue
+			}
+			_, err := client.ExecutionRun.UpdateOneID(e.ID).
+				SetScriptID(e.ScriptID).
+				SetScriptName(e.ScriptName).
+				SetSelector(e.Selector).
+				SetClientIds(e.ClientIds).
+				SetTargets(e.Targets).
+				SetTotal(e.Total).
+				SetDispatched(e.Dispatched).
+				SetBatchSize(e.BatchSize).
+				SetCanarySize(e.CanarySize).
+				SetBatchPauseSeconds(e.BatchPauseSeconds).
+				SetNillableMaxFailures(e.MaxFailures).
+				SetCurrentBatch(e.CurrentBatch).
+				SetNillableNextBatchAt(e.NextBatchAt).
+				SetStatus(e.Status).
+				SetHaltReason(e.HaltReason).
+				SetNillableCompletedAt(e.CompletedAt).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("executionRuns: update %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Updated++
+		} else {
+			_, err := client.ExecutionRun.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetScriptID(e.ScriptID).
+				SetScriptName(e.ScriptName).
+				SetSelector(e.Selector).
+				SetClientIds(e.ClientIds).
+				SetTargets(e.Targets).
+				SetTotal(e.Total).
+				SetDispatched(e.Dispatched).
+				SetBatchSize(e.BatchSize).
+				SetCanarySize(e.CanarySize).
+				SetBatchPauseSeconds(e.BatchPauseSeconds).
+				SetNillableMaxFailures(e.MaxFailures).
+				SetCurrentBatch(e.CurrentBatch).
+				SetNillableNextBatchAt(e.NextBatchAt).
+				SetStatus(e.Status).
+				SetHaltReason(e.HaltReason).
+				SetNillableCompletedAt(e.CompletedAt).
+				SetNillableScheduleID(e.ScheduleID).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("executionRuns: create %s: %v", e.ID, err))
+				result.Failed++
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, warnings
+}
+
+func (s *BackupService) importExecutionLogs(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode executorV1.RestoreMode) (*executorV1.EntityImportResult, []string) {
+	result := &executorV1.EntityImportResult{EntityType: "executionLogs", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		var e ent.ExecutionLog
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("executionLogs: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+		// Backups taken before retries existed carry no attempt number
+		if e.Attempt < 1 {
+			e.Attempt = 1
+		}
+
 		existing, _ := client.ExecutionLog.Get(ctx, e.ID)
 		if existing != nil {
 			if mode == executorV1.RestoreMode_RESTORE_MODE_SKIP {
@@ -392,6 +1255,29 @@ func (s *BackupService) importExecutionLogs(ctx context.Context, client *ent.Cli
 				SetNillableStartedAt(e.StartedAt).
 				SetNillableCompletedAt(e.CompletedAt).
 				SetNillableDurationMs(e.DurationMs).
+				SetNillableCancelRequestedAt(e.CancelRequestedAt).
+				SetNillableCancelledBy(e.CancelledBy).
+				SetCancelReason(e.CancelReason).
+				SetNillableRunID(e.RunID).
+				SetNillableEventRuleID(e.EventRuleID).
+				SetNillableEventType(e.EventType).
+				SetEventDetail(e.EventDetail).
+				SetNillableSourceExecutionID(e.SourceExecutionID).
+				SetNillableWorkflowRunID(e.WorkflowRunID).
+				SetWorkflowStepID(e.WorkflowStepID).
+				SetAttempt(e.Attempt).
+				SetNillableOriginalExecutionID(e.OriginalExecutionID).
+				SetNillableRetryAt(e.RetryAt).
+				SetNillableRetriedBy(e.RetriedBy).
+				SetWaitingForSlot(e.WaitingForSlot).
+				SetNillableHeldUntil(e.HeldUntil).
+				SetMaintenanceOverride(e.MaintenanceOverride).
+				SetParameters(e.Parameters).
+				SetSecretNames(e.SecretNames).
+				SetNillableApprovalExpiresAt(e.ApprovalExpiresAt).
+				SetNillableApprovedBy(e.ApprovedBy).
+				SetNillableApprovedAt(e.ApprovedAt).
+				SetApprovalComment(e.ApprovalComment).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
 			if err != nil {
@@ -417,6 +1303,29 @@ func (s *BackupService) importExecutionLogs(ctx context.Context, client *ent.Cli
 				SetNillableStartedAt(e.StartedAt).
 				SetNillableCompletedAt(e.CompletedAt).
 				SetNillableDurationMs(e.DurationMs).
+				SetNillableCancelRequestedAt(e.CancelRequestedAt).
+				SetNillableCancelledBy(e.CancelledBy).
+				SetCancelReason(e.CancelReason).
+				SetNillableRunID(e.RunID).
+				SetNillableEventRuleID(e.EventRuleID).
+				SetNillableEventType(e.EventType).
+				SetEventDetail(e.EventDetail).
+				SetNillableSourceExecutionID(e.SourceExecutionID).
+				SetNillableWorkflowRunID(e.WorkflowRunID).
+				SetWorkflowStepID(e.WorkflowStepID).
+				SetAttempt(e.Attempt).
+				SetNillableOriginalExecutionID(e.OriginalExecutionID).
+				SetNillableRetryAt(e.RetryAt).
+				SetNillableRetriedBy(e.RetriedBy).
+				SetWaitingForSlot(e.WaitingForSlot).
+				SetNillableHeldUntil(e.HeldUntil).
+				SetMaintenanceOverride(e.MaintenanceOverride).
+				SetParameters(e.Parameters).
+				SetSecretNames(e.SecretNames).
+				SetNillableApprovalExpiresAt(e.ApprovalExpiresAt).
+				SetNillableApprovedBy(e.ApprovedBy).
+				SetNillableApprovedAt(e.ApprovedAt).
+				SetApprovalComment(e.ApprovalComment).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
@@ -2,43 +2,92 @@ package service
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 
 	"github.com/go-tangra/go-tangra-common/middleware/mtls"
+	"github.com/go-tangra/go-tangra-common/viewer"
 	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
 
 	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
 )
 
+const (
+	// connectHeartbeatInterval is how often clients on the Connect stream send heartbeats
+	connectHeartbeatInterval = 15 * time.Second
+	// connectMissedHeartbeats is how many intervals may pass silently before eviction
+	connectMissedHeartbeats = 3
+	// clientLastSeenInterval throttles how often heartbeats update the inventory last-seen time
+	clientLastSeenInterval = time.Minute
+	// maxOutputChunkSize caps a single streamed output chunk
+	maxOutputChunkSize = 64 * 1024
+)
+
 // ClientService implements the ExecutorClientService gRPC service (daemon-facing)
 type ClientService struct {
 	executorV1.UnimplementedExecutorClientServiceServer
 
 	log        *log.Helper
 	scriptRepo *data.ScriptRepo
-	assignRepo *data.AssignmentRepo
+	resolver   *AssignmentResolver
 	execRepo   *data.ExecutionLogRepo
-	cmdReg     *CommandRegistry
+	cmdRepo    *data.CommandRepo
+	outputRepo *data.OutputChunkRepo
+	clientRepo *data.ClientRepo
+	cmdReg     CommandRegistry
+	cmdQueue   *CommandQueue
+	events     *EventEvaluator
+	workflows  *WorkflowEngine
+	retries    *RetryPlanner
+	slots      *ConcurrencyGate
+	secrets    *SecretResolver
+	signer     *CommandSigner
 }
 
 // NewClientService creates a new ClientService
 func NewClientService(
 	ctx *bootstrap.Context,
 	scriptRepo *data.ScriptRepo,
-	assignRepo *data.AssignmentRepo,
+	resolver *AssignmentResolver,
 	execRepo *data.ExecutionLogRepo,
-	cmdReg *CommandRegistry,
+	cmdRepo *data.CommandRepo,
+	outputRepo *data.OutputChunkRepo,
+	clientRepo *data.ClientRepo,
+	cmdReg CommandRegistry,
+	cmdQueue *CommandQueue,
+	events *EventEvaluator,
+	workflows *WorkflowEngine,
+	retries *RetryPlanner,
+	slots *ConcurrencyGate,
+	secrets *SecretResolver,
+	signer *CommandSigner,
 ) *ClientService {
 	return &ClientService{
 		log:        ctx.NewLoggerHelper("executor/service/client"),
 		scriptRepo: scriptRepo,
-		assignRepo: assignRepo,
+		resolver:   resolver,
 		execRepo:   execRepo,
+		cmdRepo:    cmdRepo,
+		outputRepo: outputRepo,
+		clientRepo: clientRepo,
 		cmdReg:     cmdReg,
+		cmdQueue:   cmdQueue,
+		events:     events,
+		workflows:  workflows,
+		retries:    retries,
+		slots:      slots,
+		secrets:    secrets,
+		signer:     signer,
 	}
 }
 
@@ -70,7 +119,9 @@ func getPeerCN(ctx context.Context) string {
 	return tlsInfo.State.PeerCertificates[0].Subject.CommonName
 }
 
-// FetchScript returns script content + hash, validating that the client is assigned
+// FetchScript returns script content + hash, validating that the client is
+// assigned. Clients that ask for it get the execution they run it as reserved
+// here, within the client's concurrency limits.
 func (s *ClientService) FetchScript(ctx context.Context, req *executorV1.FetchScriptRequest) (*executorV1.FetchScriptResponse, error) {
 	clientCN := getClientCN(ctx)
 
@@ -87,22 +138,67 @@ func (s *ClientService) FetchScript(ctx context.Context, req *executorV1.FetchSc
 
 	// Validate assignment — check by mTLS CN
 	if clientCN != "" {
-		assigned, aErr := s.assignRepo.ExistsAnyTenant(ctx, req.ScriptId, clientCN)
+		assigned, aErr := s.resolver.IsAssigned(ctx, nil, req.ScriptId, clientCN)
 		if aErr != nil {
 			return nil, aErr
 		}
 		if !assigned {
 			return nil, executorV1.ErrorClientNotAssigned("script is not assigned to this client")
 		}
+		if err = checkScriptTypeSupported(ctx, s.clientRepo, clientCN, string(script.ScriptType)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Secrets are filled in for the client, so the hash covers the result
+	content, err := s.secrets.RenderContent(ctx, derefTenantID(script.TenantID), script.Content)
+	if err != nil {
+		return nil, err
+	}
+	contentHash := script.ContentHash
+	if content != script.Content {
+		contentHash = ComputeContentHash(content)
+	}
+
+	resp := &executorV1.FetchScriptResponse{
+		ScriptId:    script.ID,
+		ScriptName:  script.Name,
+		ScriptType:  scriptTypeToProto(string(script.ScriptType)),
+		Content:     content,
+		ContentHash: contentHash,
+		Version:     int32(script.Version),
+	}
+	if err = s.signer.SignScript(clientCN, resp); err != nil {
+		return nil, err
+	}
+
+	if clientCN != "" && req.ReserveExecution {
+		execLog, rErr := s.slots.Reserve(ctx, script, clientCN)
+		if rErr != nil {
+			return nil, rErr
+		}
+		// The client runs the script as soon as it has it
+		if _, rErr = s.execRepo.SetStartedAt(ctx, execLog.ID); rErr != nil {
+			return nil, rErr
+		}
+		resp.ExecutionId = execLog.ID
 	}
+	return resp, nil
+}
 
-	return &executorV1.FetchScriptResponse{
-		ScriptId:   script.ID,
-		ScriptName: script.Name,
-		ScriptType: scriptTypeToProto(string(script.ScriptType)),
-		Content:    script.Content,
-		ContentHash: script.ContentHash,
-		Version:    int32(script.Version),
+// GetVerificationKeys returns the public keys commands and scripts are signed with
+func (s *ClientService) GetVerificationKeys(ctx context.Context, _ *executorV1.GetVerificationKeysRequest) (*executorV1.GetVerificationKeysResponse, error) {
+	if !s.signer.Enabled() {
+		return &executorV1.GetVerificationKeysResponse{}, nil
+	}
+
+	keys, err := s.signer.PublishedKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &executorV1.GetVerificationKeysResponse{
+		Keys:           keys,
+		SigningEnabled: true,
 	}, nil
 }
 
@@ -118,38 +214,325 @@ func (s *ClientService) StreamCommands(req *executorV1.StreamCommandsRequest, st
 	s.log.Infof("Client %s (machine-id: %s) connected to command stream (version: %s)", clientID, req.ClientId, req.GetClientVersion())
 
 	ch := s.cmdReg.Register(clientID, req.GetClientVersion())
-	defer func() {
-		s.cmdReg.Unregister(clientID)
-		s.log.Infof("Client %s disconnected from command stream", clientID)
+	defer s.detach(clientID, ch)
+
+	s.recordClient(clientID, req.ClientId, req.GetClientVersion(), req.GetFacts())
+
+	send := s.commandSender(stream.Context(), clientID, stream.Send)
+
+	// Deliver commands that were queued while the client was offline
+	if err := s.cmdQueue.Flush(viewer.NewSystemViewerContext(stream.Context()), clientID, send); err != nil {
+		s.log.Errorf("Failed to deliver queued commands to client %s: %v", clientID, err)
+		return err
+	}
+
+	for {
+		select {
+		case cmd, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := send(cmd); err != nil {
+				s.log.Errorf("Failed to send command to client %s: %v", clientID, err)
+				s.cmdQueue.Requeue(viewer.NewSystemViewerContext(context.Background()), clientID, cmd)
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// Connect opens the bidirectional client channel. The client must send a hello
+// first, then heartbeats and acks; the server pushes commands. A client that
+// stays silent for connectMissedHeartbeats intervals is evicted.
+func (s *ClientService) Connect(stream executorV1.ExecutorClientService_ConnectServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	hello := first.GetHello()
+	if hello == nil {
+		return executorV1.ErrorBadRequest("first message on the connect stream must be a hello")
+	}
+
+	clientID := getPeerCN(stream.Context())
+	if clientID == "" {
+		clientID = hello.GetClientId()
+	}
+	s.log.Infof("Client %s (machine-id: %s) connected (version: %s)", clientID, hello.GetClientId(), hello.GetClientVersion())
+
+	ch := s.cmdReg.Register(clientID, hello.GetClientVersion())
+	defer s.detach(clientID, ch)
+
+	s.recordClient(clientID, hello.GetClientId(), hello.GetClientVersion(), hello.GetFacts())
+
+	if err = stream.Send(&executorV1.ConnectResponse{
+		Payload: &executorV1.ConnectResponse_Accepted{
+			Accepted: &executorV1.ConnectAccepted{
+				HeartbeatIntervalSeconds: int32(connectHeartbeatInterval / time.Second),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	send := s.commandSender(stream.Context(), clientID, func(cmd *executorV1.ExecutionCommand) error {
+		return stream.Send(&executorV1.ConnectResponse{
+			Payload: &executorV1.ConnectResponse_Command{Command: cmd},
+		})
+	})
+
+	// Deliver commands that were queued while the client was offline
+	if err = s.cmdQueue.Flush(viewer.NewSystemViewerContext(stream.Context()), clientID, send); err != nil {
+		s.log.Errorf("Failed to deliver queued commands to client %s: %v", clientID, err)
+		return err
+	}
+
+	// Receive on a separate goroutine so commands can be sent while waiting
+	msgs := make(chan *executorV1.ConnectRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			msg, rErr := stream.Recv()
+			if rErr != nil {
+				recvErr <- rErr
+				return
+			}
+			select {
+			case msgs <- msg:
+			case <-stream.Context().Done():
+				return
+			}
+		}
 	}()
 
+	liveness := time.NewTimer(connectHeartbeatInterval * connectMissedHeartbeats)
+	defer liveness.Stop()
+	lastSeenSaved := time.Now()
+
 	for {
 		select {
 		case cmd, ok := <-ch:
 			if !ok {
 				return nil
 			}
-			if err := stream.Send(cmd); err != nil {
+			if err = send(cmd); err != nil {
 				s.log.Errorf("Failed to send command to client %s: %v", clientID, err)
+				s.cmdQueue.Requeue(viewer.NewSystemViewerContext(context.Background()), clientID, cmd)
 				return err
 			}
+		case msg := <-msgs:
+			if !liveness.Stop() {
+				<-liveness.C
+			}
+			liveness.Reset(connectHeartbeatInterval * connectMissedHeartbeats)
+			s.handleClientMessage(viewer.NewSystemViewerContext(stream.Context()), clientID, msg)
+			if msg.GetHeartbeat() != nil && time.Since(lastSeenSaved) >= clientLastSeenInterval {
+				s.touchClient(clientID)
+				lastSeenSaved = time.Now()
+			}
+		case err = <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case <-liveness.C:
+			s.log.Warnf("Client %s missed %d heartbeats, evicting", clientID, connectMissedHeartbeats)
+			return executorV1.ErrorClientOffline("heartbeat timeout")
 		case <-stream.Context().Done():
 			return stream.Context().Err()
 		}
 	}
 }
 
-// AckCommand acknowledges a command (accepted or rejected)
+// handleClientMessage processes a heartbeat or ack received on the connect stream
+func (s *ClientService) handleClientMessage(ctx context.Context, clientID string, msg *executorV1.ConnectRequest) {
+	switch payload := msg.GetPayload().(type) {
+	case *executorV1.ConnectRequest_Heartbeat:
+		s.cmdReg.Touch(ctx, clientID, ClientHeartbeat{
+			Load1:         payload.Heartbeat.GetLoad1(),
+			Load5:         payload.Heartbeat.GetLoad5(),
+			Load15:        payload.Heartbeat.GetLoad15(),
+			UptimeSeconds: payload.Heartbeat.GetUptimeSeconds(),
+		})
+	case *executorV1.ConnectRequest_Ack:
+		if err := s.ackCommand(ctx, clientID, payload.Ack); err != nil {
+			s.log.Warnf("Failed to process ack for command %s from client %s: %v", payload.Ack.GetCommandId(), clientID, err)
+		}
+	case *executorV1.ConnectRequest_Hello:
+		s.log.Warnf("Ignoring repeated hello from client %s", clientID)
+	}
+}
+
+// commandSender wraps a stream send so secrets are filled into commands and
+// commands are signed for the client right before they go out, and delivered
+// commands are marked as sent
+func (s *ClientService) commandSender(ctx context.Context, clientID string, send func(*executorV1.ExecutionCommand) error) func(*executorV1.ExecutionCommand) error {
+	return func(cmd *executorV1.ExecutionCommand) error {
+		rendered, err := s.renderCommand(viewer.NewSystemViewerContext(ctx), cmd)
+		if err != nil {
+			return err
+		}
+		if rendered == nil {
+			// The execution failed instead; there is nothing to send
+			return nil
+		}
+		signed, err := s.signer.SignCommand(clientID, rendered)
+		if err != nil {
+			return err
+		}
+		if err = send(signed); err != nil {
+			return err
+		}
+		if err := s.cmdRepo.MarkSent(viewer.NewSystemViewerContext(ctx), cmd.GetCommandId()); err != nil {
+			s.log.Errorf("Failed to mark command %s sent: %v", cmd.GetCommandId(), err)
+		}
+		return nil
+	}
+}
+
+// renderCommand fills the secrets a script command references into its
+// content. When they cannot be resolved, as a secret was deleted after the
+// execution was created, the execution fails and nil is returned.
+func (s *ClientService) renderCommand(ctx context.Context, cmd *executorV1.ExecutionCommand) (*executorV1.ExecutionCommand, error) {
+	if cmd.GetCommandType() != executorV1.CommandType_COMMAND_TYPE_SCRIPT_EXECUTION || len(secretRefs(cmd.GetContent())) == 0 {
+		return cmd, nil
+	}
+
+	execLog, err := s.execRepo.GetByID(ctx, cmd.GetExecutionId())
+	if err != nil {
+		return nil, err
+	}
+	if execLog == nil {
+		return nil, executorV1.ErrorExecutionNotFound("execution not found")
+	}
+
+	rendered, err := s.secrets.Render(ctx, derefTenantID(execLog.TenantID), cmd)
+	if err == nil {
+		return rendered, nil
+	}
+	if !executorV1.IsSecretNotFound(err) && !executorV1.IsSecretsNotConfigured(err) {
+		return nil, err
+	}
+
+	reason := "secrets could not be resolved: " + kratosErrors.FromError(err).GetMessage()
+	s.log.Errorf("Execution %s on client %s failed: %s", execLog.ID, execLog.ClientID, reason)
+	if markErr := s.cmdRepo.MarkExpired(ctx, cmd.GetCommandId()); markErr != nil {
+		s.log.Errorf("Failed to mark command %s expired: %v", cmd.GetCommandId(), markErr)
+	}
+	failed, updateErr := s.execRepo.UpdateRejection(ctx, execLog.ID, "FAILED", reason)
+	if updateErr != nil {
+		return nil, updateErr
+	}
+	if !failed {
+		return nil, nil
+	}
+	s.events.ExecutionFailed(execLog)
+	s.workflows.ExecutionFinished(execLog)
+	s.slots.ExecutionFinished(execLog)
+	return nil, nil
+}
+
+// outputMasker returns a function that masks the values of the secrets and
+// secret parameters an execution used in its output
+func (s *ClientService) outputMasker(ctx context.Context, execLog *ent.ExecutionLog) func(string) string {
+	secretValues, err := s.execRepo.SecretParameterValues(execLog)
+	if err != nil {
+		s.log.Errorf("Secret parameters of execution %s are not masked: %v", execLog.ID, err)
+	}
+	return s.secrets.Masker(ctx, derefTenantID(execLog.TenantID), execLog.SecretNames, secretValues)
+}
+
+// detach removes a client's stream from the registry and requeues commands
+// that were buffered for it but never delivered
+func (s *ClientService) detach(clientID string, ch <-chan *executorV1.ExecutionCommand) {
+	s.cmdReg.Unregister(clientID, ch)
+	s.log.Infof("Client %s disconnected from command stream", clientID)
+
+	s.touchClient(clientID)
+
+	ctx := viewer.NewSystemViewerContext(context.Background())
+	for cmd := range ch {
+		s.cmdQueue.Requeue(ctx, clientID, cmd)
+	}
+}
+
+// recordClient creates or refreshes the client's inventory entry and raises
+// the connection events. Failures are logged only; the inventory must never
+// prevent a client from connecting.
+func (s *ClientService) recordClient(clientID, machineID, version string, facts *executorV1.ClientFacts) {
+	ctx := viewer.NewSystemViewerContext(context.Background())
+	entity, previous, err := s.clientRepo.Upsert(ctx, clientID, machineID, version, facts)
+	if err != nil {
+		s.log.Warnf("Failed to record client %s in inventory: %v", clientID, err)
+		return
+	}
+	s.events.ClientConnected(entity, previous)
+}
+
+// touchClient persists the client's last-seen time
+func (s *ClientService) touchClient(clientID string) {
+	ctx := viewer.NewSystemViewerContext(context.Background())
+	if err := s.clientRepo.Touch(ctx, clientID); err != nil {
+		s.log.Warnf("Failed to update last-seen for client %s: %v", clientID, err)
+	}
+}
+
+// AckCommand acknowledges a command (accepted or rejected) and moves the
+// linked execution to RUNNING or to the matching rejection status.
 func (s *ClientService) AckCommand(ctx context.Context, req *executorV1.AckCommandRequest) (*executorV1.AckCommandResponse, error) {
-	if req.Accepted {
-		// Client accepted — it will execute and call ReportResult
-		return &executorV1.AckCommandResponse{Acknowledged: true}, nil
+	if err := s.ackCommand(ctx, getClientCN(ctx), req); err != nil {
+		return nil, err
+	}
+	return &executorV1.AckCommandResponse{Acknowledged: true}, nil
+}
+
+// ackCommand applies a command ack sent by the given client
+func (s *ClientService) ackCommand(ctx context.Context, clientCN string, req *executorV1.AckCommandRequest) error {
+	cmd, err := s.cmdRepo.GetByID(ctx, req.GetCommandId())
+	if err != nil {
+		return err
+	}
+	if cmd == nil {
+		return executorV1.ErrorCommandNotFound("command not found")
+	}
+	if clientCN != "" && clientCN != cmd.ClientID {
+		return executorV1.ErrorForbidden("command was not issued to this client")
+	}
+
+	reason := req.GetRejectionReason()
+	if err = s.cmdRepo.MarkAcked(ctx, cmd.ID, req.GetAccepted(), reason); err != nil {
+		return err
+	}
+
+	// Only script execution commands drive the execution state
+	if cmd.ExecutionID == "" || cmd.CommandType != command.CommandTypeSCRIPT_EXECUTION {
+		return nil
+	}
+
+	execLog, err := s.execRepo.GetByID(ctx, cmd.ExecutionID)
+	if err != nil {
+		return err
+	}
+	if execLog == nil {
+		return executorV1.ErrorExecutionNotFound("execution not found")
+	}
+	if execLog.Status != executionlog.StatusPENDING {
+		s.log.Warnf("Ignoring ack for command %s: execution %s is already %s", cmd.ID, execLog.ID, execLog.Status)
+		return nil
 	}
 
-	// Client rejected — determine the rejection status
-	reason := ""
-	if req.RejectionReason != nil {
-		reason = *req.RejectionReason
+	if req.GetAccepted() {
+		// Client accepted — it will execute and call ReportResult
+		started, err := s.execRepo.SetStartedAt(ctx, execLog.ID)
+		if err != nil {
+			return err
+		}
+		if !started {
+			s.log.Warnf("Ignoring ack for command %s: execution %s moved on in the meantime", cmd.ID, execLog.ID)
+		}
+		return nil
 	}
 
 	status := "REJECTED_NOT_APPROVED"
@@ -157,17 +540,23 @@ func (s *ClientService) AckCommand(ctx context.Context, req *executorV1.AckComma
 		status = "REJECTED_HASH_MISMATCH"
 	}
 
-	// The command_id can be used to map back to an execution log
-	// For now, we use the command_id as the execution_id lookup
-	// The TriggerExecution flow stores execution_id in the command
-	// Client should send the execution_id via a separate field or use command_id
-	// Since our AckCommand only has command_id, we log the rejection
-	s.log.Infof("Command %s rejected by client: %s (reason: %s)", req.CommandId, status, reason)
+	s.log.Infof("Command %s rejected by client: %s (reason: %s)", req.GetCommandId(), status, reason)
 
-	return &executorV1.AckCommandResponse{Acknowledged: true}, nil
+	rejected, err := s.execRepo.UpdateRejection(ctx, execLog.ID, status, reason)
+	if err != nil {
+		return err
+	}
+	if !rejected {
+		s.log.Warnf("Ignoring rejection of command %s: execution %s moved on in the meantime", cmd.ID, execLog.ID)
+		return nil
+	}
+	s.workflows.ExecutionFinished(execLog)
+	s.slots.ExecutionFinished(execLog)
+	return nil
 }
 
-// SubmitExecution creates a complete execution log in one shot (client-pull scenario)
+// SubmitExecution stores the result of a client-pulled execution and frees
+// its concurrency slot
 func (s *ClientService) SubmitExecution(ctx context.Context, req *executorV1.SubmitExecutionRequest) (*executorV1.SubmitExecutionResponse, error) {
 	clientCN := getClientCN(ctx)
 
@@ -182,7 +571,7 @@ func (s *ClientService) SubmitExecution(ctx context.Context, req *executorV1.Sub
 
 	// Validate assignment
 	if clientCN != "" {
-		assigned, aErr := s.assignRepo.ExistsAnyTenant(ctx, req.ScriptId, clientCN)
+		assigned, aErr := s.resolver.IsAssigned(ctx, nil, req.ScriptId, clientCN)
 		if aErr != nil {
 			return nil, aErr
 		}
@@ -191,30 +580,24 @@ func (s *ClientService) SubmitExecution(ctx context.Context, req *executorV1.Sub
 		}
 	}
 
-	// Determine status from exit code
-	status := "COMPLETED"
-	if req.ExitCode != 0 {
-		status = "FAILED"
-	}
-
-	tenantID := uint32(0)
-	if script.TenantID != nil {
-		tenantID = *script.TenantID
-	}
-
-	// Create execution log
-	execLog, err := s.execRepo.Create(
-		ctx, tenantID, script.ID, script.Name,
-		clientCN, script.ContentHash, "CLIENT_PULL", status, nil,
-	)
+	execLog, err := s.submittedExecution(ctx, req, script, clientCN)
 	if err != nil {
 		return nil, err
 	}
 
 	// Store result
-	if err := s.execRepo.UpdateResult(ctx, execLog.ID, int(req.ExitCode), req.Output, req.ErrorOutput, req.DurationMs); err != nil {
+	mask := s.outputMasker(ctx, execLog)
+	recorded, err := s.execRepo.UpdateResult(ctx, execLog.ID, int(req.ExitCode), mask(req.Output), mask(req.ErrorOutput), req.DurationMs)
+	if err != nil {
 		return nil, err
 	}
+	if !recorded {
+		return nil, executorV1.ErrorExecutionStateConflict("execution %s finished before its result arrived", execLog.ID)
+	}
+	if req.ExitCode != 0 {
+		s.events.ExecutionFailed(execLog)
+	}
+	s.slots.ExecutionFinished(execLog)
 
 	return &executorV1.SubmitExecutionResponse{
 		ExecutionId: execLog.ID,
@@ -222,6 +605,107 @@ func (s *ClientService) SubmitExecution(ctx context.Context, req *executorV1.Sub
 	}, nil
 }
 
+// submittedExecution returns the execution a client-pulled result is stored
+// on: the one reserved when the script was fetched, or a new one within the
+// client's concurrency limits for clients that fetched without a reservation
+func (s *ClientService) submittedExecution(ctx context.Context, req *executorV1.SubmitExecutionRequest, script *ent.Script, clientCN string) (*ent.ExecutionLog, error) {
+	if req.ExecutionId == nil {
+		return s.slots.Reserve(ctx, script, clientCN)
+	}
+
+	execLog, err := s.execRepo.GetByID(ctx, req.GetExecutionId())
+	if err != nil {
+		return nil, err
+	}
+	if execLog == nil || execLog.TriggerType != executionlog.TriggerTypeCLIENT_PULL || execLog.ScriptID != script.ID {
+		return nil, executorV1.ErrorExecutionNotFound("execution not found")
+	}
+	if clientCN != "" && clientCN != execLog.ClientID {
+		return nil, executorV1.ErrorForbidden("execution does not belong to this client")
+	}
+	return execLog, nil
+}
+
+// StreamOutput stores stdout/stderr chunks of running executions as the client produces them
+func (s *ClientService) StreamOutput(stream executorV1.ExecutorClientService_StreamOutputServer) error {
+	ctx := viewer.NewSystemViewerContext(stream.Context())
+	clientCN := getPeerCN(stream.Context())
+
+	// Executions already checked on this stream, by ID
+	tenants := make(map[string]uint32)
+	maskers := make(map[string]func(string) string)
+
+	var stored uint32
+	var lastSeq int64
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&executorV1.StreamOutputResponse{
+				ChunksStored: stored,
+				LastSeq:      lastSeq,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		if chunk.GetSeq() <= 0 {
+			return executorV1.ErrorBadRequest("output chunk sequence numbers start at 1")
+		}
+		if chunk.GetStream() != executorV1.OutputStream_OUTPUT_STREAM_STDOUT && chunk.GetStream() != executorV1.OutputStream_OUTPUT_STREAM_STDERR {
+			return executorV1.ErrorBadRequest("output chunk stream must be stdout or stderr")
+		}
+		if len(chunk.GetData()) > maxOutputChunkSize {
+			return executorV1.ErrorBadRequest("output chunk exceeds %d bytes", maxOutputChunkSize)
+		}
+
+		tenantID, ok := tenants[chunk.GetExecutionId()]
+		if !ok {
+			execLog, getErr := s.execRepo.GetByID(ctx, chunk.GetExecutionId())
+			if getErr != nil {
+				return getErr
+			}
+			if execLog == nil {
+				return executorV1.ErrorExecutionNotFound("execution not found")
+			}
+			if clientCN != "" && clientCN != execLog.ClientID {
+				return executorV1.ErrorForbidden("execution does not belong to this client")
+			}
+			tenantID = derefTenantID(execLog.TenantID)
+			tenants[chunk.GetExecutionId()] = tenantID
+			maskers[chunk.GetExecutionId()] = s.outputMasker(ctx, execLog)
+		}
+
+		// A secret split across two chunks stays unmasked in them; the final
+		// output is masked again as a whole
+		chunk.Data = maskers[chunk.GetExecutionId()](chunk.GetData())
+		added, err := s.outputRepo.Append(ctx, tenantID, chunk)
+		if err != nil {
+			return err
+		}
+		if added {
+			stored++
+		}
+		if chunk.GetSeq() > lastSeq {
+			lastSeq = chunk.GetSeq()
+		}
+	}
+}
+
+// finalOutput returns the output to store with a result, with the secrets the
+// execution used masked. Clients that streamed their output may leave it out
+// of the final report; it is assembled from the stored chunks instead.
+func (s *ClientService) finalOutput(ctx context.Context, execLog *ent.ExecutionLog, output, errorOutput string) (string, string, error) {
+	if output == "" && errorOutput == "" {
+		var err error
+		if output, errorOutput, err = s.outputRepo.Assemble(ctx, execLog.ID); err != nil {
+			return "", "", err
+		}
+	}
+	mask := s.outputMasker(ctx, execLog)
+	return mask(output), mask(errorOutput), nil
+}
+
 // ReportResult stores execution results from the client
 func (s *ClientService) ReportResult(ctx context.Context, req *executorV1.ReportResultRequest) (*executorV1.ReportResultResponse, error) {
 	entity, err := s.execRepo.GetByID(ctx, req.ExecutionId)
@@ -231,10 +715,101 @@ func (s *ClientService) ReportResult(ctx context.Context, req *executorV1.Report
 	if entity == nil {
 		return nil, executorV1.ErrorExecutionNotFound("execution not found")
 	}
+	if clientCN := getClientCN(ctx); clientCN != "" && clientCN != entity.ClientID {
+		return nil, executorV1.ErrorForbidden("execution does not belong to this client")
+	}
+
+	// Only executions still waiting on the client can receive a result
+	if entity.Status != executionlog.StatusPENDING && entity.Status != executionlog.StatusRUNNING {
+		s.log.Warnf("Ignoring result for execution %s: already %s", entity.ID, entity.Status)
+		return &executorV1.ReportResultResponse{Recorded: false}, nil
+	}
 
-	if err := s.execRepo.UpdateResult(ctx, req.ExecutionId, int(req.ExitCode), req.Output, req.ErrorOutput, req.DurationMs); err != nil {
+	output, errorOutput, err := s.finalOutput(ctx, entity, req.Output, req.ErrorOutput)
+	if err != nil {
 		return nil, err
 	}
 
+	recorded, err := s.execRepo.UpdateResult(ctx, req.ExecutionId, int(req.ExitCode), output, errorOutput, req.DurationMs)
+	if err != nil {
+		return nil, err
+	}
+	if !recorded {
+		return nil, executorV1.ErrorExecutionStateConflict("execution %s finished before its result arrived", entity.ID)
+	}
+	status := executionlog.StatusCOMPLETED
+	if req.ExitCode != 0 {
+		status = executionlog.StatusFAILED
+	}
+	finishExecution(ctx, entity, status, int(req.ExitCode), s.retries, s.events, s.workflows, s.slots)
+
+	cmd, err := s.cmdRepo.GetLatestByExecutionID(ctx, entity.ID, command.CommandTypeSCRIPT_EXECUTION)
+	if err != nil {
+		s.log.Errorf("Failed to look up command for execution %s: %v", entity.ID, err)
+	} else if cmd != nil {
+		if markErr := s.cmdRepo.MarkCompleted(ctx, cmd.ID); markErr != nil {
+			s.log.Errorf("Failed to mark command %s completed: %v", cmd.ID, markErr)
+		}
+	}
+
 	return &executorV1.ReportResultResponse{Recorded: true}, nil
 }
+
+// finishExecution follows up on an execution that reached a final status on
+// the client: a failure or timeout is retried if its script asks for it, and
+// fires the SCRIPT_FAILED rules once no retry follows. The workflow run and
+// the client's concurrency slot move on either way.
+func finishExecution(ctx context.Context, execLog *ent.ExecutionLog, status executionlog.Status, exitCode int,
+	retries *RetryPlanner, events *EventEvaluator, workflows *WorkflowEngine, slots *ConcurrencyGate) {
+	if status != executionlog.StatusCOMPLETED && !retries.Schedule(ctx, execLog, status, exitCode) {
+		events.ExecutionFailed(execLog)
+	}
+	workflows.ExecutionFinished(execLog)
+	slots.ExecutionFinished(execLog)
+}
+
+// ReportCancelled records that the client terminated an execution after a cancel request
+func (s *ClientService) ReportCancelled(ctx context.Context, req *executorV1.ReportCancelledRequest) (*executorV1.ReportCancelledResponse, error) {
+	entity, err := s.execRepo.GetByID(ctx, req.ExecutionId)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, executorV1.ErrorExecutionNotFound("execution not found")
+	}
+	if clientCN := getClientCN(ctx); clientCN != "" && clientCN != entity.ClientID {
+		return nil, executorV1.ErrorForbidden("execution does not belong to this client")
+	}
+	if entity.CancelRequestedAt == nil {
+		return nil, executorV1.ErrorBadRequest("execution was not cancelled")
+	}
+
+	output, errorOutput, err := s.finalOutput(ctx, entity, req.Output, req.ErrorOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded, err := s.execRepo.ConfirmCancelled(ctx, entity.ID, output, errorOutput, req.DurationMs)
+	if err != nil {
+		return nil, err
+	}
+	if !recorded {
+		s.log.Warnf("Ignoring cancel confirmation for execution %s: no longer active", entity.ID)
+		return &executorV1.ReportCancelledResponse{Recorded: false}, nil
+	}
+
+	s.log.Infof("Execution %s cancelled on client %s", entity.ID, entity.ClientID)
+	s.workflows.ExecutionFinished(entity)
+	s.slots.ExecutionFinished(entity)
+
+	cmd, err := s.cmdRepo.GetLatestByExecutionID(ctx, entity.ID, command.CommandTypeCANCEL)
+	if err != nil {
+		s.log.Errorf("Failed to look up cancel command for execution %s: %v", entity.ID, err)
+	} else if cmd != nil {
+		if markErr := s.cmdRepo.MarkCompleted(ctx, cmd.ID); markErr != nil {
+			s.log.Errorf("Failed to mark command %s completed: %v", cmd.ID, markErr)
+		}
+	}
+
+	return &executorV1.ReportCancelledResponse{Recorded: true}, nil
+}
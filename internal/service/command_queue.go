@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"os"
+	"time"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/queuedcommand"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	defaultCommandTTL       = 24 * time.Hour
+	commandQueueSweepPeriod = time.Minute
+)
+
+// CommandQueue persists execution commands for clients that are not connected
+// and delivers them in order once the client opens its command stream again.
+type CommandQueue struct {
+	log        *log.Helper
+	queueRepo  *data.QueuedCommandRepo
+	cmdRepo    *data.CommandRepo
+	execRepo   *data.ExecutionLogRepo
+	clientRepo *data.ClientRepo
+	retries    *RetryPlanner
+	ttl        time.Duration
+	stop       chan struct{}
+}
+
+// NewCommandQueue creates a new CommandQueue. The TTL of queued commands is
+// read from EXECUTOR_COMMAND_TTL (default 24h).
+func NewCommandQueue(
+	ctx *bootstrap.Context,
+	queueRepo *data.QueuedCommandRepo,
+	cmdRepo *data.CommandRepo,
+	execRepo *data.ExecutionLogRepo,
+	clientRepo *data.ClientRepo,
+	retries *RetryPlanner,
+) *CommandQueue {
+	q := &CommandQueue{
+		log:        ctx.NewLoggerHelper("executor/service/command_queue"),
+		queueRepo:  queueRepo,
+		cmdRepo:    cmdRepo,
+		execRepo:   execRepo,
+		clientRepo: clientRepo,
+		retries:    retries,
+		ttl:        defaultCommandTTL,
+		stop:       make(chan struct{}),
+	}
+
+	if v := os.Getenv("EXECUTOR_COMMAND_TTL"); v != "" {
+		if ttl, err := time.ParseDuration(v); err != nil || ttl <= 0 {
+			q.log.Warnf("Invalid EXECUTOR_COMMAND_TTL %q, using default %s", v, defaultCommandTTL)
+		} else {
+			q.ttl = ttl
+		}
+	}
+
+	return q
+}
+
+// Enqueue stores a command until the client reconnects or the TTL elapses.
+func (q *CommandQueue) Enqueue(ctx context.Context, tenantID uint32, clientID string, cmd *executorV1.ExecutionCommand) error {
+	expiresAt := time.Now().Add(q.ttl)
+	if _, err := q.queueRepo.Enqueue(ctx, tenantID, clientID, cmd, expiresAt); err != nil {
+		return err
+	}
+	q.log.Infof("Queued command %s for offline client %s (expires %s)", cmd.GetCommandId(), clientID, expiresAt.Format(time.RFC3339))
+	return nil
+}
+
+// Requeue puts back an execution command that could not be delivered over an
+// open stream, as long as its execution still needs it: script commands while
+// the execution is pending, cancel commands while it is pending or running.
+func (q *CommandQueue) Requeue(ctx context.Context, clientID string, cmd *executorV1.ExecutionCommand) {
+	if cmd.GetExecutionId() == "" {
+		return
+	}
+
+	execLog, err := q.execRepo.GetByID(ctx, cmd.GetExecutionId())
+	if err != nil || execLog == nil {
+		return
+	}
+	switch cmd.GetCommandType() {
+	case executorV1.CommandType_COMMAND_TYPE_SCRIPT_EXECUTION:
+		if execLog.Status != executionlog.StatusPENDING {
+			return
+		}
+	case executorV1.CommandType_COMMAND_TYPE_CANCEL:
+		if execLog.Status != executionlog.StatusPENDING && execLog.Status != executionlog.StatusRUNNING {
+			return
+		}
+	default:
+		return
+	}
+
+	if err := q.Enqueue(ctx, derefTenantID(execLog.TenantID), clientID, cmd); err != nil {
+		q.log.Errorf("failed to requeue command %s for client %s: %v", cmd.GetCommandId(), clientID, err)
+		return
+	}
+	if err := q.cmdRepo.MarkQueued(ctx, cmd.GetCommandId()); err != nil {
+		q.log.Errorf("failed to mark command %s queued: %v", cmd.GetCommandId(), err)
+	}
+}
+
+// Discard removes a command from the queue before it is delivered
+func (q *CommandQueue) Discard(ctx context.Context, commandID string) error {
+	return q.queueRepo.Delete(ctx, commandID)
+}
+
+// Flush delivers all queued commands for a client in the order they were queued.
+// A command is removed from the queue only after send succeeds; on the first
+// send error the remaining commands stay queued for the next connection.
+// Script commands of a type the client no longer runs fail their execution
+// instead.
+func (q *CommandQueue) Flush(ctx context.Context, clientID string, send func(*executorV1.ExecutionCommand) error) error {
+	pending, err := q.queueRepo.ListPending(ctx, clientID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, entity := range pending {
+		cmd, decodeErr := q.queueRepo.Decode(entity)
+		if decodeErr != nil {
+			_ = q.queueRepo.Delete(ctx, entity.ID)
+			continue
+		}
+
+		if cmd.GetCommandType() == executorV1.CommandType_COMMAND_TYPE_SCRIPT_EXECUTION {
+			checkErr := checkScriptTypeSupported(ctx, q.clientRepo, clientID, scriptTypeToString(cmd.GetScriptType()))
+			if executorV1.IsScriptTypeNotSupported(checkErr) {
+				q.reject(ctx, entity.ID, cmd.GetExecutionId(), kratosErrors.FromError(checkErr).GetMessage())
+				continue
+			}
+			if checkErr != nil {
+				return checkErr
+			}
+		}
+
+		if sendErr := send(cmd); sendErr != nil {
+			return sendErr
+		}
+
+		if delErr := q.queueRepo.Delete(ctx, entity.ID); delErr != nil {
+			q.log.Errorf("failed to remove delivered command %s: %v", entity.ID, delErr)
+		}
+	}
+
+	if len(pending) > 0 {
+		q.log.Infof("Delivered %d queued command(s) to client %s", len(pending), clientID)
+	}
+
+	return nil
+}
+
+// reject drops a queued script command the client cannot run and fails its
+// execution with the given reason
+func (q *CommandQueue) reject(ctx context.Context, commandID, executionID, reason string) {
+	if err := q.queueRepo.Delete(ctx, commandID); err != nil {
+		q.log.Errorf("failed to delete rejected command %s: %v", commandID, err)
+		return
+	}
+	q.log.Warnf("Command %s not delivered: %s", commandID, reason)
+	if err := q.cmdRepo.MarkExpired(ctx, commandID); err != nil {
+		q.log.Errorf("failed to mark command %s expired: %v", commandID, err)
+	}
+	if _, err := q.execRepo.UpdateRejection(ctx, executionID, "FAILED", reason); err != nil {
+		q.log.Errorf("failed to fail execution %s: %v", executionID, err)
+	}
+}
+
+// Start periodically discards expired commands until Stop is called
+func (q *CommandQueue) Start(ctx context.Context) error {
+	ticker := time.NewTicker(commandQueueSweepPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			q.expire(viewer.NewSystemViewerContext(context.Background()))
+		case <-q.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop stops the expiry sweep
+func (q *CommandQueue) Stop(_ context.Context) error {
+	close(q.stop)
+	return nil
+}
+
+// expire drops commands past their TTL and marks their executions as CLIENT_OFFLINE.
+func (q *CommandQueue) expire(ctx context.Context) {
+	expired, err := q.queueRepo.ListExpired(ctx, time.Now())
+	if err != nil {
+		q.log.Errorf("failed to list expired commands: %v", err)
+		return
+	}
+
+	for _, entity := range expired {
+		if err := q.queueRepo.Delete(ctx, entity.ID); err != nil {
+			q.log.Errorf("failed to delete expired command %s: %v", entity.ID, err)
+			continue
+		}
+		q.log.Infof("Command %s for client %s expired before delivery", entity.ID, entity.ClientID)
+		if err := q.cmdRepo.MarkExpired(ctx, entity.ID); err != nil {
+			q.log.Errorf("failed to mark command %s expired: %v", entity.ID, err)
+		}
+
+		if entity.ExecutionID == "" || entity.CommandType != queuedcommand.CommandTypeSCRIPT_EXECUTION {
+			continue
+		}
+		execLog, getErr := q.execRepo.GetByID(ctx, entity.ExecutionID)
+		if getErr != nil || execLog == nil || execLog.Status != executionlog.StatusPENDING {
+			continue
+		}
+		if updateErr := q.execRepo.UpdateStatus(ctx, entity.ExecutionID, "CLIENT_OFFLINE"); updateErr != nil {
+			q.log.Errorf("failed to update execution %s status to CLIENT_OFFLINE: %v", entity.ExecutionID, updateErr)
+			continue
+		}
+		q.retries.Schedule(ctx, execLog, executionlog.StatusCLIENT_OFFLINE, 0)
+	}
+}
+
+// derefTenantID safely dereferences an ent tenant_id pointer
+func derefTenantID(v *uint32) uint32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -1,20 +1,28 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
 	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
 )
 
 const commandChannelBufferSize = 16
 
-// connectedClient holds the command channel and metadata for a connected client.
-type connectedClient struct {
-	ch          chan *executorV1.ExecutionCommand
-	version     string
-	connectedAt time.Time
+const commandSendTimeout = 5 * time.Second
+
+// ClientHeartbeat carries the host stats reported with each heartbeat.
+type ClientHeartbeat struct {
+	Load1         float64 `json:"load1"`
+	Load5         float64 `json:"load5"`
+	Load15        float64 `json:"load15"`
+	UptimeSeconds int64   `json:"uptimeSeconds"`
 }
 
 // ConnectedClientInfo is a read-only snapshot of a connected client's metadata.
@@ -22,53 +30,126 @@ type ConnectedClientInfo struct {
 	ClientID    string
 	Version     string
 	ConnectedAt time.Time
+	LastSeen    time.Time
+	Heartbeat   ClientHeartbeat
+}
+
+// CommandRegistry routes execution commands to clients holding an open command stream.
+type CommandRegistry interface {
+	// Register creates a command channel for a client that opened its stream on this replica.
+	// If the client already has a channel on this replica, it is closed first.
+	Register(clientID, version string) <-chan *executorV1.ExecutionCommand
+
+	// Unregister removes the channel returned by Register. It is a no-op if the
+	// client has since re-registered with a new channel.
+	Unregister(clientID string, ch <-chan *executorV1.ExecutionCommand)
+
+	// Send delivers a command to a connected client.
+	// Returns an error if the client is not connected or cannot accept the command.
+	Send(ctx context.Context, clientID string, cmd *executorV1.ExecutionCommand) error
+
+	// Touch records a heartbeat from a client connected to this replica.
+	Touch(ctx context.Context, clientID string, hb ClientHeartbeat)
+
+	// IsConnected checks whether a client has an active command stream.
+	IsConnected(ctx context.Context, clientID string) bool
+
+	// ListConnected returns a snapshot of all currently connected clients.
+	ListConnected(ctx context.Context) ([]ConnectedClientInfo, error)
+}
+
+// NewCommandRegistry creates the CommandRegistry selected by EXECUTOR_COMMAND_REGISTRY:
+// "memory" (default) keeps clients in-process for single-node setups, "redis" shares
+// presence and routes commands between replicas through Redis pub/sub.
+func NewCommandRegistry(ctx *bootstrap.Context, rdb *redis.Client) (CommandRegistry, func(), error) {
+	switch mode := os.Getenv("EXECUTOR_COMMAND_REGISTRY"); mode {
+	case "", "memory":
+		return NewMemoryCommandRegistry(), func() {}, nil
+	case "redis":
+		if rdb == nil {
+			return nil, nil, fmt.Errorf("redis command registry requires a configured redis client")
+		}
+		reg, err := NewRedisCommandRegistry(ctx, rdb)
+		if err != nil {
+			return nil, nil, err
+		}
+		return reg, reg.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown EXECUTOR_COMMAND_REGISTRY %q", mode)
+	}
+}
+
+// connectedClient holds the command channel and metadata for a connected client.
+type connectedClient struct {
+	ch   chan *executorV1.ExecutionCommand
+	done chan struct{} // closed when the registration ends
+
+	// sendMu is held for reading by sends in flight, so ch is never closed
+	// under a sender
+	sendMu sync.RWMutex
+
+	version     string
+	connectedAt time.Time
+	lastSeen    time.Time
+	heartbeat   ClientHeartbeat
 }
 
-// CommandRegistry manages in-memory command channels for connected clients.
-type CommandRegistry struct {
+// MemoryCommandRegistry manages in-memory command channels for connected clients.
+type MemoryCommandRegistry struct {
 	mu      sync.RWMutex
 	clients map[string]*connectedClient
 }
 
-// NewCommandRegistry creates a new CommandRegistry.
-func NewCommandRegistry() *CommandRegistry {
-	return &CommandRegistry{
+// NewMemoryCommandRegistry creates a new MemoryCommandRegistry.
+func NewMemoryCommandRegistry() *MemoryCommandRegistry {
+	return &MemoryCommandRegistry{
 		clients: make(map[string]*connectedClient),
 	}
 }
 
 // Register creates a buffered channel for the given client.
 // If one already exists, it is closed first.
-func (r *CommandRegistry) Register(clientID, version string) <-chan *executorV1.ExecutionCommand {
+func (r *MemoryCommandRegistry) Register(clientID, version string) <-chan *executorV1.ExecutionCommand {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if old, ok := r.clients[clientID]; ok {
-		close(old.ch)
+		old.close()
 	}
+	now := time.Now()
 	ch := make(chan *executorV1.ExecutionCommand, commandChannelBufferSize)
 	r.clients[clientID] = &connectedClient{
 		ch:          ch,
+		done:        make(chan struct{}),
 		version:     version,
-		connectedAt: time.Now(),
+		connectedAt: now,
+		lastSeen:    now,
 	}
 	return ch
 }
 
 // Unregister closes and removes the channel for the given client.
-func (r *CommandRegistry) Unregister(clientID string) {
+func (r *MemoryCommandRegistry) Unregister(clientID string, ch <-chan *executorV1.ExecutionCommand) {
+	r.unregister(clientID, ch)
+}
+
+// unregister removes the client's channel and reports whether it was still registered.
+func (r *MemoryCommandRegistry) unregister(clientID string, ch <-chan *executorV1.ExecutionCommand) bool {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if c, ok := r.clients[clientID]; ok {
-		close(c.ch)
-		delete(r.clients, clientID)
+	c, ok := r.clients[clientID]
+	if !ok || (<-chan *executorV1.ExecutionCommand)(c.ch) != ch {
+		return false
 	}
+	c.close()
+	delete(r.clients, clientID)
+	return true
 }
 
 // Send sends an execution command to a connected client.
 // Returns an error if the client is not connected or the channel is full.
-func (r *CommandRegistry) Send(clientID string, cmd *executorV1.ExecutionCommand) error {
+func (r *MemoryCommandRegistry) Send(ctx context.Context, clientID string, cmd *executorV1.ExecutionCommand) error {
 	r.mu.RLock()
 	c, ok := r.clients[clientID]
 	r.mu.RUnlock()
@@ -77,16 +158,48 @@ func (r *CommandRegistry) Send(clientID string, cmd *executorV1.ExecutionCommand
 		return fmt.Errorf("client %s not connected", clientID)
 	}
 
+	c.sendMu.RLock()
+	defer c.sendMu.RUnlock()
+
+	select {
+	case <-c.done:
+		return fmt.Errorf("client %s not connected", clientID)
+	default:
+	}
+
 	select {
 	case c.ch <- cmd:
 		return nil
-	case <-time.After(5 * time.Second):
+	case <-c.done:
+		return fmt.Errorf("client %s disconnected", clientID)
+	case <-time.After(commandSendTimeout):
 		return fmt.Errorf("timeout sending command to client %s", clientID)
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
+// Touch updates the client's last-seen time and host stats.
+func (r *MemoryCommandRegistry) Touch(_ context.Context, clientID string, hb ClientHeartbeat) {
+	r.touch(clientID, hb)
+}
+
+// touch updates the client's heartbeat and returns the updated snapshot.
+func (r *MemoryCommandRegistry) touch(clientID string, hb ClientHeartbeat) (ConnectedClientInfo, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.clients[clientID]
+	if !ok {
+		return ConnectedClientInfo{}, false
+	}
+	c.lastSeen = time.Now()
+	c.heartbeat = hb
+	return c.info(clientID), true
+}
+
 // IsConnected checks whether a client has an active channel.
-func (r *CommandRegistry) IsConnected(clientID string) bool {
+func (r *MemoryCommandRegistry) IsConnected(_ context.Context, clientID string) bool {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	_, ok := r.clients[clientID]
@@ -94,17 +207,33 @@ func (r *CommandRegistry) IsConnected(clientID string) bool {
 }
 
 // ListConnected returns a snapshot of all currently connected clients.
-func (r *CommandRegistry) ListConnected() []ConnectedClientInfo {
+func (r *MemoryCommandRegistry) ListConnected(_ context.Context) ([]ConnectedClientInfo, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	result := make([]ConnectedClientInfo, 0, len(r.clients))
 	for id, c := range r.clients {
-		result = append(result, ConnectedClientInfo{
-			ClientID:    id,
-			Version:     c.version,
-			ConnectedAt: c.connectedAt,
-		})
+		result = append(result, c.info(id))
+	}
+	return result, nil
+}
+
+// close ends the registration of a client. Sends blocked on the channel give
+// up once done is closed, then the channel is closed for the stream to drain.
+func (c *connectedClient) close() {
+	close(c.done)
+	c.sendMu.Lock()
+	close(c.ch)
+	c.sendMu.Unlock()
+}
+
+// info builds the snapshot of a connected client.
+func (c *connectedClient) info(clientID string) ConnectedClientInfo {
+	return ConnectedClientInfo{
+		ClientID:    clientID,
+		Version:     c.version,
+		ConnectedAt: c.connectedAt,
+		LastSeen:    c.lastSeen,
+		Heartbeat:   c.heartbeat,
 	}
-	return result
 }
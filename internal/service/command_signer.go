@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/signingkey"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	// signatureTTL is how long a signed command or script stays valid after
+	// it was sent. Signing happens at delivery, so queueing does not count.
+	signatureTTL = 15 * time.Minute
+	// retiredKeyPublishPeriod is how long a replaced key stays published so
+	// clients can still verify what it signed
+	retiredKeyPublishPeriod = 30 * 24 * time.Hour
+	// signingKeyRefreshPeriod is how often the active key is reloaded, to
+	// pick up rotations made by other instances
+	signingKeyRefreshPeriod = time.Minute
+)
+
+// activeSigningKey is the loaded private half of the active key
+type activeSigningKey struct {
+	id        string
+	algorithm executorV1.SigningAlgorithm
+	signer    crypto.Signer
+}
+
+// CommandSigner signs execution commands and fetched script content with the
+// active signing key. Keys live in the database with their private half
+// sealed by the secret key, so signing is disabled without one. A key is
+// generated on first start; EXECUTOR_SIGNING_ALGORITHM picks its algorithm
+// (ED25519 or ECDSA_P256, default ED25519) and EXECUTOR_SIGNING_KEY_ROTATION
+// sets an age after which it is replaced automatically.
+type CommandSigner struct {
+	log         *log.Helper
+	keyRepo     *data.SigningKeyRepo
+	enabled     bool
+	algorithm   executorV1.SigningAlgorithm
+	rotateAfter time.Duration
+
+	mu     sync.RWMutex
+	active *activeSigningKey
+	stop   chan struct{}
+}
+
+// NewCommandSigner creates a new CommandSigner, makes sure an active key
+// exists and starts reloading it periodically
+func NewCommandSigner(ctx *bootstrap.Context, keyRepo *data.SigningKeyRepo, cipher *data.SecretCipher) (*CommandSigner, func(), error) {
+	s := &CommandSigner{
+		log:       ctx.NewLoggerHelper("executor/service/command_signer"),
+		keyRepo:   keyRepo,
+		enabled:   cipher.Configured(),
+		algorithm: executorV1.SigningAlgorithm_SIGNING_ALGORITHM_ED25519,
+		stop:      make(chan struct{}),
+	}
+
+	if v := os.Getenv("EXECUTOR_SIGNING_ALGORITHM"); v != "" {
+		if alg, ok := executorV1.SigningAlgorithm_value["SIGNING_ALGORITHM_"+v]; ok && alg != 0 {
+			s.algorithm = executorV1.SigningAlgorithm(alg)
+		} else {
+			s.log.Warnf("Invalid EXECUTOR_SIGNING_ALGORITHM %q, using ED25519", v)
+		}
+	}
+	if v := os.Getenv("EXECUTOR_SIGNING_KEY_ROTATION"); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			s.log.Warnf("Invalid EXECUTOR_SIGNING_KEY_ROTATION %q, keys are only rotated on request", v)
+		} else {
+			s.rotateAfter = d
+		}
+	}
+
+	if !s.enabled {
+		s.log.Warn("Signing is disabled: it requires EXECUTOR_SECRET_KEY to seal the signing keys")
+		return s, func() {}, nil
+	}
+
+	if err := s.refresh(viewer.NewSystemViewerContext(context.Background())); err != nil {
+		return nil, nil, err
+	}
+	go s.run()
+
+	return s, func() {
+		close(s.stop)
+	}, nil
+}
+
+// Enabled reports whether commands and scripts are signed
+func (s *CommandSigner) Enabled() bool {
+	return s.enabled
+}
+
+// SignCommand returns a copy of the command signed for the given client
+func (s *CommandSigner) SignCommand(clientID string, cmd *executorV1.ExecutionCommand) (*executorV1.ExecutionCommand, error) {
+	if !s.enabled {
+		return cmd, nil
+	}
+
+	signed := proto.Clone(cmd).(*executorV1.ExecutionCommand)
+	signed.Signature = nil
+	now := time.Now()
+	sig, err := s.sign(&executorV1.SignedCommand{
+		Command:   signed,
+		ClientId:  clientID,
+		IssuedAt:  timestamppb.New(now),
+		ExpiresAt: timestamppb.New(now.Add(signatureTTL)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The payload is marshaled already, so the copy can carry the signature
+	signed.Signature = sig
+	return signed, nil
+}
+
+// SignScript signs fetched script content for the given client
+func (s *CommandSigner) SignScript(clientID string, resp *executorV1.FetchScriptResponse) error {
+	if !s.enabled {
+		return nil
+	}
+
+	now := time.Now()
+	sig, err := s.sign(&executorV1.SignedScript{
+		ScriptId:    resp.GetScriptId(),
+		Version:     resp.GetVersion(),
+		ContentHash: ComputeContentHash(resp.GetContent()),
+		ClientId:    clientID,
+		IssuedAt:    timestamppb.New(now),
+		ExpiresAt:   timestamppb.New(now.Add(signatureTTL)),
+	})
+	if err != nil {
+		return err
+	}
+	resp.Signature = sig
+	return nil
+}
+
+// sign marshals the message and signs the bytes with the active key
+func (s *CommandSigner) sign(msg proto.Message) (*executorV1.Signature, error) {
+	s.mu.RLock()
+	key := s.active
+	s.mu.RUnlock()
+	if key == nil {
+		return nil, executorV1.ErrorSigningNotConfigured("no active signing key")
+	}
+
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		s.log.Errorf("marshal signed payload failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("sign failed")
+	}
+
+	var signature []byte
+	switch key.algorithm {
+	case executorV1.SigningAlgorithm_SIGNING_ALGORITHM_ECDSA_P256:
+		digest := sha256.Sum256(payload)
+		signature, err = key.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		signature, err = key.signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	}
+	if err != nil {
+		s.log.Errorf("sign with key %s failed: %s", key.id, err.Error())
+		return nil, executorV1.ErrorInternalServerError("sign failed")
+	}
+
+	return &executorV1.Signature{
+		KeyId:     key.id,
+		Algorithm: key.algorithm,
+		Payload:   payload,
+		Signature: signature,
+	}, nil
+}
+
+// Rotate generates a new active key and retires the current one. Without an
+// algorithm the configured one is used.
+func (s *CommandSigner) Rotate(ctx context.Context, algorithm executorV1.SigningAlgorithm, createdBy *uint32) (*ent.SigningKey, error) {
+	if !s.enabled {
+		return nil, executorV1.ErrorSigningNotConfigured("signing requires a secret key to be configured")
+	}
+
+	current, err := s.keyRepo.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var replaces *string
+	if current != nil {
+		replaces = &current.ID
+	}
+
+	entity, err := s.rotate(ctx, algorithm, replaces, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, executorV1.ErrorSigningKeyConflict("the active signing key was replaced concurrently")
+	}
+	return entity, nil
+}
+
+// rotate generates a new active key replacing the given active key, nil
+// when there is none. Returns nil when that key was replaced in the meantime;
+// the key that replaced it is loaded instead.
+func (s *CommandSigner) rotate(ctx context.Context, algorithm executorV1.SigningAlgorithm, replaces *string, createdBy *uint32) (*ent.SigningKey, error) {
+	if algorithm == executorV1.SigningAlgorithm_SIGNING_ALGORITHM_UNSPECIFIED {
+		algorithm = s.algorithm
+	}
+
+	var priv crypto.Signer
+	var err error
+	var algName string
+	switch algorithm {
+	case executorV1.SigningAlgorithm_SIGNING_ALGORITHM_ED25519:
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+		algName = string(signingkey.AlgorithmED25519)
+	case executorV1.SigningAlgorithm_SIGNING_ALGORITHM_ECDSA_P256:
+		priv, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		algName = string(signingkey.AlgorithmECDSA_P256)
+	default:
+		return nil, executorV1.ErrorBadRequest("unknown signing algorithm %d", algorithm)
+	}
+	if err != nil {
+		s.log.Errorf("generate signing key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("generate signing key failed")
+	}
+
+	pub, err := x509.MarshalPKIXPublicKey(priv.Public())
+	if err != nil {
+		s.log.Errorf("marshal public key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("generate signing key failed")
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		s.log.Errorf("marshal private key failed: %s", err.Error())
+		return nil, executorV1.ErrorInternalServerError("generate signing key failed")
+	}
+
+	sum := sha256.Sum256(pub)
+	entity, err := s.keyRepo.Rotate(ctx, replaces, hex.EncodeToString(sum[:8]), algName, pub, der, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	if entity != nil {
+		s.log.Infof("Signing key %s (%s) is now active", entity.ID, algName)
+	}
+
+	if err = s.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// Revoke revokes a key; the active key is replaced first
+func (s *CommandSigner) Revoke(ctx context.Context, entity *ent.SigningKey, revokedBy *uint32) (*ent.SigningKey, error) {
+	// A key replaced concurrently is no longer active either way
+	if entity.Status == signingkey.StatusACTIVE {
+		if _, err := s.rotate(ctx, executorV1.SigningAlgorithm_SIGNING_ALGORITHM_UNSPECIFIED, &entity.ID, revokedBy); err != nil {
+			return nil, err
+		}
+	}
+	revoked, err := s.keyRepo.Revoke(ctx, entity.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Warnf("Signing key %s revoked", entity.ID)
+	return revoked, nil
+}
+
+// PublishedKeys returns the keys clients verify signatures with
+func (s *CommandSigner) PublishedKeys(ctx context.Context) ([]*executorV1.SigningKey, error) {
+	entities, err := s.keyRepo.ListPublished(ctx, time.Now().Add(-retiredKeyPublishPeriod))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*executorV1.SigningKey, 0, len(entities))
+	for _, e := range entities {
+		keys = append(keys, s.ToProto(e))
+	}
+	return keys, nil
+}
+
+// ToProto converts a key and adds its public key PEM and how long it stays
+// published
+func (s *CommandSigner) ToProto(entity *ent.SigningKey) *executorV1.SigningKey {
+	proto := s.keyRepo.ToProto(entity)
+	proto.PublicKeyPem = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: entity.PublicKey}))
+	if entity.Status == signingkey.StatusRETIRED && entity.RetiredAt != nil {
+		proto.PublishedUntil = timestamppb.New(entity.RetiredAt.Add(retiredKeyPublishPeriod))
+	}
+	return proto
+}
+
+// refresh loads the active key, generating one when there is none or when
+// the active one is due for rotation. Replicas racing to rotate the same key
+// end up on the key of the one that rotated first.
+func (s *CommandSigner) refresh(ctx context.Context) error {
+	entity, err := s.keyRepo.GetActive(ctx)
+	if err != nil {
+		return err
+	}
+	if entity == nil || (s.rotateAfter > 0 && entity.CreateTime != nil && time.Since(*entity.CreateTime) > s.rotateAfter) {
+		var replaces *string
+		if entity != nil {
+			replaces = &entity.ID
+		}
+		// rotate reloads the active key itself
+		_, err = s.rotate(ctx, executorV1.SigningAlgorithm_SIGNING_ALGORITHM_UNSPECIFIED, replaces, nil)
+		return err
+	}
+
+	s.mu.RLock()
+	current := s.active
+	s.mu.RUnlock()
+	if current != nil && current.id == entity.ID {
+		return nil
+	}
+
+	der, err := s.keyRepo.PrivateKey(entity)
+	if err != nil {
+		return err
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		s.log.Errorf("parse signing key %s failed: %s", entity.ID, err.Error())
+		return executorV1.ErrorInternalServerError("parse signing key failed")
+	}
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return executorV1.ErrorInternalServerError("signing key %s cannot sign", entity.ID)
+	}
+
+	algorithm := executorV1.SigningAlgorithm_SIGNING_ALGORITHM_ED25519
+	if entity.Algorithm == signingkey.AlgorithmECDSA_P256 {
+		algorithm = executorV1.SigningAlgorithm_SIGNING_ALGORITHM_ECDSA_P256
+	}
+
+	s.mu.Lock()
+	s.active = &activeSigningKey{id: entity.ID, algorithm: algorithm, signer: signer}
+	s.mu.Unlock()
+	s.log.Infof("Signing with key %s", entity.ID)
+	return nil
+}
+
+// run reloads the active key until the signer is stopped
+func (s *CommandSigner) run() {
+	ticker := time.NewTicker(signingKeyRefreshPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.refresh(viewer.NewSystemViewerContext(context.Background())); err != nil {
+				s.log.Errorf("failed to refresh signing key: %v", err)
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
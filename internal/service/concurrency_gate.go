@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const concurrencyGatePeriod = 10 * time.Second
+
+// ConcurrencyGate starts executions that wait for a concurrency slot on their
+// client. Slots are released as soon as an execution reports its end, and on
+// a periodic sweep, which also catches executions that timed out, went
+// offline or were cancelled.
+type ConcurrencyGate struct {
+	log          *log.Helper
+	execSvc      *ExecutionService
+	execRepo     *data.ExecutionLogRepo
+	scriptRepo   *data.ScriptRepo
+	settingsRepo *data.TenantSettingRepo
+	stop         chan struct{}
+}
+
+// NewConcurrencyGate creates a new ConcurrencyGate
+func NewConcurrencyGate(
+	ctx *bootstrap.Context,
+	execSvc *ExecutionService,
+	execRepo *data.ExecutionLogRepo,
+	scriptRepo *data.ScriptRepo,
+	settingsRepo *data.TenantSettingRepo,
+) *ConcurrencyGate {
+	return &ConcurrencyGate{
+		log:          ctx.NewLoggerHelper("executor/service/concurrency_gate"),
+		execSvc:      execSvc,
+		execRepo:     execRepo,
+		scriptRepo:   scriptRepo,
+		settingsRepo: settingsRepo,
+		stop:         make(chan struct{}),
+	}
+}
+
+// Start runs the gate loop until Stop is called
+func (g *ConcurrencyGate) Start(ctx context.Context) error {
+	ticker := time.NewTicker(concurrencyGatePeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			g.sweep(viewer.NewSystemViewerContext(context.Background()))
+		case <-g.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop stops the gate loop
+func (g *ConcurrencyGate) Stop(_ context.Context) error {
+	close(g.stop)
+	return nil
+}
+
+// Reserve creates a client-pulled execution of the script, which holds a
+// slot on the client until its result is reported. Returns
+// CONCURRENCY_LIMIT_REACHED if the client may not start the script right now;
+// client-pulled executions are never queued.
+func (g *ConcurrencyGate) Reserve(ctx context.Context, script *ent.Script, clientID string) (*ent.ExecutionLog, error) {
+	tenantID := derefTenantID(script.TenantID)
+	limits, err := resolveConcurrencyLimits(ctx, g.settingsRepo, tenantID, script)
+	if err != nil {
+		return nil, err
+	}
+	limits.Queue = false
+	// Without a client ID there is no client to limit
+	if clientID == "" {
+		limits = data.ConcurrencyLimits{}
+	}
+
+	// The client fetches the script with its secrets filled in
+	var origin *data.ExecutionOrigin
+	if names := secretRefs(script.Content); len(names) > 0 {
+		origin = &data.ExecutionOrigin{SecretNames: names}
+	}
+
+	return g.execRepo.CreateWithinLimits(ctx, tenantID, script.ID, script.Name, clientID, script.ContentHash, "CLIENT_PULL", nil, origin, limits)
+}
+
+// sweep releases slots on every client with waiting executions
+func (g *ConcurrencyGate) sweep(ctx context.Context) {
+	clientIDs, err := g.execRepo.ListWaitingClients(ctx)
+	if err != nil {
+		g.log.Errorf("failed to list clients with waiting executions: %v", err)
+		return
+	}
+	for _, clientID := range clientIDs {
+		g.release(ctx, clientID)
+	}
+}
+
+// ExecutionFinished starts the executions waiting on the client of a finished
+// execution in the background
+func (g *ConcurrencyGate) ExecutionFinished(execLog *ent.ExecutionLog) {
+	clientID := execLog.ClientID
+	go g.release(viewer.NewSystemViewerContext(context.Background()), clientID)
+}
+
+// ExecutionApproved starts an approved execution if its client has a free slot
+func (g *ConcurrencyGate) ExecutionApproved(ctx context.Context, execLog *ent.ExecutionLog) {
+	g.release(ctx, execLog.ClientID)
+}
+
+// release starts as many waiting executions of the client as its limits allow
+func (g *ConcurrencyGate) release(ctx context.Context, clientID string) {
+	scripts := make(map[string]*ent.Script)
+	script := func(id string) *ent.Script {
+		if s, ok := scripts[id]; ok {
+			return s
+		}
+		s, err := g.scriptRepo.GetByID(ctx, id)
+		if err != nil {
+			g.log.Errorf("failed to load script %s: %v", id, err)
+		}
+		scripts[id] = s
+		return s
+	}
+
+	waiting, err := g.execRepo.ListWaitingByClient(ctx, clientID)
+	if err != nil || len(waiting) == 0 {
+		return
+	}
+	maxPerClient, err := g.settingsRepo.GetMaxConcurrentPerClient(ctx, derefTenantID(waiting[0].TenantID))
+	if err != nil {
+		return
+	}
+
+	admitted, err := g.execRepo.AdmitWaiting(ctx, clientID, maxPerClient, func(scriptID string) bool {
+		s := script(scriptID)
+		return s != nil && s.Singleton
+	})
+	if err != nil {
+		return
+	}
+
+	for _, e := range admitted {
+		g.start(ctx, script(e.ScriptID), e)
+	}
+}
+
+// start sends an execution that got a slot to its client
+func (g *ConcurrencyGate) start(ctx context.Context, script *ent.Script, e *ent.ExecutionLog) {
+	if script == nil || !script.Enabled {
+		g.log.Warnf("Execution %s got a slot but its script %s is missing or disabled", e.ID, e.ScriptID)
+		if _, err := g.execRepo.MarkCancelled(ctx, e.ID, nil, "script was removed or disabled while waiting for a slot"); err != nil {
+			g.log.Errorf("failed to cancel execution %s: %v", e.ID, err)
+		}
+		return
+	}
+
+	tenantID := derefTenantID(e.TenantID)
+	timeoutSeconds, err := resolveTimeoutSeconds(ctx, g.settingsRepo, tenantID, script)
+	if err != nil {
+		g.log.Errorf("failed to resolve timeout for execution %s: %v", e.ID, err)
+		return
+	}
+
+	if _, queued, err := g.execSvc.deliver(ctx, tenantID, script, e, timeoutSeconds); err != nil {
+		g.log.Errorf("failed to send execution %s to client %s: %v", e.ID, e.ClientID, err)
+	} else {
+		g.log.Infof("Execution %s got a slot on client %s (queued: %t)", e.ID, e.ClientID, queued)
+	}
+}
+
+// resolveConcurrencyLimits returns the limits that apply to executions of the script
+func resolveConcurrencyLimits(ctx context.Context, settingsRepo *data.TenantSettingRepo, tenantID uint32, script *ent.Script) (data.ConcurrencyLimits, error) {
+	maxPerClient, err := settingsRepo.GetMaxConcurrentPerClient(ctx, tenantID)
+	if err != nil {
+		return data.ConcurrencyLimits{}, err
+	}
+	return data.ConcurrencyLimits{
+		MaxPerClient: maxPerClient,
+		Singleton:    script.Singleton,
+		Queue:        script.ConcurrencyLimitAction == "QUEUE",
+	}, nil
+}
+
+// validateConcurrencyLimitAction checks a concurrency limit action sent by a client
+func validateConcurrencyLimitAction(action executorV1.ConcurrencyLimitAction) error {
+	if _, ok := executorV1.ConcurrencyLimitAction_name[int32(action)]; !ok {
+		return executorV1.ErrorBadRequest("unknown concurrency_limit_action %d", action)
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed 5-field cron expression: minute, hour, day of
+// month, month and day of week. Each field is a bit set of allowed values.
+type cronSchedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// Unrestricted day fields; when both day fields are restricted a day
+	// matching either one qualifies, as in Vixie cron
+	domStar bool
+	dowStar bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is accepted as Sunday and folded onto 0
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronAllHours is the hour bit set of "*"
+const cronAllHours = 1<<24 - 1
+
+// cronSearchYears bounds the search for the next occurrence, so expressions
+// that can never match (such as 30 February) end instead of looping forever
+const cronSearchYears = 5
+
+// parseCron parses a standard 5-field cron expression or one of the @ macros
+func parseCron(expr string) (*cronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	c := &cronSchedule{
+		domStar: fields[2] == "*" || fields[2] == "?",
+		dowStar: fields[4] == "*" || fields[4] == "?",
+	}
+	var err error
+	if c.minute, err = parseCronField(fields[0], cronMinute); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseCronField(fields[1], cronHour); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseCronField(fields[2], cronDom); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseCronField(fields[3], cronMonth); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseCronField(fields[4], cronDow); err != nil {
+		return nil, err
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+	return c, nil
+}
+
+// parseCronField parses a comma-separated list of "*", values, ranges and steps
+func parseCronField(s string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(s, ",") {
+		rangePart, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			n, err := strconv.Atoi(part[idx+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, part)
+			}
+			rangePart, step = part[:idx], n
+		}
+
+		var lo, hi int
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			lo, hi = f.min, f.max
+			if f.max == 7 {
+				hi = 6
+			}
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseCronValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseCronValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range in %s field %q", f.name, part)
+			}
+		default:
+			v, err := parseCronValue(rangePart, f)
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = v, v
+			// "5/15" means every 15 starting at 5
+			if step > 1 {
+				hi = f.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, f cronField) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s value %d out of range %d-%d", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the first occurrence strictly after t, in t's location.
+// Returns the zero time if there is none within cronSearchYears.
+func (c *cronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + cronSearchYears
+
+	// Field values are bumped from the largest to the smallest; whenever a
+	// larger field wraps the search starts over from the month
+	advanced := false
+	for t.Year() <= yearLimit {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			if !advanced {
+				advanced = true
+				t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+			}
+			t = t.AddDate(0, 1, 0)
+			continue
+		}
+
+		if !c.dayMatches(t) {
+			if !advanced {
+				advanced = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+			}
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !c.hourMatches(t) {
+			if !advanced {
+				advanced = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+			}
+			day := t.Day()
+			t = t.Add(time.Hour)
+			if t.Day() != day {
+				// Restart at midnight in case a DST shift skipped it
+				t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+			}
+			continue
+		}
+
+		if c.minute&(1<<uint(t.Minute())) == 0 || c.repeatedHour(t) {
+			if !advanced {
+				advanced = true
+				t = t.Truncate(time.Minute)
+			}
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+	return time.Time{}
+}
+
+// hourMatches reports whether t's hour is allowed. Hours skipped when clocks
+// move forward are run in the first hour after the gap instead of being lost.
+func (c *cronSchedule) hourMatches(t time.Time) bool {
+	if c.hour&(1<<uint(t.Hour())) != 0 {
+		return true
+	}
+	prev := t.Add(-time.Hour).Hour()
+	if (t.Hour()-prev+24)%24 <= 1 {
+		return false
+	}
+	for h := (prev + 1) % 24; h != t.Hour(); h = (h + 1) % 24 {
+		if c.hour&(1<<uint(h)) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// repeatedHour reports whether t falls in the second pass of an hour repeated
+// when clocks move back. Expressions with a fixed hour run only in the first
+// pass; hourly and finer expressions keep running through both.
+func (c *cronSchedule) repeatedHour(t time.Time) bool {
+	if c.hour == cronAllHours {
+		return false
+	}
+	return t.Add(-time.Hour).Hour() == t.Hour()
+}
+
+// dayMatches applies the day-of-month and day-of-week fields
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// diffContext is how many unchanged lines surround each hunk
+	diffContext = 3
+	// diffMaxEdits bounds the search for the shortest edit script; contents
+	// that differ in more lines are shown as replaced as a whole
+	diffMaxEdits = 2000
+)
+
+// diffOp is one line of an edit script
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// unifiedDiff compares two contents line by line and returns a unified diff
+// with the given file labels, plus the number of added and removed lines.
+// The diff is empty when the contents are equal.
+func unifiedDiff(fromLabel, toLabel, from, to string) (string, int, int) {
+	if from == to {
+		return "", 0, 0
+	}
+
+	ops := diffLines(splitLines(from), splitLines(to))
+
+	added, removed := 0, 0
+	for _, op := range ops {
+		switch op.kind {
+		case '+':
+			added++
+		case '-':
+			removed++
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromLabel, toLabel)
+	writeHunks(&sb, ops)
+	return sb.String(), added, removed
+}
+
+// splitLines splits content into lines without their line breaks
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines returns the shortest edit script turning a into b, found with
+// Myers' algorithm
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	maxD := min(n+m, diffMaxEdits)
+
+	// trace[d] holds the furthest x reached on each diagonal k after d edits,
+	// indexed by k+d
+	var trace [][]int
+	v := []int{0}
+	found := false
+	for d := 0; d <= maxD && !found; d++ {
+		next := make([]int, 2*d+1)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && prevX(v, d-1, k-1) < prevX(v, d-1, k+1)) {
+				x = prevX(v, d-1, k+1)
+			} else {
+				x = prevX(v, d-1, k-1) + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			next[k+d] = x
+			if x >= n && y >= m {
+				found = true
+			}
+		}
+		trace = append(trace, next)
+		v = next
+	}
+
+	if !found {
+		ops := make([]diffOp, 0, n+m)
+		for _, l := range a {
+			ops = append(ops, diffOp{'-', l})
+		}
+		for _, l := range b {
+			ops = append(ops, diffOp{'+', l})
+		}
+		return ops
+	}
+
+	// Walk the trace back from the end to recover the edits
+	var ops []diffOp
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && prevX(trace[d-1], d-1, k-1) < prevX(trace[d-1], d-1, k+1)) {
+			prevK = k + 1
+		}
+		px := prevX(trace[d-1], d-1, prevK)
+		py := px - prevK
+		for x > px && y > py {
+			x--
+			y--
+			ops = append(ops, diffOp{' ', a[x]})
+		}
+		if x == px {
+			y--
+			ops = append(ops, diffOp{'+', b[y]})
+		} else {
+			x--
+			ops = append(ops, diffOp{'-', a[x]})
+		}
+	}
+	for x > 0 {
+		x--
+		ops = append(ops, diffOp{' ', a[x]})
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// prevX reads diagonal k of the round d vector
+func prevX(v []int, d, k int) int {
+	if d < 0 {
+		return 0
+	}
+	return v[k+d]
+}
+
+// writeHunks writes the edit script as unified diff hunks
+func writeHunks(sb *strings.Builder, ops []diffOp) {
+	i := 0
+	for i < len(ops) {
+		// Find the next change
+		for i < len(ops) && ops[i].kind == ' ' {
+			i++
+		}
+		if i == len(ops) {
+			return
+		}
+
+		start := max(i-diffContext, 0)
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			// A run of unchanged lines longer than twice the context ends the hunk
+			run := end
+			for run < len(ops) && ops[run].kind == ' ' {
+				run++
+			}
+			if run == len(ops) || run-end > 2*diffContext {
+				end = min(end+diffContext, len(ops))
+				break
+			}
+			end = run
+		}
+
+		fromStart, toStart := 1, 1
+		for _, op := range ops[:start] {
+			if op.kind != '+' {
+				fromStart++
+			}
+			if op.kind != '-' {
+				toStart++
+			}
+		}
+		fromCount, toCount := 0, 0
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				fromCount++
+			}
+			if op.kind != '-' {
+				toCount++
+			}
+		}
+		if fromCount == 0 {
+			fromStart--
+		}
+		if toCount == 0 {
+			toStart--
+		}
+
+		fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", fromStart, fromCount, toStart, toCount)
+		for _, op := range ops[start:end] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.line)
+			sb.WriteByte('\n')
+		}
+		i = end
+	}
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// maxEventDetailLen is the length of the execution log's event_detail column
+const maxEventDetailLen = 1024
+
+// EventEvaluator runs the scripts of event rules when executor events happen
+// to a client. Events are evaluated in the background so rules can never slow
+// down or fail the client call that raised them.
+//
+// Clients are shared between tenants, so connection events fire the rules of
+// every tenant; label changes and failed executions only fire the rules of
+// their own tenant. Rule selectors match the labels of the rule's tenant, and
+// a rule only runs its script on clients it is assigned to in that tenant.
+type EventEvaluator struct {
+	log        *log.Helper
+	execSvc    *ExecutionService
+	ruleRepo   *data.EventRuleRepo
+	scriptRepo *data.ScriptRepo
+	clientRepo *data.ClientRepo
+	resolver   *AssignmentResolver
+}
+
+// NewEventEvaluator creates a new EventEvaluator
+func NewEventEvaluator(
+	ctx *bootstrap.Context,
+	execSvc *ExecutionService,
+	ruleRepo *data.EventRuleRepo,
+	scriptRepo *data.ScriptRepo,
+	clientRepo *data.ClientRepo,
+	resolver *AssignmentResolver,
+) *EventEvaluator {
+	return &EventEvaluator{
+		log:        ctx.NewLoggerHelper("executor/service/event_evaluator"),
+		execSvc:    execSvc,
+		ruleRepo:   ruleRepo,
+		scriptRepo: scriptRepo,
+		clientRepo: clientRepo,
+		resolver:   resolver,
+	}
+}
+
+// ClientConnected raises the events of a client connection. previous is the
+// inventory entry before the connection, nil when the client is new.
+func (e *EventEvaluator) ClientConnected(client, previous *ent.ManagedClient) {
+	switch {
+	case previous == nil:
+		go e.fire(nil, eventrule.EventTypeCLIENT_FIRST_CONNECT, client, &executorV1.ExecutionEvent{
+			Type:   executorV1.EventType_EVENT_TYPE_CLIENT_FIRST_CONNECT,
+			Detail: eventDetail("client %s connected for the first time", client.ClientID),
+		}, nil)
+	case previous.ClientVersion != client.ClientVersion:
+		go e.fire(nil, eventrule.EventTypeCLIENT_VERSION_CHANGED, client, &executorV1.ExecutionEvent{
+			Type:   executorV1.EventType_EVENT_TYPE_CLIENT_VERSION_CHANGED,
+			Detail: eventDetail("agent version changed from %q to %q", previous.ClientVersion, client.ClientVersion),
+		}, nil)
+	}
+}
+
+// ClientLabelsChanged raises the event of an operator of a tenant changing
+// the labels it set on a client
+func (e *EventEvaluator) ClientLabelsChanged(tenantID uint32, client *ent.ManagedClient, labels, previous map[string]string) {
+	if maps.Equal(previous, labels) {
+		return
+	}
+	go e.fire(&tenantID, eventrule.EventTypeCLIENT_LABELS_CHANGED, client, &executorV1.ExecutionEvent{
+		Type:   executorV1.EventType_EVENT_TYPE_CLIENT_LABELS_CHANGED,
+		Detail: eventDetail("labels changed from %v to %v", previous, labels),
+	}, nil)
+}
+
+// ExecutionFailed raises the event of a script failing on a client
+func (e *EventEvaluator) ExecutionFailed(execLog *ent.ExecutionLog) {
+	// Remediation scripts that fail themselves do not fire further rules,
+	// so rules cannot trigger each other in a loop
+	if execLog.EventType != nil && *execLog.EventType == executionlog.EventTypeSCRIPT_FAILED {
+		return
+	}
+
+	go func() {
+		ctx := viewer.NewSystemViewerContext(context.Background())
+		client, err := e.clientRepo.GetByClientID(ctx, execLog.ClientID)
+		if err != nil {
+			e.log.Errorf("failed to load client %s for event rules: %v", execLog.ClientID, err)
+			return
+		}
+		if client == nil {
+			client = &ent.ManagedClient{ClientID: execLog.ClientID}
+		}
+
+		tenantID := derefTenantID(execLog.TenantID)
+		e.fire(&tenantID, eventrule.EventTypeSCRIPT_FAILED, client, &executorV1.ExecutionEvent{
+			Type:              executorV1.EventType_EVENT_TYPE_SCRIPT_FAILED,
+			Detail:            eventDetail("script %s failed", execLog.ScriptName),
+			SourceExecutionId: &execLog.ID,
+		}, execLog)
+	}()
+}
+
+// fire starts an execution on the client for every enabled rule of the event
+// that applies to it. failed is the failed execution of a SCRIPT_FAILED event.
+func (e *EventEvaluator) fire(tenantID *uint32, eventType eventrule.EventType, client *ent.ManagedClient, event *executorV1.ExecutionEvent, failed *ent.ExecutionLog) {
+	ctx := viewer.NewSystemViewerContext(context.Background())
+
+	rules, err := e.ruleRepo.ListEnabled(ctx, tenantID, eventType)
+	if err != nil {
+		e.log.Errorf("failed to list %s event rules: %v", eventType, err)
+		return
+	}
+
+	// Labels of the rule tenants, loaded once per tenant
+	labels := make(map[uint32]map[string]string)
+	for _, rule := range rules {
+		ruleTenantID := derefTenantID(rule.TenantID)
+		clientLabels, ok := labels[ruleTenantID]
+		if !ok {
+			if clientLabels, err = e.clientRepo.GetLabels(ctx, ruleTenantID, client.ClientID); err != nil {
+				e.log.Errorf("failed to load labels of client %s for event rule %s: %v", client.ClientID, rule.ID, err)
+				continue
+			}
+			labels[ruleTenantID] = clientLabels
+		}
+		if !e.applies(rule, clientLabels, failed) {
+			continue
+		}
+		assigned, aErr := e.resolver.IsAssigned(ctx, &ruleTenantID, rule.ScriptID, client.ClientID)
+		if aErr != nil {
+			e.log.Errorf("failed to check assignment of event rule %s to client %s: %v", rule.ID, client.ClientID, aErr)
+			continue
+		}
+		if !assigned {
+			e.log.Infof("Event rule %s skipped: script %s is not assigned to client %s", rule.ID, rule.ScriptID, client.ClientID)
+			continue
+		}
+
+		ruleEvent := &executorV1.ExecutionEvent{
+			Type:              event.Type,
+			RuleId:            rule.ID,
+			Detail:            event.Detail,
+			SourceExecutionId: event.SourceExecutionId,
+		}
+		if err = e.start(ctx, rule, client.ClientID, ruleEvent); err != nil {
+			e.log.Errorf("Event rule %s failed to start script %s on client %s: %v", rule.ID, rule.ScriptID, client.ClientID, err)
+			continue
+		}
+		if err = e.ruleRepo.MarkFired(ctx, rule.ID); err != nil {
+			e.log.Errorf("failed to record firing of event rule %s: %v", rule.ID, err)
+		}
+	}
+}
+
+// applies reports whether a rule applies to a client with the given labels
+// and, for SCRIPT_FAILED, to the failed execution. Only SCRIPT_FAILED rules
+// apply without a selector.
+func (e *EventEvaluator) applies(rule *ent.EventRule, labels map[string]string, failed *ent.ExecutionLog) bool {
+	if failed != nil {
+		// A rule never reacts to the failure of its own script
+		if failed.ScriptID == rule.ScriptID {
+			return false
+		}
+		if rule.SourceScriptID != nil && *rule.SourceScriptID != failed.ScriptID {
+			return false
+		}
+	}
+
+	if rule.Selector == "" {
+		return failed != nil
+	}
+	if len(labels) == 0 {
+		return false
+	}
+	sel, err := parseLabelSelector(rule.Selector)
+	if err != nil {
+		e.log.Warnf("Event rule %s has an invalid selector: %v", rule.ID, err)
+		return false
+	}
+	return sel.Matches(labels)
+}
+
+// start dispatches the rule's script to the client
+func (e *EventEvaluator) start(ctx context.Context, rule *ent.EventRule, clientID string, event *executorV1.ExecutionEvent) error {
+	script, err := e.scriptRepo.GetByID(ctx, rule.ScriptID)
+	if err != nil {
+		return err
+	}
+	if script == nil {
+		return executorV1.ErrorScriptNotFound("script not found")
+	}
+	if !script.Enabled {
+		return executorV1.ErrorScriptDisabled("script is disabled")
+	}
+
+	tenantID := derefTenantID(rule.TenantID)
+	timeoutSeconds, err := resolveTimeoutSeconds(ctx, e.execSvc.settings, tenantID, script)
+	if err != nil {
+		return err
+	}
+
+	execLog, queued, err := e.execSvc.dispatch(ctx, tenantID, script, clientID, timeoutSeconds, "EVENT", rule.CreateBy, &data.ExecutionOrigin{Event: event})
+	if err != nil {
+		return err
+	}
+
+	e.log.Infof("Event rule %s started execution %s of script %s on client %s (queued: %t)", rule.ID, execLog.ID, script.ID, clientID, queued)
+	return nil
+}
+
+// eventDetail formats the detail of an event, cut to the stored length
+func eventDetail(format string, args ...any) *string {
+	detail := fmt.Sprintf(format, args...)
+	if len(detail) > maxEventDetailLen {
+		detail = strings.ToValidUTF8(detail[:maxEventDetailLen], "")
+	}
+	return &detail
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/eventrule"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// EventRuleService implements the ExecutorEventRuleService gRPC service
+type EventRuleService struct {
+	executorV1.UnimplementedExecutorEventRuleServiceServer
+
+	log        *log.Helper
+	ruleRepo   *data.EventRuleRepo
+	scriptRepo *data.ScriptRepo
+}
+
+// NewEventRuleService creates a new EventRuleService
+func NewEventRuleService(
+	ctx *bootstrap.Context,
+	ruleRepo *data.EventRuleRepo,
+	scriptRepo *data.ScriptRepo,
+) *EventRuleService {
+	return &EventRuleService{
+		log:        ctx.NewLoggerHelper("executor/service/event_rule"),
+		ruleRepo:   ruleRepo,
+		scriptRepo: scriptRepo,
+	}
+}
+
+// CreateEventRule creates an event rule
+func (s *EventRuleService) CreateEventRule(ctx context.Context, req *executorV1.CreateEventRuleRequest) (*executorV1.CreateEventRuleResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	if _, ok := executorV1.EventType_name[int32(req.EventType)]; !ok || req.EventType == executorV1.EventType_EVENT_TYPE_UNSPECIFIED {
+		return nil, executorV1.ErrorBadRequest("event_type is required")
+	}
+	if err := s.checkScript(ctx, tenantID, req.ScriptId); err != nil {
+		return nil, err
+	}
+	scriptFailed := req.EventType == executorV1.EventType_EVENT_TYPE_SCRIPT_FAILED
+	if err := validateEventRuleTarget(scriptFailed, req.ScriptId, req.GetSelector(), req.GetSourceScriptId()); err != nil {
+		return nil, err
+	}
+	if req.GetSourceScriptId() != "" {
+		if err := s.checkScript(ctx, tenantID, req.GetSourceScriptId()); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Enabled == nil {
+		enabled := true
+		req.Enabled = &enabled
+	}
+
+	entity, err := s.ruleRepo.Create(ctx, tenantID, req, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.CreateEventRuleResponse{
+		Rule: s.ruleRepo.ToProto(entity),
+	}, nil
+}
+
+// ListEventRules lists the event rules of the tenant
+func (s *EventRuleService) ListEventRules(ctx context.Context, req *executorV1.ListEventRulesRequest) (*executorV1.ListEventRulesResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.ruleRepo.ListByTenant(ctx, tenantID, req.EventType, req.ScriptId, req.Enabled, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*executorV1.EventRule, 0, len(entities))
+	for _, e := range entities {
+		rules = append(rules, s.ruleRepo.ToProto(e))
+	}
+
+	return &executorV1.ListEventRulesResponse{
+		Rules: rules,
+		Total: uint32(total),
+	}, nil
+}
+
+// GetEventRule retrieves an event rule
+func (s *EventRuleService) GetEventRule(ctx context.Context, req *executorV1.GetEventRuleRequest) (*executorV1.GetEventRuleResponse, error) {
+	entity, err := s.getRule(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetEventRuleResponse{
+		Rule: s.ruleRepo.ToProto(entity),
+	}, nil
+}
+
+// UpdateEventRule updates an event rule
+func (s *EventRuleService) UpdateEventRule(ctx context.Context, req *executorV1.UpdateEventRuleRequest) (*executorV1.UpdateEventRuleResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	updatedBy := getUserIDAsUint32(ctx)
+
+	existing, err := s.getRule(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ScriptId != nil {
+		if err = s.checkScript(ctx, tenantID, *req.ScriptId); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetSourceScriptId() != "" {
+		if err = s.checkScript(ctx, tenantID, req.GetSourceScriptId()); err != nil {
+			return nil, err
+		}
+	}
+
+	scriptID, selector := existing.ScriptID, existing.Selector
+	if req.ScriptId != nil {
+		scriptID = *req.ScriptId
+	}
+	if req.Selector != nil {
+		selector = *req.Selector
+	}
+	sourceScriptID := ""
+	if existing.SourceScriptID != nil {
+		sourceScriptID = *existing.SourceScriptID
+	}
+	if req.SourceScriptId != nil {
+		sourceScriptID = *req.SourceScriptId
+	}
+	scriptFailed := existing.EventType == eventrule.EventTypeSCRIPT_FAILED
+	if err = validateEventRuleTarget(scriptFailed, scriptID, selector, sourceScriptID); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.ruleRepo.Update(ctx, req.Id, req, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.UpdateEventRuleResponse{
+		Rule: s.ruleRepo.ToProto(entity),
+	}, nil
+}
+
+// DeleteEventRule deletes an event rule
+func (s *EventRuleService) DeleteEventRule(ctx context.Context, req *executorV1.DeleteEventRuleRequest) (*emptypb.Empty, error) {
+	if _, err := s.getRule(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	if err := s.ruleRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getRule loads an event rule of the caller's tenant
+func (s *EventRuleService) getRule(ctx context.Context, id string) (*ent.EventRule, error) {
+	entity, err := s.ruleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorEventRuleNotFound("event rule not found")
+	}
+	return entity, nil
+}
+
+// checkScript verifies that a script referenced by a rule belongs to the tenant
+func (s *EventRuleService) checkScript(ctx context.Context, tenantID uint32, scriptID string) error {
+	script, err := s.scriptRepo.GetByID(ctx, scriptID)
+	if err != nil {
+		return err
+	}
+	if script == nil || derefTenantID(script.TenantID) != tenantID {
+		return executorV1.ErrorScriptNotFound("script not found")
+	}
+	return nil
+}
+
+// validateEventRuleTarget checks a rule's selector and source script. Client
+// events need a selector naming the clients they apply to. A rule never
+// reacts to failures of its own script, so the two must differ.
+func validateEventRuleTarget(scriptFailed bool, scriptID, selector, sourceScriptID string) error {
+	if selector == "" && !scriptFailed {
+		return executorV1.ErrorBadRequest("selector is required for client event rules")
+	}
+	if selector != "" {
+		if err := validateLabelSelector(selector); err != nil {
+			return err
+		}
+	}
+	if sourceScriptID == "" {
+		return nil
+	}
+	if !scriptFailed {
+		return executorV1.ErrorBadRequest("source_script_id only applies to SCRIPT_FAILED rules")
+	}
+	if sourceScriptID == scriptID {
+		return executorV1.ErrorBadRequest("source_script_id must differ from script_id")
+	}
+	return nil
+}
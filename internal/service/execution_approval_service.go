@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// ExecutionApprovalService implements the ExecutorExecutionApprovalService
+// gRPC service. Executions on protected clients are created AWAITING_APPROVAL
+// by ExecutionService.dispatch; approving them hands them to the
+// ConcurrencyGate, and the ExecutionReaper expires the ones left undecided.
+type ExecutionApprovalService struct {
+	executorV1.UnimplementedExecutorExecutionApprovalServiceServer
+
+	log           *log.Helper
+	protectedRepo *data.ProtectedClientRepo
+	execRepo      *data.ExecutionLogRepo
+	scriptRepo    *data.ScriptRepo
+	settingsRepo  *data.TenantSettingRepo
+	slots         *ConcurrencyGate
+}
+
+// NewExecutionApprovalService creates a new ExecutionApprovalService
+func NewExecutionApprovalService(
+	ctx *bootstrap.Context,
+	protectedRepo *data.ProtectedClientRepo,
+	execRepo *data.ExecutionLogRepo,
+	scriptRepo *data.ScriptRepo,
+	settingsRepo *data.TenantSettingRepo,
+	slots *ConcurrencyGate,
+) *ExecutionApprovalService {
+	return &ExecutionApprovalService{
+		log:           ctx.NewLoggerHelper("executor/service/execution_approval"),
+		protectedRepo: protectedRepo,
+		execRepo:      execRepo,
+		scriptRepo:    scriptRepo,
+		settingsRepo:  settingsRepo,
+		slots:         slots,
+	}
+}
+
+// ListProtectedClients lists the protected clients of the caller's tenant
+func (s *ExecutionApprovalService) ListProtectedClients(ctx context.Context, req *executorV1.ListProtectedClientsRequest) (*executorV1.ListProtectedClientsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.protectedRepo.ListByTenant(ctx, tenantID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]*executorV1.ProtectedClient, 0, len(entities))
+	for _, e := range entities {
+		clients = append(clients, s.protectedRepo.ToProto(e))
+	}
+
+	return &executorV1.ListProtectedClientsResponse{
+		Clients: clients,
+		Total:   uint32(total),
+	}, nil
+}
+
+// ProtectClient puts a client on the tenant's protected list
+func (s *ExecutionApprovalService) ProtectClient(ctx context.Context, req *executorV1.ProtectClientRequest) (*executorV1.ProtectClientResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	clientID := strings.TrimSpace(req.ClientId)
+	if clientID == "" {
+		return nil, executorV1.ErrorBadRequest("client_id is required")
+	}
+	if err := s.checkApprover(ctx, tenantID, "protect clients"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.protectedRepo.Create(ctx, tenantID, clientID, strings.TrimSpace(req.GetReason()), getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Client %s of tenant %d is now protected", clientID, tenantID)
+
+	return &executorV1.ProtectClientResponse{
+		Client: s.protectedRepo.ToProto(entity),
+	}, nil
+}
+
+// UnprotectClient removes a client from the tenant's protected list
+func (s *ExecutionApprovalService) UnprotectClient(ctx context.Context, req *executorV1.UnprotectClientRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	if err := s.checkApprover(ctx, tenantID, "unprotect clients"); err != nil {
+		return nil, err
+	}
+	if err := s.protectedRepo.Delete(ctx, tenantID, req.ClientId); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Client %s of tenant %d is no longer protected", req.ClientId, tenantID)
+	return &emptypb.Empty{}, nil
+}
+
+// ApproveExecution approves an execution awaiting approval and starts it as
+// soon as its client has a free slot. The approver must hold the tenant's
+// approver role and not be the user who triggered the execution.
+func (s *ExecutionApprovalService) ApproveExecution(ctx context.Context, req *executorV1.ApproveExecutionRequest) (*executorV1.ApproveExecutionResponse, error) {
+	approvedBy := getUserIDAsUint32(ctx)
+
+	execLog, err := s.getAwaiting(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkApprover(ctx, derefTenantID(execLog.TenantID), "approve executions"); err != nil {
+		return nil, err
+	}
+	if approvedBy == nil {
+		return nil, executorV1.ErrorUnauthorized("cannot determine the approving user")
+	}
+	if sameUser(execLog.CreateBy, approvedBy) {
+		return nil, executorV1.ErrorForbidden("executions cannot be approved by the user who triggered them")
+	}
+
+	// The approver decides on the content the execution was requested with
+	script, err := s.scriptRepo.GetByID(ctx, execLog.ScriptID)
+	if err != nil {
+		return nil, err
+	}
+	if script == nil || script.ContentHash != execLog.ScriptHash {
+		return nil, executorV1.ErrorExecutionApprovalConflict("the script changed since the execution was requested, trigger it again")
+	}
+
+	approved, err := s.execRepo.Approve(ctx, execLog.ID, approvedBy, strings.TrimSpace(req.GetComment()))
+	if err != nil {
+		return nil, err
+	}
+	if !approved {
+		return nil, executorV1.ErrorExecutionApprovalConflict("the execution is no longer awaiting approval")
+	}
+
+	s.log.Infof("Execution %s on protected client %s approved", execLog.ID, execLog.ClientID)
+	s.slots.ExecutionApproved(ctx, execLog)
+
+	return s.approveResponse(ctx, execLog.ID)
+}
+
+// DenyExecution cancels an execution awaiting approval without running it
+func (s *ExecutionApprovalService) DenyExecution(ctx context.Context, req *executorV1.DenyExecutionRequest) (*executorV1.DenyExecutionResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, executorV1.ErrorBadRequest("a reason is required to deny an execution")
+	}
+
+	execLog, err := s.getAwaiting(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkApprover(ctx, derefTenantID(execLog.TenantID), "deny executions"); err != nil {
+		return nil, err
+	}
+
+	denied, err := s.execRepo.CloseAwaitingApproval(ctx, execLog.ID, getUserIDAsUint32(ctx), "approval denied: "+reason)
+	if err != nil {
+		return nil, err
+	}
+	if !denied {
+		return nil, executorV1.ErrorExecutionApprovalConflict("the execution is no longer awaiting approval")
+	}
+
+	s.log.Infof("Execution %s on protected client %s denied", execLog.ID, execLog.ClientID)
+
+	updated, err := s.execRepo.GetByID(ctx, execLog.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &executorV1.DenyExecutionResponse{
+		Execution: s.execRepo.ToProto(updated),
+	}, nil
+}
+
+// approveResponse re-fetches the approved execution, which may already have
+// been sent to its client
+func (s *ExecutionApprovalService) approveResponse(ctx context.Context, id string) (*executorV1.ApproveExecutionResponse, error) {
+	execLog, err := s.execRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &executorV1.ApproveExecutionResponse{
+		Execution: s.execRepo.ToProto(execLog),
+	}, nil
+}
+
+// getAwaiting loads an execution of the caller's tenant that awaits approval
+func (s *ExecutionApprovalService) getAwaiting(ctx context.Context, id string) (*ent.ExecutionLog, error) {
+	execLog, err := s.execRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if execLog == nil || derefTenantID(execLog.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorExecutionNotFound("execution not found")
+	}
+	if execLog.Status != executionlog.StatusAWAITING_APPROVAL {
+		return nil, executorV1.ErrorExecutionApprovalConflict("execution is %s, not awaiting approval", execLog.Status)
+	}
+	if execLog.ApprovalExpiresAt != nil && !execLog.ApprovalExpiresAt.After(time.Now()) {
+		return nil, executorV1.ErrorExecutionApprovalConflict("the approval of the execution expired")
+	}
+	return execLog, nil
+}
+
+// checkApprover checks that the caller holds the tenant's approver role
+func (s *ExecutionApprovalService) checkApprover(ctx context.Context, tenantID uint32, action string) error {
+	role, _, err := s.settingsRepo.GetExecutionApproval(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if !isScriptApprover(ctx, role) {
+		return executorV1.ErrorForbidden("only users with the %s role can %s", role, action)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/metrics"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const executionReaperPeriod = 30 * time.Second
+
+// ExecutionReaper moves executions that exceeded their timeout to TIMED_OUT
+// and asks the client to abort them if it is still connected. It also cancels
+// executions on protected clients whose approval expired.
+type ExecutionReaper struct {
+	log          *log.Helper
+	execRepo     *data.ExecutionLogRepo
+	scriptRepo   *data.ScriptRepo
+	settingsRepo *data.TenantSettingRepo
+	cmdRepo      *data.CommandRepo
+	cmdReg       CommandRegistry
+	collector    *metrics.Collector
+	retries      *RetryPlanner
+	events       *EventEvaluator
+	workflows    *WorkflowEngine
+	slots        *ConcurrencyGate
+	stop         chan struct{}
+}
+
+// NewExecutionReaper creates a new ExecutionReaper
+func NewExecutionReaper(
+	ctx *bootstrap.Context,
+	execRepo *data.ExecutionLogRepo,
+	scriptRepo *data.ScriptRepo,
+	settingsRepo *data.TenantSettingRepo,
+	cmdRepo *data.CommandRepo,
+	cmdReg CommandRegistry,
+	collector *metrics.Collector,
+	retries *RetryPlanner,
+	events *EventEvaluator,
+	workflows *WorkflowEngine,
+	slots *ConcurrencyGate,
+) *ExecutionReaper {
+	return &ExecutionReaper{
+		log:          ctx.NewLoggerHelper("executor/service/execution_reaper"),
+		execRepo:     execRepo,
+		scriptRepo:   scriptRepo,
+		settingsRepo: settingsRepo,
+		cmdRepo:      cmdRepo,
+		cmdReg:       cmdReg,
+		collector:    collector,
+		retries:      retries,
+		events:       events,
+		workflows:    workflows,
+		slots:        slots,
+		stop:         make(chan struct{}),
+	}
+}
+
+// Start runs the reaper loop until Stop is called
+func (r *ExecutionReaper) Start(ctx context.Context) error {
+	ticker := time.NewTicker(executionReaperPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.sweep(viewer.NewSystemViewerContext(context.Background()))
+		case <-r.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop stops the reaper loop
+func (r *ExecutionReaper) Stop(_ context.Context) error {
+	close(r.stop)
+	return nil
+}
+
+// sweep checks every active execution against its timeout
+func (r *ExecutionReaper) sweep(ctx context.Context) {
+	r.expireApprovals(ctx)
+
+	active, err := r.execRepo.ListActive(ctx)
+	if err != nil {
+		r.log.Errorf("failed to list active executions: %v", err)
+		return
+	}
+
+	now := time.Now()
+	timeouts := make(map[string]time.Duration)
+	for _, e := range active {
+		since, ok := r.activeSince(ctx, e)
+		if !ok {
+			continue
+		}
+
+		timeout, ok := timeouts[e.ScriptID]
+		if !ok {
+			timeout, err = r.timeoutFor(ctx, e)
+			if err != nil {
+				r.log.Errorf("failed to resolve timeout for execution %s: %v", e.ID, err)
+				continue
+			}
+			timeouts[e.ScriptID] = timeout
+		}
+
+		if now.Sub(since) >= timeout {
+			r.timeOut(ctx, e, timeout)
+		}
+	}
+}
+
+// expireApprovals cancels the executions that were not approved in time
+func (r *ExecutionReaper) expireApprovals(ctx context.Context) {
+	expired, err := r.execRepo.ListApprovalExpired(ctx, time.Now())
+	if err != nil {
+		r.log.Errorf("failed to list expired execution approvals: %v", err)
+		return
+	}
+	for _, e := range expired {
+		closed, cErr := r.execRepo.CloseAwaitingApproval(ctx, e.ID, nil, "approval expired")
+		if cErr != nil || !closed {
+			continue
+		}
+		r.log.Infof("Approval of execution %s on client %s expired", e.ID, e.ClientID)
+	}
+}
+
+// activeSince returns when the execution's clock started. PENDING executions
+// only count once their command reached the client; commands still waiting in
+// the queue are governed by the queue TTL instead, and executions waiting for
+// a concurrency slot do not count yet.
+func (r *ExecutionReaper) activeSince(ctx context.Context, e *ent.ExecutionLog) (time.Time, bool) {
+	// Executions waiting for a concurrency slot were not sent yet
+	if e.WaitingForSlot {
+		return time.Time{}, false
+	}
+
+	if e.Status == executionlog.StatusRUNNING && e.StartedAt != nil {
+		return *e.StartedAt, true
+	}
+
+	if e.Status == executionlog.StatusPENDING {
+		cmd, err := r.cmdRepo.GetLatestByExecutionID(ctx, e.ID, command.CommandTypeSCRIPT_EXECUTION)
+		if err != nil {
+			return time.Time{}, false
+		}
+		if cmd != nil {
+			if cmd.SentAt == nil || (cmd.Status != command.StatusSENT && cmd.Status != command.StatusACCEPTED) {
+				return time.Time{}, false
+			}
+			return *cmd.SentAt, true
+		}
+	}
+
+	if e.CreateTime == nil {
+		return time.Time{}, false
+	}
+	return *e.CreateTime, true
+}
+
+// timeoutFor resolves the script timeout, falling back to the tenant default
+func (r *ExecutionReaper) timeoutFor(ctx context.Context, e *ent.ExecutionLog) (time.Duration, error) {
+	script, err := r.scriptRepo.GetByID(ctx, e.ScriptID)
+	if err != nil {
+		return 0, err
+	}
+	seconds, err := resolveTimeoutSeconds(ctx, r.settingsRepo, derefTenantID(e.TenantID), script)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// timeOut marks the execution TIMED_OUT and tells the client to abort it
+func (r *ExecutionReaper) timeOut(ctx context.Context, e *ent.ExecutionLog, timeout time.Duration) {
+	reason := fmt.Sprintf("execution exceeded timeout of %s", timeout)
+	updated, err := r.execRepo.MarkTimedOut(ctx, e.ID, e.Status, reason)
+	if err != nil || !updated {
+		return
+	}
+
+	r.collector.ExecutionTimedOut(string(e.Status))
+	r.log.Infof("Execution %s on client %s timed out after %s (was %s)", e.ID, e.ClientID, timeout, e.Status)
+	finishExecution(ctx, e, executionlog.StatusTIMED_OUT, 0, r.retries, r.events, r.workflows, r.slots)
+
+	if !r.cmdReg.IsConnected(ctx, e.ClientID) {
+		return
+	}
+
+	cmd := &executorV1.ExecutionCommand{
+		CommandId:   uuid.New().String(),
+		CommandType: executorV1.CommandType_COMMAND_TYPE_ABORT,
+		ExecutionId: e.ID,
+		ScriptId:    e.ScriptID,
+		ScriptName:  e.ScriptName,
+	}
+	if _, err = r.cmdRepo.Create(ctx, derefTenantID(e.TenantID), e.ClientID, cmd); err != nil {
+		return
+	}
+	if err = r.cmdReg.Send(ctx, e.ClientID, cmd); err != nil {
+		r.log.Warnf("failed to send abort for execution %s to client %s: %v", e.ID, e.ClientID, err)
+		if markErr := r.cmdRepo.MarkExpired(ctx, cmd.CommandId); markErr != nil {
+			r.log.Errorf("failed to mark command %s expired: %v", cmd.CommandId, markErr)
+		}
+	}
+}
+
+// resolveTimeoutSeconds returns the script's timeout or the tenant default when unset
+func resolveTimeoutSeconds(ctx context.Context, settingsRepo *data.TenantSettingRepo, tenantID uint32, script *ent.Script) (int, error) {
+	if script != nil && script.TimeoutSeconds != nil && *script.TimeoutSeconds > 0 {
+		return *script.TimeoutSeconds, nil
+	}
+	return settingsRepo.GetDefaultTimeoutSeconds(ctx, tenantID)
+}
@@ -0,0 +1,581 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	// maxRunTargets caps how many clients a single run may fan out to
+	maxRunTargets = 5000
+	// maxBatchPauseSeconds caps the wait between two batches of a run
+	maxBatchPauseSeconds = 86400
+)
+
+// TriggerRun fans a script out to the listed clients and every inventory
+// client matching the selector. Each target gets its own execution linked to
+// the run; targets the script is not assigned to are skipped and reported, as
+// are targets outside maintenance windows that do not queue executions.
+// With a strategy only the first batch (or canary) is dispatched here and the
+// RunOrchestrator rolls out the rest.
+func (s *ExecutionService) TriggerRun(ctx context.Context, req *executorV1.TriggerRunRequest) (*executorV1.TriggerRunResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	script, err := s.scriptRepo.GetByID(ctx, req.ScriptId)
+	if err != nil {
+		return nil, err
+	}
+	if script == nil {
+		return nil, executorV1.ErrorScriptNotFound("script not found")
+	}
+	if !script.Enabled {
+		return nil, executorV1.ErrorScriptDisabled("script is disabled")
+	}
+
+	if err = validateRunStrategy(req.Strategy); err != nil {
+		return nil, err
+	}
+
+	run, skipped, err := s.startRun(ctx, tenantID, script, req.ClientIds, req.GetSelector(), req.Strategy, nil, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := s.runsToProto(ctx, []*ent.ExecutionRun{run})
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.TriggerRunResponse{
+		Run:     runs[0],
+		Skipped: skipped,
+	}, nil
+}
+
+// startRun resolves the targets, creates the run and dispatches its first
+// batch. scheduleID is set for runs started by a schedule.
+func (s *ExecutionService) startRun(ctx context.Context, tenantID uint32, script *ent.Script, clientIDs []string, selector string, strategy *executorV1.RunStrategy, scheduleID *string, createdBy *uint32) (*ent.ExecutionRun, []*executorV1.SkippedTarget, error) {
+	candidates, err := s.runTargets(ctx, tenantID, clientIDs, selector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var targets []string
+	var skipped []*executorV1.SkippedTarget
+	for _, clientID := range candidates {
+		assigned, aErr := s.resolver.IsAssigned(ctx, &tenantID, script.ID, clientID)
+		if aErr != nil {
+			skipped = append(skipped, &executorV1.SkippedTarget{ClientId: clientID, Reason: "assignment check failed"})
+			continue
+		}
+		if !assigned {
+			skipped = append(skipped, &executorV1.SkippedTarget{ClientId: clientID, Reason: "script is not assigned to this client"})
+			continue
+		}
+		targets = append(targets, clientID)
+	}
+
+	run, err := s.runRepo.Create(ctx, tenantID, script.ID, script.Name, selector, clientIDs, targets, strategy, scheduleID, createdBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(targets) == 0 {
+		if _, err = s.runRepo.MarkCompleted(ctx, run.ID); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		skipped = append(skipped, s.advanceRun(ctx, run)...)
+	}
+
+	if run, err = s.runRepo.GetByID(ctx, run.ID); err != nil {
+		return nil, nil, err
+	}
+
+	s.log.Infof("Run %s of script %s started for %d client(s), %d skipped", run.ID, script.ID, len(targets), len(skipped))
+	return run, skipped, nil
+}
+
+// GetRun retrieves a run with its progress
+func (s *ExecutionService) GetRun(ctx context.Context, req *executorV1.GetRunRequest) (*executorV1.GetRunResponse, error) {
+	run, err := s.getRun(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.runToProto(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetRunResponse{
+		Run: result,
+	}, nil
+}
+
+// ListRuns lists runs with their progress
+func (s *ExecutionService) ListRuns(ctx context.Context, req *executorV1.ListRunsRequest) (*executorV1.ListRunsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	var statusStr *string
+	if st := runStatusToString(req.GetStatus()); st != "" {
+		statusStr = &st
+	}
+
+	entities, total, err := s.runRepo.ListByTenant(ctx, tenantID, req.ScriptId, statusStr, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := s.runsToProto(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.ListRunsResponse{
+		Runs:  runs,
+		Total: uint32(total),
+	}, nil
+}
+
+// PauseRun stops a run from dispatching further batches. Executions already
+// handed out keep running.
+func (s *ExecutionService) PauseRun(ctx context.Context, req *executorV1.PauseRunRequest) (*executorV1.PauseRunResponse, error) {
+	run, err := s.getRun(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.runRepo.Transition(ctx, run.ID, []executionrun.Status{executionrun.StatusRUNNING}, executionrun.StatusPAUSED, "")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, executorV1.ErrorRunStateConflict("only running runs can be paused, run is %s", run.Status)
+	}
+
+	s.log.Infof("Run %s paused at batch %d", run.ID, run.CurrentBatch)
+
+	result, err := s.runToProto(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &executorV1.PauseRunResponse{Run: result}, nil
+}
+
+// ResumeRun continues a paused run
+func (s *ExecutionService) ResumeRun(ctx context.Context, req *executorV1.ResumeRunRequest) (*executorV1.ResumeRunResponse, error) {
+	run, err := s.getRun(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.runRepo.Transition(ctx, run.ID, []executionrun.Status{executionrun.StatusPAUSED}, executionrun.StatusRUNNING, "")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, executorV1.ErrorRunStateConflict("only paused runs can be resumed, run is %s", run.Status)
+	}
+
+	s.log.Infof("Run %s resumed", run.ID)
+
+	// Dispatch the next batch right away if it is due
+	if run, err = s.runRepo.GetByID(ctx, run.ID); err != nil {
+		return nil, err
+	}
+	s.advanceRun(ctx, run)
+
+	result, err := s.runToProto(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &executorV1.ResumeRunResponse{Run: result}, nil
+}
+
+// AbortRun stops a run for good: no further batches are dispatched and its
+// executions still pending or running are cancelled
+func (s *ExecutionService) AbortRun(ctx context.Context, req *executorV1.AbortRunRequest) (*executorV1.AbortRunResponse, error) {
+	run, err := s.getRun(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := req.GetReason()
+	if reason == "" {
+		reason = "aborted by operator"
+	}
+
+	from := []executionrun.Status{executionrun.StatusRUNNING, executionrun.StatusPAUSED, executionrun.StatusHALTED}
+	ok, err := s.runRepo.Transition(ctx, run.ID, from, executionrun.StatusABORTED, reason)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, executorV1.ErrorRunStateConflict("run is already %s", run.Status)
+	}
+
+	active, err := s.execRepo.ListActiveByRun(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelled uint32
+	for _, e := range active {
+		if _, cErr := s.CancelExecution(ctx, &executorV1.CancelExecutionRequest{Id: e.ID, Reason: &reason}); cErr != nil {
+			s.log.Warnf("failed to cancel execution %s of aborted run %s: %v", e.ID, run.ID, cErr)
+			continue
+		}
+		cancelled++
+	}
+
+	s.log.Infof("Run %s aborted, %d execution(s) cancelled", run.ID, cancelled)
+
+	result, err := s.runToProto(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &executorV1.AbortRunResponse{
+		Run:       result,
+		Cancelled: cancelled,
+	}, nil
+}
+
+// getRun loads a run of the caller's tenant
+func (s *ExecutionService) getRun(ctx context.Context, id string) (*ent.ExecutionRun, error) {
+	run, err := s.runRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil || derefTenantID(run.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorRunNotFound("run not found")
+	}
+	return run, nil
+}
+
+// advanceRun moves a RUNNING run forward: it halts the run when the failure
+// threshold or the canary is breached, waits for the current batch to finish
+// and the pause to pass, dispatches the next batch, and completes the run once
+// every batch has finished. Returns the targets whose execution could not be
+// created.
+func (s *ExecutionService) advanceRun(ctx context.Context, run *ent.ExecutionRun) []*executorV1.SkippedTarget {
+	if run.Status != executionrun.StatusRUNNING {
+		return nil
+	}
+
+	counts, err := s.execRepo.CountByRun(ctx, []string{run.ID})
+	if err != nil {
+		s.log.Errorf("failed to count executions of run %s: %v", run.ID, err)
+		return nil
+	}
+	progress := runProgress(counts[run.ID])
+
+	if run.MaxFailures != nil && int(progress.Failed) > *run.MaxFailures {
+		s.haltRun(ctx, run, fmt.Sprintf("%d execution(s) failed, more than the %d allowed", progress.Failed, *run.MaxFailures))
+		return nil
+	}
+
+	// Every claimed execution exists and reached a final status
+	settled := int(progress.Total) >= run.Total && progress.Pending+progress.Running == 0
+	if !settled {
+		return nil
+	}
+
+	if run.Dispatched >= len(run.Targets) {
+		if ok, cErr := s.runRepo.MarkCompleted(ctx, run.ID); cErr != nil {
+			s.log.Errorf("failed to mark run %s completed: %v", run.ID, cErr)
+		} else if ok {
+			s.log.Infof("Run %s completed: %d completed, %d failed, %d cancelled", run.ID, progress.Completed, progress.Failed, progress.Cancelled)
+		}
+		return nil
+	}
+
+	if run.CanarySize > 0 && run.CurrentBatch == 1 && progress.Completed < progress.Total {
+		s.haltRun(ctx, run, fmt.Sprintf("canary batch failed: %d of %d execution(s) did not complete", progress.Total-progress.Completed, progress.Total))
+		return nil
+	}
+
+	if run.CurrentBatch > 0 && run.BatchPauseSeconds > 0 {
+		if run.NextBatchAt == nil {
+			if err = s.runRepo.ScheduleNextBatch(ctx, run.ID, time.Now().Add(time.Duration(run.BatchPauseSeconds)*time.Second)); err != nil {
+				s.log.Errorf("failed to schedule next batch of run %s: %v", run.ID, err)
+			}
+			return nil
+		}
+		if time.Now().Before(*run.NextBatchAt) {
+			return nil
+		}
+	}
+
+	return s.dispatchBatch(ctx, run)
+}
+
+// dispatchBatch claims the next batch of targets and creates their executions
+func (s *ExecutionService) dispatchBatch(ctx context.Context, run *ent.ExecutionRun) []*executorV1.SkippedTarget {
+	script, err := s.scriptRepo.GetByID(ctx, run.ScriptID)
+	if err != nil {
+		s.log.Errorf("failed to load script %s for run %s: %v", run.ScriptID, run.ID, err)
+		return nil
+	}
+	if script == nil || !script.Enabled {
+		s.haltRun(ctx, run, "script was deleted or disabled during the run")
+		return nil
+	}
+
+	tenantID := derefTenantID(run.TenantID)
+	timeoutSeconds, err := resolveTimeoutSeconds(ctx, s.settings, tenantID, script)
+	if err != nil {
+		s.log.Errorf("failed to resolve timeout for run %s: %v", run.ID, err)
+		return nil
+	}
+
+	from := run.Dispatched
+	to := min(from+nextBatchSize(run), len(run.Targets))
+	batch := run.CurrentBatch + 1
+
+	claimed, err := s.runRepo.ClaimBatch(ctx, run.ID, from, to, batch)
+	if err != nil || !claimed {
+		return nil
+	}
+
+	triggerType := "UI_PUSH"
+	if run.ScheduleID != nil {
+		triggerType = "SCHEDULED"
+	}
+
+	var failed []*executorV1.SkippedTarget
+	for _, clientID := range run.Targets[from:to] {
+		origin, dErr := s.runOrigin(ctx, run, tenantID, clientID, triggerType)
+		if dErr == nil {
+			_, _, dErr = s.dispatch(ctx, tenantID, script, clientID, timeoutSeconds, triggerType, run.CreateBy, origin)
+		}
+		if dErr != nil {
+			s.log.Errorf("failed to dispatch run %s to client %s: %v", run.ID, clientID, dErr)
+			reason := "failed to create execution"
+			if executorV1.IsConcurrencyLimitReached(dErr) {
+				reason = "concurrency limit reached"
+			} else if executorV1.IsBadRequest(dErr) || executorV1.IsSecretNotFound(dErr) || executorV1.IsSecretsNotConfigured(dErr) ||
+				executorV1.IsScriptTypeNotSupported(dErr) || executorV1.IsOutsideMaintenanceWindow(dErr) || executorV1.IsMaintenanceOverrideRequired(dErr) {
+				// Parameter values of the client's assignments are missing or
+				// invalid, a secret the script references is missing, the
+				// client cannot run the script type, or it is outside its
+				// maintenance windows
+				reason = kratosErrors.FromError(dErr).GetMessage()
+			}
+			failed = append(failed, &executorV1.SkippedTarget{ClientId: clientID, Reason: reason})
+			if rErr := s.runRepo.ReleaseTarget(ctx, run.ID); rErr != nil {
+				s.log.Errorf("failed to release target %s of run %s: %v", clientID, run.ID, rErr)
+			}
+		}
+	}
+
+	s.log.Infof("Run %s dispatched batch %d to %d client(s)", run.ID, batch, to-from-len(failed))
+	return failed
+}
+
+// runOrigin returns the origin to create a run's execution on a client with.
+// Runs started by hand are subject to the client's maintenance windows like
+// single executions, with no way to override them: the execution is held
+// until the next window opens, or the target is skipped.
+func (s *ExecutionService) runOrigin(ctx context.Context, run *ent.ExecutionRun, tenantID uint32, clientID, triggerType string) (*data.ExecutionOrigin, error) {
+	origin := &data.ExecutionOrigin{}
+	if triggerType == "UI_PUSH" {
+		windows, err := s.windowRepo.ListEnabledForClient(ctx, tenantID, clientID)
+		if err != nil {
+			return nil, err
+		}
+		held, err := maintenanceOrigin(windows, clientID, time.Now(), false, "")
+		if err != nil {
+			return nil, err
+		}
+		if held != nil {
+			origin = held
+		}
+	}
+	origin.RunID = &run.ID
+	return origin, nil
+}
+
+// haltRun stops a RUNNING run from dispatching further batches
+func (s *ExecutionService) haltRun(ctx context.Context, run *ent.ExecutionRun, reason string) {
+	ok, err := s.runRepo.Transition(ctx, run.ID, []executionrun.Status{executionrun.StatusRUNNING}, executionrun.StatusHALTED, reason)
+	if err != nil {
+		s.log.Errorf("failed to halt run %s: %v", run.ID, err)
+		return
+	}
+	if ok {
+		s.log.Warnf("Run %s halted after batch %d: %s", run.ID, run.CurrentBatch, reason)
+	}
+}
+
+// nextBatchSize returns the size of the batch after the run's current one
+func nextBatchSize(run *ent.ExecutionRun) int {
+	if run.CurrentBatch == 0 && run.CanarySize > 0 {
+		return run.CanarySize
+	}
+	if run.BatchSize > 0 {
+		return run.BatchSize
+	}
+	return len(run.Targets)
+}
+
+// validateRunStrategy checks the strategy limits the generated validator skips
+func validateRunStrategy(strategy *executorV1.RunStrategy) error {
+	if strategy == nil {
+		return nil
+	}
+	if strategy.BatchSize > maxRunTargets || strategy.CanarySize > maxRunTargets {
+		return executorV1.ErrorBadRequest("batch and canary sizes must be at most %d", maxRunTargets)
+	}
+	if strategy.BatchPauseSeconds > maxBatchPauseSeconds {
+		return executorV1.ErrorBadRequest("batch pause must be at most %d seconds", maxBatchPauseSeconds)
+	}
+	if strategy.MaxFailures != nil && *strategy.MaxFailures > maxRunTargets {
+		return executorV1.ErrorBadRequest("max failures must be at most %d", maxRunTargets)
+	}
+	return nil
+}
+
+// runTargets merges the explicit client IDs with the inventory clients the
+// tenant labelled matching the selector, keeping the order and dropping
+// duplicates
+func (s *ExecutionService) runTargets(ctx context.Context, tenantID uint32, clientIDs []string, selector string) ([]string, error) {
+	if len(clientIDs) == 0 && selector == "" {
+		return nil, executorV1.ErrorBadRequest("a run needs client_ids or a selector")
+	}
+
+	seen := make(map[string]bool)
+	var targets []string
+	for _, id := range clientIDs {
+		if id == "" || len(id) > 255 {
+			return nil, executorV1.ErrorBadRequest("client IDs must be 1 to 255 characters")
+		}
+		if !seen[id] {
+			seen[id] = true
+			targets = append(targets, id)
+		}
+	}
+
+	if selector != "" {
+		sel, err := parseLabelSelector(selector)
+		if err != nil {
+			return nil, executorV1.ErrorInvalidLabelSelector("invalid label selector: %s", err.Error())
+		}
+		clients, err := s.clientRepo.ListAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		labels, err := s.clientRepo.ListLabels(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range clients {
+			if !seen[c.ClientID] && len(labels[c.ClientID]) > 0 && sel.Matches(labels[c.ClientID]) {
+				seen[c.ClientID] = true
+				targets = append(targets, c.ClientID)
+			}
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, executorV1.ErrorBadRequest("no clients match the run targets")
+	}
+	if len(targets) > maxRunTargets {
+		return nil, executorV1.ErrorBadRequest("a run can target at most %d clients, got %d", maxRunTargets, len(targets))
+	}
+	return targets, nil
+}
+
+// runToProto re-reads a run and converts it with its progress
+func (s *ExecutionService) runToProto(ctx context.Context, id string) (*executorV1.ExecutionRun, error) {
+	run, err := s.runRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, executorV1.ErrorRunNotFound("run not found")
+	}
+	runs, err := s.runsToProto(ctx, []*ent.ExecutionRun{run})
+	if err != nil {
+		return nil, err
+	}
+	return runs[0], nil
+}
+
+// runsToProto converts runs with their aggregated progress
+func (s *ExecutionService) runsToProto(ctx context.Context, runs []*ent.ExecutionRun) ([]*executorV1.ExecutionRun, error) {
+	ids := make([]string, 0, len(runs))
+	for _, r := range runs {
+		ids = append(ids, r.ID)
+	}
+
+	counts, err := s.execRepo.CountByRun(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*executorV1.ExecutionRun, 0, len(runs))
+	for _, r := range runs {
+		result = append(result, s.runRepo.ToProto(r, runProgress(counts[r.ID])))
+	}
+	return result, nil
+}
+
+// runProgress folds per-status execution counts into run progress
+func runProgress(counts map[executionlog.Status]int) *executorV1.RunProgress {
+	progress := &executorV1.RunProgress{}
+	for status, n := range counts {
+		c := uint32(n)
+		progress.Total += c
+		switch status {
+		case executionlog.StatusPENDING, executionlog.StatusAWAITING_APPROVAL:
+			progress.Pending += c
+		case executionlog.StatusRUNNING:
+			progress.Running += c
+		case executionlog.StatusCOMPLETED:
+			progress.Completed += c
+		case executionlog.StatusCANCELLED:
+			progress.Cancelled += c
+		default:
+			progress.Failed += c
+		}
+	}
+	return progress
+}
+
+// runStatusToString maps a run status filter to its stored value
+func runStatusToString(status executorV1.RunStatus) string {
+	switch status {
+	case executorV1.RunStatus_RUN_STATUS_RUNNING:
+		return string(executionrun.StatusRUNNING)
+	case executorV1.RunStatus_RUN_STATUS_PAUSED:
+		return string(executionrun.StatusPAUSED)
+	case executorV1.RunStatus_RUN_STATUS_HALTED:
+		return string(executionrun.StatusHALTED)
+	case executorV1.RunStatus_RUN_STATUS_ABORTED:
+		return string(executionrun.StatusABORTED)
+	case executorV1.RunStatus_RUN_STATUS_COMPLETED:
+		return string(executionrun.StatusCOMPLETED)
+	default:
+		return ""
+	}
+}
@@ -2,42 +2,90 @@ package service
 
 import (
 	"context"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/go-tangra/go-tangra-common/viewer"
 	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/command"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
 
 	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
 )
 
+const (
+	// tailPollInterval is how often TailExecution checks for new output
+	tailPollInterval = time.Second
+	// tailBatchSize is how many chunks TailExecution reads per query
+	tailBatchSize = 500
+)
+
 // ExecutionService implements the ExecutorExecutionService gRPC service
 type ExecutionService struct {
 	executorV1.UnimplementedExecutorExecutionServiceServer
 
 	log        *log.Helper
 	scriptRepo *data.ScriptRepo
-	assignRepo *data.AssignmentRepo
+	resolver   *AssignmentResolver
 	execRepo   *data.ExecutionLogRepo
-	cmdReg     *CommandRegistry
+	cmdRepo    *data.CommandRepo
+	outputRepo *data.OutputChunkRepo
+	runRepo    *data.ExecutionRunRepo
+	clientRepo *data.ClientRepo
+	settings   *data.TenantSettingRepo
+	cmdReg     CommandRegistry
+	cmdQueue   *CommandQueue
+	retries    *RetryPlanner
+	windowRepo *data.MaintenanceWindowRepo
+	versions   *data.ScriptVersionRepo
+	secrets    *SecretResolver
+	protected  *data.ProtectedClientRepo
 }
 
 // NewExecutionService creates a new ExecutionService
 func NewExecutionService(
 	ctx *bootstrap.Context,
 	scriptRepo *data.ScriptRepo,
-	assignRepo *data.AssignmentRepo,
+	resolver *AssignmentResolver,
 	execRepo *data.ExecutionLogRepo,
-	cmdReg *CommandRegistry,
+	cmdRepo *data.CommandRepo,
+	outputRepo *data.OutputChunkRepo,
+	runRepo *data.ExecutionRunRepo,
+	clientRepo *data.ClientRepo,
+	settings *data.TenantSettingRepo,
+	cmdReg CommandRegistry,
+	cmdQueue *CommandQueue,
+	retries *RetryPlanner,
+	windowRepo *data.MaintenanceWindowRepo,
+	versions *data.ScriptVersionRepo,
+	secrets *SecretResolver,
+	protected *data.ProtectedClientRepo,
 ) *ExecutionService {
 	return &ExecutionService{
 		log:        ctx.NewLoggerHelper("executor/service/execution"),
 		scriptRepo: scriptRepo,
-		assignRepo: assignRepo,
+		resolver:   resolver,
 		execRepo:   execRepo,
+		cmdRepo:    cmdRepo,
+		outputRepo: outputRepo,
+		runRepo:    runRepo,
+		clientRepo: clientRepo,
+		settings:   settings,
 		cmdReg:     cmdReg,
+		cmdQueue:   cmdQueue,
+		retries:    retries,
+		windowRepo: windowRepo,
+		versions:   versions,
+		secrets:    secrets,
+		protected:  protected,
 	}
 }
 
@@ -59,7 +107,7 @@ func (s *ExecutionService) TriggerExecution(ctx context.Context, req *executorV1
 	}
 
 	// Verify assignment exists
-	exists, err := s.assignRepo.Exists(ctx, tenantID, req.ScriptId, req.ClientId)
+	exists, err := s.resolver.IsAssigned(ctx, &tenantID, req.ScriptId, req.ClientId)
 	if err != nil {
 		return nil, err
 	}
@@ -67,41 +115,379 @@ func (s *ExecutionService) TriggerExecution(ctx context.Context, req *executorV1
 		return nil, executorV1.ErrorClientNotAssigned("script is not assigned to this client")
 	}
 
+	timeoutSeconds, err := resolveTimeoutSeconds(ctx, s.settings, tenantID, script)
+	if err != nil {
+		return nil, err
+	}
+
+	// Outside the client's maintenance windows the execution is rejected,
+	// held until the next window opens, or needs an override
+	windows, err := s.windowRepo.ListEnabledForClient(ctx, tenantID, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	origin, err := maintenanceOrigin(windows, req.ClientId, time.Now(), req.GetOverrideMaintenanceWindow(), req.GetOverrideJustification())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Parameters) > 0 {
+		if origin == nil {
+			origin = &data.ExecutionOrigin{}
+		}
+		origin.Parameters = req.Parameters
+	}
+
+	execLog, queued, err := s.dispatch(ctx, tenantID, script, req.ClientId, timeoutSeconds, "UI_PUSH", createdBy, origin)
+	if err != nil {
+		return nil, err
+	}
+	if execLog.MaintenanceOverride != "" {
+		s.log.Warnf("Execution %s overrides the maintenance windows of client %s: %s", execLog.ID, req.ClientId, execLog.MaintenanceOverride)
+	}
+
+	return &executorV1.TriggerExecutionResponse{
+		Execution: s.execRepo.ToProto(execLog),
+		Queued:    queued,
+	}, nil
+}
+
+// dispatch creates a server-initiated execution of the script on a client and
+// sends it, queueing the command when the client is offline. Returns whether
+// the command was queued. Over a concurrency limit the execution is rejected
+// with CONCURRENCY_LIMIT_REACHED, or created waiting for a slot without
+// sending anything yet. Executions held for a maintenance window wait too.
+// Parameter values supplied in origin are completed with the values of the
+// client's assignments and the defaults; a missing required value fails, as
+// does a secret the script references that cannot be resolved, or a script
+// type the client has no interpreter for.
+func (s *ExecutionService) dispatch(ctx context.Context, tenantID uint32, script *ent.Script, clientID string, timeoutSeconds int, triggerType string, createdBy *uint32, origin *data.ExecutionOrigin) (*ent.ExecutionLog, bool, error) {
+	if err := checkScriptTypeSupported(ctx, s.clientRepo, clientID, string(script.ScriptType)); err != nil {
+		return nil, false, err
+	}
+
+	limits, err := resolveConcurrencyLimits(ctx, s.settings, tenantID, script)
+	if err != nil {
+		return nil, false, err
+	}
+
+	origin, err = s.withParameters(ctx, tenantID, script, clientID, origin)
+	if err != nil {
+		return nil, false, err
+	}
+	origin, err = s.withSecrets(ctx, tenantID, script, origin)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Executions on protected clients wait for a second person's approval
+	// before they compete for a slot
+	awaiting, err := s.withApproval(ctx, tenantID, clientID, origin)
+	if err != nil {
+		return nil, false, err
+	}
+	if awaiting != nil {
+		execLog, cErr := s.execRepo.Create(ctx, tenantID, script.ID, script.Name, clientID, script.ContentHash, triggerType, "AWAITING_APPROVAL", createdBy, awaiting)
+		if cErr != nil {
+			return nil, false, cErr
+		}
+		s.log.Infof("Execution %s of script %s on protected client %s awaits approval until %s",
+			execLog.ID, script.ID, clientID, execLog.ApprovalExpiresAt.Format(time.RFC3339))
+		return execLog, false, nil
+	}
+
 	// Create execution log
-	execLog, err := s.execRepo.Create(ctx, tenantID, script.ID, script.Name, req.ClientId, script.ContentHash, "UI_PUSH", "PENDING", createdBy)
+	execLog, err := s.execRepo.CreateWithinLimits(ctx, tenantID, script.ID, script.Name, clientID, script.ContentHash, triggerType, createdBy, origin, limits)
+	if err != nil {
+		return nil, false, err
+	}
+	if execLog.HeldUntil != nil {
+		s.log.Infof("Execution %s of script %s is held until %s by the maintenance windows of client %s",
+			execLog.ID, script.ID, execLog.HeldUntil.Format(time.RFC3339), clientID)
+		return execLog, false, nil
+	}
+	if execLog.WaitingForSlot {
+		s.log.Infof("Execution %s of script %s waits for a free slot on client %s", execLog.ID, script.ID, clientID)
+		return execLog, false, nil
+	}
+
+	return s.deliver(ctx, tenantID, script, execLog, timeoutSeconds)
+}
+
+// checkScriptTypeSupported refuses a script type the client has no
+// interpreter for. Clients that never reported their script types, including
+// clients not seen yet, are held to the types every agent runs.
+func checkScriptTypeSupported(ctx context.Context, clientRepo *data.ClientRepo, clientID, scriptType string) error {
+	client, err := clientRepo.GetByClientID(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	supported := builtinScriptTypes
+	if client != nil && len(client.ScriptTypes) > 0 {
+		supported = client.ScriptTypes
+	}
+	if !slices.Contains(supported, scriptType) {
+		return executorV1.ErrorScriptTypeNotSupported("client %s cannot run %s scripts", clientID, scriptType)
+	}
+	return nil
+}
+
+// withParameters resolves the parameter values of a new execution and returns
+// the origin to create it with, recording secret values apart
+func (s *ExecutionService) withParameters(ctx context.Context, tenantID uint32, script *ent.Script, clientID string, origin *data.ExecutionOrigin) (*data.ExecutionOrigin, error) {
+	var supplied map[string]string
+	if origin != nil {
+		supplied = origin.Parameters
+	}
+	if len(script.Parameters) == 0 && len(supplied) == 0 {
+		return origin, nil
+	}
+
+	assigned, err := s.resolver.ParameterValues(ctx, tenantID, script.ID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	// Assignments may still hold values of parameters the script dropped
+	maps.DeleteFunc(assigned, func(name, _ string) bool {
+		return !slices.ContainsFunc(script.Parameters, func(d schema.ScriptParameter) bool { return d.Name == name })
+	})
+
+	values, err := resolveParameterValues(script.Parameters, assigned, supplied)
 	if err != nil {
 		return nil, err
 	}
 
+	resolved := data.ExecutionOrigin{}
+	if origin != nil {
+		resolved = *origin
+	}
+	resolved.Parameters, resolved.SecretParameters = maskParameterValues(script.Parameters, values)
+	return &resolved, nil
+}
+
+// withSecrets checks the secrets the script references and returns the origin
+// to create the execution with, recording their names. The values are only
+// filled in when the command is sent to the client.
+func (s *ExecutionService) withSecrets(ctx context.Context, tenantID uint32, script *ent.Script, origin *data.ExecutionOrigin) (*data.ExecutionOrigin, error) {
+	names, err := s.secrets.Check(ctx, tenantID, script.Content)
+	if err != nil || len(names) == 0 {
+		return origin, err
+	}
+
+	resolved := data.ExecutionOrigin{}
+	if origin != nil {
+		resolved = *origin
+	}
+	resolved.SecretNames = names
+	return &resolved, nil
+}
+
+// withApproval returns the origin to create an execution on a protected
+// client with, or nil if the client is not protected
+func (s *ExecutionService) withApproval(ctx context.Context, tenantID uint32, clientID string, origin *data.ExecutionOrigin) (*data.ExecutionOrigin, error) {
+	protected, err := s.protected.IsProtected(ctx, tenantID, clientID)
+	if err != nil || !protected {
+		return nil, err
+	}
+	_, ttl, err := s.settings.GetExecutionApproval(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := data.ExecutionOrigin{}
+	if origin != nil {
+		resolved = *origin
+	}
+	expiresAt := time.Now().Add(ttl)
+	resolved.ApprovalExpiresAt = &expiresAt
+	return &resolved, nil
+}
+
+// deliver sends the command of a created execution to its client, queueing it
+// when the client is offline. Returns whether the command was queued.
+func (s *ExecutionService) deliver(ctx context.Context, tenantID uint32, script *ent.Script, execLog *ent.ExecutionLog, timeoutSeconds int) (*ent.ExecutionLog, bool, error) {
+	clientID := execLog.ClientID
+
 	// Send command to client via stream
 	commandID := uuid.New().String()
 	cmd := &executorV1.ExecutionCommand{
-		CommandId:   commandID,
-		ExecutionId: execLog.ID,
-		ScriptId:    script.ID,
-		ScriptName:  script.Name,
-		ScriptType:  scriptTypeToProto(string(script.ScriptType)),
-		Content:     script.Content,
-		ContentHash: script.ContentHash,
+		CommandId:      commandID,
+		ExecutionId:    execLog.ID,
+		ScriptId:       script.ID,
+		ScriptName:     script.Name,
+		ScriptType:     scriptTypeToProto(string(script.ScriptType)),
+		Content:        script.Content,
+		ContentHash:    script.ContentHash,
+		TimeoutSeconds: int32(timeoutSeconds),
+	}
+	values, err := s.execRepo.ParameterValues(execLog)
+	if err != nil {
+		return nil, false, err
+	}
+	cmd.Env, cmd.Args = parameterDelivery(script.Parameters, values)
+	if _, err := s.cmdRepo.Create(ctx, tenantID, clientID, cmd); err != nil {
+		return nil, false, err
 	}
 
-	if sendErr := s.cmdReg.Send(req.ClientId, cmd); sendErr != nil {
-		// Client not connected — update status
-		s.log.Warnf("Client %s not connected: %v", req.ClientId, sendErr)
-		if updateErr := s.execRepo.UpdateStatus(ctx, execLog.ID, "CLIENT_OFFLINE"); updateErr != nil {
-			s.log.Errorf("failed to update execution %s status to CLIENT_OFFLINE: %v", execLog.ID, updateErr)
+	queued := false
+	if sendErr := s.cmdReg.Send(ctx, clientID, cmd); sendErr != nil {
+		// Client not connected — keep the command until it reconnects
+		s.log.Warnf("Client %s not connected: %v", clientID, sendErr)
+		if queueErr := s.cmdQueue.Enqueue(ctx, tenantID, clientID, cmd); queueErr == nil {
+			queued = true
+			if markErr := s.cmdRepo.MarkQueued(ctx, commandID); markErr != nil {
+				s.log.Errorf("failed to mark command %s queued: %v", commandID, markErr)
+			}
+		} else {
+			if markErr := s.cmdRepo.MarkExpired(ctx, commandID); markErr != nil {
+				s.log.Errorf("failed to mark command %s expired: %v", commandID, markErr)
+			}
+			s.log.Errorf("failed to queue command for execution %s: %v", execLog.ID, queueErr)
+			if updateErr := s.execRepo.UpdateStatus(ctx, execLog.ID, "CLIENT_OFFLINE"); updateErr != nil {
+				s.log.Errorf("failed to update execution %s status to CLIENT_OFFLINE: %v", execLog.ID, updateErr)
+			} else {
+				s.retries.Schedule(ctx, execLog, executionlog.StatusCLIENT_OFFLINE, 0)
+			}
+
+			// Re-fetch to get updated status
+			if updated, fetchErr := s.execRepo.GetByID(ctx, execLog.ID); fetchErr != nil {
+				s.log.Errorf("failed to re-fetch execution %s: %v", execLog.ID, fetchErr)
+			} else {
+				execLog = updated
+			}
 		}
+	}
 
-		// Re-fetch to get updated status
-		if updated, fetchErr := s.execRepo.GetByID(ctx, execLog.ID); fetchErr != nil {
-			s.log.Errorf("failed to re-fetch execution %s: %v", execLog.ID, fetchErr)
+	return execLog, queued, nil
+}
+
+// CancelExecution stops a pending or running execution. Commands still waiting
+// in the queue are discarded and the execution is cancelled right away;
+// otherwise the client is sent a cancel command and the execution moves to
+// CANCELLED once the client reports that it terminated the script.
+func (s *ExecutionService) CancelExecution(ctx context.Context, req *executorV1.CancelExecutionRequest) (*executorV1.CancelExecutionResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	cancelledBy := getUserIDAsUint32(ctx)
+	reason := req.GetReason()
+
+	execLog, err := s.execRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if execLog == nil {
+		return nil, executorV1.ErrorExecutionNotFound("execution not found")
+	}
+	// A finished attempt waiting to be retried: drop the retry
+	if execLog.RetryAt != nil {
+		dropped, err := s.execRepo.CancelRetry(ctx, execLog.ID)
+		if err != nil {
+			return nil, err
+		}
+		if dropped {
+			s.log.Infof("Retry of execution %s cancelled", execLog.ID)
+			return s.cancelResponse(ctx, execLog.ID, false)
+		}
+	}
+	// Still awaiting approval: nothing was sent yet
+	if execLog.Status == executionlog.StatusAWAITING_APPROVAL {
+		cancelled, cErr := s.execRepo.CloseAwaitingApproval(ctx, execLog.ID, cancelledBy, reason)
+		if cErr != nil {
+			return nil, cErr
+		}
+		if cancelled {
+			s.log.Infof("Execution %s cancelled while awaiting approval", execLog.ID)
+			return s.cancelResponse(ctx, execLog.ID, false)
+		}
+		// Decided meanwhile, cancel it like any other execution
+		return s.CancelExecution(ctx, req)
+	}
+	if execLog.Status != executionlog.StatusPENDING && execLog.Status != executionlog.StatusRUNNING {
+		return nil, executorV1.ErrorExecutionNotCancellable("execution is already %s", execLog.Status)
+	}
+
+	// Still waiting for a concurrency slot: nothing was sent yet
+	if execLog.WaitingForSlot {
+		cancelled, cErr := s.execRepo.CancelWaiting(ctx, execLog.ID, cancelledBy, reason)
+		if cErr != nil {
+			return nil, cErr
+		}
+		if cancelled {
+			s.log.Infof("Execution %s cancelled while waiting for a slot on client %s", execLog.ID, execLog.ClientID)
+			return s.cancelResponse(ctx, execLog.ID, false)
+		}
+		// Admitted meanwhile, cancel it like any other execution
+		return s.CancelExecution(ctx, req)
+	}
+
+	// The script never reached the client: drop it from the queue and finish here
+	scriptCmd, err := s.cmdRepo.GetLatestByExecutionID(ctx, execLog.ID, command.CommandTypeSCRIPT_EXECUTION)
+	if err != nil {
+		return nil, err
+	}
+	if execLog.Status == executionlog.StatusPENDING && scriptCmd != nil && scriptCmd.Status == command.StatusQUEUED {
+		if err = s.cmdQueue.Discard(ctx, scriptCmd.ID); err != nil {
+			return nil, err
+		}
+		if err = s.cmdRepo.MarkExpired(ctx, scriptCmd.ID); err != nil {
+			s.log.Errorf("failed to mark command %s expired: %v", scriptCmd.ID, err)
+		}
+		if _, err = s.execRepo.MarkCancelled(ctx, execLog.ID, cancelledBy, reason); err != nil {
+			return nil, err
+		}
+		s.log.Infof("Execution %s cancelled before delivery to client %s", execLog.ID, execLog.ClientID)
+		return s.cancelResponse(ctx, execLog.ID, false)
+	}
+
+	updated, err := s.execRepo.RequestCancel(ctx, execLog.ID, cancelledBy, reason)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, executorV1.ErrorExecutionNotCancellable("execution is no longer active")
+	}
+
+	commandID := uuid.New().String()
+	cmd := &executorV1.ExecutionCommand{
+		CommandId:   commandID,
+		CommandType: executorV1.CommandType_COMMAND_TYPE_CANCEL,
+		ExecutionId: execLog.ID,
+		ScriptId:    execLog.ScriptID,
+		ScriptName:  execLog.ScriptName,
+	}
+	if _, err = s.cmdRepo.Create(ctx, tenantID, execLog.ClientID, cmd); err != nil {
+		return nil, err
+	}
+
+	notified := true
+	if sendErr := s.cmdReg.Send(ctx, execLog.ClientID, cmd); sendErr != nil {
+		// Client not connected — deliver the cancel as soon as it reconnects
+		s.log.Warnf("Client %s not connected for cancel: %v", execLog.ClientID, sendErr)
+		notified = false
+		if queueErr := s.cmdQueue.Enqueue(ctx, tenantID, execLog.ClientID, cmd); queueErr == nil {
+			if markErr := s.cmdRepo.MarkQueued(ctx, commandID); markErr != nil {
+				s.log.Errorf("failed to mark command %s queued: %v", commandID, markErr)
+			}
 		} else {
-			execLog = updated
+			s.log.Errorf("failed to queue cancel for execution %s: %v", execLog.ID, queueErr)
+			if markErr := s.cmdRepo.MarkExpired(ctx, commandID); markErr != nil {
+				s.log.Errorf("failed to mark command %s expired: %v", commandID, markErr)
+			}
 		}
 	}
 
-	return &executorV1.TriggerExecutionResponse{
-		Execution: s.execRepo.ToProto(execLog),
+	s.log.Infof("Cancel requested for execution %s on client %s", execLog.ID, execLog.ClientID)
+	return s.cancelResponse(ctx, execLog.ID, notified)
+}
+
+// cancelResponse re-fetches the execution to return its updated cancel state
+func (s *ExecutionService) cancelResponse(ctx context.Context, id string, notified bool) (*executorV1.CancelExecutionResponse, error) {
+	execLog, err := s.execRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &executorV1.CancelExecutionResponse{
+		Execution:      s.execRepo.ToProto(execLog),
+		ClientNotified: notified,
 	}, nil
 }
 
@@ -113,11 +499,17 @@ func (s *ExecutionService) TriggerClientUpdate(ctx context.Context, req *executo
 		CommandType:   executorV1.CommandType_COMMAND_TYPE_CLIENT_UPDATE,
 		TargetVersion: req.GetTargetVersion(),
 	}
+	if _, err := s.cmdRepo.Create(ctx, getTenantIDFromContext(ctx), req.GetClientId(), cmd); err != nil {
+		return nil, err
+	}
 
 	clientOnline := true
-	if err := s.cmdReg.Send(req.GetClientId(), cmd); err != nil {
+	if err := s.cmdReg.Send(ctx, req.GetClientId(), cmd); err != nil {
 		s.log.Warnf("Client %s not connected for update: %v", req.GetClientId(), err)
 		clientOnline = false
+		if markErr := s.cmdRepo.MarkExpired(ctx, commandID); markErr != nil {
+			s.log.Errorf("failed to mark command %s expired: %v", commandID, markErr)
+		}
 	}
 
 	return &executorV1.TriggerClientUpdateResponse{
@@ -127,15 +519,27 @@ func (s *ExecutionService) TriggerClientUpdate(ctx context.Context, req *executo
 }
 
 // ListConnectedClients returns all currently connected clients with their versions
-func (s *ExecutionService) ListConnectedClients(_ context.Context, _ *executorV1.ListConnectedClientsRequest) (*executorV1.ListConnectedClientsResponse, error) {
-	connected := s.cmdReg.ListConnected()
+func (s *ExecutionService) ListConnectedClients(ctx context.Context, _ *executorV1.ListConnectedClientsRequest) (*executorV1.ListConnectedClientsResponse, error) {
+	connected, err := s.cmdReg.ListConnected(ctx)
+	if err != nil {
+		s.log.Errorf("list connected clients failed: %v", err)
+		return nil, executorV1.ErrorInternalServerError("list connected clients failed")
+	}
 	clients := make([]*executorV1.ConnectedClient, 0, len(connected))
 	for _, c := range connected {
-		clients = append(clients, &executorV1.ConnectedClient{
+		client := &executorV1.ConnectedClient{
 			ClientId:      c.ClientID,
 			ClientVersion: c.Version,
 			ConnectedAt:   timestamppb.New(c.ConnectedAt),
-		})
+			Load1:         c.Heartbeat.Load1,
+			Load5:         c.Heartbeat.Load5,
+			Load15:        c.Heartbeat.Load15,
+			UptimeSeconds: c.Heartbeat.UptimeSeconds,
+		}
+		if !c.LastSeen.IsZero() {
+			client.LastSeenAt = timestamppb.New(c.LastSeen)
+		}
+		clients = append(clients, client)
 	}
 	return &executorV1.ListConnectedClientsResponse{Clients: clients}, nil
 }
@@ -150,9 +554,37 @@ func (s *ExecutionService) GetExecution(ctx context.Context, req *executorV1.Get
 		return nil, executorV1.ErrorExecutionNotFound("execution not found")
 	}
 
-	return &executorV1.GetExecutionResponse{
+	resp := &executorV1.GetExecutionResponse{
 		Execution: s.execRepo.ToProto(entity),
-	}, nil
+	}
+	if version := s.scriptVersionOf(ctx, entity); version != nil {
+		resp.ScriptVersion = s.versions.ToProto(version, true)
+	}
+	return resp, nil
+}
+
+// scriptVersionOf finds the script version an execution ran by its content
+// hash. Returns nil if the content is not known.
+func (s *ExecutionService) scriptVersionOf(ctx context.Context, e *ent.ExecutionLog) *ent.ScriptVersion {
+	if e.ScriptHash == "" {
+		return nil
+	}
+
+	version, err := s.versions.FindByHash(ctx, e.ScriptID, e.ScriptHash)
+	if err != nil || version != nil {
+		return version
+	}
+
+	// Scripts saved before versions were kept only have their current content
+	script, err := s.scriptRepo.GetByID(ctx, e.ScriptID)
+	if err != nil || script == nil || script.ContentHash != e.ScriptHash {
+		return nil
+	}
+	if err = s.versions.EnsureCurrent(ctx, script); err != nil {
+		return nil
+	}
+	version, _ = s.versions.FindByHash(ctx, e.ScriptID, e.ScriptHash)
+	return version
 }
 
 // ListExecutions lists executions with pagination and filters
@@ -173,7 +605,7 @@ func (s *ExecutionService) ListExecutions(ctx context.Context, req *executorV1.L
 		statusStr = &s
 	}
 
-	entities, total, err := s.execRepo.ListByTenant(ctx, tenantID, req.ScriptId, req.ClientId, statusStr, page, pageSize)
+	entities, total, err := s.execRepo.ListByTenant(ctx, tenantID, req.ScriptId, req.ClientId, statusStr, req.RunId, req.OriginalExecutionId, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +621,67 @@ func (s *ExecutionService) ListExecutions(ctx context.Context, req *executorV1.L
 	}, nil
 }
 
+// TailExecution streams the output of an execution as it arrives and ends
+// with the final execution state. Output is read from the database, so the
+// tail works regardless of which replica the client streams to.
+func (s *ExecutionService) TailExecution(req *executorV1.TailExecutionRequest, stream executorV1.ExecutorExecutionService_TailExecutionServer) error {
+	ctx := viewer.NewSystemViewerContext(stream.Context())
+	tenantID := getTenantIDFromContext(stream.Context())
+
+	execLog, err := s.execRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+	if execLog == nil || (tenantID != 0 && derefTenantID(execLog.TenantID) != tenantID) {
+		return executorV1.ErrorExecutionNotFound("execution not found")
+	}
+
+	ticker := time.NewTicker(tailPollInterval)
+	defer ticker.Stop()
+
+	afterSeq := req.GetAfterSeq()
+	for {
+		// Read the status before the chunks so nothing stored before the
+		// final status is missed
+		execLog, err = s.execRepo.GetByID(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		finished := execLog.Status != executionlog.StatusPENDING && execLog.Status != executionlog.StatusRUNNING &&
+			execLog.Status != executionlog.StatusAWAITING_APPROVAL
+
+		for {
+			chunks, listErr := s.outputRepo.ListAfter(ctx, execLog.ID, afterSeq, tailBatchSize)
+			if listErr != nil {
+				return listErr
+			}
+			for _, c := range chunks {
+				if err = stream.Send(&executorV1.TailExecutionResponse{
+					Event: &executorV1.TailExecutionResponse_Chunk{Chunk: s.outputRepo.ToProto(c)},
+				}); err != nil {
+					return err
+				}
+				afterSeq = c.Seq
+			}
+			if len(chunks) < tailBatchSize {
+				break
+			}
+		}
+
+		if finished {
+			return stream.Send(&executorV1.TailExecutionResponse{
+				Event: &executorV1.TailExecutionResponse_Finished{Finished: s.execRepo.ToProto(execLog)},
+			})
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
 // GetExecutionOutput retrieves full stdout/stderr for an execution
 func (s *ExecutionService) GetExecutionOutput(ctx context.Context, req *executorV1.GetExecutionOutputRequest) (*executorV1.GetExecutionOutputResponse, error) {
 	entity, err := s.execRepo.GetByID(ctx, req.Id)
@@ -203,6 +696,14 @@ func (s *ExecutionService) GetExecutionOutput(ctx context.Context, req *executor
 		Output:      entity.Output,
 		ErrorOutput: entity.ErrorOutput,
 	}
+
+	// Executions still in flight, or stopped before reporting a result, only
+	// have the output streamed so far
+	if entity.Output == "" && entity.ErrorOutput == "" {
+		if resp.Output, resp.ErrorOutput, err = s.outputRepo.Assemble(ctx, entity.ID); err != nil {
+			return nil, err
+		}
+	}
 	if entity.ExitCode != nil {
 		code := int32(*entity.ExitCode)
 		resp.ExitCode = &code
@@ -211,6 +712,10 @@ func (s *ExecutionService) GetExecutionOutput(ctx context.Context, req *executor
 	return resp, nil
 }
 
+// builtinScriptTypes are run by every agent, including the ones that do not
+// report their script types
+var builtinScriptTypes = []string{"BASH", "JAVASCRIPT", "LUA"}
+
 func scriptTypeToProto(t string) executorV1.ScriptType {
 	switch t {
 	case "BASH":
@@ -219,6 +724,10 @@ func scriptTypeToProto(t string) executorV1.ScriptType {
 		return executorV1.ScriptType_SCRIPT_TYPE_JAVASCRIPT
 	case "LUA":
 		return executorV1.ScriptType_SCRIPT_TYPE_LUA
+	case "PYTHON":
+		return executorV1.ScriptType_SCRIPT_TYPE_PYTHON
+	case "POWERSHELL":
+		return executorV1.ScriptType_SCRIPT_TYPE_POWERSHELL
 	default:
 		return executorV1.ScriptType_SCRIPT_TYPE_UNSPECIFIED
 	}
@@ -240,6 +749,12 @@ func executionStatusToString(s executorV1.ExecutionStatus) string {
 		return "REJECTED_NOT_APPROVED"
 	case executorV1.ExecutionStatus_EXECUTION_STATUS_CLIENT_OFFLINE:
 		return "CLIENT_OFFLINE"
+	case executorV1.ExecutionStatus_EXECUTION_STATUS_TIMED_OUT:
+		return "TIMED_OUT"
+	case executorV1.ExecutionStatus_EXECUTION_STATUS_CANCELLED:
+		return "CANCELLED"
+	case executorV1.ExecutionStatus_EXECUTION_STATUS_AWAITING_APPROVAL:
+		return "AWAITING_APPROVAL"
 	default:
 		return ""
 	}
@@ -0,0 +1,407 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	maxClientLabels        = 64
+	maxClientLabelKeyLen   = 63
+	maxClientLabelValueLen = 255
+	maxClientGroupMembers  = 10000
+)
+
+// InventoryService implements the ExecutorInventoryService gRPC service
+type InventoryService struct {
+	executorV1.UnimplementedExecutorInventoryServiceServer
+
+	log        *log.Helper
+	clientRepo *data.ClientRepo
+	groupRepo  *data.ClientGroupRepo
+	assignRepo *data.AssignmentRepo
+	resolver   *AssignmentResolver
+	cmdReg     CommandRegistry
+	events     *EventEvaluator
+}
+
+// NewInventoryService creates a new InventoryService
+func NewInventoryService(
+	ctx *bootstrap.Context,
+	clientRepo *data.ClientRepo,
+	groupRepo *data.ClientGroupRepo,
+	assignRepo *data.AssignmentRepo,
+	resolver *AssignmentResolver,
+	cmdReg CommandRegistry,
+	events *EventEvaluator,
+) *InventoryService {
+	return &InventoryService{
+		log:        ctx.NewLoggerHelper("executor/service/inventory"),
+		clientRepo: clientRepo,
+		groupRepo:  groupRepo,
+		assignRepo: assignRepo,
+		resolver:   resolver,
+		cmdReg:     cmdReg,
+		events:     events,
+	}
+}
+
+// ListClients lists known clients with their current connection state
+func (s *InventoryService) ListClients(ctx context.Context, req *executorV1.ListClientsRequest) (*executorV1.ListClientsResponse, error) {
+	connected, err := s.connectedSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var connectedIDs []string
+	if req.Online != nil {
+		connectedIDs = make([]string, 0, len(connected))
+		for id := range connected {
+			connectedIDs = append(connectedIDs, id)
+		}
+	}
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.clientRepo.List(ctx, req.Query, req.Online, connectedIDs, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := s.clientRepo.ListLabels(ctx, getTenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]*executorV1.Client, 0, len(entities))
+	for _, e := range entities {
+		clients = append(clients, s.clientRepo.ToProto(e, labels[e.ClientID], connected[e.ClientID]))
+	}
+
+	return &executorV1.ListClientsResponse{
+		Clients: clients,
+		Total:   uint32(total),
+	}, nil
+}
+
+// GetClient retrieves a client
+func (s *InventoryService) GetClient(ctx context.Context, req *executorV1.GetClientRequest) (*executorV1.GetClientResponse, error) {
+	entity, err := s.clientRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, executorV1.ErrorClientNotFound("client not found")
+	}
+	labels, err := s.clientRepo.GetLabels(ctx, getTenantIDFromContext(ctx), entity.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetClientResponse{
+		Client: s.clientRepo.ToProto(entity, labels, s.cmdReg.IsConnected(ctx, entity.ClientID)),
+	}, nil
+}
+
+// UpdateClient updates the description of a client and the labels the
+// caller's tenant set on it. The description is shared by all tenants, so
+// only platform admins can change it.
+func (s *InventoryService) UpdateClient(ctx context.Context, req *executorV1.UpdateClientRequest) (*executorV1.UpdateClientResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	updatedBy := getUserIDAsUint32(ctx)
+
+	if req.Description != nil && !grpcx.IsPlatformAdmin(ctx) {
+		return nil, executorV1.ErrorForbidden("only platform admins can change client descriptions")
+	}
+	if req.Labels != nil {
+		if err := validateClientLabels(req.Labels.GetValues()); err != nil {
+			return nil, err
+		}
+	}
+
+	entity, err := s.clientRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, executorV1.ErrorClientNotFound("client not found")
+	}
+	if req.Description != nil {
+		if entity, err = s.clientRepo.Update(ctx, req.Id, req.Description, updatedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	labels, err := s.clientRepo.GetLabels(ctx, tenantID, entity.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Labels != nil {
+		previousLabels := labels
+		labels = req.Labels.GetValues()
+		if err = s.clientRepo.SetLabels(ctx, tenantID, entity.ClientID, labels, updatedBy); err != nil {
+			return nil, err
+		}
+		s.events.ClientLabelsChanged(tenantID, entity, labels, previousLabels)
+	}
+
+	return &executorV1.UpdateClientResponse{
+		Client: s.clientRepo.ToProto(entity, labels, s.cmdReg.IsConnected(ctx, entity.ClientID)),
+	}, nil
+}
+
+// DeleteClient removes a client from the inventory with the labels of every
+// tenant. Clients are shared by all tenants, so only platform admins can.
+func (s *InventoryService) DeleteClient(ctx context.Context, req *executorV1.DeleteClientRequest) (*emptypb.Empty, error) {
+	if !grpcx.IsPlatformAdmin(ctx) {
+		return nil, executorV1.ErrorForbidden("only platform admins can delete clients")
+	}
+
+	entity, err := s.clientRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, executorV1.ErrorClientNotFound("client not found")
+	}
+	if err = s.clientRepo.DeleteLabels(ctx, entity.ClientID); err != nil {
+		return nil, err
+	}
+	if err = s.clientRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CreateClientGroup creates a client group
+func (s *InventoryService) CreateClientGroup(ctx context.Context, req *executorV1.CreateClientGroupRequest) (*executorV1.CreateClientGroupResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	if err := validateGroupMembers(req.GetSelector(), req.ClientIds); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.groupRepo.Create(ctx, tenantID, req.Name, req.GetDescription(), req.GetSelector(), req.ClientIds, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.CreateClientGroupResponse{
+		Group: s.groupRepo.ToProto(entity),
+	}, nil
+}
+
+// ListClientGroups lists the client groups of the tenant
+func (s *InventoryService) ListClientGroups(ctx context.Context, req *executorV1.ListClientGroupsRequest) (*executorV1.ListClientGroupsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.groupRepo.ListByTenant(ctx, tenantID, req.Name, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*executorV1.ClientGroup, 0, len(entities))
+	for _, e := range entities {
+		groups = append(groups, s.groupRepo.ToProto(e))
+	}
+
+	return &executorV1.ListClientGroupsResponse{
+		Groups: groups,
+		Total:  uint32(total),
+	}, nil
+}
+
+// GetClientGroup retrieves a client group
+func (s *InventoryService) GetClientGroup(ctx context.Context, req *executorV1.GetClientGroupRequest) (*executorV1.GetClientGroupResponse, error) {
+	entity, err := s.getGroup(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetClientGroupResponse{
+		Group: s.groupRepo.ToProto(entity),
+	}, nil
+}
+
+// UpdateClientGroup updates a client group
+func (s *InventoryService) UpdateClientGroup(ctx context.Context, req *executorV1.UpdateClientGroupRequest) (*executorV1.UpdateClientGroupResponse, error) {
+	updatedBy := getUserIDAsUint32(ctx)
+
+	existing, err := s.getGroup(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := existing.Selector
+	if req.Selector != nil {
+		selector = *req.Selector
+	}
+	members := existing.ClientIds
+	var newMembers []string
+	if req.ClientIds != nil {
+		newMembers = req.ClientIds.GetValues()
+		if newMembers == nil {
+			newMembers = []string{}
+		}
+		members = newMembers
+	}
+	if err = validateGroupMembers(selector, members); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.groupRepo.Update(ctx, req.Id, req.Name, req.Description, req.Selector, newMembers, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.UpdateClientGroupResponse{
+		Group: s.groupRepo.ToProto(entity),
+	}, nil
+}
+
+// DeleteClientGroup deletes a client group and the assignments targeting it
+func (s *InventoryService) DeleteClientGroup(ctx context.Context, req *executorV1.DeleteClientGroupRequest) (*emptypb.Empty, error) {
+	if _, err := s.getGroup(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.assignRepo.DeleteByGroupID(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	if err := s.groupRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListClientGroupMembers lists the clients currently in a group
+func (s *InventoryService) ListClientGroupMembers(ctx context.Context, req *executorV1.ListClientGroupMembersRequest) (*executorV1.ListClientGroupMembersResponse, error) {
+	group, err := s.getGroup(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	connected, err := s.connectedSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := s.clientRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := s.clientRepo.ListLabels(ctx, derefTenantID(group.TenantID))
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(entities))
+	clients := make([]*executorV1.Client, 0)
+	for _, e := range entities {
+		known[e.ClientID] = true
+		if s.resolver.GroupContains(group, e.ClientID, labels[e.ClientID]) {
+			clients = append(clients, s.clientRepo.ToProto(e, labels[e.ClientID], connected[e.ClientID]))
+		}
+	}
+
+	var pending []string
+	for _, id := range group.ClientIds {
+		if !known[id] {
+			pending = append(pending, id)
+		}
+	}
+
+	return &executorV1.ListClientGroupMembersResponse{
+		Clients:          clients,
+		PendingClientIds: pending,
+	}, nil
+}
+
+// getGroup loads a group of the caller's tenant
+func (s *InventoryService) getGroup(ctx context.Context, id string) (*ent.ClientGroup, error) {
+	entity, err := s.groupRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorClientGroupNotFound("client group not found")
+	}
+	return entity, nil
+}
+
+// connectedSet returns the CNs of all clients currently holding a command stream
+func (s *InventoryService) connectedSet(ctx context.Context) (map[string]bool, error) {
+	connected, err := s.cmdReg.ListConnected(ctx)
+	if err != nil {
+		s.log.Errorf("list connected clients failed: %v", err)
+		return nil, executorV1.ErrorInternalServerError("list connected clients failed")
+	}
+	set := make(map[string]bool, len(connected))
+	for _, c := range connected {
+		set[c.ClientID] = true
+	}
+	return set, nil
+}
+
+// validateClientLabels checks label count and key/value syntax
+func validateClientLabels(labels map[string]string) error {
+	if len(labels) > maxClientLabels {
+		return executorV1.ErrorBadRequest("a client can have at most %d labels", maxClientLabels)
+	}
+	for k, v := range labels {
+		// Keys and values must be expressible in a label selector
+		if err := validateSelectorKey(k); err != nil {
+			return executorV1.ErrorBadRequest("%s", err.Error())
+		}
+		if err := validateSelectorValue(v); err != nil {
+			return executorV1.ErrorBadRequest("%s", err.Error())
+		}
+	}
+	return nil
+}
+
+// validateGroupMembers checks a group's selector and static members; a group
+// needs at least one of them
+func validateGroupMembers(selector string, clientIDs []string) error {
+	if selector == "" && len(clientIDs) == 0 {
+		return executorV1.ErrorBadRequest("a client group needs a selector or at least one client ID")
+	}
+	if selector != "" {
+		if err := validateLabelSelector(selector); err != nil {
+			return err
+		}
+	}
+	if len(clientIDs) > maxClientGroupMembers {
+		return executorV1.ErrorBadRequest("a client group can list at most %d client IDs", maxClientGroupMembers)
+	}
+	for _, id := range clientIDs {
+		if id == "" || len(id) > 255 {
+			return executorV1.ErrorBadRequest("client IDs must be 1 to 255 characters")
+		}
+	}
+	return nil
+}
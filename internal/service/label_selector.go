@@ -0,0 +1,238 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+type selectorOp int
+
+const (
+	selectorOpEquals selectorOp = iota
+	selectorOpNotEquals
+	selectorOpIn
+	selectorOpNotIn
+	selectorOpExists
+	selectorOpNotExists
+)
+
+// selectorRequirement is a single comma-separated term of a label selector
+type selectorRequirement struct {
+	key    string
+	op     selectorOp
+	values []string
+}
+
+// labelSelector matches client labels. The syntax follows Kubernetes
+// equality- and set-based selectors: "role=web,env!=dev,tier in (a,b),gpu,!legacy".
+// All requirements must hold.
+type labelSelector []selectorRequirement
+
+// parseLabelSelector parses a selector string; an empty selector is an error
+func parseLabelSelector(s string) (labelSelector, error) {
+	terms, err := splitSelectorTerms(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("selector is empty")
+	}
+
+	sel := make(labelSelector, 0, len(terms))
+	for _, term := range terms {
+		req, err := parseSelectorRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		sel = append(sel, req)
+	}
+	return sel, nil
+}
+
+// validateLabelSelector parses a selector and maps failures to INVALID_LABEL_SELECTOR
+func validateLabelSelector(s string) error {
+	if _, err := parseLabelSelector(s); err != nil {
+		return executorV1.ErrorInvalidLabelSelector("invalid label selector: %s", err.Error())
+	}
+	return nil
+}
+
+// Matches reports whether the labels satisfy every requirement
+func (sel labelSelector) Matches(labels map[string]string) bool {
+	for _, req := range sel {
+		value, ok := labels[req.key]
+		switch req.op {
+		case selectorOpEquals:
+			if !ok || value != req.values[0] {
+				return false
+			}
+		case selectorOpNotEquals:
+			if ok && value == req.values[0] {
+				return false
+			}
+		case selectorOpIn:
+			if !ok || !slices.Contains(req.values, value) {
+				return false
+			}
+		case selectorOpNotIn:
+			if ok && slices.Contains(req.values, value) {
+				return false
+			}
+		case selectorOpExists:
+			if !ok {
+				return false
+			}
+		case selectorOpNotExists:
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// splitSelectorTerms splits on commas that are not inside a value set
+func splitSelectorTerms(s string) ([]string, error) {
+	var terms []string
+	depth := 0
+	start := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("nested parentheses are not allowed")
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				terms = append(terms, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses")
+	}
+	terms = append(terms, s[start:])
+
+	out := terms[:0]
+	for _, t := range terms {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			if len(terms) > 1 {
+				return nil, fmt.Errorf("empty requirement")
+			}
+			continue
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// parseSelectorRequirement parses one term such as "env=prod" or "tier in (a,b)"
+func parseSelectorRequirement(term string) (selectorRequirement, error) {
+	if strings.HasPrefix(term, "!") {
+		key := strings.TrimSpace(term[1:])
+		if err := validateSelectorKey(key); err != nil {
+			return selectorRequirement{}, err
+		}
+		return selectorRequirement{key: key, op: selectorOpNotExists}, nil
+	}
+
+	if idx := strings.Index(term, "!="); idx >= 0 {
+		return newEqualityRequirement(term[:idx], term[idx+2:], selectorOpNotEquals)
+	}
+	if idx := strings.Index(term, "=="); idx >= 0 {
+		return newEqualityRequirement(term[:idx], term[idx+2:], selectorOpEquals)
+	}
+	if idx := strings.Index(term, "="); idx >= 0 {
+		return newEqualityRequirement(term[:idx], term[idx+1:], selectorOpEquals)
+	}
+
+	if open := strings.Index(term, "("); open >= 0 {
+		fields := strings.Fields(term[:open])
+		if len(fields) != 2 || !strings.HasSuffix(term, ")") {
+			return selectorRequirement{}, fmt.Errorf("invalid set requirement %q", term)
+		}
+		var op selectorOp
+		switch fields[1] {
+		case "in":
+			op = selectorOpIn
+		case "notin":
+			op = selectorOpNotIn
+		default:
+			return selectorRequirement{}, fmt.Errorf("unknown operator %q", fields[1])
+		}
+		if err := validateSelectorKey(fields[0]); err != nil {
+			return selectorRequirement{}, err
+		}
+
+		var values []string
+		for _, v := range strings.Split(term[open+1:len(term)-1], ",") {
+			v = strings.TrimSpace(v)
+			if err := validateSelectorValue(v); err != nil {
+				return selectorRequirement{}, err
+			}
+			values = append(values, v)
+		}
+		return selectorRequirement{key: fields[0], op: op, values: values}, nil
+	}
+
+	if err := validateSelectorKey(term); err != nil {
+		return selectorRequirement{}, err
+	}
+	return selectorRequirement{key: term, op: selectorOpExists}, nil
+}
+
+func newEqualityRequirement(key, value string, op selectorOp) (selectorRequirement, error) {
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	if err := validateSelectorKey(key); err != nil {
+		return selectorRequirement{}, err
+	}
+	if err := validateSelectorValue(value); err != nil {
+		return selectorRequirement{}, err
+	}
+	return selectorRequirement{key: key, op: op, values: []string{value}}, nil
+}
+
+func validateSelectorKey(key string) error {
+	if key == "" || len(key) > maxClientLabelKeyLen {
+		return fmt.Errorf("label keys must be 1 to %d characters", maxClientLabelKeyLen)
+	}
+	if !isSelectorToken(key) {
+		return fmt.Errorf("invalid label key %q", key)
+	}
+	return nil
+}
+
+func validateSelectorValue(value string) error {
+	if len(value) > maxClientLabelValueLen {
+		return fmt.Errorf("label values must be at most %d characters", maxClientLabelValueLen)
+	}
+	if value != "" && !isSelectorToken(value) {
+		return fmt.Errorf("invalid label value %q", value)
+	}
+	return nil
+}
+
+// isSelectorToken allows the characters used in label keys and values
+func isSelectorToken(s string) bool {
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == '/':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/maintenancewindow"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// maxWindowPatterns bounds the client patterns of a maintenance window
+const maxWindowPatterns = 1000
+
+// MaintenanceWindowService implements the ExecutorMaintenanceWindowService gRPC service
+type MaintenanceWindowService struct {
+	executorV1.UnimplementedExecutorMaintenanceWindowServiceServer
+
+	log        *log.Helper
+	windowRepo *data.MaintenanceWindowRepo
+}
+
+// NewMaintenanceWindowService creates a new MaintenanceWindowService
+func NewMaintenanceWindowService(
+	ctx *bootstrap.Context,
+	windowRepo *data.MaintenanceWindowRepo,
+) *MaintenanceWindowService {
+	return &MaintenanceWindowService{
+		log:        ctx.NewLoggerHelper("executor/service/maintenance_window"),
+		windowRepo: windowRepo,
+	}
+}
+
+// CreateMaintenanceWindow creates a maintenance window
+func (s *MaintenanceWindowService) CreateMaintenanceWindow(ctx context.Context, req *executorV1.CreateMaintenanceWindowRequest) (*executorV1.CreateMaintenanceWindowResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	if req.Timezone == nil || *req.Timezone == "" {
+		tz := defaultScheduleTimezone
+		req.Timezone = &tz
+	}
+	if req.Enabled == nil {
+		enabled := true
+		req.Enabled = &enabled
+	}
+
+	if err := validateWindowPatterns(req.ClientPatterns); err != nil {
+		return nil, err
+	}
+	if err := validateWindowDuration(req.DurationMinutes); err != nil {
+		return nil, err
+	}
+	if err := validateMaintenanceWindowAction(req.GetAction()); err != nil {
+		return nil, err
+	}
+	if _, _, err := parseScheduleTiming(req.CronExpression, req.GetTimezone()); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.windowRepo.Create(ctx, tenantID, req, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.CreateMaintenanceWindowResponse{
+		Window: s.toProto(entity, time.Now()),
+	}, nil
+}
+
+// ListMaintenanceWindows lists the maintenance windows of the tenant
+func (s *MaintenanceWindowService) ListMaintenanceWindows(ctx context.Context, req *executorV1.ListMaintenanceWindowsRequest) (*executorV1.ListMaintenanceWindowsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.windowRepo.ListByTenant(ctx, tenantID, req.Enabled, req.ClientId, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	windows := make([]*executorV1.MaintenanceWindow, 0, len(entities))
+	for _, e := range entities {
+		windows = append(windows, s.toProto(e, now))
+	}
+
+	return &executorV1.ListMaintenanceWindowsResponse{
+		Windows: windows,
+		Total:   uint32(total),
+	}, nil
+}
+
+// GetMaintenanceWindow retrieves a maintenance window
+func (s *MaintenanceWindowService) GetMaintenanceWindow(ctx context.Context, req *executorV1.GetMaintenanceWindowRequest) (*executorV1.GetMaintenanceWindowResponse, error) {
+	entity, err := s.getWindow(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetMaintenanceWindowResponse{
+		Window: s.toProto(entity, time.Now()),
+	}, nil
+}
+
+// UpdateMaintenanceWindow updates a maintenance window
+func (s *MaintenanceWindowService) UpdateMaintenanceWindow(ctx context.Context, req *executorV1.UpdateMaintenanceWindowRequest) (*executorV1.UpdateMaintenanceWindowResponse, error) {
+	updatedBy := getUserIDAsUint32(ctx)
+
+	existing, err := s.getWindow(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var patterns []string
+	if req.ClientPatterns != nil {
+		patterns = req.ClientPatterns.GetValues()
+		if err = validateWindowPatterns(patterns); err != nil {
+			return nil, err
+		}
+	}
+	if req.DurationMinutes != nil {
+		if err = validateWindowDuration(*req.DurationMinutes); err != nil {
+			return nil, err
+		}
+	}
+	if req.Action != nil {
+		if err = validateMaintenanceWindowAction(*req.Action); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Timezone != nil && *req.Timezone == "" {
+		tz := defaultScheduleTimezone
+		req.Timezone = &tz
+	}
+	expr, tz := existing.CronExpression, existing.Timezone
+	if req.CronExpression != nil {
+		expr = *req.CronExpression
+	}
+	if req.Timezone != nil {
+		tz = *req.Timezone
+	}
+	if _, _, err = parseScheduleTiming(expr, tz); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.windowRepo.Update(ctx, req.Id, req, patterns, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.UpdateMaintenanceWindowResponse{
+		Window: s.toProto(entity, time.Now()),
+	}, nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window
+func (s *MaintenanceWindowService) DeleteMaintenanceWindow(ctx context.Context, req *executorV1.DeleteMaintenanceWindowRequest) (*emptypb.Empty, error) {
+	if _, err := s.getWindow(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	if err := s.windowRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getWindow loads a maintenance window of the caller's tenant
+func (s *MaintenanceWindowService) getWindow(ctx context.Context, id string) (*ent.MaintenanceWindow, error) {
+	entity, err := s.windowRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorMaintenanceWindowNotFound("maintenance window not found")
+	}
+	return entity, nil
+}
+
+// toProto converts a window and adds whether it is open at now
+func (s *MaintenanceWindowService) toProto(entity *ent.MaintenanceWindow, now time.Time) *executorV1.MaintenanceWindow {
+	proto := s.windowRepo.ToProto(entity)
+	open, next, err := windowState(entity, now)
+	if err != nil {
+		s.log.Warnf("Maintenance window %s has invalid timing: %v", entity.ID, err)
+		return proto
+	}
+	proto.Open = open
+	if next != nil {
+		proto.NextOpenAt = timestamppb.New(*next)
+	}
+	return proto
+}
+
+// windowState reports whether a window is open at now and when it opens next
+func windowState(window *ent.MaintenanceWindow, now time.Time) (bool, *time.Time, error) {
+	cron, loc, err := parseScheduleTiming(window.CronExpression, window.Timezone)
+	if err != nil {
+		return false, nil, err
+	}
+	local := now.In(loc)
+
+	// Open if the window opened within the last duration
+	duration := time.Duration(window.DurationMinutes) * time.Minute
+	opened := cron.Next(local.Add(-duration))
+	open := !opened.IsZero() && !opened.After(local)
+
+	next := cron.Next(local)
+	if next.IsZero() {
+		return open, nil, nil
+	}
+	return open, &next, nil
+}
+
+// maintenanceOrigin decides whether an ad-hoc execution may run on a client
+// governed by the given maintenance windows at now. Clients without windows,
+// or with one open, run right away. Outside the windows the strictest action
+// applies: REJECT fails, REQUIRE_OVERRIDE runs only with an override and a
+// justification, and QUEUE holds the execution until the next window opens.
+// Returns the origin to create the execution with, nil if it is unrestricted.
+func maintenanceOrigin(windows []*ent.MaintenanceWindow, clientID string, now time.Time, override bool, justification string) (*data.ExecutionOrigin, error) {
+	justification = strings.TrimSpace(justification)
+	if override && justification == "" {
+		return nil, executorV1.ErrorBadRequest("override_justification is required to override maintenance windows")
+	}
+	if len(windows) == 0 {
+		return nil, nil
+	}
+
+	var action maintenancewindow.Action
+	var nextOpen *time.Time
+	for _, w := range windows {
+		open, next, err := windowState(w, now)
+		if err != nil {
+			continue
+		}
+		if open {
+			return nil, nil
+		}
+		if next != nil && (nextOpen == nil || next.Before(*nextOpen)) {
+			nextOpen = next
+		}
+		if actionStrictness(w.Action) > actionStrictness(action) {
+			action = w.Action
+		}
+	}
+
+	switch action {
+	case maintenancewindow.ActionREQUIRE_OVERRIDE:
+		if !override {
+			return nil, executorV1.ErrorMaintenanceOverrideRequired("client %s is outside its maintenance windows; an override with a justification is required", clientID)
+		}
+		return &data.ExecutionOrigin{MaintenanceOverride: justification}, nil
+	case maintenancewindow.ActionQUEUE:
+		if nextOpen != nil {
+			return &data.ExecutionOrigin{HeldUntil: nextOpen}, nil
+		}
+	case "":
+		// Only windows with invalid timing, which cannot restrict anything
+		return nil, nil
+	}
+
+	if nextOpen != nil {
+		return nil, executorV1.ErrorOutsideMaintenanceWindow("client %s is outside its maintenance windows; the next one opens at %s", clientID, nextOpen.Format(time.RFC3339))
+	}
+	return nil, executorV1.ErrorOutsideMaintenanceWindow("client %s is outside its maintenance windows", clientID)
+}
+
+// actionStrictness orders window actions from most lenient to strictest
+func actionStrictness(action maintenancewindow.Action) int {
+	switch action {
+	case maintenancewindow.ActionQUEUE:
+		return 1
+	case maintenancewindow.ActionREQUIRE_OVERRIDE:
+		return 2
+	case maintenancewindow.ActionREJECT:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// validateWindowPatterns checks the client patterns of a maintenance window
+func validateWindowPatterns(patterns []string) error {
+	if len(patterns) == 0 {
+		return executorV1.ErrorBadRequest("a maintenance window needs client_patterns")
+	}
+	if len(patterns) > maxWindowPatterns {
+		return executorV1.ErrorBadRequest("a maintenance window can list at most %d client patterns", maxWindowPatterns)
+	}
+	for _, p := range patterns {
+		if p == "" || len(p) > 255 {
+			return executorV1.ErrorBadRequest("client patterns must be 1 to 255 characters")
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return executorV1.ErrorBadRequest("invalid client pattern %q", p)
+		}
+	}
+	return nil
+}
+
+// validateWindowDuration checks a window's duration, at most one week
+func validateWindowDuration(minutes uint32) error {
+	if minutes < 1 || minutes > 7*24*60 {
+		return executorV1.ErrorBadRequest("duration_minutes must be between 1 and %d", 7*24*60)
+	}
+	return nil
+}
+
+// validateMaintenanceWindowAction checks a window action sent by a client
+func validateMaintenanceWindowAction(action executorV1.MaintenanceWindowAction) error {
+	if _, ok := executorV1.MaintenanceWindowAction_name[int32(action)]; !ok {
+		return executorV1.ErrorBadRequest("unknown action %d", action)
+	}
+	return nil
+}
@@ -15,11 +15,32 @@ import (
 // ProviderSet is the Wire provider set for service layer
 var ProviderSet = wire.NewSet(
 	service.NewCommandRegistry,
+	service.NewRetryPlanner,
+	service.NewCommandQueue,
 	service.NewScriptService,
+	service.NewAssignmentResolver,
 	service.NewAssignmentService,
 	service.NewExecutionService,
 	service.NewClientService,
 	service.NewStatisticsService,
 	service.NewBackupService,
+	service.NewSettingsService,
+	service.NewInventoryService,
+	service.NewExecutionReaper,
+	service.NewRunOrchestrator,
+	service.NewScheduleService,
+	service.NewScheduler,
+	service.NewEventEvaluator,
+	service.NewEventRuleService,
+	service.NewWorkflowEngine,
+	service.NewWorkflowService,
+	service.NewRetryDispatcher,
+	service.NewConcurrencyGate,
+	service.NewMaintenanceWindowService,
+	service.NewExecutionApprovalService,
+	service.NewSecretResolver,
+	service.NewSecretService,
+	service.NewCommandSigner,
+	service.NewSigningKeyService,
 	metrics.NewCollector,
 )
@@ -0,0 +1,468 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	redisRegistryClientsKey       = "executor:registry:clients"
+	redisRegistryInstancePrefix   = "executor:registry:instance:"
+	redisRegistryCommandsPrefix   = "executor:registry:commands:"
+	redisRegistryDeliveryPrefix   = "executor:registry:delivery:"
+	redisRegistryDeliveryTTL      = time.Minute
+	redisRegistryDeliveryWorkers  = 64
+	redisRegistryInstanceTTL      = 30 * time.Second
+	redisRegistryHeartbeatPeriod  = 10 * time.Second
+	redisRegistryOperationTimeout = 5 * time.Second
+)
+
+// unregisterScript removes a client's presence only if it still points at the
+// given replica, so a late disconnect cannot drop a newer connection elsewhere.
+var unregisterScript = redis.NewScript(`
+local v = redis.call('HGET', KEYS[1], ARGV[1])
+if v and cjson.decode(v).instanceId == ARGV[2] then
+	return redis.call('HDEL', KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+// touchScript refreshes a client's presence only if it still points at the given replica.
+var touchScript = redis.NewScript(`
+local v = redis.call('HGET', KEYS[1], ARGV[1])
+if v and cjson.decode(v).instanceId == ARGV[2] then
+	return redis.call('HSET', KEYS[1], ARGV[1], ARGV[3])
+end
+return 0
+`)
+
+// redisPresence is the value stored per client in the shared presence hash.
+type redisPresence struct {
+	InstanceID  string          `json:"instanceId"`
+	Version     string          `json:"version"`
+	ConnectedAt time.Time       `json:"connectedAt"`
+	LastSeen    time.Time       `json:"lastSeen"`
+	Heartbeat   ClientHeartbeat `json:"heartbeat"`
+}
+
+// redisEnvelope is published to the owning replica's command channel. The
+// owner pushes the outcome of the delivery to the DeliveryKey list: empty on
+// success, the error otherwise. Before handing the command to the stream the
+// owner claims the delivery, and a forwarding replica that gave up waiting
+// calls it off with the same claim, so a command is either delivered or
+// reported undelivered, never both.
+type redisEnvelope struct {
+	ClientID    string `json:"clientId"`
+	Command     []byte `json:"command"`
+	DeliveryKey string `json:"deliveryKey"`
+}
+
+// RedisCommandRegistry shares client presence between executor replicas.
+// Streams stay on the replica the client connected to; commands for clients
+// held by another replica are forwarded over that replica's pub/sub channel.
+type RedisCommandRegistry struct {
+	log        *log.Helper
+	rdb        *redis.Client
+	local      *MemoryCommandRegistry
+	instanceID string
+	pubsub     *redis.PubSub
+	stop       chan struct{}
+	done       chan struct{}
+
+	// deliveries bounds the forwarded commands handed to local streams at once
+	deliveries chan struct{}
+	delivering sync.WaitGroup
+}
+
+// NewRedisCommandRegistry creates a RedisCommandRegistry, subscribes to this
+// replica's command channel and starts the liveness heartbeat.
+func NewRedisCommandRegistry(ctx *bootstrap.Context, rdb *redis.Client) (*RedisCommandRegistry, error) {
+	r := &RedisCommandRegistry{
+		log:        ctx.NewLoggerHelper("executor/service/redis_command_registry"),
+		rdb:        rdb,
+		local:      NewMemoryCommandRegistry(),
+		instanceID: uuid.New().String(),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+		deliveries: make(chan struct{}, redisRegistryDeliveryWorkers),
+	}
+
+	opCtx, cancel := context.WithTimeout(context.Background(), redisRegistryOperationTimeout)
+	defer cancel()
+
+	if err := r.heartbeat(opCtx); err != nil {
+		return nil, fmt.Errorf("register executor instance in redis: %w", err)
+	}
+
+	r.pubsub = rdb.Subscribe(opCtx, redisRegistryCommandsPrefix+r.instanceID)
+	if _, err := r.pubsub.Receive(opCtx); err != nil {
+		_ = r.pubsub.Close()
+		return nil, fmt.Errorf("subscribe to command channel: %w", err)
+	}
+
+	go r.run()
+
+	r.log.Infof("Redis command registry started (instance %s)", r.instanceID)
+
+	return r, nil
+}
+
+// Register creates a local command channel and publishes the client's presence.
+func (r *RedisCommandRegistry) Register(clientID, version string) <-chan *executorV1.ExecutionCommand {
+	ch := r.local.Register(clientID, version)
+
+	now := time.Now()
+	value, err := json.Marshal(redisPresence{
+		InstanceID:  r.instanceID,
+		Version:     version,
+		ConnectedAt: now,
+		LastSeen:    now,
+	})
+	if err != nil {
+		r.log.Errorf("marshal presence for client %s failed: %v", clientID, err)
+		return ch
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisRegistryOperationTimeout)
+	defer cancel()
+
+	if err = r.rdb.HSet(ctx, redisRegistryClientsKey, clientID, value).Err(); err != nil {
+		r.log.Errorf("publish presence for client %s failed: %v", clientID, err)
+	}
+
+	return ch
+}
+
+// Unregister removes the local channel and, if it was still current, the client's presence.
+func (r *RedisCommandRegistry) Unregister(clientID string, ch <-chan *executorV1.ExecutionCommand) {
+	if !r.local.unregister(clientID, ch) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisRegistryOperationTimeout)
+	defer cancel()
+
+	if err := unregisterScript.Run(ctx, r.rdb, []string{redisRegistryClientsKey}, clientID, r.instanceID).Err(); err != nil {
+		r.log.Errorf("remove presence for client %s failed: %v", clientID, err)
+	}
+}
+
+// Touch records the heartbeat locally and refreshes the client's shared presence.
+func (r *RedisCommandRegistry) Touch(ctx context.Context, clientID string, hb ClientHeartbeat) {
+	info, ok := r.local.touch(clientID, hb)
+	if !ok {
+		return
+	}
+
+	value, err := json.Marshal(redisPresence{
+		InstanceID:  r.instanceID,
+		Version:     info.Version,
+		ConnectedAt: info.ConnectedAt,
+		LastSeen:    info.LastSeen,
+		Heartbeat:   info.Heartbeat,
+	})
+	if err != nil {
+		r.log.Errorf("marshal presence for client %s failed: %v", clientID, err)
+		return
+	}
+
+	if err = touchScript.Run(ctx, r.rdb, []string{redisRegistryClientsKey}, clientID, r.instanceID, value).Err(); err != nil {
+		r.log.Errorf("refresh presence for client %s failed: %v", clientID, err)
+	}
+}
+
+// Send delivers the command locally if the client is connected to this replica,
+// otherwise forwards it to the replica holding the client's stream and waits
+// for that replica to report whether the stream accepted it. Without a report
+// in time the delivery is called off and the command counts as undelivered,
+// unless the owner already took it on; then Send waits for its report once
+// more and, should that not come either, leaves the command as sent rather
+// than risk delivering it twice.
+func (r *RedisCommandRegistry) Send(ctx context.Context, clientID string, cmd *executorV1.ExecutionCommand) error {
+	if r.local.IsConnected(ctx, clientID) {
+		return r.local.Send(ctx, clientID, cmd)
+	}
+
+	presence, err := r.lookup(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	if presence == nil || presence.InstanceID == r.instanceID {
+		return fmt.Errorf("client %s not connected", clientID)
+	}
+
+	payload, err := proto.Marshal(cmd)
+	if err != nil {
+		return fmt.Errorf("marshal command for client %s: %w", clientID, err)
+	}
+	deliveryKey := redisRegistryDeliveryPrefix + uuid.New().String()
+	envelope, err := json.Marshal(redisEnvelope{ClientID: clientID, Command: payload, DeliveryKey: deliveryKey})
+	if err != nil {
+		return fmt.Errorf("marshal envelope for client %s: %w", clientID, err)
+	}
+
+	receivers, err := r.rdb.Publish(ctx, redisRegistryCommandsPrefix+presence.InstanceID, envelope).Result()
+	if err != nil {
+		return fmt.Errorf("forward command to client %s: %w", clientID, err)
+	}
+	if receivers == 0 {
+		return fmt.Errorf("client %s not connected (instance %s unavailable)", clientID, presence.InstanceID)
+	}
+
+	reply, err := r.awaitDelivery(ctx, deliveryKey)
+	if err == redis.Nil {
+		calledOff, claimErr := r.rdb.SetNX(ctx, deliveryClaimKey(deliveryKey), "cancelled", redisRegistryDeliveryTTL).Result()
+		if claimErr != nil {
+			return fmt.Errorf("call off delivery to client %s: %w", clientID, claimErr)
+		}
+		if calledOff {
+			return fmt.Errorf("no delivery report for client %s from instance %s", clientID, presence.InstanceID)
+		}
+		reply, err = r.awaitDelivery(ctx, deliveryKey)
+		if err == redis.Nil {
+			r.log.Warnf("Instance %s took on command %s for client %s without reporting the outcome", presence.InstanceID, cmd.GetCommandId(), clientID)
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("wait for delivery to client %s: %w", clientID, err)
+	}
+	if reply[1] != "" {
+		return fmt.Errorf("deliver command to client %s: %s", clientID, reply[1])
+	}
+
+	return nil
+}
+
+// awaitDelivery waits for the report of a forwarded delivery; redis.Nil when
+// none came in time
+func (r *RedisCommandRegistry) awaitDelivery(ctx context.Context, deliveryKey string) ([]string, error) {
+	return r.rdb.BLPop(ctx, commandSendTimeout+redisRegistryOperationTimeout, deliveryKey).Result()
+}
+
+// IsConnected checks whether a client has an active stream on any live replica.
+func (r *RedisCommandRegistry) IsConnected(ctx context.Context, clientID string) bool {
+	if r.local.IsConnected(ctx, clientID) {
+		return true
+	}
+
+	presence, err := r.lookup(ctx, clientID)
+	if err != nil || presence == nil {
+		return false
+	}
+
+	alive, err := r.rdb.Exists(ctx, redisRegistryInstancePrefix+presence.InstanceID).Result()
+	return err == nil && alive > 0
+}
+
+// ListConnected returns the clients connected to all live replicas.
+// Entries left behind by replicas that stopped heartbeating are pruned.
+func (r *RedisCommandRegistry) ListConnected(ctx context.Context) ([]ConnectedClientInfo, error) {
+	entries, err := r.rdb.HGetAll(ctx, redisRegistryClientsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("list connected clients: %w", err)
+	}
+
+	alive := map[string]bool{r.instanceID: true}
+	result := make([]ConnectedClientInfo, 0, len(entries))
+	var stale []string
+
+	for clientID, value := range entries {
+		var presence redisPresence
+		if err = json.Unmarshal([]byte(value), &presence); err != nil {
+			stale = append(stale, clientID)
+			continue
+		}
+
+		ok, checked := alive[presence.InstanceID]
+		if !checked {
+			n, existsErr := r.rdb.Exists(ctx, redisRegistryInstancePrefix+presence.InstanceID).Result()
+			if existsErr != nil {
+				return nil, fmt.Errorf("check executor instance %s: %w", presence.InstanceID, existsErr)
+			}
+			ok = n > 0
+			alive[presence.InstanceID] = ok
+		}
+		if !ok {
+			stale = append(stale, clientID)
+			continue
+		}
+
+		result = append(result, ConnectedClientInfo{
+			ClientID:    clientID,
+			Version:     presence.Version,
+			ConnectedAt: presence.ConnectedAt,
+			LastSeen:    presence.LastSeen,
+			Heartbeat:   presence.Heartbeat,
+		})
+	}
+
+	if len(stale) > 0 {
+		if err = r.rdb.HDel(ctx, redisRegistryClientsKey, stale...).Err(); err != nil {
+			r.log.Warnf("prune %d stale client presence entries failed: %v", len(stale), err)
+		}
+	}
+
+	return result, nil
+}
+
+// Close stops the heartbeat and subscription and withdraws this replica's clients.
+func (r *RedisCommandRegistry) Close() {
+	close(r.stop)
+	_ = r.pubsub.Close()
+	<-r.done
+	r.delivering.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisRegistryOperationTimeout)
+	defer cancel()
+
+	clients, _ := r.local.ListConnected(ctx)
+	for _, c := range clients {
+		if err := unregisterScript.Run(ctx, r.rdb, []string{redisRegistryClientsKey}, c.ClientID, r.instanceID).Err(); err != nil {
+			r.log.Warnf("remove presence for client %s failed: %v", c.ClientID, err)
+		}
+	}
+	if err := r.rdb.Del(ctx, redisRegistryInstancePrefix+r.instanceID).Err(); err != nil {
+		r.log.Warnf("remove executor instance %s failed: %v", r.instanceID, err)
+	}
+}
+
+// lookup returns the presence entry of a client, or nil if it has none.
+func (r *RedisCommandRegistry) lookup(ctx context.Context, clientID string) (*redisPresence, error) {
+	value, err := r.rdb.HGet(ctx, redisRegistryClientsKey, clientID).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lookup client %s: %w", clientID, err)
+	}
+
+	var presence redisPresence
+	if err = json.Unmarshal([]byte(value), &presence); err != nil {
+		return nil, fmt.Errorf("decode presence for client %s: %w", clientID, err)
+	}
+	return &presence, nil
+}
+
+// heartbeat refreshes this replica's liveness key.
+func (r *RedisCommandRegistry) heartbeat(ctx context.Context) error {
+	return r.rdb.Set(ctx, redisRegistryInstancePrefix+r.instanceID, time.Now().Unix(), redisRegistryInstanceTTL).Err()
+}
+
+// run hands forwarded commands to delivery goroutines and keeps the liveness
+// key fresh. Deliveries may block on a slow stream, so they never hold up the
+// heartbeat.
+func (r *RedisCommandRegistry) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(redisRegistryHeartbeatPeriod)
+	defer ticker.Stop()
+
+	messages := r.pubsub.Channel()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), redisRegistryOperationTimeout)
+			if err := r.heartbeat(ctx); err != nil {
+				r.log.Errorf("refresh executor instance %s failed: %v", r.instanceID, err)
+			}
+			cancel()
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			r.delivering.Add(1)
+			go func(payload string) {
+				defer r.delivering.Done()
+				r.deliveries <- struct{}{}
+				defer func() { <-r.deliveries }()
+				r.deliver(payload)
+			}(msg.Payload)
+		}
+	}
+}
+
+// deliver decodes a forwarded command, hands it to the local stream and
+// reports the outcome to the forwarding replica.
+func (r *RedisCommandRegistry) deliver(payload string) {
+	var envelope redisEnvelope
+	if err := json.Unmarshal([]byte(payload), &envelope); err != nil {
+		r.log.Errorf("decode forwarded command failed: %v", err)
+		return
+	}
+
+	cmd := &executorV1.ExecutionCommand{}
+	if err := proto.Unmarshal(envelope.Command, cmd); err != nil {
+		r.log.Errorf("decode forwarded command for client %s failed: %v", envelope.ClientID, err)
+		r.reportDelivery(envelope.DeliveryKey, err)
+		return
+	}
+
+	// Envelopes of replicas that do not wait for a report carry no key
+	if envelope.DeliveryKey != "" {
+		claimCtx, claimCancel := context.WithTimeout(context.Background(), redisRegistryOperationTimeout)
+		claimed, err := r.rdb.SetNX(claimCtx, deliveryClaimKey(envelope.DeliveryKey), "delivering", redisRegistryDeliveryTTL).Result()
+		claimCancel()
+		if err != nil {
+			r.log.Errorf("claim delivery of command %s failed: %v", cmd.GetCommandId(), err)
+			r.reportDelivery(envelope.DeliveryKey, err)
+			return
+		}
+		if !claimed {
+			r.log.Infof("Delivery of command %s to client %s was called off by the forwarding instance", cmd.GetCommandId(), envelope.ClientID)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandSendTimeout)
+	defer cancel()
+
+	err := r.local.Send(ctx, envelope.ClientID, cmd)
+	if err != nil {
+		r.log.Warnf("deliver forwarded command %s to client %s failed: %v", cmd.GetCommandId(), envelope.ClientID, err)
+	}
+	r.reportDelivery(envelope.DeliveryKey, err)
+}
+
+// deliveryClaimKey returns the key a forwarded delivery is claimed or called
+// off with
+func deliveryClaimKey(deliveryKey string) string {
+	return deliveryKey + ":claim"
+}
+
+// reportDelivery pushes the outcome of a forwarded delivery for the
+// forwarding replica's Send to pick up
+func (r *RedisCommandRegistry) reportDelivery(key string, deliveryErr error) {
+	if key == "" {
+		return
+	}
+
+	result := ""
+	if deliveryErr != nil {
+		result = deliveryErr.Error()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisRegistryOperationTimeout)
+	defer cancel()
+
+	pipe := r.rdb.TxPipeline()
+	pipe.RPush(ctx, key, result)
+	pipe.Expire(ctx, key, redisRegistryDeliveryTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Errorf("report delivery to %s failed: %v", key, err)
+	}
+}
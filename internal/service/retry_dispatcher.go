@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionrun"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const retryDispatcherPeriod = 10 * time.Second
+
+// RetryDispatcher starts the retries scheduled by the RetryPlanner once they
+// are due. A retry is a new execution linked to the original one; it keeps the
+// trigger, run, event and workflow step of the attempt it replaces. Retries
+// are claimed with a compare-and-set, so each is started once even with
+// several replicas.
+type RetryDispatcher struct {
+	log          *log.Helper
+	execSvc      *ExecutionService
+	execRepo     *data.ExecutionLogRepo
+	scriptRepo   *data.ScriptRepo
+	runRepo      *data.ExecutionRunRepo
+	settingsRepo *data.TenantSettingRepo
+	events       *EventEvaluator
+	workflows    *WorkflowEngine
+	stop         chan struct{}
+}
+
+// NewRetryDispatcher creates a new RetryDispatcher
+func NewRetryDispatcher(
+	ctx *bootstrap.Context,
+	execSvc *ExecutionService,
+	execRepo *data.ExecutionLogRepo,
+	scriptRepo *data.ScriptRepo,
+	runRepo *data.ExecutionRunRepo,
+	settingsRepo *data.TenantSettingRepo,
+	events *EventEvaluator,
+	workflows *WorkflowEngine,
+) *RetryDispatcher {
+	return &RetryDispatcher{
+		log:          ctx.NewLoggerHelper("executor/service/retry_dispatcher"),
+		execSvc:      execSvc,
+		execRepo:     execRepo,
+		scriptRepo:   scriptRepo,
+		runRepo:      runRepo,
+		settingsRepo: settingsRepo,
+		events:       events,
+		workflows:    workflows,
+		stop:         make(chan struct{}),
+	}
+}
+
+// Start runs the dispatcher loop until Stop is called
+func (d *RetryDispatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(retryDispatcherPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.sweep(viewer.NewSystemViewerContext(context.Background()))
+		case <-d.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop stops the dispatcher loop
+func (d *RetryDispatcher) Stop(_ context.Context) error {
+	close(d.stop)
+	return nil
+}
+
+// sweep starts every retry that is due
+func (d *RetryDispatcher) sweep(ctx context.Context) {
+	due, err := d.execRepo.ListRetryDue(ctx, time.Now())
+	if err != nil {
+		d.log.Errorf("failed to list due retries: %v", err)
+		return
+	}
+	for _, e := range due {
+		d.retry(ctx, e)
+	}
+}
+
+// retry starts the next attempt of an execution. If it cannot be started the
+// execution becomes final with the status of its last attempt.
+func (d *RetryDispatcher) retry(ctx context.Context, e *ent.ExecutionLog) {
+	attemptID := uuid.New().String()
+	claimed, err := d.execRepo.ClaimRetry(ctx, e.ID, *e.RetryAt, attemptID)
+	if err != nil || !claimed {
+		return
+	}
+
+	if err = d.start(ctx, e, attemptID); err != nil {
+		d.log.Warnf("Retry of execution %s not started: %v", e.ID, err)
+		if releaseErr := d.execRepo.ReleaseRetry(ctx, e.ID, attemptID); releaseErr != nil {
+			d.log.Errorf("failed to release retry of execution %s: %v", e.ID, releaseErr)
+			return
+		}
+		if e.Status == executionlog.StatusFAILED || e.Status == executionlog.StatusTIMED_OUT {
+			d.events.ExecutionFailed(e)
+		}
+		d.workflows.ExecutionFinished(e)
+	}
+}
+
+// start dispatches the attempt with the given ID
+func (d *RetryDispatcher) start(ctx context.Context, e *ent.ExecutionLog, attemptID string) error {
+	if e.RunID != nil {
+		run, err := d.runRepo.GetByID(ctx, *e.RunID)
+		if err != nil {
+			return err
+		}
+		if run != nil && run.Status == executionrun.StatusABORTED {
+			return executorV1.ErrorRunStateConflict("run %s was aborted", run.ID)
+		}
+	}
+
+	script, err := d.scriptRepo.GetByID(ctx, e.ScriptID)
+	if err != nil {
+		return err
+	}
+	if script == nil || !script.Enabled {
+		return executorV1.ErrorScriptNotFound("script %s is missing or disabled", e.ScriptID)
+	}
+
+	tenantID := derefTenantID(e.TenantID)
+	timeoutSeconds, err := resolveTimeoutSeconds(ctx, d.settingsRepo, tenantID, script)
+	if err != nil {
+		return err
+	}
+
+	origin, err := d.execRepo.RetryOrigin(e, attemptID)
+	if err != nil {
+		return err
+	}
+	attempt, _, err := d.execSvc.dispatch(ctx, tenantID, script, e.ClientID, timeoutSeconds,
+		string(e.TriggerType), e.CreateBy, origin)
+	if err != nil {
+		return err
+	}
+
+	d.log.Infof("Execution %s retried as %s (attempt %d)", e.ID, attempt.ID, attempt.Attempt)
+	return nil
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const maxRetryAttempts = 10
+
+// defaultRetryableStatuses are retried when a policy lists no statuses
+var defaultRetryableStatuses = []string{"FAILED", "CLIENT_OFFLINE", "TIMED_OUT"}
+
+// RetryPlanner decides whether a finished execution is retried under its
+// script's retry policy and schedules the next attempt. The RetryDispatcher
+// starts scheduled attempts once they are due.
+type RetryPlanner struct {
+	log        *log.Helper
+	scriptRepo *data.ScriptRepo
+	execRepo   *data.ExecutionLogRepo
+}
+
+// NewRetryPlanner creates a new RetryPlanner
+func NewRetryPlanner(
+	ctx *bootstrap.Context,
+	scriptRepo *data.ScriptRepo,
+	execRepo *data.ExecutionLogRepo,
+) *RetryPlanner {
+	return &RetryPlanner{
+		log:        ctx.NewLoggerHelper("executor/service/retry_planner"),
+		scriptRepo: scriptRepo,
+		execRepo:   execRepo,
+	}
+}
+
+// Schedule schedules the next attempt of an execution that just reached the
+// given final status. Returns true if a retry is pending, in which case the
+// execution is not final yet. Otherwise the secret parameter values it kept
+// for a retry are dropped.
+func (p *RetryPlanner) Schedule(ctx context.Context, execLog *ent.ExecutionLog, status executionlog.Status, exitCode int) bool {
+	if p.schedule(ctx, execLog, status, exitCode) {
+		return true
+	}
+	if err := p.execRepo.ClearSecretParameters(ctx, execLog.ID); err != nil {
+		p.log.Errorf("Failed to clear secret parameters of execution %s: %v", execLog.ID, err)
+	}
+	return false
+}
+
+// schedule schedules the next attempt of an execution if its script's retry
+// policy allows one
+func (p *RetryPlanner) schedule(ctx context.Context, execLog *ent.ExecutionLog, status executionlog.Status, exitCode int) bool {
+	// Client-pull executions ran on the client's own initiative
+	if execLog.TriggerType == executionlog.TriggerTypeCLIENT_PULL {
+		return false
+	}
+
+	script, err := p.scriptRepo.GetByID(ctx, execLog.ScriptID)
+	if err != nil || script == nil || script.RetryPolicy == nil {
+		return false
+	}
+	policy := script.RetryPolicy
+	if execLog.Attempt >= policy.MaxAttempts || !retryable(policy, status, exitCode) {
+		return false
+	}
+
+	delay := retryDelay(policy, execLog.Attempt)
+	scheduled, err := p.execRepo.ScheduleRetry(ctx, execLog.ID, status, time.Now().Add(delay))
+	if err != nil || !scheduled {
+		return false
+	}
+
+	p.log.Infof("Execution %s %s, retrying in %s (attempt %d of %d)",
+		execLog.ID, status, delay, execLog.Attempt+1, policy.MaxAttempts)
+	return true
+}
+
+// retryable reports whether the policy retries an execution that ended with
+// the given status and exit code
+func retryable(policy *schema.RetryPolicy, status executionlog.Status, exitCode int) bool {
+	statuses := policy.RetryableStatuses
+	if len(statuses) == 0 {
+		statuses = defaultRetryableStatuses
+	}
+	if !slices.Contains(statuses, string(status)) {
+		return false
+	}
+	if status != executionlog.StatusFAILED || len(policy.RetryableExitCodes) == 0 {
+		return true
+	}
+	return slices.Contains(policy.RetryableExitCodes, int32(exitCode))
+}
+
+// retryDelay returns the wait before the attempt that follows the given one.
+// The backoff doubles with every attempt up to the policy's maximum.
+func retryDelay(policy *schema.RetryPolicy, attempt int) time.Duration {
+	delay := time.Duration(policy.BackoffSeconds) * time.Second
+	limit := time.Duration(policy.MaxBackoffSeconds) * time.Second
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if limit > 0 && delay >= limit {
+			break
+		}
+	}
+	if limit > 0 && delay > limit {
+		delay = limit
+	}
+	return delay
+}
+
+// validateRetryPolicy checks a retry policy sent by a client
+func validateRetryPolicy(policy *executorV1.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.MaxAttempts > maxRetryAttempts {
+		return executorV1.ErrorBadRequest("retry_policy.max_attempts must be at most %d", maxRetryAttempts)
+	}
+	if policy.MaxBackoffSeconds > 0 && policy.MaxBackoffSeconds < policy.BackoffSeconds {
+		return executorV1.ErrorBadRequest("retry_policy.max_backoff_seconds must not be less than backoff_seconds")
+	}
+	for _, code := range policy.RetryableExitCodes {
+		if code < 1 || code > 255 {
+			return executorV1.ErrorBadRequest("retry_policy.retryable_exit_codes must be between 1 and 255, got %d", code)
+		}
+	}
+	for _, status := range policy.RetryableStatuses {
+		switch status {
+		case executorV1.ExecutionStatus_EXECUTION_STATUS_FAILED,
+			executorV1.ExecutionStatus_EXECUTION_STATUS_CLIENT_OFFLINE,
+			executorV1.ExecutionStatus_EXECUTION_STATUS_TIMED_OUT:
+		default:
+			return executorV1.ErrorBadRequest("retry_policy.retryable_statuses only accepts FAILED, CLIENT_OFFLINE and TIMED_OUT")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+)
+
+const runOrchestratorPeriod = 5 * time.Second
+
+// RunOrchestrator rolls out the remaining batches of running fan-out runs.
+// All rollout state lives on the run row, so a restarted or second replica
+// picks up where the previous one stopped; batches are claimed atomically so
+// replicas never dispatch the same batch twice.
+type RunOrchestrator struct {
+	log     *log.Helper
+	execSvc *ExecutionService
+	runRepo *data.ExecutionRunRepo
+	stop    chan struct{}
+}
+
+// NewRunOrchestrator creates a new RunOrchestrator
+func NewRunOrchestrator(
+	ctx *bootstrap.Context,
+	execSvc *ExecutionService,
+	runRepo *data.ExecutionRunRepo,
+) *RunOrchestrator {
+	return &RunOrchestrator{
+		log:     ctx.NewLoggerHelper("executor/service/run_orchestrator"),
+		execSvc: execSvc,
+		runRepo: runRepo,
+		stop:    make(chan struct{}),
+	}
+}
+
+// Start runs the orchestrator loop until Stop is called
+func (o *RunOrchestrator) Start(ctx context.Context) error {
+	ticker := time.NewTicker(runOrchestratorPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			o.sweep(viewer.NewSystemViewerContext(context.Background()))
+		case <-o.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop stops the orchestrator loop
+func (o *RunOrchestrator) Stop(_ context.Context) error {
+	close(o.stop)
+	return nil
+}
+
+// sweep advances every running run
+func (o *RunOrchestrator) sweep(ctx context.Context) {
+	runs, err := o.runRepo.ListRunning(ctx)
+	if err != nil {
+		o.log.Errorf("failed to list running runs: %v", err)
+		return
+	}
+	for _, run := range runs {
+		o.execSvc.advanceRun(ctx, run)
+	}
+}
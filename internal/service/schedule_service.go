@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const defaultScheduleTimezone = "UTC"
+
+// ScheduleService implements the ExecutorScheduleService gRPC service
+type ScheduleService struct {
+	executorV1.UnimplementedExecutorScheduleServiceServer
+
+	log          *log.Helper
+	scheduleRepo *data.ScheduleRepo
+	scriptRepo   *data.ScriptRepo
+}
+
+// NewScheduleService creates a new ScheduleService
+func NewScheduleService(
+	ctx *bootstrap.Context,
+	scheduleRepo *data.ScheduleRepo,
+	scriptRepo *data.ScriptRepo,
+) *ScheduleService {
+	return &ScheduleService{
+		log:          ctx.NewLoggerHelper("executor/service/schedule"),
+		scheduleRepo: scheduleRepo,
+		scriptRepo:   scriptRepo,
+	}
+}
+
+// CreateSchedule creates a schedule
+func (s *ScheduleService) CreateSchedule(ctx context.Context, req *executorV1.CreateScheduleRequest) (*executorV1.CreateScheduleResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	script, err := s.scriptRepo.GetByID(ctx, req.ScriptId)
+	if err != nil {
+		return nil, err
+	}
+	if script == nil {
+		return nil, executorV1.ErrorScriptNotFound("script not found")
+	}
+
+	if req.Timezone == nil || *req.Timezone == "" {
+		tz := defaultScheduleTimezone
+		req.Timezone = &tz
+	}
+	if req.Enabled == nil {
+		enabled := true
+		req.Enabled = &enabled
+	}
+
+	if err = validateScheduleTargets(req.GetSelector(), req.ClientIds); err != nil {
+		return nil, err
+	}
+	if err = validateRunStrategy(req.Strategy); err != nil {
+		return nil, err
+	}
+	cron, loc, err := parseScheduleTiming(req.CronExpression, req.GetTimezone())
+	if err != nil {
+		return nil, err
+	}
+
+	var nextRunAt *time.Time
+	if req.GetEnabled() {
+		if nextRunAt, err = nextOccurrence(cron, loc, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	entity, err := s.scheduleRepo.Create(ctx, tenantID, req, nextRunAt, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.CreateScheduleResponse{
+		Schedule: s.scheduleRepo.ToProto(entity),
+	}, nil
+}
+
+// ListSchedules lists the schedules of the tenant
+func (s *ScheduleService) ListSchedules(ctx context.Context, req *executorV1.ListSchedulesRequest) (*executorV1.ListSchedulesResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.scheduleRepo.ListByTenant(ctx, tenantID, req.ScriptId, req.Enabled, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*executorV1.Schedule, 0, len(entities))
+	for _, e := range entities {
+		schedules = append(schedules, s.scheduleRepo.ToProto(e))
+	}
+
+	return &executorV1.ListSchedulesResponse{
+		Schedules: schedules,
+		Total:     uint32(total),
+	}, nil
+}
+
+// GetSchedule retrieves a schedule
+func (s *ScheduleService) GetSchedule(ctx context.Context, req *executorV1.GetScheduleRequest) (*executorV1.GetScheduleResponse, error) {
+	entity, err := s.getSchedule(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetScheduleResponse{
+		Schedule: s.scheduleRepo.ToProto(entity),
+	}, nil
+}
+
+// UpdateSchedule updates a schedule
+func (s *ScheduleService) UpdateSchedule(ctx context.Context, req *executorV1.UpdateScheduleRequest) (*executorV1.UpdateScheduleResponse, error) {
+	updatedBy := getUserIDAsUint32(ctx)
+
+	existing, err := s.getSchedule(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := existing.Selector
+	if req.Selector != nil {
+		selector = *req.Selector
+	}
+	members := existing.ClientIds
+	var newMembers []string
+	if req.ClientIds != nil {
+		newMembers = req.ClientIds.GetValues()
+		if newMembers == nil {
+			newMembers = []string{}
+		}
+		members = newMembers
+	}
+	if err = validateScheduleTargets(selector, members); err != nil {
+		return nil, err
+	}
+	if err = validateRunStrategy(req.Strategy); err != nil {
+		return nil, err
+	}
+
+	if req.Timezone != nil && *req.Timezone == "" {
+		tz := defaultScheduleTimezone
+		req.Timezone = &tz
+	}
+	expr, tz, enabled := existing.CronExpression, existing.Timezone, existing.Enabled
+	if req.CronExpression != nil {
+		expr = *req.CronExpression
+	}
+	if req.Timezone != nil {
+		tz = *req.Timezone
+	}
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	cron, loc, err := parseScheduleTiming(expr, tz)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recompute the next occurrence from now when the timing changes or the
+	// schedule is switched on, so nothing missed while disabled fires
+	reschedule := req.CronExpression != nil || req.Timezone != nil || (req.Enabled != nil && *req.Enabled != existing.Enabled)
+	var nextRunAt *time.Time
+	if reschedule && enabled {
+		if nextRunAt, err = nextOccurrence(cron, loc, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	entity, err := s.scheduleRepo.Update(ctx, req.Id, req, newMembers, reschedule, nextRunAt, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.UpdateScheduleResponse{
+		Schedule: s.scheduleRepo.ToProto(entity),
+	}, nil
+}
+
+// DeleteSchedule deletes a schedule
+func (s *ScheduleService) DeleteSchedule(ctx context.Context, req *executorV1.DeleteScheduleRequest) (*emptypb.Empty, error) {
+	if _, err := s.getSchedule(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	if err := s.scheduleRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getSchedule loads a schedule of the caller's tenant
+func (s *ScheduleService) getSchedule(ctx context.Context, id string) (*ent.Schedule, error) {
+	entity, err := s.scheduleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorScheduleNotFound("schedule not found")
+	}
+	return entity, nil
+}
+
+// parseScheduleTiming parses a schedule's cron expression and timezone
+func parseScheduleTiming(expr, timezone string) (*cronSchedule, *time.Location, error) {
+	cron, err := parseCron(expr)
+	if err != nil {
+		return nil, nil, executorV1.ErrorInvalidCronExpression("invalid cron expression: %s", err.Error())
+	}
+	// "Local" would depend on the server's zone
+	if timezone == "Local" {
+		return nil, nil, executorV1.ErrorBadRequest("unknown timezone %q", timezone)
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, executorV1.ErrorBadRequest("unknown timezone %q", timezone)
+	}
+	return cron, loc, nil
+}
+
+// nextOccurrence returns the first occurrence after t in the schedule's timezone
+func nextOccurrence(cron *cronSchedule, loc *time.Location, t time.Time) (*time.Time, error) {
+	next := cron.Next(t.In(loc))
+	if next.IsZero() {
+		return nil, executorV1.ErrorInvalidCronExpression("cron expression never matches within %d years", cronSearchYears)
+	}
+	return &next, nil
+}
+
+// validateScheduleTargets checks a schedule's selector and listed clients; a
+// schedule needs at least one of them
+func validateScheduleTargets(selector string, clientIDs []string) error {
+	if selector == "" && len(clientIDs) == 0 {
+		return executorV1.ErrorBadRequest("a schedule needs client_ids or a selector")
+	}
+	if selector != "" {
+		if err := validateLabelSelector(selector); err != nil {
+			return err
+		}
+	}
+	if len(clientIDs) > maxRunTargets {
+		return executorV1.ErrorBadRequest("a schedule can list at most %d client IDs", maxRunTargets)
+	}
+	for _, id := range clientIDs {
+		if id == "" || len(id) > 255 {
+			return executorV1.ErrorBadRequest("client IDs must be 1 to 255 characters")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schedule"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	schedulerPeriod = 15 * time.Second
+	// scheduleOnTimeGrace is how late an occurrence may fire and still count
+	// as on time rather than missed
+	scheduleOnTimeGrace = time.Minute
+	// maxCatchUpRuns caps the runs RUN_ALL starts for missed occurrences
+	maxCatchUpRuns = 10
+	// maxCatchUpScan bounds how many missed occurrences are walked
+	maxCatchUpScan = 100000
+)
+
+// Scheduler starts runs for schedules whose next occurrence has come.
+// A schedule is claimed by atomically moving its next occurrence forward, so
+// every occurrence fires once even with several replicas. Occurrences missed
+// while the service was down are handled by the schedule's catch-up policy.
+type Scheduler struct {
+	log          *log.Helper
+	execSvc      *ExecutionService
+	scheduleRepo *data.ScheduleRepo
+	scriptRepo   *data.ScriptRepo
+	stop         chan struct{}
+}
+
+// NewScheduler creates a new Scheduler
+func NewScheduler(
+	ctx *bootstrap.Context,
+	execSvc *ExecutionService,
+	scheduleRepo *data.ScheduleRepo,
+	scriptRepo *data.ScriptRepo,
+) *Scheduler {
+	return &Scheduler{
+		log:          ctx.NewLoggerHelper("executor/service/scheduler"),
+		execSvc:      execSvc,
+		scheduleRepo: scheduleRepo,
+		scriptRepo:   scriptRepo,
+		stop:         make(chan struct{}),
+	}
+}
+
+// Start runs the scheduler loop until Stop is called
+func (s *Scheduler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(schedulerPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep(viewer.NewSystemViewerContext(context.Background()))
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop stops the scheduler loop
+func (s *Scheduler) Stop(_ context.Context) error {
+	close(s.stop)
+	return nil
+}
+
+// sweep fires every due schedule
+func (s *Scheduler) sweep(ctx context.Context) {
+	now := time.Now()
+	due, err := s.scheduleRepo.ListDue(ctx, now)
+	if err != nil {
+		s.log.Errorf("failed to list due schedules: %v", err)
+		return
+	}
+	for _, sch := range due {
+		s.fire(ctx, sch, now)
+	}
+}
+
+// fire claims the due occurrences of a schedule and starts their runs
+func (s *Scheduler) fire(ctx context.Context, sch *ent.Schedule, now time.Time) {
+	dueAt := *sch.NextRunAt
+
+	cron, loc, err := parseScheduleTiming(sch.CronExpression, sch.Timezone)
+	if err != nil {
+		// Stop retrying a schedule that can no longer be evaluated
+		if ok, _ := s.scheduleRepo.Advance(ctx, sch.ID, dueAt, nil); ok {
+			s.record(ctx, sch, dueAt, nil, kratosErrors.FromError(err).GetMessage())
+		}
+		return
+	}
+
+	occurrences, missed := dueOccurrences(cron, loc, dueAt, now)
+
+	var next *time.Time
+	if n := cron.Next(now.In(loc)); !n.IsZero() {
+		next = &n
+	}
+	claimed, err := s.scheduleRepo.Advance(ctx, sch.ID, dueAt, next)
+	if err != nil || !claimed {
+		return
+	}
+
+	latest := occurrences[len(occurrences)-1]
+	switch sch.CatchUpPolicy {
+	case schedule.CatchUpPolicyRUN_ALL:
+		if missed > len(occurrences) {
+			s.log.Warnf("Schedule %s missed %d occurrences, catching up the last %d", sch.ID, missed, len(occurrences))
+		}
+	case schedule.CatchUpPolicyRUN_ONCE:
+		occurrences = []time.Time{latest}
+	default:
+		if now.Sub(latest) > scheduleOnTimeGrace {
+			s.log.Infof("Schedule %s skipped %d missed occurrence(s), last due at %s", sch.ID, missed, latest)
+			s.record(ctx, sch, latest, nil, fmt.Sprintf("skipped %d missed occurrence(s)", missed))
+			return
+		}
+		occurrences = []time.Time{latest}
+	}
+
+	for _, at := range occurrences {
+		s.startRun(ctx, sch, at)
+	}
+}
+
+// startRun starts the run of one occurrence and records the outcome
+func (s *Scheduler) startRun(ctx context.Context, sch *ent.Schedule, at time.Time) {
+	script, err := s.scriptRepo.GetByID(ctx, sch.ScriptID)
+	if err != nil {
+		s.record(ctx, sch, at, nil, "failed to load script")
+		return
+	}
+	if script == nil {
+		s.record(ctx, sch, at, nil, "script not found")
+		return
+	}
+	if !script.Enabled {
+		s.record(ctx, sch, at, nil, "script is disabled")
+		return
+	}
+
+	strategy := &executorV1.RunStrategy{
+		BatchSize:         uint32(sch.BatchSize),
+		CanarySize:        uint32(sch.CanarySize),
+		BatchPauseSeconds: uint32(sch.BatchPauseSeconds),
+	}
+	if sch.MaxFailures != nil {
+		maxFailures := uint32(*sch.MaxFailures)
+		strategy.MaxFailures = &maxFailures
+	}
+
+	run, skipped, err := s.execSvc.startRun(ctx, derefTenantID(sch.TenantID), script, sch.ClientIds, sch.Selector, strategy, &sch.ID, sch.CreateBy)
+	if err != nil {
+		s.log.Errorf("Schedule %s failed to start a run for %s: %v", sch.ID, at, err)
+		s.record(ctx, sch, at, nil, kratosErrors.FromError(err).GetMessage())
+		return
+	}
+
+	s.log.Infof("Schedule %s started run %s for %s (%d target(s) skipped)", sch.ID, run.ID, at, len(skipped))
+	s.record(ctx, sch, at, &run.ID, "")
+}
+
+func (s *Scheduler) record(ctx context.Context, sch *ent.Schedule, at time.Time, runID *string, lastError string) {
+	if err := s.scheduleRepo.RecordRun(ctx, sch.ID, at, runID, lastError); err != nil {
+		s.log.Errorf("failed to record run of schedule %s: %v", sch.ID, err)
+	}
+}
+
+// dueOccurrences walks the occurrences from first up to now and returns the
+// last maxCatchUpRuns of them, oldest first, along with how many there were.
+// The walk stops after maxCatchUpScan occurrences.
+func dueOccurrences(cron *cronSchedule, loc *time.Location, first, now time.Time) ([]time.Time, int) {
+	recent := []time.Time{first}
+	count := 1
+	for t := first.In(loc); count < maxCatchUpScan; count++ {
+		t = cron.Next(t)
+		if t.IsZero() || t.After(now) {
+			break
+		}
+		if len(recent) == maxCatchUpRuns {
+			recent = recent[1:]
+		}
+		recent = append(recent, t)
+	}
+	return recent, count
+}
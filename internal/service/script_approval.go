@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// requestApproval holds a content change in a change request when the
+// script's tenant requires approval. Returns nil if the change can be saved
+// right away.
+func (s *ScriptService) requestApproval(ctx context.Context, entity *ent.Script, change *data.ContentChange, requestedBy *uint32) (*ent.ScriptChangeRequest, error) {
+	if change == nil {
+		return nil, nil
+	}
+
+	required, _, err := s.settingsRepo.GetScriptApproval(ctx, derefTenantID(entity.TenantID))
+	if err != nil || !required {
+		return nil, err
+	}
+
+	request, err := s.changeRepo.Create(ctx, entity.ID, change, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Content change of script %s awaits approval in change request %s", entity.ID, request.ID)
+	return request, nil
+}
+
+// ListScriptChangeRequests lists the change requests of the caller's tenant
+func (s *ScriptService) ListScriptChangeRequests(ctx context.Context, req *executorV1.ListScriptChangeRequestsRequest) (*executorV1.ListScriptChangeRequestsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var status *string
+	if req.Status != nil {
+		v := data.ScriptChangeRequestStatusFromProto(*req.Status)
+		status = &v
+	}
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.changeRepo.ListByTenant(ctx, tenantID, req.ScriptId, status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*executorV1.ScriptChangeRequest, 0, len(entities))
+	for _, e := range entities {
+		requests = append(requests, s.changeRepo.ToProto(e, false))
+	}
+
+	return &executorV1.ListScriptChangeRequestsResponse{
+		ChangeRequests: requests,
+		Total:          uint32(total),
+	}, nil
+}
+
+// GetScriptChangeRequest retrieves a change request with the diff of its
+// content against the version it was made on
+func (s *ScriptService) GetScriptChangeRequest(ctx context.Context, req *executorV1.GetScriptChangeRequestRequest) (*executorV1.GetScriptChangeRequestResponse, error) {
+	request, err := s.getChangeRequest(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := s.getVersion(ctx, request.ScriptID, uint32(request.BaseVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	diff, added, removed := unifiedDiff(
+		fmt.Sprintf("v%d", base.Version),
+		"proposed",
+		base.Content,
+		request.Content,
+	)
+
+	return &executorV1.GetScriptChangeRequestResponse{
+		ChangeRequest: s.changeRepo.ToProto(request, true),
+		Diff:          diff,
+		AddedLines:    uint32(added),
+		RemovedLines:  uint32(removed),
+	}, nil
+}
+
+// ApproveScriptChangeRequest saves the content of a change request as the
+// next version of its script. The approver must hold the tenant's approver
+// role, confirm with their password and not be the requester. The content is
+// validated again, as the tenant's rules may have changed since the request.
+func (s *ScriptService) ApproveScriptChangeRequest(ctx context.Context, req *executorV1.ApproveScriptChangeRequestRequest) (*executorV1.ApproveScriptChangeRequestResponse, error) {
+	reviewedBy := getUserIDAsUint32(ctx)
+
+	request, err := s.getChangeRequest(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkReviewer(ctx, request, "approve"); err != nil {
+		return nil, err
+	}
+	if err = s.verifyPassword(ctx, req.Password, "approving a script change"); err != nil {
+		return nil, err
+	}
+
+	script, err := s.getScript(ctx, request.ScriptID)
+	if err != nil {
+		return nil, err
+	}
+	diagnostics, err := s.checkScriptContent(ctx, derefTenantID(request.TenantID), string(script.ScriptType), request.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	approved, updated, err := s.changeRepo.Approve(ctx, request.ID, reviewedBy, req.GetComment())
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Change request %s approved, script %s is now at version %d", approved.ID, updated.ID, updated.Version)
+
+	return &executorV1.ApproveScriptChangeRequestResponse{
+		ChangeRequest: s.changeRepo.ToProto(approved, false),
+		Script:        s.scriptRepo.ToProto(updated),
+		Diagnostics:   diagnostics,
+	}, nil
+}
+
+// RejectScriptChangeRequest closes a change request without saving its
+// content
+func (s *ScriptService) RejectScriptChangeRequest(ctx context.Context, req *executorV1.RejectScriptChangeRequestRequest) (*executorV1.RejectScriptChangeRequestResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, executorV1.ErrorBadRequest("a rejection reason is required")
+	}
+
+	request, err := s.getChangeRequest(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkReviewer(ctx, request, "reject"); err != nil {
+		return nil, err
+	}
+
+	rejected, err := s.changeRepo.Reject(ctx, request.ID, getUserIDAsUint32(ctx), reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Change request %s of script %s rejected", rejected.ID, rejected.ScriptID)
+
+	return &executorV1.RejectScriptChangeRequestResponse{
+		ChangeRequest: s.changeRepo.ToProto(rejected, false),
+	}, nil
+}
+
+// CancelScriptChangeRequest withdraws a change request. Only the requester
+// and platform admins may cancel it.
+func (s *ScriptService) CancelScriptChangeRequest(ctx context.Context, req *executorV1.CancelScriptChangeRequestRequest) (*executorV1.CancelScriptChangeRequestResponse, error) {
+	userID := getUserIDAsUint32(ctx)
+
+	request, err := s.getChangeRequest(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !grpcx.IsPlatformAdmin(ctx) && !sameUser(request.CreateBy, userID) {
+		return nil, executorV1.ErrorForbidden("only the requester can cancel a change request")
+	}
+
+	cancelled, err := s.changeRepo.Cancel(ctx, request.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.CancelScriptChangeRequestResponse{
+		ChangeRequest: s.changeRepo.ToProto(cancelled, false),
+	}, nil
+}
+
+// CommentScriptChangeRequest adds a comment to a change request
+func (s *ScriptService) CommentScriptChangeRequest(ctx context.Context, req *executorV1.CommentScriptChangeRequestRequest) (*executorV1.CommentScriptChangeRequestResponse, error) {
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, executorV1.ErrorBadRequest("comment must not be empty")
+	}
+
+	request, err := s.getChangeRequest(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.changeRepo.AddComment(ctx, request.ID, schema.ScriptChangeComment{
+		AuthorID:   getUserIDAsUint32(ctx),
+		AuthorName: getUsernameFromContext(ctx),
+		Body:       body,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.CommentScriptChangeRequestResponse{
+		ChangeRequest: s.changeRepo.ToProto(updated, false),
+	}, nil
+}
+
+// getChangeRequest loads a change request of the caller's tenant
+func (s *ScriptService) getChangeRequest(ctx context.Context, id string) (*ent.ScriptChangeRequest, error) {
+	entity, err := s.changeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorScriptChangeRequestNotFound("script change request not found")
+	}
+	return entity, nil
+}
+
+// checkReviewer checks that the caller may approve or reject a change
+// request: they hold the tenant's approver role and did not request it.
+// Requests of an unknown requester cannot be reviewed, as nobody can tell
+// whether the reviewer requested them.
+func (s *ScriptService) checkReviewer(ctx context.Context, request *ent.ScriptChangeRequest, action string) error {
+	_, role, err := s.settingsRepo.GetScriptApproval(ctx, derefTenantID(request.TenantID))
+	if err != nil {
+		return err
+	}
+	if !isScriptApprover(ctx, role) {
+		return executorV1.ErrorForbidden("only users with the %s role can %s script changes", role, action)
+	}
+
+	userID := getUserIDAsUint32(ctx)
+	if userID == nil {
+		return executorV1.ErrorUnauthorized("cannot determine the reviewing user")
+	}
+	if request.CreateBy == nil {
+		return executorV1.ErrorForbidden("change requests of an unknown requester cannot be reviewed")
+	}
+	if sameUser(request.CreateBy, userID) {
+		return executorV1.ErrorForbidden("change requests cannot be reviewed by their requester")
+	}
+	return nil
+}
+
+// isScriptApprover reports whether the caller holds the given approver role.
+// Platform admins approve for every tenant.
+func isScriptApprover(ctx context.Context, role string) bool {
+	if grpcx.IsPlatformAdmin(ctx) {
+		return true
+	}
+	for _, r := range grpcx.GetRolesFromContext(ctx) {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// sameUser reports whether two optional user IDs are set and equal
+func sameUser(a, b *uint32) bool {
+	return a != nil && b != nil && *a == *b
+}
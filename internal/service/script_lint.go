@@ -0,0 +1,173 @@
+package service
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// lintRule flags a dangerous pattern in script content. Rules match single
+// lines, so they also catch shell commands embedded in strings of scripts in
+// other languages.
+type lintRule struct {
+	id          string
+	description string
+	severity    executorV1.DiagnosticSeverity
+	pattern     *regexp.Regexp
+
+	// accept refines a match of pattern, nil accepts every match
+	accept func(match []string) bool
+}
+
+var lintRules = []lintRule{
+	{
+		id:          "rm-rf-root",
+		description: "Recursive forced removal of the root or home directory",
+		severity:    executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR,
+		pattern:     regexp.MustCompile(`\brm((?:\s+-{1,2}[\w-]+)+)\s+["']?(?:/\*?|~/?\*?|\$\{?HOME\}?/?\*?)["']?(?:[\s;&|)]|$)`),
+		accept:      forcedRecursiveRemoval,
+	},
+	{
+		id:          "curl-pipe-shell",
+		description: "Downloaded content piped into a shell",
+		severity:    executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING,
+		pattern:     regexp.MustCompile(`\b(?:curl|wget)\b[^|;&]*\|\s*(?:sudo\s+(?:-\S+\s+)*)?(?:\S*/)?(?:ba|z|k|da|fi)?sh\b`),
+	},
+	{
+		id:          "fork-bomb",
+		description: "Fork bomb",
+		severity:    executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR,
+		pattern:     regexp.MustCompile(`(\w+|:)\(\)\s*\{\s*(\w+|:)\s*\|\s*(\w+|:)\s*&\s*\}\s*;\s*(\w+|:)`),
+		accept: func(m []string) bool {
+			return m[1] == m[2] && m[2] == m[3] && m[3] == m[4]
+		},
+	},
+	{
+		id:          "overwrite-block-device",
+		description: "Raw write to a block device",
+		severity:    executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR,
+		pattern:     regexp.MustCompile(`(?:\bof=|>\s*)/dev/(?:sd[a-z]|hd[a-z]|vd[a-z]|xvd[a-z]|nvme\d|mmcblk\d|disk\d)`),
+	},
+	{
+		id:          "mkfs",
+		description: "Filesystem creation",
+		severity:    executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING,
+		pattern:     regexp.MustCompile(`\bmkfs(?:\.\w+)?\s`),
+	},
+	{
+		id:          "chmod-777",
+		description: "World-writable permissions",
+		severity:    executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING,
+		pattern:     regexp.MustCompile(`\bchmod\s+(?:-\w+\s+)*0?777\b`),
+	},
+	{
+		id:          "insecure-download",
+		description: "Download with TLS certificate verification turned off",
+		severity:    executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING,
+		pattern:     regexp.MustCompile(`\bcurl\b.*\s(?:-k|--insecure)(?:\s|$)|\bwget\b.*\s--no-check-certificate\b`),
+	},
+	{
+		id:          "system-shutdown",
+		description: "Shutdown or reboot of the client",
+		severity:    executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_INFO,
+		pattern:     regexp.MustCompile(`\b(?:shutdown|reboot|poweroff|halt)\b|\binit\s+[06]\b`),
+	},
+}
+
+// lintScript runs the lint rules not disabled by the tenant over every
+// non-comment line of a script, reporting at most one finding per rule and
+// line
+func lintScript(scriptType, content string, disabledRules []string) []*executorV1.ScriptDiagnostic {
+	commentPrefix := lineCommentPrefix(scriptType)
+
+	var diagnostics []*executorV1.ScriptDiagnostic
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || (commentPrefix != "" && strings.HasPrefix(trimmed, commentPrefix)) {
+			continue
+		}
+
+		for _, rule := range lintRules {
+			if slices.Contains(disabledRules, rule.id) {
+				continue
+			}
+			for _, loc := range rule.pattern.FindAllStringSubmatchIndex(line, -1) {
+				if rule.accept != nil && !rule.accept(submatches(line, loc)) {
+					continue
+				}
+				diagnostics = append(diagnostics, &executorV1.ScriptDiagnostic{
+					Severity: rule.severity,
+					Rule:     rule.id,
+					Message:  rule.description,
+					Line:     uint32(i + 1),
+					Column:   uint32(utf8.RuneCountInString(line[:loc[0]]) + 1),
+				})
+				break
+			}
+		}
+	}
+	return diagnostics
+}
+
+// forcedRecursiveRemoval reports whether the options of an rm match both
+// remove recursively and force
+func forcedRecursiveRemoval(m []string) bool {
+	var recursive, force bool
+	for _, opt := range strings.Fields(m[1]) {
+		switch {
+		case opt == "--recursive":
+			recursive = true
+		case opt == "--force":
+			force = true
+		case !strings.HasPrefix(opt, "--"):
+			recursive = recursive || strings.ContainsAny(opt, "rR")
+			force = force || strings.Contains(opt, "f")
+		}
+	}
+	return recursive && force
+}
+
+// submatches returns the submatches of a match given by its index pairs
+func submatches(s string, loc []int) []string {
+	m := make([]string, len(loc)/2)
+	for i := range m {
+		if loc[2*i] >= 0 {
+			m[i] = s[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return m
+}
+
+// lineCommentPrefix returns the line comment marker of a script type
+func lineCommentPrefix(scriptType string) string {
+	switch scriptType {
+	case "BASH", "PYTHON", "POWERSHELL":
+		return "#"
+	case "LUA":
+		return "--"
+	case "JAVASCRIPT":
+		return "//"
+	default:
+		return ""
+	}
+}
+
+// normalizeLintRuleIDs trims and deduplicates lint rule IDs, rejecting
+// unknown ones
+func normalizeLintRuleIDs(ids []string) ([]string, error) {
+	normalized := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || slices.Contains(normalized, id) {
+			continue
+		}
+		if !slices.ContainsFunc(lintRules, func(r lintRule) bool { return r.id == id }) {
+			return nil, executorV1.ErrorBadRequest("unknown lint rule %q", id)
+		}
+		normalized = append(normalized, id)
+	}
+	return normalized, nil
+}
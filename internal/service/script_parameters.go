@@ -0,0 +1,208 @@
+package service
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	// maxScriptParameters bounds the parameters a script can declare
+	maxScriptParameters = 64
+	// maxParameterValueLen bounds a single parameter value
+	maxParameterValueLen = 8192
+	// secretParameterMask replaces secret values wherever they are shown
+	secretParameterMask = "********"
+)
+
+// parameterNamePattern keeps names usable as environment variable names
+var parameterNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+
+// validateScriptParameters checks the parameters a script declares
+func validateScriptParameters(params []*executorV1.ScriptParameter) error {
+	if len(params) > maxScriptParameters {
+		return executorV1.ErrorBadRequest("a script can declare at most %d parameters", maxScriptParameters)
+	}
+
+	seen := make(map[string]bool, len(params))
+	for _, p := range params {
+		name := p.GetName()
+		if !parameterNamePattern.MatchString(name) {
+			return executorV1.ErrorBadRequest("invalid parameter name %q: use letters, digits and underscores, not starting with a digit", name)
+		}
+		if seen[name] {
+			return executorV1.ErrorBadRequest("parameter %s is declared twice", name)
+		}
+		seen[name] = true
+
+		if _, ok := executorV1.ParameterType_name[int32(p.GetType())]; !ok {
+			return executorV1.ErrorBadRequest("parameter %s has unknown type %d", name, p.GetType())
+		}
+		if _, ok := executorV1.ParameterDelivery_name[int32(p.GetDelivery())]; !ok {
+			return executorV1.ErrorBadRequest("parameter %s has unknown delivery %d", name, p.GetDelivery())
+		}
+		if p.GetSecret() && p.DefaultValue != nil {
+			return executorV1.ErrorBadRequest("secret parameter %s cannot have a default value", name)
+		}
+	}
+
+	// Check defaults and allowed values against the types as stored
+	for _, def := range data.ScriptParametersFromProto(params) {
+		for _, v := range def.AllowedValues {
+			if _, err := normalizeParameterValue(def, v, false); err != nil {
+				return err
+			}
+		}
+		if def.Default != nil {
+			if _, err := normalizeParameterValue(def, *def.Default, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateParameterValues checks values supplied for a script's parameters
+// without requiring the required ones, as assignments may leave them to the
+// trigger. Returns the values in their normalized form.
+func validateParameterValues(defs []schema.ScriptParameter, values map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(values))
+	for name, v := range values {
+		idx := slices.IndexFunc(defs, func(d schema.ScriptParameter) bool { return d.Name == name })
+		if idx < 0 {
+			return nil, executorV1.ErrorBadRequest("the script has no parameter %s", name)
+		}
+		nv, err := normalizeParameterValue(defs[idx], v, true)
+		if err != nil {
+			return nil, err
+		}
+		normalized[name] = nv
+	}
+	return normalized, nil
+}
+
+// resolveParameterValues merges the defaults of a script's parameters with
+// the given layers of values, later layers taking precedence, and checks the
+// result. Every required parameter must end up with a value.
+func resolveParameterValues(defs []schema.ScriptParameter, layers ...map[string]string) (map[string]string, error) {
+	values := make(map[string]string, len(defs))
+	for _, def := range defs {
+		if def.Default != nil {
+			values[def.Name] = *def.Default
+		}
+	}
+	for _, layer := range layers {
+		for name, v := range layer {
+			values[name] = v
+		}
+	}
+
+	resolved, err := validateParameterValues(defs, values)
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range defs {
+		if _, ok := resolved[def.Name]; def.Required && !ok {
+			return nil, executorV1.ErrorBadRequest("parameter %s is required", def.Name)
+		}
+	}
+	return resolved, nil
+}
+
+// normalizeParameterValue checks a value against its parameter's type and
+// allowed values and returns its canonical form
+func normalizeParameterValue(def schema.ScriptParameter, v string, checkAllowed bool) (string, error) {
+	if len(v) > maxParameterValueLen {
+		return "", executorV1.ErrorBadRequest("the value of parameter %s exceeds %d characters", def.Name, maxParameterValueLen)
+	}
+
+	switch def.Type {
+	case "INTEGER":
+		n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return "", executorV1.ErrorBadRequest("parameter %s must be an integer, got %q", def.Name, v)
+		}
+		v = strconv.FormatInt(n, 10)
+	case "NUMBER":
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return "", executorV1.ErrorBadRequest("parameter %s must be a number, got %q", def.Name, v)
+		}
+		v = strconv.FormatFloat(f, 'f', -1, 64)
+	case "BOOLEAN":
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return "", executorV1.ErrorBadRequest("parameter %s must be true or false, got %q", def.Name, v)
+		}
+		v = strconv.FormatBool(b)
+	}
+
+	if checkAllowed && len(def.AllowedValues) > 0 {
+		for _, allowed := range def.AllowedValues {
+			// Compare in canonical form so 1.0 matches 1 for numbers
+			if a, err := normalizeParameterValue(def, allowed, false); err == nil && a == v {
+				return v, nil
+			}
+		}
+		return "", executorV1.ErrorBadRequest("parameter %s must be one of %s", def.Name, strings.Join(def.AllowedValues, ", "))
+	}
+	return v, nil
+}
+
+// maskParameterValues splits values into a copy with the secret values
+// masked, for recording and display, and the secret values alone
+func maskParameterValues(defs []schema.ScriptParameter, values map[string]string) (map[string]string, map[string]string) {
+	masked := make(map[string]string, len(values))
+	var secrets map[string]string
+	for name, v := range values {
+		if isSecretParameter(defs, name) {
+			masked[name] = secretParameterMask
+			if secrets == nil {
+				secrets = make(map[string]string)
+			}
+			secrets[name] = v
+			continue
+		}
+		masked[name] = v
+	}
+	return masked, secrets
+}
+
+// isSecretParameter reports whether the named parameter is secret
+func isSecretParameter(defs []schema.ScriptParameter, name string) bool {
+	return slices.ContainsFunc(defs, func(d schema.ScriptParameter) bool { return d.Name == name && d.Secret })
+}
+
+// parameterDelivery lays out parameter values the way the script receives
+// them: environment variables by parameter name and positional arguments in
+// declaration order. Arguments without a value are passed empty so the
+// positions stay fixed, except after the last one with a value.
+func parameterDelivery(defs []schema.ScriptParameter, values map[string]string) (map[string]string, []string) {
+	var env map[string]string
+	var args []string
+	lastArg := -1
+	for _, def := range defs {
+		v, ok := values[def.Name]
+		if def.Delivery == "ARG" {
+			if ok {
+				lastArg = len(args)
+			}
+			args = append(args, v)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if env == nil {
+			env = make(map[string]string)
+		}
+		env[def.Name] = v
+	}
+	return env, args[:lastArg+1]
+}
@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
 
 	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
 )
@@ -18,7 +20,10 @@ type ScriptService struct {
 
 	log          *log.Helper
 	scriptRepo   *data.ScriptRepo
+	versionRepo  *data.ScriptVersionRepo
+	changeRepo   *data.ScriptChangeRequestRepo
 	assignRepo   *data.AssignmentRepo
+	settingsRepo *data.TenantSettingRepo
 	portalClient *data.PortalClient
 }
 
@@ -26,36 +31,83 @@ type ScriptService struct {
 func NewScriptService(
 	ctx *bootstrap.Context,
 	scriptRepo *data.ScriptRepo,
+	versionRepo *data.ScriptVersionRepo,
+	changeRepo *data.ScriptChangeRequestRepo,
 	assignRepo *data.AssignmentRepo,
+	settingsRepo *data.TenantSettingRepo,
 	portalClient *data.PortalClient,
 ) *ScriptService {
 	return &ScriptService{
 		log:          ctx.NewLoggerHelper("executor/service/script"),
 		scriptRepo:   scriptRepo,
+		versionRepo:  versionRepo,
+		changeRepo:   changeRepo,
 		assignRepo:   assignRepo,
+		settingsRepo: settingsRepo,
 		portalClient: portalClient,
 	}
 }
 
-// CreateScript creates a new script
+// CreateScript creates a new script. When the tenant requires script
+// approval, the script is created without content and its content is held in
+// a change request, like any later content change.
 func (s *ScriptService) CreateScript(ctx context.Context, req *executorV1.CreateScriptRequest) (*executorV1.CreateScriptResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
 	createdBy := getUserIDAsUint32(ctx)
 
 	scriptType := scriptTypeToString(req.ScriptType)
 	if scriptType == "" {
-		return nil, executorV1.ErrorInvalidScriptType("script type must be BASH, JAVASCRIPT, or LUA")
+		return nil, executorV1.ErrorInvalidScriptType("script type must be BASH, JAVASCRIPT, LUA, PYTHON, or POWERSHELL")
 	}
 
-	contentHash := ComputeContentHash(req.Content)
+	if err := validateRetryPolicy(req.RetryPolicy); err != nil {
+		return nil, err
+	}
+	if err := validateConcurrencyLimitAction(req.ConcurrencyLimitAction); err != nil {
+		return nil, err
+	}
+	if err := validateScriptParameters(req.Parameters); err != nil {
+		return nil, err
+	}
+
+	diagnostics, err := s.checkScriptContent(ctx, tenantID, scriptType, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	change := &data.ContentChange{
+		Content:     req.Content,
+		ContentHash: ComputeContentHash(req.Content),
+		ChangeNote:  req.GetChangeNote(),
+	}
 
-	entity, err := s.scriptRepo.Create(ctx, tenantID, req.Name, req.Description, scriptType, req.Content, contentHash, req.Enabled, createdBy)
+	approvalRequired, _, err := s.settingsRepo.GetScriptApproval(ctx, tenantID)
 	if err != nil {
 		return nil, err
 	}
+	content, contentHash, changeNote := change.Content, change.ContentHash, change.ChangeNote
+	if approvalRequired {
+		content, contentHash, changeNote = "", ComputeContentHash(""), ""
+	}
+
+	entity, err := s.scriptRepo.Create(ctx, tenantID, req.Name, req.Description, scriptType, content, contentHash, req.Enabled, int32PtrToInt(req.TimeoutSeconds), req.RetryPolicy, req.Singleton, req.ConcurrencyLimitAction, req.Parameters, changeNote, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var request *ent.ScriptChangeRequest
+	if approvalRequired {
+		if request, err = s.requestApproval(ctx, entity, change, createdBy); err != nil {
+			if delErr := s.scriptRepo.Delete(ctx, entity.ID); delErr != nil {
+				s.log.Errorf("failed to delete script %s without its change request: %v", entity.ID, delErr)
+			}
+			return nil, err
+		}
+	}
 
 	return &executorV1.CreateScriptResponse{
-		Script: s.scriptRepo.ToProto(entity),
+		Script:        s.scriptRepo.ToProto(entity),
+		Diagnostics:   diagnostics,
+		ChangeRequest: s.changeRepo.ToProto(request, false),
 	}, nil
 }
 
@@ -108,7 +160,9 @@ func (s *ScriptService) ListScripts(ctx context.Context, req *executorV1.ListScr
 	}, nil
 }
 
-// UpdateScript updates a script, requiring password when content changes
+// UpdateScript updates a script, requiring password when content changes.
+// When the tenant requires script approval, the new content is held in a
+// change request and the other fields are saved right away.
 func (s *ScriptService) UpdateScript(ctx context.Context, req *executorV1.UpdateScriptRequest) (*executorV1.UpdateScriptResponse, error) {
 	createdBy := getUserIDAsUint32(ctx)
 
@@ -120,44 +174,56 @@ func (s *ScriptService) UpdateScript(ctx context.Context, req *executorV1.Update
 	if entity == nil {
 		return nil, executorV1.ErrorScriptNotFound("script not found")
 	}
+	if err = validateRetryPolicy(req.RetryPolicy); err != nil {
+		return nil, err
+	}
+	if req.ConcurrencyLimitAction != nil {
+		if err = validateConcurrencyLimitAction(*req.ConcurrencyLimitAction); err != nil {
+			return nil, err
+		}
+	}
+	if req.Parameters != nil {
+		if err = validateScriptParameters(req.Parameters.GetValues()); err != nil {
+			return nil, err
+		}
+	}
 
-	var newContentHash *string
-	var newVersion *int
+	var change *data.ContentChange
+	var diagnostics []*executorV1.ScriptDiagnostic
 
 	// If content is changing, require password verification
 	if req.Content != nil && *req.Content != entity.Content {
-		if req.Password == nil || *req.Password == "" {
-			return nil, executorV1.ErrorPasswordRequired("password is required when updating script content")
-		}
-
-		// Verify password via Portal
-		username := getUsernameFromContext(ctx)
-		if username == "" {
-			return nil, executorV1.ErrorUnauthorized("cannot determine username for password verification")
+		if err = s.verifyPassword(ctx, req.GetPassword(), "updating script content"); err != nil {
+			return nil, err
 		}
-
-		verified, verifyErr := s.portalClient.VerifyCredential(ctx, username, *req.Password)
-		if verifyErr != nil {
-			s.log.Errorf("Password verification failed: %v", verifyErr)
-			return nil, executorV1.ErrorPortalUnavailable("password verification service unavailable")
+		diagnostics, err = s.checkScriptContent(ctx, derefTenantID(entity.TenantID), string(entity.ScriptType), *req.Content)
+		if err != nil {
+			return nil, err
 		}
-		if !verified {
-			return nil, executorV1.ErrorPasswordVerificationFailed("password verification failed")
+		change = &data.ContentChange{
+			Content:     *req.Content,
+			ContentHash: ComputeContentHash(*req.Content),
+			ChangeNote:  req.GetChangeNote(),
 		}
+	}
 
-		hash := ComputeContentHash(*req.Content)
-		newContentHash = &hash
-		v := entity.Version + 1
-		newVersion = &v
+	request, err := s.requestApproval(ctx, entity, change, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	if request != nil {
+		change = nil
 	}
 
-	updated, err := s.scriptRepo.Update(ctx, req.Id, req.Name, req.Description, req.Content, newContentHash, req.Enabled, newVersion, createdBy)
+	updated, err := s.scriptRepo.Update(ctx, req.Id, req.Name, req.Description, change, req.Enabled, int32PtrToInt(req.TimeoutSeconds), req.RetryPolicy, req.Singleton, req.ConcurrencyLimitAction, req.Parameters, createdBy)
 	if err != nil {
 		return nil, err
 	}
 
 	return &executorV1.UpdateScriptResponse{
-		Script: s.scriptRepo.ToProto(updated),
+		Script:        s.scriptRepo.ToProto(updated),
+		ChangeRequest: s.changeRepo.ToProto(request, false),
+		Diagnostics:   diagnostics,
 	}, nil
 }
 
@@ -176,6 +242,10 @@ func (s *ScriptService) DeleteScript(ctx context.Context, req *executorV1.Delete
 		return nil, executorV1.ErrorInternalServerError("failed to delete assignments for script %s: %v", req.Id, delErr)
 	}
 
+	if err := s.changeRepo.CancelPendingByScript(ctx, req.Id, getUserIDAsUint32(ctx)); err != nil {
+		return nil, err
+	}
+
 	if err := s.scriptRepo.Delete(ctx, req.Id); err != nil {
 		return nil, err
 	}
@@ -183,6 +253,213 @@ func (s *ScriptService) DeleteScript(ctx context.Context, req *executorV1.Delete
 	return &emptypb.Empty{}, nil
 }
 
+// ListScriptVersions lists the content versions of a script, newest first
+func (s *ScriptService) ListScriptVersions(ctx context.Context, req *executorV1.ListScriptVersionsRequest) (*executorV1.ListScriptVersionsResponse, error) {
+	if _, err := s.getScript(ctx, req.ScriptId); err != nil {
+		return nil, err
+	}
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.versionRepo.ListByScript(ctx, req.ScriptId, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*executorV1.ScriptVersion, 0, len(entities))
+	for _, e := range entities {
+		versions = append(versions, s.versionRepo.ToProto(e, false))
+	}
+
+	return &executorV1.ListScriptVersionsResponse{
+		Versions: versions,
+		Total:    uint32(total),
+	}, nil
+}
+
+// GetScriptVersion retrieves a content version of a script
+func (s *ScriptService) GetScriptVersion(ctx context.Context, req *executorV1.GetScriptVersionRequest) (*executorV1.GetScriptVersionResponse, error) {
+	if _, err := s.getScript(ctx, req.ScriptId); err != nil {
+		return nil, err
+	}
+
+	version, err := s.getVersion(ctx, req.ScriptId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetScriptVersionResponse{
+		Version: s.versionRepo.ToProto(version, true),
+	}, nil
+}
+
+// DiffScriptVersions compares two content versions of a script
+func (s *ScriptService) DiffScriptVersions(ctx context.Context, req *executorV1.DiffScriptVersionsRequest) (*executorV1.DiffScriptVersionsResponse, error) {
+	entity, err := s.getScript(ctx, req.ScriptId)
+	if err != nil {
+		return nil, err
+	}
+
+	toVersion := uint32(entity.Version)
+	if req.ToVersion != nil {
+		toVersion = *req.ToVersion
+	}
+
+	from, err := s.getVersion(ctx, req.ScriptId, req.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getVersion(ctx, req.ScriptId, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, added, removed := unifiedDiff(
+		fmt.Sprintf("%s@v%d", entity.Name, from.Version),
+		fmt.Sprintf("%s@v%d", entity.Name, to.Version),
+		from.Content, to.Content)
+
+	return &executorV1.DiffScriptVersionsResponse{
+		FromVersion:  uint32(from.Version),
+		ToVersion:    uint32(to.Version),
+		Diff:         diff,
+		AddedLines:   uint32(added),
+		RemovedLines: uint32(removed),
+	}, nil
+}
+
+// RollbackScript restores the content of an earlier version. The restored
+// content is saved as a new version, so the history stays linear. Like any
+// content change it awaits approval when the tenant requires it.
+func (s *ScriptService) RollbackScript(ctx context.Context, req *executorV1.RollbackScriptRequest) (*executorV1.RollbackScriptResponse, error) {
+	updatedBy := getUserIDAsUint32(ctx)
+
+	entity, err := s.getScript(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if int(req.Version) == entity.Version {
+		return nil, executorV1.ErrorBadRequest("version %d is already the current version", req.Version)
+	}
+
+	version, err := s.getVersion(ctx, req.Id, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.verifyPassword(ctx, req.Password, "rolling back script content"); err != nil {
+		return nil, err
+	}
+	// The rules may have tightened since the version was saved
+	diagnostics, err := s.checkScriptContent(ctx, derefTenantID(entity.TenantID), string(entity.ScriptType), version.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	note := req.GetChangeNote()
+	if note == "" {
+		note = fmt.Sprintf("Rollback to version %d", version.Version)
+	}
+	restoredFrom := version.Version
+	change := &data.ContentChange{
+		Content:             version.Content,
+		ContentHash:         version.ContentHash,
+		ChangeNote:          note,
+		RestoredFromVersion: &restoredFrom,
+	}
+
+	request, err := s.requestApproval(ctx, entity, change, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if request != nil {
+		return &executorV1.RollbackScriptResponse{
+			Script:        s.scriptRepo.ToProto(entity),
+			ChangeRequest: s.changeRepo.ToProto(request, false),
+			Diagnostics:   diagnostics,
+		}, nil
+	}
+
+	updated, err := s.scriptRepo.Update(ctx, req.Id, nil, nil, change, nil, nil, nil, nil, nil, nil, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Script %s rolled back to version %d as version %d", updated.ID, restoredFrom, updated.Version)
+
+	return &executorV1.RollbackScriptResponse{
+		Script:      s.scriptRepo.ToProto(updated),
+		Diagnostics: diagnostics,
+	}, nil
+}
+
+// getScript loads a script of the caller's tenant
+func (s *ScriptService) getScript(ctx context.Context, id string) (*ent.Script, error) {
+	entity, err := s.scriptRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorScriptNotFound("script not found")
+	}
+	return entity, nil
+}
+
+// getVersion loads a version of a script the caller may access. The current
+// content of scripts saved before versions were kept is recorded first.
+func (s *ScriptService) getVersion(ctx context.Context, scriptID string, version uint32) (*ent.ScriptVersion, error) {
+	entity, err := s.versionRepo.GetByVersion(ctx, scriptID, int(version))
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		current, err := s.scriptRepo.GetByID(ctx, scriptID)
+		if err != nil {
+			return nil, err
+		}
+		if current != nil && current.Version == int(version) {
+			if err = s.versionRepo.EnsureCurrent(ctx, current); err != nil {
+				return nil, err
+			}
+			if entity, err = s.versionRepo.GetByVersion(ctx, scriptID, int(version)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if entity == nil {
+		return nil, executorV1.ErrorScriptVersionNotFound("script version %d not found", version)
+	}
+	return entity, nil
+}
+
+// verifyPassword checks the caller's password with the Portal before a
+// content change
+func (s *ScriptService) verifyPassword(ctx context.Context, password, action string) error {
+	if password == "" {
+		return executorV1.ErrorPasswordRequired("password is required when %s", action)
+	}
+
+	username := getUsernameFromContext(ctx)
+	if username == "" {
+		return executorV1.ErrorUnauthorized("cannot determine username for password verification")
+	}
+
+	verified, err := s.portalClient.VerifyCredential(ctx, username, password)
+	if err != nil {
+		s.log.Errorf("Password verification failed: %v", err)
+		return executorV1.ErrorPortalUnavailable("password verification service unavailable")
+	}
+	if !verified {
+		return executorV1.ErrorPasswordVerificationFailed("password verification failed")
+	}
+	return nil
+}
+
 // scriptTypeToString converts proto enum to ent string
 func scriptTypeToString(t executorV1.ScriptType) string {
 	switch t {
@@ -192,7 +469,20 @@ func scriptTypeToString(t executorV1.ScriptType) string {
 		return "JAVASCRIPT"
 	case executorV1.ScriptType_SCRIPT_TYPE_LUA:
 		return "LUA"
+	case executorV1.ScriptType_SCRIPT_TYPE_PYTHON:
+		return "PYTHON"
+	case executorV1.ScriptType_SCRIPT_TYPE_POWERSHELL:
+		return "POWERSHELL"
 	default:
 		return ""
 	}
 }
+
+// int32PtrToInt converts an optional proto int32 to an optional int
+func int32PtrToInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	jsparser "github.com/dop251/goja/parser"
+	lua "github.com/yuin/gopher-lua"
+	luaparse "github.com/yuin/gopher-lua/parse"
+	"mvdan.cc/sh/v3/syntax"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// syntaxRule is the rule of diagnostics reported by the script parsers
+const syntaxRule = "syntax"
+
+// ValidateScript parses script content and runs the tenant's lint rules over
+// it, reporting whether saving it would be refused
+func (s *ScriptService) ValidateScript(ctx context.Context, req *executorV1.ValidateScriptRequest) (*executorV1.ValidateScriptResponse, error) {
+	scriptType := scriptTypeToString(req.ScriptType)
+	if scriptType == "" {
+		return nil, executorV1.ErrorInvalidScriptType("script type must be BASH, JAVASCRIPT, LUA, PYTHON, or POWERSHELL")
+	}
+	if req.Content == "" {
+		return nil, executorV1.ErrorBadRequest("content is required")
+	}
+
+	blockSeverity, disabled, err := s.settingsRepo.GetScriptValidation(ctx, getTenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := validateScriptContent(scriptType, req.Content, disabled)
+	return &executorV1.ValidateScriptResponse{
+		Diagnostics:   diagnostics,
+		Blocked:       firstBlocking(diagnostics, blockSeverity) != nil,
+		BlockSeverity: blockSeverity,
+	}, nil
+}
+
+// ListLintRules lists the lint rules and whether the caller's tenant applies them
+func (s *ScriptService) ListLintRules(ctx context.Context, _ *executorV1.ListLintRulesRequest) (*executorV1.ListLintRulesResponse, error) {
+	_, disabled, err := s.settingsRepo.GetScriptValidation(ctx, getTenantIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*executorV1.LintRule, 0, len(lintRules))
+	for _, rule := range lintRules {
+		rules = append(rules, &executorV1.LintRule{
+			Id:          rule.id,
+			Description: rule.description,
+			Severity:    rule.severity,
+			Enabled:     !slices.Contains(disabled, rule.id),
+		})
+	}
+
+	return &executorV1.ListLintRulesResponse{Rules: rules}, nil
+}
+
+// checkScriptContent validates content about to be saved. Saves with a
+// finding at or above the tenant's block severity are refused; the other
+// findings are returned to be reported with the saved script.
+func (s *ScriptService) checkScriptContent(ctx context.Context, tenantID uint32, scriptType, content string) ([]*executorV1.ScriptDiagnostic, error) {
+	blockSeverity, disabled, err := s.settingsRepo.GetScriptValidation(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := validateScriptContent(scriptType, content, disabled)
+	if blocking := firstBlocking(diagnostics, blockSeverity); blocking != nil {
+		return nil, executorV1.ErrorInvalidScriptContent("script content has %s findings, first at line %d: %s (%s)",
+			strings.ToLower(strings.TrimPrefix(blocking.Severity.String(), "DIAGNOSTIC_SEVERITY_")),
+			blocking.Line, blocking.Message, blocking.Rule)
+	}
+	return diagnostics, nil
+}
+
+// firstBlocking returns the first diagnostic at or above the block severity,
+// nil when there is none or blocking is off
+func firstBlocking(diagnostics []*executorV1.ScriptDiagnostic, blockSeverity executorV1.DiagnosticSeverity) *executorV1.ScriptDiagnostic {
+	if blockSeverity == executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_UNSPECIFIED {
+		return nil
+	}
+	for _, d := range diagnostics {
+		if d.Severity >= blockSeverity {
+			return d
+		}
+	}
+	return nil
+}
+
+// validateScriptContent parses content with the parser of its script type
+// and runs the enabled lint rules over it. Python and PowerShell scripts have
+// no parser and are only linted. Diagnostics are ordered by position.
+func validateScriptContent(scriptType, content string, disabledRules []string) []*executorV1.ScriptDiagnostic {
+	// Secret references are filled in before the script runs, so the parsers
+	// see a placeholder in their place
+	parsed := secretRefPattern.ReplaceAllStringFunc(content, secretRefPlaceholder)
+
+	var diagnostics []*executorV1.ScriptDiagnostic
+	switch scriptType {
+	case "BASH":
+		diagnostics = parseBash(parsed)
+	case "LUA":
+		diagnostics = parseLua(parsed)
+	case "JAVASCRIPT":
+		diagnostics = parseJavaScript(parsed)
+	}
+
+	diagnostics = append(diagnostics, lintScript(scriptType, content, disabledRules)...)
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].Line != diagnostics[j].Line {
+			return diagnostics[i].Line < diagnostics[j].Line
+		}
+		return diagnostics[i].Column < diagnostics[j].Column
+	})
+	return diagnostics
+}
+
+// secretRefPlaceholder replaces a secret reference with underscores, which
+// read as a word in bash and an identifier in Lua and JavaScript, and fit
+// inside string literals of all three. Line breaks are kept, so diagnostics
+// after the reference keep their position.
+func secretRefPlaceholder(ref string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' {
+			return r
+		}
+		return '_'
+	}, ref)
+}
+
+// parseBash reports the first syntax error of a bash script
+func parseBash(content string) []*executorV1.ScriptDiagnostic {
+	parser := syntax.NewParser(syntax.Variant(syntax.LangBash))
+	_, err := parser.Parse(strings.NewReader(content), "")
+	if err == nil {
+		return nil
+	}
+
+	var parseErr syntax.ParseError
+	if errors.As(err, &parseErr) {
+		return []*executorV1.ScriptDiagnostic{syntaxError(parseErr.Text, parseErr.Pos.Line(), parseErr.Pos.Col())}
+	}
+	var langErr syntax.LangError
+	if errors.As(err, &langErr) {
+		return []*executorV1.ScriptDiagnostic{syntaxError(langErr.Feature+" is not supported in bash", langErr.Pos.Line(), langErr.Pos.Col())}
+	}
+	return []*executorV1.ScriptDiagnostic{syntaxError(err.Error(), 0, 0)}
+}
+
+// parseLua reports the first syntax or compile error of a Lua script
+func parseLua(content string) []*executorV1.ScriptDiagnostic {
+	chunk, err := luaparse.Parse(strings.NewReader(content), "script")
+	if err != nil {
+		var parseErr *luaparse.Error
+		if errors.As(err, &parseErr) {
+			line := parseErr.Pos.Line
+			if line == luaparse.EOF {
+				line = strings.Count(content, "\n") + 1
+			}
+			message := parseErr.Message
+			if parseErr.Token != "" {
+				message += " near '" + parseErr.Token + "'"
+			}
+			return []*executorV1.ScriptDiagnostic{syntaxError(message, uint(max(line, 0)), uint(max(parseErr.Pos.Column, 0)))}
+		}
+		return []*executorV1.ScriptDiagnostic{syntaxError(err.Error(), 0, 0)}
+	}
+
+	// The compiler catches what the grammar allows, like misplaced breaks
+	// and gotos to unknown labels
+	if _, err = lua.Compile(chunk, "script"); err != nil {
+		var compileErr *lua.CompileError
+		if errors.As(err, &compileErr) {
+			return []*executorV1.ScriptDiagnostic{syntaxError(compileErr.Message, uint(max(compileErr.Line, 0)), 0)}
+		}
+		return []*executorV1.ScriptDiagnostic{syntaxError(err.Error(), 0, 0)}
+	}
+	return nil
+}
+
+// parseJavaScript reports the syntax errors of a JavaScript script
+func parseJavaScript(content string) []*executorV1.ScriptDiagnostic {
+	_, err := jsparser.ParseFile(nil, "", content, 0)
+	if err == nil {
+		return nil
+	}
+
+	var list jsparser.ErrorList
+	if errors.As(err, &list) {
+		diagnostics := make([]*executorV1.ScriptDiagnostic, 0, len(list))
+		for _, e := range list {
+			diagnostics = append(diagnostics, syntaxError(e.Message, uint(max(e.Position.Line, 0)), uint(max(e.Position.Column, 0))))
+		}
+		return diagnostics
+	}
+	var single *jsparser.Error
+	if errors.As(err, &single) {
+		return []*executorV1.ScriptDiagnostic{syntaxError(single.Message, uint(max(single.Position.Line, 0)), uint(max(single.Position.Column, 0)))}
+	}
+	return []*executorV1.ScriptDiagnostic{syntaxError(err.Error(), 0, 0)}
+}
+
+// syntaxError builds an error diagnostic of a script parser
+func syntaxError(message string, line, column uint) *executorV1.ScriptDiagnostic {
+	return &executorV1.ScriptDiagnostic{
+		Severity: executorV1.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR,
+		Rule:     syntaxRule,
+		Message:  message,
+		Line:     uint32(min(line, math.MaxUint32)),
+		Column:   uint32(min(column, math.MaxUint32)),
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// secretRefPattern matches a secret reference such as {{secret "db_password"}}
+var secretRefPattern = regexp.MustCompile(`\{\{\s*secret\s+"([A-Za-z_][A-Za-z0-9_]{0,63})"\s*\}\}`)
+
+// SecretResolver fills secret references into script content when it is
+// handed to a client and masks the secret values in what comes back
+type SecretResolver struct {
+	log        *log.Helper
+	secretRepo *data.SecretRepo
+}
+
+// NewSecretResolver creates a new SecretResolver
+func NewSecretResolver(ctx *bootstrap.Context, secretRepo *data.SecretRepo) *SecretResolver {
+	return &SecretResolver{
+		log:        ctx.NewLoggerHelper("executor/service/secret_resolver"),
+		secretRepo: secretRepo,
+	}
+}
+
+// Check verifies that the secrets the content references can be resolved and
+// returns their names
+func (r *SecretResolver) Check(ctx context.Context, tenantID uint32, content string) ([]string, error) {
+	names := secretRefs(content)
+	if err := r.secretRepo.Check(ctx, tenantID, names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// RenderContent fills the secrets the content references into it
+func (r *SecretResolver) RenderContent(ctx context.Context, tenantID uint32, content string) (string, error) {
+	names := secretRefs(content)
+	if len(names) == 0 {
+		return content, nil
+	}
+
+	values, err := r.secretRepo.Reveal(ctx, tenantID, names)
+	if err != nil {
+		return "", err
+	}
+	return secretRefPattern.ReplaceAllStringFunc(content, func(ref string) string {
+		return values[secretRefPattern.FindStringSubmatch(ref)[1]]
+	}), nil
+}
+
+// Render returns a copy of a script command with the secrets filled into its
+// content and the content hash computed over the result. Commands without
+// secret references are returned as they are.
+func (r *SecretResolver) Render(ctx context.Context, tenantID uint32, cmd *executorV1.ExecutionCommand) (*executorV1.ExecutionCommand, error) {
+	if cmd.GetCommandType() != executorV1.CommandType_COMMAND_TYPE_SCRIPT_EXECUTION || !secretRefPattern.MatchString(cmd.GetContent()) {
+		return cmd, nil
+	}
+
+	content, err := r.RenderContent(ctx, tenantID, cmd.GetContent())
+	if err != nil {
+		return nil, err
+	}
+	rendered := proto.Clone(cmd).(*executorV1.ExecutionCommand)
+	rendered.Content = content
+	rendered.ContentHash = ComputeContentHash(content)
+	return rendered, nil
+}
+
+// Masker returns a function that masks the values of the named secrets and
+// of secret parameters in output. Secrets that can no longer be resolved are
+// logged and left out.
+func (r *SecretResolver) Masker(ctx context.Context, tenantID uint32, names []string, secretParameters map[string]string) func(string) string {
+	var values []string
+	if len(names) > 0 {
+		revealed, err := r.secretRepo.Reveal(ctx, tenantID, names)
+		if err != nil {
+			r.log.Warnf("Secrets %s cannot be masked in the output: %v", strings.Join(names, ", "), err)
+		}
+		values = slices.AppendSeq(values, maps.Values(revealed))
+	}
+	values = slices.AppendSeq(values, maps.Values(secretParameters))
+	return secretMasker(values)
+}
+
+// secretRefs returns the names of the secrets the content references, each once
+func secretRefs(content string) []string {
+	var names []string
+	for _, m := range secretRefPattern.FindAllStringSubmatch(content, -1) {
+		if !slices.Contains(names, m[1]) {
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
+
+// secretMasker returns a function that replaces the given values with the
+// mask, longest first so a value containing another is masked whole
+func secretMasker(values []string) func(string) string {
+	values = slices.DeleteFunc(values, func(v string) bool { return v == "" })
+	if len(values) == 0 {
+		return func(s string) string { return s }
+	}
+
+	slices.SortFunc(values, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+	pairs := make([]string, 0, 2*len(values))
+	for _, v := range values {
+		pairs = append(pairs, v, secretParameterMask)
+	}
+	replacer := strings.NewReplacer(pairs...)
+	return replacer.Replace
+}
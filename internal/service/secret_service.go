@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// maxSecretValueLen bounds the value of a secret
+const maxSecretValueLen = 64 * 1024
+
+// SecretService implements the ExecutorSecretService gRPC service
+type SecretService struct {
+	executorV1.UnimplementedExecutorSecretServiceServer
+
+	log        *log.Helper
+	secretRepo *data.SecretRepo
+}
+
+// NewSecretService creates a new SecretService
+func NewSecretService(
+	ctx *bootstrap.Context,
+	secretRepo *data.SecretRepo,
+) *SecretService {
+	return &SecretService{
+		log:        ctx.NewLoggerHelper("executor/service/secret"),
+		secretRepo: secretRepo,
+	}
+}
+
+// CreateSecret creates a secret
+func (s *SecretService) CreateSecret(ctx context.Context, req *executorV1.CreateSecretRequest) (*executorV1.CreateSecretResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	// Secret names follow the same rules as parameter names
+	if !parameterNamePattern.MatchString(req.Name) {
+		return nil, executorV1.ErrorBadRequest("invalid secret name %q: use letters, digits and underscores, not starting with a digit", req.Name)
+	}
+	if err := validateSecretValue(req.Value); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.secretRepo.Create(ctx, tenantID, req.Name, req.GetDescription(), req.Value, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Infof("Secret %s created in tenant %d", entity.Name, tenantID)
+
+	return &executorV1.CreateSecretResponse{
+		Secret: s.secretRepo.ToProto(entity),
+	}, nil
+}
+
+// ListSecrets lists the secrets of the tenant
+func (s *SecretService) ListSecrets(ctx context.Context, req *executorV1.ListSecretsRequest) (*executorV1.ListSecretsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.secretRepo.ListByTenant(ctx, tenantID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make([]*executorV1.Secret, 0, len(entities))
+	for _, e := range entities {
+		secrets = append(secrets, s.secretRepo.ToProto(e))
+	}
+
+	return &executorV1.ListSecretsResponse{
+		Secrets: secrets,
+		Total:   uint32(total),
+	}, nil
+}
+
+// GetSecret retrieves a secret
+func (s *SecretService) GetSecret(ctx context.Context, req *executorV1.GetSecretRequest) (*executorV1.GetSecretResponse, error) {
+	entity, err := s.getSecret(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetSecretResponse{
+		Secret: s.secretRepo.ToProto(entity),
+	}, nil
+}
+
+// UpdateSecret updates the description or the value of a secret
+func (s *SecretService) UpdateSecret(ctx context.Context, req *executorV1.UpdateSecretRequest) (*executorV1.UpdateSecretResponse, error) {
+	updatedBy := getUserIDAsUint32(ctx)
+
+	if _, err := s.getSecret(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	if req.Value != nil {
+		if err := validateSecretValue(*req.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	entity, err := s.secretRepo.Update(ctx, req.Id, req.Description, req.Value, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if req.Value != nil {
+		s.log.Infof("Value of secret %s replaced", entity.Name)
+	}
+
+	return &executorV1.UpdateSecretResponse{
+		Secret: s.secretRepo.ToProto(entity),
+	}, nil
+}
+
+// DeleteSecret deletes a secret
+func (s *SecretService) DeleteSecret(ctx context.Context, req *executorV1.DeleteSecretRequest) (*emptypb.Empty, error) {
+	entity, err := s.getSecret(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.secretRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	s.log.Infof("Secret %s deleted", entity.Name)
+	return &emptypb.Empty{}, nil
+}
+
+// getSecret loads a secret of the caller's tenant
+func (s *SecretService) getSecret(ctx context.Context, id string) (*ent.Secret, error) {
+	entity, err := s.secretRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorSecretNotFound("secret not found")
+	}
+	return entity, nil
+}
+
+// validateSecretValue checks a secret value sent by a client
+func validateSecretValue(value string) error {
+	if value == "" {
+		return executorV1.ErrorBadRequest("secret value must not be empty")
+	}
+	if len(value) > maxSecretValueLen {
+		return executorV1.ErrorBadRequest("secret value exceeds %d bytes", maxSecretValueLen)
+	}
+	return nil
+}
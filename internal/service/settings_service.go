@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// SettingsService implements the ExecutorSettingsService gRPC service
+type SettingsService struct {
+	executorV1.UnimplementedExecutorSettingsServiceServer
+
+	log           *log.Helper
+	settingsRepo  *data.TenantSettingRepo
+	protectedRepo *data.ProtectedClientRepo
+}
+
+// NewSettingsService creates a new SettingsService
+func NewSettingsService(ctx *bootstrap.Context, settingsRepo *data.TenantSettingRepo, protectedRepo *data.ProtectedClientRepo) *SettingsService {
+	return &SettingsService{
+		log:           ctx.NewLoggerHelper("executor/service/settings"),
+		settingsRepo:  settingsRepo,
+		protectedRepo: protectedRepo,
+	}
+}
+
+// GetSettings returns the settings of the caller's tenant
+func (s *SettingsService) GetSettings(ctx context.Context, _ *executorV1.GetSettingsRequest) (*executorV1.GetSettingsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetSettingsResponse{
+		Settings: s.settingsRepo.ToProto(tenantID, entity),
+	}, nil
+}
+
+// UpdateSettings updates the settings of the caller's tenant. Turning script
+// approval off, or changing the approver role while script approval is on or
+// clients are protected, is reserved to the current approvers.
+func (s *SettingsService) UpdateSettings(ctx context.Context, req *executorV1.UpdateSettingsRequest) (*executorV1.UpdateSettingsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	updatedBy := getUserIDAsUint32(ctx)
+
+	if req.DefaultTimeoutSeconds != nil && *req.DefaultTimeoutSeconds <= 0 {
+		return nil, executorV1.ErrorBadRequest("default timeout must be positive")
+	}
+
+	var maxConcurrent *int
+	if req.MaxConcurrentPerClient != nil {
+		if *req.MaxConcurrentPerClient > 1000 {
+			return nil, executorV1.ErrorBadRequest("max concurrent executions per client must be at most 1000")
+		}
+		v := int(*req.MaxConcurrentPerClient)
+		maxConcurrent = &v
+	}
+
+	var approverRole *string
+	if req.ScriptApproverRole != nil {
+		role := strings.TrimSpace(*req.ScriptApproverRole)
+		if strings.ContainsAny(role, ", \t") {
+			return nil, executorV1.ErrorBadRequest("script approver role must be a single role name")
+		}
+		approverRole = &role
+	}
+
+	if req.RequireScriptApproval != nil || approverRole != nil {
+		required, role, err := s.settingsRepo.GetScriptApproval(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		turnsOff := req.RequireScriptApproval != nil && !*req.RequireScriptApproval
+		changesRole := approverRole != nil && data.EffectiveScriptApproverRole(*approverRole) != role
+		if changesRole && !required {
+			// The role also approves executions on protected clients
+			if required, err = s.protectedRepo.ExistsForTenant(ctx, tenantID); err != nil {
+				return nil, err
+			}
+		}
+		if required && (turnsOff || changesRole) && !isScriptApprover(ctx, role) {
+			return nil, executorV1.ErrorForbidden("only users with the %s role can relax approval", role)
+		}
+	}
+
+	var approvalTTL *int
+	if req.ExecutionApprovalTtlMinutes != nil {
+		if *req.ExecutionApprovalTtlMinutes < 1 || *req.ExecutionApprovalTtlMinutes > 10080 {
+			return nil, executorV1.ErrorBadRequest("execution approval TTL must be between 1 and 10080 minutes")
+		}
+		v := int(*req.ExecutionApprovalTtlMinutes)
+		approvalTTL = &v
+	}
+
+	if req.ScriptBlockSeverity != nil {
+		if _, ok := executorV1.DiagnosticSeverity_name[int32(*req.ScriptBlockSeverity)]; !ok {
+			return nil, executorV1.ErrorBadRequest("unknown script block severity %d", *req.ScriptBlockSeverity)
+		}
+	}
+
+	var disabledLintRules *[]string
+	if req.DisabledLintRules != nil {
+		rules, err := normalizeLintRuleIDs(req.DisabledLintRules.Values)
+		if err != nil {
+			return nil, err
+		}
+		disabledLintRules = &rules
+	}
+
+	entity, err := s.settingsRepo.Update(ctx, tenantID, int32PtrToInt(req.DefaultTimeoutSeconds), maxConcurrent, req.RequireScriptApproval, approverRole, approvalTTL, req.ScriptBlockSeverity, disabledLintRules, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.UpdateSettingsResponse{
+		Settings: s.settingsRepo.ToProto(tenantID, entity),
+	}, nil
+}
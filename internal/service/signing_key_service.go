@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/signingkey"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+// SigningKeyService implements the ExecutorSigningKeyService gRPC service
+type SigningKeyService struct {
+	executorV1.UnimplementedExecutorSigningKeyServiceServer
+
+	log     *log.Helper
+	keyRepo *data.SigningKeyRepo
+	signer  *CommandSigner
+}
+
+// NewSigningKeyService creates a new SigningKeyService
+func NewSigningKeyService(
+	ctx *bootstrap.Context,
+	keyRepo *data.SigningKeyRepo,
+	signer *CommandSigner,
+) *SigningKeyService {
+	return &SigningKeyService{
+		log:     ctx.NewLoggerHelper("executor/service/signing_key"),
+		keyRepo: keyRepo,
+		signer:  signer,
+	}
+}
+
+// ListSigningKeys lists the signing keys
+func (s *SigningKeyService) ListSigningKeys(ctx context.Context, req *executorV1.ListSigningKeysRequest) (*executorV1.ListSigningKeysResponse, error) {
+	entities, err := s.keyRepo.List(ctx, req.GetIncludeRevoked())
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*executorV1.SigningKey, 0, len(entities))
+	for _, e := range entities {
+		keys = append(keys, s.signer.ToProto(e))
+	}
+
+	return &executorV1.ListSigningKeysResponse{
+		Keys: keys,
+	}, nil
+}
+
+// RotateSigningKey replaces the active signing key
+func (s *SigningKeyService) RotateSigningKey(ctx context.Context, req *executorV1.RotateSigningKeyRequest) (*executorV1.RotateSigningKeyResponse, error) {
+	if !grpcx.IsPlatformAdmin(ctx) {
+		return nil, executorV1.ErrorForbidden("only platform admins can rotate signing keys")
+	}
+	if _, ok := executorV1.SigningAlgorithm_name[int32(req.GetAlgorithm())]; !ok {
+		return nil, executorV1.ErrorBadRequest("unknown signing algorithm %d", req.GetAlgorithm())
+	}
+
+	entity, err := s.signer.Rotate(ctx, req.GetAlgorithm(), getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.RotateSigningKeyResponse{
+		Key: s.signer.ToProto(entity),
+	}, nil
+}
+
+// RevokeSigningKey revokes a signing key
+func (s *SigningKeyService) RevokeSigningKey(ctx context.Context, req *executorV1.RevokeSigningKeyRequest) (*executorV1.RevokeSigningKeyResponse, error) {
+	if !grpcx.IsPlatformAdmin(ctx) {
+		return nil, executorV1.ErrorForbidden("only platform admins can revoke signing keys")
+	}
+
+	entity, err := s.keyRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, executorV1.ErrorSigningKeyNotFound("signing key not found")
+	}
+	if entity.Status == signingkey.StatusREVOKED {
+		return &executorV1.RevokeSigningKeyResponse{Key: s.signer.ToProto(entity)}, nil
+	}
+
+	revoked, err := s.signer.Revoke(ctx, entity, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.RevokeSigningKeyResponse{
+		Key: s.signer.ToProto(revoked),
+	}, nil
+}
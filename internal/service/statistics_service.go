@@ -53,6 +53,12 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *executorV1.G
 		return nil, err
 	}
 
+	scriptsByType, err := s.repo.GetScriptCountByType(ctx, tenantID)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("failed to get script count by type: %v", err)
+		return nil, err
+	}
+
 	// Assignments
 	totalAssignments, err := s.repo.GetAssignmentCount(ctx, tenantID)
 	if err != nil {
@@ -106,6 +112,18 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *executorV1.G
 
 	rejectedExecutions := rejectedHash + rejectedApproval
 
+	timedOutExecutions, err := s.repo.GetExecutionCountByStatus(ctx, tenantID, executionlog.StatusTIMED_OUT)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("failed to get timed out execution count: %v", err)
+		return nil, err
+	}
+
+	cancelledExecutions, err := s.repo.GetExecutionCountByStatus(ctx, tenantID, executionlog.StatusCANCELLED)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("failed to get cancelled execution count: %v", err)
+		return nil, err
+	}
+
 	// Success rate
 	var successRate float64
 	if completedExecutions+failedExecutions > 0 {
@@ -163,5 +181,8 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *executorV1.G
 		ExecutionsLast_24H:  executionsLast24h,
 		ExecutionsLast_7D:   executionsLast7d,
 		RecentErrors:        recentErrors,
+		TimedOutExecutions:  timedOutExecutions,
+		CancelledExecutions: cancelledExecutions,
+		ScriptsByType:       scriptsByType,
 	}, nil
 }
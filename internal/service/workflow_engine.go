@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/executionlog"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent/workflowrun"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	workflowEnginePeriod = 10 * time.Second
+	// workflowStepClaimTimeout is how long a step may be claimed without an
+	// execution before it counts as failed, e.g. when a replica died while
+	// dispatching it
+	workflowStepClaimTimeout = 5 * time.Minute
+)
+
+// workflowStep is the state of a step of a workflow run, derived from the
+// run and the step's execution
+type workflowStep struct {
+	status  executorV1.WorkflowStepStatus
+	execLog *ent.ExecutionLog
+}
+
+func (s workflowStep) finished() bool {
+	switch s.status {
+	case executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_SUCCEEDED,
+		executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_FAILED,
+		executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_SKIPPED:
+		return true
+	}
+	return false
+}
+
+// WorkflowEngine advances workflow runs: once the dependencies of a step have
+// finished, it either dispatches the step's script to the run's client or
+// skips the step. Runs advance as soon as a step's result is reported and
+// on a periodic sweep, which also catches steps that timed out or were
+// cancelled. Steps are claimed with a compare-and-set on the run, so every
+// step is dispatched once even with several replicas.
+type WorkflowEngine struct {
+	log        *log.Helper
+	execSvc    *ExecutionService
+	runRepo    *data.WorkflowRunRepo
+	execRepo   *data.ExecutionLogRepo
+	scriptRepo *data.ScriptRepo
+	stop       chan struct{}
+}
+
+// NewWorkflowEngine creates a new WorkflowEngine
+func NewWorkflowEngine(
+	ctx *bootstrap.Context,
+	execSvc *ExecutionService,
+	runRepo *data.WorkflowRunRepo,
+	execRepo *data.ExecutionLogRepo,
+	scriptRepo *data.ScriptRepo,
+) *WorkflowEngine {
+	return &WorkflowEngine{
+		log:        ctx.NewLoggerHelper("executor/service/workflow_engine"),
+		execSvc:    execSvc,
+		runRepo:    runRepo,
+		execRepo:   execRepo,
+		scriptRepo: scriptRepo,
+		stop:       make(chan struct{}),
+	}
+}
+
+// Start runs the engine loop until Stop is called
+func (e *WorkflowEngine) Start(ctx context.Context) error {
+	ticker := time.NewTicker(workflowEnginePeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.sweep(viewer.NewSystemViewerContext(context.Background()))
+		case <-e.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop stops the engine loop
+func (e *WorkflowEngine) Stop(_ context.Context) error {
+	close(e.stop)
+	return nil
+}
+
+// sweep advances every running workflow run
+func (e *WorkflowEngine) sweep(ctx context.Context) {
+	runs, err := e.runRepo.ListRunning(ctx)
+	if err != nil {
+		e.log.Errorf("failed to list running workflow runs: %v", err)
+		return
+	}
+	for _, run := range runs {
+		e.Advance(ctx, run)
+	}
+}
+
+// ExecutionFinished advances the workflow run of a finished execution in the
+// background. Executions outside of workflows are ignored.
+func (e *WorkflowEngine) ExecutionFinished(execLog *ent.ExecutionLog) {
+	if execLog.WorkflowRunID == nil {
+		return
+	}
+	runID := *execLog.WorkflowRunID
+
+	go func() {
+		ctx := viewer.NewSystemViewerContext(context.Background())
+		run, err := e.runRepo.GetByID(ctx, runID)
+		if err != nil {
+			e.log.Errorf("failed to load workflow run %s: %v", runID, err)
+			return
+		}
+		if run == nil || run.Status != workflowrun.StatusRUNNING {
+			return
+		}
+		e.Advance(ctx, run)
+	}()
+}
+
+// Advance starts or skips every step of the run whose dependencies have
+// finished, and finishes the run once every step has.
+func (e *WorkflowEngine) Advance(ctx context.Context, run *ent.WorkflowRun) {
+	// Every round settles at least one step, unless another replica changed
+	// the run first; anything left over is picked up by the next sweep
+	for round := 0; round <= len(run.Steps)+1; round++ {
+		logs, err := e.execRepo.ListByWorkflowRuns(ctx, run.ID)
+		if err != nil {
+			e.log.Errorf("failed to load executions of workflow run %s: %v", run.ID, err)
+			return
+		}
+		steps := workflowStepStates(run, logs, time.Now())
+
+		start, skip := nextWorkflowSteps(run.Steps, steps)
+		if len(start) == 0 && len(skip) == 0 {
+			e.finish(ctx, run, steps)
+			return
+		}
+
+		started := append(slices.Clone(run.StartedSteps), workflowStepIDs(start)...)
+		skipped := append(slices.Clone(run.SkippedSteps), skip...)
+		claimed, err := e.runRepo.UpdateSteps(ctx, run.ID, run.Revision, started, skipped, run.FailedSteps)
+		if err != nil {
+			return
+		}
+		if claimed {
+			var failed []string
+			for _, step := range start {
+				if err = e.startStep(ctx, run, step); err != nil {
+					e.log.Errorf("Workflow run %s failed to start step %s: %v", run.ID, step.ID, err)
+					failed = append(failed, step.ID)
+				}
+			}
+			if len(failed) > 0 {
+				e.recordFailedSteps(ctx, run.ID, failed)
+			}
+		}
+
+		if run, err = e.runRepo.GetByID(ctx, run.ID); err != nil || run == nil || run.Status != workflowrun.StatusRUNNING {
+			return
+		}
+	}
+}
+
+// finish completes the run once every step has finished
+func (e *WorkflowEngine) finish(ctx context.Context, run *ent.WorkflowRun, steps map[string]workflowStep) {
+	status := workflowrun.StatusSUCCEEDED
+	for _, st := range steps {
+		if !st.finished() {
+			return
+		}
+		if st.status == executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_FAILED {
+			status = workflowrun.StatusFAILED
+		}
+	}
+
+	finished, err := e.runRepo.Finish(ctx, run.ID, status)
+	if err != nil || !finished {
+		return
+	}
+	e.log.Infof("Workflow run %s of workflow %s on client %s finished: %s", run.ID, run.WorkflowID, run.ClientID, status)
+}
+
+// startStep dispatches the script of a claimed step to the run's client
+func (e *WorkflowEngine) startStep(ctx context.Context, run *ent.WorkflowRun, step schema.WorkflowStep) error {
+	tenantID := derefTenantID(run.TenantID)
+
+	script, err := e.scriptRepo.GetByID(ctx, step.ScriptID)
+	if err != nil {
+		return err
+	}
+	if script == nil || derefTenantID(script.TenantID) != tenantID {
+		return executorV1.ErrorScriptNotFound("script not found")
+	}
+	if !script.Enabled {
+		return executorV1.ErrorScriptDisabled("script is disabled")
+	}
+
+	timeoutSeconds, err := resolveTimeoutSeconds(ctx, e.execSvc.settings, tenantID, script)
+	if err != nil {
+		return err
+	}
+
+	execLog, queued, err := e.execSvc.dispatch(ctx, tenantID, script, run.ClientID, timeoutSeconds, "WORKFLOW", run.CreateBy, &data.ExecutionOrigin{
+		WorkflowRunID:  &run.ID,
+		WorkflowStepID: step.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	e.log.Infof("Workflow run %s started step %s as execution %s on client %s (queued: %t)", run.ID, step.ID, execLog.ID, run.ClientID, queued)
+	return nil
+}
+
+// recordFailedSteps records steps whose execution could not be created
+func (e *WorkflowEngine) recordFailedSteps(ctx context.Context, runID string, stepIDs []string) {
+	for attempt := 0; attempt < 5; attempt++ {
+		run, err := e.runRepo.GetByID(ctx, runID)
+		if err != nil || run == nil || run.Status != workflowrun.StatusRUNNING {
+			return
+		}
+		failed := append(slices.Clone(run.FailedSteps), stepIDs...)
+		updated, err := e.runRepo.UpdateSteps(ctx, run.ID, run.Revision, run.StartedSteps, run.SkippedSteps, failed)
+		if err != nil || updated {
+			return
+		}
+	}
+	e.log.Warnf("Gave up recording failed steps %v of workflow run %s", stepIDs, runID)
+}
+
+// States returns the state of every step of the run, in step order. logs are
+// the run's executions, oldest first.
+func (e *WorkflowEngine) States(run *ent.WorkflowRun, logs []*ent.ExecutionLog) []*executorV1.WorkflowStepState {
+	steps := workflowStepStates(run, logs, time.Now())
+
+	states := make([]*executorV1.WorkflowStepState, 0, len(run.Steps))
+	for _, step := range run.Steps {
+		st := steps[step.ID]
+		state := &executorV1.WorkflowStepState{
+			StepId: step.ID,
+			Status: st.status,
+		}
+		if st.execLog != nil {
+			state.ExecutionId = &st.execLog.ID
+			if st.execLog.ExitCode != nil {
+				exitCode := int32(*st.execLog.ExitCode)
+				state.ExitCode = &exitCode
+			}
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+// workflowStepStates derives the state of every step of the run from the
+// steps it recorded and their executions, oldest first
+func workflowStepStates(run *ent.WorkflowRun, logs []*ent.ExecutionLog, now time.Time) map[string]workflowStep {
+	latest := make(map[string]*ent.ExecutionLog)
+	for _, l := range logs {
+		if l.WorkflowRunID != nil && *l.WorkflowRunID == run.ID {
+			latest[l.WorkflowStepID] = l
+		}
+	}
+
+	claimExpired := false
+	if run.UpdateTime != nil {
+		claimExpired = now.Sub(*run.UpdateTime) > workflowStepClaimTimeout
+	}
+
+	steps := make(map[string]workflowStep, len(run.Steps))
+	for _, step := range run.Steps {
+		st := workflowStep{status: executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_WAITING}
+		switch {
+		case slices.Contains(run.SkippedSteps, step.ID):
+			st.status = executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_SKIPPED
+		case latest[step.ID] != nil:
+			st.execLog = latest[step.ID]
+			st.status = workflowExecutionStatus(step, st.execLog)
+		case slices.Contains(run.FailedSteps, step.ID):
+			st.status = executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_FAILED
+		case slices.Contains(run.StartedSteps, step.ID):
+			st.status = executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_RUNNING
+			if claimExpired {
+				st.status = executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_FAILED
+			}
+		}
+		steps[step.ID] = st
+	}
+	return steps
+}
+
+// workflowExecutionStatus maps the execution of a step to the step's status.
+// An execution that is being retried keeps the step running.
+func workflowExecutionStatus(step schema.WorkflowStep, execLog *ent.ExecutionLog) executorV1.WorkflowStepStatus {
+	if execLog.RetryAt != nil || execLog.RetriedBy != nil {
+		return executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_RUNNING
+	}
+	switch execLog.Status {
+	case executionlog.StatusPENDING, executionlog.StatusRUNNING, executionlog.StatusAWAITING_APPROVAL:
+		return executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_RUNNING
+	case executionlog.StatusCOMPLETED, executionlog.StatusFAILED:
+		exitCode := int32(0)
+		if execLog.ExitCode != nil {
+			exitCode = int32(*execLog.ExitCode)
+		}
+		successCodes := step.SuccessExitCodes
+		if len(successCodes) == 0 {
+			successCodes = []int32{0}
+		}
+		if slices.Contains(successCodes, exitCode) {
+			return executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_SUCCEEDED
+		}
+		return executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_FAILED
+	default:
+		return executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_FAILED
+	}
+}
+
+// nextWorkflowSteps returns the waiting steps to start and to skip now that
+// their dependencies have finished. Skipping a step can settle the steps that
+// depend on it, so the decisions are repeated until nothing changes.
+func nextWorkflowSteps(defs []schema.WorkflowStep, steps map[string]workflowStep) ([]schema.WorkflowStep, []string) {
+	var start []schema.WorkflowStep
+	var skip []string
+
+	for changed := true; changed; {
+		changed = false
+		for _, step := range defs {
+			if steps[step.ID].status != executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_WAITING {
+				continue
+			}
+			ready := true
+			for _, dep := range step.DependsOn {
+				if !steps[dep].finished() {
+					ready = false
+					break
+				}
+			}
+			if !ready {
+				continue
+			}
+
+			changed = true
+			if workflowStepShouldRun(step, steps) {
+				start = append(start, step)
+				steps[step.ID] = workflowStep{status: executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_RUNNING}
+			} else {
+				skip = append(skip, step.ID)
+				steps[step.ID] = workflowStep{status: executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_SKIPPED}
+			}
+		}
+	}
+	return start, skip
+}
+
+// workflowStepShouldRun evaluates the condition and output pattern of a step
+// whose dependencies have all finished
+func workflowStepShouldRun(step schema.WorkflowStep, steps map[string]workflowStep) bool {
+	switch step.Condition {
+	case "ALWAYS":
+	case "ON_FAILURE":
+		failed := false
+		for _, dep := range step.DependsOn {
+			if steps[dep].status == executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_FAILED {
+				failed = true
+				break
+			}
+		}
+		if !failed {
+			return false
+		}
+	default:
+		for _, dep := range step.DependsOn {
+			if steps[dep].status != executorV1.WorkflowStepStatus_WORKFLOW_STEP_STATUS_SUCCEEDED {
+				return false
+			}
+		}
+	}
+
+	if step.OutputPattern == "" {
+		return true
+	}
+	pattern, err := regexp.Compile(step.OutputPattern)
+	if err != nil {
+		return false
+	}
+	for _, dep := range step.DependsOn {
+		if l := steps[dep].execLog; l != nil && !pattern.MatchString(l.Output) {
+			return false
+		}
+	}
+	return true
+}
+
+func workflowStepIDs(steps []schema.WorkflowStep) []string {
+	ids := make([]string, 0, len(steps))
+	for _, step := range steps {
+		ids = append(ids, step.ID)
+	}
+	return ids
+}
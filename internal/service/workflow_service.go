@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-executor/internal/data"
+	"github.com/go-tangra/go-tangra-executor/internal/data/ent"
+
+	executorV1 "github.com/go-tangra/go-tangra-executor/gen/go/executor/service/v1"
+)
+
+const (
+	maxWorkflowSteps         = 50
+	maxWorkflowOutputPattern = 1024
+)
+
+var workflowStepIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// WorkflowService implements the ExecutorWorkflowService gRPC service
+type WorkflowService struct {
+	executorV1.UnimplementedExecutorWorkflowServiceServer
+
+	log          *log.Helper
+	workflowRepo *data.WorkflowRepo
+	runRepo      *data.WorkflowRunRepo
+	execRepo     *data.ExecutionLogRepo
+	scriptRepo   *data.ScriptRepo
+	resolver     *AssignmentResolver
+	engine       *WorkflowEngine
+}
+
+// NewWorkflowService creates a new WorkflowService
+func NewWorkflowService(
+	ctx *bootstrap.Context,
+	workflowRepo *data.WorkflowRepo,
+	runRepo *data.WorkflowRunRepo,
+	execRepo *data.ExecutionLogRepo,
+	scriptRepo *data.ScriptRepo,
+	resolver *AssignmentResolver,
+	engine *WorkflowEngine,
+) *WorkflowService {
+	return &WorkflowService{
+		log:          ctx.NewLoggerHelper("executor/service/workflow"),
+		workflowRepo: workflowRepo,
+		runRepo:      runRepo,
+		execRepo:     execRepo,
+		scriptRepo:   scriptRepo,
+		resolver:     resolver,
+		engine:       engine,
+	}
+}
+
+// CreateWorkflow creates a workflow
+func (s *WorkflowService) CreateWorkflow(ctx context.Context, req *executorV1.CreateWorkflowRequest) (*executorV1.CreateWorkflowResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	if err := s.validateSteps(ctx, tenantID, req.Steps); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.workflowRepo.Create(ctx, tenantID, req.Name, req.GetDescription(), req.Steps, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.CreateWorkflowResponse{
+		Workflow: s.workflowRepo.ToProto(entity),
+	}, nil
+}
+
+// ListWorkflows lists the workflows of the tenant
+func (s *WorkflowService) ListWorkflows(ctx context.Context, req *executorV1.ListWorkflowsRequest) (*executorV1.ListWorkflowsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.workflowRepo.ListByTenant(ctx, tenantID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows := make([]*executorV1.Workflow, 0, len(entities))
+	for _, e := range entities {
+		workflows = append(workflows, s.workflowRepo.ToProto(e))
+	}
+
+	return &executorV1.ListWorkflowsResponse{
+		Workflows: workflows,
+		Total:     uint32(total),
+	}, nil
+}
+
+// GetWorkflow retrieves a workflow
+func (s *WorkflowService) GetWorkflow(ctx context.Context, req *executorV1.GetWorkflowRequest) (*executorV1.GetWorkflowResponse, error) {
+	entity, err := s.getWorkflow(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetWorkflowResponse{
+		Workflow: s.workflowRepo.ToProto(entity),
+	}, nil
+}
+
+// UpdateWorkflow updates a workflow. Runs already started keep the steps
+// they started with.
+func (s *WorkflowService) UpdateWorkflow(ctx context.Context, req *executorV1.UpdateWorkflowRequest) (*executorV1.UpdateWorkflowResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	updatedBy := getUserIDAsUint32(ctx)
+
+	if _, err := s.getWorkflow(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	steps := req.Steps
+	if len(steps) == 0 {
+		steps = nil
+	} else if err := s.validateSteps(ctx, tenantID, steps); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.workflowRepo.Update(ctx, req.Id, req.Name, req.Description, steps, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.UpdateWorkflowResponse{
+		Workflow: s.workflowRepo.ToProto(entity),
+	}, nil
+}
+
+// DeleteWorkflow deletes a workflow. Its runs are kept for the record.
+func (s *WorkflowService) DeleteWorkflow(ctx context.Context, req *executorV1.DeleteWorkflowRequest) (*emptypb.Empty, error) {
+	if _, err := s.getWorkflow(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	if err := s.workflowRepo.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// TriggerWorkflow starts a run of the workflow on a client. The steps without
+// dependencies are dispatched right away.
+func (s *WorkflowService) TriggerWorkflow(ctx context.Context, req *executorV1.TriggerWorkflowRequest) (*executorV1.TriggerWorkflowResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+
+	if req.ClientId == "" {
+		return nil, executorV1.ErrorBadRequest("client_id is required")
+	}
+
+	wf, err := s.getWorkflow(ctx, req.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every step must be allowed to run on the client before anything starts
+	for _, step := range wf.Steps {
+		assigned, aErr := s.resolver.IsAssigned(ctx, &tenantID, step.ScriptID, req.ClientId)
+		if aErr != nil {
+			return nil, aErr
+		}
+		if !assigned {
+			return nil, executorV1.ErrorClientNotAssigned("script of step %s is not assigned to this client", step.ID)
+		}
+	}
+
+	run, err := s.runRepo.Create(ctx, wf, req.ClientId, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.engine.Advance(ctx, run)
+
+	resp, err := s.GetWorkflowRun(ctx, &executorV1.GetWorkflowRunRequest{Id: run.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.TriggerWorkflowResponse{
+		Run: resp.Run,
+	}, nil
+}
+
+// GetWorkflowRun retrieves a workflow run with the status of each step
+func (s *WorkflowService) GetWorkflowRun(ctx context.Context, req *executorV1.GetWorkflowRunRequest) (*executorV1.GetWorkflowRunResponse, error) {
+	run, err := s.getRun(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := s.execRepo.ListByWorkflowRuns(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executorV1.GetWorkflowRunResponse{
+		Run: s.runRepo.ToProto(run, s.engine.States(run, logs)),
+	}, nil
+}
+
+// ListWorkflowRuns lists the workflow runs of the tenant
+func (s *WorkflowService) ListWorkflowRuns(ctx context.Context, req *executorV1.ListWorkflowRunsRequest) (*executorV1.ListWorkflowRunsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var page, pageSize uint32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	entities, total, err := s.runRepo.ListByTenant(ctx, tenantID, req.WorkflowId, req.ClientId, req.Status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	runIDs := make([]string, 0, len(entities))
+	for _, e := range entities {
+		runIDs = append(runIDs, e.ID)
+	}
+	logs, err := s.execRepo.ListByWorkflowRuns(ctx, runIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*executorV1.WorkflowRun, 0, len(entities))
+	for _, e := range entities {
+		runs = append(runs, s.runRepo.ToProto(e, s.engine.States(e, logs)))
+	}
+
+	return &executorV1.ListWorkflowRunsResponse{
+		Runs:  runs,
+		Total: uint32(total),
+	}, nil
+}
+
+// getWorkflow loads a workflow of the caller's tenant
+func (s *WorkflowService) getWorkflow(ctx context.Context, id string) (*ent.Workflow, error) {
+	entity, err := s.workflowRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorWorkflowNotFound("workflow not found")
+	}
+	return entity, nil
+}
+
+// getRun loads a workflow run of the caller's tenant
+func (s *WorkflowService) getRun(ctx context.Context, id string) (*ent.WorkflowRun, error) {
+	entity, err := s.runRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || derefTenantID(entity.TenantID) != getTenantIDFromContext(ctx) {
+		return nil, executorV1.ErrorWorkflowRunNotFound("workflow run not found")
+	}
+	return entity, nil
+}
+
+// validateSteps checks that the steps form a valid DAG of the tenant's scripts
+func (s *WorkflowService) validateSteps(ctx context.Context, tenantID uint32, steps []*executorV1.WorkflowStep) error {
+	if err := validateWorkflowSteps(steps); err != nil {
+		return err
+	}
+
+	checked := make(map[string]bool)
+	for _, step := range steps {
+		if checked[step.ScriptId] {
+			continue
+		}
+		script, err := s.scriptRepo.GetByID(ctx, step.ScriptId)
+		if err != nil {
+			return err
+		}
+		if script == nil || derefTenantID(script.TenantID) != tenantID {
+			return executorV1.ErrorInvalidWorkflow("step %s: script not found", step.Id)
+		}
+		checked[step.ScriptId] = true
+	}
+	return nil
+}
+
+// validateWorkflowSteps checks the structure of a workflow: unique step IDs,
+// known dependencies without cycles, and well-formed conditions
+func validateWorkflowSteps(steps []*executorV1.WorkflowStep) error {
+	if len(steps) == 0 {
+		return executorV1.ErrorInvalidWorkflow("a workflow needs at least one step")
+	}
+	if len(steps) > maxWorkflowSteps {
+		return executorV1.ErrorInvalidWorkflow("a workflow has at most %d steps", maxWorkflowSteps)
+	}
+
+	ids := make(map[string]bool, len(steps))
+	for _, step := range steps {
+		if !workflowStepIDPattern.MatchString(step.Id) {
+			return executorV1.ErrorInvalidWorkflow("step id %q must be 1-64 letters, digits, '-' or '_'", step.Id)
+		}
+		if ids[step.Id] {
+			return executorV1.ErrorInvalidWorkflow("duplicate step id %q", step.Id)
+		}
+		ids[step.Id] = true
+	}
+
+	for _, step := range steps {
+		if step.ScriptId == "" {
+			return executorV1.ErrorInvalidWorkflow("step %s: script_id is required", step.Id)
+		}
+		if _, ok := executorV1.WorkflowStepCondition_name[int32(step.Condition)]; !ok {
+			return executorV1.ErrorInvalidWorkflow("step %s: unknown condition", step.Id)
+		}
+
+		seen := make(map[string]bool, len(step.DependsOn))
+		for _, dep := range step.DependsOn {
+			if dep == step.Id {
+				return executorV1.ErrorInvalidWorkflow("step %s depends on itself", step.Id)
+			}
+			if !ids[dep] {
+				return executorV1.ErrorInvalidWorkflow("step %s depends on unknown step %q", step.Id, dep)
+			}
+			if seen[dep] {
+				return executorV1.ErrorInvalidWorkflow("step %s lists dependency %q twice", step.Id, dep)
+			}
+			seen[dep] = true
+		}
+
+		if len(step.DependsOn) == 0 {
+			if step.Condition != executorV1.WorkflowStepCondition_WORKFLOW_STEP_CONDITION_UNSPECIFIED &&
+				step.Condition != executorV1.WorkflowStepCondition_WORKFLOW_STEP_CONDITION_ON_SUCCESS {
+				return executorV1.ErrorInvalidWorkflow("step %s has no dependencies to apply its condition to", step.Id)
+			}
+			if step.GetOutputPattern() != "" {
+				return executorV1.ErrorInvalidWorkflow("step %s has no dependencies to match its output pattern against", step.Id)
+			}
+		}
+
+		for _, code := range step.SuccessExitCodes {
+			if code < 0 || code > 255 {
+				return executorV1.ErrorInvalidWorkflow("step %s: success exit codes must be between 0 and 255", step.Id)
+			}
+		}
+
+		if pattern := step.GetOutputPattern(); pattern != "" {
+			if len(pattern) > maxWorkflowOutputPattern {
+				return executorV1.ErrorInvalidWorkflow("step %s: output pattern exceeds %d characters", step.Id, maxWorkflowOutputPattern)
+			}
+			if _, err := regexp.Compile(pattern); err != nil {
+				return executorV1.ErrorInvalidWorkflow("step %s: invalid output pattern: %v", step.Id, err)
+			}
+		}
+	}
+
+	// Kahn's algorithm: every step must become ready once its dependencies are
+	remaining := make(map[string]int, len(steps))
+	dependents := make(map[string][]string, len(steps))
+	for _, step := range steps {
+		remaining[step.Id] = len(step.DependsOn)
+		for _, dep := range step.DependsOn {
+			dependents[dep] = append(dependents[dep], step.Id)
+		}
+	}
+	var ready []string
+	for _, step := range steps {
+		if remaining[step.Id] == 0 {
+			ready = append(ready, step.Id)
+		}
+	}
+	visited := 0
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		visited++
+		for _, next := range dependents[id] {
+			remaining[next]--
+			if remaining[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+	if visited != len(steps) {
+		return executorV1.ErrorInvalidWorkflow("the step dependencies form a cycle")
+	}
+
+	return nil
+}